@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// purgeSoftDeletedCommand purges accounts whose deletion grace period has ended
+func (a *app) purgeSoftDeletedCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-soft-deleted",
+		Short: "Purge accounts whose deletion grace period has ended",
+		Long: "Purge every account scheduled for deletion whose grace period has ended, as the\n" +
+			"server does on ACCOUNT_PURGE_INTERVAL. Accounts still in their grace period are left alone.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			deletionService := service.NewAccountDeletionService(
+				postgres.NewAccountDeletionRepository(db),
+				postgres.NewUserRepository(db),
+				postgres.NewRefreshTokenRepository(db),
+				postgres.NewAuditLogRepository(db),
+				a.cfg.Account.DeletionGracePeriod,
+			)
+
+			// PurgeDue works in batches; stop once a batch purges nothing, which also ends the loop
+			// when only accounts that fail to purge remain
+			total := 0
+			for {
+				purged, err := deletionService.PurgeDue(ctx)
+				if err != nil {
+					return err
+				}
+				if purged == 0 {
+					break
+				}
+				total += purged
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Purged %d accounts\n", total)
+			return nil
+		},
+	}
+}
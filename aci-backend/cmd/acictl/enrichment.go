@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// maxBackfillBatch is the largest batch EnrichPendingArticles accepts
+const maxBackfillBatch = 100
+
+// backfillEnrichmentCommand enriches articles that were never enriched
+func (a *app) backfillEnrichmentCommand() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "backfill-enrichment",
+		Short: "Enrich articles that have not been enriched yet",
+		Long: "Run AI enrichment for unenriched articles in batches, recording usage against the\n" +
+			"monthly AI budget exactly as the server does. Stops after --limit articles have been\n" +
+			"attempted or when no unenriched articles remain.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			enrichmentService, err := a.newEnrichmentService(cmd, db)
+			if err != nil {
+				return err
+			}
+
+			enriched := 0
+			for remaining := limit; remaining > 0; {
+				batch := min(remaining, maxBackfillBatch)
+
+				count, err := enrichmentService.EnrichPendingArticles(ctx, batch)
+				enriched += count
+				if err != nil {
+					return fmt.Errorf("enriched %d articles before failing: %w", enriched, err)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Enriched %d of %d articles in batch\n", count, batch)
+
+				// A short or empty batch means the backlog is drained or every remaining article fails
+				if count < batch {
+					break
+				}
+				remaining -= batch
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Enriched %d articles\n", enriched)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", maxBackfillBatch, "maximum number of articles to enrich")
+
+	return cmd
+}
+
+// newEnrichmentService wires enrichment with the same summaries, IOC extraction, usage tracking,
+// and response cache as the server
+func (a *app) newEnrichmentService(cmd *cobra.Command, db *postgres.DB) (*service.EnrichmentService, error) {
+	ctx := cmd.Context()
+
+	aiClient, err := ai.NewClient(ai.Config{
+		Provider:   ai.ProviderType(a.cfg.AI.Provider),
+		APIKey:     a.cfg.AI.APIKey(),
+		Model:      a.cfg.AI.Model,
+		BaseURL:    a.cfg.AI.BaseURL,
+		APIVersion: a.cfg.AI.APIVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize AI client: %w", err)
+	}
+
+	var pricingOverride *ai.ModelPricing
+	if a.cfg.AI.InputCostPerMTok > 0 || a.cfg.AI.OutputCostPerMTok > 0 {
+		pricingOverride = &ai.ModelPricing{
+			InputPerMTok:  a.cfg.AI.InputCostPerMTok,
+			OutputPerMTok: a.cfg.AI.OutputCostPerMTok,
+		}
+	}
+	aiUsageService := service.NewAIUsageService(postgres.NewAIUsageRepository(db), a.cfg.AI.MonthlyBudgetUSD, pricingOverride)
+	if err := aiUsageService.Load(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load month-to-date AI spend: %w", err)
+	}
+	aiClient.SetUsageRecorder(aiUsageService)
+
+	if a.cfg.AI.CacheEnabled {
+		aiClient.SetResponseCache(service.NewAICacheService(postgres.NewAIResponseCacheRepository(db), a.cfg.AI.CacheTTL))
+	}
+
+	articleRepo := postgres.NewArticleRepository(db)
+	enricher := ai.NewEnricher(aiClient)
+	enricher.SetPromptSource(service.NewEnrichmentPromptService(
+		postgres.NewEnrichmentPromptRepository(db),
+		articleRepo,
+		postgres.NewAuditLogRepository(db),
+		enricher,
+	))
+	enrichmentService := service.NewEnrichmentService(enricher, articleRepo)
+	enrichmentService.SetSummarizeService(service.NewSummarizeService(
+		ai.NewSummarizer(aiClient),
+		articleRepo,
+		postgres.NewArticleSummaryRepository(db),
+	))
+	enrichmentService.SetAIUsageService(aiUsageService)
+	enrichmentService.SetIOCService(service.NewIOCService(postgres.NewIOCRepository(db)))
+	enrichmentService.SetThreatActorService(service.NewThreatActorService(postgres.NewThreatActorRepository(db), articleRepo))
+	enrichmentService.SetBatching(service.EnrichmentBatchConfig{
+		MaxArticles:      a.cfg.Enrichment.BatchSize,
+		MaxTokens:        a.cfg.Enrichment.BatchMaxTokens,
+		MaxArticleTokens: a.cfg.Enrichment.BatchMaxArticleTokens,
+	})
+
+	return enrichmentService, nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// importArticlesCommand backfills historical articles from a JSONL or CSV dump
+func (a *app) importArticlesCommand() *cobra.Command {
+	var format, resume string
+
+	cmd := &cobra.Command{
+		Use:   "import-articles FILE",
+		Short: "Import historical articles from a JSONL or CSV dump",
+		Long: "Stream articles from FILE through the same validation and duplicate detection as\n" +
+			"the n8n webhook and store them as historical articles: published without review,\n" +
+			"unenriched, and not matched against alerts. Every record needs a category_slug.\n" +
+			"Progress is checkpointed every 100 records; pass --resume with the import ID to\n" +
+			"continue a failed or interrupted import from the same file. Run backfill-enrichment\n" +
+			"and reindex-search afterwards.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			path := args[0]
+
+			if format == "" {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+			}
+			if !domain.IsValidArticleImportFormat(format) {
+				return fmt.Errorf("--format must be jsonl or csv")
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("failed to open import file: %w", err)
+			}
+			defer file.Close()
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			importService := a.newArticleImportService(cmd, db)
+
+			var articleImport *domain.ArticleImport
+			if resume != "" {
+				importID, err := uuid.Parse(resume)
+				if err != nil {
+					return fmt.Errorf("invalid --resume import ID: %w", err)
+				}
+
+				articleImport, err = importService.Resume(ctx, importID, nil, "", "")
+				if err != nil {
+					return err
+				}
+				if articleImport.Format != format {
+					return fmt.Errorf("import %s reads %s, not %s", articleImport.ID, articleImport.Format, format)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Resuming import %s after record %d\n", articleImport.ID, articleImport.Processed)
+			} else {
+				articleImport, err = importService.Start(ctx, format, filepath.Base(path), nil, "", "")
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Started import %s\n", articleImport.ID)
+			}
+
+			progress := func(articleImport *domain.ArticleImport) {
+				fmt.Fprintf(cmd.OutOrStdout(), "%d records: %d created, %d duplicates, %d failed\n",
+					articleImport.Processed, articleImport.Created, articleImport.Duplicates, articleImport.Failed)
+			}
+
+			processErr := importService.Process(ctx, articleImport, file, progress)
+
+			progress(articleImport)
+			for _, importErr := range articleImport.Errors {
+				fmt.Fprintf(cmd.ErrOrStderr(), "record %d: %s\n", importErr.Record, importErr.Message)
+			}
+			if articleImport.Failed > len(articleImport.Errors) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "... and %d more failed records\n", articleImport.Failed-len(articleImport.Errors))
+			}
+
+			if processErr != nil {
+				return fmt.Errorf("import %s stopped after record %d, resume with --resume %s: %w",
+					articleImport.ID, articleImport.Processed, articleImport.ID, processErr)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "", "jsonl or csv (default: the file extension)")
+	cmd.Flags().StringVar(&resume, "resume", "", "ID of a failed or interrupted import to continue")
+
+	return cmd
+}
+
+// newArticleImportService wires article creation with the same duplicate detection, tags,
+// IOC extraction and relevance rules as the server
+func (a *app) newArticleImportService(cmd *cobra.Command, db *postgres.DB) *service.ArticleImportService {
+	ctx := cmd.Context()
+
+	articleRepo := postgres.NewArticleRepository(db)
+	categoryRepo := postgres.NewCategoryRepository(db)
+	auditLogRepo := postgres.NewAuditLogRepository(db)
+
+	articleService := service.NewArticleService(articleRepo, categoryRepo, postgres.NewSourceRepository(db), postgres.NewWebhookLogRepository(db))
+	articleService.SetTxManager(db)
+	articleService.SetDeduplicationService(service.NewDeduplicationService(
+		postgres.NewArticleFingerprintRepository(db),
+		articleRepo,
+		a.cfg.Deduplication.MaxDistance,
+		a.cfg.Deduplication.Window,
+	))
+	articleService.SetIdentityService(service.NewArticleIdentityService(
+		postgres.NewArticleIdentityRepository(db),
+		a.cfg.Deduplication.ResolveRedirects,
+		a.cfg.Deduplication.RedirectTimeout,
+	))
+	articleService.SetIOCService(service.NewIOCService(postgres.NewIOCRepository(db)))
+	articleService.SetThreatActorService(service.NewThreatActorService(postgres.NewThreatActorRepository(db), articleRepo))
+	articleService.SetTagService(service.NewTagService(postgres.NewTagRepository(db), auditLogRepo))
+
+	relevanceScorer := service.NewRelevanceScorer()
+	articleService.SetRelevanceScorer(relevanceScorer)
+	relevanceRulesService := service.NewRelevanceRulesService(postgres.NewRelevanceRulesRepository(db), articleRepo, auditLogRepo, relevanceScorer)
+	if err := relevanceRulesService.Load(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to load relevance rules, using defaults")
+	}
+
+	return service.NewArticleImportService(postgres.NewArticleImportRepository(db), auditLogRepo, articleService)
+}
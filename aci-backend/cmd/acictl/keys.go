@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+)
+
+// rotateJWTKeysCommand replaces the token signing key pair
+func (a *app) rotateJWTKeysCommand() *cobra.Command {
+	var bits int
+
+	cmd := &cobra.Command{
+		Use:   "rotate-jwt-keys",
+		Short: "Generate a new JWT signing key pair",
+		Long: "Generate a new RSA key pair at JWT_PRIVATE_KEY_PATH and JWT_PUBLIC_KEY_PATH.\n" +
+			"The current keys are kept alongside with a timestamp suffix. Tokens signed with the\n" +
+			"old key stop validating once servers restart with the new keys, so every user must sign in again.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privatePEM, publicPEM, err := jwt.GenerateKeyPair(bits)
+			if err != nil {
+				return err
+			}
+
+			suffix := ".bak-" + time.Now().UTC().Format("20060102T150405Z")
+			privatePath := a.cfg.JWT.PrivateKeyPath
+			publicPath := a.cfg.JWT.PublicKeyPath
+
+			for _, path := range []string{privatePath, publicPath} {
+				if err := backupFile(path, path+suffix); err != nil {
+					return err
+				}
+			}
+
+			if err := os.WriteFile(privatePath, privatePEM, 0o600); err != nil {
+				return fmt.Errorf("failed to write private key: %w", err)
+			}
+			if err := os.WriteFile(publicPath, publicPEM, 0o644); err != nil {
+				return fmt.Errorf("failed to write public key: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Wrote new %d-bit key pair to %s and %s\n", bits, privatePath, publicPath)
+			fmt.Fprintf(out, "Previous keys saved with suffix %s\n", suffix)
+			fmt.Fprintln(out, "Restart every server instance to start signing with the new key")
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&bits, "bits", jwt.DefaultKeyBits, "RSA key size in bits")
+
+	return cmd
+}
+
+// backupFile renames path to backup; a missing file needs no backup
+func backupFile(path, backup string) error {
+	if err := os.Rename(path, backup); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	return nil
+}
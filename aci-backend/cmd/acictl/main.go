@@ -0,0 +1,94 @@
+// Command acictl runs operational tasks against an ACI backend deployment
+// It reads the same environment as the server and uses the same services and repositories
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/config"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+)
+
+// app holds state shared by all subcommands
+type app struct {
+	cfg *config.Config
+}
+
+func main() {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	// Interrupting a long task cancels its context so work in progress stops cleanly
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := newRootCommand().ExecuteContext(ctx); err != nil {
+		stop()
+		os.Exit(1)
+	}
+}
+
+// newRootCommand builds the acictl command tree
+func newRootCommand() *cobra.Command {
+	a := &app{}
+
+	root := &cobra.Command{
+		Use:          "acictl",
+		Short:        "Operational tasks for the ACI backend",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load configuration: %w", err)
+			}
+			a.cfg = cfg
+			return nil
+		},
+	}
+
+	root.AddCommand(
+		a.createAdminUserCommand(),
+		a.rotateJWTKeysCommand(),
+		a.reindexSearchCommand(),
+		a.backfillEnrichmentCommand(),
+		a.importArticlesCommand(),
+		a.replayWebhookCommand(),
+		a.purgeSoftDeletedCommand(),
+	)
+
+	return root
+}
+
+// openDB connects to the configured database with a small pool suited to one-off tasks
+// The returned func closes the pool
+func (a *app) openDB(ctx context.Context) (*postgres.DB, func(), error) {
+	poolConfig, err := pgxpool.ParseConfig(a.cfg.Database.URL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse database URL: %w", err)
+	}
+
+	poolConfig.MaxConns = 4
+	poolConfig.MaxConnIdleTime = time.Minute
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create database pool: %w", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &postgres.DB{Pool: pool}, pool.Close, nil
+}
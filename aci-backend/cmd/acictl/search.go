@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+)
+
+// reindexSearchCommand rebuilds the article search indexes
+func (a *app) reindexSearchCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reindex-search",
+		Short: "Rebuild the article search indexes",
+		Long: "Rebuild the full-text, tag, CVE, and vendor indexes used by search without blocking\n" +
+			"writes, then refresh planner statistics. Use after bulk imports or when search slows down.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			start := time.Now()
+			if err := postgres.NewSearchRepository(db).Reindex(ctx); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Search indexes rebuilt in %s\n", time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	}
+}
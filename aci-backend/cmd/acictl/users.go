@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// createAdminUserCommand creates an administrator account
+func (a *app) createAdminUserCommand() *cobra.Command {
+	var email, name string
+
+	cmd := &cobra.Command{
+		Use:   "create-admin-user",
+		Short: "Create an administrator account",
+		Long: "Create an administrator account with the same validation as registration.\n" +
+			"The password is read from the first line of stdin so it does not appear in shell history.",
+		Example: "  echo \"$ADMIN_PASSWORD\" | acictl create-admin-user --email admin@example.com --name \"Ops Admin\"",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			password, err := readPassword()
+			if err != nil {
+				return err
+			}
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			jwtService, err := jwt.NewService(&jwt.Config{
+				PrivateKeyPath: a.cfg.JWT.PrivateKeyPath,
+				PublicKeyPath:  a.cfg.JWT.PublicKeyPath,
+				Issuer:         "aci-backend",
+			})
+			if err != nil {
+				return fmt.Errorf("failed to initialize JWT service: %w", err)
+			}
+
+			authService := service.NewAuthService(
+				postgres.NewUserRepository(db),
+				postgres.NewRefreshTokenRepository(db),
+				jwtService,
+			)
+
+			user, err := authService.CreateAdmin(ctx, email, password, name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Created admin user %s (%s)\n", user.Email, user.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "email address of the new admin (required)")
+	cmd.Flags().StringVar(&name, "name", "", "display name of the new admin (required)")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+// readPassword reads a password from the first line of stdin
+func readPassword() (string, error) {
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read password from stdin: %w", err)
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
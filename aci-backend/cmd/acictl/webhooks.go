@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+)
+
+// webhookPath is the n8n webhook route relative to the server URL
+const webhookPath = "/v1/webhooks/n8n"
+
+// replayWebhookCommand re-delivers a logged webhook payload to a running server
+func (a *app) replayWebhookCommand() *cobra.Command {
+	var serverURL string
+
+	cmd := &cobra.Command{
+		Use:   "replay-webhook <webhook-log-id>",
+		Short: "Re-deliver a logged n8n webhook to the server",
+		Long: "Load a webhook payload from the webhook log and POST it to the server's n8n webhook,\n" +
+			"signed with N8N_WEBHOOK_SECRET. The server processes it as a new delivery and logs it\n" +
+			"again, so a failed ingestion can be retried after the cause is fixed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			logID, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid webhook log ID: %w", err)
+			}
+
+			if serverURL == "" {
+				serverURL = fmt.Sprintf("http://localhost:%d", a.cfg.Server.Port)
+			}
+
+			db, closeDB, err := a.openDB(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			webhookLog, err := postgres.NewWebhookLogRepository(db).GetByID(ctx, logID)
+			if err != nil {
+				return err
+			}
+
+			// A fresh timestamp makes this a new delivery rather than a replay of the original
+			body := []byte(webhookLog.Payload)
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			mac := hmac.New(sha256.New, []byte(a.cfg.N8N.WebhookSecret))
+			mac.Write([]byte(timestamp + "."))
+			mac.Write(body)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(serverURL, "/")+webhookPath, bytes.NewReader(body))
+			if err != nil {
+				return fmt.Errorf("failed to build request: %w", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-N8N-Timestamp", timestamp)
+			req.Header.Set("X-N8N-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+			client := &http.Client{Timeout: 30 * time.Second}
+			resp, err := client.Do(req)
+			if err != nil {
+				return fmt.Errorf("failed to deliver webhook: %w", err)
+			}
+			defer resp.Body.Close()
+
+			respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+			if resp.StatusCode >= 300 {
+				return fmt.Errorf("server responded %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Replayed %s webhook %s: %s\n", webhookLog.EventType, webhookLog.ID, resp.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&serverURL, "server", "", "base URL of the server (default http://localhost:$PORT)")
+
+	return cmd
+}
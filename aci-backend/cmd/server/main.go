@@ -2,25 +2,36 @@ package main
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
 	"github.com/phillipboles/aci-backend/internal/ai"
 	"github.com/phillipboles/aci-backend/internal/api"
+	"github.com/phillipboles/aci-backend/internal/api/graph"
 	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/captcha"
 	"github.com/phillipboles/aci-backend/internal/config"
+	"github.com/phillipboles/aci-backend/internal/crm"
+	"github.com/phillipboles/aci-backend/internal/email"
+	"github.com/phillipboles/aci-backend/internal/metrics"
 	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/repository"
 	"github.com/phillipboles/aci-backend/internal/repository/postgres"
 	"github.com/phillipboles/aci-backend/internal/service"
+	"github.com/phillipboles/aci-backend/internal/siem"
+	"github.com/phillipboles/aci-backend/internal/storage"
+	"github.com/phillipboles/aci-backend/internal/tracing"
 	"github.com/phillipboles/aci-backend/internal/websocket"
 )
 
@@ -37,21 +48,56 @@ func main() {
 		log.Fatal().Err(err).Msg("Failed to load configuration")
 	}
 
+	setLogLevel(cfg.Logger.Level)
+
 	log.Info().
 		Int("port", cfg.Server.Port).
 		Str("log_level", cfg.Logger.Level).
+		Str("config_file", cfg.File()).
 		Msg("Configuration loaded")
 
+	// Initialize tracing before anything that creates spans
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Enabled:     cfg.Tracing.Enabled,
+		ServiceName: cfg.Tracing.ServiceName,
+		Endpoint:    cfg.Tracing.OTLPEndpoint,
+		Insecure:    cfg.Tracing.OTLPInsecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize tracing")
+	}
+
 	// Initialize database connection using pgxpool
 	poolConfig, err := pgxpool.ParseConfig(cfg.Database.URL)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to parse database URL")
 	}
 
-	poolConfig.MaxConns = 25
-	poolConfig.MinConns = 5
-	poolConfig.MaxConnLifetime = time.Hour
-	poolConfig.MaxConnIdleTime = 30 * time.Minute
+	queryTracers := []pgx.QueryTracer{
+		postgres.NewQueryLimiter(cfg.Database.QueryTimeout, cfg.Database.SlowQueryThreshold),
+	}
+
+	if cfg.Tracing.Enabled {
+		queryTracers = append(queryTracers, tracing.NewQueryTracer())
+		log.Info().
+			Str("endpoint", cfg.Tracing.OTLPEndpoint).
+			Float64("sample_ratio", cfg.Tracing.SampleRatio).
+			Msg("Tracing enabled")
+	}
+
+	// Replicas share the primary's pool settings and query tracers
+	configurePool := func(poolConfig *pgxpool.Config) {
+		poolConfig.ConnConfig.Tracer = multitracer.New(queryTracers...)
+		poolConfig.MaxConns = int32(cfg.Database.MaxConns)
+		poolConfig.MinConns = int32(cfg.Database.MinConns)
+		poolConfig.MaxConnLifetime = cfg.Database.MaxConnLifetime
+		poolConfig.MaxConnIdleTime = cfg.Database.MaxConnIdleTime
+		if cfg.Tenancy.Enabled {
+			postgres.ScopeTenants(poolConfig)
+		}
+	}
+	configurePool(poolConfig)
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
@@ -65,20 +111,26 @@ func main() {
 
 	log.Info().Msg("Database connection established")
 
-	// Create postgres.DB wrapper for pgx-based repositories
+	// Create postgres.DB wrapper for the repositories
 	db := &postgres.DB{Pool: pool}
 
-	// Create database/sql connection for repositories that still require it
-	// (article_read_repo, audit_log_repo, bookmark_repo)
-	connString := stdlib.RegisterConnConfig(poolConfig.ConnConfig)
-	sqlDB, err := sql.Open("pgx", connString)
-	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to open sql.DB connection")
+	for i, replicaURL := range cfg.Database.ReplicaURLs {
+		replicaConfig, err := pgxpool.ParseConfig(replicaURL)
+		if err != nil {
+			log.Fatal().Err(err).Int("replica", i).Msg("Failed to parse database replica URL")
+		}
+		configurePool(replicaConfig)
+
+		replicaPool, err := pgxpool.NewWithConfig(ctx, replicaConfig)
+		if err != nil {
+			log.Fatal().Err(err).Int("replica", i).Msg("Failed to create database replica pool")
+		}
+		db.AddReplica(ctx, replicaPool)
 	}
-	defer sqlDB.Close()
 
-	if err := sqlDB.Ping(); err != nil {
-		log.Fatal().Err(err).Msg("Failed to ping sql.DB connection")
+	if len(cfg.Database.ReplicaURLs) > 0 {
+		healthy, total := db.HealthyReplicas()
+		log.Info().Int("healthy", healthy).Int("replicas", total).Msg("Database read replicas configured")
 	}
 
 	// Initialize JWT service
@@ -95,15 +147,51 @@ func main() {
 
 	// Initialize AI client and enricher
 	aiClient, err := ai.NewClient(ai.Config{
-		APIKey: cfg.AI.AnthropicAPIKey,
-		Model:  "claude-3-haiku-20240307",
+		Provider:   ai.ProviderType(cfg.AI.Provider),
+		APIKey:     cfg.AI.APIKey(),
+		Model:      cfg.AI.Model,
+		BaseURL:    cfg.AI.BaseURL,
+		APIVersion: cfg.AI.APIVersion,
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize AI client")
 	}
 
 	enricher := ai.NewEnricher(aiClient)
-	log.Info().Msg("AI enrichment service initialized")
+	summarizer := ai.NewSummarizer(aiClient)
+	classifier := ai.NewClassifier(aiClient)
+	if aiClient.Enabled() {
+		log.Info().Str("provider", aiClient.ProviderName()).Msg("AI enrichment service initialized")
+	} else {
+		log.Warn().Msg("AI provider disabled: articles are not enriched and summaries are canned")
+	}
+
+	// Track AI token usage and cost; the monthly budget pauses non-critical enrichment
+	var pricingOverride *ai.ModelPricing
+	if cfg.AI.InputCostPerMTok > 0 || cfg.AI.OutputCostPerMTok > 0 {
+		pricingOverride = &ai.ModelPricing{
+			InputPerMTok:  cfg.AI.InputCostPerMTok,
+			OutputPerMTok: cfg.AI.OutputCostPerMTok,
+		}
+	}
+	aiUsageService := service.NewAIUsageService(postgres.NewAIUsageRepository(db), cfg.AI.MonthlyBudgetUSD, pricingOverride)
+	if err := aiUsageService.Load(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to load month-to-date AI spend")
+	}
+	aiClient.SetUsageRecorder(aiUsageService)
+
+	// Serve repeated prompts (bulk re-imports, retries) from the response cache
+	var aiCacheService *service.AICacheService
+	if cfg.AI.CacheEnabled {
+		aiCacheService = service.NewAICacheService(postgres.NewAIResponseCacheRepository(db), cfg.AI.CacheTTL)
+		if purged, err := aiCacheService.PurgeExpired(ctx); err != nil {
+			log.Warn().Err(err).Msg("Failed to purge expired AI cache entries")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("Purged expired AI cache entries")
+		}
+		aiClient.SetResponseCache(aiCacheService)
+		log.Info().Dur("ttl", cfg.AI.CacheTTL).Msg("AI response cache enabled")
+	}
 
 	// Initialize repositories
 	// Repositories using postgres.DB (pgx-based)
@@ -115,11 +203,31 @@ func main() {
 	webhookLogRepo := postgres.NewWebhookLogRepository(db)
 	alertRepo := postgres.NewAlertRepository(db)
 	alertMatchRepo := postgres.NewAlertMatchRepository(db)
-
-	// Repositories still using *sql.DB
-	bookmarkRepo := postgres.NewBookmarkRepository(sqlDB)
-	articleReadRepo := postgres.NewArticleReadRepository(sqlDB)
-	_ = postgres.NewAuditLogRepository(sqlDB) // TODO: Wire into AdminService once UserRepository type mismatch is resolved
+	searchRepo := postgres.NewSearchRepository(db)
+	notificationTemplateRepo := postgres.NewNotificationTemplateRepository(db)
+	ingestLatencyRepo := postgres.NewIngestLatencyRepository(db)
+	articleSummaryRepo := postgres.NewArticleSummaryRepository(db)
+	classificationRepo := postgres.NewClassificationSuggestionRepository(db)
+	fingerprintRepo := postgres.NewArticleFingerprintRepository(db)
+	iocRepo := postgres.NewIOCRepository(db)
+	organizationRepo := postgres.NewOrganizationRepository(db)
+	collectionRepo := postgres.NewBookmarkCollectionRepository(db)
+	accountDeletionRepo := postgres.NewAccountDeletionRepository(db)
+	feedPreferenceRepo := postgres.NewFeedPreferenceRepository(db)
+	analyticsRepo := postgres.NewAnalyticsRepository(db)
+	threatLandscapeRepo := postgres.NewThreatLandscapeRepository(db)
+	articleReviewRepo := postgres.NewArticleReviewRepository(db)
+	featuredArticleRepo := postgres.NewFeaturedArticleRepository(db)
+	tagRepo := postgres.NewTagRepository(db)
+	sourceTrustRepo := postgres.NewSourceTrustRepository(db)
+	relevanceRulesRepo := postgres.NewRelevanceRulesRepository(db)
+	ctaVariantRepo := postgres.NewCTAVariantRepository(db)
+	publicAPIKeyRepo := postgres.NewPublicAPIKeyRepository(db)
+	auditOutboxRepo := postgres.NewAuditOutboxRepository(db)
+	bookmarkRepo := postgres.NewBookmarkRepository(db)
+	articleReadRepo := postgres.NewArticleReadRepository(db)
+	auditLogRepo := postgres.NewAuditLogRepository(db)
+	registrationPolicyRepo := postgres.NewRegistrationPolicyRepository(db)
 
 	log.Info().Msg("Repositories initialized")
 
@@ -129,28 +237,554 @@ func main() {
 		MaxChannelsPerClient:  50,
 	})
 
-	// Start hub in background
-	go hub.Run()
+	// Start hub in background; stopped after connections are drained on shutdown
+	hubCtx, hubCancel := context.WithCancel(ctx)
+	defer hubCancel()
+	go hub.Run(hubCtx)
 	log.Info().Msg("WebSocket hub started")
 
 	// Initialize services
 	authService := service.NewAuthService(userRepo, tokenRepo, jwtService)
 	articleService := service.NewArticleService(articleRepo, categoryRepo, sourceRepo, webhookLogRepo)
 	alertService := service.NewAlertService(alertRepo, alertMatchRepo, articleRepo)
+	articleService.SetTxManager(db)
+	articleService.SetAlertService(alertService)
 	searchService := service.NewSearchService(articleRepo)
+	searchService.SetSuggestionRepository(searchRepo)
+	if cfg.Search.CacheTTL > 0 {
+		searchService.EnableCache(categoryRepo, cfg.Search.CacheTTL)
+		articleService.SetSearchService(searchService)
+	}
+	globalSearchService := service.NewGlobalSearchService(searchRepo)
+	notificationTemplateService := service.NewNotificationTemplateService(notificationTemplateRepo)
+	ingestSLOService := service.NewIngestSLOService(ingestLatencyRepo, cfg.SLO.IngestLatencyBudget, cfg.SLO.IngestObjective)
+	articleService.SetIngestSLOService(ingestSLOService)
+	classificationService := service.NewClassificationService(
+		classifier,
+		classificationRepo,
+		articleRepo,
+		categoryRepo,
+		cfg.Classification.AutoApplyThreshold,
+		cfg.Classification.FallbackCategory,
+	)
+	if cfg.Classification.Enabled {
+		articleService.SetClassificationService(classificationService)
+	}
+	deduplicationService := service.NewDeduplicationService(
+		fingerprintRepo,
+		articleRepo,
+		cfg.Deduplication.MaxDistance,
+		cfg.Deduplication.Window,
+	)
+	articleService.SetDeduplicationService(deduplicationService)
+	articleIdentityService := service.NewArticleIdentityService(
+		postgres.NewArticleIdentityRepository(db),
+		cfg.Deduplication.ResolveRedirects,
+		cfg.Deduplication.RedirectTimeout,
+	)
+	articleService.SetIdentityService(articleIdentityService)
+	iocService := service.NewIOCService(iocRepo)
+	articleService.SetIOCService(iocService)
+	threatActorService := service.NewThreatActorService(postgres.NewThreatActorRepository(db), articleRepo)
+	articleService.SetThreatActorService(threatActorService)
+	incidentService := service.NewIncidentService(postgres.NewIncidentRepository(db), articleRepo)
+	tagService := service.NewTagService(tagRepo, auditLogRepo)
+	articleService.SetTagService(tagService)
+	classificationService.SetTagService(tagService)
+	sourceTrustService := service.NewSourceTrustService(sourceTrustRepo, auditLogRepo, cfg.Trust.Window, cfg.Trust.MaxDelta, cfg.Trust.MinArticles)
+	articleService.SetSourceTrustService(sourceTrustService)
+
+	// Relevance scoring rules are editable at runtime; saved rules replace the built-in defaults
+	relevanceScorer := service.NewRelevanceScorer()
+	articleService.SetRelevanceScorer(relevanceScorer)
+	relevanceRulesService := service.NewRelevanceRulesService(relevanceRulesRepo, articleRepo, auditLogRepo, relevanceScorer)
+	if err := relevanceRulesService.Load(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to load relevance rules, using defaults")
+	}
 	engagementService := service.NewEngagementService(bookmarkRepo, articleReadRepo, articleRepo)
 	enrichmentService := service.NewEnrichmentService(enricher, articleRepo)
 
+	// Admin-managed prompts replace the built-in ones once a version is activated
+	enrichmentPromptService := service.NewEnrichmentPromptService(postgres.NewEnrichmentPromptRepository(db), articleRepo, auditLogRepo, enricher)
+	enricher.SetPromptSource(enrichmentPromptService)
+
+	summarizeService := service.NewSummarizeService(summarizer, articleRepo, articleSummaryRepo)
+	feedPreferenceService := service.NewFeedPreferenceService(feedPreferenceRepo, categoryRepo)
+
+	// Watched vendors get a raised-priority alert each and are ranked first in the feed
+	vendorWatchlistService := service.NewVendorWatchlistService(postgres.NewVendorWatchlistRepository(db), alertService)
+	feedPreferenceService.SetVendorWatchlistService(vendorWatchlistService)
+	analyticsService := service.NewAnalyticsService(analyticsRepo)
+
+	// Client engagement events are buffered, written in batches and aggregated for the analytics dashboard
+	var clientEventService *service.ClientEventService
+	if cfg.Events.Enabled {
+		clientEventService = service.NewClientEventService(
+			postgres.NewClientEventRepository(db),
+			cfg.Events.BatchSize,
+			cfg.Events.BufferLimit,
+			cfg.Events.RetentionMonths,
+		)
+	}
+
+	// Newsletters are sent through the SMTP relay only when enabled
+	var newsletterService *service.NewsletterService
+	var smtpSender *email.SMTPSender
+	if cfg.Newsletter.Enabled {
+		smtpSender, err = email.NewSMTPSender(email.SMTPConfig{
+			Host:     cfg.Newsletter.SMTPHost,
+			Port:     cfg.Newsletter.SMTPPort,
+			Username: cfg.Newsletter.SMTPUsername,
+			Password: cfg.Newsletter.SMTPPassword,
+			From:     cfg.Newsletter.From,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize newsletter email sender")
+		}
+		newsletterService = service.NewNewsletterService(
+			postgres.NewNewsletterRepository(db),
+			articleRepo,
+			smtpSender,
+			service.NewsletterServiceConfig{
+				BaseURL:     cfg.Newsletter.BaseURL,
+				SiteURL:     cfg.Newsletter.SiteURL,
+				BatchSize:   cfg.Newsletter.BatchSize,
+				TokenSecret: cfg.Newsletter.TokenSecret,
+				ConfirmTTL:  cfg.Newsletter.ConfirmTTL,
+			},
+		)
+	}
+
+	// Weekly threat briefings; emailing them to weekly digest readers reuses the newsletter relay
+	reportService := service.NewReportService(postgres.NewReportRepository(db), service.ReportServiceConfig{
+		SiteURL:   cfg.Newsletter.SiteURL,
+		BatchSize: cfg.Reports.EmailBatchSize,
+	})
+	if cfg.Reports.EmailEnabled {
+		reportService.SetMailer(smtpSender)
+	}
+
+	// CTA clicks and lead form submissions are pushed to the CRM only when enabled
+	var crmService *service.CRMService
+	if cfg.CRM.Enabled {
+		connector, err := crm.NewConnector(crm.Config{
+			Connector:              crm.ConnectorType(cfg.CRM.Connector),
+			FieldMap:               cfg.CRM.FieldMap,
+			HubSpotToken:           cfg.CRM.HubSpotToken,
+			SalesforceInstanceURL:  cfg.CRM.SalesforceInstanceURL,
+			SalesforceClientID:     cfg.CRM.SalesforceClientID,
+			SalesforceClientSecret: cfg.CRM.SalesforceClientSecret,
+			SalesforceAPIVersion:   cfg.CRM.SalesforceAPIVersion,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize CRM connector")
+		}
+		crmService = service.NewCRMService(
+			postgres.NewCRMEventRepository(db),
+			userRepo,
+			organizationRepo,
+			articleRepo,
+			connector,
+			service.CRMServiceConfig{
+				SiteURL:     cfg.CRM.SiteURL,
+				BatchSize:   cfg.CRM.BatchSize,
+				MaxAttempts: cfg.CRM.MaxAttempts,
+			},
+		)
+	}
+	threatLandscapeService := service.NewThreatLandscapeService(threatLandscapeRepo)
+	featuredArticleService := service.NewFeaturedArticleService(featuredArticleRepo, articleRepo)
+	ctaExperimentService := service.NewCTAExperimentService(ctaVariantRepo, articleRepo, auditLogRepo)
+	if crmService != nil {
+		ctaExperimentService.SetCRMService(crmService)
+	}
+	publicAPIKeyService := service.NewPublicAPIKeyService(publicAPIKeyRepo, auditLogRepo, cfg.PublicAPI.DefaultKeyRequestsPerMinute)
+
+	// Logins, password changes, alert changes and API key use are recorded for each user's security activity
+	securityEventService := service.NewSecurityEventService(auditLogRepo)
+	authService.SetSecurityEventService(securityEventService)
+	alertService.SetSecurityEventService(securityEventService)
+	publicAPIKeyService.SetSecurityEventService(securityEventService)
+
+	enrichmentService.SetSummarizeService(summarizeService)
+	enrichmentService.SetAIUsageService(aiUsageService)
+	enrichmentService.SetIOCService(iocService)
+	enrichmentService.SetThreatActorService(threatActorService)
+	enrichmentService.SetBatching(service.EnrichmentBatchConfig{
+		MaxArticles:      cfg.Enrichment.BatchSize,
+		MaxTokens:        cfg.Enrichment.BatchMaxTokens,
+		MaxArticleTokens: cfg.Enrichment.BatchMaxArticleTokens,
+	})
+
+	// Enriched articles and their IOCs are forwarded to the configured SIEM destinations only when enabled
+	var siemService *service.SIEMService
+	if cfg.SIEM.Enabled {
+		var forwarders []siem.Forwarder
+		if cfg.SIEM.SplunkHECURL != "" {
+			forwarder, err := siem.NewSplunkForwarder(siem.SplunkConfig{
+				URL:   cfg.SIEM.SplunkHECURL,
+				Token: cfg.SIEM.SplunkHECToken,
+				Index: cfg.SIEM.SplunkIndex,
+			})
+			if err != nil {
+				log.Fatal().Err(err).Msg("Failed to initialize Splunk forwarder")
+			}
+			forwarders = append(forwarders, forwarder)
+		}
+		if cfg.SIEM.ElasticsearchURL != "" {
+			forwarder, err := siem.NewElasticsearchForwarder(siem.ElasticsearchConfig{
+				URL:      cfg.SIEM.ElasticsearchURL,
+				APIKey:   cfg.SIEM.ElasticsearchAPIKey,
+				Username: cfg.SIEM.ElasticsearchUsername,
+				Password: cfg.SIEM.ElasticsearchPassword,
+				Index:    cfg.SIEM.ElasticsearchIndex,
+			})
+			if err != nil {
+				log.Fatal().Err(err).Msg("Failed to initialize Elasticsearch forwarder")
+			}
+			forwarders = append(forwarders, forwarder)
+		}
+		siemService = service.NewSIEMService(postgres.NewSIEMRepository(db), forwarders, service.SIEMServiceConfig{
+			SiteURL:     cfg.SIEM.SiteURL,
+			BatchSize:   cfg.SIEM.BatchSize,
+			MaxAttempts: cfg.SIEM.MaxAttempts,
+			Retention:   cfg.SIEM.Retention,
+		})
+		enrichmentService.SetSIEMService(siemService)
+	}
+
+	// Organizations share alerts and bookmark collections across their members
+	organizationService := service.NewOrganizationService(organizationRepo)
+	authService.SetOrganizationService(organizationService)
+	alertService.SetOrganizationService(organizationService)
+
+	// Organization reports are generated on their schedule and emailed through the newsletter relay when it is enabled
+	orgReportService := service.NewOrganizationReportService(
+		postgres.NewOrganizationReportRepository(db),
+		reportService,
+		organizationService,
+		service.OrganizationReportServiceConfig{BatchSize: cfg.Reports.ScheduleBatchSize},
+	)
+	if smtpSender != nil {
+		orgReportService.SetMailer(smtpSender)
+	}
+
+	// Organizations label matching articles with their own categories as they are ingested
+	orgCategoryService := service.NewOrgCategoryService(postgres.NewOrgCategoryRepository(db), articleRepo, organizationService)
+	articleService.SetOrgCategoryService(orgCategoryService)
+
+	// Articles about CVEs in the CISA KEV catalog are flagged and their alert matches raised
+	kevService := service.NewKEVService(postgres.NewKEVRepository(db), cfg.KEV.FeedURL, cfg.KEV.Timeout)
+	alertService.SetKEVService(kevService)
+
+	// Articles about CVEs with a public exploit are flagged and can raise exploit alerts
+	exploitService := service.NewExploitService(
+		postgres.NewExploitRepository(db),
+		articleRepo,
+		cfg.Exploit.ExploitDBURL,
+		cfg.Exploit.GitHubPoCURL,
+		cfg.Exploit.Lookback,
+		cfg.Exploit.GitHubMaxCVEs,
+		cfg.Exploit.Timeout,
+	)
+	exploitService.SetAlertService(alertService)
+	alertService.SetExploitService(exploitService)
+
+	// Articles about CVEs affecting a product in the reader's software inventory are flagged,
+	// matched against the affected products NVD lists for each CVE
+	nvdRepo := postgres.NewNVDRepository(db)
+	softwareInventoryService := service.NewSoftwareInventoryService(postgres.NewSoftwareInventoryRepository(db), nvdRepo, organizationService)
+	nvdService := service.NewNVDService(
+		nvdRepo,
+		cfg.NVD.APIURL,
+		cfg.NVD.APIKey,
+		cfg.NVD.Lookback,
+		cfg.NVD.RefreshAfter,
+		cfg.NVD.MaxCVEs,
+		cfg.NVD.RequestInterval,
+		cfg.NVD.Timeout,
+	)
+	nvdService.SetSoftwareInventoryService(softwareInventoryService)
+	collectionService := service.NewBookmarkCollectionService(collectionRepo, articleRepo, organizationService)
+	annotationService := service.NewAnnotationService(postgres.NewAnnotationRepository(db), articleRepo, organizationService)
+
+	// Self-service account deletion: sessions end at once, data is purged after the grace period
+	accountDeletionService := service.NewAccountDeletionService(accountDeletionRepo, userRepo, tokenRepo, auditLogRepo, cfg.Account.DeletionGracePeriod)
+	authService.SetAccountDeletionService(accountDeletionService)
+
+	// Admin suspensions and bans: sessions end at once and blocked users are refused on every request
+	userStatusService := service.NewUserStatusService(userRepo, tokenRepo, auditOutboxRepo, db)
+
+	// Self-registration can be closed or limited to email domains; admins override the configured defaults
+	registrationPolicyService := service.NewRegistrationPolicyService(registrationPolicyRepo, auditLogRepo,
+		cfg.Registration.Enabled, cfg.Registration.AllowedDomains)
+	authService.SetRegistrationPolicyService(registrationPolicyService)
+
+	// Admin invitations create accounts whose invitees choose their password through an emailed link
+	var userInviteHandler *handlers.UserInviteHandler
+	if cfg.Invites.Enabled && smtpSender != nil {
+		userInviteService := service.NewUserInviteService(userRepo, auditLogRepo, authService, smtpSender, service.UserInviteServiceConfig{
+			SiteURL:     cfg.Newsletter.SiteURL,
+			TokenSecret: cfg.Invites.TokenSecret,
+			TTL:         cfg.Invites.TTL,
+		})
+		userInviteService.SetOrganizationService(organizationService)
+		userInviteHandler = handlers.NewUserInviteHandler(userInviteService)
+	}
+
+	// Audit log archives, source page snapshots and article images go to object storage only when a bucket is configured
+	var auditArchiveStore service.AuditArchiveStore
+	var articleArchiveService *service.ArticleArchiveService
+	var articleImageService *service.ArticleImageService
+	if cfg.Storage.Bucket != "" {
+		s3Store, err := storage.NewS3Store(storage.S3Config{
+			Endpoint:        cfg.Storage.Endpoint,
+			Region:          cfg.Storage.Region,
+			Bucket:          cfg.Storage.Bucket,
+			AccessKeyID:     cfg.Storage.AccessKeyID,
+			SecretAccessKey: cfg.Storage.SecretAccessKey,
+			UsePathStyle:    cfg.Storage.UsePathStyle,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize object storage")
+		}
+		auditArchiveStore = s3Store
+		if cfg.Archive.Enabled {
+			articleArchiveService = service.NewArticleArchiveService(postgres.NewArticleArchiveRepository(db), s3Store, cfg.Archive.Prefix)
+		}
+		if cfg.Images.Enabled {
+			articleImageService = service.NewArticleImageService(postgres.NewArticleImageRepository(db), s3Store, cfg.Images.Prefix)
+		}
+	}
+	auditRetention := time.Duration(cfg.Audit.RetentionDays) * 24 * time.Hour
+	auditLogRetentionService := service.NewAuditLogRetentionService(auditLogRepo, auditLogRepo, auditArchiveStore, auditRetention, cfg.Audit.ArchivePrefix)
+
 	// NOTE: AdminService initialization blocked due to interface mismatch
 	// UserRepository expects domain.User but postgres.UserRepository uses entities.User
 	// This needs to be resolved before AdminService can be initialized
-	// adminService := service.NewAdminService(articleRepo, sourceRepo, userRepo, auditLogRepo)
+	// adminService := service.NewAdminService(articleRepo, sourceRepo, userRepo, auditLogRepo, auditOutboxRepo, db)
 
 	notificationService, err := service.NewNotificationService(hub)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize notification service")
 	}
 
+	// Per-user notification preferences gate realtime and alert deliveries
+	notificationPreferenceService := service.NewNotificationPreferenceService(postgres.NewNotificationPreferenceRepository(db))
+	notificationService.SetPreferenceService(notificationPreferenceService)
+
+	// New alerts created with backfill_days send their owner a summary of past matches
+	alertService.SetNotificationService(notificationService)
+
+	// Members mentioned in an analyst annotation are notified in realtime
+	annotationService.SetNotificationService(notificationService)
+
+	// Review mode holds new articles unpublished until an admin approves them; the queue
+	// stays manageable after review mode is switched off
+	articleReviewService := service.NewArticleReviewService(articleReviewRepo, articleRepo, auditLogRepo)
+	articleReviewService.SetNotificationService(notificationService)
+	if cfg.Review.Enabled {
+		articleService.SetReviewService(articleReviewService)
+	}
+
+	// Maintenance jobs serve every workspace; in multi-tenant mode anything else started here runs
+	// in the default workspace, so newsletters, email reports and CRM sync only cover its data
+	systemCtx := repository.WithoutTenant(ctx)
+
+	// Enrich articles missed by inline enrichment (failures, restarts, skipped backlogs)
+	enrichmentWorker := service.NewEnrichmentWorker(enrichmentService, articleRepo, service.EnrichmentWorkerConfig{
+		Concurrency:   cfg.Enrichment.Concurrency,
+		RatePerMinute: cfg.Enrichment.RatePerMinute,
+		PollInterval:  cfg.Enrichment.PollInterval,
+	})
+
+	workerCtx, workerCancel := context.WithCancel(systemCtx)
+	defer workerCancel()
+	workerDone := make(chan struct{})
+	if cfg.Enrichment.WorkerEnabled && enrichmentService.Enabled() {
+		go func() {
+			defer close(workerDone)
+			enrichmentWorker.Run(workerCtx)
+		}()
+	} else {
+		close(workerDone)
+	}
+
+	// Alert when the ingest-to-notify latency error budget is exhausted
+	sloCtx, sloCancel := context.WithCancel(systemCtx)
+	defer sloCancel()
+	go ingestSLOService.Monitor(sloCtx, time.Minute, time.Hour)
+
+	// Take replicas out of rotation while they fail health checks
+	replicaCtx, replicaCancel := context.WithCancel(ctx)
+	defer replicaCancel()
+	go db.MonitorReplicas(replicaCtx, cfg.Database.ReplicaCheckInterval)
+
+	// Purge accounts whose deletion grace period has ended
+	purgeCtx, purgeCancel := context.WithCancel(systemCtx)
+	defer purgeCancel()
+	go accountDeletionService.Run(purgeCtx, cfg.Account.PurgeInterval)
+
+	// Delete refresh tokens that expired or were revoked before the retention period
+	tokenCleanupCtx, tokenCleanupCancel := context.WithCancel(systemCtx)
+	defer tokenCleanupCancel()
+	tokenCleanupService := service.NewRefreshTokenCleanupService(tokenRepo, cfg.Tokens.Retention)
+	go tokenCleanupService.Run(tokenCleanupCtx, cfg.Tokens.CleanupInterval)
+
+	// Move audit logs past their retention period to object storage
+	auditArchiveCtx, auditArchiveCancel := context.WithCancel(systemCtx)
+	defer auditArchiveCancel()
+	if cfg.Audit.RetentionDays > 0 {
+		go auditLogRetentionService.Run(auditArchiveCtx, cfg.Audit.ArchiveInterval)
+	}
+
+	// Snapshot the source pages of newly ingested articles
+	articleArchiveCtx, articleArchiveCancel := context.WithCancel(systemCtx)
+	defer articleArchiveCancel()
+	if articleArchiveService != nil {
+		go articleArchiveService.Run(articleArchiveCtx, cfg.Archive.Interval)
+	}
+
+	// Resize and store the hero images of newly ingested articles
+	articleImageCtx, articleImageCancel := context.WithCancel(systemCtx)
+	defer articleImageCancel()
+	if articleImageService != nil {
+		go articleImageService.Run(articleImageCtx, cfg.Images.Interval)
+	}
+
+	// Deliver audit logs staged in the outbox alongside the changes they record
+	auditRelayCtx, auditRelayCancel := context.WithCancel(systemCtx)
+	defer auditRelayCancel()
+	go service.NewAuditOutboxRelay(auditOutboxRepo).Run(auditRelayCtx, cfg.Audit.OutboxRelayInterval)
+
+	// Nudge source trust scores towards their ingestion and engagement signals
+	trustCtx, trustCancel := context.WithCancel(systemCtx)
+	defer trustCancel()
+	if cfg.Trust.CalibrationEnabled {
+		go sourceTrustService.Run(trustCtx, cfg.Trust.CalibrationInterval)
+	}
+
+	// Keep the KEV catalog current
+	kevCtx, kevCancel := context.WithCancel(systemCtx)
+	defer kevCancel()
+	if cfg.KEV.SyncEnabled {
+		go kevService.Run(kevCtx, cfg.KEV.SyncInterval)
+	}
+
+	// Keep public exploits current and raise exploit alerts for articles they flag
+	exploitCtx, exploitCancel := context.WithCancel(systemCtx)
+	defer exploitCancel()
+	if cfg.Exploit.SyncEnabled {
+		go exploitService.Run(exploitCtx, cfg.Exploit.SyncInterval)
+	}
+
+	// Keep NVD affected products current and rematch software inventories against them
+	nvdCtx, nvdCancel := context.WithCancel(systemCtx)
+	defer nvdCancel()
+	if cfg.NVD.SyncEnabled {
+		go nvdService.Run(nvdCtx, cfg.NVD.SyncInterval)
+	}
+
+	// Write buffered client events and keep their partitions and daily aggregates current
+	clientEventCtx, clientEventCancel := context.WithCancel(systemCtx)
+	defer clientEventCancel()
+	if clientEventService != nil {
+		go clientEventService.Run(clientEventCtx, cfg.Events.FlushInterval, cfg.Events.AggregationInterval)
+	}
+
+	// Send queued newsletter deliveries
+	newsletterCtx, newsletterCancel := context.WithCancel(ctx)
+	defer newsletterCancel()
+	if newsletterService != nil {
+		go newsletterService.Run(newsletterCtx, cfg.Newsletter.SendInterval)
+	}
+
+	// Email last week's briefing to readers on the weekly digest
+	reportCtx, reportCancel := context.WithCancel(ctx)
+	defer reportCancel()
+	if cfg.Reports.EmailEnabled {
+		go reportService.Run(reportCtx, cfg.Reports.EmailInterval)
+	}
+
+	// Generate organization reports whose period has ended
+	orgReportCtx, orgReportCancel := context.WithCancel(ctx)
+	defer orgReportCancel()
+	go orgReportService.Run(orgReportCtx, cfg.Reports.ScheduleInterval)
+
+	// Push queued CTA clicks and lead form submissions to the CRM
+	crmCtx, crmCancel := context.WithCancel(ctx)
+	defer crmCancel()
+	if crmService != nil {
+		go crmService.Run(crmCtx, cfg.CRM.Interval)
+	}
+
+	// Forward queued enrichment events to the SIEM destinations
+	siemCtx, siemCancel := context.WithCancel(systemCtx)
+	defer siemCancel()
+	if siemService != nil {
+		go siemService.Run(siemCtx, cfg.SIEM.Interval)
+	}
+
+	// Forget webhook signatures once their timestamps can no longer be replayed
+	webhookReplayService := service.NewWebhookReplayService(postgres.NewWebhookNonceRepository(db), cfg.N8N.MaxSkew)
+	webhookNonceCtx, webhookNonceCancel := context.WithCancel(systemCtx)
+	defer webhookNonceCancel()
+	go webhookReplayService.Run(webhookNonceCtx, cfg.N8N.MaxSkew)
+
+	// Accept every active webhook secret version and pick up rotations made through other instances
+	webhookSecretService := service.NewWebhookSecretService(postgres.NewWebhookSecretRepository(db), auditLogRepo, cfg.N8N.WebhookSecret, cfg.N8N.SecretGracePeriod, cfg.N8N.SecretKeyBytes())
+	if err := webhookSecretService.Load(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to load webhook secrets, using N8N_WEBHOOK_SECRET")
+	}
+	webhookSecretCtx, webhookSecretCancel := context.WithCancel(systemCtx)
+	defer webhookSecretCancel()
+	go webhookSecretService.Run(webhookSecretCtx, time.Minute)
+
+	// Resolve workspace hostnames and pick up workspaces created through other instances
+	var tenantHandler *handlers.TenantHandler
+	if cfg.Tenancy.Enabled {
+		tenantService := service.NewTenantService(postgres.NewTenantRepository(db), auditLogRepo, cfg.Tenancy.BaseDomain)
+		if err := tenantService.Load(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load tenants")
+		}
+		tenantCtx, tenantCancel := context.WithCancel(systemCtx)
+		defer tenantCancel()
+		go tenantService.Run(tenantCtx, time.Minute)
+		tenantHandler = handlers.NewTenantHandler(tenantService)
+
+		log.Info().Str("base_domain", cfg.Tenancy.BaseDomain).Msg("Multi-tenant mode enabled")
+	}
+
+	// Pick up relevance rules saved through other instances
+	rulesCtx, rulesCancel := context.WithCancel(systemCtx)
+	defer rulesCancel()
+	go relevanceRulesService.Run(rulesCtx, time.Minute)
+
+	// SIGHUP re-reads CONFIG_FILE and applies rate limits and feature flags without a restart
+	configReloader := config.NewReloader(cfg)
+	configReloader.OnReload(func(cfg *config.Config) {
+		setLogLevel(cfg.Logger.Level)
+		aiUsageService.SetMonthlyBudget(cfg.AI.MonthlyBudgetUSD)
+		enrichmentWorker.SetRatePerMinute(cfg.Enrichment.RatePerMinute)
+		publicAPIKeyService.SetDefaultRate(cfg.PublicAPI.DefaultKeyRequestsPerMinute)
+
+		if cfg.Review.Enabled {
+			articleService.SetReviewService(articleReviewService)
+		} else {
+			articleService.SetReviewService(nil)
+		}
+
+		if cfg.Classification.Enabled {
+			articleService.SetClassificationService(classificationService)
+		} else {
+			articleService.SetClassificationService(nil)
+		}
+	})
+
+	reloadCtx, reloadCancel := context.WithCancel(ctx)
+	defer reloadCancel()
+	go configReloader.Run(reloadCtx)
+
 	log.Info().Msg("Services initialized")
 
 	// Initialize WebSocket handler
@@ -161,12 +795,162 @@ func main() {
 
 	// Initialize HTTP handlers
 	authHandler := handlers.NewAuthHandler(authService)
+	if cfg.Captcha.Provider != "" {
+		verifier, err := captcha.New(captcha.Config{
+			Provider:  captcha.Provider(cfg.Captcha.Provider),
+			SecretKey: cfg.Captcha.SecretKey,
+			VerifyURL: cfg.Captcha.VerifyURL,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize CAPTCHA verifier")
+		}
+		authHandler.SetCaptchaService(service.NewCaptchaService(verifier, service.CaptchaServiceConfig{
+			Provider:           cfg.Captcha.Provider,
+			SiteKey:            cfg.Captcha.SiteKey,
+			Registration:       cfg.Captcha.Registration,
+			LoginFailures:      cfg.Captcha.LoginFailures,
+			LoginFailureWindow: cfg.Captcha.LoginFailureWindow,
+		}))
+		log.Info().Str("provider", cfg.Captcha.Provider).Msg("CAPTCHA challenges enabled")
+	}
+
+	// Cookie auth mode keeps refresh tokens out of browser storage
+	if cfg.Auth.Mode == "cookie" {
+		authCookies, err := handlers.NewAuthCookies(cfg.Auth.CookieDomain, cfg.Auth.CookieSecure, cfg.Auth.CookieSameSite, jwt.RefreshTokenExpiry)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to configure auth cookies")
+		}
+		authHandler.SetAuthCookies(authCookies)
+		if userInviteHandler != nil {
+			userInviteHandler.SetAuthCookies(authCookies)
+		}
+		log.Info().Str("same_site", cfg.Auth.CookieSameSite).Msg("Cookie auth mode enabled")
+	}
 	articleHandler := handlers.NewArticleHandler(articleRepo, searchService, engagementService)
+	articleHandler.SetSummarizeService(summarizeService)
+	articleHandler.SetDeduplicationService(deduplicationService)
+	articleHandler.SetArticleIdentityService(articleIdentityService)
+	articleHandler.SetFeedPreferenceService(feedPreferenceService)
+	articleHandler.SetSoftwareInventoryService(softwareInventoryService)
+	articleHandler.SetFeaturedArticleService(featuredArticleService)
+	articleHandler.SetCTAExperimentService(ctaExperimentService)
+	if articleArchiveService != nil {
+		articleHandler.SetArticleArchiveService(articleArchiveService)
+	}
+	if articleImageService != nil {
+		articleHandler.SetArticleImageService(articleImageService)
+	}
 	alertHandler := handlers.NewAlertHandler(alertService)
 	categoryHandler := handlers.NewCategoryHandler(categoryRepo, articleRepo)
 	userHandler := handlers.NewUserHandler(engagementService, userRepo)
+	userHandler.SetExportService(service.NewUserExportService(bookmarkRepo, articleReadRepo, alertRepo, notificationPreferenceService))
+	userHandler.SetDeletionService(accountDeletionService)
 	webhookHandler := handlers.NewWebhookHandler(articleService, enrichmentService, webhookLogRepo, cfg.N8N.WebhookSecret)
+	webhookHandler.SetNotificationService(notificationService)
+	webhookHandler.SetIngestSLOService(ingestSLOService)
+	webhookHandler.SetReplayService(webhookReplayService, cfg.N8N.RequireTimestamp)
+	webhookHandler.SetSecretService(webhookSecretService)
+	// Without an AI provider, new articles can be enriched by an external n8n workflow instead
+	if cfg.Enrichment.RequestWebhookURL != "" {
+		webhookHandler.SetEnrichmentRequestService(service.NewEnrichmentRequestService(webhookSecretService, service.EnrichmentRequestConfig{
+			WebhookURL:  cfg.Enrichment.RequestWebhookURL,
+			CallbackURL: cfg.Enrichment.CallbackURL,
+		}))
+	}
+	webhookSecretHandler := handlers.NewWebhookSecretHandler(webhookSecretService)
+	articleImportService := service.NewArticleImportService(postgres.NewArticleImportRepository(db), auditLogRepo, articleService)
+	articleImportHandler := handlers.NewArticleImportHandler(articleImportService)
 	dashboardHandler := handlers.NewDashboardHandler(articleRepo)
+	searchHandler := handlers.NewSearchHandler(globalSearchService)
+	wsStatsHandler := handlers.NewWebSocketStatsHandler(notificationService)
+	notificationTemplateHandler := handlers.NewNotificationTemplateHandler(notificationTemplateService)
+	sloHandler := handlers.NewSLOHandler(ingestSLOService)
+	enrichmentHandler := handlers.NewEnrichmentHandler(enrichmentWorker)
+	enrichmentPromptHandler := handlers.NewEnrichmentPromptHandler(enrichmentPromptService)
+	enrichmentFeedbackHandler := handlers.NewEnrichmentFeedbackHandler(
+		service.NewEnrichmentFeedbackService(postgres.NewEnrichmentFeedbackRepository(db), articleRepo, auditLogRepo),
+	)
+	classificationHandler := handlers.NewClassificationHandler(classificationService)
+	aiUsageHandler := handlers.NewAIUsageHandler(aiUsageService)
+	iocHandler := handlers.NewIOCHandler(iocService)
+	notificationPreferenceHandler := handlers.NewNotificationPreferenceHandler(notificationPreferenceService)
+	organizationHandler := handlers.NewOrganizationHandler(organizationService)
+	collectionHandler := handlers.NewCollectionHandler(collectionService)
+	feedPreferenceHandler := handlers.NewFeedPreferenceHandler(feedPreferenceService)
+	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
+	articleReviewHandler := handlers.NewArticleReviewHandler(articleReviewService)
+	articleEditorialHandler := handlers.NewArticleEditorialHandler(service.NewArticleEditorialService(articleRepo, auditLogRepo))
+	articleSlugHandler := handlers.NewArticleSlugHandler(service.NewArticleSlugService(articleRepo, auditLogRepo))
+	featuredHandler := handlers.NewFeaturedHandler(featuredArticleService)
+	tagHandler := handlers.NewTagHandler(tagService)
+	sourceTrustHandler := handlers.NewSourceTrustHandler(sourceTrustService)
+	relevanceRulesHandler := handlers.NewRelevanceRulesHandler(relevanceRulesService)
+	ctaHandler := handlers.NewCTAHandler(ctaExperimentService)
+	publicAPIKeyHandler := handlers.NewPublicAPIKeyHandler(publicAPIKeyService)
+	var shareLinkHandler *handlers.ShareLinkHandler
+	if cfg.Share.Enabled {
+		shareLinkHandler = handlers.NewShareLinkHandler(service.NewShareLinkService(
+			postgres.NewShareLinkRepository(db),
+			articleRepo,
+			cfg.Share.Secret,
+			cfg.Share.BaseURL,
+			cfg.Share.SiteURL,
+			cfg.Share.MaxTTL,
+		))
+	}
+	var clientEventHandler *handlers.ClientEventHandler
+	if clientEventService != nil {
+		clientEventHandler = handlers.NewClientEventHandler(clientEventService)
+	}
+	var newsletterHandler *handlers.NewsletterHandler
+	if newsletterService != nil {
+		newsletterHandler = handlers.NewNewsletterHandler(newsletterService)
+	}
+	var crmHandler *handlers.CRMHandler
+	if crmService != nil {
+		crmHandler = handlers.NewCRMHandler(crmService)
+	}
+	var siemHandler *handlers.SIEMHandler
+	if siemService != nil {
+		siemHandler = handlers.NewSIEMHandler(siemService)
+	}
+	var publicHandler *handlers.PublicHandler
+	if cfg.PublicAPI.Enabled {
+		publicHandler = handlers.NewPublicHandler(articleRepo, publicAPIKeyService, handlers.PublicAPIOptions{
+			AnonymousRequestsPerMinute: cfg.PublicAPI.AnonymousRequestsPerMinute,
+			CacheTTL:                   cfg.PublicAPI.CacheTTL,
+		})
+	}
+	graphqlHandler := graph.LoadersMiddleware(categoryRepo, sourceRepo, bookmarkRepo)(
+		graph.NewHandler(graph.NewResolver(articleRepo, categoryRepo, userRepo, alertService, engagementService)),
+	)
+	categoryAdminHandler := handlers.NewCategoryAdminHandler(service.NewCategoryService(categoryRepo, articleRepo, auditLogRepo))
+	// Liveness and readiness probes
+	var aiPinger handlers.AIPinger
+	if cfg.Health.CheckAIProvider {
+		aiPinger = aiClient
+	}
+	healthHandler := handlers.NewHealthHandler(
+		postgres.NewSchemaRepository(db),
+		postgres.RequiredSchemaVersion,
+		hub,
+		aiPinger,
+		cfg.Health.CheckTimeout,
+	)
+
+	// Prometheus metrics; pool, hub, and worker gauges are read at scrape time
+	var metricsHandler http.Handler
+	if cfg.Metrics.Enabled {
+		metrics.RegisterDBPool(pool)
+		metrics.RegisterWebSocketConnections(hub.ClientCount)
+		metrics.RegisterEnrichmentQueue(enrichmentWorker.QueueDepth, enrichmentWorker.InFlight)
+		metricsHandler = middleware.MetricsAuth(cfg.Metrics.Token)(metrics.Handler())
+	}
+
+	var aiCacheHandler *handlers.AICacheHandler
+	if aiCacheService != nil {
+		aiCacheHandler = handlers.NewAICacheHandler(aiCacheService)
+	}
 
 	// NOTE: AdminHandler blocked until AdminService interface issue is resolved
 	// adminHandler := handlers.NewAdminHandler(adminService)
@@ -175,7 +959,7 @@ func main() {
 
 	// Create HTTP server
 	// TODO: Router agent needs to wire handlers into SetupRoutes()
-	// Services available: notificationService, enrichmentService
+	// Services available: enrichmentService
 	// NOTE: adminHandler not available until UserRepository interface mismatch resolved
 	handlers := &api.Handlers{
 		Auth:      authHandler,
@@ -186,6 +970,65 @@ func main() {
 		Admin:     nil, // TODO: Wire AdminHandler once UserRepository type mismatch is resolved
 		Category:  categoryHandler,
 		Dashboard: dashboardHandler,
+		Search:    searchHandler,
+		WSStats:   wsStatsHandler,
+		SLO:       sloHandler,
+
+		Enrichment:           enrichmentHandler,
+		EnrichmentFeedback:   enrichmentFeedbackHandler,
+		EnrichmentPrompt:     enrichmentPromptHandler,
+		NotificationTemplate: notificationTemplateHandler,
+		Classification:       classificationHandler,
+		AIUsage:              aiUsageHandler,
+		AICache:              aiCacheHandler,
+		IOC:                  iocHandler,
+
+		NotificationPreference: notificationPreferenceHandler,
+		Organization:           organizationHandler,
+		Collection:             collectionHandler,
+		FeedPreference:         feedPreferenceHandler,
+		Analytics:              analyticsHandler,
+		ArticleReview:          articleReviewHandler,
+		ArticleEditorial:       articleEditorialHandler,
+		ArticleSlug:            articleSlugHandler,
+		Featured:               featuredHandler,
+		Tag:                    tagHandler,
+		CategoryAdmin:          categoryAdminHandler,
+		SourceTrust:            sourceTrustHandler,
+		RelevanceRules:         relevanceRulesHandler,
+		CTA:                    ctaHandler,
+		Public:                 publicHandler,
+		PublicAPIKey:           publicAPIKeyHandler,
+		WebhookSecret:          webhookSecretHandler,
+		Tenant:                 tenantHandler,
+		ArticleImport:          articleImportHandler,
+		Config:                 handlers.NewConfigHandler(configReloader),
+		AuditLog:               handlers.NewAuditLogHandler(auditLogRetentionService),
+		SecurityActivity:       handlers.NewSecurityActivityHandler(securityEventService),
+		ThreatLandscape:        handlers.NewThreatLandscapeHandler(threatLandscapeService),
+		VendorWatchlist:        handlers.NewVendorWatchlistHandler(vendorWatchlistService),
+		KEV:                    handlers.NewKEVHandler(kevService),
+		Exploit:                handlers.NewExploitHandler(exploitService),
+		NVD:                    handlers.NewNVDHandler(nvdService),
+		SoftwareInventory:      handlers.NewSoftwareInventoryHandler(softwareInventoryService),
+		ThreatActor:            handlers.NewThreatActorHandler(threatActorService),
+		Incident:               handlers.NewIncidentHandler(incidentService),
+		Annotation:             handlers.NewAnnotationHandler(annotationService),
+		ClientEvent:            clientEventHandler,
+		ShareLink:              shareLinkHandler,
+		Newsletter:             newsletterHandler,
+		CRM:                    crmHandler,
+		SIEM:                   siemHandler,
+		Report:                 handlers.NewReportHandler(reportService),
+		OrganizationReport:     handlers.NewOrganizationReportHandler(orgReportService),
+		OrgCategory:            handlers.NewOrgCategoryHandler(orgCategoryService),
+		UserStatus:             handlers.NewUserStatusHandler(userStatusService),
+		UserInvite:             userInviteHandler,
+		RegistrationPolicy:     handlers.NewRegistrationPolicyHandler(registrationPolicyService),
+
+		GraphQL: graphqlHandler,
+		Health:  healthHandler,
+		Metrics: metricsHandler,
 	}
 
 	serverConfig := api.Config{
@@ -198,9 +1041,6 @@ func main() {
 	// Create server with WebSocket handler wired
 	server := api.NewServerWithWebSocket(serverConfig, handlers, jwtService, wsHandler)
 
-	// Prevent unused variable warnings until services are wired
-	_ = notificationService
-
 	log.Info().Msg("ACI Backend server starting...")
 
 	// Start HTTP server in background
@@ -225,19 +1065,47 @@ func main() {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	// Drain WebSocket clients first - hijacked connections are not tracked by http.Server
+	if err := hub.Drain(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("WebSocket drain did not complete")
+	}
+	hubCancel()
+
 	// Shutdown HTTP server
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Error().Err(err).Msg("Server shutdown failed")
 	}
 
+	// Stop background workers before the database goes away
+	workerCancel()
+	sloCancel()
+	replicaCancel()
+	purgeCancel()
+	trustCancel()
+	rulesCancel()
+	select {
+	case <-workerDone:
+	case <-shutdownCtx.Done():
+		log.Warn().Msg("Enrichment worker did not stop before shutdown deadline")
+	}
+
+	// Write the client events still buffered now that no more can arrive
+	clientEventCancel()
+	if clientEventService != nil {
+		if err := clientEventService.Flush(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Failed to write buffered client events")
+		}
+	}
+
 	// Close database connections
-	pool.Close()
-	sqlDB.Close()
+	db.Close()
 	log.Info().Msg("Database connections closed")
 
-	// Hub cleanup happens automatically when goroutines finish
+	// Flush spans from the final requests and queries
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("Failed to flush traces")
+	}
 
-	<-shutdownCtx.Done()
 	if shutdownCtx.Err() == context.DeadlineExceeded {
 		log.Warn().Msg("Shutdown deadline exceeded")
 	}
@@ -245,3 +1113,10 @@ func main() {
 	log.Info().Msg("Server stopped")
 	fmt.Println("Goodbye!")
 }
+
+// setLogLevel applies LOG_LEVEL; configuration validation guarantees a known level name
+func setLogLevel(level string) {
+	if parsed, err := zerolog.ParseLevel(level); err == nil {
+		zerolog.SetGlobalLevel(parsed)
+	}
+}
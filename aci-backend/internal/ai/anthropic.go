@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+)
+
+// AnthropicProvider implements Provider using the Anthropic Claude SDK
+type AnthropicProvider struct {
+	client anthropic.Client
+	model  anthropic.Model
+}
+
+// newAnthropicProvider creates an Anthropic provider
+func newAnthropicProvider(cfg Config) (*AnthropicProvider, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("api key is required")
+	}
+
+	modelName := cfg.Model
+	if modelName == "" {
+		modelName = DefaultAnthropicModel // Default to Haiku for cost efficiency
+	}
+
+	opts := []option.RequestOption{option.WithAPIKey(cfg.APIKey)}
+	if cfg.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(cfg.BaseURL))
+	}
+
+	return &AnthropicProvider{
+		client: anthropic.NewClient(opts...),
+		model:  anthropic.Model(modelName),
+	}, nil
+}
+
+// Name returns the provider identifier
+func (p *AnthropicProvider) Name() string {
+	return string(ProviderAnthropic)
+}
+
+// Model returns the configured model
+func (p *AnthropicProvider) Model() string {
+	return string(p.model)
+}
+
+// Ping looks up the configured model, which verifies the API key without spending tokens
+func (p *AnthropicProvider) Ping(ctx context.Context) error {
+	if _, err := p.client.Models.Get(ctx, string(p.model), anthropic.ModelGetParams{}); err != nil {
+		return fmt.Errorf("anthropic api unreachable: %w", err)
+	}
+	return nil
+}
+
+// Complete sends a message to Claude and returns the response
+func (p *AnthropicProvider) Complete(ctx context.Context, systemPrompt, userMessage string) (*Completion, error) {
+	// Build system parameter
+	system := []anthropic.TextBlockParam{
+		{Text: systemPrompt},
+	}
+
+	// Build messages
+	messages := []anthropic.MessageParam{
+		anthropic.NewUserMessage(anthropic.NewTextBlock(userMessage)),
+	}
+
+	// Call the API
+	response, err := p.client.Messages.New(ctx, anthropic.MessageNewParams{
+		Model:     anthropic.Model(requestModel(ctx, p)),
+		MaxTokens: int64(defaultMaxTokens),
+		System:    system,
+		Messages:  messages,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("claude api call failed: %w", err)
+	}
+
+	if len(response.Content) == 0 {
+		return nil, fmt.Errorf("empty response from claude")
+	}
+
+	// Extract text from the first content block
+	contentBlock := response.Content[0]
+	if contentBlock.Type == "text" {
+		textBlock := contentBlock.AsText()
+		return &Completion{
+			Text:         textBlock.Text,
+			Model:        string(response.Model),
+			InputTokens:  response.Usage.InputTokens,
+			OutputTokens: response.Usage.OutputTokens,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected content type in response: %s", contentBlock.Type)
+}
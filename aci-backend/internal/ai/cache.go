@@ -0,0 +1,33 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// CachedResponse is a stored provider response for a prompt
+type CachedResponse struct {
+	Key       string
+	Provider  string
+	Model     string
+	Operation string
+	Response  string
+}
+
+// ResponseCache stores responses keyed by a hash of the prompt so identical requests are not re-paid
+// Implementations treat lookup and store failures as cache misses
+type ResponseCache interface {
+	Lookup(ctx context.Context, key string) (string, bool)
+	Store(ctx context.Context, entry CachedResponse)
+}
+
+// CacheKey returns the cache key for a prompt sent to a provider and model
+func CacheKey(provider, model, systemPrompt, userMessage string) string {
+	h := sha256.New()
+	for _, part := range []string{provider, model, systemPrompt, userMessage} {
+		h.Write([]byte(part))
+		h.Write([]byte{0}) // separator so adjacent parts cannot run together
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
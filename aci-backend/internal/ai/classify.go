@@ -0,0 +1,146 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// maxSuggestedTags caps the number of tags accepted from a classification
+const maxSuggestedTags = 8
+
+// ClassifiedValue is a single suggested value with its confidence
+type ClassifiedValue struct {
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+// ClassifiedValues is a suggested list of values with a shared confidence
+type ClassifiedValues struct {
+	Values     []string `json:"values"`
+	Confidence float64  `json:"confidence"`
+}
+
+// ClassificationResult holds suggested article metadata
+type ClassificationResult struct {
+	Category ClassifiedValue  `json:"category"`
+	Severity ClassifiedValue  `json:"severity"`
+	Tags     ClassifiedValues `json:"tags"`
+	Vendors  ClassifiedValues `json:"vendors"`
+}
+
+// normalize drops values the model was not allowed to return and clamps confidences
+func (r *ClassificationResult) normalize(categorySlugs []string) {
+	r.Category.Value = strings.ToLower(strings.TrimSpace(r.Category.Value))
+	if !containsString(categorySlugs, r.Category.Value) {
+		r.Category = ClassifiedValue{}
+	}
+
+	r.Severity.Value = strings.ToLower(strings.TrimSpace(r.Severity.Value))
+	if !domain.Severity(r.Severity.Value).IsValid() {
+		r.Severity = ClassifiedValue{}
+	}
+
+	r.Tags.Values = cleanValues(r.Tags.Values, true)
+	if len(r.Tags.Values) > maxSuggestedTags {
+		r.Tags.Values = r.Tags.Values[:maxSuggestedTags]
+	}
+	r.Vendors.Values = cleanValues(r.Vendors.Values, false)
+
+	r.Category.Confidence = clampConfidence(r.Category.Confidence)
+	r.Severity.Confidence = clampConfidence(r.Severity.Confidence)
+	r.Tags.Confidence = clampConfidence(r.Tags.Confidence)
+	r.Vendors.Confidence = clampConfidence(r.Vendors.Confidence)
+}
+
+// Classifier suggests category, severity, tags, and vendors for articles
+type Classifier struct {
+	client *Client
+}
+
+// NewClassifier creates a new classifier instance
+func NewClassifier(client *Client) *Classifier {
+	if client == nil {
+		panic("client cannot be nil")
+	}
+
+	return &Classifier{
+		client: client,
+	}
+}
+
+// ProviderName returns the name of the provider generating classifications
+func (c *Classifier) ProviderName() string {
+	return c.client.ProviderName()
+}
+
+// Classify suggests metadata for an article; the category is restricted to categorySlugs
+func (c *Classifier) Classify(ctx context.Context, title, content string, categorySlugs []string) (*ClassificationResult, error) {
+	if title == "" {
+		return nil, fmt.Errorf("article title is required")
+	}
+
+	if content == "" {
+		return nil, fmt.Errorf("article content is required")
+	}
+
+	if len(categorySlugs) == 0 {
+		return nil, fmt.Errorf("at least one category is required")
+	}
+
+	// Add timeout to prevent long-running requests during ingest
+	ctx, cancel := context.WithTimeout(WithOperation(ctx, OperationClassification), 30*time.Second)
+	defer cancel()
+
+	userPrompt := BuildClassificationPrompt(title, content, categorySlugs)
+
+	// Call the AI provider
+	var result ClassificationResult
+	if err := c.client.CompleteWithJSON(ctx, ClassificationSystemPrompt, userPrompt, &result); err != nil {
+		return nil, fmt.Errorf("failed to classify article: %w", err)
+	}
+
+	result.normalize(categorySlugs)
+
+	return &result, nil
+}
+
+// cleanValues trims values and removes blanks and duplicates
+func cleanValues(values []string, lowercase bool) []string {
+	cleaned := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if lowercase {
+			value = strings.ToLower(value)
+		}
+		if value == "" || seen[strings.ToLower(value)] {
+			continue
+		}
+		seen[strings.ToLower(value)] = true
+		cleaned = append(cleaned, value)
+	}
+	return cleaned
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+func clampConfidence(confidence float64) float64 {
+	if confidence < 0 {
+		return 0
+	}
+	if confidence > 1 {
+		return 1
+	}
+	return confidence
+}
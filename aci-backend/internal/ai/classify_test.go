@@ -0,0 +1,55 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifier_Classify(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		require.Len(t, body.Messages, 2)
+		assert.Contains(t, body.Messages[1].Content, "ransomware, vulnerabilities")
+	}, `{
+		"category": {"value": "Ransomware", "confidence": 0.92},
+		"severity": {"value": "high", "confidence": 0.7},
+		"tags": {"values": ["Healthcare", "healthcare", " lockbit "], "confidence": 0.85},
+		"vendors": {"values": ["Citrix"], "confidence": 1.4}
+	}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+
+	result, err := NewClassifier(client).Classify(context.Background(), "title", "content", []string{"ransomware", "vulnerabilities"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ransomware", result.Category.Value)
+	assert.Equal(t, 0.92, result.Category.Confidence)
+	assert.Equal(t, "high", result.Severity.Value)
+	assert.Equal(t, []string{"healthcare", "lockbit"}, result.Tags.Values, "tags are lowercased and deduplicated")
+	assert.Equal(t, 1.0, result.Vendors.Confidence, "confidence is clamped to 1")
+}
+
+func TestClassifier_DropsUnknownCategoryAndSeverity(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {}, `{
+		"category": {"value": "crypto-scams", "confidence": 0.9},
+		"severity": {"value": "urgent", "confidence": 0.9},
+		"tags": {"values": [], "confidence": 0},
+		"vendors": {"values": [], "confidence": 0}
+	}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+
+	result, err := NewClassifier(client).Classify(context.Background(), "title", "content", []string{"ransomware"})
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Category.Value)
+	assert.Zero(t, result.Category.Confidence)
+	assert.Empty(t, result.Severity.Value)
+}
@@ -4,45 +4,82 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/anthropics/anthropic-sdk-go"
-	"github.com/anthropics/anthropic-sdk-go/option"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/phillipboles/aci-backend/internal/tracing"
 )
 
-// Client wraps the Anthropic Claude SDK client
+// Client sends prompts to the configured AI provider
 type Client struct {
-	client anthropic.Client
-	model  anthropic.Model
+	provider Provider
+	usage    UsageRecorder
+	cache    ResponseCache
 }
 
 // Config holds configuration for the AI client
 type Config struct {
-	APIKey string
-	Model  string
+	Provider   ProviderType // anthropic (default), openai, azure, local, or disabled
+	APIKey     string
+	Model      string // model name; the deployment name for azure
+	BaseURL    string // required for azure and local, optional override otherwise
+	APIVersion string // azure only
 }
 
-// NewClient creates a new AI client instance
+// NewClient creates a new AI client instance for the configured provider
 func NewClient(cfg Config) (*Client, error) {
-	if cfg.APIKey == "" {
-		return nil, fmt.Errorf("api key is required")
+	provider, err := NewProvider(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	modelName := cfg.Model
-	if modelName == "" {
-		modelName = "claude-3-haiku-20240307" // Default to Haiku for cost efficiency
-	}
+	return NewClientWithProvider(provider)
+}
 
-	client := anthropic.NewClient(
-		option.WithAPIKey(cfg.APIKey),
-	)
+// NewClientWithProvider creates a client backed by an existing provider
+func NewClientWithProvider(provider Provider) (*Client, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("provider is required")
+	}
 
 	return &Client{
-		client: client,
-		model:  anthropic.Model(modelName),
+		provider: provider,
 	}, nil
 }
 
-// Complete sends a message to Claude and returns the response
+// SetUsageRecorder reports token usage of every call to recorder
+func (c *Client) SetUsageRecorder(recorder UsageRecorder) {
+	c.usage = recorder
+}
+
+// SetResponseCache serves repeated JSON completions from cache instead of the provider
+func (c *Client) SetResponseCache(cache ResponseCache) {
+	c.cache = cache
+}
+
+// ProviderName returns the name of the underlying provider
+func (c *Client) ProviderName() string {
+	return c.provider.Name()
+}
+
+// Enabled reports whether calls reach a model; a disabled client answers with canned responses
+func (c *Client) Enabled() bool {
+	return c.provider.Name() != string(ProviderDisabled)
+}
+
+// Ping checks that the provider is reachable; providers without a cheap check are assumed reachable
+func (c *Client) Ping(ctx context.Context) error {
+	pinger, ok := c.provider.(Pinger)
+	if !ok {
+		return nil
+	}
+	return pinger.Ping(ctx)
+}
+
+// Complete sends a message to the provider and returns the response
 func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string) (string, error) {
 	if systemPrompt == "" {
 		return "", fmt.Errorf("system prompt is required")
@@ -52,40 +89,48 @@ func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string)
 		return "", fmt.Errorf("user message is required")
 	}
 
-	// Build system parameter
-	system := []anthropic.TextBlockParam{
-		{Text: systemPrompt},
-	}
-
-	// Build messages
-	messages := []anthropic.MessageParam{
-		anthropic.NewUserMessage(anthropic.NewTextBlock(userMessage)),
-	}
-
-	// Call the API
-	response, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     c.model,
-		MaxTokens: int64(4096),
-		System:    system,
-		Messages:  messages,
-	})
+	ctx, span := tracing.Start(ctx, "ai.complete",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("ai.provider", c.provider.Name()),
+			attribute.String("ai.model", requestModel(ctx, c.provider)),
+			attribute.String("ai.operation", OperationFromContext(ctx)),
+		),
+	)
 
-	if err != nil {
-		return "", fmt.Errorf("claude api call failed: %w", err)
+	start := time.Now()
+	completion, err := c.provider.Complete(ctx, systemPrompt, userMessage)
+	if completion != nil {
+		span.SetAttributes(
+			attribute.Int64("ai.input_tokens", completion.InputTokens),
+			attribute.Int64("ai.output_tokens", completion.OutputTokens),
+		)
 	}
-
-	if len(response.Content) == 0 {
-		return "", fmt.Errorf("empty response from claude")
+	tracing.End(span, err)
+
+	if c.usage != nil {
+		usage := Usage{
+			Provider:  c.provider.Name(),
+			Model:     requestModel(ctx, c.provider),
+			Operation: OperationFromContext(ctx),
+			Success:   err == nil,
+			Duration:  time.Since(start),
+		}
+		if completion != nil {
+			usage.InputTokens = completion.InputTokens
+			usage.OutputTokens = completion.OutputTokens
+			if completion.Model != "" {
+				usage.Model = completion.Model
+			}
+		}
+		c.usage.RecordUsage(ctx, usage)
 	}
 
-	// Extract text from the first content block
-	contentBlock := response.Content[0]
-	if contentBlock.Type == "text" {
-		textBlock := contentBlock.AsText()
-		return textBlock.Text, nil
+	if err != nil {
+		return "", err
 	}
 
-	return "", fmt.Errorf("unexpected content type in response: %s", contentBlock.Type)
+	return completion.Text, nil
 }
 
 // CompleteWithJSON sends a message and parses JSON response
@@ -94,14 +139,67 @@ func (c *Client) CompleteWithJSON(ctx context.Context, systemPrompt, userMessage
 		return fmt.Errorf("result pointer is required")
 	}
 
+	var cacheKey string
+	if c.cache != nil {
+		cacheKey = CacheKey(c.provider.Name(), requestModel(ctx, c.provider), systemPrompt, userMessage)
+		if cached, ok := c.cache.Lookup(ctx, cacheKey); ok {
+			if err := json.Unmarshal([]byte(cached), result); err == nil {
+				trace.SpanFromContext(ctx).AddEvent("ai.cache_hit", trace.WithAttributes(
+					attribute.String("ai.operation", OperationFromContext(ctx)),
+				))
+				return nil
+			}
+			// An entry that no longer parses is replaced by a fresh response below
+		}
+	}
+
 	response, err := c.Complete(ctx, systemPrompt, userMessage)
 	if err != nil {
 		return fmt.Errorf("completion failed: %w", err)
 	}
 
+	response = stripCodeFence(response)
 	if err := json.Unmarshal([]byte(response), result); err != nil {
 		return fmt.Errorf("failed to parse json response: %w", err)
 	}
 
+	if c.cache != nil && isCacheable(result) {
+		c.cache.Store(ctx, CachedResponse{
+			Key:       cacheKey,
+			Provider:  c.provider.Name(),
+			Model:     requestModel(ctx, c.provider),
+			Operation: OperationFromContext(ctx),
+			Response:  response,
+		})
+	}
+
 	return nil
 }
+
+// isCacheable reports whether a parsed result passes its own validation,
+// so a malformed response is retried rather than served from cache until it expires
+func isCacheable(result interface{}) bool {
+	switch v := result.(type) {
+	case interface{ Validate() error }:
+		return v.Validate() == nil
+	case interface{ IsValid() bool }:
+		return v.IsValid()
+	default:
+		return true
+	}
+}
+
+// stripCodeFence removes a surrounding markdown code fence, which some models add around JSON
+func stripCodeFence(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "```") {
+		return s
+	}
+
+	s = strings.TrimPrefix(s, "```")
+	if newline := strings.IndexByte(s, '\n'); newline >= 0 {
+		s = s[newline+1:] // drop the language tag line, e.g. ```json
+	}
+
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "```"))
+}
@@ -15,12 +15,13 @@ type EnrichmentResult struct {
 	ImpactAssessment   string   `json:"impact_assessment"`
 	RecommendedActions []string `json:"recommended_actions"`
 	IOCs               []IOC    `json:"iocs"`
+	ThreatActors       []string `json:"threat_actors"`
 	ConfidenceScore    float64  `json:"confidence_score"`
 }
 
 // IOC represents an Indicator of Compromise
 type IOC struct {
-	Type    string `json:"type"`              // ip, domain, hash, url
+	Type    string `json:"type"`              // ip, domain, hash, url, email
 	Value   string `json:"value"`             // The actual IOC value
 	Context string `json:"context,omitempty"` // Additional context
 }
@@ -71,18 +72,45 @@ func validateIOC(ioc *IOC) error {
 		"domain": true,
 		"hash":   true,
 		"url":    true,
+		"email":  true,
 	}
 
 	if !validTypes[ioc.Type] {
-		return fmt.Errorf("invalid type: %s (must be ip, domain, hash, or url)", ioc.Type)
+		return fmt.Errorf("invalid type: %s (must be ip, domain, hash, url, or email)", ioc.Type)
 	}
 
 	return nil
 }
 
+// Prompt is a system prompt and the model to run it on; an empty Model uses the configured one
+type Prompt struct {
+	SystemPrompt string
+	Model        string
+}
+
+// PromptSource supplies the enricher's prompts at runtime; ok is false to use the built-in prompt
+type PromptSource interface {
+	Prompt(ctx context.Context, key domain.EnrichmentPromptKey) (prompt Prompt, ok bool)
+}
+
+// BuiltInPrompt returns the prompt compiled into the enricher for key
+func BuiltInPrompt(key domain.EnrichmentPromptKey) (Prompt, bool) {
+	switch key {
+	case domain.EnrichmentPromptThreatAnalysis:
+		return Prompt{SystemPrompt: ThreatAnalysisSystemPrompt}, true
+	case domain.EnrichmentPromptBatchThreatAnalysis:
+		return Prompt{SystemPrompt: BatchThreatAnalysisSystemPrompt}, true
+	case domain.EnrichmentPromptArmorCTA:
+		return Prompt{SystemPrompt: ArmorCTASystemPrompt}, true
+	default:
+		return Prompt{}, false
+	}
+}
+
 // Enricher performs AI enrichment on articles
 type Enricher struct {
-	client *Client
+	client  *Client
+	prompts PromptSource
 }
 
 // NewEnricher creates a new enricher instance
@@ -96,8 +124,43 @@ func NewEnricher(client *Client) *Enricher {
 	}
 }
 
+// SetPromptSource replaces built-in prompts with the ones source supplies
+func (e *Enricher) SetPromptSource(source PromptSource) {
+	e.prompts = source
+}
+
+// Enabled reports whether enrichment comes from a model rather than canned responses
+func (e *Enricher) Enabled() bool {
+	return e.client.Enabled()
+}
+
+// prompt returns the prompt to use for key: the prompt source's, or the built-in one
+func (e *Enricher) prompt(ctx context.Context, key domain.EnrichmentPromptKey) Prompt {
+	if e.prompts != nil {
+		if prompt, ok := e.prompts.Prompt(ctx, key); ok {
+			return prompt
+		}
+	}
+
+	prompt, _ := BuiltInPrompt(key)
+	return prompt
+}
+
+// withPromptModel runs calls made with ctx on the prompt's model, if it names one
+func withPromptModel(ctx context.Context, prompt Prompt) context.Context {
+	if prompt.Model == "" {
+		return ctx
+	}
+	return WithModel(ctx, prompt.Model)
+}
+
 // EnrichArticle analyzes an article and returns enrichment data
 func (e *Enricher) EnrichArticle(ctx context.Context, article *domain.Article) (*EnrichmentResult, error) {
+	return e.EnrichArticleWithPrompt(ctx, article, e.prompt(ctx, domain.EnrichmentPromptThreatAnalysis))
+}
+
+// EnrichArticleWithPrompt analyzes an article with the given prompt, e.g. to try out a draft
+func (e *Enricher) EnrichArticleWithPrompt(ctx context.Context, article *domain.Article, prompt Prompt) (*EnrichmentResult, error) {
 	if article == nil {
 		return nil, fmt.Errorf("article cannot be nil")
 	}
@@ -111,7 +174,7 @@ func (e *Enricher) EnrichArticle(ctx context.Context, article *domain.Article) (
 	}
 
 	// Add timeout to prevent long-running requests
-	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	ctx, cancel := context.WithTimeout(withPromptModel(WithOperation(ctx, OperationEnrichment), prompt), 60*time.Second)
 	defer cancel()
 
 	// Build the prompt
@@ -122,9 +185,9 @@ func (e *Enricher) EnrichArticle(ctx context.Context, article *domain.Article) (
 		article.Vendors,
 	)
 
-	// Call Claude API
+	// Call the AI provider
 	var result EnrichmentResult
-	if err := e.client.CompleteWithJSON(ctx, ThreatAnalysisSystemPrompt, userPrompt, &result); err != nil {
+	if err := e.client.CompleteWithJSON(ctx, prompt.SystemPrompt, userPrompt, &result); err != nil {
 		return nil, fmt.Errorf("failed to analyze article: %w", err)
 	}
 
@@ -136,8 +199,74 @@ func (e *Enricher) EnrichArticle(ctx context.Context, article *domain.Article) (
 	return &result, nil
 }
 
+// batchEnrichmentResult is one article's analysis in a batch response
+type batchEnrichmentResult struct {
+	Article int `json:"article"` // 1-based position in the prompt
+	EnrichmentResult
+}
+
+// EnrichArticles analyzes several articles in a single call
+// The result has one entry per article, in the same order; an entry is nil when the response
+// had no valid analysis for that article, so the caller can enrich it on its own
+func (e *Enricher) EnrichArticles(ctx context.Context, articles []*domain.Article) ([]*EnrichmentResult, error) {
+	return e.EnrichArticlesWithPrompt(ctx, articles, e.prompt(ctx, domain.EnrichmentPromptBatchThreatAnalysis))
+}
+
+// EnrichArticlesWithPrompt analyzes several articles in a single call with the given batch prompt
+func (e *Enricher) EnrichArticlesWithPrompt(ctx context.Context, articles []*domain.Article, prompt Prompt) ([]*EnrichmentResult, error) {
+	if len(articles) == 0 {
+		return nil, fmt.Errorf("at least one article is required")
+	}
+
+	for i, article := range articles {
+		if article == nil || article.Title == "" || article.Content == "" {
+			return nil, fmt.Errorf("article %d needs a title and content", i+1)
+		}
+	}
+
+	// A batch takes longer than a single article, but still has to end
+	ctx, cancel := context.WithTimeout(withPromptModel(WithOperation(ctx, OperationEnrichment), prompt), 120*time.Second)
+	defer cancel()
+
+	userPrompt := BuildBatchThreatAnalysisPrompt(articles)
+
+	var response struct {
+		Results []batchEnrichmentResult `json:"results"`
+	}
+	if err := e.client.CompleteWithJSON(ctx, prompt.SystemPrompt, userPrompt, &response); err != nil {
+		return nil, fmt.Errorf("failed to analyze articles: %w", err)
+	}
+
+	results := make([]*EnrichmentResult, len(articles))
+	for _, entry := range response.Results {
+		index := entry.Article - 1
+		if index < 0 || index >= len(articles) || results[index] != nil {
+			continue
+		}
+
+		result := entry.EnrichmentResult
+		if err := result.Validate(); err != nil {
+			continue
+		}
+		results[index] = &result
+	}
+
+	return results, nil
+}
+
+// EstimateTokens approximates the number of tokens in text at four characters per token,
+// close enough for sizing batches without a provider-specific tokenizer
+func EstimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
 // GenerateArmorCTA generates Armor.com call-to-action based on content
 func (e *Enricher) GenerateArmorCTA(ctx context.Context, article *domain.Article) (*domain.ArmorCTA, error) {
+	return e.GenerateArmorCTAWithPrompt(ctx, article, e.prompt(ctx, domain.EnrichmentPromptArmorCTA))
+}
+
+// GenerateArmorCTAWithPrompt generates an Armor.com call-to-action with the given prompt
+func (e *Enricher) GenerateArmorCTAWithPrompt(ctx context.Context, article *domain.Article, prompt Prompt) (*domain.ArmorCTA, error) {
 	if article == nil {
 		return nil, fmt.Errorf("article cannot be nil")
 	}
@@ -151,7 +280,7 @@ func (e *Enricher) GenerateArmorCTA(ctx context.Context, article *domain.Article
 	}
 
 	// Add timeout to prevent long-running requests
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	ctx, cancel := context.WithTimeout(withPromptModel(WithOperation(ctx, OperationArmorCTA), prompt), 30*time.Second)
 	defer cancel()
 
 	// Get threat context if available
@@ -173,9 +302,9 @@ func (e *Enricher) GenerateArmorCTA(ctx context.Context, article *domain.Article
 		attackVector,
 	)
 
-	// Call Claude API
+	// Call the AI provider
 	var cta domain.ArmorCTA
-	if err := e.client.CompleteWithJSON(ctx, ArmorCTASystemPrompt, userPrompt, &cta); err != nil {
+	if err := e.client.CompleteWithJSON(ctx, prompt.SystemPrompt, userPrompt, &cta); err != nil {
 		return nil, fmt.Errorf("failed to generate armor cta: %w", err)
 	}
 
@@ -0,0 +1,111 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func TestEnricher_EnrichArticlesSplitsResponse(t *testing.T) {
+	articles := []*domain.Article{
+		{Title: "Ransomware hits hospital", Content: "Details one."},
+		{Title: "Phishing kit sold online", Content: "Details two."},
+		{Title: "Router flaw exploited", Content: "Details three.", CVEs: []string{"CVE-2026-1234"}},
+	}
+
+	// Results arrive out of order; article 2 is invalid and article 3 has a duplicate
+	reply := `{"results": [
+		{"article": 3, "threat_type": "vulnerability", "attack_vector": "network", "impact_assessment": "Remote takeover.", "recommended_actions": ["Patch"], "confidence_score": 0.9},
+		{"article": 1, "threat_type": "ransomware", "attack_vector": "email", "impact_assessment": "Outage.", "recommended_actions": ["Restore backups"], "confidence_score": 0.8},
+		{"article": 2, "threat_type": "", "attack_vector": "web", "impact_assessment": "Fraud.", "recommended_actions": ["Block"], "confidence_score": 0.5},
+		{"article": 3, "threat_type": "malware", "attack_vector": "web", "impact_assessment": "Ignored.", "recommended_actions": ["Ignore"], "confidence_score": 0.1},
+		{"article": 9, "threat_type": "malware", "attack_vector": "web", "impact_assessment": "Unknown.", "recommended_actions": ["Ignore"], "confidence_score": 0.1}
+	]}`
+
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		require.Len(t, body.Messages, 2)
+		assert.Equal(t, BatchThreatAnalysisSystemPrompt, body.Messages[0].Content)
+		assert.Contains(t, body.Messages[1].Content, "## Article 1")
+		assert.Contains(t, body.Messages[1].Content, "## Article 3")
+		assert.Contains(t, body.Messages[1].Content, "exactly 3 results")
+		assert.Contains(t, body.Messages[1].Content, "CVE-2026-1234")
+	}, reply)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+
+	results, err := NewEnricher(client).EnrichArticles(context.Background(), articles)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.NotNil(t, results[0])
+	assert.Equal(t, "ransomware", results[0].ThreatType)
+	assert.Nil(t, results[1], "an invalid analysis is left for a single call")
+	require.NotNil(t, results[2])
+	assert.Equal(t, "vulnerability", results[2].ThreatType, "the first analysis of an article wins")
+}
+
+func TestEnricher_EnrichArticlesRequiresContent(t *testing.T) {
+	enricher := NewEnricher(newStubClient(t))
+
+	_, err := enricher.EnrichArticles(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, err = enricher.EnrichArticles(context.Background(), []*domain.Article{{Title: "No content"}})
+	assert.Error(t, err)
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("abc"))
+	assert.Equal(t, 1, EstimateTokens("abcd"))
+	assert.Equal(t, 2, EstimateTokens("abcde"))
+}
+
+// promptSource serves fixed prompts by key
+type promptSource map[domain.EnrichmentPromptKey]Prompt
+
+func (s promptSource) Prompt(ctx context.Context, key domain.EnrichmentPromptKey) (Prompt, bool) {
+	prompt, ok := s[key]
+	return prompt, ok
+}
+
+func TestEnricher_PromptSource(t *testing.T) {
+	article := &domain.Article{Title: "Ransomware hits hospital", Content: "Details."}
+	reply := `{"threat_type": "ransomware", "attack_vector": "email", "impact_assessment": "Outage.", "recommended_actions": ["Restore backups"], "confidence_score": 0.8}`
+
+	var gotModel, gotPrompt string
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		require.Len(t, body.Messages, 2)
+		gotModel = body.Model
+		gotPrompt = body.Messages[0].Content
+	}, reply)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+	enricher := NewEnricher(client)
+
+	enricher.SetPromptSource(promptSource{
+		domain.EnrichmentPromptThreatAnalysis: {SystemPrompt: "Custom analyst prompt", Model: "qwen2.5"},
+	})
+
+	_, err = enricher.EnrichArticle(context.Background(), article)
+	require.NoError(t, err)
+	assert.Equal(t, "Custom analyst prompt", gotPrompt)
+	assert.Equal(t, "qwen2.5", gotModel, "the prompt's model overrides the configured one")
+
+	// A key the source does not supply falls back to the built-in prompt and configured model
+	enricher.SetPromptSource(promptSource{})
+
+	_, err = enricher.EnrichArticle(context.Background(), article)
+	require.NoError(t, err)
+	assert.Equal(t, ThreatAnalysisSystemPrompt, gotPrompt)
+	assert.Equal(t, "llama3.1", gotModel)
+}
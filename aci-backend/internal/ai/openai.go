@@ -0,0 +1,248 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultOpenAIBaseURL is the public OpenAI API endpoint
+	defaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+	// defaultHTTPTimeout bounds requests when the caller's context has no deadline
+	defaultHTTPTimeout = 120 * time.Second
+
+	// maxErrorBodyBytes limits how much of an error response is included in errors
+	maxErrorBodyBytes = 512
+)
+
+// OpenAIProvider implements Provider for the OpenAI chat completions API
+// The same wire format serves OpenAI, Azure OpenAI and OpenAI-compatible local servers
+type OpenAIProvider struct {
+	name       ProviderType
+	endpoint   string
+	models     string // model listing endpoint, used by Ping
+	model      string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// newOpenAIProvider creates a provider for api.openai.com (or a compatible BaseURL)
+func newOpenAIProvider(cfg Config) (*OpenAIProvider, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("api key is required")
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultOpenAIBaseURL
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+
+	return &OpenAIProvider{
+		name:       ProviderOpenAI,
+		endpoint:   strings.TrimRight(baseURL, "/") + "/chat/completions",
+		models:     strings.TrimRight(baseURL, "/") + "/models",
+		model:      model,
+		headers:    map[string]string{"Authorization": "Bearer " + cfg.APIKey},
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+// newAzureProvider creates a provider for an Azure OpenAI deployment
+// BaseURL is the resource endpoint (https://{resource}.openai.azure.com) and Model is the deployment name
+func newAzureProvider(cfg Config) (*OpenAIProvider, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("api key is required")
+	}
+
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("base url is required for azure")
+	}
+
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("model (deployment name) is required for azure")
+	}
+
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = DefaultAzureAPIVersion
+	}
+
+	endpoint := fmt.Sprintf("%s/openai/deployments/%s/chat/completions?api-version=%s",
+		strings.TrimRight(cfg.BaseURL, "/"),
+		url.PathEscape(cfg.Model),
+		url.QueryEscape(apiVersion),
+	)
+
+	models := fmt.Sprintf("%s/openai/models?api-version=%s",
+		strings.TrimRight(cfg.BaseURL, "/"),
+		url.QueryEscape(apiVersion),
+	)
+
+	return &OpenAIProvider{
+		name:       ProviderAzure,
+		endpoint:   endpoint,
+		models:     models,
+		model:      cfg.Model,
+		headers:    map[string]string{"api-key": cfg.APIKey},
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+// newLocalProvider creates a provider for a self-hosted OpenAI-compatible server
+// The API key is optional since most local servers do not require one
+func newLocalProvider(cfg Config) (*OpenAIProvider, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("base url is required for local provider")
+	}
+
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("model is required for local provider")
+	}
+
+	headers := map[string]string{}
+	if cfg.APIKey != "" {
+		headers["Authorization"] = "Bearer " + cfg.APIKey
+	}
+
+	return &OpenAIProvider{
+		name:       ProviderLocal,
+		endpoint:   strings.TrimRight(cfg.BaseURL, "/") + "/chat/completions",
+		models:     strings.TrimRight(cfg.BaseURL, "/") + "/models",
+		model:      cfg.Model,
+		headers:    headers,
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+// chatMessage is a single chat completion message
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatCompletionRequest is the chat completions request body
+type chatCompletionRequest struct {
+	Model     string        `json:"model"`
+	Messages  []chatMessage `json:"messages"`
+	MaxTokens int           `json:"max_tokens"`
+}
+
+// chatCompletionResponse is the subset of the chat completions response we use
+type chatCompletionResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int64 `json:"prompt_tokens"`
+		CompletionTokens int64 `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// Name returns the provider identifier
+func (p *OpenAIProvider) Name() string {
+	return string(p.name)
+}
+
+// Model returns the configured model (the deployment name for azure)
+func (p *OpenAIProvider) Model() string {
+	return p.model
+}
+
+// Ping lists models, which verifies the endpoint and credentials without spending tokens
+func (p *OpenAIProvider) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.models, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s api unreachable: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s api unreachable: status %d", p.name, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Complete sends a chat completion request and returns the first choice's content
+func (p *OpenAIProvider) Complete(ctx context.Context, systemPrompt, userMessage string) (*Completion, error) {
+	requested := requestModel(ctx, p)
+
+	body, err := json.Marshal(chatCompletionRequest{
+		Model: requested,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: userMessage},
+		},
+		MaxTokens: defaultMaxTokens,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s api call failed: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return nil, fmt.Errorf("%s api call failed: status %d: %s", p.name, resp.StatusCode, strings.TrimSpace(string(errBody)))
+	}
+
+	var completion chatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return nil, fmt.Errorf("failed to decode %s response: %w", p.name, err)
+	}
+
+	if len(completion.Choices) == 0 || completion.Choices[0].Message.Content == "" {
+		return nil, fmt.Errorf("empty response from %s", p.name)
+	}
+
+	// Azure reports the underlying model name rather than the deployment
+	model := completion.Model
+	if model == "" {
+		model = requested
+	}
+
+	return &Completion{
+		Text:         completion.Choices[0].Message.Content,
+		Model:        model,
+		InputTokens:  completion.Usage.PromptTokens,
+		OutputTokens: completion.Usage.CompletionTokens,
+	}, nil
+}
@@ -0,0 +1,243 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func newChatServer(t *testing.T, check func(r *http.Request, body chatCompletionRequest), reply string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body chatCompletionRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		check(r, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{
+				{"message": map[string]string{"role": "assistant", "content": reply}},
+			},
+			"usage": map[string]int{"prompt_tokens": 120, "completion_tokens": 30},
+		})
+	}))
+}
+
+func TestOpenAIProvider_Complete(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		assert.Equal(t, DefaultOpenAIModel, body.Model)
+		require.Len(t, body.Messages, 2)
+		assert.Equal(t, "system", body.Messages[0].Role)
+		assert.Equal(t, "user", body.Messages[1].Role)
+	}, "hello")
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL + "/v1"})
+	require.NoError(t, err)
+
+	out, err := provider.Complete(context.Background(), "system", "user")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", out.Text)
+	assert.Equal(t, int64(120), out.InputTokens)
+	assert.Equal(t, int64(30), out.OutputTokens)
+}
+
+func TestAzureProvider_UsesDeploymentAndAPIKeyHeader(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		assert.Equal(t, "/openai/deployments/enrich-gpt4o/chat/completions", r.URL.Path)
+		assert.Equal(t, DefaultAzureAPIVersion, r.URL.Query().Get("api-version"))
+		assert.Equal(t, "azure-key", r.Header.Get("api-key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+	}, "ok")
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Provider: ProviderAzure, APIKey: "azure-key", BaseURL: server.URL, Model: "enrich-gpt4o"})
+	require.NoError(t, err)
+
+	_, err = provider.Complete(context.Background(), "system", "user")
+	require.NoError(t, err)
+}
+
+func TestLocalProvider_APIKeyOptional(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "llama3.1", body.Model)
+	}, "```json\n{\"threat_type\": \"malware\"}\n```")
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL + "/v1", Model: "llama3.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "local", client.ProviderName())
+
+	var result struct {
+		ThreatType string `json:"threat_type"`
+	}
+	require.NoError(t, client.CompleteWithJSON(context.Background(), "system", "user", &result))
+	assert.Equal(t, "malware", result.ThreatType)
+}
+
+func TestOpenAIProvider_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"rate limited"}`, http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = provider.Complete(context.Background(), "system", "user")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 429")
+}
+
+func TestOpenAIProvider_Ping(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/models", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL + "/v1"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Ping(context.Background()))
+
+	status = http.StatusUnauthorized
+	err = client.Ping(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+}
+
+func TestNewProvider_Validation(t *testing.T) {
+	_, err := NewProvider(Config{Provider: "bedrock", APIKey: "x"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(Config{Provider: ProviderAzure, APIKey: "x", BaseURL: "https://example.openai.azure.com"})
+	assert.Error(t, err, "azure requires a deployment name")
+
+	_, err = NewProvider(Config{Provider: ProviderLocal, Model: "llama3.1"})
+	assert.Error(t, err, "local requires a base url")
+
+	provider, err := NewProvider(Config{APIKey: "sk-ant-test"})
+	require.NoError(t, err)
+	assert.Equal(t, "anthropic", provider.Name(), "anthropic is the default provider")
+}
+
+type recordedUsage struct {
+	usages []Usage
+}
+
+func (r *recordedUsage) RecordUsage(ctx context.Context, usage Usage) {
+	r.usages = append(r.usages, usage)
+}
+
+func TestClient_RecordsUsage(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {}, `{"short": "a", "medium": "b", "executive": "c"}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	recorder := &recordedUsage{}
+	client.SetUsageRecorder(recorder)
+
+	_, err = NewSummarizer(client).Summarize(context.Background(), &domain.Article{Title: "t", Content: "c"})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.usages, 1)
+	usage := recorder.usages[0]
+	assert.Equal(t, "openai", usage.Provider)
+	assert.Equal(t, DefaultOpenAIModel, usage.Model)
+	assert.Equal(t, OperationSummary, usage.Operation)
+	assert.Equal(t, int64(120), usage.InputTokens)
+	assert.Equal(t, int64(30), usage.OutputTokens)
+	assert.True(t, usage.Success)
+}
+
+func TestLookupPricing(t *testing.T) {
+	pricing, ok := LookupPricing("claude-3-haiku-20240307")
+	require.True(t, ok)
+	assert.InDelta(t, 0.25+1.25, pricing.Cost(1_000_000, 1_000_000), 1e-9)
+
+	pricing, ok = LookupPricing("gpt-4o-mini-2024-07-18")
+	require.True(t, ok)
+	assert.Equal(t, 0.15, pricing.InputPerMTok, "longest prefix wins over gpt-4o")
+
+	_, ok = LookupPricing("llama3.1")
+	assert.False(t, ok)
+}
+
+type memoryCache struct {
+	entries map[string]CachedResponse
+}
+
+func (m *memoryCache) Lookup(ctx context.Context, key string) (string, bool) {
+	entry, ok := m.entries[key]
+	return entry.Response, ok
+}
+
+func (m *memoryCache) Store(ctx context.Context, entry CachedResponse) {
+	m.entries[entry.Key] = entry
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	calls := 0
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) { calls++ },
+		"```json\n{\"short\": \"a\", \"medium\": \"b\", \"executive\": \"c\"}\n```")
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	cache := &memoryCache{entries: map[string]CachedResponse{}}
+	client.SetResponseCache(cache)
+
+	summarizer := NewSummarizer(client)
+	article := &domain.Article{Title: "t", Content: "c"}
+
+	first, err := summarizer.Summarize(context.Background(), article)
+	require.NoError(t, err)
+	second, err := summarizer.Summarize(context.Background(), article)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls, "identical prompt is served from cache")
+	assert.Equal(t, first, second)
+	require.Len(t, cache.entries, 1)
+	for _, entry := range cache.entries {
+		assert.Equal(t, OperationSummary, entry.Operation)
+		assert.NotContains(t, entry.Response, "```", "code fence is stripped before caching")
+	}
+
+	_, err = summarizer.Summarize(context.Background(), &domain.Article{Title: "t", Content: "different"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_ResponseCacheSkipsInvalidResults(t *testing.T) {
+	calls := 0
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) { calls++ }, `{"short": "", "medium": "b", "executive": "c"}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderOpenAI, APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	cache := &memoryCache{entries: map[string]CachedResponse{}}
+	client.SetResponseCache(cache)
+
+	_, err = NewSummarizer(client).Summarize(context.Background(), &domain.Article{Title: "t", Content: "c"})
+	require.Error(t, err)
+	assert.Empty(t, cache.entries)
+}
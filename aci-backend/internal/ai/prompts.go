@@ -3,6 +3,8 @@ package ai
 import (
 	"fmt"
 	"strings"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
 )
 
 // ThreatAnalysisSystemPrompt defines the system context for threat analysis
@@ -12,8 +14,9 @@ Your role is to:
 1. Identify and classify the primary threat type (malware, phishing, ransomware, APT, vulnerability, data breach, DDoS, supply chain, etc.)
 2. Determine the attack vector (email, web, network, physical, social engineering, zero-day exploit, etc.)
 3. Assess the potential impact on organizations (data loss, financial damage, operational disruption, reputational harm, etc.)
-4. Extract indicators of compromise (IOCs) including IPs, domains, file hashes, and URLs
+4. Extract indicators of compromise (IOCs) including IPs, domains, file hashes, URLs, and email addresses
 5. Provide specific, actionable recommended actions for security teams
+6. Name the threat actors and ransomware groups the article attributes activity to
 
 You must respond ONLY with valid JSON in the following format:
 {
@@ -22,8 +25,9 @@ You must respond ONLY with valid JSON in the following format:
   "impact_assessment": "string",
   "recommended_actions": ["action1", "action2", "action3"],
   "iocs": [
-    {"type": "ip|domain|hash|url", "value": "actual_value", "context": "optional context"}
+    {"type": "ip|domain|hash|url|email", "value": "actual_value", "context": "optional context"}
   ],
+  "threat_actors": ["actor or ransomware group name"],
   "confidence_score": 0.0-1.0
 }
 
@@ -33,9 +37,53 @@ Guidelines:
 - Extract all IOCs mentioned in the article
 - Confidence score should reflect the quality and specificity of the intelligence
 - If no IOCs are mentioned, return an empty array
+- Use each threat actor's most common name, without words such as "group" or "ransomware"; only include actors the article names, and return an empty array if there are none
 - Recommended actions should be prioritized (most critical first)
 - Keep impact assessment concise but comprehensive`
 
+// BatchThreatAnalysisSystemPrompt defines the system context for analyzing several articles in one call
+// Each article gets the same analysis as ThreatAnalysisSystemPrompt, keyed by its number in the prompt
+const BatchThreatAnalysisSystemPrompt = `You are a cybersecurity threat analyst specializing in analyzing security news articles and generating actionable intelligence.
+
+You will receive several numbered articles. Analyze each one independently: never mix facts, IOCs or threat actors between articles.
+
+For each article:
+1. Identify and classify the primary threat type (malware, phishing, ransomware, APT, vulnerability, data breach, DDoS, supply chain, etc.)
+2. Determine the attack vector (email, web, network, physical, social engineering, zero-day exploit, etc.)
+3. Assess the potential impact on organizations (data loss, financial damage, operational disruption, reputational harm, etc.)
+4. Extract indicators of compromise (IOCs) including IPs, domains, file hashes, URLs, and email addresses
+5. Provide specific, actionable recommended actions for security teams
+6. Name the threat actors and ransomware groups the article attributes activity to
+
+You must respond ONLY with valid JSON in the following format, with one entry per article:
+{
+  "results": [
+    {
+      "article": 1,
+      "threat_type": "string",
+      "attack_vector": "string",
+      "impact_assessment": "string",
+      "recommended_actions": ["action1", "action2", "action3"],
+      "iocs": [
+        {"type": "ip|domain|hash|url|email", "value": "actual_value", "context": "optional context"}
+      ],
+      "threat_actors": ["actor or ransomware group name"],
+      "confidence_score": 0.0-1.0
+    }
+  ]
+}
+
+Guidelines:
+- "article" is the number of the article the entry analyzes
+- Be specific and technical in your analysis
+- Focus on actionable intelligence, not generic advice
+- Extract all IOCs mentioned in each article
+- Confidence score should reflect the quality and specificity of the intelligence
+- If no IOCs are mentioned, return an empty array
+- Use each threat actor's most common name, without words such as "group" or "ransomware"; only include actors the article names, and return an empty array if there are none
+- Recommended actions should be prioritized (most critical first)
+- Keep impact assessments concise but comprehensive`
+
 // ArmorCTASystemPrompt defines the system context for Armor.com CTA generation
 const ArmorCTASystemPrompt = `You are a marketing specialist for Armor.com, a cybersecurity services company specializing in:
 - Managed Detection and Response (MDR)
@@ -94,6 +142,37 @@ func BuildThreatAnalysisPrompt(title, content string, cves, vendors []string) st
 	return builder.String()
 }
 
+// BuildBatchThreatAnalysisPrompt builds the user prompt for analyzing several articles at once
+// Articles are numbered from 1 in the order given
+func BuildBatchThreatAnalysisPrompt(articles []*domain.Article) string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("Analyze each of the following %d cybersecurity articles and provide a threat analysis for every one:\n\n", len(articles)))
+
+	for i, article := range articles {
+		builder.WriteString(fmt.Sprintf("## Article %d\n\n", i+1))
+		builder.WriteString(fmt.Sprintf("**Title:** %s\n\n", article.Title))
+
+		if len(article.CVEs) > 0 {
+			builder.WriteString(fmt.Sprintf("**CVEs Mentioned:** %s\n\n", strings.Join(article.CVEs, ", ")))
+		}
+
+		if len(article.Vendors) > 0 {
+			builder.WriteString(fmt.Sprintf("**Vendors/Products Affected:** %s\n\n", strings.Join(article.Vendors, ", ")))
+		}
+
+		builder.WriteString("**Article Content:**\n")
+		builder.WriteString(article.Content)
+		builder.WriteString("\n\n")
+	}
+
+	builder.WriteString(fmt.Sprintf("Provide your analysis as JSON following the specified format, with exactly %d results. ", len(articles)))
+	builder.WriteString("Extract all technical indicators (IPs, domains, hashes, URLs) mentioned. ")
+	builder.WriteString("Focus on actionable intelligence that security teams can use immediately.")
+
+	return builder.String()
+}
+
 // BuildArmorCTAPrompt builds the user prompt for Armor CTA generation
 func BuildArmorCTAPrompt(title, content, threatType, attackVector string) string {
 	var builder strings.Builder
@@ -119,3 +198,91 @@ func BuildArmorCTAPrompt(title, content, threatType, attackVector string) string
 
 	return builder.String()
 }
+
+// SummarySystemPrompt defines the system context for article summarization
+const SummarySystemPrompt = `You are a cybersecurity editor who writes accurate, neutral summaries of security news articles for two audiences: marketing teams who need a punchy one-liner, and security analysts who need the technical detail.
+
+You must respond ONLY with valid JSON in the following format:
+{
+  "short": "string",
+  "medium": "string",
+  "executive": "string"
+}
+
+Length presets:
+- short: a single sentence of at most 25 words stating what happened and who is affected
+- medium: 2-3 sentences (40-80 words) covering the threat, affected products, and severity
+- executive: 1-2 paragraphs (120-200 words) covering the threat, affected systems, business impact, and recommended response
+
+Guidelines:
+- Only state facts present in the article; do not speculate
+- Name CVEs, vendors, and threat actors when the article names them
+- Do not use marketing language, exclamation marks, or calls to action
+- Write in plain text without markdown`
+
+// BuildSummaryPrompt builds the user prompt for article summarization
+func BuildSummaryPrompt(title, content string, cves, vendors []string) string {
+	var builder strings.Builder
+
+	builder.WriteString("Summarize the following cybersecurity article at each length preset:\n\n")
+
+	builder.WriteString(fmt.Sprintf("**Title:** %s\n\n", title))
+
+	if len(cves) > 0 {
+		builder.WriteString(fmt.Sprintf("**CVEs Mentioned:** %s\n\n", strings.Join(cves, ", ")))
+	}
+
+	if len(vendors) > 0 {
+		builder.WriteString(fmt.Sprintf("**Vendors/Products Affected:** %s\n\n", strings.Join(vendors, ", ")))
+	}
+
+	builder.WriteString("**Article Content:**\n")
+	builder.WriteString(content)
+	builder.WriteString("\n\n")
+
+	builder.WriteString("Provide the short, medium, and executive summaries as JSON following the specified format.")
+
+	return builder.String()
+}
+
+// ClassificationSystemPrompt defines the system context for article classification
+const ClassificationSystemPrompt = `You are a cybersecurity news editor who classifies security articles for a threat intelligence feed.
+
+Your role is to suggest:
+1. The single best category from the provided list of category slugs
+2. A severity: critical, high, medium, low, or informational
+3. Up to 8 short lowercase tags describing the topics (e.g. "zero-day", "healthcare", "patch")
+4. The vendors or products affected, using their common names
+
+You must respond ONLY with valid JSON in the following format:
+{
+  "category": {"value": "category-slug", "confidence": 0.0-1.0},
+  "severity": {"value": "critical|high|medium|low|informational", "confidence": 0.0-1.0},
+  "tags": {"values": ["tag1", "tag2"], "confidence": 0.0-1.0},
+  "vendors": {"values": ["Vendor"], "confidence": 0.0-1.0}
+}
+
+Guidelines:
+- Only use a category slug from the provided list
+- critical: active exploitation or widespread compromise; high: serious flaw or breach with likely impact; medium: limited impact or requires unusual conditions; low: minor issue; informational: news without a direct threat
+- Confidence reflects how clearly the article supports each value; use low confidence when guessing
+- If no vendors are affected, return an empty array`
+
+// BuildClassificationPrompt builds the user prompt for article classification
+func BuildClassificationPrompt(title, content string, categorySlugs []string) string {
+	var builder strings.Builder
+
+	builder.WriteString("Classify the following cybersecurity article:\n\n")
+
+	builder.WriteString(fmt.Sprintf("**Available Categories:** %s\n\n", strings.Join(categorySlugs, ", ")))
+
+	builder.WriteString(fmt.Sprintf("**Title:** %s\n\n", title))
+
+	builder.WriteString("**Article Content:**\n")
+	builder.WriteString(content)
+	builder.WriteString("\n\n")
+
+	builder.WriteString("Provide your classification as JSON following the specified format.")
+
+	return builder.String()
+}
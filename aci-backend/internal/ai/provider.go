@@ -0,0 +1,109 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ProviderType identifies an AI completion backend
+type ProviderType string
+
+const (
+	ProviderAnthropic ProviderType = "anthropic"
+	ProviderOpenAI    ProviderType = "openai"
+	ProviderAzure     ProviderType = "azure"
+	ProviderLocal     ProviderType = "local"    // any OpenAI-compatible endpoint, e.g. Ollama, vLLM, LM Studio
+	ProviderDisabled  ProviderType = "disabled" // no model; canned responses from StubProvider
+)
+
+// Default models per provider (Azure and local require an explicit model or deployment)
+const (
+	DefaultAnthropicModel = "claude-3-haiku-20240307"
+	DefaultOpenAIModel    = "gpt-4o-mini"
+
+	// DefaultAzureAPIVersion is the Azure OpenAI REST API version used when none is configured
+	DefaultAzureAPIVersion = "2024-06-01"
+
+	// defaultMaxTokens caps completion length for all providers
+	defaultMaxTokens = 4096
+)
+
+// IsValid checks if the provider type is valid
+func (p ProviderType) IsValid() bool {
+	switch p {
+	case ProviderAnthropic, ProviderOpenAI, ProviderAzure, ProviderLocal, ProviderDisabled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Completion is a provider response with the token usage reported for it
+type Completion struct {
+	Text         string
+	Model        string
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// Provider sends a single-turn completion to an AI model
+type Provider interface {
+	// Name returns the provider identifier for logging
+	Name() string
+
+	// Model returns the configured model (the deployment name for azure)
+	Model() string
+
+	// Complete sends a system prompt and user message and returns the response with its token usage
+	Complete(ctx context.Context, systemPrompt, userMessage string) (*Completion, error)
+}
+
+// Pinger is implemented by providers that can check reachability and credentials without a completion
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type modelKey struct{}
+
+// WithModel runs AI calls made with ctx on model instead of the provider's configured one
+// Azure and the stub provider ignore it: a deployment and the stub each have a fixed model
+func WithModel(ctx context.Context, model string) context.Context {
+	return context.WithValue(ctx, modelKey{}, model)
+}
+
+// requestModel returns the model a call made with ctx runs on
+func requestModel(ctx context.Context, provider Provider) string {
+	switch ProviderType(provider.Name()) {
+	case ProviderAzure, ProviderDisabled:
+		return provider.Model()
+	}
+
+	if model, ok := ctx.Value(modelKey{}).(string); ok && model != "" {
+		return model
+	}
+	return provider.Model()
+}
+
+// NewProvider creates the provider selected by cfg.Provider (Anthropic when empty)
+func NewProvider(cfg Config) (Provider, error) {
+	providerType := ProviderType(strings.ToLower(string(cfg.Provider)))
+	if providerType == "" {
+		providerType = ProviderAnthropic
+	}
+
+	switch providerType {
+	case ProviderAnthropic:
+		return newAnthropicProvider(cfg)
+	case ProviderOpenAI:
+		return newOpenAIProvider(cfg)
+	case ProviderAzure:
+		return newAzureProvider(cfg)
+	case ProviderLocal:
+		return newLocalProvider(cfg)
+	case ProviderDisabled:
+		return NewStubProvider(), nil
+	default:
+		return nil, fmt.Errorf("unsupported ai provider: %s (must be anthropic, openai, azure, local, or disabled)", cfg.Provider)
+	}
+}
@@ -0,0 +1,83 @@
+package ai
+
+import (
+	"context"
+)
+
+// stubModel is reported as the model of the stub provider
+const stubModel = "stub"
+
+// Canned responses returned by the stub provider, one per operation
+// Classification suggests nothing, so no metadata is ever auto-applied from it
+const (
+	stubEnrichmentResponse = `{
+		"threat_type": "unknown",
+		"attack_vector": "unknown",
+		"impact_assessment": "AI analysis is disabled in this environment.",
+		"recommended_actions": ["Review the source article for guidance."],
+		"iocs": [],
+		"threat_actors": [],
+		"confidence_score": 0
+	}`
+
+	stubArmorCTAResponse = `{
+		"type": "consultation",
+		"title": "Talk to a security expert",
+		"url": "https://www.armor.com/contact"
+	}`
+
+	stubSummaryResponse = `{
+		"short": "AI summaries are disabled in this environment.",
+		"medium": "AI summaries are disabled in this environment.",
+		"executive": "AI summaries are disabled in this environment."
+	}`
+
+	stubClassificationResponse = `{
+		"category": {"value": "", "confidence": 0},
+		"severity": {"value": "", "confidence": 0},
+		"tags": {"values": [], "confidence": 0},
+		"vendors": {"values": [], "confidence": 0}
+	}`
+)
+
+// StubProvider implements Provider without calling a model: it answers every prompt with a
+// deterministic canned response for the operation, for tests and for running offline
+// (AI_PROVIDER=disabled). It reports no token usage, so it never counts against a budget
+type StubProvider struct{}
+
+// NewStubProvider creates a stub provider
+func NewStubProvider() *StubProvider {
+	return &StubProvider{}
+}
+
+// Name returns the provider identifier
+func (p *StubProvider) Name() string {
+	return string(ProviderDisabled)
+}
+
+// Model returns the stub model name
+func (p *StubProvider) Model() string {
+	return stubModel
+}
+
+// Complete returns the canned response for the operation labelled on ctx
+// Prompts for other operations get an empty JSON object
+func (p *StubProvider) Complete(ctx context.Context, systemPrompt, userMessage string) (*Completion, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	text := "{}"
+	switch OperationFromContext(ctx) {
+	case OperationEnrichment:
+		text = stubEnrichmentResponse
+	case OperationArmorCTA:
+		text = stubArmorCTAResponse
+	case OperationSummary:
+		text = stubSummaryResponse
+	case OperationClassification:
+		text = stubClassificationResponse
+	}
+
+	return &Completion{Text: text, Model: stubModel}, nil
+}
@@ -0,0 +1,72 @@
+package ai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func newStubClient(t *testing.T) *Client {
+	t.Helper()
+
+	client, err := NewClient(Config{Provider: ProviderDisabled})
+	require.NoError(t, err)
+	return client
+}
+
+var stubArticle = &domain.Article{
+	Title:   "Critical flaw patched",
+	Content: "Details of the flaw.",
+	CVEs:    []string{"CVE-2026-1234"},
+}
+
+func TestStubProvider_NeedsNoCredentials(t *testing.T) {
+	client := newStubClient(t)
+
+	assert.False(t, client.Enabled())
+	assert.Equal(t, "disabled", client.ProviderName())
+	assert.NoError(t, client.Ping(context.Background()))
+}
+
+func TestStubProvider_EnrichmentIsValidAndDeterministic(t *testing.T) {
+	enricher := NewEnricher(newStubClient(t))
+	assert.False(t, enricher.Enabled())
+
+	first, err := enricher.EnrichArticle(context.Background(), stubArticle)
+	require.NoError(t, err)
+	second, err := enricher.EnrichArticle(context.Background(), stubArticle)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, "unknown", first.ThreatType)
+	assert.Zero(t, first.ConfidenceScore)
+
+	cta, err := enricher.GenerateArmorCTA(context.Background(), stubArticle)
+	require.NoError(t, err)
+	assert.True(t, cta.IsValid())
+}
+
+func TestStubProvider_SummaryAndClassification(t *testing.T) {
+	client := newStubClient(t)
+
+	summary, err := NewSummarizer(client).Summarize(context.Background(), stubArticle)
+	require.NoError(t, err)
+	assert.NotEmpty(t, summary.ForLength(domain.SummaryLengthShort))
+
+	classification, err := NewClassifier(client).Classify(context.Background(), stubArticle.Title, stubArticle.Content, []string{"vulnerabilities"})
+	require.NoError(t, err)
+	assert.Empty(t, classification.Category.Value)
+	assert.Zero(t, classification.Category.Confidence)
+	assert.Empty(t, classification.Tags.Values)
+}
+
+func TestStubProvider_UnknownOperation(t *testing.T) {
+	out, err := NewStubProvider().Complete(context.Background(), "system", "user")
+	require.NoError(t, err)
+	assert.Equal(t, "{}", out.Text)
+	assert.Zero(t, out.InputTokens)
+}
@@ -0,0 +1,108 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// SummaryResult holds an article summary at each length preset
+type SummaryResult struct {
+	Short     string `json:"short"`
+	Medium    string `json:"medium"`
+	Executive string `json:"executive"`
+}
+
+// Validate checks that every length preset was generated
+func (r *SummaryResult) Validate() error {
+	if r.Short == "" {
+		return fmt.Errorf("short summary is required")
+	}
+
+	if r.Medium == "" {
+		return fmt.Errorf("medium summary is required")
+	}
+
+	if r.Executive == "" {
+		return fmt.Errorf("executive summary is required")
+	}
+
+	return nil
+}
+
+// ForLength returns the summary for the given length preset
+func (r *SummaryResult) ForLength(length domain.SummaryLength) string {
+	switch length {
+	case domain.SummaryLengthShort:
+		return r.Short
+	case domain.SummaryLengthMedium:
+		return r.Medium
+	case domain.SummaryLengthExecutive:
+		return r.Executive
+	default:
+		return ""
+	}
+}
+
+// Summarizer generates article summaries
+type Summarizer struct {
+	client *Client
+}
+
+// NewSummarizer creates a new summarizer instance
+func NewSummarizer(client *Client) *Summarizer {
+	if client == nil {
+		panic("client cannot be nil")
+	}
+
+	return &Summarizer{
+		client: client,
+	}
+}
+
+// ProviderName returns the name of the provider generating summaries
+func (s *Summarizer) ProviderName() string {
+	return s.client.ProviderName()
+}
+
+// Summarize generates all length presets for an article in a single call
+func (s *Summarizer) Summarize(ctx context.Context, article *domain.Article) (*SummaryResult, error) {
+	if article == nil {
+		return nil, fmt.Errorf("article cannot be nil")
+	}
+
+	if article.Title == "" {
+		return nil, fmt.Errorf("article title is required")
+	}
+
+	if article.Content == "" {
+		return nil, fmt.Errorf("article content is required")
+	}
+
+	// Add timeout to prevent long-running requests
+	ctx, cancel := context.WithTimeout(WithOperation(ctx, OperationSummary), 60*time.Second)
+	defer cancel()
+
+	// Build the prompt
+	userPrompt := BuildSummaryPrompt(
+		article.Title,
+		article.Content,
+		article.CVEs,
+		article.Vendors,
+	)
+
+	// Call the AI provider
+	var result SummaryResult
+	if err := s.client.CompleteWithJSON(ctx, SummarySystemPrompt, userPrompt, &result); err != nil {
+		return nil, fmt.Errorf("failed to summarize article: %w", err)
+	}
+
+	// Validate the result
+	if err := result.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid summary result: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,47 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func TestSummarizer_Summarize(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {
+		require.Len(t, body.Messages, 2)
+		assert.Equal(t, SummarySystemPrompt, body.Messages[0].Content)
+		assert.Contains(t, body.Messages[1].Content, "CVE-2026-1234")
+	}, `{"short": "One line.", "medium": "A few sentences.", "executive": "A full briefing."}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+
+	result, err := NewSummarizer(client).Summarize(context.Background(), &domain.Article{
+		Title:   "Critical flaw patched",
+		Content: "Details of the flaw.",
+		CVEs:    []string{"CVE-2026-1234"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "One line.", result.ForLength(domain.SummaryLengthShort))
+	assert.Equal(t, "A few sentences.", result.ForLength(domain.SummaryLengthMedium))
+	assert.Equal(t, "A full briefing.", result.ForLength(domain.SummaryLengthExecutive))
+}
+
+func TestSummarizer_RejectsIncompleteResult(t *testing.T) {
+	server := newChatServer(t, func(r *http.Request, body chatCompletionRequest) {}, `{"short": "One line."}`)
+	defer server.Close()
+
+	client, err := NewClient(Config{Provider: ProviderLocal, BaseURL: server.URL, Model: "llama3.1"})
+	require.NoError(t, err)
+
+	_, err = NewSummarizer(client).Summarize(context.Background(), &domain.Article{Title: "t", Content: "c"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "medium summary is required")
+}
@@ -0,0 +1,96 @@
+package ai
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Operations label AI calls for usage reporting
+const (
+	OperationEnrichment     = "enrichment"
+	OperationArmorCTA       = "armor_cta"
+	OperationSummary        = "summary"
+	OperationClassification = "classification"
+	OperationOther          = "other"
+)
+
+// Usage describes a single AI call for cost tracking
+type Usage struct {
+	Provider     string
+	Model        string
+	Operation    string
+	InputTokens  int64
+	OutputTokens int64
+	Success      bool
+	Duration     time.Duration
+}
+
+// UsageRecorder receives usage for every AI call made through a Client
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, usage Usage)
+}
+
+type operationKey struct{}
+
+// WithOperation labels AI calls made with ctx for usage reporting
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext returns the operation label set by WithOperation
+func OperationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok && operation != "" {
+		return operation
+	}
+	return OperationOther
+}
+
+// ModelPricing is the price of a model in USD per million tokens
+type ModelPricing struct {
+	InputPerMTok  float64
+	OutputPerMTok float64
+}
+
+// Cost returns the USD cost of a call with the given token counts
+func (p ModelPricing) Cost(inputTokens, outputTokens int64) float64 {
+	return (float64(inputTokens)*p.InputPerMTok + float64(outputTokens)*p.OutputPerMTok) / 1_000_000
+}
+
+// modelPricing lists list prices by model name prefix; longer prefixes are matched first
+// Dated model names (e.g. claude-3-haiku-20240307) match their family prefix
+var modelPricing = []struct {
+	prefix  string
+	pricing ModelPricing
+}{
+	{"claude-3-5-haiku", ModelPricing{InputPerMTok: 0.80, OutputPerMTok: 4.00}},
+	{"claude-3-haiku", ModelPricing{InputPerMTok: 0.25, OutputPerMTok: 1.25}},
+	{"claude-3-5-sonnet", ModelPricing{InputPerMTok: 3.00, OutputPerMTok: 15.00}},
+	{"claude-3-7-sonnet", ModelPricing{InputPerMTok: 3.00, OutputPerMTok: 15.00}},
+	{"claude-sonnet-4", ModelPricing{InputPerMTok: 3.00, OutputPerMTok: 15.00}},
+	{"claude-3-opus", ModelPricing{InputPerMTok: 15.00, OutputPerMTok: 75.00}},
+	{"claude-opus-4", ModelPricing{InputPerMTok: 15.00, OutputPerMTok: 75.00}},
+	{"gpt-4o-mini", ModelPricing{InputPerMTok: 0.15, OutputPerMTok: 0.60}},
+	{"gpt-4o", ModelPricing{InputPerMTok: 2.50, OutputPerMTok: 10.00}},
+	{"gpt-4.1-mini", ModelPricing{InputPerMTok: 0.40, OutputPerMTok: 1.60}},
+	{"gpt-4.1", ModelPricing{InputPerMTok: 2.00, OutputPerMTok: 8.00}},
+}
+
+// LookupPricing returns the list price for a model, if known
+// Unknown models (local models, custom Azure deployments) return false
+func LookupPricing(model string) (ModelPricing, bool) {
+	model = strings.ToLower(model)
+
+	best := -1
+	for i, entry := range modelPricing {
+		if strings.HasPrefix(model, entry.prefix) && (best < 0 || len(entry.prefix) > len(modelPricing[best].prefix)) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return ModelPricing{}, false
+	}
+
+	return modelPricing[best].pricing, true
+}
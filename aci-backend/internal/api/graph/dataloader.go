@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// loaderWait is how long a loader collects keys before fetching them in one query
+	loaderWait = 2 * time.Millisecond
+
+	// loaderMaxBatch fetches a batch early once it holds this many keys
+	loaderMaxBatch = 200
+)
+
+type loadersContextKey struct{}
+
+// Loaders batch the per-article lookups of a single request, so resolving a page of articles
+// costs one query per field instead of one per article
+type Loaders struct {
+	Categories *Loader[uuid.UUID, *domain.Category]
+	Sources    *Loader[uuid.UUID, *domain.Source]
+	Bookmarked *Loader[uuid.UUID, bool]
+}
+
+// NewLoaders creates the loaders for one request; userID scopes the bookmark loader
+func NewLoaders(
+	ctx context.Context,
+	categoryRepo repository.CategoryRepository,
+	sourceRepo repository.SourceRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	userID uuid.UUID,
+) *Loaders {
+	return &Loaders{
+		Categories: NewLoader(ctx, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.Category, error) {
+			categories, err := categoryRepo.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]*domain.Category, len(categories))
+			for _, category := range categories {
+				byID[category.ID] = category
+			}
+			return byID, nil
+		}),
+		Sources: NewLoader(ctx, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.Source, error) {
+			sources, err := sourceRepo.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]*domain.Source, len(sources))
+			for _, source := range sources {
+				byID[source.ID] = source
+			}
+			return byID, nil
+		}),
+		Bookmarked: NewLoader(ctx, func(ctx context.Context, articleIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+			bookmarked, err := bookmarkRepo.FilterBookmarked(ctx, userID, articleIDs)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]bool, len(bookmarked))
+			for _, id := range bookmarked {
+				byID[id] = true
+			}
+			return byID, nil
+		}),
+	}
+}
+
+// LoadersMiddleware attaches a fresh set of loaders to each authenticated request
+func LoadersMiddleware(
+	categoryRepo repository.CategoryRepository,
+	sourceRepo repository.SourceRepository,
+	bookmarkRepo repository.BookmarkRepository,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			var userID uuid.UUID
+			if claims, ok := middleware.GetUserFromContext(ctx); ok {
+				userID = claims.UserID
+			}
+
+			loaders := NewLoaders(ctx, categoryRepo, sourceRepo, bookmarkRepo, userID)
+			ctx = context.WithValue(ctx, loadersContextKey{}, loaders)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// loadersFromContext returns the request's loaders
+func loadersFromContext(ctx context.Context) *Loaders {
+	loaders, _ := ctx.Value(loadersContextKey{}).(*Loaders)
+	return loaders
+}
+
+// Loader collects the keys requested by concurrent resolvers and fetches them in batches,
+// caching results for the rest of the request. Keys missing from a fetch load as the zero value
+type Loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending *loaderBatch[K, V]
+	batches map[K]*loaderBatch[K, V]
+}
+
+// loaderBatch is a set of keys fetched together
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// NewLoader creates a loader whose fetches run with ctx
+func NewLoader[K comparable, V any](ctx context.Context, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{
+		ctx:     ctx,
+		fetch:   fetch,
+		batches: make(map[K]*loaderBatch[K, V]),
+	}
+}
+
+// Load returns the value for key, waiting for the batch that fetches it
+func (l *Loader[K, V]) Load(key K) (V, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[key]
+	if !ok {
+		if l.pending == nil {
+			l.pending = &loaderBatch[K, V]{done: make(chan struct{})}
+			pending := l.pending
+			time.AfterFunc(loaderWait, func() { l.dispatch(pending) })
+		}
+		batch = l.pending
+		batch.keys = append(batch.keys, key)
+		l.batches[key] = batch
+
+		if len(batch.keys) >= loaderMaxBatch {
+			go l.dispatch(batch)
+		}
+	}
+	l.mu.Unlock()
+
+	<-batch.done
+	return batch.results[key], batch.err
+}
+
+// LoadMany returns the values for keys in order
+func (l *Loader[K, V]) LoadMany(keys []K) ([]V, error) {
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key K) {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(key)
+		}(i, key)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// dispatch fetches a batch once; a batch is dispatched by its timer or when it fills up
+func (l *Loader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	l.mu.Lock()
+	if l.pending != batch {
+		l.mu.Unlock()
+		return
+	}
+	l.pending = nil
+	l.mu.Unlock()
+
+	batch.results, batch.err = l.fetch(l.ctx, batch.keys)
+	close(batch.done)
+}
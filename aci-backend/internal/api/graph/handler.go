@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/rs/zerolog/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// NewHandler creates the GraphQL HTTP handler, accepting queries over GET and POST
+// Requests must pass through LoadersMiddleware so field resolvers can batch their lookups
+func NewHandler(resolver *Resolver) *handler.Server {
+	if resolver == nil {
+		panic("resolver cannot be nil")
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxQueryComplexity))
+
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(func(ctx context.Context, p interface{}) error {
+		log.Error().
+			Str("request_id", middleware.GetRequestID(ctx)).
+			Interface("panic", p).
+			Msg("GraphQL resolver panicked")
+		return fmt.Errorf("internal server error")
+	})
+
+	return srv
+}
+
+// presentError maps resolver errors to client-safe messages, hiding internal failures
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var validationErr *domainerrors.ValidationError
+	var notFoundErr *domainerrors.NotFoundError
+	switch {
+	case errors.As(err, &validationErr):
+		gqlErr.Message = validationErr.Message
+		gqlErr.Extensions = map[string]interface{}{"code": "VALIDATION_ERROR"}
+	case errors.As(err, &notFoundErr):
+		gqlErr.Message = notFoundErr.Error()
+		gqlErr.Extensions = map[string]interface{}{"code": "NOT_FOUND"}
+	case errors.Is(err, domainerrors.ErrUnauthorized):
+		gqlErr.Message = "authentication required"
+		gqlErr.Extensions = map[string]interface{}{"code": "UNAUTHORIZED"}
+	case gqlErr.Err != nil:
+		// Parse and validation errors carry no wrapped error and are safe to return as is
+		log.Error().
+			Err(err).
+			Str("request_id", middleware.GetRequestID(ctx)).
+			Str("path", gqlErr.Path.String()).
+			Msg("GraphQL resolver failed")
+		gqlErr.Message = "internal server error"
+		gqlErr.Extensions = map[string]interface{}{"code": "INTERNAL_ERROR"}
+	}
+
+	return gqlErr
+}
@@ -0,0 +1,34 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+type ArticleConnection struct {
+	Items      []*domain.Article `json:"items"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"pageSize"`
+	TotalCount int               `json:"totalCount"`
+	TotalPages int               `json:"totalPages"`
+}
+
+type ArticleFilter struct {
+	CategoryID  *uuid.UUID `json:"categoryId,omitempty"`
+	SourceID    *uuid.UUID `json:"sourceId,omitempty"`
+	Severity    *string    `json:"severity,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	Cve         *string    `json:"cve,omitempty"`
+	Vendor      *string    `json:"vendor,omitempty"`
+	Industry    *string    `json:"industry,omitempty"`
+	HasDeepDive *bool      `json:"hasDeepDive,omitempty"`
+	DateFrom    *time.Time `json:"dateFrom,omitempty"`
+	DateTo      *time.Time `json:"dateTo,omitempty"`
+}
+
+type Query struct {
+}
@@ -0,0 +1,109 @@
+package graph
+
+//go:generate go tool gqlgen generate --config gqlgen.yml
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+const (
+	// maxPageSize caps pageSize on paginated fields
+	maxPageSize = 100
+
+	// maxQueryComplexity rejects queries that would fan out into too many field resolutions
+	maxQueryComplexity = 1000
+)
+
+// Resolver is the root resolver; it reads through the same repositories and services as the REST handlers
+type Resolver struct {
+	articleRepo       repository.ArticleRepository
+	categoryRepo      repository.CategoryRepository
+	userRepo          repository.UserRepository
+	alertService      *service.AlertService
+	engagementService *service.EngagementService
+}
+
+// NewResolver creates a new root resolver
+func NewResolver(
+	articleRepo repository.ArticleRepository,
+	categoryRepo repository.CategoryRepository,
+	userRepo repository.UserRepository,
+	alertService *service.AlertService,
+	engagementService *service.EngagementService,
+) *Resolver {
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if categoryRepo == nil {
+		panic("categoryRepo cannot be nil")
+	}
+	if userRepo == nil {
+		panic("userRepo cannot be nil")
+	}
+	if alertService == nil {
+		panic("alertService cannot be nil")
+	}
+	if engagementService == nil {
+		panic("engagementService cannot be nil")
+	}
+
+	return &Resolver{
+		articleRepo:       articleRepo,
+		categoryRepo:      categoryRepo,
+		userRepo:          userRepo,
+		alertService:      alertService,
+		engagementService: engagementService,
+	}
+}
+
+// currentUserID returns the authenticated user's ID
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return uuid.Nil, domainerrors.ErrUnauthorized
+	}
+	return claims.UserID, nil
+}
+
+// pagination validates optional page arguments, applying the schema defaults
+func pagination(page, pageSize *int) (int, int, error) {
+	p, size := 1, 20
+	if page != nil {
+		p = *page
+	}
+	if pageSize != nil {
+		size = *pageSize
+	}
+
+	if p < 1 {
+		return 0, 0, &domainerrors.ValidationError{Field: "page", Message: "page must be at least 1"}
+	}
+	if size < 1 || size > maxPageSize {
+		return 0, 0, &domainerrors.ValidationError{
+			Field:   "pageSize",
+			Message: fmt.Sprintf("pageSize must be between 1 and %d", maxPageSize),
+		}
+	}
+
+	return p, size, nil
+}
+
+// newArticleConnection wraps a page of articles
+func newArticleConnection(articles []*domain.Article, total, page, pageSize int) *ArticleConnection {
+	return &ArticleConnection{
+		Items:      articles,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalCount: total,
+		TotalPages: (total + pageSize - 1) / pageSize,
+	}
+}
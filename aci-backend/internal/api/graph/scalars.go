@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+)
+
+// MarshalUUID writes a uuid.UUID as the UUID scalar, in its canonical string form
+func MarshalUUID(id uuid.UUID) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(id.String()))
+	})
+}
+
+// UnmarshalUUID reads the UUID scalar into a uuid.UUID
+func UnmarshalUUID(v interface{}) (uuid.UUID, error) {
+	s, ok := v.(string)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("UUID must be a string")
+	}
+
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid UUID: %s", s)
+	}
+
+	return id, nil
+}
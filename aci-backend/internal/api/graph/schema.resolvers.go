@@ -0,0 +1,240 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// Type is the resolver for the type field.
+func (r *alertResolver) Type(ctx context.Context, obj *domain.Alert) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Title is the resolver for the title field.
+func (r *articleResolver) Title(ctx context.Context, obj *domain.Article) (string, error) {
+	return obj.DisplayTitle(), nil
+}
+
+// Summary is the resolver for the summary field.
+func (r *articleResolver) Summary(ctx context.Context, obj *domain.Article) (*string, error) {
+	return obj.DisplaySummary(), nil
+}
+
+// Category is the resolver for the category field.
+func (r *articleResolver) Category(ctx context.Context, obj *domain.Article) (*domain.Category, error) {
+	if obj.Category != nil {
+		return obj.Category, nil
+	}
+	if obj.CategoryID == uuid.Nil {
+		return nil, nil
+	}
+	return loadersFromContext(ctx).Categories.Load(obj.CategoryID)
+}
+
+// Categories is the resolver for the categories field.
+func (r *articleResolver) Categories(ctx context.Context, obj *domain.Article) ([]*domain.Category, error) {
+	ids := obj.CategoryIDs
+	if len(ids) == 0 && obj.CategoryID != uuid.Nil {
+		ids = []uuid.UUID{obj.CategoryID}
+	}
+
+	loaded, err := loadersFromContext(ctx).Categories.LoadMany(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*domain.Category, 0, len(loaded))
+	for _, category := range loaded {
+		if category != nil {
+			categories = append(categories, category)
+		}
+	}
+	return categories, nil
+}
+
+// Source is the resolver for the source field.
+func (r *articleResolver) Source(ctx context.Context, obj *domain.Article) (*domain.Source, error) {
+	if obj.Source != nil {
+		return obj.Source, nil
+	}
+	if obj.SourceID == uuid.Nil {
+		return nil, nil
+	}
+	return loadersFromContext(ctx).Sources.Load(obj.SourceID)
+}
+
+// Severity is the resolver for the severity field.
+func (r *articleResolver) Severity(ctx context.Context, obj *domain.Article) (string, error) {
+	return string(obj.Severity), nil
+}
+
+// IsBookmarked is the resolver for the isBookmarked field.
+func (r *articleResolver) IsBookmarked(ctx context.Context, obj *domain.Article) (bool, error) {
+	if _, err := currentUserID(ctx); err != nil {
+		return false, err
+	}
+	return loadersFromContext(ctx).Bookmarked.Load(obj.ID)
+}
+
+// Articles is the resolver for the articles field.
+func (r *queryResolver) Articles(ctx context.Context, filter *ArticleFilter, page *int, pageSize *int) (*ArticleConnection, error) {
+	p, size, err := pagination(page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	articleFilter := domain.NewArticleFilter()
+	articleFilter.Page = p
+	articleFilter.PageSize = size
+	articleFilter.PublishedOnly = true
+	articleFilter.ExcludeDuplicates = true
+
+	if filter != nil {
+		articleFilter.CategoryID = filter.CategoryID
+		articleFilter.SourceID = filter.SourceID
+		articleFilter.Tags = filter.Tags
+		articleFilter.CVE = filter.Cve
+		articleFilter.Vendor = filter.Vendor
+		articleFilter.Industry = filter.Industry
+		articleFilter.HasDeepDive = filter.HasDeepDive
+		articleFilter.DateFrom = filter.DateFrom
+		articleFilter.DateTo = filter.DateTo
+		if filter.Severity != nil {
+			severity := domain.Severity(*filter.Severity)
+			articleFilter.Severity = &severity
+		}
+	}
+
+	if err := articleFilter.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	articles, total, err := r.articleRepo.List(ctx, articleFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles: %w", err)
+	}
+
+	return newArticleConnection(articles, total, p, size), nil
+}
+
+// Article is the resolver for the article field.
+func (r *queryResolver) Article(ctx context.Context, id *uuid.UUID, slug *string) (*domain.Article, error) {
+	var (
+		article *domain.Article
+		err     error
+	)
+	switch {
+	case id != nil:
+		article, err = r.articleRepo.GetByID(ctx, *id)
+	case slug != nil:
+		article, err = r.articleRepo.GetBySlug(ctx, *slug)
+	default:
+		return nil, &domainerrors.ValidationError{Field: "id", Message: "id or slug is required"}
+	}
+
+	// The article repository reports missing articles as plain errors
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get article: %w", err)
+	}
+
+	if !article.IsPublished {
+		return nil, nil
+	}
+
+	return article, nil
+}
+
+// Categories is the resolver for the categories field.
+func (r *queryResolver) Categories(ctx context.Context) ([]*domain.Category, error) {
+	categories, err := r.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+// Category is the resolver for the category field.
+func (r *queryResolver) Category(ctx context.Context, slug string) (*domain.Category, error) {
+	category, err := r.categoryRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return category, nil
+}
+
+// Alerts is the resolver for the alerts field.
+func (r *queryResolver) Alerts(ctx context.Context) ([]*domain.Alert, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.alertService.List(ctx, userID)
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*entities.User, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.userRepo.GetByID(ctx, userID)
+}
+
+// Role is the resolver for the role field.
+func (r *userResolver) Role(ctx context.Context, obj *entities.User) (string, error) {
+	return string(obj.Role), nil
+}
+
+// Stats is the resolver for the stats field.
+func (r *userResolver) Stats(ctx context.Context, obj *entities.User) (*repository.UserReadStats, error) {
+	return r.engagementService.GetUserStats(ctx, obj.ID)
+}
+
+// Bookmarks is the resolver for the bookmarks field.
+func (r *userResolver) Bookmarks(ctx context.Context, obj *entities.User, page *int, pageSize *int) (*ArticleConnection, error) {
+	p, size, err := pagination(page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, total, err := r.engagementService.GetBookmarks(ctx, obj.ID, p, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return newArticleConnection(articles, total, p, size), nil
+}
+
+// Alert returns AlertResolver implementation.
+func (r *Resolver) Alert() AlertResolver { return &alertResolver{r} }
+
+// Article returns ArticleResolver implementation.
+func (r *Resolver) Article() ArticleResolver { return &articleResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type alertResolver struct{ *Resolver }
+type articleResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
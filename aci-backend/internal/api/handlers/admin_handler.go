@@ -1,8 +1,8 @@
 package handlers
 
 import (
-	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -12,17 +12,17 @@ import (
 	"github.com/phillipboles/aci-backend/internal/api/middleware"
 	"github.com/phillipboles/aci-backend/internal/api/response"
 	"github.com/phillipboles/aci-backend/internal/domain"
-	"github.com/phillipboles/aci-backend/internal/service"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
 )
 
 // AdminHandler handles admin-only HTTP requests
 type AdminHandler struct {
-	adminService      *service.AdminService
-	enrichmentService *service.EnrichmentService
+	adminService      AdminService
+	enrichmentService EnrichmentService
 }
 
 // NewAdminHandler creates a new admin handler instance
-func NewAdminHandler(adminService *service.AdminService) *AdminHandler {
+func NewAdminHandler(adminService AdminService) *AdminHandler {
 	if adminService == nil {
 		panic("adminService cannot be nil")
 	}
@@ -34,11 +34,14 @@ func NewAdminHandler(adminService *service.AdminService) *AdminHandler {
 
 // UpdateArticleRequest represents the request body for updating an article
 type UpdateArticleRequest struct {
-	Severity    *string `json:"severity,omitempty"`
+	Severity    *string `json:"severity,omitempty" validate:"omitempty,oneof=critical high medium low informational"`
 	IsPublished *bool   `json:"is_published,omitempty"`
-	Title       *string `json:"title,omitempty"`
+	Title       *string `json:"title,omitempty" validate:"omitempty,min=1,max=500"`
 	Summary     *string `json:"summary,omitempty"`
 	Content     *string `json:"content,omitempty"`
+
+	// ContentFormat is html or markdown; omitted keeps the article's current format
+	ContentFormat *string `json:"content_format,omitempty" validate:"omitempty,oneof=html markdown"`
 }
 
 // UpdateArticle handles PUT /v1/admin/articles/{id}
@@ -68,12 +71,7 @@ func (h *AdminHandler) UpdateArticle(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req UpdateArticleRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode update article request")
-		response.BadRequestWithDetails(w, "Invalid request body", err.Error(), requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -94,6 +92,9 @@ func (h *AdminHandler) UpdateArticle(w http.ResponseWriter, r *http.Request) {
 	if req.Content != nil {
 		updates["content"] = *req.Content
 	}
+	if req.ContentFormat != nil {
+		updates["content_format"] = *req.ContentFormat
+	}
 
 	if len(updates) == 0 {
 		response.BadRequest(w, "No updates provided")
@@ -192,6 +193,30 @@ func (h *AdminHandler) ListSources(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
+	export, err := parseExport(r, sourceExportColumns)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if export != nil {
+		// Every source comes back in the first page, so later pages are empty
+		err := writeExport(w, requestID, export, "sources", func(offset, limit int) ([]*domain.Source, error) {
+			if offset > 0 {
+				return nil, nil
+			}
+			return h.adminService.ListSources(ctx)
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to export sources")
+			response.InternalError(w, "Failed to export sources", requestID)
+		}
+		return
+	}
+
 	// List all sources (including inactive)
 	sources, err := h.adminService.ListSources(ctx)
 	if err != nil {
@@ -206,12 +231,24 @@ func (h *AdminHandler) ListSources(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, sources)
 }
 
+// sourceExportColumns are the columns of a source export
+var sourceExportColumns = []exportColumn[*domain.Source]{
+	{"id", func(s *domain.Source) string { return s.ID.String() }},
+	{"name", func(s *domain.Source) string { return s.Name }},
+	{"url", func(s *domain.Source) string { return s.URL }},
+	{"description", func(s *domain.Source) string { return exportOptionalString(s.Description) }},
+	{"is_active", func(s *domain.Source) string { return exportBool(s.IsActive) }},
+	{"trust_score", func(s *domain.Source) string { return strconv.FormatFloat(s.TrustScore, 'f', -1, 64) }},
+	{"last_scraped_at", func(s *domain.Source) string { return exportOptionalTime(s.LastScrapedAt) }},
+	{"created_at", func(s *domain.Source) string { return exportTime(s.CreatedAt) }},
+}
+
 // CreateSourceRequest represents the request body for creating a source
 type CreateSourceRequest struct {
-	Name        string   `json:"name"`
-	URL         string   `json:"url"`
+	Name        string   `json:"name" validate:"required,max=255"`
+	URL         string   `json:"url" validate:"required,http_url,max=500"`
 	Description *string  `json:"description,omitempty"`
-	TrustScore  *float64 `json:"trust_score,omitempty"`
+	TrustScore  *float64 `json:"trust_score,omitempty" validate:"omitempty,gte=0,lte=1"`
 }
 
 // CreateSource handles POST /v1/admin/sources
@@ -228,23 +265,7 @@ func (h *AdminHandler) CreateSource(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req CreateSourceRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode create source request")
-		response.BadRequestWithDetails(w, "Invalid request body", err.Error(), requestID)
-		return
-	}
-
-	// Validate required fields
-	if req.Name == "" {
-		response.BadRequest(w, "Source name is required")
-		return
-	}
-
-	if req.URL == "" {
-		response.BadRequest(w, "Source URL is required")
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -299,11 +320,11 @@ func (h *AdminHandler) CreateSource(w http.ResponseWriter, r *http.Request) {
 
 // UpdateSourceRequest represents the request body for updating a source
 type UpdateSourceRequest struct {
-	Name        *string  `json:"name,omitempty"`
-	URL         *string  `json:"url,omitempty"`
+	Name        *string  `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	URL         *string  `json:"url,omitempty" validate:"omitempty,http_url,max=500"`
 	Description *string  `json:"description,omitempty"`
 	IsActive    *bool    `json:"is_active,omitempty"`
-	TrustScore  *float64 `json:"trust_score,omitempty"`
+	TrustScore  *float64 `json:"trust_score,omitempty" validate:"omitempty,gte=0,lte=1"`
 }
 
 // UpdateSource handles PUT /v1/admin/sources/{id}
@@ -333,12 +354,7 @@ func (h *AdminHandler) UpdateSource(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req UpdateSourceRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode update source request")
-		response.BadRequestWithDetails(w, "Invalid request body", err.Error(), requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -457,6 +473,27 @@ func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
+	export, err := parseExport(r, userExportColumns)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if export != nil {
+		err := writeExport(w, requestID, export, "users", func(offset, limit int) ([]*entities.User, error) {
+			users, _, err := h.adminService.ListUsers(ctx, limit, offset)
+			return users, err
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to export users")
+			response.InternalError(w, "Failed to export users", requestID)
+		}
+		return
+	}
+
 	// Parse pagination parameters
 	limit, offset := ParseLimitOffset(r)
 
@@ -472,22 +509,34 @@ func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Calculate pagination metadata
-	meta := &response.Meta{
-		Page:       (offset / limit) + 1,
-		PageSize:   limit,
-		TotalCount: totalCount,
-		TotalPages: (totalCount + limit - 1) / limit,
+	meta := response.OffsetMeta(r, offset, limit, totalCount)
+
+	userResponses := make([]UserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = toUserResponse(user)
 	}
 
-	response.SuccessWithMeta(w, users, meta)
+	response.SuccessWithMeta(w, userResponses, meta)
+}
+
+// userExportColumns are the columns of a user export; credentials are never exported
+var userExportColumns = []exportColumn[*entities.User]{
+	{"id", func(u *entities.User) string { return u.ID.String() }},
+	{"email", func(u *entities.User) string { return u.Email }},
+	{"name", func(u *entities.User) string { return u.Name }},
+	{"role", func(u *entities.User) string { return string(u.Role) }},
+	{"email_verified", func(u *entities.User) string { return exportBool(u.EmailVerified) }},
+	{"created_at", func(u *entities.User) string { return exportTime(u.CreatedAt) }},
+	{"last_login_at", func(u *entities.User) string { return exportOptionalTime(u.LastLoginAt) }},
+	{"status", func(u *entities.User) string { return string(u.EffectiveStatus(time.Now())) }},
 }
 
 // UpdateUserRequest represents the request body for updating a user
 type UpdateUserRequest struct {
-	Role          *string `json:"role,omitempty"`
+	Role          *string `json:"role,omitempty" validate:"omitempty,oneof=user analyst admin"`
 	EmailVerified *bool   `json:"email_verified,omitempty"`
-	Email         *string `json:"email,omitempty"`
-	Name          *string `json:"name,omitempty"`
+	Email         *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Name          *string `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
 }
 
 // UpdateUser handles PUT /v1/admin/users/{id}
@@ -517,12 +566,7 @@ func (h *AdminHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req UpdateUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode update user request")
-		response.BadRequestWithDetails(w, "Invalid request body", err.Error(), requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -655,6 +699,28 @@ func (h *AdminHandler) ListAuditLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	export, err := parseExport(r, auditLogExportColumns)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if export != nil {
+		err := writeExport(w, requestID, export, "audit-logs", func(offset, limit int) ([]*domain.AuditLog, error) {
+			filter.Offset, filter.Limit = offset, limit
+			logs, _, err := h.adminService.ListAuditLogs(ctx, filter)
+			return logs, err
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to export audit logs")
+			response.InternalError(w, "Failed to export audit logs", requestID)
+		}
+		return
+	}
+
 	// List audit logs
 	logs, totalCount, err := h.adminService.ListAuditLogs(ctx, filter)
 	if err != nil {
@@ -667,16 +733,26 @@ func (h *AdminHandler) ListAuditLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Calculate pagination metadata
-	meta := &response.Meta{
-		Page:       (filter.Offset / filter.Limit) + 1,
-		PageSize:   filter.Limit,
-		TotalCount: totalCount,
-		TotalPages: (totalCount + filter.Limit - 1) / filter.Limit,
-	}
+	meta := response.OffsetMeta(r, filter.Offset, filter.Limit, totalCount)
 
 	response.SuccessWithMeta(w, logs, meta)
 }
 
+// auditLogExportColumns are the columns of an audit log export; old_value and new_value are JSON
+var auditLogExportColumns = []exportColumn[*domain.AuditLog]{
+	{"id", func(l *domain.AuditLog) string { return l.ID.String() }},
+	{"created_at", func(l *domain.AuditLog) string { return exportTime(l.CreatedAt) }},
+	{"user_id", func(l *domain.AuditLog) string { return exportOptionalUUID(l.UserID) }},
+	{"user_email", func(l *domain.AuditLog) string { return exportOptionalString(l.UserEmail) }},
+	{"action", func(l *domain.AuditLog) string { return l.Action }},
+	{"resource_type", func(l *domain.AuditLog) string { return l.ResourceType }},
+	{"resource_id", func(l *domain.AuditLog) string { return exportOptionalUUID(l.ResourceID) }},
+	{"ip_address", func(l *domain.AuditLog) string { return exportOptionalString(l.IPAddress) }},
+	{"user_agent", func(l *domain.AuditLog) string { return exportOptionalString(l.UserAgent) }},
+	{"old_value", func(l *domain.AuditLog) string { return exportJSON(l.OldValue) }},
+	{"new_value", func(l *domain.AuditLog) string { return exportJSON(l.NewValue) }},
+}
+
 // Helper functions (shared helpers are in helpers.go)
 
 func parseAuditLogFilter(r *http.Request) (*domain.AuditLogFilter, error) {
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// AICacheHandler exposes the AI response cache to administrators
+type AICacheHandler struct {
+	cacheService AICacheService
+}
+
+// NewAICacheHandler creates a new AI cache handler instance
+func NewAICacheHandler(cacheService AICacheService) *AICacheHandler {
+	if cacheService == nil {
+		panic("cacheService cannot be nil")
+	}
+
+	return &AICacheHandler{
+		cacheService: cacheService,
+	}
+}
+
+// GetStats handles GET /v1/admin/ai/cache
+func (h *AICacheHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	stats, err := h.cacheService.Stats(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to get AI cache stats")
+		response.InternalError(w, "Failed to retrieve AI cache stats", requestID)
+		return
+	}
+
+	response.Success(w, stats)
+}
+
+// Invalidate handles DELETE /v1/admin/ai/cache
+// Query params: operation (optional; all entries are removed when omitted)
+func (h *AICacheHandler) Invalidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	operation := strings.TrimSpace(r.URL.Query().Get("operation"))
+
+	deleted, err := h.cacheService.Invalidate(ctx, operation)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("operation", operation).
+			Msg("Failed to invalidate AI cache")
+		response.InternalError(w, "Failed to invalidate AI cache", requestID)
+		return
+	}
+
+	response.Success(w, map[string]interface{}{
+		"operation": operation,
+		"deleted":   deleted,
+	})
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// AIUsageHandler exposes AI token usage and spend to administrators
+type AIUsageHandler struct {
+	usageService AIUsageService
+}
+
+// NewAIUsageHandler creates a new AI usage handler instance
+func NewAIUsageHandler(usageService AIUsageService) *AIUsageHandler {
+	if usageService == nil {
+		panic("usageService cannot be nil")
+	}
+
+	return &AIUsageHandler{
+		usageService: usageService,
+	}
+}
+
+// GetUsage handles GET /v1/admin/ai/usage
+// Query params: days (1-366, default 30)
+func (h *AIUsageHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	days := service.DefaultAIUsageDays
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed < 1 || parsed > service.MaxAIUsageDays {
+			response.BadRequest(w, "Invalid days: must be between 1 and 366")
+			return
+		}
+		days = parsed
+	}
+
+	report, err := h.usageService.Report(ctx, days)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to build AI usage report")
+		response.InternalError(w, "Failed to retrieve AI usage", requestID)
+		return
+	}
+
+	response.Success(w, report)
+}
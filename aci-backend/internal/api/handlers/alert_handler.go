@@ -1,9 +1,9 @@
 package handlers
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -12,16 +12,20 @@ import (
 	"github.com/phillipboles/aci-backend/internal/api/middleware"
 	"github.com/phillipboles/aci-backend/internal/api/response"
 	"github.com/phillipboles/aci-backend/internal/domain"
-	"github.com/phillipboles/aci-backend/internal/service"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
 )
 
+// alertBackfillTimeout bounds the background backfill of a new alert
+const alertBackfillTimeout = 10 * time.Minute
+
 // AlertHandler handles alert-related HTTP requests
 type AlertHandler struct {
-	alertService *service.AlertService
+	alertService AlertService
 }
 
 // NewAlertHandler creates a new alert handler instance
-func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+func NewAlertHandler(alertService AlertService) *AlertHandler {
 	if alertService == nil {
 		panic("alertService cannot be nil")
 	}
@@ -34,8 +38,15 @@ func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
 // CreateAlertRequest represents the request body for creating an alert
 type CreateAlertRequest struct {
 	Name  string `json:"name" validate:"required,min=1,max=255"`
-	Type  string `json:"type" validate:"required,oneof=keyword category severity vendor cve"`
+	Type  string `json:"type" validate:"required,oneof=keyword category severity vendor cve exploit"`
 	Value string `json:"value" validate:"required,min=1,max=500"`
+
+	// Shared creates the alert for the user's organization
+	Shared bool `json:"shared,omitempty"`
+
+	// BackfillDays matches the new alert against articles published in that many past days,
+	// up to domain.MaxAlertBackfillDays
+	BackfillDays int `json:"backfill_days,omitempty" validate:"min=0,max=90"`
 }
 
 // UpdateAlertRequest represents the request body for updating an alert
@@ -55,6 +66,10 @@ type AlertResponse struct {
 	MatchCount int       `json:"match_count"`
 	CreatedAt  string    `json:"created_at"`
 	UpdatedAt  string    `json:"updated_at"`
+
+	UnacknowledgedCount int        `json:"unacknowledged_count"`
+	OrganizationID      *uuid.UUID `json:"organization_id,omitempty"`
+	Watchlist           bool       `json:"watchlist"`
 }
 
 // AlertMatchResponse represents an alert match in API responses
@@ -66,69 +81,18 @@ type AlertMatchResponse struct {
 	MatchedAt  string                   `json:"matched_at"`
 	NotifiedAt *string                  `json:"notified_at,omitempty"`
 	Article    *ArticleResponse         `json:"article,omitempty"`
-}
-
-// Validate validates the CreateAlertRequest
-func (r *CreateAlertRequest) Validate() error {
-	if r.Name == "" {
-		return fmt.Errorf("name is required")
-	}
 
-	if len(r.Name) > 255 {
-		return fmt.Errorf("name cannot exceed 255 characters")
-	}
-
-	if r.Type == "" {
-		return fmt.Errorf("type is required")
-	}
-
-	alertType := domain.AlertType(r.Type)
-	if !alertType.IsValid() {
-		return fmt.Errorf("invalid alert type: must be keyword, category, severity, vendor, or cve")
-	}
-
-	if r.Value == "" {
-		return fmt.Errorf("value is required")
-	}
-
-	if len(r.Value) > 500 {
-		return fmt.Errorf("value cannot exceed 500 characters")
-	}
+	Status          string  `json:"status"`
+	StatusChangedAt *string `json:"status_changed_at,omitempty"`
+}
 
-	// Type-specific validation
-	switch alertType {
+// validateCrossFields checks that the value suits the alert type
+func (r *CreateAlertRequest) validateCrossFields() error {
+	switch domain.AlertType(r.Type) {
 	case domain.AlertTypeSeverity:
-		severity := domain.Severity(r.Value)
-		if !severity.IsValid() {
-			return fmt.Errorf("invalid severity value: must be critical, high, medium, low, or informational")
-		}
+		return validator.Var("value", r.Value, "oneof=critical high medium low informational")
 	case domain.AlertTypeCategory:
-		if _, err := uuid.Parse(r.Value); err != nil {
-			return fmt.Errorf("category value must be a valid UUID")
-		}
-	}
-
-	return nil
-}
-
-// Validate validates the UpdateAlertRequest
-func (r *UpdateAlertRequest) Validate() error {
-	if r.Name != nil {
-		if *r.Name == "" {
-			return fmt.Errorf("name cannot be empty")
-		}
-		if len(*r.Name) > 255 {
-			return fmt.Errorf("name cannot exceed 255 characters")
-		}
-	}
-
-	if r.Value != nil {
-		if *r.Value == "" {
-			return fmt.Errorf("value cannot be empty")
-		}
-		if len(*r.Value) > 500 {
-			return fmt.Errorf("value cannot exceed 500 characters")
-		}
+		return validator.Var("value", r.Value, "uuid")
 	}
 
 	return nil
@@ -146,30 +110,18 @@ func (h *AlertHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Parse request body
 	var req CreateAlertRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode alert creation request")
-		response.BadRequest(w, "Invalid request body")
-		return
-	}
-
-	// Validate request
-	if err := req.Validate(); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Alert creation validation failed")
-		response.BadRequestWithDetails(w, "Validation failed", err.Error(), requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
 	// Create alert
-	alert, err := h.alertService.Create(ctx, claims.UserID, req.Name, domain.AlertType(req.Type), req.Value)
+	alert, err := h.alertService.Create(ctx, claims.UserID, req.Name, domain.AlertType(req.Type), req.Value, req.Shared, GetClientIP(r), r.UserAgent())
 	if err != nil {
+		if writeValidationError(w, err, requestID) {
+			return
+		}
+
 		log.Error().
 			Err(err).
 			Str("request_id", requestID).
@@ -179,6 +131,23 @@ func (h *AlertHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Backfill in the background; the user is sent a summary when it completes
+	if req.BackfillDays > 0 {
+		requestctx.Go(ctx, func(ctx context.Context) {
+			bgCtx, cancel := context.WithTimeout(ctx, alertBackfillTimeout)
+			defer cancel()
+
+			if _, err := h.alertService.Backfill(bgCtx, alert, req.BackfillDays); err != nil {
+				log.Error().
+					Err(err).
+					Str("request_id", requestID).
+					Str("alert_id", alert.ID.String()).
+					Int("backfill_days", req.BackfillDays).
+					Msg("Failed to backfill alert")
+			}
+		})
+	}
+
 	alertResp := toAlertResponse(alert)
 	response.Created(w, alertResp)
 }
@@ -254,7 +223,7 @@ func (h *AlertHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 			Str("alert_id", alertID.String()).
 			Str("user_id", claims.UserID.String()).
 			Msg("Failed to get alert")
-		response.NotFound(w, "Alert not found")
+		response.NotFoundResource(w, "alert", "Alert not found")
 		return
 	}
 
@@ -292,24 +261,8 @@ func (h *AlertHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Parse request body
 	var req UpdateAlertRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode alert update request")
-		response.BadRequest(w, "Invalid request body")
-		return
-	}
-
-	// Validate request
-	if err := req.Validate(); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Alert update validation failed")
-		response.BadRequestWithDetails(w, "Validation failed", err.Error(), requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -322,7 +275,7 @@ func (h *AlertHandler) Update(w http.ResponseWriter, r *http.Request) {
 			Str("alert_id", alertID.String()).
 			Str("user_id", claims.UserID.String()).
 			Msg("Failed to update alert")
-		response.NotFound(w, "Alert not found")
+		response.NotFoundResource(w, "alert", "Alert not found")
 		return
 	}
 
@@ -361,14 +314,14 @@ func (h *AlertHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Delete alert with ownership check
-	if err := h.alertService.Delete(ctx, alertID, claims.UserID); err != nil {
+	if err := h.alertService.Delete(ctx, alertID, claims.UserID, GetClientIP(r), r.UserAgent()); err != nil {
 		log.Error().
 			Err(err).
 			Str("request_id", requestID).
 			Str("alert_id", alertID.String()).
 			Str("user_id", claims.UserID.String()).
 			Msg("Failed to delete alert")
-		response.NotFound(w, "Alert not found")
+		response.NotFoundResource(w, "alert", "Alert not found")
 		return
 	}
 
@@ -376,6 +329,7 @@ func (h *AlertHandler) Delete(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListMatches handles GET /v1/alerts/{id}/matches - returns all matches for an alert
+// Query params: status (new, acknowledged, dismissed, escalated), page, page_size, export (csv or xlsx), columns
 func (h *AlertHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
@@ -416,8 +370,42 @@ func (h *AlertHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Optional triage state filter
+	var status *domain.AlertMatchStatus
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		matchStatus := domain.AlertMatchStatus(statusStr)
+		if !matchStatus.IsValid() {
+			response.BadRequest(w, "Invalid status: must be new, acknowledged, dismissed, or escalated")
+			return
+		}
+		status = &matchStatus
+	}
+
+	export, err := parseExport(r, alertMatchExportColumns)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if export != nil {
+		err := writeExport(w, requestID, export, "alert-matches", func(offset, limit int) ([]*domain.AlertMatch, error) {
+			matches, _, err := h.alertService.ListMatches(ctx, alertID, claims.UserID, status, offset/limit+1, limit)
+			return matches, err
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Str("alert_id", alertID.String()).
+				Str("user_id", claims.UserID.String()).
+				Msg("Failed to export alert matches")
+			response.NotFoundResource(w, "alert", "Alert not found")
+		}
+		return
+	}
+
 	// List matches with ownership check
-	matches, total, err := h.alertService.ListMatches(ctx, alertID, claims.UserID, page, pageSize)
+	matches, total, err := h.alertService.ListMatches(ctx, alertID, claims.UserID, status, page, pageSize)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -425,7 +413,7 @@ func (h *AlertHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 			Str("alert_id", alertID.String()).
 			Str("user_id", claims.UserID.String()).
 			Msg("Failed to list alert matches")
-		response.NotFound(w, "Alert not found")
+		response.NotFoundResource(w, "alert", "Alert not found")
 		return
 	}
 
@@ -434,16 +422,72 @@ func (h *AlertHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 		matchResponses[i] = toAlertMatchResponse(match)
 	}
 
-	meta := &response.Meta{
-		Page:       page,
-		PageSize:   pageSize,
-		TotalCount: total,
-		TotalPages: CalculateTotalPages(total, pageSize),
-	}
+	meta := response.PageMeta(r, page, pageSize, total)
 
 	response.SuccessWithMeta(w, matchResponses, meta)
 }
 
+// AcknowledgeMatch handles POST /v1/alerts/{id}/matches/{matchID}/ack
+func (h *AlertHandler) AcknowledgeMatch(w http.ResponseWriter, r *http.Request) {
+	h.updateMatchStatus(w, r, domain.AlertMatchStatusAcknowledged)
+}
+
+// DismissMatch handles POST /v1/alerts/{id}/matches/{matchID}/dismiss
+func (h *AlertHandler) DismissMatch(w http.ResponseWriter, r *http.Request) {
+	h.updateMatchStatus(w, r, domain.AlertMatchStatusDismissed)
+}
+
+// EscalateMatch handles POST /v1/alerts/{id}/matches/{matchID}/escalate
+func (h *AlertHandler) EscalateMatch(w http.ResponseWriter, r *http.Request) {
+	h.updateMatchStatus(w, r, domain.AlertMatchStatusEscalated)
+}
+
+// ReopenMatch handles POST /v1/alerts/{id}/matches/{matchID}/reopen - returns a match to new
+func (h *AlertHandler) ReopenMatch(w http.ResponseWriter, r *http.Request) {
+	h.updateMatchStatus(w, r, domain.AlertMatchStatusNew)
+}
+
+// updateMatchStatus moves the match in the URL to status for the authenticated user
+func (h *AlertHandler) updateMatchStatus(w http.ResponseWriter, r *http.Request, status domain.AlertMatchStatus) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	// Get authenticated user from context
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	alertID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid alert ID format")
+		return
+	}
+
+	matchID, err := uuid.Parse(chi.URLParam(r, "matchID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid match ID format")
+		return
+	}
+
+	match, err := h.alertService.UpdateMatchStatus(ctx, alertID, matchID, claims.UserID, status)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("alert_id", alertID.String()).
+			Str("match_id", matchID.String()).
+			Str("user_id", claims.UserID.String()).
+			Str("status", string(status)).
+			Msg("Failed to update alert match status")
+		response.NotFoundResource(w, "alert match", "Alert match not found")
+		return
+	}
+
+	response.Success(w, toAlertMatchResponse(match))
+}
+
 // toAlertResponse converts domain alert to API response
 func toAlertResponse(alert *domain.Alert) AlertResponse {
 	if alert == nil {
@@ -459,9 +503,26 @@ func toAlertResponse(alert *domain.Alert) AlertResponse {
 		MatchCount: alert.MatchCount,
 		CreatedAt:  alert.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:  alert.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		UnacknowledgedCount: alert.UnacknowledgedCount,
+		OrganizationID:      alert.OrganizationID,
+		Watchlist:           alert.Watchlist,
 	}
 }
 
+// alertMatchExportColumns are the columns of an alert match export
+var alertMatchExportColumns = []exportColumn[*domain.AlertMatch]{
+	{"id", func(m *domain.AlertMatch) string { return m.ID.String() }},
+	{"alert_id", func(m *domain.AlertMatch) string { return m.AlertID.String() }},
+	{"article_id", func(m *domain.AlertMatch) string { return m.ArticleID.String() }},
+	{"priority", func(m *domain.AlertMatch) string { return m.Priority }},
+	{"status", func(m *domain.AlertMatch) string { return string(m.Status) }},
+	{"matched_at", func(m *domain.AlertMatch) string { return exportTime(m.MatchedAt) }},
+	{"notified_at", func(m *domain.AlertMatch) string { return exportOptionalTime(m.NotifiedAt) }},
+	{"status_changed_at", func(m *domain.AlertMatch) string { return exportOptionalTime(m.StatusChangedAt) }},
+	{"status_changed_by", func(m *domain.AlertMatch) string { return exportOptionalUUID(m.StatusChangedBy) }},
+}
+
 // toAlertMatchResponse converts domain alert match to API response
 func toAlertMatchResponse(match *domain.AlertMatch) AlertMatchResponse {
 	if match == nil {
@@ -474,6 +535,12 @@ func toAlertMatchResponse(match *domain.AlertMatch) AlertMatchResponse {
 		ArticleID: match.ArticleID,
 		Priority:  match.Priority,
 		MatchedAt: match.MatchedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Status:    string(match.Status),
+	}
+
+	if match.StatusChangedAt != nil {
+		changedStr := match.StatusChangedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.StatusChangedAt = &changedStr
 	}
 
 	if match.NotifiedAt != nil {
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// AnalyticsHandler exposes the admin analytics dashboard
+type AnalyticsHandler struct {
+	analyticsService AnalyticsService
+}
+
+// NewAnalyticsHandler creates a new analytics handler instance
+func NewAnalyticsHandler(analyticsService AnalyticsService) *AnalyticsHandler {
+	if analyticsService == nil {
+		panic("analyticsService cannot be nil")
+	}
+
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// Get handles GET /v1/admin/analytics
+// Query params: window (Go duration, e.g. 24h, 168h; default 720h), limit (top N, default 10)
+func (h *AnalyticsHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	window := service.DefaultAnalyticsWindow
+	if windowStr := r.URL.Query().Get("window"); windowStr != "" {
+		parsed, err := time.ParseDuration(windowStr)
+		if err != nil || parsed <= 0 || parsed > service.MaxAnalyticsWindow {
+			response.BadRequest(w, "Invalid window: must be a positive duration up to 2160h")
+			return
+		}
+		window = parsed
+	}
+
+	limit := service.DefaultAnalyticsTopN
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > service.MaxAnalyticsTopN {
+			response.BadRequest(w, "Invalid limit: must be between 1 and 50")
+			return
+		}
+		limit = parsed
+	}
+
+	report, err := h.analyticsService.Report(ctx, window, limit)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to build analytics report")
+		response.InternalError(w, "Failed to retrieve analytics", requestID)
+		return
+	}
+
+	response.Success(w, report)
+}
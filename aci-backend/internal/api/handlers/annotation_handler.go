@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// AnnotationHandler handles analyst annotations on articles
+type AnnotationHandler struct {
+	annotationService AnnotationService
+}
+
+// NewAnnotationHandler creates a new annotation handler instance
+func NewAnnotationHandler(annotationService AnnotationService) *AnnotationHandler {
+	if annotationService == nil {
+		panic("annotationService cannot be nil")
+	}
+
+	return &AnnotationHandler{
+		annotationService: annotationService,
+	}
+}
+
+// AnnotationRequest represents the content of an annotation being created or replaced
+type AnnotationRequest struct {
+	Body     string      `json:"body"`
+	Quote    *string     `json:"quote,omitempty"`    // highlighted passage of the article
+	Mentions []uuid.UUID `json:"mentions,omitempty"` // organization members to notify
+}
+
+func (req AnnotationRequest) toInput() service.AnnotationInput {
+	return service.AnnotationInput{
+		Body:     req.Body,
+		Quote:    req.Quote,
+		Mentions: req.Mentions,
+	}
+}
+
+// ListForArticle handles GET /v1/articles/{id}/annotations
+func (h *AnnotationHandler) ListForArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	annotations, err := h.annotationService.ListForArticle(ctx, claims.UserID, articleID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list annotations")
+		return
+	}
+
+	response.Success(w, annotations)
+}
+
+// Create handles POST /v1/articles/{id}/annotations
+func (h *AnnotationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req AnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	annotation, err := h.annotationService.Create(ctx, claims.UserID, domain.UserRole(claims.Role), articleID, req.toInput())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create annotation")
+		return
+	}
+
+	response.Created(w, annotation)
+}
+
+// Update handles PUT /v1/annotations/{id}
+func (h *AnnotationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	annotationID, ok := parseAnnotationID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	annotation, err := h.annotationService.Update(ctx, annotationID, claims.UserID, req.toInput())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update annotation")
+		return
+	}
+
+	response.Success(w, annotation)
+}
+
+// Delete handles DELETE /v1/annotations/{id}
+func (h *AnnotationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	annotationID, ok := parseAnnotationID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.annotationService.Delete(ctx, annotationID, claims.UserID); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete annotation")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListRecent handles GET /v1/annotations - the organization's annotations, newest first
+// Query params: since (RFC3339), page, page_size
+func (h *AnnotationHandler) ListRecent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	var since *time.Time
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		parsed, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			response.BadRequestWithDetails(w, "Invalid since parameter", "since must be an RFC3339 timestamp", requestID)
+			return
+		}
+		since = &parsed
+	}
+
+	annotations, total, err := h.annotationService.ListRecent(ctx, claims.UserID, since, page, pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list annotations")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, annotations, meta)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *AnnotationHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only analysts can annotate, only the author can edit, and only the author or an organization admin can delete")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseAnnotationID extracts the annotation ID URL parameter, writing a 400 on failure
+func parseAnnotationID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid annotation ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleEditorialHandler handles editorial title and summary overrides for administrators
+type ArticleEditorialHandler struct {
+	editorialService ArticleEditorialService
+}
+
+// NewArticleEditorialHandler creates a new article editorial handler instance
+func NewArticleEditorialHandler(editorialService ArticleEditorialService) *ArticleEditorialHandler {
+	if editorialService == nil {
+		panic("editorialService cannot be nil")
+	}
+
+	return &ArticleEditorialHandler{
+		editorialService: editorialService,
+	}
+}
+
+// UpdateEditorialRequest represents a request to set an article's editorial overrides
+// Both fields are replaced; omitting a field or sending null or "" clears it
+type UpdateEditorialRequest struct {
+	EditorialTitle   *string `json:"editorial_title"`
+	EditorialSummary *string `json:"editorial_summary"`
+}
+
+// Get handles GET /v1/admin/articles/{id}/editorial
+func (h *ArticleEditorialHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	editorial, err := h.editorialService.Get(ctx, articleID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get editorial overrides")
+		return
+	}
+
+	response.Success(w, editorial)
+}
+
+// Update handles PUT /v1/admin/articles/{id}/editorial
+func (h *ArticleEditorialHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req UpdateEditorialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	editorial, err := h.editorialService.Update(
+		ctx,
+		articleID,
+		req.EditorialTitle,
+		req.EditorialSummary,
+		suggestionReviewer(r),
+		GetClientIP(r),
+		r.UserAgent(),
+	)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update editorial overrides")
+		return
+	}
+
+	response.Success(w, editorial)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ArticleEditorialHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
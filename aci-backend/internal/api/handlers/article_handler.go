@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -16,22 +18,33 @@ import (
 	"github.com/phillipboles/aci-backend/internal/api/middleware"
 	"github.com/phillipboles/aci-backend/internal/api/response"
 	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/pkg/sanitizer"
 	"github.com/phillipboles/aci-backend/internal/repository"
-	"github.com/phillipboles/aci-backend/internal/service"
 )
 
 // ArticleHandler handles article-related HTTP requests
 type ArticleHandler struct {
 	articleRepo       repository.ArticleRepository
-	searchService     *service.SearchService
-	engagementService *service.EngagementService
+	searchService     SearchService
+	engagementService EngagementService
+	summarizeService  SummarizeService
+	dedupService      DeduplicationService
+	feedService       FeedPreferenceService
+	featuredService   FeaturedArticleService
+	ctaService        CTAExperimentService
+	archiveService    ArticleArchiveService
+	imageService      ArticleImageService
+	identityService   ArticleIdentityService
+	inventoryService  SoftwareInventoryService
 }
 
 // NewArticleHandler creates a new article handler instance
 func NewArticleHandler(
 	articleRepo repository.ArticleRepository,
-	searchService *service.SearchService,
-	engagementService *service.EngagementService,
+	searchService SearchService,
+	engagementService EngagementService,
 ) *ArticleHandler {
 	if articleRepo == nil {
 		panic("articleRepo cannot be nil")
@@ -50,6 +63,53 @@ func NewArticleHandler(
 	}
 }
 
+// SetSummarizeService enables the ?summary= length presets on the article detail endpoints
+func (h *ArticleHandler) SetSummarizeService(summarizeService SummarizeService) {
+	h.summarizeService = summarizeService
+}
+
+// SetDeduplicationService enables duplicate clusters and hides near-duplicates from article lists
+func (h *ArticleHandler) SetDeduplicationService(dedupService DeduplicationService) {
+	h.dedupService = dedupService
+}
+
+// SetArticleIdentityService lists the URLs an article was received under with its duplicates
+func (h *ArticleHandler) SetArticleIdentityService(identityService ArticleIdentityService) {
+	h.identityService = identityService
+}
+
+// SetFeedPreferenceService enables GET /v1/articles/feed
+func (h *ArticleHandler) SetFeedPreferenceService(feedService FeedPreferenceService) {
+	h.feedService = feedService
+}
+
+// SetFeaturedArticleService enables GET /v1/articles/featured
+func (h *ArticleHandler) SetFeaturedArticleService(featuredService FeaturedArticleService) {
+	h.featuredService = featuredService
+}
+
+// SetCTAExperimentService serves A/B variants of the Armor CTA on the article detail endpoints
+func (h *ArticleHandler) SetCTAExperimentService(ctaService CTAExperimentService) {
+	h.ctaService = ctaService
+}
+
+// SetArticleArchiveService links archived source pages from the article detail endpoints and
+// enables GET /v1/articles/{id}/archive
+func (h *ArticleHandler) SetArticleArchiveService(archiveService ArticleArchiveService) {
+	h.archiveService = archiveService
+}
+
+// SetArticleImageService enables GET /v1/images/articles/{id}/{size}.jpg
+func (h *ArticleHandler) SetArticleImageService(imageService ArticleImageService) {
+	h.imageService = imageService
+}
+
+// SetSoftwareInventoryService flags articles affecting the reader's software inventory and
+// enables the affects_stack filter
+func (h *ArticleHandler) SetSoftwareInventoryService(inventoryService SoftwareInventoryService) {
+	h.inventoryService = inventoryService
+}
+
 // CategorySummary represents a minimal category response
 type CategorySummary struct {
 	ID    uuid.UUID `json:"id"`
@@ -72,7 +132,9 @@ type ArticleResponse struct {
 	Title              string                  `json:"title"`
 	Slug               string                  `json:"slug"`
 	Summary            *string                 `json:"summary,omitempty"`
+	SummaryLength      string                  `json:"summary_length,omitempty"`
 	Category           *CategorySummary        `json:"category,omitempty"`
+	CategoryIDs        []uuid.UUID             `json:"category_ids,omitempty"` // every category, primary first
 	Source             *SourceSummary          `json:"source,omitempty"`
 	SourceURL          string                  `json:"source_url"`
 	Severity           string                  `json:"severity"`
@@ -81,15 +143,34 @@ type ArticleResponse struct {
 	Vendors            []string                `json:"vendors"`
 	Industries         []domain.Industry       `json:"industries,omitempty"`
 	HasDeepDive        bool                    `json:"has_deep_dive"`
+	KEV                bool                    `json:"kev"`
+	KEVDueDate         *string                 `json:"kev_due_date,omitempty"` // YYYY-MM-DD
+	ExploitAvailable   bool                    `json:"exploit_available"`
+	ExploitSources     []string                `json:"exploit_sources,omitempty"`
+	AffectsStack       bool                    `json:"affects_stack"`            // a CVE affects the reader's software inventory
+	StackProducts      []string                `json:"stack_products,omitempty"` // the inventory products affected
 	ReadingTimeMinutes int                     `json:"reading_time_minutes"`
+	WordCount          int                     `json:"word_count"`
+	Language           *string                 `json:"language,omitempty"` // ISO 639-1, when detected
 	ViewCount          int                     `json:"view_count"`
 	PublishedAt        string                  `json:"published_at"`
+	Image              *ArticleImageResponse   `json:"image,omitempty"`
+}
+
+// ArticleImageResponse links the article's hero image at each standard size
+// The URLs are stable and the images never change, so they can be cached indefinitely
+type ArticleImageResponse struct {
+	ThumbnailURL string `json:"thumbnail_url"`
+	MediumURL    string `json:"medium_url"`
+	LargeURL     string `json:"large_url"`
 }
 
 // ArticleDetailResponse represents a full article with all details
 type ArticleDetailResponse struct {
 	ArticleResponse
 	Content            string                      `json:"content"`
+	ContentFormat      string                      `json:"content_format"` // format of content in this response
+	ExternalLinks      []string                    `json:"external_links,omitempty"`
 	ThreatType         *string                     `json:"threat_type,omitempty"`
 	AttackVector       *string                     `json:"attack_vector,omitempty"`
 	ImpactAssessment   *string                     `json:"impact_assessment,omitempty"`
@@ -98,6 +179,15 @@ type ArticleDetailResponse struct {
 	ArmorCTA           *domain.ArmorCTA            `json:"armor_cta,omitempty"`
 	ExternalReferences []domain.ExternalReference  `json:"external_references,omitempty"`
 	Recommendations    []domain.Recommendation     `json:"recommendations,omitempty"`
+	Archive            *ArticleArchiveResponse     `json:"archive,omitempty"`
+	RedirectedFrom     string                      `json:"redirected_from,omitempty"` // former slug the request used
+}
+
+// ArticleArchiveResponse links the archived snapshot of an article's source page
+type ArticleArchiveResponse struct {
+	HTMLURL    string `json:"html_url"`
+	TextURL    string `json:"text_url"`
+	ArchivedAt string `json:"archived_at"`
 }
 
 // List handles GET /v1/articles - returns paginated list of articles
@@ -105,6 +195,12 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	filter, err := parseArticleFilter(r)
 	if err != nil {
 		log.Error().
@@ -124,6 +220,10 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.applyStackFilter(w, r, filter) {
+		return
+	}
+
 	articles, total, err := h.articleRepo.List(ctx, filter)
 	if err != nil {
 		log.Error().
@@ -133,27 +233,275 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 		response.InternalError(w, "Failed to retrieve articles", requestID)
 		return
 	}
+	h.annotateStack(r, articles...)
 
 	articleResponses := make([]ArticleResponse, len(articles))
 	for i, article := range articles {
 		articleResponses[i] = toArticleResponse(article)
 	}
 
-	meta := &response.Meta{
-		Page:       filter.Page,
-		PageSize:   filter.PageSize,
-		TotalCount: total,
-		TotalPages: CalculateTotalPages(total, filter.PageSize),
+	data, err := applyAll(fields, articleResponses)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, data, meta)
+}
+
+// AdminArticleResponse is an article in the admin listing, with its publication state
+type AdminArticleResponse struct {
+	ArticleResponse
+	IsPublished bool   `json:"is_published"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// AdminList handles GET /v1/admin/articles - lists every article, including unpublished ones
+// Accepts the same filters as List, plus export (csv or xlsx) and columns to download the matches
+func (h *ArticleHandler) AdminList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	filter, err := parseArticleFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+	filter.PublishedOnly = false
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", err.Error(), requestID)
+		return
+	}
+
+	export, err := parseExport(r, articleExportColumns)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if export != nil {
+		err := writeExport(w, requestID, export, "articles", func(offset, limit int) ([]*domain.Article, error) {
+			filter.Page, filter.PageSize = offset/limit+1, limit
+			articles, _, err := h.articleRepo.List(ctx, filter)
+			return articles, err
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to export articles")
+			response.InternalError(w, "Failed to export articles", requestID)
+		}
+		return
+	}
+
+	articles, total, err := h.articleRepo.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list articles")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return
 	}
 
+	articleResponses := make([]AdminArticleResponse, len(articles))
+	for i, article := range articles {
+		articleResponses[i] = AdminArticleResponse{
+			ArticleResponse: toArticleResponse(article),
+			IsPublished:     article.IsPublished,
+			CreatedAt:       article.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
 	response.SuccessWithMeta(w, articleResponses, meta)
 }
 
+// articleExportColumns are the columns of an article export
+var articleExportColumns = []exportColumn[*domain.Article]{
+	{"id", func(a *domain.Article) string { return a.ID.String() }},
+	{"title", func(a *domain.Article) string { return a.Title }},
+	{"slug", func(a *domain.Article) string { return a.Slug }},
+	{"severity", func(a *domain.Article) string { return string(a.Severity) }},
+	{"category", func(a *domain.Article) string {
+		if a.Category == nil {
+			return ""
+		}
+		return a.Category.Name
+	}},
+	{"source", func(a *domain.Article) string {
+		if a.Source == nil {
+			return ""
+		}
+		return a.Source.Name
+	}},
+	{"source_url", func(a *domain.Article) string { return a.SourceURL }},
+	{"tags", func(a *domain.Article) string { return exportList(a.Tags) }},
+	{"cves", func(a *domain.Article) string { return exportList(a.CVEs) }},
+	{"vendors", func(a *domain.Article) string { return exportList(a.Vendors) }},
+	{"kev", func(a *domain.Article) string { return exportBool(a.KEV) }},
+	{"exploit_available", func(a *domain.Article) string { return exportBool(a.ExploitAvailable) }},
+	{"is_published", func(a *domain.Article) string { return exportBool(a.IsPublished) }},
+	{"view_count", func(a *domain.Article) string { return strconv.Itoa(a.ViewCount) }},
+	{"published_at", func(a *domain.Article) string { return exportTime(a.PublishedAt) }},
+	{"enriched_at", func(a *domain.Article) string { return exportOptionalTime(a.EnrichedAt) }},
+	{"created_at", func(a *domain.Article) string { return exportTime(a.CreatedAt) }},
+}
+
+// Feed handles GET /v1/articles/feed - lists articles filtered by the user's feed preferences
+// Accepts the same query parameters as List to narrow the feed further
+func (h *ArticleHandler) Feed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if h.feedService == nil {
+		response.ServiceUnavailable(w, "Personalized feed is not available")
+		return
+	}
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	filter, err := parseArticleFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	if err := h.feedService.ApplyTo(ctx, claims.UserID, filter); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to load feed preferences")
+		response.InternalError(w, "Failed to retrieve feed", requestID)
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", err.Error(), requestID)
+		return
+	}
+
+	if !h.applyStackFilter(w, r, filter) {
+		return
+	}
+
+	articles, total, err := h.articleRepo.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list feed articles")
+		response.InternalError(w, "Failed to retrieve feed", requestID)
+		return
+	}
+	h.annotateStack(r, articles...)
+
+	articleResponses := make([]ArticleResponse, len(articles))
+	for i, article := range articles {
+		articleResponses[i] = toArticleResponse(article)
+	}
+
+	data, err := applyAll(fields, articleResponses)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, data, meta)
+}
+
+// Featured handles GET /v1/articles/featured - articles featured in the homepage carousel right now
+// Accepts ?limit= (default 5, max 20)
+func (h *ArticleHandler) Featured(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	if h.featuredService == nil {
+		response.ServiceUnavailable(w, "Featured articles are not available")
+		return
+	}
+
+	limit := domain.DefaultFeaturedLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > domain.MaxFeaturedLimit {
+			response.BadRequest(w, fmt.Sprintf("limit must be between 1 and %d", domain.MaxFeaturedLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	articles, err := h.featuredService.Active(ctx, limit)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list featured articles")
+		response.InternalError(w, "Failed to retrieve featured articles", requestID)
+		return
+	}
+
+	articleResponses := make([]ArticleResponse, len(articles))
+	for i, article := range articles {
+		articleResponses[i] = toArticleResponse(article)
+	}
+
+	data, err := applyAll(fields, articleResponses)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return
+	}
+
+	response.Success(w, data)
+}
+
 // GetByID handles GET /v1/articles/{id} - returns a single article by ID
 func (h *ArticleHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	idStr := chi.URLParam(r, "id")
 	if idStr == "" {
 		response.BadRequest(w, "Article ID is required")
@@ -171,34 +519,276 @@ func (h *ArticleHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	article, err := h.articleRepo.GetByID(ctx, articleID)
+	summaryLength, ok := parseSummaryLength(w, r)
+	if !ok {
+		return
+	}
+
+	contentFormat, ok := parseContentFormat(w, r)
+	if !ok {
+		return
+	}
+
+	article, err := h.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get article")
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	if !article.IsPublished {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	// Increment view count asynchronously; shared feed articles count views from every workspace
+	requestctx.Go(ctx, func(ctx context.Context) {
+		if err := h.articleRepo.IncrementViewCount(repository.WithoutTenant(ctx), articleID); err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Str("article_id", articleID.String()).
+				Msg("Failed to increment view count")
+		}
+	})
+
+	h.annotateStack(r, article)
+	articleDetail := toArticleDetailResponse(article)
+	if fields.Has("summary") {
+		h.applySummaryLength(ctx, requestID, article, summaryLength, &articleDetail)
+	}
+	// Only serve (and count an impression for) a CTA variant the client will see
+	if fields.Has("armor_cta") {
+		h.applyCTAVariant(r, &articleDetail)
+	}
+	if fields.Has("archive") {
+		h.applyArchive(ctx, requestID, article.ID, &articleDetail)
+	}
+	applyContentFormat(article, contentFormat, &articleDetail)
+
+	data, err := fields.Apply(articleDetail)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve article", requestID)
+		return
+	}
+
+	response.Success(w, data)
+}
+
+// DuplicatesResponse represents an article's near-duplicate cluster
+type DuplicatesResponse struct {
+	CanonicalID uuid.UUID                  `json:"canonical_id"`
+	Duplicates  []ArticleResponse          `json:"duplicates"`
+	SourceURLs  []*domain.ArticleSourceURL `json:"source_urls,omitempty"` // URLs this article was received under
+}
+
+// GetDuplicates handles GET /v1/articles/{id}/duplicates - returns the other articles in the duplicate cluster
+func (h *ArticleHandler) GetDuplicates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.dedupService == nil {
+		response.ServiceUnavailable(w, "Duplicate detection is not available")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	canonicalID, duplicates, err := h.dedupService.GetDuplicates(ctx, articleID)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "article", "Article not found")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get duplicate articles")
+		response.InternalError(w, "Failed to retrieve duplicate articles", requestID)
+		return
+	}
+
+	items := make([]ArticleResponse, len(duplicates))
+	for i, article := range duplicates {
+		items[i] = toArticleResponse(article)
+	}
+
+	var sourceURLs []*domain.ArticleSourceURL
+	if h.identityService != nil {
+		sourceURLs, err = h.identityService.ListURLs(ctx, articleID)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Str("article_id", articleID.String()).
+				Msg("Failed to list article source URLs")
+			response.InternalError(w, "Failed to retrieve duplicate articles", requestID)
+			return
+		}
+	}
+
+	response.Success(w, DuplicatesResponse{
+		CanonicalID: canonicalID,
+		Duplicates:  items,
+		SourceURLs:  sourceURLs,
+	})
+}
+
+// GetBySlug handles GET /v1/articles/slug/{slug} - returns a single article by slug
+// A slug the article used before a rename resolves to the article, with redirected_from set
+func (h *ArticleHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	slug := chi.URLParam(r, "slug")
+	if slug == "" {
+		response.BadRequest(w, "Article slug is required")
+		return
+	}
+
+	summaryLength, ok := parseSummaryLength(w, r)
+	if !ok {
+		return
+	}
+
+	contentFormat, ok := parseContentFormat(w, r)
+	if !ok {
+		return
+	}
+
+	article, err := getArticleBySlug(ctx, h.articleRepo, slug)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("slug", slug).
+			Msg("Failed to get article by slug")
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	if !article.IsPublished {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	// Increment view count asynchronously; shared feed articles count views from every workspace
+	requestctx.Go(ctx, func(ctx context.Context) {
+		if err := h.articleRepo.IncrementViewCount(repository.WithoutTenant(ctx), article.ID); err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to increment view count")
+		}
+	})
+
+	h.annotateStack(r, article)
+	articleDetail := toArticleDetailResponse(article)
+	if fields.Has("summary") {
+		h.applySummaryLength(ctx, requestID, article, summaryLength, &articleDetail)
+	}
+	// Only serve (and count an impression for) a CTA variant the client will see
+	if fields.Has("armor_cta") {
+		h.applyCTAVariant(r, &articleDetail)
+	}
+	if fields.Has("archive") {
+		h.applyArchive(ctx, requestID, article.ID, &articleDetail)
+	}
+	applyContentFormat(article, contentFormat, &articleDetail)
+	if article.Slug != slug {
+		articleDetail.RedirectedFrom = slug
+	}
+
+	data, err := fields.Apply(articleDetail)
 	if err != nil {
 		log.Error().
 			Err(err).
 			Str("request_id", requestID).
-			Str("article_id", articleID.String()).
-			Msg("Failed to get article")
-		response.NotFound(w, "Article not found")
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve article", requestID)
 		return
 	}
 
-	// Increment view count asynchronously
-	go func() {
-		bgCtx := context.Background()
-		if err := h.articleRepo.IncrementViewCount(bgCtx, articleID); err != nil {
-			log.Error().
-				Err(err).
-				Str("article_id", articleID.String()).
-				Msg("Failed to increment view count")
-		}
-	}()
+	response.Success(w, data)
+}
 
-	articleDetail := toArticleDetailResponse(article)
-	response.Success(w, articleDetail)
+const (
+	// metaDescriptionLength is the longest description in article metadata; longer text is cut
+	// at a word boundary, matching what social previews and search results display
+	metaDescriptionLength = 200
+
+	// metaHeadlineLength is the longest NewsArticle headline search engines accept
+	metaHeadlineLength = 110
+
+	// metaCacheMaxAge is how long, in seconds, article metadata may be cached
+	metaCacheMaxAge = 300
+
+	// metaSiteName names the site in social previews
+	metaSiteName = "Armor Cyber Intelligence"
+)
+
+// ArticleMetaResponse holds the social preview and structured data for an article page
+type ArticleMetaResponse struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Path        string            `json:"path"`
+	OpenGraph   OpenGraphMeta     `json:"open_graph"`
+	JSONLD      NewsArticleJSONLD `json:"json_ld"`
 }
 
-// GetBySlug handles GET /v1/articles/slug/{slug} - returns a single article by slug
-func (h *ArticleHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+// OpenGraphMeta are the Open Graph article properties, keyed as in the og: and article: tags
+type OpenGraphMeta struct {
+	Type          string   `json:"og:type"`
+	Title         string   `json:"og:title"`
+	Description   string   `json:"og:description"`
+	Image         string   `json:"og:image,omitempty"`
+	SiteName      string   `json:"og:site_name"`
+	PublishedTime string   `json:"article:published_time"`
+	ModifiedTime  string   `json:"article:modified_time"`
+	Section       string   `json:"article:section,omitempty"`
+	Tags          []string `json:"article:tag"`
+}
+
+// NewsArticleJSONLD is a schema.org NewsArticle, ready to embed in a JSON-LD script tag
+type NewsArticleJSONLD struct {
+	Context        string   `json:"@context"`
+	Type           string   `json:"@type"`
+	Headline       string   `json:"headline"`
+	Description    string   `json:"description"`
+	DatePublished  string   `json:"datePublished"`
+	DateModified   string   `json:"dateModified"`
+	Keywords       []string `json:"keywords"`
+	ArticleSection string   `json:"articleSection,omitempty"`
+	Image          []string `json:"image,omitempty"`
+	IsBasedOn      string   `json:"isBasedOn,omitempty"`
+}
+
+// GetMeta handles GET /v1/articles/{slug}/meta - returns Open Graph and JSON-LD metadata
+// for a published article, so the frontend can render social previews without recomputing them.
+// Image URLs are relative to the API origin, and path is the article's page on the frontend
+func (h *ArticleHandler) GetMeta(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
@@ -208,30 +798,102 @@ func (h *ArticleHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	article, err := h.articleRepo.GetBySlug(ctx, slug)
+	article, err := getArticleBySlug(ctx, h.articleRepo, slug)
 	if err != nil {
 		log.Error().
 			Err(err).
 			Str("request_id", requestID).
 			Str("slug", slug).
 			Msg("Failed to get article by slug")
-		response.NotFound(w, "Article not found")
+		response.NotFoundResource(w, "article", "Article not found")
 		return
 	}
 
-	// Increment view count asynchronously
-	go func() {
-		bgCtx := context.Background()
-		if err := h.articleRepo.IncrementViewCount(bgCtx, article.ID); err != nil {
-			log.Error().
-				Err(err).
-				Str("article_id", article.ID.String()).
-				Msg("Failed to increment view count")
+	if !article.IsPublished {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", metaCacheMaxAge))
+	response.Success(w, toArticleMetaResponse(article))
+}
+
+// toArticleMetaResponse builds the metadata for an article as readers see it
+func toArticleMetaResponse(article *domain.Article) ArticleMetaResponse {
+	title := article.DisplayTitle()
+	description := articleMetaDescription(article)
+	publishedAt := article.PublishedAt.Format(time.RFC3339)
+	modifiedAt := article.UpdatedAt.Format(time.RFC3339)
+	if article.EditorialUpdatedAt != nil && article.EditorialUpdatedAt.After(article.UpdatedAt) {
+		modifiedAt = article.EditorialUpdatedAt.Format(time.RFC3339)
+	}
+
+	// Keywords are the tags followed by the CVEs, without repeats
+	keywords := make([]string, 0, len(article.Tags)+len(article.CVEs))
+	seen := make(map[string]bool, cap(keywords))
+	for _, keyword := range append(append([]string{}, article.Tags...), article.CVEs...) {
+		key := strings.ToLower(keyword)
+		if keyword == "" || seen[key] {
+			continue
 		}
-	}()
+		seen[key] = true
+		keywords = append(keywords, keyword)
+	}
 
-	articleDetail := toArticleDetailResponse(article)
-	response.Success(w, articleDetail)
+	section := ""
+	if article.Category != nil {
+		section = article.Category.Name
+	}
+
+	meta := ArticleMetaResponse{
+		Title:       title,
+		Description: description,
+		Path:        "/threats/" + article.ID.String(),
+		OpenGraph: OpenGraphMeta{
+			Type:          "article",
+			Title:         title,
+			Description:   description,
+			SiteName:      metaSiteName,
+			PublishedTime: publishedAt,
+			ModifiedTime:  modifiedAt,
+			Section:       section,
+			Tags:          keywords,
+		},
+		JSONLD: NewsArticleJSONLD{
+			Context:        "https://schema.org",
+			Type:           "NewsArticle",
+			Headline:       sanitizer.TruncateText(title, metaHeadlineLength),
+			Description:    description,
+			DatePublished:  publishedAt,
+			DateModified:   modifiedAt,
+			Keywords:       keywords,
+			ArticleSection: section,
+			IsBasedOn:      article.SourceURL,
+		},
+	}
+
+	if article.ImageKey != nil {
+		meta.OpenGraph.Image = articleImageURL(article.ID, "large")
+		meta.JSONLD.Image = []string{
+			articleImageURL(article.ID, "large"),
+			articleImageURL(article.ID, "medium"),
+		}
+	}
+
+	return meta
+}
+
+// articleMetaDescription is the display summary, or the start of the content when there is none
+func articleMetaDescription(article *domain.Article) string {
+	text := ""
+	if summary := article.DisplaySummary(); summary != nil {
+		text = *summary
+	}
+	if strings.TrimSpace(text) == "" {
+		text = sanitizer.New().StripHTML(article.Content)
+	}
+
+	return sanitizer.TruncateText(strings.Join(strings.Fields(text), " "), metaDescriptionLength)
 }
 
 // Search handles GET /v1/articles/search - performs full-text search
@@ -239,6 +901,12 @@ func (h *ArticleHandler) Search(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := getRequestID(ctx)
 
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	query := r.URL.Query().Get("q")
 	if query == "" {
 		response.BadRequest(w, "Search query parameter 'q' is required")
@@ -255,8 +923,18 @@ func (h *ArticleHandler) Search(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	results, total, err := h.searchService.Search(ctx, query, filter)
+	if !h.applyStackFilter(w, r, filter) {
+		return
+	}
+
+	page, err := h.searchService.Search(ctx, query, filter)
 	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Invalid search query", validationErr.Message, requestID)
+			return
+		}
+
 		log.Error().
 			Err(err).
 			Str("request_id", requestID).
@@ -266,21 +944,33 @@ func (h *ArticleHandler) Search(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	searchResponses := make([]map[string]interface{}, len(results))
-	for i, result := range results {
+	results := make([]*domain.Article, len(page.Results))
+	for i, result := range page.Results {
+		results[i] = result.Article
+	}
+	h.annotateStack(r, results...)
+
+	searchResponses := make([]map[string]interface{}, len(page.Results))
+	for i, result := range page.Results {
+		article, err := fields.Apply(toArticleResponse(result.Article))
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Msg("Failed to apply field selection")
+			response.InternalError(w, "Failed to search articles", requestID)
+			return
+		}
+
 		searchResponses[i] = map[string]interface{}{
-			"article":   toArticleResponse(result.Article),
+			"article":   article,
 			"score":     result.Score,
 			"highlight": result.Highlight,
 		}
 	}
 
-	meta := &response.Meta{
-		Page:       filter.Page,
-		PageSize:   filter.PageSize,
-		TotalCount: total,
-		TotalPages: CalculateTotalPages(total, filter.PageSize),
-	}
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, page.Total)
+	meta.DidYouMean = page.DidYouMean
 
 	response.SuccessWithMeta(w, searchResponses, meta)
 }
@@ -288,6 +978,7 @@ func (h *ArticleHandler) Search(w http.ResponseWriter, r *http.Request) {
 // parseArticleFilter extracts and validates filter parameters from request
 func parseArticleFilter(r *http.Request) (*domain.ArticleFilter, error) {
 	filter := domain.NewArticleFilter()
+	filter.PublishedOnly = true
 
 	query := r.URL.Query()
 
@@ -308,6 +999,9 @@ func parseArticleFilter(r *http.Request) (*domain.ArticleFilter, error) {
 		filter.PageSize = pageSize
 	}
 
+	// Near-duplicates are hidden unless explicitly requested
+	filter.ExcludeDuplicates = query.Get("include_duplicates") != "true"
+
 	// Parse category_id
 	if categoryIDStr := query.Get("category_id"); categoryIDStr != "" {
 		categoryID, err := uuid.Parse(categoryIDStr)
@@ -371,6 +1065,36 @@ func parseArticleFilter(r *http.Request) (*domain.ArticleFilter, error) {
 		}
 	}
 
+	// Parse kev
+	switch query.Get("kev") {
+	case "true":
+		kev := true
+		filter.KEV = &kev
+	case "false":
+		kev := false
+		filter.KEV = &kev
+	}
+
+	// Parse exploit_available
+	switch query.Get("exploit_available") {
+	case "true":
+		exploitAvailable := true
+		filter.ExploitAvailable = &exploitAvailable
+	case "false":
+		exploitAvailable := false
+		filter.ExploitAvailable = &exploitAvailable
+	}
+
+	// Parse affects_stack; the handler scopes it to the reader's inventories
+	switch query.Get("affects_stack") {
+	case "true":
+		affectsStack := true
+		filter.AffectsStack = &affectsStack
+	case "false":
+		affectsStack := false
+		filter.AffectsStack = &affectsStack
+	}
+
 	// Parse date range
 	if dateFromStr := query.Get("date_from"); dateFromStr != "" {
 		dateFrom, err := time.Parse(time.RFC3339, dateFromStr)
@@ -391,6 +1115,327 @@ func parseArticleFilter(r *http.Request) (*domain.ArticleFilter, error) {
 	return filter, nil
 }
 
+// parseSummaryLength reads the optional summary length preset, writing a bad request on invalid values
+func parseSummaryLength(w http.ResponseWriter, r *http.Request) (domain.SummaryLength, bool) {
+	value := r.URL.Query().Get("summary")
+	if value == "" {
+		return "", true
+	}
+
+	length := domain.SummaryLength(strings.ToLower(value))
+	if !length.IsValid() {
+		response.BadRequest(w, "Invalid summary parameter (use short, medium, or executive)")
+		return "", false
+	}
+
+	return length, true
+}
+
+// getArticleBySlug loads an article by its current slug or, failing that, by a slug it used
+// before a rename; callers compare article.Slug with the requested slug to tell the two apart
+func getArticleBySlug(ctx context.Context, articleRepo repository.ArticleRepository, slug string) (*domain.Article, error) {
+	article, err := articleRepo.GetBySlug(ctx, slug)
+	if err == nil {
+		return article, nil
+	}
+
+	current, redirectErr := articleRepo.GetSlugRedirect(ctx, slug)
+	if redirectErr != nil {
+		return nil, err
+	}
+
+	return articleRepo.GetBySlug(ctx, current)
+}
+
+// redirectFormerSlug answers a request for a slug an article used before with a permanent
+// redirect to the same URL under its current slug, reporting whether it did
+func redirectFormerSlug(w http.ResponseWriter, r *http.Request, articleRepo repository.ArticleRepository, slug string) bool {
+	current, err := articleRepo.GetSlugRedirect(r.Context(), slug)
+	if err != nil {
+		return false
+	}
+
+	segments := strings.Split(r.URL.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] == slug {
+			segments[i] = current
+			break
+		}
+	}
+
+	target := url.URL{Path: strings.Join(segments, "/"), RawQuery: r.URL.RawQuery}
+	http.Redirect(w, r, target.String(), http.StatusMovedPermanently)
+	return true
+}
+
+// parseContentFormat reads the requested content format from ?content_format= or, without it,
+// an Accept header naming text/markdown, writing a bad request on invalid values
+// The response varies with Accept, so caches are told to key on it
+func parseContentFormat(w http.ResponseWriter, r *http.Request) (domain.ContentFormat, bool) {
+	w.Header().Add("Vary", "Accept")
+
+	if value := r.URL.Query().Get("content_format"); value != "" {
+		format := domain.ContentFormat(strings.ToLower(value))
+		if !format.IsValid() {
+			response.BadRequest(w, "Invalid content_format parameter (use html or markdown)")
+			return "", false
+		}
+		return format, true
+	}
+
+	for _, mediaRange := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(mediaRange, ";")
+		if strings.EqualFold(strings.TrimSpace(mediaType), "text/markdown") {
+			return domain.ContentFormatMarkdown, true
+		}
+	}
+
+	return domain.ContentFormatHTML, true
+}
+
+// applyContentFormat returns the markdown source in place of the rendered HTML when it was asked for
+// Articles written in HTML have no markdown source and are always returned as HTML
+func applyContentFormat(article *domain.Article, format domain.ContentFormat, detail *ArticleDetailResponse) {
+	if format != domain.ContentFormatMarkdown || article.ContentMarkdown == nil {
+		return
+	}
+
+	detail.Content = *article.ContentMarkdown
+	detail.ContentFormat = string(domain.ContentFormatMarkdown)
+}
+
+// applySummaryLength replaces the default summary with the requested length preset
+// Generation failures are logged and the default summary is kept; an editorial summary always wins
+func (h *ArticleHandler) applySummaryLength(ctx context.Context, requestID string, article *domain.Article, length domain.SummaryLength, detail *ArticleDetailResponse) {
+	if length == "" || h.summarizeService == nil || article.EditorialSummary != nil {
+		return
+	}
+
+	summary, err := h.summarizeService.GetSummary(ctx, article, length)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", article.ID.String()).
+			Str("summary_length", string(length)).
+			Msg("Failed to get article summary, using default")
+		return
+	}
+
+	detail.Summary = &summary.Content
+	detail.SummaryLength = string(summary.Length)
+}
+
+// applyCTAVariant replaces the Armor CTA with the variant assigned to the reader, if the CTA is under test
+func (h *ArticleHandler) applyCTAVariant(r *http.Request, detail *ArticleDetailResponse) {
+	if h.ctaService == nil || detail.ArmorCTA == nil {
+		return
+	}
+
+	readerID := GetClientIP(r)
+	if claims, ok := middleware.GetUserFromContext(r.Context()); ok {
+		readerID = claims.UserID.String()
+	}
+
+	detail.ArmorCTA = h.ctaService.Serve(r.Context(), detail.ArmorCTA, readerID)
+}
+
+// applyStackFilter scopes an affects_stack filter to the reader's own and their organization's
+// software inventories, reporting false after writing an error response
+func (h *ArticleHandler) applyStackFilter(w http.ResponseWriter, r *http.Request, filter *domain.ArticleFilter) bool {
+	if filter.AffectsStack == nil {
+		return true
+	}
+
+	if h.inventoryService == nil {
+		response.ServiceUnavailable(w, "Software inventory matching is not available")
+		return false
+	}
+
+	ctx := r.Context()
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return false
+	}
+
+	if err := h.inventoryService.ApplyTo(ctx, claims.UserID, filter); err != nil {
+		requestID := getRequestID(ctx)
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to scope the affects_stack filter")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return false
+	}
+
+	return true
+}
+
+// annotateStack sets the affects-your-stack badge of the articles for the reader
+// The badge is best effort: if it cannot be computed the articles are served without it
+func (h *ArticleHandler) annotateStack(r *http.Request, articles ...*domain.Article) {
+	if h.inventoryService == nil {
+		return
+	}
+
+	ctx := r.Context()
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.inventoryService.Annotate(ctx, claims.UserID, articles); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", getRequestID(ctx)).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to match articles against the software inventory")
+	}
+}
+
+// applyArchive links the archived source page, if there is one
+// Lookup failures are logged and the article is returned without the link
+func (h *ArticleHandler) applyArchive(ctx context.Context, requestID string, articleID uuid.UUID, detail *ArticleDetailResponse) {
+	if h.archiveService == nil {
+		return
+	}
+
+	archive, err := h.archiveService.GetArchive(ctx, articleID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get article archive")
+		return
+	}
+
+	if archive == nil || archive.ArchivedAt == nil {
+		return
+	}
+
+	archiveURL := fmt.Sprintf("/v1/articles/%s/archive", articleID)
+	detail.Archive = &ArticleArchiveResponse{
+		HTMLURL:    archiveURL,
+		TextURL:    archiveURL + "?format=text",
+		ArchivedAt: archive.ArchivedAt.Format(time.RFC3339),
+	}
+}
+
+// GetArchive handles GET /v1/articles/{id}/archive - returns the archived source page
+// ?format=text returns the plain text instead of the HTML
+func (h *ArticleHandler) GetArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.archiveService == nil {
+		response.ServiceUnavailable(w, "Source page archive is not available")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "html" && format != "text" {
+		response.BadRequest(w, "Invalid format parameter (use html or text)")
+		return
+	}
+
+	article, err := h.articleRepo.GetByID(ctx, articleID)
+	if err != nil || !article.IsPublished {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	body, contentType, err := h.archiveService.GetSnapshot(ctx, articleID, format == "text")
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "article archive", "Source page has not been archived")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get archived source page")
+		response.InternalError(w, "Failed to retrieve archived source page", requestID)
+		return
+	}
+
+	// The snapshot is third-party markup, so it may not run scripts or be framed
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; img-src *; style-src 'unsafe-inline'; sandbox")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to write archived source page")
+	}
+}
+
+// GetImage handles GET /v1/images/articles/{id}/{size}.jpg - returns a stored hero image
+// No authentication is required, so images load in <img> tags, and responses are cacheable
+// by browsers and CDNs
+func (h *ArticleHandler) GetImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.imageService == nil {
+		response.ServiceUnavailable(w, "Article images are not available")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	article, err := h.articleRepo.GetByID(ctx, articleID)
+	if err != nil || !article.IsPublished {
+		response.NotFoundResource(w, "article image", "Image not found")
+		return
+	}
+
+	body, contentType, err := h.imageService.GetImage(ctx, article, chi.URLParam(r, "size"))
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "article image", "Image not found")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get article image")
+		response.InternalError(w, "Failed to retrieve image", requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to write article image")
+	}
+}
+
 // toArticleResponse converts domain article to API response
 func toArticleResponse(article *domain.Article) ArticleResponse {
 	if article == nil {
@@ -399,17 +1444,25 @@ func toArticleResponse(article *domain.Article) ArticleResponse {
 
 	response := ArticleResponse{
 		ID:                 article.ID,
-		Title:              article.Title,
+		Title:              article.DisplayTitle(),
 		Slug:               article.Slug,
-		Summary:            article.Summary,
+		Summary:            article.DisplaySummary(),
 		SourceURL:          article.SourceURL,
 		Severity:           string(article.Severity),
+		CategoryIDs:        article.CategoryIDs,
 		Tags:               article.Tags,
 		CVEs:               article.CVEs,
 		Vendors:            article.Vendors,
 		Industries:         article.Industries,
 		HasDeepDive:        article.HasDeepDive,
+		KEV:                article.KEV,
+		ExploitAvailable:   article.ExploitAvailable,
+		ExploitSources:     article.ExploitSources,
+		AffectsStack:       article.AffectsStack,
+		StackProducts:      article.StackProducts,
 		ReadingTimeMinutes: article.ReadingTimeMinutes,
+		WordCount:          article.WordCount,
+		Language:           article.Language,
 		ViewCount:          article.ViewCount,
 		PublishedAt:        article.PublishedAt.Format(time.RFC3339),
 	}
@@ -432,9 +1485,27 @@ func toArticleResponse(article *domain.Article) ArticleResponse {
 		}
 	}
 
+	if article.KEVDueDate != nil {
+		dueDate := article.KEVDueDate.Format("2006-01-02")
+		response.KEVDueDate = &dueDate
+	}
+
+	if article.ImageKey != nil {
+		response.Image = &ArticleImageResponse{
+			ThumbnailURL: articleImageURL(article.ID, "thumbnail"),
+			MediumURL:    articleImageURL(article.ID, "medium"),
+			LargeURL:     articleImageURL(article.ID, "large"),
+		}
+	}
+
 	return response
 }
 
+// articleImageURL is where the article's hero image is served at the named size
+func articleImageURL(articleID uuid.UUID, size string) string {
+	return fmt.Sprintf("/v1/images/articles/%s/%s.jpg", articleID, size)
+}
+
 // toArticleDetailResponse converts domain article to detailed API response
 func toArticleDetailResponse(article *domain.Article) ArticleDetailResponse {
 	if article == nil {
@@ -444,6 +1515,8 @@ func toArticleDetailResponse(article *domain.Article) ArticleDetailResponse {
 	return ArticleDetailResponse{
 		ArticleResponse:    toArticleResponse(article),
 		Content:            article.Content,
+		ContentFormat:      string(domain.ContentFormatHTML),
+		ExternalLinks:      article.ExternalLinks,
 		ThreatType:         article.ThreatType,
 		AttackVector:       article.AttackVector,
 		ImpactAssessment:   article.ImpactAssessment,
@@ -460,10 +1533,7 @@ func toArticleDetailResponse(article *domain.Article) ArticleDetailResponse {
 func (h *ArticleHandler) AddBookmark(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	requestID := ""
-	if reqID, ok := ctx.Value("request_id").(string); ok {
-		requestID = reqID
-	}
+	requestID := getRequestID(ctx)
 
 	// Get user from context (set by auth middleware)
 	claims, ok := middleware.GetUserFromContext(ctx)
@@ -512,10 +1582,7 @@ func (h *ArticleHandler) AddBookmark(w http.ResponseWriter, r *http.Request) {
 func (h *ArticleHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	requestID := ""
-	if reqID, ok := ctx.Value("request_id").(string); ok {
-		requestID = reqID
-	}
+	requestID := getRequestID(ctx)
 
 	// Get user from context
 	claims, ok := middleware.GetUserFromContext(ctx)
@@ -569,10 +1636,7 @@ type MarkReadRequest struct {
 func (h *ArticleHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	requestID := ""
-	if reqID, ok := ctx.Value("request_id").(string); ok {
-		requestID = reqID
-	}
+	requestID := getRequestID(ctx)
 
 	// Get user from context
 	claims, ok := middleware.GetUserFromContext(ctx)
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+)
+
+const (
+	// articleImportMaxUploadBytes bounds an uploaded import file
+	articleImportMaxUploadBytes = 1 << 30
+
+	// articleImportUploadTimeout replaces the server read timeout while a file is uploaded
+	articleImportUploadTimeout = 30 * time.Minute
+)
+
+// ArticleImportHandler handles backfills of historical articles
+// Uploaded files are spooled to a temporary file and imported in the background, so the
+// request returns once the upload is complete; progress is read from the import
+type ArticleImportHandler struct {
+	importService ArticleImportService
+}
+
+// NewArticleImportHandler creates a new article import handler instance
+func NewArticleImportHandler(importService ArticleImportService) *ArticleImportHandler {
+	if importService == nil {
+		panic("importService cannot be nil")
+	}
+
+	return &ArticleImportHandler{
+		importService: importService,
+	}
+}
+
+// List handles GET /v1/admin/article-imports
+func (h *ArticleImportHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	imports, err := h.importService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list article imports")
+		return
+	}
+
+	response.Success(w, imports)
+}
+
+// Get handles GET /v1/admin/article-imports/{id}
+func (h *ArticleImportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	importID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid import ID format")
+		return
+	}
+
+	articleImport, err := h.importService.Get(ctx, importID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get article import")
+		return
+	}
+
+	response.Success(w, articleImport)
+}
+
+// Create handles POST /v1/admin/article-imports - the request body is the JSONL or CSV file
+func (h *ArticleImportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	format := importFormat(r)
+	if !domain.IsValidArticleImportFormat(format) {
+		response.BadRequest(w, "Invalid format: must be jsonl or csv")
+		return
+	}
+
+	file, err := h.spool(w, r)
+	if err != nil {
+		h.handleUploadError(w, err, requestID)
+		return
+	}
+
+	articleImport, err := h.importService.Start(ctx, format, r.URL.Query().Get("filename"), suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		removeSpool(file)
+		h.handleError(w, err, requestID, "Failed to start article import")
+		return
+	}
+
+	// Respond before processing starts, since processing updates the import
+	response.JSON(w, http.StatusAccepted, response.Response{Data: articleImport})
+	requestctx.Go(ctx, func(ctx context.Context) {
+		h.process(ctx, articleImport, file)
+	})
+}
+
+// Resume handles POST /v1/admin/article-imports/{id}/resume - the request body is the same file
+func (h *ArticleImportHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	importID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid import ID format")
+		return
+	}
+
+	file, err := h.spool(w, r)
+	if err != nil {
+		h.handleUploadError(w, err, requestID)
+		return
+	}
+
+	articleImport, err := h.importService.Resume(ctx, importID, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		removeSpool(file)
+		h.handleError(w, err, requestID, "Failed to resume article import")
+		return
+	}
+
+	// Respond before processing starts, since processing updates the import
+	response.JSON(w, http.StatusAccepted, response.Response{Data: articleImport})
+	requestctx.Go(ctx, func(ctx context.Context) {
+		h.process(ctx, articleImport, file)
+	})
+}
+
+// spool copies the request body to a temporary file, which the caller must remove
+func (h *ArticleImportHandler) spool(w http.ResponseWriter, r *http.Request) (*os.File, error) {
+	// Large dumps outlast the server read timeout
+	if err := http.NewResponseController(w).SetReadDeadline(time.Now().Add(articleImportUploadTimeout)); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", getRequestID(r.Context())).
+			Msg("Failed to extend read deadline for article import upload")
+	}
+
+	file, err := os.CreateTemp("", "aci-article-import-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spool file: %w", err)
+	}
+
+	body := http.MaxBytesReader(w, r.Body, articleImportMaxUploadBytes)
+	if _, err := io.Copy(file, body); err != nil {
+		removeSpool(file)
+		return nil, err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		removeSpool(file)
+		return nil, fmt.Errorf("failed to rewind spool file: %w", err)
+	}
+
+	return file, nil
+}
+
+// process runs an import from its spooled file and removes the file
+// ctx is detached from the request, which has already been answered, but keeps its workspace
+func (h *ArticleImportHandler) process(ctx context.Context, articleImport *domain.ArticleImport, file *os.File) {
+	defer removeSpool(file)
+
+	// Failures are recorded on the import and logged by the service
+	_ = h.importService.Process(ctx, articleImport, file, nil)
+}
+
+// handleUploadError maps a failed upload to an HTTP response
+func (h *ArticleImportHandler) handleUploadError(w http.ResponseWriter, err error, requestID string) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		response.BadRequest(w, fmt.Sprintf("Import file exceeds %d bytes", maxBytesErr.Limit))
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg("Failed to receive article import file")
+	response.InternalError(w, "Failed to receive import file", requestID)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ArticleImportHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "article import", "Article import not found")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// importFormat reads the import format from ?format=, or else from the Content-Type
+func importFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return domain.ArticleImportFormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return domain.ArticleImportFormatJSONL
+	default:
+		return ""
+	}
+}
+
+// removeSpool closes and deletes a spooled upload
+func removeSpool(file *os.File) {
+	file.Close()
+	if err := os.Remove(file.Name()); err != nil {
+		log.Warn().Err(err).Str("path", file.Name()).Msg("Failed to remove spooled import file")
+	}
+}
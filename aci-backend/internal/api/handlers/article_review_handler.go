@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleReviewHandler handles the admin review queue for articles held before publication
+type ArticleReviewHandler struct {
+	reviewService ArticleReviewService
+}
+
+// NewArticleReviewHandler creates a new article review handler instance
+func NewArticleReviewHandler(reviewService ArticleReviewService) *ArticleReviewHandler {
+	if reviewService == nil {
+		panic("reviewService cannot be nil")
+	}
+
+	return &ArticleReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+// RejectArticleRequest represents a request to reject an article
+type RejectArticleRequest struct {
+	Reason string `json:"reason"`
+}
+
+// BulkApproveRequest represents a request to approve several articles
+type BulkApproveRequest struct {
+	ArticleIDs []uuid.UUID `json:"article_ids"`
+}
+
+// List handles GET /v1/admin/reviews
+// Query params: status (pending_review, approved, rejected; default pending_review),
+// category_id, source_id, severity, page, page_size
+func (h *ArticleReviewHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	query := r.URL.Query()
+	status := domain.ReviewStatusPending
+	filter := &domain.ArticleReviewFilter{Status: &status, Page: 1, PageSize: 20}
+
+	if statusStr := query.Get("status"); statusStr != "" {
+		status = domain.ReviewStatus(statusStr)
+		if !status.IsValid() {
+			response.BadRequest(w, "Invalid status: must be pending_review, approved, or rejected")
+			return
+		}
+	}
+
+	if categoryStr := query.Get("category_id"); categoryStr != "" {
+		categoryID, err := uuid.Parse(categoryStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid category_id parameter")
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	if sourceStr := query.Get("source_id"); sourceStr != "" {
+		sourceID, err := uuid.Parse(sourceStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid source_id parameter")
+			return
+		}
+		filter.SourceID = &sourceID
+	}
+
+	if severityStr := query.Get("severity"); severityStr != "" {
+		severity := domain.Severity(severityStr)
+		filter.Severity = &severity
+	}
+
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid page parameter")
+			return
+		}
+		filter.Page = page
+	}
+
+	if pageSizeStr := query.Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.Atoi(pageSizeStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid page_size parameter")
+			return
+		}
+		filter.PageSize = pageSize
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	reviews, total, err := h.reviewService.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list article reviews")
+		response.InternalError(w, "Failed to retrieve review queue", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, reviews, meta)
+}
+
+// Approve handles POST /v1/admin/reviews/{articleID}/approve - publishes the article
+func (h *ArticleReviewHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, ok := parseReviewArticleID(w, r)
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewService.Approve(ctx, articleID, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to approve article")
+		return
+	}
+
+	response.Success(w, review)
+}
+
+// Reject handles POST /v1/admin/reviews/{articleID}/reject - keeps the article unpublished
+func (h *ArticleReviewHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, ok := parseReviewArticleID(w, r)
+	if !ok {
+		return
+	}
+
+	var req RejectArticleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	review, err := h.reviewService.Reject(ctx, articleID, suggestionReviewer(r), req.Reason, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to reject article")
+		return
+	}
+
+	response.Success(w, review)
+}
+
+// BulkApprove handles POST /v1/admin/reviews/approve - publishes up to 100 articles
+// Articles that cannot be approved are listed under failed; the rest are still approved
+func (h *ArticleReviewHandler) BulkApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req BulkApproveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	result, err := h.reviewService.BulkApprove(ctx, req.ArticleIDs, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to approve articles")
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ArticleReviewHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "article review", "Article is not in the review queue")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseReviewArticleID extracts the article ID URL parameter, writing a 400 on failure
+func parseReviewArticleID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
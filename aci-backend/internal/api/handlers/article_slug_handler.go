@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleSlugHandler handles article slug changes for administrators
+type ArticleSlugHandler struct {
+	slugService ArticleSlugService
+}
+
+// NewArticleSlugHandler creates a new article slug handler instance
+func NewArticleSlugHandler(slugService ArticleSlugService) *ArticleSlugHandler {
+	if slugService == nil {
+		panic("slugService cannot be nil")
+	}
+
+	return &ArticleSlugHandler{
+		slugService: slugService,
+	}
+}
+
+// UpdateSlugRequest represents a request to change an article's slug
+type UpdateSlugRequest struct {
+	Slug string `json:"slug"`
+}
+
+// Update handles PUT /v1/admin/articles/{id}/slug
+func (h *ArticleSlugHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req UpdateSlugRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	change, err := h.slugService.Update(
+		ctx,
+		articleID,
+		req.Slug,
+		suggestionReviewer(r),
+		GetClientIP(r),
+		r.UserAgent(),
+	)
+	if err != nil {
+		h.handleError(w, err, requestID)
+		return
+	}
+
+	response.Success(w, change)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ArticleSlugHandler) handleError(w http.ResponseWriter, err error, requestID string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, "article slug", "Slug is used by another article, now or before")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg("Failed to update article slug")
+	response.InternalError(w, "Failed to update article slug", requestID)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// AuditLogHandler exports audit logs for compliance reviews
+type AuditLogHandler struct {
+	retentionService AuditLogRetentionService
+}
+
+// NewAuditLogHandler creates a new audit log handler instance
+func NewAuditLogHandler(retentionService AuditLogRetentionService) *AuditLogHandler {
+	if retentionService == nil {
+		panic("retentionService cannot be nil")
+	}
+
+	return &AuditLogHandler{
+		retentionService: retentionService,
+	}
+}
+
+// Export handles GET /v1/admin/audit-logs/export
+// Query params: from, to (RFC3339 timestamps or YYYY-MM-DD dates; a date for to includes that whole day)
+// Only entries still in the database are exported; older ones are in the object storage archive
+func (h *AuditLogHandler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	from, err := parseExportTime(r.URL.Query().Get("from"), false)
+	if err != nil {
+		response.BadRequest(w, "Invalid from: must be an RFC3339 timestamp or YYYY-MM-DD date")
+		return
+	}
+
+	to, err := parseExportTime(r.URL.Query().Get("to"), true)
+	if err != nil {
+		response.BadRequest(w, "Invalid to: must be an RFC3339 timestamp or YYYY-MM-DD date")
+		return
+	}
+
+	filename := fmt.Sprintf("audit-logs-%s-%s.csv", from.UTC().Format("20060102"), to.UTC().Format("20060102"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+
+	out := &trackingWriter{ResponseWriter: w}
+	if err := h.retentionService.ExportCSV(ctx, from, to, out); err != nil {
+		if out.written {
+			// The CSV is already partly sent, so the client sees a truncated file
+			log.Error().Err(err).Str("request_id", requestID).Msg("Failed to write audit log export")
+			return
+		}
+
+		w.Header().Del("Content-Disposition")
+
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to export audit logs")
+		response.InternalError(w, "Failed to export audit logs", requestID)
+	}
+}
+
+// parseExportTime accepts an RFC3339 timestamp or a YYYY-MM-DD date
+// A date used as the end of a range moves to the start of the next day so the day is included
+func parseExportTime(value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("value is required")
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if end {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+
+	return parsed, nil
+}
+
+// trackingWriter records whether any of the response body has been sent
+type trackingWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (t *trackingWriter) Write(p []byte) (int, error) {
+	t.written = true
+	return t.ResponseWriter.Write(p)
+}
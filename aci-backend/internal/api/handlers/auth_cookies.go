@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+)
+
+const (
+	// RefreshCookieName holds the refresh token in cookie auth mode
+	RefreshCookieName = "aci_refresh"
+
+	// refreshCookiePath limits the refresh cookie to the endpoints that read it
+	refreshCookiePath = "/v1/auth"
+)
+
+// AuthCookies issues the httpOnly refresh cookie and CSRF cookie used in cookie auth mode
+// Access tokens are still returned in the body and sent as bearer tokens; only the refresh
+// token moves into a cookie, so browser apps never keep it in script-readable storage
+type AuthCookies struct {
+	domain     string
+	secure     bool
+	sameSite   http.SameSite
+	refreshTTL time.Duration
+}
+
+// NewAuthCookies creates the cookie settings for cookie auth mode
+// sameSite is lax or strict; None is not offered, since other sites could then send the cookies,
+// so the web app and the API must be served from the same site (e.g. app. and api.example.com)
+func NewAuthCookies(domain string, secure bool, sameSite string, refreshTTL time.Duration) (*AuthCookies, error) {
+	var mode http.SameSite
+	switch sameSite {
+	case "lax":
+		mode = http.SameSiteLaxMode
+	case "strict":
+		mode = http.SameSiteStrictMode
+	default:
+		return nil, fmt.Errorf("unsupported SameSite mode %q: must be lax or strict", sameSite)
+	}
+
+	return &AuthCookies{
+		domain:     domain,
+		secure:     secure,
+		sameSite:   mode,
+		refreshTTL: refreshTTL,
+	}, nil
+}
+
+// RefreshToken returns the refresh token sent in the cookie, if any
+func (c *AuthCookies) RefreshToken(r *http.Request) string {
+	cookie, err := r.Cookie(RefreshCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// SetRefreshToken stores the refresh token in the httpOnly cookie
+func (c *AuthCookies) SetRefreshToken(w http.ResponseWriter, token string) {
+	http.SetCookie(w, c.cookie(RefreshCookieName, token, refreshCookiePath, true, int(c.refreshTTL.Seconds())))
+}
+
+// ClearRefreshToken removes the refresh cookie
+func (c *AuthCookies) ClearRefreshToken(w http.ResponseWriter) {
+	http.SetCookie(w, c.cookie(RefreshCookieName, "", refreshCookiePath, true, -1))
+}
+
+// IssueCSRFToken sets a new CSRF cookie and returns its value, which the client sends back
+// in X-CSRF-Token; the cookie is readable by script on the same site but not by other sites
+func (c *AuthCookies) IssueCSRFToken(w http.ResponseWriter) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate CSRF token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	http.SetCookie(w, c.cookie(middleware.CSRFCookieName, token, "/", false, int(c.refreshTTL.Seconds())))
+	return token, nil
+}
+
+// cookie builds a cookie with the configured domain, Secure and SameSite attributes
+func (c *AuthCookies) cookie(name, value, path string, httpOnly bool, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   c.domain,
+		MaxAge:   maxAge,
+		Secure:   c.secure,
+		HttpOnly: httpOnly,
+		SameSite: c.sameSite,
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/rs/zerolog/log"
@@ -16,11 +17,13 @@ import (
 
 // AuthHandler handles authentication HTTP requests
 type AuthHandler struct {
-	authService *service.AuthService
+	authService AuthService
+	captcha     CaptchaService
+	cookies     *AuthCookies
 }
 
 // NewAuthHandler creates a new authentication handler
-func NewAuthHandler(authService *service.AuthService) *AuthHandler {
+func NewAuthHandler(authService AuthService) *AuthHandler {
 	if authService == nil {
 		panic("authService cannot be nil")
 	}
@@ -29,17 +32,30 @@ func NewAuthHandler(authService *service.AuthService) *AuthHandler {
 	}
 }
 
+// SetCaptchaService requires CAPTCHA challenges on registration and after repeated failed logins
+func (h *AuthHandler) SetCaptchaService(captcha CaptchaService) {
+	h.captcha = captcha
+}
+
+// SetAuthCookies switches to cookie auth mode: refresh tokens are set in an httpOnly cookie
+// instead of the response body, and refresh and logout read them from it
+func (h *AuthHandler) SetAuthCookies(cookies *AuthCookies) {
+	h.cookies = cookies
+}
+
 // RegisterRequest represents the registration request payload
 type RegisterRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Name     string `json:"name"`
+	Email        string `json:"email" validate:"required,email,max=255"`
+	Password     string `json:"password" validate:"required,password,max=128"`
+	Name         string `json:"name" validate:"required,min=2,max=255"`
+	CaptchaToken string `json:"captcha_token,omitempty"`
 }
 
 // LoginRequest represents the login request payload
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email        string `json:"email" validate:"required"`
+	Password     string `json:"password" validate:"required"`
+	CaptchaToken string `json:"captcha_token,omitempty"` // required after repeated failed logins
 }
 
 // RefreshRequest represents the refresh token request payload
@@ -53,11 +69,17 @@ type LogoutRequest struct {
 	AllDevices   bool   `json:"all_devices"`
 }
 
+// ChangePasswordRequest represents the change password request payload
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,password,max=128"`
+}
+
 // AuthResponse represents the authentication response
 type AuthResponse struct {
 	User         UserDTO  `json:"user"`
 	AccessToken  string   `json:"access_token"`
-	RefreshToken string   `json:"refresh_token"`
+	RefreshToken string   `json:"refresh_token,omitempty"` // omitted in cookie auth mode
 	ExpiresAt    string   `json:"expires_at"`
 }
 
@@ -74,7 +96,7 @@ type UserDTO struct {
 // TokenResponse represents token refresh response
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	RefreshToken string `json:"refresh_token,omitempty"` // omitted in cookie auth mode
 	ExpiresAt    string `json:"expires_at"`
 }
 
@@ -82,13 +104,17 @@ type TokenResponse struct {
 // POST /v1/auth/register
 func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		requestID := middleware.GetRequestID(r.Context())
-		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
+	if h.captcha != nil {
+		if err := h.captcha.CheckRegistration(r.Context(), req.CaptchaToken, GetClientIP(r)); err != nil {
+			h.handleCaptchaError(w, r, err)
+			return
+		}
+	}
+
 	user, tokens, err := h.authService.Register(r.Context(), req.Email, req.Password, req.Name)
 	if err != nil {
 		h.handleAuthError(w, r, err)
@@ -96,12 +122,17 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	authResp := AuthResponse{
-		User:         h.userToDTO(user),
+		User:         userToDTO(user),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresAt:    tokens.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	if h.cookies != nil {
+		h.cookies.SetRefreshToken(w, tokens.RefreshToken)
+		authResp.RefreshToken = ""
+	}
+
 	response.Created(w, authResp)
 }
 
@@ -109,46 +140,80 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 // POST /v1/auth/login
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		requestID := middleware.GetRequestID(r.Context())
-		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
-	user, tokens, err := h.authService.Login(r.Context(), req.Email, req.Password)
+	clientIP := GetClientIP(r)
+
+	if h.captcha != nil {
+		if err := h.captcha.CheckLogin(r.Context(), req.Email, req.CaptchaToken, clientIP); err != nil {
+			h.handleCaptchaError(w, r, err)
+			return
+		}
+	}
+
+	user, tokens, err := h.authService.Login(r.Context(), req.Email, req.Password, clientIP, r.UserAgent())
 	if err != nil {
+		if h.captcha != nil && errors.Is(err, domainerrors.ErrUnauthorized) {
+			h.captcha.LoginFailed(req.Email, clientIP)
+		}
 		h.handleAuthError(w, r, err)
 		return
 	}
 
+	if h.captcha != nil {
+		h.captcha.LoginSucceeded(req.Email)
+	}
+
 	authResp := AuthResponse{
-		User:         h.userToDTO(user),
+		User:         userToDTO(user),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresAt:    tokens.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	if h.cookies != nil {
+		h.cookies.SetRefreshToken(w, tokens.RefreshToken)
+		authResp.RefreshToken = ""
+	}
+
 	response.Success(w, authResp)
 }
 
+// Captcha returns the CAPTCHA provider and site key clients render challenges with
+// GET /v1/auth/captcha
+func (h *AuthHandler) Captcha(w http.ResponseWriter, r *http.Request) {
+	if h.captcha == nil {
+		response.Success(w, service.CaptchaSettings{})
+		return
+	}
+
+	response.Success(w, h.captcha.Settings())
+}
+
 // Refresh handles token refresh
 // POST /v1/auth/refresh
 func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 	var req RefreshRequest
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	// Cookie clients may send no body at all
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !(h.cookies != nil && errors.Is(err, io.EOF)) {
 		requestID := middleware.GetRequestID(r.Context())
 		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
 		return
 	}
 
+	if req.RefreshToken == "" && h.cookies != nil {
+		req.RefreshToken = h.cookies.RefreshToken(r)
+	}
+
 	if req.RefreshToken == "" {
 		response.BadRequest(w, "refresh_token is required")
 		return
 	}
 
-	tokens, err := h.authService.Refresh(r.Context(), req.RefreshToken)
+	tokens, err := h.authService.Refresh(r.Context(), req.RefreshToken, GetClientIP(r), r.UserAgent())
 	if err != nil {
 		h.handleAuthError(w, r, err)
 		return
@@ -160,6 +225,11 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		ExpiresAt:    tokens.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	if h.cookies != nil {
+		h.cookies.SetRefreshToken(w, tokens.RefreshToken)
+		tokenResp.RefreshToken = ""
+	}
+
 	response.Success(w, tokenResp)
 }
 
@@ -168,12 +238,17 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	var req LogoutRequest
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	// Cookie clients may send no body at all
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !(h.cookies != nil && errors.Is(err, io.EOF)) {
 		requestID := middleware.GetRequestID(r.Context())
 		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
 		return
 	}
 
+	if req.RefreshToken == "" && h.cookies != nil {
+		req.RefreshToken = h.cookies.RefreshToken(r)
+	}
+
 	// If logging out all devices, get user ID from JWT context
 	if req.AllDevices {
 		claims, ok := middleware.GetUserFromContext(r.Context())
@@ -199,25 +274,91 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if h.cookies != nil {
+		h.cookies.ClearRefreshToken(w)
+	}
+
 	response.SuccessWithMessage(w, nil, "Logged out successfully")
 }
 
+// CSRFToken issues the CSRF token cookie clients repeat in X-CSRF-Token when refreshing or
+// logging out with the refresh cookie
+// GET /v1/auth/csrf
+func (h *AuthHandler) CSRFToken(w http.ResponseWriter, r *http.Request) {
+	if h.cookies == nil {
+		response.NotFoundResource(w, "cookie authentication", "Cookie authentication is not enabled")
+		return
+	}
+
+	token, err := h.cookies.IssueCSRFToken(w)
+	if err != nil {
+		requestID := middleware.GetRequestID(r.Context())
+		log.Error().Err(err).Str("request_id", requestID).Msg("Failed to issue CSRF token")
+		response.InternalError(w, "Failed to issue CSRF token", requestID)
+		return
+	}
+
+	response.Success(w, map[string]string{"csrf_token": token})
+}
+
+// ChangePassword handles password changes for the current user
+// POST /v1/users/me/password
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req ChangePasswordRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	err := h.authService.ChangePassword(r.Context(), claims.UserID, req.CurrentPassword, req.NewPassword, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		// A wrong current password is not a failed authentication of the session
+		if errors.Is(err, domainerrors.ErrUnauthorized) {
+			response.Error(w, http.StatusBadRequest, "INVALID_PASSWORD", "Current password is incorrect")
+			return
+		}
+		h.handleAuthError(w, r, err)
+		return
+	}
+
+	response.Success(w, map[string]string{"message": "Password updated successfully"})
+}
 
 // handleAuthError handles authentication-specific errors
 func (h *AuthHandler) handleAuthError(w http.ResponseWriter, r *http.Request, err error) {
 	requestID := middleware.GetRequestID(r.Context())
 
 	// Handle validation errors
-	var validationErr *domainerrors.ValidationError
-	if errors.As(err, &validationErr) {
-		response.BadRequestWithDetails(w, validationErr.Error(), nil, requestID)
+	if writeValidationError(w, err, requestID) {
 		return
 	}
 
 	// Handle conflict errors (email already exists)
 	var conflictErr *domainerrors.ConflictError
 	if errors.As(err, &conflictErr) {
-		response.Conflict(w, conflictErr.Error())
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+		return
+	}
+
+	// Handle suspended and banned accounts
+	var blockedErr *domainerrors.AccountBlockedError
+	if errors.As(err, &blockedErr) {
+		response.AccountBlocked(w, blockedErr.Status, blockedErr.Reason, blockedErr.Until)
+		return
+	}
+
+	// Handle registration closed by policy
+	if errors.Is(err, service.ErrRegistrationDisabled) {
+		response.Error(w, http.StatusForbidden, response.ErrCodeRegistrationDisabled, "Registration is closed; ask an administrator for an invitation")
+		return
+	}
+	if errors.Is(err, service.ErrRegistrationDomainNotAllowed) {
+		response.Error(w, http.StatusForbidden, response.ErrCodeEmailDomainNotAllowed, "Registration is limited to approved email domains")
 		return
 	}
 
@@ -230,7 +371,7 @@ func (h *AuthHandler) handleAuthError(w http.ResponseWriter, r *http.Request, er
 	// Handle not found errors
 	var notFoundErr *domainerrors.NotFoundError
 	if errors.As(err, &notFoundErr) {
-		response.NotFound(w, notFoundErr.Error())
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
 		return
 	}
 
@@ -241,8 +382,33 @@ func (h *AuthHandler) handleAuthError(w http.ResponseWriter, r *http.Request, er
 		Msg("Unhandled error in auth handler")
 	response.InternalError(w, "An unexpected error occurred", requestID)
 }
+
+// handleCaptchaError maps CAPTCHA check failures to HTTP responses
+// A provider that cannot be reached fails closed, since the challenge exists to stop automated signups
+func (h *AuthHandler) handleCaptchaError(w http.ResponseWriter, r *http.Request, err error) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	if errors.Is(err, service.ErrCaptchaRequired) {
+		response.ErrorWithDetails(w, http.StatusBadRequest, response.ErrCodeCaptchaRequired,
+			"Complete the CAPTCHA challenge and try again", h.captcha.Settings(), requestID)
+		return
+	}
+
+	if errors.Is(err, service.ErrCaptchaFailed) {
+		response.ErrorWithDetails(w, http.StatusBadRequest, response.ErrCodeCaptchaFailed,
+			"CAPTCHA verification failed; complete a new challenge", nil, requestID)
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg("CAPTCHA verification unavailable")
+	response.ServiceUnavailable(w, "CAPTCHA verification is unavailable; try again shortly")
+}
+
 // userToDTO converts entities.User to DTO
-func (h *AuthHandler) userToDTO(u *entities.User) UserDTO {
+func userToDTO(u *entities.User) UserDTO {
 	dto := UserDTO{
 		ID:            u.ID.String(),
 		Email:         u.Email,
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// CategoryAdminHandler handles the category hierarchy and article categories for administrators
+type CategoryAdminHandler struct {
+	categoryService CategoryService
+}
+
+// NewCategoryAdminHandler creates a new category admin handler instance
+func NewCategoryAdminHandler(categoryService CategoryService) *CategoryAdminHandler {
+	if categoryService == nil {
+		panic("categoryService cannot be nil")
+	}
+
+	return &CategoryAdminHandler{
+		categoryService: categoryService,
+	}
+}
+
+// SetCategoryParentRequest represents a request to move a category; a null parent_id makes it top-level
+type SetCategoryParentRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+// SetArticleCategoriesRequest represents an article's full category list, primary first
+type SetArticleCategoriesRequest struct {
+	CategoryIDs []uuid.UUID `json:"category_ids"`
+}
+
+// ArticleCategoriesResponse represents an article's categories after an update
+type ArticleCategoriesResponse struct {
+	ArticleID   uuid.UUID   `json:"article_id"`
+	CategoryIDs []uuid.UUID `json:"category_ids"`
+}
+
+// SetParent handles PUT /v1/admin/categories/{id}/parent
+func (h *CategoryAdminHandler) SetParent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	categoryID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid category ID format")
+		return
+	}
+
+	var req SetCategoryParentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	category, err := h.categoryService.SetParent(ctx, categoryID, req.ParentID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to set category parent")
+		return
+	}
+
+	response.Success(w, toCategoryResponse(category))
+}
+
+// SetArticleCategories handles PUT /v1/admin/articles/{id}/categories
+func (h *CategoryAdminHandler) SetArticleCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req SetArticleCategoriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	categoryIDs, err := h.categoryService.SetArticleCategories(
+		ctx,
+		articleID,
+		req.CategoryIDs,
+		suggestionReviewer(r),
+		GetClientIP(r),
+		r.UserAgent(),
+	)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to set article categories")
+		return
+	}
+
+	response.Success(w, ArticleCategoriesResponse{
+		ArticleID:   articleID,
+		CategoryIDs: categoryIDs,
+	})
+}
+
+// handleError maps service errors to HTTP responses
+func (h *CategoryAdminHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
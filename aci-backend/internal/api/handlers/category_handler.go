@@ -36,22 +36,26 @@ func NewCategoryHandler(categoryRepo repository.CategoryRepository, articleRepo
 
 // CategoryResponse represents a category in API responses
 type CategoryResponse struct {
-	ID           uuid.UUID `json:"id"`
-	Name         string    `json:"name"`
-	Slug         string    `json:"slug"`
-	Description  *string   `json:"description,omitempty"`
-	Color        string    `json:"color"`
-	Icon         *string   `json:"icon,omitempty"`
-	ArticleCount *int      `json:"article_count,omitempty"`
+	ID           uuid.UUID          `json:"id"`
+	ParentID     *uuid.UUID         `json:"parent_id,omitempty"`
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	Description  *string            `json:"description,omitempty"`
+	Color        string             `json:"color"`
+	Icon         *string            `json:"icon,omitempty"`
+	ArticleCount *int               `json:"article_count,omitempty"` // includes subcategories
+	Children     []CategoryResponse `json:"children,omitempty"`
 }
 
 // List handles GET /v1/categories - returns all categories
+// With ?tree=true only top-level categories are returned, with subcategories nested as children
 func (h *CategoryHandler) List(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	requestID := getCategoryRequestID(ctx)
+	requestID := getRequestID(ctx)
 
 	// Check if article counts should be included
 	includeCounts := r.URL.Query().Get("include_counts") == "true"
+	asTree := r.URL.Query().Get("tree") == "true"
 
 	categories, err := h.categoryRepo.List(ctx)
 	if err != nil {
@@ -63,6 +67,15 @@ func (h *CategoryHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if asTree {
+		categories = domain.BuildCategoryTree(categories)
+	}
+
+	response.Success(w, h.toCategoryResponses(ctx, categories, includeCounts))
+}
+
+// toCategoryResponses converts categories and any nested children, optionally with article counts
+func (h *CategoryHandler) toCategoryResponses(ctx context.Context, categories []*domain.Category, includeCounts bool) []CategoryResponse {
 	categoryResponses := make([]CategoryResponse, len(categories))
 	for i, category := range categories {
 		categoryResp := toCategoryResponse(category)
@@ -80,16 +93,20 @@ func (h *CategoryHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
+		if len(category.Children) > 0 {
+			categoryResp.Children = h.toCategoryResponses(ctx, category.Children, includeCounts)
+		}
+
 		categoryResponses[i] = categoryResp
 	}
 
-	response.Success(w, categoryResponses)
+	return categoryResponses
 }
 
 // GetBySlug handles GET /v1/categories/{slug} - returns a single category by slug
 func (h *CategoryHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	requestID := getCategoryRequestID(ctx)
+	requestID := getRequestID(ctx)
 
 	slug := chi.URLParam(r, "slug")
 	if slug == "" {
@@ -104,7 +121,7 @@ func (h *CategoryHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
 			Str("request_id", requestID).
 			Str("slug", slug).
 			Msg("Failed to get category by slug")
-		response.NotFound(w, "Category not found")
+		response.NotFoundResource(w, "category", "Category not found")
 		return
 	}
 
@@ -127,9 +144,10 @@ func (h *CategoryHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
 // getCategoryArticleCount retrieves the count of articles for a category
 func (h *CategoryHandler) getCategoryArticleCount(ctx context.Context, categoryID uuid.UUID) (int, error) {
 	filter := &domain.ArticleFilter{
-		CategoryID: &categoryID,
-		Page:       1,
-		PageSize:   1,
+		CategoryID:    &categoryID,
+		PublishedOnly: true,
+		Page:          1,
+		PageSize:      1,
 	}
 
 	_, total, err := h.articleRepo.List(ctx, filter)
@@ -148,6 +166,7 @@ func toCategoryResponse(category *domain.Category) CategoryResponse {
 
 	return CategoryResponse{
 		ID:          category.ID,
+		ParentID:    category.ParentID,
 		Name:        category.Name,
 		Slug:        category.Slug,
 		Description: category.Description,
@@ -155,11 +174,3 @@ func toCategoryResponse(category *domain.Category) CategoryResponse {
 		Icon:        category.Icon,
 	}
 }
-
-// getCategoryRequestID extracts request ID from context
-func getCategoryRequestID(ctx context.Context) string {
-	if reqID, ok := ctx.Value("request_id").(string); ok {
-		return reqID
-	}
-	return ""
-}
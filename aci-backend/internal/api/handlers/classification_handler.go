@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ClassificationHandler handles the admin review queue for AI classification suggestions
+type ClassificationHandler struct {
+	classificationService ClassificationService
+}
+
+// NewClassificationHandler creates a new classification handler instance
+func NewClassificationHandler(classificationService ClassificationService) *ClassificationHandler {
+	if classificationService == nil {
+		panic("classificationService cannot be nil")
+	}
+
+	return &ClassificationHandler{
+		classificationService: classificationService,
+	}
+}
+
+// List handles GET /v1/admin/classifications
+// Query params: status (applied, pending, approved, rejected), page, page_size
+func (h *ClassificationHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	query := r.URL.Query()
+	filter := &domain.ClassificationFilter{Page: 1, PageSize: 20}
+
+	if statusStr := query.Get("status"); statusStr != "" {
+		status := domain.ClassificationStatus(statusStr)
+		if !status.IsValid() {
+			response.BadRequest(w, "Invalid status: must be applied, pending, approved, or rejected")
+			return
+		}
+		filter.Status = &status
+	}
+
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid page parameter")
+			return
+		}
+		filter.Page = page
+	}
+
+	if pageSizeStr := query.Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.Atoi(pageSizeStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid page_size parameter")
+			return
+		}
+		filter.PageSize = pageSize
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	suggestions, total, err := h.classificationService.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list classification suggestions")
+		response.InternalError(w, "Failed to retrieve classification suggestions", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, suggestions, meta)
+}
+
+// GetByID handles GET /v1/admin/classifications/{id}
+func (h *ClassificationHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseSuggestionID(w, r)
+	if !ok {
+		return
+	}
+
+	suggestion, err := h.classificationService.GetByID(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get classification suggestion")
+		return
+	}
+
+	response.Success(w, suggestion)
+}
+
+// Approve handles POST /v1/admin/classifications/{id}/approve - applies pending fields to the article
+func (h *ClassificationHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseSuggestionID(w, r)
+	if !ok {
+		return
+	}
+
+	suggestion, err := h.classificationService.Approve(ctx, id, suggestionReviewer(r))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to approve classification suggestion")
+		return
+	}
+
+	response.Success(w, suggestion)
+}
+
+// Reject handles POST /v1/admin/classifications/{id}/reject - discards pending fields
+func (h *ClassificationHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseSuggestionID(w, r)
+	if !ok {
+		return
+	}
+
+	suggestion, err := h.classificationService.Reject(ctx, id, suggestionReviewer(r))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to reject classification suggestion")
+		return
+	}
+
+	response.Success(w, suggestion)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ClassificationHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "classification suggestion", "Classification suggestion not found")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseSuggestionID extracts the suggestion ID URL parameter, writing a 400 on failure
+func parseSuggestionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid suggestion ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// suggestionReviewer returns the authenticated admin's ID, if any
+func suggestionReviewer(r *http.Request) *uuid.UUID {
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return &claims.UserID
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// ClientEventHandler ingests engagement events reported by clients
+type ClientEventHandler struct {
+	clientEventService ClientEventService
+}
+
+// NewClientEventHandler creates a new client event handler instance
+func NewClientEventHandler(clientEventService ClientEventService) *ClientEventHandler {
+	if clientEventService == nil {
+		panic("clientEventService cannot be nil")
+	}
+
+	return &ClientEventHandler{
+		clientEventService: clientEventService,
+	}
+}
+
+// ClientEventRequest represents one engagement event
+type ClientEventRequest struct {
+	Type        domain.ClientEventType `json:"type"`
+	ArticleID   uuid.UUID              `json:"article_id"`
+	SessionID   *string                `json:"session_id,omitempty"`
+	ScrollDepth *int                   `json:"scroll_depth,omitempty"` // percentage reached, required for scroll events
+	Channel     *string                `json:"channel,omitempty"`      // share target, for share events
+	VariantID   *uuid.UUID             `json:"variant_id,omitempty"`   // CTA variant, for cta_click events
+	OccurredAt  *time.Time             `json:"occurred_at,omitempty"`
+}
+
+// RecordClientEventsRequest represents a batch of engagement events
+type RecordClientEventsRequest struct {
+	Events []ClientEventRequest `json:"events"`
+}
+
+// RecordClientEventsResponse reports how many events were accepted for writing
+type RecordClientEventsResponse struct {
+	Accepted int `json:"accepted"`
+}
+
+// Record handles POST /v1/events
+func (h *ClientEventHandler) Record(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req RecordClientEventsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	inputs := make([]service.ClientEventInput, len(req.Events))
+	for i, event := range req.Events {
+		inputs[i] = service.ClientEventInput{
+			Type:        event.Type,
+			ArticleID:   event.ArticleID,
+			SessionID:   event.SessionID,
+			ScrollDepth: event.ScrollDepth,
+			Channel:     event.Channel,
+			VariantID:   event.VariantID,
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	userID := claims.UserID
+	accepted, err := h.clientEventService.Record(&userID, inputs)
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Validation failed", validationErr.Field+": "+validationErr.Message, requestID)
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to record client events")
+		response.InternalError(w, "Failed to record client events", requestID)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, response.Response{Data: RecordClientEventsResponse{Accepted: accepted}})
+}
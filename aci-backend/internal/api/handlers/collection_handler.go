@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// CollectionHandler handles bookmark collections
+type CollectionHandler struct {
+	collectionService BookmarkCollectionService
+}
+
+// NewCollectionHandler creates a new bookmark collection handler instance
+func NewCollectionHandler(collectionService BookmarkCollectionService) *CollectionHandler {
+	if collectionService == nil {
+		panic("collectionService cannot be nil")
+	}
+
+	return &CollectionHandler{
+		collectionService: collectionService,
+	}
+}
+
+// CreateCollectionRequest represents a bookmark collection creation request
+type CreateCollectionRequest struct {
+	Name   string `json:"name" validate:"required,max=255"`
+	Shared bool   `json:"shared"` // share with the user's organization
+}
+
+// AddCollectionArticleRequest represents a request to add an article to a collection
+type AddCollectionArticleRequest struct {
+	ArticleID uuid.UUID `json:"article_id" validate:"required"`
+}
+
+// List handles GET /v1/collections - private collections plus the organization's shared collections
+func (h *CollectionHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collections, err := h.collectionService.List(ctx, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list collections")
+		return
+	}
+
+	response.Success(w, collections)
+}
+
+// Create handles POST /v1/collections
+func (h *CollectionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req CreateCollectionRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	collection, err := h.collectionService.Create(ctx, claims.UserID, req.Name, req.Shared)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create collection")
+		return
+	}
+
+	response.Created(w, collection)
+}
+
+// Get handles GET /v1/collections/{id}
+func (h *CollectionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collectionID, ok := parseCollectionID(w, r)
+	if !ok {
+		return
+	}
+
+	collection, err := h.collectionService.Get(ctx, collectionID, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get collection")
+		return
+	}
+
+	response.Success(w, collection)
+}
+
+// Delete handles DELETE /v1/collections/{id}
+func (h *CollectionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collectionID, ok := parseCollectionID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.collectionService.Delete(ctx, collectionID, claims.UserID); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete collection")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListArticles handles GET /v1/collections/{id}/articles
+func (h *CollectionHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collectionID, ok := parseCollectionID(w, r)
+	if !ok {
+		return
+	}
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	articles, total, err := h.collectionService.ListArticles(ctx, collectionID, claims.UserID, page, pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list collection articles")
+		return
+	}
+
+	articleResponses := make([]ArticleResponse, len(articles))
+	for i, article := range articles {
+		articleResponses[i] = toArticleResponse(article)
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, articleResponses, meta)
+}
+
+// AddArticle handles POST /v1/collections/{id}/articles
+func (h *CollectionHandler) AddArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collectionID, ok := parseCollectionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddCollectionArticleRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if err := h.collectionService.AddArticle(ctx, collectionID, claims.UserID, req.ArticleID); err != nil {
+		h.handleError(w, err, requestID, "Failed to add article to collection")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// RemoveArticle handles DELETE /v1/collections/{id}/articles/{articleID}
+func (h *CollectionHandler) RemoveArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	collectionID, ok := parseCollectionID(w, r)
+	if !ok {
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	if err := h.collectionService.RemoveArticle(ctx, collectionID, claims.UserID, articleID); err != nil {
+		h.handleError(w, err, requestID, "Failed to remove article from collection")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *CollectionHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	if writeValidationError(w, err, requestID) {
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only the creator or an organization admin can delete a shared collection")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseCollectionID extracts the collection ID URL parameter, writing a 400 on failure
+func parseCollectionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid collection ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/config"
+)
+
+// ConfigSnapshotter reports the running configuration
+type ConfigSnapshotter interface {
+	Snapshot() *config.Snapshot
+}
+
+// ConfigHandler exposes the running configuration to administrators
+type ConfigHandler struct {
+	configs ConfigSnapshotter
+}
+
+// NewConfigHandler creates a new config handler instance
+func NewConfigHandler(configs ConfigSnapshotter) *ConfigHandler {
+	if configs == nil {
+		panic("configs cannot be nil")
+	}
+
+	return &ConfigHandler{
+		configs: configs,
+	}
+}
+
+// Get handles GET /v1/admin/config
+// Secrets are redacted; settings changed in the config file that need a restart are flagged
+func (h *ConfigHandler) Get(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.configs.Snapshot())
+}
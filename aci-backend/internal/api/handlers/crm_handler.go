@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// CRMHandler handles lead form submissions and the admin view of the CRM event queue
+type CRMHandler struct {
+	crmService CRMService
+}
+
+// NewCRMHandler creates a new CRM handler instance
+func NewCRMHandler(crmService CRMService) *CRMHandler {
+	if crmService == nil {
+		panic("crmService cannot be nil")
+	}
+
+	return &CRMHandler{
+		crmService: crmService,
+	}
+}
+
+// SubmitLead handles POST /v1/leads - queues a lead form submission for the CRM
+func (h *CRMHandler) SubmitLead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req service.LeadInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if err := h.crmService.SubmitLead(ctx, req); err != nil {
+		h.handleError(w, err, requestID, "Failed to submit lead")
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, response.Response{
+		Data: map[string]string{"message": "Thanks, we'll be in touch"},
+	})
+}
+
+// ListEvents handles GET /v1/admin/crm/events
+// Query params: status (pending, delivered or failed), type (cta_click or lead_form), page, page_size
+func (h *CRMHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	filter := &domain.CRMEventFilter{Page: page, PageSize: pageSize}
+	if status := r.URL.Query().Get("status"); status != "" {
+		eventStatus := domain.CRMEventStatus(status)
+		filter.Status = &eventStatus
+	}
+	if conversionType := r.URL.Query().Get("type"); conversionType != "" {
+		eventType := domain.ConversionType(conversionType)
+		filter.Type = &eventType
+	}
+
+	events, total, err := h.crmService.ListEvents(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list CRM events")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, events, meta)
+}
+
+// RetryEvent handles POST /v1/admin/crm/events/{id}/retry - queues a failed event again
+func (h *CRMHandler) RetryEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid event ID format")
+		return
+	}
+
+	event, err := h.crmService.Retry(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to retry CRM event")
+		return
+	}
+
+	response.Success(w, event)
+}
+
+// RetryFailed handles POST /v1/admin/crm/events/retry - queues every failed event again
+func (h *CRMHandler) RetryFailed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	retried, err := h.crmService.RetryFailed(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to retry CRM events")
+		return
+	}
+
+	response.Success(w, map[string]int64{"retried": retried})
+}
+
+// handleError maps service errors to HTTP responses
+func (h *CRMHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
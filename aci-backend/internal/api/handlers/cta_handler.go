@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// CTAHandler handles Armor CTA A/B variants: click tracking for readers and management for administrators
+type CTAHandler struct {
+	ctaService CTAExperimentService
+}
+
+// NewCTAHandler creates a new CTA handler instance
+func NewCTAHandler(ctaService CTAExperimentService) *CTAHandler {
+	if ctaService == nil {
+		panic("ctaService cannot be nil")
+	}
+
+	return &CTAHandler{
+		ctaService: ctaService,
+	}
+}
+
+// CTAClickRequest represents a click on the CTA shown with an article
+type CTAClickRequest struct {
+	VariantID uuid.UUID `json:"variant_id,omitempty"` // the served variant, when the CTA is under test
+}
+
+// Click handles POST /v1/articles/{id}/cta-click - records a click on the article's CTA
+func (h *CTAHandler) Click(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req CTAClickRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if err := h.ctaService.Click(ctx, articleID, req.VariantID, claims.UserID); err != nil {
+		h.handleError(w, err, requestID, "Failed to record CTA click")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// List handles GET /v1/admin/cta-variants
+func (h *CTAHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	variants, err := h.ctaService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list CTA variants")
+		return
+	}
+
+	response.Success(w, variants)
+}
+
+// Report handles GET /v1/admin/cta-variants/report - impressions, clicks and CTR per variant
+// Query params: days (1-365, default 30)
+func (h *CTAHandler) Report(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	days := service.DefaultCTAReportDays
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed < 1 || parsed > service.MaxCTAReportDays {
+			response.BadRequest(w, "Invalid days: must be between 1 and 365")
+			return
+		}
+		days = parsed
+	}
+
+	stats, err := h.ctaService.Report(ctx, days)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to build CTA variant report")
+		return
+	}
+
+	response.Success(w, stats)
+}
+
+// Create handles POST /v1/admin/cta-variants
+func (h *CTAHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req service.CTAVariantInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	variant, err := h.ctaService.Create(ctx, req, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create CTA variant")
+		return
+	}
+
+	response.Created(w, variant)
+}
+
+// Update handles PUT /v1/admin/cta-variants/{id}
+func (h *CTAHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	variantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid variant ID format")
+		return
+	}
+
+	var req service.CTAVariantInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	variant, err := h.ctaService.Update(ctx, variantID, req, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update CTA variant")
+		return
+	}
+
+	response.Success(w, variant)
+}
+
+// Delete handles DELETE /v1/admin/cta-variants/{id}
+func (h *CTAHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	variantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid variant ID format")
+		return
+	}
+
+	if err := h.ctaService.Delete(ctx, variantID, suggestionReviewer(r), GetClientIP(r), r.UserAgent()); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete CTA variant")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *CTAHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -78,6 +78,7 @@ func (h *DashboardHandler) GetSummary(w http.ResponseWriter, r *http.Request) {
 	// Get articles for aggregation - fetch multiple pages if needed
 	filter := domain.NewArticleFilter()
 	filter.PageSize = 100 // Max allowed by filter validation
+	filter.PublishedOnly = true
 
 	articles, total, err := h.articleRepo.List(ctx, filter)
 	if err != nil {
@@ -103,6 +104,7 @@ func (h *DashboardHandler) GetRecentActivity(w http.ResponseWriter, r *http.Requ
 	filter := domain.NewArticleFilter()
 	filter.PageSize = 10
 	filter.Page = 1
+	filter.PublishedOnly = true
 
 	articles, _, err := h.articleRepo.List(ctx, filter)
 	if err != nil {
@@ -120,7 +122,7 @@ func (h *DashboardHandler) GetRecentActivity(w http.ResponseWriter, r *http.Requ
 		activity := RecentActivity{
 			ID:          article.ID.String(),
 			Type:        "new_threat",
-			Title:       article.Title,
+			Title:       article.DisplayTitle(),
 			Severity:    string(article.Severity),
 			Timestamp:   article.PublishedAt.Format(time.RFC3339),
 			ThreatID:    &threatID,
@@ -100,7 +100,7 @@ func (h *DeepDiveHandler) GetDeepDive(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !exists {
-		response.NotFound(w, "No deep dive analysis available for this article")
+		response.NotFoundResource(w, "deep dive", "No deep dive analysis available for this article")
 		return
 	}
 
@@ -149,12 +149,8 @@ func (h *DeepDiveHandler) GetDeepDive(w http.ResponseWriter, r *http.Request) {
 
 	// Return preview with upgrade prompt for free users
 	preview := domain.NewDeepDivePreview(deepDive, h.config.UpgradeURL)
-	w.WriteHeader(http.StatusPaymentRequired) // 402 Payment Required
-	response.JSON(w, http.StatusPaymentRequired, map[string]interface{}{
-		"error":   "Subscription required",
-		"message": "This content requires a premium subscription",
-		"preview": preview,
-	})
+	response.ErrorWithDetails(w, http.StatusPaymentRequired, response.ErrCodeSubscriptionRequired,
+		"This content requires a premium subscription", preview, requestID)
 }
 
 // hasDeepDiveAccess checks if user's subscription tier allows access to deep dive
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// EnrichmentFeedbackHandler handles analyst feedback on AI enrichment and the accuracy report
+type EnrichmentFeedbackHandler struct {
+	feedbackService EnrichmentFeedbackService
+}
+
+// NewEnrichmentFeedbackHandler creates a new enrichment feedback handler instance
+func NewEnrichmentFeedbackHandler(feedbackService EnrichmentFeedbackService) *EnrichmentFeedbackHandler {
+	if feedbackService == nil {
+		panic("feedbackService cannot be nil")
+	}
+
+	return &EnrichmentFeedbackHandler{
+		feedbackService: feedbackService,
+	}
+}
+
+// EnrichmentFeedbackRequest represents a flag on one field of an article's enrichment
+type EnrichmentFeedbackRequest struct {
+	Field    domain.EnrichmentField `json:"field"`
+	IOCType  *string                `json:"ioc_type,omitempty"`  // required when field is ioc
+	IOCValue *string                `json:"ioc_value,omitempty"` // required when field is ioc
+	Comment  *string                `json:"comment,omitempty"`
+}
+
+// Create handles POST /v1/articles/{id}/enrichment-feedback
+func (h *EnrichmentFeedbackHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req EnrichmentFeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	input := service.EnrichmentFeedbackInput{
+		Field:    req.Field,
+		IOCType:  req.IOCType,
+		IOCValue: req.IOCValue,
+		Comment:  req.Comment,
+	}
+
+	feedback, err := h.feedbackService.Flag(ctx, claims.UserID, domain.UserRole(claims.Role), articleID, input, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to record enrichment feedback")
+		return
+	}
+
+	response.Created(w, feedback)
+}
+
+// List handles GET /v1/admin/enrichment/feedback
+// Query params: article_id, field, page, page_size
+func (h *EnrichmentFeedbackHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := &domain.EnrichmentFeedbackFilter{Page: page, PageSize: pageSize}
+
+	if articleStr := query.Get("article_id"); articleStr != "" {
+		articleID, err := uuid.Parse(articleStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid article_id parameter")
+			return
+		}
+		filter.ArticleID = &articleID
+	}
+
+	if fieldStr := query.Get("field"); fieldStr != "" {
+		field := domain.EnrichmentField(fieldStr)
+		if !field.IsValid() {
+			response.BadRequest(w, "Invalid field parameter")
+			return
+		}
+		filter.Field = &field
+	}
+
+	feedback, total, err := h.feedbackService.List(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list enrichment feedback")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, feedback, meta)
+}
+
+// Delete handles DELETE /v1/admin/enrichment/feedback/{id}
+func (h *EnrichmentFeedbackHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid feedback ID format")
+		return
+	}
+
+	if err := h.feedbackService.Delete(ctx, id, claims.UserID, GetClientIP(r), r.UserAgent()); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete enrichment feedback")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// GetAccuracy handles GET /v1/admin/enrichment/accuracy
+// Query params: weeks (1-104, default 12)
+func (h *EnrichmentFeedbackHandler) GetAccuracy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	weeks := service.DefaultEnrichmentAccuracyWeeks
+	if weeksStr := r.URL.Query().Get("weeks"); weeksStr != "" {
+		parsed, err := strconv.Atoi(weeksStr)
+		if err != nil || parsed < 1 || parsed > service.MaxEnrichmentAccuracyWeeks {
+			response.BadRequest(w, "Invalid weeks: must be between 1 and 104")
+			return
+		}
+		weeks = parsed
+	}
+
+	report, err := h.feedbackService.Accuracy(ctx, weeks)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to build enrichment accuracy report")
+		return
+	}
+
+	response.Success(w, report)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *EnrichmentFeedbackHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, "enrichment feedback", "You have already flagged this")
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only analysts and admins can give enrichment feedback")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// EnrichmentHandler exposes background enrichment worker metrics to administrators
+type EnrichmentHandler struct {
+	enrichmentWorker EnrichmentWorker
+}
+
+// NewEnrichmentHandler creates a new enrichment handler instance
+func NewEnrichmentHandler(enrichmentWorker EnrichmentWorker) *EnrichmentHandler {
+	if enrichmentWorker == nil {
+		panic("enrichmentWorker cannot be nil")
+	}
+
+	return &EnrichmentHandler{
+		enrichmentWorker: enrichmentWorker,
+	}
+}
+
+// GetWorkerStats handles GET /v1/admin/enrichment/worker
+// Returns throughput, failure counters and in-flight work for the enrichment worker
+func (h *EnrichmentHandler) GetWorkerStats(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.enrichmentWorker.Stats())
+}
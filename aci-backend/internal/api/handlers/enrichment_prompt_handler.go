@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// EnrichmentPromptHandler handles admin management of the enricher's prompts
+type EnrichmentPromptHandler struct {
+	promptService EnrichmentPromptService
+}
+
+// NewEnrichmentPromptHandler creates a new enrichment prompt handler instance
+func NewEnrichmentPromptHandler(promptService EnrichmentPromptService) *EnrichmentPromptHandler {
+	if promptService == nil {
+		panic("promptService cannot be nil")
+	}
+
+	return &EnrichmentPromptHandler{
+		promptService: promptService,
+	}
+}
+
+// EnrichmentPromptRequest represents the editable fields of a prompt version
+// Key is only read when creating; an update keeps the key of the version it edits
+type EnrichmentPromptRequest struct {
+	Key          domain.EnrichmentPromptKey `json:"key,omitempty"`
+	SystemPrompt string                     `json:"system_prompt"`
+	Model        *string                    `json:"model,omitempty"`
+	Notes        *string                    `json:"notes,omitempty"`
+}
+
+// EnrichmentPromptTrialRequest selects the articles a prompt is tried on
+// ArticleIDs takes precedence; otherwise the SampleSize most recent enriched articles are used
+type EnrichmentPromptTrialRequest struct {
+	ArticleIDs []uuid.UUID `json:"article_ids,omitempty"`
+	SampleSize int         `json:"sample_size,omitempty"`
+}
+
+func (req EnrichmentPromptRequest) toInput() service.EnrichmentPromptInput {
+	return service.EnrichmentPromptInput{
+		SystemPrompt: req.SystemPrompt,
+		Model:        req.Model,
+		Notes:        req.Notes,
+	}
+}
+
+// List handles GET /v1/admin/enrichment/prompts
+func (h *EnrichmentPromptHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	overviews, err := h.promptService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list enrichment prompts")
+		return
+	}
+
+	response.Success(w, overviews)
+}
+
+// Create handles POST /v1/admin/enrichment/prompts
+func (h *EnrichmentPromptHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req EnrichmentPromptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	prompt, err := h.promptService.Create(ctx, req.Key, req.toInput(), suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create enrichment prompt")
+		return
+	}
+
+	response.Created(w, prompt)
+}
+
+// GetByID handles GET /v1/admin/enrichment/prompts/{id}
+func (h *EnrichmentPromptHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	prompt, err := h.promptService.GetByID(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get enrichment prompt")
+		return
+	}
+
+	response.Success(w, prompt)
+}
+
+// Update handles PUT /v1/admin/enrichment/prompts/{id} - stores the edit as a new draft version
+func (h *EnrichmentPromptHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	var req EnrichmentPromptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	prompt, err := h.promptService.Update(ctx, id, req.toInput(), suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update enrichment prompt")
+		return
+	}
+
+	response.Created(w, prompt)
+}
+
+// Delete handles DELETE /v1/admin/enrichment/prompts/{id} - drafts that were never active only
+func (h *EnrichmentPromptHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.promptService.Delete(ctx, id, suggestionReviewer(r), GetClientIP(r), r.UserAgent()); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete enrichment prompt")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListVersions handles GET /v1/admin/enrichment/prompts/{id}/versions
+func (h *EnrichmentPromptHandler) ListVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	versions, err := h.promptService.ListVersions(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list enrichment prompt versions")
+		return
+	}
+
+	response.Success(w, versions)
+}
+
+// Activate handles POST /v1/admin/enrichment/prompts/{id}/activate
+func (h *EnrichmentPromptHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	prompt, err := h.promptService.Activate(ctx, id, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to activate enrichment prompt")
+		return
+	}
+
+	response.Success(w, prompt)
+}
+
+// Trial handles POST /v1/admin/enrichment/prompts/{id}/trial
+func (h *EnrichmentPromptHandler) Trial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parsePromptID(w, r)
+	if !ok {
+		return
+	}
+
+	// The body is optional; an empty one tries the default sample
+	var req EnrichmentPromptTrialRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.BadRequest(w, "Invalid request body")
+			return
+		}
+	}
+
+	trial, err := h.promptService.Trial(ctx, id, req.ArticleIDs, req.SampleSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to try enrichment prompt")
+		return
+	}
+
+	response.Success(w, trial)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *EnrichmentPromptHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, service.ErrEnrichmentDisabled) {
+		response.ServiceUnavailable(w, "enrichment is disabled (AI_PROVIDER=disabled)")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parsePromptID extracts the prompt ID URL parameter, writing a 400 on failure
+func parsePromptID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid prompt ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// exploitSyncWriteTimeout bounds a manual sync, which downloads the Exploit-DB index and
+// looks up GitHub proofs of concept one CVE at a time
+const exploitSyncWriteTimeout = 10 * time.Minute
+
+// ExploitHandler exposes public exploits for CVEs and the sync of the exploit feeds
+type ExploitHandler struct {
+	exploitService ExploitService
+}
+
+// NewExploitHandler creates a new exploit handler instance
+func NewExploitHandler(exploitService ExploitService) *ExploitHandler {
+	if exploitService == nil {
+		panic("exploitService cannot be nil")
+	}
+
+	return &ExploitHandler{
+		exploitService: exploitService,
+	}
+}
+
+// ListForArticle handles GET /v1/articles/{id}/exploits
+func (h *ExploitHandler) ListForArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	exploits, err := h.exploitService.ListForArticle(ctx, articleID)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "article", "Article not found")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get article exploits")
+		response.InternalError(w, "Failed to retrieve article exploits", requestID)
+		return
+	}
+
+	response.Success(w, exploits)
+}
+
+// GetStatus handles GET /v1/admin/exploits
+func (h *ExploitHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	status, err := h.exploitService.Status(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to get exploit source status")
+		response.InternalError(w, "Failed to retrieve exploit source status", requestID)
+		return
+	}
+
+	response.Success(w, map[string]interface{}{
+		"enabled_sources": h.exploitService.Sources(),
+		"sources":         status,
+	})
+}
+
+// Sync handles POST /v1/admin/exploits/sync - syncs the exploit feeds now instead of waiting for the schedule
+// ?source=exploitdb|github syncs one feed; otherwise every enabled feed is synced and exploit alerts are matched
+func (h *ExploitHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var source domain.ExploitSource
+	if value := r.URL.Query().Get("source"); value != "" {
+		source = domain.ExploitSource(value)
+		if !source.IsValid() {
+			response.BadRequest(w, "source must be exploitdb or github")
+			return
+		}
+	}
+
+	// Downloading the feeds can outlast the server write timeout
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(exploitSyncWriteTimeout)); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to extend write deadline for exploit sync")
+	}
+
+	if source != "" {
+		result, err := h.exploitService.Sync(ctx, source)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestID).
+				Str("source", string(source)).
+				Msg("Failed to sync exploit source")
+			response.ServiceUnavailable(w, "Failed to sync exploit source")
+			return
+		}
+
+		response.Success(w, []*domain.ExploitSyncResult{result})
+		return
+	}
+
+	results, err := h.exploitService.SyncAll(ctx)
+	if err != nil && len(results) == 0 {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to sync exploit sources")
+		response.ServiceUnavailable(w, "Failed to sync exploit sources")
+		return
+	}
+
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Some exploit sources failed to sync")
+	}
+
+	response.Success(w, results)
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/util/tabular"
+)
+
+const (
+	// exportPageSize is how many rows an export fetches at a time
+	exportPageSize = 100
+
+	// maxExportRows caps the rows in one export; narrow the filters to export more
+	maxExportRows = 100000
+)
+
+// exportColumn is a column of a CSV or XLSX export
+type exportColumn[T any] struct {
+	name  string
+	value func(T) string
+}
+
+// exportRequest is a requested export: its format and the columns to write, in order
+type exportRequest[T any] struct {
+	format  tabular.Format
+	columns []exportColumn[T]
+}
+
+// parseExport reads ?export=csv|xlsx and ?columns=id,name (default: every column)
+// It returns nil when no export was requested, so the endpoint responds with JSON as usual
+func parseExport[T any](r *http.Request, columns []exportColumn[T]) (*exportRequest[T], error) {
+	query := r.URL.Query()
+
+	exportParam := query.Get("export")
+	if exportParam == "" {
+		return nil, nil
+	}
+
+	format, err := tabular.ParseFormat(exportParam)
+	if err != nil {
+		return nil, err
+	}
+
+	names := splitList(query.Get("columns"))
+	if len(names) == 0 {
+		return &exportRequest[T]{format: format, columns: columns}, nil
+	}
+
+	byName := make(map[string]exportColumn[T], len(columns))
+	for _, column := range columns {
+		byName[column.name] = column
+	}
+
+	selected := make([]exportColumn[T], 0, len(names))
+	for _, name := range names {
+		column, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid columns parameter: unknown column %q", name)
+		}
+		selected = append(selected, column)
+	}
+
+	return &exportRequest[T]{format: format, columns: selected}, nil
+}
+
+// writeExport streams an export as an attachment named <name>-YYYYMMDD.<format>
+// Rows are fetched exportPageSize at a time through next until a short page or maxExportRows,
+// so only one page is held in memory. The first page is fetched before anything is sent and
+// its error is returned for the caller to respond with; later errors are logged, since the
+// client already has part of the file
+func writeExport[T any](
+	w http.ResponseWriter,
+	requestID string,
+	export *exportRequest[T],
+	name string,
+	next func(offset, limit int) ([]T, error),
+) error {
+	rows, err := next(0, exportPageSize)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102"), export.format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Type", export.format.ContentType())
+
+	if err := streamExport(w, export, name, rows, next); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msgf("Failed to write %s export", name)
+	}
+
+	return nil
+}
+
+// streamExport writes the header and every page of rows, starting from the first page
+func streamExport[T any](w http.ResponseWriter, export *exportRequest[T], name string, rows []T, next func(offset, limit int) ([]T, error)) error {
+	writer, err := tabular.NewWriter(w, export.format, name)
+	if err != nil {
+		return err
+	}
+
+	record := make([]string, len(export.columns))
+	for i, column := range export.columns {
+		record[i] = column.name
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	written := 0
+	for {
+		for _, row := range rows {
+			if written == maxExportRows {
+				return writer.Close()
+			}
+
+			for i, column := range export.columns {
+				record[i] = column.value(row)
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+			written++
+		}
+
+		if len(rows) < exportPageSize {
+			break
+		}
+
+		if rows, err = next(written, exportPageSize); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// exportTime formats a timestamp for an export cell
+func exportTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// exportOptionalTime formats an optional timestamp, blank when unset
+func exportOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return exportTime(*t)
+}
+
+// exportOptionalString returns an optional string, blank when unset
+func exportOptionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// exportOptionalUUID formats an optional ID, blank when unset
+func exportOptionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// exportList joins a list into one cell
+func exportList(values []string) string {
+	return strings.Join(values, "; ")
+}
+
+// exportJSON encodes a structured value into one cell, blank when unset
+func exportJSON(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// exportBool formats a flag as true or false
+func exportBool(b bool) string {
+	return strconv.FormatBool(b)
+}
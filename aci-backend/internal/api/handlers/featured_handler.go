@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// FeaturedHandler handles management of the featured article set for administrators
+type FeaturedHandler struct {
+	featuredService FeaturedArticleService
+}
+
+// NewFeaturedHandler creates a new featured article handler instance
+func NewFeaturedHandler(featuredService FeaturedArticleService) *FeaturedHandler {
+	if featuredService == nil {
+		panic("featuredService cannot be nil")
+	}
+
+	return &FeaturedHandler{
+		featuredService: featuredService,
+	}
+}
+
+// FeatureArticleRequest represents a request to feature an article
+// Omitting position keeps an existing entry's position or appends a new entry to the end
+type FeatureArticleRequest struct {
+	Position     *int       `json:"position"`
+	FeatureFrom  *time.Time `json:"feature_from"`
+	FeatureUntil *time.Time `json:"feature_until"`
+}
+
+// ReorderFeaturedRequest represents the new carousel order
+type ReorderFeaturedRequest struct {
+	ArticleIDs []uuid.UUID `json:"article_ids"`
+}
+
+// List handles GET /v1/admin/featured - the whole featured set, including scheduled and expired entries
+func (h *FeaturedHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	featured, err := h.featuredService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list featured articles")
+		return
+	}
+
+	response.Success(w, featured)
+}
+
+// Feature handles PUT /v1/admin/featured/{articleID}
+func (h *FeaturedHandler) Feature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req FeatureArticleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	featured, err := h.featuredService.Feature(
+		ctx,
+		articleID,
+		req.Position,
+		req.FeatureFrom,
+		req.FeatureUntil,
+		suggestionReviewer(r),
+	)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to feature article")
+		return
+	}
+
+	response.Success(w, featured)
+}
+
+// Unfeature handles DELETE /v1/admin/featured/{articleID}
+func (h *FeaturedHandler) Unfeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	if err := h.featuredService.Unfeature(ctx, articleID); err != nil {
+		h.handleError(w, err, requestID, "Failed to unfeature article")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// Reorder handles PUT /v1/admin/featured/order
+func (h *FeaturedHandler) Reorder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req ReorderFeaturedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if err := h.featuredService.Reorder(ctx, req.ArticleIDs); err != nil {
+		h.handleError(w, err, requestID, "Failed to reorder featured articles")
+		return
+	}
+
+	featured, err := h.featuredService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list featured articles")
+		return
+	}
+
+	response.Success(w, featured)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *FeaturedHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var limitErr *domainerrors.LimitExceededError
+	if errors.As(err, &limitErr) {
+		response.LimitExceeded(w, limitErr.Resource, limitErr.Error())
+		return
+	}
+
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// FeedPreferenceHandler handles the current user's default feed filters
+type FeedPreferenceHandler struct {
+	preferenceService FeedPreferenceService
+}
+
+// NewFeedPreferenceHandler creates a new feed preference handler instance
+func NewFeedPreferenceHandler(preferenceService FeedPreferenceService) *FeedPreferenceHandler {
+	if preferenceService == nil {
+		panic("preferenceService cannot be nil")
+	}
+
+	return &FeedPreferenceHandler{
+		preferenceService: preferenceService,
+	}
+}
+
+// UpdateFeedPreferencesRequest represents a feed preference update request
+type UpdateFeedPreferencesRequest struct {
+	PreferredCategories []uuid.UUID     `json:"preferred_categories"`
+	PreferredVendors    []string        `json:"preferred_vendors"`
+	MinSeverity         domain.Severity `json:"min_severity"`
+	ExcludedTags        []string        `json:"excluded_tags"`
+}
+
+// GetMine handles GET /v1/users/me/preferences
+func (h *FeedPreferenceHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		log.Error().
+			Str("request_id", requestID).
+			Msg("User claims not found in context")
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	prefs, err := h.preferenceService.Get(ctx, claims.UserID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to get feed preferences")
+		response.InternalError(w, "Failed to retrieve feed preferences", requestID)
+		return
+	}
+
+	response.Success(w, prefs)
+}
+
+// UpdateMine handles PUT /v1/users/me/preferences
+// The request replaces all feed preferences; omitted lists are cleared
+func (h *FeedPreferenceHandler) UpdateMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		log.Error().
+			Str("request_id", requestID).
+			Msg("User claims not found in context")
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req UpdateFeedPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to decode request body")
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	prefs, err := h.preferenceService.Update(ctx, &domain.FeedPreferences{
+		UserID:              claims.UserID,
+		PreferredCategories: req.PreferredCategories,
+		PreferredVendors:    req.PreferredVendors,
+		MinSeverity:         req.MinSeverity,
+		ExcludedTags:        req.ExcludedTags,
+	})
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Invalid feed preferences", validationErr.Message, requestID)
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to update feed preferences")
+		response.InternalError(w, "Failed to update feed preferences", requestID)
+		return
+	}
+
+	log.Info().
+		Str("request_id", requestID).
+		Str("user_id", claims.UserID.String()).
+		Msg("Feed preferences updated")
+
+	response.Success(w, prefs)
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// articleIncludes are the related objects that can be requested with ?include=
+var articleIncludes = map[string]bool{
+	"category": true,
+	"source":   true,
+}
+
+// articleFields are the JSON field names of the full article representation
+var articleFields = jsonFieldNames(reflect.TypeOf(ArticleDetailResponse{}))
+
+// FieldSelection is a sparse fieldset requested with ?fields= and ?include=
+// A nil selection returns the full representation
+type FieldSelection struct {
+	fields map[string]bool
+}
+
+// parseArticleFieldSelection reads ?fields=title,slug (top-level article fields; id is always
+// returned) and ?include=category,source (related objects added to the selected fields)
+// Include has no effect without fields, since the full representation already has them
+func parseArticleFieldSelection(r *http.Request) (*FieldSelection, error) {
+	query := r.URL.Query()
+	fieldsParam := query.Get("fields")
+	includeParam := query.Get("include")
+
+	for _, name := range splitList(includeParam) {
+		if !articleIncludes[name] {
+			return nil, fmt.Errorf("invalid include parameter: %q (use category or source)", name)
+		}
+	}
+
+	if fieldsParam == "" {
+		return nil, nil
+	}
+
+	selection := &FieldSelection{fields: map[string]bool{"id": true}}
+	for _, name := range splitList(fieldsParam) {
+		if !articleFields[name] {
+			return nil, fmt.Errorf("invalid fields parameter: unknown field %q", name)
+		}
+		selection.fields[name] = true
+	}
+
+	// The summary length preset describes the summary, so it travels with it
+	if selection.fields["summary"] {
+		selection.fields["summary_length"] = true
+	}
+
+	// Likewise the content format describes the content
+	if selection.fields["content"] {
+		selection.fields["content_format"] = true
+	}
+
+	// A slug resolved from a former one says which it was, so clients can update stored links
+	if selection.fields["slug"] {
+		selection.fields["redirected_from"] = true
+	}
+
+	for _, name := range splitList(includeParam) {
+		selection.fields[name] = true
+	}
+
+	return selection, nil
+}
+
+// Has reports whether the field is part of the response
+func (s *FieldSelection) Has(name string) bool {
+	return s == nil || s.fields[name]
+}
+
+// Apply strips unselected top-level fields from a response object
+func (s *FieldSelection) Apply(v interface{}) (interface{}, error) {
+	if s == nil {
+		return v, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for name := range object {
+		if !s.fields[name] {
+			delete(object, name)
+		}
+	}
+
+	return object, nil
+}
+
+// applyAll strips unselected fields from each response object in a list
+func applyAll[T any](s *FieldSelection, items []T) (interface{}, error) {
+	if s == nil {
+		return items, nil
+	}
+
+	selected := make([]interface{}, len(items))
+	for i := range items {
+		item, err := s.Apply(items[i])
+		if err != nil {
+			return nil, err
+		}
+		selected[i] = item
+	}
+
+	return selected, nil
+}
+
+// splitList splits a comma-separated query value, dropping blanks
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	parts := strings.Split(value, ",")
+	items := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
+// jsonFieldNames collects the JSON names of a struct's fields, including embedded structs
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		names[name] = true
+	}
+	return names
+}
@@ -1,39 +1,276 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
 const version = "1.0.0"
 
-// HealthCheck returns the health status of the service
-// GET /health
-func HealthCheck(w http.ResponseWriter, r *http.Request) {
-	healthData := map[string]interface{}{
-		"status":    "healthy",
-		"version":   version,
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+const (
+	// DefaultHealthCheckTimeout bounds each dependency check
+	DefaultHealthCheckTimeout = 2 * time.Second
+
+	// aiCheckInterval caches the AI provider result so frequent probes do not hit the provider API
+	aiCheckInterval = time.Minute
+)
+
+// Health check statuses
+const (
+	CheckStatusOK      = "ok"
+	CheckStatusFailed  = "failed"
+	CheckStatusSkipped = "skipped"
+)
+
+// HubStatus reports the state of the WebSocket hub
+type HubStatus interface {
+	IsRunning() bool
+	IsDraining() bool
+	ClientCount() int
+}
+
+// AIPinger checks that the AI provider is reachable
+type AIPinger interface {
+	ProviderName() string
+	Ping(ctx context.Context) error
+}
+
+// HealthCheckResult is the outcome of one dependency check
+type HealthCheckResult struct {
+	Status    string                 `json:"status"`
+	Critical  bool                   `json:"critical"`
+	LatencyMs int64                  `json:"latency_ms"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	CheckedAt time.Time              `json:"checked_at"`
+}
+
+// HealthReport is the body of the liveness and readiness endpoints
+type HealthReport struct {
+	Status    string                        `json:"status"`
+	Version   string                        `json:"version"`
+	Checks    map[string]*HealthCheckResult `json:"checks"`
+	Timestamp time.Time                     `json:"timestamp"`
+}
+
+// HealthHandler serves liveness and readiness probes
+type HealthHandler struct {
+	schemaRepo      repository.SchemaRepository
+	requiredVersion int64
+	hub             HubStatus
+	ai              AIPinger // optional
+	timeout         time.Duration
+
+	aiMu     sync.Mutex
+	aiResult *HealthCheckResult
+}
+
+// NewHealthHandler creates a new health handler; ai may be nil to skip the provider check
+func NewHealthHandler(
+	schemaRepo repository.SchemaRepository,
+	requiredVersion int64,
+	hub HubStatus,
+	ai AIPinger,
+	timeout time.Duration,
+) *HealthHandler {
+	if schemaRepo == nil {
+		panic("schemaRepo cannot be nil")
+	}
+	if hub == nil {
+		panic("hub cannot be nil")
+	}
+	if timeout <= 0 {
+		timeout = DefaultHealthCheckTimeout
+	}
+
+	return &HealthHandler{
+		schemaRepo:      schemaRepo,
+		requiredVersion: requiredVersion,
+		hub:             hub,
+		ai:              ai,
+		timeout:         timeout,
+	}
+}
+
+// Liveness handles GET /healthz
+// It only fails when the process itself is stuck; dependency outages are left to readiness
+// so that Kubernetes does not restart pods for a database blip
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	checks := map[string]*HealthCheckResult{
+		"websocket_hub": h.checkHubRunning(),
+	}
+
+	h.writeReport(w, checks, "alive", "unhealthy")
+}
+
+// Readiness handles GET /readyz
+// It fails while the database is unreachable, migrations are missing or dirty, or the hub is draining
+// The AI provider check is informational: enrichment degrades but reads keep working without it
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		checks = make(map[string]*HealthCheckResult, 4)
+	)
+
+	run := func(name string, check func(ctx context.Context) *HealthCheckResult) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := check(ctx)
+			mu.Lock()
+			checks[name] = result
+			mu.Unlock()
+		}()
+	}
+
+	run("database", h.checkDatabase)
+	run("migrations", h.checkMigrations)
+	run("ai_provider", h.checkAI)
+	checks["websocket_hub"] = h.checkHubReady()
+	wg.Wait()
+
+	h.writeReport(w, checks, "ready", "not_ready")
+}
+
+// checkDatabase pings Postgres
+func (h *HealthHandler) checkDatabase(ctx context.Context) *HealthCheckResult {
+	return h.timed(ctx, true, func(ctx context.Context) (map[string]interface{}, error) {
+		return nil, h.schemaRepo.Ping(ctx)
+	})
+}
+
+// checkMigrations requires the schema to be at least the version this build was written against
+func (h *HealthHandler) checkMigrations(ctx context.Context) *HealthCheckResult {
+	return h.timed(ctx, true, func(ctx context.Context) (map[string]interface{}, error) {
+		current, dirty, err := h.schemaRepo.Version(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		details := map[string]interface{}{
+			"version":  current,
+			"required": h.requiredVersion,
+			"dirty":    dirty,
+		}
+
+		if dirty {
+			return details, fmt.Errorf("migration %d failed partway and must be fixed manually", current)
+		}
+		if current < h.requiredVersion {
+			return details, fmt.Errorf("schema version %d is behind required version %d", current, h.requiredVersion)
+		}
+
+		return details, nil
+	})
+}
+
+// checkAI pings the AI provider, reusing a recent result
+func (h *HealthHandler) checkAI(ctx context.Context) *HealthCheckResult {
+	if h.ai == nil {
+		return &HealthCheckResult{Status: CheckStatusSkipped, CheckedAt: time.Now().UTC()}
+	}
+
+	h.aiMu.Lock()
+	defer h.aiMu.Unlock()
+
+	if h.aiResult != nil && time.Since(h.aiResult.CheckedAt) < aiCheckInterval {
+		return h.aiResult
 	}
 
-	response.Success(w, healthData)
+	h.aiResult = h.timed(ctx, false, func(ctx context.Context) (map[string]interface{}, error) {
+		details := map[string]interface{}{"provider": h.ai.ProviderName()}
+		return details, h.ai.Ping(ctx)
+	})
+	return h.aiResult
 }
 
-// ReadinessCheck returns the readiness status of the service
-// GET /ready
-func ReadinessCheck(w http.ResponseWriter, r *http.Request) {
-	// TODO: Add actual health checks for dependencies (database, redis, etc.)
-	// For now, return a basic ready status
-	readinessData := map[string]interface{}{
-		"status": "ready",
-		"checks": map[string]string{
-			"database": "ok",
-			"redis":    "ok",
-		},
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+// checkHubRunning fails when the hub loop has stopped
+func (h *HealthHandler) checkHubRunning() *HealthCheckResult {
+	result := &HealthCheckResult{
+		Status:    CheckStatusOK,
+		Critical:  true,
+		Details:   map[string]interface{}{"connections": h.hub.ClientCount()},
+		CheckedAt: time.Now().UTC(),
+	}
+
+	if !h.hub.IsRunning() {
+		result.Status = CheckStatusFailed
+		result.Error = "hub is not running"
+	}
+
+	return result
+}
+
+// checkHubReady also fails while the hub drains connections for shutdown
+func (h *HealthHandler) checkHubReady() *HealthCheckResult {
+	result := h.checkHubRunning()
+	if result.Status == CheckStatusOK && h.hub.IsDraining() {
+		result.Status = CheckStatusFailed
+		result.Error = "hub is draining connections"
+	}
+	return result
+}
+
+// timed runs a check with the configured timeout and records its latency
+func (h *HealthHandler) timed(
+	ctx context.Context,
+	critical bool,
+	check func(ctx context.Context) (map[string]interface{}, error),
+) *HealthCheckResult {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := check(ctx)
+
+	result := &HealthCheckResult{
+		Status:    CheckStatusOK,
+		Critical:  critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Details:   details,
+		CheckedAt: start.UTC(),
+	}
+	if err != nil {
+		result.Status = CheckStatusFailed
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// writeReport responds 200 when every critical check passed and 503 otherwise
+// A failed non-critical check reports "degraded" but keeps the 200
+func (h *HealthHandler) writeReport(w http.ResponseWriter, checks map[string]*HealthCheckResult, okStatus, failedStatus string) {
+	report := &HealthReport{
+		Status:    okStatus,
+		Version:   version,
+		Checks:    checks,
+		Timestamp: time.Now().UTC(),
+	}
+
+	status := http.StatusOK
+	for _, check := range checks {
+		if check.Status != CheckStatusFailed {
+			continue
+		}
+		if check.Critical {
+			report.Status = failedStatus
+			status = http.StatusServiceUnavailable
+			break
+		}
+		report.Status = "degraded"
 	}
 
-	response.Success(w, readinessData)
+	// Probes must always see the current state
+	w.Header().Set("Cache-Control", "no-store")
+	response.JSON(w, status, report)
 }
@@ -2,9 +2,18 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
 )
 
 // ParsePagination extracts pagination parameters from request
@@ -73,26 +82,90 @@ func CalculateTotalPages(total, pageSize int) int {
 	return pages
 }
 
+// crossFieldValidator is implemented by requests whose fields are checked against each other once
+// their tags pass, such as an alert value whose format depends on the alert type
+type crossFieldValidator interface {
+	validateCrossFields() error
+}
+
+// decodeAndValidate decodes a JSON request body into req, a pointer to a request struct, and checks
+// its validate tags, then its cross-field rules if it has any. On failure it writes a 400, listing
+// the invalid fields, and returns false
+func decodeAndValidate(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	requestID := middleware.GetRequestID(r.Context())
+
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+		return false
+	}
+
+	err := validator.Struct(req)
+	if cross, ok := req.(crossFieldValidator); ok && err == nil {
+		err = cross.validateCrossFields()
+	}
+	if err != nil {
+		if !writeValidationError(w, err, requestID) {
+			response.BadRequest(w, "Invalid request body")
+		}
+		return false
+	}
+
+	return true
+}
+
+// writeValidationError writes a 400 listing the invalid fields when err is a struct tag or service
+// validation failure, and reports whether it was
+// Either way the details are the same array of field errors
+func writeValidationError(w http.ResponseWriter, err error, requestID string) bool {
+	var invalid *validator.ValidationErrors
+	if errors.As(err, &invalid) {
+		response.BadRequestWithDetails(w, "Validation failed", invalid.Errors, requestID)
+		return true
+	}
+
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", []validator.FieldError{
+			{Field: validationErr.Field, Message: validationErr.Message},
+		}, requestID)
+		return true
+	}
+
+	return false
+}
+
 // getRequestID extracts request ID from context
 func getRequestID(ctx context.Context) string {
-	if id, ok := ctx.Value("request_id").(string); ok {
-		return id
-	}
-	return ""
+	return middleware.GetRequestID(ctx)
 }
 
 // GetClientIP extracts client IP from request headers
+// Only a valid IP address is returned, so it can be stored in INET and VARCHAR(45) columns;
+// an empty string means none of the sources held one
 func GetClientIP(r *http.Request) string {
-	// Check X-Forwarded-For header
+	// Check X-Forwarded-For header; the first entry is the original client
 	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		return xff
+		first, _, _ := strings.Cut(xff, ",")
+		if ip := net.ParseIP(strings.TrimSpace(first)); ip != nil {
+			return ip.String()
+		}
 	}
 
 	// Check X-Real-IP header
 	if xri := r.Header.Get("X-Real-IP"); xri != "" {
-		return xri
+		if ip := net.ParseIP(strings.TrimSpace(xri)); ip != nil {
+			return ip.String()
+		}
+	}
+
+	// Fallback to RemoteAddr, which includes the port
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
 	}
 
-	// Fallback to RemoteAddr
-	return r.RemoteAddr
+	return ""
 }
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const (
+	// defaultIncidentSuggestions is how many suggestions are returned when no limit is given
+	defaultIncidentSuggestions = 10
+	maxIncidentSuggestions     = 50
+)
+
+// IncidentHandler serves incident timelines and their admin curation
+type IncidentHandler struct {
+	incidentService IncidentService
+}
+
+// NewIncidentHandler creates a new incident handler instance
+func NewIncidentHandler(incidentService IncidentService) *IncidentHandler {
+	if incidentService == nil {
+		panic("incidentService cannot be nil")
+	}
+
+	return &IncidentHandler{
+		incidentService: incidentService,
+	}
+}
+
+// CreateIncidentRequest represents an incident creation request
+type CreateIncidentRequest struct {
+	Title   string  `json:"title" validate:"required,max=255"`
+	Summary *string `json:"summary,omitempty" validate:"omitempty,max=5000"`
+}
+
+// UpdateIncidentRequest replaces an incident's title, summary and status
+type UpdateIncidentRequest struct {
+	Title   string                `json:"title" validate:"required,max=255"`
+	Summary *string               `json:"summary,omitempty" validate:"omitempty,max=5000"` // omitted or empty clears the summary
+	Status  domain.IncidentStatus `json:"status" validate:"required,oneof=ongoing resolved"`
+}
+
+// AddIncidentArticleRequest represents a request to link an article to an incident
+type AddIncidentArticleRequest struct {
+	ArticleID uuid.UUID `json:"article_id" validate:"required"`
+}
+
+// IncidentTimelineResponse is an incident with its articles in chronological order
+type IncidentTimelineResponse struct {
+	Incident *domain.Incident       `json:"incident"`
+	Summary  domain.IncidentSummary `json:"summary"`
+	Articles []ArticleResponse      `json:"articles"`
+}
+
+// IncidentSuggestionResponse is an article that may belong to an incident and why
+type IncidentSuggestionResponse struct {
+	Article ArticleResponse                   `json:"article"`
+	Reasons []domain.IncidentSuggestionReason `json:"reasons"`
+}
+
+// List handles GET /v1/incidents
+// Query params: status (ongoing or resolved), page, page_size
+func (h *IncidentHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	filter := domain.NewIncidentFilter()
+	filter.Page = page
+	filter.PageSize = pageSize
+	if status := r.URL.Query().Get("status"); status != "" {
+		incidentStatus := domain.IncidentStatus(status)
+		filter.Status = &incidentStatus
+	}
+
+	incidents, total, err := h.incidentService.List(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list incidents")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, incidents, meta)
+}
+
+// GetTimeline handles GET /v1/incidents/{id}/timeline
+func (h *IncidentHandler) GetTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	timeline, err := h.incidentService.Timeline(ctx, incidentID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get incident timeline")
+		return
+	}
+
+	articles := make([]ArticleResponse, len(timeline.Articles))
+	for i, article := range timeline.Articles {
+		articles[i] = toArticleResponse(article)
+	}
+
+	response.Success(w, IncidentTimelineResponse{
+		Incident: timeline.Incident,
+		Summary:  timeline.Summary,
+		Articles: articles,
+	})
+}
+
+// Create handles POST /v1/admin/incidents
+func (h *IncidentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req CreateIncidentRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	incident, err := h.incidentService.Create(ctx, claims.UserID, req.Title, req.Summary)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create incident")
+		return
+	}
+
+	response.Created(w, incident)
+}
+
+// Update handles PUT /v1/admin/incidents/{id}
+func (h *IncidentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateIncidentRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	incident, err := h.incidentService.Update(ctx, incidentID, req.Title, req.Summary, req.Status)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update incident")
+		return
+	}
+
+	response.Success(w, incident)
+}
+
+// Delete handles DELETE /v1/admin/incidents/{id}
+func (h *IncidentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.incidentService.Delete(ctx, incidentID); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete incident")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// AddArticle handles POST /v1/admin/incidents/{id}/articles
+func (h *IncidentHandler) AddArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddIncidentArticleRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if err := h.incidentService.AddArticle(ctx, incidentID, claims.UserID, req.ArticleID); err != nil {
+		h.handleError(w, err, requestID, "Failed to add article to incident")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// RemoveArticle handles DELETE /v1/admin/incidents/{id}/articles/{articleID}
+func (h *IncidentHandler) RemoveArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	if err := h.incidentService.RemoveArticle(ctx, incidentID, articleID); err != nil {
+		h.handleError(w, err, requestID, "Failed to remove article from incident")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListSuggestions handles GET /v1/admin/incidents/{id}/suggestions
+// Query params: limit (default 10, max 50)
+func (h *IncidentHandler) ListSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	incidentID, ok := parseIncidentID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := defaultIncidentSuggestions
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > maxIncidentSuggestions {
+			response.BadRequestWithDetails(w, "Invalid query parameters", fmt.Sprintf("limit must be between 1 and %d", maxIncidentSuggestions), requestID)
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.incidentService.Suggest(ctx, incidentID, limit)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to suggest incident articles")
+		return
+	}
+
+	items := make([]IncidentSuggestionResponse, len(suggestions))
+	for i, suggestion := range suggestions {
+		items[i] = IncidentSuggestionResponse{
+			Article: toArticleResponse(suggestion.Article),
+			Reasons: suggestion.Reasons,
+		}
+	}
+
+	response.Success(w, items)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *IncidentHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var limitErr *domainerrors.LimitExceededError
+	if errors.As(err, &limitErr) {
+		response.LimitExceeded(w, limitErr.Resource, limitErr.Error())
+		return
+	}
+
+	if writeValidationError(w, err, requestID) {
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseIncidentID extracts the incident ID URL parameter, writing a 400 on failure
+func parseIncidentID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid incident ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
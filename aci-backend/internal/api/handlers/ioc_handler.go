@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// IOCHandler handles indicator of compromise search and export
+type IOCHandler struct {
+	iocService IOCService
+}
+
+// NewIOCHandler creates a new IOC handler instance
+func NewIOCHandler(iocService IOCService) *IOCHandler {
+	if iocService == nil {
+		panic("iocService cannot be nil")
+	}
+
+	return &IOCHandler{
+		iocService: iocService,
+	}
+}
+
+// List handles GET /v1/iocs
+// Query params: type, value (prefix), severity, first_seen_from, first_seen_to,
+// last_seen_from, last_seen_to (RFC3339), page, page_size
+func (h *IOCHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	filter, err := parseIndicatorFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	indicators, total, err := h.iocService.Search(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to search IOCs")
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, indicators, meta)
+}
+
+// Export handles GET /v1/iocs/export
+// Accepts the same filters as List (pagination is ignored) plus format (csv or json, default csv)
+func (h *IOCHandler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		response.BadRequest(w, "Invalid format: must be csv or json")
+		return
+	}
+
+	filter, err := parseIndicatorFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	indicators, err := h.iocService.Export(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to export IOCs")
+		return
+	}
+
+	filename := fmt.Sprintf("iocs-%s.%s", time.Now().UTC().Format("20060102-150405"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(indicators); err != nil {
+			log.Error().Err(err).Str("request_id", requestID).Msg("Failed to write IOC export")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{"type", "value", "first_seen", "last_seen", "article_count", "max_severity"})
+	for _, indicator := range indicators {
+		_ = writer.Write([]string{
+			indicator.Type,
+			indicator.Value,
+			indicator.FirstSeen.UTC().Format(time.RFC3339),
+			indicator.LastSeen.UTC().Format(time.RFC3339),
+			strconv.Itoa(indicator.ArticleCount),
+			string(indicator.MaxSeverity),
+		})
+	}
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		log.Error().Err(err).Str("request_id", requestID).Msg("Failed to write IOC export")
+	}
+}
+
+// handleError maps service errors to HTTP responses
+func (h *IOCHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", validationErr.Message, requestID)
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseIndicatorFilter parses IOC search query parameters
+func parseIndicatorFilter(r *http.Request) (*domain.IndicatorFilter, error) {
+	filter := domain.NewIndicatorFilter()
+	query := r.URL.Query()
+
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page parameter: %w", err)
+		}
+		filter.Page = page
+	}
+
+	if pageSizeStr := query.Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.Atoi(pageSizeStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page_size parameter: %w", err)
+		}
+		filter.PageSize = pageSize
+	}
+
+	if iocType := query.Get("type"); iocType != "" {
+		filter.Type = &iocType
+	}
+
+	if value := query.Get("value"); value != "" {
+		filter.ValuePrefix = &value
+	}
+
+	if severityStr := query.Get("severity"); severityStr != "" {
+		severity := domain.Severity(severityStr)
+		filter.Severity = &severity
+	}
+
+	timeParams := []struct {
+		name   string
+		target **time.Time
+	}{
+		{"first_seen_from", &filter.FirstSeenFrom},
+		{"first_seen_to", &filter.FirstSeenTo},
+		{"last_seen_from", &filter.LastSeenFrom},
+		{"last_seen_to", &filter.LastSeenTo},
+	}
+
+	for _, param := range timeParams {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter (use RFC3339 format): %w", param.name, err)
+		}
+		*param.target = &parsed
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// kevSyncWriteTimeout bounds a manual sync, which downloads the whole catalog
+const kevSyncWriteTimeout = 2 * time.Minute
+
+// KEVHandler exposes the local copy of the CISA Known Exploited Vulnerabilities catalog
+type KEVHandler struct {
+	kevService KEVService
+}
+
+// NewKEVHandler creates a new KEV handler instance
+func NewKEVHandler(kevService KEVService) *KEVHandler {
+	if kevService == nil {
+		panic("kevService cannot be nil")
+	}
+
+	return &KEVHandler{
+		kevService: kevService,
+	}
+}
+
+// GetStatus handles GET /v1/admin/kev
+func (h *KEVHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	status, err := h.kevService.Status(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to get KEV catalog status")
+		response.InternalError(w, "Failed to retrieve KEV catalog status", requestID)
+		return
+	}
+
+	response.Success(w, status)
+}
+
+// Sync handles POST /v1/admin/kev/sync - downloads the catalog now instead of waiting for the schedule
+func (h *KEVHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	// Downloading the catalog can outlast the server write timeout
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(kevSyncWriteTimeout)); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to extend write deadline for KEV sync")
+	}
+
+	result, err := h.kevService.Sync(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to sync KEV catalog")
+		response.ServiceUnavailable(w, "Failed to sync KEV catalog")
+		return
+	}
+
+	response.Success(w, result)
+}
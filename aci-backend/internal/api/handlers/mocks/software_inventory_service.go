@@ -0,0 +1,380 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/phillipboles/aci-backend/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/phillipboles/aci-backend/internal/service"
+
+	uuid "github.com/google/uuid"
+)
+
+// SoftwareInventoryService is an autogenerated mock type for the SoftwareInventoryService type
+type SoftwareInventoryService struct {
+	mock.Mock
+}
+
+type SoftwareInventoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SoftwareInventoryService) EXPECT() *SoftwareInventoryService_Expecter {
+	return &SoftwareInventoryService_Expecter{mock: &_m.Mock}
+}
+
+// Annotate provides a mock function with given fields: ctx, userID, articles
+func (_m *SoftwareInventoryService) Annotate(ctx context.Context, userID uuid.UUID, articles []*domain.Article) error {
+	ret := _m.Called(ctx, userID, articles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Annotate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*domain.Article) error); ok {
+		r0 = rf(ctx, userID, articles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftwareInventoryService_Annotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Annotate'
+type SoftwareInventoryService_Annotate_Call struct {
+	*mock.Call
+}
+
+// Annotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - articles []*domain.Article
+func (_e *SoftwareInventoryService_Expecter) Annotate(ctx interface{}, userID interface{}, articles interface{}) *SoftwareInventoryService_Annotate_Call {
+	return &SoftwareInventoryService_Annotate_Call{Call: _e.mock.On("Annotate", ctx, userID, articles)}
+}
+
+func (_c *SoftwareInventoryService_Annotate_Call) Run(run func(ctx context.Context, userID uuid.UUID, articles []*domain.Article)) *SoftwareInventoryService_Annotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]*domain.Article))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_Annotate_Call) Return(_a0 error) *SoftwareInventoryService_Annotate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_Annotate_Call) RunAndReturn(run func(context.Context, uuid.UUID, []*domain.Article) error) *SoftwareInventoryService_Annotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyTo provides a mock function with given fields: ctx, userID, filter
+func (_m *SoftwareInventoryService) ApplyTo(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter) error {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.ArticleFilter) error); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftwareInventoryService_ApplyTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyTo'
+type SoftwareInventoryService_ApplyTo_Call struct {
+	*mock.Call
+}
+
+// ApplyTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - filter *domain.ArticleFilter
+func (_e *SoftwareInventoryService_Expecter) ApplyTo(ctx interface{}, userID interface{}, filter interface{}) *SoftwareInventoryService_ApplyTo_Call {
+	return &SoftwareInventoryService_ApplyTo_Call{Call: _e.mock.On("ApplyTo", ctx, userID, filter)}
+}
+
+func (_c *SoftwareInventoryService_ApplyTo_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter)) *SoftwareInventoryService_ApplyTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*domain.ArticleFilter))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ApplyTo_Call) Return(_a0 error) *SoftwareInventoryService_ApplyTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ApplyTo_Call) RunAndReturn(run func(context.Context, uuid.UUID, *domain.ArticleFilter) error) *SoftwareInventoryService_ApplyTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListForOrganization provides a mock function with given fields: ctx, orgID, userID, role
+func (_m *SoftwareInventoryService) ListForOrganization(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role domain.UserRole) ([]*domain.InventoryItem, error) {
+	ret := _m.Called(ctx, orgID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListForOrganization")
+	}
+
+	var r0 []*domain.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole) ([]*domain.InventoryItem, error)); ok {
+		return rf(ctx, orgID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole) []*domain.InventoryItem); ok {
+		r0 = rf(ctx, orgID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.InventoryItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole) error); ok {
+		r1 = rf(ctx, orgID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftwareInventoryService_ListForOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListForOrganization'
+type SoftwareInventoryService_ListForOrganization_Call struct {
+	*mock.Call
+}
+
+// ListForOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+//   - role domain.UserRole
+func (_e *SoftwareInventoryService_Expecter) ListForOrganization(ctx interface{}, orgID interface{}, userID interface{}, role interface{}) *SoftwareInventoryService_ListForOrganization_Call {
+	return &SoftwareInventoryService_ListForOrganization_Call{Call: _e.mock.On("ListForOrganization", ctx, orgID, userID, role)}
+}
+
+func (_c *SoftwareInventoryService_ListForOrganization_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role domain.UserRole)) *SoftwareInventoryService_ListForOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(domain.UserRole))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ListForOrganization_Call) Return(_a0 []*domain.InventoryItem, _a1 error) *SoftwareInventoryService_ListForOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ListForOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole) ([]*domain.InventoryItem, error)) *SoftwareInventoryService_ListForOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMine provides a mock function with given fields: ctx, userID
+func (_m *SoftwareInventoryService) ListMine(ctx context.Context, userID uuid.UUID) ([]*domain.InventoryItem, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMine")
+	}
+
+	var r0 []*domain.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*domain.InventoryItem, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*domain.InventoryItem); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.InventoryItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftwareInventoryService_ListMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMine'
+type SoftwareInventoryService_ListMine_Call struct {
+	*mock.Call
+}
+
+// ListMine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SoftwareInventoryService_Expecter) ListMine(ctx interface{}, userID interface{}) *SoftwareInventoryService_ListMine_Call {
+	return &SoftwareInventoryService_ListMine_Call{Call: _e.mock.On("ListMine", ctx, userID)}
+}
+
+func (_c *SoftwareInventoryService_ListMine_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SoftwareInventoryService_ListMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ListMine_Call) Return(_a0 []*domain.InventoryItem, _a1 error) *SoftwareInventoryService_ListMine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ListMine_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*domain.InventoryItem, error)) *SoftwareInventoryService_ListMine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceForOrganization provides a mock function with given fields: ctx, orgID, userID, role, inputs
+func (_m *SoftwareInventoryService) ReplaceForOrganization(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role domain.UserRole, inputs []service.InventoryItemInput) ([]*domain.InventoryItem, error) {
+	ret := _m.Called(ctx, orgID, userID, role, inputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceForOrganization")
+	}
+
+	var r0 []*domain.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole, []service.InventoryItemInput) ([]*domain.InventoryItem, error)); ok {
+		return rf(ctx, orgID, userID, role, inputs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole, []service.InventoryItemInput) []*domain.InventoryItem); ok {
+		r0 = rf(ctx, orgID, userID, role, inputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.InventoryItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole, []service.InventoryItemInput) error); ok {
+		r1 = rf(ctx, orgID, userID, role, inputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftwareInventoryService_ReplaceForOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceForOrganization'
+type SoftwareInventoryService_ReplaceForOrganization_Call struct {
+	*mock.Call
+}
+
+// ReplaceForOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+//   - role domain.UserRole
+//   - inputs []service.InventoryItemInput
+func (_e *SoftwareInventoryService_Expecter) ReplaceForOrganization(ctx interface{}, orgID interface{}, userID interface{}, role interface{}, inputs interface{}) *SoftwareInventoryService_ReplaceForOrganization_Call {
+	return &SoftwareInventoryService_ReplaceForOrganization_Call{Call: _e.mock.On("ReplaceForOrganization", ctx, orgID, userID, role, inputs)}
+}
+
+func (_c *SoftwareInventoryService_ReplaceForOrganization_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role domain.UserRole, inputs []service.InventoryItemInput)) *SoftwareInventoryService_ReplaceForOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(domain.UserRole), args[4].([]service.InventoryItemInput))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ReplaceForOrganization_Call) Return(_a0 []*domain.InventoryItem, _a1 error) *SoftwareInventoryService_ReplaceForOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ReplaceForOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, domain.UserRole, []service.InventoryItemInput) ([]*domain.InventoryItem, error)) *SoftwareInventoryService_ReplaceForOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMine provides a mock function with given fields: ctx, userID, inputs
+func (_m *SoftwareInventoryService) ReplaceMine(ctx context.Context, userID uuid.UUID, inputs []service.InventoryItemInput) ([]*domain.InventoryItem, error) {
+	ret := _m.Called(ctx, userID, inputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMine")
+	}
+
+	var r0 []*domain.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []service.InventoryItemInput) ([]*domain.InventoryItem, error)); ok {
+		return rf(ctx, userID, inputs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []service.InventoryItemInput) []*domain.InventoryItem); ok {
+		r0 = rf(ctx, userID, inputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.InventoryItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []service.InventoryItemInput) error); ok {
+		r1 = rf(ctx, userID, inputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftwareInventoryService_ReplaceMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMine'
+type SoftwareInventoryService_ReplaceMine_Call struct {
+	*mock.Call
+}
+
+// ReplaceMine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - inputs []service.InventoryItemInput
+func (_e *SoftwareInventoryService_Expecter) ReplaceMine(ctx interface{}, userID interface{}, inputs interface{}) *SoftwareInventoryService_ReplaceMine_Call {
+	return &SoftwareInventoryService_ReplaceMine_Call{Call: _e.mock.On("ReplaceMine", ctx, userID, inputs)}
+}
+
+func (_c *SoftwareInventoryService_ReplaceMine_Call) Run(run func(ctx context.Context, userID uuid.UUID, inputs []service.InventoryItemInput)) *SoftwareInventoryService_ReplaceMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]service.InventoryItemInput))
+	})
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ReplaceMine_Call) Return(_a0 []*domain.InventoryItem, _a1 error) *SoftwareInventoryService_ReplaceMine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoftwareInventoryService_ReplaceMine_Call) RunAndReturn(run func(context.Context, uuid.UUID, []service.InventoryItemInput) ([]*domain.InventoryItem, error)) *SoftwareInventoryService_ReplaceMine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSoftwareInventoryService creates a new instance of SoftwareInventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSoftwareInventoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SoftwareInventoryService {
+	mock := &SoftwareInventoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,582 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// trackingPixel is a transparent 1x1 GIF served by the open-tracking endpoint
+var trackingPixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+// NewsletterHandler serves the admin newsletter builder, public sign-up and the tracking links in sent newsletters
+type NewsletterHandler struct {
+	newsletterService NewsletterService
+}
+
+// NewNewsletterHandler creates a new newsletter handler instance
+func NewNewsletterHandler(newsletterService NewsletterService) *NewsletterHandler {
+	if newsletterService == nil {
+		panic("newsletterService cannot be nil")
+	}
+
+	return &NewsletterHandler{
+		newsletterService: newsletterService,
+	}
+}
+
+// AddSubscriberRequest represents an opted-in newsletter subscriber
+type AddSubscriberRequest struct {
+	Email         string  `json:"email" validate:"required,email,max=255"`
+	Name          *string `json:"name,omitempty" validate:"omitempty,max=255"`
+	ConsentSource string  `json:"consent_source" validate:"required,max=100"` // how the subscriber opted in, e.g. signup-form
+}
+
+// SubscribeRequest represents a public newsletter sign-up
+type SubscribeRequest struct {
+	Email string  `json:"email" validate:"required,email,max=255"`
+	Name  *string `json:"name,omitempty" validate:"omitempty,max=255"`
+}
+
+// SubscriptionTokenRequest carries a token from a confirmation or unsubscribe link
+type SubscriptionTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// AddSuppressionRequest represents an address to suppress
+type AddSuppressionRequest struct {
+	Email   string                   `json:"email" validate:"required,email,max=255"`
+	Reason  domain.SuppressionReason `json:"reason,omitempty" validate:"omitempty,oneof=bounce complaint manual"` // defaults to manual
+	Details *string                  `json:"details,omitempty" validate:"omitempty,max=2000"`
+}
+
+// CampaignRequest represents the content of a newsletter campaign
+type CampaignRequest struct {
+	Subject    string      `json:"subject" validate:"required,max=200"`
+	Preheader  *string     `json:"preheader,omitempty" validate:"omitempty,max=200"`
+	Intro      *string     `json:"intro,omitempty" validate:"omitempty,max=5000"`
+	Template   string      `json:"template,omitempty"`
+	ArticleIDs []uuid.UUID `json:"article_ids" validate:"max=20,unique,dive,required"` // in the order they appear
+}
+
+// input converts the request to the service input
+func (req CampaignRequest) input() service.CampaignInput {
+	return service.CampaignInput{
+		Subject:    req.Subject,
+		Preheader:  req.Preheader,
+		Intro:      req.Intro,
+		Template:   req.Template,
+		ArticleIDs: req.ArticleIDs,
+	}
+}
+
+// ListSubscribers handles GET /v1/admin/newsletter/subscribers
+// Query params: status (pending, subscribed or unsubscribed), search, page, page_size
+func (h *NewsletterHandler) ListSubscribers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	filter := &domain.SubscriberFilter{Page: page, PageSize: pageSize}
+	if status := r.URL.Query().Get("status"); status != "" {
+		subscriberStatus := domain.SubscriberStatus(status)
+		filter.Status = &subscriberStatus
+	}
+	if search := strings.TrimSpace(r.URL.Query().Get("search")); search != "" {
+		filter.Search = &search
+	}
+
+	subscribers, total, err := h.newsletterService.ListSubscribers(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list newsletter subscribers")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, subscribers, meta)
+}
+
+// AddSubscriber handles POST /v1/admin/newsletter/subscribers
+func (h *NewsletterHandler) AddSubscriber(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req AddSubscriberRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	subscriber, err := h.newsletterService.AddSubscriber(ctx, req.Email, req.Name, req.ConsentSource)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to add newsletter subscriber")
+		return
+	}
+
+	response.Created(w, subscriber)
+}
+
+// RemoveSubscriber handles DELETE /v1/admin/newsletter/subscribers/{id}
+// The subscriber is unsubscribed rather than deleted, so the address is never mailed again
+func (h *NewsletterHandler) RemoveSubscriber(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "subscriber")
+	if !ok {
+		return
+	}
+
+	if err := h.newsletterService.Unsubscribe(ctx, id); err != nil {
+		h.handleError(w, err, requestID, "Failed to unsubscribe newsletter subscriber")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ExportSubscribers handles GET /v1/admin/newsletter/subscribers/export
+// Query params: status (pending, subscribed or unsubscribed; all subscribers when omitted)
+func (h *NewsletterHandler) ExportSubscribers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var status *domain.SubscriberStatus
+	if value := r.URL.Query().Get("status"); value != "" {
+		subscriberStatus := domain.SubscriberStatus(value)
+		status = &subscriberStatus
+	}
+
+	filename := fmt.Sprintf("newsletter-subscribers-%s.csv", time.Now().UTC().Format("20060102"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+
+	out := &trackingWriter{ResponseWriter: w}
+	if err := h.newsletterService.ExportSubscribersCSV(ctx, status, out); err != nil {
+		if out.written {
+			// The CSV is already partly sent, so the client sees a truncated file
+			log.Error().Err(err).Str("request_id", requestID).Msg("Failed to write newsletter subscriber export")
+			return
+		}
+
+		w.Header().Del("Content-Disposition")
+		h.handleError(w, err, requestID, "Failed to export newsletter subscribers")
+		return
+	}
+}
+
+// ListSuppressions handles GET /v1/admin/newsletter/suppressions
+// Query params: page, page_size
+func (h *NewsletterHandler) ListSuppressions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	suppressions, total, err := h.newsletterService.ListSuppressions(ctx, pageSize, (page-1)*pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list newsletter suppressions")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, suppressions, meta)
+}
+
+// AddSuppression handles POST /v1/admin/newsletter/suppressions
+func (h *NewsletterHandler) AddSuppression(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req AddSuppressionRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	suppression, err := h.newsletterService.AddSuppression(ctx, claims.UserID, req.Email, req.Reason, req.Details)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to add newsletter suppression")
+		return
+	}
+
+	response.Created(w, suppression)
+}
+
+// RemoveSuppression handles DELETE /v1/admin/newsletter/suppressions/{id}
+func (h *NewsletterHandler) RemoveSuppression(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "suppression")
+	if !ok {
+		return
+	}
+
+	if err := h.newsletterService.RemoveSuppression(ctx, id); err != nil {
+		h.handleError(w, err, requestID, "Failed to remove newsletter suppression")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListTemplates handles GET /v1/admin/newsletter/templates
+func (h *NewsletterHandler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.newsletterService.Templates())
+}
+
+// ListCampaigns handles GET /v1/admin/newsletter/campaigns
+// Query params: page, page_size
+func (h *NewsletterHandler) ListCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	campaigns, total, err := h.newsletterService.ListCampaigns(ctx, pageSize, (page-1)*pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list newsletter campaigns")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, campaigns, meta)
+}
+
+// CreateCampaign handles POST /v1/admin/newsletter/campaigns
+func (h *NewsletterHandler) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req CampaignRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	campaign, err := h.newsletterService.CreateCampaign(ctx, claims.UserID, req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create newsletter campaign")
+		return
+	}
+
+	response.Created(w, campaign)
+}
+
+// GetCampaign handles GET /v1/admin/newsletter/campaigns/{id}
+func (h *NewsletterHandler) GetCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "campaign")
+	if !ok {
+		return
+	}
+
+	campaign, err := h.newsletterService.GetCampaign(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get newsletter campaign")
+		return
+	}
+
+	response.Success(w, campaign)
+}
+
+// UpdateCampaign handles PUT /v1/admin/newsletter/campaigns/{id}
+// The request replaces the draft's content, including the order of its articles
+func (h *NewsletterHandler) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "campaign")
+	if !ok {
+		return
+	}
+
+	var req CampaignRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	campaign, err := h.newsletterService.UpdateCampaign(ctx, id, req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update newsletter campaign")
+		return
+	}
+
+	response.Success(w, campaign)
+}
+
+// DeleteCampaign handles DELETE /v1/admin/newsletter/campaigns/{id}
+func (h *NewsletterHandler) DeleteCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "campaign")
+	if !ok {
+		return
+	}
+
+	if err := h.newsletterService.DeleteCampaign(ctx, id); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete newsletter campaign")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// PreviewCampaign handles GET /v1/admin/newsletter/campaigns/{id}/preview
+func (h *NewsletterHandler) PreviewCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseNewsletterID(w, r, "campaign")
+	if !ok {
+		return
+	}
+
+	preview, err := h.newsletterService.Preview(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to preview newsletter campaign")
+		return
+	}
+
+	response.Success(w, preview)
+}
+
+// SendCampaign handles POST /v1/admin/newsletter/campaigns/{id}/send
+// The campaign is queued and sent in the background; poll the campaign for its stats
+func (h *NewsletterHandler) SendCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	id, ok := parseNewsletterID(w, r, "campaign")
+	if !ok {
+		return
+	}
+
+	campaign, err := h.newsletterService.Send(ctx, id, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to send newsletter campaign")
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, response.Response{Data: campaign})
+}
+
+// TrackOpen handles GET /n/o/{delivery} - records an open and serves a transparent pixel
+// The pixel is served even when the open cannot be recorded, so mail clients never show a broken image
+func (h *NewsletterHandler) TrackOpen(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if deliveryID, err := uuid.Parse(chi.URLParam(r, "delivery")); err == nil {
+		if err := h.newsletterService.TrackOpen(ctx, deliveryID); err != nil {
+			var notFoundErr *domainerrors.NotFoundError
+			if !errors.As(err, &notFoundErr) {
+				log.Error().
+					Err(err).
+					Str("request_id", getRequestID(ctx)).
+					Msg("Failed to record newsletter open")
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "image/gif")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(trackingPixel)
+}
+
+// TrackClick handles GET /n/c/{delivery}/{article} - records the click and redirects to the article
+func (h *NewsletterHandler) TrackClick(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	deliveryID, err := uuid.Parse(chi.URLParam(r, "delivery"))
+	if err != nil {
+		response.NotFoundResource(w, "newsletter link", "Newsletter link not found")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "article"))
+	if err != nil {
+		response.NotFoundResource(w, "newsletter link", "Newsletter link not found")
+		return
+	}
+
+	target, err := h.newsletterService.TrackClick(ctx, deliveryID, articleID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to follow newsletter link")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+// Unsubscribe handles GET and POST /n/u/{delivery}
+// POST is the one-click unsubscribe of RFC 8058, sent by mail clients from the List-Unsubscribe header
+func (h *NewsletterHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	deliveryID, err := uuid.Parse(chi.URLParam(r, "delivery"))
+	if err != nil {
+		response.NotFoundResource(w, "newsletter link", "Newsletter link not found")
+		return
+	}
+
+	if err := h.newsletterService.UnsubscribeByDelivery(ctx, deliveryID); err != nil {
+		h.handleError(w, err, requestID, "Failed to unsubscribe")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("You have been unsubscribed and will not receive further newsletters.\n"))
+}
+
+// Subscribe handles POST /v1/newsletter/subscribe
+// Always 202 for a valid address, so the response does not reveal whether it is already subscribed
+func (h *NewsletterHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req SubscribeRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if err := h.newsletterService.Subscribe(ctx, req.Email, req.Name); err != nil {
+		h.handleError(w, err, requestID, "Failed to subscribe to newsletter")
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, response.Response{
+		Data: map[string]string{"message": "Check your inbox to confirm your subscription"},
+	})
+}
+
+// ConfirmSubscription handles POST /v1/newsletter/confirm
+func (h *NewsletterHandler) ConfirmSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req SubscriptionTokenRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if err := h.newsletterService.Confirm(ctx, req.Token); err != nil {
+		h.handleError(w, err, requestID, "Failed to confirm newsletter subscription")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// UnsubscribeByToken handles POST /v1/newsletter/unsubscribe
+func (h *NewsletterHandler) UnsubscribeByToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req SubscriptionTokenRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if err := h.newsletterService.UnsubscribeByToken(ctx, req.Token); err != nil {
+		h.handleError(w, err, requestID, "Failed to unsubscribe")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *NewsletterHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	if writeValidationError(w, err, requestID) {
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, service.ErrSubscriptionTokenExpired) {
+		response.Error(w, http.StatusGone, "LINK_EXPIRED", "This confirmation link has expired; please sign up again")
+		return
+	}
+
+	if errors.Is(err, service.ErrCampaignNotDraft) {
+		response.Error(w, http.StatusConflict, "CAMPAIGN_ALREADY_SENT", "The campaign has already been sent; only drafts can be changed or sent")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseNewsletterID extracts the ID URL parameter of a subscriber, suppression or campaign, writing a 400 on failure
+func parseNewsletterID(w http.ResponseWriter, r *http.Request, resource string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid "+resource+" ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
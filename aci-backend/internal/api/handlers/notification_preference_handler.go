@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// NotificationPreferenceHandler handles the current user's notification preferences
+type NotificationPreferenceHandler struct {
+	preferenceService NotificationPreferenceService
+}
+
+// NewNotificationPreferenceHandler creates a new notification preference handler instance
+func NewNotificationPreferenceHandler(preferenceService NotificationPreferenceService) *NotificationPreferenceHandler {
+	if preferenceService == nil {
+		panic("preferenceService cannot be nil")
+	}
+
+	return &NotificationPreferenceHandler{
+		preferenceService: preferenceService,
+	}
+}
+
+// UpdateNotificationPreferencesRequest represents a notification preference update request
+type UpdateNotificationPreferencesRequest struct {
+	Channels        map[domain.NotificationChannel]domain.ChannelPreference `json:"channels"`
+	DigestFrequency domain.DigestFrequency                                  `json:"digest_frequency"`
+}
+
+// GetMine handles GET /v1/users/me/notifications
+func (h *NotificationPreferenceHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		log.Error().
+			Str("request_id", requestID).
+			Msg("User claims not found in context")
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	prefs, err := h.preferenceService.Get(ctx, claims.UserID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to get notification preferences")
+		response.InternalError(w, "Failed to retrieve notification preferences", requestID)
+		return
+	}
+
+	response.Success(w, prefs)
+}
+
+// UpdateMine handles PUT /v1/users/me/notifications
+// Channels omitted from the request keep their default settings
+func (h *NotificationPreferenceHandler) UpdateMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		log.Error().
+			Str("request_id", requestID).
+			Msg("User claims not found in context")
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to decode request body")
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	prefs, err := h.preferenceService.Update(ctx, &domain.NotificationPreferences{
+		UserID:          claims.UserID,
+		Channels:        req.Channels,
+		DigestFrequency: req.DigestFrequency,
+	})
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Invalid notification preferences", validationErr.Message, requestID)
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to update notification preferences")
+		response.InternalError(w, "Failed to update notification preferences", requestID)
+		return
+	}
+
+	log.Info().
+		Str("request_id", requestID).
+		Str("user_id", claims.UserID.String()).
+		Str("digest_frequency", string(prefs.DigestFrequency)).
+		Msg("Notification preferences updated")
+
+	response.Success(w, prefs)
+}
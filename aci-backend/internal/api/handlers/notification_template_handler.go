@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// NotificationTemplateHandler handles admin notification template HTTP requests
+type NotificationTemplateHandler struct {
+	templateService NotificationTemplateService
+}
+
+// NewNotificationTemplateHandler creates a new notification template handler instance
+func NewNotificationTemplateHandler(templateService NotificationTemplateService) *NotificationTemplateHandler {
+	if templateService == nil {
+		panic("templateService cannot be nil")
+	}
+
+	return &NotificationTemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// CreateNotificationTemplateRequest represents the request body for creating a template
+type CreateNotificationTemplateRequest struct {
+	Key       string   `json:"key"`
+	Channel   string   `json:"channel"`
+	Locale    string   `json:"locale,omitempty"`
+	Subject   *string  `json:"subject,omitempty"`
+	Body      string   `json:"body"`
+	Variables []string `json:"variables"`
+}
+
+// UpdateNotificationTemplateRequest represents the request body for a new template version
+type UpdateNotificationTemplateRequest struct {
+	Subject   *string  `json:"subject,omitempty"`
+	Body      string   `json:"body"`
+	Variables []string `json:"variables"`
+}
+
+// PreviewNotificationTemplateRequest represents the request body for previewing a template
+// Template fields are ignored when previewing a stored version
+type PreviewNotificationTemplateRequest struct {
+	CreateNotificationTemplateRequest
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// toInput converts the request to service input
+func (r *CreateNotificationTemplateRequest) toInput() service.NotificationTemplateInput {
+	return service.NotificationTemplateInput{
+		Key:       r.Key,
+		Channel:   domain.NotificationChannel(r.Channel),
+		Locale:    r.Locale,
+		Subject:   r.Subject,
+		Body:      r.Body,
+		Variables: r.Variables,
+	}
+}
+
+// List handles GET /v1/admin/notification-templates
+// Query params: key, channel, locale, active_only
+func (h *NotificationTemplateHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	params := r.URL.Query()
+	filter := &domain.NotificationTemplateFilter{
+		ActiveOnly: params.Get("active_only") == "true",
+	}
+
+	if key := params.Get("key"); key != "" {
+		filter.Key = &key
+	}
+
+	if channelStr := params.Get("channel"); channelStr != "" {
+		channel := domain.NotificationChannel(channelStr)
+		if !channel.IsValid() {
+			response.BadRequest(w, "Invalid channel: must be email, slack, push, or in_app")
+			return
+		}
+		filter.Channel = &channel
+	}
+
+	if locale := params.Get("locale"); locale != "" {
+		filter.Locale = &locale
+	}
+
+	templates, err := h.templateService.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list notification templates")
+		response.InternalError(w, "Failed to retrieve notification templates", requestID)
+		return
+	}
+
+	response.Success(w, templates)
+}
+
+// Create handles POST /v1/admin/notification-templates - creates a new active template version
+func (h *NotificationTemplateHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req CreateNotificationTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	tmpl, err := h.templateService.Create(ctx, req.toInput(), templateEditor(r))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create notification template")
+		return
+	}
+
+	response.Created(w, tmpl)
+}
+
+// GetByID handles GET /v1/admin/notification-templates/{id}
+func (h *NotificationTemplateHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	tmpl, err := h.templateService.GetByID(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get notification template")
+		return
+	}
+
+	response.Success(w, tmpl)
+}
+
+// Update handles PUT /v1/admin/notification-templates/{id} - creates and activates a new version
+func (h *NotificationTemplateHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateNotificationTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	tmpl, err := h.templateService.Update(ctx, id, req.Subject, req.Body, req.Variables, templateEditor(r))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update notification template")
+		return
+	}
+
+	response.Success(w, tmpl)
+}
+
+// ListVersions handles GET /v1/admin/notification-templates/{id}/versions
+func (h *NotificationTemplateHandler) ListVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	versions, err := h.templateService.ListVersions(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list notification template versions")
+		return
+	}
+
+	response.Success(w, versions)
+}
+
+// Activate handles POST /v1/admin/notification-templates/{id}/activate - rolls to the given version
+func (h *NotificationTemplateHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	tmpl, err := h.templateService.Activate(ctx, id)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to activate notification template")
+		return
+	}
+
+	response.Success(w, tmpl)
+}
+
+// Preview handles POST /v1/admin/notification-templates/preview - renders an unsaved template
+func (h *NotificationTemplateHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req PreviewNotificationTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	rendered, err := h.templateService.Preview(req.toInput(), req.Data)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to preview notification template")
+		return
+	}
+
+	response.Success(w, rendered)
+}
+
+// PreviewVersion handles POST /v1/admin/notification-templates/{id}/preview - renders a stored version
+func (h *NotificationTemplateHandler) PreviewVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	id, ok := parseTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	var req PreviewNotificationTemplateRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.BadRequest(w, "Invalid request body")
+			return
+		}
+	}
+
+	rendered, err := h.templateService.PreviewVersion(ctx, id, req.Data)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to preview notification template")
+		return
+	}
+
+	response.Success(w, rendered)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *NotificationTemplateHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, "notification template", "Notification template not found")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseTemplateID extracts the template ID URL parameter, writing a 400 on failure
+func parseTemplateID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid template ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// templateEditor returns the authenticated admin's ID, if any
+func templateEditor(r *http.Request) *uuid.UUID {
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return &claims.UserID
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// nvdSyncWriteTimeout bounds a manual sync, which looks up CVEs one at a time, paced to the NVD rate limit
+const nvdSyncWriteTimeout = 30 * time.Minute
+
+// NVDHandler exposes the local copy of NVD affected products and its sync
+type NVDHandler struct {
+	nvdService NVDService
+}
+
+// NewNVDHandler creates a new NVD handler instance
+func NewNVDHandler(nvdService NVDService) *NVDHandler {
+	if nvdService == nil {
+		panic("nvdService cannot be nil")
+	}
+
+	return &NVDHandler{
+		nvdService: nvdService,
+	}
+}
+
+// GetStatus handles GET /v1/admin/nvd
+func (h *NVDHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	status, err := h.nvdService.Status(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to get NVD status")
+		response.InternalError(w, "Failed to retrieve NVD status", requestID)
+		return
+	}
+
+	response.Success(w, status)
+}
+
+// Sync handles POST /v1/admin/nvd/sync - looks up the CVEs due now instead of waiting for the schedule
+func (h *NVDHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	// A paced sync can outlast the server write timeout
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(nvdSyncWriteTimeout)); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to extend write deadline for NVD sync")
+	}
+
+	result, err := h.nvdService.Sync(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to sync NVD affected products")
+		response.ServiceUnavailable(w, "Failed to sync NVD affected products")
+		return
+	}
+
+	response.Success(w, result)
+}
@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// OrgCategoryHandler serves an organization's private categories: its members browse them and
+// the articles carrying them, its admins define them and apply them by hand
+type OrgCategoryHandler struct {
+	orgCategoryService OrgCategoryService
+}
+
+// NewOrgCategoryHandler creates a new organization category handler instance
+func NewOrgCategoryHandler(orgCategoryService OrgCategoryService) *OrgCategoryHandler {
+	if orgCategoryService == nil {
+		panic("orgCategoryService cannot be nil")
+	}
+
+	return &OrgCategoryHandler{
+		orgCategoryService: orgCategoryService,
+	}
+}
+
+// OrgCategoryRequest is the body of an organization category create or update
+type OrgCategoryRequest struct {
+	Name        string                   `json:"name"`
+	Slug        string                   `json:"slug"`
+	Description *string                  `json:"description"`
+	Color       string                   `json:"color"`
+	Rules       []domain.OrgCategoryRule `json:"rules"`
+}
+
+// input converts the request for the service
+func (req *OrgCategoryRequest) input() service.OrgCategoryInput {
+	return service.OrgCategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		Color:       req.Color,
+		Rules:       req.Rules,
+	}
+}
+
+// List handles GET /v1/orgs/{id}/categories
+func (h *OrgCategoryHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	categories, err := h.orgCategoryService.List(ctx, orgID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organization categories")
+		return
+	}
+
+	response.Success(w, categories)
+}
+
+// Create handles POST /v1/orgs/{id}/categories
+func (h *OrgCategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrgCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	category, err := h.orgCategoryService.Create(ctx, orgID, claims.UserID, domain.UserRole(claims.Role), req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create organization category")
+		return
+	}
+
+	response.Created(w, category)
+}
+
+// Get handles GET /v1/orgs/{id}/categories/{categoryID}
+func (h *OrgCategoryHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, ok := parseOrgCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	category, err := h.orgCategoryService.Get(ctx, orgID, categoryID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization category")
+		return
+	}
+
+	response.Success(w, category)
+}
+
+// Update handles PUT /v1/orgs/{id}/categories/{categoryID}
+// The request replaces the category's definition; articles already labeled keep the category
+func (h *OrgCategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, ok := parseOrgCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrgCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	category, err := h.orgCategoryService.Update(ctx, orgID, categoryID, claims.UserID, domain.UserRole(claims.Role), req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update organization category")
+		return
+	}
+
+	response.Success(w, category)
+}
+
+// Delete handles DELETE /v1/orgs/{id}/categories/{categoryID}
+func (h *OrgCategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, ok := parseOrgCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgCategoryService.Delete(ctx, orgID, categoryID, claims.UserID, domain.UserRole(claims.Role)); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete organization category")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListArticles handles GET /v1/orgs/{id}/categories/{categoryID}/articles
+// Accepts the same filters as the article list
+func (h *OrgCategoryHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, ok := parseOrgCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	filter, err := parseArticleFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", err.Error(), requestID)
+		return
+	}
+
+	articles, total, err := h.orgCategoryService.Articles(ctx, orgID, categoryID, claims.UserID, domain.UserRole(claims.Role), filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organization category articles")
+		return
+	}
+
+	items := make([]ArticleResponse, len(articles))
+	for i, article := range articles {
+		items[i] = toArticleResponse(article)
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, items, meta)
+}
+
+// ArticleCategories handles GET /v1/orgs/{id}/articles/{articleID}/categories
+func (h *OrgCategoryHandler) ArticleCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	assignments, err := h.orgCategoryService.ArticleCategories(ctx, orgID, articleID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list article organization categories")
+		return
+	}
+
+	response.Success(w, assignments)
+}
+
+// AddArticle handles PUT /v1/orgs/{id}/categories/{categoryID}/articles/{articleID}
+func (h *OrgCategoryHandler) AddArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, articleID, ok := parseOrgCategoryArticleID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgCategoryService.AddArticle(ctx, orgID, categoryID, articleID, claims.UserID, domain.UserRole(claims.Role)); err != nil {
+		h.handleError(w, err, requestID, "Failed to apply organization category")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// RemoveArticle handles DELETE /v1/orgs/{id}/categories/{categoryID}/articles/{articleID}
+func (h *OrgCategoryHandler) RemoveArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, categoryID, articleID, ok := parseOrgCategoryArticleID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgCategoryService.RemoveArticle(ctx, orgID, categoryID, articleID, claims.UserID, domain.UserRole(claims.Role)); err != nil {
+		h.handleError(w, err, requestID, "Failed to remove organization category")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// parseOrgCategoryID extracts the organization and category ID URL parameters, writing a 400 on failure
+func parseOrgCategoryID(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	categoryID, err := uuid.Parse(chi.URLParam(r, "categoryID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid category ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return orgID, categoryID, true
+}
+
+// parseOrgCategoryArticleID extracts the organization, category and article ID URL parameters,
+// writing a 400 on failure
+func parseOrgCategoryArticleID(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	orgID, categoryID, ok := parseOrgCategoryID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "articleID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	return orgID, categoryID, articleID, true
+}
+
+// handleError maps service errors to HTTP responses
+func (h *OrgCategoryHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var limitErr *domainerrors.LimitExceededError
+	if errors.As(err, &limitErr) {
+		response.LimitExceeded(w, limitErr.Resource, limitErr.Error())
+		return
+	}
+
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only members of the organization can see its categories, and only its admins can change them")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
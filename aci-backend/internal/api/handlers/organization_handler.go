@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// OrganizationHandler handles organization management and membership
+type OrganizationHandler struct {
+	orgService OrganizationService
+}
+
+// NewOrganizationHandler creates a new organization handler instance
+func NewOrganizationHandler(orgService OrganizationService) *OrganizationHandler {
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &OrganizationHandler{
+		orgService: orgService,
+	}
+}
+
+// CreateOrganizationRequest represents an organization creation request
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// SetMemberRequest represents a membership change request
+type SetMemberRequest struct {
+	Role domain.OrganizationRole `json:"role"`
+}
+
+// OrganizationDetailResponse is an organization with its members
+type OrganizationDetailResponse struct {
+	*domain.Organization
+	Members []*domain.OrganizationMember `json:"members"`
+	Role    domain.OrganizationRole      `json:"role,omitempty"` // the requesting user's role
+}
+
+// GetMine handles GET /v1/organization - returns the current user's organization
+func (h *OrganizationHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	member, ok := h.currentMembership(w, r)
+	if !ok {
+		return
+	}
+
+	detail, err := h.detail(r, member.OrganizationID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization")
+		return
+	}
+	detail.Role = member.Role
+
+	response.Success(w, detail)
+}
+
+// GetMyStats handles GET /v1/organization/stats - engagement rollup for the current user's organization
+func (h *OrganizationHandler) GetMyStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	member, ok := h.currentMembership(w, r)
+	if !ok {
+		return
+	}
+
+	stats, err := h.orgService.Stats(ctx, member.OrganizationID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization stats")
+		return
+	}
+
+	response.Success(w, stats)
+}
+
+// List handles GET /v1/admin/organizations
+func (h *OrganizationHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgs, err := h.orgService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organizations")
+		return
+	}
+
+	response.Success(w, orgs)
+}
+
+// Create handles POST /v1/admin/organizations
+func (h *OrganizationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	org, err := h.orgService.Create(ctx, req.Name)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create organization")
+		return
+	}
+
+	response.Created(w, org)
+}
+
+// Get handles GET /v1/admin/organizations/{id}
+func (h *OrganizationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	detail, err := h.detail(r, orgID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization")
+		return
+	}
+
+	response.Success(w, detail)
+}
+
+// Delete handles DELETE /v1/admin/organizations/{id}
+func (h *OrganizationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgService.Delete(ctx, orgID); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete organization")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// GetStats handles GET /v1/admin/organizations/{id}/stats
+func (h *OrganizationHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	stats, err := h.orgService.Stats(ctx, orgID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization stats")
+		return
+	}
+
+	response.Success(w, stats)
+}
+
+// SetMember handles PUT /v1/admin/organizations/{id}/members/{userID}
+// Adds the user to the organization or changes their role (default member)
+func (h *OrganizationHandler) SetMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid user ID format")
+		return
+	}
+
+	var req SetMemberRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.BadRequest(w, "Invalid request body")
+			return
+		}
+	}
+
+	member, err := h.orgService.SetMember(ctx, orgID, userID, req.Role)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to set organization member")
+		return
+	}
+
+	response.Success(w, member)
+}
+
+// RemoveMember handles DELETE /v1/admin/organizations/{id}/members/{userID}
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid user ID format")
+		return
+	}
+
+	if err := h.orgService.RemoveMember(ctx, orgID, userID); err != nil {
+		h.handleError(w, err, requestID, "Failed to remove organization member")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// detail loads an organization with its members
+func (h *OrganizationHandler) detail(r *http.Request, orgID uuid.UUID) (*OrganizationDetailResponse, error) {
+	org, err := h.orgService.Get(r.Context(), orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.orgService.Members(r.Context(), orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrganizationDetailResponse{Organization: org, Members: members}, nil
+}
+
+// currentMembership returns the authenticated user's membership, writing a response when there is none
+func (h *OrganizationHandler) currentMembership(w http.ResponseWriter, r *http.Request) (*domain.OrganizationMember, bool) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return nil, false
+	}
+
+	member, err := h.orgService.MembershipOf(ctx, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization membership")
+		return nil, false
+	}
+
+	if member == nil {
+		response.NotFoundResource(w, "organization", "User does not belong to an organization")
+		return nil, false
+	}
+
+	return member, true
+}
+
+// handleError maps service errors to HTTP responses
+func (h *OrganizationHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
+
+// parseOrganizationID extracts the organization ID URL parameter, writing a 400 on failure
+func parseOrganizationID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid organization ID format")
+		return uuid.Nil, false
+	}
+	return id, true
+}
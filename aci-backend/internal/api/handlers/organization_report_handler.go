@@ -0,0 +1,400 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// OrganizationReportHandler lets organization admins define scheduled reports, re-run them and
+// download their history
+type OrganizationReportHandler struct {
+	orgReportService OrganizationReportService
+}
+
+// NewOrganizationReportHandler creates a new organization report handler instance
+func NewOrganizationReportHandler(orgReportService OrganizationReportService) *OrganizationReportHandler {
+	if orgReportService == nil {
+		panic("orgReportService cannot be nil")
+	}
+
+	return &OrganizationReportHandler{
+		orgReportService: orgReportService,
+	}
+}
+
+// OrganizationReportRequest is the body of a report template create or update
+type OrganizationReportRequest struct {
+	Name       string                 `json:"name"`
+	Filters    domain.ReportFilter    `json:"filters"`
+	Sections   []domain.ReportSection `json:"sections"`
+	Recipients []string               `json:"recipients"`
+	Cadence    domain.ReportCadence   `json:"cadence"`
+	Enabled    *bool                  `json:"enabled"` // defaults to true
+}
+
+// input converts the request for the service
+func (req *OrganizationReportRequest) input() service.OrganizationReportInput {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	return service.OrganizationReportInput{
+		Name:       req.Name,
+		Filter:     req.Filters,
+		Sections:   req.Sections,
+		Recipients: req.Recipients,
+		Cadence:    req.Cadence,
+		Enabled:    enabled,
+	}
+}
+
+// ReportRunRequest is the body of a report re-run
+type ReportRunRequest struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Deliver bool   `json:"deliver"`
+}
+
+// List handles GET /v1/orgs/{id}/reports
+func (h *OrganizationReportHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	reports, err := h.orgReportService.List(ctx, orgID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organization reports")
+		return
+	}
+
+	response.Success(w, reports)
+}
+
+// Create handles POST /v1/orgs/{id}/reports
+func (h *OrganizationReportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrganizationReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	report, err := h.orgReportService.Create(ctx, orgID, claims.UserID, domain.UserRole(claims.Role), req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create organization report")
+		return
+	}
+
+	response.Created(w, report)
+}
+
+// Get handles GET /v1/orgs/{id}/reports/{reportID}
+func (h *OrganizationReportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.orgReportService.Get(ctx, orgID, reportID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization report")
+		return
+	}
+
+	response.Success(w, report)
+}
+
+// Update handles PUT /v1/orgs/{id}/reports/{reportID}
+// The request replaces the template's definition
+func (h *OrganizationReportHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrganizationReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	report, err := h.orgReportService.Update(ctx, orgID, reportID, claims.UserID, domain.UserRole(claims.Role), req.input())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update organization report")
+		return
+	}
+
+	response.Success(w, report)
+}
+
+// Delete handles DELETE /v1/orgs/{id}/reports/{reportID}
+func (h *OrganizationReportHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgReportService.Delete(ctx, orgID, reportID, claims.UserID, domain.UserRole(claims.Role)); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete organization report")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListRuns handles GET /v1/orgs/{id}/reports/{reportID}/runs
+// Query params: page, page_size
+func (h *OrganizationReportHandler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	runs, total, err := h.orgReportService.ListRuns(ctx, orgID, reportID, claims.UserID, domain.UserRole(claims.Role), pageSize, (page-1)*pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organization report runs")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, runs, meta)
+}
+
+// Rerun handles POST /v1/orgs/{id}/reports/{reportID}/runs
+// from and to are dates (YYYY-MM-DD, to inclusive) or RFC 3339 timestamps (to exclusive)
+func (h *OrganizationReportHandler) Rerun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReportRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	from, err := parseReportTime(req.From, false)
+	if err != nil {
+		response.BadRequest(w, "Invalid from: "+err.Error())
+		return
+	}
+
+	to, err := parseReportTime(req.To, true)
+	if err != nil {
+		response.BadRequest(w, "Invalid to: "+err.Error())
+		return
+	}
+
+	run, err := h.orgReportService.Rerun(ctx, orgID, reportID, claims.UserID, domain.UserRole(claims.Role), from, to, req.Deliver)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to run organization report")
+		return
+	}
+
+	response.Created(w, run)
+}
+
+// Download handles GET /v1/orgs/{id}/reports/{reportID}/runs/{runID}/pdf
+func (h *OrganizationReportHandler) Download(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, reportID, ok := parseOrgReportID(w, r)
+	if !ok {
+		return
+	}
+
+	runID, err := uuid.Parse(chi.URLParam(r, "runID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid run ID format")
+		return
+	}
+
+	filename, document, err := h.orgReportService.RunDocument(ctx, orgID, reportID, runID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get organization report document")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(document)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(document); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to write organization report document")
+	}
+}
+
+// parseOrgID extracts the organization ID URL parameter, writing a 400 on failure
+func parseOrgID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	orgID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid organization ID format")
+		return uuid.Nil, false
+	}
+	return orgID, true
+}
+
+// parseOrgReportID extracts the organization and report ID URL parameters, writing a 400 on failure
+func parseOrgReportID(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	reportID, err := uuid.Parse(chi.URLParam(r, "reportID"))
+	if err != nil {
+		response.BadRequest(w, "Invalid report ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return orgID, reportID, true
+}
+
+// parseReportTime parses a re-run bound: a date, which for the end of the range includes the
+// whole day, or an RFC 3339 timestamp
+func parseReportTime(value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("is required")
+	}
+
+	if day, err := time.Parse("2006-01-02", value); err == nil {
+		if end {
+			return day.AddDate(0, 0, 1), nil
+		}
+		return day, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be a date (YYYY-MM-DD) or an RFC 3339 timestamp")
+	}
+
+	return t, nil
+}
+
+// handleError maps service errors to HTTP responses
+func (h *OrganizationReportHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only an admin of the organization can manage its reports")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// PublicAPIKeyHandler handles administration of public API keys
+type PublicAPIKeyHandler struct {
+	keyService PublicAPIKeyService
+}
+
+// NewPublicAPIKeyHandler creates a new public API key handler instance
+func NewPublicAPIKeyHandler(keyService PublicAPIKeyService) *PublicAPIKeyHandler {
+	if keyService == nil {
+		panic("keyService cannot be nil")
+	}
+
+	return &PublicAPIKeyHandler{
+		keyService: keyService,
+	}
+}
+
+// CreatePublicAPIKeyRequest represents a request to issue a public API key
+type CreatePublicAPIKeyRequest struct {
+	Name              string `json:"name"`
+	RequestsPerMinute int    `json:"requests_per_minute,omitempty"` // defaults to PUBLIC_API_KEY_REQUESTS_PER_MINUTE
+}
+
+// CreatePublicAPIKeyResponse returns a new key with its plaintext value, which is only shown once
+type CreatePublicAPIKeyResponse struct {
+	*domain.PublicAPIKey
+	Key string `json:"key"`
+}
+
+// List handles GET /v1/admin/public-api-keys
+func (h *PublicAPIKeyHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	keys, err := h.keyService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list public API keys")
+		return
+	}
+
+	response.Success(w, keys)
+}
+
+// Create handles POST /v1/admin/public-api-keys
+func (h *PublicAPIKeyHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req CreatePublicAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	key, plaintext, err := h.keyService.Create(ctx, req.Name, req.RequestsPerMinute, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create public API key")
+		return
+	}
+
+	response.Created(w, CreatePublicAPIKeyResponse{
+		PublicAPIKey: key,
+		Key:          plaintext,
+	})
+}
+
+// Revoke handles DELETE /v1/admin/public-api-keys/{id}
+func (h *PublicAPIKeyHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	keyID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid key ID format")
+		return
+	}
+
+	key, err := h.keyService.Revoke(ctx, keyID, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to revoke public API key")
+		return
+	}
+
+	response.Success(w, key)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *PublicAPIKeyHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// MaxPublicPageSize caps page_size on the public article list
+const MaxPublicPageSize = 50
+
+// PublicHandler serves the read-only public API for the marketing site
+// Responses leave out internal fields such as relevance and competitor scores, view counts and IOCs
+type PublicHandler struct {
+	articleRepo repository.ArticleRepository
+	middleware  []func(http.Handler) http.Handler
+}
+
+// PublicAPIOptions configures access to the public API
+type PublicAPIOptions struct {
+	AnonymousRequestsPerMinute int           // per IP address; 0 requires an API key
+	CacheTTL                   time.Duration // 0 disables response caching
+}
+
+// NewPublicHandler creates a new public API handler instance
+func NewPublicHandler(
+	articleRepo repository.ArticleRepository,
+	keyService middleware.PublicAPIKeyAuthenticator,
+	opts PublicAPIOptions,
+) *PublicHandler {
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if keyService == nil {
+		panic("keyService cannot be nil")
+	}
+
+	return &PublicHandler{
+		articleRepo: articleRepo,
+		middleware: []func(http.Handler) http.Handler{
+			middleware.PublicAPIKey(keyService, opts.AnonymousRequestsPerMinute > 0),
+			middleware.PublicRateLimiter(opts.AnonymousRequestsPerMinute),
+			middleware.ResponseCache(opts.CacheTTL),
+		},
+	}
+}
+
+// Middleware returns the API key, rate limit and caching middleware for the public routes, in order
+func (h *PublicHandler) Middleware() []func(http.Handler) http.Handler {
+	return h.middleware
+}
+
+// PublicArticleResponse represents a published article in the public list view
+type PublicArticleResponse struct {
+	ID                 uuid.UUID             `json:"id"`
+	Title              string                `json:"title"`
+	Slug               string                `json:"slug"`
+	Summary            *string               `json:"summary,omitempty"`
+	Category           *CategorySummary      `json:"category,omitempty"`
+	Source             *SourceSummary        `json:"source,omitempty"`
+	SourceURL          string                `json:"source_url"`
+	Severity           string                `json:"severity"`
+	Tags               []string              `json:"tags"`
+	CVEs               []string              `json:"cves"`
+	Vendors            []string              `json:"vendors"`
+	ReadingTimeMinutes int                   `json:"reading_time_minutes"`
+	WordCount          int                   `json:"word_count"`
+	Language           *string               `json:"language,omitempty"`
+	PublishedAt        string                `json:"published_at"`
+	Image              *ArticleImageResponse `json:"image,omitempty"`
+}
+
+// PublicArticleDetailResponse represents a published article in the public detail view
+type PublicArticleDetailResponse struct {
+	PublicArticleResponse
+	Content            string                     `json:"content"`
+	ExternalLinks      []string                   `json:"external_links,omitempty"`
+	ThreatType         *string                    `json:"threat_type,omitempty"`
+	AttackVector       *string                    `json:"attack_vector,omitempty"`
+	ImpactAssessment   *string                    `json:"impact_assessment,omitempty"`
+	RecommendedActions []string                   `json:"recommended_actions,omitempty"`
+	ExternalReferences []domain.ExternalReference `json:"external_references,omitempty"`
+	ArmorCTA           *domain.ArmorCTA           `json:"armor_cta,omitempty"`
+}
+
+// ListArticles handles GET /v1/public/articles - returns published articles
+// Accepts the article list filters; page_size is capped at MaxPublicPageSize
+func (h *PublicHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	filter, err := parseArticleFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	filter.PublishedOnly = true
+	filter.ExcludeDuplicates = true
+	if filter.PageSize > MaxPublicPageSize {
+		filter.PageSize = MaxPublicPageSize
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", err.Error(), requestID)
+		return
+	}
+
+	articles, total, err := h.articleRepo.List(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list public articles")
+		response.InternalError(w, "Failed to retrieve articles", requestID)
+		return
+	}
+
+	items := make([]PublicArticleResponse, len(articles))
+	for i, article := range articles {
+		items[i] = toPublicArticleResponse(article)
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, items, meta)
+}
+
+// GetArticle handles GET /v1/public/articles/{idOrSlug} - returns a published article by ID or slug
+func (h *PublicHandler) GetArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	idOrSlug := chi.URLParam(r, "idOrSlug")
+	if idOrSlug == "" {
+		response.BadRequest(w, "Article ID or slug is required")
+		return
+	}
+
+	var (
+		article *domain.Article
+		err     error
+	)
+	if articleID, parseErr := uuid.Parse(idOrSlug); parseErr == nil {
+		article, err = h.articleRepo.GetByID(ctx, articleID)
+	} else {
+		article, err = h.articleRepo.GetBySlug(ctx, idOrSlug)
+	}
+
+	if err != nil {
+		if _, parseErr := uuid.Parse(idOrSlug); parseErr != nil && redirectFormerSlug(w, r, h.articleRepo, idOrSlug) {
+			return
+		}
+
+		log.Debug().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article", idOrSlug).
+			Msg("Public article not found")
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	if !article.IsPublished {
+		response.NotFoundResource(w, "article", "Article not found")
+		return
+	}
+
+	response.Success(w, PublicArticleDetailResponse{
+		PublicArticleResponse: toPublicArticleResponse(article),
+		Content:               article.Content,
+		ExternalLinks:         article.ExternalLinks,
+		ThreatType:            article.ThreatType,
+		AttackVector:          article.AttackVector,
+		ImpactAssessment:      article.ImpactAssessment,
+		RecommendedActions:    article.RecommendedActions,
+		ExternalReferences:    article.ExternalReferences,
+		ArmorCTA:              article.ArmorCTA,
+	})
+}
+
+// toPublicArticleResponse converts a domain article to the public list response
+func toPublicArticleResponse(article *domain.Article) PublicArticleResponse {
+	full := toArticleResponse(article)
+
+	return PublicArticleResponse{
+		ID:                 full.ID,
+		Title:              full.Title,
+		Slug:               full.Slug,
+		Summary:            full.Summary,
+		Category:           full.Category,
+		Source:             full.Source,
+		SourceURL:          full.SourceURL,
+		Severity:           full.Severity,
+		Tags:               full.Tags,
+		CVEs:               full.CVEs,
+		Vendors:            full.Vendors,
+		ReadingTimeMinutes: full.ReadingTimeMinutes,
+		WordCount:          full.WordCount,
+		Language:           full.Language,
+		PublishedAt:        full.PublishedAt,
+		Image:              full.Image,
+	}
+}
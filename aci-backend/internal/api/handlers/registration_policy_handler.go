@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// RegistrationPolicyHandler handles the self-registration policy for administrators
+type RegistrationPolicyHandler struct {
+	policyService RegistrationPolicyService
+}
+
+// NewRegistrationPolicyHandler creates a new registration policy handler instance
+func NewRegistrationPolicyHandler(policyService RegistrationPolicyService) *RegistrationPolicyHandler {
+	if policyService == nil {
+		panic("policyService cannot be nil")
+	}
+
+	return &RegistrationPolicyHandler{
+		policyService: policyService,
+	}
+}
+
+// UpdateRegistrationPolicyRequest represents a request to replace the registration policy
+type UpdateRegistrationPolicyRequest struct {
+	Enabled        *bool    `json:"enabled"`
+	AllowedDomains []string `json:"allowed_domains"` // empty allows any domain
+}
+
+// Get handles GET /v1/admin/registration - the policy in effect
+func (h *RegistrationPolicyHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	policy, err := h.policyService.Policy(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get registration policy")
+		return
+	}
+
+	response.Success(w, policy)
+}
+
+// Update handles PUT /v1/admin/registration - replaces the configured policy
+func (h *RegistrationPolicyHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req UpdateRegistrationPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+		return
+	}
+
+	if req.Enabled == nil {
+		response.BadRequestWithDetails(w, "Validation failed", "enabled is required", requestID)
+		return
+	}
+
+	policy, err := h.policyService.Update(ctx, *req.Enabled, req.AllowedDomains, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update registration policy")
+		return
+	}
+
+	response.Success(w, policy)
+}
+
+// Reset handles DELETE /v1/admin/registration - returns to the configured policy
+func (h *RegistrationPolicyHandler) Reset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	policy, err := h.policyService.Reset(ctx, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to reset registration policy")
+		return
+	}
+
+	response.Success(w, policy)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *RegistrationPolicyHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// RelevanceRulesHandler handles the relevance scoring ruleset for administrators
+type RelevanceRulesHandler struct {
+	rulesService RelevanceRulesService
+}
+
+// NewRelevanceRulesHandler creates a new relevance rules handler instance
+func NewRelevanceRulesHandler(rulesService RelevanceRulesService) *RelevanceRulesHandler {
+	if rulesService == nil {
+		panic("rulesService cannot be nil")
+	}
+
+	return &RelevanceRulesHandler{
+		rulesService: rulesService,
+	}
+}
+
+// PreviewRelevanceRequest represents a request to score an article under a proposed ruleset
+// Either article_id or article is required; without rules the current ruleset is used
+type PreviewRelevanceRequest struct {
+	ArticleID *uuid.UUID                       `json:"article_id,omitempty"`
+	Article   *service.RelevancePreviewArticle `json:"article,omitempty"`
+	Rules     *domain.RelevanceRules           `json:"rules,omitempty"`
+}
+
+// Get handles GET /v1/admin/relevance-rules - the ruleset in use (version 0 is the built-in default)
+func (h *RelevanceRulesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.rulesService.Get())
+}
+
+// Update handles PUT /v1/admin/relevance-rules - saves and applies a new ruleset version
+func (h *RelevanceRulesHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var rules domain.RelevanceRules
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	updated, err := h.rulesService.Update(ctx, &rules, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update relevance rules")
+		return
+	}
+
+	response.Success(w, updated)
+}
+
+// Preview handles POST /v1/admin/relevance-rules/preview - scores an article without saving anything
+func (h *RelevanceRulesHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req PreviewRelevanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	preview, err := h.rulesService.Preview(ctx, req.ArticleID, req.Article, req.Rules)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to preview relevance score")
+		return
+	}
+
+	response.Success(w, preview)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *RelevanceRulesHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.Error(w, http.StatusConflict, "RELEVANCE_RULES_CHANGED", "The relevance rules were changed by another update; reload and try again")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// ReportHandler serves the weekly threat briefing as a PDF
+type ReportHandler struct {
+	reportService ReportService
+}
+
+// NewReportHandler creates a new report handler instance
+func NewReportHandler(reportService ReportService) *ReportHandler {
+	if reportService == nil {
+		panic("reportService cannot be nil")
+	}
+
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// Weekly handles GET /v1/reports/weekly
+// Query params: week (YYYY-MM-DD, any day of the week; default the last completed week)
+func (h *ReportHandler) Weekly(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	now := time.Now()
+	periodStart := service.LastWeek(now)
+	if weekStr := r.URL.Query().Get("week"); weekStr != "" {
+		day, err := time.Parse("2006-01-02", weekStr)
+		if err != nil {
+			response.BadRequest(w, "Invalid week: must be a date in YYYY-MM-DD format")
+			return
+		}
+
+		periodStart = service.WeekStart(day)
+		if periodStart.After(now) {
+			response.BadRequest(w, "Invalid week: must not be in the future")
+			return
+		}
+	}
+
+	report, err := h.reportService.Weekly(ctx, claims.UserID, periodStart)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to build weekly report")
+		response.InternalError(w, "Failed to build weekly report", requestID)
+		return
+	}
+
+	file, err := h.reportService.RenderPDF(report)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to render weekly report")
+		response.InternalError(w, "Failed to render weekly report", requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, service.ReportFilename(report.PeriodStart)))
+	w.Header().Set("Content-Length", strconv.Itoa(len(file)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(file); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to write weekly report")
+	}
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// SearchHandler handles global search HTTP requests
+type SearchHandler struct {
+	globalSearchService GlobalSearchService
+}
+
+// NewSearchHandler creates a new search handler instance
+func NewSearchHandler(globalSearchService GlobalSearchService) *SearchHandler {
+	if globalSearchService == nil {
+		panic("globalSearchService cannot be nil")
+	}
+
+	return &SearchHandler{
+		globalSearchService: globalSearchService,
+	}
+}
+
+// GlobalSearchResponse represents grouped global search results
+// Only requested types appear in Results; each requested group is always an array
+type GlobalSearchResponse struct {
+	Query   string                                  `json:"query"`
+	Results map[domain.SearchEntityType]interface{} `json:"results"`
+}
+
+// GlobalSearch handles GET /v1/search - typed search across all entity kinds
+// Query params: q (required), types (comma-separated), limit (per type),
+// and <type>_limit to override the limit for a single type (e.g. cves_limit=10)
+func (h *SearchHandler) GlobalSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	query, err := parseGlobalSearchQuery(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	if err := query.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid search query", err.Error(), requestID)
+		return
+	}
+
+	results, err := h.globalSearchService.Search(ctx, query)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("query", query.Query).
+			Msg("Failed to perform global search")
+		response.InternalError(w, "Failed to perform search", requestID)
+		return
+	}
+
+	response.Success(w, toGlobalSearchResponse(query, results))
+}
+
+// parseGlobalSearchQuery extracts global search parameters from request
+func parseGlobalSearchQuery(r *http.Request) (*domain.GlobalSearchQuery, error) {
+	params := r.URL.Query()
+
+	q := strings.TrimSpace(params.Get("q"))
+	if q == "" {
+		return nil, fmt.Errorf("search query parameter 'q' is required")
+	}
+
+	query := domain.NewGlobalSearchQuery(q)
+
+	if typesStr := params.Get("types"); typesStr != "" {
+		query.Types = make([]domain.SearchEntityType, 0)
+		for _, raw := range strings.Split(typesStr, ",") {
+			t := domain.SearchEntityType(strings.TrimSpace(raw))
+			if t == "" || query.Includes(t) {
+				continue
+			}
+			query.Types = append(query.Types, t)
+		}
+	}
+
+	if limitStr := params.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit parameter: %w", err)
+		}
+		for _, t := range domain.AllSearchEntityTypes() {
+			query.Limits[t] = limit
+		}
+	}
+
+	for _, t := range domain.AllSearchEntityTypes() {
+		param := string(t) + "_limit"
+		if limitStr := params.Get(param); limitStr != "" {
+			limit, err := strconv.Atoi(limitStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s parameter: %w", param, err)
+			}
+			query.Limits[t] = limit
+		}
+	}
+
+	return query, nil
+}
+
+// toGlobalSearchResponse converts grouped results to API response
+func toGlobalSearchResponse(query *domain.GlobalSearchQuery, results *domain.GlobalSearchResults) GlobalSearchResponse {
+	resp := GlobalSearchResponse{
+		Query:   results.Query,
+		Results: make(map[domain.SearchEntityType]interface{}, len(query.Types)),
+	}
+
+	for _, t := range query.Types {
+		switch t {
+		case domain.SearchEntityArticle:
+			resp.Results[t] = nonNilArticleHits(results.Articles)
+		case domain.SearchEntityCVE:
+			resp.Results[t] = nonNilTermHits(results.CVEs)
+		case domain.SearchEntityVendor:
+			resp.Results[t] = nonNilTermHits(results.Vendors)
+		case domain.SearchEntityThreatActor:
+			resp.Results[t] = nonNilTermHits(results.ThreatActors)
+		}
+	}
+
+	return resp
+}
+
+func nonNilArticleHits(hits []*domain.ArticleSearchHit) []*domain.ArticleSearchHit {
+	if hits == nil {
+		return []*domain.ArticleSearchHit{}
+	}
+	return hits
+}
+
+func nonNilTermHits(hits []*domain.TermSearchHit) []*domain.TermSearchHit {
+	if hits == nil {
+		return []*domain.TermSearchHit{}
+	}
+	return hits
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// SecurityActivityHandler shows users the security events on their own account
+type SecurityActivityHandler struct {
+	securityEvents SecurityEventService
+}
+
+// NewSecurityActivityHandler creates a new security activity handler instance
+func NewSecurityActivityHandler(securityEvents SecurityEventService) *SecurityActivityHandler {
+	if securityEvents == nil {
+		panic("securityEvents cannot be nil")
+	}
+
+	return &SecurityActivityHandler{
+		securityEvents: securityEvents,
+	}
+}
+
+// ListMine handles GET /v1/users/me/security-activity - returns the user's security events, newest first
+func (h *SecurityActivityHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		log.Error().
+			Str("request_id", requestID).
+			Msg("User claims not found in context")
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequest(w, "Invalid pagination parameters")
+		return
+	}
+
+	activity, total, err := h.securityEvents.ListForUser(ctx, claims.UserID, page, pageSize)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to list security activity")
+		response.InternalError(w, "Failed to retrieve security activity", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, activity, meta)
+}
@@ -0,0 +1,550 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+//go:generate mockery --config ../../../.mockery.yaml
+
+// The services below are what handlers depend on, as interfaces so handler tests can substitute
+// the mocks in package mocks. Each is implemented by the service type of the same name and
+// lists only the methods handlers call
+
+// AICacheService reports on and invalidates the AI response cache
+type AICacheService interface {
+	Invalidate(ctx context.Context, operation string) (int64, error)
+	Stats(ctx context.Context) (*domain.AICacheStats, error)
+}
+
+// AIUsageService records the token usage and cost of AI calls and enforces the monthly budget
+type AIUsageService interface {
+	Report(ctx context.Context, days int) (*domain.AIUsageReport, error)
+}
+
+// AccountDeletionService handles self-service account deletion
+type AccountDeletionService interface {
+	Cancel(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (bool, error)
+	Request(ctx context.Context, userID uuid.UUID, password, ipAddress, userAgent string) (*domain.AccountDeletion, error)
+}
+
+// AdminService handles admin-only business logic
+type AdminService interface {
+	CreateSource(ctx context.Context, source *domain.Source, adminUserID uuid.UUID, ipAddress, userAgent string) (*domain.Source, error)
+	DeleteArticle(ctx context.Context, articleID uuid.UUID, adminUserID uuid.UUID, ipAddress, userAgent string) error
+	DeleteSource(ctx context.Context, sourceID uuid.UUID, adminUserID uuid.UUID, ipAddress, userAgent string) error
+	DeleteUser(ctx context.Context, userID uuid.UUID, adminUserID uuid.UUID, ipAddress, userAgent string) error
+	ListAuditLogs(ctx context.Context, filter *domain.AuditLogFilter) ([]*domain.AuditLog, int, error)
+	ListSources(ctx context.Context) ([]*domain.Source, error)
+	ListUsers(ctx context.Context, limit, offset int) ([]*entities.User, int, error)
+	UpdateArticle(ctx context.Context, articleID uuid.UUID, updates map[string]interface{}, adminUserID uuid.UUID, ipAddress, userAgent string) (*domain.Article, error)
+	UpdateSource(ctx context.Context, sourceID uuid.UUID, updates map[string]interface{}, adminUserID uuid.UUID, ipAddress, userAgent string) (*domain.Source, error)
+	UpdateUser(ctx context.Context, userID uuid.UUID, updates map[string]interface{}, adminUserID uuid.UUID, ipAddress, userAgent string) (*entities.User, error)
+}
+
+// AlertService handles alert business logic
+type AlertService interface {
+	Backfill(ctx context.Context, alert *domain.Alert, days int) (*domain.AlertBackfillSummary, error)
+	Create(ctx context.Context, userID uuid.UUID, name string, alertType domain.AlertType, value string, shared bool, ipAddress, userAgent string) (*domain.Alert, error)
+	Delete(ctx context.Context, id, userID uuid.UUID, ipAddress, userAgent string) error
+	GetByID(ctx context.Context, id, userID uuid.UUID) (*domain.Alert, error)
+	List(ctx context.Context, userID uuid.UUID) ([]*domain.Alert, error)
+	ListMatches(ctx context.Context, alertID, userID uuid.UUID, status *domain.AlertMatchStatus, page, pageSize int) ([]*domain.AlertMatch, int, error)
+	Update(ctx context.Context, id, userID uuid.UUID, name, value *string, isActive *bool) (*domain.Alert, error)
+	UpdateMatchStatus(ctx context.Context, alertID, matchID, userID uuid.UUID, status domain.AlertMatchStatus) (*domain.AlertMatch, error)
+}
+
+// AnalyticsService builds the admin analytics dashboard
+type AnalyticsService interface {
+	Report(ctx context.Context, window time.Duration, topN int) (*domain.AdminAnalytics, error)
+}
+
+// AnnotationService manages analysts' internal comments and highlights on articles
+type AnnotationService interface {
+	Create(ctx context.Context, userID uuid.UUID, role domain.UserRole, articleID uuid.UUID, input service.AnnotationInput) (*domain.Annotation, error)
+	Delete(ctx context.Context, id, userID uuid.UUID) error
+	ListForArticle(ctx context.Context, userID, articleID uuid.UUID) ([]*domain.Annotation, error)
+	ListRecent(ctx context.Context, userID uuid.UUID, since *time.Time, page, pageSize int) ([]*domain.Annotation, int, error)
+	Update(ctx context.Context, id, userID uuid.UUID, input service.AnnotationInput) (*domain.Annotation, error)
+}
+
+// ArticleArchiveService serves the archived snapshots of articles' source pages
+type ArticleArchiveService interface {
+	GetArchive(ctx context.Context, articleID uuid.UUID) (*domain.ArticleArchive, error)
+	GetSnapshot(ctx context.Context, articleID uuid.UUID, text bool) ([]byte, string, error)
+}
+
+// ArticleEditorialService manages editorial title and summary overrides
+type ArticleEditorialService interface {
+	Get(ctx context.Context, articleID uuid.UUID) (*domain.ArticleEditorial, error)
+	Update(ctx context.Context, articleID uuid.UUID, title, summary *string, editor *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleEditorial, error)
+}
+
+// ArticleIdentityService detects articles that arrive again under a different URL
+type ArticleIdentityService interface {
+	ListURLs(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSourceURL, error)
+}
+
+// ArticleImageService stores the hero images of ingested articles
+type ArticleImageService interface {
+	GetImage(ctx context.Context, article *domain.Article, sizeName string) ([]byte, string, error)
+}
+
+// ArticleImportService backfills historical articles from JSONL and CSV dumps
+type ArticleImportService interface {
+	Get(ctx context.Context, id uuid.UUID) (*domain.ArticleImport, error)
+	List(ctx context.Context) ([]*domain.ArticleImport, error)
+	Process(ctx context.Context, articleImport *domain.ArticleImport, r io.Reader, progress func(*domain.ArticleImport)) error
+	Resume(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleImport, error)
+	Start(ctx context.Context, format, filename string, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleImport, error)
+}
+
+// ArticleReviewService moderates webhook-ingested articles before publication
+type ArticleReviewService interface {
+	Approve(ctx context.Context, articleID uuid.UUID, reviewer *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleReview, error)
+	BulkApprove(ctx context.Context, articleIDs []uuid.UUID, reviewer *uuid.UUID, ipAddress, userAgent string) (*domain.BulkReviewResult, error)
+	List(ctx context.Context, filter *domain.ArticleReviewFilter) ([]*domain.ArticleReview, int, error)
+	Reject(ctx context.Context, articleID uuid.UUID, reviewer *uuid.UUID, reason, ipAddress, userAgent string) (*domain.ArticleReview, error)
+}
+
+// ArticleService handles article business logic
+type ArticleService interface {
+	BulkImport(ctx context.Context, articles []service.ArticleCreatedData) (int, []error)
+	CreateArticle(ctx context.Context, data service.ArticleCreatedData) (*domain.Article, error)
+	DeleteArticle(ctx context.Context, id uuid.UUID) error
+	UpdateArticle(ctx context.Context, id uuid.UUID, data service.ArticleUpdatedData) (*domain.Article, error)
+}
+
+// ArticleSlugService changes article slugs while keeping links to the old ones working
+type ArticleSlugService interface {
+	Update(ctx context.Context, articleID uuid.UUID, newSlug string, editor *uuid.UUID, ipAddress, userAgent string) (*service.ArticleSlugChange, error)
+}
+
+// AuditLogRetentionService archives old audit logs to object storage and exports them as CSV
+type AuditLogRetentionService interface {
+	ExportCSV(ctx context.Context, from, to time.Time, w io.Writer) error
+}
+
+// AuthService handles authentication business logic
+type AuthService interface {
+	ChangePassword(ctx context.Context, userID uuid.UUID, currentPassword, newPassword, ipAddress, userAgent string) error
+	Login(ctx context.Context, email, password, ipAddress, userAgent string) (*entities.User, *jwt.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context, userID uuid.UUID) error
+	Refresh(ctx context.Context, refreshToken, ipAddress, userAgent string) (*jwt.TokenPair, error)
+	Register(ctx context.Context, email, password, name string) (*entities.User, *jwt.TokenPair, error)
+}
+
+// BookmarkCollectionService manages named bookmark collections
+type BookmarkCollectionService interface {
+	AddArticle(ctx context.Context, id, userID, articleID uuid.UUID) error
+	Create(ctx context.Context, userID uuid.UUID, name string, shared bool) (*domain.BookmarkCollection, error)
+	Delete(ctx context.Context, id, userID uuid.UUID) error
+	Get(ctx context.Context, id, userID uuid.UUID) (*domain.BookmarkCollection, error)
+	List(ctx context.Context, userID uuid.UUID) ([]*domain.BookmarkCollection, error)
+	ListArticles(ctx context.Context, id, userID uuid.UUID, page, pageSize int) ([]*domain.Article, int, error)
+	RemoveArticle(ctx context.Context, id, userID, articleID uuid.UUID) error
+}
+
+// CRMService pushes CTA clicks and lead form submissions to the configured CRM
+type CRMService interface {
+	ListEvents(ctx context.Context, filter *domain.CRMEventFilter) ([]*domain.CRMEvent, int, error)
+	Retry(ctx context.Context, id uuid.UUID) (*domain.CRMEvent, error)
+	RetryFailed(ctx context.Context) (int64, error)
+	SubmitLead(ctx context.Context, input service.LeadInput) error
+}
+
+// CTAExperimentService runs A/B tests on the Armor CTAs shown with articles
+type CTAExperimentService interface {
+	Click(ctx context.Context, articleID, variantID, userID uuid.UUID) error
+	Create(ctx context.Context, input service.CTAVariantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.CTAVariant, error)
+	Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error
+	List(ctx context.Context) ([]*domain.CTAVariant, error)
+	Report(ctx context.Context, days int) ([]*domain.CTAVariantStats, error)
+	Serve(ctx context.Context, cta *domain.ArmorCTA, readerID string) *domain.ArmorCTA
+	Update(ctx context.Context, id uuid.UUID, input service.CTAVariantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.CTAVariant, error)
+}
+
+// CaptchaService requires CAPTCHA challenges on registration and after repeated failed logins
+type CaptchaService interface {
+	CheckLogin(ctx context.Context, email, token, remoteIP string) error
+	CheckRegistration(ctx context.Context, token, remoteIP string) error
+	LoginFailed(email, remoteIP string)
+	LoginSucceeded(email string)
+	Settings() service.CaptchaSettings
+}
+
+// CategoryService manages the category hierarchy and article category membership
+type CategoryService interface {
+	SetArticleCategories(ctx context.Context, articleID uuid.UUID, categoryIDs []uuid.UUID, actor *uuid.UUID, ipAddress, userAgent string) ([]uuid.UUID, error)
+	SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Category, error)
+}
+
+// ClassificationService suggests metadata for articles ingested without a category or severity
+type ClassificationService interface {
+	Approve(ctx context.Context, id uuid.UUID, reviewer *uuid.UUID) (*domain.ClassificationSuggestion, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ClassificationSuggestion, error)
+	List(ctx context.Context, filter *domain.ClassificationFilter) ([]*domain.ClassificationSuggestion, int, error)
+	Reject(ctx context.Context, id uuid.UUID, reviewer *uuid.UUID) (*domain.ClassificationSuggestion, error)
+}
+
+// ClientEventService ingests client engagement events and rolls them up for the analytics dashboard
+type ClientEventService interface {
+	Record(userID *uuid.UUID, inputs []service.ClientEventInput) (int, error)
+}
+
+// DeduplicationService clusters near-duplicate articles so syndicated advisories appear once in the feed
+type DeduplicationService interface {
+	GetDuplicates(ctx context.Context, articleID uuid.UUID) (uuid.UUID, []*domain.Article, error)
+}
+
+// EngagementService handles user engagement operations (bookmarks, reads, stats)
+type EngagementService interface {
+	AddBookmark(ctx context.Context, userID, articleID uuid.UUID) error
+	GetBookmarks(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]*domain.Article, int, error)
+	GetReadingHistory(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]*repository.ArticleRead, int, error)
+	GetUserStats(ctx context.Context, userID uuid.UUID) (*repository.UserReadStats, error)
+	MarkRead(ctx context.Context, userID, articleID uuid.UUID, readingTimeSeconds *int) error
+	RemoveBookmark(ctx context.Context, userID, articleID uuid.UUID) error
+}
+
+// EnrichmentFeedbackService records analysts' flags on wrong AI enrichment output
+type EnrichmentFeedbackService interface {
+	Accuracy(ctx context.Context, weeks int) (*domain.EnrichmentAccuracyReport, error)
+	Delete(ctx context.Context, id, actorID uuid.UUID, ipAddress, userAgent string) error
+	Flag(ctx context.Context, userID uuid.UUID, role domain.UserRole, articleID uuid.UUID, input service.EnrichmentFeedbackInput, ipAddress, userAgent string) (*domain.EnrichmentFeedback, error)
+	List(ctx context.Context, filter *domain.EnrichmentFeedbackFilter) ([]*domain.EnrichmentFeedback, int, error)
+}
+
+// EnrichmentPromptService manages the enricher's versioned system prompts
+type EnrichmentPromptService interface {
+	Activate(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error)
+	Create(ctx context.Context, key domain.EnrichmentPromptKey, input service.EnrichmentPromptInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error)
+	Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentPrompt, error)
+	List(ctx context.Context) ([]*domain.EnrichmentPromptOverview, error)
+	ListVersions(ctx context.Context, id uuid.UUID) ([]*domain.EnrichmentPrompt, error)
+	Trial(ctx context.Context, id uuid.UUID, articleIDs []uuid.UUID, sampleSize int) (*service.EnrichmentPromptTrial, error)
+	Update(ctx context.Context, id uuid.UUID, input service.EnrichmentPromptInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error)
+}
+
+// EnrichmentRequestService hands enrichment to an external pipeline while the AI provider is disabled
+type EnrichmentRequestService interface {
+	Request(ctx context.Context, article *domain.Article) error
+}
+
+// EnrichmentService handles AI enrichment of articles
+type EnrichmentService interface {
+	ApplyExternalEnrichment(ctx context.Context, articleID uuid.UUID, result *ai.EnrichmentResult) error
+	Enabled() bool
+	EnrichArticle(ctx context.Context, articleID uuid.UUID) error
+	EnrichPendingArticles(ctx context.Context, limit int) (int, error)
+}
+
+// EnrichmentWorker enriches articles that have no enrichment yet
+type EnrichmentWorker interface {
+	Stats() service.EnrichmentWorkerStats
+}
+
+// ExploitService tracks public exploits for CVEs from Exploit-DB and GitHub proof-of-concept repositories
+type ExploitService interface {
+	ListForArticle(ctx context.Context, articleID uuid.UUID) ([]*domain.PublicExploit, error)
+	Sources() []domain.ExploitSource
+	Status(ctx context.Context) ([]*domain.ExploitSourceStatus, error)
+	Sync(ctx context.Context, source domain.ExploitSource) (*domain.ExploitSyncResult, error)
+	SyncAll(ctx context.Context) ([]*domain.ExploitSyncResult, error)
+}
+
+// FeaturedArticleService manages the homepage hero carousel
+type FeaturedArticleService interface {
+	Active(ctx context.Context, limit int) ([]*domain.Article, error)
+	Feature(ctx context.Context, articleID uuid.UUID, position *int, featureFrom, featureUntil *time.Time, createdBy *uuid.UUID) (*domain.FeaturedArticle, error)
+	List(ctx context.Context) ([]*domain.FeaturedArticle, error)
+	Reorder(ctx context.Context, articleIDs []uuid.UUID) error
+	Unfeature(ctx context.Context, articleID uuid.UUID) error
+}
+
+// FeedPreferenceService manages each user's default feed filters
+type FeedPreferenceService interface {
+	ApplyTo(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter) error
+	Get(ctx context.Context, userID uuid.UUID) (*domain.FeedPreferences, error)
+	Update(ctx context.Context, prefs *domain.FeedPreferences) (*domain.FeedPreferences, error)
+}
+
+// GlobalSearchService performs typed search across articles, CVEs, vendors, and threat actors
+type GlobalSearchService interface {
+	Search(ctx context.Context, query *domain.GlobalSearchQuery) (*domain.GlobalSearchResults, error)
+}
+
+// IOCService keeps the normalized IOC tables in sync with articles and serves indicator search
+type IOCService interface {
+	Export(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, error)
+	Search(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, int, error)
+}
+
+// IncidentService groups the articles covering the same breach or campaign into incident timelines
+type IncidentService interface {
+	AddArticle(ctx context.Context, id, userID, articleID uuid.UUID) error
+	Create(ctx context.Context, userID uuid.UUID, title string, summary *string) (*domain.Incident, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, int, error)
+	RemoveArticle(ctx context.Context, id, articleID uuid.UUID) error
+	Suggest(ctx context.Context, id uuid.UUID, limit int) ([]*domain.IncidentSuggestion, error)
+	Timeline(ctx context.Context, id uuid.UUID) (*domain.IncidentTimeline, error)
+	Update(ctx context.Context, id uuid.UUID, title string, summary *string, status domain.IncidentStatus) (*domain.Incident, error)
+}
+
+// IngestSLOService tracks article ingest pipeline latency against an SLO
+type IngestSLOService interface {
+	GetTiming(ctx context.Context, articleID uuid.UUID) (*domain.IngestTiming, error)
+	RecordStage(ctx context.Context, articleID uuid.UUID, stage domain.IngestStage, at time.Time)
+	Report(ctx context.Context, window time.Duration) (*domain.IngestSLOReport, error)
+}
+
+// KEVService keeps a local copy of the CISA Known Exploited Vulnerabilities catalog
+type KEVService interface {
+	Status(ctx context.Context) (*domain.KEVCatalogStatus, error)
+	Sync(ctx context.Context) (*domain.KEVSyncResult, error)
+}
+
+// NVDService keeps a local copy of the products NVD lists as affected by recent CVEs
+type NVDService interface {
+	Status(ctx context.Context) (*domain.NVDStatus, error)
+	Sync(ctx context.Context) (*domain.NVDSyncResult, error)
+}
+
+// NewsletterService composes newsletters from selected articles and sends them to subscribers
+type NewsletterService interface {
+	AddSubscriber(ctx context.Context, email string, name *string, consentSource string) (*domain.NewsletterSubscriber, error)
+	AddSuppression(ctx context.Context, userID uuid.UUID, email string, reason domain.SuppressionReason, details *string) (*domain.NewsletterSuppression, error)
+	Confirm(ctx context.Context, token string) error
+	CreateCampaign(ctx context.Context, userID uuid.UUID, input service.CampaignInput) (*domain.NewsletterCampaign, error)
+	DeleteCampaign(ctx context.Context, id uuid.UUID) error
+	ExportSubscribersCSV(ctx context.Context, status *domain.SubscriberStatus, w io.Writer) error
+	GetCampaign(ctx context.Context, id uuid.UUID) (*domain.NewsletterCampaign, error)
+	ListCampaigns(ctx context.Context, limit, offset int) ([]*domain.NewsletterCampaign, int, error)
+	ListSubscribers(ctx context.Context, filter *domain.SubscriberFilter) ([]*domain.NewsletterSubscriber, int, error)
+	ListSuppressions(ctx context.Context, limit, offset int) ([]*domain.NewsletterSuppression, int, error)
+	Preview(ctx context.Context, id uuid.UUID) (*service.NewsletterPreview, error)
+	RemoveSuppression(ctx context.Context, id uuid.UUID) error
+	Send(ctx context.Context, id, userID uuid.UUID) (*domain.NewsletterCampaign, error)
+	Subscribe(ctx context.Context, email string, name *string) error
+	Templates() []string
+	TrackClick(ctx context.Context, deliveryID, articleID uuid.UUID) (string, error)
+	TrackOpen(ctx context.Context, deliveryID uuid.UUID) error
+	Unsubscribe(ctx context.Context, id uuid.UUID) error
+	UnsubscribeByDelivery(ctx context.Context, deliveryID uuid.UUID) error
+	UnsubscribeByToken(ctx context.Context, token string) error
+	UpdateCampaign(ctx context.Context, id uuid.UUID, input service.CampaignInput) (*domain.NewsletterCampaign, error)
+}
+
+// NotificationPreferenceService manages per-user notification preferences
+type NotificationPreferenceService interface {
+	Get(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error)
+	Update(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error)
+}
+
+// NotificationService handles broadcasting notifications via WebSocket
+type NotificationService interface {
+	GetHubStats() map[string]interface{}
+	NotifyNewArticle(article *domain.Article) error
+}
+
+// NotificationTemplateService manages versioned notification templates
+type NotificationTemplateService interface {
+	Activate(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error)
+	Create(ctx context.Context, input service.NotificationTemplateInput, createdBy *uuid.UUID) (*domain.NotificationTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error)
+	List(ctx context.Context, filter *domain.NotificationTemplateFilter) ([]*domain.NotificationTemplate, error)
+	ListVersions(ctx context.Context, id uuid.UUID) ([]*domain.NotificationTemplate, error)
+	Preview(input service.NotificationTemplateInput, vars map[string]interface{}) (*domain.RenderedNotification, error)
+	PreviewVersion(ctx context.Context, id uuid.UUID, vars map[string]interface{}) (*domain.RenderedNotification, error)
+	Update(ctx context.Context, id uuid.UUID, subject *string, body string, variables []string, createdBy *uuid.UUID) (*domain.NotificationTemplate, error)
+}
+
+// OrgCategoryService manages the private categories organizations apply on top of the global taxonomy
+type OrgCategoryService interface {
+	AddArticle(ctx context.Context, orgID, id, articleID, userID uuid.UUID, role domain.UserRole) error
+	ArticleCategories(ctx context.Context, orgID, articleID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrgArticleCategory, error)
+	Articles(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, filter *domain.ArticleFilter) ([]*domain.Article, int, error)
+	Create(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, input service.OrgCategoryInput) (*domain.OrgCategory, error)
+	Delete(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) error
+	Get(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) (*domain.OrgCategory, error)
+	List(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrgCategory, error)
+	RemoveArticle(ctx context.Context, orgID, id, articleID, userID uuid.UUID, role domain.UserRole) error
+	Update(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, input service.OrgCategoryInput) (*domain.OrgCategory, error)
+}
+
+// OrganizationReportService manages and generates report templates defined by organization admins
+type OrganizationReportService interface {
+	Create(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, input service.OrganizationReportInput) (*domain.OrganizationReport, error)
+	Delete(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) error
+	Get(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) (*domain.OrganizationReport, error)
+	List(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrganizationReport, error)
+	ListRuns(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, limit, offset int) ([]*domain.OrganizationReportRun, int, error)
+	Rerun(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, from, to time.Time, deliver bool) (*domain.OrganizationReportRun, error)
+	RunDocument(ctx context.Context, orgID, id, runID, userID uuid.UUID, role domain.UserRole) (string, []byte, error)
+	Update(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, input service.OrganizationReportInput) (*domain.OrganizationReport, error)
+}
+
+// OrganizationService manages organizations, their members and engagement rollups
+type OrganizationService interface {
+	Create(ctx context.Context, name string) (*domain.Organization, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.Organization, error)
+	List(ctx context.Context) ([]*domain.Organization, error)
+	Members(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMember, error)
+	MembershipOf(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error)
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+	SetMember(ctx context.Context, orgID, userID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error)
+	Stats(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationStats, error)
+}
+
+// PublicAPIKeyService issues and authenticates keys for the public read-only API
+type PublicAPIKeyService interface {
+	Create(ctx context.Context, name string, requestsPerMinute int, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.PublicAPIKey, string, error)
+	List(ctx context.Context) ([]*domain.PublicAPIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.PublicAPIKey, error)
+}
+
+// RegistrationPolicyService decides whether public registration is open and to which email domains
+type RegistrationPolicyService interface {
+	Policy(ctx context.Context) (*domain.RegistrationPolicy, error)
+	Reset(ctx context.Context, actorID uuid.UUID, ipAddress, userAgent string) (*domain.RegistrationPolicy, error)
+	Update(ctx context.Context, enabled bool, allowedDomains []string, actorID uuid.UUID, ipAddress, userAgent string) (*domain.RegistrationPolicy, error)
+}
+
+// RelevanceRulesService manages the relevance scorer's ruleset at runtime
+type RelevanceRulesService interface {
+	Get() *domain.RelevanceRules
+	Preview(ctx context.Context, articleID *uuid.UUID, draft *service.RelevancePreviewArticle, rules *domain.RelevanceRules) (*service.RelevancePreview, error)
+	Update(ctx context.Context, rules *domain.RelevanceRules, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.RelevanceRules, error)
+}
+
+// ReportService builds threat reports and renders them to PDF
+type ReportService interface {
+	RenderPDF(report *domain.ThreatReport) ([]byte, error)
+	Weekly(ctx context.Context, userID uuid.UUID, periodStart time.Time) (*domain.ThreatReport, error)
+}
+
+// SIEMService forwards enriched articles and their IOCs to the configured SIEM destinations
+type SIEMService interface {
+	ListDestinations(ctx context.Context) ([]*domain.SIEMDestination, error)
+	ListEvents(ctx context.Context, filter *domain.SIEMEventFilter) ([]*domain.SIEMEvent, int, error)
+	RetryFailed(ctx context.Context, name string) (int64, error)
+	SetDestinationEnabled(ctx context.Context, name string, enabled bool, updatedBy uuid.UUID) (*domain.SIEMDestination, error)
+}
+
+// SearchService handles article search operations
+type SearchService interface {
+	Search(ctx context.Context, query string, filter *domain.ArticleFilter) (*service.SearchPage, error)
+}
+
+// SecurityEventService records security events on user accounts and lists them for their owners
+type SecurityEventService interface {
+	ListForUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]*domain.SecurityActivity, int, error)
+}
+
+// ShareLinkService issues short signed share links for articles and resolves them
+type ShareLinkService interface {
+	Create(ctx context.Context, userID, articleID uuid.UUID, channel *string, ttl time.Duration) (*domain.ShareLink, error)
+	Follow(ctx context.Context, token, source, referrer string) (string, error)
+}
+
+// SoftwareInventoryService manages software inventories and flags articles affecting them
+type SoftwareInventoryService interface {
+	Annotate(ctx context.Context, userID uuid.UUID, articles []*domain.Article) error
+	ApplyTo(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter) error
+	ListForOrganization(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.InventoryItem, error)
+	ListMine(ctx context.Context, userID uuid.UUID) ([]*domain.InventoryItem, error)
+	ReplaceForOrganization(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, inputs []service.InventoryItemInput) ([]*domain.InventoryItem, error)
+	ReplaceMine(ctx context.Context, userID uuid.UUID, inputs []service.InventoryItemInput) ([]*domain.InventoryItem, error)
+}
+
+// SourceTrustService calibrates source trust scores from ingestion and reader signals
+type SourceTrustService interface {
+	History(ctx context.Context, sourceID uuid.UUID, page, pageSize int) ([]*domain.SourceTrustChange, int, error)
+}
+
+// SummarizeService generates and stores article summaries at each length preset
+type SummarizeService interface {
+	GetSummary(ctx context.Context, article *domain.Article, length domain.SummaryLength) (*domain.ArticleSummary, error)
+}
+
+// TagService keeps article tags canonical
+type TagService interface {
+	List(ctx context.Context, query string, page, pageSize int) ([]*domain.Tag, int, error)
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID, actor *uuid.UUID, ipAddress, userAgent string) (*domain.TagChange, error)
+	Rename(ctx context.Context, id uuid.UUID, name string, actor *uuid.UUID, ipAddress, userAgent string) (*domain.TagChange, error)
+}
+
+// TenantService manages the workspaces of a multi-tenant deployment and resolves request hostnames to them
+type TenantService interface {
+	Create(ctx context.Context, input service.TenantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.Tenant, error)
+	Current(ctx context.Context) *domain.Tenant
+	Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.Tenant, error)
+	List(ctx context.Context) ([]*domain.Tenant, error)
+	Resolve(host string) (*domain.Tenant, bool)
+	Update(ctx context.Context, id uuid.UUID, input service.TenantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.Tenant, error)
+}
+
+// ThreatActorService links articles to threat actor and ransomware group profiles
+type ThreatActorService interface {
+	List(ctx context.Context, filter *domain.ThreatActorFilter) ([]*domain.ThreatActor, int, error)
+	ListArticles(ctx context.Context, actorSlug string, filter *domain.ArticleFilter) (*domain.ThreatActor, []*domain.Article, int, error)
+}
+
+// ThreatLandscapeService builds severity trends and threat rankings for dashboard charts
+type ThreatLandscapeService interface {
+	Landscape(ctx context.Context, window time.Duration, topN int) (*domain.ThreatLandscape, error)
+}
+
+// UserExportService streams a user's bookmarks, reading history, alerts and preferences for data-portability requests
+type UserExportService interface {
+	Export(ctx context.Context, userID uuid.UUID, format string, w io.Writer) error
+}
+
+// UserInviteService creates accounts for invited users, who choose their password through a signed link
+type UserInviteService interface {
+	Accept(ctx context.Context, token, name, password string, ipAddress, userAgent string) (*entities.User, *jwt.TokenPair, error)
+	Invite(ctx context.Context, input service.InviteInput, adminID uuid.UUID, ipAddress, userAgent string) (*entities.User, time.Time, error)
+	Lookup(ctx context.Context, token string) (*entities.User, error)
+}
+
+// UserStatusService suspends, bans and reinstates user accounts
+type UserStatusService interface {
+	CheckStatus(ctx context.Context, userID uuid.UUID) error
+	Suspend(ctx context.Context, userID uuid.UUID, status entities.UserStatus, reason string, until *time.Time, adminID uuid.UUID, ipAddress, userAgent string) (*entities.User, error)
+	Unsuspend(ctx context.Context, userID, adminID uuid.UUID, ipAddress, userAgent string) (*entities.User, error)
+}
+
+// VendorWatchlistService manages the vendors each user runs
+type VendorWatchlistService interface {
+	Add(ctx context.Context, userID uuid.UUID, vendor, ipAddress, userAgent string) (*domain.WatchedVendor, error)
+	List(ctx context.Context, userID uuid.UUID) ([]*domain.WatchedVendor, error)
+	Remove(ctx context.Context, id, userID uuid.UUID, ipAddress, userAgent string) error
+}
+
+// WebhookReplayService rejects stale and replayed signed webhooks
+type WebhookReplayService interface {
+	Check(ctx context.Context, timestamp, signature string) error
+	Release(ctx context.Context, signature string) error
+}
+
+// WebhookSecretService holds the webhook secrets accepted for n8n signatures
+type WebhookSecretService interface {
+	List(ctx context.Context) []*domain.WebhookSecret
+	Rotate(ctx context.Context, actorID *uuid.UUID, ipAddress, userAgent string) (*service.WebhookSecretRotation, error)
+	Verify(ctx context.Context, payload []byte, signature string) (int, bool)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// ShareLinkHandler issues article share links and follows them
+type ShareLinkHandler struct {
+	shareLinkService ShareLinkService
+}
+
+// NewShareLinkHandler creates a new share link handler instance
+func NewShareLinkHandler(shareLinkService ShareLinkService) *ShareLinkHandler {
+	if shareLinkService == nil {
+		panic("shareLinkService cannot be nil")
+	}
+
+	return &ShareLinkHandler{
+		shareLinkService: shareLinkService,
+	}
+}
+
+// CreateShareLinkRequest represents a share link request; the body is optional
+type CreateShareLinkRequest struct {
+	Channel   *string `json:"channel,omitempty"`    // where the link will be posted, e.g. linkedin
+	ExpiresIn string  `json:"expires_in,omitempty"` // duration such as 72h; omitted for a link that never expires
+}
+
+// Create handles POST /v1/articles/{id}/share
+func (h *ShareLinkHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	var req CreateShareLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	var ttl time.Duration
+	if req.ExpiresIn != "" {
+		ttl, err = time.ParseDuration(req.ExpiresIn)
+		if err != nil {
+			response.BadRequestWithDetails(w, "Invalid expires_in", "expires_in must be a duration such as 72h", requestID)
+			return
+		}
+	}
+
+	link, err := h.shareLinkService.Create(ctx, claims.UserID, articleID, req.Channel, ttl)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create share link")
+		return
+	}
+
+	response.Created(w, link)
+}
+
+// Follow handles GET /s/{token} - records the click and redirects to the article
+// Query params: src (where the link was posted, overriding the link's channel)
+func (h *ShareLinkHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	target, err := h.shareLinkService.Follow(ctx, chi.URLParam(r, "token"), r.URL.Query().Get("src"), r.Referer())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to follow share link")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *ShareLinkHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, service.ErrShareLinkExpired) {
+		response.Error(w, http.StatusGone, "LINK_EXPIRED", "This share link has expired")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// SIEMHandler handles the admin view of SIEM destinations and their event queues
+type SIEMHandler struct {
+	siemService SIEMService
+}
+
+// NewSIEMHandler creates a new SIEM handler instance
+func NewSIEMHandler(siemService SIEMService) *SIEMHandler {
+	if siemService == nil {
+		panic("siemService cannot be nil")
+	}
+
+	return &SIEMHandler{
+		siemService: siemService,
+	}
+}
+
+// UpdateSIEMDestinationRequest turns a SIEM destination on or off
+type UpdateSIEMDestinationRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// ListDestinations handles GET /v1/admin/siem/destinations
+func (h *SIEMHandler) ListDestinations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	destinations, err := h.siemService.ListDestinations(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list SIEM destinations")
+		return
+	}
+
+	response.Success(w, destinations)
+}
+
+// UpdateDestination handles PATCH /v1/admin/siem/destinations/{name}
+func (h *SIEMHandler) UpdateDestination(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req UpdateSIEMDestinationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.Enabled == nil {
+		response.BadRequest(w, "enabled is required")
+		return
+	}
+
+	destination, err := h.siemService.SetDestinationEnabled(ctx, chi.URLParam(r, "name"), *req.Enabled, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update SIEM destination")
+		return
+	}
+
+	response.Success(w, destination)
+}
+
+// ListEvents handles GET /v1/admin/siem/events
+// Query params: destination, status (pending, delivered or failed), type (article.enriched or ioc.observed), page, page_size
+func (h *SIEMHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	filter := &domain.SIEMEventFilter{Page: page, PageSize: pageSize}
+	if destination := r.URL.Query().Get("destination"); destination != "" {
+		filter.Destination = &destination
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		eventStatus := domain.SIEMEventStatus(status)
+		filter.Status = &eventStatus
+	}
+	if eventType := r.URL.Query().Get("type"); eventType != "" {
+		siemEventType := domain.SIEMEventType(eventType)
+		filter.Type = &siemEventType
+	}
+
+	events, total, err := h.siemService.ListEvents(ctx, filter)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list SIEM events")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, events, meta)
+}
+
+// RetryFailed handles POST /v1/admin/siem/destinations/{name}/retry - queues the destination's failed events again
+func (h *SIEMHandler) RetryFailed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	retried, err := h.siemService.RetryFailed(ctx, chi.URLParam(r, "name"))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to retry SIEM events")
+		return
+	}
+
+	response.Success(w, map[string]int64{"retried": retried})
+}
+
+// handleError maps service errors to HTTP responses
+func (h *SIEMHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
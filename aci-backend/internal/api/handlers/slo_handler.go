@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// SLOHandler exposes service level objective dashboards to administrators
+type SLOHandler struct {
+	ingestSLOService IngestSLOService
+}
+
+// NewSLOHandler creates a new SLO handler instance
+func NewSLOHandler(ingestSLOService IngestSLOService) *SLOHandler {
+	if ingestSLOService == nil {
+		panic("ingestSLOService cannot be nil")
+	}
+
+	return &SLOHandler{
+		ingestSLOService: ingestSLOService,
+	}
+}
+
+// GetIngestSLO handles GET /v1/admin/slo/ingest
+// Query params: window (Go duration, e.g. 1h, 24h; default 24h)
+func (h *SLOHandler) GetIngestSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	window := service.DefaultSLOWindow
+	if windowStr := r.URL.Query().Get("window"); windowStr != "" {
+		parsed, err := time.ParseDuration(windowStr)
+		if err != nil || parsed <= 0 || parsed > service.MaxSLOWindow {
+			response.BadRequest(w, "Invalid window: must be a positive duration up to 720h")
+			return
+		}
+		window = parsed
+	}
+
+	report, err := h.ingestSLOService.Report(ctx, window)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to build ingest SLO report")
+		response.InternalError(w, "Failed to retrieve ingest SLO", requestID)
+		return
+	}
+
+	response.Success(w, report)
+}
+
+// GetArticleTiming handles GET /v1/admin/slo/ingest/articles/{id}
+// Returns the pipeline stage timestamps recorded for one article
+func (h *SLOHandler) GetArticleTiming(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	articleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid article ID format")
+		return
+	}
+
+	timing, err := h.ingestSLOService.GetTiming(ctx, articleID)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "ingest timing", "No ingest timing recorded for article")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("article_id", articleID.String()).
+			Msg("Failed to get ingest timing")
+		response.InternalError(w, "Failed to retrieve ingest timing", requestID)
+		return
+	}
+
+	response.Success(w, timing)
+}
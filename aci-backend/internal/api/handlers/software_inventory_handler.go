@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// SoftwareInventoryHandler serves the software inventories articles are matched against: the
+// current user's own, and their organization's, which its admins maintain
+type SoftwareInventoryHandler struct {
+	inventoryService SoftwareInventoryService
+}
+
+// NewSoftwareInventoryHandler creates a new software inventory handler instance
+func NewSoftwareInventoryHandler(inventoryService SoftwareInventoryService) *SoftwareInventoryHandler {
+	if inventoryService == nil {
+		panic("inventoryService cannot be nil")
+	}
+
+	return &SoftwareInventoryHandler{
+		inventoryService: inventoryService,
+	}
+}
+
+// SoftwareInventoryRequest is an uploaded software inventory, replacing the previous one
+type SoftwareInventoryRequest struct {
+	Items []InventoryItemRequest `json:"items" validate:"dive"`
+}
+
+// InventoryItemRequest is a product release, given as a CPE name or as a vendor and product
+type InventoryItemRequest struct {
+	CPE     string `json:"cpe" validate:"max=500"`
+	Vendor  string `json:"vendor" validate:"required_without=CPE,max=255"`
+	Product string `json:"product" validate:"required_without=CPE,max=255"`
+	Version string `json:"version" validate:"max=100"`
+}
+
+// inputs converts the request for the service
+func (req *SoftwareInventoryRequest) inputs() []service.InventoryItemInput {
+	inputs := make([]service.InventoryItemInput, len(req.Items))
+	for i, item := range req.Items {
+		inputs[i] = service.InventoryItemInput{
+			CPE:     item.CPE,
+			Vendor:  item.Vendor,
+			Product: item.Product,
+			Version: item.Version,
+		}
+	}
+	return inputs
+}
+
+// ListMine handles GET /v1/users/me/inventory
+func (h *SoftwareInventoryHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	items, err := h.inventoryService.ListMine(ctx, claims.UserID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list software inventory")
+		return
+	}
+
+	response.Success(w, items)
+}
+
+// ReplaceMine handles PUT /v1/users/me/inventory
+func (h *SoftwareInventoryHandler) ReplaceMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req SoftwareInventoryRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	items, err := h.inventoryService.ReplaceMine(ctx, claims.UserID, req.inputs())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to replace software inventory")
+		return
+	}
+
+	response.Success(w, items)
+}
+
+// ListForOrganization handles GET /v1/orgs/{id}/inventory
+func (h *SoftwareInventoryHandler) ListForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.inventoryService.ListForOrganization(ctx, orgID, claims.UserID, domain.UserRole(claims.Role))
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list organization software inventory")
+		return
+	}
+
+	response.Success(w, items)
+}
+
+// ReplaceForOrganization handles PUT /v1/orgs/{id}/inventory
+func (h *SoftwareInventoryHandler) ReplaceForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	orgID, ok := parseOrgID(w, r)
+	if !ok {
+		return
+	}
+
+	var req SoftwareInventoryRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	items, err := h.inventoryService.ReplaceForOrganization(ctx, orgID, claims.UserID, domain.UserRole(claims.Role), req.inputs())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to replace organization software inventory")
+		return
+	}
+
+	response.Success(w, items)
+}
+
+// handleError maps software inventory service errors to responses
+func (h *SoftwareInventoryHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var limitErr *domainerrors.LimitExceededError
+	if errors.As(err, &limitErr) {
+		response.LimitExceeded(w, limitErr.Resource, limitErr.Error())
+		return
+	}
+
+	if writeValidationError(w, err, requestID) {
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		response.Forbidden(w, "Only members of the organization can see its inventory, and only its admins can change it")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// SourceTrustHandler exposes automatic source trust calibration to administrators
+type SourceTrustHandler struct {
+	trustService SourceTrustService
+}
+
+// NewSourceTrustHandler creates a new source trust handler instance
+func NewSourceTrustHandler(trustService SourceTrustService) *SourceTrustHandler {
+	if trustService == nil {
+		panic("trustService cannot be nil")
+	}
+
+	return &SourceTrustHandler{
+		trustService: trustService,
+	}
+}
+
+// History handles GET /v1/admin/sources/{id}/trust-history - automatic trust score changes, newest first
+func (h *SourceTrustHandler) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	sourceID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid source ID format")
+		return
+	}
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	changes, total, err := h.trustService.History(ctx, sourceID, page, pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get source trust history")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, changes, meta)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *SourceTrustHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
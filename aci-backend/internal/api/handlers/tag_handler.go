@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// TagHandler handles tag management for administrators
+type TagHandler struct {
+	tagService TagService
+}
+
+// NewTagHandler creates a new tag handler instance
+func NewTagHandler(tagService TagService) *TagHandler {
+	if tagService == nil {
+		panic("tagService cannot be nil")
+	}
+
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// RenameTagRequest represents a request to rename a tag
+type RenameTagRequest struct {
+	Name string `json:"name"`
+}
+
+// MergeTagRequest represents a request to merge a tag into another
+type MergeTagRequest struct {
+	TargetID uuid.UUID `json:"target_id"`
+}
+
+// List handles GET /v1/admin/tags - tags with aliases and article counts, filtered by ?q=
+func (h *TagHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	page, pageSize, err := ParsePagination(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid pagination parameters", err.Error(), requestID)
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	tags, total, err := h.tagService.List(ctx, query, page, pageSize)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list tags")
+		return
+	}
+
+	meta := response.PageMeta(r, page, pageSize, total)
+
+	response.SuccessWithMeta(w, tags, meta)
+}
+
+// Rename handles PUT /v1/admin/tags/{id}
+func (h *TagHandler) Rename(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tagID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid tag ID format")
+		return
+	}
+
+	var req RenameTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	change, err := h.tagService.Rename(ctx, tagID, req.Name, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to rename tag")
+		return
+	}
+
+	response.Success(w, change)
+}
+
+// Merge handles POST /v1/admin/tags/{id}/merge - folds the tag into target_id
+func (h *TagHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tagID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid tag ID format")
+		return
+	}
+
+	var req MergeTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.TargetID == uuid.Nil {
+		response.BadRequest(w, "target_id is required")
+		return
+	}
+
+	change, err := h.tagService.Merge(ctx, tagID, req.TargetID, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to merge tag")
+		return
+	}
+
+	response.Success(w, change)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *TagHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error()+"; merge the tags instead")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// TenantHandler handles the workspaces of a multi-tenant deployment: their branding for the web
+// app and their management by the default workspace's administrators
+type TenantHandler struct {
+	tenantService TenantService
+}
+
+// NewTenantHandler creates a new tenant handler instance
+func NewTenantHandler(tenantService TenantService) *TenantHandler {
+	if tenantService == nil {
+		panic("tenantService cannot be nil")
+	}
+
+	return &TenantHandler{
+		tenantService: tenantService,
+	}
+}
+
+// Middleware returns the middleware scoping requests to the workspace served at their host
+func (h *TenantHandler) Middleware() func(http.Handler) http.Handler {
+	return middleware.Tenant(h.tenantService)
+}
+
+// CurrentTenantResponse is the workspace the web app is serving
+type CurrentTenantResponse struct {
+	Slug     *string               `json:"slug,omitempty"` // absent for the default workspace
+	Name     *string               `json:"name,omitempty"`
+	Branding domain.TenantBranding `json:"branding"`
+}
+
+// TenantRequest is the request body for creating or replacing a workspace
+type TenantRequest struct {
+	Slug     string                `json:"slug" validate:"required,max=63"`
+	Name     string                `json:"name" validate:"required,max=255"`
+	Hostname *string               `json:"hostname,omitempty" validate:"omitempty,hostname_rfc1123,max=255"`
+	Branding TenantBrandingRequest `json:"branding"`
+}
+
+// TenantBrandingRequest is the branding of a TenantRequest
+type TenantBrandingRequest struct {
+	DisplayName  *string `json:"display_name,omitempty" validate:"omitempty,max=255"`
+	LogoURL      *string `json:"logo_url,omitempty" validate:"omitempty,https_url"`
+	PrimaryColor *string `json:"primary_color,omitempty" validate:"omitempty,hexcolor,len=7"`
+	SupportEmail *string `json:"support_email,omitempty" validate:"omitempty,email"`
+}
+
+// toInput converts the request to the service's input
+func (r *TenantRequest) toInput() service.TenantInput {
+	return service.TenantInput{
+		Slug:     r.Slug,
+		Name:     r.Name,
+		Hostname: r.Hostname,
+		Branding: domain.TenantBranding{
+			DisplayName:  r.Branding.DisplayName,
+			LogoURL:      r.Branding.LogoURL,
+			PrimaryColor: r.Branding.PrimaryColor,
+			SupportEmail: r.Branding.SupportEmail,
+		},
+	}
+}
+
+// Current handles GET /v1/tenant - the workspace served at the request's host and its branding
+func (h *TenantHandler) Current(w http.ResponseWriter, r *http.Request) {
+	tenant := h.tenantService.Current(r.Context())
+	if tenant == nil {
+		response.Success(w, CurrentTenantResponse{})
+		return
+	}
+
+	response.Success(w, CurrentTenantResponse{
+		Slug:     &tenant.Slug,
+		Name:     &tenant.Name,
+		Branding: tenant.Branding,
+	})
+}
+
+// List handles GET /v1/admin/tenants
+func (h *TenantHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tenants, err := h.tenantService.List(ctx)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to list tenants")
+		return
+	}
+
+	response.Success(w, tenants)
+}
+
+// Get handles GET /v1/admin/tenants/{id}
+func (h *TenantHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid tenant ID format")
+		return
+	}
+
+	tenant, err := h.tenantService.Get(ctx, tenantID)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to get tenant")
+		return
+	}
+
+	response.Success(w, tenant)
+}
+
+// Create handles POST /v1/admin/tenants
+func (h *TenantHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req TenantRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	tenant, err := h.tenantService.Create(ctx, req.toInput(), suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to create tenant")
+		return
+	}
+
+	response.Created(w, tenant)
+}
+
+// Update handles PUT /v1/admin/tenants/{id}
+func (h *TenantHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid tenant ID format")
+		return
+	}
+
+	var req TenantRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	tenant, err := h.tenantService.Update(ctx, tenantID, req.toInput(), suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to update tenant")
+		return
+	}
+
+	response.Success(w, tenant)
+}
+
+// Delete handles DELETE /v1/admin/tenants/{id} - removes the workspace and all of its data
+func (h *TenantHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid tenant ID format")
+		return
+	}
+
+	if err := h.tenantService.Delete(ctx, tenantID, suggestionReviewer(r), GetClientIP(r), r.UserAgent()); err != nil {
+		h.handleError(w, err, requestID, "Failed to delete tenant")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *TenantHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	if writeValidationError(w, err, requestID) {
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ThreatActorHandler serves threat actor and ransomware group profiles and their articles
+type ThreatActorHandler struct {
+	actorService ThreatActorService
+}
+
+// NewThreatActorHandler creates a new threat actor handler instance
+func NewThreatActorHandler(actorService ThreatActorService) *ThreatActorHandler {
+	if actorService == nil {
+		panic("actorService cannot be nil")
+	}
+
+	return &ThreatActorHandler{
+		actorService: actorService,
+	}
+}
+
+// ThreatActorArticlesResponse is a threat actor profile with a page of its articles
+type ThreatActorArticlesResponse struct {
+	Actor    *domain.ThreatActor `json:"actor"`
+	Articles interface{}         `json:"articles"`
+}
+
+// List handles GET /v1/actors
+// Query params: kind (ransomware_group or threat_actor), q (name or alias), page, page_size
+func (h *ThreatActorHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	filter, err := parseThreatActorFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	actors, total, err := h.actorService.List(ctx, filter)
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Invalid filter parameters", validationErr.Message, requestID)
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to list threat actors")
+		response.InternalError(w, "Failed to retrieve threat actors", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, actors, meta)
+}
+
+// ListArticles handles GET /v1/actors/{slug}/articles
+// Accepts the same query parameters as the article list
+func (h *ThreatActorHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	fields, err := parseArticleFieldSelection(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	filter, err := parseArticleFilter(r)
+	if err != nil {
+		response.BadRequestWithDetails(w, "Invalid query parameters", err.Error(), requestID)
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		response.BadRequestWithDetails(w, "Invalid filter parameters", err.Error(), requestID)
+		return
+	}
+
+	actorSlug := chi.URLParam(r, "slug")
+	profile, articles, total, err := h.actorService.ListArticles(ctx, actorSlug, filter)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "threat actor", "Threat actor not found")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("slug", actorSlug).
+			Msg("Failed to list threat actor articles")
+		response.InternalError(w, "Failed to retrieve threat actor articles", requestID)
+		return
+	}
+
+	articleResponses := make([]ArticleResponse, len(articles))
+	for i, article := range articles {
+		articleResponses[i] = toArticleResponse(article)
+	}
+
+	data, err := applyAll(fields, articleResponses)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to apply field selection")
+		response.InternalError(w, "Failed to retrieve threat actor articles", requestID)
+		return
+	}
+
+	meta := response.PageMeta(r, filter.Page, filter.PageSize, total)
+
+	response.SuccessWithMeta(w, ThreatActorArticlesResponse{Actor: profile, Articles: data}, meta)
+}
+
+// parseThreatActorFilter parses threat actor list query parameters
+func parseThreatActorFilter(r *http.Request) (*domain.ThreatActorFilter, error) {
+	filter := domain.NewThreatActorFilter()
+	query := r.URL.Query()
+
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page parameter: %w", err)
+		}
+		filter.Page = page
+	}
+
+	if pageSizeStr := query.Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.Atoi(pageSizeStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page_size parameter: %w", err)
+		}
+		filter.PageSize = pageSize
+	}
+
+	if kind := query.Get("kind"); kind != "" {
+		actorKind := domain.ThreatActorKind(kind)
+		filter.Kind = &actorKind
+	}
+
+	if q := query.Get("q"); q != "" {
+		filter.Search = &q
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// ThreatLandscapeHandler exposes severity trends and threat rankings for dashboard charts
+type ThreatLandscapeHandler struct {
+	landscapeService ThreatLandscapeService
+}
+
+// NewThreatLandscapeHandler creates a new threat landscape handler instance
+func NewThreatLandscapeHandler(landscapeService ThreatLandscapeService) *ThreatLandscapeHandler {
+	if landscapeService == nil {
+		panic("landscapeService cannot be nil")
+	}
+
+	return &ThreatLandscapeHandler{
+		landscapeService: landscapeService,
+	}
+}
+
+// Get handles GET /v1/stats/threat-landscape
+// Query params: window (Go duration, e.g. 24h, 168h; default 720h), limit (top N, default 10)
+func (h *ThreatLandscapeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	window := service.DefaultThreatLandscapeWindow
+	if windowStr := r.URL.Query().Get("window"); windowStr != "" {
+		parsed, err := time.ParseDuration(windowStr)
+		if err != nil || parsed <= 0 || parsed > service.MaxThreatLandscapeWindow {
+			response.BadRequest(w, "Invalid window: must be a positive duration up to 2160h")
+			return
+		}
+		window = parsed
+	}
+
+	limit := service.DefaultThreatLandscapeTopN
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > service.MaxThreatLandscapeTopN {
+			response.BadRequest(w, "Invalid limit: must be between 1 and 50")
+			return
+		}
+		limit = parsed
+	}
+
+	landscape, err := h.landscapeService.Landscape(ctx, window, limit)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to build threat landscape")
+		response.InternalError(w, "Failed to retrieve threat landscape", requestID)
+		return
+	}
+
+	response.Success(w, landscape)
+}
@@ -1,26 +1,32 @@
 package handlers
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
 	"github.com/phillipboles/aci-backend/internal/api/middleware"
 	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/repository"
 	"github.com/phillipboles/aci-backend/internal/service"
 )
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	engagementService *service.EngagementService
+	engagementService EngagementService
 	userRepo          repository.UserRepository
+	exportService     UserExportService
+	deletionService   AccountDeletionService
 }
 
 // NewUserHandler creates a new user handler instance
 func NewUserHandler(
-	engagementService *service.EngagementService,
+	engagementService EngagementService,
 	userRepo repository.UserRepository,
 ) *UserHandler {
 	if engagementService == nil {
@@ -36,6 +42,16 @@ func NewUserHandler(
 	}
 }
 
+// SetExportService enables GET /v1/users/me/export
+func (h *UserHandler) SetExportService(exportService UserExportService) {
+	h.exportService = exportService
+}
+
+// SetDeletionService enables self-service account deletion
+func (h *UserHandler) SetDeletionService(deletionService AccountDeletionService) {
+	h.deletionService = deletionService
+}
+
 // UserResponse represents a user profile response
 type UserResponse struct {
 	ID            string  `json:"id"`
@@ -45,11 +61,20 @@ type UserResponse struct {
 	EmailVerified bool    `json:"email_verified"`
 	CreatedAt     string  `json:"created_at"`
 	LastLoginAt   *string `json:"last_login_at,omitempty"`
+
+	Status         string  `json:"status"` // active, suspended or banned
+	StatusReason   *string `json:"status_reason,omitempty"`
+	SuspendedUntil *string `json:"suspended_until,omitempty"`
 }
 
 // UpdateProfileRequest represents a user profile update request
 type UpdateProfileRequest struct {
-	Name string `json:"name"`
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+// DeleteAccountRequest confirms an account deletion request
+type DeleteAccountRequest struct {
+	Password string `json:"password" validate:"required"`
 }
 
 // UserStats represents user engagement statistics
@@ -91,21 +116,7 @@ func (h *UserHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userResponse := UserResponse{
-		ID:            user.ID.String(),
-		Email:         user.Email,
-		Name:          user.Name,
-		Role:          string(user.Role),
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
-	if user.LastLoginAt != nil {
-		lastLogin := user.LastLoginAt.Format("2006-01-02T15:04:05Z07:00")
-		userResponse.LastLoginAt = &lastLogin
-	}
-
-	response.Success(w, userResponse)
+	response.Success(w, toUserResponse(user))
 }
 
 // UpdateCurrentUser handles PATCH /v1/users/me - updates current user profile
@@ -123,20 +134,8 @@ func (h *UserHandler) UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Parse request body
 	var req UpdateProfileRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().
-			Err(err).
-			Str("request_id", requestID).
-			Msg("Failed to decode request body")
-		response.BadRequest(w, "Invalid request body")
-		return
-	}
-
-	// Validate input
-	if req.Name == "" {
-		response.BadRequest(w, "Name is required")
+	if !decodeAndValidate(w, r, &req) {
 		return
 	}
 
@@ -165,21 +164,7 @@ func (h *UserHandler) UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	userResponse := UserResponse{
-		ID:            user.ID.String(),
-		Email:         user.Email,
-		Name:          user.Name,
-		Role:          string(user.Role),
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
-	if user.LastLoginAt != nil {
-		lastLogin := user.LastLoginAt.Format("2006-01-02T15:04:05Z07:00")
-		userResponse.LastLoginAt = &lastLogin
-	}
-
-	response.Success(w, userResponse)
+	response.Success(w, toUserResponse(user))
 }
 
 // GetBookmarks handles GET /v1/users/me/bookmarks - returns paginated bookmarks
@@ -224,12 +209,7 @@ func (h *UserHandler) GetBookmarks(w http.ResponseWriter, r *http.Request) {
 		articleResponses[i] = toArticleResponse(article)
 	}
 
-	meta := &response.Meta{
-		Page:       page,
-		PageSize:   pageSize,
-		TotalCount: total,
-		TotalPages: CalculateTotalPages(total, pageSize),
-	}
+	meta := response.PageMeta(r, page, pageSize, total)
 
 	response.SuccessWithMeta(w, articleResponses, meta)
 }
@@ -282,12 +262,7 @@ func (h *UserHandler) GetReadingHistory(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	meta := &response.Meta{
-		Page:       page,
-		PageSize:   pageSize,
-		TotalCount: total,
-		TotalPages: CalculateTotalPages(total, pageSize),
-	}
+	meta := response.PageMeta(r, page, pageSize, total)
 
 	response.SuccessWithMeta(w, historyResponses, meta)
 }
@@ -333,3 +308,179 @@ func (h *UserHandler) GetStats(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, userStats)
 }
 
+
+// exportWriteTimeout replaces the server write timeout for streamed exports
+const exportWriteTimeout = 10 * time.Minute
+
+// Export handles GET /v1/users/me/export - streams the user's data as a download
+// Query params: format (csv for a zip archive of CSV files, or json; default csv)
+func (h *UserHandler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.exportService == nil {
+		response.ServiceUnavailable(w, "Data export is not available")
+		return
+	}
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = service.ExportFormatCSV
+	}
+
+	contentType := "application/zip"
+	extension := "zip"
+	switch format {
+	case service.ExportFormatCSV:
+	case service.ExportFormatJSON:
+		contentType = "application/json"
+		extension = "json"
+	default:
+		response.BadRequest(w, "Invalid format: must be csv or json")
+		return
+	}
+
+	// Large histories outlast the server write timeout
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(exportWriteTimeout)); err != nil {
+		log.Warn().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to extend write deadline for export")
+	}
+
+	filename := fmt.Sprintf("aci-export-%s.%s", time.Now().UTC().Format("20060102-150405"), extension)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+
+	if err := h.exportService.Export(ctx, claims.UserID, format, w); err != nil {
+		// Headers are already sent; the client receives a truncated file
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Str("format", format).
+			Msg("User data export failed")
+		return
+	}
+
+	log.Info().
+		Str("request_id", requestID).
+		Str("user_id", claims.UserID.String()).
+		Str("format", format).
+		Msg("User data exported")
+}
+
+// DeleteCurrentUser handles DELETE /v1/users/me - schedules the account for deletion
+// Sessions are revoked at once; personal data is purged after the grace period
+func (h *UserHandler) DeleteCurrentUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.deletionService == nil {
+		response.ServiceUnavailable(w, "Account deletion is not available")
+		return
+	}
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req DeleteAccountRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	deletion, err := h.deletionService.Request(ctx, claims.UserID, req.Password, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		if writeValidationError(w, err, requestID) {
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrUnauthorized) {
+			response.Forbidden(w, "Password is incorrect")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to schedule account deletion")
+		response.InternalError(w, "Failed to schedule account deletion", requestID)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, response.Response{Data: deletion})
+}
+
+// CancelDeletion handles DELETE /v1/users/me/deletion - withdraws a pending account deletion
+func (h *UserHandler) CancelDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	if h.deletionService == nil {
+		response.ServiceUnavailable(w, "Account deletion is not available")
+		return
+	}
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	cancelled, err := h.deletionService.Cancel(ctx, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to cancel account deletion")
+		response.InternalError(w, "Failed to cancel account deletion", requestID)
+		return
+	}
+
+	if !cancelled {
+		response.NotFoundResource(w, "account deletion", "No account deletion is pending")
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// toUserResponse converts a user to its profile response, leaving out credentials
+func toUserResponse(user *entities.User) UserResponse {
+	userResponse := UserResponse{
+		ID:            user.ID.String(),
+		Email:         user.Email,
+		Name:          user.Name,
+		Role:          string(user.Role),
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if user.LastLoginAt != nil {
+		lastLogin := user.LastLoginAt.Format("2006-01-02T15:04:05Z07:00")
+		userResponse.LastLoginAt = &lastLogin
+	}
+
+	userResponse.Status = string(user.EffectiveStatus(time.Now()))
+	if userResponse.Status != string(entities.UserStatusActive) {
+		userResponse.StatusReason = user.StatusReason
+		if user.SuspendedUntil != nil {
+			until := user.SuspendedUntil.Format("2006-01-02T15:04:05Z07:00")
+			userResponse.SuspendedUntil = &until
+		}
+	}
+
+	return userResponse
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// UserInviteHandler handles inviting users and accepting invitations
+type UserInviteHandler struct {
+	inviteService UserInviteService
+	cookies       *AuthCookies
+}
+
+// NewUserInviteHandler creates a new user invitation handler instance
+func NewUserInviteHandler(inviteService UserInviteService) *UserInviteHandler {
+	if inviteService == nil {
+		panic("inviteService cannot be nil")
+	}
+
+	return &UserInviteHandler{
+		inviteService: inviteService,
+	}
+}
+
+// SetAuthCookies sets the refresh token of an accepted invitation in its cookie in cookie auth mode
+func (h *UserInviteHandler) SetAuthCookies(cookies *AuthCookies) {
+	h.cookies = cookies
+}
+
+// InviteUserRequest represents a request to invite a user
+type InviteUserRequest struct {
+	Email            string     `json:"email"`
+	Name             string     `json:"name"`
+	Role             string     `json:"role,omitempty"`              // user, analyst or admin; defaults to user
+	OrganizationID   *uuid.UUID `json:"organization_id,omitempty"`   // organization the invitee joins
+	OrganizationRole string     `json:"organization_role,omitempty"` // admin or member; defaults to member
+}
+
+// InviteUserResponse returns the invited user and when their invitation link expires
+type InviteUserResponse struct {
+	User      UserResponse `json:"user"`
+	ExpiresAt string       `json:"expires_at"`
+}
+
+// InvitationResponse describes an open invitation to the invitee
+type InvitationResponse struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// AcceptInvitationRequest represents the invitee choosing their password
+type AcceptInvitationRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+	Name     string `json:"name,omitempty"` // replaces the name the admin gave
+}
+
+// Invite handles POST /v1/admin/users/invite
+func (h *UserInviteHandler) Invite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req InviteUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+		return
+	}
+
+	input := service.InviteInput{
+		Email:            req.Email,
+		Name:             req.Name,
+		Role:             entities.UserRole(req.Role),
+		OrganizationID:   req.OrganizationID,
+		OrganizationRole: domain.OrganizationRole(req.OrganizationRole),
+	}
+
+	user, expiresAt, err := h.inviteService.Invite(ctx, input, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to invite user")
+		return
+	}
+
+	response.Created(w, InviteUserResponse{
+		User:      toUserResponse(user),
+		ExpiresAt: expiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	})
+}
+
+// Get handles GET /v1/auth/invite?token=
+func (h *UserInviteHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.BadRequest(w, "token is required")
+		return
+	}
+
+	user, err := h.inviteService.Lookup(ctx, token)
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to look up invitation")
+		return
+	}
+
+	response.Success(w, InvitationResponse{
+		Email: user.Email,
+		Name:  user.Name,
+	})
+}
+
+// Accept handles POST /v1/auth/invite/accept
+func (h *UserInviteHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	var req AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+		return
+	}
+
+	if req.Token == "" {
+		response.BadRequest(w, "token is required")
+		return
+	}
+
+	user, tokens, err := h.inviteService.Accept(ctx, req.Token, req.Name, req.Password, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to accept invitation")
+		return
+	}
+
+	authResp := AuthResponse{
+		User:         userToDTO(user),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if h.cookies != nil {
+		h.cookies.SetRefreshToken(w, tokens.RefreshToken)
+		authResp.RefreshToken = ""
+	}
+
+	response.Success(w, authResp)
+}
+
+// handleError maps service errors to HTTP responses
+func (h *UserInviteHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.ConflictResource(w, "user", "A user with this email already exists")
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	if errors.Is(err, service.ErrInvitationExpired) {
+		response.Error(w, http.StatusGone, "LINK_EXPIRED", "This invitation has expired; ask an administrator to invite you again")
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
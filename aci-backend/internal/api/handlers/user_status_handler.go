@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// UserStatusHandler handles suspending, banning and reinstating user accounts
+type UserStatusHandler struct {
+	statusService UserStatusService
+}
+
+// NewUserStatusHandler creates a new user status handler instance
+func NewUserStatusHandler(statusService UserStatusService) *UserStatusHandler {
+	if statusService == nil {
+		panic("statusService cannot be nil")
+	}
+
+	return &UserStatusHandler{
+		statusService: statusService,
+	}
+}
+
+// Middleware returns the middleware that rejects suspended and banned users on authenticated routes
+func (h *UserStatusHandler) Middleware() func(http.Handler) http.Handler {
+	return middleware.ActiveUser(h.statusService)
+}
+
+// SuspendUserRequest represents a request to suspend or ban a user
+type SuspendUserRequest struct {
+	Reason string     `json:"reason"`
+	Until  *time.Time `json:"until,omitempty"` // suspension end; omitted suspends until lifted
+	Ban    bool       `json:"ban,omitempty"`   // a ban has no end
+}
+
+// Suspend handles POST /v1/admin/users/{id}/suspend
+func (h *UserStatusHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid user ID format")
+		return
+	}
+
+	var req SuspendUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequestWithDetails(w, "Invalid request body", nil, requestID)
+		return
+	}
+
+	status := entities.UserStatusSuspended
+	if req.Ban {
+		status = entities.UserStatusBanned
+	}
+
+	user, err := h.statusService.Suspend(ctx, userID, status, req.Reason, req.Until, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to suspend user")
+		return
+	}
+
+	response.Success(w, toUserResponse(user))
+}
+
+// Unsuspend handles POST /v1/admin/users/{id}/unsuspend
+func (h *UserStatusHandler) Unsuspend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid user ID format")
+		return
+	}
+
+	user, err := h.statusService.Unsuspend(ctx, userID, claims.UserID, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.handleError(w, err, requestID, "Failed to unsuspend user")
+		return
+	}
+
+	response.Success(w, toUserResponse(user))
+}
+
+// handleError maps service errors to HTTP responses
+func (h *UserStatusHandler) handleError(w http.ResponseWriter, err error, requestID, msg string) {
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		response.BadRequestWithDetails(w, "Validation failed", validationErr.Message, requestID)
+		return
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		response.NotFoundResource(w, notFoundErr.Resource, notFoundErr.Error())
+		return
+	}
+
+	log.Error().
+		Err(err).
+		Str("request_id", requestID).
+		Msg(msg)
+	response.InternalError(w, msg, requestID)
+}
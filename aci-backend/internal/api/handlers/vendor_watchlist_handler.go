@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// VendorWatchlistHandler handles the current user's vendor watchlist
+type VendorWatchlistHandler struct {
+	watchlistService VendorWatchlistService
+}
+
+// NewVendorWatchlistHandler creates a new vendor watchlist handler instance
+func NewVendorWatchlistHandler(watchlistService VendorWatchlistService) *VendorWatchlistHandler {
+	if watchlistService == nil {
+		panic("watchlistService cannot be nil")
+	}
+
+	return &VendorWatchlistHandler{
+		watchlistService: watchlistService,
+	}
+}
+
+// AddWatchedVendorRequest represents a request to watch a vendor
+type AddWatchedVendorRequest struct {
+	Vendor string `json:"vendor"`
+}
+
+// ListMine handles GET /v1/users/me/vendors
+func (h *VendorWatchlistHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	vendors, err := h.watchlistService.List(ctx, claims.UserID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to list watched vendors")
+		response.InternalError(w, "Failed to retrieve vendor watchlist", requestID)
+		return
+	}
+
+	response.Success(w, vendors)
+}
+
+// AddMine handles POST /v1/users/me/vendors
+// Watching a vendor also creates its watchlist alert
+func (h *VendorWatchlistHandler) AddMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	var req AddWatchedVendorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	vendor, err := h.watchlistService.Add(ctx, claims.UserID, req.Vendor, GetClientIP(r), r.UserAgent())
+	if err != nil {
+		var limitErr *domainerrors.LimitExceededError
+		if errors.As(err, &limitErr) {
+			response.LimitExceeded(w, limitErr.Resource, limitErr.Error())
+			return
+		}
+
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			response.BadRequestWithDetails(w, "Invalid vendor", validationErr.Message, requestID)
+			return
+		}
+
+		var conflictErr *domainerrors.ConflictError
+		if errors.As(err, &conflictErr) {
+			response.ConflictResource(w, conflictErr.Resource, conflictErr.Error())
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to add watched vendor")
+		response.InternalError(w, "Failed to add watched vendor", requestID)
+		return
+	}
+
+	response.Created(w, vendor)
+}
+
+// RemoveMine handles DELETE /v1/users/me/vendors/{id}
+// Unwatching a vendor also deletes its watchlist alert
+func (h *VendorWatchlistHandler) RemoveMine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	claims, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		response.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Invalid watched vendor ID")
+		return
+	}
+
+	if err := h.watchlistService.Remove(ctx, id, claims.UserID, GetClientIP(r), r.UserAgent()); err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			response.NotFoundResource(w, "watched vendor", "Watched vendor not found")
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("user_id", claims.UserID.String()).
+			Msg("Failed to remove watched vendor")
+		response.InternalError(w, "Failed to remove watched vendor", requestID)
+		return
+	}
+
+	response.NoContent(w)
+}
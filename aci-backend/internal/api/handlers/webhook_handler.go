@@ -6,29 +6,45 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
 	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/api/webhookschema"
 	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
 	"github.com/phillipboles/aci-backend/internal/repository"
 	"github.com/phillipboles/aci-backend/internal/service"
 )
 
 // WebhookHandler handles n8n webhook events
 type WebhookHandler struct {
-	articleService    *service.ArticleService
-	enrichmentService *service.EnrichmentService
-	webhookLogRepo    repository.WebhookLogRepository
-	webhookSecret     string
+	articleService      ArticleService
+	enrichmentService   EnrichmentService
+	notificationService NotificationService
+	ingestSLOService    IngestSLOService
+	webhookLogRepo      repository.WebhookLogRepository
+	webhookSecret       string
+	replayService       WebhookReplayService
+	requireTimestamp    bool
+	secretService       WebhookSecretService
+	requestService      EnrichmentRequestService
 }
 
 // WebhookPayload represents the incoming webhook payload from n8n
 type WebhookPayload struct {
-	EventType string           `json:"event_type"`
+	EventType string           `json:"event_type" validate:"required,max=100"`
 	Data      json.RawMessage  `json:"data"`
 	Metadata  *WebhookMetadata `json:"metadata,omitempty"`
 }
@@ -44,12 +60,15 @@ type WebhookMetadata struct {
 type ArticleCreatedData struct {
 	Title          string   `json:"title"`
 	Content        string   `json:"content"`
+	ContentFormat  string   `json:"content_format,omitempty"` // html (default) or markdown
 	Summary        string   `json:"summary,omitempty"`
 	CategorySlug   string   `json:"category_slug"`
+	CategorySlugs  []string `json:"category_slugs,omitempty"` // additional categories
 	Severity       string   `json:"severity,omitempty"`
 	Tags           []string `json:"tags,omitempty"`
 	SourceURL      string   `json:"source_url"`
 	SourceName     string   `json:"source_name,omitempty"`
+	ImageURL       string   `json:"image_url,omitempty"` // hero image, fetched and resized after ingestion
 	PublishedAt    string   `json:"published_at,omitempty"`
 	CVEs           []string `json:"cves,omitempty"`
 	Vendors        []string `json:"vendors,omitempty"`
@@ -58,15 +77,16 @@ type ArticleCreatedData struct {
 
 // ArticleUpdatedData represents article.updated event data
 type ArticleUpdatedData struct {
-	ArticleID   string   `json:"article_id"`
-	Title       *string  `json:"title,omitempty"`
-	Content     *string  `json:"content,omitempty"`
-	Summary     *string  `json:"summary,omitempty"`
-	Severity    *string  `json:"severity,omitempty"`
-	Tags        []string `json:"tags,omitempty"`
-	CVEs        []string `json:"cves,omitempty"`
-	Vendors     []string `json:"vendors,omitempty"`
-	IsPublished *bool    `json:"is_published,omitempty"`
+	ArticleID     string   `json:"article_id"`
+	Title         *string  `json:"title,omitempty"`
+	Content       *string  `json:"content,omitempty"`
+	ContentFormat *string  `json:"content_format,omitempty"`
+	Summary       *string  `json:"summary,omitempty"`
+	Severity      *string  `json:"severity,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	CVEs          []string `json:"cves,omitempty"`
+	Vendors       []string `json:"vendors,omitempty"`
+	IsPublished   *bool    `json:"is_published,omitempty"`
 }
 
 // ArticleDeletedData represents article.deleted event data
@@ -98,8 +118,8 @@ type IOC struct {
 
 // NewWebhookHandler creates a new webhook handler
 func NewWebhookHandler(
-	articleService *service.ArticleService,
-	enrichmentService *service.EnrichmentService,
+	articleService ArticleService,
+	enrichmentService EnrichmentService,
 	webhookLogRepo repository.WebhookLogRepository,
 	webhookSecret string,
 ) *WebhookHandler {
@@ -111,9 +131,46 @@ func NewWebhookHandler(
 	}
 }
 
+// SetNotificationService announces new published articles to WebSocket subscribers
+func (h *WebhookHandler) SetNotificationService(notificationService NotificationService) {
+	h.notificationService = notificationService
+}
+
+// SetIngestSLOService records the enriched and notified stages of each ingested article
+func (h *WebhookHandler) SetIngestSLOService(ingestSLOService IngestSLOService) {
+	h.ingestSLOService = ingestSLOService
+}
+
+// SetReplayService rejects stale and replayed timestamped webhooks
+// When requireTimestamp is set, requests without an X-N8N-Timestamp header are rejected too
+func (h *WebhookHandler) SetReplayService(replayService WebhookReplayService, requireTimestamp bool) {
+	h.replayService = replayService
+	h.requireTimestamp = requireTimestamp
+}
+
+// SetSecretService verifies signatures against every active secret version instead of the
+// single configured secret
+func (h *WebhookHandler) SetSecretService(secretService WebhookSecretService) {
+	h.secretService = secretService
+}
+
+// SetEnrichmentRequestService sends new articles to an external pipeline for enrichment while
+// the AI provider is disabled; the pipeline answers with an enrichment.complete event
+func (h *WebhookHandler) SetEnrichmentRequestService(requestService EnrichmentRequestService) {
+	h.requestService = requestService
+}
+
 // HandleN8nWebhook handles POST /v1/webhooks/n8n
 func (h *WebhookHandler) HandleN8nWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	requestID := getRequestID(ctx)
+	receivedAt := time.Now()
+
+	// Rejected requests are labelled "unknown" so arbitrary event types cannot inflate label cardinality
+	eventType, outcome := "unknown", "rejected"
+	defer func() {
+		metrics.ObserveWebhook(eventType, outcome, time.Since(receivedAt))
+	}()
 
 	// Read body
 	body, err := io.ReadAll(r.Body)
@@ -123,13 +180,54 @@ func (h *WebhookHandler) HandleN8nWebhook(w http.ResponseWriter, r *http.Request
 	}
 	defer r.Body.Close()
 
-	// Verify HMAC signature
+	// Verify HMAC signature; a timestamped request signs "<timestamp>.<body>"
 	signature := r.Header.Get("X-N8N-Signature")
-	if !h.verifySignature(body, signature) {
+	timestamp := r.Header.Get("X-N8N-Timestamp")
+	if timestamp == "" && h.requireTimestamp {
+		response.Unauthorized(w, "X-N8N-Timestamp header is required")
+		return
+	}
+
+	signed := body
+	if timestamp != "" {
+		signed = append([]byte(timestamp+"."), body...)
+	}
+
+	keyVersion, ok := h.verifySignature(r.Context(), signed, signature)
+	if !ok {
 		response.Unauthorized(w, "invalid signature")
 		return
 	}
 
+	if timestamp == "" {
+		log.Warn().
+			Str("request_id", requestID).
+			Int("key_version", keyVersion).
+			Msg("Accepted n8n webhook without X-N8N-Timestamp; body-only signatures are deprecated, sign the timestamp and set N8N_WEBHOOK_REQUIRE_TIMESTAMP=true")
+	}
+
+	if timestamp != "" && h.replayService != nil {
+		if err := h.replayService.Check(ctx, timestamp, strings.TrimPrefix(signature, "sha256=")); err != nil {
+			if errors.Is(err, service.ErrWebhookTimestampInvalid) ||
+				errors.Is(err, service.ErrWebhookTimestampSkew) ||
+				errors.Is(err, service.ErrWebhookReplayed) {
+				response.Unauthorized(w, err.Error())
+				return
+			}
+
+			response.InternalError(w, "failed to verify webhook", requestID)
+			return
+		}
+
+		// The signature is claimed before the event is processed; a delivery that is not accepted
+		// gives it back so the sender can retry it
+		defer func() {
+			if outcome != "success" {
+				h.releaseSignature(ctx, requestID, strings.TrimPrefix(signature, "sha256="))
+			}
+		}()
+	}
+
 	// Parse payload
 	var payload WebhookPayload
 	if err := json.Unmarshal(body, &payload); err != nil {
@@ -137,8 +235,13 @@ func (h *WebhookHandler) HandleN8nWebhook(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if payload.EventType == "" {
-		response.BadRequest(w, "event_type is required")
+	if err := validator.Struct(&payload); err != nil {
+		var invalid *validator.ValidationErrors
+		if errors.As(err, &invalid) {
+			response.BadRequestWithDetails(w, "Invalid webhook payload", invalid.Errors, requestID)
+			return
+		}
+		response.BadRequest(w, "invalid webhook payload")
 		return
 	}
 
@@ -159,60 +262,100 @@ func (h *WebhookHandler) HandleN8nWebhook(w http.ResponseWriter, r *http.Request
 		workflowID,
 		executionID,
 	)
+	webhookLog.KeyVersion = &keyVersion
+
+	log.Debug().
+		Str("webhook_log_id", webhookLog.ID.String()).
+		Str("event_type", payload.EventType).
+		Int("key_version", keyVersion).
+		Msg("Webhook signature verified")
 
 	if err := h.webhookLogRepo.Create(ctx, webhookLog); err != nil {
 		// Log error but don't fail the request
-		fmt.Printf("Failed to create webhook log: %v\n", err)
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("webhook_log_id", webhookLog.ID.String()).
+			Msg("Failed to create webhook log")
 	}
 
 	// Mark as processing
 	webhookLog.MarkProcessing()
 	_ = h.webhookLogRepo.Update(ctx, webhookLog)
 
+	if _, ok := webhookschema.Schema(payload.EventType); !ok {
+		eventType = "unsupported"
+		webhookLog.MarkFailed(fmt.Sprintf("unsupported event type: %s", payload.EventType))
+		_ = h.webhookLogRepo.Update(ctx, webhookLog)
+		response.BadRequest(w, "unsupported event type")
+		return
+	}
+	eventType = payload.EventType
+
+	// Validate the whole payload against the event type's published schema
+	if fieldErrors := webhookschema.Validate(payload.EventType, body); len(fieldErrors) > 0 {
+		invalid := &validator.ValidationErrors{Errors: fieldErrors}
+		webhookLog.MarkFailed(fmt.Sprintf("invalid payload: %s", invalid.Error()))
+		_ = h.webhookLogRepo.Update(ctx, webhookLog)
+		response.BadRequestWithDetails(w, "Invalid webhook payload", fieldErrors, requestID)
+		return
+	}
+
 	// Route by event type
 	var result interface{}
 	var handlerErr error
 
 	switch payload.EventType {
 	case "article.created":
-		result, handlerErr = h.handleArticleCreated(ctx, payload.Data)
+		result, handlerErr = h.handleArticleCreated(ctx, payload.Data, receivedAt)
 	case "article.updated":
 		result, handlerErr = h.handleArticleUpdated(ctx, payload.Data)
 	case "article.deleted":
 		result, handlerErr = h.handleArticleDeleted(ctx, payload.Data)
 	case "bulk.import":
-		result, handlerErr = h.handleBulkImport(ctx, payload.Data)
+		result, handlerErr = h.handleBulkImport(ctx, payload.Data, receivedAt)
 	case "enrichment.complete":
 		result, handlerErr = h.handleEnrichmentComplete(ctx, payload.Data)
 	default:
-		webhookLog.MarkFailed(fmt.Sprintf("unsupported event type: %s", payload.EventType))
-		_ = h.webhookLogRepo.Update(ctx, webhookLog)
-		response.BadRequest(w, "unsupported event type")
-		return
+		// Every event type with a schema must be routed above
+		handlerErr = fmt.Errorf("no handler for event type: %s", payload.EventType)
 	}
 
 	// Handle errors
 	if handlerErr != nil {
+		outcome = "failed"
 		webhookLog.MarkFailed(handlerErr.Error())
 		_ = h.webhookLogRepo.Update(ctx, webhookLog)
-		response.InternalError(w, handlerErr.Error(), "")
+		response.InternalError(w, handlerErr.Error(), requestID)
 		return
 	}
 
 	// Mark as success
+	outcome = "success"
 	webhookLog.MarkSuccess()
 	_ = h.webhookLogRepo.Update(ctx, webhookLog)
 
 	// Return 202 Accepted with job_id
-	response.JSON(w, http.StatusAccepted, map[string]interface{}{
+	response.JSON(w, http.StatusAccepted, response.Response{Data: map[string]interface{}{
 		"job_id": webhookLog.ID.String(),
 		"status": "accepted",
 		"result": result,
-	})
+	}})
+}
+
+// releaseSignature releases a claimed signature, logging a failure since the response is already decided
+// It outlives the request context so a disconnected sender can still retry
+func (h *WebhookHandler) releaseSignature(ctx context.Context, requestID, signature string) {
+	if err := h.replayService.Release(context.WithoutCancel(ctx), signature); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to release webhook signature; the sender cannot retry this request until it expires")
+	}
 }
 
 // handleArticleCreated handles article.created events
-func (h *WebhookHandler) handleArticleCreated(ctx context.Context, data json.RawMessage) (interface{}, error) {
+func (h *WebhookHandler) handleArticleCreated(ctx context.Context, data json.RawMessage, receivedAt time.Time) (interface{}, error) {
 	var articleData ArticleCreatedData
 	if err := json.Unmarshal(data, &articleData); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal article data: %w", err)
@@ -222,34 +365,41 @@ func (h *WebhookHandler) handleArticleCreated(ctx context.Context, data json.Raw
 	serviceData := service.ArticleCreatedData{
 		Title:          articleData.Title,
 		Content:        articleData.Content,
+		ContentFormat:  articleData.ContentFormat,
 		Summary:        articleData.Summary,
 		CategorySlug:   articleData.CategorySlug,
+		CategorySlugs:  articleData.CategorySlugs,
 		Severity:       articleData.Severity,
 		Tags:           articleData.Tags,
 		SourceURL:      articleData.SourceURL,
 		SourceName:     articleData.SourceName,
+		ImageURL:       articleData.ImageURL,
 		PublishedAt:    articleData.PublishedAt,
 		CVEs:           articleData.CVEs,
 		Vendors:        articleData.Vendors,
 		SkipEnrichment: articleData.SkipEnrichment,
+		ReceivedAt:     receivedAt,
 	}
 
 	article, err := h.articleService.CreateArticle(ctx, serviceData)
 	if err != nil {
+		// A duplicate under another URL was linked to the stored article, so the event is handled
+		var duplicateErr *service.DuplicateArticleError
+		if errors.As(err, &duplicateErr) {
+			return map[string]interface{}{
+				"article_id":       duplicateErr.ArticleID.String(),
+				"duplicate":        true,
+				"duplicate_reason": duplicateErr.Reason,
+			}, nil
+		}
+
 		return nil, fmt.Errorf("failed to create article: %w", err)
 	}
 
-	// Trigger AI enrichment asynchronously if not skipped
-	if !articleData.SkipEnrichment && h.enrichmentService != nil {
-		go func() {
-			enrichCtx := context.Background()
-			if err := h.enrichmentService.EnrichArticle(enrichCtx, article.ID); err != nil {
-				fmt.Printf("Failed to enrich article %s: %v\n", article.ID, err)
-			} else {
-				fmt.Printf("Successfully enriched article %s\n", article.ID)
-			}
-		}()
-	}
+	// Enrich (unless skipped) and notify subscribers asynchronously
+	requestctx.Go(ctx, func(ctx context.Context) {
+		h.completeIngest(ctx, article, !articleData.SkipEnrichment)
+	})
 
 	return map[string]interface{}{
 		"article_id": article.ID.String(),
@@ -257,6 +407,56 @@ func (h *WebhookHandler) handleArticleCreated(ctx context.Context, data json.Raw
 	}, nil
 }
 
+// completeIngest runs the post-persist pipeline stages for a new article
+// Enrichment and notification timestamps are recorded for latency SLO tracking.
+// ctx is detached from the request but keeps its workspace
+func (h *WebhookHandler) completeIngest(ctx context.Context, article *domain.Article, enrich bool) {
+	if enrich && h.enrichmentService != nil && h.enrichmentService.Enabled() {
+		if err := h.enrichmentService.EnrichArticle(ctx, article.ID); err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestctx.RequestID(ctx)).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to enrich article")
+		} else {
+			log.Info().
+				Str("request_id", requestctx.RequestID(ctx)).
+				Str("article_id", article.ID.String()).
+				Msg("Enriched article")
+			if h.ingestSLOService != nil {
+				h.ingestSLOService.RecordStage(ctx, article.ID, domain.IngestStageEnriched, time.Now())
+			}
+		}
+	} else if enrich && h.requestService != nil {
+		// The enriched stage is recorded when the pipeline calls back
+		if err := h.requestService.Request(ctx, article); err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestctx.RequestID(ctx)).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to request enrichment of article")
+		}
+	}
+
+	// Articles held for review are announced when an admin approves them
+	if h.notificationService == nil || !article.IsPublished {
+		return
+	}
+
+	if err := h.notificationService.NotifyNewArticle(article); err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestctx.RequestID(ctx)).
+			Str("article_id", article.ID.String()).
+			Msg("Failed to notify new article")
+		return
+	}
+
+	if h.ingestSLOService != nil {
+		h.ingestSLOService.RecordStage(ctx, article.ID, domain.IngestStageNotified, time.Now())
+	}
+}
+
 // handleArticleUpdated handles article.updated events
 func (h *WebhookHandler) handleArticleUpdated(ctx context.Context, data json.RawMessage) (interface{}, error) {
 	var updateData ArticleUpdatedData
@@ -271,14 +471,15 @@ func (h *WebhookHandler) handleArticleUpdated(ctx context.Context, data json.Raw
 
 	// Convert to service data
 	serviceData := service.ArticleUpdatedData{
-		Title:       updateData.Title,
-		Content:     updateData.Content,
-		Summary:     updateData.Summary,
-		Severity:    updateData.Severity,
-		Tags:        updateData.Tags,
-		CVEs:        updateData.CVEs,
-		Vendors:     updateData.Vendors,
-		IsPublished: updateData.IsPublished,
+		Title:         updateData.Title,
+		Content:       updateData.Content,
+		ContentFormat: updateData.ContentFormat,
+		Summary:       updateData.Summary,
+		Severity:      updateData.Severity,
+		Tags:          updateData.Tags,
+		CVEs:          updateData.CVEs,
+		Vendors:       updateData.Vendors,
+		IsPublished:   updateData.IsPublished,
 	}
 
 	article, err := h.articleService.UpdateArticle(ctx, articleID, serviceData)
@@ -315,7 +516,7 @@ func (h *WebhookHandler) handleArticleDeleted(ctx context.Context, data json.Raw
 }
 
 // handleBulkImport handles bulk.import events
-func (h *WebhookHandler) handleBulkImport(ctx context.Context, data json.RawMessage) (interface{}, error) {
+func (h *WebhookHandler) handleBulkImport(ctx context.Context, data json.RawMessage, receivedAt time.Time) (interface{}, error) {
 	var bulkData BulkImportData
 	if err := json.Unmarshal(data, &bulkData); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal bulk data: %w", err)
@@ -327,16 +528,19 @@ func (h *WebhookHandler) handleBulkImport(ctx context.Context, data json.RawMess
 		serviceArticles[i] = service.ArticleCreatedData{
 			Title:          article.Title,
 			Content:        article.Content,
+			ContentFormat:  article.ContentFormat,
 			Summary:        article.Summary,
 			CategorySlug:   article.CategorySlug,
 			Severity:       article.Severity,
 			Tags:           article.Tags,
 			SourceURL:      article.SourceURL,
 			SourceName:     article.SourceName,
+			ImageURL:       article.ImageURL,
 			PublishedAt:    article.PublishedAt,
 			CVEs:           article.CVEs,
 			Vendors:        article.Vendors,
 			SkipEnrichment: article.SkipEnrichment,
+			ReceivedAt:     receivedAt,
 		}
 	}
 
@@ -362,8 +566,39 @@ func (h *WebhookHandler) handleEnrichmentComplete(ctx context.Context, data json
 		return nil, fmt.Errorf("failed to unmarshal enrichment data: %w", err)
 	}
 
-	// TODO: Implement enrichment handling
-	// This would update an article with AI-generated enrichment data
+	articleID, err := uuid.Parse(enrichmentData.ArticleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid article ID: %w", err)
+	}
+
+	if h.enrichmentService == nil {
+		return nil, fmt.Errorf("enrichment service is not available")
+	}
+
+	result := &ai.EnrichmentResult{
+		RecommendedActions: enrichmentData.RecommendedActions,
+		IOCs:               make([]ai.IOC, len(enrichmentData.IOCs)),
+	}
+	if enrichmentData.ThreatType != nil {
+		result.ThreatType = *enrichmentData.ThreatType
+	}
+	if enrichmentData.AttackVector != nil {
+		result.AttackVector = *enrichmentData.AttackVector
+	}
+	if enrichmentData.ImpactAssessment != nil {
+		result.ImpactAssessment = *enrichmentData.ImpactAssessment
+	}
+	for i, ioc := range enrichmentData.IOCs {
+		result.IOCs[i] = ai.IOC{Type: ioc.Type, Value: ioc.Value, Context: ioc.Context}
+	}
+
+	if err := h.enrichmentService.ApplyExternalEnrichment(ctx, articleID, result); err != nil {
+		return nil, fmt.Errorf("failed to apply enrichment: %w", err)
+	}
+
+	if h.ingestSLOService != nil {
+		h.ingestSLOService.RecordStage(ctx, articleID, domain.IngestStageEnriched, time.Now())
+	}
 
 	return map[string]interface{}{
 		"article_id": enrichmentData.ArticleID,
@@ -371,6 +606,37 @@ func (h *WebhookHandler) handleEnrichmentComplete(ctx context.Context, data json
 	}, nil
 }
 
+// ListSchemas handles GET /v1/webhooks/schemas - returns the JSON Schema of every event type,
+// so workflow authors can validate payloads before sending them
+func (h *WebhookHandler) ListSchemas(w http.ResponseWriter, r *http.Request) {
+	eventTypes := webhookschema.EventTypes()
+	schemas := make(map[string]json.RawMessage, len(eventTypes))
+	for _, eventType := range eventTypes {
+		schemas[eventType], _ = webhookschema.Schema(eventType)
+	}
+
+	response.Success(w, map[string]interface{}{
+		"event_types": eventTypes,
+		"schemas":     schemas,
+	})
+}
+
+// GetSchema handles GET /v1/webhooks/schemas/{eventType} - returns one event type's JSON Schema
+// as a bare document, ready for a schema validator
+func (h *WebhookHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	eventType := strings.TrimSuffix(chi.URLParam(r, "eventType"), ".json")
+
+	schema, ok := webhookschema.Schema(eventType)
+	if !ok {
+		response.NotFoundResource(w, "event schema", "No schema for this event type")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(schema)
+}
+
 // TriggerEnrichmentRequest represents the request to trigger enrichment
 type TriggerEnrichmentRequest struct {
 	Limit int `json:"limit"`
@@ -402,6 +668,11 @@ func (h *WebhookHandler) TriggerEnrichment(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if !h.enrichmentService.Enabled() {
+		response.ServiceUnavailable(w, "enrichment is disabled (AI_PROVIDER=disabled)")
+		return
+	}
+
 	// Trigger enrichment
 	enrichedCount, err := h.enrichmentService.EnrichPendingArticles(ctx, req.Limit)
 	if err != nil {
@@ -409,30 +680,34 @@ func (h *WebhookHandler) TriggerEnrichment(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	response.JSON(w, http.StatusOK, map[string]interface{}{
+	response.SuccessWithMessage(w, map[string]interface{}{
 		"enriched_count": enrichedCount,
 		"limit":          req.Limit,
-		"message":        fmt.Sprintf("Successfully enriched %d articles", enrichedCount),
-	})
+	}, fmt.Sprintf("Successfully enriched %d articles", enrichedCount))
 }
 
-// verifySignature verifies the HMAC-SHA256 signature
-func (h *WebhookHandler) verifySignature(payload []byte, signature string) bool {
+// verifySignature verifies the HMAC-SHA256 signature against the secrets of the request's
+// workspace and returns the secret version that made it
+func (h *WebhookHandler) verifySignature(ctx context.Context, payload []byte, signature string) (int, bool) {
 	if signature == "" {
-		return false
+		return 0, false
 	}
 
 	// Parse "sha256=<hex>" format
 	parts := strings.SplitN(signature, "=", 2)
 	if len(parts) != 2 {
-		return false
+		return 0, false
 	}
 
 	algorithm := parts[0]
 	receivedHex := parts[1]
 
 	if algorithm != "sha256" {
-		return false
+		return 0, false
+	}
+
+	if h.secretService != nil {
+		return h.secretService.Verify(ctx, payload, receivedHex)
 	}
 
 	// Compute HMAC-SHA256
@@ -442,5 +717,5 @@ func (h *WebhookHandler) verifySignature(payload []byte, signature string) bool
 	expectedHex := hex.EncodeToString(expectedMAC)
 
 	// Compare using constant-time comparison
-	return hmac.Equal([]byte(expectedHex), []byte(receivedHex))
+	return 0, hmac.Equal([]byte(expectedHex), []byte(receivedHex))
 }
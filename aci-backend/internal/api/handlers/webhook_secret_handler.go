@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// WebhookSecretHandler handles rotation of the n8n webhook secret
+type WebhookSecretHandler struct {
+	secretService WebhookSecretService
+}
+
+// NewWebhookSecretHandler creates a new webhook secret handler instance
+func NewWebhookSecretHandler(secretService WebhookSecretService) *WebhookSecretHandler {
+	if secretService == nil {
+		panic("secretService cannot be nil")
+	}
+
+	return &WebhookSecretHandler{
+		secretService: secretService,
+	}
+}
+
+// List handles GET /v1/admin/webhook-secrets
+func (h *WebhookSecretHandler) List(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.secretService.List(r.Context()))
+}
+
+// Rotate handles POST /v1/admin/webhook-secrets/rotate
+func (h *WebhookSecretHandler) Rotate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := getRequestID(ctx)
+
+	rotation, err := h.secretService.Rotate(ctx, suggestionReviewer(r), GetClientIP(r), r.UserAgent())
+	if errors.Is(err, service.ErrWebhookSecretKeyMissing) {
+		response.ServiceUnavailable(w, "Webhook secrets cannot be rotated until N8N_WEBHOOK_SECRET_KEY is configured")
+		return
+	}
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Msg("Failed to rotate webhook secret")
+		response.InternalError(w, "Failed to rotate webhook secret", requestID)
+		return
+	}
+
+	response.Created(w, rotation)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+// WebSocketStatsHandler exposes realtime hub health to administrators
+type WebSocketStatsHandler struct {
+	notificationService NotificationService
+}
+
+// NewWebSocketStatsHandler creates a new WebSocket stats handler instance
+func NewWebSocketStatsHandler(notificationService NotificationService) *WebSocketStatsHandler {
+	if notificationService == nil {
+		panic("notificationService cannot be nil")
+	}
+
+	return &WebSocketStatsHandler{
+		notificationService: notificationService,
+	}
+}
+
+// GetStats handles GET /v1/admin/websocket/stats
+// Returns connection totals, delivery counters, and per-connection heartbeat metrics
+func (h *WebSocketStatsHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	response.Success(w, h.notificationService.GetHubStats())
+}
@@ -2,12 +2,18 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
 	"github.com/phillipboles/aci-backend/internal/api/response"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
 // contextKey is a custom type for context keys to avoid collisions
@@ -52,8 +58,19 @@ func Auth(jwtService jwt.Service) func(http.Handler) http.Handler {
 				return
 			}
 
+			// Scope queries to the token's workspace; at a workspace's own host the token must be from it
+			tenantID := uuid.Nil
+			if claims.TenantID != nil {
+				tenantID = *claims.TenantID
+			}
+			if hostTenant, ok := hostTenantFromContext(r.Context()); ok && hostTenant != tenantID {
+				response.Unauthorized(w, "Token was issued for another workspace")
+				return
+			}
+
 			// Store claims in context
 			ctx := context.WithValue(r.Context(), userClaimsKey, claims)
+			ctx = repository.WithTenant(ctx, tenantID)
 
 			// Call next handler with updated context
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -61,6 +78,56 @@ func Auth(jwtService jwt.Service) func(http.Handler) http.Handler {
 	}
 }
 
+// UserStatusChecker reports whether an authenticated user may still use the API
+type UserStatusChecker interface {
+	// CheckStatus returns an AccountBlockedError for a suspended or banned user
+	CheckStatus(ctx context.Context, userID uuid.UUID) error
+}
+
+// ActiveUser middleware rejects suspended and banned users whose access tokens have not expired yet
+// It must run after Auth
+func ActiveUser(checker UserStatusChecker) func(http.Handler) http.Handler {
+	if checker == nil {
+		panic("checker cannot be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := GetUserFromContext(r.Context())
+			if !ok {
+				response.Unauthorized(w, "Authentication required")
+				return
+			}
+
+			err := checker.CheckStatus(r.Context(), claims.UserID)
+			if err != nil {
+				var blockedErr *domainerrors.AccountBlockedError
+				if errors.As(err, &blockedErr) {
+					response.AccountBlocked(w, blockedErr.Status, blockedErr.Reason, blockedErr.Until)
+					return
+				}
+
+				var notFoundErr *domainerrors.NotFoundError
+				if errors.As(err, &notFoundErr) {
+					response.Unauthorized(w, "Account no longer exists")
+					return
+				}
+
+				requestID := GetRequestID(r.Context())
+				log.Error().
+					Err(err).
+					Str("request_id", requestID).
+					Str("user_id", claims.UserID.String()).
+					Msg("Failed to check user status")
+				response.InternalError(w, "Failed to check account status", requestID)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // RequireRole middleware checks if user has required role
 func RequireRole(role string) func(http.Handler) http.Handler {
 	if role == "" {
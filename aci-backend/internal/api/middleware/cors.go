@@ -32,6 +32,8 @@ func DefaultCORSConfig() CORSConfig {
 			"Authorization",
 			"Content-Type",
 			"X-Request-ID",
+			"X-API-Key",
+			CSRFHeaderName,
 		},
 		ExposedHeaders: []string{
 			"X-Request-ID",
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+const (
+	// CSRFCookieName holds the CSRF token issued by GET /v1/auth/csrf
+	CSRFCookieName = "aci_csrf"
+
+	// CSRFHeaderName carries the CSRF token back on requests that need it
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// CSRF middleware protects endpoints that authenticate with a cookie the browser sends on its own
+// Unsafe requests carrying the named cookie must repeat the CSRF cookie's value in X-CSRF-Token
+// (the double-submit pattern), which another site cannot read; requests without the cookie,
+// such as bearer clients sending the token in the body, are not affected
+func CSRF(protectedCookie string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if _, err := r.Cookie(protectedCookie); err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := r.Header.Get(CSRFHeaderName)
+			cookie, err := r.Cookie(CSRFCookieName)
+			if err != nil || header == "" || cookie.Value == "" ||
+				subtle.ConstantTimeCompare([]byte(header), []byte(cookie.Value)) != 1 {
+				response.Error(w, http.StatusForbidden, response.ErrCodeCSRFFailed,
+					"Missing or invalid CSRF token; fetch one from /v1/auth/csrf")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -24,11 +24,19 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// probePaths are polled by load balancers and Kubernetes and not worth logging
+var probePaths = map[string]bool{
+	"/health":  true,
+	"/ready":   true,
+	"/healthz": true,
+	"/readyz":  true,
+}
+
 // Logger is a middleware that logs HTTP requests using zerolog
 func Logger(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Skip health check endpoints
-		if r.URL.Path == "/health" || r.URL.Path == "/ready" {
+		if probePaths[r.URL.Path] {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, so unknown paths cannot inflate label cardinality
+const unmatchedRoute = "unmatched"
+
+// Metrics records request latency and status by route pattern
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		done := metrics.HTTPRequestStarted()
+		defer done()
+
+		rw := &responseWriter{
+			ResponseWriter: w,
+			status:         http.StatusOK,
+		}
+
+		next.ServeHTTP(rw, r)
+
+		metrics.ObserveHTTPRequest(r.Method, routePattern(r), rw.status, time.Since(start))
+	})
+}
+
+// routePattern returns the matched chi route pattern; it is complete only after routing has finished
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return unmatchedRoute
+}
+
+// MetricsAuth requires the scrape token as a bearer token; an empty token leaves the endpoint open
+func MetricsAuth(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if token == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				response.Unauthorized(w, "Invalid metrics token")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
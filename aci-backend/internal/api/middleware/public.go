@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/httprate"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+)
+
+// PublicAPIKeyHeader carries the key for the public API
+const PublicAPIKeyHeader = "X-API-Key"
+
+const publicAPIKeyContextKey authContextKey = "public_api_key"
+
+// maxCachedResponses bounds the public response cache; responses past it are served uncached
+const maxCachedResponses = 2000
+
+// PublicAPIKeyAuthenticator resolves a plaintext public API key
+type PublicAPIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, plaintext string) (*domain.PublicAPIKey, error)
+}
+
+// PublicAPIKey authenticates the optional X-API-Key header and stores the key in the context
+// An invalid key is rejected; a missing key is rejected only when anonymous access is off
+func PublicAPIKey(authenticator PublicAPIKeyAuthenticator, allowAnonymous bool) func(http.Handler) http.Handler {
+	if authenticator == nil {
+		panic("authenticator cannot be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			plaintext := strings.TrimSpace(r.Header.Get(PublicAPIKeyHeader))
+			if plaintext == "" {
+				if !allowAnonymous {
+					response.Unauthorized(w, "Missing API key")
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key, err := authenticator.Authenticate(r.Context(), plaintext)
+			if err != nil {
+				response.Unauthorized(w, "Invalid or revoked API key")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), publicAPIKeyContextKey, key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// GetPublicAPIKeyFromContext retrieves the authenticated public API key from request context
+func GetPublicAPIKeyFromContext(ctx context.Context) (*domain.PublicAPIKey, bool) {
+	key, ok := ctx.Value(publicAPIKeyContextKey).(*domain.PublicAPIKey)
+	return key, ok
+}
+
+// PublicRateLimiter limits public API requests per minute: by key at the key's own rate,
+// and anonymous requests by IP address at anonymousPerMinute
+func PublicRateLimiter(anonymousPerMinute int) func(http.Handler) http.Handler {
+	if anonymousPerMinute < 1 {
+		// Anonymous requests are rejected before this point; the limit only needs to be valid
+		anonymousPerMinute = 1
+	}
+
+	limiter := httprate.Limit(anonymousPerMinute, time.Minute,
+		httprate.WithKeyFuncs(func(r *http.Request) (string, error) {
+			if key, ok := GetPublicAPIKeyFromContext(r.Context()); ok {
+				return "key:" + key.ID.String(), nil
+			}
+			ip, err := httprate.KeyByIP(r)
+			return "ip:" + ip, err
+		}),
+		httprate.WithLimitHandler(func(w http.ResponseWriter, r *http.Request) {
+			response.TooManyRequests(w, "Rate limit exceeded")
+		}),
+	)
+
+	return func(next http.Handler) http.Handler {
+		limited := limiter(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if key, ok := GetPublicAPIKeyFromContext(r.Context()); ok {
+				r = r.WithContext(httprate.WithRequestLimit(r.Context(), key.RequestsPerMinute))
+			}
+			limited.ServeHTTP(w, r)
+		})
+	}
+}
+
+// cachedResponse is a successful GET response kept by ResponseCache
+type cachedResponse struct {
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// ResponseCache serves successful GET responses from memory for ttl and marks them publicly
+// cacheable, so CDNs and browsers can serve them too. Only use it on routes whose responses
+// are the same for every caller
+func ResponseCache(ttl time.Duration) func(http.Handler) http.Handler {
+	var (
+		mu      sync.RWMutex
+		entries = make(map[string]*cachedResponse)
+	)
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(ttl.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || ttl <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Keyed by host as well, since each workspace's hostname serves its own articles
+			key := r.Host + r.URL.RequestURI()
+			now := time.Now()
+
+			mu.RLock()
+			entry, ok := entries[key]
+			mu.RUnlock()
+
+			if ok && now.Before(entry.expiresAt) {
+				metrics.RecordCacheLookup(metrics.CachePublicAPI, true)
+				for name, values := range entry.header {
+					w.Header()[name] = values
+				}
+				w.Header().Set("Cache-Control", cacheControl)
+				w.Header().Set("X-Cache", "HIT")
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write(entry.body); err != nil {
+					log.Debug().Err(err).Msg("Failed to write cached response")
+				}
+				return
+			}
+
+			metrics.RecordCacheLookup(metrics.CachePublicAPI, false)
+			recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			w.Header().Set("Cache-Control", cacheControl)
+			w.Header().Set("X-Cache", "MISS")
+			next.ServeHTTP(recorder, r)
+
+			if recorder.status != http.StatusOK {
+				return
+			}
+
+			header := w.Header().Clone()
+			header.Del("Cache-Control")
+			header.Del("X-Cache")
+			header.Del("X-Request-ID")
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(entries) >= maxCachedResponses {
+				for k, e := range entries {
+					if now.After(e.expiresAt) {
+						delete(entries, k)
+					}
+				}
+			}
+			if len(entries) < maxCachedResponses {
+				entries[key] = &cachedResponse{
+					header:    header,
+					body:      recorder.body.Bytes(),
+					expiresAt: now.Add(ttl),
+				}
+			}
+		})
+	}
+}
+
+// responseRecorder copies the response body and status while writing it through
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	if status != http.StatusOK {
+		// Errors must not be cached downstream either
+		r.ResponseWriter.Header().Set("Cache-Control", "no-store")
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// ReplicaReads lets GET and HEAD requests read from database read replicas
+// Other methods always read from the primary so they see their own writes
+func ReplicaReads(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			r = r.WithContext(repository.WithReplicaReads(r.Context()))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -3,25 +3,33 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"regexp"
 
 	"github.com/google/uuid"
-)
 
-type contextKey string
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+)
 
-const RequestIDKey contextKey = "request_id"
+// validRequestID matches request IDs accepted from clients and proxies; others are replaced,
+// as the ID is written to logs, headers and error bodies
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
 
 // RequestID is a middleware that generates or extracts a request ID from headers
 // and stores it in the request context and response headers
+// The ID reaches services and background tasks through requestctx
 func RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestID := r.Header.Get("X-Request-ID")
-		if requestID == "" {
+		if !validRequestID.MatchString(requestID) {
 			requestID = uuid.New().String()
 		}
 
 		// Store in context
-		ctx := context.WithValue(r.Context(), RequestIDKey, requestID)
+		ctx := requestctx.With(r.Context(), requestctx.Metadata{
+			RequestID: requestID,
+			Method:    r.Method,
+			Path:      r.URL.Path,
+		})
 
 		// Add to response header
 		w.Header().Set("X-Request-ID", requestID)
@@ -32,8 +40,5 @@ func RequestID(next http.Handler) http.Handler {
 
 // GetRequestID retrieves the request ID from context
 func GetRequestID(ctx context.Context) string {
-	if requestID, ok := ctx.Value(RequestIDKey).(string); ok {
-		return requestID
-	}
-	return ""
+	return requestctx.RequestID(ctx)
 }
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const hostTenantKey authContextKey = "host_tenant"
+
+// TenantResolver resolves the workspace served at a request's host
+type TenantResolver interface {
+	// Resolve returns the tenant served at the host; ok is false for the default workspace
+	Resolve(host string) (*domain.Tenant, bool)
+}
+
+// Tenant scopes each request's queries to the workspace served at its host
+// Requests to other hosts stay in the default workspace until Auth scopes them to the
+// workspace of their access token
+func Tenant(resolver TenantResolver) func(http.Handler) http.Handler {
+	if resolver == nil {
+		panic("resolver cannot be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if tenant, ok := resolver.Resolve(r.Host); ok {
+				ctx := context.WithValue(r.Context(), hostTenantKey, tenant.ID)
+				r = r.WithContext(repository.WithTenant(ctx, tenant.ID))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// hostTenantFromContext returns the workspace served at the request's host, if any
+func hostTenantFromContext(ctx context.Context) (uuid.UUID, bool) {
+	tenantID, ok := ctx.Value(hostTenantKey).(uuid.UUID)
+	return tenantID, ok
+}
+
+// RequireDefaultTenant rejects requests scoped to a workspace other than the default one
+// It must run after Auth, and guards routes that manage the whole deployment
+func RequireDefaultTenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tenantID, ok := repository.TenantFromContext(r.Context()); ok && tenantID != uuid.Nil {
+			response.Forbidden(w, "Only administrators of the default workspace can manage workspaces")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -2,6 +2,8 @@ package response
 
 import (
 	"net/http"
+	"strings"
+	"time"
 )
 
 // ErrorResponse represents an API error response
@@ -28,10 +30,45 @@ const (
 	ErrCodeInternal        = "INTERNAL_ERROR"
 	ErrCodeValidation      = "VALIDATION_ERROR"
 	ErrCodeServiceDown     = "SERVICE_UNAVAILABLE"
+
+	ErrCodeAccountSuspended = "ACCOUNT_SUSPENDED"
+	ErrCodeAccountBanned    = "ACCOUNT_BANNED"
+
+	ErrCodeRegistrationDisabled  = "REGISTRATION_DISABLED"
+	ErrCodeEmailDomainNotAllowed = "EMAIL_DOMAIN_NOT_ALLOWED"
+
+	ErrCodeCaptchaRequired = "CAPTCHA_REQUIRED"
+	ErrCodeCaptchaFailed   = "CAPTCHA_FAILED"
+
+	ErrCodeCSRFFailed = "CSRF_FAILED"
+
+	ErrCodeSubscriptionRequired = "SUBSCRIPTION_REQUIRED"
+)
+
+// Suffixes of resource error codes, see ResourceCode
+const (
+	CodeSuffixNotFound      = "NOT_FOUND"
+	CodeSuffixAlreadyExists = "ALREADY_EXISTS"
+	CodeSuffixLimitExceeded = "LIMIT_EXCEEDED"
 )
 
+// ResourceCode returns the error code of a failure concerning a resource, so that clients can
+// branch on it without parsing the message: ("alert match", "NOT_FOUND") gives ALERT_MATCH_NOT_FOUND
+func ResourceCode(resource, suffix string) string {
+	resource = strings.ToUpper(strings.Join(strings.Fields(resource), "_"))
+	if resource == "" {
+		return suffix
+	}
+	return resource + "_" + suffix
+}
+
 // ErrorWithDetails sends an error response with additional details and request ID
+// A missing request ID is taken from the X-Request-ID header set by the RequestID middleware
 func ErrorWithDetails(w http.ResponseWriter, status int, code, message string, details interface{}, requestID string) {
+	if requestID == "" {
+		requestID = w.Header().Get("X-Request-ID")
+	}
+
 	errResp := ErrorResponse{
 		Error: ErrorBody{
 			Code:      code,
@@ -70,6 +107,25 @@ func Forbidden(w http.ResponseWriter, message string) {
 	Error(w, http.StatusForbidden, ErrCodeForbidden, message)
 }
 
+// AccountBlocked sends a 403 Forbidden error response for a suspended or banned account
+// The reason and, for a suspension with an end, when it lifts are returned as details
+func AccountBlocked(w http.ResponseWriter, status, reason string, until *time.Time) {
+	code, message := ErrCodeAccountSuspended, "Your account is suspended"
+	if status == "banned" {
+		code, message = ErrCodeAccountBanned, "Your account is banned"
+	}
+
+	details := map[string]string{}
+	if reason != "" {
+		details["reason"] = reason
+	}
+	if until != nil {
+		details["until"] = until.UTC().Format(time.RFC3339)
+	}
+
+	ErrorWithDetails(w, http.StatusForbidden, code, message, details, "")
+}
+
 // NotFound sends a 404 Not Found error response
 func NotFound(w http.ResponseWriter, message string) {
 	if message == "" {
@@ -78,11 +134,30 @@ func NotFound(w http.ResponseWriter, message string) {
 	Error(w, http.StatusNotFound, ErrCodeNotFound, message)
 }
 
+// NotFoundResource sends a 404 Not Found error response coded for the resource, e.g. ARTICLE_NOT_FOUND
+func NotFoundResource(w http.ResponseWriter, resource, message string) {
+	if message == "" {
+		message = "Resource not found"
+	}
+	Error(w, http.StatusNotFound, ResourceCode(resource, CodeSuffixNotFound), message)
+}
+
 // Conflict sends a 409 Conflict error response
 func Conflict(w http.ResponseWriter, message string) {
 	Error(w, http.StatusConflict, ErrCodeConflict, message)
 }
 
+// ConflictResource sends a 409 Conflict error response coded for the resource, e.g. USER_ALREADY_EXISTS
+func ConflictResource(w http.ResponseWriter, resource, message string) {
+	Error(w, http.StatusConflict, ResourceCode(resource, CodeSuffixAlreadyExists), message)
+}
+
+// LimitExceeded sends a 400 Bad Request error response for a resource quota that is full,
+// coded for the resource, e.g. WATCHED_VENDOR_LIMIT_EXCEEDED
+func LimitExceeded(w http.ResponseWriter, resource, message string) {
+	Error(w, http.StatusBadRequest, ResourceCode(resource, CodeSuffixLimitExceeded), message)
+}
+
 // TooManyRequests sends a 429 Too Many Requests error response
 func TooManyRequests(w http.ResponseWriter, message string) {
 	if message == "" {
@@ -0,0 +1,67 @@
+package response
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// PageMeta returns the pagination metadata of one page of a page-numbered list
+// Next and Prev repeat the request's path and query with only the page parameter changed
+func PageMeta(r *http.Request, page, pageSize, total int) *Meta {
+	meta := &Meta{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalCount: total,
+		TotalPages: totalPages(total, pageSize),
+	}
+
+	if page < meta.TotalPages {
+		meta.Next = pageLink(r, map[string]int{"page": page + 1})
+	}
+	if page > 1 && meta.TotalPages > 0 {
+		meta.Prev = pageLink(r, map[string]int{"page": min(page-1, meta.TotalPages)})
+	}
+
+	return meta
+}
+
+// OffsetMeta returns the pagination metadata of a list paged by limit and offset
+// Next and Prev repeat the request's path and query with the offset moved by one page
+func OffsetMeta(r *http.Request, offset, limit, total int) *Meta {
+	if limit <= 0 {
+		return &Meta{TotalCount: total}
+	}
+
+	meta := &Meta{
+		Page:       offset/limit + 1,
+		PageSize:   limit,
+		TotalCount: total,
+		TotalPages: totalPages(total, limit),
+	}
+
+	if offset+limit < total {
+		meta.Next = pageLink(r, map[string]int{"offset": offset + limit, "limit": limit})
+	}
+	if offset > 0 && total > 0 {
+		meta.Prev = pageLink(r, map[string]int{"offset": max(min(offset-limit, total-limit), 0), "limit": limit})
+	}
+
+	return meta
+}
+
+// totalPages returns the number of pages of pageSize needed for total items
+func totalPages(total, pageSize int) int {
+	if pageSize <= 0 {
+		return 0
+	}
+	return (total + pageSize - 1) / pageSize
+}
+
+// pageLink returns the request's path and query with params overridden
+func pageLink(r *http.Request, params map[string]int) string {
+	query := r.URL.Query()
+	for key, value := range params {
+		query.Set(key, strconv.Itoa(value))
+	}
+	return r.URL.Path + "?" + query.Encode()
+}
@@ -0,0 +1,65 @@
+package response
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageMeta(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		page       int
+		total      int
+		wantNext   string
+		wantPrev   string
+		wantTotals int
+	}{
+		{"first page", "/v1/articles?severity=high", 1, 45, "/v1/articles?page=2&severity=high", "", 3},
+		{"middle page", "/v1/articles?page=2&severity=high", 2, 45, "/v1/articles?page=3&severity=high", "/v1/articles?page=1&severity=high", 3},
+		{"last page", "/v1/articles?page=3", 3, 45, "", "/v1/articles?page=2", 3},
+		{"past the end", "/v1/articles?page=9", 9, 45, "", "/v1/articles?page=3", 3},
+		{"empty list", "/v1/articles", 1, 0, "", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := PageMeta(httptest.NewRequest("GET", tt.target, nil), tt.page, 20, tt.total)
+
+			assert.Equal(t, tt.wantNext, meta.Next)
+			assert.Equal(t, tt.wantPrev, meta.Prev)
+			assert.Equal(t, tt.wantTotals, meta.TotalPages)
+		})
+	}
+}
+
+func TestOffsetMeta(t *testing.T) {
+	meta := OffsetMeta(httptest.NewRequest("GET", "/v1/admin/users?offset=50", nil), 50, 50, 120)
+
+	assert.Equal(t, 2, meta.Page)
+	assert.Equal(t, 3, meta.TotalPages)
+	assert.Equal(t, "/v1/admin/users?limit=50&offset=100", meta.Next)
+	assert.Equal(t, "/v1/admin/users?limit=50&offset=0", meta.Prev)
+
+	last := OffsetMeta(httptest.NewRequest("GET", "/v1/admin/users?offset=100", nil), 100, 50, 120)
+	assert.Empty(t, last.Next)
+}
+
+func TestResourceCode(t *testing.T) {
+	assert.Equal(t, "ARTICLE_NOT_FOUND", ResourceCode("article", CodeSuffixNotFound))
+	assert.Equal(t, "ORGANIZATION_CATEGORY_LIMIT_EXCEEDED", ResourceCode("organization category", CodeSuffixLimitExceeded))
+	assert.Equal(t, "ALERT_MATCH_NOT_FOUND", ResourceCode("alert_match", CodeSuffixNotFound))
+	assert.Equal(t, "NOT_FOUND", ResourceCode("", CodeSuffixNotFound))
+}
+
+func TestErrorWithDetails_FillsRequestIDFromHeader(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("X-Request-ID", "req-123")
+
+	NotFoundResource(rec, "alert", "Alert not found")
+
+	assert.Equal(t, 404, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"ALERT_NOT_FOUND","message":"Alert not found","request_id":"req-123"}}`, rec.Body.String())
+}
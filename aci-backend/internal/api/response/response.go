@@ -20,6 +20,11 @@ type Meta struct {
 	PageSize   int `json:"page_size,omitempty"`
 	TotalCount int `json:"total_count,omitempty"`
 	TotalPages int `json:"total_pages,omitempty"`
+	// Next and Prev link to the neighbouring pages of a list and are omitted at either end
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+	// DidYouMean is a corrected query suggested by article search
+	DidYouMean string `json:"did_you_mean,omitempty"`
 }
 
 // JSON sends a JSON response with the specified status code and data
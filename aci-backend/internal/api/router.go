@@ -32,24 +32,66 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 	s.router.Use(middleware.Logger)
 	s.router.Use(middleware.Recoverer)
 	s.router.Use(middleware.CORS)
+	if s.handlers.Metrics != nil {
+		s.router.Use(middleware.Metrics)
+	}
+	s.router.Use(middleware.Tracing)
+	s.router.Use(middleware.ReplicaReads)
+	if s.handlers.Tenant != nil {
+		s.router.Use(s.handlers.Tenant.Middleware())
+	}
 
-	// Health endpoints (no authentication required)
-	s.router.Get("/health", handlers.HealthCheck)
-	s.router.Get("/ready", handlers.ReadinessCheck)
+	// Liveness and readiness probes (no authentication required); /health and /ready are kept as aliases
+	if s.handlers.Health != nil {
+		s.router.Get("/healthz", s.handlers.Health.Liveness)
+		s.router.Get("/readyz", s.handlers.Health.Readiness)
+		s.router.Get("/health", s.handlers.Health.Liveness)
+		s.router.Get("/ready", s.handlers.Health.Readiness)
+	}
+
+	// Prometheus scrape endpoint (optional bearer token, applied when the handler is built)
+	if s.handlers.Metrics != nil {
+		s.router.Get("/metrics", s.handlers.Metrics.ServeHTTP)
+	}
 
-	// WebSocket endpoint (authentication handled in handler via query param token)
+	// WebSocket endpoint (authentication handled in handler via subprotocol, auth message, or legacy query token)
 	if wsHandler != nil {
 		s.router.Get("/ws", wsHandler.ServeHTTP)
 	}
 
+	// Short article share links redirect to the web app (no authentication required)
+	if s.handlers.ShareLink != nil {
+		s.router.Get("/s/{token}", s.handlers.ShareLink.Follow)
+	}
+
+	// Newsletter open pixel, click tracking and unsubscribe links (no authentication required)
+	if s.handlers.Newsletter != nil {
+		s.router.Route("/n", func(r chi.Router) {
+			r.Get("/o/{delivery}", s.handlers.Newsletter.TrackOpen)
+			r.Get("/c/{delivery}/{article}", s.handlers.Newsletter.TrackClick)
+			r.Get("/u/{delivery}", s.handlers.Newsletter.Unsubscribe)
+			r.Post("/u/{delivery}", s.handlers.Newsletter.Unsubscribe)
+		})
+	}
+
 	// API v1 routes
 	s.router.Route("/v1", func(r chi.Router) {
 		// Auth routes (no authentication required)
 		r.Route("/auth", func(r chi.Router) {
 			r.Post("/register", s.handlers.Auth.Register)
 			r.Post("/login", s.handlers.Auth.Login)
-			r.Post("/refresh", s.handlers.Auth.Refresh)
-			r.Post("/logout", s.handlers.Auth.Logout)
+			r.Get("/captcha", s.handlers.Auth.Captcha)
+
+			// Refresh and logout may authenticate with the refresh cookie, so they need a CSRF token when it is sent
+			r.Get("/csrf", s.handlers.Auth.CSRFToken)
+			r.With(middleware.CSRF(handlers.RefreshCookieName)).Post("/refresh", s.handlers.Auth.Refresh)
+			r.With(middleware.CSRF(handlers.RefreshCookieName)).Post("/logout", s.handlers.Auth.Logout)
+
+			// Invitations sent by admins (the signed token authenticates the invitee)
+			if s.handlers.UserInvite != nil {
+				r.With(middleware.AuthRateLimiter()).Get("/invite", s.handlers.UserInvite.Get)
+				r.With(middleware.AuthRateLimiter()).Post("/invite/accept", s.handlers.UserInvite.Accept)
+			}
 		})
 
 		// Category routes (no authentication required)
@@ -58,9 +100,46 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 			r.Get("/{slug}", s.handlers.Category.GetBySlug)
 		})
 
+		// Branding of the workspace served at the request's host (no authentication required)
+		if s.handlers.Tenant != nil {
+			r.Get("/tenant", s.handlers.Tenant.Current)
+		}
+
+		// Article images (no authentication required so they load in <img> tags)
+		r.Get("/images/articles/{id}/{size}.jpg", s.handlers.Article.GetImage)
+
+		// Public read-only routes for the marketing site (optional API key, no user authentication)
+		if s.handlers.Public != nil {
+			r.Route("/public", func(r chi.Router) {
+				r.Use(s.handlers.Public.Middleware()...)
+
+				r.Get("/articles", s.handlers.Public.ListArticles)
+				r.Get("/articles/{idOrSlug}", s.handlers.Public.GetArticle)
+				r.Get("/categories", s.handlers.Category.List)
+			})
+		}
+
+		// Public newsletter sign-up with double opt-in (no authentication required)
+		if s.handlers.Newsletter != nil {
+			r.Route("/newsletter", func(r chi.Router) {
+				r.Use(middleware.AuthRateLimiter())
+
+				r.Post("/subscribe", s.handlers.Newsletter.Subscribe)
+				r.Post("/confirm", s.handlers.Newsletter.ConfirmSubscription)
+				r.Post("/unsubscribe", s.handlers.Newsletter.UnsubscribeByToken)
+			})
+		}
+
+		// Lead form submissions pushed to the CRM (no authentication required)
+		if s.handlers.CRM != nil {
+			r.With(middleware.AuthRateLimiter()).Post("/leads", s.handlers.CRM.SubmitLead)
+		}
+
 		// Webhook routes (HMAC validation handled in handler)
 		r.Route("/webhooks", func(r chi.Router) {
 			r.Post("/n8n", s.handlers.Webhook.HandleN8nWebhook)
+			r.Get("/schemas", s.handlers.Webhook.ListSchemas)
+			r.Get("/schemas/{eventType}", s.handlers.Webhook.GetSchema)
 			r.Post("/trigger-enrichment", s.handlers.Webhook.TriggerEnrichment)
 		})
 
@@ -68,6 +147,11 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 		r.Group(func(r chi.Router) {
 			r.Use(middleware.Auth(s.jwtService))
 
+			// Suspended and banned users are refused even while their access token is valid
+			if s.handlers.UserStatus != nil {
+				r.Use(s.handlers.UserStatus.Middleware())
+			}
+
 			// Dashboard routes
 			r.Route("/dashboard", func(r chi.Router) {
 				// Handle case where Dashboard handler is not initialized
@@ -82,12 +166,33 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 				r.Get("/recent-activity", s.handlers.Dashboard.GetRecentActivity)
 			})
 
+			// Severity trends and threat rankings for dashboard charts
+			if s.handlers.ThreatLandscape != nil {
+				r.Get("/stats/threat-landscape", s.handlers.ThreatLandscape.Get)
+			}
+
+			// GraphQL queries over articles, categories, alerts, and the current user
+			if s.handlers.GraphQL != nil {
+				r.Get("/graphql", s.handlers.GraphQL.ServeHTTP)
+				r.Post("/graphql", s.handlers.GraphQL.ServeHTTP)
+			}
+
+			// Global search across articles, CVEs, vendors, and threat actors
+			if s.handlers.Search != nil {
+				r.Get("/search", s.handlers.Search.GlobalSearch)
+			}
+
 			// Article routes
 			r.Route("/articles", func(r chi.Router) {
 				r.Get("/", s.handlers.Article.List)
 				r.Get("/search", s.handlers.Article.Search)
+				r.Get("/feed", s.handlers.Article.Feed)
+				r.Get("/featured", s.handlers.Article.Featured)
 				r.Get("/{id}", s.handlers.Article.GetByID)
 				r.Get("/slug/{slug}", s.handlers.Article.GetBySlug)
+				r.Get("/{slug}/meta", s.handlers.Article.GetMeta)
+				r.Get("/{id}/duplicates", s.handlers.Article.GetDuplicates)
+				r.Get("/{id}/archive", s.handlers.Article.GetArchive)
 
 				// Deep dive route
 				r.Get("/{id}/deep-dive", s.handlers.DeepDive.GetDeepDive)
@@ -96,8 +201,133 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 				r.Post("/{id}/bookmark", s.handlers.Article.AddBookmark)
 				r.Delete("/{id}/bookmark", s.handlers.Article.RemoveBookmark)
 				r.Post("/{id}/read", s.handlers.Article.MarkRead)
+
+				// Public exploits for the article's CVEs, with the feed each came from
+				if s.handlers.Exploit != nil {
+					r.Get("/{id}/exploits", s.handlers.Exploit.ListForArticle)
+				}
+
+				// Analyst annotations shared within the user's organization
+				if s.handlers.Annotation != nil {
+					r.Get("/{id}/annotations", s.handlers.Annotation.ListForArticle)
+					r.Post("/{id}/annotations", s.handlers.Annotation.Create)
+				}
+
+				// Analyst flags on wrong AI enrichment output
+				if s.handlers.EnrichmentFeedback != nil {
+					r.Post("/{id}/enrichment-feedback", s.handlers.EnrichmentFeedback.Create)
+				}
+
+				// Short signed share links
+				if s.handlers.ShareLink != nil {
+					r.Post("/{id}/share", s.handlers.ShareLink.Create)
+				}
+
+				// CTA A/B click tracking
+				if s.handlers.CTA != nil {
+					r.Post("/{id}/cta-click", s.handlers.CTA.Click)
+				}
 			})
 
+			// Threat actor and ransomware group profiles
+			if s.handlers.ThreatActor != nil {
+				r.Route("/actors", func(r chi.Router) {
+					r.Get("/", s.handlers.ThreatActor.List)
+					r.Get("/{slug}/articles", s.handlers.ThreatActor.ListArticles)
+				})
+			}
+
+			// Incident timelines grouping the articles about one breach or campaign
+			if s.handlers.Incident != nil {
+				r.Route("/incidents", func(r chi.Router) {
+					r.Get("/", s.handlers.Incident.List)
+					r.Get("/{id}/timeline", s.handlers.Incident.GetTimeline)
+				})
+			}
+
+			// Indicator of compromise search and export
+			if s.handlers.IOC != nil {
+				r.Route("/iocs", func(r chi.Router) {
+					r.Get("/", s.handlers.IOC.List)
+					r.Get("/export", s.handlers.IOC.Export)
+				})
+			}
+
+			// Bookmark collections, private or shared with the user's organization
+			if s.handlers.Collection != nil {
+				r.Route("/collections", func(r chi.Router) {
+					r.Get("/", s.handlers.Collection.List)
+					r.Post("/", s.handlers.Collection.Create)
+					r.Get("/{id}", s.handlers.Collection.Get)
+					r.Delete("/{id}", s.handlers.Collection.Delete)
+					r.Get("/{id}/articles", s.handlers.Collection.ListArticles)
+					r.Post("/{id}/articles", s.handlers.Collection.AddArticle)
+					r.Delete("/{id}/articles/{articleID}", s.handlers.Collection.RemoveArticle)
+				})
+			}
+
+			// The organization's recent annotations, and editing or deleting one
+			if s.handlers.Annotation != nil {
+				r.Route("/annotations", func(r chi.Router) {
+					r.Get("/", s.handlers.Annotation.ListRecent)
+					r.Put("/{id}", s.handlers.Annotation.Update)
+					r.Delete("/{id}", s.handlers.Annotation.Delete)
+				})
+			}
+
+			// Engagement events reported by clients, aggregated for the analytics dashboard
+			if s.handlers.ClientEvent != nil {
+				r.Post("/events", s.handlers.ClientEvent.Record)
+			}
+
+			// Weekly threat briefing as a PDF
+			if s.handlers.Report != nil {
+				r.Get("/reports/weekly", s.handlers.Report.Weekly)
+			}
+
+			// Scheduled reports defined by organization admins
+			if s.handlers.OrganizationReport != nil {
+				r.Route("/orgs/{id}/reports", func(r chi.Router) {
+					r.Get("/", s.handlers.OrganizationReport.List)
+					r.Post("/", s.handlers.OrganizationReport.Create)
+					r.Get("/{reportID}", s.handlers.OrganizationReport.Get)
+					r.Put("/{reportID}", s.handlers.OrganizationReport.Update)
+					r.Delete("/{reportID}", s.handlers.OrganizationReport.Delete)
+					r.Get("/{reportID}/runs", s.handlers.OrganizationReport.ListRuns)
+					r.Post("/{reportID}/runs", s.handlers.OrganizationReport.Rerun)
+					r.Get("/{reportID}/runs/{runID}/pdf", s.handlers.OrganizationReport.Download)
+				})
+			}
+
+			// Private categories organizations apply on top of the global taxonomy
+			if s.handlers.OrgCategory != nil {
+				r.Route("/orgs/{id}/categories", func(r chi.Router) {
+					r.Get("/", s.handlers.OrgCategory.List)
+					r.Post("/", s.handlers.OrgCategory.Create)
+					r.Get("/{categoryID}", s.handlers.OrgCategory.Get)
+					r.Put("/{categoryID}", s.handlers.OrgCategory.Update)
+					r.Delete("/{categoryID}", s.handlers.OrgCategory.Delete)
+					r.Get("/{categoryID}/articles", s.handlers.OrgCategory.ListArticles)
+					r.Put("/{categoryID}/articles/{articleID}", s.handlers.OrgCategory.AddArticle)
+					r.Delete("/{categoryID}/articles/{articleID}", s.handlers.OrgCategory.RemoveArticle)
+				})
+				r.Get("/orgs/{id}/articles/{articleID}/categories", s.handlers.OrgCategory.ArticleCategories)
+			}
+
+			// Organization software inventory, matched against the products affected by article CVEs
+			if s.handlers.SoftwareInventory != nil {
+				r.Get("/orgs/{id}/inventory", s.handlers.SoftwareInventory.ListForOrganization)
+				r.Put("/orgs/{id}/inventory", s.handlers.SoftwareInventory.ReplaceForOrganization)
+			}
+
+			// Current user's organization
+			if s.handlers.Organization != nil {
+				r.Route("/organization", func(r chi.Router) {
+					r.Get("/", s.handlers.Organization.GetMine)
+					r.Get("/stats", s.handlers.Organization.GetMyStats)
+				})
+			}
+
 			// Alert routes
 			r.Route("/alerts", func(r chi.Router) {
 				r.Get("/", s.handlers.Alert.List)
@@ -106,21 +336,353 @@ func (s *Server) setupRoutesWithWebSocket(wsHandler WebSocketHandler) {
 				r.Patch("/{id}", s.handlers.Alert.Update)
 				r.Delete("/{id}", s.handlers.Alert.Delete)
 				r.Get("/{id}/matches", s.handlers.Alert.ListMatches)
+				r.Post("/{id}/matches/{matchID}/ack", s.handlers.Alert.AcknowledgeMatch)
+				r.Post("/{id}/matches/{matchID}/dismiss", s.handlers.Alert.DismissMatch)
+				r.Post("/{id}/matches/{matchID}/escalate", s.handlers.Alert.EscalateMatch)
+				r.Post("/{id}/matches/{matchID}/reopen", s.handlers.Alert.ReopenMatch)
 			})
 
 			// User routes
 			r.Route("/users", func(r chi.Router) {
 				r.Get("/me", s.handlers.User.GetCurrentUser)
 				r.Patch("/me", s.handlers.User.UpdateCurrentUser)
+				r.Delete("/me", s.handlers.User.DeleteCurrentUser)
+				r.Delete("/me/deletion", s.handlers.User.CancelDeletion)
 				r.Get("/me/bookmarks", s.handlers.User.GetBookmarks)
 				r.Get("/me/history", s.handlers.User.GetReadingHistory)
 				r.Get("/me/stats", s.handlers.User.GetStats)
+				r.Get("/me/export", s.handlers.User.Export)
+				r.Post("/me/password", s.handlers.Auth.ChangePassword)
+
+				if s.handlers.SecurityActivity != nil {
+					r.Get("/me/security-activity", s.handlers.SecurityActivity.ListMine)
+				}
+
+				if s.handlers.NotificationPreference != nil {
+					r.Get("/me/notifications", s.handlers.NotificationPreference.GetMine)
+					r.Put("/me/notifications", s.handlers.NotificationPreference.UpdateMine)
+				}
+				if s.handlers.FeedPreference != nil {
+					r.Get("/me/preferences", s.handlers.FeedPreference.GetMine)
+					r.Put("/me/preferences", s.handlers.FeedPreference.UpdateMine)
+				}
+				if s.handlers.VendorWatchlist != nil {
+					r.Get("/me/vendors", s.handlers.VendorWatchlist.ListMine)
+					r.Post("/me/vendors", s.handlers.VendorWatchlist.AddMine)
+					r.Delete("/me/vendors/{id}", s.handlers.VendorWatchlist.RemoveMine)
+				}
+				if s.handlers.SoftwareInventory != nil {
+					r.Get("/me/inventory", s.handlers.SoftwareInventory.ListMine)
+					r.Put("/me/inventory", s.handlers.SoftwareInventory.ReplaceMine)
+				}
 			})
 
 			// Admin routes (require admin role)
 			r.Route("/admin", func(r chi.Router) {
 				r.Use(middleware.RequireAdmin())
 
+				// Realtime hub health (independent of the admin service)
+				if s.handlers.WSStats != nil {
+					r.Get("/websocket/stats", s.handlers.WSStats.GetStats)
+				}
+
+				// Ingest latency SLO dashboard (independent of the admin service)
+				if s.handlers.SLO != nil {
+					r.Get("/slo/ingest", s.handlers.SLO.GetIngestSLO)
+					r.Get("/slo/ingest/articles/{id}", s.handlers.SLO.GetArticleTiming)
+				}
+
+				// Enrichment worker metrics (independent of the admin service)
+				if s.handlers.Enrichment != nil {
+					r.Get("/enrichment/worker", s.handlers.Enrichment.GetWorkerStats)
+				}
+
+				// Enrichment feedback and accuracy report (independent of the admin service)
+				if s.handlers.EnrichmentFeedback != nil {
+					r.Get("/enrichment/feedback", s.handlers.EnrichmentFeedback.List)
+					r.Delete("/enrichment/feedback/{id}", s.handlers.EnrichmentFeedback.Delete)
+					r.Get("/enrichment/accuracy", s.handlers.EnrichmentFeedback.GetAccuracy)
+				}
+
+				// Versioned enrichment prompts with trial runs (independent of the admin service)
+				if s.handlers.EnrichmentPrompt != nil {
+					r.Route("/enrichment/prompts", func(r chi.Router) {
+						r.Get("/", s.handlers.EnrichmentPrompt.List)
+						r.Post("/", s.handlers.EnrichmentPrompt.Create)
+						r.Get("/{id}", s.handlers.EnrichmentPrompt.GetByID)
+						r.Put("/{id}", s.handlers.EnrichmentPrompt.Update)
+						r.Delete("/{id}", s.handlers.EnrichmentPrompt.Delete)
+						r.Get("/{id}/versions", s.handlers.EnrichmentPrompt.ListVersions)
+						r.Post("/{id}/activate", s.handlers.EnrichmentPrompt.Activate)
+						r.Post("/{id}/trial", s.handlers.EnrichmentPrompt.Trial)
+					})
+				}
+
+				// AI token usage and spend (independent of the admin service)
+				if s.handlers.AIUsage != nil {
+					r.Get("/ai/usage", s.handlers.AIUsage.GetUsage)
+				}
+
+				// AI response cache stats and invalidation (independent of the admin service)
+				if s.handlers.AICache != nil {
+					r.Get("/ai/cache", s.handlers.AICache.GetStats)
+					r.Delete("/ai/cache", s.handlers.AICache.Invalidate)
+				}
+
+				// Notification template management (independent of the admin service)
+				if s.handlers.NotificationTemplate != nil {
+					r.Route("/notification-templates", func(r chi.Router) {
+						r.Get("/", s.handlers.NotificationTemplate.List)
+						r.Post("/", s.handlers.NotificationTemplate.Create)
+						r.Post("/preview", s.handlers.NotificationTemplate.Preview)
+						r.Get("/{id}", s.handlers.NotificationTemplate.GetByID)
+						r.Put("/{id}", s.handlers.NotificationTemplate.Update)
+						r.Get("/{id}/versions", s.handlers.NotificationTemplate.ListVersions)
+						r.Post("/{id}/activate", s.handlers.NotificationTemplate.Activate)
+						r.Post("/{id}/preview", s.handlers.NotificationTemplate.PreviewVersion)
+					})
+				}
+
+				// Classification suggestion review queue (independent of the admin service)
+				if s.handlers.Classification != nil {
+					r.Route("/classifications", func(r chi.Router) {
+						r.Get("/", s.handlers.Classification.List)
+						r.Get("/{id}", s.handlers.Classification.GetByID)
+						r.Post("/{id}/approve", s.handlers.Classification.Approve)
+						r.Post("/{id}/reject", s.handlers.Classification.Reject)
+					})
+				}
+
+				// Organization and membership management (independent of the admin service)
+				if s.handlers.Organization != nil {
+					r.Route("/organizations", func(r chi.Router) {
+						r.Get("/", s.handlers.Organization.List)
+						r.Post("/", s.handlers.Organization.Create)
+						r.Get("/{id}", s.handlers.Organization.Get)
+						r.Delete("/{id}", s.handlers.Organization.Delete)
+						r.Get("/{id}/stats", s.handlers.Organization.GetStats)
+						r.Put("/{id}/members/{userID}", s.handlers.Organization.SetMember)
+						r.Delete("/{id}/members/{userID}", s.handlers.Organization.RemoveMember)
+					})
+				}
+
+				// Every article, including unpublished ones, with CSV and XLSX export (independent of the admin service)
+				r.Get("/articles", s.handlers.Article.AdminList)
+
+				// Editorial title and summary overrides (independent of the admin service)
+				if s.handlers.ArticleEditorial != nil {
+					r.Get("/articles/{id}/editorial", s.handlers.ArticleEditorial.Get)
+					r.Put("/articles/{id}/editorial", s.handlers.ArticleEditorial.Update)
+				}
+
+				// Slug changes; former slugs redirect to the new one (independent of the admin service)
+				if s.handlers.ArticleSlug != nil {
+					r.Put("/articles/{id}/slug", s.handlers.ArticleSlug.Update)
+				}
+
+				// Article review queue (independent of the admin service)
+				if s.handlers.ArticleReview != nil {
+					r.Route("/reviews", func(r chi.Router) {
+						r.Get("/", s.handlers.ArticleReview.List)
+						r.Post("/approve", s.handlers.ArticleReview.BulkApprove)
+						r.Post("/{articleID}/approve", s.handlers.ArticleReview.Approve)
+						r.Post("/{articleID}/reject", s.handlers.ArticleReview.Reject)
+					})
+				}
+
+				// Featured articles carousel (independent of the admin service)
+				if s.handlers.Featured != nil {
+					r.Route("/featured", func(r chi.Router) {
+						r.Get("/", s.handlers.Featured.List)
+						r.Put("/order", s.handlers.Featured.Reorder)
+						r.Put("/{articleID}", s.handlers.Featured.Feature)
+						r.Delete("/{articleID}", s.handlers.Featured.Unfeature)
+					})
+				}
+
+				// Category hierarchy and article categories (independent of the admin service)
+				if s.handlers.CategoryAdmin != nil {
+					r.Put("/categories/{id}/parent", s.handlers.CategoryAdmin.SetParent)
+					r.Put("/articles/{id}/categories", s.handlers.CategoryAdmin.SetArticleCategories)
+				}
+
+				// Tag management (independent of the admin service)
+				if s.handlers.Tag != nil {
+					r.Route("/tags", func(r chi.Router) {
+						r.Get("/", s.handlers.Tag.List)
+						r.Put("/{id}", s.handlers.Tag.Rename)
+						r.Post("/{id}/merge", s.handlers.Tag.Merge)
+					})
+				}
+
+				// Source trust calibration history (independent of the admin service)
+				if s.handlers.SourceTrust != nil {
+					r.Get("/sources/{id}/trust-history", s.handlers.SourceTrust.History)
+				}
+
+				// Relevance scoring rules (independent of the admin service)
+				if s.handlers.RelevanceRules != nil {
+					r.Route("/relevance-rules", func(r chi.Router) {
+						r.Get("/", s.handlers.RelevanceRules.Get)
+						r.Put("/", s.handlers.RelevanceRules.Update)
+						r.Post("/preview", s.handlers.RelevanceRules.Preview)
+					})
+				}
+
+				// CTA A/B variants and their report (independent of the admin service)
+				if s.handlers.CTA != nil {
+					r.Route("/cta-variants", func(r chi.Router) {
+						r.Get("/", s.handlers.CTA.List)
+						r.Post("/", s.handlers.CTA.Create)
+						r.Get("/report", s.handlers.CTA.Report)
+						r.Put("/{id}", s.handlers.CTA.Update)
+						r.Delete("/{id}", s.handlers.CTA.Delete)
+					})
+				}
+
+				// Public API keys (independent of the admin service)
+				if s.handlers.PublicAPIKey != nil {
+					r.Route("/public-api-keys", func(r chi.Router) {
+						r.Get("/", s.handlers.PublicAPIKey.List)
+						r.Post("/", s.handlers.PublicAPIKey.Create)
+						r.Delete("/{id}", s.handlers.PublicAPIKey.Revoke)
+					})
+				}
+
+				// n8n webhook secret rotation (independent of the admin service)
+				if s.handlers.WebhookSecret != nil {
+					r.Route("/webhook-secrets", func(r chi.Router) {
+						r.Get("/", s.handlers.WebhookSecret.List)
+						r.Post("/rotate", s.handlers.WebhookSecret.Rotate)
+					})
+				}
+
+				// Historical article backfills (independent of the admin service)
+				if s.handlers.ArticleImport != nil {
+					r.Route("/article-imports", func(r chi.Router) {
+						r.Get("/", s.handlers.ArticleImport.List)
+						r.Post("/", s.handlers.ArticleImport.Create)
+						r.Get("/{id}", s.handlers.ArticleImport.Get)
+						r.Post("/{id}/resume", s.handlers.ArticleImport.Resume)
+					})
+				}
+
+				// CISA KEV catalog sync (independent of the admin service)
+				if s.handlers.KEV != nil {
+					r.Get("/kev", s.handlers.KEV.GetStatus)
+					r.Post("/kev/sync", s.handlers.KEV.Sync)
+				}
+
+				// NVD affected products sync (independent of the admin service)
+				if s.handlers.NVD != nil {
+					r.Get("/nvd", s.handlers.NVD.GetStatus)
+					r.Post("/nvd/sync", s.handlers.NVD.Sync)
+				}
+
+				// Incident curation and article suggestions (independent of the admin service)
+				if s.handlers.Incident != nil {
+					r.Route("/incidents", func(r chi.Router) {
+						r.Post("/", s.handlers.Incident.Create)
+						r.Put("/{id}", s.handlers.Incident.Update)
+						r.Delete("/{id}", s.handlers.Incident.Delete)
+						r.Post("/{id}/articles", s.handlers.Incident.AddArticle)
+						r.Delete("/{id}/articles/{articleID}", s.handlers.Incident.RemoveArticle)
+						r.Get("/{id}/suggestions", s.handlers.Incident.ListSuggestions)
+					})
+				}
+
+				// Public exploit feed sync (independent of the admin service)
+				if s.handlers.Exploit != nil {
+					r.Get("/exploits", s.handlers.Exploit.GetStatus)
+					r.Post("/exploits/sync", s.handlers.Exploit.Sync)
+				}
+
+				// Analytics dashboard (independent of the admin service)
+				if s.handlers.Analytics != nil {
+					r.Get("/analytics", s.handlers.Analytics.Get)
+				}
+
+				// Running configuration with secrets redacted (independent of the admin service)
+				if s.handlers.Config != nil {
+					r.With(middleware.RequireDefaultTenant).Get("/config", s.handlers.Config.Get)
+				}
+
+				// Workspaces of a multi-tenant deployment, managed from the default workspace
+				if s.handlers.Tenant != nil {
+					r.Route("/tenants", func(r chi.Router) {
+						r.Use(middleware.RequireDefaultTenant)
+
+						r.Get("/", s.handlers.Tenant.List)
+						r.Post("/", s.handlers.Tenant.Create)
+						r.Get("/{id}", s.handlers.Tenant.Get)
+						r.Put("/{id}", s.handlers.Tenant.Update)
+						r.Delete("/{id}", s.handlers.Tenant.Delete)
+					})
+				}
+
+				// Audit log CSV export (independent of the admin service)
+				if s.handlers.AuditLog != nil {
+					r.Get("/audit-logs/export", s.handlers.AuditLog.Export)
+				}
+
+				// Newsletter subscribers and campaigns (independent of the admin service)
+				if s.handlers.Newsletter != nil {
+					r.Route("/newsletter", func(r chi.Router) {
+						r.Get("/subscribers", s.handlers.Newsletter.ListSubscribers)
+						r.Post("/subscribers", s.handlers.Newsletter.AddSubscriber)
+						r.Get("/subscribers/export", s.handlers.Newsletter.ExportSubscribers)
+						r.Delete("/subscribers/{id}", s.handlers.Newsletter.RemoveSubscriber)
+						r.Get("/suppressions", s.handlers.Newsletter.ListSuppressions)
+						r.Post("/suppressions", s.handlers.Newsletter.AddSuppression)
+						r.Delete("/suppressions/{id}", s.handlers.Newsletter.RemoveSuppression)
+						r.Get("/templates", s.handlers.Newsletter.ListTemplates)
+						r.Get("/campaigns", s.handlers.Newsletter.ListCampaigns)
+						r.Post("/campaigns", s.handlers.Newsletter.CreateCampaign)
+						r.Get("/campaigns/{id}", s.handlers.Newsletter.GetCampaign)
+						r.Put("/campaigns/{id}", s.handlers.Newsletter.UpdateCampaign)
+						r.Delete("/campaigns/{id}", s.handlers.Newsletter.DeleteCampaign)
+						r.Get("/campaigns/{id}/preview", s.handlers.Newsletter.PreviewCampaign)
+						r.Post("/campaigns/{id}/send", s.handlers.Newsletter.SendCampaign)
+					})
+				}
+
+				// CRM event queue and its failures (independent of the admin service)
+				if s.handlers.CRM != nil {
+					r.Route("/crm/events", func(r chi.Router) {
+						r.Get("/", s.handlers.CRM.ListEvents)
+						r.Post("/retry", s.handlers.CRM.RetryFailed)
+						r.Post("/{id}/retry", s.handlers.CRM.RetryEvent)
+					})
+				}
+
+				// SIEM destinations and their event queues (independent of the admin service)
+				if s.handlers.SIEM != nil {
+					r.Route("/siem", func(r chi.Router) {
+						r.Get("/destinations", s.handlers.SIEM.ListDestinations)
+						r.Patch("/destinations/{name}", s.handlers.SIEM.UpdateDestination)
+						r.Post("/destinations/{name}/retry", s.handlers.SIEM.RetryFailed)
+						r.Get("/events", s.handlers.SIEM.ListEvents)
+					})
+				}
+
+				// User invitations (independent of the admin service)
+				if s.handlers.UserInvite != nil {
+					r.Post("/users/invite", s.handlers.UserInvite.Invite)
+				}
+
+				// Self-registration policy (independent of the admin service)
+				if s.handlers.RegistrationPolicy != nil {
+					r.Get("/registration", s.handlers.RegistrationPolicy.Get)
+					r.Put("/registration", s.handlers.RegistrationPolicy.Update)
+					r.Delete("/registration", s.handlers.RegistrationPolicy.Reset)
+				}
+
+				// User suspensions and bans (independent of the admin service)
+				if s.handlers.UserStatus != nil {
+					r.Post("/users/{id}/suspend", s.handlers.UserStatus.Suspend)
+					r.Post("/users/{id}/unsuspend", s.handlers.UserStatus.Unsuspend)
+				}
+
 				// Handle case where Admin handler is not initialized
 				if s.handlers.Admin == nil {
 					r.HandleFunc("/*", func(w http.ResponseWriter, req *http.Request) {
@@ -32,6 +32,70 @@ type Handlers struct {
 	Category  *handlers.CategoryHandler
 	Dashboard *handlers.DashboardHandler
 	DeepDive  *handlers.DeepDiveHandler
+	Search    *handlers.SearchHandler
+	WSStats   *handlers.WebSocketStatsHandler
+	SLO       *handlers.SLOHandler
+
+	Enrichment           *handlers.EnrichmentHandler
+	EnrichmentFeedback   *handlers.EnrichmentFeedbackHandler
+	EnrichmentPrompt     *handlers.EnrichmentPromptHandler
+	NotificationTemplate *handlers.NotificationTemplateHandler
+	Classification       *handlers.ClassificationHandler
+	AIUsage              *handlers.AIUsageHandler
+	AICache              *handlers.AICacheHandler
+	IOC                  *handlers.IOCHandler
+
+	NotificationPreference *handlers.NotificationPreferenceHandler
+	Organization           *handlers.OrganizationHandler
+	Collection             *handlers.CollectionHandler
+	FeedPreference         *handlers.FeedPreferenceHandler
+	Analytics              *handlers.AnalyticsHandler
+	ArticleReview          *handlers.ArticleReviewHandler
+	ArticleEditorial       *handlers.ArticleEditorialHandler
+	ArticleSlug            *handlers.ArticleSlugHandler
+	Featured               *handlers.FeaturedHandler
+	Tag                    *handlers.TagHandler
+	CategoryAdmin          *handlers.CategoryAdminHandler
+	SourceTrust            *handlers.SourceTrustHandler
+	RelevanceRules         *handlers.RelevanceRulesHandler
+	CTA                    *handlers.CTAHandler
+	Public                 *handlers.PublicHandler
+	PublicAPIKey           *handlers.PublicAPIKeyHandler
+	WebhookSecret          *handlers.WebhookSecretHandler
+	Tenant                 *handlers.TenantHandler
+	ArticleImport          *handlers.ArticleImportHandler
+	Config                 *handlers.ConfigHandler
+	AuditLog               *handlers.AuditLogHandler
+	SecurityActivity       *handlers.SecurityActivityHandler
+	ThreatLandscape        *handlers.ThreatLandscapeHandler
+	VendorWatchlist        *handlers.VendorWatchlistHandler
+	KEV                    *handlers.KEVHandler
+	Exploit                *handlers.ExploitHandler
+	NVD                    *handlers.NVDHandler
+	SoftwareInventory      *handlers.SoftwareInventoryHandler
+	ThreatActor            *handlers.ThreatActorHandler
+	Incident               *handlers.IncidentHandler
+	Annotation             *handlers.AnnotationHandler
+	ClientEvent            *handlers.ClientEventHandler
+	ShareLink              *handlers.ShareLinkHandler
+	Newsletter             *handlers.NewsletterHandler
+	CRM                    *handlers.CRMHandler
+	SIEM                   *handlers.SIEMHandler
+	Report                 *handlers.ReportHandler
+	OrganizationReport     *handlers.OrganizationReportHandler
+	OrgCategory            *handlers.OrgCategoryHandler
+	UserStatus             *handlers.UserStatusHandler
+	UserInvite             *handlers.UserInviteHandler
+	RegistrationPolicy     *handlers.RegistrationPolicyHandler
+
+	// GraphQL serves /v1/graphql; it expects the authenticated user in the request context
+	GraphQL http.Handler
+
+	// Health serves the liveness and readiness probes
+	Health *handlers.HealthHandler
+
+	// Metrics serves Prometheus metrics on /metrics; nil disables both the endpoint and request instrumentation
+	Metrics http.Handler
 }
 
 // Config holds server configuration
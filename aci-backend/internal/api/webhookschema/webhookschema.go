@@ -0,0 +1,161 @@
+// Package webhookschema validates n8n webhook payloads against the published JSON Schema
+// of each event type
+package webhookschema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// schemaBaseURL is where the schemas are registered with the compiler; it is never fetched
+const schemaBaseURL = "https://aci.invalid/webhooks/"
+
+var (
+	// sources holds each event type's schema document as published
+	sources = map[string]json.RawMessage{}
+
+	// compiled holds each event type's compiled schema
+	compiled = map[string]*jsonschema.Schema{}
+
+	printer = message.NewPrinter(language.English)
+)
+
+func init() {
+	entries, err := schemaFiles.ReadDir("schemas")
+	if err != nil {
+		panic(fmt.Sprintf("webhookschema: failed to read schemas: %v", err))
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	// Schemas are self-contained, so nothing outside them may be loaded
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+
+	for _, entry := range entries {
+		eventType := strings.TrimSuffix(entry.Name(), ".json")
+
+		data, err := schemaFiles.ReadFile("schemas/" + entry.Name())
+		if err != nil {
+			panic(fmt.Sprintf("webhookschema: failed to read %s: %v", entry.Name(), err))
+		}
+
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			panic(fmt.Sprintf("webhookschema: invalid JSON in %s: %v", entry.Name(), err))
+		}
+
+		if err := compiler.AddResource(schemaBaseURL+entry.Name(), doc); err != nil {
+			panic(fmt.Sprintf("webhookschema: failed to add %s: %v", entry.Name(), err))
+		}
+
+		sources[eventType] = json.RawMessage(data)
+	}
+
+	for eventType := range sources {
+		schema, err := compiler.Compile(schemaBaseURL + eventType + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("webhookschema: failed to compile %s: %v", eventType, err))
+		}
+		compiled[eventType] = schema
+	}
+}
+
+// EventTypes returns the event types that have a schema, sorted
+func EventTypes() []string {
+	eventTypes := make([]string, 0, len(sources))
+	for eventType := range sources {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+// Schema returns the JSON Schema document for an event type
+func Schema(eventType string) (json.RawMessage, bool) {
+	schema, ok := sources[eventType]
+	return schema, ok
+}
+
+// Validate checks a whole webhook payload against its event type's schema and returns
+// the invalid fields, or nil when the payload is valid. Fields are named by their path
+// in the payload, such as data.title or data.articles.2.source_url
+// Validate panics if the event type has no schema; check with Schema first
+func Validate(eventType string, payload []byte) []validator.FieldError {
+	schema, ok := compiled[eventType]
+	if !ok {
+		panic(fmt.Sprintf("webhookschema: no schema for event type %q", eventType))
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(payload))
+	if err != nil {
+		return []validator.FieldError{{Field: "", Message: "invalid JSON payload"}}
+	}
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []validator.FieldError{{Field: "", Message: err.Error()}}
+	}
+
+	var fieldErrors []validator.FieldError
+	collectFieldErrors(validationErr, &fieldErrors)
+	sort.SliceStable(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+
+	return fieldErrors
+}
+
+// collectFieldErrors flattens a validation error into one entry per failed keyword
+func collectFieldErrors(err *jsonschema.ValidationError, fieldErrors *[]validator.FieldError) {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			collectFieldErrors(cause, fieldErrors)
+		}
+		return
+	}
+
+	field := strings.Join(err.InstanceLocation, ".")
+
+	// A missing property is reported against the property, not the object holding it
+	if required, ok := err.ErrorKind.(*kind.Required); ok {
+		for _, property := range required.Missing {
+			*fieldErrors = append(*fieldErrors, validator.FieldError{
+				Field:   joinFieldPath(field, property),
+				Message: "is required",
+			})
+		}
+		return
+	}
+
+	*fieldErrors = append(*fieldErrors, validator.FieldError{
+		Field:   field,
+		Message: err.ErrorKind.LocalizedString(printer),
+	})
+}
+
+// joinFieldPath appends a property to a field path
+func joinFieldPath(field, property string) string {
+	if field == "" {
+		return property
+	}
+	return field + "." + property
+}
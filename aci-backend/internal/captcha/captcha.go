@@ -0,0 +1,143 @@
+// Package captcha verifies challenge responses with hCaptcha or Cloudflare Turnstile
+// Both providers take the same siteverify form (secret, response, remoteip) and answer with
+// {"success": bool, "error-codes": [...]}, so one client serves either
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultHTTPTimeout bounds requests when the caller's context has no deadline
+	defaultHTTPTimeout = 10 * time.Second
+
+	// maxResponseBytes limits how much of a siteverify response is read
+	maxResponseBytes = 64 * 1024
+)
+
+// Provider identifies a CAPTCHA service
+type Provider string
+
+const (
+	ProviderHCaptcha  Provider = "hcaptcha"
+	ProviderTurnstile Provider = "turnstile"
+)
+
+// verifyURLs are the providers' public siteverify endpoints
+var verifyURLs = map[Provider]string{
+	ProviderHCaptcha:  "https://api.hcaptcha.com/siteverify",
+	ProviderTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+}
+
+// IsValid checks if the provider is supported
+func (p Provider) IsValid() bool {
+	_, ok := verifyURLs[p]
+	return ok
+}
+
+// ErrRejected is returned when the provider does not accept a challenge response
+// It wraps nothing; the provider's error codes are in the message
+var ErrRejected = errors.New("captcha response rejected")
+
+// Config configures a verifier
+type Config struct {
+	Provider  Provider
+	SecretKey string
+	VerifyURL string // defaults to the provider's siteverify endpoint
+}
+
+// Verifier checks challenge responses with a provider's siteverify endpoint
+type Verifier struct {
+	provider   Provider
+	secretKey  string
+	verifyURL  string
+	httpClient *http.Client
+}
+
+// New creates a verifier for cfg.Provider
+func New(cfg Config) (*Verifier, error) {
+	if !cfg.Provider.IsValid() {
+		return nil, fmt.Errorf("unsupported captcha provider %q: must be hcaptcha or turnstile", cfg.Provider)
+	}
+	if cfg.SecretKey == "" {
+		return nil, fmt.Errorf("captcha secret key is required")
+	}
+
+	verifyURL := cfg.VerifyURL
+	if verifyURL == "" {
+		verifyURL = verifyURLs[cfg.Provider]
+	}
+
+	return &Verifier{
+		provider:   cfg.Provider,
+		secretKey:  cfg.SecretKey,
+		verifyURL:  verifyURL,
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+// Provider returns the provider responses are verified with
+func (v *Verifier) Provider() Provider {
+	return v.provider
+}
+
+// siteverifyResponse is the part of a siteverify answer the verifier uses
+type siteverifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify checks a challenge response, passing the client's IP address when known
+// A response the provider does not accept returns an error wrapping ErrRejected; any other
+// error means the provider could not be asked
+func (v *Verifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return fmt.Errorf("%w: missing response", ErrRejected)
+	}
+
+	form := url.Values{
+		"secret":   {v.secretKey},
+		"response": {token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s siteverify request failed: %w", v.provider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s siteverify returned status %d", v.provider, resp.StatusCode)
+	}
+
+	var result siteverifyResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode %s siteverify response: %w", v.provider, err)
+	}
+
+	if !result.Success {
+		if len(result.ErrorCodes) == 0 {
+			return ErrRejected
+		}
+		return fmt.Errorf("%w: %s", ErrRejected, strings.Join(result.ErrorCodes, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerifier(t *testing.T, handler http.HandlerFunc) *Verifier {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	verifier, err := New(Config{Provider: ProviderTurnstile, SecretKey: "secret", VerifyURL: server.URL})
+	require.NoError(t, err)
+	return verifier
+}
+
+func TestNew_RejectsInvalidConfig(t *testing.T) {
+	_, err := New(Config{Provider: "recaptcha", SecretKey: "secret"})
+	assert.Error(t, err)
+
+	_, err = New(Config{Provider: ProviderHCaptcha})
+	assert.Error(t, err)
+
+	verifier, err := New(Config{Provider: ProviderHCaptcha, SecretKey: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.hcaptcha.com/siteverify", verifier.verifyURL)
+}
+
+func TestVerify_Success(t *testing.T) {
+	verifier := newTestVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "token-123", r.PostForm.Get("response"))
+		assert.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+
+		_, _ = w.Write([]byte(`{"success": true, "hostname": "app.example.com"}`))
+	})
+
+	assert.NoError(t, verifier.Verify(context.Background(), "token-123", "203.0.113.7"))
+}
+
+func TestVerify_Rejected(t *testing.T) {
+	verifier := newTestVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response", "timeout-or-duplicate"]}`))
+	})
+
+	err := verifier.Verify(context.Background(), "token-123", "")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRejected))
+	assert.Contains(t, err.Error(), "timeout-or-duplicate")
+}
+
+func TestVerify_MissingTokenIsRejectedWithoutRequest(t *testing.T) {
+	called := false
+	verifier := newTestVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	err := verifier.Verify(context.Background(), "", "203.0.113.7")
+	assert.True(t, errors.Is(err, ErrRejected))
+	assert.False(t, called)
+}
+
+func TestVerify_ProviderErrorIsNotRejection(t *testing.T) {
+	verifier := newTestVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	err := verifier.Verify(context.Background(), "token-123", "")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrRejected))
+}
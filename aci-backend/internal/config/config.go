@@ -1,21 +1,61 @@
 package config
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	N8N      N8NConfig
-	AI       AIConfig
-	Redis    RedisConfig
-	Logger   LoggerConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	N8N        N8NConfig
+	AI         AIConfig
+	Redis      RedisConfig
+	Logger     LoggerConfig
+	SLO        SLOConfig
+	Enrichment EnrichmentConfig
+	Account    AccountConfig
+	Tokens     TokenConfig
+	Audit      AuditConfig
+	Storage    StorageConfig
+	Archive    ArchiveConfig
+	Images     ImageConfig
+	Review     ReviewConfig
+	Trust      TrustConfig
+	PublicAPI  PublicAPIConfig
+	Search     SearchConfig
+	Metrics    MetricsConfig
+	Tracing    TracingConfig
+	Health     HealthConfig
+	KEV        KEVConfig
+	Exploit    ExploitConfig
+	NVD        NVDConfig
+	Events     ClientEventsConfig
+	Share      ShareConfig
+	Newsletter NewsletterConfig
+	CRM        CRMConfig
+	SIEM       SIEMConfig
+	Reports    ReportsConfig
+	Invites    InvitesConfig
+	Tenancy    TenancyConfig
+
+	Registration RegistrationConfig
+	Captcha      CaptchaConfig
+	Auth         AuthConfig
+
+	Classification ClassificationConfig
+	Deduplication  DeduplicationConfig
+
+	file     string    // CONFIG_FILE the settings were read from, if any
+	settings []Setting // resolved settings, with secrets redacted
+	loadedAt time.Time
 }
 
 type ServerConfig struct {
@@ -23,7 +63,17 @@ type ServerConfig struct {
 }
 
 type DatabaseConfig struct {
-	URL string
+	URL                string
+	MaxConns           int
+	MinConns           int
+	MaxConnLifetime    time.Duration
+	MaxConnIdleTime    time.Duration
+	QueryTimeout       time.Duration // default deadline for each query; 0 disables
+	SlowQueryThreshold time.Duration // queries at least this slow are logged; 0 disables
+
+	// Read replicas serve GET requests' list, search and lookup queries; none reads everything from the primary
+	ReplicaURLs          []string
+	ReplicaCheckInterval time.Duration
 }
 
 type JWTConfig struct {
@@ -34,11 +84,52 @@ type JWTConfig struct {
 }
 
 type N8NConfig struct {
-	WebhookSecret string
+	WebhookSecret     string
+	MaxSkew           time.Duration // how far a webhook timestamp may be from the server clock
+	RequireTimestamp  bool          // reject webhooks without X-N8N-Timestamp
+	SecretGracePeriod time.Duration // how long a rotated webhook secret is still accepted
+	SecretKey         string        // hex-encoded AES-256 key that encrypts stored webhook secrets
+}
+
+// SecretKeyBytes returns the decoded N8N_WEBHOOK_SECRET_KEY, or nil if it is unset or invalid
+func (c N8NConfig) SecretKeyBytes() []byte {
+	key, err := hex.DecodeString(c.SecretKey)
+	if err != nil || len(key) == 0 {
+		return nil
+	}
+	return key
 }
 
 type AIConfig struct {
+	Provider        string // anthropic, openai, azure, local, or disabled
+	Model           string
+	BaseURL         string
+	APIVersion      string
 	AnthropicAPIKey string
+	OpenAIAPIKey    string
+	AzureAPIKey     string
+	LocalAPIKey     string
+
+	MonthlyBudgetUSD  float64 // 0 disables the budget
+	InputCostPerMTok  float64 // USD per million tokens; overrides list prices when either is set
+	OutputCostPerMTok float64
+
+	CacheEnabled bool
+	CacheTTL     time.Duration
+}
+
+// APIKey returns the API key for the selected provider
+func (c AIConfig) APIKey() string {
+	switch c.Provider {
+	case "openai":
+		return c.OpenAIAPIKey
+	case "azure":
+		return c.AzureAPIKey
+	case "local":
+		return c.LocalAPIKey
+	default:
+		return c.AnthropicAPIKey
+	}
 }
 
 type RedisConfig struct {
@@ -49,92 +140,940 @@ type LoggerConfig struct {
 	Level string
 }
 
-// Load loads configuration from environment variables
+type EnrichmentConfig struct {
+	WorkerEnabled bool
+	Concurrency   int
+	RatePerMinute int
+	PollInterval  time.Duration
+
+	BatchSize             int // articles analyzed per AI call; 1 disables batching
+	BatchMaxTokens        int // estimated prompt tokens per batch
+	BatchMaxArticleTokens int // longer articles are always enriched on their own
+
+	RequestWebhookURL string // n8n webhook sent enrichment.requested while AI_PROVIDER=disabled; empty sends none
+	CallbackURL       string // public URL of /v1/webhooks/n8n, where the workflow posts enrichment.complete
+}
+
+type AccountConfig struct {
+	DeletionGracePeriod time.Duration
+	PurgeInterval       time.Duration
+}
+
+type TokenConfig struct {
+	CleanupInterval time.Duration
+	Retention       time.Duration // how long expired and revoked refresh tokens are kept
+}
+
+type AuditConfig struct {
+	RetentionDays   int // audit logs older than this are archived and purged; 0 keeps them forever
+	ArchiveInterval time.Duration
+	ArchivePrefix   string // object key prefix for archives in STORAGE_BUCKET
+
+	OutboxRelayInterval time.Duration // how often audit logs staged with admin changes are delivered
+}
+
+// StorageConfig is the S3-compatible object storage used for archives
+type StorageConfig struct {
+	Endpoint        string // empty for AWS S3
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UsePathStyle    bool // required by MinIO and most self-hosted services
+}
+
+// ArchiveConfig controls snapshots of article source pages kept in STORAGE_BUCKET
+type ArchiveConfig struct {
+	Enabled  bool
+	Interval time.Duration // how often newly ingested articles are archived
+	Prefix   string        // object key prefix for snapshots
+}
+
+// ImageConfig controls resized copies of article hero images kept in STORAGE_BUCKET
+type ImageConfig struct {
+	Enabled  bool
+	Interval time.Duration // how often images of newly ingested articles are stored
+	Prefix   string        // object key prefix for resized images
+}
+
+type ReviewConfig struct {
+	Enabled bool // hold webhook-ingested articles unpublished until an admin approves them
+}
+
+type TrustConfig struct {
+	CalibrationEnabled  bool
+	CalibrationInterval time.Duration // also the minimum time between two changes to one source
+	Window              time.Duration
+	MaxDelta            float64 // largest change to a trust score per calibration
+	MinArticles         int
+}
+
+type SearchConfig struct {
+	CacheTTL time.Duration // 0 disables the search result cache
+}
+
+type PublicAPIConfig struct {
+	Enabled                     bool
+	AnonymousRequestsPerMinute  int // per IP address; 0 requires an API key
+	DefaultKeyRequestsPerMinute int
+	CacheTTL                    time.Duration
+}
+
+type MetricsConfig struct {
+	Enabled bool
+	Token   string // bearer token required to scrape /metrics; empty leaves it open
+}
+
+type TracingConfig struct {
+	Enabled      bool
+	ServiceName  string
+	OTLPEndpoint string // host:port of an OTLP/HTTP collector
+	OTLPInsecure bool
+	SampleRatio  float64
+}
+
+type HealthConfig struct {
+	CheckAIProvider bool // include AI provider reachability in /readyz (informational only)
+	CheckTimeout    time.Duration
+}
+
+// KEVConfig controls syncing of the CISA Known Exploited Vulnerabilities catalog
+type KEVConfig struct {
+	SyncEnabled  bool
+	FeedURL      string
+	SyncInterval time.Duration
+	Timeout      time.Duration // bound on downloading the catalog
+}
+
+// ExploitConfig controls syncing of public exploits from Exploit-DB and GitHub proofs of concept
+// An empty URL disables that source
+type ExploitConfig struct {
+	SyncEnabled   bool
+	ExploitDBURL  string
+	GitHubPoCURL  string
+	SyncInterval  time.Duration
+	Timeout       time.Duration // bound on each download
+	Lookback      time.Duration // age of articles whose CVEs are looked up on GitHub and matched against exploit alerts
+	GitHubMaxCVEs int           // CVEs looked up on GitHub per sync
+}
+
+// NVDConfig controls syncing of affected products from the NVD CVE API, which software
+// inventories are matched against
+type NVDConfig struct {
+	SyncEnabled     bool
+	APIURL          string
+	APIKey          string        // raises the NVD rate limit; optional
+	SyncInterval    time.Duration
+	Timeout         time.Duration // bound on each request
+	RequestInterval time.Duration // pause between requests, to stay under the NVD rate limit
+	Lookback        time.Duration // age of articles whose CVEs are looked up
+	RefreshAfter    time.Duration // age after which a CVE already looked up is fetched again
+	MaxCVEs         int           // CVEs looked up per sync
+}
+
+// ClientEventsConfig controls ingestion and aggregation of client engagement events
+type ClientEventsConfig struct {
+	Enabled             bool
+	BatchSize           int           // events written per insert
+	BufferLimit         int           // events held in memory awaiting a write; more are dropped
+	FlushInterval       time.Duration // longest an event waits in memory before it is written
+	AggregationInterval time.Duration // how often the daily aggregates are recomputed
+	RetentionMonths     int           // monthly partitions older than this are dropped; 0 keeps them forever
+}
+
+// ShareConfig controls the short signed share links for articles
+type ShareConfig struct {
+	Enabled bool
+	Secret  string        // signs share link tokens
+	BaseURL string        // public origin of this API, prefixed to /s/{token}
+	SiteURL string        // origin of the web app share links redirect to
+	MaxTTL  time.Duration // longest expiry a link can be given; links created without one never expire
+}
+
+// NewsletterConfig controls the admin newsletter builder and the SMTP relay newsletters are sent through
+type NewsletterConfig struct {
+	Enabled      bool
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string // empty for relays that do not authenticate
+	SMTPPassword string
+	From         string        // sender address, optionally with a display name
+	BaseURL      string        // public origin of this API, prefixed to the tracking and unsubscribe links
+	SiteURL      string        // origin of the web app article links redirect to; hosts the confirm and unsubscribe pages
+	SendInterval time.Duration // how often queued deliveries are sent
+	BatchSize    int           // messages sent per interval
+	TokenSecret  string        // signs the confirmation and unsubscribe links of public sign-ups
+	ConfirmTTL   time.Duration // how long a confirmation link stays valid
+}
+
+// CRMConfig controls pushing CTA clicks and lead form submissions to HubSpot or Salesforce
+type CRMConfig struct {
+	Enabled     bool
+	Connector   string        // hubspot or salesforce
+	FieldMap    string        // field=property overrides of the connector's default mapping
+	SiteURL     string        // origin of the web app, used for article links in pushed activities
+	Interval    time.Duration // how often queued events are pushed
+	BatchSize   int           // events pushed per interval
+	MaxAttempts int           // attempts before an event is moved to the failure queue
+
+	HubSpotToken string // private app access token
+
+	SalesforceInstanceURL  string
+	SalesforceClientID     string
+	SalesforceClientSecret string
+	SalesforceAPIVersion   string
+}
+
+// SIEMConfig controls forwarding enriched articles and IOCs to Splunk and Elasticsearch
+// A destination is configured by its URL; admins can turn configured destinations off and on
+type SIEMConfig struct {
+	Enabled     bool
+	SiteURL     string        // origin of the web app, used for article links in forwarded events
+	Interval    time.Duration // how often queued events are sent
+	BatchSize   int           // events per request to a destination
+	MaxAttempts int           // attempts before an event is marked failed
+	Retention   time.Duration // how long delivered events are kept
+
+	SplunkHECURL   string // HTTP Event Collector base URL
+	SplunkHECToken string
+	SplunkIndex    string // overrides the token's default index when set
+
+	ElasticsearchURL      string
+	ElasticsearchAPIKey   string // takes precedence over the username and password
+	ElasticsearchUsername string
+	ElasticsearchPassword string
+	ElasticsearchIndex    string
+}
+
+// ReportsConfig controls emailing the weekly threat briefing to readers on the weekly digest,
+// and generating organization reports on their schedule
+// Reports are emailed through the newsletter SMTP relay and link to its site URL
+type ReportsConfig struct {
+	EmailEnabled   bool
+	EmailInterval  time.Duration // how often pending briefings are looked for
+	EmailBatchSize int           // briefings sent per interval
+
+	ScheduleInterval  time.Duration // how often due organization reports are looked for
+	ScheduleBatchSize int           // organization reports generated per interval
+}
+
+// InvitesConfig controls admins inviting users, who set their password through a signed link
+// Invitations are emailed through the newsletter SMTP relay and link to its site URL
+type InvitesConfig struct {
+	Enabled     bool
+	TokenSecret string        // signs invitation links
+	TTL         time.Duration // how long an invitation link stays valid
+}
+
+// TenancyConfig controls multi-tenant mode, where one deployment serves several customer
+// workspaces. Requests are scoped to the workspace of their hostname or access token
+type TenancyConfig struct {
+	Enabled    bool
+	BaseDomain string // <slug>.<BaseDomain> serves each workspace; custom hostnames work without it
+}
+
+// RegistrationConfig controls public self-registration
+// These are defaults; admins can override them at runtime through /v1/admin/registration
+type RegistrationConfig struct {
+	Enabled        bool
+	AllowedDomains []string // email domains allowed to register; empty allows any
+}
+
+// CaptchaConfig controls CAPTCHA challenges on registration and after repeated failed logins
+// An empty provider disables them
+type CaptchaConfig struct {
+	Provider           string        // hcaptcha or turnstile
+	SiteKey            string        // public key the web app renders the widget with
+	SecretKey          string        // verifies responses with the provider
+	VerifyURL          string        // overrides the provider's siteverify endpoint
+	Registration       bool          // challenge every registration
+	LoginFailures      int           // failed logins per email or address before login is challenged; 0 never
+	LoginFailureWindow time.Duration // how long failed logins are counted
+}
+
+// AuthConfig selects how browser clients hold their refresh token
+// In bearer mode it is returned in the response body; in cookie mode it is set in an httpOnly
+// cookie, and refresh and logout require a CSRF token. Access tokens are bearer tokens in both
+type AuthConfig struct {
+	Mode           string // bearer or cookie
+	CookieDomain   string // defaults to the API host
+	CookieSecure   bool
+	CookieSameSite string // lax or strict
+}
+
+type ClassificationConfig struct {
+	Enabled            bool
+	AutoApplyThreshold float64
+	FallbackCategory   string
+}
+
+type DeduplicationConfig struct {
+	MaxDistance      int
+	Window           time.Duration
+	ResolveRedirects bool          // follow source URL redirects before comparing URLs
+	RedirectTimeout  time.Duration // bound on resolving one source URL
+}
+
+type SLOConfig struct {
+	IngestLatencyBudget time.Duration
+	IngestObjective     float64
+}
+
+// validLogLevels are the zerolog level names accepted by LOG_LEVEL
+var validLogLevels = map[string]bool{
+	"trace":    true,
+	"debug":    true,
+	"info":     true,
+	"warn":     true,
+	"error":    true,
+	"fatal":    true,
+	"panic":    true,
+	"disabled": true,
+}
+
+// Load loads configuration from environment variables and the optional YAML file named by CONFIG_FILE
+// Environment variables take precedence over the file, and the file over built-in defaults
 func Load() (*Config, error) {
 	// Load .env file if exists (optional)
 	_ = godotenv.Load()
 
+	src, err := newSource(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
 	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnvInt("SERVER_PORT", 8080),
+			Port: src.getInt("SERVER_PORT", 8080),
 		},
 		Database: DatabaseConfig{
-			URL: os.Getenv("DATABASE_URL"),
+			URL:                src.getString("DATABASE_URL", ""),
+			MaxConns:           src.getInt("DATABASE_MAX_CONNS", 25),
+			MinConns:           src.getInt("DATABASE_MIN_CONNS", 5),
+			MaxConnLifetime:    src.getDuration("DATABASE_MAX_CONN_LIFETIME", time.Hour),
+			MaxConnIdleTime:    src.getDuration("DATABASE_MAX_CONN_IDLE_TIME", 30*time.Minute),
+			QueryTimeout:       src.getDuration("DATABASE_QUERY_TIMEOUT", 30*time.Second),
+			SlowQueryThreshold: src.getDuration("DATABASE_SLOW_QUERY_THRESHOLD", time.Second),
+
+			ReplicaURLs:          src.getList("DATABASE_REPLICA_URLS"),
+			ReplicaCheckInterval: src.getDuration("DATABASE_REPLICA_CHECK_INTERVAL", 10*time.Second),
 		},
 		JWT: JWTConfig{
-			PrivateKeyPath:     os.Getenv("JWT_PRIVATE_KEY_PATH"),
-			PublicKeyPath:      os.Getenv("JWT_PUBLIC_KEY_PATH"),
-			AccessTokenExpiry:  getEnvDuration("JWT_ACCESS_TOKEN_EXPIRY", 15*time.Minute),
-			RefreshTokenExpiry: getEnvDuration("JWT_REFRESH_TOKEN_EXPIRY", 168*time.Hour),
+			PrivateKeyPath:     src.getString("JWT_PRIVATE_KEY_PATH", ""),
+			PublicKeyPath:      src.getString("JWT_PUBLIC_KEY_PATH", ""),
+			AccessTokenExpiry:  src.getDuration("JWT_ACCESS_TOKEN_EXPIRY", 15*time.Minute),
+			RefreshTokenExpiry: src.getDuration("JWT_REFRESH_TOKEN_EXPIRY", 168*time.Hour),
 		},
 		N8N: N8NConfig{
-			WebhookSecret: os.Getenv("N8N_WEBHOOK_SECRET"),
+			WebhookSecret:     src.getString("N8N_WEBHOOK_SECRET", ""),
+			MaxSkew:           src.getDuration("N8N_WEBHOOK_MAX_SKEW", 5*time.Minute),
+			RequireTimestamp:  src.getBool("N8N_WEBHOOK_REQUIRE_TIMESTAMP", false),
+			SecretGracePeriod: src.getDuration("N8N_WEBHOOK_SECRET_GRACE_PERIOD", 24*time.Hour),
+			SecretKey:         src.getString("N8N_WEBHOOK_SECRET_KEY", ""),
 		},
 		AI: AIConfig{
-			AnthropicAPIKey: os.Getenv("ANTHROPIC_API_KEY"),
+			Provider:        strings.ToLower(src.getString("AI_PROVIDER", "anthropic")),
+			Model:           src.getString("AI_MODEL", ""),
+			BaseURL:         src.getString("AI_BASE_URL", ""),
+			APIVersion:      src.getString("AZURE_OPENAI_API_VERSION", ""),
+			AnthropicAPIKey: src.getString("ANTHROPIC_API_KEY", ""),
+			OpenAIAPIKey:    src.getString("OPENAI_API_KEY", ""),
+			AzureAPIKey:     src.getString("AZURE_OPENAI_API_KEY", ""),
+			LocalAPIKey:     src.getString("AI_API_KEY", ""),
+
+			MonthlyBudgetUSD:  src.getFloat("AI_MONTHLY_BUDGET_USD", 0),
+			InputCostPerMTok:  src.getFloat("AI_INPUT_COST_PER_MTOK", 0),
+			OutputCostPerMTok: src.getFloat("AI_OUTPUT_COST_PER_MTOK", 0),
+
+			CacheEnabled: src.getBool("AI_CACHE_ENABLED", true),
+			CacheTTL:     src.getDuration("AI_CACHE_TTL", 7*24*time.Hour),
 		},
 		Redis: RedisConfig{
-			URL: os.Getenv("REDIS_URL"),
+			URL: src.getString("REDIS_URL", ""),
 		},
 		Logger: LoggerConfig{
-			Level: getEnvString("LOG_LEVEL", "info"),
+			Level: strings.ToLower(src.getString("LOG_LEVEL", "info")),
+		},
+		Enrichment: EnrichmentConfig{
+			WorkerEnabled: src.getBool("ENRICHMENT_WORKER_ENABLED", true),
+			Concurrency:   src.getInt("ENRICHMENT_CONCURRENCY", 2),
+			RatePerMinute: src.getInt("ENRICHMENT_RATE_PER_MINUTE", 30),
+			PollInterval:  src.getDuration("ENRICHMENT_POLL_INTERVAL", 30*time.Second),
+
+			BatchSize:             src.getInt("ENRICHMENT_BATCH_SIZE", 1),
+			BatchMaxTokens:        src.getInt("ENRICHMENT_BATCH_MAX_TOKENS", 12000),
+			BatchMaxArticleTokens: src.getInt("ENRICHMENT_BATCH_MAX_ARTICLE_TOKENS", 2000),
+
+			RequestWebhookURL: src.getString("ENRICHMENT_REQUEST_WEBHOOK_URL", ""),
+			CallbackURL:       src.getString("ENRICHMENT_CALLBACK_URL", ""),
+		},
+		SLO: SLOConfig{
+			IngestLatencyBudget: src.getDuration("SLO_INGEST_LATENCY_BUDGET", 5*time.Minute),
+			IngestObjective:     src.getFloat("SLO_INGEST_OBJECTIVE", 0.95),
+		},
+		Account: AccountConfig{
+			DeletionGracePeriod: src.getDuration("ACCOUNT_DELETION_GRACE_PERIOD", 30*24*time.Hour),
+			PurgeInterval:       src.getDuration("ACCOUNT_PURGE_INTERVAL", time.Hour),
+		},
+		Tokens: TokenConfig{
+			CleanupInterval: src.getDuration("REFRESH_TOKEN_CLEANUP_INTERVAL", time.Hour),
+			Retention:       src.getDuration("REFRESH_TOKEN_RETENTION", 7*24*time.Hour),
+		},
+		Audit: AuditConfig{
+			RetentionDays:   src.getInt("AUDIT_LOG_RETENTION_DAYS", 0),
+			ArchiveInterval: src.getDuration("AUDIT_LOG_ARCHIVE_INTERVAL", 24*time.Hour),
+			ArchivePrefix:   src.getString("AUDIT_LOG_ARCHIVE_PREFIX", "audit-logs"),
+
+			OutboxRelayInterval: src.getDuration("AUDIT_OUTBOX_RELAY_INTERVAL", 5*time.Second),
+		},
+		Storage: StorageConfig{
+			Endpoint:        src.getString("STORAGE_ENDPOINT", ""),
+			Region:          src.getString("STORAGE_REGION", "us-east-1"),
+			Bucket:          src.getString("STORAGE_BUCKET", ""),
+			AccessKeyID:     src.getString("STORAGE_ACCESS_KEY_ID", ""),
+			SecretAccessKey: src.getString("STORAGE_SECRET_ACCESS_KEY", ""),
+			UsePathStyle:    src.getBool("STORAGE_USE_PATH_STYLE", false),
+		},
+		Archive: ArchiveConfig{
+			Enabled:  src.getBool("ARTICLE_ARCHIVE_ENABLED", false),
+			Interval: src.getDuration("ARTICLE_ARCHIVE_INTERVAL", time.Minute),
+			Prefix:   src.getString("ARTICLE_ARCHIVE_PREFIX", "article-archives"),
+		},
+		Images: ImageConfig{
+			Enabled:  src.getBool("ARTICLE_IMAGES_ENABLED", false),
+			Interval: src.getDuration("ARTICLE_IMAGE_INTERVAL", time.Minute),
+			Prefix:   src.getString("ARTICLE_IMAGE_PREFIX", "article-images"),
+		},
+		Review: ReviewConfig{
+			Enabled: src.getBool("ARTICLE_REVIEW_ENABLED", false),
+		},
+		Trust: TrustConfig{
+			CalibrationEnabled:  src.getBool("SOURCE_TRUST_CALIBRATION_ENABLED", true),
+			CalibrationInterval: src.getDuration("SOURCE_TRUST_CALIBRATION_INTERVAL", 24*time.Hour),
+			Window:              src.getDuration("SOURCE_TRUST_WINDOW", 30*24*time.Hour),
+			MaxDelta:            src.getFloat("SOURCE_TRUST_MAX_DELTA", 0.05),
+			MinArticles:         src.getInt("SOURCE_TRUST_MIN_ARTICLES", 10),
+		},
+		PublicAPI: PublicAPIConfig{
+			Enabled:                     src.getBool("PUBLIC_API_ENABLED", true),
+			AnonymousRequestsPerMinute:  src.getInt("PUBLIC_API_ANONYMOUS_REQUESTS_PER_MINUTE", 30),
+			DefaultKeyRequestsPerMinute: src.getInt("PUBLIC_API_KEY_REQUESTS_PER_MINUTE", 600),
+			CacheTTL:                    src.getDuration("PUBLIC_API_CACHE_TTL", time.Minute),
+		},
+		Search: SearchConfig{
+			CacheTTL: src.getDuration("SEARCH_CACHE_TTL", 30*time.Second),
+		},
+		Metrics: MetricsConfig{
+			Enabled: src.getBool("METRICS_ENABLED", true),
+			Token:   src.getString("METRICS_TOKEN", ""),
+		},
+		Tracing: TracingConfig{
+			Enabled:      src.getBool("TRACING_ENABLED", false),
+			ServiceName:  src.getString("TRACING_SERVICE_NAME", "aci-backend"),
+			OTLPEndpoint: src.getString("TRACING_OTLP_ENDPOINT", "localhost:4318"),
+			OTLPInsecure: src.getBool("TRACING_OTLP_INSECURE", true),
+			SampleRatio:  src.getFloat("TRACING_SAMPLE_RATIO", 0.1),
+		},
+		Health: HealthConfig{
+			CheckAIProvider: src.getBool("HEALTH_CHECK_AI_PROVIDER", false),
+			CheckTimeout:    src.getDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+		},
+		KEV: KEVConfig{
+			SyncEnabled:  src.getBool("KEV_SYNC_ENABLED", true),
+			FeedURL:      src.getString("KEV_FEED_URL", "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"),
+			SyncInterval: src.getDuration("KEV_SYNC_INTERVAL", 12*time.Hour),
+			Timeout:      src.getDuration("KEV_SYNC_TIMEOUT", time.Minute),
+		},
+		Exploit: ExploitConfig{
+			SyncEnabled:   src.getBool("EXPLOIT_SYNC_ENABLED", true),
+			ExploitDBURL:  src.getString("EXPLOIT_DB_URL", "https://gitlab.com/exploit-database/exploitdb/-/raw/main/files_exploits.csv"),
+			GitHubPoCURL:  src.getString("EXPLOIT_GITHUB_POC_URL", "https://raw.githubusercontent.com/nomi-sec/PoC-in-GitHub/master"),
+			SyncInterval:  src.getDuration("EXPLOIT_SYNC_INTERVAL", 24*time.Hour),
+			Timeout:       src.getDuration("EXPLOIT_SYNC_TIMEOUT", 2*time.Minute),
+			Lookback:      src.getDuration("EXPLOIT_LOOKBACK", 90*24*time.Hour),
+			GitHubMaxCVEs: src.getInt("EXPLOIT_GITHUB_MAX_CVES", 500),
+		},
+		NVD: NVDConfig{
+			SyncEnabled:     src.getBool("NVD_SYNC_ENABLED", true),
+			APIURL:          src.getString("NVD_API_URL", "https://services.nvd.nist.gov/rest/json/cves/2.0"),
+			APIKey:          src.getString("NVD_API_KEY", ""),
+			SyncInterval:    src.getDuration("NVD_SYNC_INTERVAL", 6*time.Hour),
+			Timeout:         src.getDuration("NVD_SYNC_TIMEOUT", 30*time.Second),
+			RequestInterval: src.getDuration("NVD_REQUEST_INTERVAL", 6*time.Second),
+			Lookback:        src.getDuration("NVD_LOOKBACK", 90*24*time.Hour),
+			RefreshAfter:    src.getDuration("NVD_REFRESH_AFTER", 7*24*time.Hour),
+			MaxCVEs:         src.getInt("NVD_MAX_CVES", 200),
+		},
+		Events: ClientEventsConfig{
+			Enabled:             src.getBool("CLIENT_EVENTS_ENABLED", true),
+			BatchSize:           src.getInt("CLIENT_EVENTS_BATCH_SIZE", 500),
+			BufferLimit:         src.getInt("CLIENT_EVENTS_BUFFER_LIMIT", 10000),
+			FlushInterval:       src.getDuration("CLIENT_EVENTS_FLUSH_INTERVAL", 5*time.Second),
+			AggregationInterval: src.getDuration("CLIENT_EVENTS_AGGREGATION_INTERVAL", 15*time.Minute),
+			RetentionMonths:     src.getInt("CLIENT_EVENTS_RETENTION_MONTHS", 13),
+		},
+		Share: ShareConfig{
+			Enabled: src.getBool("SHARE_LINKS_ENABLED", false),
+			Secret:  src.getString("SHARE_LINK_SECRET", ""),
+			BaseURL: src.getString("SHARE_LINK_BASE_URL", ""),
+			SiteURL: src.getString("SHARE_SITE_URL", ""),
+			MaxTTL:  src.getDuration("SHARE_LINK_MAX_TTL", 30*24*time.Hour),
+		},
+		Newsletter: NewsletterConfig{
+			Enabled:      src.getBool("NEWSLETTER_ENABLED", false),
+			SMTPHost:     src.getString("NEWSLETTER_SMTP_HOST", ""),
+			SMTPPort:     src.getInt("NEWSLETTER_SMTP_PORT", 587),
+			SMTPUsername: src.getString("NEWSLETTER_SMTP_USERNAME", ""),
+			SMTPPassword: src.getString("NEWSLETTER_SMTP_PASSWORD", ""),
+			From:         src.getString("NEWSLETTER_FROM", ""),
+			BaseURL:      src.getString("NEWSLETTER_BASE_URL", ""),
+			SiteURL:      src.getString("NEWSLETTER_SITE_URL", ""),
+			SendInterval: src.getDuration("NEWSLETTER_SEND_INTERVAL", 10*time.Second),
+			BatchSize:    src.getInt("NEWSLETTER_BATCH_SIZE", 50),
+			TokenSecret:  src.getString("NEWSLETTER_TOKEN_SECRET", ""),
+			ConfirmTTL:   src.getDuration("NEWSLETTER_CONFIRM_TTL", 72*time.Hour),
+		},
+		CRM: CRMConfig{
+			Enabled:                src.getBool("CRM_ENABLED", false),
+			Connector:              strings.ToLower(src.getString("CRM_CONNECTOR", "")),
+			FieldMap:               src.getString("CRM_FIELD_MAP", ""),
+			SiteURL:                src.getString("CRM_SITE_URL", ""),
+			Interval:               src.getDuration("CRM_PUSH_INTERVAL", 30*time.Second),
+			BatchSize:              src.getInt("CRM_BATCH_SIZE", 50),
+			MaxAttempts:            src.getInt("CRM_MAX_ATTEMPTS", 8),
+			HubSpotToken:           src.getString("CRM_HUBSPOT_TOKEN", ""),
+			SalesforceInstanceURL:  src.getString("CRM_SALESFORCE_INSTANCE_URL", ""),
+			SalesforceClientID:     src.getString("CRM_SALESFORCE_CLIENT_ID", ""),
+			SalesforceClientSecret: src.getString("CRM_SALESFORCE_CLIENT_SECRET", ""),
+			SalesforceAPIVersion:   src.getString("CRM_SALESFORCE_API_VERSION", "v60.0"),
+		},
+		SIEM: SIEMConfig{
+			Enabled:               src.getBool("SIEM_ENABLED", false),
+			SiteURL:               src.getString("SIEM_SITE_URL", ""),
+			Interval:              src.getDuration("SIEM_FORWARD_INTERVAL", 10*time.Second),
+			BatchSize:             src.getInt("SIEM_BATCH_SIZE", 100),
+			MaxAttempts:           src.getInt("SIEM_MAX_ATTEMPTS", 10),
+			Retention:             src.getDuration("SIEM_RETENTION", 7*24*time.Hour),
+			SplunkHECURL:          src.getString("SIEM_SPLUNK_HEC_URL", ""),
+			SplunkHECToken:        src.getString("SIEM_SPLUNK_HEC_TOKEN", ""),
+			SplunkIndex:           src.getString("SIEM_SPLUNK_INDEX", ""),
+			ElasticsearchURL:      src.getString("SIEM_ELASTICSEARCH_URL", ""),
+			ElasticsearchAPIKey:   src.getString("SIEM_ELASTICSEARCH_API_KEY", ""),
+			ElasticsearchUsername: src.getString("SIEM_ELASTICSEARCH_USERNAME", ""),
+			ElasticsearchPassword: src.getString("SIEM_ELASTICSEARCH_PASSWORD", ""),
+			ElasticsearchIndex:    src.getString("SIEM_ELASTICSEARCH_INDEX", "aci-events"),
+		},
+		Reports: ReportsConfig{
+			EmailEnabled:   src.getBool("REPORTS_EMAIL_ENABLED", false),
+			EmailInterval:  src.getDuration("REPORTS_EMAIL_INTERVAL", 15*time.Minute),
+			EmailBatchSize: src.getInt("REPORTS_EMAIL_BATCH_SIZE", 50),
+
+			ScheduleInterval:  src.getDuration("REPORTS_SCHEDULE_INTERVAL", 5*time.Minute),
+			ScheduleBatchSize: src.getInt("REPORTS_SCHEDULE_BATCH_SIZE", 20),
+		},
+		Invites: InvitesConfig{
+			Enabled:     src.getBool("INVITES_ENABLED", false),
+			TokenSecret: src.getString("INVITES_TOKEN_SECRET", ""),
+			TTL:         src.getDuration("INVITES_TTL", 7*24*time.Hour),
+		},
+		Tenancy: TenancyConfig{
+			Enabled:    src.getBool("MULTI_TENANT_ENABLED", false),
+			BaseDomain: src.getString("TENANT_BASE_DOMAIN", ""),
+		},
+		Registration: RegistrationConfig{
+			Enabled:        src.getBool("REGISTRATION_ENABLED", true),
+			AllowedDomains: src.getList("REGISTRATION_ALLOWED_DOMAINS"),
+		},
+		Captcha: CaptchaConfig{
+			Provider:           src.getString("CAPTCHA_PROVIDER", ""),
+			SiteKey:            src.getString("CAPTCHA_SITE_KEY", ""),
+			SecretKey:          src.getString("CAPTCHA_SECRET_KEY", ""),
+			VerifyURL:          src.getString("CAPTCHA_VERIFY_URL", ""),
+			Registration:       src.getBool("CAPTCHA_REGISTRATION", true),
+			LoginFailures:      src.getInt("CAPTCHA_LOGIN_FAILURES", 3),
+			LoginFailureWindow: src.getDuration("CAPTCHA_LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		},
+		Auth: AuthConfig{
+			Mode:           src.getString("AUTH_MODE", "bearer"),
+			CookieDomain:   src.getString("AUTH_COOKIE_DOMAIN", ""),
+			CookieSecure:   src.getBool("AUTH_COOKIE_SECURE", true),
+			CookieSameSite: src.getString("AUTH_COOKIE_SAMESITE", "strict"),
+		},
+		Classification: ClassificationConfig{
+			Enabled:            src.getBool("CLASSIFICATION_ENABLED", true),
+			AutoApplyThreshold: src.getFloat("CLASSIFICATION_AUTO_APPLY_THRESHOLD", 0.8),
+			FallbackCategory:   src.getString("CLASSIFICATION_FALLBACK_CATEGORY", "industry-news"),
+		},
+		Deduplication: DeduplicationConfig{
+			MaxDistance:      src.getInt("DEDUP_MAX_DISTANCE", 6),
+			Window:           src.getDuration("DEDUP_WINDOW", 14*24*time.Hour),
+			ResolveRedirects: src.getBool("DEDUP_RESOLVE_REDIRECTS", true),
+			RedirectTimeout:  src.getDuration("DEDUP_REDIRECT_TIMEOUT", 5*time.Second),
 		},
 	}
 
-	if err := cfg.Validate(); err != nil {
+	// Report malformed values together with failed rules so every problem is fixed in one pass
+	if err := errors.Join(append(src.errs, cfg.Validate())...); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	cfg.file = src.path
+	cfg.settings = src.settings
+	cfg.loadedAt = time.Now().UTC()
+
 	return cfg, nil
 }
 
-// Validate validates the configuration
+// Validate validates the configuration and reports every rule that fails
 func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("SERVER_PORT must be between 1 and 65535"))
+	}
+
 	if c.Database.URL == "" {
-		return fmt.Errorf("DATABASE_URL is required")
+		errs = append(errs, fmt.Errorf("DATABASE_URL is required"))
+	}
+
+	if c.Database.MaxConns < 1 || c.Database.MinConns < 0 || c.Database.MinConns > c.Database.MaxConns {
+		errs = append(errs, fmt.Errorf("DATABASE_MAX_CONNS must be at least 1 and DATABASE_MIN_CONNS between 0 and DATABASE_MAX_CONNS"))
+	}
+
+	if c.Database.MaxConnLifetime <= 0 || c.Database.MaxConnIdleTime <= 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_MAX_CONN_LIFETIME and DATABASE_MAX_CONN_IDLE_TIME must be positive"))
+	}
+
+	if c.Database.QueryTimeout < 0 || c.Database.SlowQueryThreshold < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_QUERY_TIMEOUT and DATABASE_SLOW_QUERY_THRESHOLD cannot be negative"))
+	}
+
+	if len(c.Database.ReplicaURLs) > 0 && c.Database.ReplicaCheckInterval <= 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_REPLICA_CHECK_INTERVAL must be positive"))
 	}
 
 	if c.JWT.PrivateKeyPath == "" {
-		return fmt.Errorf("JWT_PRIVATE_KEY_PATH is required")
+		errs = append(errs, fmt.Errorf("JWT_PRIVATE_KEY_PATH is required"))
 	}
 
 	if c.JWT.PublicKeyPath == "" {
-		return fmt.Errorf("JWT_PUBLIC_KEY_PATH is required")
+		errs = append(errs, fmt.Errorf("JWT_PUBLIC_KEY_PATH is required"))
 	}
 
 	if c.N8N.WebhookSecret == "" {
-		return fmt.Errorf("N8N_WEBHOOK_SECRET is required")
+		errs = append(errs, fmt.Errorf("N8N_WEBHOOK_SECRET is required"))
 	}
 
-	if c.AI.AnthropicAPIKey == "" {
-		return fmt.Errorf("ANTHROPIC_API_KEY is required")
+	if c.N8N.MaxSkew <= 0 {
+		errs = append(errs, fmt.Errorf("N8N_WEBHOOK_MAX_SKEW must be positive"))
 	}
 
-	return nil
-}
+	if c.N8N.SecretGracePeriod <= 0 {
+		errs = append(errs, fmt.Errorf("N8N_WEBHOOK_SECRET_GRACE_PERIOD must be positive"))
+	}
 
-func getEnvInt(key string, defaultVal int) int {
-	if val := os.Getenv(key); val != "" {
-		var i int
-		if _, err := fmt.Sscanf(val, "%d", &i); err == nil {
-			return i
+	if c.N8N.SecretKey != "" {
+		if key := c.N8N.SecretKeyBytes(); len(key) != 32 {
+			errs = append(errs, fmt.Errorf("N8N_WEBHOOK_SECRET_KEY must be 64 hex characters (32 bytes)"))
 		}
 	}
-	return defaultVal
-}
 
-func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
-	if val := os.Getenv(key); val != "" {
-		if d, err := time.ParseDuration(val); err == nil {
-			return d
+	if !validLogLevels[c.Logger.Level] {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL must be one of trace, debug, info, warn, error, fatal, panic, or disabled"))
+	}
+
+	switch c.AI.Provider {
+	case "anthropic":
+		if c.AI.AnthropicAPIKey == "" {
+			errs = append(errs, fmt.Errorf("ANTHROPIC_API_KEY is required"))
+		}
+	case "openai":
+		if c.AI.OpenAIAPIKey == "" {
+			errs = append(errs, fmt.Errorf("OPENAI_API_KEY is required when AI_PROVIDER=openai"))
+		}
+	case "azure":
+		if c.AI.AzureAPIKey == "" {
+			errs = append(errs, fmt.Errorf("AZURE_OPENAI_API_KEY is required when AI_PROVIDER=azure"))
+		}
+		if c.AI.BaseURL == "" || c.AI.Model == "" {
+			errs = append(errs, fmt.Errorf("AI_BASE_URL and AI_MODEL (deployment name) are required when AI_PROVIDER=azure"))
 		}
+	case "local":
+		if c.AI.BaseURL == "" || c.AI.Model == "" {
+			errs = append(errs, fmt.Errorf("AI_BASE_URL and AI_MODEL are required when AI_PROVIDER=local"))
+		}
+	case "disabled":
+		// No key needed: AI calls get canned responses and enrichment is skipped
+	default:
+		errs = append(errs, fmt.Errorf("AI_PROVIDER must be anthropic, openai, azure, local, or disabled"))
+	}
+
+	if c.AI.MonthlyBudgetUSD < 0 || c.AI.InputCostPerMTok < 0 || c.AI.OutputCostPerMTok < 0 {
+		errs = append(errs, fmt.Errorf("AI_MONTHLY_BUDGET_USD and AI token costs cannot be negative"))
+	}
+
+	if c.AI.CacheEnabled && c.AI.CacheTTL <= 0 {
+		errs = append(errs, fmt.Errorf("AI_CACHE_TTL must be positive"))
+	}
+
+	if c.Enrichment.Concurrency < 1 || c.Enrichment.RatePerMinute < 1 {
+		errs = append(errs, fmt.Errorf("ENRICHMENT_CONCURRENCY and ENRICHMENT_RATE_PER_MINUTE must be at least 1"))
+	}
+
+	if c.Enrichment.BatchSize < 1 || c.Enrichment.BatchMaxTokens < 1 || c.Enrichment.BatchMaxArticleTokens < 1 {
+		errs = append(errs, fmt.Errorf("ENRICHMENT_BATCH_SIZE, ENRICHMENT_BATCH_MAX_TOKENS and ENRICHMENT_BATCH_MAX_ARTICLE_TOKENS must be at least 1"))
+	}
+
+	if c.Enrichment.RequestWebhookURL != "" && c.Enrichment.CallbackURL == "" {
+		errs = append(errs, fmt.Errorf("ENRICHMENT_CALLBACK_URL is required when ENRICHMENT_REQUEST_WEBHOOK_URL is set"))
+	}
+
+	if c.Classification.AutoApplyThreshold <= 0 || c.Classification.AutoApplyThreshold > 1 {
+		errs = append(errs, fmt.Errorf("CLASSIFICATION_AUTO_APPLY_THRESHOLD must be greater than 0 and at most 1"))
+	}
+
+	if c.Account.DeletionGracePeriod < 0 || c.Account.PurgeInterval <= 0 {
+		errs = append(errs, fmt.Errorf("ACCOUNT_DELETION_GRACE_PERIOD cannot be negative and ACCOUNT_PURGE_INTERVAL must be positive"))
+	}
+
+	if c.Tokens.CleanupInterval <= 0 || c.Tokens.Retention < 0 {
+		errs = append(errs, fmt.Errorf("REFRESH_TOKEN_CLEANUP_INTERVAL must be positive and REFRESH_TOKEN_RETENTION cannot be negative"))
+	}
+
+	if c.Audit.RetentionDays < 0 || c.Audit.ArchiveInterval <= 0 {
+		errs = append(errs, fmt.Errorf("AUDIT_LOG_RETENTION_DAYS cannot be negative and AUDIT_LOG_ARCHIVE_INTERVAL must be positive"))
+	}
+
+	if c.Audit.OutboxRelayInterval <= 0 {
+		errs = append(errs, fmt.Errorf("AUDIT_OUTBOX_RELAY_INTERVAL must be positive"))
+	}
+
+	if c.Audit.RetentionDays > 0 && c.Storage.Bucket == "" {
+		errs = append(errs, fmt.Errorf("STORAGE_BUCKET is required when AUDIT_LOG_RETENTION_DAYS is set"))
+	}
+
+	if c.Archive.Interval <= 0 {
+		errs = append(errs, fmt.Errorf("ARTICLE_ARCHIVE_INTERVAL must be positive"))
+	}
+
+	if c.Archive.Enabled && c.Storage.Bucket == "" {
+		errs = append(errs, fmt.Errorf("STORAGE_BUCKET is required when ARTICLE_ARCHIVE_ENABLED is set"))
+	}
+
+	if c.Images.Interval <= 0 {
+		errs = append(errs, fmt.Errorf("ARTICLE_IMAGE_INTERVAL must be positive"))
+	}
+
+	if c.Images.Enabled && c.Storage.Bucket == "" {
+		errs = append(errs, fmt.Errorf("STORAGE_BUCKET is required when ARTICLE_IMAGES_ENABLED is set"))
+	}
+
+	if c.Storage.Bucket != "" && (c.Storage.AccessKeyID == "" || c.Storage.SecretAccessKey == "") {
+		errs = append(errs, fmt.Errorf("STORAGE_ACCESS_KEY_ID and STORAGE_SECRET_ACCESS_KEY are required when STORAGE_BUCKET is set"))
+	}
+
+	if c.Trust.CalibrationInterval <= 0 || c.Trust.Window <= 0 {
+		errs = append(errs, fmt.Errorf("SOURCE_TRUST_CALIBRATION_INTERVAL and SOURCE_TRUST_WINDOW must be positive"))
+	}
+
+	if c.Trust.MaxDelta <= 0 || c.Trust.MaxDelta > 1 {
+		errs = append(errs, fmt.Errorf("SOURCE_TRUST_MAX_DELTA must be greater than 0 and at most 1"))
+	}
+
+	if c.PublicAPI.AnonymousRequestsPerMinute < 0 || c.PublicAPI.CacheTTL < 0 {
+		errs = append(errs, fmt.Errorf("PUBLIC_API_ANONYMOUS_REQUESTS_PER_MINUTE and PUBLIC_API_CACHE_TTL cannot be negative"))
+	}
+
+	if c.Search.CacheTTL < 0 {
+		errs = append(errs, fmt.Errorf("SEARCH_CACHE_TTL cannot be negative"))
+	}
+
+	if c.PublicAPI.DefaultKeyRequestsPerMinute < 1 || c.PublicAPI.DefaultKeyRequestsPerMinute > 100000 {
+		errs = append(errs, fmt.Errorf("PUBLIC_API_KEY_REQUESTS_PER_MINUTE must be between 1 and 100000"))
+	}
+
+	if c.Tracing.Enabled && c.Tracing.OTLPEndpoint == "" {
+		errs = append(errs, fmt.Errorf("TRACING_OTLP_ENDPOINT is required when tracing is enabled"))
+	}
+
+	if c.KEV.SyncInterval <= 0 || c.KEV.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("KEV_SYNC_INTERVAL and KEV_SYNC_TIMEOUT must be positive"))
+	}
+
+	if c.KEV.SyncEnabled && c.KEV.FeedURL == "" {
+		errs = append(errs, fmt.Errorf("KEV_FEED_URL is required when KEV_SYNC_ENABLED is set"))
+	}
+
+	if c.Exploit.SyncInterval <= 0 || c.Exploit.Timeout <= 0 || c.Exploit.Lookback <= 0 {
+		errs = append(errs, fmt.Errorf("EXPLOIT_SYNC_INTERVAL, EXPLOIT_SYNC_TIMEOUT and EXPLOIT_LOOKBACK must be positive"))
+	}
+
+	if c.Exploit.GitHubMaxCVEs < 1 {
+		errs = append(errs, fmt.Errorf("EXPLOIT_GITHUB_MAX_CVES must be at least 1"))
 	}
-	return defaultVal
-}
 
-func getEnvString(key, defaultVal string) string {
-	if val := os.Getenv(key); val != "" {
-		return val
+	if c.NVD.SyncInterval <= 0 || c.NVD.Timeout <= 0 || c.NVD.Lookback <= 0 || c.NVD.RefreshAfter <= 0 {
+		errs = append(errs, fmt.Errorf("NVD_SYNC_INTERVAL, NVD_SYNC_TIMEOUT, NVD_LOOKBACK and NVD_REFRESH_AFTER must be positive"))
 	}
-	return defaultVal
+
+	if c.NVD.RequestInterval < 0 {
+		errs = append(errs, fmt.Errorf("NVD_REQUEST_INTERVAL cannot be negative"))
+	}
+
+	if c.NVD.MaxCVEs < 1 {
+		errs = append(errs, fmt.Errorf("NVD_MAX_CVES must be at least 1"))
+	}
+
+	if c.NVD.SyncEnabled && c.NVD.APIURL == "" {
+		errs = append(errs, fmt.Errorf("NVD_API_URL is required when NVD_SYNC_ENABLED is set"))
+	}
+
+	if c.Events.BatchSize < 1 || c.Events.BufferLimit < c.Events.BatchSize {
+		errs = append(errs, fmt.Errorf("CLIENT_EVENTS_BATCH_SIZE must be at least 1 and no larger than CLIENT_EVENTS_BUFFER_LIMIT"))
+	}
+
+	if c.Events.FlushInterval <= 0 || c.Events.AggregationInterval <= 0 {
+		errs = append(errs, fmt.Errorf("CLIENT_EVENTS_FLUSH_INTERVAL and CLIENT_EVENTS_AGGREGATION_INTERVAL must be positive"))
+	}
+
+	if c.Events.RetentionMonths < 0 {
+		errs = append(errs, fmt.Errorf("CLIENT_EVENTS_RETENTION_MONTHS cannot be negative"))
+	}
+
+	if c.Share.Enabled {
+		if len(c.Share.Secret) < 32 {
+			errs = append(errs, fmt.Errorf("SHARE_LINK_SECRET must be at least 32 characters when share links are enabled"))
+		}
+		if c.Share.BaseURL == "" || c.Share.SiteURL == "" {
+			errs = append(errs, fmt.Errorf("SHARE_LINK_BASE_URL and SHARE_SITE_URL are required when share links are enabled"))
+		}
+		if c.Share.MaxTTL <= 0 {
+			errs = append(errs, fmt.Errorf("SHARE_LINK_MAX_TTL must be positive"))
+		}
+	}
+
+	if c.Newsletter.Enabled {
+		if c.Newsletter.SMTPHost == "" || c.Newsletter.From == "" {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_SMTP_HOST and NEWSLETTER_FROM are required when newsletters are enabled"))
+		}
+		if c.Newsletter.BaseURL == "" || c.Newsletter.SiteURL == "" {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_BASE_URL and NEWSLETTER_SITE_URL are required when newsletters are enabled"))
+		}
+		if c.Newsletter.SMTPPort <= 0 || c.Newsletter.SMTPPort > 65535 {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_SMTP_PORT must be between 1 and 65535"))
+		}
+		if c.Newsletter.SendInterval <= 0 || c.Newsletter.BatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_SEND_INTERVAL and NEWSLETTER_BATCH_SIZE must be positive"))
+		}
+		if len(c.Newsletter.TokenSecret) < 32 {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_TOKEN_SECRET must be at least 32 characters when newsletters are enabled"))
+		}
+		if c.Newsletter.ConfirmTTL <= 0 {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_CONFIRM_TTL must be positive"))
+		}
+	}
+
+	if c.CRM.Enabled {
+		switch c.CRM.Connector {
+		case "hubspot":
+			if c.CRM.HubSpotToken == "" {
+				errs = append(errs, fmt.Errorf("CRM_HUBSPOT_TOKEN is required when the CRM connector is hubspot"))
+			}
+		case "salesforce":
+			if c.CRM.SalesforceInstanceURL == "" || c.CRM.SalesforceClientID == "" || c.CRM.SalesforceClientSecret == "" {
+				errs = append(errs, fmt.Errorf("CRM_SALESFORCE_INSTANCE_URL, CRM_SALESFORCE_CLIENT_ID and CRM_SALESFORCE_CLIENT_SECRET are required when the CRM connector is salesforce"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("CRM_CONNECTOR must be hubspot or salesforce when the CRM is enabled"))
+		}
+		if c.CRM.Interval <= 0 || c.CRM.BatchSize <= 0 || c.CRM.MaxAttempts <= 0 {
+			errs = append(errs, fmt.Errorf("CRM_PUSH_INTERVAL, CRM_BATCH_SIZE and CRM_MAX_ATTEMPTS must be positive"))
+		}
+	}
+
+	if c.SIEM.Enabled {
+		if c.SIEM.SplunkHECURL == "" && c.SIEM.ElasticsearchURL == "" {
+			errs = append(errs, fmt.Errorf("SIEM_SPLUNK_HEC_URL or SIEM_ELASTICSEARCH_URL is required when SIEM forwarding is enabled"))
+		}
+		if c.SIEM.SplunkHECURL != "" && c.SIEM.SplunkHECToken == "" {
+			errs = append(errs, fmt.Errorf("SIEM_SPLUNK_HEC_TOKEN is required when SIEM_SPLUNK_HEC_URL is set"))
+		}
+		if c.SIEM.Interval <= 0 || c.SIEM.BatchSize <= 0 || c.SIEM.MaxAttempts <= 0 || c.SIEM.Retention <= 0 {
+			errs = append(errs, fmt.Errorf("SIEM_FORWARD_INTERVAL, SIEM_BATCH_SIZE, SIEM_MAX_ATTEMPTS and SIEM_RETENTION must be positive"))
+		}
+	}
+
+	if c.Reports.EmailEnabled {
+		if !c.Newsletter.Enabled {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_ENABLED is required when REPORTS_EMAIL_ENABLED is set, since briefings are sent through the newsletter relay"))
+		}
+		if c.Reports.EmailInterval <= 0 || c.Reports.EmailBatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("REPORTS_EMAIL_INTERVAL and REPORTS_EMAIL_BATCH_SIZE must be positive"))
+		}
+	}
+
+	if c.Reports.ScheduleInterval <= 0 || c.Reports.ScheduleBatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("REPORTS_SCHEDULE_INTERVAL and REPORTS_SCHEDULE_BATCH_SIZE must be positive"))
+	}
+
+	if c.Invites.Enabled {
+		if !c.Newsletter.Enabled {
+			errs = append(errs, fmt.Errorf("NEWSLETTER_ENABLED is required when INVITES_ENABLED is set, since invitations are sent through the newsletter relay"))
+		}
+		if len(c.Invites.TokenSecret) < 32 {
+			errs = append(errs, fmt.Errorf("INVITES_TOKEN_SECRET must be at least 32 characters when INVITES_ENABLED is set"))
+		}
+		if c.Invites.TTL <= 0 {
+			errs = append(errs, fmt.Errorf("INVITES_TTL must be positive"))
+		}
+	}
+
+	for _, d := range c.Registration.AllowedDomains {
+		if strings.Contains(d, "@") || !strings.Contains(d, ".") {
+			errs = append(errs, fmt.Errorf("REGISTRATION_ALLOWED_DOMAINS entry %q is not a domain", d))
+		}
+	}
+
+	if c.Captcha.Provider != "" {
+		if c.Captcha.Provider != "hcaptcha" && c.Captcha.Provider != "turnstile" {
+			errs = append(errs, fmt.Errorf("CAPTCHA_PROVIDER must be hcaptcha or turnstile"))
+		}
+		if c.Captcha.SiteKey == "" || c.Captcha.SecretKey == "" {
+			errs = append(errs, fmt.Errorf("CAPTCHA_SITE_KEY and CAPTCHA_SECRET_KEY are required when CAPTCHA_PROVIDER is set"))
+		}
+		if c.Captcha.LoginFailures < 0 {
+			errs = append(errs, fmt.Errorf("CAPTCHA_LOGIN_FAILURES cannot be negative"))
+		}
+		if c.Captcha.LoginFailures > 0 && c.Captcha.LoginFailureWindow <= 0 {
+			errs = append(errs, fmt.Errorf("CAPTCHA_LOGIN_FAILURE_WINDOW must be positive"))
+		}
+	}
+
+	switch c.Auth.Mode {
+	case "bearer":
+	case "cookie":
+		if c.Auth.CookieSameSite != "lax" && c.Auth.CookieSameSite != "strict" {
+			errs = append(errs, fmt.Errorf("AUTH_COOKIE_SAMESITE must be lax or strict"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("AUTH_MODE must be bearer or cookie"))
+	}
+
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1"))
+	}
+
+	if c.Health.CheckTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("HEALTH_CHECK_TIMEOUT must be positive"))
+	}
+
+	if c.Deduplication.MaxDistance < 1 || c.Deduplication.MaxDistance > 32 {
+		errs = append(errs, fmt.Errorf("DEDUP_MAX_DISTANCE must be between 1 and 32"))
+	}
+
+	if c.Deduplication.RedirectTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("DEDUP_REDIRECT_TIMEOUT must be positive"))
+	}
+
+	return errors.Join(errs...)
 }
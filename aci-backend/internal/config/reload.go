@@ -0,0 +1,159 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// reloadableKeys are the settings a reload applies to the running server; every other setting
+// needs a restart. Keep in sync with applyReloadable
+var reloadableKeys = map[string]bool{
+	"LOG_LEVEL":                          true,
+	"AI_MONTHLY_BUDGET_USD":              true,
+	"ENRICHMENT_RATE_PER_MINUTE":         true,
+	"PUBLIC_API_KEY_REQUESTS_PER_MINUTE": true,
+	"ARTICLE_REVIEW_ENABLED":             true,
+	"CLASSIFICATION_ENABLED":             true,
+}
+
+// applyReloadable copies the reloadable settings from src into dst
+func applyReloadable(dst, src *Config) {
+	dst.Logger.Level = src.Logger.Level
+	dst.AI.MonthlyBudgetUSD = src.AI.MonthlyBudgetUSD
+	dst.Enrichment.RatePerMinute = src.Enrichment.RatePerMinute
+	dst.PublicAPI.DefaultKeyRequestsPerMinute = src.PublicAPI.DefaultKeyRequestsPerMinute
+	dst.Review.Enabled = src.Review.Enabled
+	dst.Classification.Enabled = src.Classification.Enabled
+}
+
+// Snapshot describes the running configuration for administrators
+type Snapshot struct {
+	File       string     `json:"file,omitempty"`
+	LoadedAt   time.Time  `json:"loaded_at"`
+	ReloadedAt *time.Time `json:"reloaded_at,omitempty"`
+	Settings   []Setting  `json:"settings"`
+}
+
+// Reloader re-reads configuration on SIGHUP and applies the reloadable settings
+// Environment variables are fixed for the life of the process, so a reload picks up changes
+// made to CONFIG_FILE for settings that are not also set in the environment
+type Reloader struct {
+	mu         sync.RWMutex
+	current    *Config
+	reloadedAt *time.Time
+	appliers   []func(cfg *Config)
+}
+
+// NewReloader creates a reloader for the configuration the server started with
+func NewReloader(cfg *Config) *Reloader {
+	if cfg == nil {
+		panic("cfg cannot be nil")
+	}
+
+	return &Reloader{current: cfg}
+}
+
+// OnReload registers apply to be called with the new configuration after each successful reload
+// Register appliers before calling Run
+func (r *Reloader) OnReload(apply func(cfg *Config)) {
+	r.appliers = append(r.appliers, apply)
+}
+
+// Current returns the configuration in effect
+func (r *Reloader) Current() *Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// Snapshot returns the configuration in effect with secrets redacted
+func (r *Reloader) Snapshot() *Snapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return &Snapshot{
+		File:       r.current.file,
+		LoadedAt:   r.current.loadedAt,
+		ReloadedAt: r.reloadedAt,
+		Settings:   r.current.Settings(),
+	}
+}
+
+// Reload loads and validates the configuration again and applies its reloadable settings
+// An invalid configuration is rejected as a whole and the running configuration is kept
+func (r *Reloader) Reload() error {
+	loaded, err := Load()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+
+	// Start from the running configuration so settings that need a restart keep their current values
+	next := *r.current
+	applyReloadable(&next, loaded)
+	next.file = loaded.file
+	next.loadedAt = r.current.loadedAt
+
+	running := make(map[string]Setting, len(r.current.settings))
+	for _, setting := range r.current.settings {
+		running[setting.Key] = setting
+	}
+
+	var pending []string
+	next.settings = make([]Setting, 0, len(loaded.settings))
+	for _, setting := range loaded.settings {
+		previous, ok := running[setting.Key]
+		if !setting.Reloadable && ok {
+			if previous.raw != setting.raw {
+				previous.RestartRequired = true
+				pending = append(pending, setting.Key)
+			} else {
+				previous.RestartRequired = false
+			}
+			setting = previous
+		}
+		next.settings = append(next.settings, setting)
+	}
+
+	now := time.Now().UTC()
+	r.current = &next
+	r.reloadedAt = &now
+	r.mu.Unlock()
+
+	for _, apply := range r.appliers {
+		apply(&next)
+	}
+
+	if len(pending) > 0 {
+		log.Warn().Strs("settings", pending).Msg("Changed settings take effect after a restart")
+	}
+
+	return nil
+}
+
+// Run reloads the configuration on every SIGHUP until the context is cancelled
+func (r *Reloader) Run(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			if err := r.Reload(); err != nil {
+				log.Error().Err(err).Msg("Failed to reload configuration, keeping current settings")
+				continue
+			}
+			log.Info().Msg("Configuration reloaded")
+		}
+	}
+}
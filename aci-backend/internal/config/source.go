@@ -0,0 +1,268 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Origins of a resolved setting
+const (
+	OriginEnv     = "env"
+	OriginFile    = "file"
+	OriginDefault = "default"
+)
+
+// redacted replaces secret values in Settings
+const redacted = "[REDACTED]"
+
+// secretKeys are settings whose values are never exposed
+var secretKeys = map[string]bool{
+	"N8N_WEBHOOK_SECRET":     true,
+	"N8N_WEBHOOK_SECRET_KEY": true,
+	"ANTHROPIC_API_KEY":      true,
+	"OPENAI_API_KEY":         true,
+	"AZURE_OPENAI_API_KEY":   true,
+	"AI_API_KEY":             true,
+	"METRICS_TOKEN":          true,
+	"SHARE_LINK_SECRET":      true,
+
+	"NEWSLETTER_SMTP_PASSWORD":     true,
+	"NEWSLETTER_TOKEN_SECRET":      true,
+	"CRM_HUBSPOT_TOKEN":            true,
+	"CRM_SALESFORCE_CLIENT_SECRET": true,
+	"SIEM_SPLUNK_HEC_TOKEN":        true,
+	"SIEM_ELASTICSEARCH_API_KEY":   true,
+	"SIEM_ELASTICSEARCH_PASSWORD":  true,
+	"STORAGE_SECRET_ACCESS_KEY":    true,
+	"INVITES_TOKEN_SECRET":         true,
+	"CAPTCHA_SECRET_KEY":           true,
+	"NVD_API_KEY":                  true,
+}
+
+// urlKeys are connection strings whose passwords are redacted
+var urlKeys = map[string]bool{
+	"DATABASE_URL":          true,
+	"DATABASE_REPLICA_URLS": true,
+	"REDIS_URL":             true,
+}
+
+// Setting is one resolved configuration value as reported by the admin config endpoint
+type Setting struct {
+	Key             string `json:"key"`
+	Value           string `json:"value"`
+	Origin          string `json:"origin"`
+	Reloadable      bool   `json:"reloadable"`
+	RestartRequired bool   `json:"restart_required,omitempty"` // the file has a new value that applies after a restart
+
+	raw string // unredacted value, used to detect changes on reload
+}
+
+// source resolves settings from the environment, then the config file, then defaults
+// Malformed values are collected in errs rather than silently replaced by the default
+type source struct {
+	path     string
+	file     map[string]string
+	settings []Setting
+	errs     []error
+}
+
+// newSource reads the optional YAML config file at path
+// Keys are environment variable names, written flat (AI_PROVIDER: openai) or nested
+// (ai: {provider: openai}); nested keys are joined with underscores and upper-cased
+func newSource(path string) (*source, error) {
+	src := &source{path: path, file: make(map[string]string)}
+	if path == "" {
+		return src, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CONFIG_FILE: %w", err)
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse CONFIG_FILE %s: %w", path, err)
+	}
+
+	if err := flatten("", doc, src.file); err != nil {
+		return nil, fmt.Errorf("invalid CONFIG_FILE %s: %w", path, err)
+	}
+
+	return src, nil
+}
+
+// flatten converts nested YAML mappings into environment variable style keys
+func flatten(prefix string, node map[string]interface{}, out map[string]string) error {
+	for name, value := range node {
+		key := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := flatten(key, v, out); err != nil {
+				return err
+			}
+		case []interface{}:
+			return fmt.Errorf("%s: lists are not supported", key)
+		case nil:
+			// An empty value leaves the setting to the environment or its default
+		default:
+			out[key] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the raw value for key and where it came from; an empty origin means unset
+func (s *source) lookup(key string) (string, string) {
+	if val := os.Getenv(key); val != "" {
+		return val, OriginEnv
+	}
+	if val := s.file[key]; val != "" {
+		return val, OriginFile
+	}
+	return "", ""
+}
+
+// record remembers the value in effect for key
+func (s *source) record(key, value, origin string) {
+	setting := Setting{
+		Key:        key,
+		Value:      value,
+		Origin:     origin,
+		Reloadable: reloadableKeys[key],
+		raw:        value,
+	}
+
+	switch {
+	case secretKeys[key] && value != "":
+		setting.Value = redacted
+	case urlKeys[key] && value != "":
+		setting.Value = redactURL(value)
+	}
+
+	s.settings = append(s.settings, setting)
+}
+
+// invalid records a malformed value; the default is used until Load reports the error
+func (s *source) invalid(key, value, origin, expected string) {
+	s.errs = append(s.errs, fmt.Errorf("%s must be %s, got %q (from %s)", key, expected, value, origin))
+}
+
+func (s *source) getString(key, defaultVal string) string {
+	val, origin := s.lookup(key)
+	if origin == "" {
+		val, origin = defaultVal, OriginDefault
+	}
+	s.record(key, val, origin)
+	return val
+}
+
+// getList splits a comma-separated value, dropping empty entries
+func (s *source) getList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(s.getString(key, ""), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func (s *source) getInt(key string, defaultVal int) int {
+	if val, origin := s.lookup(key); origin != "" {
+		i, err := strconv.Atoi(strings.TrimSpace(val))
+		if err == nil {
+			s.record(key, val, origin)
+			return i
+		}
+		s.invalid(key, val, origin, "an integer")
+	}
+	s.record(key, strconv.Itoa(defaultVal), OriginDefault)
+	return defaultVal
+}
+
+func (s *source) getDuration(key string, defaultVal time.Duration) time.Duration {
+	if val, origin := s.lookup(key); origin != "" {
+		d, err := time.ParseDuration(strings.TrimSpace(val))
+		if err == nil {
+			s.record(key, val, origin)
+			return d
+		}
+		s.invalid(key, val, origin, "a duration such as 30s or 24h")
+	}
+	s.record(key, defaultVal.String(), OriginDefault)
+	return defaultVal
+}
+
+func (s *source) getFloat(key string, defaultVal float64) float64 {
+	if val, origin := s.lookup(key); origin != "" {
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err == nil {
+			s.record(key, val, origin)
+			return f
+		}
+		s.invalid(key, val, origin, "a number")
+	}
+	s.record(key, strconv.FormatFloat(defaultVal, 'g', -1, 64), OriginDefault)
+	return defaultVal
+}
+
+func (s *source) getBool(key string, defaultVal bool) bool {
+	if val, origin := s.lookup(key); origin != "" {
+		switch strings.ToLower(strings.TrimSpace(val)) {
+		case "true", "1", "yes":
+			s.record(key, val, origin)
+			return true
+		case "false", "0", "no":
+			s.record(key, val, origin)
+			return false
+		}
+		s.invalid(key, val, origin, "true or false")
+	}
+	s.record(key, strconv.FormatBool(defaultVal), OriginDefault)
+	return defaultVal
+}
+
+// redactURL hides the passwords in a connection string or a comma-separated list of them
+func redactURL(raw string) string {
+	parts := strings.Split(raw, ",")
+	for i, part := range parts {
+		u, err := url.Parse(part)
+		if err != nil {
+			// An unparseable connection string may still contain credentials
+			return redacted
+		}
+		parts[i] = u.Redacted()
+	}
+	return strings.Join(parts, ",")
+}
+
+// Settings returns the resolved settings sorted by key, with secrets redacted
+func (c *Config) Settings() []Setting {
+	settings := make([]Setting, len(c.settings))
+	copy(settings, c.settings)
+	sort.Slice(settings, func(i, j int) bool { return settings[i].Key < settings[j].Key })
+	return settings
+}
+
+// File returns the CONFIG_FILE the configuration was read from, or "" when none was set
+func (c *Config) File() string {
+	return c.file
+}
+
+// LoadedAt returns when the configuration was loaded
+func (c *Config) LoadedAt() time.Time {
+	return c.loadedAt
+}
@@ -0,0 +1,282 @@
+// Package crm pushes CTA conversions to a CRM as a contact and an activity on that contact
+// Each connector upserts the contact by email, with the conversion's fields mapped to CRM
+// properties, then records the conversion as an activity (a note in HubSpot, a completed task
+// on the lead in Salesforce)
+package crm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// defaultHTTPTimeout bounds requests when the caller's context has no deadline
+	defaultHTTPTimeout = 30 * time.Second
+
+	// maxErrorBodyBytes limits how much of an error response is included in errors
+	maxErrorBodyBytes = 512
+)
+
+// ConnectorType identifies a CRM
+type ConnectorType string
+
+const (
+	ConnectorHubSpot    ConnectorType = "hubspot"
+	ConnectorSalesforce ConnectorType = "salesforce"
+)
+
+// IsValid checks if the connector type is valid
+func (t ConnectorType) IsValid() bool {
+	switch t {
+	case ConnectorHubSpot, ConnectorSalesforce:
+		return true
+	default:
+		return false
+	}
+}
+
+// Connector pushes conversions to a CRM
+type Connector interface {
+	// Name returns the connector identifier, stored with each queued event
+	Name() string
+
+	// Push upserts the conversion's contact and records the conversion against it
+	// Errors the CRM will keep returning, such as a rejected field, implement Permanent() bool
+	Push(ctx context.Context, conversion *domain.CRMConversion) error
+}
+
+// Config selects and configures a connector
+type Config struct {
+	Connector ConnectorType
+	// FieldMap overrides the connector's default mapping, e.g. "job_title=jobtitle,phone="
+	FieldMap string
+
+	HubSpotToken   string // private app access token
+	HubSpotBaseURL string // defaults to the public HubSpot API
+
+	SalesforceInstanceURL  string // e.g. https://example.my.salesforce.com
+	SalesforceClientID     string // connected app using the client credentials flow
+	SalesforceClientSecret string
+	SalesforceAPIVersion   string // defaults to DefaultSalesforceAPIVersion
+}
+
+// NewConnector creates the connector selected by cfg.Connector
+func NewConnector(cfg Config) (Connector, error) {
+	switch cfg.Connector {
+	case ConnectorHubSpot:
+		mapping, err := ParseFieldMap(hubSpotFieldMap, cfg.FieldMap)
+		if err != nil {
+			return nil, err
+		}
+		return newHubSpotConnector(cfg, mapping)
+	case ConnectorSalesforce:
+		mapping, err := ParseFieldMap(salesforceFieldMap, cfg.FieldMap)
+		if err != nil {
+			return nil, err
+		}
+		return newSalesforceConnector(cfg, mapping)
+	default:
+		return nil, fmt.Errorf("unsupported CRM connector %q: must be hubspot or salesforce", cfg.Connector)
+	}
+}
+
+// FieldMap maps conversion field names (see domain.CRMConversion.Fields) to CRM property names
+type FieldMap map[string]string
+
+// knownFields are the conversion field names a mapping may use
+var knownFields = map[string]bool{
+	"conversion_type": true,
+	"email":           true,
+	"first_name":      true,
+	"last_name":       true,
+	"company":         true,
+	"job_title":       true,
+	"phone":           true,
+	"message":         true,
+	"article_title":   true,
+	"article_url":     true,
+	"cta_title":       true,
+	"cta_url":         true,
+	"cta_variant":     true,
+}
+
+// ParseFieldMap applies comma-separated field=property overrides to a copy of defaults
+// An override with no property, e.g. "phone=", stops the field from being sent.
+// The email mapping cannot be removed, since contacts are matched by email
+func ParseFieldMap(defaults FieldMap, overrides string) (FieldMap, error) {
+	mapping := make(FieldMap, len(defaults))
+	for field, property := range defaults {
+		mapping[field] = property
+	}
+
+	for _, entry := range strings.Split(overrides, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		field, property, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid CRM field mapping %q: must be field=property", entry)
+		}
+
+		field = strings.TrimSpace(field)
+		property = strings.TrimSpace(property)
+
+		if !knownFields[field] {
+			return nil, fmt.Errorf("invalid CRM field mapping %q: unknown field %q", entry, field)
+		}
+
+		if property == "" {
+			if field == "email" {
+				return nil, fmt.Errorf("invalid CRM field mapping %q: email must be mapped", entry)
+			}
+			delete(mapping, field)
+			continue
+		}
+
+		mapping[field] = property
+	}
+
+	return mapping, nil
+}
+
+// Properties returns the conversion's mapped fields by CRM property name
+func (m FieldMap) Properties(conversion *domain.CRMConversion) map[string]string {
+	properties := make(map[string]string)
+	for field, value := range conversion.Fields() {
+		if property, ok := m[field]; ok {
+			properties[property] = value
+		}
+	}
+	return properties
+}
+
+// PermanentError is returned when the CRM rejects a request in a way retrying will not fix,
+// e.g. a validation error on a mapped property or revoked credentials
+type PermanentError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *PermanentError) Error() string {
+	return fmt.Sprintf("CRM rejected request: status %d: %s", e.StatusCode, e.Message)
+}
+
+// Permanent reports that retrying the request will not help
+func (e *PermanentError) Permanent() bool {
+	return true
+}
+
+// ActivitySubject is a one-line description of the conversion
+func ActivitySubject(conversion *domain.CRMConversion) string {
+	switch conversion.Type {
+	case domain.ConversionLeadForm:
+		return "Submitted a lead form"
+	default:
+		if conversion.CTATitle != "" {
+			return fmt.Sprintf("Clicked CTA: %s", conversion.CTATitle)
+		}
+		return "Clicked a CTA"
+	}
+}
+
+// ActivityBody describes the conversion in plain text for the activity recorded on the contact
+func ActivityBody(conversion *domain.CRMConversion) string {
+	var lines []string
+	add := func(label, value string) {
+		if value != "" {
+			lines = append(lines, label+": "+value)
+		}
+	}
+
+	lines = append(lines, ActivitySubject(conversion))
+	add("Article", conversion.ArticleTitle)
+	add("Article URL", conversion.ArticleURL)
+	add("CTA URL", conversion.CTAURL)
+	add("CTA variant", conversion.CTAVariant)
+	add("Time", conversion.OccurredAt.UTC().Format(time.RFC3339))
+
+	if conversion.Message != "" {
+		lines = append(lines, "", conversion.Message)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// client sends JSON requests to a CRM API
+type client struct {
+	name       string
+	httpClient *http.Client
+}
+
+// do sends a request with an optional JSON body and decodes a JSON response into out when it is non-nil
+// 4xx responses other than 408, 409 and 429 are PermanentErrors; everything else is worth retrying
+func (c *client) do(ctx context.Context, method, endpoint string, headers map[string]string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		req.Header.Set(name, headers[name])
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s api call failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		message := strings.TrimSpace(string(errBody))
+
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout &&
+			resp.StatusCode != http.StatusConflict &&
+			resp.StatusCode != http.StatusTooManyRequests {
+			return &PermanentError{StatusCode: resp.StatusCode, Message: message}
+		}
+
+		return fmt.Errorf("%s api call failed: status %d: %s", c.name, resp.StatusCode, message)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", c.name, err)
+	}
+
+	return nil
+}
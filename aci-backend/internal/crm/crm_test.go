@@ -0,0 +1,268 @@
+package crm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func sampleConversion() *domain.CRMConversion {
+	articleID := uuid.New()
+	return &domain.CRMConversion{
+		Type:         domain.ConversionCTAClick,
+		Email:        "jane@example.com",
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		JobTitle:     "CISO",
+		ArticleID:    &articleID,
+		ArticleTitle: "Ransomware hits hospitals",
+		ArticleURL:   "https://app.example.com/threats/" + articleID.String(),
+		CTATitle:     "Book a ransomware readiness review",
+		CTAURL:       "https://armor.com/readiness",
+		CTAVariant:   "urgent-copy",
+		OccurredAt:   time.Date(2026, 10, 15, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func TestParseFieldMap(t *testing.T) {
+	mapping, err := ParseFieldMap(hubSpotFieldMap, " job_title=role , phone=, article_title=last_threat_article ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "role", mapping["job_title"])
+	assert.Equal(t, "last_threat_article", mapping["article_title"])
+	assert.NotContains(t, mapping, "phone")
+	assert.Equal(t, "email", mapping["email"])
+
+	// The defaults are not modified
+	assert.Equal(t, "jobtitle", hubSpotFieldMap["job_title"])
+	assert.Equal(t, "phone", hubSpotFieldMap["phone"])
+}
+
+func TestParseFieldMap_RejectsInvalidEntries(t *testing.T) {
+	for name, overrides := range map[string]string{
+		"no separator":  "job_title",
+		"unknown field": "favourite_colour=colour",
+		"unmapped":      "email=",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseFieldMap(hubSpotFieldMap, overrides)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFieldMap_Properties(t *testing.T) {
+	mapping, err := ParseFieldMap(salesforceFieldMap, "cta_variant=CTA_Variant__c")
+	require.NoError(t, err)
+
+	properties := mapping.Properties(sampleConversion())
+	assert.Equal(t, map[string]string{
+		"Email":          "jane@example.com",
+		"FirstName":      "Jane",
+		"LastName":       "Doe",
+		"Title":          "CISO",
+		"CTA_Variant__c": "urgent-copy",
+	}, properties)
+}
+
+func TestActivityBody(t *testing.T) {
+	body := ActivityBody(sampleConversion())
+
+	assert.True(t, strings.HasPrefix(body, "Clicked CTA: Book a ransomware readiness review\n"))
+	assert.Contains(t, body, "Article: Ransomware hits hospitals")
+	assert.Contains(t, body, "CTA variant: urgent-copy")
+	assert.Contains(t, body, "Time: 2026-10-15T09:30:00Z")
+
+	lead := &domain.CRMConversion{Type: domain.ConversionLeadForm, Message: "Call me", OccurredAt: time.Now()}
+	assert.Contains(t, ActivityBody(lead), "Submitted a lead form")
+	assert.True(t, strings.HasSuffix(ActivityBody(lead), "\n\nCall me"))
+}
+
+func TestNewConnector_RejectsUnknownConnector(t *testing.T) {
+	_, err := NewConnector(Config{Connector: "pipedrive"})
+	assert.Error(t, err)
+}
+
+func TestHubSpotConnector_Push(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		assert.Equal(t, "Bearer pat-test", r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		switch r.URL.Path {
+		case "/crm/v3/objects/contacts/batch/upsert":
+			input := body["inputs"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "email", input["idProperty"])
+			assert.Equal(t, "jane@example.com", input["id"])
+			assert.Equal(t, "CISO", input["properties"].(map[string]interface{})["jobtitle"])
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"results": []map[string]interface{}{{"id": "501", "properties": map[string]string{}}},
+			})
+		case "/crm/v3/objects/notes":
+			association := body["associations"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "501", association["to"].(map[string]interface{})["id"])
+			assert.Contains(t, body["properties"].(map[string]interface{})["hs_note_body"], "Ransomware hits hospitals")
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"9001"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	connector, err := NewConnector(Config{Connector: ConnectorHubSpot, HubSpotToken: "pat-test", HubSpotBaseURL: server.URL})
+	require.NoError(t, err)
+
+	require.NoError(t, connector.Push(context.Background(), sampleConversion()))
+	assert.Equal(t, []string{"/crm/v3/objects/contacts/batch/upsert", "/crm/v3/objects/notes"}, calls)
+}
+
+func TestHubSpotConnector_ClassifiesErrors(t *testing.T) {
+	status := http.StatusBadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"Property \"jobtitle\" does not exist"}`))
+	}))
+	defer server.Close()
+
+	connector, err := NewConnector(Config{Connector: ConnectorHubSpot, HubSpotToken: "pat-test", HubSpotBaseURL: server.URL})
+	require.NoError(t, err)
+
+	err = connector.Push(context.Background(), sampleConversion())
+	var permanent *PermanentError
+	require.ErrorAs(t, err, &permanent)
+	assert.Contains(t, permanent.Message, "jobtitle")
+
+	for _, status = range []int{http.StatusTooManyRequests, http.StatusBadGateway} {
+		err = connector.Push(context.Background(), sampleConversion())
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &permanent), "status %d should be retried", status)
+	}
+}
+
+func TestSalesforceConnector_CreatesLeadAndTask(t *testing.T) {
+	tokens := 0
+	var lead, task map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/services/oauth2/token":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+			tokens++
+			_, _ = w.Write([]byte(`{"access_token":"token-1","token_type":"Bearer"}`))
+		case r.URL.Path == "/services/data/v60.0/query":
+			assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+			assert.Contains(t, r.URL.Query().Get("q"), "WHERE Email = 'o\\'brien@example.com'")
+			_, _ = w.Write([]byte(`{"totalSize":0,"records":[]}`))
+		case r.URL.Path == "/services/data/v60.0/sobjects/Lead" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&lead))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"00Q1","success":true}`))
+		case r.URL.Path == "/services/data/v60.0/sobjects/Task":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&task))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"00T1","success":true}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	connector, err := NewConnector(Config{
+		Connector:              ConnectorSalesforce,
+		SalesforceInstanceURL:  server.URL,
+		SalesforceClientID:     "client-id",
+		SalesforceClientSecret: "client-secret",
+	})
+	require.NoError(t, err)
+
+	conversion := sampleConversion()
+	conversion.Email = "o'brien@example.com"
+	conversion.LastName = ""
+
+	require.NoError(t, connector.Push(context.Background(), conversion))
+	require.NoError(t, connector.Push(context.Background(), conversion))
+
+	// The access token is reused
+	assert.Equal(t, 1, tokens)
+
+	assert.Equal(t, "o'brien@example.com", lead["Email"])
+	assert.Equal(t, salesforceNotProvided, lead["LastName"])
+	assert.Equal(t, salesforceNotProvided, lead["Company"])
+	assert.Equal(t, "00Q1", task["WhoId"])
+	assert.Equal(t, "Completed", task["Status"])
+	assert.Equal(t, "2026-10-15", task["ActivityDate"])
+}
+
+func TestSalesforceConnector_UpdatesLeadAndRefreshesToken(t *testing.T) {
+	tokens := 0
+	var updated map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/services/oauth2/token":
+			tokens++
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d"}`, tokens)
+		case r.Header.Get("Authorization") == "Bearer token-1":
+			// The first token has expired
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`[{"errorCode":"INVALID_SESSION_ID"}]`))
+		case r.URL.Path == "/services/data/v60.0/query":
+			_, _ = w.Write([]byte(`{"totalSize":1,"records":[{"Id":"00Q9"}]}`))
+		case r.URL.Path == "/services/data/v60.0/sobjects/Lead/00Q9" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/services/data/v60.0/sobjects/Task":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"00T1","success":true}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	connector, err := NewConnector(Config{
+		Connector:              ConnectorSalesforce,
+		SalesforceInstanceURL:  server.URL,
+		SalesforceClientID:     "client-id",
+		SalesforceClientSecret: "client-secret",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, connector.Push(context.Background(), sampleConversion()))
+	assert.Equal(t, 2, tokens)
+
+	// Existing leads are not given placeholder values
+	assert.Equal(t, "Doe", updated["LastName"])
+	assert.NotContains(t, updated, "Company")
+}
+
+func TestSalesforceConnector_RejectsUnsafeEmailField(t *testing.T) {
+	_, err := NewConnector(Config{
+		Connector:              ConnectorSalesforce,
+		FieldMap:               "email=Email OR Id != null",
+		SalesforceInstanceURL:  "https://example.my.salesforce.com",
+		SalesforceClientID:     "client-id",
+		SalesforceClientSecret: "client-secret",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package crm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// defaultHubSpotBaseURL is the public HubSpot API endpoint
+	defaultHubSpotBaseURL = "https://api.hubapi.com"
+
+	// hubSpotNoteToContact is HubSpot's association type for a note on a contact
+	hubSpotNoteToContact = 202
+)
+
+// hubSpotFieldMap maps contact fields to HubSpot's default contact properties
+var hubSpotFieldMap = FieldMap{
+	"email":      "email",
+	"first_name": "firstname",
+	"last_name":  "lastname",
+	"company":    "company",
+	"job_title":  "jobtitle",
+	"phone":      "phone",
+}
+
+// HubSpotConnector implements Connector for the HubSpot CRM v3 API
+// Contacts are upserted by email and each conversion is added as a note on the contact
+type HubSpotConnector struct {
+	client
+	baseURL string
+	mapping FieldMap
+	headers map[string]string
+}
+
+// newHubSpotConnector creates a connector authenticated with a private app token
+func newHubSpotConnector(cfg Config, mapping FieldMap) (*HubSpotConnector, error) {
+	if cfg.HubSpotToken == "" {
+		return nil, fmt.Errorf("hubspot token is required")
+	}
+
+	baseURL := cfg.HubSpotBaseURL
+	if baseURL == "" {
+		baseURL = defaultHubSpotBaseURL
+	}
+
+	return &HubSpotConnector{
+		client:  client{name: string(ConnectorHubSpot), httpClient: &http.Client{Timeout: defaultHTTPTimeout}},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		mapping: mapping,
+		headers: map[string]string{"Authorization": "Bearer " + cfg.HubSpotToken},
+	}, nil
+}
+
+// hubSpotObject is a CRM object in a HubSpot request or response
+type hubSpotObject struct {
+	ID         string            `json:"id,omitempty"`
+	IDProperty string            `json:"idProperty,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// Name returns the connector identifier
+func (c *HubSpotConnector) Name() string {
+	return string(ConnectorHubSpot)
+}
+
+// Push upserts the contact and adds the conversion as a note on it
+func (c *HubSpotConnector) Push(ctx context.Context, conversion *domain.CRMConversion) error {
+	properties := c.mapping.Properties(conversion)
+
+	var upserted struct {
+		Results []hubSpotObject `json:"results"`
+	}
+	err := c.do(ctx, http.MethodPost, c.baseURL+"/crm/v3/objects/contacts/batch/upsert", c.headers, map[string]interface{}{
+		"inputs": []hubSpotObject{{
+			ID:         conversion.Email,
+			IDProperty: c.mapping["email"],
+			Properties: properties,
+		}},
+	}, &upserted)
+	if err != nil {
+		return fmt.Errorf("failed to upsert hubspot contact: %w", err)
+	}
+
+	if len(upserted.Results) == 0 || upserted.Results[0].ID == "" {
+		return fmt.Errorf("hubspot contact upsert returned no contact")
+	}
+
+	note := map[string]interface{}{
+		"properties": map[string]string{
+			"hs_timestamp": conversion.OccurredAt.UTC().Format(time.RFC3339),
+			"hs_note_body": ActivityBody(conversion),
+		},
+		"associations": []map[string]interface{}{{
+			"to": map[string]string{"id": upserted.Results[0].ID},
+			"types": []map[string]interface{}{{
+				"associationCategory": "HUBSPOT_DEFINED",
+				"associationTypeId":   hubSpotNoteToContact,
+			}},
+		}},
+	}
+
+	if err := c.do(ctx, http.MethodPost, c.baseURL+"/crm/v3/objects/notes", c.headers, note, nil); err != nil {
+		return fmt.Errorf("failed to add hubspot note: %w", err)
+	}
+
+	return nil
+}
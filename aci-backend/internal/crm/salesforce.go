@@ -0,0 +1,235 @@
+package crm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// DefaultSalesforceAPIVersion is the Salesforce REST API version used when none is configured
+	DefaultSalesforceAPIVersion = "v60.0"
+
+	// salesforceNotProvided fills the fields Salesforce requires on a new lead when the conversion has no value
+	salesforceNotProvided = "[not provided]"
+
+	// maxSalesforceSubjectLength is the length of the Task Subject field
+	maxSalesforceSubjectLength = 255
+)
+
+// salesforceFieldMap maps contact fields to the standard Lead fields
+var salesforceFieldMap = FieldMap{
+	"email":      "Email",
+	"first_name": "FirstName",
+	"last_name":  "LastName",
+	"company":    "Company",
+	"job_title":  "Title",
+	"phone":      "Phone",
+}
+
+// salesforceFieldName matches a Salesforce field API name, which is interpolated into SOQL
+var salesforceFieldName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// soqlEscaper escapes a value for a single-quoted SOQL string literal
+var soqlEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// SalesforceConnector implements Connector for the Salesforce REST API
+// Contacts are upserted as open leads matched by email, and each conversion is logged as a
+// completed task on the lead. It authenticates with the OAuth client credentials flow of a
+// connected app and fetches a new access token when the current one is rejected
+type SalesforceConnector struct {
+	client
+	instanceURL  string
+	apiVersion   string
+	clientID     string
+	clientSecret string
+	mapping      FieldMap
+
+	mu          sync.Mutex
+	accessToken string
+}
+
+// newSalesforceConnector creates a connector for a Salesforce org
+func newSalesforceConnector(cfg Config, mapping FieldMap) (*SalesforceConnector, error) {
+	if cfg.SalesforceInstanceURL == "" {
+		return nil, fmt.Errorf("salesforce instance url is required")
+	}
+
+	if cfg.SalesforceClientID == "" || cfg.SalesforceClientSecret == "" {
+		return nil, fmt.Errorf("salesforce client id and secret are required")
+	}
+
+	if !salesforceFieldName.MatchString(mapping["email"]) {
+		return nil, fmt.Errorf("invalid salesforce email field %q", mapping["email"])
+	}
+
+	apiVersion := cfg.SalesforceAPIVersion
+	if apiVersion == "" {
+		apiVersion = DefaultSalesforceAPIVersion
+	}
+
+	return &SalesforceConnector{
+		client:       client{name: string(ConnectorSalesforce), httpClient: &http.Client{Timeout: defaultHTTPTimeout}},
+		instanceURL:  strings.TrimRight(cfg.SalesforceInstanceURL, "/"),
+		apiVersion:   apiVersion,
+		clientID:     cfg.SalesforceClientID,
+		clientSecret: cfg.SalesforceClientSecret,
+		mapping:      mapping,
+	}, nil
+}
+
+// Name returns the connector identifier
+func (c *SalesforceConnector) Name() string {
+	return string(ConnectorSalesforce)
+}
+
+// Push upserts the lead and logs the conversion as a completed task on it
+func (c *SalesforceConnector) Push(ctx context.Context, conversion *domain.CRMConversion) error {
+	err := c.push(ctx, conversion)
+
+	var permanent *PermanentError
+	if errors.As(err, &permanent) && permanent.StatusCode == http.StatusUnauthorized {
+		// The access token expired or was revoked; try once more with a new one
+		c.mu.Lock()
+		c.accessToken = ""
+		c.mu.Unlock()
+
+		err = c.push(ctx, conversion)
+	}
+
+	return err
+}
+
+// push upserts the lead and adds the task with the current access token
+func (c *SalesforceConnector) push(ctx context.Context, conversion *domain.CRMConversion) error {
+	headers, err := c.authHeaders(ctx)
+	if err != nil {
+		return err
+	}
+
+	base := c.instanceURL + "/services/data/" + c.apiVersion
+	properties := c.mapping.Properties(conversion)
+
+	query := fmt.Sprintf("SELECT Id FROM Lead WHERE %s = '%s' AND IsConverted = false ORDER BY CreatedDate DESC LIMIT 1",
+		c.mapping["email"], soqlEscaper.Replace(conversion.Email))
+
+	var found struct {
+		Records []struct {
+			ID string `json:"Id"`
+		} `json:"records"`
+	}
+	if err := c.do(ctx, http.MethodGet, base+"/query?q="+url.QueryEscape(query), headers, nil, &found); err != nil {
+		return fmt.Errorf("failed to find salesforce lead: %w", err)
+	}
+
+	var leadID string
+	if len(found.Records) > 0 {
+		leadID = found.Records[0].ID
+		if err := c.do(ctx, http.MethodPatch, base+"/sobjects/Lead/"+url.PathEscape(leadID), headers, properties, nil); err != nil {
+			return fmt.Errorf("failed to update salesforce lead: %w", err)
+		}
+	} else {
+		// Leads require a last name and company
+		for _, field := range []string{"LastName", "Company"} {
+			if properties[field] == "" {
+				properties[field] = salesforceNotProvided
+			}
+		}
+
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := c.do(ctx, http.MethodPost, base+"/sobjects/Lead", headers, properties, &created); err != nil {
+			return fmt.Errorf("failed to create salesforce lead: %w", err)
+		}
+		if created.ID == "" {
+			return fmt.Errorf("salesforce lead create returned no id")
+		}
+		leadID = created.ID
+	}
+
+	subject := ActivitySubject(conversion)
+	if len(subject) > maxSalesforceSubjectLength {
+		subject = subject[:maxSalesforceSubjectLength]
+	}
+
+	task := map[string]string{
+		"WhoId":        leadID,
+		"Subject":      subject,
+		"Description":  ActivityBody(conversion),
+		"ActivityDate": conversion.OccurredAt.UTC().Format("2006-01-02"),
+		"Status":       "Completed",
+	}
+
+	if err := c.do(ctx, http.MethodPost, base+"/sobjects/Task", headers, task, nil); err != nil {
+		return fmt.Errorf("failed to add salesforce task: %w", err)
+	}
+
+	return nil
+}
+
+// authHeaders returns the authorization header, fetching an access token if there is none
+func (c *SalesforceConnector) authHeaders(ctx context.Context) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken == "" {
+		token, err := c.fetchToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.accessToken = token
+	}
+
+	return map[string]string{"Authorization": "Bearer " + c.accessToken}, nil
+}
+
+// fetchToken requests an access token with the client credentials flow
+func (c *SalesforceConnector) fetchToken(ctx context.Context) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.instanceURL+"/services/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("salesforce token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}
+	// Error responses are JSON too; a body that does not decode leaves both fields empty
+	_ = json.NewDecoder(resp.Body).Decode(&token)
+
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		message := fmt.Sprintf("salesforce token request failed: status %d", resp.StatusCode)
+		if token.Error != "" {
+			message += ": " + token.Error
+		}
+
+		// Bad client credentials will not fix themselves
+		if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+			return "", &PermanentError{StatusCode: resp.StatusCode, Message: message}
+		}
+		return "", errors.New(message)
+	}
+
+	return token.AccessToken, nil
+}
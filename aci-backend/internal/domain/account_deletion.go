@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded for self-service account deletion
+const (
+	AuditActionAccountDeletionRequested = "request_account_deletion"
+	AuditActionAccountDeletionCancelled = "cancel_account_deletion"
+	AuditActionAccountPurged            = "purge_account"
+)
+
+// AccountDeletion is a user's pending request to delete their own account
+// The account stays recoverable until PurgeAfter, when its personal data is purged
+type AccountDeletion struct {
+	UserID      uuid.UUID `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	PurgeAfter  time.Time `json:"purge_after"`
+}
+
+// IsDue reports whether the grace period has elapsed
+func (d *AccountDeletion) IsDue(now time.Time) bool {
+	return !now.Before(d.PurgeAfter)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// AIResponseCacheEntry is a cached AI provider response keyed by a hash of the prompt
+type AIResponseCacheEntry struct {
+	Key       string     `json:"key"`
+	Provider  string     `json:"provider"`
+	Model     string     `json:"model"`
+	Operation string     `json:"operation"`
+	Response  string     `json:"-"`
+	Hits      int64      `json:"hits"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	LastHitAt *time.Time `json:"last_hit_at,omitempty"`
+}
+
+// IsExpired reports whether the entry is past its TTL at the given time
+func (e *AIResponseCacheEntry) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// AICacheOperationStats summarizes live cache entries for one operation
+type AICacheOperationStats struct {
+	Entries int64 `json:"entries"`
+	Hits    int64 `json:"hits"`
+}
+
+// AICacheStats summarizes the AI response cache
+type AICacheStats struct {
+	Entries     int64                            `json:"entries"`
+	Hits        int64                            `json:"hits"`
+	ByOperation map[string]AICacheOperationStats `json:"by_operation"`
+	TTLSeconds  int64                            `json:"ttl_seconds"`
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AIUsageRecord is the token usage and estimated cost of a single AI call
+type AIUsageRecord struct {
+	ID           uuid.UUID `json:"id"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model"`
+	Operation    string    `json:"operation"`
+	InputTokens  int64     `json:"input_tokens"`
+	OutputTokens int64     `json:"output_tokens"`
+	CostUSD      float64   `json:"cost_usd"`
+	Success      bool      `json:"success"`
+	DurationMs   int64     `json:"duration_ms"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// AIUsageDaily aggregates AI usage for one UTC day
+type AIUsageDaily struct {
+	Date         string             `json:"date"` // YYYY-MM-DD
+	Calls        int                `json:"calls"`
+	FailedCalls  int                `json:"failed_calls"`
+	InputTokens  int64              `json:"input_tokens"`
+	OutputTokens int64              `json:"output_tokens"`
+	CostUSD      float64            `json:"cost_usd"`
+	CostByOp     map[string]float64 `json:"cost_by_operation"`
+}
+
+// AIUsageReport summarizes AI spend against the monthly budget
+type AIUsageReport struct {
+	Days               []*AIUsageDaily `json:"days"`
+	MonthToDateCostUSD float64         `json:"month_to_date_cost_usd"`
+	MonthlyBudgetUSD   float64         `json:"monthly_budget_usd"` // 0 means no budget
+	BudgetRemainingUSD *float64        `json:"budget_remaining_usd,omitempty"`
+	BudgetExceeded     bool            `json:"budget_exceeded"`
+	GeneratedAt        time.Time       `json:"generated_at"`
+}
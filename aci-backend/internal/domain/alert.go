@@ -16,12 +16,16 @@ const (
 	AlertTypeSeverity AlertType = "severity"
 	AlertTypeVendor   AlertType = "vendor"
 	AlertTypeCVE      AlertType = "cve"
+	AlertTypeExploit  AlertType = "exploit"
 )
 
+// AlertExploitAnyValue is the value of an exploit alert that matches a public exploit for any CVE
+const AlertExploitAnyValue = "*"
+
 // IsValid validates the alert type value
 func (t AlertType) IsValid() bool {
 	switch t {
-	case AlertTypeKeyword, AlertTypeCategory, AlertTypeSeverity, AlertTypeVendor, AlertTypeCVE:
+	case AlertTypeKeyword, AlertTypeCategory, AlertTypeSeverity, AlertTypeVendor, AlertTypeCVE, AlertTypeExploit:
 		return true
 	default:
 		return false
@@ -39,8 +43,15 @@ type Alert struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	// OrganizationID shares the alert with every member of the organization
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+
+	// Watchlist marks an alert created for a vendor on the user's watchlist; its matches have raised priority
+	Watchlist bool `json:"watchlist,omitempty"`
+
 	// Statistics (populated on query)
-	MatchCount int `json:"match_count,omitempty"`
+	MatchCount          int `json:"match_count,omitempty"`
+	UnacknowledgedCount int `json:"unacknowledged_count,omitempty"`
 }
 
 // Validate performs validation on the Alert
@@ -77,6 +88,11 @@ func (a *Alert) Validate() error {
 	return nil
 }
 
+// IsShared reports whether the alert is shared with an organization
+func (a *Alert) IsShared() bool {
+	return a.OrganizationID != nil
+}
+
 // Matches checks if the alert matches the given article
 func (a *Alert) Matches(article *Article) bool {
 	if article == nil {
@@ -96,7 +112,7 @@ func (a *Alert) Matches(article *Article) bool {
 		if err != nil {
 			return false
 		}
-		return article.CategoryID == categoryID
+		return article.InCategory(categoryID)
 
 	case AlertTypeSeverity:
 		return strings.EqualFold(string(article.Severity), a.Value)
@@ -107,6 +123,57 @@ func (a *Alert) Matches(article *Article) bool {
 	case AlertTypeCVE:
 		return article.HasCVE(a.Value)
 
+	// An exploit alert's value narrows it to articles mentioning a CVE or vendor
+	case AlertTypeExploit:
+		if !article.ExploitAvailable {
+			return false
+		}
+		return a.Value == AlertExploitAnyValue || article.HasCVE(a.Value) || article.HasVendor(a.Value)
+
+	default:
+		return false
+	}
+}
+
+// MatchPriority returns the priority of a match of the alert against the article
+// Watchlist alerts and articles about a KEV-listed CVE each raise the severity-based priority by one level
+func (a *Alert) MatchPriority(article *Article) string {
+	priority := DeterminePriority(article)
+
+	if a.Watchlist {
+		priority = raisePriority(priority)
+	}
+
+	if article != nil && article.KEV {
+		priority = raisePriority(priority)
+	}
+
+	return priority
+}
+
+// raisePriority returns the next higher match priority
+func raisePriority(priority string) string {
+	if priority == "normal" {
+		return "high"
+	}
+	return "critical"
+}
+
+// AlertMatchStatus is the triage state of an alert match
+type AlertMatchStatus string
+
+const (
+	AlertMatchStatusNew          AlertMatchStatus = "new"
+	AlertMatchStatusAcknowledged AlertMatchStatus = "acknowledged"
+	AlertMatchStatusDismissed    AlertMatchStatus = "dismissed"
+	AlertMatchStatusEscalated    AlertMatchStatus = "escalated"
+)
+
+// IsValid validates the alert match status value
+func (s AlertMatchStatus) IsValid() bool {
+	switch s {
+	case AlertMatchStatusNew, AlertMatchStatusAcknowledged, AlertMatchStatusDismissed, AlertMatchStatusEscalated:
+		return true
 	default:
 		return false
 	}
@@ -114,12 +181,15 @@ func (a *Alert) Matches(article *Article) bool {
 
 // AlertMatch records when an alert matches an article
 type AlertMatch struct {
-	ID         uuid.UUID  `json:"id"`
-	AlertID    uuid.UUID  `json:"alert_id"`
-	ArticleID  uuid.UUID  `json:"article_id"`
-	Priority   string     `json:"priority"` // critical, high, normal
-	MatchedAt  time.Time  `json:"matched_at"`
-	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	ID              uuid.UUID        `json:"id"`
+	AlertID         uuid.UUID        `json:"alert_id"`
+	ArticleID       uuid.UUID        `json:"article_id"`
+	Priority        string           `json:"priority"` // critical, high, normal
+	MatchedAt       time.Time        `json:"matched_at"`
+	NotifiedAt      *time.Time       `json:"notified_at,omitempty"`
+	Status          AlertMatchStatus `json:"status"`
+	StatusChangedAt *time.Time       `json:"status_changed_at,omitempty"`
+	StatusChangedBy *uuid.UUID       `json:"status_changed_by,omitempty"`
 
 	// Populated on query
 	Alert   *Alert   `json:"alert,omitempty"`
@@ -150,9 +220,21 @@ func (m *AlertMatch) Validate() error {
 		return fmt.Errorf("priority must be critical, high, or normal")
 	}
 
+	if m.Status != "" && !m.Status.IsValid() {
+		return fmt.Errorf("status must be new, acknowledged, dismissed, or escalated")
+	}
+
 	return nil
 }
 
+// SetStatus moves the match to a triage state on behalf of a user
+func (m *AlertMatch) SetStatus(status AlertMatchStatus, userID uuid.UUID) {
+	now := time.Now()
+	m.Status = status
+	m.StatusChangedAt = &now
+	m.StatusChangedBy = &userID
+}
+
 // IsNotified returns true if the match has been notified
 func (m *AlertMatch) IsNotified() bool {
 	return m.NotifiedAt != nil
@@ -180,6 +262,24 @@ func DeterminePriority(article *Article) string {
 	}
 }
 
+// MaxAlertBackfillDays is how far back a new alert may be matched against existing articles
+const MaxAlertBackfillDays = 90
+
+// AlertBackfillTopMatches is how many matches an alert backfill summary lists
+const AlertBackfillTopMatches = 5
+
+// AlertBackfillSummary reports the matches found when a new alert was run against existing articles
+type AlertBackfillSummary struct {
+	AlertID   uuid.UUID `json:"alert_id"`
+	AlertName string    `json:"alert_name"`
+	Days      int       `json:"days"`
+	Scanned   int       `json:"scanned"`
+	Matched   int       `json:"matched"`
+
+	// TopMatches lists up to AlertBackfillTopMatches matches, highest priority and newest first
+	TopMatches []*AlertMatch `json:"top_matches"`
+}
+
 // AlertFilter represents query parameters for filtering alerts
 type AlertFilter struct {
 	UserID   uuid.UUID
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailyCount is a count for one calendar day (UTC)
+type DailyCount struct {
+	Date  string `json:"date"` // YYYY-MM-DD
+	Count int    `json:"count"`
+}
+
+// NamedCount is a count for a named entity such as a source or category
+type NamedCount struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Count int       `json:"count"`
+}
+
+// IngestionVolume breaks down articles ingested in a window
+type IngestionVolume struct {
+	Total      int          `json:"total"`
+	ByDay      []DailyCount `json:"by_day"`
+	BySource   []NamedCount `json:"by_source"`
+	ByCategory []NamedCount `json:"by_category"`
+}
+
+// EnrichmentLatency is the ingest-to-enrichment delay for articles ingested in a window
+type EnrichmentLatency struct {
+	SampleCount int     `json:"sample_count"`
+	Pending     int     `json:"pending"` // ingested in the window but not yet enriched
+	P50Ms       float64 `json:"p50_ms"`
+	P90Ms       float64 `json:"p90_ms"`
+	P95Ms       float64 `json:"p95_ms"`
+	P99Ms       float64 `json:"p99_ms"`
+}
+
+// ArticleEngagement summarizes reader activity on one article in a window
+type ArticleEngagement struct {
+	ArticleID     uuid.UUID `json:"article_id"`
+	Title         string    `json:"title"`
+	Slug          string    `json:"slug"`
+	Severity      Severity  `json:"severity"`
+	Reads         int       `json:"reads"`
+	UniqueReaders int       `json:"unique_readers"`
+	Bookmarks     int       `json:"bookmarks"`
+	// Views, Shares and CTAClicks come from the daily client event aggregates
+	Views     int `json:"views"`
+	Shares    int `json:"shares"`
+	CTAClicks int `json:"cta_clicks"`
+}
+
+// ActiveUsers counts users who logged in or read an article recently
+// Daily, weekly and monthly are relative to now; InWindow uses the report window
+type ActiveUsers struct {
+	Daily      int `json:"daily"`
+	Weekly     int `json:"weekly"`
+	Monthly    int `json:"monthly"`
+	InWindow   int `json:"in_window"`
+	TotalUsers int `json:"total_users"`
+}
+
+// AlertMatchRates summarizes alert matching in a window
+type AlertMatchRates struct {
+	ActiveAlerts    int     `json:"active_alerts"`
+	Matches         int     `json:"matches"`
+	AlertsMatched   int     `json:"alerts_matched"`
+	ArticlesMatched int     `json:"articles_matched"`
+	MatchRate       float64 `json:"match_rate"` // fraction of ingested articles that matched at least one alert
+}
+
+// ClientEngagementDay totals the client events aggregated for one calendar day (UTC)
+type ClientEngagementDay struct {
+	Date          string `json:"date"` // YYYY-MM-DD
+	Views         int    `json:"views"`
+	UniqueViewers int    `json:"unique_viewers"`
+	Shares        int    `json:"shares"`
+	CTAClicks     int    `json:"cta_clicks"`
+}
+
+// ClientEngagement totals the client events aggregated in a window
+// Unique viewers are counted per day and cannot be summed across days
+type ClientEngagement struct {
+	Views          int                   `json:"views"`
+	Shares         int                   `json:"shares"`
+	CTAClicks      int                   `json:"cta_clicks"`
+	AvgScrollDepth float64               `json:"avg_scroll_depth"` // mean percentage of an article reached
+	ByDay          []ClientEngagementDay `json:"by_day"`
+}
+
+// LabeledCount is a count for a free-form label such as a share source or referring host
+type LabeledCount struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// SharedArticle counts the share links created for one article in a window and the clicks they received
+type SharedArticle struct {
+	ArticleID uuid.UUID `json:"article_id"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Links     int       `json:"links"`
+	Clicks    int       `json:"clicks"`
+}
+
+// ShareLinkStats summarizes share links created and followed in a window
+type ShareLinkStats struct {
+	LinksCreated int             `json:"links_created"`
+	Clicks       int             `json:"clicks"`
+	BySource     []LabeledCount  `json:"by_source"`
+	ByReferrer   []LabeledCount  `json:"by_referrer"`
+	TopArticles  []SharedArticle `json:"top_articles"` // ranked by clicks in the window
+}
+
+// AdminAnalytics is the admin analytics dashboard for a reporting window
+type AdminAnalytics struct {
+	Window            string              `json:"window"`
+	Since             time.Time           `json:"since"`
+	GeneratedAt       time.Time           `json:"generated_at"`
+	Ingestion         IngestionVolume     `json:"ingestion"`
+	EnrichmentLatency EnrichmentLatency   `json:"enrichment_latency"`
+	TopArticles       []ArticleEngagement `json:"top_articles"`
+	ActiveUsers       ActiveUsers         `json:"active_users"`
+	AlertMatches      AlertMatchRates     `json:"alert_matches"`
+	ClientEngagement  ClientEngagement    `json:"client_engagement"`
+	ShareLinks        ShareLinkStats      `json:"share_links"`
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAnnotationMentions caps how many organization members one annotation can mention
+const MaxAnnotationMentions = 10
+
+// Annotation is an analyst's internal comment or highlight on an article, visible to their organization
+type Annotation struct {
+	ID             uuid.UUID `json:"id"`
+	ArticleID      uuid.UUID `json:"article_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Body           string    `json:"body"`
+	// Quote is the highlighted passage of the article
+	Quote     *string     `json:"quote,omitempty"`
+	Mentions  []uuid.UUID `json:"mentions"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+
+	// Populated on query
+	AuthorName   string `json:"author_name,omitempty"`
+	ArticleTitle string `json:"article_title,omitempty"`
+}
+
+// Validate performs validation on the Annotation
+func (a *Annotation) Validate() error {
+	if a.ArticleID == uuid.Nil {
+		return fmt.Errorf("article_id is required")
+	}
+
+	if strings.TrimSpace(a.Body) == "" && (a.Quote == nil || strings.TrimSpace(*a.Quote) == "") {
+		return fmt.Errorf("body or quote is required")
+	}
+
+	if len(a.Body) > 5000 {
+		return fmt.Errorf("body cannot exceed 5000 characters")
+	}
+
+	if a.Quote != nil && len(*a.Quote) > 2000 {
+		return fmt.Errorf("quote cannot exceed 2000 characters")
+	}
+
+	if len(a.Mentions) > MaxAnnotationMentions {
+		return fmt.Errorf("cannot mention more than %d users", MaxAnnotationMentions)
+	}
+
+	return nil
+}
+
+// AnnotationMention is sent to an organization member mentioned in an annotation
+type AnnotationMention struct {
+	AnnotationID uuid.UUID `json:"annotation_id"`
+	ArticleID    uuid.UUID `json:"article_id"`
+	ArticleTitle string    `json:"article_title"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	AuthorName   string    `json:"author_name"`
+	Body         string    `json:"body"`
+	Quote        *string   `json:"quote,omitempty"`
+}
@@ -28,11 +28,39 @@ func (s Severity) IsValid() bool {
 	}
 }
 
+// AuditActionArticleSlugUpdated is recorded when an admin changes an article's slug
+const AuditActionArticleSlugUpdated = "update_article_slug"
+
+// ContentFormat is the format an article's content was written in
+type ContentFormat string
+
+const (
+	ContentFormatHTML     ContentFormat = "html"
+	ContentFormatMarkdown ContentFormat = "markdown"
+)
+
+// IsValid validates the content format value
+func (f ContentFormat) IsValid() bool {
+	switch f {
+	case ContentFormatHTML, ContentFormatMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// IOC sources record whether an indicator was found by the deterministic extractor or by AI enrichment
+const (
+	IOCSourceExtracted = "extracted"
+	IOCSourceAI        = "ai"
+)
+
 // IOC represents an Indicator of Compromise
 type IOC struct {
-	Type    string `json:"type"`              // ip, domain, hash, url
+	Type    string `json:"type"`              // ip, domain, hash, url, email
 	Value   string `json:"value"`             // The actual IOC value
 	Context string `json:"context,omitempty"` // Additional context
+	Source  string `json:"source,omitempty"`  // extracted or ai
 }
 
 // IsValid validates the IOC structure
@@ -45,21 +73,25 @@ func (i *IOC) IsValid() bool {
 		return false
 	}
 
-	validTypes := map[string]bool{
-		"ip":     true,
-		"domain": true,
-		"hash":   true,
-		"url":    true,
-	}
+	return IsValidIOCType(i.Type)
+}
 
-	return validTypes[i.Type]
+// IsValidIOCType reports whether t is a supported IOC type
+func IsValidIOCType(t string) bool {
+	switch t {
+	case "ip", "domain", "hash", "url", "email":
+		return true
+	default:
+		return false
+	}
 }
 
 // ArmorCTA represents a call to action for Armor.com marketing
 type ArmorCTA struct {
-	Type  string `json:"type"`  // product, service, consultation
-	Title string `json:"title"` // Display title
-	URL   string `json:"url"`   // Target URL
+	Type      string     `json:"type"`                 // product, service, consultation
+	Title     string     `json:"title"`                // Display title
+	URL       string     `json:"url"`                  // Target URL
+	VariantID *uuid.UUID `json:"variant_id,omitempty"` // A/B variant served, passed back when tracking clicks
 }
 
 // IsValid validates the ArmorCTA structure
@@ -84,7 +116,7 @@ type Article struct {
 	Slug       string    `json:"slug"`
 	Content    string    `json:"content"`
 	Summary    *string   `json:"summary,omitempty"`
-	CategoryID uuid.UUID `json:"category_id"`
+	CategoryID uuid.UUID `json:"category_id"` // primary category
 	Category   *Category `json:"category,omitempty"`
 	SourceID   uuid.UUID `json:"source_id"`
 	Source     *Source   `json:"source,omitempty"`
@@ -94,6 +126,14 @@ type Article struct {
 	CVEs       []string  `json:"cves"`
 	Vendors    []string  `json:"vendors"`
 
+	// Content is always sanitized HTML; articles written in markdown keep the source in
+	// ContentMarkdown, with Content rendered from it
+	ContentFormat   ContentFormat `json:"content_format"`
+	ContentMarkdown *string       `json:"-"`
+
+	// Every category the article belongs to, primary first
+	CategoryIDs []uuid.UUID `json:"category_ids,omitempty"`
+
 	// AI Enrichment fields
 	ThreatType         *string  `json:"threat_type,omitempty"`
 	AttackVector       *string  `json:"attack_vector,omitempty"`
@@ -116,6 +156,40 @@ type Article struct {
 	HasDeepDive        bool                `json:"has_deep_dive"`
 	DeepDive           *DeepDive           `json:"deep_dive,omitempty"` // Only populated if user has access
 
+	// KEV is set when any of the article's CVEs is in the CISA Known Exploited Vulnerabilities
+	// catalog; KEVDueDate is the earliest remediation due date among them
+	KEV        bool       `json:"kev"`
+	KEVDueDate *time.Time `json:"kev_due_date,omitempty"`
+
+	// ExploitAvailable is set when a public exploit exists for any of the article's CVEs;
+	// ExploitSources names the feeds that list one
+	ExploitAvailable bool     `json:"exploit_available"`
+	ExploitSources   []string `json:"exploit_sources,omitempty"`
+
+	// AffectsStack is set for the reader when a CVE of the article affects a product in their own
+	// or their organization's software inventory; StackProducts names those products
+	AffectsStack  bool     `json:"affects_stack"`
+	StackProducts []string `json:"stack_products,omitempty"`
+
+	// Editorial overrides shown to readers in place of the source title and summary
+	EditorialTitle     *string    `json:"editorial_title,omitempty"`
+	EditorialSummary   *string    `json:"editorial_summary,omitempty"`
+	EditorialUpdatedBy *uuid.UUID `json:"editorial_updated_by,omitempty"`
+	EditorialUpdatedAt *time.Time `json:"editorial_updated_at,omitempty"`
+
+	// Hero image: ImageURL is the remote image from ingestion, ImageKey the object key prefix
+	// of the resized copies once they are stored
+	ImageURL *string `json:"image_url,omitempty"`
+	ImageKey *string `json:"-"`
+
+	// Content metrics, measured from Content whenever it is set
+	WordCount     int      `json:"word_count"`
+	Language      *string  `json:"language,omitempty"` // ISO 639-1; nil when it could not be detected
+	ExternalLinks []string `json:"external_links,omitempty"`
+
+	// TenantID is the workspace that owns the article; nil for the shared feed every workspace sees
+	TenantID *uuid.UUID `json:"-"`
+
 	// Metadata
 	ReadingTimeMinutes int        `json:"reading_time_minutes"`
 	ViewCount          int        `json:"view_count"`
@@ -140,6 +214,14 @@ func (a *Article) Validate() error {
 		return fmt.Errorf("content is required")
 	}
 
+	if !a.ContentFormat.IsValid() {
+		return fmt.Errorf("invalid content_format value")
+	}
+
+	if a.ContentFormat == ContentFormatMarkdown && (a.ContentMarkdown == nil || *a.ContentMarkdown == "") {
+		return fmt.Errorf("content_markdown is required for markdown content")
+	}
+
 	if a.CategoryID == uuid.Nil {
 		return fmt.Errorf("category_id is required")
 	}
@@ -170,10 +252,18 @@ func (a *Article) Validate() error {
 		}
 	}
 
+	if a.ImageURL != nil && len(*a.ImageURL) > MaxArticleImageURLLength {
+		return fmt.Errorf("image_url cannot exceed %d characters", MaxArticleImageURLLength)
+	}
+
 	if a.ReadingTimeMinutes < 0 {
 		return fmt.Errorf("reading_time_minutes cannot be negative")
 	}
 
+	if a.WordCount < 0 {
+		return fmt.Errorf("word_count cannot be negative")
+	}
+
 	if a.ViewCount < 0 {
 		return fmt.Errorf("view_count cannot be negative")
 	}
@@ -181,6 +271,35 @@ func (a *Article) Validate() error {
 	return nil
 }
 
+// DisplayTitle returns the editorial title if one is set, otherwise the source title
+func (a *Article) DisplayTitle() string {
+	if a.EditorialTitle != nil && *a.EditorialTitle != "" {
+		return *a.EditorialTitle
+	}
+	return a.Title
+}
+
+// DisplaySummary returns the editorial summary if one is set, otherwise the source summary
+func (a *Article) DisplaySummary() *string {
+	if a.EditorialSummary != nil && *a.EditorialSummary != "" {
+		return a.EditorialSummary
+	}
+	return a.Summary
+}
+
+// ForDisplay returns a copy of the article as readers see it, with the editorial
+// overrides in place of the source title and summary
+func (a *Article) ForDisplay() *Article {
+	display := *a
+	display.Title = a.DisplayTitle()
+	display.Summary = a.DisplaySummary()
+	display.EditorialTitle = nil
+	display.EditorialSummary = nil
+	display.EditorialUpdatedBy = nil
+	display.EditorialUpdatedAt = nil
+	return &display
+}
+
 // ContainsKeyword checks if the article contains the given keyword in title, content, or summary
 func (a *Article) ContainsKeyword(keyword string) bool {
 	if keyword == "" {
@@ -236,8 +355,24 @@ func (a *Article) HasVendor(vendor string) bool {
 	return false
 }
 
+// InCategory checks if the article belongs to the given category, as its primary or an additional category
+func (a *Article) InCategory(categoryID uuid.UUID) bool {
+	if a.CategoryID == categoryID {
+		return true
+	}
+
+	for _, id := range a.CategoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ArticleFilter represents query parameters for filtering articles
 type ArticleFilter struct {
+	// CategoryID matches articles in the category or any of its subcategories, through any of their categories
 	CategoryID   *uuid.UUID
 	SourceID     *uuid.UUID
 	Severity     *Severity
@@ -246,9 +381,43 @@ type ArticleFilter struct {
 	Vendor       *string
 	Industry     *string
 	HasDeepDive  *bool
+	// KEV matches articles with (or without) a CVE in the KEV catalog
+	KEV          *bool
+	// ExploitAvailable matches articles with (or without) a public exploit for one of their CVEs
+	ExploitAvailable *bool
+	// AffectsStack matches articles with (or without) a CVE affecting a product in the software
+	// inventory of StackUserID or of StackOrganizationID
+	AffectsStack        *bool
+	StackUserID         *uuid.UUID
+	StackOrganizationID *uuid.UUID
+	// ThreatActorID matches articles linked to the threat actor
+	ThreatActorID *uuid.UUID
+	// IncidentID matches articles linked to the incident
+	IncidentID   *uuid.UUID
+	// OrgCategoryID matches articles the organization category is applied to
+	OrgCategoryID *uuid.UUID
+	IsEnriched   *bool
+	// ExcludeDuplicates hides near-duplicates, keeping only the canonical article of each cluster
+	ExcludeDuplicates bool
+	// PublishedOnly hides articles that are unpublished, such as those waiting in the review queue
+	PublishedOnly bool
+	// InterestCategoryIDs and InterestVendors match articles in any of the categories (or their
+	// subcategories) or mentioning any of the vendors
+	InterestCategoryIDs []uuid.UUID
+	InterestVendors     []string
+	// BoostVendors ranks articles mentioning any of the vendors, ignoring case, ahead of the rest
+	BoostVendors        []string
+	MinSeverity         *Severity
+	ExcludeTags         []string
 	DateFrom     *time.Time
 	DateTo       *time.Time
 	SearchQuery  *string
+	// Query is a parsed search query; SearchQuery is a plain substring match
+	Query        *SearchQuery
+	// SimilarTo matches titles similar to the text by trigram similarity, most similar first
+	SimilarTo    *string
+	// Chronological orders oldest first instead of newest first
+	Chronological bool
 	Page         int
 	PageSize     int
 }
@@ -279,6 +448,10 @@ func (f *ArticleFilter) Validate() error {
 		return fmt.Errorf("invalid severity value")
 	}
 
+	if f.MinSeverity != nil && !f.MinSeverity.IsValid() {
+		return fmt.Errorf("invalid min_severity value")
+	}
+
 	if f.DateFrom != nil && f.DateTo != nil && f.DateFrom.After(*f.DateTo) {
 		return fmt.Errorf("date_from cannot be after date_to")
 	}
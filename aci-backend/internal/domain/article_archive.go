@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleArchiveStatus is the outcome of the last attempt to archive an article's source page
+type ArticleArchiveStatus string
+
+const (
+	ArticleArchiveArchived ArticleArchiveStatus = "archived"
+	ArticleArchiveFailed   ArticleArchiveStatus = "failed"
+)
+
+// ArticleArchive is a snapshot of an article's source page kept in object storage
+// HTMLKey and TextKey are set once the page is archived; a failed attempt records its error
+// and is retried until the attempts run out
+type ArticleArchive struct {
+	ArticleID  uuid.UUID
+	Status     ArticleArchiveStatus
+	HTMLKey    *string
+	TextKey    *string
+	Attempts   int
+	LastError  *string
+	ArchivedAt *time.Time
+	UpdatedAt  time.Time
+}
+
+// ArticleArchiveCandidate is an article whose source page is waiting to be archived
+type ArticleArchiveCandidate struct {
+	ArticleID uuid.UUID
+	SourceURL string
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Editorial override limits
+const (
+	MaxEditorialTitleLength   = 500
+	MaxEditorialSummaryLength = 5000
+)
+
+// AuditActionArticleEditorialUpdated is recorded when an article's editorial overrides change
+const AuditActionArticleEditorialUpdated = "update_article_editorial"
+
+// ArticleEditorial shows an article's source title and summary next to the editorial
+// overrides readers see instead
+type ArticleEditorial struct {
+	ArticleID        uuid.UUID  `json:"article_id"`
+	Slug             string     `json:"slug"`                        // follows the editorial title
+	Title            string     `json:"title"`                       // as ingested from the source
+	Summary          *string    `json:"summary,omitempty"`           // as ingested or enriched
+	EditorialTitle   *string    `json:"editorial_title,omitempty"`   // shown to readers when set
+	EditorialSummary *string    `json:"editorial_summary,omitempty"` // shown to readers when set
+	DisplayTitle     string     `json:"display_title"`
+	DisplaySummary   *string    `json:"display_summary,omitempty"`
+	UpdatedBy        *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+// NewArticleEditorial builds the editorial view of an article
+func NewArticleEditorial(article *Article) *ArticleEditorial {
+	return &ArticleEditorial{
+		ArticleID:        article.ID,
+		Slug:             article.Slug,
+		Title:            article.Title,
+		Summary:          article.Summary,
+		EditorialTitle:   article.EditorialTitle,
+		EditorialSummary: article.EditorialSummary,
+		DisplayTitle:     article.DisplayTitle(),
+		DisplaySummary:   article.DisplaySummary(),
+		UpdatedBy:        article.EditorialUpdatedBy,
+		UpdatedAt:        article.EditorialUpdatedAt,
+	}
+}
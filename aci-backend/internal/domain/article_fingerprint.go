@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleFingerprint is the near-duplicate fingerprint of an article
+// Articles sharing a CanonicalID form a duplicate cluster; the canonical article has CanonicalID == ArticleID
+type ArticleFingerprint struct {
+	ArticleID   uuid.UUID `json:"article_id"`
+	Simhash     uint64    `json:"simhash"`
+	CanonicalID uuid.UUID `json:"canonical_id"`
+	Distance    int       `json:"distance"` // Hamming distance to the canonical article
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// IsCanonical reports whether the article is the canonical member of its cluster
+func (f *ArticleFingerprint) IsCanonical() bool {
+	return f.ArticleID == f.CanonicalID
+}
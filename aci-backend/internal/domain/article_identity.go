@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateReason says how an incoming article was found to duplicate a stored one
+type DuplicateReason string
+
+const (
+	// DuplicateReasonURL means the source URLs are the same page once canonicalized
+	DuplicateReasonURL DuplicateReason = "url"
+
+	// DuplicateReasonContent means the normalized article text is identical
+	DuplicateReasonContent DuplicateReason = "content"
+)
+
+// ArticleSourceURL links a canonical source URL to the article stored for it
+// An article has one per URL it was received under; SourceURL is the URL as received
+type ArticleSourceURL struct {
+	CanonicalURL string    `json:"canonical_url"`
+	ArticleID    uuid.UUID `json:"article_id"`
+	SourceURL    string    `json:"source_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// MaxArticleImageURLLength is the longest accepted hero image URL
+const MaxArticleImageURLLength = 2000
+
+// ArticleImageSize is a standard width hero images are resized to
+// Images narrower than the width are stored at their own width
+type ArticleImageSize struct {
+	Name  string
+	Width int
+}
+
+// ArticleImageSizes are the sizes every stored hero image is available in
+var ArticleImageSizes = []ArticleImageSize{
+	{Name: "thumbnail", Width: 320},
+	{Name: "medium", Width: 800},
+	{Name: "large", Width: 1600},
+}
+
+// ArticleImageSizeByName returns the standard size with the given name
+func ArticleImageSizeByName(name string) (ArticleImageSize, bool) {
+	for _, size := range ArticleImageSizes {
+		if size.Name == name {
+			return size, true
+		}
+	}
+	return ArticleImageSize{}, false
+}
+
+// ArticleImageCandidate is an article whose hero image is waiting to be fetched and stored
+type ArticleImageCandidate struct {
+	ArticleID uuid.UUID
+	ImageURL  string
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditActionArticleImportStarted is the audit log action for starting or resuming an article import
+const AuditActionArticleImportStarted = "start_article_import"
+
+// Article import formats
+const (
+	ArticleImportFormatJSONL = "jsonl" // one article object per line, with the fields of an article.created webhook
+	ArticleImportFormatCSV   = "csv"   // a header row naming the same fields; list fields are separated by ';'
+)
+
+// MaxArticleImportErrors is how many record errors an import keeps; later errors are only counted
+const MaxArticleImportErrors = 100
+
+// ArticleImportStatus is the state of an article import
+type ArticleImportStatus string
+
+const (
+	ArticleImportStatusRunning   ArticleImportStatus = "running"
+	ArticleImportStatusCompleted ArticleImportStatus = "completed"
+	ArticleImportStatusFailed    ArticleImportStatus = "failed" // stopped early; resumable from Processed
+)
+
+// IsValidArticleImportFormat reports whether format is a supported import format
+func IsValidArticleImportFormat(format string) bool {
+	return format == ArticleImportFormatJSONL || format == ArticleImportFormatCSV
+}
+
+// ArticleImportError records why one record of an import was not stored
+type ArticleImportError struct {
+	Record  int    `json:"record"` // 1-based, not counting a CSV header
+	Message string `json:"message"`
+}
+
+// ArticleImport is a backfill of historical articles from a JSONL or CSV dump
+// Processed is the checkpoint: a resumed import skips that many records, after checking
+// against Checksum that they are the records already processed
+type ArticleImport struct {
+	ID          uuid.UUID            `json:"id"`
+	Format      string               `json:"format"`
+	Filename    string               `json:"filename,omitempty"`
+	Status      ArticleImportStatus  `json:"status"`
+	Processed   int                  `json:"processed"`
+	Created     int                  `json:"created"`
+	Duplicates  int                  `json:"duplicates"`
+	Failed      int                  `json:"failed"`
+	Checksum    string               `json:"-"` // hex SHA-256 of the first Processed records
+	Errors      []ArticleImportError `json:"errors"`
+	Error       *string              `json:"error,omitempty"` // why a failed import stopped
+	CreatedBy   *uuid.UUID           `json:"created_by,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	CompletedAt *time.Time           `json:"completed_at,omitempty"`
+}
+
+// AddError records a record error, keeping the first MaxArticleImportErrors
+func (i *ArticleImport) AddError(record int, message string) {
+	i.Failed++
+	if len(i.Errors) < MaxArticleImportErrors {
+		i.Errors = append(i.Errors, ArticleImportError{Record: record, Message: message})
+	}
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewStatus represents the moderation state of an article held for review
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending_review" // unpublished, waiting in the review queue
+	ReviewStatusApproved ReviewStatus = "approved"       // published by an admin
+	ReviewStatusRejected ReviewStatus = "rejected"       // kept unpublished, with a reason
+)
+
+// IsValid checks if the review status is valid
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxBulkReviewSize is the largest number of articles accepted in one bulk review
+const MaxBulkReviewSize = 100
+
+// Audit actions recorded for article moderation
+const (
+	AuditActionArticleApproved = "approve_article"
+	AuditActionArticleRejected = "reject_article"
+)
+
+// ArticleReview is the moderation record of an article ingested in review mode
+type ArticleReview struct {
+	ArticleID  uuid.UUID    `json:"article_id"`
+	Status     ReviewStatus `json:"status"`
+	Reason     *string      `json:"reason,omitempty"` // required when rejected
+	ReviewedBy *uuid.UUID   `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time   `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+	Article    *Article     `json:"article,omitempty"` // populated when listing the queue
+}
+
+// BulkReviewFailure is an article a bulk review could not apply
+type BulkReviewFailure struct {
+	ArticleID uuid.UUID `json:"article_id"`
+	Error     string    `json:"error"`
+}
+
+// BulkReviewResult reports the outcome of a bulk review
+type BulkReviewResult struct {
+	Approved []uuid.UUID         `json:"approved"`
+	Failed   []BulkReviewFailure `json:"failed"`
+}
+
+// ArticleReviewFilter represents query parameters for listing the review queue
+type ArticleReviewFilter struct {
+	Status     *ReviewStatus
+	CategoryID *uuid.UUID
+	SourceID   *uuid.UUID
+	Severity   *Severity
+	Page       int
+	PageSize   int
+}
+
+// Validate validates the filter parameters
+func (f *ArticleReviewFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Status != nil && !f.Status.IsValid() {
+		return fmt.Errorf("invalid status value")
+	}
+
+	if f.Severity != nil && !f.Severity.IsValid() {
+		return fmt.Errorf("invalid severity value")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *ArticleReviewFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SummaryLength is a preset length for AI-generated article summaries
+type SummaryLength string
+
+const (
+	SummaryLengthShort     SummaryLength = "short"     // one-liner for marketing and social copy
+	SummaryLengthMedium    SummaryLength = "medium"    // short paragraph for list and card views
+	SummaryLengthExecutive SummaryLength = "executive" // detailed briefing for analysts and leadership
+)
+
+// SummaryLengths returns all summary length presets
+func SummaryLengths() []SummaryLength {
+	return []SummaryLength{
+		SummaryLengthShort,
+		SummaryLengthMedium,
+		SummaryLengthExecutive,
+	}
+}
+
+// IsValid checks if the summary length is valid
+func (l SummaryLength) IsValid() bool {
+	switch l {
+	case SummaryLengthShort, SummaryLengthMedium, SummaryLengthExecutive:
+		return true
+	default:
+		return false
+	}
+}
+
+// ArticleSummary is a generated summary of an article at a given length
+type ArticleSummary struct {
+	ArticleID   uuid.UUID     `json:"article_id"`
+	Length      SummaryLength `json:"length"`
+	Content     string        `json:"content"`
+	Provider    string        `json:"provider"`
+	GeneratedAt time.Time     `json:"generated_at"`
+}
+
+// Validate validates the article summary
+func (s *ArticleSummary) Validate() error {
+	if s.ArticleID == uuid.Nil {
+		return fmt.Errorf("article ID is required")
+	}
+
+	if !s.Length.IsValid() {
+		return fmt.Errorf("invalid summary length: %s", s.Length)
+	}
+
+	if s.Content == "" {
+		return fmt.Errorf("summary content is required")
+	}
+
+	return nil
+}
@@ -55,6 +55,7 @@ func (a *AuditLog) Validate() error {
 type AuditLogFilter struct {
 	UserID       *uuid.UUID
 	Action       *string
+	Actions      []string // matches any of the actions; combined with Action if both are set
 	ResourceType *string
 	ResourceID   *uuid.UUID
 	StartDate    *time.Time
@@ -104,3 +105,6 @@ func NewAuditLog(
 		CreatedAt:    time.Now(),
 	}
 }
+
+// AuditActionAuditLogsArchived is recorded for each batch of audit logs moved to object storage
+const AuditActionAuditLogsArchived = "archive_audit_logs"
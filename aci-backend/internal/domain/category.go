@@ -17,15 +17,24 @@ var (
 	slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 )
 
+// MaxArticleCategories is the largest number of categories an article can belong to
+const MaxArticleCategories = 5
+
+// AuditActionArticleCategoriesUpdated is recorded when an admin changes an article's categories
+const AuditActionArticleCategoriesUpdated = "update_article_categories"
+
 // Category represents a news category in the system
+// Categories form a hierarchy through ParentID; top-level categories have none
 type Category struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Slug        string    `json:"slug"`
-	Description *string   `json:"description,omitempty"`
-	Color       string    `json:"color"`
-	Icon        *string   `json:"icon,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID   `json:"id"`
+	ParentID    *uuid.UUID  `json:"parent_id,omitempty"`
+	Name        string      `json:"name"`
+	Slug        string      `json:"slug"`
+	Description *string     `json:"description,omitempty"`
+	Color       string      `json:"color"`
+	Icon        *string     `json:"icon,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	Children    []*Category `json:"children,omitempty"` // populated by BuildCategoryTree
 }
 
 // Validate validates the category entity
@@ -66,6 +75,10 @@ func (c *Category) Validate() error {
 		return fmt.Errorf("description must not exceed 500 characters")
 	}
 
+	if c.ParentID != nil && *c.ParentID == c.ID {
+		return fmt.Errorf("category cannot be its own parent")
+	}
+
 	if c.Icon != nil && len(*c.Icon) > 100 {
 		return fmt.Errorf("icon must not exceed 100 characters")
 	}
@@ -112,3 +125,26 @@ func NewCategory(name, color string, description *string, icon *string) *Categor
 		CreatedAt:   now,
 	}
 }
+
+// BuildCategoryTree nests categories under their parents and returns the top-level categories
+// Input order is kept among siblings; a category whose parent is missing is treated as top-level
+func BuildCategoryTree(categories []*Category) []*Category {
+	byID := make(map[uuid.UUID]*Category, len(categories))
+	for _, category := range categories {
+		category.Children = nil
+		byID[category.ID] = category
+	}
+
+	roots := make([]*Category, 0)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			if parent, ok := byID[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, category)
+				continue
+			}
+		}
+		roots = append(roots, category)
+	}
+
+	return roots
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClassificationField is an article field that can be suggested by AI classification
+type ClassificationField string
+
+const (
+	ClassificationFieldCategory ClassificationField = "category"
+	ClassificationFieldSeverity ClassificationField = "severity"
+	ClassificationFieldTags     ClassificationField = "tags"
+	ClassificationFieldVendors  ClassificationField = "vendors"
+)
+
+// ClassificationStatus represents the review state of a classification suggestion
+type ClassificationStatus string
+
+const (
+	ClassificationStatusApplied  ClassificationStatus = "applied"  // every missing field was auto-applied
+	ClassificationStatusPending  ClassificationStatus = "pending"  // some fields are below the threshold and await review
+	ClassificationStatusApproved ClassificationStatus = "approved" // an admin applied the remaining fields
+	ClassificationStatusRejected ClassificationStatus = "rejected" // an admin discarded the remaining fields
+)
+
+// IsValid checks if the classification status is valid
+func (s ClassificationStatus) IsValid() bool {
+	switch s {
+	case ClassificationStatusApplied, ClassificationStatusPending, ClassificationStatusApproved, ClassificationStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClassificationSuggestion holds AI-suggested metadata for an article whose payload omitted it
+type ClassificationSuggestion struct {
+	ID                 uuid.UUID             `json:"id"`
+	ArticleID          uuid.UUID             `json:"article_id"`
+	CategorySlug       *string               `json:"category_slug,omitempty"`
+	CategoryConfidence float64               `json:"category_confidence"`
+	Severity           *Severity             `json:"severity,omitempty"`
+	SeverityConfidence float64               `json:"severity_confidence"`
+	Tags               []string              `json:"tags"`
+	TagsConfidence     float64               `json:"tags_confidence"`
+	Vendors            []string              `json:"vendors"`
+	VendorsConfidence  float64               `json:"vendors_confidence"`
+	MissingFields      []ClassificationField `json:"missing_fields"` // fields the ingest payload omitted
+	AppliedFields      []ClassificationField `json:"applied_fields"` // fields written to the article
+	Status             ClassificationStatus  `json:"status"`
+	Provider           string                `json:"provider"`
+	ReviewedBy         *uuid.UUID            `json:"reviewed_by,omitempty"`
+	ReviewedAt         *time.Time            `json:"reviewed_at,omitempty"`
+	CreatedAt          time.Time             `json:"created_at"`
+}
+
+// Confidence returns the suggestion confidence for a field
+func (s *ClassificationSuggestion) Confidence(field ClassificationField) float64 {
+	switch field {
+	case ClassificationFieldCategory:
+		return s.CategoryConfidence
+	case ClassificationFieldSeverity:
+		return s.SeverityConfidence
+	case ClassificationFieldTags:
+		return s.TagsConfidence
+	case ClassificationFieldVendors:
+		return s.VendorsConfidence
+	default:
+		return 0
+	}
+}
+
+// HasValue reports whether a usable value was suggested for a field
+func (s *ClassificationSuggestion) HasValue(field ClassificationField) bool {
+	switch field {
+	case ClassificationFieldCategory:
+		return s.CategorySlug != nil && *s.CategorySlug != ""
+	case ClassificationFieldSeverity:
+		return s.Severity != nil && s.Severity.IsValid()
+	case ClassificationFieldTags:
+		return len(s.Tags) > 0
+	case ClassificationFieldVendors:
+		return len(s.Vendors) > 0
+	default:
+		return false
+	}
+}
+
+// IsApplied reports whether a field has been written to the article
+func (s *ClassificationSuggestion) IsApplied(field ClassificationField) bool {
+	for _, applied := range s.AppliedFields {
+		if applied == field {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingFields returns missing fields with a suggested value that have not been applied
+func (s *ClassificationSuggestion) PendingFields() []ClassificationField {
+	pending := make([]ClassificationField, 0, len(s.MissingFields))
+	for _, field := range s.MissingFields {
+		if s.HasValue(field) && !s.IsApplied(field) {
+			pending = append(pending, field)
+		}
+	}
+	return pending
+}
+
+// ClassificationFilter represents query parameters for listing classification suggestions
+type ClassificationFilter struct {
+	Status   *ClassificationStatus
+	Page     int
+	PageSize int
+}
+
+// Validate validates the filter parameters
+func (f *ClassificationFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Status != nil && !f.Status.IsValid() {
+		return fmt.Errorf("invalid status value")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *ClassificationFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientEventType is the kind of engagement a client reports
+type ClientEventType string
+
+const (
+	ClientEventView     ClientEventType = "view"
+	ClientEventScroll   ClientEventType = "scroll"
+	ClientEventShare    ClientEventType = "share"
+	ClientEventCTAClick ClientEventType = "cta_click"
+)
+
+// IsValid checks if the client event type is valid
+func (t ClientEventType) IsValid() bool {
+	switch t {
+	case ClientEventView, ClientEventScroll, ClientEventShare, ClientEventCTAClick:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClientEvent is one engagement event reported by a client for an article
+type ClientEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      ClientEventType `json:"type"`
+	ArticleID uuid.UUID       `json:"article_id"`
+	UserID    *uuid.UUID      `json:"user_id,omitempty"`
+	SessionID *string         `json:"session_id,omitempty"`
+	// ScrollDepth is the deepest point of the article reached, as a percentage (scroll events)
+	ScrollDepth *int `json:"scroll_depth,omitempty"`
+	// Channel is where the article was shared to (share events)
+	Channel *string `json:"channel,omitempty"`
+	// VariantID is the CTA variant clicked (cta_click events)
+	VariantID  *uuid.UUID `json:"variant_id,omitempty"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	ReceivedAt time.Time  `json:"received_at"`
+}
+
+// Validate performs validation on the ClientEvent
+func (e *ClientEvent) Validate() error {
+	if !e.Type.IsValid() {
+		return fmt.Errorf("invalid event type: %s", e.Type)
+	}
+
+	if e.ArticleID == uuid.Nil {
+		return fmt.Errorf("article_id is required")
+	}
+
+	if e.Type == ClientEventScroll && e.ScrollDepth == nil {
+		return fmt.Errorf("scroll_depth is required for scroll events")
+	}
+
+	if e.ScrollDepth != nil && (*e.ScrollDepth < 0 || *e.ScrollDepth > 100) {
+		return fmt.Errorf("scroll_depth must be between 0 and 100")
+	}
+
+	if e.SessionID != nil && len(*e.SessionID) > 64 {
+		return fmt.Errorf("session_id cannot exceed 64 characters")
+	}
+
+	if e.Channel != nil && len(*e.Channel) > 32 {
+		return fmt.Errorf("channel cannot exceed 32 characters")
+	}
+
+	if e.OccurredAt.IsZero() {
+		return fmt.Errorf("occurred_at is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lead form limits
+const (
+	MaxLeadNameLength    = 100
+	MaxLeadFieldLength   = 255
+	MaxLeadMessageLength = 2000
+)
+
+// ConversionType identifies what a reader did that is pushed to the CRM
+type ConversionType string
+
+const (
+	ConversionCTAClick ConversionType = "cta_click"
+	ConversionLeadForm ConversionType = "lead_form"
+)
+
+// IsValid validates the conversion type value
+func (t ConversionType) IsValid() bool {
+	switch t {
+	case ConversionCTAClick, ConversionLeadForm:
+		return true
+	default:
+		return false
+	}
+}
+
+// CRMConversion is a contact and the activity that identified them
+// Contact fields are mapped to CRM properties by the connector's field mapping;
+// the activity is recorded against the contact
+type CRMConversion struct {
+	Type      ConversionType `json:"type"`
+	Email     string         `json:"email"`
+	FirstName string         `json:"first_name,omitempty"`
+	LastName  string         `json:"last_name,omitempty"`
+	Company   string         `json:"company,omitempty"`
+	JobTitle  string         `json:"job_title,omitempty"`
+	Phone     string         `json:"phone,omitempty"`
+	Message   string         `json:"message,omitempty"` // lead forms only
+
+	// The article the reader converted on, if any
+	ArticleID    *uuid.UUID `json:"article_id,omitempty"`
+	ArticleTitle string     `json:"article_title,omitempty"`
+	ArticleURL   string     `json:"article_url,omitempty"`
+
+	// The CTA clicked (cta_click only); CTAVariant names the A/B variant served
+	CTATitle   string `json:"cta_title,omitempty"`
+	CTAURL     string `json:"cta_url,omitempty"`
+	CTAVariant string `json:"cta_variant,omitempty"`
+
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Fields returns the conversion's values by field name, the names a field mapping maps from
+// Empty values are left out
+func (c *CRMConversion) Fields() map[string]string {
+	fields := map[string]string{
+		"conversion_type": string(c.Type),
+		"email":           c.Email,
+		"first_name":      c.FirstName,
+		"last_name":       c.LastName,
+		"company":         c.Company,
+		"job_title":       c.JobTitle,
+		"phone":           c.Phone,
+		"message":         c.Message,
+		"article_title":   c.ArticleTitle,
+		"article_url":     c.ArticleURL,
+		"cta_title":       c.CTATitle,
+		"cta_url":         c.CTAURL,
+		"cta_variant":     c.CTAVariant,
+	}
+
+	for name, value := range fields {
+		if value == "" {
+			delete(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// Validate validates the contact details of the conversion
+func (c *CRMConversion) Validate() error {
+	if !c.Type.IsValid() {
+		return fmt.Errorf("type must be cta_click or lead_form")
+	}
+
+	if c.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	if len(c.Email) > MaxLeadFieldLength {
+		return fmt.Errorf("email cannot exceed %d characters", MaxLeadFieldLength)
+	}
+
+	if addr, err := mail.ParseAddress(c.Email); err != nil || addr.Address != c.Email {
+		return fmt.Errorf("email must be a valid address")
+	}
+
+	for field, value := range map[string]string{"first_name": c.FirstName, "last_name": c.LastName} {
+		if len(value) > MaxLeadNameLength {
+			return fmt.Errorf("%s cannot exceed %d characters", field, MaxLeadNameLength)
+		}
+	}
+
+	for field, value := range map[string]string{"company": c.Company, "job_title": c.JobTitle, "phone": c.Phone} {
+		if len(value) > MaxLeadFieldLength {
+			return fmt.Errorf("%s cannot exceed %d characters", field, MaxLeadFieldLength)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("%s cannot contain line breaks", field)
+		}
+	}
+
+	if len(c.Message) > MaxLeadMessageLength {
+		return fmt.Errorf("message cannot exceed %d characters", MaxLeadMessageLength)
+	}
+
+	return nil
+}
+
+// CRMEventStatus represents where a CRM event is in delivery
+type CRMEventStatus string
+
+const (
+	CRMEventPending   CRMEventStatus = "pending"
+	CRMEventDelivered CRMEventStatus = "delivered"
+	// CRMEventFailed events gave up after a permanent error or too many attempts; an admin can retry them
+	CRMEventFailed CRMEventStatus = "failed"
+)
+
+// IsValid validates the CRM event status value
+func (s CRMEventStatus) IsValid() bool {
+	switch s {
+	case CRMEventPending, CRMEventDelivered, CRMEventFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// CRMEvent is a conversion queued for delivery to the CRM
+type CRMEvent struct {
+	ID            uuid.UUID      `json:"id"`
+	Connector     string         `json:"connector"`
+	Conversion    CRMConversion  `json:"conversion"`
+	UserID        *uuid.UUID     `json:"user_id,omitempty"` // nil for anonymous lead forms
+	Status        CRMEventStatus `json:"status"`
+	Attempts      int            `json:"attempts"`
+	LastError     *string        `json:"last_error,omitempty"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	DeliveredAt   *time.Time     `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// CRMEventFilter represents filtering options for CRM events
+type CRMEventFilter struct {
+	Status   *CRMEventStatus
+	Type     *ConversionType
+	Page     int
+	PageSize int
+}
+
+// Offset calculates the offset for pagination
+func (f *CRMEventFilter) Offset() int {
+	if f.Page < 1 {
+		return 0
+	}
+	return (f.Page - 1) * f.PageSize
+}
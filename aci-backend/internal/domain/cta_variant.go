@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CTA variant audit log actions
+const (
+	AuditActionCTAVariantCreated = "create_cta_variant"
+	AuditActionCTAVariantUpdated = "update_cta_variant"
+	AuditActionCTAVariantDeleted = "delete_cta_variant"
+)
+
+// CTA variant limits
+const (
+	MaxCTAVariantWeight     = 1000
+	MaxCTAVariantNameLength = 100
+)
+
+// CTAVariant is an alternative to a generated Armor CTA
+// Readers of an article whose CTA URL equals BaseURL are assigned one of the active
+// variants for that URL in proportion to their weights
+type CTAVariant struct {
+	ID        uuid.UUID  `json:"id"`
+	BaseURL   string     `json:"base_url"`
+	Name      string     `json:"name"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	URL       string     `json:"url"`
+	Weight    int        `json:"weight"`
+	IsActive  bool       `json:"is_active"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// CTAVariantStats reports a variant's impressions, clicks and click-through rate
+type CTAVariantStats struct {
+	VariantID   uuid.UUID `json:"variant_id"`
+	BaseURL     string    `json:"base_url"`
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	Weight      int       `json:"weight"`
+	IsActive    bool      `json:"is_active"`
+	Impressions int64     `json:"impressions"`
+	Clicks      int64     `json:"clicks"`
+	CTR         float64   `json:"ctr"`
+}
+
+// CTA returns the call-to-action served for the variant
+func (v *CTAVariant) CTA() *ArmorCTA {
+	id := v.ID
+	return &ArmorCTA{
+		Type:      v.Type,
+		Title:     v.Title,
+		URL:       v.URL,
+		VariantID: &id,
+	}
+}
+
+// Validate validates the variant's copy, URLs and weight
+func (v *CTAVariant) Validate() error {
+	v.Name = strings.TrimSpace(v.Name)
+	v.Title = strings.TrimSpace(v.Title)
+
+	if v.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(v.Name) > MaxCTAVariantNameLength {
+		return fmt.Errorf("name cannot exceed %d characters", MaxCTAVariantNameLength)
+	}
+
+	cta := ArmorCTA{Type: v.Type, Title: v.Title, URL: v.URL}
+	if !cta.IsValid() {
+		return fmt.Errorf("title, url and a type of product, service or consultation are required")
+	}
+
+	for field, value := range map[string]string{"base_url": v.BaseURL, "url": v.URL} {
+		parsed, err := url.Parse(value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%s must be an absolute http or https URL", field)
+		}
+	}
+
+	if v.Weight < 1 || v.Weight > MaxCTAVariantWeight {
+		return fmt.Errorf("weight must be between 1 and %d", MaxCTAVariantWeight)
+	}
+
+	return nil
+}
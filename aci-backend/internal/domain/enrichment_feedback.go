@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EnrichmentField names the part of an article's AI enrichment that feedback is about
+type EnrichmentField string
+
+const (
+	EnrichmentFieldThreatType         EnrichmentField = "threat_type"
+	EnrichmentFieldAttackVector       EnrichmentField = "attack_vector"
+	EnrichmentFieldImpactAssessment   EnrichmentField = "impact_assessment"
+	EnrichmentFieldRecommendedActions EnrichmentField = "recommended_actions"
+	EnrichmentFieldIOC                EnrichmentField = "ioc" // one indicator, given by IOCType and IOCValue
+	EnrichmentFieldArmorCTA           EnrichmentField = "armor_cta"
+)
+
+// EnrichmentFields lists every field feedback can be given on, in report order
+var EnrichmentFields = []EnrichmentField{
+	EnrichmentFieldThreatType,
+	EnrichmentFieldAttackVector,
+	EnrichmentFieldImpactAssessment,
+	EnrichmentFieldRecommendedActions,
+	EnrichmentFieldIOC,
+	EnrichmentFieldArmorCTA,
+}
+
+// IsValid checks if the enrichment field is valid
+func (f EnrichmentField) IsValid() bool {
+	for _, field := range EnrichmentFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxEnrichmentFeedbackComment bounds the length of a feedback comment
+const MaxEnrichmentFeedbackComment = 1000
+
+// Audit actions recorded for enrichment feedback
+const (
+	AuditActionEnrichmentFlagged   = "flag_enrichment"
+	AuditActionEnrichmentUnflagged = "unflag_enrichment"
+)
+
+// EnrichmentFeedback marks one field of an article's AI enrichment as wrong
+// EnrichedAt pins the feedback to the enrichment run it was given on, so re-enriching the
+// article does not move it to a later week of the accuracy report
+type EnrichmentFeedback struct {
+	ID         uuid.UUID       `json:"id"`
+	ArticleID  uuid.UUID       `json:"article_id"`
+	Field      EnrichmentField `json:"field"`
+	IOCType    *string         `json:"ioc_type,omitempty"`  // set only for ioc feedback
+	IOCValue   *string         `json:"ioc_value,omitempty"` // set only for ioc feedback
+	Comment    *string         `json:"comment,omitempty"`
+	EnrichedAt time.Time       `json:"enriched_at"`
+	CreatedBy  uuid.UUID       `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// Validate performs validation on the feedback
+func (f *EnrichmentFeedback) Validate() error {
+	if f.ArticleID == uuid.Nil {
+		return fmt.Errorf("article_id is required")
+	}
+
+	if !f.Field.IsValid() {
+		return fmt.Errorf("invalid field value")
+	}
+
+	if f.Field == EnrichmentFieldIOC {
+		if f.IOCType == nil || !IsValidIOCType(*f.IOCType) {
+			return fmt.Errorf("a valid ioc_type is required for ioc feedback")
+		}
+		if f.IOCValue == nil || *f.IOCValue == "" {
+			return fmt.Errorf("ioc_value is required for ioc feedback")
+		}
+	} else if f.IOCType != nil || f.IOCValue != nil {
+		return fmt.Errorf("ioc_type and ioc_value are only allowed for ioc feedback")
+	}
+
+	if f.Comment != nil && len(*f.Comment) > MaxEnrichmentFeedbackComment {
+		return fmt.Errorf("comment cannot exceed %d characters", MaxEnrichmentFeedbackComment)
+	}
+
+	return nil
+}
+
+// EnrichmentFeedbackFilter represents query parameters for listing feedback
+type EnrichmentFeedbackFilter struct {
+	ArticleID *uuid.UUID
+	Field     *EnrichmentField
+	Page      int
+	PageSize  int
+}
+
+// Validate validates the filter parameters
+func (f *EnrichmentFeedbackFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Field != nil && !f.Field.IsValid() {
+		return fmt.Errorf("invalid field value")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *EnrichmentFeedbackFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
+
+// EnrichmentAccuracyWeek counts enriched articles and the ones flagged wrong for one UTC week
+type EnrichmentAccuracyWeek struct {
+	WeekStart       time.Time               `json:"week_start"` // Monday 00:00 UTC
+	Enriched        int                     `json:"enriched"`
+	FlaggedArticles int                     `json:"flagged_articles"` // articles with feedback on any field
+	FlaggedByField  map[EnrichmentField]int `json:"flagged_by_field"` // articles with feedback on each field
+	Accuracy        float64                 `json:"accuracy"`         // share of enriched articles with no feedback
+}
+
+// EnrichmentAccuracyReport tracks how often enrichment output is flagged, week by week,
+// so the effect of prompt and model changes can be compared
+type EnrichmentAccuracyReport struct {
+	Weeks           []*EnrichmentAccuracyWeek `json:"weeks"`
+	Enriched        int                       `json:"enriched"`
+	FlaggedArticles int                       `json:"flagged_articles"`
+	Accuracy        float64                   `json:"accuracy"`
+	GeneratedAt     time.Time                 `json:"generated_at"`
+}
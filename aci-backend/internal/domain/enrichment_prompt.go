@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EnrichmentPromptKey names one of the enricher's prompts
+type EnrichmentPromptKey string
+
+const (
+	EnrichmentPromptThreatAnalysis      EnrichmentPromptKey = "threat_analysis"       // one article
+	EnrichmentPromptBatchThreatAnalysis EnrichmentPromptKey = "batch_threat_analysis" // several short articles in one call
+	EnrichmentPromptArmorCTA            EnrichmentPromptKey = "armor_cta"
+)
+
+// EnrichmentPromptKeys lists every prompt key, in display order
+var EnrichmentPromptKeys = []EnrichmentPromptKey{
+	EnrichmentPromptThreatAnalysis,
+	EnrichmentPromptBatchThreatAnalysis,
+	EnrichmentPromptArmorCTA,
+}
+
+// IsValid checks if the prompt key is valid
+func (k EnrichmentPromptKey) IsValid() bool {
+	for _, key := range EnrichmentPromptKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// MaxEnrichmentPromptLength bounds the size of a system prompt
+	MaxEnrichmentPromptLength = 20000
+
+	// MaxEnrichmentPromptTrialArticles bounds the sample a draft prompt is tried on
+	MaxEnrichmentPromptTrialArticles = 20
+)
+
+// Audit actions recorded for enrichment prompt changes
+const (
+	AuditActionEnrichmentPromptCreated   = "create_enrichment_prompt"
+	AuditActionEnrichmentPromptActivated = "activate_enrichment_prompt"
+	AuditActionEnrichmentPromptDeleted   = "delete_enrichment_prompt"
+)
+
+// EnrichmentPrompt is one version of an enricher system prompt
+// New versions are drafts; at most one version per key is active, and a key without an
+// active version uses the prompt built into the enricher
+type EnrichmentPrompt struct {
+	ID           uuid.UUID           `json:"id"`
+	Key          EnrichmentPromptKey `json:"key"`
+	Version      int                 `json:"version"`
+	SystemPrompt string              `json:"system_prompt"`
+	Model        *string             `json:"model,omitempty"` // nil runs on the configured AI_MODEL
+	Notes        *string             `json:"notes,omitempty"`
+	IsActive     bool                `json:"is_active"`
+	ActivatedAt  *time.Time          `json:"activated_at,omitempty"` // last activation; nil for a draft never used
+	CreatedBy    *uuid.UUID          `json:"created_by,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+}
+
+// Validate performs validation on the prompt
+func (p *EnrichmentPrompt) Validate() error {
+	if !p.Key.IsValid() {
+		return fmt.Errorf("invalid key: %s", p.Key)
+	}
+
+	if strings.TrimSpace(p.SystemPrompt) == "" {
+		return fmt.Errorf("system_prompt is required")
+	}
+
+	if len(p.SystemPrompt) > MaxEnrichmentPromptLength {
+		return fmt.Errorf("system_prompt cannot exceed %d characters", MaxEnrichmentPromptLength)
+	}
+
+	if p.Model != nil && (*p.Model == "" || len(*p.Model) > 100) {
+		return fmt.Errorf("model must be between 1 and 100 characters")
+	}
+
+	if p.Notes != nil && len(*p.Notes) > 1000 {
+		return fmt.Errorf("notes cannot exceed 1000 characters")
+	}
+
+	return nil
+}
+
+// EnrichmentPromptOverview is the state of one prompt key for the admin prompt list
+type EnrichmentPromptOverview struct {
+	Key           EnrichmentPromptKey `json:"key"`
+	Active        *EnrichmentPrompt   `json:"active"` // nil when the built-in prompt is in use
+	Latest        *EnrichmentPrompt   `json:"latest"` // newest version, often a draft
+	BuiltInPrompt string              `json:"builtin_system_prompt"`
+}
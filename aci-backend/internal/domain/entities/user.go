@@ -10,8 +10,9 @@ import (
 type UserRole string
 
 const (
-	RoleUser  UserRole = "user"
-	RoleAdmin UserRole = "admin"
+	RoleUser    UserRole = "user"
+	RoleAdmin   UserRole = "admin"
+	RoleAnalyst UserRole = "analyst"
 )
 
 // SubscriptionTier represents user subscription levels
@@ -23,6 +24,26 @@ const (
 	SubscriptionEnterprise SubscriptionTier = "enterprise"
 )
 
+// UserStatus represents whether a user may sign in
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusBanned    UserStatus = "banned"
+	UserStatusInvited   UserStatus = "invited" // created by an admin; active once the invitation is accepted
+)
+
+// IsValid checks if the user status is valid
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusSuspended, UserStatusBanned, UserStatusInvited:
+		return true
+	default:
+		return false
+	}
+}
+
 // User represents a user in the system
 type User struct {
 	ID               uuid.UUID
@@ -35,6 +56,11 @@ type User struct {
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	LastLoginAt      *time.Time
+
+	// Status is active unless an admin suspended or banned the account or it awaits an invitation
+	Status         UserStatus
+	StatusReason   *string
+	SuspendedUntil *time.Time // nil suspends until an admin lifts it
 }
 
 // NewUser creates a new user with default values
@@ -48,6 +74,7 @@ func NewUser(email, passwordHash, name string) *User {
 		Role:             RoleUser,
 		SubscriptionTier: SubscriptionFree,
 		EmailVerified:    false,
+		Status:           UserStatusActive,
 		CreatedAt:        now,
 		UpdatedAt:        now,
 	}
@@ -69,3 +96,19 @@ func (u *User) UpdateLastLogin() {
 	now := time.Now()
 	u.LastLoginAt = &now
 }
+
+// EffectiveStatus returns the user's status at the given time; a suspension past its end is active
+func (u *User) EffectiveStatus(now time.Time) UserStatus {
+	if u.Status == UserStatusSuspended && u.SuspendedUntil != nil && !now.Before(*u.SuspendedUntil) {
+		return UserStatusActive
+	}
+	if u.Status == "" {
+		return UserStatusActive
+	}
+	return u.Status
+}
+
+// IsBlocked checks if the user is suspended, banned or not yet active at the given time
+func (u *User) IsBlocked(now time.Time) bool {
+	return u.EffectiveStatus(now) != UserStatusActive
+}
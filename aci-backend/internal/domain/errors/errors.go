@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Domain errors - clean, semantic error types for business logic
 
@@ -43,3 +46,31 @@ type ConflictError struct {
 func (e *ConflictError) Error() string {
 	return fmt.Sprintf("%s already exists with %s: %s", e.Resource, e.Field, e.Value)
 }
+
+// LimitExceededError reports that adding to a resource would go past its quota
+type LimitExceededError struct {
+	Resource string
+	Limit    int
+	Message  string
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s limit of %d reached", e.Resource, e.Limit)
+}
+
+// AccountBlockedError reports that a suspended or banned account tried to authenticate
+type AccountBlockedError struct {
+	Status string // suspended or banned
+	Reason string
+	Until  *time.Time // nil when the block has no end
+}
+
+func (e *AccountBlockedError) Error() string {
+	if e.Until != nil {
+		return fmt.Sprintf("account %s until %s", e.Status, e.Until.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("account %s", e.Status)
+}
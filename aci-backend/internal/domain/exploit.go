@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// ExploitSource is a public feed of exploit code
+type ExploitSource string
+
+const (
+	ExploitSourceExploitDB ExploitSource = "exploitdb"
+	ExploitSourceGitHub    ExploitSource = "github"
+)
+
+// IsValid validates the exploit source value
+func (s ExploitSource) IsValid() bool {
+	switch s {
+	case ExploitSourceExploitDB, ExploitSourceGitHub:
+		return true
+	default:
+		return false
+	}
+}
+
+// PublicExploit is a published exploit or proof of concept for a CVE
+type PublicExploit struct {
+	CVEID       string        `json:"cve_id"`
+	Source      ExploitSource `json:"source"`
+	URL         string        `json:"url"`
+	Title       string        `json:"title"`
+	PublishedAt *time.Time    `json:"published_at,omitempty"`
+	SyncedAt    time.Time     `json:"synced_at"`
+}
+
+// ExploitSourceStatus describes the local copy of one exploit source
+type ExploitSourceStatus struct {
+	Source       ExploitSource `json:"source"`
+	Count        int           `json:"count"`
+	CVECount     int           `json:"cve_count"`
+	LastSyncedAt *time.Time    `json:"last_synced_at,omitempty"` // nil until the first sync
+}
+
+// ExploitSyncResult reports one sync of an exploit source
+type ExploitSyncResult struct {
+	Source      ExploitSource `json:"source"`
+	CVEsChecked int           `json:"cves_checked,omitempty"` // sources queried per CVE only
+	Count       int           `json:"count"`
+	Removed     int           `json:"removed"` // exploits no longer listed by the source
+	SyncedAt    time.Time     `json:"synced_at"`
+}
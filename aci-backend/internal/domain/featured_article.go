@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Featured article limits
+const (
+	MaxFeaturedArticles  = 50 // size of the managed featured set
+	DefaultFeaturedLimit = 5  // carousel slides returned by default
+	MaxFeaturedLimit     = 20
+)
+
+// FeaturedArticle places an article in the homepage hero carousel
+// Lower positions are shown first; an unset schedule bound is open-ended
+type FeaturedArticle struct {
+	ArticleID    uuid.UUID  `json:"article_id"`
+	Position     int        `json:"position"`
+	FeatureFrom  *time.Time `json:"feature_from,omitempty"`
+	FeatureUntil *time.Time `json:"feature_until,omitempty"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Active       bool       `json:"active"`            // inside its schedule now
+	Article      *Article   `json:"article,omitempty"` // populated when listing the featured set
+}
+
+// Validate performs validation on the FeaturedArticle
+func (f *FeaturedArticle) Validate() error {
+	if f.ArticleID == uuid.Nil {
+		return fmt.Errorf("article_id is required")
+	}
+
+	if f.Position < 0 {
+		return fmt.Errorf("position cannot be negative")
+	}
+
+	if f.FeatureFrom != nil && f.FeatureUntil != nil && !f.FeatureUntil.After(*f.FeatureFrom) {
+		return fmt.Errorf("feature_until must be after feature_from")
+	}
+
+	return nil
+}
+
+// IsActive reports whether the article is scheduled to be featured at the given time
+func (f *FeaturedArticle) IsActive(at time.Time) bool {
+	if f.FeatureFrom != nil && at.Before(*f.FeatureFrom) {
+		return false
+	}
+
+	if f.FeatureUntil != nil && !at.Before(*f.FeatureUntil) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxFeedPreferenceItems caps each list in a user's feed preferences
+const maxFeedPreferenceItems = 50
+
+// FeedPreferences are the default filters applied to a user's article feed
+type FeedPreferences struct {
+	UserID              uuid.UUID   `json:"user_id"`
+	PreferredCategories []uuid.UUID `json:"preferred_categories"`
+	PreferredVendors    []string    `json:"preferred_vendors"`
+	MinSeverity         Severity    `json:"min_severity,omitempty"`
+	ExcludedTags        []string    `json:"excluded_tags"`
+	UpdatedAt           *time.Time  `json:"updated_at,omitempty"` // nil until the user saves preferences
+}
+
+// DefaultFeedPreferences returns the preferences of a user who has not saved any; the feed is unfiltered
+func DefaultFeedPreferences(userID uuid.UUID) *FeedPreferences {
+	return &FeedPreferences{
+		UserID:              userID,
+		PreferredCategories: []uuid.UUID{},
+		PreferredVendors:    []string{},
+		ExcludedTags:        []string{},
+	}
+}
+
+// Normalize trims and de-duplicates the preference lists
+func (p *FeedPreferences) Normalize() {
+	seen := make(map[uuid.UUID]bool, len(p.PreferredCategories))
+	categories := make([]uuid.UUID, 0, len(p.PreferredCategories))
+	for _, id := range p.PreferredCategories {
+		if id != uuid.Nil && !seen[id] {
+			seen[id] = true
+			categories = append(categories, id)
+		}
+	}
+	p.PreferredCategories = categories
+
+	p.PreferredVendors = normalizeTerms(p.PreferredVendors)
+	p.ExcludedTags = normalizeTerms(p.ExcludedTags)
+}
+
+// Validate validates the feed preferences
+func (p *FeedPreferences) Validate() error {
+	if p.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if p.MinSeverity != "" && !p.MinSeverity.IsValid() {
+		return fmt.Errorf("invalid min_severity: %s", p.MinSeverity)
+	}
+
+	if len(p.PreferredCategories) > maxFeedPreferenceItems {
+		return fmt.Errorf("preferred_categories cannot exceed %d entries", maxFeedPreferenceItems)
+	}
+
+	if len(p.PreferredVendors) > maxFeedPreferenceItems {
+		return fmt.Errorf("preferred_vendors cannot exceed %d entries", maxFeedPreferenceItems)
+	}
+
+	if len(p.ExcludedTags) > maxFeedPreferenceItems {
+		return fmt.Errorf("excluded_tags cannot exceed %d entries", maxFeedPreferenceItems)
+	}
+
+	return nil
+}
+
+// ApplyTo narrows an article filter to the preferences
+// Preferred categories and vendors widen each other: an article matching either is shown
+func (p *FeedPreferences) ApplyTo(filter *ArticleFilter) {
+	filter.InterestCategoryIDs = p.PreferredCategories
+	filter.InterestVendors = p.PreferredVendors
+	filter.ExcludeTags = p.ExcludedTags
+
+	if p.MinSeverity != "" {
+		minSeverity := p.MinSeverity
+		filter.MinSeverity = &minSeverity
+	}
+}
+
+// SeveritiesAtLeast returns the severities at least as severe as min
+func SeveritiesAtLeast(min Severity) []Severity {
+	severities := make([]Severity, 0, len(severityRank))
+	for _, severity := range []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityInformational} {
+		if severity.AtLeast(min) {
+			severities = append(severities, severity)
+		}
+	}
+	return severities
+}
+
+// normalizeTerms trims terms and drops blanks and case-insensitive duplicates
+func normalizeTerms(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	normalized := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, term)
+	}
+	return normalized
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IncidentStatus represents whether an incident is still developing
+type IncidentStatus string
+
+const (
+	IncidentStatusOngoing  IncidentStatus = "ongoing"
+	IncidentStatusResolved IncidentStatus = "resolved"
+)
+
+// IsValid validates the incident status value
+func (s IncidentStatus) IsValid() bool {
+	switch s {
+	case IncidentStatusOngoing, IncidentStatusResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Incident groups the articles covering the same breach or campaign
+type Incident struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	// Summary is written by an editor; when empty, timelines merge one from the articles
+	Summary   *string        `json:"summary,omitempty"`
+	Status    IncidentStatus `json:"status"`
+	CreatedBy *uuid.UUID     `json:"created_by,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+
+	// Aggregated over the incident's published articles (populated on query)
+	ArticleCount     int        `json:"article_count"`
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// Validate performs validation on the Incident
+func (i *Incident) Validate() error {
+	if strings.TrimSpace(i.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+
+	if len(i.Title) > 255 {
+		return fmt.Errorf("title cannot exceed 255 characters")
+	}
+
+	if i.Summary != nil && len(*i.Summary) > 5000 {
+		return fmt.Errorf("summary cannot exceed 5000 characters")
+	}
+
+	if !i.Status.IsValid() {
+		return fmt.Errorf("status must be ongoing or resolved")
+	}
+
+	return nil
+}
+
+// IncidentSummary merges what the incident's articles report
+type IncidentSummary struct {
+	// Text is the editor's summary, or else one line per article in chronological order
+	Text             string     `json:"text"`
+	Severity         Severity   `json:"severity"` // highest severity among the articles
+	CVEs             []string   `json:"cves"`
+	Vendors          []string   `json:"vendors"`
+	KEV              bool       `json:"kev"`
+	ExploitAvailable bool       `json:"exploit_available"`
+	FirstReportedAt  *time.Time `json:"first_reported_at,omitempty"`
+	LastUpdatedAt    *time.Time `json:"last_updated_at,omitempty"`
+	ArticleCount     int        `json:"article_count"`
+}
+
+// IncidentTimeline is an incident with its published articles, oldest first, and their merged summary
+type IncidentTimeline struct {
+	Incident *Incident
+	Summary  IncidentSummary
+	Articles []*Article
+}
+
+// IncidentSuggestionReason explains why an article was suggested for an incident
+type IncidentSuggestionReason string
+
+const (
+	// IncidentSuggestionSharedCVE articles mention a CVE that an incident article mentions
+	IncidentSuggestionSharedCVE IncidentSuggestionReason = "shared_cve"
+	// IncidentSuggestionSimilarTitle articles have a title similar to the incident title
+	IncidentSuggestionSimilarTitle IncidentSuggestionReason = "similar_title"
+)
+
+// IncidentSuggestion is an article that may cover the incident but is not linked to it
+type IncidentSuggestion struct {
+	Article *Article
+	Reasons []IncidentSuggestionReason
+}
+
+// IncidentFilter represents query parameters for listing incidents
+type IncidentFilter struct {
+	Status   *IncidentStatus
+	Page     int
+	PageSize int
+}
+
+// NewIncidentFilter returns a filter with default values
+func NewIncidentFilter() *IncidentFilter {
+	return &IncidentFilter{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+// Validate validates the filter parameters
+func (f *IncidentFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Status != nil && !f.Status.IsValid() {
+		return fmt.Errorf("status must be ongoing or resolved")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *IncidentFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
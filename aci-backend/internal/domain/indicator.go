@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxIndicatorExport bounds the number of indicators in a single export
+const MaxIndicatorExport = 10000
+
+// Indicator is a distinct IOC, aggregated across the articles that mention it
+type Indicator struct {
+	ID           uuid.UUID `json:"id"`
+	Type         string    `json:"type"`
+	Value        string    `json:"value"`
+	FirstSeen    time.Time `json:"first_seen"` // earliest published_at of a mentioning article
+	LastSeen     time.Time `json:"last_seen"`  // latest published_at of a mentioning article
+	ArticleCount int       `json:"article_count"`
+	MaxSeverity  Severity  `json:"max_severity"`
+}
+
+// IndicatorFilter represents query parameters for searching indicators
+// Severity restricts the mentioning articles, so first/last seen and counts reflect only those articles
+type IndicatorFilter struct {
+	Type          *string
+	ValuePrefix   *string
+	Severity      *Severity
+	FirstSeenFrom *time.Time
+	FirstSeenTo   *time.Time
+	LastSeenFrom  *time.Time
+	LastSeenTo    *time.Time
+	// ExcludeFlagged leaves out indicators an analyst flagged as bogus in enrichment feedback
+	ExcludeFlagged bool
+	Page           int
+	PageSize       int
+}
+
+// NewIndicatorFilter returns a filter with default values
+func NewIndicatorFilter() *IndicatorFilter {
+	return &IndicatorFilter{
+		Page:     1,
+		PageSize: 50,
+	}
+}
+
+// Validate validates the filter parameters
+func (f *IndicatorFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Type != nil && !IsValidIOCType(*f.Type) {
+		return fmt.Errorf("invalid type value")
+	}
+
+	if f.Severity != nil && !f.Severity.IsValid() {
+		return fmt.Errorf("invalid severity value")
+	}
+
+	if f.FirstSeenFrom != nil && f.FirstSeenTo != nil && f.FirstSeenFrom.After(*f.FirstSeenTo) {
+		return fmt.Errorf("first_seen_from cannot be after first_seen_to")
+	}
+
+	if f.LastSeenFrom != nil && f.LastSeenTo != nil && f.LastSeenFrom.After(*f.LastSeenTo) {
+		return fmt.Errorf("last_seen_from cannot be after last_seen_to")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *IndicatorFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IngestStage represents a stage of the article ingest pipeline
+type IngestStage string
+
+const (
+	IngestStageReceived  IngestStage = "received"
+	IngestStageValidated IngestStage = "validated"
+	IngestStagePersisted IngestStage = "persisted"
+	IngestStageEnriched  IngestStage = "enriched"
+	IngestStageNotified  IngestStage = "notified"
+)
+
+// IngestStages returns the pipeline stages in order
+func IngestStages() []IngestStage {
+	return []IngestStage{
+		IngestStageReceived,
+		IngestStageValidated,
+		IngestStagePersisted,
+		IngestStageEnriched,
+		IngestStageNotified,
+	}
+}
+
+// IsValid checks if the ingest stage is valid
+func (s IngestStage) IsValid() bool {
+	switch s {
+	case IngestStageReceived, IngestStageValidated, IngestStagePersisted, IngestStageEnriched, IngestStageNotified:
+		return true
+	default:
+		return false
+	}
+}
+
+// IngestTiming records when an article reached each pipeline stage
+type IngestTiming struct {
+	ArticleID   uuid.UUID  `json:"article_id"`
+	ReceivedAt  *time.Time `json:"received_at,omitempty"`
+	ValidatedAt *time.Time `json:"validated_at,omitempty"`
+	PersistedAt *time.Time `json:"persisted_at,omitempty"`
+	EnrichedAt  *time.Time `json:"enriched_at,omitempty"`
+	NotifiedAt  *time.Time `json:"notified_at,omitempty"`
+}
+
+// EndToEnd returns the received-to-notified latency, if both stages were recorded
+func (t *IngestTiming) EndToEnd() (time.Duration, bool) {
+	if t.ReceivedAt == nil || t.NotifiedAt == nil {
+		return 0, false
+	}
+	return t.NotifiedAt.Sub(*t.ReceivedAt), true
+}
+
+// LatencyPercentiles holds latency percentiles in milliseconds
+type LatencyPercentiles struct {
+	P50Ms float64 `json:"p50_ms"`
+	P95Ms float64 `json:"p95_ms"`
+}
+
+// IngestLatencyStats is the raw latency aggregate for a reporting window
+// Stages maps each stage to the latency from the previous stage
+type IngestLatencyStats struct {
+	SampleCount   int                                `json:"sample_count"`
+	BreachedCount int                                `json:"breached_count"`
+	EndToEnd      LatencyPercentiles                 `json:"end_to_end"`
+	Stages        map[IngestStage]LatencyPercentiles `json:"stages"`
+}
+
+// IngestSLOReport summarizes ingest-to-notify latency against the SLO
+// Objective is the fraction of articles that must be notified within the budget
+// AlertingSince is when the background monitor last found the budget exhausted over its own
+// window, and is nil while it is not alerting
+type IngestSLOReport struct {
+	IngestLatencyStats
+	Window         string     `json:"window"`
+	BudgetMs       int64      `json:"budget_ms"`
+	Objective      float64    `json:"objective"`
+	Compliance     float64    `json:"compliance"`
+	BudgetBreached bool       `json:"budget_breached"`
+	AlertingSince  *time.Time `json:"alerting_since,omitempty"`
+	GeneratedAt    time.Time  `json:"generated_at"`
+}
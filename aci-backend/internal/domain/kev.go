@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// KEVVulnerability is an entry in the CISA Known Exploited Vulnerabilities catalog
+type KEVVulnerability struct {
+	CVEID              string     `json:"cve_id"`
+	VendorProject      string     `json:"vendor_project"`
+	Product            string     `json:"product"`
+	VulnerabilityName  string     `json:"vulnerability_name"`
+	ShortDescription   string     `json:"short_description"`
+	RequiredAction     string     `json:"required_action"`
+	DateAdded          time.Time  `json:"date_added"`
+	DueDate            *time.Time `json:"due_date,omitempty"` // remediation deadline for US federal agencies
+	KnownRansomwareUse bool       `json:"known_ransomware_use"`
+	Notes              string     `json:"notes,omitempty"`
+	SyncedAt           time.Time  `json:"synced_at"`
+}
+
+// KEVCatalogStatus describes the local copy of the KEV catalog
+type KEVCatalogStatus struct {
+	Count           int        `json:"count"`
+	LatestDateAdded *time.Time `json:"latest_date_added,omitempty"`
+	LastSyncedAt    *time.Time `json:"last_synced_at,omitempty"` // nil until the first sync
+}
+
+// KEVSyncResult reports one sync of the KEV catalog
+type KEVSyncResult struct {
+	CatalogVersion string    `json:"catalog_version"`
+	Count          int       `json:"count"`
+	Removed        int       `json:"removed"` // entries no longer in the catalog
+	SyncedAt       time.Time `json:"synced_at"`
+}
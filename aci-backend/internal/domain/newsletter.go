@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNewsletterArticles caps how many articles one newsletter can feature
+const MaxNewsletterArticles = 20
+
+// SubscriberStatus represents whether a newsletter subscriber still receives newsletters
+type SubscriberStatus string
+
+const (
+	// SubscriberStatusPending is a public sign-up whose address has not been confirmed yet
+	SubscriberStatusPending      SubscriberStatus = "pending"
+	SubscriberStatusSubscribed   SubscriberStatus = "subscribed"
+	SubscriberStatusUnsubscribed SubscriberStatus = "unsubscribed"
+)
+
+// IsValid validates the subscriber status value
+func (s SubscriberStatus) IsValid() bool {
+	switch s {
+	case SubscriberStatusPending, SubscriberStatusSubscribed, SubscriberStatusUnsubscribed:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewsletterSubscriber is a recipient who opted in to the newsletter
+// Subscribers are not user accounts: anyone can sign up with just an email address
+type NewsletterSubscriber struct {
+	ID     uuid.UUID        `json:"id"`
+	Email  string           `json:"email"`
+	Name   *string          `json:"name,omitempty"`
+	Status SubscriberStatus `json:"status"`
+	// ConsentSource records how the subscriber opted in, e.g. signup-form
+	ConsentSource  string     `json:"consent_source"`
+	SubscribedAt   time.Time  `json:"subscribed_at"`
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty"`
+	// Double opt-in: when the last confirmation email was sent and when the address was confirmed;
+	// both are nil for subscribers added by admins
+	ConfirmationSentAt *time.Time `json:"confirmation_sent_at,omitempty"`
+	ConfirmedAt        *time.Time `json:"confirmed_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+
+	// Suppressed is set when the address is on the suppression list (populated on query)
+	Suppressed bool `json:"suppressed"`
+}
+
+// Validate performs validation on the NewsletterSubscriber
+func (s *NewsletterSubscriber) Validate() error {
+	if s.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	if len(s.Email) > 255 {
+		return fmt.Errorf("email cannot exceed 255 characters")
+	}
+
+	if addr, err := mail.ParseAddress(s.Email); err != nil || addr.Address != s.Email {
+		return fmt.Errorf("email must be a valid address")
+	}
+
+	if s.Name != nil && len(*s.Name) > 255 {
+		return fmt.Errorf("name cannot exceed 255 characters")
+	}
+
+	if strings.TrimSpace(s.ConsentSource) == "" {
+		return fmt.Errorf("consent_source is required")
+	}
+
+	if len(s.ConsentSource) > 100 {
+		return fmt.Errorf("consent_source cannot exceed 100 characters")
+	}
+
+	if !s.Status.IsValid() {
+		return fmt.Errorf("status must be pending, subscribed or unsubscribed")
+	}
+
+	return nil
+}
+
+// SubscriberFilter represents filtering options for newsletter subscribers
+type SubscriberFilter struct {
+	Status   *SubscriberStatus
+	Search   *string // matches email or name
+	Page     int
+	PageSize int
+}
+
+// Offset calculates the offset for pagination
+func (f *SubscriberFilter) Offset() int {
+	if f.Page < 1 {
+		return 0
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// SuppressionReason represents why an address is never mailed
+type SuppressionReason string
+
+const (
+	SuppressionReasonBounce    SuppressionReason = "bounce"
+	SuppressionReasonComplaint SuppressionReason = "complaint"
+	SuppressionReasonManual    SuppressionReason = "manual"
+)
+
+// IsValid validates the suppression reason value
+func (r SuppressionReason) IsValid() bool {
+	switch r {
+	case SuppressionReasonBounce, SuppressionReasonComplaint, SuppressionReasonManual:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewsletterSuppression is an address that is never mailed, whether or not it is subscribed
+type NewsletterSuppression struct {
+	ID     uuid.UUID         `json:"id"`
+	Email  string            `json:"email"`
+	Reason SuppressionReason `json:"reason"`
+	// Details holds the relay's rejection for bounces, or a note for manual entries
+	Details   *string    `json:"details,omitempty"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"` // nil when suppressed automatically
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Validate performs validation on the NewsletterSuppression
+func (s *NewsletterSuppression) Validate() error {
+	if s.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	if len(s.Email) > 255 {
+		return fmt.Errorf("email cannot exceed 255 characters")
+	}
+
+	if addr, err := mail.ParseAddress(s.Email); err != nil || addr.Address != s.Email {
+		return fmt.Errorf("email must be a valid address")
+	}
+
+	if !s.Reason.IsValid() {
+		return fmt.Errorf("reason must be bounce, complaint or manual")
+	}
+
+	if s.Details != nil && len(*s.Details) > 2000 {
+		return fmt.Errorf("details cannot exceed 2000 characters")
+	}
+
+	return nil
+}
+
+// CampaignStatus represents where a newsletter campaign is in its lifecycle
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft   CampaignStatus = "draft"
+	CampaignStatusSending CampaignStatus = "sending"
+	CampaignStatusSent    CampaignStatus = "sent"
+)
+
+// IsValid validates the campaign status value
+func (s CampaignStatus) IsValid() bool {
+	switch s {
+	case CampaignStatusDraft, CampaignStatusSending, CampaignStatusSent:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewsletterCampaign is a newsletter composed from selected articles
+// Only drafts can be edited or deleted; sent campaigns are kept for history
+type NewsletterCampaign struct {
+	ID        uuid.UUID `json:"id"`
+	Subject   string    `json:"subject"`
+	Preheader *string   `json:"preheader,omitempty"` // preview text shown after the subject in inboxes
+	Intro     *string   `json:"intro,omitempty"`
+	Template  string    `json:"template"`
+	// ArticleIDs lists the featured articles in the order they appear
+	ArticleIDs    []uuid.UUID    `json:"article_ids"`
+	Status        CampaignStatus `json:"status"`
+	CreatedBy     *uuid.UUID     `json:"created_by,omitempty"`
+	SentBy        *uuid.UUID     `json:"sent_by,omitempty"`
+	SendStartedAt *time.Time     `json:"send_started_at,omitempty"`
+	SentAt        *time.Time     `json:"sent_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// Delivery counts (populated on query)
+	Stats *CampaignStats `json:"stats,omitempty"`
+}
+
+// Validate performs validation on the NewsletterCampaign
+func (c *NewsletterCampaign) Validate() error {
+	if strings.TrimSpace(c.Subject) == "" {
+		return fmt.Errorf("subject is required")
+	}
+
+	if len(c.Subject) > 200 {
+		return fmt.Errorf("subject cannot exceed 200 characters")
+	}
+
+	if c.Preheader != nil && len(*c.Preheader) > 200 {
+		return fmt.Errorf("preheader cannot exceed 200 characters")
+	}
+
+	if c.Intro != nil && len(*c.Intro) > 5000 {
+		return fmt.Errorf("intro cannot exceed 5000 characters")
+	}
+
+	if c.Template == "" {
+		return fmt.Errorf("template is required")
+	}
+
+	if len(c.ArticleIDs) > MaxNewsletterArticles {
+		return fmt.Errorf("cannot feature more than %d articles", MaxNewsletterArticles)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(c.ArticleIDs))
+	for _, id := range c.ArticleIDs {
+		if id == uuid.Nil {
+			return fmt.Errorf("article_ids cannot contain a nil ID")
+		}
+		if seen[id] {
+			return fmt.Errorf("article %s is featured more than once", id)
+		}
+		seen[id] = true
+	}
+
+	if !c.Status.IsValid() {
+		return fmt.Errorf("status must be draft, sending or sent")
+	}
+
+	return nil
+}
+
+// CampaignStats counts a campaign's deliveries and engagement
+type CampaignStats struct {
+	Recipients int `json:"recipients"`
+	Pending    int `json:"pending"`
+	Sent       int `json:"sent"`
+	Failed     int `json:"failed"`
+	// Opens and Clicks count unique recipients
+	Opens  int `json:"opens"`
+	Clicks int `json:"clicks"`
+	// TotalClicks counts every click, including repeats by the same recipient
+	TotalClicks int `json:"total_clicks"`
+}
+
+// DeliveryStatus represents whether a newsletter was sent to a recipient
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSending DeliveryStatus = "sending"
+	DeliveryStatusSent    DeliveryStatus = "sent"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+)
+
+// NewsletterDelivery is one campaign recipient; its ID is the token in the recipient's tracking links
+type NewsletterDelivery struct {
+	ID           uuid.UUID      `json:"id"`
+	CampaignID   uuid.UUID      `json:"campaign_id"`
+	SubscriberID uuid.UUID      `json:"subscriber_id"`
+	Email        string         `json:"email"`
+	Name         *string        `json:"name,omitempty"`
+	Status       DeliveryStatus `json:"status"`
+	Error        *string        `json:"error,omitempty"`
+	SentAt       *time.Time     `json:"sent_at,omitempty"`
+}
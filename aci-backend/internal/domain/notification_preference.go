@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationChannelWebSocket is realtime delivery to connected clients
+// It is a preference channel only; templates are not rendered for it
+const NotificationChannelWebSocket NotificationChannel = "websocket"
+
+// PreferenceChannels are the delivery channels a user can configure
+func PreferenceChannels() []NotificationChannel {
+	return []NotificationChannel{NotificationChannelWebSocket, NotificationChannelEmail, NotificationChannelSlack}
+}
+
+// IsPreferenceChannel reports whether users can configure the channel
+func (c NotificationChannel) IsPreferenceChannel() bool {
+	switch c {
+	case NotificationChannelWebSocket, NotificationChannelEmail, NotificationChannelSlack:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationEvent is a type of event users can be notified about
+type NotificationEvent string
+
+const (
+	NotificationEventArticleNew     NotificationEvent = "article.new"
+	NotificationEventArticleUpdated NotificationEvent = "article.updated"
+	NotificationEventAlertMatch     NotificationEvent = "alert.match"
+	NotificationEventAnnotation     NotificationEvent = "annotation.mention"
+)
+
+// IsValid checks if the notification event is valid
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationEventArticleNew, NotificationEventArticleUpdated, NotificationEventAlertMatch, NotificationEventAnnotation:
+		return true
+	default:
+		return false
+	}
+}
+
+// DigestFrequency controls how email and Slack notifications are batched
+type DigestFrequency string
+
+const (
+	DigestRealtime DigestFrequency = "realtime"
+	DigestHourly   DigestFrequency = "hourly"
+	DigestDaily    DigestFrequency = "daily"
+	DigestWeekly   DigestFrequency = "weekly"
+	DigestNever    DigestFrequency = "never"
+)
+
+// IsValid checks if the digest frequency is valid
+func (f DigestFrequency) IsValid() bool {
+	switch f {
+	case DigestRealtime, DigestHourly, DigestDaily, DigestWeekly, DigestNever:
+		return true
+	default:
+		return false
+	}
+}
+
+// severityRank orders severities from least to most severe
+var severityRank = map[Severity]int{
+	SeverityInformational: 1,
+	SeverityLow:           2,
+	SeverityMedium:        3,
+	SeverityHigh:          4,
+	SeverityCritical:      5,
+}
+
+// AtLeast reports whether s is at least as severe as min
+func (s Severity) AtLeast(min Severity) bool {
+	return severityRank[s] >= severityRank[min]
+}
+
+// ChannelPreference configures delivery on one channel
+type ChannelPreference struct {
+	Enabled     bool                       `json:"enabled"`
+	Events      map[NotificationEvent]bool `json:"events"`       // events not listed are delivered
+	MinSeverity Severity                   `json:"min_severity"` // least severe article delivered
+}
+
+// NotificationPreferences is a user's notification settings
+type NotificationPreferences struct {
+	UserID          uuid.UUID                                 `json:"user_id"`
+	Channels        map[NotificationChannel]ChannelPreference `json:"channels"`
+	DigestFrequency DigestFrequency                           `json:"digest_frequency"`
+	UpdatedAt       *time.Time                                `json:"updated_at,omitempty"` // nil until the user saves preferences
+}
+
+// DefaultNotificationPreferences returns the preferences of a user who has not saved any
+// Realtime and email notifications are on for every event; Slack is opt-in
+func DefaultNotificationPreferences(userID uuid.UUID) *NotificationPreferences {
+	prefs := &NotificationPreferences{
+		UserID:          userID,
+		Channels:        make(map[NotificationChannel]ChannelPreference),
+		DigestFrequency: DigestDaily,
+	}
+
+	for _, channel := range PreferenceChannels() {
+		prefs.Channels[channel] = ChannelPreference{
+			Enabled:     channel != NotificationChannelSlack,
+			Events:      map[NotificationEvent]bool{},
+			MinSeverity: SeverityInformational,
+		}
+	}
+
+	return prefs
+}
+
+// Validate validates the notification preferences
+func (p *NotificationPreferences) Validate() error {
+	if p.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if !p.DigestFrequency.IsValid() {
+		return fmt.Errorf("digest_frequency must be realtime, hourly, daily, weekly, or never")
+	}
+
+	for channel, pref := range p.Channels {
+		if !channel.IsPreferenceChannel() {
+			return fmt.Errorf("invalid channel: %s (must be websocket, email, or slack)", channel)
+		}
+
+		if pref.MinSeverity != "" && !pref.MinSeverity.IsValid() {
+			return fmt.Errorf("invalid min_severity for %s: %s", channel, pref.MinSeverity)
+		}
+
+		for event := range pref.Events {
+			if !event.IsValid() {
+				return fmt.Errorf("invalid event for %s: %s", channel, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Allows reports whether an event of the given severity should be delivered on channel right away
+// Email and Slack deliver individually only with the realtime digest; other frequencies batch them
+func (p *NotificationPreferences) Allows(channel NotificationChannel, event NotificationEvent, severity Severity) bool {
+	pref, ok := p.Channels[channel]
+	if !ok || !pref.Enabled {
+		return false
+	}
+
+	if enabled, listed := pref.Events[event]; listed && !enabled {
+		return false
+	}
+
+	if pref.MinSeverity != "" && severity != "" && !severity.AtLeast(pref.MinSeverity) {
+		return false
+	}
+
+	if channel != NotificationChannelWebSocket && p.DigestFrequency != DigestRealtime {
+		return false
+	}
+
+	return true
+}
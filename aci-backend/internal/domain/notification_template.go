@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationChannel represents a notification delivery channel
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "email"
+	NotificationChannelSlack NotificationChannel = "slack"
+	NotificationChannelPush  NotificationChannel = "push"
+	NotificationChannelInApp NotificationChannel = "in_app"
+)
+
+// DefaultLocale is used when a template is not available in the requested locale
+const DefaultLocale = "en"
+
+var (
+	templateKeyRegex = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+	localeRegex      = regexp.MustCompile(`^[a-z]{2}(?:-[A-Z]{2})?$`)
+	variableRegex    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// IsValid checks if the notification channel is valid
+func (c NotificationChannel) IsValid() bool {
+	switch c {
+	case NotificationChannelEmail, NotificationChannelSlack, NotificationChannelPush, NotificationChannelInApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresSubject reports whether templates for the channel must have a subject
+func (c NotificationChannel) RequiresSubject() bool {
+	return c == NotificationChannelEmail || c == NotificationChannelPush
+}
+
+// NotificationTemplate is one version of a notification template
+// Templates use Go text/template syntax and may only reference declared variables, e.g. {{.article_title}}
+type NotificationTemplate struct {
+	ID        uuid.UUID           `json:"id"`
+	Key       string              `json:"key"`
+	Channel   NotificationChannel `json:"channel"`
+	Locale    string              `json:"locale"`
+	Version   int                 `json:"version"`
+	Subject   *string             `json:"subject,omitempty"`
+	Body      string              `json:"body"`
+	Variables []string            `json:"variables"`
+	IsActive  bool                `json:"is_active"`
+	CreatedBy *uuid.UUID          `json:"created_by,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// RenderedNotification is the output of rendering a template
+type RenderedNotification struct {
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body"`
+}
+
+// Validate validates the template fields and checks that the subject and body
+// parse and only reference declared variables
+func (t *NotificationTemplate) Validate() error {
+	if !templateKeyRegex.MatchString(t.Key) {
+		return fmt.Errorf("key must be lowercase alphanumeric segments separated by '.', '_' or '-'")
+	}
+
+	if len(t.Key) > 100 {
+		return fmt.Errorf("key must not exceed 100 characters")
+	}
+
+	if !t.Channel.IsValid() {
+		return fmt.Errorf("invalid channel: %s", t.Channel)
+	}
+
+	if !localeRegex.MatchString(t.Locale) {
+		return fmt.Errorf("invalid locale: %s", t.Locale)
+	}
+
+	if t.Channel.RequiresSubject() && (t.Subject == nil || strings.TrimSpace(*t.Subject) == "") {
+		return fmt.Errorf("subject is required for %s templates", t.Channel)
+	}
+
+	if strings.TrimSpace(t.Body) == "" {
+		return fmt.Errorf("body is required")
+	}
+
+	declared := make(map[string]bool, len(t.Variables))
+	for _, v := range t.Variables {
+		if !variableRegex.MatchString(v) {
+			return fmt.Errorf("invalid variable name: %s", v)
+		}
+		declared[v] = true
+	}
+
+	if t.Subject != nil {
+		if err := checkTemplateVariables("subject", *t.Subject, declared); err != nil {
+			return err
+		}
+	}
+
+	return checkTemplateVariables("body", t.Body, declared)
+}
+
+// Render executes the template against the given variables
+// Every declared variable must be supplied; unknown variables are ignored
+func (t *NotificationTemplate) Render(vars map[string]interface{}) (*RenderedNotification, error) {
+	missing := make([]string, 0)
+	for _, v := range t.Variables {
+		if _, ok := vars[v]; !ok {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing template variables: %s", strings.Join(missing, ", "))
+	}
+
+	rendered := &RenderedNotification{}
+
+	if t.Subject != nil {
+		subject, err := executeTemplate("subject", *t.Subject, vars)
+		if err != nil {
+			return nil, err
+		}
+		rendered.Subject = subject
+	}
+
+	body, err := executeTemplate("body", t.Body, vars)
+	if err != nil {
+		return nil, err
+	}
+	rendered.Body = body
+
+	return rendered, nil
+}
+
+// SampleVariables returns placeholder values for every declared variable, for previews
+func (t *NotificationTemplate) SampleVariables() map[string]interface{} {
+	vars := make(map[string]interface{}, len(t.Variables))
+	for _, v := range t.Variables {
+		vars[v] = "{" + v + "}"
+	}
+	return vars
+}
+
+// executeTemplate parses and executes a single template string
+func executeTemplate(name, text string, vars map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
+
+// checkTemplateVariables parses a template and rejects references to undeclared variables
+func checkTemplateVariables(name, text string, declared map[string]bool) error {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	if tmpl.Tree == nil {
+		return nil
+	}
+
+	referenced := make(map[string]bool)
+	collectTemplateFields(tmpl.Tree.Root, referenced)
+
+	undeclared := make([]string, 0)
+	for v := range referenced {
+		if !declared[v] {
+			undeclared = append(undeclared, v)
+		}
+	}
+
+	if len(undeclared) > 0 {
+		sort.Strings(undeclared)
+		return fmt.Errorf("%s references undeclared variables: %s", name, strings.Join(undeclared, ", "))
+	}
+
+	return nil
+}
+
+// collectTemplateFields walks a template parse tree and records top-level field names
+func collectTemplateFields(node parse.Node, fields map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateFields(child, fields)
+		}
+	case *parse.ActionNode:
+		collectTemplateFields(n.Pipe, fields)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectTemplateFields(cmd, fields)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectTemplateFields(arg, fields)
+		}
+	case *parse.FieldNode:
+		if len(n.Ident) > 0 {
+			fields[n.Ident[0]] = true
+		}
+	case *parse.IfNode:
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.List, fields)
+		collectTemplateFields(n.ElseList, fields)
+	case *parse.RangeNode:
+		// Dot is rebound inside the range body, so only the pipeline names top-level variables
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.ElseList, fields)
+	case *parse.WithNode:
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.ElseList, fields)
+	}
+}
+
+// NotificationTemplateFilter represents filter criteria for listing templates
+type NotificationTemplateFilter struct {
+	Key        *string
+	Channel    *NotificationChannel
+	Locale     *string
+	ActiveOnly bool
+}
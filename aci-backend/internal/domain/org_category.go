@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization category limits
+const (
+	MaxOrgCategories        = 100
+	MaxOrgCategoryRules     = 20
+	MaxOrgCategoryRuleTerms = 50
+)
+
+// OrgCategorySource is how an article came to carry an organization category
+type OrgCategorySource string
+
+const (
+	OrgCategorySourceRule   OrgCategorySource = "rule"
+	OrgCategorySourceManual OrgCategorySource = "manual"
+)
+
+// OrgCategoryRule decides which incoming articles an organization category is applied to
+// Every field that is set must match: the title or content contains any of the keywords, the
+// article mentions any of the vendors or CVEs, carries any of the tags, is in any of the global
+// categories, and is at least as severe as MinSeverity. A rule with no fields set matches nothing
+type OrgCategoryRule struct {
+	Keywords    []string    `json:"keywords,omitempty"`
+	Vendors     []string    `json:"vendors,omitempty"`
+	CVEs        []string    `json:"cves,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	CategoryIDs []uuid.UUID `json:"category_ids,omitempty"`
+	MinSeverity Severity    `json:"min_severity,omitempty"`
+}
+
+// OrgCategory is a private category an organization applies on top of the global taxonomy
+// Only the organization's members see it; its rules apply it to matching articles as they are
+// ingested, and organization admins can apply or remove it by hand
+type OrgCategory struct {
+	ID             uuid.UUID         `json:"id"`
+	OrganizationID uuid.UUID         `json:"organization_id"`
+	Name           string            `json:"name"`
+	Slug           string            `json:"slug"`
+	Description    *string           `json:"description,omitempty"`
+	Color          string            `json:"color"`
+	Rules          []OrgCategoryRule `json:"rules"`
+	CreatedBy      *uuid.UUID        `json:"created_by,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+
+	// Populated on query
+	ArticleCount int `json:"article_count"`
+}
+
+// OrgArticleCategory is an organization category applied to an article
+type OrgArticleCategory struct {
+	Category  *OrgCategory      `json:"category"`
+	Source    OrgCategorySource `json:"source"`
+	AppliedBy *uuid.UUID        `json:"applied_by,omitempty"`
+	AppliedAt time.Time         `json:"applied_at"`
+}
+
+// Normalize trims the name, derives a missing slug from it, and normalizes rule terms:
+// keywords, vendors and tags are lowercased, CVE IDs uppercased, blanks and duplicates dropped
+func (c *OrgCategory) Normalize() {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Slug = strings.TrimSpace(c.Slug)
+	if c.Slug == "" {
+		c.Slug = GenerateSlug(c.Name)
+	}
+
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		rule.Keywords = normalizeKeywords(rule.Keywords)
+		rule.Vendors = normalizeKeywords(rule.Vendors)
+		rule.Tags = normalizeKeywords(rule.Tags)
+		rule.CVEs = normalizeKeywords(rule.CVEs)
+		for j := range rule.CVEs {
+			rule.CVEs[j] = strings.ToUpper(rule.CVEs[j])
+		}
+	}
+}
+
+// Validate performs validation on the OrgCategory
+func (c *OrgCategory) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(c.Name) > 100 {
+		return fmt.Errorf("name must not exceed 100 characters")
+	}
+
+	if c.Slug == "" || len(c.Slug) > 100 || !slugRegex.MatchString(c.Slug) {
+		return fmt.Errorf("slug must be up to 100 lowercase letters, numbers, and hyphens")
+	}
+
+	if !hexColorRegex.MatchString(c.Color) {
+		return fmt.Errorf("color must be a valid hex color code (e.g., #FF5733)")
+	}
+
+	if c.Description != nil && len(*c.Description) > 500 {
+		return fmt.Errorf("description must not exceed 500 characters")
+	}
+
+	if len(c.Rules) > MaxOrgCategoryRules {
+		return fmt.Errorf("rules must not exceed %d entries", MaxOrgCategoryRules)
+	}
+
+	for i, rule := range c.Rules {
+		if rule.IsEmpty() {
+			return fmt.Errorf("rules[%d]: at least one condition is required", i)
+		}
+
+		terms := len(rule.Keywords) + len(rule.Vendors) + len(rule.CVEs) + len(rule.Tags) + len(rule.CategoryIDs)
+		if terms > MaxOrgCategoryRuleTerms {
+			return fmt.Errorf("rules[%d]: must not exceed %d terms", i, MaxOrgCategoryRuleTerms)
+		}
+
+		if rule.MinSeverity != "" && !rule.MinSeverity.IsValid() {
+			return fmt.Errorf("rules[%d]: invalid min_severity: %s", i, rule.MinSeverity)
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether any of the category's rules matches the article
+func (c *OrgCategory) Matches(article *Article) bool {
+	if len(c.Rules) == 0 {
+		return false
+	}
+
+	text := strings.ToLower(article.Title + " " + article.Content)
+	for i := range c.Rules {
+		if c.Rules[i].matches(article, text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEmpty reports whether the rule has no conditions
+func (r *OrgCategoryRule) IsEmpty() bool {
+	return len(r.Keywords) == 0 && len(r.Vendors) == 0 && len(r.CVEs) == 0 &&
+		len(r.Tags) == 0 && len(r.CategoryIDs) == 0 && r.MinSeverity == ""
+}
+
+// matches reports whether the rule matches the article, whose title and content are given lowercased
+func (r *OrgCategoryRule) matches(article *Article, text string) bool {
+	if r.IsEmpty() {
+		return false
+	}
+
+	if len(r.Keywords) > 0 && !containsAny(text, r.Keywords) {
+		return false
+	}
+
+	if len(r.Vendors) > 0 && !intersectsFold(article.Vendors, r.Vendors) {
+		return false
+	}
+
+	if len(r.CVEs) > 0 && !intersectsFold(article.CVEs, r.CVEs) {
+		return false
+	}
+
+	if len(r.Tags) > 0 && !intersectsFold(article.Tags, r.Tags) {
+		return false
+	}
+
+	if len(r.CategoryIDs) > 0 && !r.inCategory(article) {
+		return false
+	}
+
+	if r.MinSeverity != "" && !article.Severity.AtLeast(r.MinSeverity) {
+		return false
+	}
+
+	return true
+}
+
+// inCategory reports whether the article is in any of the rule's global categories
+func (r *OrgCategoryRule) inCategory(article *Article) bool {
+	for _, want := range r.CategoryIDs {
+		if want == article.CategoryID {
+			return true
+		}
+		for _, id := range article.CategoryIDs {
+			if id == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsAny reports whether text contains any of the terms
+func containsAny(text string, terms []string) bool {
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectsFold reports whether any value equals any of the terms, ignoring case
+func intersectsFold(values, terms []string) bool {
+	for _, value := range values {
+		for _, term := range terms {
+			if strings.EqualFold(value, term) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationRole is a member's role within an organization
+type OrganizationRole string
+
+const (
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// IsValid checks if the organization role is valid
+func (r OrganizationRole) IsValid() bool {
+	switch r {
+	case OrganizationRoleAdmin, OrganizationRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Organization is a team of users that share alerts and bookmark collections
+type Organization struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Populated on query
+	MemberCount int `json:"member_count"`
+}
+
+// Validate performs validation on the Organization
+func (o *Organization) Validate() error {
+	if strings.TrimSpace(o.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(o.Name) > 255 {
+		return fmt.Errorf("name cannot exceed 255 characters")
+	}
+
+	if o.Slug == "" {
+		return fmt.Errorf("slug is required")
+	}
+
+	return nil
+}
+
+// OrganizationMember links a user to an organization
+type OrganizationMember struct {
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	UserID         uuid.UUID        `json:"user_id"`
+	Role           OrganizationRole `json:"role"`
+	JoinedAt       time.Time        `json:"joined_at"`
+
+	// Populated on query
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// IsAdmin reports whether the member administers the organization
+func (m *OrganizationMember) IsAdmin() bool {
+	return m.Role == OrganizationRoleAdmin
+}
+
+// OrganizationStats rolls up member engagement for an organization
+type OrganizationStats struct {
+	OrganizationID        uuid.UUID `json:"organization_id"`
+	Members               int       `json:"members"`
+	ActiveReaders30d      int       `json:"active_readers_30d"`
+	ArticlesRead          int       `json:"articles_read"`
+	UniqueArticlesRead    int       `json:"unique_articles_read"`
+	ReadingTimeSeconds    int       `json:"reading_time_seconds"`
+	ArticlesReadThisWeek  int       `json:"articles_read_this_week"`
+	ArticlesReadThisMonth int       `json:"articles_read_this_month"`
+	Bookmarks             int       `json:"bookmarks"`
+	SharedCollections     int       `json:"shared_collections"`
+	Alerts                int       `json:"alerts"`
+	SharedAlerts          int       `json:"shared_alerts"`
+	AlertMatches          int       `json:"alert_matches"`
+	UnacknowledgedMatches int       `json:"unacknowledged_matches"`
+}
+
+// BookmarkCollection is a named set of articles, private to its creator or shared with an organization
+type BookmarkCollection struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Name           string     `json:"name"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+
+	// Populated on query
+	ArticleCount int `json:"article_count"`
+}
+
+// Validate performs validation on the BookmarkCollection
+func (c *BookmarkCollection) Validate() error {
+	if c.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(c.Name) > 255 {
+		return fmt.Errorf("name cannot exceed 255 characters")
+	}
+
+	return nil
+}
+
+// IsShared reports whether the collection is shared with an organization
+func (c *BookmarkCollection) IsShared() bool {
+	return c.OrganizationID != nil
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxReportRecipients is the most addresses an organization report is emailed to
+const MaxReportRecipients = 50
+
+// ReportCadence is how often a scheduled report is generated
+type ReportCadence string
+
+const (
+	ReportCadenceDaily   ReportCadence = "daily"
+	ReportCadenceWeekly  ReportCadence = "weekly"
+	ReportCadenceMonthly ReportCadence = "monthly"
+)
+
+// IsValid checks if the report cadence is valid
+func (c ReportCadence) IsValid() bool {
+	switch c {
+	case ReportCadenceDaily, ReportCadenceWeekly, ReportCadenceMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// PeriodStart returns the start of the cadence's period that ends at end, a period boundary
+func (c ReportCadence) PeriodStart(end time.Time) time.Time {
+	switch c {
+	case ReportCadenceDaily:
+		return end.AddDate(0, 0, -1)
+	case ReportCadenceWeekly:
+		return end.AddDate(0, 0, -7)
+	default:
+		return end.AddDate(0, -1, 0)
+	}
+}
+
+// NextBoundary returns the first period boundary after t: the next midnight, Monday
+// or first of the month, in UTC
+func (c ReportCadence) NextBoundary(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch c {
+	case ReportCadenceDaily:
+		return day.AddDate(0, 0, 1)
+	case ReportCadenceWeekly:
+		return day.AddDate(0, 0, 7-(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// ReportSection is a section an organization report can include
+type ReportSection string
+
+const (
+	ReportSectionSeverity     ReportSection = "severity"
+	ReportSectionTopArticles  ReportSection = "top_articles"
+	ReportSectionNotableCVEs  ReportSection = "notable_cves"
+	ReportSectionAlertMatches ReportSection = "alert_matches"
+)
+
+// ReportSections lists every report section in the order they are laid out
+var ReportSections = []ReportSection{
+	ReportSectionSeverity,
+	ReportSectionTopArticles,
+	ReportSectionNotableCVEs,
+	ReportSectionAlertMatches,
+}
+
+// IsValid checks if the report section is valid
+func (s ReportSection) IsValid() bool {
+	switch s {
+	case ReportSectionSeverity, ReportSectionTopArticles, ReportSectionNotableCVEs, ReportSectionAlertMatches:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrganizationReport is a report template defined by an organization admin: what it covers,
+// who it is emailed to, and how often it is generated
+type OrganizationReport struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Name           string          `json:"name"`
+	Filter         ReportFilter    `json:"filters"`
+	Sections       []ReportSection `json:"sections"`
+	Recipients     []string        `json:"recipients"`
+	Cadence        ReportCadence   `json:"cadence"`
+	Enabled        bool            `json:"enabled"`
+	NextRunAt      time.Time       `json:"next_run_at"`
+	LastRunAt      *time.Time      `json:"last_run_at,omitempty"`
+	CreatedBy      *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Validate performs validation on the OrganizationReport
+func (r *OrganizationReport) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(r.Name) > 200 {
+		return fmt.Errorf("name cannot exceed 200 characters")
+	}
+
+	if !r.Cadence.IsValid() {
+		return fmt.Errorf("cadence must be daily, weekly or monthly")
+	}
+
+	if len(r.Sections) == 0 {
+		return fmt.Errorf("at least one section is required")
+	}
+
+	seen := make(map[ReportSection]bool, len(r.Sections))
+	for _, section := range r.Sections {
+		if !section.IsValid() {
+			return fmt.Errorf("invalid section: %s", section)
+		}
+		if seen[section] {
+			return fmt.Errorf("duplicate section: %s", section)
+		}
+		seen[section] = true
+	}
+
+	if len(r.Recipients) > MaxReportRecipients {
+		return fmt.Errorf("cannot have more than %d recipients", MaxReportRecipients)
+	}
+
+	for _, recipient := range r.Recipients {
+		if addr, err := mail.ParseAddress(recipient); err != nil || addr.Address != recipient {
+			return fmt.Errorf("invalid recipient: %s", recipient)
+		}
+	}
+
+	for _, severity := range r.Filter.Severities {
+		if !severity.IsValid() {
+			return fmt.Errorf("invalid severity filter: %s", severity)
+		}
+	}
+
+	for _, id := range r.Filter.CategoryIDs {
+		if id == uuid.Nil {
+			return fmt.Errorf("category_ids cannot contain a nil ID")
+		}
+	}
+
+	for _, vendor := range r.Filter.Vendors {
+		if strings.TrimSpace(vendor) == "" {
+			return fmt.Errorf("vendors cannot contain an empty name")
+		}
+	}
+
+	return nil
+}
+
+// HasSection reports whether the report includes the section
+func (r *OrganizationReport) HasSection(section ReportSection) bool {
+	for _, s := range r.Sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// ReportRunTrigger is what started an organization report run
+type ReportRunTrigger string
+
+const (
+	ReportRunTriggerScheduled ReportRunTrigger = "scheduled"
+	ReportRunTriggerManual    ReportRunTrigger = "manual"
+)
+
+// ReportRunStatus is the state of an organization report run
+type ReportRunStatus string
+
+const (
+	ReportRunStatusRunning   ReportRunStatus = "running"
+	ReportRunStatusCompleted ReportRunStatus = "completed"
+	ReportRunStatusFailed    ReportRunStatus = "failed"
+)
+
+// OrganizationReportRun is one generation of an organization report for a period
+type OrganizationReportRun struct {
+	ID             uuid.UUID        `json:"id"`
+	ReportID       uuid.UUID        `json:"report_id"`
+	PeriodStart    time.Time        `json:"period_start"`
+	PeriodEnd      time.Time        `json:"period_end"`
+	Trigger        ReportRunTrigger `json:"trigger"`
+	Status         ReportRunStatus  `json:"status"`
+	RequestedBy    *uuid.UUID       `json:"requested_by,omitempty"`
+	RecipientsSent int              `json:"recipients_sent"`
+	Error          *string          `json:"error,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	CompletedAt    *time.Time       `json:"completed_at,omitempty"`
+
+	// Populated on query
+	DocumentSize int `json:"document_size"`
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Public API key audit log actions
+const (
+	AuditActionPublicAPIKeyCreated = "create_public_api_key"
+	AuditActionPublicAPIKeyRevoked = "revoke_public_api_key"
+)
+
+// Public API key format and limits
+const (
+	PublicAPIKeyPrefix            = "aci_pk_"
+	PublicAPIKeyDisplayLength     = 15 // leading characters of a key kept to identify it
+	MaxPublicAPIKeyNameLength     = 100
+	MaxPublicAPIRequestsPerMinute = 100000
+)
+
+// PublicAPIKey grants access to the read-only public API with its own rate limit
+// Only a hash of the key is stored; the key itself is shown once, when it is created
+type PublicAPIKey struct {
+	ID                uuid.UUID  `json:"id"`
+	Name              string     `json:"name"`
+	KeyPrefix         string     `json:"key_prefix"`
+	KeyHash           string     `json:"-"`
+	RequestsPerMinute int        `json:"requests_per_minute"`
+	CreatedBy         *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	LastUsedAt        *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt         *time.Time `json:"revoked_at,omitempty"`
+}
+
+// IsActive reports whether the key has not been revoked
+func (k *PublicAPIKey) IsActive() bool {
+	return k.RevokedAt == nil
+}
+
+// Validate validates the key's name and rate limit
+func (k *PublicAPIKey) Validate() error {
+	k.Name = strings.TrimSpace(k.Name)
+
+	if k.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(k.Name) > MaxPublicAPIKeyNameLength {
+		return fmt.Errorf("name cannot exceed %d characters", MaxPublicAPIKeyNameLength)
+	}
+
+	if k.RequestsPerMinute < 1 || k.RequestsPerMinute > MaxPublicAPIRequestsPerMinute {
+		return fmt.Errorf("requests_per_minute must be between 1 and %d", MaxPublicAPIRequestsPerMinute)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Registration policy audit log actions
+const (
+	AuditActionRegistrationPolicyUpdated = "update_registration_policy"
+	AuditActionRegistrationPolicyReset   = "reset_registration_policy"
+)
+
+// MaxRegistrationDomains bounds the allow-listed email domains
+const MaxRegistrationDomains = 100
+
+// Registration policy sources
+const (
+	RegistrationPolicySourceConfig = "config" // REGISTRATION_* settings
+	RegistrationPolicySourceAdmin  = "admin"  // saved through the admin API
+)
+
+// RegistrationPolicy decides who may create an account through public registration
+// Invited users and bootstrapped admins are not subject to it
+type RegistrationPolicy struct {
+	Enabled        bool       `json:"enabled"`
+	AllowedDomains []string   `json:"allowed_domains"` // empty allows any domain
+	Source         string     `json:"source"`
+	UpdatedBy      *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// Normalize lowercases and trims domains, dropping blanks, a leading "@" and duplicates
+func (p *RegistrationPolicy) Normalize() {
+	seen := make(map[string]bool, len(p.AllowedDomains))
+	domains := make([]string, 0, len(p.AllowedDomains))
+	for _, d := range p.AllowedDomains {
+		d = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "@")
+		if d == "" || seen[d] {
+			continue
+		}
+		seen[d] = true
+		domains = append(domains, d)
+	}
+	sort.Strings(domains)
+	p.AllowedDomains = domains
+}
+
+// Validate checks the allowed domains look like domain names
+func (p *RegistrationPolicy) Validate() error {
+	if len(p.AllowedDomains) > MaxRegistrationDomains {
+		return fmt.Errorf("allowed_domains must not exceed %d entries", MaxRegistrationDomains)
+	}
+
+	for _, d := range p.AllowedDomains {
+		if len(d) > 253 || !strings.Contains(d, ".") || strings.ContainsAny(d, "@ /") ||
+			strings.HasPrefix(d, ".") || strings.HasSuffix(d, ".") {
+			return fmt.Errorf("%q is not a valid domain", d)
+		}
+	}
+
+	return nil
+}
+
+// AllowsDomain reports whether an email address's domain may register
+// Domains match exactly, so allowing example.com does not allow mail.example.com
+func (p *RegistrationPolicy) AllowsDomain(email string) bool {
+	if len(p.AllowedDomains) == 0 {
+		return true
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+
+	for _, d := range p.AllowedDomains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditActionRelevanceRulesUpdated is the audit log action for saving a new relevance ruleset
+const AuditActionRelevanceRulesUpdated = "update_relevance_rules"
+
+// Relevance ruleset limits
+const (
+	MaxRelevanceKeywords = 200
+	MaxCTARules          = 20
+	MaxSeverityBoost     = 3.0
+)
+
+// CTARule selects a call-to-action for relevant articles
+// A rule matches when the title or content contains any of its keywords (if set) and the
+// article's severity is one of its severities (if set); a rule with neither always matches
+type CTARule struct {
+	Keywords   []string   `json:"keywords,omitempty"`
+	Severities []Severity `json:"severities,omitempty"`
+	CTA        ArmorCTA   `json:"cta"`
+}
+
+// RelevanceRules is a version of the Armor relevance scoring ruleset
+// The score is the weighted share of product and industry keywords found in the article,
+// multiplied by its severity boost and capped at 1; CTA rules are tried in order
+type RelevanceRules struct {
+	Version          int                  `json:"version"`
+	ProductKeywords  []string             `json:"product_keywords"`
+	IndustryKeywords []string             `json:"industry_keywords"`
+	ProductWeight    float64              `json:"product_weight"`
+	IndustryWeight   float64              `json:"industry_weight"`
+	SeverityBoosts   map[Severity]float64 `json:"severity_boosts"`
+	CTAThreshold     float64              `json:"cta_threshold"` // CTAs are only generated above this score
+	CTARules         []CTARule            `json:"cta_rules"`
+	CreatedBy        *uuid.UUID           `json:"created_by,omitempty"`
+	CreatedAt        time.Time            `json:"created_at"`
+}
+
+// RelevanceScore explains how an article scored under a ruleset
+type RelevanceScore struct {
+	Score           float64   `json:"score"`
+	ProductMatches  []string  `json:"product_matches"`
+	IndustryMatches []string  `json:"industry_matches"`
+	SeverityBoost   float64   `json:"severity_boost"`
+	CTA             *ArmorCTA `json:"cta"`
+}
+
+// Normalize lowercases and trims keywords, dropping blanks and duplicates
+func (r *RelevanceRules) Normalize() {
+	r.ProductKeywords = normalizeKeywords(r.ProductKeywords)
+	r.IndustryKeywords = normalizeKeywords(r.IndustryKeywords)
+	for i := range r.CTARules {
+		r.CTARules[i].Keywords = normalizeKeywords(r.CTARules[i].Keywords)
+	}
+}
+
+// Validate checks weights, boosts and CTA rules
+func (r *RelevanceRules) Validate() error {
+	if len(r.ProductKeywords) == 0 && len(r.IndustryKeywords) == 0 {
+		return fmt.Errorf("at least one product or industry keyword is required")
+	}
+
+	if len(r.ProductKeywords) > MaxRelevanceKeywords || len(r.IndustryKeywords) > MaxRelevanceKeywords {
+		return fmt.Errorf("keyword lists must not exceed %d entries", MaxRelevanceKeywords)
+	}
+
+	if r.ProductWeight < 0 || r.IndustryWeight < 0 {
+		return fmt.Errorf("weights cannot be negative")
+	}
+
+	if r.ProductWeight+r.IndustryWeight <= 0 {
+		return fmt.Errorf("product and industry weights cannot both be zero")
+	}
+
+	for severity, boost := range r.SeverityBoosts {
+		if !severity.IsValid() {
+			return fmt.Errorf("invalid severity in severity boosts: %s", severity)
+		}
+		if boost <= 0 || boost > MaxSeverityBoost {
+			return fmt.Errorf("severity boost for %s must be greater than 0 and at most %.1f", severity, MaxSeverityBoost)
+		}
+	}
+
+	if r.CTAThreshold < 0 || r.CTAThreshold > 1 {
+		return fmt.Errorf("cta_threshold must be between 0 and 1")
+	}
+
+	if len(r.CTARules) > MaxCTARules {
+		return fmt.Errorf("cta_rules must not exceed %d entries", MaxCTARules)
+	}
+
+	for i, rule := range r.CTARules {
+		if !rule.CTA.IsValid() {
+			return fmt.Errorf("cta_rules[%d]: cta requires a title, URL and a type of product, service or consultation", i)
+		}
+		for _, severity := range rule.Severities {
+			if !severity.IsValid() {
+				return fmt.Errorf("cta_rules[%d]: invalid severity: %s", i, severity)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether the rule applies to an article with the given lowercased text and severity
+func (c *CTARule) Matches(text string, severity Severity) bool {
+	if len(c.Keywords) > 0 {
+		found := false
+		for _, keyword := range c.Keywords {
+			if strings.Contains(text, keyword) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(c.Severities) > 0 {
+		for _, s := range c.Severities {
+			if s == severity {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// DefaultRelevanceRules returns the built-in ruleset used until an admin saves one
+func DefaultRelevanceRules() *RelevanceRules {
+	return &RelevanceRules{
+		ProductKeywords: []string{
+			// Armor products and services
+			"managed security",
+			"security operations center",
+			"soc",
+			"threat detection",
+			"threat response",
+			"incident response",
+			"security monitoring",
+			"cloud security",
+			"compliance",
+			"pci dss",
+			"pci compliance",
+			"hipaa",
+			"gdpr",
+			"vulnerability management",
+			"penetration testing",
+			"security assessment",
+			"managed cloud",
+			"cloud hosting",
+			"dedicated hosting",
+			"hybrid cloud",
+			"disaster recovery",
+			"business continuity",
+			"backup",
+			"security automation",
+			"threat intelligence",
+			"log management",
+			"siem",
+		},
+		IndustryKeywords: []string{
+			// Target industries
+			"healthcare",
+			"financial services",
+			"fintech",
+			"banking",
+			"e-commerce",
+			"retail",
+			"payment processing",
+			"credit card",
+			"payment card industry",
+			"online payments",
+			"saas",
+			"software as a service",
+			"enterprise",
+			"small business",
+			"medium business",
+			"smb",
+		},
+		ProductWeight:  0.7,
+		IndustryWeight: 0.3,
+		SeverityBoosts: map[Severity]float64{
+			SeverityCritical: 1.2,
+			SeverityHigh:     1.1,
+		},
+		CTAThreshold: 0.5,
+		CTARules: []CTARule{
+			{
+				Keywords: []string{"managed security", "soc", "threat detection", "incident response"},
+				CTA: ArmorCTA{
+					Type:  "service",
+					Title: "Protect Your Business with Armor Managed Security",
+					URL:   "https://www.armor.com/services/managed-security",
+				},
+			},
+			{
+				Keywords: []string{"cloud security", "cloud hosting", "aws", "azure", "gcp"},
+				CTA: ArmorCTA{
+					Type:  "service",
+					Title: "Secure Your Cloud Infrastructure with Armor",
+					URL:   "https://www.armor.com/services/cloud-security",
+				},
+			},
+			{
+				Keywords: []string{"compliance", "pci", "hipaa", "gdpr"},
+				CTA: ArmorCTA{
+					Type:  "service",
+					Title: "Achieve Compliance with Armor's Expert Guidance",
+					URL:   "https://www.armor.com/services/compliance",
+				},
+			},
+			{
+				Keywords: []string{"vulnerability", "penetration test", "security assessment"},
+				CTA: ArmorCTA{
+					Type:  "service",
+					Title: "Schedule a Security Assessment with Armor",
+					URL:   "https://www.armor.com/services/security-assessment",
+				},
+			},
+			{
+				Severities: []Severity{SeverityCritical, SeverityHigh},
+				CTA: ArmorCTA{
+					Type:  "consultation",
+					Title: "Speak with an Armor Security Expert",
+					URL:   "https://www.armor.com/contact",
+				},
+			},
+			{
+				// Default CTA
+				CTA: ArmorCTA{
+					Type:  "product",
+					Title: "Learn How Armor Can Protect Your Business",
+					URL:   "https://www.armor.com/solutions",
+				},
+			},
+		},
+	}
+}
+
+// normalizeKeywords lowercases and trims keywords, dropping blanks and duplicates
+func normalizeKeywords(keywords []string) []string {
+	seen := make(map[string]bool, len(keywords))
+	normalized := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeverityBreakdown counts published articles by severity
+type SeverityBreakdown struct {
+	Critical      int `json:"critical"`
+	High          int `json:"high"`
+	Medium        int `json:"medium"`
+	Low           int `json:"low"`
+	Informational int `json:"informational"`
+	Total         int `json:"total"`
+}
+
+// ReportArticle is an article listed in a report
+type ReportArticle struct {
+	ID          uuid.UUID `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Severity    Severity  `json:"severity"`
+	SourceName  string    `json:"source_name"`
+	CVEs        []string  `json:"cves"`
+	ViewCount   int       `json:"view_count"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// ReportCVE is a CVE mentioned in a report's period, with whether it is known to be exploited
+type ReportCVE struct {
+	CVE              string     `json:"cve"`
+	ArticleCount     int        `json:"article_count"`
+	KEV              bool       `json:"kev"`
+	KEVDueDate       *time.Time `json:"kev_due_date,omitempty"`
+	ExploitAvailable bool       `json:"exploit_available"`
+}
+
+// ReportAlertMatches summarizes the matches of one of the reader's alerts in a report's period
+type ReportAlertMatches struct {
+	AlertID        uuid.UUID `json:"alert_id"`
+	AlertName      string    `json:"alert_name"`
+	Shared         bool      `json:"shared"` // shared with the reader's organization rather than their own
+	Matches        int       `json:"matches"`
+	Critical       int       `json:"critical"`
+	High           int       `json:"high"`
+	Unacknowledged int       `json:"unacknowledged"`
+}
+
+// ThreatReport is a threat briefing for a period: the period's published articles
+// and CVEs, and the matches of the alerts of the reader or organization it was built for
+type ThreatReport struct {
+	PeriodStart  time.Time            `json:"period_start"`
+	PeriodEnd    time.Time            `json:"period_end"`
+	GeneratedAt  time.Time            `json:"generated_at"`
+	Severity     SeverityBreakdown    `json:"severity"`
+	TopArticles  []*ReportArticle     `json:"top_articles"`
+	NotableCVEs  []ReportCVE          `json:"notable_cves"`
+	AlertMatches []ReportAlertMatches `json:"alert_matches"`
+}
+
+// ReportFilter limits the articles a report covers; empty lists do not filter
+// An article must match every non-empty list, and any value within a list
+type ReportFilter struct {
+	Severities  []Severity  `json:"severities,omitempty"`
+	CategoryIDs []uuid.UUID `json:"category_ids,omitempty"` // matches the categories and their subcategories
+	Vendors     []string    `json:"vendors,omitempty"`
+}
+
+// ReportRecipient is a reader who receives the weekly briefing by email
+type ReportRecipient struct {
+	UserID uuid.UUID
+	Email  string
+	Name   string
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchEntityType identifies a result group in global search
+type SearchEntityType string
+
+const (
+	SearchEntityArticle     SearchEntityType = "articles"
+	SearchEntityCVE         SearchEntityType = "cves"
+	SearchEntityVendor      SearchEntityType = "vendors"
+	SearchEntityThreatActor SearchEntityType = "threat_actors"
+)
+
+const (
+	// DefaultGlobalSearchLimit is the per-type result limit when none is requested
+	DefaultGlobalSearchLimit = 5
+	// MaxGlobalSearchLimit is the upper bound for any per-type limit
+	MaxGlobalSearchLimit = 25
+	// MinGlobalSearchQueryLength prevents single-character scans across all entity kinds
+	MinGlobalSearchQueryLength = 2
+)
+
+// AllSearchEntityTypes returns every entity kind covered by global search, in display order
+func AllSearchEntityTypes() []SearchEntityType {
+	return []SearchEntityType{
+		SearchEntityArticle,
+		SearchEntityCVE,
+		SearchEntityVendor,
+		SearchEntityThreatActor,
+	}
+}
+
+// IsValid checks if the search entity type is valid
+func (t SearchEntityType) IsValid() bool {
+	switch t {
+	case SearchEntityArticle, SearchEntityCVE, SearchEntityVendor, SearchEntityThreatActor:
+		return true
+	default:
+		return false
+	}
+}
+
+// GlobalSearchQuery holds the parameters for a typed global search
+type GlobalSearchQuery struct {
+	Query  string
+	Types  []SearchEntityType
+	Limits map[SearchEntityType]int
+}
+
+// NewGlobalSearchQuery creates a query covering all entity types with default limits
+func NewGlobalSearchQuery(query string) *GlobalSearchQuery {
+	return &GlobalSearchQuery{
+		Query:  query,
+		Types:  AllSearchEntityTypes(),
+		Limits: make(map[SearchEntityType]int),
+	}
+}
+
+// Validate validates the global search query
+func (q *GlobalSearchQuery) Validate() error {
+	q.Query = strings.TrimSpace(q.Query)
+
+	if len(q.Query) < MinGlobalSearchQueryLength {
+		return fmt.Errorf("query must be at least %d characters", MinGlobalSearchQueryLength)
+	}
+
+	if len(q.Types) == 0 {
+		return fmt.Errorf("at least one search type is required")
+	}
+
+	for _, t := range q.Types {
+		if !t.IsValid() {
+			return fmt.Errorf("invalid search type: %s", t)
+		}
+	}
+
+	for t, limit := range q.Limits {
+		if !t.IsValid() {
+			return fmt.Errorf("invalid search type: %s", t)
+		}
+		if limit < 1 || limit > MaxGlobalSearchLimit {
+			return fmt.Errorf("limit for %s must be between 1 and %d", t, MaxGlobalSearchLimit)
+		}
+	}
+
+	return nil
+}
+
+// LimitFor returns the result limit for an entity type
+func (q *GlobalSearchQuery) LimitFor(t SearchEntityType) int {
+	if limit, ok := q.Limits[t]; ok && limit > 0 {
+		return limit
+	}
+	return DefaultGlobalSearchLimit
+}
+
+// Includes checks if an entity type was requested
+func (q *GlobalSearchQuery) Includes(t SearchEntityType) bool {
+	for _, requested := range q.Types {
+		if requested == t {
+			return true
+		}
+	}
+	return false
+}
+
+// ArticleSearchHit is a lightweight article match for global search
+type ArticleSearchHit struct {
+	ID          uuid.UUID `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Summary     *string   `json:"summary,omitempty"`
+	Severity    Severity  `json:"severity"`
+	PublishedAt time.Time `json:"published_at"`
+	Score       float64   `json:"score"`
+}
+
+// TermSearchHit is an aggregated match for a CVE, vendor, or threat actor name
+type TermSearchHit struct {
+	Value        string    `json:"value"`
+	ArticleCount int       `json:"article_count"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+	Score        float64   `json:"score"`
+}
+
+// GlobalSearchResults groups global search hits by entity type
+type GlobalSearchResults struct {
+	Query        string              `json:"query"`
+	Articles     []*ArticleSearchHit `json:"articles,omitempty"`
+	CVEs         []*TermSearchHit    `json:"cves,omitempty"`
+	Vendors      []*TermSearchHit    `json:"vendors,omitempty"`
+	ThreatActors []*TermSearchHit    `json:"threat_actors,omitempty"`
+}
+
+// SearchField scopes a search term to an article field
+type SearchField string
+
+const (
+	// SearchFieldText matches the article title, summary and content
+	SearchFieldText   SearchField = ""
+	SearchFieldCVE    SearchField = "cve"
+	SearchFieldVendor SearchField = "vendor"
+	SearchFieldTag    SearchField = "tag"
+)
+
+// MaxSearchTerms bounds how many terms a parsed search query may contain
+const MaxSearchTerms = 20
+
+// SearchTerm is one word, quoted phrase or field match in a parsed search query
+// A field value ending in * matches as a prefix
+type SearchTerm struct {
+	Field   SearchField `json:"field,omitempty"`
+	Value   string      `json:"value"`
+	Phrase  bool        `json:"phrase,omitempty"`
+	Negated bool        `json:"negated,omitempty"`
+}
+
+// SearchQuery is a parsed article search query
+// An article matches when every group matches, and a group matches when any of its terms does
+type SearchQuery struct {
+	Groups [][]SearchTerm `json:"groups"`
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Security events record activity on a user's own account, as opposed to admin actions
+// They are stored as audit logs under the affected user so each user can review them
+const (
+	SecurityEventLogin           = "security.login"
+	SecurityEventLoginFailed     = "security.login_failed"
+	SecurityEventTokenRefreshed  = "security.token_refreshed"
+	SecurityEventPasswordChanged = "security.password_changed"
+	SecurityEventAlertCreated    = "security.alert_created"
+	SecurityEventAlertDeleted    = "security.alert_deleted"
+	SecurityEventAPIKeyUsed      = "security.api_key_used"
+)
+
+// SecurityEvents lists every security event action
+var SecurityEvents = []string{
+	SecurityEventLogin,
+	SecurityEventLoginFailed,
+	SecurityEventTokenRefreshed,
+	SecurityEventPasswordChanged,
+	SecurityEventAlertCreated,
+	SecurityEventAlertDeleted,
+	SecurityEventAPIKeyUsed,
+}
+
+// SecurityActivity is one entry in a user's security activity feed
+type SecurityActivity struct {
+	ID           uuid.UUID   `json:"id"`
+	Event        string      `json:"event"`
+	ResourceType string      `json:"resource_type"`
+	ResourceID   *uuid.UUID  `json:"resource_id,omitempty"`
+	Details      interface{} `json:"details,omitempty"`
+	IPAddress    *string     `json:"ip_address,omitempty"`
+	UserAgent    *string     `json:"user_agent,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+// NewSecurityActivity presents a security event audit log to the user it concerns
+func NewSecurityActivity(log *AuditLog) *SecurityActivity {
+	return &SecurityActivity{
+		ID:           log.ID,
+		Event:        log.Action,
+		ResourceType: log.ResourceType,
+		ResourceID:   log.ResourceID,
+		Details:      log.NewValue,
+		IPAddress:    log.IPAddress,
+		UserAgent:    log.UserAgent,
+		CreatedAt:    log.CreatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShareLink is a short signed link to an article, created by a reader to share it
+type ShareLink struct {
+	ID        uuid.UUID  `json:"id"`
+	Code      string     `json:"-"`
+	ArticleID uuid.UUID  `json:"article_id"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// Channel is where the link is meant to be posted, e.g. linkedin or email
+	Channel       *string    `json:"channel,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	ClickCount    int        `json:"click_count"`
+	LastClickedAt *time.Time `json:"last_clicked_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+
+	// URL is the short URL, populated when the link is issued
+	URL string `json:"url,omitempty"`
+}
+
+// Validate performs validation on the ShareLink
+func (l *ShareLink) Validate() error {
+	if l.ArticleID == uuid.Nil {
+		return fmt.Errorf("article_id is required")
+	}
+
+	if l.Channel != nil && len(*l.Channel) > 32 {
+		return fmt.Errorf("channel cannot exceed 32 characters")
+	}
+
+	if l.ExpiresAt != nil && !l.ExpiresAt.After(l.CreatedAt) {
+		return fmt.Errorf("expires_at must be after created_at")
+	}
+
+	return nil
+}
+
+// IsExpired reports whether the link had expired at the given time
+func (l *ShareLink) IsExpired(at time.Time) bool {
+	return l.ExpiresAt != nil && !at.Before(*l.ExpiresAt)
+}
+
+// ShareClick records one visit through a share link
+type ShareClick struct {
+	ID          uuid.UUID `json:"id"`
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	// Source is the src query parameter of the followed link, else the link's channel, else direct
+	Source       string    `json:"source"`
+	ReferrerHost *string   `json:"referrer_host,omitempty"`
+	ClickedAt    time.Time `json:"clicked_at"`
+}
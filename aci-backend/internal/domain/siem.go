@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SIEMEventType identifies what a forwarded SIEM event describes
+type SIEMEventType string
+
+const (
+	// SIEMEventArticle is an article with its enrichment
+	SIEMEventArticle SIEMEventType = "article.enriched"
+	// SIEMEventIOC is one indicator found in an enriched article
+	SIEMEventIOC SIEMEventType = "ioc.observed"
+)
+
+// IsValid validates the SIEM event type value
+func (t SIEMEventType) IsValid() bool {
+	switch t {
+	case SIEMEventArticle, SIEMEventIOC:
+		return true
+	default:
+		return false
+	}
+}
+
+// SIEMArticle is the payload of an article.enriched event
+type SIEMArticle struct {
+	ArticleID      uuid.UUID  `json:"article_id"`
+	Title          string     `json:"title"`
+	URL            string     `json:"url,omitempty"` // article page in the web app
+	SourceURL      string     `json:"source_url"`
+	Severity       Severity   `json:"severity"`
+	ThreatType     string     `json:"threat_type,omitempty"`
+	AttackVector   string     `json:"attack_vector,omitempty"`
+	CVEs           []string   `json:"cves,omitempty"`
+	Vendors        []string   `json:"vendors,omitempty"`
+	Tags           []string   `json:"tags,omitempty"`
+	IOCCount       int        `json:"ioc_count"`
+	KEV            bool       `json:"kev"`
+	ArmorRelevance float64    `json:"armor_relevance"`
+	PublishedAt    time.Time  `json:"published_at"`
+	EnrichedAt     *time.Time `json:"enriched_at,omitempty"`
+}
+
+// SIEMIndicator is the payload of an ioc.observed event
+type SIEMIndicator struct {
+	Type         string    `json:"ioc_type"`
+	Value        string    `json:"ioc_value"`
+	Context      string    `json:"ioc_context,omitempty"`
+	Source       string    `json:"ioc_source,omitempty"` // extracted or ai
+	ArticleID    uuid.UUID `json:"article_id"`
+	ArticleTitle string    `json:"article_title"`
+	ArticleURL   string    `json:"article_url,omitempty"`
+	Severity     Severity  `json:"severity"`
+}
+
+// SIEMEventStatus represents where a SIEM event is in delivery
+type SIEMEventStatus string
+
+const (
+	SIEMEventPending   SIEMEventStatus = "pending"
+	SIEMEventDelivered SIEMEventStatus = "delivered"
+	// SIEMEventFailed events gave up after a permanent error or too many attempts; an admin can retry them
+	SIEMEventFailed SIEMEventStatus = "failed"
+)
+
+// IsValid validates the SIEM event status value
+func (s SIEMEventStatus) IsValid() bool {
+	switch s {
+	case SIEMEventPending, SIEMEventDelivered, SIEMEventFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// SIEMEvent is an event queued for one SIEM destination
+// Payload is a SIEMArticle or SIEMIndicator, as given by Type; destinations wrap it in their own format
+type SIEMEvent struct {
+	ID            uuid.UUID       `json:"id"`
+	Destination   string          `json:"destination"`
+	Type          SIEMEventType   `json:"type"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Status        SIEMEventStatus `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     *string         `json:"last_error,omitempty"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	DeliveredAt   *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// SIEMEventFilter represents filtering options for SIEM events
+type SIEMEventFilter struct {
+	Destination *string
+	Status      *SIEMEventStatus
+	Type        *SIEMEventType
+	Page        int
+	PageSize    int
+}
+
+// Offset calculates the offset for pagination
+func (f *SIEMEventFilter) Offset() int {
+	if f.Page < 1 {
+		return 0
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// SIEMDestinationState is the admin toggle and queue of a SIEM destination
+// Destinations without a stored state are enabled
+type SIEMDestinationState struct {
+	Enabled         bool       `json:"enabled"`
+	UpdatedBy       *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+	Pending         int        `json:"pending"`
+	Failed          int        `json:"failed"`
+	LastDeliveredAt *time.Time `json:"last_delivered_at,omitempty"`
+}
+
+// SIEMDestination is a configured SIEM destination
+type SIEMDestination struct {
+	Name     string `json:"name"`     // splunk or elasticsearch
+	Endpoint string `json:"endpoint"` // URL events are sent to
+	SIEMDestinationState
+}
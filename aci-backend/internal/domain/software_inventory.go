@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxInventoryItems caps the products in one software inventory
+const MaxInventoryItems = 1000
+
+// InventoryItem is a product release in a user's or an organization's software inventory
+// Vendor and product are normalized as in CPE names (lowercase, words joined by underscores)
+type InventoryItem struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Vendor         string     `json:"vendor"`
+	Product        string     `json:"product"`
+	Version        string     `json:"version,omitempty"` // empty when unknown; every affected range then matches
+	CPE            string     `json:"cpe,omitempty"`     // as uploaded, when the item was given as a CPE name
+	MatchedCVEs    []string   `json:"matched_cves"`      // CVEs whose NVD affected products include this release
+	CreatedBy      *uuid.UUID `json:"-"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Validate validates the inventory item
+func (i *InventoryItem) Validate() error {
+	if (i.UserID == nil) == (i.OrganizationID == nil) {
+		return fmt.Errorf("an inventory item belongs to either a user or an organization")
+	}
+
+	if strings.TrimSpace(i.Vendor) == "" || strings.TrimSpace(i.Product) == "" {
+		return fmt.Errorf("vendor and product are required")
+	}
+
+	if len(i.Vendor) > 255 || len(i.Product) > 255 {
+		return fmt.Errorf("vendor and product cannot exceed 255 characters")
+	}
+
+	if len(i.Version) > 100 {
+		return fmt.Errorf("version cannot exceed 100 characters")
+	}
+
+	return nil
+}
+
+// Key returns the vendor and product the item is matched on
+func (i *InventoryItem) Key() ProductKey {
+	return ProductKey{Vendor: i.Vendor, Product: i.Product}
+}
+
+// InventoryOwner is the user or the organization a software inventory belongs to; exactly one is set
+type InventoryOwner struct {
+	UserID         *uuid.UUID
+	OrganizationID *uuid.UUID
+}
+
+// ProductKey identifies a product by its normalized CPE vendor and product names
+type ProductKey struct {
+	Vendor  string
+	Product string
+}
+
+// AffectedProduct is a range of releases of a product that an NVD CVE record lists as vulnerable
+// Version is a single affected release; when it is empty the bounds decide, and with no bounds
+// every release is affected
+type AffectedProduct struct {
+	CVEID                 string `json:"cve_id"`
+	Criteria              string `json:"criteria"` // CPE match string from the NVD configuration
+	Vendor                string `json:"vendor"`
+	Product               string `json:"product"`
+	Version               string `json:"version,omitempty"`
+	VersionStartIncluding string `json:"version_start_including,omitempty"`
+	VersionStartExcluding string `json:"version_start_excluding,omitempty"`
+	VersionEndIncluding   string `json:"version_end_including,omitempty"`
+	VersionEndExcluding   string `json:"version_end_excluding,omitempty"`
+}
+
+// Key returns the vendor and product the range applies to
+func (p *AffectedProduct) Key() ProductKey {
+	return ProductKey{Vendor: p.Vendor, Product: p.Product}
+}
+
+// NVDStatus describes the local copy of NVD affected products
+type NVDStatus struct {
+	CVECount     int        `json:"cve_count"`
+	ProductCount int        `json:"product_count"`
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"` // nil until the first sync
+}
+
+// NVDSyncResult reports one sync of NVD affected products
+type NVDSyncResult struct {
+	CVEsChecked    int       `json:"cves_checked"`
+	Products       int       `json:"products"`
+	Failed         int       `json:"failed"`
+	RateLimited    bool      `json:"rate_limited"` // the sync stopped early when NVD refused further requests
+	ItemsRematched int       `json:"items_rematched"`
+	SyncedAt       time.Time `json:"synced_at"`
+}
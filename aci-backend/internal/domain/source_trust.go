@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditActionSourceTrustCalibrated is the audit log action for an automatic trust score change
+const AuditActionSourceTrustCalibrated = "calibrate_source_trust"
+
+// SourceTrustEventType is a signal recorded against a source when one of its articles changes
+type SourceTrustEventType string
+
+const (
+	SourceTrustEventCorrected SourceTrustEventType = "corrected" // title or content edited after ingestion
+	SourceTrustEventDeleted   SourceTrustEventType = "deleted"
+)
+
+// Weights of each signal in the calibrated trust score; they sum to 1
+const (
+	trustWeightUniqueness = 0.35
+	trustWeightAccuracy   = 0.25
+	trustWeightEngagement = 0.25
+	trustWeightNeutrality = 0.15
+	trustScorePrecision   = 100 // trust scores are stored with two decimals
+)
+
+// SourceTrustSignals are a source's article counts over the calibration window and the rates derived from them
+type SourceTrustSignals struct {
+	SourceID            uuid.UUID  `json:"-"`
+	TrustScore          float64    `json:"-"`
+	LastCalibratedAt    *time.Time `json:"-"`
+	Articles            int        `json:"articles"`
+	Duplicates          int        `json:"duplicates"`
+	Corrected           int        `json:"corrected"`
+	Deleted             int        `json:"deleted"`
+	CompetitorFavorable int        `json:"competitor_favorable"`
+	Reads               int        `json:"reads"`
+
+	DuplicateRate           float64 `json:"duplicate_rate"`
+	CorrectionRate          float64 `json:"correction_rate"`  // corrected or deleted
+	EngagementScore         float64 `json:"engagement_score"` // 0.5 at the average reads per article
+	CompetitorFavorableRate float64 `json:"competitor_favorable_rate"`
+}
+
+// Sample returns the number of articles the signals are based on, including deleted ones
+func (s *SourceTrustSignals) Sample() int {
+	return s.Articles + s.Deleted
+}
+
+// Target computes the signal rates and returns the trust score they support
+// avgReads is the average reads per article across all sources over the same window
+func (s *SourceTrustSignals) Target(avgReads float64) float64 {
+	s.DuplicateRate, s.CorrectionRate, s.CompetitorFavorableRate = 0, 0, 0
+	s.EngagementScore = 0.5
+
+	if s.Articles > 0 {
+		s.DuplicateRate = float64(s.Duplicates) / float64(s.Articles)
+		s.CompetitorFavorableRate = float64(s.CompetitorFavorable) / float64(s.Articles)
+
+		if avgReads > 0 {
+			ratio := float64(s.Reads) / float64(s.Articles) / avgReads
+			s.EngagementScore = ratio / (1 + ratio)
+		}
+	}
+
+	if sample := s.Sample(); sample > 0 {
+		s.CorrectionRate = math.Min(1, float64(s.Corrected+s.Deleted)/float64(sample))
+	}
+
+	target := trustWeightUniqueness*(1-s.DuplicateRate) +
+		trustWeightAccuracy*(1-s.CorrectionRate) +
+		trustWeightEngagement*s.EngagementScore +
+		trustWeightNeutrality*(1-s.CompetitorFavorableRate)
+
+	return RoundTrustScore(target)
+}
+
+// SourceTrustChange records an automatic trust score adjustment and the signals behind it
+type SourceTrustChange struct {
+	ID            uuid.UUID           `json:"id"`
+	SourceID      uuid.UUID           `json:"source_id"`
+	PreviousScore float64             `json:"previous_score"`
+	NewScore      float64             `json:"new_score"`
+	Delta         float64             `json:"delta"`
+	TargetScore   float64             `json:"target_score"`
+	Signals       *SourceTrustSignals `json:"signals"`
+	CreatedAt     time.Time           `json:"created_at"`
+}
+
+// StepTrustScore moves a trust score towards the target by at most maxDelta, staying within [0, 1]
+func StepTrustScore(current, target, maxDelta float64) float64 {
+	delta := math.Max(-maxDelta, math.Min(maxDelta, target-current))
+	return RoundTrustScore(math.Max(0, math.Min(1, current+delta)))
+}
+
+// RoundTrustScore rounds a trust score to the stored precision
+func RoundTrustScore(score float64) float64 {
+	return math.Round(score*trustScorePrecision) / trustScorePrecision
+}
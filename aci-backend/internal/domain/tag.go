@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// MaxTagLength is the longest canonical tag name
+const MaxTagLength = 100
+
+// Audit actions recorded for tag management
+const (
+	AuditActionTagRenamed = "rename_tag"
+	AuditActionTagMerged  = "merge_tag"
+)
+
+// Tag is a canonical article tag; aliases are rewritten to it on ingestion
+type Tag struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Aliases      []string  `json:"aliases"`
+	ArticleCount int       `json:"article_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TagChange reports the outcome of a rename or merge
+type TagChange struct {
+	Tag             *Tag  `json:"tag"`
+	ArticlesUpdated int64 `json:"articles_updated"`
+}
+
+// NormalizeTag returns the canonical spelling of a tag: lowercase, trimmed, with runs of
+// whitespace, underscores and hyphens collapsed to a single hyphen
+func NormalizeTag(tag string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(strings.TrimSpace(tag)) {
+		if unicode.IsSpace(r) || r == '_' || r == '-' {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// NormalizeTags normalizes each tag, dropping blanks and duplicates while keeping first-seen order
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		name := NormalizeTag(tag)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
+
+// ValidateTagName checks that a normalized tag name can be stored
+func ValidateTagName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name is required")
+	}
+
+	if len(name) > MaxTagLength {
+		return fmt.Errorf("tag name cannot exceed %d characters", MaxTagLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tenant audit log actions
+const (
+	AuditActionTenantCreated = "create_tenant"
+	AuditActionTenantUpdated = "update_tenant"
+	AuditActionTenantDeleted = "delete_tenant"
+)
+
+// Tenant limits
+const (
+	MaxTenantSlugLength     = 63
+	MaxTenantNameLength     = 255
+	MaxTenantHostnameLength = 255
+)
+
+var (
+	// tenantSlugPattern matches a DNS label, so the slug can serve as a subdomain
+	tenantSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+	// tenantColorPattern matches a hex RGB color such as #1a73e8
+	tenantColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// Tenant is a customer workspace in a multi-tenant deployment
+// Its users, articles, organizations and webhook secrets are visible only within it; articles
+// without a tenant form the shared feed every workspace reads. Data without a tenant belongs to
+// the default workspace, which is all there is in single-tenant mode
+type Tenant struct {
+	ID        uuid.UUID      `json:"id"`
+	Slug      string         `json:"slug"`
+	Name      string         `json:"name"`
+	Hostname  *string        `json:"hostname,omitempty"` // custom domain, besides <slug>.<TENANT_BASE_DOMAIN>
+	Branding  TenantBranding `json:"branding"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TenantBranding is how the web app presents a workspace; unset fields use the defaults
+type TenantBranding struct {
+	DisplayName  *string `json:"display_name,omitempty"`
+	LogoURL      *string `json:"logo_url,omitempty"`
+	PrimaryColor *string `json:"primary_color,omitempty"`
+	SupportEmail *string `json:"support_email,omitempty"`
+}
+
+// Validate validates the tenant's slug, name, hostname and branding
+func (t *Tenant) Validate() error {
+	t.Slug = strings.ToLower(strings.TrimSpace(t.Slug))
+	t.Name = strings.TrimSpace(t.Name)
+
+	if len(t.Slug) > MaxTenantSlugLength || !tenantSlugPattern.MatchString(t.Slug) {
+		return fmt.Errorf("slug must be up to %d lowercase letters, digits and inner hyphens", MaxTenantSlugLength)
+	}
+
+	if t.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if len(t.Name) > MaxTenantNameLength {
+		return fmt.Errorf("name cannot exceed %d characters", MaxTenantNameLength)
+	}
+
+	if t.Hostname != nil {
+		hostname := strings.ToLower(strings.TrimSpace(*t.Hostname))
+		if hostname == "" || len(hostname) > MaxTenantHostnameLength || strings.ContainsAny(hostname, "/:@ ") {
+			return fmt.Errorf("hostname must be a bare host name such as intel.example.com")
+		}
+		t.Hostname = &hostname
+	}
+
+	if logo := t.Branding.LogoURL; logo != nil {
+		parsed, err := url.Parse(*logo)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return fmt.Errorf("logo_url must be an absolute https URL")
+		}
+	}
+
+	if color := t.Branding.PrimaryColor; color != nil && !tenantColorPattern.MatchString(*color) {
+		return fmt.Errorf("primary_color must be a hex color such as #1a73e8")
+	}
+
+	if email := t.Branding.SupportEmail; email != nil && !strings.Contains(*email, "@") {
+		return fmt.Errorf("support_email must be an email address")
+	}
+
+	return nil
+}
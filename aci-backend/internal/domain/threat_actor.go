@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ThreatActorKind distinguishes ransomware groups from other threat actors
+type ThreatActorKind string
+
+const (
+	ThreatActorKindRansomware ThreatActorKind = "ransomware_group"
+	ThreatActorKindActor      ThreatActorKind = "threat_actor"
+)
+
+// IsValid validates the threat actor kind value
+func (k ThreatActorKind) IsValid() bool {
+	switch k {
+	case ThreatActorKindRansomware, ThreatActorKindActor:
+		return true
+	default:
+		return false
+	}
+}
+
+// ActorMentionSource records how an article was linked to a threat actor
+type ActorMentionSource string
+
+const (
+	// ActorMentionDictionary links come from matching the curated names and aliases in the article text
+	ActorMentionDictionary ActorMentionSource = "dictionary"
+	// ActorMentionAI links come from actors named by the AI enricher
+	ActorMentionAI ActorMentionSource = "ai"
+)
+
+// ThreatActor is a threat actor or ransomware group profile with the articles that mention it
+type ThreatActor struct {
+	ID      uuid.UUID       `json:"id"`
+	Slug    string          `json:"slug"`
+	Name    string          `json:"name"`
+	Kind    ThreatActorKind `json:"kind"`
+	Aliases []string        `json:"aliases"`
+	// MatchName is false when the name is a common word, such as "Play", so only aliases are matched in text
+	MatchName   bool    `json:"-"`
+	Motivation  string  `json:"motivation"` // financial, espionage, hacktivism, terrorism, unknown
+	Origin      *string `json:"origin,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Curated profiles are part of the alias dictionary; others were first named by the AI enricher
+	Curated bool `json:"curated"`
+
+	// Aggregated over published articles mentioning the actor (populated on list)
+	ArticleCount int        `json:"article_count"`
+	FirstSeen    *time.Time `json:"first_seen,omitempty"`
+	LastSeen     *time.Time `json:"last_seen,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ThreatActorFilter represents query parameters for listing threat actors
+type ThreatActorFilter struct {
+	Kind *ThreatActorKind
+	// Search matches names and aliases containing the text, ignoring case
+	Search   *string
+	Page     int
+	PageSize int
+}
+
+// NewThreatActorFilter returns a filter with default values
+func NewThreatActorFilter() *ThreatActorFilter {
+	return &ThreatActorFilter{
+		Page:     1,
+		PageSize: 50,
+	}
+}
+
+// Validate validates the filter parameters
+func (f *ThreatActorFilter) Validate() error {
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+
+	if f.PageSize < 1 {
+		return fmt.Errorf("page_size must be at least 1")
+	}
+
+	if f.PageSize > 100 {
+		return fmt.Errorf("page_size cannot exceed 100")
+	}
+
+	if f.Kind != nil && !f.Kind.IsValid() {
+		return fmt.Errorf("kind must be ransomware_group or threat_actor")
+	}
+
+	return nil
+}
+
+// Offset calculates the offset for pagination
+func (f *ThreatActorFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// ThreatLandscapeInterval is the bucket size of a threat landscape time series
+type ThreatLandscapeInterval string
+
+const (
+	ThreatLandscapeIntervalHour ThreatLandscapeInterval = "hour"
+	ThreatLandscapeIntervalDay  ThreatLandscapeInterval = "day"
+)
+
+// Duration returns the length of one bucket
+func (i ThreatLandscapeInterval) Duration() time.Duration {
+	if i == ThreatLandscapeIntervalHour {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// SeverityTrendPoint counts published articles by severity in one time bucket (UTC)
+type SeverityTrendPoint struct {
+	Bucket        time.Time `json:"bucket"`
+	Critical      int       `json:"critical"`
+	High          int       `json:"high"`
+	Medium        int       `json:"medium"`
+	Low           int       `json:"low"`
+	Informational int       `json:"informational"`
+	Total         int       `json:"total"`
+}
+
+// TermCount is a count for a free-form term such as a vendor or CVE ID
+type TermCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ThreatLandscape summarizes published articles in a window for dashboard charts
+type ThreatLandscape struct {
+	Window        string                  `json:"window"`
+	Interval      ThreatLandscapeInterval `json:"interval"`
+	Since         time.Time               `json:"since"`
+	GeneratedAt   time.Time               `json:"generated_at"`
+	Total         int                     `json:"total"`
+	SeverityTrend []SeverityTrendPoint    `json:"severity_trend"`
+	ByCategory    []NamedCount            `json:"by_category"`
+	TopVendors    []TermCount             `json:"top_vendors"`
+	TopCVEs       []TermCount             `json:"top_cves"`
+}
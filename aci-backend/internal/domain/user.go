@@ -11,18 +11,24 @@ import (
 type UserRole string
 
 const (
-	RoleUser  UserRole = "user"
-	RoleAdmin UserRole = "admin"
+	RoleUser    UserRole = "user"
+	RoleAdmin   UserRole = "admin"
+	RoleAnalyst UserRole = "analyst"
 )
 
 // IsValid checks if the user role is valid
 func (r UserRole) IsValid() error {
-	if r != RoleUser && r != RoleAdmin {
+	if r != RoleUser && r != RoleAdmin && r != RoleAnalyst {
 		return fmt.Errorf("invalid user role: %s", r)
 	}
 	return nil
 }
 
+// CanAnnotate reports whether the role may write article annotations
+func (r UserRole) CanAnnotate() bool {
+	return r == RoleAnalyst || r == RoleAdmin
+}
+
 // String returns the string representation of the role
 func (r UserRole) String() string {
 	return string(r)
@@ -0,0 +1,14 @@
+package domain
+
+// User suspension and ban audit log actions
+const (
+	AuditActionUserSuspended   = "suspend_user"
+	AuditActionUserBanned      = "ban_user"
+	AuditActionUserUnsuspended = "unsuspend_user"
+)
+
+// AuditActionUserInvited is the audit log action for inviting a user or resending their invitation
+const AuditActionUserInvited = "invite_user"
+
+// MaxUserStatusReasonLength bounds the reason given for a suspension or ban
+const MaxUserStatusReasonLength = 500
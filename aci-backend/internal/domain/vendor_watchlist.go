@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxWatchedVendors caps the number of vendors on a user's watchlist
+const MaxWatchedVendors = 50
+
+// WatchedVendor is a vendor on a user's watchlist, typically part of their tech stack
+type WatchedVendor struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Vendor    string     `json:"vendor"`
+	AlertID   *uuid.UUID `json:"alert_id,omitempty"` // nil if the user deleted the watchlist alert
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Validate validates the watched vendor
+func (v *WatchedVendor) Validate() error {
+	if v.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if strings.TrimSpace(v.Vendor) == "" {
+		return fmt.Errorf("vendor is required")
+	}
+
+	if len(v.Vendor) > 255 {
+		return fmt.Errorf("vendor cannot exceed 255 characters")
+	}
+
+	return nil
+}
@@ -35,6 +35,7 @@ type WebhookLog struct {
 	Payload     string        `json:"payload"`
 	WorkflowID  *string       `json:"workflow_id,omitempty"`
 	ExecutionID *string       `json:"execution_id,omitempty"`
+	KeyVersion  *int          `json:"key_version,omitempty"` // secret version that verified the signature
 	ErrorMsg    *string       `json:"error_msg,omitempty"`
 	ProcessedAt *time.Time    `json:"processed_at,omitempty"`
 	CreatedAt   time.Time     `json:"created_at"`
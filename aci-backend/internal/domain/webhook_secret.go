@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditActionWebhookSecretRotated is the audit log action for rotating the n8n webhook secret
+const AuditActionWebhookSecretRotated = "rotate_webhook_secret"
+
+// WebhookSecret is a version of the secret that signs n8n webhooks
+// The newest version is the primary; older versions are accepted until they expire.
+// Version 0 is the secret configured in N8N_WEBHOOK_SECRET. Each workspace numbers its own
+// versions; only the default workspace (TenantID nil) has the configured secret
+type WebhookSecret struct {
+	TenantID  *uuid.UUID `json:"-"`
+	Version   int        `json:"version"`
+	Secret    string     `json:"-"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// IsActive reports whether signatures made with this version are still accepted
+func (s *WebhookSecret) IsActive(now time.Time) bool {
+	return s.ExpiresAt == nil || s.ExpiresAt.After(now)
+}
@@ -0,0 +1,318 @@
+// Package email sends email through an SMTP relay
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig holds the settings of an SMTP relay
+// Username and Password are empty for relays that do not authenticate
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string // sender address, optionally with a display name
+}
+
+// RejectedError is returned when the relay permanently rejects a recipient or message with a
+// 5xx reply, e.g. for an unknown mailbox; sending it again will fail the same way
+type RejectedError struct {
+	Code    int
+	Message string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("SMTP relay rejected message: %d %s", e.Code, e.Message)
+}
+
+// Permanent reports that retrying the message will not help
+func (e *RejectedError) Permanent() bool {
+	return true
+}
+
+// Attachment is a file attached to a message
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// SMTPSender sends multipart HTML and plain-text messages through an SMTP relay
+// Each message opens its own connection, upgraded with STARTTLS when the relay offers it
+type SMTPSender struct {
+	cfg  SMTPConfig
+	from *mail.Address
+}
+
+// NewSMTPSender creates a new SMTP sender instance
+func NewSMTPSender(cfg SMTPConfig) (*SMTPSender, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("host is required")
+	}
+
+	if cfg.Port <= 0 {
+		return nil, fmt.Errorf("port must be positive")
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+
+	return &SMTPSender{cfg: cfg, from: from}, nil
+}
+
+// Send sends one message with HTML and plain-text alternatives
+// headers are added to the message as given, e.g. List-Unsubscribe
+func (s *SMTPSender) Send(ctx context.Context, to, subject, htmlBody, textBody string, headers map[string]string) error {
+	return s.SendWithAttachments(ctx, to, subject, htmlBody, textBody, headers, nil)
+}
+
+// SendWithAttachments sends one message with HTML and plain-text alternatives and the attached files
+func (s *SMTPSender) SendWithAttachments(
+	ctx context.Context,
+	to, subject, htmlBody, textBody string,
+	headers map[string]string,
+	attachments []Attachment,
+) error {
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	message, err := s.buildMessage(recipient, subject, htmlBody, textBody, headers, attachments)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP relay: %w", err)
+	}
+
+	// The SMTP client has no context support, so bound the whole exchange by the deadline
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if s.cfg.Username != "" {
+		auth := smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM rejected: %w", err)
+	}
+
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return rejection(fmt.Errorf("SMTP RCPT TO rejected: %w", err))
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA rejected: %w", err)
+	}
+
+	if _, err := w.Write(message); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return rejection(fmt.Errorf("SMTP relay rejected message: %w", err))
+	}
+
+	return client.Quit()
+}
+
+// rejection converts a permanent (5xx) SMTP reply into a RejectedError and returns other errors as they are
+func rejection(err error) error {
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 && reply.Code < 600 {
+		return &RejectedError{Code: reply.Code, Message: reply.Msg}
+	}
+	return err
+}
+
+// buildMessage assembles the headers and the multipart/alternative body, wrapped in
+// multipart/mixed with the attachments when there are any
+func (s *SMTPSender) buildMessage(
+	to *mail.Address,
+	subject, htmlBody, textBody string,
+	headers map[string]string,
+	attachments []Attachment,
+) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", textBody},
+		{"text/html; charset=utf-8", htmlBody},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create message part: %w", err)
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("failed to encode message part: %w", err)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode message part: %w", err)
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close message: %w", err)
+	}
+
+	contentType := "multipart/alternative; boundary=" + parts.Boundary()
+	if len(attachments) > 0 {
+		mixed, boundary, err := attach(body.Bytes(), contentType, attachments)
+		if err != nil {
+			return nil, err
+		}
+		body.Reset()
+		body.Write(mixed)
+		contentType = "multipart/mixed; boundary=" + boundary
+	}
+
+	messageID, err := s.messageID()
+	if err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	writeHeader := func(name, value string) {
+		// Header values never span lines; drop any line breaks rather than allow header injection
+		value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+		fmt.Fprintf(&message, "%s: %s\r\n", name, value)
+	}
+
+	writeHeader("From", s.from.String())
+	writeHeader("To", to.String())
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", subject))
+	writeHeader("Date", time.Now().Format(time.RFC1123Z))
+	writeHeader("Message-ID", messageID)
+	writeHeader("MIME-Version", "1.0")
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeHeader(textproto.CanonicalMIMEHeaderKey(name), headers[name])
+	}
+
+	writeHeader("Content-Type", contentType)
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// attach wraps a message body of the given content type and the attachments in multipart/mixed,
+// returning the new body and its boundary
+// Attachments are base64 encoded in lines of 76 characters
+func attach(content []byte, contentType string, attachments []Attachment) ([]byte, string, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	w, err := parts.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create message part: %w", err)
+	}
+	if _, err := w.Write(content); err != nil {
+		return nil, "", fmt.Errorf("failed to write message part: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		filename := strings.NewReplacer("\r", "", "\n", "", `"`, "").Replace(attachment.Filename)
+
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": filename})},
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create attachment part: %w", err)
+		}
+
+		encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+		for len(encoded) > 76 {
+			if _, err := io.WriteString(w, encoded[:76]+"\r\n"); err != nil {
+				return nil, "", fmt.Errorf("failed to write attachment: %w", err)
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := io.WriteString(w, encoded+"\r\n"); err != nil {
+			return nil, "", fmt.Errorf("failed to write attachment: %w", err)
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close message: %w", err)
+	}
+
+	return body.Bytes(), parts.Boundary(), nil
+}
+
+// messageID returns a unique Message-ID in the sender's domain
+func (s *SMTPSender) messageID() (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate message ID: %w", err)
+	}
+
+	domain := s.cfg.Host
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		domain = s.from.Address[at+1:]
+	}
+
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">", nil
+}
@@ -0,0 +1,312 @@
+// Package metrics exposes Prometheus metrics for the HTTP API, database pool,
+// WebSocket hub, webhook processing, enrichment worker, caches, refresh token cleanup,
+// and the ingest latency SLO
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "aci"
+
+// Cache names used as the cache label on cache lookups
+const (
+	CacheAIResponse = "ai_response"
+	CachePublicAPI  = "public_api"
+	CacheSearch     = "search"
+)
+
+// Reasons used as the reason label on dropped client events
+const (
+	ClientEventsStale       = "stale"
+	ClientEventsBufferFull  = "buffer_full"
+	ClientEventsWriteFailed = "write_failed"
+)
+
+// registry holds every collector served on /metrics
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method, route pattern, and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	httpRequestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "HTTP requests currently being served.",
+	})
+
+	webhookDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "processing_duration_seconds",
+		Help:      "Webhook processing latency by event type and outcome.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"event_type", "outcome"})
+
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Cache lookups by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	refreshTokensPurged = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "refresh_tokens_purged_total",
+		Help:      "Expired and revoked refresh tokens deleted by the cleanup job.",
+	})
+
+	refreshTokenCleanupLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "refresh_token_cleanup_last_success_timestamp_seconds",
+		Help:      "Unix time the refresh token cleanup job last completed without error.",
+	})
+
+	auditLogsArchived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit",
+		Name:      "logs_archived_total",
+		Help:      "Audit log entries uploaded to object storage and deleted from the database.",
+	})
+
+	auditArchiveLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "audit",
+		Name:      "archive_last_success_timestamp_seconds",
+		Help:      "Unix time the audit log archive job last completed without error.",
+	})
+
+	auditOutboxRelayed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit",
+		Name:      "outbox_relayed_total",
+		Help:      "Staged audit log entries delivered to the audit log.",
+	})
+
+	auditOutboxFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit",
+		Name:      "outbox_delivery_failures_total",
+		Help:      "Failed attempts to deliver a staged audit log entry; the entry is retried.",
+	})
+
+	auditOutboxPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "audit",
+		Name:      "outbox_pending",
+		Help:      "Audit log entries staged in the outbox and not yet delivered.",
+	})
+
+	clientEventsWritten = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "client_events",
+		Name:      "written_total",
+		Help:      "Client engagement events written to the events table.",
+	})
+
+	clientEventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "client_events",
+		Name:      "dropped_total",
+		Help:      "Client engagement events discarded by reason (stale, buffer_full or write_failed).",
+	}, []string{"reason"})
+
+	ingestSLOCompliance = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest_slo",
+		Name:      "compliance",
+		Help:      "Fraction of articles notified within the ingest latency budget over the monitored window.",
+	})
+
+	ingestSLOBudgetBreached = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest_slo",
+		Name:      "budget_breached",
+		Help:      "1 while compliance over the monitored window is below the ingest SLO objective, else 0.",
+	})
+
+	ingestSLOBreaches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest_slo",
+		Name:      "breaches_total",
+		Help:      "Times the ingest latency error budget became exhausted.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		httpRequestsInFlight,
+		webhookDuration,
+		cacheLookups,
+		refreshTokensPurged,
+		refreshTokenCleanupLastSuccess,
+		auditLogsArchived,
+		auditArchiveLastSuccess,
+		auditOutboxRelayed,
+		auditOutboxFailures,
+		auditOutboxPending,
+		clientEventsWritten,
+		clientEventsDropped,
+		ingestSLOCompliance,
+		ingestSLOBudgetBreached,
+		ingestSLOBreaches,
+	)
+}
+
+// Handler serves the registered metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest records one served request; route is the matched route pattern, not the raw path
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// HTTPRequestStarted tracks an in-flight request; call the returned func when it completes
+func HTTPRequestStarted() func() {
+	httpRequestsInFlight.Inc()
+	return httpRequestsInFlight.Dec
+}
+
+// ObserveWebhook records how long a webhook event took to process
+// outcome is "success", "failed", or "rejected"
+func ObserveWebhook(eventType, outcome string, duration time.Duration) {
+	webhookDuration.WithLabelValues(eventType, outcome).Observe(duration.Seconds())
+}
+
+// RecordCacheLookup counts a cache hit or miss
+func RecordCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// AddRefreshTokensPurged counts refresh tokens deleted by the cleanup job
+func AddRefreshTokensPurged(purged int64) {
+	refreshTokensPurged.Add(float64(purged))
+}
+
+// RefreshTokenCleanupSucceeded records the completion time of a cleanup run that finished without error
+func RefreshTokenCleanupSucceeded() {
+	refreshTokenCleanupLastSuccess.SetToCurrentTime()
+}
+
+// AddAuditLogsArchived counts audit log entries moved to object storage
+func AddAuditLogsArchived(archived int64) {
+	auditLogsArchived.Add(float64(archived))
+}
+
+// AuditArchiveSucceeded records the completion time of an archive run that finished without error
+func AuditArchiveSucceeded() {
+	auditArchiveLastSuccess.SetToCurrentTime()
+}
+
+// AddAuditOutboxRelayed counts staged audit log entries delivered to the audit log
+func AddAuditOutboxRelayed(relayed int) {
+	auditOutboxRelayed.Add(float64(relayed))
+}
+
+// AddAuditOutboxFailures counts failed deliveries of staged audit log entries
+func AddAuditOutboxFailures(failures int) {
+	auditOutboxFailures.Add(float64(failures))
+}
+
+// SetAuditOutboxPending records how many audit log entries are waiting in the outbox
+func SetAuditOutboxPending(pending int64) {
+	auditOutboxPending.Set(float64(pending))
+}
+
+// AddClientEventsWritten counts client engagement events written to the events table
+func AddClientEventsWritten(written int) {
+	clientEventsWritten.Add(float64(written))
+}
+
+// AddClientEventsDropped counts client engagement events discarded for the given reason
+func AddClientEventsDropped(reason string, dropped int) {
+	clientEventsDropped.WithLabelValues(reason).Add(float64(dropped))
+}
+
+// ObserveIngestSLO records the latest evaluation of the ingest latency SLO
+func ObserveIngestSLO(compliance float64, breached bool) {
+	ingestSLOCompliance.Set(compliance)
+	if breached {
+		ingestSLOBudgetBreached.Set(1)
+	} else {
+		ingestSLOBudgetBreached.Set(0)
+	}
+}
+
+// IngestSLOBreached counts the ingest latency error budget becoming exhausted
+func IngestSLOBreached() {
+	ingestSLOBreaches.Inc()
+}
+
+// RegisterDBPool exports connection pool statistics, read at scrape time
+func RegisterDBPool(pool *pgxpool.Pool) {
+	if pool == nil {
+		panic("pool cannot be nil")
+	}
+
+	registry.MustRegister(newPoolCollector(pool))
+}
+
+// RegisterWebSocketConnections exports the number of open WebSocket connections, read at scrape time
+func RegisterWebSocketConnections(count func() int) {
+	if count == nil {
+		panic("count cannot be nil")
+	}
+
+	registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "connections",
+		Help:      "Open WebSocket connections.",
+	}, func() float64 { return float64(count()) }))
+}
+
+// RegisterEnrichmentQueue exports the enrichment backlog and in-flight count, read at scrape time
+func RegisterEnrichmentQueue(depth func() int64, inFlight func() int64) {
+	if depth == nil {
+		panic("depth cannot be nil")
+	}
+	if inFlight == nil {
+		panic("inFlight cannot be nil")
+	}
+
+	registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "enrichment",
+			Name:      "queue_depth",
+			Help:      "Unenriched articles found by the enrichment worker's last scan.",
+		}, func() float64 { return float64(depth()) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "enrichment",
+			Name:      "in_flight",
+			Help:      "Articles currently being enriched by the worker.",
+		}, func() float64 { return float64(inFlight()) }),
+	)
+}
@@ -0,0 +1,237 @@
+// Package newsletter renders newsletter emails from the embedded templates
+// Every template has an HTML part, escaped with html/template, and a plain-text part
+package newsletter
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.html templates/*.txt
+var templateFiles embed.FS
+
+//go:embed system/*.html system/*.txt
+var systemFiles embed.FS
+
+const (
+	// DefaultTemplate is used when a campaign does not name one
+	DefaultTemplate = "standard"
+
+	// ConfirmationSubject is the subject of the double opt-in confirmation email
+	ConfirmationSubject = "Confirm your newsletter subscription"
+
+	// WeeklyReportSubject is the subject of the weekly briefing email, formatted with its period
+	WeeklyReportSubject = "Your weekly threat briefing: %s"
+
+	// OrganizationReportSubject is the subject of a scheduled organization report email,
+	// formatted with the report's name and period
+	OrganizationReportSubject = "%s: %s"
+
+	// InvitationSubject is the subject of the email inviting a user to create their account
+	InvitationSubject = "You have been invited to the threat intelligence platform"
+)
+
+// template pairs the HTML and plain-text parts of one newsletter template
+type template struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// templates holds every embedded campaign template by name
+var templates = map[string]template{}
+
+// System emails, which are not offered as campaign templates
+var (
+	// confirmation is the double opt-in confirmation email
+	confirmation template
+
+	// weeklyReport is the weekly briefing email, sent with the briefing PDF attached
+	weeklyReport template
+
+	// organizationReport is an organization report email, sent with the report PDF attached
+	organizationReport template
+
+	// invitation invites a user created by an admin to set their password
+	invitation template
+)
+
+func init() {
+	entries, err := templateFiles.ReadDir("templates")
+	if err != nil {
+		panic(fmt.Sprintf("newsletter: failed to read templates: %v", err))
+	}
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".html")
+		if !ok {
+			continue
+		}
+
+		html, err := htmltemplate.ParseFS(templateFiles, "templates/"+name+".html")
+		if err != nil {
+			panic(fmt.Sprintf("newsletter: failed to parse %s.html: %v", name, err))
+		}
+
+		text, err := texttemplate.ParseFS(templateFiles, "templates/"+name+".txt")
+		if err != nil {
+			panic(fmt.Sprintf("newsletter: failed to parse %s.txt: %v", name, err))
+		}
+
+		templates[name] = template{html: html, text: text}
+	}
+
+	confirmation = parseSystem("confirmation")
+	weeklyReport = parseSystem("weekly_report")
+	organizationReport = parseSystem("organization_report")
+	invitation = parseSystem("invitation")
+}
+
+// parseSystem parses both parts of a system email
+func parseSystem(name string) template {
+	html, err := htmltemplate.ParseFS(systemFiles, "system/"+name+".html")
+	if err != nil {
+		panic(fmt.Sprintf("newsletter: failed to parse %s.html: %v", name, err))
+	}
+
+	text, err := texttemplate.ParseFS(systemFiles, "system/"+name+".txt")
+	if err != nil {
+		panic(fmt.Sprintf("newsletter: failed to parse %s.txt: %v", name, err))
+	}
+
+	return template{html: html, text: text}
+}
+
+// Article is one featured article as it appears in a newsletter
+type Article struct {
+	Title    string
+	Summary  string
+	Severity string
+	Category string
+	URL      string // where the article link points, usually a click-tracking URL
+}
+
+// Data is everything a template renders
+type Data struct {
+	Subject   string
+	Preheader string
+	// Intro is split into paragraphs on blank lines
+	Intro          string
+	Articles       []Article
+	UnsubscribeURL string
+	OpenPixelURL   string // omitted from previews
+}
+
+// IntroParagraphs returns the intro's paragraphs with surrounding whitespace trimmed
+func (d Data) IntroParagraphs() []string {
+	paragraphs := make([]string, 0)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(d.Intro, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	return paragraphs
+}
+
+// ConfirmationData is everything the confirmation email renders
+type ConfirmationData struct {
+	Name           string
+	ConfirmURL     string
+	UnsubscribeURL string // lets someone signed up by another person ask not to be mailed again
+	ExpiresIn      string // how long the confirmation link stays valid, e.g. 3 days
+}
+
+// WeeklyReportData is everything the weekly briefing email renders
+type WeeklyReportData struct {
+	Name         string
+	Period       string // the week covered, e.g. Oct 5 - Oct 11, 2026
+	Articles     int
+	Critical     int
+	High         int
+	AlertMatches int
+	DashboardURL string
+}
+
+// OrganizationReportData is everything an organization report email renders
+type OrganizationReportData struct {
+	ReportName   string
+	Period       string
+	Articles     int
+	Critical     int
+	High         int
+	DashboardURL string
+}
+
+// InvitationData is everything the invitation email renders
+type InvitationData struct {
+	Name         string
+	InvitedBy    string // name of the admin who sent the invitation
+	Organization string // organization the invitee joins, if any
+	AcceptURL    string
+	ExpiresIn    string // how long the invitation link stays valid, e.g. 3 days
+}
+
+// Templates returns the names of the available templates, sorted
+func Templates() []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Exists reports whether a template with the given name exists
+func Exists(name string) bool {
+	_, ok := templates[name]
+	return ok
+}
+
+// Render renders both parts of the named template
+func Render(name string, data Data) (htmlBody, textBody string, err error) {
+	tmpl, ok := templates[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown newsletter template %q", name)
+	}
+
+	return tmpl.render(name, data)
+}
+
+// RenderConfirmation renders both parts of the double opt-in confirmation email
+func RenderConfirmation(data ConfirmationData) (htmlBody, textBody string, err error) {
+	return confirmation.render("confirmation", data)
+}
+
+// RenderWeeklyReport renders both parts of the weekly briefing email
+func RenderWeeklyReport(data WeeklyReportData) (htmlBody, textBody string, err error) {
+	return weeklyReport.render("weekly_report", data)
+}
+
+// RenderOrganizationReport renders both parts of an organization report email
+func RenderOrganizationReport(data OrganizationReportData) (htmlBody, textBody string, err error) {
+	return organizationReport.render("organization_report", data)
+}
+
+// RenderInvitation renders both parts of the user invitation email
+func RenderInvitation(data InvitationData) (htmlBody, textBody string, err error) {
+	return invitation.render("invitation", data)
+}
+
+// render executes both parts of a template
+func (t template) render(name string, data interface{}) (string, string, error) {
+	var html, text bytes.Buffer
+
+	if err := t.html.Execute(&html, data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s.html: %w", name, err)
+	}
+
+	if err := t.text.Execute(&text, data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s.txt: %w", name, err)
+	}
+
+	return html.String(), text.String(), nil
+}
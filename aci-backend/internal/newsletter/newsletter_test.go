@@ -0,0 +1,148 @@
+package newsletter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleData() Data {
+	return Data{
+		Subject: "Weekly <threat> briefing",
+		Intro:   "First paragraph.\n\n  Second paragraph.  \n\n\n",
+		Articles: []Article{
+			{Title: "Alpha & Omega breach", Summary: "Summary one", Severity: "critical", Category: "Ransomware", URL: "https://api.example.com/n/c/1/a"},
+			{Title: "Beta campaign", Severity: "high", URL: "https://api.example.com/n/c/1/b"},
+		},
+		UnsubscribeURL: "https://api.example.com/n/u/1",
+		OpenPixelURL:   "https://api.example.com/n/o/1",
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	names := Templates()
+
+	assert.Contains(t, names, DefaultTemplate)
+	assert.Contains(t, names, "compact")
+	assert.True(t, Exists(DefaultTemplate))
+	assert.False(t, Exists("missing"))
+}
+
+func TestIntroParagraphs(t *testing.T) {
+	assert.Equal(t, []string{"First paragraph.", "Second paragraph."}, sampleData().IntroParagraphs())
+	assert.Empty(t, Data{Intro: "  \n\n "}.IntroParagraphs())
+}
+
+func TestRender(t *testing.T) {
+	for _, name := range Templates() {
+		t.Run(name, func(t *testing.T) {
+			html, text, err := Render(name, sampleData())
+			require.NoError(t, err)
+
+			// Articles appear in the order given
+			assert.Less(t, strings.Index(html, "Alpha"), strings.Index(html, "Beta campaign"))
+			assert.Less(t, strings.Index(text, "Alpha"), strings.Index(text, "Beta campaign"))
+
+			// HTML is escaped, plain text is not
+			assert.Contains(t, html, "Alpha &amp; Omega breach")
+			assert.NotContains(t, html, "<threat>")
+			assert.Contains(t, text, "Alpha & Omega breach")
+
+			assert.Contains(t, html, "https://api.example.com/n/u/1")
+			assert.Contains(t, text, "https://api.example.com/n/u/1")
+			assert.Contains(t, html, "https://api.example.com/n/o/1")
+			assert.Contains(t, html, "Second paragraph.")
+		})
+	}
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	_, _, err := Render("missing", sampleData())
+	assert.Error(t, err)
+}
+
+func TestRenderConfirmation(t *testing.T) {
+	html, text, err := RenderConfirmation(ConfirmationData{
+		Name:           "Jane <script>",
+		ConfirmURL:     "https://app.example.com/newsletter/confirm?token=abc.def",
+		UnsubscribeURL: "https://app.example.com/newsletter/unsubscribe?token=ghi.jkl",
+		ExpiresIn:      "3 days",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "Hi Jane &lt;script&gt;,")
+	assert.Contains(t, html, "https://app.example.com/newsletter/confirm?token=abc.def")
+	assert.Contains(t, html, "https://app.example.com/newsletter/unsubscribe?token=ghi.jkl")
+	assert.Contains(t, text, "https://app.example.com/newsletter/confirm?token=abc.def")
+	assert.Contains(t, text, "3 days")
+
+	// The confirmation email is not offered as a campaign template
+	assert.False(t, Exists("confirmation"))
+}
+
+func TestRenderWeeklyReport(t *testing.T) {
+	html, text, err := RenderWeeklyReport(WeeklyReportData{
+		Name:         "Jane",
+		Period:       "Oct 5 - Oct 11, 2026",
+		Articles:     42,
+		Critical:     3,
+		High:         9,
+		AlertMatches: 7,
+		DashboardURL: "https://app.example.com/dashboard",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "Hi Jane,")
+	assert.Contains(t, html, "Oct 5 - Oct 11, 2026")
+	assert.Contains(t, html, "https://app.example.com/dashboard")
+	assert.Contains(t, text, "42 articles, 3 critical and 9 high severity")
+	assert.Contains(t, text, "7 alert matches")
+	assert.Contains(t, text, "https://app.example.com/dashboard")
+
+	assert.False(t, Exists("weekly_report"))
+}
+
+func TestRenderOrganizationReport(t *testing.T) {
+	html, text, err := RenderOrganizationReport(OrganizationReportData{
+		ReportName:   "Ransomware & Extortion",
+		Period:       "Sep 1 - Sep 30, 2026",
+		Articles:     120,
+		Critical:     8,
+		High:         31,
+		DashboardURL: "https://app.example.com/dashboard",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "Ransomware &amp; Extortion")
+	assert.Contains(t, text, "Ransomware & Extortion")
+	assert.Contains(t, text, "120 articles, 8 critical and 31 high severity")
+	assert.Contains(t, html, "https://app.example.com/dashboard")
+}
+
+func TestRenderInvitation(t *testing.T) {
+	html, text, err := RenderInvitation(InvitationData{
+		Name:         "Jane",
+		InvitedBy:    "Ada <Admin>",
+		Organization: "Acme & Co",
+		AcceptURL:    "https://app.example.com/invite/accept?token=abc.def",
+		ExpiresIn:    "7 days",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "Hi Jane,")
+	assert.Contains(t, html, "Ada &lt;Admin&gt; has invited you")
+	assert.Contains(t, html, "as part of Acme &amp; Co")
+	assert.Contains(t, html, "https://app.example.com/invite/accept?token=abc.def")
+	assert.Contains(t, text, "Ada <Admin> has invited you")
+	assert.Contains(t, text, "https://app.example.com/invite/accept?token=abc.def")
+	assert.Contains(t, text, "7 days")
+
+	html, _, err = RenderInvitation(InvitationData{AcceptURL: "https://app.example.com/invite/accept?token=x"})
+	require.NoError(t, err)
+	assert.Contains(t, html, "You have been invited to join")
+	assert.NotContains(t, html, "as part of")
+
+	assert.False(t, Exists("invitation"))
+}
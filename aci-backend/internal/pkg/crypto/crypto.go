@@ -1,9 +1,12 @@
 package crypto
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 
@@ -20,6 +23,9 @@ const (
 
 	// defaultTokenLength is the default length for random tokens in bytes
 	defaultTokenLength = 32
+
+	// EncryptionKeyLength is the length of the keys Encrypt and Decrypt take (AES-256)
+	EncryptionKeyLength = 32
 )
 
 // HashPassword hashes a password using bcrypt with cost factor 12
@@ -234,3 +240,82 @@ func VerifyHMAC(secret, payload, signature string) bool {
 func SecureCompare(a, b string) bool {
 	return hmac.Equal([]byte(a), []byte(b))
 }
+
+// Encrypt encrypts a secret with AES-256-GCM for storage
+//
+// Unlike HashToken, the secret can be recovered with Decrypt, so use this only for values
+// the server must use again, such as signing keys. Each call uses a random nonce, so
+// encrypting the same value twice gives different results.
+//
+// The result is base64 and contains the nonce followed by the sealed value.
+//
+// Example:
+//
+//	key, _ := hex.DecodeString(os.Getenv("SECRET_KEY")) // 32 bytes
+//	stored, err := Encrypt(key, signingSecret)
+//	if err != nil {
+//	    return err
+//	}
+//	db.SaveSecret(stored)
+func Encrypt(key []byte, plaintext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt recovers a secret encrypted by Encrypt with the same key
+//
+// Returns an error if the key is wrong or the value was modified.
+//
+// Example:
+//
+//	signingSecret, err := Decrypt(key, stored)
+//	if err != nil {
+//	    return err
+//	}
+func Decrypt(key []byte, ciphertext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, sealed := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// newGCM returns the AES-256-GCM cipher for key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeyLength {
+		return nil, fmt.Errorf("encryption key must be %d bytes", EncryptionKeyLength)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,44 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, EncryptionKeyLength)
+
+	first, err := Encrypt(key, "webhook-secret")
+	require.NoError(t, err)
+	second, err := Encrypt(key, "webhook-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second, "each encryption uses a new nonce")
+	assert.NotContains(t, first, "webhook-secret")
+
+	value, err := Decrypt(key, first)
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-secret", value)
+}
+
+func TestDecrypt_Rejects(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, EncryptionKeyLength)
+	encrypted, err := Encrypt(key, "webhook-secret")
+	require.NoError(t, err)
+
+	_, err = Decrypt(bytes.Repeat([]byte{8}, EncryptionKeyLength), encrypted)
+	assert.Error(t, err, "wrong key")
+
+	tampered := []byte(encrypted)
+	tampered[len(tampered)-3] ^= 1
+	_, err = Decrypt(key, string(tampered))
+	assert.Error(t, err, "modified ciphertext")
+
+	_, err = Decrypt(key, "AAAA")
+	assert.Error(t, err, "too short")
+
+	_, err = Encrypt(key[:16], "webhook-secret")
+	assert.Error(t, err, "short key")
+}
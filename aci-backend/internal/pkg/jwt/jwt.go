@@ -31,11 +31,36 @@ type Claims struct {
 	UserID uuid.UUID `json:"user_id"`
 	Email  string    `json:"email"`
 	Role   string    `json:"role"`
+
+	// Organization membership at issue time; authorization re-checks membership
+	OrgID   *uuid.UUID `json:"org_id,omitempty"`
+	OrgRole string     `json:"org_role,omitempty"`
+
+	// Workspace of the user in multi-tenant mode; absent for the default workspace
+	TenantID *uuid.UUID `json:"tenant_id,omitempty"`
+}
+
+// TokenOption adds optional claims to a generated access token
+type TokenOption func(*Claims)
+
+// WithOrganization records the user's organization membership in the access token
+func WithOrganization(orgID uuid.UUID, orgRole string) TokenOption {
+	return func(c *Claims) {
+		c.OrgID = &orgID
+		c.OrgRole = orgRole
+	}
+}
+
+// WithTenant records the user's workspace in the access token
+func WithTenant(tenantID uuid.UUID) TokenOption {
+	return func(c *Claims) {
+		c.TenantID = &tenantID
+	}
 }
 
 // Service defines the interface for JWT operations
 type Service interface {
-	GenerateTokenPair(userID uuid.UUID, email, role string) (*TokenPair, error)
+	GenerateTokenPair(userID uuid.UUID, email, role string, opts ...TokenOption) (*TokenPair, error)
 	ValidateAccessToken(tokenString string) (*Claims, error)
 	ValidateRefreshToken(tokenString string) (uuid.UUID, error)
 }
@@ -128,7 +153,7 @@ func (s *service) LoadPublicKey(path string) error {
 }
 
 // GenerateTokenPair generates both access and refresh tokens
-func (s *service) GenerateTokenPair(userID uuid.UUID, email, role string) (*TokenPair, error) {
+func (s *service) GenerateTokenPair(userID uuid.UUID, email, role string, opts ...TokenOption) (*TokenPair, error) {
 	if userID == uuid.Nil {
 		return nil, fmt.Errorf("user ID is required")
 	}
@@ -163,6 +188,10 @@ func (s *service) GenerateTokenPair(userID uuid.UUID, email, role string) (*Toke
 		Role:   role,
 	}
 
+	for _, opt := range opts {
+		opt(accessClaims)
+	}
+
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodRS256, accessClaims)
 	accessTokenString, err := accessToken.SignedString(s.privateKey)
 	if err != nil {
@@ -0,0 +1,40 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// DefaultKeyBits is the RSA key size used for new signing keys
+const DefaultKeyBits = 2048
+
+// GenerateKeyPair creates a new RSA signing key pair encoded as PEM
+// The private key is PKCS#8 and the public key PKIX, matching the files LoadPrivateKey and LoadPublicKey read
+func GenerateKeyPair(bits int) (privatePEM, publicPEM []byte, err error) {
+	if bits < DefaultKeyBits {
+		return nil, nil, fmt.Errorf("key size must be at least %d bits", DefaultKeyBits)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode private key: %w", err)
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	privatePEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	return privatePEM, publicPEM, nil
+}
@@ -0,0 +1,61 @@
+// Package requestctx carries metadata about the HTTP request that started a piece of work, so
+// that logs written by services and by background tasks the request spawns can be tied back to it
+package requestctx
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Metadata describes the request a context belongs to
+type Metadata struct {
+	RequestID string
+	Method    string
+	Path      string
+}
+
+type metadataKey struct{}
+
+// With returns a copy of ctx carrying meta
+func With(ctx context.Context, meta Metadata) context.Context {
+	return context.WithValue(ctx, metadataKey{}, meta)
+}
+
+// From returns the metadata carried by ctx; ok is false outside a request, e.g. in scheduled jobs
+func From(ctx context.Context) (meta Metadata, ok bool) {
+	meta, ok = ctx.Value(metadataKey{}).(Metadata)
+	return meta, ok
+}
+
+// RequestID returns the ID of the request ctx belongs to, or "" outside a request
+func RequestID(ctx context.Context) string {
+	meta, _ := From(ctx)
+	return meta.RequestID
+}
+
+// Detach returns a context for work that outlives the request: it is not cancelled when the
+// response is written, but keeps the request's metadata, tenant scope and trace
+func Detach(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
+}
+
+// Go runs fn in a new goroutine with a context detached from ctx
+// A panic in fn is logged with the request ID instead of crashing the server
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	detached := Detach(ctx)
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Error().
+					Str("request_id", RequestID(detached)).
+					Interface("panic", err).
+					Bytes("stack", debug.Stack()).
+					Msg("Panic recovered in background task")
+			}
+		}()
+
+		fn(detached)
+	}()
+}
@@ -0,0 +1,60 @@
+package requestctx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	assert.Empty(t, RequestID(context.Background()))
+
+	ctx := With(context.Background(), Metadata{RequestID: "req-123", Method: "GET", Path: "/v1/articles"})
+	assert.Equal(t, "req-123", RequestID(ctx))
+
+	meta, ok := From(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "/v1/articles", meta.Path)
+}
+
+func TestGo_OutlivesTheRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(With(context.Background(), Metadata{RequestID: "req-123"}))
+
+	started := make(chan struct{})
+	done := make(chan string, 1)
+	Go(ctx, func(ctx context.Context) {
+		<-started
+		if ctx.Err() != nil {
+			done <- "cancelled"
+			return
+		}
+		done <- RequestID(ctx)
+	})
+
+	// The request ends before the task runs
+	cancel()
+	close(started)
+
+	select {
+	case got := <-done:
+		assert.Equal(t, "req-123", got)
+	case <-time.After(time.Second):
+		t.Fatal("background task did not run")
+	}
+}
+
+func TestGo_RecoversPanics(t *testing.T) {
+	done := make(chan struct{})
+	Go(context.Background(), func(ctx context.Context) {
+		defer close(done)
+		panic("boom")
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("background task did not run")
+	}
+}
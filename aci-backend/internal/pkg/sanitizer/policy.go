@@ -0,0 +1,100 @@
+package sanitizer
+
+// Policy is an allowlist of the elements and attributes sanitized HTML may keep
+// Elements not listed are unwrapped, keeping their text; elements that never hold readable
+// content (script, style, iframe, svg, ...) are removed with everything inside them
+type Policy struct {
+	// Elements maps each allowed element to the attributes it may keep
+	Elements map[string][]string
+
+	// GlobalAttributes may be kept on any allowed element
+	GlobalAttributes []string
+
+	// URLSchemes are the schemes allowed in href, src and cite; other URLs drop the attribute
+	URLSchemes []string
+
+	// AllowRelativeURLs keeps URLs without a scheme, e.g. links to other pages of the site
+	AllowRelativeURLs bool
+
+	// LinkRel, when set, replaces the rel attribute of every link that keeps its href
+	LinkRel string
+}
+
+// FeedPolicy is for content ingested from feeds and other third-party sources
+// It keeps basic formatting and absolute http(s) links, marked nofollow
+func FeedPolicy() *Policy {
+	return &Policy{
+		Elements: map[string][]string{
+			"p":          nil,
+			"br":         nil,
+			"strong":     nil,
+			"b":          nil,
+			"em":         nil,
+			"i":          nil,
+			"u":          nil,
+			"h1":         nil,
+			"h2":         nil,
+			"h3":         nil,
+			"h4":         nil,
+			"h5":         nil,
+			"h6":         nil,
+			"ul":         nil,
+			"ol":         nil,
+			"li":         nil,
+			"a":          {"href", "title"},
+			"code":       nil,
+			"pre":        nil,
+			"blockquote": nil,
+		},
+		URLSchemes: []string{"http", "https"},
+		LinkRel:    "nofollow noopener noreferrer",
+	}
+}
+
+// EditorialPolicy is for content written by administrators
+// On top of the feed policy it keeps images, tables, figures and finer inline markup,
+// and allows mailto and relative links
+func EditorialPolicy() *Policy {
+	policy := FeedPolicy()
+
+	for element, attributes := range map[string][]string{
+		"img":        {"src", "alt", "width", "height"},
+		"figure":     nil,
+		"figcaption": nil,
+		"hr":         nil,
+		"div":        nil,
+		"span":       nil,
+		"sub":        nil,
+		"sup":        nil,
+		"s":          nil,
+		"del":        nil,
+		"ins":        nil,
+		"mark":       nil,
+		"small":      nil,
+		"kbd":        nil,
+		"abbr":       nil,
+		"cite":       nil,
+		"q":          {"cite"},
+		"blockquote": {"cite"},
+		"dl":         nil,
+		"dt":         nil,
+		"dd":         nil,
+		"table":      nil,
+		"caption":    nil,
+		"thead":      nil,
+		"tbody":      nil,
+		"tfoot":      nil,
+		"tr":         nil,
+		"th":         {"colspan", "rowspan", "scope"},
+		"td":         {"colspan", "rowspan"},
+	} {
+		policy.Elements[element] = attributes
+	}
+
+	policy.GlobalAttributes = []string{"title"}
+	policy.URLSchemes = []string{"http", "https", "mailto"}
+	policy.AllowRelativeURLs = true
+	policy.LinkRel = "noopener noreferrer"
+
+	return policy
+}
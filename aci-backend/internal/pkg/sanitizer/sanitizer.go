@@ -2,73 +2,130 @@ package sanitizer
 
 import (
 	"fmt"
-	"html"
 	"net/url"
 	"regexp"
 	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
-var (
-	// allowedTags defines safe HTML tags for formatting
-	allowedTags = map[string]bool{
-		"p":      true,
-		"br":     true,
-		"strong": true,
-		"em":     true,
-		"ul":     true,
-		"ol":     true,
-		"li":     true,
-		"a":      true,
-		"code":   true,
-		"pre":    true,
-	}
+// removedElements never hold content readers should see and are dropped with their children
+var removedElements = map[atom.Atom]bool{
+	atom.Script:    true,
+	atom.Style:     true,
+	atom.Noscript:  true,
+	atom.Template:  true,
+	atom.Iframe:    true,
+	atom.Frame:     true,
+	atom.Frameset:  true,
+	atom.Noframes:  true,
+	atom.Object:    true,
+	atom.Embed:     true,
+	atom.Applet:    true,
+	atom.Noembed:   true,
+	atom.Xmp:       true,
+	atom.Plaintext: true,
+	atom.Title:     true,
+	atom.Head:      true,
+	atom.Form:      true,
+	atom.Button:    true,
+	atom.Input:     true,
+	atom.Select:    true,
+	atom.Textarea:  true,
+	atom.Svg:       true,
+	atom.Math:      true,
+}
 
-	// dangerousTags must be removed for security
-	dangerousTags = []string{
-		"script", "iframe", "object", "embed", "form",
-		"input", "button", "textarea", "select", "option",
-		"style", "link", "meta", "base", "frame", "frameset",
-	}
+// blockElements separate words in extracted text
+var blockElements = map[atom.Atom]bool{
+	atom.P:          true,
+	atom.Br:         true,
+	atom.Div:        true,
+	atom.Li:         true,
+	atom.Tr:         true,
+	atom.Td:         true,
+	atom.Th:         true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Pre:        true,
+	atom.Blockquote: true,
+}
 
-	// htmlTagRegex matches HTML tags
-	htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+// voidElements have no closing tag
+var voidElements = map[string]bool{
+	"br":  true,
+	"hr":  true,
+	"img": true,
+}
 
-	// scriptEventRegex matches event handlers (onclick, onerror, etc.)
-	scriptEventRegex = regexp.MustCompile(`(?i)\s*on\w+\s*=`)
+// urlAttributes hold URLs, which must pass the policy's scheme check
+var urlAttributes = map[string]bool{
+	"href": true,
+	"src":  true,
+	"cite": true,
+}
 
-	// allowedSchemes for URLs
-	allowedSchemes = map[string]bool{
-		"http":  true,
-		"https": true,
-	}
-)
+// allowedSchemes for SanitizeURL
+var allowedSchemes = map[string]bool{
+	"http":  true,
+	"https": true,
+}
 
 // Sanitizer handles HTML/text sanitization
+// Input is parsed the way a browser parses it, so nested and malformed markup cannot hide
+// from the allowlist, and only allowed elements and attributes are written back out
 type Sanitizer struct {
 	maxURLLength int
+
+	elements          map[string]map[string]bool
+	globalAttributes  map[string]bool
+	urlSchemes        map[string]bool
+	allowRelativeURLs bool
+	linkRel           string
 }
 
-// New creates a new sanitizer with default configuration
+// New creates a new sanitizer with the feed policy
 //
 // Example usage:
 //
 //	s := sanitizer.New()
 //	clean := s.SanitizeHTML(userInput)
 func New() *Sanitizer {
-	return &Sanitizer{
-		maxURLLength: 2048,
+	return NewWithPolicy(FeedPolicy())
+}
+
+// NewWithPolicy creates a sanitizer that keeps what policy allows
+// The policy is copied, so changing it afterwards does not affect the sanitizer
+func NewWithPolicy(policy *Policy) *Sanitizer {
+	s := &Sanitizer{
+		maxURLLength:      2048,
+		elements:          make(map[string]map[string]bool, len(policy.Elements)),
+		globalAttributes:  toSet(policy.GlobalAttributes),
+		urlSchemes:        toSet(policy.URLSchemes),
+		allowRelativeURLs: policy.AllowRelativeURLs,
+		linkRel:           policy.LinkRel,
 	}
+
+	for element, attributes := range policy.Elements {
+		s.elements[strings.ToLower(element)] = toSet(attributes)
+	}
+
+	return s
 }
 
-// SanitizeHTML removes dangerous HTML tags while preserving safe formatting
-//
-// Allowed tags: p, br, strong, em, ul, ol, li, a (with sanitized href), code, pre
-// Removed tags: script, iframe, object, embed, form, input, style, and all event handlers
+// SanitizeHTML keeps the elements and attributes the policy allows
 //
-// The function also:
-// - Escapes remaining HTML entities
-// - Removes event handlers (onclick, onerror, etc.)
-// - Sanitizes href attributes in <a> tags
+// The function:
+// - Removes script, style, iframe, object, svg, math, form controls and their content
+// - Unwraps other disallowed elements, keeping their text
+// - Drops attributes not on the allowlist, including every event handler and style
+// - Drops URLs whose scheme is not allowed (javascript:, data:, vbscript:, ...)
+// - Escapes all text, so output never contains markup the policy did not allow
 //
 // Example:
 //
@@ -80,36 +137,26 @@ func (s *Sanitizer) SanitizeHTML(input string) string {
 		return ""
 	}
 
-	// First pass: remove dangerous tags completely
-	cleaned := input
-	for _, tag := range dangerousTags {
-		// Remove both opening and closing tags with content
-		pattern := regexp.MustCompile(fmt.Sprintf(`(?i)<\s*%s[^>]*>.*?<\s*/\s*%s\s*>`, tag, tag))
-		cleaned = pattern.ReplaceAllString(cleaned, "")
-
-		// Remove self-closing dangerous tags
-		pattern = regexp.MustCompile(fmt.Sprintf(`(?i)<\s*%s[^>]*/?>`, tag))
-		cleaned = pattern.ReplaceAllString(cleaned, "")
+	nodes, err := parseFragment(input)
+	if err != nil {
+		// A strings.Reader never fails; escaping everything is the safe fallback
+		return html.EscapeString(input)
 	}
 
-	// Remove event handlers from all remaining tags
-	cleaned = scriptEventRegex.ReplaceAllString(cleaned, "")
-
-	// Second pass: sanitize URLs in allowed tags
-	cleaned = s.sanitizeHrefAttributes(cleaned)
-
-	// Third pass: remove any tags not in allowedTags
-	cleaned = s.removeDisallowedTags(cleaned)
+	var buf strings.Builder
+	for _, n := range nodes {
+		s.render(&buf, n)
+	}
 
-	return strings.TrimSpace(cleaned)
+	return strings.TrimSpace(buf.String())
 }
 
 // SanitizeText removes all HTML and returns plain text
 //
 // This function:
-// - Strips all HTML tags
+// - Strips all HTML tags, dropping the content of scripts, styles and similar elements
 // - Decodes HTML entities
-// - Trims whitespace
+// - Normalizes whitespace
 //
 // Example:
 //
@@ -121,16 +168,17 @@ func (s *Sanitizer) SanitizeText(input string) string {
 		return ""
 	}
 
-	// Remove all HTML tags
-	text := htmlTagRegex.ReplaceAllString(input, "")
-
-	// Decode HTML entities
-	text = html.UnescapeString(text)
+	nodes, err := parseFragment(input)
+	if err != nil {
+		return strings.Join(strings.Fields(input), " ")
+	}
 
-	// Normalize whitespace
-	text = strings.Join(strings.Fields(text), " ")
+	var buf strings.Builder
+	for _, n := range nodes {
+		extractText(&buf, n)
+	}
 
-	return strings.TrimSpace(text)
+	return strings.Join(strings.Fields(buf.String()), " ")
 }
 
 // SanitizeURL validates and sanitizes URLs
@@ -182,53 +230,168 @@ func (s *Sanitizer) SanitizeURL(rawURL string) (string, error) {
 	return parsedURL.String(), nil
 }
 
-// sanitizeHrefAttributes sanitizes href attributes in anchor tags
-func (s *Sanitizer) sanitizeHrefAttributes(input string) string {
-	hrefPattern := regexp.MustCompile(`(?i)<a\s+[^>]*href\s*=\s*["']([^"']+)["'][^>]*>`)
+// render writes a node if the policy allows it, or its allowed descendants if not
+func (s *Sanitizer) render(buf *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		buf.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		// Comments and doctypes are dropped
+		return
+	}
+
+	// Foreign (SVG and MathML) content parses by different rules and is never kept
+	if n.Namespace != "" || removedElements[n.DataAtom] {
+		return
+	}
+
+	name := strings.ToLower(n.Data)
+	attributes, ok := s.elements[name]
+	if !ok {
+		s.renderChildren(buf, n)
+		return
+	}
+
+	buf.WriteByte('<')
+	buf.WriteString(name)
 
-	return hrefPattern.ReplaceAllStringFunc(input, func(match string) string {
-		// Extract href value
-		hrefValue := hrefPattern.FindStringSubmatch(match)
-		if len(hrefValue) < 2 {
-			// Remove anchor if we can't extract href
-			return ""
+	hasHref := false
+	for _, attr := range n.Attr {
+		key := strings.ToLower(attr.Key)
+		if attr.Namespace != "" || !(attributes[key] || s.globalAttributes[key]) {
+			continue
+		}
+		if name == "a" && key == "rel" && s.linkRel != "" {
+			continue
 		}
 
-		// Sanitize the URL
-		cleanURL, err := s.SanitizeURL(hrefValue[1])
-		if err != nil {
-			// Remove anchor if URL is invalid
-			return ""
+		value := attr.Val
+		if urlAttributes[key] {
+			var ok bool
+			if value, ok = s.allowedURL(value); !ok {
+				continue
+			}
+			if key == "href" {
+				hasHref = true
+			}
 		}
 
-		// Return sanitized anchor tag
-		return fmt.Sprintf(`<a href="%s">`, html.EscapeString(cleanURL))
-	})
+		buf.WriteByte(' ')
+		buf.WriteString(key)
+		buf.WriteString(`="`)
+		buf.WriteString(html.EscapeString(value))
+		buf.WriteByte('"')
+	}
+
+	if name == "a" && hasHref && s.linkRel != "" {
+		buf.WriteString(` rel="`)
+		buf.WriteString(html.EscapeString(s.linkRel))
+		buf.WriteByte('"')
+	}
+
+	buf.WriteByte('>')
+
+	if voidElements[name] {
+		return
+	}
+
+	s.renderChildren(buf, n)
+
+	buf.WriteString("</")
+	buf.WriteString(name)
+	buf.WriteByte('>')
 }
 
-// removeDisallowedTags removes HTML tags not in allowedTags list
-func (s *Sanitizer) removeDisallowedTags(input string) string {
-	tagPattern := regexp.MustCompile(`<\s*/?\s*([a-zA-Z][a-zA-Z0-9]*)[^>]*>`)
+// renderChildren renders each child of a node
+func (s *Sanitizer) renderChildren(buf *strings.Builder, n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		s.render(buf, c)
+	}
+}
 
-	return tagPattern.ReplaceAllStringFunc(input, func(match string) string {
-		// Extract tag name
-		tagMatch := tagPattern.FindStringSubmatch(match)
-		if len(tagMatch) < 2 {
-			return ""
+// allowedURL returns the URL with the whitespace browsers ignore removed, and whether the
+// policy allows it
+func (s *Sanitizer) allowedURL(raw string) (string, bool) {
+	// Browsers drop tabs and newlines anywhere in a URL, so "java\tscript:" is still javascript:
+	cleaned := strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
 		}
+		return r
+	}, raw)
+	cleaned = strings.TrimFunc(cleaned, func(r rune) bool {
+		return r <= ' '
+	})
+
+	if cleaned == "" || len(cleaned) > s.maxURLLength {
+		return "", false
+	}
 
-		tagName := strings.ToLower(tagMatch[1])
+	parsed, err := url.Parse(cleaned)
+	if err != nil {
+		return "", false
+	}
 
-		// Keep allowed tags
-		if allowedTags[tagName] {
-			return match
+	if parsed.Scheme == "" {
+		// A colon before any slash that url.Parse did not take as a scheme is still one to a browser
+		if before, _, found := strings.Cut(cleaned, ":"); found && !strings.ContainsAny(before, "/?#") {
+			return "", false
 		}
+		return cleaned, s.allowRelativeURLs
+	}
 
-		// Remove disallowed tags
-		return ""
+	if !s.urlSchemes[strings.ToLower(parsed.Scheme)] {
+		return "", false
+	}
+
+	return cleaned, true
+}
+
+// parseFragment parses input as the content of a <body> element, as a browser would
+func parseFragment(input string) ([]*html.Node, error) {
+	return html.ParseFragment(strings.NewReader(input), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
 	})
 }
 
+// extractText writes the text of a node, separating block elements with spaces
+func extractText(buf *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		buf.WriteString(n.Data)
+		return
+	case html.ElementNode:
+		if n.Namespace != "" || removedElements[n.DataAtom] {
+			return
+		}
+	default:
+		return
+	}
+
+	if blockElements[n.DataAtom] {
+		buf.WriteByte(' ')
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		extractText(buf, c)
+	}
+	if blockElements[n.DataAtom] {
+		buf.WriteByte(' ')
+	}
+}
+
+// toSet lowercases values into a set
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = true
+	}
+	return set
+}
+
 // TruncateText truncates text to specified length, adding ellipsis if needed
 //
 // The function ensures:
@@ -0,0 +1,269 @@
+package sanitizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/html"
+)
+
+func TestSanitizeHTML_KeepsAllowedMarkup(t *testing.T) {
+	s := New()
+
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"formatting": {
+			input: `<p>Patch <strong>now</strong> or <em>soon</em></p>`,
+			want:  `<p>Patch <strong>now</strong> or <em>soon</em></p>`,
+		},
+		"lists": {
+			input: `<ul><li>CVE-2026-1234</li><li>CVE-2026-5678</li></ul>`,
+			want:  `<ul><li>CVE-2026-1234</li><li>CVE-2026-5678</li></ul>`,
+		},
+		"link gets rel": {
+			input: `<a href="https://example.com/advisory" title="Advisory">advisory</a>`,
+			want:  `<a href="https://example.com/advisory" title="Advisory" rel="nofollow noopener noreferrer">advisory</a>`,
+		},
+		"supplied rel is replaced": {
+			input: `<a href="https://example.com" rel="opener">x</a>`,
+			want:  `<a href="https://example.com" rel="nofollow noopener noreferrer">x</a>`,
+		},
+		"code keeps escaped text": {
+			input: `<pre><code>if a &lt; b &amp;&amp; c &gt; d {}</code></pre>`,
+			want:  `<pre><code>if a &lt; b &amp;&amp; c &gt; d {}</code></pre>`,
+		},
+		"disallowed element is unwrapped": {
+			input: `<p>Read <span class="x">this</span> <font color="red">now</font></p>`,
+			want:  `<p>Read this now</p>`,
+		},
+		"unclosed tags are balanced": {
+			input: `<p><strong>Critical`,
+			want:  `<p><strong>Critical</strong></p>`,
+		},
+		"misnested tags are repaired": {
+			input: `<strong><em>a</strong>b</em>`,
+			want:  `<strong><em>a</em></strong><em>b</em>`,
+		},
+		"bare text is escaped": {
+			input: `1 < 2 & "quoted"`,
+			want:  `1 &lt; 2 &amp; &#34;quoted&#34;`,
+		},
+		"attributes not allowed are dropped": {
+			input: `<p id="x" class="y" style="color:red" align="center">text</p>`,
+			want:  `<p>text</p>`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.SanitizeHTML(tt.input))
+		})
+	}
+}
+
+// xssVectors are payloads that execute script when rendered unsanitized
+// Sources include the OWASP XSS filter evasion cheat sheet and known mutation XSS vectors
+var xssVectors = []string{
+	`<script>alert(1)</script>`,
+	`<SCRIPT SRC=https://evil.example/xss.js></SCRIPT>`,
+	`<scr<script>ipt>alert(1)</scr</script>ipt>`,
+	`<script/xss src="https://evil.example/x.js"></script>`,
+	`<<script>alert(1);//<</script>`,
+	`<script>alert(1)`,
+	`<img src=x onerror=alert(1)>`,
+	`<img src="x" onerror="alert(1)"/>`,
+	`<IMG SRC=javascript:alert('XSS')>`,
+	`<img src=x:alert(alt) onerror=eval(src) alt=0>`,
+	`<img """><script>alert(1)</script>">`,
+	`<img src="x` + "\x00" + `" onerror="alert(1)">`,
+	`<svg onload=alert(1)>`,
+	`<svg><script>alert(1)</script></svg>`,
+	`<svg><style><img src=x onerror=alert(1)></style></svg>`,
+	`<svg><a xlink:href="javascript:alert(1)"><text x="20" y="20">XSS</text></a></svg>`,
+	`<math><mtext><table><mglyph><style><img src=x onerror=alert(1)></style></mglyph></table></mtext></math>`,
+	`<math><mi xlink:href="javascript:alert(1)">x</mi></math>`,
+	`<body onload=alert(1)>`,
+	`<iframe src="javascript:alert(1)"></iframe>`,
+	`<iframe srcdoc="<script>alert(1)</script>"></iframe>`,
+	`<object data="javascript:alert(1)"></object>`,
+	`<embed src="javascript:alert(1)">`,
+	`<a href="javascript:alert(1)">x</a>`,
+	`<a href="JaVaScRiPt:alert(1)">x</a>`,
+	`<a href=" javascript:alert(1)">x</a>`,
+	`<a href="java` + "\t" + `script:alert(1)">x</a>`,
+	`<a href="java` + "\n" + `script:alert(1)">x</a>`,
+	`<a href="&#106;&#97;&#118;&#97;&#115;&#99;&#114;&#105;&#112;&#116;&#58;alert(1)">x</a>`,
+	`<a href="&#x6A;avascript:alert(1)">x</a>`,
+	`<a href="javascript&colon;alert(1)">x</a>`,
+	`<a href="vbscript:msgbox(1)">x</a>`,
+	`<a href="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">x</a>`,
+	`<a href="https://example.com" onclick="alert(1)">x</a>`,
+	`<a href="https://example.com" onmouseover=alert(1)>x</a>`,
+	`<p onmouseover="alert(1)">hover</p>`,
+	`<p style="background:url(javascript:alert(1))">x</p>`,
+	`<div style="width: expression(alert(1))">x</div>`,
+	`<style>@import 'https://evil.example/x.css';</style>`,
+	`<link rel="stylesheet" href="javascript:alert(1)">`,
+	`<meta http-equiv="refresh" content="0;url=javascript:alert(1)">`,
+	`<base href="javascript:alert(1)//">`,
+	`<form action="javascript:alert(1)"><input type="submit"></form>`,
+	`<button formaction="javascript:alert(1)">x</button>`,
+	`<input autofocus onfocus=alert(1)>`,
+	`<select autofocus onfocus=alert(1)>`,
+	`<textarea autofocus onfocus=alert(1)>`,
+	`<details open ontoggle=alert(1)>`,
+	`<video><source onerror="alert(1)"></video>`,
+	`<audio src=x onerror=alert(1)>`,
+	`<marquee onstart=alert(1)>`,
+	`<noscript><p title="</noscript><img src=x onerror=alert(1)>">`,
+	`<template><script>alert(1)</script></template>`,
+	`<xmp><script>alert(1)</script></xmp>`,
+	`<noembed><img title="</noembed><img src=x onerror=alert(1)>"></noembed>`,
+	`<!--<img src="--><img src=x onerror=alert(1)//">`,
+	`<![CDATA[<script>alert(1)</script>]]>`,
+	`<p>text</p ><script>alert(1)</script>`,
+	`<a href="https://example.com"><script>alert(1)</script></a>`,
+	`<table><td><img src=x onerror=alert(1)></td></table>`,
+	`<div><p><a href="https://ok.example" title="&quot;><script>alert(1)</script>">x</a></p></div>`,
+	`"><script>alert(1)</script>`,
+	`'><img src=x onerror=alert(1)>`,
+}
+
+// assertNoScript fails if the sanitized output would run script when rendered
+// The output is parsed like a browser would parse it, then checked for executable elements,
+// event handler attributes and script URLs
+func assertNoScript(t *testing.T, output string) {
+	t.Helper()
+
+	nodes, err := parseFragment(output)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			assert.False(t, removedElements[n.DataAtom], "output contains <%s>: %s", n.Data, output)
+			assert.Empty(t, n.Namespace, "output contains foreign content: %s", output)
+
+			for _, attr := range n.Attr {
+				key := strings.ToLower(attr.Key)
+				assert.False(t, strings.HasPrefix(key, "on"), "output keeps %s: %s", key, output)
+				assert.NotEqual(t, "style", key, "output keeps style: %s", output)
+				assert.NotEqual(t, "srcdoc", key, "output keeps srcdoc: %s", output)
+
+				value := strings.ToLower(strings.Join(strings.Fields(attr.Val), ""))
+				for _, scheme := range []string{"javascript:", "vbscript:", "data:"} {
+					assert.False(t, strings.HasPrefix(value, scheme), "output keeps %s URL: %s", scheme, output)
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+
+	for _, n := range nodes {
+		walk(n)
+	}
+}
+
+func TestSanitizeHTML_XSSVectors(t *testing.T) {
+	for _, policy := range map[string]*Policy{"feed": FeedPolicy(), "editorial": EditorialPolicy()} {
+		s := NewWithPolicy(policy)
+
+		for _, vector := range xssVectors {
+			output := s.SanitizeHTML(vector)
+			assertNoScript(t, output)
+
+			// Sanitizing is stable: clean output passes through unchanged
+			assert.Equal(t, output, s.SanitizeHTML(output), "not idempotent for %q", vector)
+		}
+	}
+}
+
+func TestSanitizeHTML_DropsScriptContent(t *testing.T) {
+	s := New()
+
+	assert.Equal(t, "<p>before</p><p>after</p>",
+		s.SanitizeHTML(`<p>before</p><script>document.cookie</script><style>p{}</style><p>after</p>`))
+}
+
+func TestSanitizeHTML_URLs(t *testing.T) {
+	feed := New()
+	editorial := NewWithPolicy(EditorialPolicy())
+
+	// Relative and mailto links are editorial only
+	assert.Equal(t, `<a>home</a>`, feed.SanitizeHTML(`<a href="/threats">home</a>`))
+	assert.Equal(t, `<a href="/threats" rel="noopener noreferrer">home</a>`, editorial.SanitizeHTML(`<a href="/threats">home</a>`))
+	assert.Equal(t, `<a>mail</a>`, feed.SanitizeHTML(`<a href="mailto:soc@example.com">mail</a>`))
+	assert.Equal(t, `<a href="mailto:soc@example.com" rel="noopener noreferrer">mail</a>`,
+		editorial.SanitizeHTML(`<a href="mailto:soc@example.com">mail</a>`))
+
+	// Whitespace browsers ignore is removed before the scheme is checked
+	assert.Equal(t, `<a href="https://example.com/a" rel="nofollow noopener noreferrer">x</a>`,
+		feed.SanitizeHTML("<a href=\" https://example.com/\ta \">x</a>"))
+
+	// A relative-looking URL with a colon before any slash is a scheme to a browser
+	assert.Equal(t, `<a>x</a>`, editorial.SanitizeHTML(`<a href="javascript&#58;alert(1)">x</a>`))
+	assert.Equal(t, `<a href="/search?q=a:b" rel="noopener noreferrer">x</a>`,
+		editorial.SanitizeHTML(`<a href="/search?q=a:b">x</a>`))
+}
+
+func TestSanitizeHTML_EditorialPolicy(t *testing.T) {
+	s := NewWithPolicy(EditorialPolicy())
+
+	assert.Equal(t,
+		`<figure><img src="https://cdn.example.com/chart.png" alt="Chart" title="Attacks"><figcaption>Attacks by month</figcaption></figure>`,
+		s.SanitizeHTML(`<figure><img src="https://cdn.example.com/chart.png" alt="Chart" title="Attacks" onload="x()"><figcaption>Attacks by month</figcaption></figure>`))
+
+	assert.Equal(t,
+		`<table><tbody><tr><th colspan="2">Vendor</th></tr><tr><td>Acme</td><td>3</td></tr></tbody></table>`,
+		s.SanitizeHTML(`<table><tr><th colspan="2" bgcolor="red">Vendor</th></tr><tr><td>Acme</td><td>3</td></tr></table>`))
+
+	// The feed policy keeps neither
+	assert.Equal(t, `AcmeChart`, New().SanitizeHTML(`<table><tr><td>Acme</td></tr></table><img src="https://x.example/a.png" alt="a">Chart`))
+}
+
+func TestNewWithPolicy_CopiesPolicy(t *testing.T) {
+	policy := FeedPolicy()
+	s := NewWithPolicy(policy)
+
+	policy.Elements["img"] = []string{"src"}
+	policy.URLSchemes = append(policy.URLSchemes, "javascript")
+
+	assert.Equal(t, "", s.SanitizeHTML(`<img src="https://example.com/a.png">`))
+	assert.Equal(t, `<a>x</a>`, s.SanitizeHTML(`<a href="javascript:alert(1)">x</a>`))
+}
+
+func TestSanitizeText(t *testing.T) {
+	s := New()
+
+	assert.Equal(t, "Hello World!", s.SanitizeText(`<p>Hello <strong>World</strong>!</p>`))
+	assert.Equal(t, "Tom & Jerry <3", s.SanitizeText(`Tom &amp; Jerry &lt;3`))
+	assert.Equal(t, "one two", s.SanitizeText(`<p>one</p><p>two</p>`))
+	assert.Equal(t, "visible", s.SanitizeText(`<script>alert(1)</script><style>p{}</style>visible`))
+	assert.Equal(t, "", s.SanitizeText(`<svg><text>hidden</text></svg>`))
+}
+
+func TestSanitizeURL(t *testing.T) {
+	s := New()
+
+	clean, err := s.SanitizeURL("https://example.com/path")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/path", clean)
+
+	for _, raw := range []string{"", "javascript:alert(1)", "data:text/html,x", "/relative", "https://"} {
+		_, err := s.SanitizeURL(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestTruncateText(t *testing.T) {
+	assert.Equal(t, "Hello...", TruncateText("Hello World", 8))
+	assert.Equal(t, "Hello", TruncateText("Hello", 10))
+	assert.Equal(t, "", TruncateText("Hello", 0))
+}
@@ -1,13 +1,26 @@
 package validator
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
 
+var (
+	slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	cvePattern  = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+	// indexReplacer rewrites the "[2]" of slice elements in go-playground namespaces as ".2"
+	indexReplacer = strings.NewReplacer("[", ".", "]", "")
+)
+
+// std is the validator shared by Struct and Var
+var std = New()
+
 // Validator wraps go-playground/validator with custom validations
 type Validator struct {
 	v *validator.Validate
@@ -19,6 +32,8 @@ type ValidationErrors struct {
 }
 
 // FieldError represents a single field validation error
+// Field is the JSON path of the field, such as "email" or "articles.2.title", as webhook schema
+// errors name them too
 type FieldError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -39,6 +54,7 @@ func (v *ValidationErrors) Error() string {
 }
 
 // New creates a new validator with custom validations
+// Fields are reported by their JSON names, so errors match the request body the client sent
 //
 // Custom validations:
 // - "password" - minimum 8 characters, at least 1 uppercase, 1 lowercase, 1 digit
@@ -58,7 +74,16 @@ func (v *ValidationErrors) Error() string {
 //	    // Handle validation errors
 //	}
 func New() *Validator {
-	v := validator.New()
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Report fields by their JSON names, or their Go names when they have none
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
 
 	// Register custom password validation
 	v.RegisterValidation("password", validatePassword)
@@ -92,27 +117,62 @@ func (v *Validator) Validate(i interface{}) error {
 		return fmt.Errorf("cannot validate nil value")
 	}
 
-	err := v.v.Struct(i)
+	return translate(v.v.Struct(i), "")
+}
+
+// Var validates a single value against a tag such as "required,email"
+// Failures are reported under field, which also names the value in their messages
+func (v *Validator) Var(field string, value interface{}, tag string) error {
+	return translate(v.v.Var(value, tag), field)
+}
+
+// Struct validates a struct with the shared validator
+func Struct(i interface{}) error {
+	return std.Validate(i)
+}
+
+// Var validates a single value with the shared validator
+func Var(field string, value interface{}, tag string) error {
+	return std.Var(field, value, tag)
+}
+
+// translate converts go-playground errors to ValidationErrors
+// field names the value of a Var check; struct fields are named by their path below the struct
+func translate(err error, field string) error {
 	if err == nil {
 		return nil
 	}
 
-	validationErrs, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	var fieldErrors []FieldError
+	fieldErrors := make([]FieldError, 0, len(validationErrs))
 	for _, err := range validationErrs {
+		path, name := field, field
+		if path == "" {
+			path = fieldPath(err)
+			name = err.Field()
+		}
 		fieldErrors = append(fieldErrors, FieldError{
-			Field:   err.Field(),
-			Message: getErrorMessage(err),
+			Field:   path,
+			Message: getErrorMessage(err, name),
 		})
 	}
 
 	return &ValidationErrors{Errors: fieldErrors}
 }
 
+// fieldPath returns the path of a failed field below the validated struct, such as "articles.2.title"
+func fieldPath(err validator.FieldError) string {
+	namespace := err.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		namespace = namespace[i+1:]
+	}
+	return indexReplacer.Replace(namespace)
+}
+
 // validatePassword ensures password meets security requirements
 // - Minimum 8 characters
 // - At least 1 uppercase letter
@@ -157,7 +217,6 @@ func validateSlug(fl validator.FieldLevel) bool {
 	}
 
 	// Check for valid characters only
-	slugPattern := regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 	return slugPattern.MatchString(slug)
 }
 
@@ -167,26 +226,40 @@ func validateSlug(fl validator.FieldLevel) bool {
 func validateCVE(fl validator.FieldLevel) bool {
 	cve := fl.Field().String()
 
-	cvePattern := regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
 	return cvePattern.MatchString(cve)
 }
 
-// getErrorMessage returns a user-friendly error message for validation errors
-func getErrorMessage(err validator.FieldError) string {
-	field := err.Field()
+// getErrorMessage returns a user-friendly error message for validation errors, naming the field field
+func getErrorMessage(err validator.FieldError, field string) string {
 	tag := err.Tag()
 
 	switch tag {
 	case "required":
 		return fmt.Sprintf("%s is required", field)
+	case "required_if", "required_with", "required_without":
+		return fmt.Sprintf("%s is required here", field)
+	case "excluded_with", "excluded_without":
+		return fmt.Sprintf("%s must not be set here", field)
 	case "email":
 		return fmt.Sprintf("%s must be a valid email address", field)
 	case "min":
-		return fmt.Sprintf("%s must be at least %s characters", field, err.Param())
+		return fmt.Sprintf("%s must be at least %s", field, sized(err))
 	case "max":
-		return fmt.Sprintf("%s must not exceed %s characters", field, err.Param())
-	case "url":
+		return fmt.Sprintf("%s must not exceed %s", field, sized(err))
+	case "len":
+		return fmt.Sprintf("%s must be exactly %s", field, sized(err))
+	case "url", "http_url":
 		return fmt.Sprintf("%s must be a valid URL", field)
+	case "https_url":
+		return fmt.Sprintf("%s must be an absolute https URL", field)
+	case "hostname_rfc1123":
+		return fmt.Sprintf("%s must be a bare host name such as intel.example.com", field)
+	case "uuid", "uuid4":
+		return fmt.Sprintf("%s must be a valid UUID", field)
+	case "hexcolor":
+		return fmt.Sprintf("%s must be a valid hex color code (e.g., #FF5733)", field)
+	case "unique":
+		return fmt.Sprintf("%s must not contain duplicates", field)
 	case "password":
 		return fmt.Sprintf("%s must be at least 8 characters with 1 uppercase, 1 lowercase, and 1 digit", field)
 	case "slug":
@@ -207,3 +280,15 @@ func getErrorMessage(err validator.FieldError) string {
 		return fmt.Sprintf("%s failed validation: %s", field, tag)
 	}
 }
+
+// sized describes the bound of a min, max or len check in the units of the field's kind
+func sized(err validator.FieldError) string {
+	switch err.Kind() {
+	case reflect.String:
+		return err.Param() + " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return err.Param() + " items"
+	default:
+		return err.Param()
+	}
+}
@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testArticle struct {
+	Title string   `json:"title" validate:"required,max=10"`
+	Tags  []string `json:"tags" validate:"max=2"`
+}
+
+type testRequest struct {
+	Email    string        `json:"email" validate:"required,email"`
+	Password string        `json:"password" validate:"required,password"`
+	Severity *string       `json:"severity,omitempty" validate:"omitempty,oneof=critical high"`
+	Limit    int           `json:"limit" validate:"min=1"`
+	Articles []testArticle `json:"articles" validate:"dive"`
+}
+
+// fieldErrors returns the field errors of a validation failure
+func fieldErrors(t *testing.T, err error) []FieldError {
+	t.Helper()
+
+	var invalid *ValidationErrors
+	require.True(t, errors.As(err, &invalid), "expected ValidationErrors, got %v", err)
+	return invalid.Errors
+}
+
+func TestStruct_Valid(t *testing.T) {
+	severity := "high"
+	err := Struct(&testRequest{
+		Email:    "analyst@example.com",
+		Password: "Str0ngPass",
+		Severity: &severity,
+		Limit:    5,
+		Articles: []testArticle{{Title: "Patch now", Tags: []string{"rce"}}},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestStruct_ReportsFieldsByJSONPath(t *testing.T) {
+	severity := "urgent"
+	err := Struct(&testRequest{
+		Email:    "analyst@example",
+		Password: "weak",
+		Severity: &severity,
+		Limit:    0,
+		Articles: []testArticle{{Title: "ok"}, {Title: "", Tags: []string{"a", "b", "c"}}},
+	})
+
+	got := map[string]string{}
+	for _, fieldErr := range fieldErrors(t, err) {
+		got[fieldErr.Field] = fieldErr.Message
+	}
+
+	assert.Equal(t, map[string]string{
+		"email":            "email must be a valid email address",
+		"password":         "password must be at least 8 characters with 1 uppercase, 1 lowercase, and 1 digit",
+		"severity":         "severity must be one of: critical high",
+		"limit":            "limit must be at least 1",
+		"articles.1.title": "title is required",
+		"articles.1.tags":  "tags must not exceed 2 items",
+	}, got)
+}
+
+func TestStruct_LengthsAreInCharacters(t *testing.T) {
+	err := Struct(&testArticle{Title: "a title that is too long"})
+
+	errs := fieldErrors(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "title must not exceed 10 characters", errs[0].Message)
+}
+
+func TestVar_NamesTheGivenField(t *testing.T) {
+	err := Var("new_password", "password", "required,password")
+
+	errs := fieldErrors(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "new_password", errs[0].Field)
+	assert.Contains(t, errs[0].Message, "new_password must be at least 8 characters")
+
+	assert.NoError(t, Var("email", "analyst@example.com", "required,email"))
+}
+
+func TestCustomValidations(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"password", "Abcdefg1", true},
+		{"password", "abcdefg1", false},
+		{"password", "ABCDEFG1", false},
+		{"password", "Abcdefgh", false},
+		{"password", "Abc1", false},
+		{"slug", "threat-intel-2024", true},
+		{"slug", "-threat", false},
+		{"slug", "Threat", false},
+		{"slug", "threat--intel", false},
+		{"cve", "CVE-2024-12345", true},
+		{"cve", "CVE-2024-123", false},
+		{"cve", "cve-2024-12345", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag+"/"+tt.value, func(t *testing.T) {
+			err := Var("value", tt.value, tt.tag)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	err := &ValidationErrors{Errors: []FieldError{
+		{Field: "email", Message: "email is required"},
+		{Field: "name", Message: "name is required"},
+	}}
+
+	assert.Equal(t, "email: email is required; name: name is required", err.Error())
+	assert.Equal(t, "validation failed", (&ValidationErrors{}).Error())
+}
@@ -21,18 +21,35 @@ type UserRepository interface {
 	Update(ctx context.Context, user *entities.User) error
 	UpdateLastLogin(ctx context.Context, id uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns a page of users, newest first, with the total count
+	List(ctx context.Context, limit, offset int) ([]*entities.User, int, error)
+	// SetStatus changes a user's account status; reason and until are cleared when nil
+	SetStatus(ctx context.Context, id uuid.UUID, status entities.UserStatus, reason *string, until *time.Time, changedBy *uuid.UUID) error
+	// ActivateInvited sets an invited user's name and password and makes the account active;
+	// it reports false when the user is not awaiting an invitation
+	ActivateInvited(ctx context.Context, id uuid.UUID, name, passwordHash string) (bool, error)
 }
 
 // ArticleRepository defines operations for article persistence
 type ArticleRepository interface {
 	Create(ctx context.Context, article *domain.Article) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Article, error)
+	// GetByIDs returns the articles with the given IDs in one query, skipping unknown IDs
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Article, error)
 	GetBySlug(ctx context.Context, slug string) (*domain.Article, error)
 	GetBySourceURL(ctx context.Context, sourceURL string) (*domain.Article, error)
 	List(ctx context.Context, filter *domain.ArticleFilter) ([]*domain.Article, int, error)
 	Update(ctx context.Context, article *domain.Article) error
+	// UpdateEditorial sets or clears the editorial overrides; nil clears a field
+	UpdateEditorial(ctx context.Context, id uuid.UUID, title, summary *string, updatedBy *uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	IncrementViewCount(ctx context.Context, id uuid.UUID) error
+	// SlugExists reports whether any article uses the slug now or used it before
+	SlugExists(ctx context.Context, slug string) (bool, error)
+	// UpdateSlug changes an article's slug, keeping the old one so links to it still resolve
+	UpdateSlug(ctx context.Context, id uuid.UUID, slug string) error
+	// GetSlugRedirect returns the current slug of the article that used oldSlug before, or a NotFoundError
+	GetSlugRedirect(ctx context.Context, oldSlug string) (string, error)
 }
 
 // AlertRepository defines operations for alert persistence
@@ -48,8 +65,31 @@ type AlertRepository interface {
 // AlertMatchRepository defines operations for alert matches
 type AlertMatchRepository interface {
 	Create(ctx context.Context, match *domain.AlertMatch) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.AlertMatch, error)
 	GetByAlertID(ctx context.Context, alertID uuid.UUID) ([]*domain.AlertMatch, error)
 	MarkNotified(ctx context.Context, id uuid.UUID) error
+	UpdateStatus(ctx context.Context, match *domain.AlertMatch) error
+}
+
+// GlobalSearchRepository defines typed lookups used by global search
+// Each method returns hits with a match score in [0,1] ordered best-first
+type GlobalSearchRepository interface {
+	SearchArticles(ctx context.Context, query string, limit int) ([]*domain.ArticleSearchHit, error)
+	SearchCVEs(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error)
+	SearchVendors(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error)
+	SearchThreatActors(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error)
+}
+
+// NotificationTemplateRepository defines operations for versioned notification templates
+type NotificationTemplateRepository interface {
+	// CreateVersion stores a new version (version number assigned by the repository)
+	// and makes it the active version when activate is true
+	CreateVersion(ctx context.Context, tmpl *domain.NotificationTemplate, activate bool) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error)
+	GetActive(ctx context.Context, key string, channel domain.NotificationChannel, locale string) (*domain.NotificationTemplate, error)
+	ListVersions(ctx context.Context, key string, channel domain.NotificationChannel, locale string) ([]*domain.NotificationTemplate, error)
+	List(ctx context.Context, filter *domain.NotificationTemplateFilter) ([]*domain.NotificationTemplate, error)
+	Activate(ctx context.Context, id uuid.UUID) error
 }
 
 // RefreshTokenRepository defines operations for refresh token management
@@ -59,6 +99,9 @@ type RefreshTokenRepository interface {
 	Revoke(ctx context.Context, id uuid.UUID) error
 	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
 	DeleteExpired(ctx context.Context) error
+	// DeleteExpiredBefore deletes up to limit tokens that expired or were revoked before the given time
+	// and returns how many were deleted
+	DeleteExpiredBefore(ctx context.Context, before time.Time, limit int) (int64, error)
 }
 
 // SessionRepository defines operations for session management (Redis)
@@ -73,9 +116,15 @@ type CategoryRepository interface {
 	Create(ctx context.Context, category *domain.Category) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Category, error)
 	GetBySlug(ctx context.Context, slug string) (*domain.Category, error)
+	// GetByIDs returns the categories with the given IDs, skipping unknown IDs
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Category, error)
 	List(ctx context.Context) ([]*domain.Category, error)
 	Update(ctx context.Context, category *domain.Category) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// SetParent moves a category under a parent, or to the top level when parentID is nil
+	SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error
+	// SetArticleCategories replaces an article's categories; the first becomes its primary category
+	SetArticleCategories(ctx context.Context, articleID uuid.UUID, categoryIDs []uuid.UUID) error
 }
 
 // SourceRepository defines operations for source persistence
@@ -84,6 +133,8 @@ type SourceRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Source, error)
 	GetByURL(ctx context.Context, url string) (*domain.Source, error)
 	GetByName(ctx context.Context, name string) (*domain.Source, error)
+	// GetByIDs returns the sources with the given IDs, skipping unknown IDs
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Source, error)
 	List(ctx context.Context, activeOnly bool) ([]*domain.Source, error)
 	Update(ctx context.Context, source *domain.Source) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -109,6 +160,8 @@ type BookmarkRepository interface {
 	Create(ctx context.Context, userID, articleID uuid.UUID) error
 	Delete(ctx context.Context, userID, articleID uuid.UUID) error
 	IsBookmarked(ctx context.Context, userID, articleID uuid.UUID) (bool, error)
+	// FilterBookmarked returns the subset of articleIDs the user has bookmarked
+	FilterBookmarked(ctx context.Context, userID uuid.UUID, articleIDs []uuid.UUID) ([]uuid.UUID, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.Article, int, error)
 	CountByUserID(ctx context.Context, userID uuid.UUID) (int, error)
 }
@@ -142,3 +195,694 @@ type UserReadStats struct {
 	ArticlesThisMonth      int
 	AverageReadingTime     float64
 }
+
+// IngestLatencyRepository defines operations for ingest pipeline stage timings
+type IngestLatencyRepository interface {
+	// RecordStages stores stage timestamps for an article; the first recorded time for a stage wins
+	RecordStages(ctx context.Context, articleID uuid.UUID, stages map[domain.IngestStage]time.Time) (*domain.IngestTiming, error)
+	GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.IngestTiming, error)
+	// GetStats aggregates latency for articles notified since the given time
+	GetStats(ctx context.Context, since time.Time, budget time.Duration) (*domain.IngestLatencyStats, error)
+}
+
+// ArticleSummaryRepository defines operations for generated article summaries
+type ArticleSummaryRepository interface {
+	// Upsert stores a summary, replacing any existing summary of the same length
+	Upsert(ctx context.Context, summary *domain.ArticleSummary) error
+	Get(ctx context.Context, articleID uuid.UUID, length domain.SummaryLength) (*domain.ArticleSummary, error)
+	ListByArticle(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSummary, error)
+}
+
+// ClassificationSuggestionRepository defines operations for AI classification suggestions
+type ClassificationSuggestionRepository interface {
+	Create(ctx context.Context, suggestion *domain.ClassificationSuggestion) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ClassificationSuggestion, error)
+	List(ctx context.Context, filter *domain.ClassificationFilter) ([]*domain.ClassificationSuggestion, int, error)
+	// UpdateReview stores the status, applied fields, and reviewer of a suggestion
+	UpdateReview(ctx context.Context, suggestion *domain.ClassificationSuggestion) error
+}
+
+// ArticleFingerprintRepository defines operations for near-duplicate fingerprints
+type ArticleFingerprintRepository interface {
+	Create(ctx context.Context, fingerprint *domain.ArticleFingerprint) error
+	GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleFingerprint, error)
+	// ListSince returns fingerprints created at or after since, used as match candidates
+	ListSince(ctx context.Context, since time.Time) ([]*domain.ArticleFingerprint, error)
+	// ListByCanonical returns all members of a duplicate cluster, canonical first
+	ListByCanonical(ctx context.Context, canonicalID uuid.UUID) ([]*domain.ArticleFingerprint, error)
+}
+
+// AIUsageRepository defines operations for AI call usage records
+type AIUsageRepository interface {
+	Create(ctx context.Context, record *domain.AIUsageRecord) error
+	// DailyAggregates returns per-day (UTC) usage since the given time, oldest first
+	DailyAggregates(ctx context.Context, since time.Time) ([]*domain.AIUsageDaily, error)
+	// TotalCost returns the summed cost of calls since the given time
+	TotalCost(ctx context.Context, since time.Time) (float64, error)
+}
+
+// AIResponseCacheRepository defines operations for cached AI responses
+type AIResponseCacheRepository interface {
+	// Get returns an unexpired entry and counts the hit
+	Get(ctx context.Context, key string) (*domain.AIResponseCacheEntry, error)
+	// Upsert stores an entry, replacing any existing entry with the same key
+	Upsert(ctx context.Context, entry *domain.AIResponseCacheEntry) error
+	// Delete removes entries for an operation, or all entries when operation is empty
+	Delete(ctx context.Context, operation string) (int64, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+	// Stats summarizes unexpired entries
+	Stats(ctx context.Context) (*domain.AICacheStats, error)
+}
+
+// IOCRepository defines operations for normalized indicators of compromise
+type IOCRepository interface {
+	// ReplaceForArticle links an article to exactly the given IOCs, creating indicators as needed
+	ReplaceForArticle(ctx context.Context, articleID uuid.UUID, iocs []domain.IOC) error
+	// List returns indicators matching the filter, most recently seen first
+	List(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, int, error)
+}
+
+// NotificationPreferenceRepository defines operations for per-user notification preferences
+type NotificationPreferenceRepository interface {
+	// Get returns a user's saved preferences, or a NotFoundError if they have none
+	Get(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error)
+	Upsert(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+// OrganizationRepository defines operations for organizations and their members
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *domain.Organization) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error)
+	List(ctx context.Context) ([]*domain.Organization, error)
+	SlugExists(ctx context.Context, slug string) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetMembership returns the user's membership, or a NotFoundError if they belong to no organization
+	GetMembership(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error)
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMember, error)
+	// UpsertMember adds a member or changes their role; ConflictError if the user belongs to another organization
+	UpsertMember(ctx context.Context, member *domain.OrganizationMember) error
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+	// GetStats rolls up engagement across the organization's members
+	GetStats(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationStats, error)
+}
+
+// BookmarkCollectionRepository defines operations for bookmark collections
+type BookmarkCollectionRepository interface {
+	Create(ctx context.Context, collection *domain.BookmarkCollection) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.BookmarkCollection, error)
+	// ListVisible returns the user's private collections and, when orgID is set, the organization's shared ones
+	ListVisible(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) ([]*domain.BookmarkCollection, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	AddArticle(ctx context.Context, collectionID, articleID, addedBy uuid.UUID) error
+	RemoveArticle(ctx context.Context, collectionID, articleID uuid.UUID) error
+	// ListArticleIDs returns article IDs in the collection, most recently added first
+	ListArticleIDs(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]uuid.UUID, int, error)
+}
+
+// AccountDeletionRepository defines operations for pending self-service account deletions
+type AccountDeletionRepository interface {
+	// Create schedules a deletion; ConflictError if one is already pending for the user
+	Create(ctx context.Context, deletion *domain.AccountDeletion) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// ListDue returns deletions whose grace period ended before the given time, oldest first
+	ListDue(ctx context.Context, before time.Time, limit int) ([]*domain.AccountDeletion, error)
+	// Purge anonymizes the user's reads and deletes the user with all their personal data
+	Purge(ctx context.Context, userID uuid.UUID) error
+}
+
+// FeedPreferenceRepository defines operations for a user's default feed filters
+type FeedPreferenceRepository interface {
+	// Get returns the user's saved feed preferences, or a NotFoundError if they have none
+	Get(ctx context.Context, userID uuid.UUID) (*domain.FeedPreferences, error)
+	Upsert(ctx context.Context, prefs *domain.FeedPreferences) error
+}
+
+// AnalyticsRepository defines aggregate queries for the admin analytics dashboard
+type AnalyticsRepository interface {
+	GetIngestionVolume(ctx context.Context, since time.Time, limit int) (*domain.IngestionVolume, error)
+	GetEnrichmentLatency(ctx context.Context, since time.Time) (*domain.EnrichmentLatency, error)
+	// GetTopArticles ranks articles by reads, bookmarks and client views since the given time
+	GetTopArticles(ctx context.Context, since time.Time, limit int) ([]domain.ArticleEngagement, error)
+	GetActiveUsers(ctx context.Context, since time.Time) (*domain.ActiveUsers, error)
+	GetAlertMatchRates(ctx context.Context, since time.Time) (*domain.AlertMatchRates, error)
+	// GetClientEngagement totals the daily client event aggregates since the given time
+	GetClientEngagement(ctx context.Context, since time.Time) (*domain.ClientEngagement, error)
+	// GetShareLinkStats counts share links created and clicks since the given time, ranking the top entries
+	GetShareLinkStats(ctx context.Context, since time.Time, limit int) (*domain.ShareLinkStats, error)
+}
+
+// ArticleReviewRepository defines operations for the article moderation queue
+type ArticleReviewRepository interface {
+	Create(ctx context.Context, review *domain.ArticleReview) error
+	GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleReview, error)
+	// List returns reviews with a summary of each article, oldest first
+	List(ctx context.Context, filter *domain.ArticleReviewFilter) ([]*domain.ArticleReview, int, error)
+	// Decide stores a pending review's outcome and publishes the article if approved
+	Decide(ctx context.Context, review *domain.ArticleReview) error
+}
+
+// EnrichmentPromptRepository defines operations for versioned enrichment prompts
+type EnrichmentPromptRepository interface {
+	// CreateVersion stores a new inactive version (version number assigned by the repository)
+	CreateVersion(ctx context.Context, prompt *domain.EnrichmentPrompt) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentPrompt, error)
+	// GetActive returns the active version of a key, or a NotFoundError if the built-in prompt is in use
+	GetActive(ctx context.Context, key domain.EnrichmentPromptKey) (*domain.EnrichmentPrompt, error)
+	// ListVersions returns every version of a key, newest first
+	ListVersions(ctx context.Context, key domain.EnrichmentPromptKey) ([]*domain.EnrichmentPrompt, error)
+	// ListCurrent returns the active and the latest version of every key that has versions
+	ListCurrent(ctx context.Context) ([]*domain.EnrichmentPrompt, error)
+	// Activate makes a version the active one for its key
+	Activate(ctx context.Context, id uuid.UUID) error
+	// Delete removes a version that was never active
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// EnrichmentFeedbackRepository defines operations for analyst feedback on AI enrichment
+type EnrichmentFeedbackRepository interface {
+	// Create stores feedback, or returns a ConflictError if the user already gave the same flag
+	Create(ctx context.Context, feedback *domain.EnrichmentFeedback) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentFeedback, error)
+	// List returns feedback matching the filter, newest first
+	List(ctx context.Context, filter *domain.EnrichmentFeedbackFilter) ([]*domain.EnrichmentFeedback, int, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// WeeklyAccuracy returns per-week enriched and flagged counts for enrichment runs since since, oldest first
+	WeeklyAccuracy(ctx context.Context, since time.Time) ([]*domain.EnrichmentAccuracyWeek, error)
+}
+
+// FeaturedArticleRepository defines operations for the homepage featured set
+type FeaturedArticleRepository interface {
+	// Upsert features an article or updates its position and schedule
+	Upsert(ctx context.Context, featured *domain.FeaturedArticle) error
+	Delete(ctx context.Context, articleID uuid.UUID) error
+	// List returns the whole featured set ordered by position
+	List(ctx context.Context) ([]*domain.FeaturedArticle, error)
+	// ListActive returns entries whose schedule includes the given time, ordered by position
+	ListActive(ctx context.Context, at time.Time, limit int) ([]*domain.FeaturedArticle, error)
+	// Reorder assigns positions 0..n-1 in the given order; every ID must already be featured
+	Reorder(ctx context.Context, articleIDs []uuid.UUID) error
+	Count(ctx context.Context) (int, error)
+}
+
+// TagRepository defines operations for canonical tags and their aliases
+type TagRepository interface {
+	// List returns tags with their aliases and article counts, most used first
+	// A non-empty query matches tag names and aliases by substring
+	List(ctx context.Context, query string, limit, offset int) ([]*domain.Tag, int, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error)
+	// ResolveAliases maps each given name that is an alias to its canonical tag name
+	ResolveAliases(ctx context.Context, names []string) (map[string]string, error)
+	// EnsureExist registers canonical tags that are not yet known
+	EnsureExist(ctx context.Context, names []string) error
+	// Rename renames a tag, keeping the old name as an alias, and rewrites article tags
+	Rename(ctx context.Context, id uuid.UUID, name string) (int64, error)
+	// Merge folds the source tag and its aliases into the target and rewrites article tags
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) (int64, error)
+}
+
+// SourceTrustRepository defines operations for source trust calibration
+type SourceTrustRepository interface {
+	// RecordEvent stores a correction or deletion against the article's source
+	RecordEvent(ctx context.Context, sourceID, articleID uuid.UUID, eventType domain.SourceTrustEventType) error
+	// GetSignals aggregates each active source's signals over articles created since the given time
+	GetSignals(ctx context.Context, since time.Time) ([]*domain.SourceTrustSignals, error)
+	// GetAverageReads returns the average reads per article across all sources since the given time
+	GetAverageReads(ctx context.Context, since time.Time) (float64, error)
+	// Apply updates the source's trust score and records the change in one transaction
+	Apply(ctx context.Context, change *domain.SourceTrustChange) error
+	// ListChanges returns the source's trust score changes, newest first
+	ListChanges(ctx context.Context, sourceID uuid.UUID, limit, offset int) ([]*domain.SourceTrustChange, int, error)
+}
+
+// RelevanceRulesRepository defines operations for versioned relevance scoring rulesets
+type RelevanceRulesRepository interface {
+	// GetLatest returns the ruleset in use, or a NotFoundError if none has been saved
+	GetLatest(ctx context.Context) (*domain.RelevanceRules, error)
+	// Create stores the ruleset as the next version, setting Version and CreatedAt
+	Create(ctx context.Context, rules *domain.RelevanceRules) error
+}
+
+// CTAVariantRepository defines operations for Armor CTA A/B variants and their statistics
+type CTAVariantRepository interface {
+	Create(ctx context.Context, variant *domain.CTAVariant) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.CTAVariant, error)
+	Update(ctx context.Context, variant *domain.CTAVariant) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns all variants ordered by base URL and name; activeOnly limits it to active variants
+	List(ctx context.Context, activeOnly bool) ([]*domain.CTAVariant, error)
+	// RecordImpression and RecordClick add to the variant's counts for the current day
+	RecordImpression(ctx context.Context, variantID uuid.UUID) error
+	RecordClick(ctx context.Context, variantID uuid.UUID) error
+	// Stats sums every variant's impressions and clicks since the given day
+	Stats(ctx context.Context, since time.Time) ([]*domain.CTAVariantStats, error)
+}
+
+// PublicAPIKeyRepository defines operations for public API keys
+type PublicAPIKeyRepository interface {
+	Create(ctx context.Context, key *domain.PublicAPIKey) error
+	// GetByHash returns the key with the given hash, revoked or not
+	GetByHash(ctx context.Context, keyHash string) (*domain.PublicAPIKey, error)
+	// List returns all keys, newest first
+	List(ctx context.Context) ([]*domain.PublicAPIKey, error)
+	// Revoke marks the key revoked and returns it; revoking twice keeps the first time
+	Revoke(ctx context.Context, id uuid.UUID) (*domain.PublicAPIKey, error)
+	TouchLastUsed(ctx context.Context, id uuid.UUID) error
+}
+
+// SchemaRepository reports database connectivity and the applied migration version
+type SchemaRepository interface {
+	Ping(ctx context.Context) error
+	// Version returns the last applied migration and whether it failed partway (dirty)
+	Version(ctx context.Context) (version int64, dirty bool, err error)
+}
+
+// SearchIndexRepository maintains the full-text and array indexes behind article search
+type SearchIndexRepository interface {
+	// Reindex rebuilds the search indexes without blocking writes and refreshes planner statistics
+	Reindex(ctx context.Context) error
+}
+
+// AuditLogArchiveRepository reads and removes audit logs for retention and export
+type AuditLogArchiveRepository interface {
+	// ListBefore returns up to limit entries created before the cutoff, oldest first
+	ListBefore(ctx context.Context, before time.Time, limit int) ([]*domain.AuditLog, error)
+	// DeleteByIDs removes the given entries and returns how many were deleted
+	DeleteByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
+	// Each calls fn for every entry created in [from, to), oldest first, stopping at the first error
+	Each(ctx context.Context, from, to time.Time, fn func(*domain.AuditLog) error) error
+}
+
+// TxManager runs work in a database transaction
+// Repositories called with the context passed to fn take part in the transaction;
+// a nested call runs in a savepoint that rolls back on its own when fn fails
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// AuditOutboxRepository stages audit logs in the transaction of the change they record
+// and relays them to the audit log afterwards
+type AuditOutboxRepository interface {
+	// Enqueue stages the entry; within TxManager.WithinTx it commits or rolls back with the change
+	Enqueue(ctx context.Context, log *domain.AuditLog) error
+	// RelayNext moves the oldest due entry into the audit log and reports whether there was one
+	// A failed delivery stays staged with a backoff, is recorded on the entry and returned as the error
+	RelayNext(ctx context.Context) (found bool, err error)
+	// Pending counts staged entries, including those waiting to be retried
+	Pending(ctx context.Context) (int64, error)
+}
+
+// SearchSuggestionRepository suggests corrections for misspelled search words
+type SearchSuggestionRepository interface {
+	// SuggestTerm returns the tag or title word most similar to the word by trigram similarity,
+	// or "" when nothing is similar or the word itself is known
+	SuggestTerm(ctx context.Context, word string) (string, error)
+}
+
+// ArticleArchiveRepository tracks snapshots of article source pages kept in object storage
+type ArticleArchiveRepository interface {
+	// ListPending returns articles created since the cutoff that have no archive yet, or whose
+	// failed attempts are due for a retry and fewer than maxAttempts, oldest first
+	ListPending(ctx context.Context, since time.Time, maxAttempts, limit int) ([]*domain.ArticleArchiveCandidate, error)
+	// MarkArchived records the object keys of an archived page
+	MarkArchived(ctx context.Context, articleID uuid.UUID, htmlKey, textKey string) error
+	// MarkFailed records a failed attempt; retries back off exponentially
+	MarkFailed(ctx context.Context, articleID uuid.UUID, message string) error
+	// GetByArticleID returns a *domainerrors.NotFoundError when the article has no archive record
+	GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleArchive, error)
+}
+
+// ArticleImageRepository tracks hero images waiting to be stored in object storage
+type ArticleImageRepository interface {
+	// ListPending returns articles created since the cutoff whose image is not stored yet,
+	// skipping images that failed maxAttempts times or are waiting for a retry, oldest first
+	ListPending(ctx context.Context, since time.Time, maxAttempts, limit int) ([]*domain.ArticleImageCandidate, error)
+	// MarkStored sets the object key prefix of the article's resized images
+	MarkStored(ctx context.Context, articleID uuid.UUID, key string) error
+	// MarkFailed records a failed attempt; retries back off exponentially
+	MarkFailed(ctx context.Context, articleID uuid.UUID, message string) error
+}
+
+// WebhookNonceRepository records the signatures of accepted webhooks until they expire
+type WebhookNonceRepository interface {
+	// Claim records a signature and reports whether it was new; false means it was already used
+	Claim(ctx context.Context, signature string, expiresAt time.Time) (bool, error)
+	// Release removes a claimed signature so the request can be sent again
+	Release(ctx context.Context, signature string) error
+	// DeleteExpired removes signatures whose expiry has passed
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// WebhookSecretRepository stores the versions of the n8n webhook secret
+type WebhookSecretRepository interface {
+	// ListActive returns the versions that have not expired, grouped by tenant with the default
+	// workspace first, newest first within each
+	ListActive(ctx context.Context) ([]*domain.WebhookSecret, error)
+	// Rotate expires every active version at retireAt, or keeps its earlier expiry, and stores
+	// secret as the next version, setting its Version and CreatedAt. A non-nil initial secret is
+	// recorded first as version 0 if the table has no version 0 yet
+	Rotate(ctx context.Context, secret *domain.WebhookSecret, initial *domain.WebhookSecret, retireAt time.Time) error
+	// UpdateSecret replaces the stored value of a version of the given tenant's secret (nil for
+	// the default workspace)
+	UpdateSecret(ctx context.Context, tenantID *uuid.UUID, version int, secret string) error
+}
+
+// ArticleIdentityRepository stores the canonical source URLs and content hashes of articles
+type ArticleIdentityRepository interface {
+	// FindByCanonicalURL returns the article linked to a canonical URL, or a NotFoundError
+	FindByCanonicalURL(ctx context.Context, canonicalURL string) (uuid.UUID, error)
+	// FindByContentHash returns the oldest article with a content hash, or a NotFoundError
+	FindByContentHash(ctx context.Context, contentHash string) (uuid.UUID, error)
+	// Record stores the identity of a new article; an empty contentHash is not stored
+	Record(ctx context.Context, sourceURL *domain.ArticleSourceURL, contentHash string) error
+	// LinkURL links another canonical URL to an article; a URL already linked is left unchanged
+	LinkURL(ctx context.Context, sourceURL *domain.ArticleSourceURL) error
+	// ListURLs returns the URLs an article was received under, oldest first
+	ListURLs(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSourceURL, error)
+}
+
+// ArticleImportRepository stores the progress of article imports
+type ArticleImportRepository interface {
+	Create(ctx context.Context, articleImport *domain.ArticleImport) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ArticleImport, error)
+	// List returns the most recent imports, newest first
+	List(ctx context.Context, limit int) ([]*domain.ArticleImport, error)
+	// UpdateProgress stores the status, counts, checkpoint and errors of an import
+	UpdateProgress(ctx context.Context, articleImport *domain.ArticleImport) error
+}
+
+// ThreatLandscapeRepository defines aggregate queries over published articles for dashboard charts
+type ThreatLandscapeRepository interface {
+	// GetSeverityTrend counts articles published since the given time by severity, per interval bucket
+	GetSeverityTrend(ctx context.Context, since time.Time, interval domain.ThreatLandscapeInterval) ([]domain.SeverityTrendPoint, error)
+	GetCategoryCounts(ctx context.Context, since time.Time, limit int) ([]domain.NamedCount, error)
+	GetTopVendors(ctx context.Context, since time.Time, limit int) ([]domain.TermCount, error)
+	GetTopCVEs(ctx context.Context, since time.Time, limit int) ([]domain.TermCount, error)
+}
+
+// VendorWatchlistRepository defines operations for users' vendor watchlists
+type VendorWatchlistRepository interface {
+	// List returns a user's watched vendors, oldest first
+	List(ctx context.Context, userID uuid.UUID) ([]*domain.WatchedVendor, error)
+	// Create adds a vendor; a vendor already watched, ignoring case, returns a ConflictError
+	Create(ctx context.Context, vendor *domain.WatchedVendor) error
+	// Delete removes one of a user's watched vendors and returns it, or a NotFoundError
+	Delete(ctx context.Context, id, userID uuid.UUID) (*domain.WatchedVendor, error)
+}
+
+// KEVRepository stores the local copy of the CISA Known Exploited Vulnerabilities catalog
+type KEVRepository interface {
+	// ReplaceAll upserts the catalog and removes entries not in it, returning how many were removed
+	ReplaceAll(ctx context.Context, vulnerabilities []*domain.KEVVulnerability, syncedAt time.Time) (int, error)
+	// GetByCVEs returns the catalog entries for any of the CVE IDs
+	GetByCVEs(ctx context.Context, cveIDs []string) ([]*domain.KEVVulnerability, error)
+	Status(ctx context.Context) (*domain.KEVCatalogStatus, error)
+}
+
+// ExploitRepository stores public exploits for CVEs, collected from exploit feeds
+type ExploitRepository interface {
+	// Replace upserts a source's exploits and removes its older ones, returning how many were removed
+	// cveIDs limits removal to those CVEs; nil replaces everything from the source
+	Replace(ctx context.Context, source domain.ExploitSource, cveIDs []string, exploits []*domain.PublicExploit, syncedAt time.Time) (int, error)
+	// GetByCVEs returns the exploits for any of the CVE IDs
+	GetByCVEs(ctx context.Context, cveIDs []string) ([]*domain.PublicExploit, error)
+	// ListArticleCVEs returns up to limit distinct CVEs mentioned by articles published since, newest first
+	ListArticleCVEs(ctx context.Context, since time.Time, limit int) ([]string, error)
+	Status(ctx context.Context) ([]*domain.ExploitSourceStatus, error)
+}
+
+// ThreatActorRepository stores threat actor profiles and the articles that mention them
+type ThreatActorRepository interface {
+	// Create stores a new profile; ConflictError if the slug is taken
+	Create(ctx context.Context, actor *domain.ThreatActor) error
+	// GetBySlug returns a profile with its article statistics, or a NotFoundError
+	GetBySlug(ctx context.Context, slug string) (*domain.ThreatActor, error)
+	// List returns profiles matching the filter, most recently mentioned first
+	List(ctx context.Context, filter *domain.ThreatActorFilter) ([]*domain.ThreatActor, int, error)
+	// ListAll returns every profile without statistics, for building the alias dictionary
+	ListAll(ctx context.Context) ([]*domain.ThreatActor, error)
+	// ReplaceForArticle links an article to exactly the given actors for one mention source
+	ReplaceForArticle(ctx context.Context, articleID uuid.UUID, source domain.ActorMentionSource, actorIDs []uuid.UUID) error
+}
+
+// IncidentRepository stores incidents and the articles linked to them
+type IncidentRepository interface {
+	Create(ctx context.Context, incident *domain.Incident) error
+	// GetByID returns an incident with its article statistics, or a NotFoundError
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Incident, error)
+	// List returns incidents matching the filter, most recently updated first
+	List(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, int, error)
+	// Update saves the title, summary and status
+	Update(ctx context.Context, incident *domain.Incident) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// AddArticle links an article to an incident (idempotent); NotFoundError if the article does not exist
+	AddArticle(ctx context.Context, incidentID, articleID, addedBy uuid.UUID) error
+	RemoveArticle(ctx context.Context, incidentID, articleID uuid.UUID) error
+}
+
+// AnnotationRepository stores analyst annotations on articles
+type AnnotationRepository interface {
+	Create(ctx context.Context, annotation *domain.Annotation) error
+	// GetByID returns an annotation with its author's name, or a NotFoundError
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Annotation, error)
+	// Update saves the body, quote and mentions
+	Update(ctx context.Context, annotation *domain.Annotation) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByArticle returns the organization's annotations on an article, oldest first
+	ListByArticle(ctx context.Context, orgID, articleID uuid.UUID) ([]*domain.Annotation, error)
+	// ListByOrganization returns a page of the organization's annotations created since, newest first
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, since *time.Time, limit, offset int) ([]*domain.Annotation, int, error)
+}
+
+// ClientEventRepository defines storage for client engagement events and their daily aggregates
+type ClientEventRepository interface {
+	// InsertBatch bulk-inserts events; every event's month must have a partition
+	InsertBatch(ctx context.Context, events []*domain.ClientEvent) error
+	// EnsurePartitions creates the monthly partitions from the month of from, for the given number of months
+	EnsurePartitions(ctx context.Context, from time.Time, months int) error
+	// DropPartitionsBefore drops the monthly partitions that end on or before cutoff and returns how many were dropped
+	DropPartitionsBefore(ctx context.Context, cutoff time.Time) (int, error)
+	// Aggregate recomputes the daily aggregates for every UTC day from the day of from up to to
+	Aggregate(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+// ShareLinkRepository stores article share links and the clicks they receive
+type ShareLinkRepository interface {
+	Create(ctx context.Context, link *domain.ShareLink) error
+	// GetByCode returns a share link, or a NotFoundError
+	GetByCode(ctx context.Context, code string) (*domain.ShareLink, error)
+	// RecordClick stores a click and increments the link's click count
+	RecordClick(ctx context.Context, click *domain.ShareClick) error
+}
+
+// NewsletterRepository stores newsletter subscribers, campaigns and their deliveries
+type NewsletterRepository interface {
+	// UpsertSubscriber adds a subscriber, or re-subscribes an existing address with the new consent source
+	UpsertSubscriber(ctx context.Context, subscriber *domain.NewsletterSubscriber) error
+	// UpsertPendingSubscriber records a public sign-up: a new or unsubscribed address becomes pending,
+	// others keep their status. It returns the stored subscriber
+	UpsertPendingSubscriber(ctx context.Context, subscriber *domain.NewsletterSubscriber) (*domain.NewsletterSubscriber, error)
+	// GetSubscriber returns a subscriber, or a NotFoundError
+	GetSubscriber(ctx context.Context, id uuid.UUID) (*domain.NewsletterSubscriber, error)
+	MarkConfirmationSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	// ConfirmSubscriber subscribes a pending subscriber; NotFoundError if there is no such pending subscriber
+	ConfirmSubscriber(ctx context.Context, id uuid.UUID, at time.Time) error
+	// ListSubscribers returns subscribers matching the filter, newest first
+	ListSubscribers(ctx context.Context, filter *domain.SubscriberFilter) ([]*domain.NewsletterSubscriber, int, error)
+	// EachSubscriber calls fn for every subscriber with the given status, or every subscriber when nil, oldest first
+	EachSubscriber(ctx context.Context, status *domain.SubscriberStatus, fn func(*domain.NewsletterSubscriber) error) error
+	// Unsubscribe marks a subscriber unsubscribed, or returns a NotFoundError
+	Unsubscribe(ctx context.Context, id uuid.UUID) error
+
+	// AddSuppression adds an address to the suppression list; an address already on it keeps its entry
+	AddSuppression(ctx context.Context, suppression *domain.NewsletterSuppression) error
+	IsSuppressed(ctx context.Context, email string) (bool, error)
+	// ListSuppressions returns suppressed addresses, newest first
+	ListSuppressions(ctx context.Context, limit, offset int) ([]*domain.NewsletterSuppression, int, error)
+	// DeleteSuppression removes an address from the suppression list, or returns a NotFoundError
+	DeleteSuppression(ctx context.Context, id uuid.UUID) error
+
+	CreateCampaign(ctx context.Context, campaign *domain.NewsletterCampaign) error
+	// GetCampaign returns a campaign with its delivery stats, or a NotFoundError
+	GetCampaign(ctx context.Context, id uuid.UUID) (*domain.NewsletterCampaign, error)
+	// ListCampaigns returns campaigns with their delivery stats, newest first
+	ListCampaigns(ctx context.Context, limit, offset int) ([]*domain.NewsletterCampaign, int, error)
+	// UpdateCampaign saves a draft's content; NotFoundError if there is no such draft
+	UpdateCampaign(ctx context.Context, campaign *domain.NewsletterCampaign) error
+	// DeleteCampaign deletes a draft; NotFoundError if there is no such draft
+	DeleteCampaign(ctx context.Context, id uuid.UUID) error
+	// StartCampaign moves a draft to sending and queues a delivery for every current subscriber whose
+	// address is not suppressed, returning how many were queued; NotFoundError if there is no such draft
+	StartCampaign(ctx context.Context, id, sentBy uuid.UUID, startedAt time.Time) (int, error)
+	// CompleteCampaigns marks sending campaigns with no unsent deliveries as sent
+	CompleteCampaigns(ctx context.Context) (int64, error)
+
+	// ClaimDeliveries leases up to limit unsent deliveries to the caller; deliveries claimed before
+	// staleBefore are claimed again. Deliveries to subscribers who have since unsubscribed or been
+	// suppressed are failed
+	ClaimDeliveries(ctx context.Context, limit int, staleBefore time.Time) ([]*domain.NewsletterDelivery, error)
+	// MarkDelivery records the outcome of sending a claimed delivery
+	MarkDelivery(ctx context.Context, id uuid.UUID, status domain.DeliveryStatus, errMsg *string, at time.Time) error
+	// RecordOpen counts an open of a delivery, or returns a NotFoundError
+	RecordOpen(ctx context.Context, deliveryID uuid.UUID, at time.Time) error
+	// RecordClick counts a click on one of the campaign's articles and returns the campaign ID;
+	// NotFoundError if the delivery does not exist or its campaign does not feature the article
+	RecordClick(ctx context.Context, deliveryID, articleID uuid.UUID, at time.Time) (uuid.UUID, error)
+	// UnsubscribeByDelivery unsubscribes the recipient of a delivery, or returns a NotFoundError
+	UnsubscribeByDelivery(ctx context.Context, deliveryID uuid.UUID) error
+}
+
+// CRMEventRepository queues CTA conversions for delivery to the CRM and keeps them as a record
+type CRMEventRepository interface {
+	Enqueue(ctx context.Context, event *domain.CRMEvent) error
+	// HasRecent reports whether the same address converted the same way on the same article since the given time
+	HasRecent(ctx context.Context, email string, conversionType domain.ConversionType, articleID *uuid.UUID, since time.Time) (bool, error)
+	// ClaimDue claims due pending events, which are not due again until leaseUntil
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*domain.CRMEvent, error)
+	MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error
+	// RecordFailure records a failed attempt and returns the event's new status: pending to retry, or failed
+	RecordFailure(ctx context.Context, id uuid.UUID, message string, permanent bool, maxAttempts int) (domain.CRMEventStatus, error)
+	// List returns events matching the filter, newest first
+	List(ctx context.Context, filter *domain.CRMEventFilter) ([]*domain.CRMEvent, int, error)
+	// Retry queues a failed event again, or returns a NotFoundError
+	Retry(ctx context.Context, id uuid.UUID) (*domain.CRMEvent, error)
+	// RetryFailed queues every failed event again and returns how many there were
+	RetryFailed(ctx context.Context) (int64, error)
+}
+
+// SIEMRepository queues enriched events for SIEM destinations and stores the admin toggle of each destination
+type SIEMRepository interface {
+	Enqueue(ctx context.Context, events []*domain.SIEMEvent) error
+	// ClaimDue claims a destination's due pending events, which are not due again until leaseUntil
+	ClaimDue(ctx context.Context, destination string, limit int, leaseUntil time.Time) ([]*domain.SIEMEvent, error)
+	MarkDelivered(ctx context.Context, ids []uuid.UUID, at time.Time) error
+	// RecordFailure records a failed attempt for the events and returns how many of them are now failed
+	RecordFailure(ctx context.Context, ids []uuid.UUID, message string, permanent bool, maxAttempts int) (int64, error)
+	// List returns events matching the filter, newest first
+	List(ctx context.Context, filter *domain.SIEMEventFilter) ([]*domain.SIEMEvent, int, error)
+	// RetryFailed queues a destination's failed events again and returns how many there were
+	RetryFailed(ctx context.Context, destination string) (int64, error)
+	// DeleteDeliveredBefore deletes events delivered before the cutoff
+	DeleteDeliveredBefore(ctx context.Context, cutoff time.Time) (int64, error)
+
+	// GetDestinationState returns a destination's toggle and queue counts
+	GetDestinationState(ctx context.Context, name string) (*domain.SIEMDestinationState, error)
+	SetDestinationEnabled(ctx context.Context, name string, enabled bool, updatedBy uuid.UUID) error
+}
+
+// ReportRepository aggregates published articles and alert matches for threat briefings and
+// records which weekly briefings have been emailed
+type ReportRepository interface {
+	// The article aggregates cover articles published in [from, to) that match the filter, if any
+	GetSeverityBreakdown(ctx context.Context, from, to time.Time, filter *domain.ReportFilter) (*domain.SeverityBreakdown, error)
+	// GetTopArticles returns the period's most severe articles, most viewed first within a severity
+	GetTopArticles(ctx context.Context, from, to time.Time, filter *domain.ReportFilter, limit int) ([]*domain.ReportArticle, error)
+	// GetNotableCVEs returns the period's CVEs, KEV-listed and exploited ones first
+	GetNotableCVEs(ctx context.Context, from, to time.Time, filter *domain.ReportFilter, limit int) ([]domain.ReportCVE, error)
+	// GetAlertMatches summarizes the period's matches of the alerts the user owns or shares through their organization
+	GetAlertMatches(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.ReportAlertMatches, error)
+	// GetOrganizationAlertMatches summarizes the period's matches of the alerts shared with the organization
+	GetOrganizationAlertMatches(ctx context.Context, orgID uuid.UUID, from, to time.Time) ([]domain.ReportAlertMatches, error)
+
+	// ListPendingRecipients returns weekly digest readers not yet sent the briefing for the week starting periodStart
+	ListPendingRecipients(ctx context.Context, periodStart time.Time, limit int) ([]*domain.ReportRecipient, error)
+	// ClaimDelivery records the briefing as sent to the user, returning false if it already was
+	ClaimDelivery(ctx context.Context, userID uuid.UUID, periodStart time.Time) (bool, error)
+	// ReleaseDelivery removes a claim so the briefing is sent again
+	ReleaseDelivery(ctx context.Context, userID uuid.UUID, periodStart time.Time) error
+}
+
+// OrganizationReportRepository stores organization report templates and the history of their runs
+type OrganizationReportRepository interface {
+	Create(ctx context.Context, report *domain.OrganizationReport) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.OrganizationReport, error)
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationReport, error)
+	Update(ctx context.Context, report *domain.OrganizationReport) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDue returns enabled reports whose next run is at or before now, earliest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.OrganizationReport, error)
+	// AdvanceSchedule moves a report's next run from expected to next, returning false when
+	// another instance already moved it
+	AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error)
+
+	CreateRun(ctx context.Context, run *domain.OrganizationReportRun) error
+	// CompleteRun records a run's outcome, its PDF (nil when it failed) and the report's last run time
+	CompleteRun(ctx context.Context, run *domain.OrganizationReportRun, document []byte) error
+	GetRun(ctx context.Context, id uuid.UUID) (*domain.OrganizationReportRun, error)
+	// ListRuns returns a report's runs, newest first, and the total
+	ListRuns(ctx context.Context, reportID uuid.UUID, limit, offset int) ([]*domain.OrganizationReportRun, int, error)
+	// GetRunDocument returns a completed run's PDF
+	GetRunDocument(ctx context.Context, id uuid.UUID) ([]byte, error)
+}
+
+// RegistrationPolicyRepository stores the admin override of the self-registration settings
+type RegistrationPolicyRepository interface {
+	// Get returns the saved policy, or a NotFoundError if admins have not overridden the settings
+	Get(ctx context.Context) (*domain.RegistrationPolicy, error)
+	// Upsert saves the policy, setting UpdatedAt
+	Upsert(ctx context.Context, policy *domain.RegistrationPolicy) error
+	// Delete removes the saved policy so the settings apply again
+	Delete(ctx context.Context) error
+}
+
+// TenantRepository defines operations for the workspaces of a multi-tenant deployment
+type TenantRepository interface {
+	Create(ctx context.Context, tenant *domain.Tenant) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Tenant, error)
+	// List returns all tenants ordered by slug
+	List(ctx context.Context) ([]*domain.Tenant, error)
+	Update(ctx context.Context, tenant *domain.Tenant) error
+	// Delete removes the tenant along with its users, articles and other data
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// OrgCategoryRepository stores organization categories and the articles they are applied to
+type OrgCategoryRepository interface {
+	Create(ctx context.Context, category *domain.OrgCategory) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.OrgCategory, error)
+	// ListByOrganization returns an organization's categories ordered by name, with article counts
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrgCategory, error)
+	// ListWithRules returns every category that has rules, across organizations
+	ListWithRules(ctx context.Context) ([]*domain.OrgCategory, error)
+	Update(ctx context.Context, category *domain.OrgCategory) error
+	// Delete removes the category and its article assignments
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AddArticle applies the category to an article; applying it again keeps the first assignment
+	AddArticle(ctx context.Context, category *domain.OrgCategory, articleID uuid.UUID, source domain.OrgCategorySource, appliedBy *uuid.UUID) error
+	// RemoveArticle removes the category from an article, returning a NotFoundError if it was not applied
+	RemoveArticle(ctx context.Context, categoryID, articleID uuid.UUID) error
+	// ListByArticle returns the organization's categories applied to an article, ordered by name
+	ListByArticle(ctx context.Context, orgID, articleID uuid.UUID) ([]*domain.OrgArticleCategory, error)
+}
+
+// NVDRepository stores the affected products of CVEs looked up in the NVD API
+type NVDRepository interface {
+	// ReplaceProducts records a CVE lookup and replaces the CVE's affected products
+	ReplaceProducts(ctx context.Context, cveID string, lastModified *time.Time, products []*domain.AffectedProduct, syncedAt time.Time) error
+	// ListByCVE returns the affected product ranges recorded for a CVE
+	ListByCVE(ctx context.Context, cveID string) ([]*domain.AffectedProduct, error)
+	// ListByProducts returns the affected product ranges of any of the products
+	ListByProducts(ctx context.Context, keys []domain.ProductKey) ([]*domain.AffectedProduct, error)
+	// ListCVEsToSync returns up to limit CVEs of articles published since that were never looked up
+	// or were last looked up before staleBefore, those never looked up first, then newest first
+	ListCVEsToSync(ctx context.Context, since, staleBefore time.Time, limit int) ([]string, error)
+	Status(ctx context.Context) (*domain.NVDStatus, error)
+}
+
+// SoftwareInventoryRepository stores the software inventories of users and organizations and the
+// CVEs matched against them
+type SoftwareInventoryRepository interface {
+	// ListByOwner returns the owner's inventory ordered by vendor, product and version, with matched CVEs
+	ListByOwner(ctx context.Context, owner domain.InventoryOwner) ([]*domain.InventoryItem, error)
+	// Replace swaps the owner's whole inventory for the items, with their matched CVEs, in one transaction
+	Replace(ctx context.Context, owner domain.InventoryOwner, items []*domain.InventoryItem) error
+	// ListByProducts returns every inventory item of any of the products, across owners
+	ListByProducts(ctx context.Context, keys []domain.ProductKey) ([]*domain.InventoryItem, error)
+	// ReplaceMatches replaces the matched CVEs of each item
+	ReplaceMatches(ctx context.Context, items []*domain.InventoryItem) error
+	// ListMatching returns the items of the user's or the organization's inventory matched to any
+	// of the CVEs, each with only those matched CVEs; orgID may be nil
+	ListMatching(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID, cveIDs []string) ([]*domain.InventoryItem, error)
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// AccountDeletionRepository implements repository.AccountDeletionRepository for PostgreSQL
+type AccountDeletionRepository struct {
+	db *DB
+}
+
+// NewAccountDeletionRepository creates a new PostgreSQL account deletion repository
+func NewAccountDeletionRepository(db *DB) *AccountDeletionRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &AccountDeletionRepository{db: db}
+}
+
+// Create schedules an account deletion
+func (r *AccountDeletionRepository) Create(ctx context.Context, deletion *domain.AccountDeletion) error {
+	if deletion == nil {
+		return fmt.Errorf("account deletion cannot be nil")
+	}
+
+	if deletion.UserID == uuid.Nil {
+		return fmt.Errorf("user ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO account_deletion_requests (user_id, requested_at, purge_after)
+		VALUES ($1, $2, $3)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, deletion.UserID, deletion.RequestedAt, deletion.PurgeAfter)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return &domainerrors.ConflictError{
+					Resource: "account deletion",
+					Field:    "user_id",
+					Value:    deletion.UserID.String(),
+				}
+			case "23503":
+				return &domainerrors.NotFoundError{
+					Resource: "user",
+					ID:       deletion.UserID.String(),
+				}
+			}
+		}
+		return fmt.Errorf("failed to create account deletion: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserID returns the user's pending deletion
+func (r *AccountDeletionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	query := `
+		SELECT user_id, requested_at, purge_after
+		FROM account_deletion_requests
+		WHERE user_id = $1
+	`
+
+	deletion := &domain.AccountDeletion{}
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+		&deletion.UserID,
+		&deletion.RequestedAt,
+		&deletion.PurgeAfter,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "account deletion",
+				ID:       userID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get account deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// Delete cancels the user's pending deletion
+func (r *AccountDeletionRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM account_deletion_requests WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete account deletion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "account deletion",
+			ID:       userID.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListDue returns deletions whose grace period has ended
+func (r *AccountDeletionRepository) ListDue(ctx context.Context, before time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	query := `
+		SELECT user_id, requested_at, purge_after
+		FROM account_deletion_requests
+		WHERE purge_after <= $1
+		ORDER BY purge_after ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due account deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var deletions []*domain.AccountDeletion
+	for rows.Next() {
+		deletion := &domain.AccountDeletion{}
+		if err := rows.Scan(&deletion.UserID, &deletion.RequestedAt, &deletion.PurgeAfter); err != nil {
+			return nil, fmt.Errorf("failed to scan account deletion: %w", err)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating account deletions: %w", err)
+	}
+
+	return deletions, nil
+}
+
+// Purge anonymizes the user's reads, then deletes the user row
+// Everything else keyed to the user (tokens, bookmarks, alerts, preferences, memberships,
+// collections, the deletion request itself) is removed by ON DELETE CASCADE
+func (r *AccountDeletionRepository) Purge(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `UPDATE article_reads SET user_id = NULL WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to anonymize article reads: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM users WHERE id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "user",
+			ID:       userID.String(),
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit account purge: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// AIResponseCacheRepository implements repository.AIResponseCacheRepository for PostgreSQL
+type AIResponseCacheRepository struct {
+	db *DB
+}
+
+// NewAIResponseCacheRepository creates a new PostgreSQL AI response cache repository
+func NewAIResponseCacheRepository(db *DB) *AIResponseCacheRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &AIResponseCacheRepository{db: db}
+}
+
+// Get returns an unexpired entry and counts the hit
+func (r *AIResponseCacheRepository) Get(ctx context.Context, key string) (*domain.AIResponseCacheEntry, error) {
+	query := `
+		UPDATE ai_response_cache
+		SET hits = hits + 1, last_hit_at = NOW()
+		WHERE key = $1 AND expires_at > NOW()
+		RETURNING key, provider, model, operation, response, hits, created_at, expires_at, last_hit_at
+	`
+
+	entry := &domain.AIResponseCacheEntry{}
+	err := r.db.Pool.QueryRow(ctx, query, key).Scan(
+		&entry.Key,
+		&entry.Provider,
+		&entry.Model,
+		&entry.Operation,
+		&entry.Response,
+		&entry.Hits,
+		&entry.CreatedAt,
+		&entry.ExpiresAt,
+		&entry.LastHitAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "ai response cache entry",
+				ID:       key,
+			}
+		}
+		return nil, fmt.Errorf("failed to get ai response cache entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Upsert stores an entry, replacing any existing entry with the same key
+func (r *AIResponseCacheRepository) Upsert(ctx context.Context, entry *domain.AIResponseCacheEntry) error {
+	if entry == nil {
+		return fmt.Errorf("cache entry cannot be nil")
+	}
+
+	query := `
+		INSERT INTO ai_response_cache (
+			key, provider, model, operation, response, hits, created_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, 0, $6, $7)
+		ON CONFLICT (key) DO UPDATE SET
+			provider = EXCLUDED.provider,
+			model = EXCLUDED.model,
+			operation = EXCLUDED.operation,
+			response = EXCLUDED.response,
+			hits = 0,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at,
+			last_hit_at = NULL
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		entry.Key,
+		entry.Provider,
+		entry.Model,
+		entry.Operation,
+		entry.Response,
+		entry.CreatedAt,
+		entry.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert ai response cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes entries for an operation, or all entries when operation is empty
+func (r *AIResponseCacheRepository) Delete(ctx context.Context, operation string) (int64, error) {
+	query := `DELETE FROM ai_response_cache WHERE $1 = '' OR operation = $1`
+
+	result, err := r.db.Pool.Exec(ctx, query, operation)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ai response cache entries: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// DeleteExpired removes entries past their TTL
+func (r *AIResponseCacheRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	query := `DELETE FROM ai_response_cache WHERE expires_at <= NOW()`
+
+	result, err := r.db.Pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired ai response cache entries: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Stats summarizes unexpired entries
+func (r *AIResponseCacheRepository) Stats(ctx context.Context) (*domain.AICacheStats, error) {
+	query := `
+		SELECT operation, COUNT(*), COALESCE(SUM(hits), 0)
+		FROM ai_response_cache
+		WHERE expires_at > NOW()
+		GROUP BY operation
+		ORDER BY operation ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ai response cache stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := &domain.AICacheStats{
+		ByOperation: make(map[string]domain.AICacheOperationStats),
+	}
+
+	for rows.Next() {
+		var (
+			operation string
+			opStats   domain.AICacheOperationStats
+		)
+
+		if err := rows.Scan(&operation, &opStats.Entries, &opStats.Hits); err != nil {
+			return nil, fmt.Errorf("failed to scan ai response cache stats: %w", err)
+		}
+
+		stats.ByOperation[operation] = opStats
+		stats.Entries += opStats.Entries
+		stats.Hits += opStats.Hits
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ai response cache stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// AIUsageRepository implements repository.AIUsageRepository for PostgreSQL
+type AIUsageRepository struct {
+	db *DB
+}
+
+// NewAIUsageRepository creates a new PostgreSQL AI usage repository
+func NewAIUsageRepository(db *DB) *AIUsageRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &AIUsageRepository{db: db}
+}
+
+// Create inserts an AI usage record
+func (r *AIUsageRepository) Create(ctx context.Context, record *domain.AIUsageRecord) error {
+	if record == nil {
+		return fmt.Errorf("usage record cannot be nil")
+	}
+
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+
+	query := `
+		INSERT INTO ai_usage (
+			id, provider, model, operation, input_tokens, output_tokens,
+			cost_usd, success, duration_ms, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		record.ID,
+		record.Provider,
+		record.Model,
+		record.Operation,
+		record.InputTokens,
+		record.OutputTokens,
+		record.CostUSD,
+		record.Success,
+		record.DurationMs,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create ai usage record: %w", err)
+	}
+
+	return nil
+}
+
+// DailyAggregates returns per-day (UTC) usage since the given time, oldest first
+func (r *AIUsageRepository) DailyAggregates(ctx context.Context, since time.Time) ([]*domain.AIUsageDaily, error) {
+	query := `
+		SELECT
+			to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS day,
+			operation,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE NOT success),
+			COALESCE(SUM(input_tokens), 0),
+			COALESCE(SUM(output_tokens), 0),
+			COALESCE(SUM(cost_usd), 0)::float8
+		FROM ai_usage
+		WHERE created_at >= $1
+		GROUP BY day, operation
+		ORDER BY day ASC, operation ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate ai usage: %w", err)
+	}
+	defer rows.Close()
+
+	days := make([]*domain.AIUsageDaily, 0)
+	var current *domain.AIUsageDaily
+
+	for rows.Next() {
+		var (
+			date, operation           string
+			calls, failed             int
+			inputTokens, outputTokens int64
+			cost                      float64
+		)
+
+		if err := rows.Scan(&date, &operation, &calls, &failed, &inputTokens, &outputTokens, &cost); err != nil {
+			return nil, fmt.Errorf("failed to scan ai usage aggregate: %w", err)
+		}
+
+		// Rows are ordered by day, so a new date starts a new aggregate
+		if current == nil || current.Date != date {
+			current = &domain.AIUsageDaily{
+				Date:     date,
+				CostByOp: make(map[string]float64),
+			}
+			days = append(days, current)
+		}
+
+		current.Calls += calls
+		current.FailedCalls += failed
+		current.InputTokens += inputTokens
+		current.OutputTokens += outputTokens
+		current.CostUSD += cost
+		current.CostByOp[operation] += cost
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ai usage aggregates: %w", err)
+	}
+
+	return days, nil
+}
+
+// TotalCost returns the summed cost of calls since the given time
+func (r *AIUsageRepository) TotalCost(ctx context.Context, since time.Time) (float64, error) {
+	query := `SELECT COALESCE(SUM(cost_usd), 0)::float8 FROM ai_usage WHERE created_at >= $1`
+
+	var total float64
+	if err := r.db.Pool.QueryRow(ctx, query, since).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to sum ai usage cost: %w", err)
+	}
+
+	return total, nil
+}
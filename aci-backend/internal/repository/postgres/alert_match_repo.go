@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
@@ -43,13 +44,18 @@ func (r *AlertMatchRepository) Create(ctx context.Context, match *domain.AlertMa
 		return fmt.Errorf("article ID cannot be nil")
 	}
 
+	status := match.Status
+	if status == "" {
+		status = domain.AlertMatchStatusNew
+	}
+
 	query := `
-		INSERT INTO alert_matches (id, alert_id, article_id, priority, matched_at, notified_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO alert_matches (id, alert_id, article_id, priority, matched_at, notified_at, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (alert_id, article_id) DO NOTHING
 	`
 
-	result, err := r.db.Pool.Exec(
+	result, err := r.db.conn(ctx).Exec(
 		ctx,
 		query,
 		match.ID,
@@ -58,6 +64,7 @@ func (r *AlertMatchRepository) Create(ctx context.Context, match *domain.AlertMa
 		match.Priority,
 		match.MatchedAt,
 		match.NotifiedAt,
+		status,
 	)
 
 	if err != nil {
@@ -86,6 +93,53 @@ func (r *AlertMatchRepository) Create(ctx context.Context, match *domain.AlertMa
 	return nil
 }
 
+// GetByID retrieves an alert match by ID
+func (r *AlertMatchRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AlertMatch, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("alert match ID cannot be nil")
+	}
+
+	query := `
+		SELECT
+			id,
+			alert_id,
+			article_id,
+			priority,
+			matched_at,
+			notified_at,
+			status,
+			status_changed_at,
+			status_changed_by
+		FROM alert_matches
+		WHERE id = $1
+	`
+
+	var match domain.AlertMatch
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+		&match.ID,
+		&match.AlertID,
+		&match.ArticleID,
+		&match.Priority,
+		&match.MatchedAt,
+		&match.NotifiedAt,
+		&match.Status,
+		&match.StatusChangedAt,
+		&match.StatusChangedBy,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "alert_match",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get alert match by ID: %w", err)
+	}
+
+	return &match, nil
+}
+
 // GetByAlertID retrieves all matches for an alert
 func (r *AlertMatchRepository) GetByAlertID(ctx context.Context, alertID uuid.UUID) ([]*domain.AlertMatch, error) {
 	if alertID == uuid.Nil {
@@ -99,7 +153,10 @@ func (r *AlertMatchRepository) GetByAlertID(ctx context.Context, alertID uuid.UU
 			article_id,
 			priority,
 			matched_at,
-			notified_at
+			notified_at,
+			status,
+			status_changed_at,
+			status_changed_by
 		FROM alert_matches
 		WHERE alert_id = $1
 		ORDER BY matched_at DESC
@@ -122,6 +179,9 @@ func (r *AlertMatchRepository) GetByAlertID(ctx context.Context, alertID uuid.UU
 			&match.Priority,
 			&match.MatchedAt,
 			&match.NotifiedAt,
+			&match.Status,
+			&match.StatusChangedAt,
+			&match.StatusChangedBy,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan alert match row: %w", err)
@@ -177,3 +237,34 @@ func (r *AlertMatchRepository) MarkNotified(ctx context.Context, id uuid.UUID) e
 
 	return nil
 }
+
+// UpdateStatus persists an alert match's triage state
+func (r *AlertMatchRepository) UpdateStatus(ctx context.Context, match *domain.AlertMatch) error {
+	if match == nil {
+		return fmt.Errorf("alert match cannot be nil")
+	}
+
+	if match.ID == uuid.Nil {
+		return fmt.Errorf("alert match ID cannot be nil")
+	}
+
+	query := `
+		UPDATE alert_matches
+		SET status = $2, status_changed_at = $3, status_changed_by = $4
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, match.ID, match.Status, match.StatusChangedAt, match.StatusChangedBy)
+	if err != nil {
+		return fmt.Errorf("failed to update alert match status: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "alert_match",
+			ID:       match.ID.String(),
+		}
+	}
+
+	return nil
+}
@@ -41,8 +41,8 @@ func (r *AlertRepository) Create(ctx context.Context, alert *domain.Alert) error
 	}
 
 	query := `
-		INSERT INTO alerts (id, user_id, name, type, value, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO alerts (id, user_id, name, type, value, is_active, created_at, updated_at, organization_id, watchlist)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err := r.db.Pool.Exec(
@@ -56,6 +56,8 @@ func (r *AlertRepository) Create(ctx context.Context, alert *domain.Alert) error
 		alert.IsActive,
 		alert.CreatedAt,
 		alert.UpdatedAt,
+		alert.OrganizationID,
+		alert.Watchlist,
 	)
 
 	if err != nil {
@@ -92,11 +94,14 @@ func (r *AlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Al
 			a.is_active,
 			a.created_at,
 			a.updated_at,
-			COALESCE(COUNT(am.id), 0) as match_count
+			a.organization_id,
+			a.watchlist,
+			COALESCE(COUNT(am.id), 0) as match_count,
+			COUNT(am.id) FILTER (WHERE am.status = 'new') as unacknowledged_count
 		FROM alerts a
 		LEFT JOIN alert_matches am ON a.id = am.alert_id
 		WHERE a.id = $1
-		GROUP BY a.id, a.user_id, a.name, a.type, a.value, a.is_active, a.created_at, a.updated_at
+		GROUP BY a.id
 	`
 
 	var alert domain.Alert
@@ -109,7 +114,10 @@ func (r *AlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Al
 		&alert.IsActive,
 		&alert.CreatedAt,
 		&alert.UpdatedAt,
+		&alert.OrganizationID,
+		&alert.Watchlist,
 		&alert.MatchCount,
+		&alert.UnacknowledgedCount,
 	)
 
 	if err != nil {
@@ -125,7 +133,7 @@ func (r *AlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Al
 	return &alert, nil
 }
 
-// GetByUserID retrieves all alerts for a user with match counts
+// GetByUserID retrieves a user's alerts and those shared with their organization, with match counts
 func (r *AlertRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Alert, error) {
 	if userID == uuid.Nil {
 		return nil, fmt.Errorf("user ID cannot be nil")
@@ -141,11 +149,15 @@ func (r *AlertRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]
 			a.is_active,
 			a.created_at,
 			a.updated_at,
-			COALESCE(COUNT(am.id), 0) as match_count
+			a.organization_id,
+			a.watchlist,
+			COALESCE(COUNT(am.id), 0) as match_count,
+			COUNT(am.id) FILTER (WHERE am.status = 'new') as unacknowledged_count
 		FROM alerts a
 		LEFT JOIN alert_matches am ON a.id = am.alert_id
 		WHERE a.user_id = $1
-		GROUP BY a.id, a.user_id, a.name, a.type, a.value, a.is_active, a.created_at, a.updated_at
+			OR a.organization_id = (SELECT organization_id FROM organization_members WHERE user_id = $1)
+		GROUP BY a.id
 		ORDER BY a.created_at DESC
 	`
 
@@ -168,7 +180,10 @@ func (r *AlertRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]
 			&alert.IsActive,
 			&alert.CreatedAt,
 			&alert.UpdatedAt,
+			&alert.OrganizationID,
+			&alert.Watchlist,
 			&alert.MatchCount,
+			&alert.UnacknowledgedCount,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan alert row: %w", err)
@@ -258,7 +273,9 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context) ([]*domain.Alert,
 			value,
 			is_active,
 			created_at,
-			updated_at
+			updated_at,
+			organization_id,
+			watchlist
 		FROM alerts
 		WHERE is_active = true
 		ORDER BY created_at DESC
@@ -283,6 +300,8 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context) ([]*domain.Alert,
 			&alert.IsActive,
 			&alert.CreatedAt,
 			&alert.UpdatedAt,
+			&alert.OrganizationID,
+			&alert.Watchlist,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan alert row: %w", err)
@@ -0,0 +1,451 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// AnalyticsRepository implements repository.AnalyticsRepository for PostgreSQL
+// Every figure is aggregated from the source tables at query time, except client engagement,
+// which is read from the daily aggregates maintained by the client event aggregation job
+type AnalyticsRepository struct {
+	db *DB
+}
+
+// NewAnalyticsRepository creates a new PostgreSQL analytics repository
+func NewAnalyticsRepository(db *DB) *AnalyticsRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &AnalyticsRepository{db: db}
+}
+
+// GetIngestionVolume counts articles created since the given time by day, source and category
+// Source and category breakdowns are limited to the largest entries
+func (r *AnalyticsRepository) GetIngestionVolume(ctx context.Context, since time.Time, limit int) (*domain.IngestionVolume, error) {
+	volume := &domain.IngestionVolume{
+		ByDay:      make([]domain.DailyCount, 0),
+		BySource:   make([]domain.NamedCount, 0),
+		ByCategory: make([]domain.NamedCount, 0),
+	}
+
+	dayQuery := `
+		SELECT TO_CHAR(DATE_TRUNC('day', created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD') AS day, COUNT(*)
+		FROM articles
+		WHERE created_at >= $1
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, dayQuery, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingestion by day: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var day domain.DailyCount
+		if err := rows.Scan(&day.Date, &day.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan ingestion day: %w", err)
+		}
+		volume.ByDay = append(volume.ByDay, day)
+		volume.Total += day.Count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ingestion days: %w", err)
+	}
+
+	sourceQuery := `
+		SELECT s.id, s.name, COUNT(*) AS total
+		FROM articles a
+		JOIN sources s ON s.id = a.source_id
+		WHERE a.created_at >= $1
+		GROUP BY s.id, s.name
+		ORDER BY total DESC, s.name ASC
+		LIMIT $2
+	`
+
+	if volume.BySource, err = r.namedCounts(ctx, sourceQuery, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get ingestion by source: %w", err)
+	}
+
+	categoryQuery := `
+		SELECT c.id, c.name, COUNT(*) AS total
+		FROM articles a
+		JOIN categories c ON c.id = a.category_id
+		WHERE a.created_at >= $1
+		GROUP BY c.id, c.name
+		ORDER BY total DESC, c.name ASC
+		LIMIT $2
+	`
+
+	if volume.ByCategory, err = r.namedCounts(ctx, categoryQuery, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get ingestion by category: %w", err)
+	}
+
+	return volume, nil
+}
+
+// GetEnrichmentLatency computes created-to-enriched percentiles for articles created since the given time
+func (r *AnalyticsRepository) GetEnrichmentLatency(ctx context.Context, since time.Time) (*domain.EnrichmentLatency, error) {
+	query := `
+		WITH latencies AS (
+			SELECT EXTRACT(EPOCH FROM (enriched_at - created_at)) * 1000 AS latency
+			FROM articles
+			WHERE created_at >= $1 AND enriched_at IS NOT NULL
+		)
+		SELECT
+			(SELECT COUNT(*) FROM latencies),
+			(SELECT COUNT(*) FROM articles WHERE created_at >= $1 AND enriched_at IS NULL),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY latency), 0),
+			COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY latency), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY latency), 0),
+			COALESCE(percentile_cont(0.99) WITHIN GROUP (ORDER BY latency), 0)
+		FROM latencies
+	`
+
+	latency := &domain.EnrichmentLatency{}
+	err := r.db.Pool.QueryRow(ctx, query, since).Scan(
+		&latency.SampleCount,
+		&latency.Pending,
+		&latency.P50Ms,
+		&latency.P90Ms,
+		&latency.P95Ms,
+		&latency.P99Ms,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrichment latency: %w", err)
+	}
+
+	return latency, nil
+}
+
+// GetTopArticles ranks articles by reads since the given time, breaking ties with bookmarks and then client views
+func (r *AnalyticsRepository) GetTopArticles(ctx context.Context, since time.Time, limit int) ([]domain.ArticleEngagement, error) {
+	query := `
+		WITH reads AS (
+			SELECT article_id, COUNT(*) AS reads, COUNT(DISTINCT user_id) AS unique_readers
+			FROM article_reads
+			WHERE read_at >= $1
+			GROUP BY article_id
+		),
+		saves AS (
+			SELECT article_id, COUNT(*) AS bookmarks
+			FROM bookmarks
+			WHERE created_at >= $1
+			GROUP BY article_id
+		),
+		events AS (
+			SELECT
+				article_id,
+				SUM(events) FILTER (WHERE event_type = 'view') AS views,
+				SUM(events) FILTER (WHERE event_type = 'share') AS shares,
+				SUM(events) FILTER (WHERE event_type = 'cta_click') AS cta_clicks
+			FROM client_event_daily_stats
+			WHERE day >= ($1::timestamptz AT TIME ZONE 'UTC')::date
+			GROUP BY article_id
+		)
+		SELECT
+			a.id, a.title, a.slug, a.severity,
+			COALESCE(rd.reads, 0), COALESCE(rd.unique_readers, 0), COALESCE(sv.bookmarks, 0),
+			COALESCE(ev.views, 0), COALESCE(ev.shares, 0), COALESCE(ev.cta_clicks, 0)
+		FROM articles a
+		LEFT JOIN reads rd ON rd.article_id = a.id
+		LEFT JOIN saves sv ON sv.article_id = a.id
+		LEFT JOIN events ev ON ev.article_id = a.id
+		WHERE rd.article_id IS NOT NULL OR sv.article_id IS NOT NULL OR ev.article_id IS NOT NULL
+		ORDER BY COALESCE(rd.reads, 0) DESC, COALESCE(sv.bookmarks, 0) DESC, COALESCE(ev.views, 0) DESC, a.published_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top articles: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]domain.ArticleEngagement, 0)
+	for rows.Next() {
+		var article domain.ArticleEngagement
+		if err := rows.Scan(
+			&article.ArticleID,
+			&article.Title,
+			&article.Slug,
+			&article.Severity,
+			&article.Reads,
+			&article.UniqueReaders,
+			&article.Bookmarks,
+			&article.Views,
+			&article.Shares,
+			&article.CTAClicks,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan top article: %w", err)
+		}
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating top articles: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetActiveUsers counts users who logged in or read an article over the last day, week, month and window
+func (r *AnalyticsRepository) GetActiveUsers(ctx context.Context, since time.Time) (*domain.ActiveUsers, error) {
+	query := `
+		WITH activity AS (
+			SELECT id AS user_id, last_login_at AS active_at FROM users WHERE last_login_at IS NOT NULL
+			UNION ALL
+			SELECT user_id, MAX(read_at) FROM article_reads WHERE user_id IS NOT NULL GROUP BY user_id
+		),
+		last_active AS (
+			SELECT user_id, MAX(active_at) AS active_at FROM activity GROUP BY user_id
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE active_at >= NOW() - INTERVAL '1 day'),
+			COUNT(*) FILTER (WHERE active_at >= NOW() - INTERVAL '7 days'),
+			COUNT(*) FILTER (WHERE active_at >= NOW() - INTERVAL '30 days'),
+			COUNT(*) FILTER (WHERE active_at >= $1),
+			(SELECT COUNT(*) FROM users)
+		FROM last_active
+	`
+
+	active := &domain.ActiveUsers{}
+	err := r.db.Pool.QueryRow(ctx, query, since).Scan(
+		&active.Daily,
+		&active.Weekly,
+		&active.Monthly,
+		&active.InWindow,
+		&active.TotalUsers,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active users: %w", err)
+	}
+
+	return active, nil
+}
+
+// GetAlertMatchRates summarizes alert matches against articles created since the given time
+func (r *AnalyticsRepository) GetAlertMatchRates(ctx context.Context, since time.Time) (*domain.AlertMatchRates, error) {
+	query := `
+		WITH window_articles AS (
+			SELECT id FROM articles WHERE created_at >= $1
+		),
+		window_matches AS (
+			SELECT alert_id, article_id FROM alert_matches WHERE matched_at >= $1
+		)
+		SELECT
+			(SELECT COUNT(*) FROM alerts WHERE is_active = true),
+			(SELECT COUNT(*) FROM window_matches),
+			(SELECT COUNT(DISTINCT alert_id) FROM window_matches),
+			(SELECT COUNT(DISTINCT wm.article_id) FROM window_matches wm
+				JOIN window_articles wa ON wa.id = wm.article_id),
+			(SELECT COUNT(*) FROM window_articles)
+	`
+
+	rates := &domain.AlertMatchRates{}
+	var ingested int
+	err := r.db.Pool.QueryRow(ctx, query, since).Scan(
+		&rates.ActiveAlerts,
+		&rates.Matches,
+		&rates.AlertsMatched,
+		&rates.ArticlesMatched,
+		&ingested,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert match rates: %w", err)
+	}
+
+	if ingested > 0 {
+		rates.MatchRate = float64(rates.ArticlesMatched) / float64(ingested)
+	}
+
+	return rates, nil
+}
+
+// GetClientEngagement totals the daily client event aggregates from the UTC day of since
+// Events reported since the last aggregation run are not included yet
+func (r *AnalyticsRepository) GetClientEngagement(ctx context.Context, since time.Time) (*domain.ClientEngagement, error) {
+	engagement := &domain.ClientEngagement{
+		ByDay: make([]domain.ClientEngagementDay, 0),
+	}
+
+	dayQuery := `
+		SELECT
+			TO_CHAR(day, 'YYYY-MM-DD'),
+			COALESCE(SUM(events) FILTER (WHERE event_type = 'view'), 0),
+			COALESCE(SUM(unique_users) FILTER (WHERE event_type = 'view'), 0),
+			COALESCE(SUM(events) FILTER (WHERE event_type = 'share'), 0),
+			COALESCE(SUM(events) FILTER (WHERE event_type = 'cta_click'), 0)
+		FROM client_event_daily_stats
+		WHERE day >= ($1::timestamptz AT TIME ZONE 'UTC')::date
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, dayQuery, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client engagement by day: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var day domain.ClientEngagementDay
+		if err := rows.Scan(&day.Date, &day.Views, &day.UniqueViewers, &day.Shares, &day.CTAClicks); err != nil {
+			return nil, fmt.Errorf("failed to scan client engagement day: %w", err)
+		}
+		engagement.ByDay = append(engagement.ByDay, day)
+		engagement.Views += day.Views
+		engagement.Shares += day.Shares
+		engagement.CTAClicks += day.CTAClicks
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating client engagement days: %w", err)
+	}
+
+	// Weight each day's average by its number of scroll events
+	scrollQuery := `
+		SELECT COALESCE(SUM(avg_scroll_depth * events) / NULLIF(SUM(events), 0), 0)::float8
+		FROM client_event_daily_stats
+		WHERE event_type = 'scroll' AND day >= ($1::timestamptz AT TIME ZONE 'UTC')::date
+	`
+
+	if err := r.db.Pool.QueryRow(ctx, scrollQuery, since).Scan(&engagement.AvgScrollDepth); err != nil {
+		return nil, fmt.Errorf("failed to get average scroll depth: %w", err)
+	}
+
+	return engagement, nil
+}
+
+// GetShareLinkStats counts share links created and followed since the given time
+// Sources, referring hosts and articles are limited to the largest entries
+func (r *AnalyticsRepository) GetShareLinkStats(ctx context.Context, since time.Time, limit int) (*domain.ShareLinkStats, error) {
+	stats := &domain.ShareLinkStats{}
+
+	totalsQuery := `
+		SELECT
+			(SELECT COUNT(*) FROM article_share_links WHERE created_at >= $1),
+			(SELECT COUNT(*) FROM article_share_clicks WHERE clicked_at >= $1)
+	`
+
+	if err := r.db.Pool.QueryRow(ctx, totalsQuery, since).Scan(&stats.LinksCreated, &stats.Clicks); err != nil {
+		return nil, fmt.Errorf("failed to count share links: %w", err)
+	}
+
+	sourceQuery := `
+		SELECT source, COUNT(*) AS clicks
+		FROM article_share_clicks
+		WHERE clicked_at >= $1
+		GROUP BY source
+		ORDER BY clicks DESC, source ASC
+		LIMIT $2
+	`
+
+	var err error
+	if stats.BySource, err = r.labeledCounts(ctx, sourceQuery, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get share clicks by source: %w", err)
+	}
+
+	referrerQuery := `
+		SELECT referrer_host, COUNT(*) AS clicks
+		FROM article_share_clicks
+		WHERE clicked_at >= $1 AND referrer_host IS NOT NULL
+		GROUP BY referrer_host
+		ORDER BY clicks DESC, referrer_host ASC
+		LIMIT $2
+	`
+
+	if stats.ByReferrer, err = r.labeledCounts(ctx, referrerQuery, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get share clicks by referrer: %w", err)
+	}
+
+	articleQuery := `
+		WITH links AS (
+			SELECT article_id, COUNT(*) AS links
+			FROM article_share_links
+			WHERE created_at >= $1
+			GROUP BY article_id
+		),
+		clicks AS (
+			SELECT l.article_id, COUNT(*) AS clicks
+			FROM article_share_clicks c
+			JOIN article_share_links l ON l.id = c.share_link_id
+			WHERE c.clicked_at >= $1
+			GROUP BY l.article_id
+		)
+		SELECT a.id, a.title, a.slug, COALESCE(lk.links, 0), COALESCE(ck.clicks, 0)
+		FROM articles a
+		LEFT JOIN links lk ON lk.article_id = a.id
+		LEFT JOIN clicks ck ON ck.article_id = a.id
+		WHERE lk.article_id IS NOT NULL OR ck.article_id IS NOT NULL
+		ORDER BY COALESCE(ck.clicks, 0) DESC, COALESCE(lk.links, 0) DESC, a.published_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, articleQuery, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most shared articles: %w", err)
+	}
+	defer rows.Close()
+
+	stats.TopArticles = make([]domain.SharedArticle, 0)
+	for rows.Next() {
+		var article domain.SharedArticle
+		if err := rows.Scan(&article.ArticleID, &article.Title, &article.Slug, &article.Links, &article.Clicks); err != nil {
+			return nil, fmt.Errorf("failed to scan shared article: %w", err)
+		}
+		stats.TopArticles = append(stats.TopArticles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shared articles: %w", err)
+	}
+
+	return stats, nil
+}
+
+// namedCounts runs a query returning (id, name, count) rows
+func (r *AnalyticsRepository) namedCounts(ctx context.Context, query string, args ...interface{}) ([]domain.NamedCount, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]domain.NamedCount, 0)
+	for rows.Next() {
+		var count domain.NamedCount
+		if err := rows.Scan(&count.ID, &count.Name, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, rows.Err()
+}
+
+// labeledCounts runs a query returning (label, count) rows
+func (r *AnalyticsRepository) labeledCounts(ctx context.Context, query string, args ...interface{}) ([]domain.LabeledCount, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]domain.LabeledCount, 0)
+	for rows.Next() {
+		var count domain.LabeledCount
+		if err := rows.Scan(&count.Label, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, rows.Err()
+}
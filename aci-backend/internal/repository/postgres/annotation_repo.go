@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// AnnotationRepository implements repository.AnnotationRepository for PostgreSQL
+type AnnotationRepository struct {
+	db *DB
+}
+
+// NewAnnotationRepository creates a new PostgreSQL annotation repository
+func NewAnnotationRepository(db *DB) *AnnotationRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &AnnotationRepository{db: db}
+}
+
+// annotationColumns are the annotation columns followed by the author's name and the article title
+const annotationColumns = `
+	n.id, n.article_id, n.organization_id, n.user_id, n.body, n.quote, n.mentions,
+	n.created_at, n.updated_at, u.name, COALESCE(NULLIF(a.editorial_title, ''), a.title)
+`
+
+// annotationJoins join each annotation to its author and article
+const annotationJoins = `
+	FROM article_annotations n
+	JOIN users u ON u.id = n.user_id
+	JOIN articles a ON a.id = n.article_id
+`
+
+// Create inserts a new annotation
+func (r *AnnotationRepository) Create(ctx context.Context, annotation *domain.Annotation) error {
+	if annotation == nil {
+		return fmt.Errorf("annotation cannot be nil")
+	}
+
+	if annotation.ID == uuid.Nil {
+		return fmt.Errorf("annotation ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO article_annotations (
+			id, article_id, organization_id, user_id, body, quote, mentions, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		annotation.ID,
+		annotation.ArticleID,
+		annotation.OrganizationID,
+		annotation.UserID,
+		annotation.Body,
+		annotation.Quote,
+		annotationMentions(annotation),
+		annotation.CreatedAt,
+		annotation.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create annotation: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an annotation with its author's name
+func (r *AnnotationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Annotation, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("annotation ID cannot be nil")
+	}
+
+	query := `SELECT ` + annotationColumns + annotationJoins + `WHERE n.id = $1`
+
+	annotation, err := scanAnnotation(r.db.Pool.QueryRow(ctx, query, id), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "annotation",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get annotation: %w", err)
+	}
+
+	return annotation, nil
+}
+
+// Update saves an annotation's body, quote and mentions
+func (r *AnnotationRepository) Update(ctx context.Context, annotation *domain.Annotation) error {
+	if annotation == nil {
+		return fmt.Errorf("annotation cannot be nil")
+	}
+
+	query := `
+		UPDATE article_annotations
+		SET body = $2, quote = $3, mentions = $4
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		annotation.ID,
+		annotation.Body,
+		annotation.Quote,
+		annotationMentions(annotation),
+	).Scan(&annotation.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domainerrors.NotFoundError{
+			Resource: "annotation",
+			ID:       annotation.ID.String(),
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update annotation: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes an annotation
+func (r *AnnotationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM article_annotations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete annotation: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "annotation",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListByArticle returns the organization's annotations on an article, oldest first
+func (r *AnnotationRepository) ListByArticle(ctx context.Context, orgID, articleID uuid.UUID) ([]*domain.Annotation, error) {
+	query := `SELECT ` + annotationColumns + annotationJoins + `
+		WHERE n.organization_id = $1 AND n.article_id = $2
+		ORDER BY n.created_at ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, orgID, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list annotations: %w", err)
+	}
+	defer rows.Close()
+
+	annotations := make([]*domain.Annotation, 0)
+	for rows.Next() {
+		annotation, err := scanAnnotation(rows, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan annotation: %w", err)
+		}
+		annotations = append(annotations, annotation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating annotations: %w", err)
+	}
+
+	return annotations, nil
+}
+
+// ListByOrganization returns a page of the organization's annotations created since, newest first
+func (r *AnnotationRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, since *time.Time, limit, offset int) ([]*domain.Annotation, int, error) {
+	query := `SELECT ` + annotationColumns + `, COUNT(*) OVER ()` + annotationJoins + `
+		WHERE n.organization_id = $1 AND ($2::timestamptz IS NULL OR n.created_at >= $2)
+		ORDER BY n.created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, orgID, since, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list organization annotations: %w", err)
+	}
+	defer rows.Close()
+
+	annotations := make([]*domain.Annotation, 0)
+	total := 0
+
+	for rows.Next() {
+		annotation, err := scanAnnotation(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan annotation: %w", err)
+		}
+		annotations = append(annotations, annotation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating annotations: %w", err)
+	}
+
+	return annotations, total, nil
+}
+
+// annotationMentions returns the mentions to store, never nil
+func annotationMentions(annotation *domain.Annotation) []uuid.UUID {
+	if annotation.Mentions == nil {
+		return []uuid.UUID{}
+	}
+	return annotation.Mentions
+}
+
+// scanAnnotation scans annotationColumns, followed by the total row count when total is set
+func scanAnnotation(row pgx.Row, total *int) (*domain.Annotation, error) {
+	annotation := &domain.Annotation{}
+	dest := []interface{}{
+		&annotation.ID, &annotation.ArticleID, &annotation.OrganizationID, &annotation.UserID,
+		&annotation.Body, &annotation.Quote, &annotation.Mentions,
+		&annotation.CreatedAt, &annotation.UpdatedAt, &annotation.AuthorName, &annotation.ArticleTitle,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return annotation, nil
+}
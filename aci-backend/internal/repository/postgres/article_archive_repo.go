@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// maxArticleArchiveErrorLength bounds the error stored for a failed archive attempt
+const maxArticleArchiveErrorLength = 1000
+
+// ArticleArchiveRepository implements repository.ArticleArchiveRepository
+type ArticleArchiveRepository struct {
+	db *DB
+}
+
+// NewArticleArchiveRepository creates a new article archive repository instance
+func NewArticleArchiveRepository(db *DB) *ArticleArchiveRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &ArticleArchiveRepository{db: db}
+}
+
+// ListPending implements repository.ArticleArchiveRepository
+func (r *ArticleArchiveRepository) ListPending(ctx context.Context, since time.Time, maxAttempts, limit int) ([]*domain.ArticleArchiveCandidate, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT a.id, a.source_url
+		FROM articles a
+		LEFT JOIN article_archives aa ON aa.article_id = a.id
+		WHERE a.created_at >= $1
+			AND (
+				aa.article_id IS NULL
+				OR (aa.status = 'failed' AND aa.attempts < $2 AND aa.next_attempt_at <= NOW())
+			)
+		ORDER BY a.created_at
+		LIMIT $3
+	`, since, maxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles pending archive: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := make([]*domain.ArticleArchiveCandidate, 0)
+	for rows.Next() {
+		candidate := &domain.ArticleArchiveCandidate{}
+		if err := rows.Scan(&candidate.ArticleID, &candidate.SourceURL); err != nil {
+			return nil, fmt.Errorf("failed to scan article pending archive: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating articles pending archive: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// MarkArchived implements repository.ArticleArchiveRepository
+func (r *ArticleArchiveRepository) MarkArchived(ctx context.Context, articleID uuid.UUID, htmlKey, textKey string) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO article_archives (article_id, status, html_key, text_key, attempts, archived_at, updated_at)
+		VALUES ($1, 'archived', $2, $3, 1, NOW(), NOW())
+		ON CONFLICT (article_id) DO UPDATE SET
+			status = 'archived',
+			html_key = EXCLUDED.html_key,
+			text_key = EXCLUDED.text_key,
+			attempts = article_archives.attempts + 1,
+			last_error = NULL,
+			next_attempt_at = NULL,
+			archived_at = NOW(),
+			updated_at = NOW()
+	`, articleID, htmlKey, textKey)
+	if err != nil {
+		return fmt.Errorf("failed to record article archive: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed implements repository.ArticleArchiveRepository
+// Retries wait 5 minutes, doubling with each attempt up to 6 hours
+func (r *ArticleArchiveRepository) MarkFailed(ctx context.Context, articleID uuid.UUID, message string) error {
+	if len(message) > maxArticleArchiveErrorLength {
+		message = message[:maxArticleArchiveErrorLength]
+	}
+
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO article_archives (article_id, status, attempts, last_error, next_attempt_at, updated_at)
+		VALUES ($1, 'failed', 1, $2, NOW() + INTERVAL '5 minutes', NOW())
+		ON CONFLICT (article_id) DO UPDATE SET
+			status = 'failed',
+			attempts = article_archives.attempts + 1,
+			last_error = EXCLUDED.last_error,
+			next_attempt_at = NOW() + LEAST(INTERVAL '6 hours', INTERVAL '5 minutes' * POWER(2, LEAST(article_archives.attempts, 10))),
+			updated_at = NOW()
+	`, articleID, message)
+	if err != nil {
+		return fmt.Errorf("failed to record article archive failure: %w", err)
+	}
+
+	return nil
+}
+
+// GetByArticleID implements repository.ArticleArchiveRepository
+func (r *ArticleArchiveRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleArchive, error) {
+	archive := &domain.ArticleArchive{}
+
+	err := r.db.read(ctx).QueryRow(ctx, `
+		SELECT article_id, status, html_key, text_key, attempts, last_error, archived_at, updated_at
+		FROM article_archives
+		WHERE article_id = $1
+	`, articleID).Scan(
+		&archive.ArticleID,
+		&archive.Status,
+		&archive.HTMLKey,
+		&archive.TextKey,
+		&archive.Attempts,
+		&archive.LastError,
+		&archive.ArchivedAt,
+		&archive.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article archive",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get article archive: %w", err)
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleFingerprintRepository implements repository.ArticleFingerprintRepository for PostgreSQL
+type ArticleFingerprintRepository struct {
+	db *DB
+}
+
+// NewArticleFingerprintRepository creates a new PostgreSQL article fingerprint repository
+func NewArticleFingerprintRepository(db *DB) *ArticleFingerprintRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ArticleFingerprintRepository{db: db}
+}
+
+// Create inserts a fingerprint; an existing fingerprint for the article is left unchanged
+func (r *ArticleFingerprintRepository) Create(ctx context.Context, fingerprint *domain.ArticleFingerprint) error {
+	if fingerprint == nil {
+		return fmt.Errorf("fingerprint cannot be nil")
+	}
+
+	if fingerprint.ArticleID == uuid.Nil || fingerprint.CanonicalID == uuid.Nil {
+		return fmt.Errorf("article ID and canonical ID are required")
+	}
+
+	query := `
+		INSERT INTO article_fingerprints (article_id, simhash, canonical_id, distance, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (article_id) DO NOTHING
+	`
+
+	// The simhash bit pattern is stored in a signed BIGINT
+	_, err := r.db.Pool.Exec(ctx, query,
+		fingerprint.ArticleID,
+		int64(fingerprint.Simhash),
+		fingerprint.CanonicalID,
+		fingerprint.Distance,
+		fingerprint.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create article fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+// GetByArticleID retrieves the fingerprint of an article
+func (r *ArticleFingerprintRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleFingerprint, error) {
+	query := `
+		SELECT article_id, simhash, canonical_id, distance, created_at
+		FROM article_fingerprints
+		WHERE article_id = $1
+	`
+
+	fingerprint, err := scanArticleFingerprint(r.db.Pool.QueryRow(ctx, query, articleID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article fingerprint",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get article fingerprint: %w", err)
+	}
+
+	return fingerprint, nil
+}
+
+// ListSince returns fingerprints created at or after since, oldest first
+func (r *ArticleFingerprintRepository) ListSince(ctx context.Context, since time.Time) ([]*domain.ArticleFingerprint, error) {
+	query := `
+		SELECT article_id, simhash, canonical_id, distance, created_at
+		FROM article_fingerprints
+		WHERE created_at >= $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article fingerprints: %w", err)
+	}
+	defer rows.Close()
+
+	return collectArticleFingerprints(rows)
+}
+
+// ListByCanonical returns all members of a duplicate cluster, canonical first
+func (r *ArticleFingerprintRepository) ListByCanonical(ctx context.Context, canonicalID uuid.UUID) ([]*domain.ArticleFingerprint, error) {
+	query := `
+		SELECT article_id, simhash, canonical_id, distance, created_at
+		FROM article_fingerprints
+		WHERE canonical_id = $1
+		ORDER BY (article_id = canonical_id) DESC, created_at ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, canonicalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate cluster: %w", err)
+	}
+	defer rows.Close()
+
+	return collectArticleFingerprints(rows)
+}
+
+// scanArticleFingerprint scans a single fingerprint row
+func scanArticleFingerprint(row pgx.Row) (*domain.ArticleFingerprint, error) {
+	fingerprint := &domain.ArticleFingerprint{}
+
+	var simhash int64
+	err := row.Scan(
+		&fingerprint.ArticleID,
+		&simhash,
+		&fingerprint.CanonicalID,
+		&fingerprint.Distance,
+		&fingerprint.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint.Simhash = uint64(simhash)
+
+	return fingerprint, nil
+}
+
+// collectArticleFingerprints scans all fingerprint rows
+func collectArticleFingerprints(rows pgx.Rows) ([]*domain.ArticleFingerprint, error) {
+	fingerprints := make([]*domain.ArticleFingerprint, 0)
+	for rows.Next() {
+		fingerprint, err := scanArticleFingerprint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article fingerprint: %w", err)
+		}
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article fingerprints: %w", err)
+	}
+
+	return fingerprints, nil
+}
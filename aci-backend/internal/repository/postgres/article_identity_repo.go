@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleIdentityRepository implements repository.ArticleIdentityRepository for PostgreSQL
+type ArticleIdentityRepository struct {
+	db *DB
+}
+
+// NewArticleIdentityRepository creates a new PostgreSQL article identity repository
+func NewArticleIdentityRepository(db *DB) *ArticleIdentityRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ArticleIdentityRepository{db: db}
+}
+
+// FindByCanonicalURL returns the article linked to a canonical URL
+func (r *ArticleIdentityRepository) FindByCanonicalURL(ctx context.Context, canonicalURL string) (uuid.UUID, error) {
+	query := `
+		SELECT article_id
+		FROM article_source_urls
+		WHERE canonical_url = $1
+	`
+
+	var articleID uuid.UUID
+	if err := r.db.conn(ctx).QueryRow(ctx, query, canonicalURL).Scan(&articleID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, &domainerrors.NotFoundError{
+				Resource: "article source URL",
+				ID:       canonicalURL,
+			}
+		}
+		return uuid.Nil, fmt.Errorf("failed to find article by canonical URL: %w", err)
+	}
+
+	return articleID, nil
+}
+
+// FindByContentHash returns the oldest article with a content hash
+func (r *ArticleIdentityRepository) FindByContentHash(ctx context.Context, contentHash string) (uuid.UUID, error) {
+	query := `
+		SELECT article_id
+		FROM article_content_hashes
+		WHERE content_hash = $1
+		ORDER BY created_at ASC
+		LIMIT 1
+	`
+
+	var articleID uuid.UUID
+	if err := r.db.conn(ctx).QueryRow(ctx, query, contentHash).Scan(&articleID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, &domainerrors.NotFoundError{
+				Resource: "article content hash",
+				ID:       contentHash,
+			}
+		}
+		return uuid.Nil, fmt.Errorf("failed to find article by content hash: %w", err)
+	}
+
+	return articleID, nil
+}
+
+// Record stores the identity of a new article
+// It returns a ConflictError when the canonical URL already belongs to another article, so
+// an article ingested twice at once is stored only once
+func (r *ArticleIdentityRepository) Record(ctx context.Context, sourceURL *domain.ArticleSourceURL, contentHash string) error {
+	if sourceURL == nil {
+		return fmt.Errorf("source URL cannot be nil")
+	}
+
+	conn := r.db.conn(ctx)
+
+	_, err := conn.Exec(ctx, `
+		INSERT INTO article_source_urls (canonical_url, article_id, source_url, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, sourceURL.CanonicalURL, sourceURL.ArticleID, sourceURL.SourceURL, sourceURL.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &domainerrors.ConflictError{
+				Resource: "article",
+				Field:    "canonical_url",
+				Value:    sourceURL.CanonicalURL,
+			}
+		}
+		return fmt.Errorf("failed to record article source URL: %w", err)
+	}
+
+	if contentHash == "" {
+		return nil
+	}
+
+	_, err = conn.Exec(ctx, `
+		INSERT INTO article_content_hashes (article_id, content_hash, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (article_id) DO NOTHING
+	`, sourceURL.ArticleID, contentHash, sourceURL.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record article content hash: %w", err)
+	}
+
+	return nil
+}
+
+// LinkURL links another canonical URL to an article
+func (r *ArticleIdentityRepository) LinkURL(ctx context.Context, sourceURL *domain.ArticleSourceURL) error {
+	if sourceURL == nil {
+		return fmt.Errorf("source URL cannot be nil")
+	}
+
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO article_source_urls (canonical_url, article_id, source_url, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (canonical_url) DO NOTHING
+	`, sourceURL.CanonicalURL, sourceURL.ArticleID, sourceURL.SourceURL, sourceURL.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to link article source URL: %w", err)
+	}
+
+	return nil
+}
+
+// ListURLs returns the URLs an article was received under, oldest first
+func (r *ArticleIdentityRepository) ListURLs(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSourceURL, error) {
+	query := `
+		SELECT canonical_url, article_id, source_url, created_at
+		FROM article_source_urls
+		WHERE article_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article source URLs: %w", err)
+	}
+	defer rows.Close()
+
+	sourceURLs := make([]*domain.ArticleSourceURL, 0)
+	for rows.Next() {
+		sourceURL := &domain.ArticleSourceURL{}
+		if err := rows.Scan(&sourceURL.CanonicalURL, &sourceURL.ArticleID, &sourceURL.SourceURL, &sourceURL.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan article source URL: %w", err)
+		}
+		sourceURLs = append(sourceURLs, sourceURL)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article source URLs: %w", err)
+	}
+
+	return sourceURLs, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// maxArticleImageErrorLength bounds the error stored for a failed image attempt
+const maxArticleImageErrorLength = 1000
+
+// ArticleImageRepository implements repository.ArticleImageRepository
+type ArticleImageRepository struct {
+	db *DB
+}
+
+// NewArticleImageRepository creates a new article image repository instance
+func NewArticleImageRepository(db *DB) *ArticleImageRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &ArticleImageRepository{db: db}
+}
+
+// ListPending implements repository.ArticleImageRepository
+func (r *ArticleImageRepository) ListPending(ctx context.Context, since time.Time, maxAttempts, limit int) ([]*domain.ArticleImageCandidate, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT a.id, a.image_url
+		FROM articles a
+		LEFT JOIN article_image_failures f ON f.article_id = a.id
+		WHERE a.image_url IS NOT NULL AND a.image_key IS NULL
+			AND a.created_at >= $1
+			AND (f.article_id IS NULL OR (f.attempts < $2 AND f.next_attempt_at <= NOW()))
+		ORDER BY a.created_at
+		LIMIT $3
+	`, since, maxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending article images: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := make([]*domain.ArticleImageCandidate, 0)
+	for rows.Next() {
+		candidate := &domain.ArticleImageCandidate{}
+		if err := rows.Scan(&candidate.ArticleID, &candidate.ImageURL); err != nil {
+			return nil, fmt.Errorf("failed to scan pending article image: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pending article images: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// MarkStored implements repository.ArticleImageRepository
+func (r *ArticleImageRepository) MarkStored(ctx context.Context, articleID uuid.UUID, key string) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		WITH cleared AS (
+			DELETE FROM article_image_failures WHERE article_id = $1
+		)
+		UPDATE articles SET image_key = $2 WHERE id = $1
+	`, articleID, key)
+	if err != nil {
+		return fmt.Errorf("failed to record stored article image: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed implements repository.ArticleImageRepository
+// Retries wait 5 minutes, doubling with each attempt up to 6 hours
+func (r *ArticleImageRepository) MarkFailed(ctx context.Context, articleID uuid.UUID, message string) error {
+	if len(message) > maxArticleImageErrorLength {
+		message = message[:maxArticleImageErrorLength]
+	}
+
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO article_image_failures (article_id, attempts, last_error, next_attempt_at, updated_at)
+		VALUES ($1, 1, $2, NOW() + INTERVAL '5 minutes', NOW())
+		ON CONFLICT (article_id) DO UPDATE SET
+			attempts = article_image_failures.attempts + 1,
+			last_error = EXCLUDED.last_error,
+			next_attempt_at = NOW() + LEAST(INTERVAL '6 hours', INTERVAL '5 minutes' * POWER(2, LEAST(article_image_failures.attempts, 10))),
+			updated_at = NOW()
+	`, articleID, message)
+	if err != nil {
+		return fmt.Errorf("failed to record article image failure: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// articleImportColumns lists the columns scanned by scanArticleImport
+const articleImportColumns = `
+	id, format, filename, status, processed, created, duplicates, failed,
+	COALESCE(checksum, ''), errors, error, created_by, created_at, updated_at, completed_at
+`
+
+// ArticleImportRepository implements repository.ArticleImportRepository for PostgreSQL
+type ArticleImportRepository struct {
+	db *DB
+}
+
+// NewArticleImportRepository creates a new PostgreSQL article import repository
+func NewArticleImportRepository(db *DB) *ArticleImportRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ArticleImportRepository{db: db}
+}
+
+// Create inserts a new import
+func (r *ArticleImportRepository) Create(ctx context.Context, articleImport *domain.ArticleImport) error {
+	if articleImport == nil {
+		return fmt.Errorf("article import cannot be nil")
+	}
+
+	var filename *string
+	if articleImport.Filename != "" {
+		filename = &articleImport.Filename
+	}
+
+	query := `
+		INSERT INTO article_imports (id, format, filename, status, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		articleImport.ID,
+		articleImport.Format,
+		filename,
+		articleImport.Status,
+		articleImport.CreatedBy,
+		articleImport.CreatedAt,
+		articleImport.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create article import: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an import
+func (r *ArticleImportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ArticleImport, error) {
+	query := `SELECT ` + articleImportColumns + ` FROM article_imports WHERE id = $1`
+
+	articleImport, err := scanArticleImport(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article import",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get article import: %w", err)
+	}
+
+	return articleImport, nil
+}
+
+// List returns the most recent imports, newest first
+func (r *ArticleImportRepository) List(ctx context.Context, limit int) ([]*domain.ArticleImport, error) {
+	query := `SELECT ` + articleImportColumns + ` FROM article_imports ORDER BY created_at DESC LIMIT $1`
+
+	rows, err := r.db.Pool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article imports: %w", err)
+	}
+	defer rows.Close()
+
+	imports := make([]*domain.ArticleImport, 0)
+	for rows.Next() {
+		articleImport, err := scanArticleImport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article import: %w", err)
+		}
+		imports = append(imports, articleImport)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article imports: %w", err)
+	}
+
+	return imports, nil
+}
+
+// UpdateProgress stores the status, counts, checkpoint and errors of an import
+func (r *ArticleImportRepository) UpdateProgress(ctx context.Context, articleImport *domain.ArticleImport) error {
+	if articleImport == nil {
+		return fmt.Errorf("article import cannot be nil")
+	}
+
+	importErrors := articleImport.Errors
+	if importErrors == nil {
+		importErrors = []domain.ArticleImportError{}
+	}
+
+	errorsJSON, err := json.Marshal(importErrors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal article import errors: %w", err)
+	}
+
+	articleImport.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE article_imports
+		SET status = $2,
+			processed = $3,
+			created = $4,
+			duplicates = $5,
+			failed = $6,
+			checksum = NULLIF($7, ''),
+			errors = $8,
+			error = $9,
+			updated_at = $10,
+			completed_at = $11
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query,
+		articleImport.ID,
+		articleImport.Status,
+		articleImport.Processed,
+		articleImport.Created,
+		articleImport.Duplicates,
+		articleImport.Failed,
+		articleImport.Checksum,
+		errorsJSON,
+		articleImport.Error,
+		articleImport.UpdatedAt,
+		articleImport.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update article import: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "article import",
+			ID:       articleImport.ID.String(),
+		}
+	}
+
+	return nil
+}
+
+// scanArticleImport scans a single import row
+func scanArticleImport(row pgx.Row) (*domain.ArticleImport, error) {
+	articleImport := &domain.ArticleImport{}
+
+	var filename *string
+	var errorsJSON []byte
+	err := row.Scan(
+		&articleImport.ID,
+		&articleImport.Format,
+		&filename,
+		&articleImport.Status,
+		&articleImport.Processed,
+		&articleImport.Created,
+		&articleImport.Duplicates,
+		&articleImport.Failed,
+		&articleImport.Checksum,
+		&errorsJSON,
+		&articleImport.Error,
+		&articleImport.CreatedBy,
+		&articleImport.CreatedAt,
+		&articleImport.UpdatedAt,
+		&articleImport.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if filename != nil {
+		articleImport.Filename = *filename
+	}
+
+	if err := json.Unmarshal(errorsJSON, &articleImport.Errors); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal article import errors: %w", err)
+	}
+
+	return articleImport, nil
+}
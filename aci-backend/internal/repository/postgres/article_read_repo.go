@@ -2,7 +2,6 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 
@@ -13,13 +12,13 @@ import (
 
 // articleReadRepo implements repository.ArticleReadRepository
 type articleReadRepo struct {
-	db *sql.DB
+	db *DB
 }
 
 // NewArticleReadRepository creates a new article read repository instance
-func NewArticleReadRepository(db *sql.DB) repository.ArticleReadRepository {
+func NewArticleReadRepository(db *DB) repository.ArticleReadRepository {
 	if db == nil {
-		panic("db cannot be nil")
+		panic("database cannot be nil")
 	}
 
 	return &articleReadRepo{db: db}
@@ -43,7 +42,7 @@ func (r *articleReadRepo) Create(ctx context.Context, userID, articleID uuid.UUI
 	query := `SELECT record_article_read($1, $2, $3)`
 
 	var readID uuid.UUID
-	err := r.db.QueryRowContext(ctx, query, userID, articleID, readingTimeSeconds).Scan(&readID)
+	err := r.db.Pool.QueryRow(ctx, query, userID, articleID, readingTimeSeconds).Scan(&readID)
 	if err != nil {
 		return fmt.Errorf("failed to record article read: %w", err)
 	}
@@ -73,7 +72,7 @@ func (r *articleReadRepo) GetByUserID(ctx context.Context, userID uuid.UUID, lim
 	`
 
 	var total int
-	err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total)
+	err := r.db.Pool.QueryRow(ctx, countQuery, userID).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count article reads: %w", err)
 	}
@@ -86,7 +85,7 @@ func (r *articleReadRepo) GetByUserID(ctx context.Context, userID uuid.UUID, lim
 	query := `
 		SELECT
 			ar.id, ar.user_id, ar.article_id, ar.read_at, ar.reading_time_seconds,
-			a.id, a.title, a.slug, a.content, a.summary,
+			a.id, COALESCE(a.editorial_title, a.title), a.slug, a.content, COALESCE(a.editorial_summary, a.summary),
 			a.category_id, a.source_id, a.source_url,
 			a.severity, a.tags, a.cves, a.vendors,
 			a.threat_type, a.attack_vector, a.impact_assessment,
@@ -108,7 +107,7 @@ func (r *articleReadRepo) GetByUserID(ctx context.Context, userID uuid.UUID, lim
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := r.db.Pool.Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query article reads: %w", err)
 	}
@@ -224,14 +223,13 @@ func (r *articleReadRepo) GetUserStats(ctx context.Context, userID uuid.UUID) (*
 	`
 
 	stats := &repository.UserReadStats{}
-	var favoriteCategory sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
 		&stats.TotalArticlesRead,
 		&stats.TotalBookmarks,
 		&stats.TotalReadingTime,
 		&stats.AverageReadingTime,
-		&favoriteCategory,
+		&stats.FavoriteCategory,
 		&stats.ArticlesThisWeek,
 		&stats.ArticlesThisMonth,
 	)
@@ -239,10 +237,6 @@ func (r *articleReadRepo) GetUserStats(ctx context.Context, userID uuid.UUID) (*
 		return nil, fmt.Errorf("failed to get user stats: %w", err)
 	}
 
-	if favoriteCategory.Valid {
-		stats.FavoriteCategory = favoriteCategory.String
-	}
-
 	// Get alert counts separately (not in the DB function)
 	alertQuery := `
 		SELECT
@@ -256,7 +250,7 @@ func (r *articleReadRepo) GetUserStats(ctx context.Context, userID uuid.UUID) (*
 		WHERE a.user_id = $1
 	`
 
-	err = r.db.QueryRowContext(ctx, alertQuery, userID).Scan(
+	err = r.db.Pool.QueryRow(ctx, alertQuery, userID).Scan(
 		&stats.TotalAlerts,
 		&stats.TotalAlertMatches,
 	)
@@ -9,7 +9,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
@@ -56,14 +58,17 @@ func (r *articleRepository) Create(ctx context.Context, article *domain.Article)
 			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
 			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
 			is_competitor_favorable, reading_time_minutes, view_count, is_published,
-			published_at, enriched_at, created_at, updated_at
+			published_at, enriched_at, created_at, updated_at, image_url,
+			content_format, content_markdown, word_count, language, external_links
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
-			$18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28
+			$18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32,
+			$33, $34
 		)
+		RETURNING tenant_id
 	`
 
-	_, err = r.db.Pool.Exec(ctx, query,
+	err = r.db.conn(ctx).QueryRow(ctx, query,
 		article.ID,
 		article.Title,
 		article.Slug,
@@ -92,9 +97,19 @@ func (r *articleRepository) Create(ctx context.Context, article *domain.Article)
 		article.EnrichedAt,
 		article.CreatedAt,
 		article.UpdatedAt,
-	)
+		article.ImageURL,
+		article.ContentFormat,
+		article.ContentMarkdown,
+		article.WordCount,
+		article.Language,
+		externalLinks(article.ExternalLinks),
+	).Scan(&article.TenantID)
 
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "articles_slug_key" {
+			return &domainerrors.ConflictError{Resource: "article", Field: "slug", Value: article.Slug}
+		}
 		return fmt.Errorf("failed to create article: %w", err)
 	}
 
@@ -113,7 +128,18 @@ func (r *articleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
 			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
 			is_competitor_favorable, reading_time_minutes, view_count, is_published,
-			published_at, enriched_at, created_at, updated_at
+			published_at, enriched_at, created_at, updated_at,
+			editorial_title, editorial_summary, editorial_updated_by, editorial_updated_at,
+			image_url, image_key, content_format, content_markdown,
+			word_count, language, external_links, tenant_id,
+			EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			(SELECT MIN(k.due_date) FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			ARRAY(SELECT DISTINCT e.source FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves) ORDER BY e.source),
+			ARRAY(
+				SELECT ac.category_id FROM article_categories ac
+				WHERE ac.article_id = articles.id
+				ORDER BY ac.category_id <> articles.category_id, ac.created_at
+			)
 		FROM articles
 		WHERE id = $1
 	`
@@ -122,7 +148,7 @@ func (r *articleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 	var ctaJSON []byte
 	article := &domain.Article{}
 
-	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+	err := r.db.read(ctx).QueryRow(ctx, query, id).Scan(
 		&article.ID,
 		&article.Title,
 		&article.Slug,
@@ -151,6 +177,22 @@ func (r *articleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 		&article.EnrichedAt,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.EditorialTitle,
+		&article.EditorialSummary,
+		&article.EditorialUpdatedBy,
+		&article.EditorialUpdatedAt,
+		&article.ImageURL,
+		&article.ImageKey,
+		&article.ContentFormat,
+		&article.ContentMarkdown,
+		&article.WordCount,
+		&article.Language,
+		&article.ExternalLinks,
+		&article.TenantID,
+		&article.KEV,
+		&article.KEVDueDate,
+		&article.ExploitSources,
+		&article.CategoryIDs,
 	)
 
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -162,6 +204,8 @@ func (r *articleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 	}
 
 	// Unmarshal IOCs
+	article.ExploitAvailable = len(article.ExploitSources) > 0
+
 	if len(iocsJSON) > 0 {
 		if err := json.Unmarshal(iocsJSON, &article.IOCs); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal IOCs: %w", err)
@@ -178,6 +222,121 @@ func (r *articleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 	return article, nil
 }
 
+// GetByIDs retrieves the articles with the given IDs in a single query
+// Unknown IDs are skipped and the order of the result is unspecified
+func (r *articleRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Article, error) {
+	articles := make([]*domain.Article, 0, len(ids))
+	if len(ids) == 0 {
+		return articles, nil
+	}
+
+	query := `
+		SELECT
+			id, title, slug, content, summary, category_id, source_id, source_url,
+			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
+			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
+			is_competitor_favorable, reading_time_minutes, view_count, is_published,
+			published_at, enriched_at, created_at, updated_at,
+			editorial_title, editorial_summary, editorial_updated_by, editorial_updated_at,
+			image_url, image_key, content_format, content_markdown,
+			word_count, language, external_links, tenant_id,
+			EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			(SELECT MIN(k.due_date) FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			ARRAY(SELECT DISTINCT e.source FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves) ORDER BY e.source),
+			ARRAY(
+				SELECT ac.category_id FROM article_categories ac
+				WHERE ac.article_id = articles.id
+				ORDER BY ac.category_id <> articles.category_id, ac.created_at
+			)
+		FROM articles
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get articles: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var iocsJSON []byte
+		var ctaJSON []byte
+		article := &domain.Article{}
+
+		err := rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Content,
+			&article.Summary,
+			&article.CategoryID,
+			&article.SourceID,
+			&article.SourceURL,
+			&article.Severity,
+			&article.Tags,
+			&article.CVEs,
+			&article.Vendors,
+			&article.ThreatType,
+			&article.AttackVector,
+			&article.ImpactAssessment,
+			&article.RecommendedActions,
+			&iocsJSON,
+			&article.ArmorRelevance,
+			&ctaJSON,
+			&article.CompetitorScore,
+			&article.IsCompetitorFavorable,
+			&article.ReadingTimeMinutes,
+			&article.ViewCount,
+			&article.IsPublished,
+			&article.PublishedAt,
+			&article.EnrichedAt,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.EditorialTitle,
+			&article.EditorialSummary,
+			&article.EditorialUpdatedBy,
+			&article.EditorialUpdatedAt,
+			&article.ImageURL,
+			&article.ImageKey,
+			&article.ContentFormat,
+			&article.ContentMarkdown,
+			&article.WordCount,
+			&article.Language,
+			&article.ExternalLinks,
+			&article.TenantID,
+			&article.KEV,
+			&article.KEVDueDate,
+			&article.ExploitSources,
+			&article.CategoryIDs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article: %w", err)
+		}
+
+		article.ExploitAvailable = len(article.ExploitSources) > 0
+
+		if len(iocsJSON) > 0 {
+			if err := json.Unmarshal(iocsJSON, &article.IOCs); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal IOCs: %w", err)
+			}
+		}
+
+		if len(ctaJSON) > 0 {
+			if err := json.Unmarshal(ctaJSON, &article.ArmorCTA); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal ArmorCTA: %w", err)
+			}
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating articles: %w", err)
+	}
+
+	return articles, nil
+}
+
 // GetBySlug retrieves an article by slug
 func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain.Article, error) {
 	if slug == "" {
@@ -190,7 +349,18 @@ func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain
 			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
 			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
 			is_competitor_favorable, reading_time_minutes, view_count, is_published,
-			published_at, enriched_at, created_at, updated_at
+			published_at, enriched_at, created_at, updated_at,
+			editorial_title, editorial_summary, editorial_updated_by, editorial_updated_at,
+			image_url, image_key, content_format, content_markdown,
+			word_count, language, external_links, tenant_id,
+			EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			(SELECT MIN(k.due_date) FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			ARRAY(SELECT DISTINCT e.source FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves) ORDER BY e.source),
+			ARRAY(
+				SELECT ac.category_id FROM article_categories ac
+				WHERE ac.article_id = articles.id
+				ORDER BY ac.category_id <> articles.category_id, ac.created_at
+			)
 		FROM articles
 		WHERE slug = $1
 	`
@@ -199,7 +369,7 @@ func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain
 	var ctaJSON []byte
 	article := &domain.Article{}
 
-	err := r.db.Pool.QueryRow(ctx, query, slug).Scan(
+	err := r.db.read(ctx).QueryRow(ctx, query, slug).Scan(
 		&article.ID,
 		&article.Title,
 		&article.Slug,
@@ -228,6 +398,22 @@ func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain
 		&article.EnrichedAt,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.EditorialTitle,
+		&article.EditorialSummary,
+		&article.EditorialUpdatedBy,
+		&article.EditorialUpdatedAt,
+		&article.ImageURL,
+		&article.ImageKey,
+		&article.ContentFormat,
+		&article.ContentMarkdown,
+		&article.WordCount,
+		&article.Language,
+		&article.ExternalLinks,
+		&article.TenantID,
+		&article.KEV,
+		&article.KEVDueDate,
+		&article.ExploitSources,
+		&article.CategoryIDs,
 	)
 
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -239,6 +425,8 @@ func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain
 	}
 
 	// Unmarshal IOCs
+	article.ExploitAvailable = len(article.ExploitSources) > 0
+
 	if len(iocsJSON) > 0 {
 		if err := json.Unmarshal(iocsJSON, &article.IOCs); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal IOCs: %w", err)
@@ -267,7 +455,18 @@ func (r *articleRepository) GetBySourceURL(ctx context.Context, sourceURL string
 			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
 			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
 			is_competitor_favorable, reading_time_minutes, view_count, is_published,
-			published_at, enriched_at, created_at, updated_at
+			published_at, enriched_at, created_at, updated_at,
+			editorial_title, editorial_summary, editorial_updated_by, editorial_updated_at,
+			image_url, image_key, content_format, content_markdown,
+			word_count, language, external_links, tenant_id,
+			EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			(SELECT MIN(k.due_date) FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			ARRAY(SELECT DISTINCT e.source FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves) ORDER BY e.source),
+			ARRAY(
+				SELECT ac.category_id FROM article_categories ac
+				WHERE ac.article_id = articles.id
+				ORDER BY ac.category_id <> articles.category_id, ac.created_at
+			)
 		FROM articles
 		WHERE source_url = $1
 	`
@@ -305,6 +504,22 @@ func (r *articleRepository) GetBySourceURL(ctx context.Context, sourceURL string
 		&article.EnrichedAt,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.EditorialTitle,
+		&article.EditorialSummary,
+		&article.EditorialUpdatedBy,
+		&article.EditorialUpdatedAt,
+		&article.ImageURL,
+		&article.ImageKey,
+		&article.ContentFormat,
+		&article.ContentMarkdown,
+		&article.WordCount,
+		&article.Language,
+		&article.ExternalLinks,
+		&article.TenantID,
+		&article.KEV,
+		&article.KEVDueDate,
+		&article.ExploitSources,
+		&article.CategoryIDs,
 	)
 
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -316,6 +531,8 @@ func (r *articleRepository) GetBySourceURL(ctx context.Context, sourceURL string
 	}
 
 	// Unmarshal IOCs
+	article.ExploitAvailable = len(article.ExploitSources) > 0
+
 	if len(iocsJSON) > 0 {
 		if err := json.Unmarshal(iocsJSON, &article.IOCs); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal IOCs: %w", err)
@@ -349,8 +566,8 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 
 	if filter.CategoryID != nil {
 		argCount++
-		where = append(where, fmt.Sprintf("category_id = $%d", argCount))
-		args = append(args, *filter.CategoryID)
+		where = append(where, inCategorySubtrees(argCount))
+		args = append(args, []uuid.UUID{*filter.CategoryID})
 	}
 
 	if filter.SourceID != nil {
@@ -383,6 +600,39 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 		args = append(args, *filter.Vendor)
 	}
 
+	switch {
+	case len(filter.InterestCategoryIDs) > 0 && len(filter.InterestVendors) > 0:
+		where = append(where, fmt.Sprintf("(%s OR vendors && $%d)", inCategorySubtrees(argCount+1), argCount+2))
+		args = append(args, filter.InterestCategoryIDs, filter.InterestVendors)
+		argCount += 2
+	case len(filter.InterestCategoryIDs) > 0:
+		argCount++
+		where = append(where, inCategorySubtrees(argCount))
+		args = append(args, filter.InterestCategoryIDs)
+	case len(filter.InterestVendors) > 0:
+		argCount++
+		where = append(where, fmt.Sprintf("vendors && $%d", argCount))
+		args = append(args, filter.InterestVendors)
+	}
+
+	if filter.MinSeverity != nil {
+		severities := domain.SeveritiesAtLeast(*filter.MinSeverity)
+		values := make([]string, len(severities))
+		for i, severity := range severities {
+			values[i] = string(severity)
+		}
+
+		argCount++
+		where = append(where, fmt.Sprintf("severity = ANY($%d)", argCount))
+		args = append(args, values)
+	}
+
+	if len(filter.ExcludeTags) > 0 {
+		argCount++
+		where = append(where, fmt.Sprintf("NOT (tags && $%d)", argCount))
+		args = append(args, filter.ExcludeTags)
+	}
+
 	if filter.DateFrom != nil {
 		argCount++
 		where = append(where, fmt.Sprintf("published_at >= $%d", argCount))
@@ -395,22 +645,119 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 		args = append(args, *filter.DateTo)
 	}
 
+	if filter.IsEnriched != nil {
+		if *filter.IsEnriched {
+			where = append(where, "enriched_at IS NOT NULL")
+		} else {
+			where = append(where, "enriched_at IS NULL")
+		}
+	}
+
+	if filter.KEV != nil {
+		if *filter.KEV {
+			where = append(where, "EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves))")
+		} else {
+			where = append(where, "NOT EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves))")
+		}
+	}
+
+	if filter.ExploitAvailable != nil {
+		if *filter.ExploitAvailable {
+			where = append(where, "EXISTS (SELECT 1 FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves))")
+		} else {
+			where = append(where, "NOT EXISTS (SELECT 1 FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves))")
+		}
+	}
+
+	if filter.AffectsStack != nil {
+		argCount += 2
+		stack := fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM software_inventory_cves m
+			JOIN software_inventory i ON i.id = m.item_id
+			WHERE m.cve_id = ANY(articles.cves) AND (i.user_id = $%d OR i.organization_id = $%d)
+		)`, argCount-1, argCount)
+		if *filter.AffectsStack {
+			where = append(where, stack)
+		} else {
+			where = append(where, "NOT "+stack)
+		}
+		args = append(args, filter.StackUserID, filter.StackOrganizationID)
+	}
+
+	if filter.ThreatActorID != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM article_threat_actors ata WHERE ata.article_id = articles.id AND ata.actor_id = $%d)", argCount))
+		args = append(args, *filter.ThreatActorID)
+	}
+
+	if filter.IncidentID != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM incident_articles ia WHERE ia.article_id = articles.id AND ia.incident_id = $%d)", argCount))
+		args = append(args, *filter.IncidentID)
+	}
+
+	if filter.OrgCategoryID != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM org_article_categories oac WHERE oac.article_id = articles.id AND oac.category_id = $%d)", argCount))
+		args = append(args, *filter.OrgCategoryID)
+	}
+
+	if filter.PublishedOnly {
+		where = append(where, "is_published = true")
+	}
+
+	if filter.ExcludeDuplicates {
+		where = append(where, `NOT EXISTS (
+			SELECT 1 FROM article_fingerprints f
+			WHERE f.article_id = articles.id AND f.canonical_id <> f.article_id
+		)`)
+	}
+
 	if filter.SearchQuery != nil {
 		argCount++
 		where = append(where, fmt.Sprintf("(title ILIKE $%d OR content ILIKE $%d)", argCount, argCount))
 		args = append(args, "%"+*filter.SearchQuery+"%")
 	}
 
+	if filter.Query != nil {
+		var condition string
+		condition, args, argCount = searchQueryCondition(filter.Query, args, argCount)
+		where = append(where, condition)
+	}
+
+	orderBy := "published_at DESC"
+	if filter.Chronological {
+		orderBy = "published_at ASC"
+	}
+	if filter.SimilarTo != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("title %% $%d", argCount))
+		orderBy = fmt.Sprintf("similarity(title, $%d) DESC, published_at DESC", argCount)
+		args = append(args, *filter.SimilarTo)
+	}
+
 	whereClause := strings.Join(where, " AND ")
 
 	// Count total
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM articles WHERE %s", whereClause)
 	var total int
-	err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total)
+	err := r.db.read(ctx).QueryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count articles: %w", err)
 	}
 
+	// Boosting only reorders, so it is added after the count query
+	if len(filter.BoostVendors) > 0 {
+		boosted := make([]string, len(filter.BoostVendors))
+		for i, vendor := range filter.BoostVendors {
+			boosted[i] = strings.ToLower(vendor)
+		}
+
+		argCount++
+		orderBy = fmt.Sprintf("EXISTS (SELECT 1 FROM UNNEST(vendors) AS v WHERE LOWER(v) = ANY($%d)) DESC, %s", argCount, orderBy)
+		args = append(args, boosted)
+	}
+
 	// Get articles
 	argCount++
 	limitArg := argCount
@@ -423,16 +770,27 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 			severity, tags, cves, vendors, threat_type, attack_vector, impact_assessment,
 			recommended_actions, iocs, armor_relevance, armor_cta, competitor_score,
 			is_competitor_favorable, reading_time_minutes, view_count, is_published,
-			published_at, enriched_at, created_at, updated_at
+			published_at, enriched_at, created_at, updated_at,
+			editorial_title, editorial_summary, editorial_updated_by, editorial_updated_at,
+			image_url, image_key, content_format, content_markdown,
+			word_count, language, external_links, tenant_id,
+			EXISTS (SELECT 1 FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			(SELECT MIN(k.due_date) FROM kev_vulnerabilities k WHERE k.cve_id = ANY(articles.cves)),
+			ARRAY(SELECT DISTINCT e.source FROM cve_exploits e WHERE e.cve_id = ANY(articles.cves) ORDER BY e.source),
+			ARRAY(
+				SELECT ac.category_id FROM article_categories ac
+				WHERE ac.article_id = articles.id
+				ORDER BY ac.category_id <> articles.category_id, ac.created_at
+			)
 		FROM articles
 		WHERE %s
-		ORDER BY published_at DESC
+		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, whereClause, limitArg, offsetArg)
+	`, whereClause, orderBy, limitArg, offsetArg)
 
 	args = append(args, filter.PageSize, filter.Offset())
 
-	rows, err := r.db.Pool.Query(ctx, query, args...)
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list articles: %w", err)
 	}
@@ -473,12 +831,30 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 			&article.EnrichedAt,
 			&article.CreatedAt,
 			&article.UpdatedAt,
+			&article.EditorialTitle,
+			&article.EditorialSummary,
+			&article.EditorialUpdatedBy,
+			&article.EditorialUpdatedAt,
+			&article.ImageURL,
+			&article.ImageKey,
+			&article.ContentFormat,
+			&article.ContentMarkdown,
+			&article.WordCount,
+			&article.Language,
+			&article.ExternalLinks,
+			&article.TenantID,
+			&article.KEV,
+			&article.KEVDueDate,
+			&article.ExploitSources,
+			&article.CategoryIDs,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan article: %w", err)
 		}
 
 		// Unmarshal IOCs
+		article.ExploitAvailable = len(article.ExploitSources) > 0
+
 		if len(iocsJSON) > 0 {
 			if err := json.Unmarshal(iocsJSON, &article.IOCs); err != nil {
 				return nil, 0, fmt.Errorf("failed to unmarshal IOCs: %w", err)
@@ -502,6 +878,22 @@ func (r *articleRepository) List(ctx context.Context, filter *domain.ArticleFilt
 	return articles, total, nil
 }
 
+// inCategorySubtrees matches articles belonging to any of the categories in the given
+// uuid[] argument, or to any of their subcategories
+func inCategorySubtrees(arg int) string {
+	return fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM article_categories ac
+		WHERE ac.article_id = articles.id AND ac.category_id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM categories WHERE id = ANY($%d)
+				UNION ALL
+				SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+			)
+			SELECT id FROM subtree
+		)
+	)`, arg)
+}
+
 // Update updates an existing article
 func (r *articleRepository) Update(ctx context.Context, article *domain.Article) error {
 	if article == nil {
@@ -535,11 +927,12 @@ func (r *articleRepository) Update(ctx context.Context, article *domain.Article)
 			recommended_actions = $16, iocs = $17, armor_relevance = $18, armor_cta = $19,
 			competitor_score = $20, is_competitor_favorable = $21, reading_time_minutes = $22,
 			view_count = $23, is_published = $24, published_at = $25, enriched_at = $26,
-			updated_at = $27
+			updated_at = $27, content_format = $28, content_markdown = $29,
+			word_count = $30, language = $31, external_links = $32
 		WHERE id = $1
 	`
 
-	cmdTag, err := r.db.Pool.Exec(ctx, query,
+	cmdTag, err := r.db.conn(ctx).Exec(ctx, query,
 		article.ID,
 		article.Title,
 		article.Slug,
@@ -567,6 +960,11 @@ func (r *articleRepository) Update(ctx context.Context, article *domain.Article)
 		article.PublishedAt,
 		article.EnrichedAt,
 		article.UpdatedAt,
+		article.ContentFormat,
+		article.ContentMarkdown,
+		article.WordCount,
+		article.Language,
+		externalLinks(article.ExternalLinks),
 	)
 
 	if err != nil {
@@ -588,7 +986,7 @@ func (r *articleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	query := `DELETE FROM articles WHERE id = $1`
 
-	cmdTag, err := r.db.Pool.Exec(ctx, query, id)
+	cmdTag, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete article: %w", err)
 	}
@@ -600,6 +998,32 @@ func (r *articleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// UpdateEditorial sets or clears the editorial title and summary overrides
+// The source title and summary are left untouched
+func (r *articleRepository) UpdateEditorial(ctx context.Context, id uuid.UUID, title, summary *string, updatedBy *uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	query := `
+		UPDATE articles SET
+			editorial_title = $2, editorial_summary = $3,
+			editorial_updated_by = $4, editorial_updated_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	cmdTag, err := r.db.Pool.Exec(ctx, query, id, title, summary, updatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to update editorial overrides: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("article not found")
+	}
+
+	return nil
+}
+
 // IncrementViewCount increments the view count for an article
 func (r *articleRepository) IncrementViewCount(ctx context.Context, id uuid.UUID) error {
 	if id == uuid.Nil {
@@ -619,3 +1043,153 @@ func (r *articleRepository) IncrementViewCount(ctx context.Context, id uuid.UUID
 
 	return nil
 }
+
+// SlugExists reports whether any article uses the slug now or used it before
+func (r *articleRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM articles WHERE slug = $1)
+			OR EXISTS (SELECT 1 FROM slug_redirects WHERE slug = $1)
+	`
+
+	var exists bool
+	if err := r.db.conn(ctx).QueryRow(ctx, query, slug).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check article slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+// UpdateSlug changes an article's slug and keeps a redirect from the old one
+// Taking back one of the article's own former slugs removes its redirect
+func (r *articleRepository) UpdateSlug(ctx context.Context, id uuid.UUID, slug string) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	return r.db.WithinTx(ctx, func(ctx context.Context) error {
+		conn := r.db.conn(ctx)
+
+		var previous string
+		err := conn.QueryRow(ctx, `SELECT slug FROM articles WHERE id = $1 FOR UPDATE`, id).Scan(&previous)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.NotFoundError{Resource: "article", ID: id.String()}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get article slug: %w", err)
+		}
+
+		if previous == slug {
+			return nil
+		}
+
+		if _, err := conn.Exec(ctx,
+			`DELETE FROM slug_redirects WHERE slug = $1 AND article_id = $2`, slug, id,
+		); err != nil {
+			return fmt.Errorf("failed to update slug redirects: %w", err)
+		}
+
+		if _, err := conn.Exec(ctx,
+			`UPDATE articles SET slug = $2, updated_at = NOW() WHERE id = $1`, id, slug,
+		); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return &domainerrors.ConflictError{Resource: "article", Field: "slug", Value: slug}
+			}
+			return fmt.Errorf("failed to update article slug: %w", err)
+		}
+
+		if _, err := conn.Exec(ctx, `
+			INSERT INTO slug_redirects (slug, article_id) VALUES ($1, $2)
+			ON CONFLICT (slug) DO NOTHING
+		`, previous, id); err != nil {
+			return fmt.Errorf("failed to record slug redirect: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetSlugRedirect returns the current slug of the article that used oldSlug before
+func (r *articleRepository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	query := `
+		SELECT a.slug
+		FROM slug_redirects sr
+		JOIN articles a ON a.id = sr.article_id
+		WHERE sr.slug = $1
+	`
+
+	var slug string
+	err := r.db.read(ctx).QueryRow(ctx, query, oldSlug).Scan(&slug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", &domainerrors.NotFoundError{Resource: "article slug", ID: oldSlug}
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve article slug: %w", err)
+	}
+
+	return slug, nil
+}
+
+// searchFieldColumns are the array columns matched by field-scoped search terms
+var searchFieldColumns = map[domain.SearchField]string{
+	domain.SearchFieldCVE:    "cves",
+	domain.SearchFieldVendor: "vendors",
+	domain.SearchFieldTag:    "tags",
+}
+
+// searchQueryCondition translates a parsed search query into a WHERE condition
+// Text terms match the full-text index or a substring of the title or content; field terms
+// match an element of the cves, vendors or tags array, with a trailing * matching a prefix
+func searchQueryCondition(query *domain.SearchQuery, args []interface{}, argCount int) (string, []interface{}, int) {
+	groups := make([]string, 0, len(query.Groups))
+
+	for _, group := range query.Groups {
+		alternatives := make([]string, 0, len(group))
+
+		for _, term := range group {
+			var condition string
+
+			switch term.Field {
+			case domain.SearchFieldCVE, domain.SearchFieldVendor, domain.SearchFieldTag:
+				pattern := escapeLikePattern(term.Value)
+				if strings.HasSuffix(term.Value, "*") {
+					pattern = escapeLikePattern(strings.TrimSuffix(term.Value, "*")) + "%"
+				}
+
+				argCount++
+				condition = fmt.Sprintf(`EXISTS (SELECT 1 FROM unnest(%s) AS element WHERE element ILIKE $%d ESCAPE '\')`, searchFieldColumns[term.Field], argCount)
+				args = append(args, pattern)
+			default:
+				toTSQuery := "plainto_tsquery"
+				if term.Phrase {
+					toTSQuery = "phraseto_tsquery"
+				}
+
+				argCount += 2
+				condition = fmt.Sprintf(
+					`(search_vector @@ %s('english', $%d) OR title ILIKE $%d ESCAPE '\' OR content ILIKE $%d ESCAPE '\')`,
+					toTSQuery, argCount-1, argCount, argCount,
+				)
+				args = append(args, term.Value, "%"+escapeLikePattern(term.Value)+"%")
+			}
+
+			if term.Negated {
+				condition = "NOT " + condition
+			}
+
+			alternatives = append(alternatives, condition)
+		}
+
+		groups = append(groups, "("+strings.Join(alternatives, " OR ")+")")
+	}
+
+	return strings.Join(groups, " AND "), args, argCount
+}
+
+// externalLinks stores a nil link list as an empty array, since the column is NOT NULL
+func externalLinks(links []string) []string {
+	if links == nil {
+		return []string{}
+	}
+	return links
+}
@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleReviewRepository implements repository.ArticleReviewRepository for PostgreSQL
+type ArticleReviewRepository struct {
+	db *DB
+}
+
+// NewArticleReviewRepository creates a new PostgreSQL article review repository
+func NewArticleReviewRepository(db *DB) *ArticleReviewRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ArticleReviewRepository{db: db}
+}
+
+// Create adds an article to the review queue
+func (r *ArticleReviewRepository) Create(ctx context.Context, review *domain.ArticleReview) error {
+	if review == nil {
+		return fmt.Errorf("review cannot be nil")
+	}
+
+	if review.ArticleID == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO article_reviews (article_id, status, created_at)
+		VALUES ($1, $2, $3)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, review.ArticleID, review.Status, review.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return &domainerrors.ConflictError{
+					Resource: "article review",
+					Field:    "article_id",
+					Value:    review.ArticleID.String(),
+				}
+			case "23503":
+				return &domainerrors.NotFoundError{
+					Resource: "article",
+					ID:       review.ArticleID.String(),
+				}
+			}
+		}
+		return fmt.Errorf("failed to create article review: %w", err)
+	}
+
+	return nil
+}
+
+// GetByArticleID retrieves the review of an article
+func (r *ArticleReviewRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleReview, error) {
+	query := `
+		SELECT article_id, status, reason, reviewed_by, reviewed_at, created_at
+		FROM article_reviews
+		WHERE article_id = $1
+	`
+
+	review := &domain.ArticleReview{}
+	err := r.db.Pool.QueryRow(ctx, query, articleID).Scan(
+		&review.ArticleID,
+		&review.Status,
+		&review.Reason,
+		&review.ReviewedBy,
+		&review.ReviewedAt,
+		&review.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article review",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get article review: %w", err)
+	}
+
+	return review, nil
+}
+
+// List retrieves reviews, oldest first, with the article fields a reviewer needs
+func (r *ArticleReviewRepository) List(ctx context.Context, filter *domain.ArticleReviewFilter) ([]*domain.ArticleReview, int, error) {
+	if filter == nil {
+		filter = &domain.ArticleReviewFilter{Page: 1, PageSize: 20}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where = append(where, fmt.Sprintf("rv.status = $%d", len(args)))
+	}
+
+	if filter.CategoryID != nil {
+		args = append(args, *filter.CategoryID)
+		where = append(where, fmt.Sprintf("a.category_id = $%d", len(args)))
+	}
+
+	if filter.SourceID != nil {
+		args = append(args, *filter.SourceID)
+		where = append(where, fmt.Sprintf("a.source_id = $%d", len(args)))
+	}
+
+	if filter.Severity != nil {
+		args = append(args, *filter.Severity)
+		where = append(where, fmt.Sprintf("a.severity = $%d", len(args)))
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	var total int
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM article_reviews rv
+		JOIN articles a ON a.id = rv.article_id
+		WHERE %s
+	`, whereClause)
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count article reviews: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			rv.article_id, rv.status, rv.reason, rv.reviewed_by, rv.reviewed_at, rv.created_at,
+			a.title, a.slug, a.summary, a.category_id, a.source_id, a.source_url, a.severity,
+			a.tags, a.cves, a.vendors, a.is_published, a.published_at, a.created_at
+		FROM article_reviews rv
+		JOIN articles a ON a.id = rv.article_id
+		WHERE %s
+		ORDER BY rv.created_at ASC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list article reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]*domain.ArticleReview, 0)
+	for rows.Next() {
+		review := &domain.ArticleReview{}
+		article := &domain.Article{}
+
+		err := rows.Scan(
+			&review.ArticleID,
+			&review.Status,
+			&review.Reason,
+			&review.ReviewedBy,
+			&review.ReviewedAt,
+			&review.CreatedAt,
+			&article.Title,
+			&article.Slug,
+			&article.Summary,
+			&article.CategoryID,
+			&article.SourceID,
+			&article.SourceURL,
+			&article.Severity,
+			&article.Tags,
+			&article.CVEs,
+			&article.Vendors,
+			&article.IsPublished,
+			&article.PublishedAt,
+			&article.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan article review: %w", err)
+		}
+
+		article.ID = review.ArticleID
+		review.Article = article
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating article reviews: %w", err)
+	}
+
+	return reviews, total, nil
+}
+
+// Decide stores the outcome of a pending review and sets the article's publication state
+// Both writes happen in one transaction; a review that is no longer pending is not found
+func (r *ArticleReviewRepository) Decide(ctx context.Context, review *domain.ArticleReview) error {
+	if review == nil {
+		return fmt.Errorf("review cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE article_reviews
+		SET status = $2, reason = $3, reviewed_by = $4, reviewed_at = $5
+		WHERE article_id = $1 AND status = $6
+	`
+
+	result, err := tx.Exec(ctx, query,
+		review.ArticleID,
+		review.Status,
+		review.Reason,
+		review.ReviewedBy,
+		review.ReviewedAt,
+		domain.ReviewStatusPending,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update article review: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "pending article review",
+			ID:       review.ArticleID.String(),
+		}
+	}
+
+	published := review.Status == domain.ReviewStatusApproved
+	if _, err := tx.Exec(ctx,
+		`UPDATE articles SET is_published = $2, updated_at = NOW() WHERE id = $1`,
+		review.ArticleID, published,
+	); err != nil {
+		return fmt.Errorf("failed to update article publication: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit article review: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ArticleSummaryRepository implements repository.ArticleSummaryRepository for PostgreSQL
+type ArticleSummaryRepository struct {
+	db *DB
+}
+
+// NewArticleSummaryRepository creates a new PostgreSQL article summary repository
+func NewArticleSummaryRepository(db *DB) *ArticleSummaryRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ArticleSummaryRepository{db: db}
+}
+
+// Upsert stores a summary, replacing any existing summary of the same length
+func (r *ArticleSummaryRepository) Upsert(ctx context.Context, summary *domain.ArticleSummary) error {
+	if summary == nil {
+		return fmt.Errorf("summary cannot be nil")
+	}
+
+	if err := summary.Validate(); err != nil {
+		return fmt.Errorf("invalid summary: %w", err)
+	}
+
+	query := `
+		INSERT INTO article_summaries (article_id, length, content, provider, generated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (article_id, length) DO UPDATE SET
+			content = EXCLUDED.content,
+			provider = EXCLUDED.provider,
+			generated_at = EXCLUDED.generated_at
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		summary.ArticleID,
+		summary.Length,
+		summary.Content,
+		summary.Provider,
+		summary.GeneratedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert article summary: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves the summary of an article at the given length
+func (r *ArticleSummaryRepository) Get(ctx context.Context, articleID uuid.UUID, length domain.SummaryLength) (*domain.ArticleSummary, error) {
+	query := `
+		SELECT article_id, length, content, provider, generated_at
+		FROM article_summaries
+		WHERE article_id = $1 AND length = $2
+	`
+
+	summary, err := scanArticleSummary(r.db.Pool.QueryRow(ctx, query, articleID, length))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article summary",
+				ID:       fmt.Sprintf("%s/%s", articleID, length),
+			}
+		}
+		return nil, fmt.Errorf("failed to get article summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// ListByArticle retrieves all stored summaries of an article
+func (r *ArticleSummaryRepository) ListByArticle(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSummary, error) {
+	query := `
+		SELECT article_id, length, content, provider, generated_at
+		FROM article_summaries
+		WHERE article_id = $1
+		ORDER BY length
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article summaries: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := make([]*domain.ArticleSummary, 0, len(domain.SummaryLengths()))
+	for rows.Next() {
+		summary, err := scanArticleSummary(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article summary: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article summaries: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// scanArticleSummary scans a single article summary row
+func scanArticleSummary(row pgx.Row) (*domain.ArticleSummary, error) {
+	var summary domain.ArticleSummary
+	if err := row.Scan(
+		&summary.ArticleID,
+		&summary.Length,
+		&summary.Content,
+		&summary.Provider,
+		&summary.GeneratedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
@@ -2,25 +2,27 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/lib/pq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
 )
 
 // AuditLogRepository implements repository.AuditLogRepository interface
 type AuditLogRepository struct {
-	db *sql.DB
+	db *DB
 }
 
 // NewAuditLogRepository creates a new audit log repository instance
-func NewAuditLogRepository(db *sql.DB) *AuditLogRepository {
+func NewAuditLogRepository(db *DB) *AuditLogRepository {
 	if db == nil {
-		panic("db cannot be nil")
+		panic("database cannot be nil")
 	}
 
 	return &AuditLogRepository{db: db}
@@ -69,7 +71,7 @@ func (r *AuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) e
 		}
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = r.db.Pool.Exec(
 		ctx,
 		query,
 		log.ID,
@@ -85,11 +87,9 @@ func (r *AuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) e
 	)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code {
-			case "23503": // Foreign key violation
-				return fmt.Errorf("user not found: %w", err)
-			}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // Foreign key violation
+			return fmt.Errorf("user not found: %w", err)
 		}
 		return fmt.Errorf("failed to create audit log: %w", err)
 	}
@@ -124,6 +124,12 @@ func (r *AuditLogRepository) List(ctx context.Context, filter *domain.AuditLogFi
 		argCount++
 	}
 
+	if len(filter.Actions) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("al.action = ANY($%d)", argCount))
+		args = append(args, filter.Actions)
+		argCount++
+	}
+
 	if filter.ResourceType != nil {
 		whereClauses = append(whereClauses, fmt.Sprintf("al.resource_type = $%d", argCount))
 		args = append(args, *filter.ResourceType)
@@ -163,24 +169,13 @@ func (r *AuditLogRepository) List(ctx context.Context, filter *domain.AuditLogFi
 		` + whereClause
 
 	var totalCount int
-	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&totalCount); err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
 	}
 
 	// Retrieve paginated results with user email
 	query := `
-		SELECT
-			al.id,
-			al.user_id,
-			u.email,
-			al.action,
-			al.resource_type,
-			al.resource_id,
-			al.old_value,
-			al.new_value,
-			al.ip_address,
-			al.user_agent,
-			al.created_at
+		SELECT` + auditLogColumns + `
 		FROM audit_logs al
 		LEFT JOIN users u ON al.user_id = u.id
 		` + whereClause + `
@@ -189,7 +184,7 @@ func (r *AuditLogRepository) List(ctx context.Context, filter *domain.AuditLogFi
 
 	args = append(args, filter.Limit, filter.Offset)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.db.Pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query audit logs: %w", err)
 	}
@@ -197,39 +192,10 @@ func (r *AuditLogRepository) List(ctx context.Context, filter *domain.AuditLogFi
 
 	logs := make([]*domain.AuditLog, 0)
 	for rows.Next() {
-		log := &domain.AuditLog{}
-		var oldValueJSON, newValueJSON []byte
-
-		err := rows.Scan(
-			&log.ID,
-			&log.UserID,
-			&log.UserEmail,
-			&log.Action,
-			&log.ResourceType,
-			&log.ResourceID,
-			&oldValueJSON,
-			&newValueJSON,
-			&log.IPAddress,
-			&log.UserAgent,
-			&log.CreatedAt,
-		)
+		log, err := scanAuditLog(rows)
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan audit log: %w", err)
-		}
-
-		// Unmarshal JSON values
-		if oldValueJSON != nil {
-			if err := json.Unmarshal(oldValueJSON, &log.OldValue); err != nil {
-				return nil, 0, fmt.Errorf("failed to unmarshal old_value: %w", err)
-			}
+			return nil, 0, err
 		}
-
-		if newValueJSON != nil {
-			if err := json.Unmarshal(newValueJSON, &log.NewValue); err != nil {
-				return nil, 0, fmt.Errorf("failed to unmarshal new_value: %w", err)
-			}
-		}
-
 		logs = append(logs, log)
 	}
 
@@ -247,27 +213,45 @@ func (r *AuditLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	}
 
 	query := `
-		SELECT
-			al.id,
-			al.user_id,
-			u.email,
-			al.action,
-			al.resource_type,
-			al.resource_id,
-			al.old_value,
-			al.new_value,
-			al.ip_address,
-			al.user_agent,
-			al.created_at
+		SELECT` + auditLogColumns + `
 		FROM audit_logs al
 		LEFT JOIN users u ON al.user_id = u.id
 		WHERE al.id = $1
 	`
 
+	log, err := scanAuditLog(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("audit log not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get audit log: %w", err)
+	}
+
+	return log, nil
+}
+
+// auditLogColumns are the columns scanned by scanAuditLog
+// The INET address is selected as text since pgx cannot scan it into a string
+const auditLogColumns = `
+	al.id,
+	al.user_id,
+	u.email,
+	al.action,
+	al.resource_type,
+	al.resource_id,
+	al.old_value,
+	al.new_value,
+	host(al.ip_address),
+	al.user_agent,
+	al.created_at
+`
+
+// scanAuditLog reads one row selected with auditLogColumns
+func scanAuditLog(row pgx.Row) (*domain.AuditLog, error) {
 	log := &domain.AuditLog{}
 	var oldValueJSON, newValueJSON []byte
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := row.Scan(
 		&log.ID,
 		&log.UserID,
 		&log.UserEmail,
@@ -280,12 +264,8 @@ func (r *AuditLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 		&log.UserAgent,
 		&log.CreatedAt,
 	)
-
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("audit log not found: %w", err)
-		}
-		return nil, fmt.Errorf("failed to get audit log: %w", err)
+		return nil, fmt.Errorf("failed to scan audit log: %w", err)
 	}
 
 	// Unmarshal JSON values
@@ -303,3 +283,96 @@ func (r *AuditLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 
 	return log, nil
 }
+
+// ListBefore returns up to limit entries created before the cutoff, oldest first
+func (r *AuditLogRepository) ListBefore(ctx context.Context, before time.Time, limit int) ([]*domain.AuditLog, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+
+	query := `
+		SELECT` + auditLogColumns + `
+		FROM audit_logs al
+		LEFT JOIN users u ON al.user_id = u.id
+		WHERE al.created_at < $1
+		ORDER BY al.created_at ASC, al.id ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs for archiving: %w", err)
+	}
+	defer rows.Close()
+
+	logs := make([]*domain.AuditLog, 0, limit)
+	for rows.Next() {
+		log, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit logs: %w", err)
+	}
+
+	return logs, nil
+}
+
+// DeleteByIDs removes the given entries and returns how many were deleted
+func (r *AuditLogRepository) DeleteByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM audit_logs WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit logs: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Each calls fn for every entry created in [from, to), oldest first, stopping at the first error
+// Rows are streamed so large exports are not held in memory; as streaming is paced by fn,
+// the query is exempt from the default query timeout
+func (r *AuditLogRepository) Each(ctx context.Context, from, to time.Time, fn func(*domain.AuditLog) error) error {
+	if fn == nil {
+		return fmt.Errorf("fn cannot be nil")
+	}
+
+	ctx = withQueryTimeout(ctx, 0)
+
+	query := `
+		SELECT` + auditLogColumns + `
+		FROM audit_logs al
+		LEFT JOIN users u ON al.user_id = u.id
+		WHERE al.created_at >= $1 AND al.created_at < $2
+		ORDER BY al.created_at ASC, al.id ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to query audit logs for export: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		log, err := scanAuditLog(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(log); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating audit logs: %w", err)
+	}
+
+	return nil
+}
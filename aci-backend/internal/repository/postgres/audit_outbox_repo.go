@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// maxAuditOutboxErrorLength bounds the delivery error stored on a staged entry
+const maxAuditOutboxErrorLength = 1000
+
+// AuditOutboxRepository implements repository.AuditOutboxRepository
+type AuditOutboxRepository struct {
+	db *DB
+}
+
+// NewAuditOutboxRepository creates a new audit outbox repository instance
+func NewAuditOutboxRepository(db *DB) *AuditOutboxRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &AuditOutboxRepository{db: db}
+}
+
+// Enqueue stages the entry; within DB.WithinTx it commits or rolls back with the change
+func (r *AuditOutboxRepository) Enqueue(ctx context.Context, log *domain.AuditLog) error {
+	if log == nil {
+		return fmt.Errorf("audit log cannot be nil")
+	}
+
+	if err := log.Validate(); err != nil {
+		return fmt.Errorf("invalid audit log: %w", err)
+	}
+
+	var oldValueJSON, newValueJSON []byte
+	var err error
+
+	if log.OldValue != nil {
+		oldValueJSON, err = json.Marshal(log.OldValue)
+		if err != nil {
+			return fmt.Errorf("failed to marshal old_value: %w", err)
+		}
+	}
+
+	if log.NewValue != nil {
+		newValueJSON, err = json.Marshal(log.NewValue)
+		if err != nil {
+			return fmt.Errorf("failed to marshal new_value: %w", err)
+		}
+	}
+
+	query := `
+		INSERT INTO audit_outbox (
+			id, user_id, action, resource_type, resource_id,
+			old_value, new_value, ip_address, user_agent, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = r.db.conn(ctx).Exec(ctx, query,
+		log.ID,
+		log.UserID,
+		log.Action,
+		log.ResourceType,
+		log.ResourceID,
+		oldValueJSON,
+		newValueJSON,
+		nullIfEmpty(log.IPAddress),
+		nullIfEmpty(log.UserAgent),
+		log.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stage audit log: %w", err)
+	}
+
+	return nil
+}
+
+// RelayNext moves the oldest due entry into audit_logs and reports whether there was one
+// The entry is locked with SKIP LOCKED so several relays can run at once. Delivery is
+// idempotent on the entry ID; a failure is recorded on the entry, which is retried with
+// exponential backoff capped at an hour
+func (r *AuditOutboxRepository) RelayNext(ctx context.Context) (bool, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT id
+		FROM audit_outbox
+		WHERE next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim staged audit log: %w", err)
+	}
+
+	// The insert runs in a savepoint so a failure can still be recorded on the entry
+	delivery, err := tx.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start audit log delivery: %w", err)
+	}
+
+	_, deliveryErr := delivery.Exec(ctx, `
+		INSERT INTO audit_logs (
+			id, user_id, action, resource_type, resource_id,
+			old_value, new_value, ip_address, user_agent, created_at, tenant_id
+		)
+		SELECT
+			o.id,
+			(SELECT u.id FROM users u WHERE u.id = o.user_id),
+			o.action, o.resource_type, o.resource_id,
+			o.old_value, o.new_value, o.ip_address, o.user_agent, o.created_at, o.tenant_id
+		FROM audit_outbox o
+		WHERE o.id = $1
+		ON CONFLICT (id) DO NOTHING
+	`, id)
+
+	if deliveryErr != nil {
+		if err := delivery.Rollback(ctx); err != nil {
+			return true, fmt.Errorf("failed to roll back audit log delivery: %w", err)
+		}
+
+		message := deliveryErr.Error()
+		if len(message) > maxAuditOutboxErrorLength {
+			message = message[:maxAuditOutboxErrorLength]
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE audit_outbox
+			SET attempts = attempts + 1,
+				last_error = $2,
+				next_attempt_at = NOW() + LEAST(INTERVAL '1 hour', INTERVAL '5 seconds' * POWER(2, LEAST(attempts, 10)))
+			WHERE id = $1
+		`, id, message)
+		if err != nil {
+			return true, fmt.Errorf("failed to record audit log delivery failure: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return true, fmt.Errorf("failed to commit audit log delivery failure: %w", err)
+		}
+
+		return true, fmt.Errorf("failed to deliver audit log %s: %w", id, deliveryErr)
+	}
+
+	if err := delivery.Commit(ctx); err != nil {
+		return true, fmt.Errorf("failed to release audit log delivery: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM audit_outbox WHERE id = $1`, id); err != nil {
+		return true, fmt.Errorf("failed to remove relayed audit log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return true, fmt.Errorf("failed to commit audit log delivery: %w", err)
+	}
+
+	return true, nil
+}
+
+// Pending counts staged entries, including those waiting to be retried
+func (r *AuditOutboxRepository) Pending(ctx context.Context) (int64, error) {
+	var pending int64
+	if err := r.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_outbox`).Scan(&pending); err != nil {
+		return 0, fmt.Errorf("failed to count staged audit logs: %w", err)
+	}
+
+	return pending, nil
+}
+
+// nullIfEmpty stores an unset or empty string as NULL
+func nullIfEmpty(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
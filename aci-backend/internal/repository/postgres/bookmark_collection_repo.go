@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// BookmarkCollectionRepository implements repository.BookmarkCollectionRepository for PostgreSQL
+type BookmarkCollectionRepository struct {
+	db *DB
+}
+
+// NewBookmarkCollectionRepository creates a new PostgreSQL bookmark collection repository
+func NewBookmarkCollectionRepository(db *DB) *BookmarkCollectionRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &BookmarkCollectionRepository{db: db}
+}
+
+// Create inserts a new bookmark collection
+func (r *BookmarkCollectionRepository) Create(ctx context.Context, collection *domain.BookmarkCollection) error {
+	if collection == nil {
+		return fmt.Errorf("bookmark collection cannot be nil")
+	}
+
+	if collection.ID == uuid.Nil {
+		return fmt.Errorf("bookmark collection ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO bookmark_collections (id, user_id, organization_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Pool.Exec(
+		ctx,
+		query,
+		collection.ID,
+		collection.UserID,
+		collection.OrganizationID,
+		collection.Name,
+		collection.CreatedAt,
+		collection.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create bookmark collection: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a bookmark collection with its article count
+func (r *BookmarkCollectionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BookmarkCollection, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("bookmark collection ID cannot be nil")
+	}
+
+	query := `
+		SELECT
+			c.id, c.user_id, c.organization_id, c.name, c.created_at, c.updated_at,
+			(SELECT COUNT(*) FROM bookmark_collection_articles ca WHERE ca.collection_id = c.id)
+		FROM bookmark_collections c
+		WHERE c.id = $1
+	`
+
+	var collection domain.BookmarkCollection
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+		&collection.ID,
+		&collection.UserID,
+		&collection.OrganizationID,
+		&collection.Name,
+		&collection.CreatedAt,
+		&collection.UpdatedAt,
+		&collection.ArticleCount,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "bookmark collection",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get bookmark collection: %w", err)
+	}
+
+	return &collection, nil
+}
+
+// ListVisible returns the user's private collections and the organization's shared collections
+func (r *BookmarkCollectionRepository) ListVisible(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) ([]*domain.BookmarkCollection, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("user ID cannot be nil")
+	}
+
+	query := `
+		SELECT
+			c.id, c.user_id, c.organization_id, c.name, c.created_at, c.updated_at,
+			COUNT(ca.article_id)
+		FROM bookmark_collections c
+		LEFT JOIN bookmark_collection_articles ca ON ca.collection_id = c.id
+		WHERE (c.user_id = $1 AND c.organization_id IS NULL)
+			OR ($2::uuid IS NOT NULL AND c.organization_id = $2)
+		GROUP BY c.id
+		ORDER BY c.name ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bookmark collections: %w", err)
+	}
+	defer rows.Close()
+
+	collections := make([]*domain.BookmarkCollection, 0)
+
+	for rows.Next() {
+		var collection domain.BookmarkCollection
+		if err := rows.Scan(
+			&collection.ID,
+			&collection.UserID,
+			&collection.OrganizationID,
+			&collection.Name,
+			&collection.CreatedAt,
+			&collection.UpdatedAt,
+			&collection.ArticleCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan bookmark collection: %w", err)
+		}
+		collections = append(collections, &collection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bookmark collections: %w", err)
+	}
+
+	return collections, nil
+}
+
+// Delete removes a bookmark collection and its article links
+func (r *BookmarkCollectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM bookmark_collections WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete bookmark collection: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "bookmark collection",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// AddArticle adds an article to a collection (idempotent)
+func (r *BookmarkCollectionRepository) AddArticle(ctx context.Context, collectionID, articleID, addedBy uuid.UUID) error {
+	query := `
+		INSERT INTO bookmark_collection_articles (collection_id, article_id, added_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (collection_id, article_id) DO NOTHING
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, collectionID, articleID, addedBy); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" &&
+			pgErr.ConstraintName == "fk_bookmark_collection_articles_article" {
+			return &domainerrors.NotFoundError{Resource: "article", ID: articleID.String()}
+		}
+		return fmt.Errorf("failed to add article to bookmark collection: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveArticle removes an article from a collection
+func (r *BookmarkCollectionRepository) RemoveArticle(ctx context.Context, collectionID, articleID uuid.UUID) error {
+	query := `DELETE FROM bookmark_collection_articles WHERE collection_id = $1 AND article_id = $2`
+
+	result, err := r.db.Pool.Exec(ctx, query, collectionID, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to remove article from bookmark collection: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "bookmark collection article",
+			ID:       articleID.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListArticleIDs returns a page of article IDs in a collection, most recently added first
+func (r *BookmarkCollectionRepository) ListArticleIDs(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]uuid.UUID, int, error) {
+	query := `
+		SELECT article_id, COUNT(*) OVER ()
+		FROM bookmark_collection_articles
+		WHERE collection_id = $1
+		ORDER BY added_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, collectionID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bookmark collection articles: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	total := 0
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan bookmark collection article: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating bookmark collection articles: %w", err)
+	}
+
+	return ids, total, nil
+}
@@ -2,24 +2,24 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/phillipboles/aci-backend/internal/domain"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
 // bookmarkRepo implements repository.BookmarkRepository
 type bookmarkRepo struct {
-	db *sql.DB
+	db *DB
 }
 
 // NewBookmarkRepository creates a new bookmark repository instance
-func NewBookmarkRepository(db *sql.DB) repository.BookmarkRepository {
+func NewBookmarkRepository(db *DB) repository.BookmarkRepository {
 	if db == nil {
-		panic("db cannot be nil")
+		panic("database cannot be nil")
 	}
 
 	return &bookmarkRepo{db: db}
@@ -41,7 +41,7 @@ func (r *bookmarkRepo) Create(ctx context.Context, userID, articleID uuid.UUID)
 		ON CONFLICT (user_id, article_id) DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(ctx, query, userID, articleID)
+	_, err := r.db.Pool.Exec(ctx, query, userID, articleID)
 	if err != nil {
 		return fmt.Errorf("failed to create bookmark: %w", err)
 	}
@@ -64,17 +64,12 @@ func (r *bookmarkRepo) Delete(ctx context.Context, userID, articleID uuid.UUID)
 		WHERE user_id = $1 AND article_id = $2
 	`
 
-	result, err := r.db.ExecContext(ctx, query, userID, articleID)
+	result, err := r.db.Pool.Exec(ctx, query, userID, articleID)
 	if err != nil {
 		return fmt.Errorf("failed to delete bookmark: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
+	if result.RowsAffected() == 0 {
 		return fmt.Errorf("bookmark not found")
 	}
 
@@ -99,7 +94,7 @@ func (r *bookmarkRepo) IsBookmarked(ctx context.Context, userID, articleID uuid.
 	`
 
 	var exists bool
-	err := r.db.QueryRowContext(ctx, query, userID, articleID).Scan(&exists)
+	err := r.db.Pool.QueryRow(ctx, query, userID, articleID).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check bookmark: %w", err)
 	}
@@ -107,6 +102,43 @@ func (r *bookmarkRepo) IsBookmarked(ctx context.Context, userID, articleID uuid.
 	return exists, nil
 }
 
+// FilterBookmarked returns the subset of articleIDs bookmarked by a user
+func (r *bookmarkRepo) FilterBookmarked(ctx context.Context, userID uuid.UUID, articleIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("userID cannot be empty")
+	}
+
+	bookmarked := make([]uuid.UUID, 0)
+	if len(articleIDs) == 0 {
+		return bookmarked, nil
+	}
+
+	query := `
+		SELECT article_id FROM bookmarks
+		WHERE user_id = $1 AND article_id = ANY($2)
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, articleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bookmarks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID uuid.UUID
+		if err := rows.Scan(&articleID); err != nil {
+			return nil, fmt.Errorf("failed to scan bookmark: %w", err)
+		}
+		bookmarked = append(bookmarked, articleID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bookmarks: %w", err)
+	}
+
+	return bookmarked, nil
+}
+
 // GetByUserID returns paginated bookmarked articles for a user
 func (r *bookmarkRepo) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*domain.Article, int, error) {
 	if userID == uuid.Nil {
@@ -129,7 +161,7 @@ func (r *bookmarkRepo) GetByUserID(ctx context.Context, userID uuid.UUID, limit,
 	`
 
 	var total int
-	err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total)
+	err := r.db.Pool.QueryRow(ctx, countQuery, userID).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count bookmarks: %w", err)
 	}
@@ -141,7 +173,7 @@ func (r *bookmarkRepo) GetByUserID(ctx context.Context, userID uuid.UUID, limit,
 	// Get paginated articles with joins
 	query := `
 		SELECT
-			a.id, a.title, a.slug, a.content, a.summary,
+			a.id, COALESCE(a.editorial_title, a.title), a.slug, a.content, COALESCE(a.editorial_summary, a.summary),
 			a.category_id, a.source_id, a.source_url,
 			a.severity, a.tags, a.cves, a.vendors,
 			a.threat_type, a.attack_vector, a.impact_assessment,
@@ -149,7 +181,7 @@ func (r *bookmarkRepo) GetByUserID(ctx context.Context, userID uuid.UUID, limit,
 			a.armor_relevance, a.armor_cta,
 			a.reading_time_minutes, a.view_count,
 			a.is_published, a.published_at, a.enriched_at,
-			a.created_at, a.updated_at,
+			a.created_at, a.updated_at, a.content_format, a.word_count, a.language,
 			c.id, c.name, c.slug, c.color, c.icon, c.description,
 			c.created_at,
 			s.id, s.name, s.url, s.description, s.is_active,
@@ -163,7 +195,7 @@ func (r *bookmarkRepo) GetByUserID(ctx context.Context, userID uuid.UUID, limit,
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := r.db.Pool.Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query bookmarks: %w", err)
 	}
@@ -198,7 +230,7 @@ func (r *bookmarkRepo) CountByUserID(ctx context.Context, userID uuid.UUID) (int
 	`
 
 	var count int
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count bookmarks: %w", err)
 	}
@@ -207,7 +239,7 @@ func (r *bookmarkRepo) CountByUserID(ctx context.Context, userID uuid.UUID) (int
 }
 
 // scanArticleWithRelations scans an article row with joined category and source
-func scanArticleWithRelations(rows *sql.Rows) (*domain.Article, error) {
+func scanArticleWithRelations(rows pgx.Rows) (*domain.Article, error) {
 	article := &domain.Article{}
 	category := &domain.Category{}
 	source := &domain.Source{}
@@ -242,6 +274,9 @@ func scanArticleWithRelations(rows *sql.Rows) (*domain.Article, error) {
 		&article.EnrichedAt,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.ContentFormat,
+		&article.WordCount,
+		&article.Language,
 		&category.ID,
 		&category.Name,
 		&category.Slug,
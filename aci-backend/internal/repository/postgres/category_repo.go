@@ -7,7 +7,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
@@ -34,12 +36,13 @@ func (r *categoryRepository) Create(ctx context.Context, category *domain.Catego
 	}
 
 	query := `
-		INSERT INTO categories (id, name, slug, description, color, icon, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO categories (id, parent_id, name, slug, description, color, icon, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
 	_, err := r.db.Pool.Exec(ctx, query,
 		category.ID,
+		category.ParentID,
 		category.Name,
 		category.Slug,
 		category.Description,
@@ -62,7 +65,7 @@ func (r *categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	}
 
 	query := `
-		SELECT id, name, slug, description, color, icon, created_at
+		SELECT id, parent_id, name, slug, description, color, icon, created_at
 		FROM categories
 		WHERE id = $1
 	`
@@ -70,6 +73,7 @@ func (r *categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	category := &domain.Category{}
 	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
 		&category.ID,
+		&category.ParentID,
 		&category.Name,
 		&category.Slug,
 		&category.Description,
@@ -96,7 +100,7 @@ func (r *categoryRepository) GetBySlug(ctx context.Context, slug string) (*domai
 	}
 
 	query := `
-		SELECT id, name, slug, description, color, icon, created_at
+		SELECT id, parent_id, name, slug, description, color, icon, created_at
 		FROM categories
 		WHERE slug = $1
 	`
@@ -104,6 +108,7 @@ func (r *categoryRepository) GetBySlug(ctx context.Context, slug string) (*domai
 	category := &domain.Category{}
 	err := r.db.Pool.QueryRow(ctx, query, slug).Scan(
 		&category.ID,
+		&category.ParentID,
 		&category.Name,
 		&category.Slug,
 		&category.Description,
@@ -123,15 +128,59 @@ func (r *categoryRepository) GetBySlug(ctx context.Context, slug string) (*domai
 	return category, nil
 }
 
+// GetByIDs retrieves the categories with the given IDs
+func (r *categoryRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Category, error) {
+	categories := make([]*domain.Category, 0, len(ids))
+	if len(ids) == 0 {
+		return categories, nil
+	}
+
+	query := `
+		SELECT id, parent_id, name, slug, description, color, icon, created_at
+		FROM categories
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		category := &domain.Category{}
+		err := rows.Scan(
+			&category.ID,
+			&category.ParentID,
+			&category.Name,
+			&category.Slug,
+			&category.Description,
+			&category.Color,
+			&category.Icon,
+			&category.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating categories: %w", err)
+	}
+
+	return categories, nil
+}
+
 // List retrieves all categories
 func (r *categoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
 	query := `
-		SELECT id, name, slug, description, color, icon, created_at
+		SELECT id, parent_id, name, slug, description, color, icon, created_at
 		FROM categories
 		ORDER BY name ASC
 	`
 
-	rows, err := r.db.Pool.Query(ctx, query)
+	rows, err := r.db.read(ctx).Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list categories: %w", err)
 	}
@@ -142,6 +191,7 @@ func (r *categoryRepository) List(ctx context.Context) ([]*domain.Category, erro
 		category := &domain.Category{}
 		err := rows.Scan(
 			&category.ID,
+			&category.ParentID,
 			&category.Name,
 			&category.Slug,
 			&category.Description,
@@ -162,7 +212,7 @@ func (r *categoryRepository) List(ctx context.Context) ([]*domain.Category, erro
 	return categories, nil
 }
 
-// Update updates an existing category
+// Update updates an existing category; the parent is changed through SetParent
 func (r *categoryRepository) Update(ctx context.Context, category *domain.Category) error {
 	if category == nil {
 		return fmt.Errorf("category cannot be nil")
@@ -217,3 +267,126 @@ func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// SetParent moves a category under a parent, or to the top level when parentID is nil
+// A parent inside the category's own subtree is rejected to keep the hierarchy acyclic
+func (r *categoryRepository) SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("category ID cannot be nil")
+	}
+
+	if parentID != nil {
+		query := `
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM categories WHERE id = $1
+				UNION ALL
+				SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+			)
+			SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)
+		`
+
+		var cyclic bool
+		if err := r.db.Pool.QueryRow(ctx, query, id, *parentID).Scan(&cyclic); err != nil {
+			return fmt.Errorf("failed to check category hierarchy: %w", err)
+		}
+
+		if cyclic {
+			return &domainerrors.ValidationError{
+				Field:   "parent_id",
+				Message: "a category cannot be moved under itself or one of its subcategories",
+			}
+		}
+	}
+
+	cmdTag, err := r.db.Pool.Exec(ctx, `UPDATE categories SET parent_id = $2 WHERE id = $1`, id, parentID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &domainerrors.NotFoundError{
+				Resource: "category",
+				ID:       parentID.String(),
+			}
+		}
+		return fmt.Errorf("failed to set category parent: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "category",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// SetArticleCategories replaces an article's categories within a transaction
+// The first category becomes the article's primary category
+func (r *categoryRepository) SetArticleCategories(ctx context.Context, articleID uuid.UUID, categoryIDs []uuid.UUID) error {
+	if articleID == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	if len(categoryIDs) == 0 {
+		return fmt.Errorf("at least one category is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	cmdTag, err := tx.Exec(ctx,
+		`UPDATE articles SET category_id = $2, updated_at = NOW() WHERE id = $1`,
+		articleID, categoryIDs[0],
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &domainerrors.NotFoundError{
+				Resource: "category",
+				ID:       categoryIDs[0].String(),
+			}
+		}
+		return fmt.Errorf("failed to set primary category: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "article",
+			ID:       articleID.String(),
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`DELETE FROM article_categories WHERE article_id = $1 AND NOT (category_id = ANY($2))`,
+		articleID, categoryIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove article categories: %w", err)
+	}
+
+	for _, categoryID := range categoryIDs[1:] {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO article_categories (article_id, category_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			articleID, categoryID,
+		)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return &domainerrors.NotFoundError{
+					Resource: "category",
+					ID:       categoryID.String(),
+				}
+			}
+			return fmt.Errorf("failed to add article category: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit article categories: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const classificationSuggestionColumns = `id, article_id, category_slug, category_confidence, severity, severity_confidence,
+	tags, tags_confidence, vendors, vendors_confidence, missing_fields, applied_fields,
+	status, provider, reviewed_by, reviewed_at, created_at`
+
+// ClassificationSuggestionRepository implements repository.ClassificationSuggestionRepository for PostgreSQL
+type ClassificationSuggestionRepository struct {
+	db *DB
+}
+
+// NewClassificationSuggestionRepository creates a new PostgreSQL classification suggestion repository
+func NewClassificationSuggestionRepository(db *DB) *ClassificationSuggestionRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ClassificationSuggestionRepository{db: db}
+}
+
+// Create inserts a new classification suggestion
+func (r *ClassificationSuggestionRepository) Create(ctx context.Context, suggestion *domain.ClassificationSuggestion) error {
+	if suggestion == nil {
+		return fmt.Errorf("suggestion cannot be nil")
+	}
+
+	if suggestion.ArticleID == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	var severity *string
+	if suggestion.Severity != nil {
+		value := string(*suggestion.Severity)
+		severity = &value
+	}
+
+	query := `
+		INSERT INTO classification_suggestions (
+			id, article_id, category_slug, category_confidence, severity, severity_confidence,
+			tags, tags_confidence, vendors, vendors_confidence, missing_fields, applied_fields,
+			status, provider, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		suggestion.ID,
+		suggestion.ArticleID,
+		suggestion.CategorySlug,
+		suggestion.CategoryConfidence,
+		severity,
+		suggestion.SeverityConfidence,
+		nonNilStrings(suggestion.Tags),
+		suggestion.TagsConfidence,
+		nonNilStrings(suggestion.Vendors),
+		suggestion.VendorsConfidence,
+		classificationFieldsToStrings(suggestion.MissingFields),
+		classificationFieldsToStrings(suggestion.AppliedFields),
+		suggestion.Status,
+		suggestion.Provider,
+		suggestion.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create classification suggestion: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a classification suggestion by ID
+func (r *ClassificationSuggestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	query := fmt.Sprintf(`SELECT %s FROM classification_suggestions WHERE id = $1`, classificationSuggestionColumns)
+
+	suggestion, err := scanClassificationSuggestion(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "classification suggestion",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get classification suggestion: %w", err)
+	}
+
+	return suggestion, nil
+}
+
+// List retrieves classification suggestions, newest first, with optional status filter
+func (r *ClassificationSuggestionRepository) List(ctx context.Context, filter *domain.ClassificationFilter) ([]*domain.ClassificationSuggestion, int, error) {
+	if filter == nil {
+		filter = &domain.ClassificationFilter{Page: 1, PageSize: 20}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	whereClause := "1=1"
+	args := []interface{}{}
+	if filter.Status != nil {
+		whereClause = "status = $1"
+		args = append(args, *filter.Status)
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM classification_suggestions WHERE %s", whereClause)
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count classification suggestions: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM classification_suggestions
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, classificationSuggestionColumns, whereClause, len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list classification suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	suggestions := make([]*domain.ClassificationSuggestion, 0)
+	for rows.Next() {
+		suggestion, err := scanClassificationSuggestion(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan classification suggestion: %w", err)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating classification suggestions: %w", err)
+	}
+
+	return suggestions, total, nil
+}
+
+// UpdateReview stores the review outcome of a classification suggestion
+func (r *ClassificationSuggestionRepository) UpdateReview(ctx context.Context, suggestion *domain.ClassificationSuggestion) error {
+	if suggestion == nil {
+		return fmt.Errorf("suggestion cannot be nil")
+	}
+
+	query := `
+		UPDATE classification_suggestions
+		SET status = $2, applied_fields = $3, reviewed_by = $4, reviewed_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query,
+		suggestion.ID,
+		suggestion.Status,
+		classificationFieldsToStrings(suggestion.AppliedFields),
+		suggestion.ReviewedBy,
+		suggestion.ReviewedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update classification suggestion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "classification suggestion",
+			ID:       suggestion.ID.String(),
+		}
+	}
+
+	return nil
+}
+
+// scanClassificationSuggestion scans a single classification suggestion row
+func scanClassificationSuggestion(row pgx.Row) (*domain.ClassificationSuggestion, error) {
+	suggestion := &domain.ClassificationSuggestion{}
+
+	var (
+		severity      *string
+		missingFields []string
+		appliedFields []string
+	)
+
+	err := row.Scan(
+		&suggestion.ID,
+		&suggestion.ArticleID,
+		&suggestion.CategorySlug,
+		&suggestion.CategoryConfidence,
+		&severity,
+		&suggestion.SeverityConfidence,
+		&suggestion.Tags,
+		&suggestion.TagsConfidence,
+		&suggestion.Vendors,
+		&suggestion.VendorsConfidence,
+		&missingFields,
+		&appliedFields,
+		&suggestion.Status,
+		&suggestion.Provider,
+		&suggestion.ReviewedBy,
+		&suggestion.ReviewedAt,
+		&suggestion.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if severity != nil {
+		value := domain.Severity(*severity)
+		suggestion.Severity = &value
+	}
+
+	suggestion.MissingFields = stringsToClassificationFields(missingFields)
+	suggestion.AppliedFields = stringsToClassificationFields(appliedFields)
+
+	return suggestion, nil
+}
+
+// classificationFieldsToStrings converts fields for storage in a TEXT[] column
+func classificationFieldsToStrings(fields []domain.ClassificationField) []string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = string(field)
+	}
+	return values
+}
+
+// stringsToClassificationFields converts a TEXT[] column to fields
+func stringsToClassificationFields(values []string) []domain.ClassificationField {
+	fields := make([]domain.ClassificationField, len(values))
+	for i, value := range values {
+		fields[i] = domain.ClassificationField(value)
+	}
+	return fields
+}
+
+// nonNilStrings returns an empty slice for nil, as required by NOT NULL array columns
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
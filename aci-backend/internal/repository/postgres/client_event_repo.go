@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// clientEventPartitionPrefix names the monthly partitions of client_events, e.g. client_events_2026_10
+const clientEventPartitionPrefix = "client_events_"
+
+// ClientEventRepository implements repository.ClientEventRepository for PostgreSQL
+// Events go to client_events, which is partitioned by month so old months are dropped
+// whole; the aggregation job rolls them up into client_event_daily_stats
+type ClientEventRepository struct {
+	db *DB
+}
+
+// NewClientEventRepository creates a new PostgreSQL client event repository
+func NewClientEventRepository(db *DB) *ClientEventRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ClientEventRepository{db: db}
+}
+
+// InsertBatch copies the events into client_events in one round trip
+func (r *ClientEventRepository) InsertBatch(ctx context.Context, events []*domain.ClientEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	columns := []string{
+		"id", "event_type", "article_id", "user_id", "session_id",
+		"scroll_depth", "channel", "variant_id", "occurred_at", "received_at",
+	}
+
+	rows := make([][]interface{}, len(events))
+	for i, event := range events {
+		rows[i] = []interface{}{
+			event.ID,
+			string(event.Type),
+			event.ArticleID,
+			event.UserID,
+			event.SessionID,
+			event.ScrollDepth,
+			event.Channel,
+			event.VariantID,
+			event.OccurredAt,
+			event.ReceivedAt,
+		}
+	}
+
+	if _, err := r.db.Pool.CopyFrom(ctx, pgx.Identifier{"client_events"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to insert client events: %w", err)
+	}
+
+	return nil
+}
+
+// EnsurePartitions creates the monthly partitions from the month of from, for the given number of months
+func (r *ClientEventRepository) EnsurePartitions(ctx context.Context, from time.Time, months int) error {
+	first := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < months; i++ {
+		month := first.AddDate(0, i, 0)
+		if _, err := r.db.Pool.Exec(ctx, `SELECT create_client_events_partition($1)`, month); err != nil {
+			return fmt.Errorf("failed to create client events partition for %s: %w", month.Format("2006-01"), err)
+		}
+	}
+
+	return nil
+}
+
+// DropPartitionsBefore drops the monthly partitions that end on or before cutoff
+func (r *ClientEventRepository) DropPartitionsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	query := `
+		SELECT child.relname
+		FROM pg_inherits
+		JOIN pg_class parent ON parent.oid = pg_inherits.inhparent
+		JOIN pg_class child ON child.oid = pg_inherits.inhrelid
+		WHERE parent.relname = 'client_events'
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list client events partitions: %w", err)
+	}
+	defer rows.Close()
+
+	expired := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return 0, fmt.Errorf("failed to scan client events partition: %w", err)
+		}
+
+		month, err := time.Parse("2006_01", strings.TrimPrefix(name, clientEventPartitionPrefix))
+		if err != nil {
+			continue // not one of the monthly partitions
+		}
+
+		if !month.AddDate(0, 1, 0).After(cutoff) {
+			expired = append(expired, name)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating client events partitions: %w", err)
+	}
+
+	for i, name := range expired {
+		if _, err := r.db.Pool.Exec(ctx, "DROP TABLE IF EXISTS "+pgx.Identifier{name}.Sanitize()); err != nil {
+			return i, fmt.Errorf("failed to drop client events partition %s: %w", name, err)
+		}
+	}
+
+	return len(expired), nil
+}
+
+// Aggregate recomputes the daily aggregates for every UTC day from the day of from up to to
+// Whole days are recomputed, so running it again over the same days is harmless
+func (r *ClientEventRepository) Aggregate(ctx context.Context, from, to time.Time) (int64, error) {
+	from = from.UTC().Truncate(24 * time.Hour)
+
+	query := `
+		INSERT INTO client_event_daily_stats (
+			day, article_id, event_type, events, unique_users, unique_sessions, avg_scroll_depth, aggregated_at
+		)
+		SELECT
+			(occurred_at AT TIME ZONE 'UTC')::date AS day,
+			article_id,
+			event_type,
+			COUNT(*),
+			COUNT(DISTINCT user_id),
+			COUNT(DISTINCT session_id),
+			AVG(scroll_depth),
+			NOW()
+		FROM client_events
+		WHERE occurred_at >= $1 AND occurred_at < $2
+		GROUP BY day, article_id, event_type
+		ON CONFLICT (day, article_id, event_type) DO UPDATE SET
+			events = EXCLUDED.events,
+			unique_users = EXCLUDED.unique_users,
+			unique_sessions = EXCLUDED.unique_sessions,
+			avg_scroll_depth = EXCLUDED.avg_scroll_depth,
+			aggregated_at = EXCLUDED.aggregated_at
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to aggregate client events: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
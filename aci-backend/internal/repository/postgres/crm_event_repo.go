@@ -0,0 +1,298 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// maxCRMEventErrorLength bounds the delivery error stored on an event
+const maxCRMEventErrorLength = 1000
+
+// crmEventColumns are the columns scanned by scanCRMEvent
+const crmEventColumns = `
+	id, connector, payload, user_id, status, attempts, last_error,
+	next_attempt_at, delivered_at, created_at, updated_at`
+
+// CRMEventRepository implements repository.CRMEventRepository
+type CRMEventRepository struct {
+	db *DB
+}
+
+// NewCRMEventRepository creates a new CRM event repository instance
+func NewCRMEventRepository(db *DB) *CRMEventRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &CRMEventRepository{db: db}
+}
+
+// Enqueue queues an event for delivery
+func (r *CRMEventRepository) Enqueue(ctx context.Context, event *domain.CRMEvent) error {
+	if event == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	payload, err := json.Marshal(event.Conversion)
+	if err != nil {
+		return fmt.Errorf("failed to marshal CRM conversion: %w", err)
+	}
+
+	query := `
+		INSERT INTO crm_events (
+			id, connector, conversion_type, email, payload, user_id, article_id,
+			status, next_attempt_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, 'pending', $8, $8, $8)
+	`
+
+	_, err = r.db.Pool.Exec(ctx, query,
+		event.ID,
+		event.Connector,
+		event.Conversion.Type,
+		event.Conversion.Email,
+		payload,
+		event.UserID,
+		event.Conversion.ArticleID,
+		event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to queue CRM event: %w", err)
+	}
+
+	return nil
+}
+
+// HasRecent reports whether a conversion of the same type by the same address on the same
+// article (or on no article when articleID is nil) was queued since the given time
+func (r *CRMEventRepository) HasRecent(ctx context.Context, email string, conversionType domain.ConversionType, articleID *uuid.UUID, since time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM crm_events
+			WHERE LOWER(email) = LOWER($1)
+			  AND conversion_type = $2
+			  AND article_id IS NOT DISTINCT FROM $3
+			  AND created_at >= $4
+		)
+	`
+
+	var exists bool
+	if err := r.db.Pool.QueryRow(ctx, query, email, conversionType, articleID, since).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check recent CRM events: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ClaimDue claims up to limit due pending events, oldest first
+// Claimed events are not due again until leaseUntil, so several workers can run at once
+// and events held by a crashed worker are picked up once the lease runs out
+func (r *CRMEventRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*domain.CRMEvent, error) {
+	query := `
+		UPDATE crm_events
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM crm_events
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + crmEventColumns
+
+	rows, err := r.db.Pool.Query(ctx, query, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim CRM events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.CRMEvent, 0, limit)
+	for rows.Next() {
+		event, err := scanCRMEvent(rows, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan CRM event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating CRM events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkDelivered records that an event reached the CRM
+func (r *CRMEventRepository) MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE crm_events
+		SET status = 'delivered', attempts = attempts + 1, last_error = NULL, delivered_at = $2
+		WHERE id = $1
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, id, at); err != nil {
+		return fmt.Errorf("failed to mark CRM event delivered: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFailure records a failed delivery attempt
+// The event is retried with exponential backoff capped at six hours, unless the failure is
+// permanent or this was attempt maxAttempts, in which case it is marked failed
+func (r *CRMEventRepository) RecordFailure(ctx context.Context, id uuid.UUID, message string, permanent bool, maxAttempts int) (domain.CRMEventStatus, error) {
+	if len(message) > maxCRMEventErrorLength {
+		message = message[:maxCRMEventErrorLength]
+	}
+
+	query := `
+		UPDATE crm_events
+		SET attempts = attempts + 1,
+			last_error = $2,
+			status = CASE WHEN $3 OR attempts + 1 >= $4 THEN 'failed' ELSE 'pending' END,
+			next_attempt_at = NOW() + LEAST(INTERVAL '6 hours', INTERVAL '30 seconds' * POWER(2, LEAST(attempts, 12)))
+		WHERE id = $1
+		RETURNING status
+	`
+
+	var status domain.CRMEventStatus
+	if err := r.db.Pool.QueryRow(ctx, query, id, message, permanent, maxAttempts).Scan(&status); err != nil {
+		return "", fmt.Errorf("failed to record CRM event failure: %w", err)
+	}
+
+	return status, nil
+}
+
+// List returns events matching the filter, newest first
+func (r *CRMEventRepository) List(ctx context.Context, filter *domain.CRMEventFilter) ([]*domain.CRMEvent, int, error) {
+	if filter == nil {
+		filter = &domain.CRMEventFilter{Page: 1, PageSize: 20}
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if filter.Type != nil {
+		args = append(args, *filter.Type)
+		where = append(where, fmt.Sprintf("conversion_type = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER ()
+		FROM crm_events
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, crmEventColumns, strings.Join(where, " AND "), len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list CRM events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.CRMEvent, 0)
+	total := 0
+
+	for rows.Next() {
+		event, err := scanCRMEvent(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan CRM event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating CRM events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// Retry queues a failed event for immediate delivery with a fresh set of attempts
+// It returns a NotFoundError if there is no such failed event
+func (r *CRMEventRepository) Retry(ctx context.Context, id uuid.UUID) (*domain.CRMEvent, error) {
+	query := `
+		UPDATE crm_events
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+		WHERE id = $1 AND status = 'failed'
+		RETURNING ` + crmEventColumns
+
+	event, err := scanCRMEvent(r.db.Pool.QueryRow(ctx, query, id), nil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "failed CRM event",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to retry CRM event: %w", err)
+	}
+
+	return event, nil
+}
+
+// RetryFailed queues every failed event for immediate delivery and returns how many there were
+func (r *CRMEventRepository) RetryFailed(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE crm_events
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+		WHERE status = 'failed'
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retry CRM events: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// scanCRMEvent scans crmEventColumns, followed by a total count when total is non-nil
+func scanCRMEvent(row pgx.Row, total *int) (*domain.CRMEvent, error) {
+	event := &domain.CRMEvent{}
+	var payload []byte
+
+	dest := []interface{}{
+		&event.ID,
+		&event.Connector,
+		&payload,
+		&event.UserID,
+		&event.Status,
+		&event.Attempts,
+		&event.LastError,
+		&event.NextAttemptAt,
+		&event.DeliveredAt,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(payload, &event.Conversion); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal CRM conversion: %w", err)
+	}
+
+	return event, nil
+}
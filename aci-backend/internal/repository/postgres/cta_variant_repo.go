@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const ctaVariantColumns = `id, base_url, name, cta_type, title, url, weight, is_active, created_by, created_at, updated_at`
+
+// CTAVariantRepository implements repository.CTAVariantRepository for PostgreSQL
+type CTAVariantRepository struct {
+	db *DB
+}
+
+// NewCTAVariantRepository creates a new PostgreSQL CTA variant repository
+func NewCTAVariantRepository(db *DB) *CTAVariantRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &CTAVariantRepository{db: db}
+}
+
+// Create creates a new CTA variant
+func (r *CTAVariantRepository) Create(ctx context.Context, variant *domain.CTAVariant) error {
+	if variant == nil {
+		return fmt.Errorf("cta variant cannot be nil")
+	}
+
+	query := `
+		INSERT INTO cta_variants (base_url, name, cta_type, title, url, weight, is_active, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		variant.BaseURL,
+		variant.Name,
+		variant.Type,
+		variant.Title,
+		variant.URL,
+		variant.Weight,
+		variant.IsActive,
+		variant.CreatedBy,
+	).Scan(&variant.ID, &variant.CreatedAt, &variant.UpdatedAt)
+	if err != nil {
+		return r.mapWriteError(err, variant, "failed to create cta variant")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a CTA variant by ID
+func (r *CTAVariantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CTAVariant, error) {
+	query := fmt.Sprintf(`SELECT %s FROM cta_variants WHERE id = $1`, ctaVariantColumns)
+
+	variant, err := scanCTAVariant(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "cta variant",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get cta variant: %w", err)
+	}
+
+	return variant, nil
+}
+
+// Update updates a CTA variant's copy, weight and status
+func (r *CTAVariantRepository) Update(ctx context.Context, variant *domain.CTAVariant) error {
+	if variant == nil {
+		return fmt.Errorf("cta variant cannot be nil")
+	}
+
+	query := `
+		UPDATE cta_variants
+		SET base_url = $2, name = $3, cta_type = $4, title = $5, url = $6, weight = $7, is_active = $8, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		variant.ID,
+		variant.BaseURL,
+		variant.Name,
+		variant.Type,
+		variant.Title,
+		variant.URL,
+		variant.Weight,
+		variant.IsActive,
+	).Scan(&variant.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.NotFoundError{
+				Resource: "cta variant",
+				ID:       variant.ID.String(),
+			}
+		}
+		return r.mapWriteError(err, variant, "failed to update cta variant")
+	}
+
+	return nil
+}
+
+// Delete deletes a CTA variant and its statistics
+func (r *CTAVariantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM cta_variants WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete cta variant: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "cta variant",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// List returns all variants ordered by base URL and name; activeOnly limits it to active variants
+func (r *CTAVariantRepository) List(ctx context.Context, activeOnly bool) ([]*domain.CTAVariant, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM cta_variants
+		WHERE ($1 = false OR is_active = true)
+		ORDER BY base_url ASC, name ASC
+	`, ctaVariantColumns)
+
+	rows, err := r.db.Pool.Query(ctx, query, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cta variants: %w", err)
+	}
+	defer rows.Close()
+
+	variants := make([]*domain.CTAVariant, 0)
+	for rows.Next() {
+		variant, err := scanCTAVariant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan cta variant: %w", err)
+		}
+		variants = append(variants, variant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cta variants: %w", err)
+	}
+
+	return variants, nil
+}
+
+// RecordImpression adds an impression to the variant's count for the current day
+func (r *CTAVariantRepository) RecordImpression(ctx context.Context, variantID uuid.UUID) error {
+	return r.increment(ctx, variantID, 1, 0)
+}
+
+// RecordClick adds a click to the variant's count for the current day
+func (r *CTAVariantRepository) RecordClick(ctx context.Context, variantID uuid.UUID) error {
+	return r.increment(ctx, variantID, 0, 1)
+}
+
+// Stats sums every variant's impressions and clicks since the given day
+// Variants without traffic are included with zero counts
+func (r *CTAVariantRepository) Stats(ctx context.Context, since time.Time) ([]*domain.CTAVariantStats, error) {
+	query := `
+		SELECT v.id, v.base_url, v.name, v.title, v.weight, v.is_active,
+			COALESCE(SUM(s.impressions), 0), COALESCE(SUM(s.clicks), 0)
+		FROM cta_variants v
+		LEFT JOIN cta_variant_stats s ON s.variant_id = v.id AND s.day >= $1::DATE
+		GROUP BY v.id
+		ORDER BY v.base_url ASC, v.name ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cta variant stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]*domain.CTAVariantStats, 0)
+	for rows.Next() {
+		s := &domain.CTAVariantStats{}
+		if err := rows.Scan(
+			&s.VariantID,
+			&s.BaseURL,
+			&s.Name,
+			&s.Title,
+			&s.Weight,
+			&s.IsActive,
+			&s.Impressions,
+			&s.Clicks,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan cta variant stats: %w", err)
+		}
+
+		if s.Impressions > 0 {
+			s.CTR = float64(s.Clicks) / float64(s.Impressions)
+		}
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cta variant stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// increment upserts the variant's counts for the current UTC day
+func (r *CTAVariantRepository) increment(ctx context.Context, variantID uuid.UUID, impressions, clicks int) error {
+	query := `
+		INSERT INTO cta_variant_stats (variant_id, day, impressions, clicks)
+		VALUES ($1, (NOW() AT TIME ZONE 'UTC')::DATE, $2, $3)
+		ON CONFLICT (variant_id, day) DO UPDATE SET
+			impressions = cta_variant_stats.impressions + EXCLUDED.impressions,
+			clicks = cta_variant_stats.clicks + EXCLUDED.clicks
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, variantID, impressions, clicks); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &domainerrors.NotFoundError{
+				Resource: "cta variant",
+				ID:       variantID.String(),
+			}
+		}
+		return fmt.Errorf("failed to record cta variant stats: %w", err)
+	}
+
+	return nil
+}
+
+// mapWriteError maps unique violations on (base_url, name) to a ConflictError
+func (r *CTAVariantRepository) mapWriteError(err error, variant *domain.CTAVariant, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return &domainerrors.ConflictError{
+			Resource: "cta variant",
+			Field:    "name",
+			Value:    variant.Name,
+		}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// scanCTAVariant scans a row selected with ctaVariantColumns
+func scanCTAVariant(row pgx.Row) (*domain.CTAVariant, error) {
+	variant := &domain.CTAVariant{}
+	err := row.Scan(
+		&variant.ID,
+		&variant.BaseURL,
+		&variant.Name,
+		&variant.Type,
+		&variant.Title,
+		&variant.URL,
+		&variant.Weight,
+		&variant.IsActive,
+		&variant.CreatedBy,
+		&variant.CreatedAt,
+		&variant.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
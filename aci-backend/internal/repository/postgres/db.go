@@ -3,15 +3,21 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DB wraps pgxpool for database operations
+// Pool is the primary; read replicas added with AddReplica serve reads that allow them
 type DB struct {
 	Pool *pgxpool.Pool
+
+	replicas    []*replica
+	nextReplica atomic.Uint64
 }
 
 // Config for database connection
@@ -108,11 +114,15 @@ func buildConnectionString(cfg Config) string {
 	)
 }
 
-// Close closes the database connection pool gracefully
+// Close closes the database connection pools gracefully
 func (db *DB) Close() {
 	if db.Pool != nil {
 		db.Pool.Close()
 	}
+
+	for _, r := range db.replicas {
+		r.pool.Close()
+	}
 }
 
 // Ping checks database connectivity
@@ -142,6 +152,56 @@ func (db *DB) BeginTx(ctx context.Context) (pgx.Tx, error) {
 	return tx, nil
 }
 
+// txContextKey carries the transaction started by WithinTx
+type txContextKey struct{}
+
+// querier is the part of pgxpool.Pool and pgx.Tx that repositories use
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// WithinTx runs fn in a transaction, committing if it returns nil and rolling back otherwise
+// Repositories that query through conn with the context passed to fn take part in the
+// transaction. A nested call runs in a savepoint of the outer transaction, so its failure
+// rolls back only its own work and the caller may recover and carry on
+func (db *DB) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var tx pgx.Tx
+	var err error
+
+	if outer, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		tx, err = outer.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create savepoint: %w", err)
+		}
+	} else {
+		tx, err = db.BeginTx(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// conn returns the transaction started by WithinTx, or the pool outside one
+func (db *DB) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.Pool
+}
+
 // Stats returns connection pool statistics
 func (db *DB) Stats() *pgxpool.Stat {
 	if db.Pool == nil {
@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// EnrichmentFeedbackRepository implements repository.EnrichmentFeedbackRepository for PostgreSQL
+type EnrichmentFeedbackRepository struct {
+	db *DB
+}
+
+// NewEnrichmentFeedbackRepository creates a new PostgreSQL enrichment feedback repository
+func NewEnrichmentFeedbackRepository(db *DB) *EnrichmentFeedbackRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &EnrichmentFeedbackRepository{db: db}
+}
+
+const enrichmentFeedbackColumns = `
+	id, article_id, field, ioc_type, ioc_value, comment, enriched_at, created_by, created_at
+`
+
+// Create stores feedback, or returns a ConflictError if the user already gave the same flag
+func (r *EnrichmentFeedbackRepository) Create(ctx context.Context, feedback *domain.EnrichmentFeedback) error {
+	if feedback == nil {
+		return fmt.Errorf("feedback cannot be nil")
+	}
+
+	query := `
+		INSERT INTO enrichment_feedback (
+			id, article_id, field, ioc_type, ioc_value, comment, enriched_at, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		feedback.ID,
+		feedback.ArticleID,
+		feedback.Field,
+		feedback.IOCType,
+		feedback.IOCValue,
+		feedback.Comment,
+		feedback.EnrichedAt,
+		feedback.CreatedBy,
+		feedback.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return &domainerrors.ConflictError{
+					Resource: "enrichment feedback",
+					Field:    "field",
+					Value:    string(feedback.Field),
+				}
+			case "23503":
+				return &domainerrors.NotFoundError{
+					Resource: "article",
+					ID:       feedback.ArticleID.String(),
+				}
+			}
+		}
+		return fmt.Errorf("failed to create enrichment feedback: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves feedback by ID
+func (r *EnrichmentFeedbackRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentFeedback, error) {
+	query := `SELECT ` + enrichmentFeedbackColumns + ` FROM enrichment_feedback WHERE id = $1`
+
+	feedback, err := scanEnrichmentFeedback(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "enrichment feedback",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get enrichment feedback: %w", err)
+	}
+
+	return feedback, nil
+}
+
+// List returns feedback matching the filter, newest first
+func (r *EnrichmentFeedbackRepository) List(ctx context.Context, filter *domain.EnrichmentFeedbackFilter) ([]*domain.EnrichmentFeedback, int, error) {
+	if filter == nil {
+		filter = &domain.EnrichmentFeedbackFilter{Page: 1, PageSize: 20}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.ArticleID != nil {
+		args = append(args, *filter.ArticleID)
+		where = append(where, fmt.Sprintf("article_id = $%d", len(args)))
+	}
+
+	if filter.Field != nil {
+		args = append(args, *filter.Field)
+		where = append(where, fmt.Sprintf("field = $%d", len(args)))
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM enrichment_feedback WHERE ` + whereClause
+	if err := r.db.read(ctx).QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count enrichment feedback: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM enrichment_feedback
+		WHERE %s
+		ORDER BY created_at DESC, id ASC
+		LIMIT $%d OFFSET $%d
+	`, enrichmentFeedbackColumns, whereClause, len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list enrichment feedback: %w", err)
+	}
+	defer rows.Close()
+
+	feedback := make([]*domain.EnrichmentFeedback, 0)
+	for rows.Next() {
+		item, err := scanEnrichmentFeedback(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan enrichment feedback: %w", err)
+		}
+		feedback = append(feedback, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating enrichment feedback: %w", err)
+	}
+
+	return feedback, total, nil
+}
+
+// Delete removes feedback
+func (r *EnrichmentFeedbackRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM enrichment_feedback WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete enrichment feedback: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "enrichment feedback",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// WeeklyAccuracy returns per-week enriched and flagged counts for enrichment runs since since, oldest first
+// Articles are counted in the week of their latest enrichment and feedback in the week of the run it
+// was given on; flagged counts are distinct articles, overall and per field
+func (r *EnrichmentFeedbackRepository) WeeklyAccuracy(ctx context.Context, since time.Time) ([]*domain.EnrichmentAccuracyWeek, error) {
+	query := `
+		WITH enriched AS (
+			SELECT date_trunc('week', enriched_at AT TIME ZONE 'UTC') AS week, COUNT(*) AS articles
+			FROM articles
+			WHERE enriched_at >= $1
+			GROUP BY 1
+		),
+		flagged AS (
+			SELECT week, field, COUNT(DISTINCT article_id) AS articles
+			FROM (
+				SELECT date_trunc('week', enriched_at AT TIME ZONE 'UTC') AS week, field, article_id
+				FROM enrichment_feedback
+				WHERE enriched_at >= $1
+			) f
+			GROUP BY GROUPING SETS ((week, field), (week))
+		)
+		SELECT COALESCE(e.week, f.week) AS week, COALESCE(e.articles, 0), f.field, COALESCE(f.articles, 0)
+		FROM enriched e
+		FULL JOIN flagged f ON f.week = e.week
+		ORDER BY week ASC, f.field ASC NULLS FIRST
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate enrichment accuracy: %w", err)
+	}
+	defer rows.Close()
+
+	weeks := make([]*domain.EnrichmentAccuracyWeek, 0)
+	var current *domain.EnrichmentAccuracyWeek
+
+	for rows.Next() {
+		var (
+			week              time.Time
+			enriched, flagged int
+			field             *string
+		)
+
+		if err := rows.Scan(&week, &enriched, &field, &flagged); err != nil {
+			return nil, fmt.Errorf("failed to scan enrichment accuracy: %w", err)
+		}
+
+		// Rows are ordered by week, so a new week starts a new aggregate
+		if current == nil || !current.WeekStart.Equal(week) {
+			current = &domain.EnrichmentAccuracyWeek{
+				WeekStart:      week,
+				Enriched:       enriched,
+				FlaggedByField: make(map[domain.EnrichmentField]int),
+			}
+			weeks = append(weeks, current)
+		}
+
+		// The grouping set without a field carries the week's total
+		if field == nil {
+			current.FlaggedArticles = flagged
+		} else {
+			current.FlaggedByField[domain.EnrichmentField(*field)] = flagged
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating enrichment accuracy: %w", err)
+	}
+
+	return weeks, nil
+}
+
+// scanEnrichmentFeedback scans a row selected with enrichmentFeedbackColumns
+func scanEnrichmentFeedback(row pgx.Row) (*domain.EnrichmentFeedback, error) {
+	feedback := &domain.EnrichmentFeedback{}
+	var field string
+
+	if err := row.Scan(
+		&feedback.ID,
+		&feedback.ArticleID,
+		&field,
+		&feedback.IOCType,
+		&feedback.IOCValue,
+		&feedback.Comment,
+		&feedback.EnrichedAt,
+		&feedback.CreatedBy,
+		&feedback.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	feedback.Field = domain.EnrichmentField(field)
+	return feedback, nil
+}
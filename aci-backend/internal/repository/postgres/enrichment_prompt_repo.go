@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const enrichmentPromptColumns = `
+	id, prompt_key, version, system_prompt, model, notes,
+	is_active, activated_at, created_by, created_at
+`
+
+// EnrichmentPromptRepository implements repository.EnrichmentPromptRepository for PostgreSQL
+type EnrichmentPromptRepository struct {
+	db *DB
+}
+
+// NewEnrichmentPromptRepository creates a new PostgreSQL enrichment prompt repository
+func NewEnrichmentPromptRepository(db *DB) *EnrichmentPromptRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &EnrichmentPromptRepository{db: db}
+}
+
+// CreateVersion inserts the next version of a prompt as an inactive draft
+// Version assignment is serialized per key so concurrent edits cannot produce duplicate versions
+func (r *EnrichmentPromptRepository) CreateVersion(ctx context.Context, prompt *domain.EnrichmentPrompt) error {
+	if prompt == nil {
+		return fmt.Errorf("prompt cannot be nil")
+	}
+
+	if prompt.ID == uuid.Nil {
+		return fmt.Errorf("prompt ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	lockKey := fmt.Sprintf("enrichment_prompt:%s", prompt.Key)
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, lockKey); err != nil {
+		return fmt.Errorf("failed to lock prompt: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(version), 0) + 1 FROM enrichment_prompts WHERE prompt_key = $1
+	`, prompt.Key).Scan(&prompt.Version)
+	if err != nil {
+		return fmt.Errorf("failed to get next prompt version: %w", err)
+	}
+
+	prompt.IsActive = false
+	prompt.ActivatedAt = nil
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO enrichment_prompts (
+			id, prompt_key, version, system_prompt, model, notes, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		prompt.ID,
+		prompt.Key,
+		prompt.Version,
+		prompt.SystemPrompt,
+		prompt.Model,
+		prompt.Notes,
+		prompt.CreatedBy,
+		prompt.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create prompt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit prompt: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a prompt version by ID
+func (r *EnrichmentPromptRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentPrompt, error) {
+	query := `SELECT ` + enrichmentPromptColumns + ` FROM enrichment_prompts WHERE id = $1`
+
+	prompt, err := scanEnrichmentPrompt(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "enrichment prompt",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get prompt by ID: %w", err)
+	}
+
+	return prompt, nil
+}
+
+// GetActive retrieves the active version of a prompt
+func (r *EnrichmentPromptRepository) GetActive(ctx context.Context, key domain.EnrichmentPromptKey) (*domain.EnrichmentPrompt, error) {
+	query := `SELECT ` + enrichmentPromptColumns + ` FROM enrichment_prompts WHERE prompt_key = $1 AND is_active = true`
+
+	prompt, err := scanEnrichmentPrompt(r.db.Pool.QueryRow(ctx, query, key))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "enrichment prompt",
+				ID:       string(key),
+			}
+		}
+		return nil, fmt.Errorf("failed to get active prompt: %w", err)
+	}
+
+	return prompt, nil
+}
+
+// ListVersions retrieves all versions of a prompt, newest first
+func (r *EnrichmentPromptRepository) ListVersions(ctx context.Context, key domain.EnrichmentPromptKey) ([]*domain.EnrichmentPrompt, error) {
+	query := `
+		SELECT ` + enrichmentPromptColumns + `
+		FROM enrichment_prompts
+		WHERE prompt_key = $1
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prompt versions: %w", err)
+	}
+	defer rows.Close()
+
+	return collectEnrichmentPrompts(rows)
+}
+
+// ListCurrent retrieves the active and the latest version of every key, newest first within a key
+// A key's active version is often also its latest, in which case it is returned once
+func (r *EnrichmentPromptRepository) ListCurrent(ctx context.Context) ([]*domain.EnrichmentPrompt, error) {
+	query := `
+		SELECT ` + enrichmentPromptColumns + `
+		FROM enrichment_prompts p
+		WHERE is_active = true
+		   OR version = (SELECT MAX(version) FROM enrichment_prompts WHERE prompt_key = p.prompt_key)
+		ORDER BY prompt_key, version DESC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prompts: %w", err)
+	}
+	defer rows.Close()
+
+	return collectEnrichmentPrompts(rows)
+}
+
+// Activate makes the given version the active one for its key
+func (r *EnrichmentPromptRepository) Activate(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var key string
+	err = tx.QueryRow(ctx, `SELECT prompt_key FROM enrichment_prompts WHERE id = $1 FOR UPDATE`, id).Scan(&key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.NotFoundError{
+				Resource: "enrichment prompt",
+				ID:       id.String(),
+			}
+		}
+		return fmt.Errorf("failed to get prompt: %w", err)
+	}
+
+	// Deactivate first so the partial unique index never sees two active versions
+	if _, err := tx.Exec(ctx, `
+		UPDATE enrichment_prompts SET is_active = false
+		WHERE prompt_key = $1 AND is_active = true AND id <> $2
+	`, key, id); err != nil {
+		return fmt.Errorf("failed to deactivate previous prompt: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE enrichment_prompts SET is_active = true, activated_at = NOW()
+		WHERE id = $1
+	`, id); err != nil {
+		return fmt.Errorf("failed to activate prompt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit prompt activation: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a version that was never active; active and former versions are kept as history
+func (r *EnrichmentPromptRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM enrichment_prompts WHERE id = $1 AND activated_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete prompt: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "enrichment prompt draft",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// scanEnrichmentPrompt scans a single prompt row
+func scanEnrichmentPrompt(row pgx.Row) (*domain.EnrichmentPrompt, error) {
+	prompt := &domain.EnrichmentPrompt{}
+	var key string
+
+	err := row.Scan(
+		&prompt.ID,
+		&key,
+		&prompt.Version,
+		&prompt.SystemPrompt,
+		&prompt.Model,
+		&prompt.Notes,
+		&prompt.IsActive,
+		&prompt.ActivatedAt,
+		&prompt.CreatedBy,
+		&prompt.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt.Key = domain.EnrichmentPromptKey(key)
+	return prompt, nil
+}
+
+// collectEnrichmentPrompts scans all prompt rows
+func collectEnrichmentPrompts(rows pgx.Rows) ([]*domain.EnrichmentPrompt, error) {
+	prompts := make([]*domain.EnrichmentPrompt, 0)
+	for rows.Next() {
+		prompt, err := scanEnrichmentPrompt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan prompt: %w", err)
+		}
+		prompts = append(prompts, prompt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating prompts: %w", err)
+	}
+
+	return prompts, nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// ExploitRepository implements repository.ExploitRepository for PostgreSQL
+type ExploitRepository struct {
+	db *DB
+}
+
+// NewExploitRepository creates a new PostgreSQL exploit repository
+func NewExploitRepository(db *DB) *ExploitRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ExploitRepository{db: db}
+}
+
+// Replace upserts a source's exploits and removes its older ones, in one transaction
+func (r *ExploitRepository) Replace(ctx context.Context, source domain.ExploitSource, cveIDs []string, exploits []*domain.PublicExploit, syncedAt time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, e := range exploits {
+		batch.Queue(`
+			INSERT INTO cve_exploits (cve_id, source, url, title, published_at, synced_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (cve_id, source, url) DO UPDATE SET
+				title = EXCLUDED.title,
+				published_at = EXCLUDED.published_at,
+				synced_at = EXCLUDED.synced_at
+		`, e.CVEID, source, e.URL, e.Title, e.PublishedAt, syncedAt)
+	}
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return 0, fmt.Errorf("failed to upsert exploits: %w", err)
+		}
+	}
+
+	query := `DELETE FROM cve_exploits WHERE source = $1 AND synced_at < $2`
+	args := []interface{}{source, syncedAt}
+	if cveIDs != nil {
+		query += ` AND cve_id = ANY($3)`
+		args = append(args, cveIDs)
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove delisted exploits: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit exploits: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// GetByCVEs returns the exploits for any of the CVE IDs
+func (r *ExploitRepository) GetByCVEs(ctx context.Context, cveIDs []string) ([]*domain.PublicExploit, error) {
+	query := `
+		SELECT cve_id, source, url, title, published_at, synced_at
+		FROM cve_exploits
+		WHERE cve_id = ANY($1)
+		ORDER BY cve_id ASC, published_at DESC NULLS LAST, source ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, cveIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exploits: %w", err)
+	}
+	defer rows.Close()
+
+	exploits := make([]*domain.PublicExploit, 0)
+	for rows.Next() {
+		var e domain.PublicExploit
+		if err := rows.Scan(&e.CVEID, &e.Source, &e.URL, &e.Title, &e.PublishedAt, &e.SyncedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan exploit: %w", err)
+		}
+		exploits = append(exploits, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating exploits: %w", err)
+	}
+
+	return exploits, nil
+}
+
+// ListArticleCVEs returns up to limit distinct CVEs mentioned by articles published since, newest first
+func (r *ExploitRepository) ListArticleCVEs(ctx context.Context, since time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT UPPER(c.cve) AS cve
+		FROM articles a, UNNEST(a.cves) AS c(cve)
+		WHERE a.is_published = true AND a.published_at >= $1 AND c.cve <> ''
+		GROUP BY UPPER(c.cve)
+		ORDER BY MAX(a.published_at) DESC, cve DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article CVEs: %w", err)
+	}
+	defer rows.Close()
+
+	cveIDs := make([]string, 0)
+	for rows.Next() {
+		var cveID string
+		if err := rows.Scan(&cveID); err != nil {
+			return nil, fmt.Errorf("failed to scan article CVE: %w", err)
+		}
+		cveIDs = append(cveIDs, cveID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article CVEs: %w", err)
+	}
+
+	return cveIDs, nil
+}
+
+// Status describes the local copy of each exploit source that has been synced
+func (r *ExploitRepository) Status(ctx context.Context) ([]*domain.ExploitSourceStatus, error) {
+	query := `
+		SELECT source, COUNT(*), COUNT(DISTINCT cve_id), MAX(synced_at)
+		FROM cve_exploits
+		GROUP BY source
+		ORDER BY source
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exploit source status: %w", err)
+	}
+	defer rows.Close()
+
+	statuses := make([]*domain.ExploitSourceStatus, 0)
+	for rows.Next() {
+		var status domain.ExploitSourceStatus
+		if err := rows.Scan(&status.Source, &status.Count, &status.CVECount, &status.LastSyncedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan exploit source status: %w", err)
+		}
+		statuses = append(statuses, &status)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating exploit source status: %w", err)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const featuredArticleColumns = `article_id, position, feature_from, feature_until, created_by, created_at, updated_at`
+
+// FeaturedArticleRepository implements repository.FeaturedArticleRepository for PostgreSQL
+type FeaturedArticleRepository struct {
+	db *DB
+}
+
+// NewFeaturedArticleRepository creates a new PostgreSQL featured article repository
+func NewFeaturedArticleRepository(db *DB) *FeaturedArticleRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &FeaturedArticleRepository{db: db}
+}
+
+// Upsert features an article, or updates the position and schedule of a featured article
+func (r *FeaturedArticleRepository) Upsert(ctx context.Context, featured *domain.FeaturedArticle) error {
+	if featured == nil {
+		return fmt.Errorf("featured article cannot be nil")
+	}
+
+	if err := featured.Validate(); err != nil {
+		return fmt.Errorf("invalid featured article: %w", err)
+	}
+
+	query := `
+		INSERT INTO featured_articles (article_id, position, feature_from, feature_until, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (article_id) DO UPDATE SET
+			position = EXCLUDED.position,
+			feature_from = EXCLUDED.feature_from,
+			feature_until = EXCLUDED.feature_until,
+			updated_at = NOW()
+		RETURNING created_by, created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		featured.ArticleID,
+		featured.Position,
+		featured.FeatureFrom,
+		featured.FeatureUntil,
+		featured.CreatedBy,
+	).Scan(&featured.CreatedBy, &featured.CreatedAt, &featured.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       featured.ArticleID.String(),
+			}
+		}
+		return fmt.Errorf("failed to upsert featured article: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes an article from the featured set
+func (r *FeaturedArticleRepository) Delete(ctx context.Context, articleID uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM featured_articles WHERE article_id = $1`, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete featured article: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "featured article",
+			ID:       articleID.String(),
+		}
+	}
+
+	return nil
+}
+
+// List returns the whole featured set, including scheduled and expired entries
+func (r *FeaturedArticleRepository) List(ctx context.Context) ([]*domain.FeaturedArticle, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM featured_articles
+		ORDER BY position ASC, created_at ASC
+	`, featuredArticleColumns)
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list featured articles: %w", err)
+	}
+	defer rows.Close()
+
+	return scanFeaturedArticles(rows)
+}
+
+// ListActive returns published featured articles whose schedule includes the given time
+func (r *FeaturedArticleRepository) ListActive(ctx context.Context, at time.Time, limit int) ([]*domain.FeaturedArticle, error) {
+	query := `
+		SELECT f.article_id, f.position, f.feature_from, f.feature_until, f.created_by, f.created_at, f.updated_at
+		FROM featured_articles f
+		JOIN articles a ON a.id = f.article_id
+		WHERE a.is_published = true
+			AND (f.feature_from IS NULL OR f.feature_from <= $1)
+			AND (f.feature_until IS NULL OR f.feature_until > $1)
+		ORDER BY f.position ASC, f.created_at ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, at, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active featured articles: %w", err)
+	}
+	defer rows.Close()
+
+	return scanFeaturedArticles(rows)
+}
+
+// Reorder assigns positions in the given order within a transaction
+func (r *FeaturedArticleRepository) Reorder(ctx context.Context, articleIDs []uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for position, articleID := range articleIDs {
+		result, err := tx.Exec(ctx,
+			`UPDATE featured_articles SET position = $2, updated_at = NOW() WHERE article_id = $1`,
+			articleID, position,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to reorder featured articles: %w", err)
+		}
+
+		if result.RowsAffected() == 0 {
+			return &domainerrors.NotFoundError{
+				Resource: "featured article",
+				ID:       articleID.String(),
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit featured article order: %w", err)
+	}
+
+	return nil
+}
+
+// Count returns the size of the featured set
+func (r *FeaturedArticleRepository) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM featured_articles`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count featured articles: %w", err)
+	}
+	return count, nil
+}
+
+// scanFeaturedArticles scans featured article rows
+func scanFeaturedArticles(rows pgx.Rows) ([]*domain.FeaturedArticle, error) {
+	featured := make([]*domain.FeaturedArticle, 0)
+	for rows.Next() {
+		entry := &domain.FeaturedArticle{}
+		if err := rows.Scan(
+			&entry.ArticleID,
+			&entry.Position,
+			&entry.FeatureFrom,
+			&entry.FeatureUntil,
+			&entry.CreatedBy,
+			&entry.CreatedAt,
+			&entry.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan featured article: %w", err)
+		}
+		featured = append(featured, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating featured articles: %w", err)
+	}
+
+	return featured, nil
+}
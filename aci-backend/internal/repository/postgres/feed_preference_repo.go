@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// FeedPreferenceRepository implements repository.FeedPreferenceRepository for PostgreSQL
+// Feed preferences share the user_preferences row with the legacy notification settings
+type FeedPreferenceRepository struct {
+	db *DB
+}
+
+// NewFeedPreferenceRepository creates a new PostgreSQL feed preference repository
+func NewFeedPreferenceRepository(db *DB) *FeedPreferenceRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &FeedPreferenceRepository{db: db}
+}
+
+// Get returns a user's saved feed preferences
+func (r *FeedPreferenceRepository) Get(ctx context.Context, userID uuid.UUID) (*domain.FeedPreferences, error) {
+	query := `
+		SELECT user_id, COALESCE(preferred_categories, '{}'), preferred_vendors,
+			COALESCE(min_severity, ''), excluded_tags, updated_at
+		FROM user_preferences
+		WHERE user_id = $1
+	`
+
+	prefs := &domain.FeedPreferences{}
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+		&prefs.UserID,
+		&prefs.PreferredCategories,
+		&prefs.PreferredVendors,
+		&prefs.MinSeverity,
+		&prefs.ExcludedTags,
+		&prefs.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "feed preferences",
+				ID:       userID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get feed preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// Upsert creates or replaces a user's feed preferences, leaving notification settings untouched
+func (r *FeedPreferenceRepository) Upsert(ctx context.Context, prefs *domain.FeedPreferences) error {
+	if prefs == nil {
+		return fmt.Errorf("feed preferences cannot be nil")
+	}
+
+	query := `
+		INSERT INTO user_preferences (user_id, preferred_categories, preferred_vendors, min_severity, excluded_tags)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+		ON CONFLICT (user_id) DO UPDATE SET
+			preferred_categories = EXCLUDED.preferred_categories,
+			preferred_vendors = EXCLUDED.preferred_vendors,
+			min_severity = EXCLUDED.min_severity,
+			excluded_tags = EXCLUDED.excluded_tags
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(
+		ctx,
+		query,
+		prefs.UserID,
+		prefs.PreferredCategories,
+		prefs.PreferredVendors,
+		string(prefs.MinSeverity),
+		prefs.ExcludedTags,
+	).Scan(&prefs.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert feed preferences: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// IncidentRepository implements repository.IncidentRepository for PostgreSQL
+type IncidentRepository struct {
+	db *DB
+}
+
+// NewIncidentRepository creates a new PostgreSQL incident repository
+func NewIncidentRepository(db *DB) *IncidentRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &IncidentRepository{db: db}
+}
+
+// incidentColumns are the incident columns followed by the statistics over its published articles
+const incidentColumns = `
+	i.id, i.title, i.summary, i.status, i.created_by, i.created_at, i.updated_at,
+	COUNT(a.id), MIN(a.published_at), MAX(a.published_at)
+`
+
+// incidentJoins join each incident to its published articles
+const incidentJoins = `
+	FROM incidents i
+	LEFT JOIN incident_articles ia ON ia.incident_id = i.id
+	LEFT JOIN articles a ON a.id = ia.article_id AND a.is_published = true
+`
+
+// Create inserts a new incident
+func (r *IncidentRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	if incident == nil {
+		return fmt.Errorf("incident cannot be nil")
+	}
+
+	if incident.ID == uuid.Nil {
+		return fmt.Errorf("incident ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO incidents (id, title, summary, status, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		incident.ID,
+		incident.Title,
+		incident.Summary,
+		incident.Status,
+		incident.CreatedBy,
+		incident.CreatedAt,
+		incident.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an incident with its article statistics
+func (r *IncidentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Incident, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("incident ID cannot be nil")
+	}
+
+	query := `SELECT ` + incidentColumns + incidentJoins + `
+		WHERE i.id = $1
+		GROUP BY i.id
+	`
+
+	incident, err := scanIncident(r.db.read(ctx).QueryRow(ctx, query, id), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "incident",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	return incident, nil
+}
+
+// List returns incidents matching the filter, most recently updated first
+func (r *IncidentRepository) List(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, int, error) {
+	if filter == nil {
+		filter = domain.NewIncidentFilter()
+	}
+
+	where := "1=1"
+	args := []interface{}{}
+
+	if filter.Status != nil {
+		where = "i.status = $1"
+		args = append(args, *filter.Status)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER ()
+		%s
+		WHERE %s
+		GROUP BY i.id
+		ORDER BY GREATEST(i.updated_at, MAX(a.published_at)) DESC, i.created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, incidentColumns, incidentJoins, where, len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list incidents: %w", err)
+	}
+	defer rows.Close()
+
+	incidents := make([]*domain.Incident, 0)
+	total := 0
+
+	for rows.Next() {
+		incident, err := scanIncident(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, incident)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating incidents: %w", err)
+	}
+
+	return incidents, total, nil
+}
+
+// Update saves an incident's title, summary and status
+func (r *IncidentRepository) Update(ctx context.Context, incident *domain.Incident) error {
+	if incident == nil {
+		return fmt.Errorf("incident cannot be nil")
+	}
+
+	query := `
+		UPDATE incidents
+		SET title = $2, summary = $3, status = $4
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		incident.ID,
+		incident.Title,
+		incident.Summary,
+		incident.Status,
+	).Scan(&incident.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domainerrors.NotFoundError{
+			Resource: "incident",
+			ID:       incident.ID.String(),
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update incident: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes an incident and its article links
+func (r *IncidentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM incidents WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete incident: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "incident",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// AddArticle links an article to an incident (idempotent)
+// Linking touches the incident so it moves up the list
+func (r *IncidentRepository) AddArticle(ctx context.Context, incidentID, articleID, addedBy uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO incident_articles (incident_id, article_id, added_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (incident_id, article_id) DO NOTHING
+	`
+
+	if _, err := tx.Exec(ctx, query, incidentID, articleID, addedBy); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			switch pgErr.ConstraintName {
+			case "fk_incident_articles_article":
+				return &domainerrors.NotFoundError{Resource: "article", ID: articleID.String()}
+			case "fk_incident_articles_incident":
+				return &domainerrors.NotFoundError{Resource: "incident", ID: incidentID.String()}
+			}
+		}
+		return fmt.Errorf("failed to add article to incident: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE incidents SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`, incidentID); err != nil {
+		return fmt.Errorf("failed to touch incident: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit incident article: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveArticle unlinks an article from an incident
+func (r *IncidentRepository) RemoveArticle(ctx context.Context, incidentID, articleID uuid.UUID) error {
+	query := `DELETE FROM incident_articles WHERE incident_id = $1 AND article_id = $2`
+
+	result, err := r.db.Pool.Exec(ctx, query, incidentID, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to remove article from incident: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "incident article",
+			ID:       articleID.String(),
+		}
+	}
+
+	return nil
+}
+
+// scanIncident scans incidentColumns, followed by the total row count when total is set
+func scanIncident(row pgx.Row, total *int) (*domain.Incident, error) {
+	incident := &domain.Incident{}
+	dest := []interface{}{
+		&incident.ID, &incident.Title, &incident.Summary, &incident.Status, &incident.CreatedBy,
+		&incident.CreatedAt, &incident.UpdatedAt,
+		&incident.ArticleCount, &incident.FirstPublishedAt, &incident.LastPublishedAt,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ingestStageColumns maps pipeline stages to their timestamp columns
+var ingestStageColumns = map[domain.IngestStage]string{
+	domain.IngestStageReceived:  "received_at",
+	domain.IngestStageValidated: "validated_at",
+	domain.IngestStagePersisted: "persisted_at",
+	domain.IngestStageEnriched:  "enriched_at",
+	domain.IngestStageNotified:  "notified_at",
+}
+
+// IngestLatencyRepository implements repository.IngestLatencyRepository for PostgreSQL
+type IngestLatencyRepository struct {
+	db *DB
+}
+
+// NewIngestLatencyRepository creates a new PostgreSQL ingest latency repository
+func NewIngestLatencyRepository(db *DB) *IngestLatencyRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &IngestLatencyRepository{db: db}
+}
+
+// RecordStages upserts stage timestamps for an article
+// Existing timestamps are kept so retried stages do not hide the original latency
+func (r *IngestLatencyRepository) RecordStages(ctx context.Context, articleID uuid.UUID, stages map[domain.IngestStage]time.Time) (*domain.IngestTiming, error) {
+	if articleID == uuid.Nil {
+		return nil, fmt.Errorf("article ID cannot be nil")
+	}
+
+	if len(stages) == 0 {
+		return nil, fmt.Errorf("at least one stage is required")
+	}
+
+	columns := []string{"article_id"}
+	placeholders := []string{"$1"}
+	updates := make([]string, 0, len(stages))
+	args := []interface{}{articleID}
+
+	// Iterate in pipeline order for a stable query shape
+	for _, stage := range domain.IngestStages() {
+		at, ok := stages[stage]
+		if !ok {
+			continue
+		}
+
+		column := ingestStageColumns[stage]
+		args = append(args, at)
+		columns = append(columns, column)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		updates = append(updates, fmt.Sprintf("%s = COALESCE(article_ingest_timings.%s, EXCLUDED.%s)", column, column, column))
+	}
+
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("no valid stages provided")
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO article_ingest_timings (%s)
+		VALUES (%s)
+		ON CONFLICT (article_id) DO UPDATE SET %s
+		RETURNING article_id, received_at, validated_at, persisted_at, enriched_at, notified_at
+	`, strings.Join(columns, ", "), strings.Join(placeholders, ", "), strings.Join(updates, ", "))
+
+	timing, err := scanIngestTiming(r.db.Pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record ingest stages: %w", err)
+	}
+
+	return timing, nil
+}
+
+// GetByArticleID retrieves stage timings for an article
+func (r *IngestLatencyRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.IngestTiming, error) {
+	query := `
+		SELECT article_id, received_at, validated_at, persisted_at, enriched_at, notified_at
+		FROM article_ingest_timings
+		WHERE article_id = $1
+	`
+
+	timing, err := scanIngestTiming(r.db.Pool.QueryRow(ctx, query, articleID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "ingest timing",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get ingest timing: %w", err)
+	}
+
+	return timing, nil
+}
+
+// GetStats computes p50/p95 end-to-end and per-stage latency for articles notified since the given time
+// Each stage latency is measured from the previous recorded stage; enrichment is optional
+func (r *IngestLatencyRepository) GetStats(ctx context.Context, since time.Time, budget time.Duration) (*domain.IngestLatencyStats, error) {
+	query := `
+		WITH latencies AS (
+			SELECT
+				EXTRACT(EPOCH FROM (notified_at - received_at)) * 1000 AS end_to_end,
+				EXTRACT(EPOCH FROM (validated_at - received_at)) * 1000 AS validated,
+				EXTRACT(EPOCH FROM (persisted_at - validated_at)) * 1000 AS persisted,
+				EXTRACT(EPOCH FROM (enriched_at - persisted_at)) * 1000 AS enriched,
+				EXTRACT(EPOCH FROM (notified_at - COALESCE(enriched_at, persisted_at))) * 1000 AS notified
+			FROM article_ingest_timings
+			WHERE notified_at >= $1 AND received_at IS NOT NULL
+		)
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE end_to_end > $2),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY end_to_end), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY end_to_end), 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY validated), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY validated), 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY persisted), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY persisted), 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY enriched), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY enriched), 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY notified), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY notified), 0)
+		FROM latencies
+	`
+
+	var (
+		validated, persisted, enriched, notified domain.LatencyPercentiles
+		stats                                    = &domain.IngestLatencyStats{}
+	)
+
+	err := r.db.Pool.QueryRow(ctx, query, since, float64(budget.Milliseconds())).Scan(
+		&stats.SampleCount,
+		&stats.BreachedCount,
+		&stats.EndToEnd.P50Ms,
+		&stats.EndToEnd.P95Ms,
+		&validated.P50Ms,
+		&validated.P95Ms,
+		&persisted.P50Ms,
+		&persisted.P95Ms,
+		&enriched.P50Ms,
+		&enriched.P95Ms,
+		&notified.P50Ms,
+		&notified.P95Ms,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingest latency stats: %w", err)
+	}
+
+	stats.Stages = map[domain.IngestStage]domain.LatencyPercentiles{
+		domain.IngestStageValidated: validated,
+		domain.IngestStagePersisted: persisted,
+		domain.IngestStageEnriched:  enriched,
+		domain.IngestStageNotified:  notified,
+	}
+
+	return stats, nil
+}
+
+// scanIngestTiming scans a single ingest timing row
+func scanIngestTiming(row pgx.Row) (*domain.IngestTiming, error) {
+	timing := &domain.IngestTiming{}
+
+	err := row.Scan(
+		&timing.ArticleID,
+		&timing.ReceivedAt,
+		&timing.ValidatedAt,
+		&timing.PersistedAt,
+		&timing.EnrichedAt,
+		&timing.NotifiedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return timing, nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// IOCRepository implements repository.IOCRepository for PostgreSQL
+type IOCRepository struct {
+	db *DB
+}
+
+// NewIOCRepository creates a new PostgreSQL IOC repository
+func NewIOCRepository(db *DB) *IOCRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &IOCRepository{db: db}
+}
+
+// ReplaceForArticle links an article to exactly the given IOCs, creating indicators as needed
+func (r *IOCRepository) ReplaceForArticle(ctx context.Context, articleID uuid.UUID, iocs []domain.IOC) error {
+	if articleID == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM article_iocs WHERE article_id = $1`, articleID); err != nil {
+		return fmt.Errorf("failed to clear article iocs: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for _, ioc := range iocs {
+		if !ioc.IsValid() {
+			continue
+		}
+
+		// The no-op update makes RETURNING yield the id of an existing indicator
+		batch.Queue(`
+			WITH indicator AS (
+				INSERT INTO iocs (type, value)
+				VALUES ($1, $2)
+				ON CONFLICT (type, value) DO UPDATE SET type = EXCLUDED.type
+				RETURNING id
+			)
+			INSERT INTO article_iocs (article_id, ioc_id, context, source)
+			SELECT $3, id, NULLIF($4, ''), NULLIF($5, '') FROM indicator
+			ON CONFLICT (article_id, ioc_id) DO NOTHING
+		`, ioc.Type, ioc.Value, articleID, ioc.Context, ioc.Source)
+	}
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to link article iocs: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit article iocs: %w", err)
+	}
+
+	return nil
+}
+
+// List returns indicators matching the filter, most recently seen first
+func (r *IOCRepository) List(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, int, error) {
+	if filter == nil {
+		filter = domain.NewIndicatorFilter()
+	}
+
+	where := []string{"1=1"}
+	having := []string{"1=1"}
+	args := []interface{}{}
+	argCount := 0
+
+	if filter.Type != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("i.type = $%d", argCount))
+		args = append(args, *filter.Type)
+	}
+
+	if filter.ValuePrefix != nil && *filter.ValuePrefix != "" {
+		argCount++
+		where = append(where, fmt.Sprintf(`i.value LIKE $%d ESCAPE '\'`, argCount))
+		args = append(args, escapeLikePattern(*filter.ValuePrefix)+"%")
+	}
+
+	if filter.ExcludeFlagged {
+		where = append(where, `NOT EXISTS (
+			SELECT 1 FROM enrichment_feedback f
+			WHERE f.field = 'ioc' AND f.ioc_type = i.type AND f.ioc_value = i.value
+		)`)
+	}
+
+	if filter.Severity != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("a.severity = $%d", argCount))
+		args = append(args, *filter.Severity)
+	}
+
+	if filter.FirstSeenFrom != nil {
+		argCount++
+		having = append(having, fmt.Sprintf("MIN(a.published_at) >= $%d", argCount))
+		args = append(args, *filter.FirstSeenFrom)
+	}
+
+	if filter.FirstSeenTo != nil {
+		argCount++
+		having = append(having, fmt.Sprintf("MIN(a.published_at) <= $%d", argCount))
+		args = append(args, *filter.FirstSeenTo)
+	}
+
+	if filter.LastSeenFrom != nil {
+		argCount++
+		having = append(having, fmt.Sprintf("MAX(a.published_at) >= $%d", argCount))
+		args = append(args, *filter.LastSeenFrom)
+	}
+
+	if filter.LastSeenTo != nil {
+		argCount++
+		having = append(having, fmt.Sprintf("MAX(a.published_at) <= $%d", argCount))
+		args = append(args, *filter.LastSeenTo)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			i.id, i.type, i.value,
+			MIN(a.published_at), MAX(a.published_at), COUNT(*),
+			(ARRAY['informational', 'low', 'medium', 'high', 'critical'])[MAX(
+				CASE a.severity
+					WHEN 'critical' THEN 5
+					WHEN 'high' THEN 4
+					WHEN 'medium' THEN 3
+					WHEN 'low' THEN 2
+					ELSE 1
+				END
+			)],
+			COUNT(*) OVER ()
+		FROM iocs i
+		JOIN article_iocs ai ON ai.ioc_id = i.id
+		JOIN articles a ON a.id = ai.article_id
+		WHERE %s
+		GROUP BY i.id
+		HAVING %s
+		ORDER BY MAX(a.published_at) DESC, i.value ASC
+		LIMIT $%d OFFSET $%d
+	`, strings.Join(where, " AND "), strings.Join(having, " AND "), argCount+1, argCount+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list iocs: %w", err)
+	}
+	defer rows.Close()
+
+	indicators := make([]*domain.Indicator, 0)
+	total := 0
+
+	for rows.Next() {
+		indicator := &domain.Indicator{}
+		var severity string
+
+		if err := rows.Scan(
+			&indicator.ID,
+			&indicator.Type,
+			&indicator.Value,
+			&indicator.FirstSeen,
+			&indicator.LastSeen,
+			&indicator.ArticleCount,
+			&severity,
+			&total,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan ioc: %w", err)
+		}
+
+		indicator.MaxSeverity = domain.Severity(severity)
+		indicators = append(indicators, indicator)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating iocs: %w", err)
+	}
+
+	return indicators, total, nil
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// KEVRepository implements repository.KEVRepository for PostgreSQL
+type KEVRepository struct {
+	db *DB
+}
+
+// NewKEVRepository creates a new PostgreSQL KEV repository
+func NewKEVRepository(db *DB) *KEVRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &KEVRepository{db: db}
+}
+
+// ReplaceAll upserts the catalog and removes entries not in it, in one transaction
+func (r *KEVRepository) ReplaceAll(ctx context.Context, vulnerabilities []*domain.KEVVulnerability, syncedAt time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, v := range vulnerabilities {
+		batch.Queue(`
+			INSERT INTO kev_vulnerabilities (
+				cve_id, vendor_project, product, vulnerability_name, short_description,
+				required_action, date_added, due_date, known_ransomware_use, notes, synced_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (cve_id) DO UPDATE SET
+				vendor_project = EXCLUDED.vendor_project,
+				product = EXCLUDED.product,
+				vulnerability_name = EXCLUDED.vulnerability_name,
+				short_description = EXCLUDED.short_description,
+				required_action = EXCLUDED.required_action,
+				date_added = EXCLUDED.date_added,
+				due_date = EXCLUDED.due_date,
+				known_ransomware_use = EXCLUDED.known_ransomware_use,
+				notes = EXCLUDED.notes,
+				synced_at = EXCLUDED.synced_at
+		`, v.CVEID, v.VendorProject, v.Product, v.VulnerabilityName, v.ShortDescription,
+			v.RequiredAction, v.DateAdded, v.DueDate, v.KnownRansomwareUse, v.Notes, syncedAt)
+	}
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return 0, fmt.Errorf("failed to upsert KEV vulnerabilities: %w", err)
+		}
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM kev_vulnerabilities WHERE synced_at < $1`, syncedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove delisted KEV vulnerabilities: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit KEV vulnerabilities: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// GetByCVEs returns the catalog entries for any of the CVE IDs
+func (r *KEVRepository) GetByCVEs(ctx context.Context, cveIDs []string) ([]*domain.KEVVulnerability, error) {
+	query := `
+		SELECT cve_id, vendor_project, product, vulnerability_name, short_description,
+			required_action, date_added, due_date, known_ransomware_use, notes, synced_at
+		FROM kev_vulnerabilities
+		WHERE cve_id = ANY($1)
+		ORDER BY due_date ASC NULLS LAST, cve_id ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, cveIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KEV vulnerabilities: %w", err)
+	}
+	defer rows.Close()
+
+	vulnerabilities := make([]*domain.KEVVulnerability, 0)
+	for rows.Next() {
+		var v domain.KEVVulnerability
+		if err := rows.Scan(
+			&v.CVEID,
+			&v.VendorProject,
+			&v.Product,
+			&v.VulnerabilityName,
+			&v.ShortDescription,
+			&v.RequiredAction,
+			&v.DateAdded,
+			&v.DueDate,
+			&v.KnownRansomwareUse,
+			&v.Notes,
+			&v.SyncedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan KEV vulnerability: %w", err)
+		}
+		vulnerabilities = append(vulnerabilities, &v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating KEV vulnerabilities: %w", err)
+	}
+
+	return vulnerabilities, nil
+}
+
+// Status describes the local copy of the catalog
+func (r *KEVRepository) Status(ctx context.Context) (*domain.KEVCatalogStatus, error) {
+	query := `SELECT COUNT(*), MAX(date_added), MAX(synced_at) FROM kev_vulnerabilities`
+
+	status := &domain.KEVCatalogStatus{}
+	if err := r.db.Pool.QueryRow(ctx, query).Scan(&status.Count, &status.LatestDateAdded, &status.LastSyncedAt); err != nil {
+		return nil, fmt.Errorf("failed to get KEV catalog status: %w", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,846 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// NewsletterRepository implements repository.NewsletterRepository for PostgreSQL
+type NewsletterRepository struct {
+	db *DB
+}
+
+// NewNewsletterRepository creates a new PostgreSQL newsletter repository
+func NewNewsletterRepository(db *DB) *NewsletterRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &NewsletterRepository{db: db}
+}
+
+// subscriberColumns are the subscriber columns followed by whether the address is suppressed
+const subscriberColumns = `
+	ns.id, ns.email, ns.name, ns.status, ns.consent_source, ns.subscribed_at, ns.unsubscribed_at,
+	ns.confirmation_sent_at, ns.confirmed_at, ns.created_at, ns.updated_at,
+	EXISTS (SELECT 1 FROM newsletter_suppressions sup WHERE LOWER(sup.email) = LOWER(ns.email))
+`
+
+// UpsertSubscriber adds a subscriber, or re-subscribes an existing address
+// A re-subscription records the new consent source and keeps the name unless a new one is given
+func (r *NewsletterRepository) UpsertSubscriber(ctx context.Context, subscriber *domain.NewsletterSubscriber) error {
+	if subscriber == nil {
+		return fmt.Errorf("subscriber cannot be nil")
+	}
+
+	query := `
+		WITH ns AS (
+			INSERT INTO newsletter_subscribers (id, email, name, status, consent_source, subscribed_at, created_at, updated_at)
+			VALUES ($1, $2, $3, 'subscribed', $4, $5, $5, $5)
+			ON CONFLICT ((LOWER(email))) DO UPDATE SET
+				name = COALESCE(EXCLUDED.name, newsletter_subscribers.name),
+				status = 'subscribed',
+				consent_source = EXCLUDED.consent_source,
+				subscribed_at = CASE
+					WHEN newsletter_subscribers.status = 'subscribed' THEN newsletter_subscribers.subscribed_at
+					ELSE EXCLUDED.subscribed_at
+				END,
+				unsubscribed_at = NULL
+			RETURNING *
+		)
+		SELECT ` + subscriberColumns + ` FROM ns
+	`
+
+	updated, err := scanSubscriber(r.db.Pool.QueryRow(ctx, query,
+		subscriber.ID,
+		subscriber.Email,
+		subscriber.Name,
+		subscriber.ConsentSource,
+		subscriber.SubscribedAt,
+	), nil)
+	if err != nil {
+		return fmt.Errorf("failed to upsert newsletter subscriber: %w", err)
+	}
+
+	*subscriber = *updated
+	return nil
+}
+
+// UpsertPendingSubscriber records a public sign-up
+// A new or unsubscribed address becomes pending with the given consent source; a pending or
+// subscribed address is left as it is apart from the name. The stored subscriber is returned
+func (r *NewsletterRepository) UpsertPendingSubscriber(ctx context.Context, subscriber *domain.NewsletterSubscriber) (*domain.NewsletterSubscriber, error) {
+	if subscriber == nil {
+		return nil, fmt.Errorf("subscriber cannot be nil")
+	}
+
+	query := `
+		WITH ns AS (
+			INSERT INTO newsletter_subscribers (id, email, name, status, consent_source, subscribed_at, created_at, updated_at)
+			VALUES ($1, $2, $3, 'pending', $4, $5, $5, $5)
+			ON CONFLICT ((LOWER(email))) DO UPDATE SET
+				name = COALESCE(EXCLUDED.name, newsletter_subscribers.name),
+				status = CASE
+					WHEN newsletter_subscribers.status = 'unsubscribed' THEN 'pending'
+					ELSE newsletter_subscribers.status
+				END,
+				consent_source = CASE
+					WHEN newsletter_subscribers.status = 'unsubscribed' THEN EXCLUDED.consent_source
+					ELSE newsletter_subscribers.consent_source
+				END
+			RETURNING *
+		)
+		SELECT ` + subscriberColumns + ` FROM ns
+	`
+
+	stored, err := scanSubscriber(r.db.Pool.QueryRow(ctx, query,
+		subscriber.ID,
+		subscriber.Email,
+		subscriber.Name,
+		subscriber.ConsentSource,
+		subscriber.SubscribedAt,
+	), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert pending newsletter subscriber: %w", err)
+	}
+
+	return stored, nil
+}
+
+// GetSubscriber retrieves a subscriber
+func (r *NewsletterRepository) GetSubscriber(ctx context.Context, id uuid.UUID) (*domain.NewsletterSubscriber, error) {
+	query := `SELECT ` + subscriberColumns + ` FROM newsletter_subscribers ns WHERE ns.id = $1`
+
+	subscriber, err := scanSubscriber(r.db.Pool.QueryRow(ctx, query, id), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "newsletter subscriber",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter subscriber: %w", err)
+	}
+
+	return subscriber, nil
+}
+
+// MarkConfirmationSent records when a confirmation email was sent to a pending subscriber
+func (r *NewsletterRepository) MarkConfirmationSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if _, err := r.db.Pool.Exec(ctx, `UPDATE newsletter_subscribers SET confirmation_sent_at = $2 WHERE id = $1`, id, at); err != nil {
+		return fmt.Errorf("failed to mark newsletter confirmation sent: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmSubscriber subscribes a pending subscriber
+func (r *NewsletterRepository) ConfirmSubscriber(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE newsletter_subscribers
+		SET status = 'subscribed', confirmed_at = $2, subscribed_at = $2, unsubscribed_at = NULL
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to confirm newsletter subscriber: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "pending newsletter subscriber",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListSubscribers returns subscribers matching the filter, newest first
+func (r *NewsletterRepository) ListSubscribers(ctx context.Context, filter *domain.SubscriberFilter) ([]*domain.NewsletterSubscriber, int, error) {
+	if filter == nil {
+		filter = &domain.SubscriberFilter{Page: 1, PageSize: 20}
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where = append(where, fmt.Sprintf("ns.status = $%d", len(args)))
+	}
+
+	if filter.Search != nil && *filter.Search != "" {
+		args = append(args, "%"+*filter.Search+"%")
+		where = append(where, fmt.Sprintf("(ns.email ILIKE $%d OR ns.name ILIKE $%d)", len(args), len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER ()
+		FROM newsletter_subscribers ns
+		WHERE %s
+		ORDER BY ns.created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, subscriberColumns, strings.Join(where, " AND "), len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list newsletter subscribers: %w", err)
+	}
+	defer rows.Close()
+
+	subscribers := make([]*domain.NewsletterSubscriber, 0)
+	total := 0
+
+	for rows.Next() {
+		subscriber, err := scanSubscriber(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan newsletter subscriber: %w", err)
+		}
+		subscribers = append(subscribers, subscriber)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating newsletter subscribers: %w", err)
+	}
+
+	return subscribers, total, nil
+}
+
+// EachSubscriber calls fn for every subscriber with the given status (every subscriber when nil), oldest first
+// Rows are streamed so large exports are not held in memory; as streaming is paced by fn,
+// the query is exempt from the default query timeout
+func (r *NewsletterRepository) EachSubscriber(ctx context.Context, status *domain.SubscriberStatus, fn func(*domain.NewsletterSubscriber) error) error {
+	if fn == nil {
+		return fmt.Errorf("fn cannot be nil")
+	}
+
+	ctx = withQueryTimeout(ctx, 0)
+
+	query := `
+		SELECT ` + subscriberColumns + `
+		FROM newsletter_subscribers ns
+		WHERE $1::text IS NULL OR ns.status = $1
+		ORDER BY ns.created_at ASC, ns.id ASC
+	`
+
+	var statusArg *string
+	if status != nil {
+		value := string(*status)
+		statusArg = &value
+	}
+
+	rows, err := r.db.Pool.Query(ctx, query, statusArg)
+	if err != nil {
+		return fmt.Errorf("failed to query newsletter subscribers for export: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		subscriber, err := scanSubscriber(rows, nil)
+		if err != nil {
+			return fmt.Errorf("failed to scan newsletter subscriber: %w", err)
+		}
+
+		if err := fn(subscriber); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating newsletter subscribers: %w", err)
+	}
+
+	return nil
+}
+
+// Unsubscribe marks a subscriber unsubscribed; the row is kept so the address is never mailed again
+func (r *NewsletterRepository) Unsubscribe(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE newsletter_subscribers
+		SET status = 'unsubscribed', unsubscribed_at = COALESCE(unsubscribed_at, CURRENT_TIMESTAMP)
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe newsletter subscriber: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter subscriber",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// AddSuppression adds an address to the suppression list
+// An address already on it keeps its original entry, which is returned in place of the new one
+func (r *NewsletterRepository) AddSuppression(ctx context.Context, suppression *domain.NewsletterSuppression) error {
+	if suppression == nil {
+		return fmt.Errorf("suppression cannot be nil")
+	}
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO newsletter_suppressions (id, email, reason, details, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT ((LOWER(email))) DO NOTHING
+			RETURNING id, email, reason, details, created_by, created_at
+		)
+		SELECT id, email, reason, details, created_by, created_at FROM inserted
+		UNION ALL
+		SELECT id, email, reason, details, created_by, created_at
+		FROM newsletter_suppressions
+		WHERE LOWER(email) = LOWER($2) AND NOT EXISTS (SELECT 1 FROM inserted)
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		suppression.ID,
+		suppression.Email,
+		suppression.Reason,
+		suppression.Details,
+		suppression.CreatedBy,
+		suppression.CreatedAt,
+	).Scan(
+		&suppression.ID,
+		&suppression.Email,
+		&suppression.Reason,
+		&suppression.Details,
+		&suppression.CreatedBy,
+		&suppression.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add newsletter suppression: %w", err)
+	}
+
+	return nil
+}
+
+// IsSuppressed reports whether an address is on the suppression list
+func (r *NewsletterRepository) IsSuppressed(ctx context.Context, email string) (bool, error) {
+	var suppressed bool
+	err := r.db.Pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM newsletter_suppressions WHERE LOWER(email) = LOWER($1))`,
+		email,
+	).Scan(&suppressed)
+	if err != nil {
+		return false, fmt.Errorf("failed to check newsletter suppression: %w", err)
+	}
+
+	return suppressed, nil
+}
+
+// ListSuppressions returns suppressed addresses, newest first
+func (r *NewsletterRepository) ListSuppressions(ctx context.Context, limit, offset int) ([]*domain.NewsletterSuppression, int, error) {
+	query := `
+		SELECT id, email, reason, details, created_by, created_at, COUNT(*) OVER ()
+		FROM newsletter_suppressions
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list newsletter suppressions: %w", err)
+	}
+	defer rows.Close()
+
+	suppressions := make([]*domain.NewsletterSuppression, 0)
+	total := 0
+
+	for rows.Next() {
+		var s domain.NewsletterSuppression
+		if err := rows.Scan(&s.ID, &s.Email, &s.Reason, &s.Details, &s.CreatedBy, &s.CreatedAt, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan newsletter suppression: %w", err)
+		}
+		suppressions = append(suppressions, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating newsletter suppressions: %w", err)
+	}
+
+	return suppressions, total, nil
+}
+
+// DeleteSuppression removes an address from the suppression list
+func (r *NewsletterRepository) DeleteSuppression(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM newsletter_suppressions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete newsletter suppression: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter suppression",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// campaignColumns are the campaign columns followed by its delivery stats
+const campaignColumns = `
+	c.id, c.subject, c.preheader, c.intro, c.template, c.article_ids, c.status,
+	c.created_by, c.sent_by, c.send_started_at, c.sent_at, c.created_at, c.updated_at,
+	COALESCE(s.recipients, 0), COALESCE(s.pending, 0), COALESCE(s.sent, 0), COALESCE(s.failed, 0),
+	COALESCE(s.opens, 0), COALESCE(s.clicks, 0), COALESCE(s.total_clicks, 0)
+`
+
+// campaignJoins aggregate each campaign's deliveries
+const campaignJoins = `
+	FROM newsletter_campaigns c
+	LEFT JOIN LATERAL (
+		SELECT
+			COUNT(*) AS recipients,
+			COUNT(*) FILTER (WHERE d.status IN ('pending', 'sending')) AS pending,
+			COUNT(*) FILTER (WHERE d.status = 'sent') AS sent,
+			COUNT(*) FILTER (WHERE d.status = 'failed') AS failed,
+			COUNT(d.opened_at) AS opens,
+			COUNT(d.clicked_at) AS clicks,
+			SUM(d.click_count) AS total_clicks
+		FROM newsletter_deliveries d
+		WHERE d.campaign_id = c.id
+	) s ON true
+`
+
+// CreateCampaign inserts a new campaign
+func (r *NewsletterRepository) CreateCampaign(ctx context.Context, campaign *domain.NewsletterCampaign) error {
+	if campaign == nil {
+		return fmt.Errorf("campaign cannot be nil")
+	}
+
+	if campaign.ID == uuid.Nil {
+		return fmt.Errorf("campaign ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO newsletter_campaigns (
+			id, subject, preheader, intro, template, article_ids, status, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		campaign.ID,
+		campaign.Subject,
+		campaign.Preheader,
+		campaign.Intro,
+		campaign.Template,
+		campaign.ArticleIDs,
+		campaign.Status,
+		campaign.CreatedBy,
+		campaign.CreatedAt,
+		campaign.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create newsletter campaign: %w", err)
+	}
+
+	return nil
+}
+
+// GetCampaign retrieves a campaign with its delivery stats
+// It reads from the primary, since a campaign's stats are watched while it sends
+func (r *NewsletterRepository) GetCampaign(ctx context.Context, id uuid.UUID) (*domain.NewsletterCampaign, error) {
+	query := `SELECT ` + campaignColumns + campaignJoins + ` WHERE c.id = $1`
+
+	campaign, err := scanCampaign(r.db.Pool.QueryRow(ctx, query, id), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "newsletter campaign",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter campaign: %w", err)
+	}
+
+	return campaign, nil
+}
+
+// ListCampaigns returns campaigns with their delivery stats, newest first
+func (r *NewsletterRepository) ListCampaigns(ctx context.Context, limit, offset int) ([]*domain.NewsletterCampaign, int, error) {
+	query := `SELECT ` + campaignColumns + `, COUNT(*) OVER ()` + campaignJoins + `
+		ORDER BY c.created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list newsletter campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns := make([]*domain.NewsletterCampaign, 0)
+	total := 0
+
+	for rows.Next() {
+		campaign, err := scanCampaign(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan newsletter campaign: %w", err)
+		}
+		campaigns = append(campaigns, campaign)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating newsletter campaigns: %w", err)
+	}
+
+	return campaigns, total, nil
+}
+
+// UpdateCampaign saves a draft's subject, preheader, intro, template and articles
+func (r *NewsletterRepository) UpdateCampaign(ctx context.Context, campaign *domain.NewsletterCampaign) error {
+	if campaign == nil {
+		return fmt.Errorf("campaign cannot be nil")
+	}
+
+	query := `
+		UPDATE newsletter_campaigns
+		SET subject = $2, preheader = $3, intro = $4, template = $5, article_ids = $6
+		WHERE id = $1 AND status = 'draft'
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		campaign.ID,
+		campaign.Subject,
+		campaign.Preheader,
+		campaign.Intro,
+		campaign.Template,
+		campaign.ArticleIDs,
+	).Scan(&campaign.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter campaign draft",
+			ID:       campaign.ID.String(),
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update newsletter campaign: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCampaign deletes a draft
+func (r *NewsletterRepository) DeleteCampaign(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM newsletter_campaigns WHERE id = $1 AND status = 'draft'`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete newsletter campaign: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter campaign draft",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// StartCampaign moves a draft to sending and queues a delivery for every current subscriber
+// whose address is not suppressed
+func (r *NewsletterRepository) StartCampaign(ctx context.Context, id, sentBy uuid.UUID, startedAt time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE newsletter_campaigns
+		SET status = 'sending', sent_by = $2, send_started_at = $3
+		WHERE id = $1 AND status = 'draft'
+	`, id, sentBy, startedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start newsletter campaign: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return 0, &domainerrors.NotFoundError{
+			Resource: "newsletter campaign draft",
+			ID:       id.String(),
+		}
+	}
+
+	result, err = tx.Exec(ctx, `
+		INSERT INTO newsletter_deliveries (campaign_id, subscriber_id, email)
+		SELECT $1, ns.id, ns.email
+		FROM newsletter_subscribers ns
+		WHERE ns.status = 'subscribed'
+		  AND NOT EXISTS (SELECT 1 FROM newsletter_suppressions sup WHERE LOWER(sup.email) = LOWER(ns.email))
+	`, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue newsletter deliveries: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit newsletter campaign start: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// CompleteCampaigns marks sending campaigns with no unsent deliveries as sent
+func (r *NewsletterRepository) CompleteCampaigns(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE newsletter_campaigns c
+		SET status = 'sent', sent_at = CURRENT_TIMESTAMP
+		WHERE c.status = 'sending'
+		  AND NOT EXISTS (
+			SELECT 1 FROM newsletter_deliveries d
+			WHERE d.campaign_id = c.id AND d.status IN ('pending', 'sending')
+		  )
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete newsletter campaigns: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ClaimDeliveries leases up to limit unsent deliveries to the caller
+// Rows locked by another sender are skipped, so several instances can send the same campaign
+func (r *NewsletterRepository) ClaimDeliveries(ctx context.Context, limit int, staleBefore time.Time) ([]*domain.NewsletterDelivery, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE newsletter_deliveries d
+		SET status = 'failed', error = 'unsubscribed before sending', claimed_at = NULL
+		FROM newsletter_subscribers s
+		WHERE s.id = d.subscriber_id
+		  AND s.status = 'unsubscribed'
+		  AND d.status = 'pending'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip unsubscribed deliveries: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE newsletter_deliveries d
+		SET status = 'failed', error = 'address suppressed before sending', claimed_at = NULL
+		FROM newsletter_suppressions sup
+		WHERE LOWER(sup.email) = LOWER(d.email)
+		  AND d.status = 'pending'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip suppressed deliveries: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE newsletter_deliveries d
+		SET status = 'sending', claimed_at = CURRENT_TIMESTAMP
+		FROM newsletter_subscribers s
+		WHERE s.id = d.subscriber_id
+		  AND d.id IN (
+			SELECT id FROM newsletter_deliveries
+			WHERE status = 'pending' OR (status = 'sending' AND claimed_at < $2)
+			ORDER BY campaign_id, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		  )
+		RETURNING d.id, d.campaign_id, d.subscriber_id, d.email, s.name
+	`, limit, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim newsletter deliveries: %w", err)
+	}
+
+	deliveries := make([]*domain.NewsletterDelivery, 0, limit)
+	for rows.Next() {
+		delivery := &domain.NewsletterDelivery{Status: domain.DeliveryStatusSending}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CampaignID,
+			&delivery.SubscriberID,
+			&delivery.Email,
+			&delivery.Name,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan newsletter delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating newsletter deliveries: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit newsletter delivery claim: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// MarkDelivery records the outcome of sending a claimed delivery
+func (r *NewsletterRepository) MarkDelivery(ctx context.Context, id uuid.UUID, status domain.DeliveryStatus, errMsg *string, at time.Time) error {
+	query := `
+		UPDATE newsletter_deliveries
+		SET status = $2,
+			error = $3,
+			claimed_at = NULL,
+			sent_at = CASE WHEN $2 = 'sent' THEN $4::timestamptz ELSE sent_at END
+		WHERE id = $1
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, id, status, errMsg, at); err != nil {
+		return fmt.Errorf("failed to mark newsletter delivery: %w", err)
+	}
+
+	return nil
+}
+
+// RecordOpen counts an open of a delivery
+func (r *NewsletterRepository) RecordOpen(ctx context.Context, deliveryID uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE newsletter_deliveries
+		SET open_count = open_count + 1, opened_at = COALESCE(opened_at, $2)
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, deliveryID, at)
+	if err != nil {
+		return fmt.Errorf("failed to record newsletter open: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter delivery",
+			ID:       deliveryID.String(),
+		}
+	}
+
+	return nil
+}
+
+// RecordClick stores a click and increments the delivery's click count in one transaction
+// A click also counts as an open, since image blocking hides many opens from the pixel
+func (r *NewsletterRepository) RecordClick(ctx context.Context, deliveryID, articleID uuid.UUID, at time.Time) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var campaignID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		UPDATE newsletter_deliveries d
+		SET click_count = d.click_count + 1,
+			clicked_at = COALESCE(d.clicked_at, $3),
+			opened_at = COALESCE(d.opened_at, $3)
+		FROM newsletter_campaigns c
+		WHERE d.id = $1 AND c.id = d.campaign_id AND $2 = ANY(c.article_ids)
+		RETURNING d.campaign_id
+	`, deliveryID, articleID, at).Scan(&campaignID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, &domainerrors.NotFoundError{
+			Resource: "newsletter link",
+			ID:       deliveryID.String() + "/" + articleID.String(),
+		}
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to record newsletter click: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO newsletter_clicks (delivery_id, article_id, clicked_at)
+		VALUES ($1, $2, $3)
+	`, deliveryID, articleID, at)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to store newsletter click: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit newsletter click: %w", err)
+	}
+
+	return campaignID, nil
+}
+
+// UnsubscribeByDelivery unsubscribes the recipient of a delivery
+func (r *NewsletterRepository) UnsubscribeByDelivery(ctx context.Context, deliveryID uuid.UUID) error {
+	query := `
+		UPDATE newsletter_subscribers
+		SET status = 'unsubscribed', unsubscribed_at = COALESCE(unsubscribed_at, CURRENT_TIMESTAMP)
+		WHERE id = (SELECT subscriber_id FROM newsletter_deliveries WHERE id = $1)
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, deliveryID)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe newsletter recipient: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "newsletter delivery",
+			ID:       deliveryID.String(),
+		}
+	}
+
+	return nil
+}
+
+// scanCampaign scans a row selected with campaignColumns, and the total count when requested
+func scanCampaign(row pgx.Row, total *int) (*domain.NewsletterCampaign, error) {
+	campaign := &domain.NewsletterCampaign{Stats: &domain.CampaignStats{}}
+	dest := []interface{}{
+		&campaign.ID, &campaign.Subject, &campaign.Preheader, &campaign.Intro, &campaign.Template,
+		&campaign.ArticleIDs, &campaign.Status, &campaign.CreatedBy, &campaign.SentBy,
+		&campaign.SendStartedAt, &campaign.SentAt, &campaign.CreatedAt, &campaign.UpdatedAt,
+		&campaign.Stats.Recipients, &campaign.Stats.Pending, &campaign.Stats.Sent, &campaign.Stats.Failed,
+		&campaign.Stats.Opens, &campaign.Stats.Clicks, &campaign.Stats.TotalClicks,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// scanSubscriber scans a row selected with subscriberColumns, and the total count when requested
+func scanSubscriber(row pgx.Row, total *int) (*domain.NewsletterSubscriber, error) {
+	subscriber := &domain.NewsletterSubscriber{}
+	dest := []interface{}{
+		&subscriber.ID, &subscriber.Email, &subscriber.Name, &subscriber.Status, &subscriber.ConsentSource,
+		&subscriber.SubscribedAt, &subscriber.UnsubscribedAt, &subscriber.ConfirmationSentAt,
+		&subscriber.ConfirmedAt, &subscriber.CreatedAt, &subscriber.UpdatedAt, &subscriber.Suppressed,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return subscriber, nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// NotificationPreferenceRepository implements repository.NotificationPreferenceRepository for PostgreSQL
+type NotificationPreferenceRepository struct {
+	db *DB
+}
+
+// NewNotificationPreferenceRepository creates a new PostgreSQL notification preference repository
+func NewNotificationPreferenceRepository(db *DB) *NotificationPreferenceRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &NotificationPreferenceRepository{db: db}
+}
+
+// Get returns a user's saved preferences
+func (r *NotificationPreferenceRepository) Get(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error) {
+	query := `
+		SELECT user_id, channels, digest_frequency, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	prefs := &domain.NotificationPreferences{}
+	var channelsJSON []byte
+
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+		&prefs.UserID,
+		&channelsJSON,
+		&prefs.DigestFrequency,
+		&prefs.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "notification preferences",
+				ID:       userID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	if err := json.Unmarshal(channelsJSON, &prefs.Channels); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal notification channels: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// Upsert creates or replaces a user's preferences
+func (r *NotificationPreferenceRepository) Upsert(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	if prefs == nil {
+		return fmt.Errorf("notification preferences cannot be nil")
+	}
+
+	channelsJSON, err := json.Marshal(prefs.Channels)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification channels: %w", err)
+	}
+
+	query := `
+		INSERT INTO notification_preferences (user_id, channels, digest_frequency)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			channels = EXCLUDED.channels,
+			digest_frequency = EXCLUDED.digest_frequency
+		RETURNING updated_at
+	`
+
+	if err := r.db.Pool.QueryRow(ctx, query, prefs.UserID, channelsJSON, prefs.DigestFrequency).Scan(&prefs.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to upsert notification preferences: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const notificationTemplateColumns = `
+	id, template_key, channel, locale, version, subject, body,
+	variables, is_active, created_by, created_at
+`
+
+// NotificationTemplateRepository implements repository.NotificationTemplateRepository for PostgreSQL
+type NotificationTemplateRepository struct {
+	db *DB
+}
+
+// NewNotificationTemplateRepository creates a new PostgreSQL notification template repository
+func NewNotificationTemplateRepository(db *DB) *NotificationTemplateRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &NotificationTemplateRepository{db: db}
+}
+
+// CreateVersion inserts the next version of a template, optionally activating it
+// Version assignment and activation happen in one transaction so concurrent edits
+// cannot produce duplicate versions or two active templates
+func (r *NotificationTemplateRepository) CreateVersion(ctx context.Context, tmpl *domain.NotificationTemplate, activate bool) error {
+	if tmpl == nil {
+		return fmt.Errorf("template cannot be nil")
+	}
+
+	if tmpl.ID == uuid.Nil {
+		return fmt.Errorf("template ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Serialize version assignment per (key, channel, locale)
+	lockKey := fmt.Sprintf("notification_template:%s:%s:%s", tmpl.Key, tmpl.Channel, tmpl.Locale)
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, lockKey); err != nil {
+		return fmt.Errorf("failed to lock template: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(version), 0) + 1
+		FROM notification_templates
+		WHERE template_key = $1 AND channel = $2 AND locale = $3
+	`, tmpl.Key, tmpl.Channel, tmpl.Locale).Scan(&tmpl.Version)
+	if err != nil {
+		return fmt.Errorf("failed to get next template version: %w", err)
+	}
+
+	if activate {
+		if _, err := tx.Exec(ctx, `
+			UPDATE notification_templates SET is_active = false
+			WHERE template_key = $1 AND channel = $2 AND locale = $3 AND is_active = true
+		`, tmpl.Key, tmpl.Channel, tmpl.Locale); err != nil {
+			return fmt.Errorf("failed to deactivate previous template: %w", err)
+		}
+	}
+
+	tmpl.IsActive = activate
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO notification_templates (
+			id, template_key, channel, locale, version, subject, body,
+			variables, is_active, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`,
+		tmpl.ID,
+		tmpl.Key,
+		tmpl.Channel,
+		tmpl.Locale,
+		tmpl.Version,
+		tmpl.Subject,
+		tmpl.Body,
+		tmpl.Variables,
+		tmpl.IsActive,
+		tmpl.CreatedBy,
+		tmpl.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit template: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a template version by ID
+func (r *NotificationTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("template ID cannot be nil")
+	}
+
+	query := `SELECT ` + notificationTemplateColumns + ` FROM notification_templates WHERE id = $1`
+
+	tmpl, err := scanNotificationTemplate(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "notification template",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get template by ID: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// GetActive retrieves the active version of a template
+func (r *NotificationTemplateRepository) GetActive(ctx context.Context, key string, channel domain.NotificationChannel, locale string) (*domain.NotificationTemplate, error) {
+	query := `
+		SELECT ` + notificationTemplateColumns + `
+		FROM notification_templates
+		WHERE template_key = $1 AND channel = $2 AND locale = $3 AND is_active = true
+	`
+
+	tmpl, err := scanNotificationTemplate(r.db.Pool.QueryRow(ctx, query, key, channel, locale))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "notification template",
+				ID:       fmt.Sprintf("%s/%s/%s", key, channel, locale),
+			}
+		}
+		return nil, fmt.Errorf("failed to get active template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// ListVersions retrieves all versions of a template, newest first
+func (r *NotificationTemplateRepository) ListVersions(ctx context.Context, key string, channel domain.NotificationChannel, locale string) ([]*domain.NotificationTemplate, error) {
+	query := `
+		SELECT ` + notificationTemplateColumns + `
+		FROM notification_templates
+		WHERE template_key = $1 AND channel = $2 AND locale = $3
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, key, channel, locale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template versions: %w", err)
+	}
+	defer rows.Close()
+
+	return collectNotificationTemplates(rows)
+}
+
+// List retrieves templates matching the filter
+// Without ActiveOnly, the latest version of each (key, channel, locale) is returned
+func (r *NotificationTemplateRepository) List(ctx context.Context, filter *domain.NotificationTemplateFilter) ([]*domain.NotificationTemplate, error) {
+	if filter == nil {
+		filter = &domain.NotificationTemplateFilter{}
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+	argCount := 0
+
+	if filter.Key != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("template_key = $%d", argCount))
+		args = append(args, *filter.Key)
+	}
+
+	if filter.Channel != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("channel = $%d", argCount))
+		args = append(args, *filter.Channel)
+	}
+
+	if filter.Locale != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("locale = $%d", argCount))
+		args = append(args, *filter.Locale)
+	}
+
+	if filter.ActiveOnly {
+		where = append(where, "is_active = true")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT DISTINCT ON (template_key, channel, locale) %s
+		FROM notification_templates
+		WHERE %s
+		ORDER BY template_key, channel, locale, version DESC
+	`, notificationTemplateColumns, strings.Join(where, " AND "))
+
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	defer rows.Close()
+
+	return collectNotificationTemplates(rows)
+}
+
+// Activate makes the given version the active one for its (key, channel, locale)
+func (r *NotificationTemplateRepository) Activate(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("template ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var key, channel, locale string
+	err = tx.QueryRow(ctx, `
+		SELECT template_key, channel, locale FROM notification_templates WHERE id = $1 FOR UPDATE
+	`, id).Scan(&key, &channel, &locale)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.NotFoundError{
+				Resource: "notification template",
+				ID:       id.String(),
+			}
+		}
+		return fmt.Errorf("failed to get template: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE notification_templates SET is_active = (id = $4)
+		WHERE template_key = $1 AND channel = $2 AND locale = $3
+	`, key, channel, locale, id); err != nil {
+		return fmt.Errorf("failed to activate template: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit template activation: %w", err)
+	}
+
+	return nil
+}
+
+// scanNotificationTemplate scans a single template row
+func scanNotificationTemplate(row pgx.Row) (*domain.NotificationTemplate, error) {
+	tmpl := &domain.NotificationTemplate{}
+
+	err := row.Scan(
+		&tmpl.ID,
+		&tmpl.Key,
+		&tmpl.Channel,
+		&tmpl.Locale,
+		&tmpl.Version,
+		&tmpl.Subject,
+		&tmpl.Body,
+		&tmpl.Variables,
+		&tmpl.IsActive,
+		&tmpl.CreatedBy,
+		&tmpl.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// collectNotificationTemplates scans all template rows
+func collectNotificationTemplates(rows pgx.Rows) ([]*domain.NotificationTemplate, error) {
+	templates := make([]*domain.NotificationTemplate, 0)
+	for rows.Next() {
+		tmpl, err := scanNotificationTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, tmpl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating templates: %w", err)
+	}
+
+	return templates, nil
+}
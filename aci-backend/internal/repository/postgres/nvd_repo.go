@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// NVDRepository implements repository.NVDRepository for PostgreSQL
+type NVDRepository struct {
+	db *DB
+}
+
+// NewNVDRepository creates a new PostgreSQL NVD repository
+func NewNVDRepository(db *DB) *NVDRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &NVDRepository{db: db}
+}
+
+// ReplaceProducts records a CVE lookup and replaces the CVE's affected products, in one transaction
+func (r *NVDRepository) ReplaceProducts(ctx context.Context, cveID string, lastModified *time.Time, products []*domain.AffectedProduct, syncedAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO nvd_cves (cve_id, last_modified, synced_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (cve_id) DO UPDATE SET
+			last_modified = EXCLUDED.last_modified,
+			synced_at = EXCLUDED.synced_at
+	`, cveID, lastModified, syncedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record NVD CVE: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM cve_affected_products WHERE cve_id = $1`, cveID); err != nil {
+		return fmt.Errorf("failed to remove affected products: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for _, p := range products {
+		batch.Queue(`
+			INSERT INTO cve_affected_products (
+				cve_id, criteria, vendor, product, version,
+				version_start_including, version_start_excluding, version_end_including, version_end_excluding
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, cveID, p.Criteria, p.Vendor, p.Product, p.Version,
+			p.VersionStartIncluding, p.VersionStartExcluding, p.VersionEndIncluding, p.VersionEndExcluding)
+	}
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to insert affected products: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit affected products: %w", err)
+	}
+
+	return nil
+}
+
+// affectedProductColumns are the columns scanned by scanAffectedProducts
+const affectedProductColumns = `
+	cve_id, criteria, vendor, product, version,
+	version_start_including, version_start_excluding, version_end_including, version_end_excluding
+`
+
+// ListByCVE returns the affected product ranges recorded for a CVE
+func (r *NVDRepository) ListByCVE(ctx context.Context, cveID string) ([]*domain.AffectedProduct, error) {
+	query := `SELECT ` + affectedProductColumns + ` FROM cve_affected_products WHERE cve_id = $1 ORDER BY vendor ASC, product ASC`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, cveID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list affected products: %w", err)
+	}
+
+	return scanAffectedProducts(rows)
+}
+
+// ListByProducts returns the affected product ranges of any of the products
+func (r *NVDRepository) ListByProducts(ctx context.Context, keys []domain.ProductKey) ([]*domain.AffectedProduct, error) {
+	if len(keys) == 0 {
+		return []*domain.AffectedProduct{}, nil
+	}
+
+	vendors, products := splitProductKeys(keys)
+	query := `SELECT ` + affectedProductColumns + `
+		FROM cve_affected_products
+		WHERE (vendor, product) IN (SELECT * FROM UNNEST($1::TEXT[], $2::TEXT[]))
+		ORDER BY cve_id ASC, vendor ASC, product ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, vendors, products)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list affected products: %w", err)
+	}
+
+	return scanAffectedProducts(rows)
+}
+
+// scanAffectedProducts reads and closes rows selected with affectedProductColumns
+func scanAffectedProducts(rows pgx.Rows) ([]*domain.AffectedProduct, error) {
+	defer rows.Close()
+
+	affected := make([]*domain.AffectedProduct, 0)
+	for rows.Next() {
+		var p domain.AffectedProduct
+		if err := rows.Scan(
+			&p.CVEID, &p.Criteria, &p.Vendor, &p.Product, &p.Version,
+			&p.VersionStartIncluding, &p.VersionStartExcluding, &p.VersionEndIncluding, &p.VersionEndExcluding,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan affected product: %w", err)
+		}
+		affected = append(affected, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating affected products: %w", err)
+	}
+
+	return affected, nil
+}
+
+// ListCVEsToSync returns up to limit CVEs of articles published since that were never looked up or
+// were last looked up before staleBefore, those never looked up first, then newest first
+func (r *NVDRepository) ListCVEsToSync(ctx context.Context, since, staleBefore time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT c.cve_id
+		FROM (
+			SELECT UPPER(ac.cve) AS cve_id, MAX(a.published_at) AS published_at
+			FROM articles a, UNNEST(a.cves) AS ac(cve)
+			WHERE a.is_published = true AND a.published_at >= $1 AND ac.cve <> ''
+			GROUP BY UPPER(ac.cve)
+		) c
+		LEFT JOIN nvd_cves n ON n.cve_id = c.cve_id
+		WHERE n.cve_id IS NULL OR n.synced_at < $2
+		ORDER BY n.synced_at ASC NULLS FIRST, c.published_at DESC, c.cve_id DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, since, staleBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CVEs to sync: %w", err)
+	}
+	defer rows.Close()
+
+	cveIDs := make([]string, 0)
+	for rows.Next() {
+		var cveID string
+		if err := rows.Scan(&cveID); err != nil {
+			return nil, fmt.Errorf("failed to scan CVE to sync: %w", err)
+		}
+		cveIDs = append(cveIDs, cveID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating CVEs to sync: %w", err)
+	}
+
+	return cveIDs, nil
+}
+
+// Status describes the local copy of NVD affected products
+func (r *NVDRepository) Status(ctx context.Context) (*domain.NVDStatus, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM nvd_cves),
+			(SELECT COUNT(*) FROM cve_affected_products),
+			(SELECT MAX(synced_at) FROM nvd_cves)
+	`
+
+	var status domain.NVDStatus
+	if err := r.db.Pool.QueryRow(ctx, query).Scan(&status.CVECount, &status.ProductCount, &status.LastSyncedAt); err != nil {
+		return nil, fmt.Errorf("failed to get NVD status: %w", err)
+	}
+
+	return &status, nil
+}
+
+// splitProductKeys returns the vendors and products of the keys as parallel arrays, for UNNEST
+func splitProductKeys(keys []domain.ProductKey) ([]string, []string) {
+	vendors := make([]string, len(keys))
+	products := make([]string, len(keys))
+	for i, key := range keys {
+		vendors[i] = key.Vendor
+		products[i] = key.Product
+	}
+	return vendors, products
+}
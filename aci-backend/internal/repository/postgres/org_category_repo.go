@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// orgCategoryColumns are the columns scanned by scanOrgCategory, selected from org_categories c
+const orgCategoryColumns = `
+	c.id, c.organization_id, c.name, c.slug, c.description, c.color, c.rules, c.created_by,
+	c.created_at, c.updated_at,
+	(SELECT COUNT(*) FROM org_article_categories oac WHERE oac.category_id = c.id)
+`
+
+// OrgCategoryRepository implements repository.OrgCategoryRepository
+type OrgCategoryRepository struct {
+	db *DB
+}
+
+// NewOrgCategoryRepository creates a new organization category repository instance
+func NewOrgCategoryRepository(db *DB) *OrgCategoryRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &OrgCategoryRepository{db: db}
+}
+
+// Create inserts an organization category
+func (r *OrgCategoryRepository) Create(ctx context.Context, category *domain.OrgCategory) error {
+	if category == nil {
+		return fmt.Errorf("category cannot be nil")
+	}
+
+	rules, err := json.Marshal(category.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal category rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO org_categories (
+			id, organization_id, name, slug, description, color, rules, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = r.db.Pool.Exec(ctx, query,
+		category.ID,
+		category.OrganizationID,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.Color,
+		rules,
+		category.CreatedBy,
+		category.CreatedAt,
+		category.UpdatedAt,
+	)
+	if err != nil {
+		return r.mapWriteError(err, category, "failed to create organization category")
+	}
+
+	return nil
+}
+
+// GetByID returns an organization category
+func (r *OrgCategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OrgCategory, error) {
+	query := `SELECT ` + orgCategoryColumns + ` FROM org_categories c WHERE c.id = $1`
+
+	category, err := scanOrgCategory(r.db.read(ctx).QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "organization category",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization category: %w", err)
+	}
+
+	return category, nil
+}
+
+// ListByOrganization returns an organization's categories ordered by name
+func (r *OrgCategoryRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrgCategory, error) {
+	query := `SELECT ` + orgCategoryColumns + `
+		FROM org_categories c
+		WHERE c.organization_id = $1
+		ORDER BY c.name ASC
+	`
+
+	return r.queryOrgCategories(ctx, query, orgID)
+}
+
+// ListWithRules returns every category that has rules, across the organizations visible to ctx
+func (r *OrgCategoryRepository) ListWithRules(ctx context.Context) ([]*domain.OrgCategory, error) {
+	query := `SELECT ` + orgCategoryColumns + `
+		FROM org_categories c
+		WHERE c.rules <> '[]'::JSONB
+		ORDER BY c.organization_id, c.name
+	`
+
+	return r.queryOrgCategories(ctx, query)
+}
+
+// Update saves an organization category's name, slug, description, color and rules
+func (r *OrgCategoryRepository) Update(ctx context.Context, category *domain.OrgCategory) error {
+	if category == nil {
+		return fmt.Errorf("category cannot be nil")
+	}
+
+	rules, err := json.Marshal(category.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal category rules: %w", err)
+	}
+
+	query := `
+		UPDATE org_categories
+		SET name = $2, slug = $3, description = $4, color = $5, rules = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err = r.db.Pool.QueryRow(ctx, query,
+		category.ID,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.Color,
+		rules,
+	).Scan(&category.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domainerrors.NotFoundError{
+			Resource: "organization category",
+			ID:       category.ID.String(),
+		}
+	}
+	if err != nil {
+		return r.mapWriteError(err, category, "failed to update organization category")
+	}
+
+	return nil
+}
+
+// Delete removes an organization category; its article assignments cascade
+func (r *OrgCategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM org_categories WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization category: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization category",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// AddArticle applies a category to an article, keeping an existing assignment as it is
+func (r *OrgCategoryRepository) AddArticle(ctx context.Context, category *domain.OrgCategory, articleID uuid.UUID, source domain.OrgCategorySource, appliedBy *uuid.UUID) error {
+	if category == nil {
+		return fmt.Errorf("category cannot be nil")
+	}
+
+	query := `
+		INSERT INTO org_article_categories (organization_id, category_id, article_id, source, applied_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (category_id, article_id) DO NOTHING
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, category.OrganizationID, category.ID, articleID, source, appliedBy)
+	if err != nil {
+		return fmt.Errorf("failed to apply organization category: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveArticle removes a category from an article
+func (r *OrgCategoryRepository) RemoveArticle(ctx context.Context, categoryID, articleID uuid.UUID) error {
+	query := `DELETE FROM org_article_categories WHERE category_id = $1 AND article_id = $2`
+
+	result, err := r.db.Pool.Exec(ctx, query, categoryID, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization category: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization category assignment",
+			ID:       articleID.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListByArticle returns the organization's categories applied to an article, ordered by name
+func (r *OrgCategoryRepository) ListByArticle(ctx context.Context, orgID, articleID uuid.UUID) ([]*domain.OrgArticleCategory, error) {
+	query := `SELECT ` + orgCategoryColumns + `, a.source, a.applied_by, a.created_at
+		FROM org_article_categories a
+		JOIN org_categories c ON c.id = a.category_id
+		WHERE a.organization_id = $1 AND a.article_id = $2
+		ORDER BY c.name ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, orgID, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article organization categories: %w", err)
+	}
+	defer rows.Close()
+
+	assignments := make([]*domain.OrgArticleCategory, 0)
+	for rows.Next() {
+		assignment := &domain.OrgArticleCategory{}
+		category, err := scanOrgCategory(rows, &assignment.Source, &assignment.AppliedBy, &assignment.AppliedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article organization category: %w", err)
+		}
+		assignment.Category = category
+		assignments = append(assignments, assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article organization categories: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// queryOrgCategories runs a query selecting orgCategoryColumns
+func (r *OrgCategoryRepository) queryOrgCategories(ctx context.Context, query string, args ...interface{}) ([]*domain.OrgCategory, error) {
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]*domain.OrgCategory, 0)
+	for rows.Next() {
+		category, err := scanOrgCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organization categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// mapWriteError maps a slug clash within the organization to a ConflictError
+func (r *OrgCategoryRepository) mapWriteError(err error, category *domain.OrgCategory, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return &domainerrors.ConflictError{
+			Resource: "organization category",
+			Field:    "slug",
+			Value:    category.Slug,
+		}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// scanOrgCategory scans a row selected with orgCategoryColumns, followed by any extra columns
+func scanOrgCategory(row pgx.Row, extra ...interface{}) (*domain.OrgCategory, error) {
+	category := &domain.OrgCategory{}
+	var rules []byte
+
+	dest := []interface{}{
+		&category.ID, &category.OrganizationID, &category.Name, &category.Slug, &category.Description,
+		&category.Color, &rules, &category.CreatedBy, &category.CreatedAt, &category.UpdatedAt,
+		&category.ArticleCount,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rules, &category.Rules); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal category rules: %w", err)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,365 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// OrganizationRepository implements repository.OrganizationRepository for PostgreSQL
+type OrganizationRepository struct {
+	db *DB
+}
+
+// NewOrganizationRepository creates a new PostgreSQL organization repository
+func NewOrganizationRepository(db *DB) *OrganizationRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &OrganizationRepository{db: db}
+}
+
+// Create inserts a new organization
+func (r *OrganizationRepository) Create(ctx context.Context, org *domain.Organization) error {
+	if org == nil {
+		return fmt.Errorf("organization cannot be nil")
+	}
+
+	if org.ID == uuid.Nil {
+		return fmt.Errorf("organization ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO organizations (id, name, slug, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, org.ID, org.Name, org.Slug, org.CreatedAt, org.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &domainerrors.ConflictError{
+				Resource: "organization",
+				Field:    "slug",
+				Value:    org.Slug,
+			}
+		}
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an organization with its member count
+func (r *OrganizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Organization, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("organization ID cannot be nil")
+	}
+
+	query := `
+		SELECT
+			o.id, o.name, o.slug, o.created_at, o.updated_at,
+			(SELECT COUNT(*) FROM organization_members m WHERE m.organization_id = o.id)
+		FROM organizations o
+		WHERE o.id = $1
+	`
+
+	var org domain.Organization
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+		&org.ID,
+		&org.Name,
+		&org.Slug,
+		&org.CreatedAt,
+		&org.UpdatedAt,
+		&org.MemberCount,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "organization",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	return &org, nil
+}
+
+// List returns all organizations ordered by name
+func (r *OrganizationRepository) List(ctx context.Context) ([]*domain.Organization, error) {
+	query := `
+		SELECT
+			o.id, o.name, o.slug, o.created_at, o.updated_at,
+			COUNT(m.user_id)
+		FROM organizations o
+		LEFT JOIN organization_members m ON m.organization_id = o.id
+		GROUP BY o.id
+		ORDER BY o.name ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	orgs := make([]*domain.Organization, 0)
+
+	for rows.Next() {
+		var org domain.Organization
+		if err := rows.Scan(
+			&org.ID,
+			&org.Name,
+			&org.Slug,
+			&org.CreatedAt,
+			&org.UpdatedAt,
+			&org.MemberCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		orgs = append(orgs, &org)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organizations: %w", err)
+	}
+
+	return orgs, nil
+}
+
+// SlugExists reports whether an organization already uses the slug
+func (r *OrganizationRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
+	var exists bool
+	err := r.db.Pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM organizations WHERE slug = $1)`, slug).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check organization slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Delete removes an organization; memberships, shared alerts and shared collections cascade
+func (r *OrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("organization ID cannot be nil")
+	}
+
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM organizations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// GetMembership returns the organization membership of a user
+func (r *OrganizationRepository) GetMembership(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("user ID cannot be nil")
+	}
+
+	query := `
+		SELECT m.organization_id, m.user_id, m.role, m.joined_at, u.email, u.name
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.user_id = $1
+	`
+
+	var member domain.OrganizationMember
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+		&member.OrganizationID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+		&member.Email,
+		&member.Name,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "organization membership",
+				ID:       userID.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+
+	return &member, nil
+}
+
+// ListMembers returns an organization's members, admins first
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	if orgID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID cannot be nil")
+	}
+
+	query := `
+		SELECT m.organization_id, m.user_id, m.role, m.joined_at, u.email, u.name
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.organization_id = $1
+		ORDER BY m.role = 'admin' DESC, u.name ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]*domain.OrganizationMember, 0)
+
+	for rows.Next() {
+		var member domain.OrganizationMember
+		if err := rows.Scan(
+			&member.OrganizationID,
+			&member.UserID,
+			&member.Role,
+			&member.JoinedAt,
+			&member.Email,
+			&member.Name,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+		members = append(members, &member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organization members: %w", err)
+	}
+
+	return members, nil
+}
+
+// UpsertMember adds a user to an organization or updates their role
+func (r *OrganizationRepository) UpsertMember(ctx context.Context, member *domain.OrganizationMember) error {
+	if member == nil {
+		return fmt.Errorf("organization member cannot be nil")
+	}
+
+	if member.OrganizationID == uuid.Nil || member.UserID == uuid.Nil {
+		return fmt.Errorf("organization ID and user ID are required")
+	}
+
+	// The conditional update leaves membership in another organization untouched and returns no row
+	query := `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+			SET role = EXCLUDED.role
+			WHERE organization_members.organization_id = EXCLUDED.organization_id
+		RETURNING joined_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query, member.OrganizationID, member.UserID, member.Role).Scan(&member.JoinedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.ConflictError{
+				Resource: "organization membership",
+				Field:    "user_id",
+				Value:    member.UserID.String(),
+			}
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if pgErr.ConstraintName == "fk_organization_members_user" {
+				return &domainerrors.NotFoundError{Resource: "user", ID: member.UserID.String()}
+			}
+			return &domainerrors.NotFoundError{Resource: "organization", ID: member.OrganizationID.String()}
+		}
+		return fmt.Errorf("failed to upsert organization member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from an organization
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := `DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2`
+
+	result, err := r.db.Pool.Exec(ctx, query, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization member",
+			ID:       userID.String(),
+		}
+	}
+
+	return nil
+}
+
+// GetStats rolls up reading, bookmark and alert activity across an organization's members
+func (r *OrganizationRepository) GetStats(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationStats, error) {
+	if orgID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID cannot be nil")
+	}
+
+	query := `
+		WITH members AS (
+			SELECT user_id FROM organization_members WHERE organization_id = $1
+		),
+		reads AS (
+			SELECT ar.* FROM article_reads ar JOIN members m ON m.user_id = ar.user_id
+		),
+		org_alerts AS (
+			SELECT id FROM alerts
+			WHERE organization_id = $1 OR user_id IN (SELECT user_id FROM members)
+		)
+		SELECT
+			(SELECT COUNT(*) FROM members),
+			(SELECT COUNT(DISTINCT user_id) FROM reads WHERE read_at >= NOW() - INTERVAL '30 days'),
+			(SELECT COUNT(*) FROM reads),
+			(SELECT COUNT(DISTINCT article_id) FROM reads),
+			(SELECT COALESCE(SUM(reading_time_seconds), 0) FROM reads),
+			(SELECT COUNT(*) FROM reads WHERE read_at >= DATE_TRUNC('week', NOW())),
+			(SELECT COUNT(*) FROM reads WHERE read_at >= DATE_TRUNC('month', NOW())),
+			(SELECT COUNT(*) FROM bookmarks b JOIN members m ON m.user_id = b.user_id),
+			(SELECT COUNT(*) FROM bookmark_collections WHERE organization_id = $1),
+			(SELECT COUNT(*) FROM org_alerts),
+			(SELECT COUNT(*) FROM alerts WHERE organization_id = $1),
+			(SELECT COUNT(*) FROM alert_matches am JOIN org_alerts a ON a.id = am.alert_id),
+			(SELECT COUNT(*) FROM alert_matches am JOIN org_alerts a ON a.id = am.alert_id WHERE am.status = 'new')
+	`
+
+	stats := &domain.OrganizationStats{OrganizationID: orgID}
+	err := r.db.Pool.QueryRow(ctx, query, orgID).Scan(
+		&stats.Members,
+		&stats.ActiveReaders30d,
+		&stats.ArticlesRead,
+		&stats.UniqueArticlesRead,
+		&stats.ReadingTimeSeconds,
+		&stats.ArticlesReadThisWeek,
+		&stats.ArticlesReadThisMonth,
+		&stats.Bookmarks,
+		&stats.SharedCollections,
+		&stats.Alerts,
+		&stats.SharedAlerts,
+		&stats.AlertMatches,
+		&stats.UnacknowledgedMatches,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,412 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// organizationReportColumns are the columns scanned by scanOrganizationReport
+const organizationReportColumns = `
+	id, organization_id, name, filters, sections, recipients, cadence, enabled,
+	next_run_at, last_run_at, created_by, created_at, updated_at
+`
+
+// organizationReportRunColumns are the columns scanned by scanOrganizationReportRun
+const organizationReportRunColumns = `
+	id, report_id, period_start, period_end, trigger, status, requested_by, recipients_sent,
+	error, created_at, completed_at, COALESCE(OCTET_LENGTH(document), 0)
+`
+
+// OrganizationReportRepository implements repository.OrganizationReportRepository
+type OrganizationReportRepository struct {
+	db *DB
+}
+
+// NewOrganizationReportRepository creates a new organization report repository instance
+func NewOrganizationReportRepository(db *DB) *OrganizationReportRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &OrganizationReportRepository{db: db}
+}
+
+// Create inserts a report template
+func (r *OrganizationReportRepository) Create(ctx context.Context, report *domain.OrganizationReport) error {
+	if report == nil {
+		return fmt.Errorf("report cannot be nil")
+	}
+
+	filters, err := json.Marshal(report.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal report filters: %w", err)
+	}
+
+	query := `
+		INSERT INTO organization_reports (
+			id, organization_id, name, filters, sections, recipients, cadence, enabled,
+			next_run_at, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.db.Pool.Exec(ctx, query,
+		report.ID,
+		report.OrganizationID,
+		report.Name,
+		filters,
+		sectionStrings(report.Sections),
+		report.Recipients,
+		report.Cadence,
+		report.Enabled,
+		report.NextRunAt,
+		report.CreatedBy,
+		report.CreatedAt,
+		report.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create organization report: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns a report template
+func (r *OrganizationReportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OrganizationReport, error) {
+	query := `SELECT ` + organizationReportColumns + ` FROM organization_reports WHERE id = $1`
+
+	report, err := scanOrganizationReport(r.db.Pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "organization report",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization report: %w", err)
+	}
+
+	return report, nil
+}
+
+// ListByOrganization returns an organization's report templates, newest first
+func (r *OrganizationReportRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationReport, error) {
+	query := `SELECT ` + organizationReportColumns + `
+		FROM organization_reports
+		WHERE organization_id = $1
+		ORDER BY created_at DESC
+	`
+
+	return r.queryOrganizationReports(ctx, query, orgID)
+}
+
+// Update saves a report template's definition and schedule
+func (r *OrganizationReportRepository) Update(ctx context.Context, report *domain.OrganizationReport) error {
+	if report == nil {
+		return fmt.Errorf("report cannot be nil")
+	}
+
+	filters, err := json.Marshal(report.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal report filters: %w", err)
+	}
+
+	query := `
+		UPDATE organization_reports
+		SET name = $2, filters = $3, sections = $4, recipients = $5, cadence = $6, enabled = $7,
+			next_run_at = $8, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err = r.db.Pool.QueryRow(ctx, query,
+		report.ID,
+		report.Name,
+		filters,
+		sectionStrings(report.Sections),
+		report.Recipients,
+		report.Cadence,
+		report.Enabled,
+		report.NextRunAt,
+	).Scan(&report.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domainerrors.NotFoundError{
+			Resource: "organization report",
+			ID:       report.ID.String(),
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update organization report: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a report template and its runs
+func (r *OrganizationReportRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM organization_reports WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization report: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization report",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// ListDue returns enabled reports whose next run is at or before now, earliest first
+func (r *OrganizationReportRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.OrganizationReport, error) {
+	query := `SELECT ` + organizationReportColumns + `
+		FROM organization_reports
+		WHERE enabled = true AND next_run_at <= $1
+		ORDER BY next_run_at ASC
+		LIMIT $2
+	`
+
+	return r.queryOrganizationReports(ctx, query, now, limit)
+}
+
+// AdvanceSchedule moves a report's next run from expected to next
+// It returns false when the next run is no longer expected, because another instance claimed it
+func (r *OrganizationReportRepository) AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	query := `UPDATE organization_reports SET next_run_at = $3 WHERE id = $1 AND next_run_at = $2`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, expected, next)
+	if err != nil {
+		return false, fmt.Errorf("failed to advance organization report schedule: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// CreateRun inserts a run
+func (r *OrganizationReportRepository) CreateRun(ctx context.Context, run *domain.OrganizationReportRun) error {
+	if run == nil {
+		return fmt.Errorf("run cannot be nil")
+	}
+
+	query := `
+		INSERT INTO organization_report_runs (
+			id, report_id, period_start, period_end, trigger, status, requested_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		run.ID,
+		run.ReportID,
+		run.PeriodStart,
+		run.PeriodEnd,
+		run.Trigger,
+		run.Status,
+		run.RequestedBy,
+		run.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create organization report run: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteRun records a run's outcome and PDF, and the report's last run time
+func (r *OrganizationReportRepository) CompleteRun(ctx context.Context, run *domain.OrganizationReportRun, document []byte) error {
+	if run == nil {
+		return fmt.Errorf("run cannot be nil")
+	}
+
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE organization_report_runs
+		SET status = $2, document = $3, recipients_sent = $4, error = $5, completed_at = $6
+		WHERE id = $1
+	`
+
+	result, err := tx.Exec(ctx, query, run.ID, run.Status, document, run.RecipientsSent, run.Error, run.CompletedAt)
+	if err != nil {
+		return fmt.Errorf("failed to complete organization report run: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "organization report run",
+			ID:       run.ID.String(),
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE organization_reports SET last_run_at = $2 WHERE id = $1`, run.ReportID, run.CompletedAt); err != nil {
+		return fmt.Errorf("failed to update organization report last run: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	run.DocumentSize = len(document)
+
+	return nil
+}
+
+// GetRun returns a run without its PDF
+func (r *OrganizationReportRepository) GetRun(ctx context.Context, id uuid.UUID) (*domain.OrganizationReportRun, error) {
+	query := `SELECT ` + organizationReportRunColumns + ` FROM organization_report_runs WHERE id = $1`
+
+	run, err := scanOrganizationReportRun(r.db.Pool.QueryRow(ctx, query, id), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "organization report run",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization report run: %w", err)
+	}
+
+	return run, nil
+}
+
+// ListRuns returns a report's runs, newest first, and the total
+func (r *OrganizationReportRepository) ListRuns(ctx context.Context, reportID uuid.UUID, limit, offset int) ([]*domain.OrganizationReportRun, int, error) {
+	query := `SELECT ` + organizationReportRunColumns + `, COUNT(*) OVER ()
+		FROM organization_report_runs
+		WHERE report_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, reportID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list organization report runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]*domain.OrganizationReportRun, 0)
+	total := 0
+	for rows.Next() {
+		run, err := scanOrganizationReportRun(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan organization report run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating organization report runs: %w", err)
+	}
+
+	return runs, total, nil
+}
+
+// GetRunDocument returns a completed run's PDF
+func (r *OrganizationReportRepository) GetRunDocument(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	query := `SELECT document FROM organization_report_runs WHERE id = $1 AND document IS NOT NULL`
+
+	var document []byte
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(&document)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "organization report document",
+			ID:       id.String(),
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization report document: %w", err)
+	}
+
+	return document, nil
+}
+
+// queryOrganizationReports runs a query selecting organizationReportColumns
+func (r *OrganizationReportRepository) queryOrganizationReports(ctx context.Context, query string, args ...interface{}) ([]*domain.OrganizationReport, error) {
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization reports: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make([]*domain.OrganizationReport, 0)
+	for rows.Next() {
+		report, err := scanOrganizationReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization report: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organization reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+// scanOrganizationReport scans a row selected with organizationReportColumns
+func scanOrganizationReport(row pgx.Row) (*domain.OrganizationReport, error) {
+	report := &domain.OrganizationReport{}
+	var filters []byte
+	var sections []string
+
+	if err := row.Scan(
+		&report.ID, &report.OrganizationID, &report.Name, &filters, &sections, &report.Recipients,
+		&report.Cadence, &report.Enabled, &report.NextRunAt, &report.LastRunAt, &report.CreatedBy,
+		&report.CreatedAt, &report.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(filters, &report.Filter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal report filters: %w", err)
+	}
+
+	report.Sections = make([]domain.ReportSection, len(sections))
+	for i, section := range sections {
+		report.Sections[i] = domain.ReportSection(section)
+	}
+
+	return report, nil
+}
+
+// scanOrganizationReportRun scans a row selected with organizationReportRunColumns, and the
+// total count when requested
+func scanOrganizationReportRun(row pgx.Row, total *int) (*domain.OrganizationReportRun, error) {
+	run := &domain.OrganizationReportRun{}
+	dest := []interface{}{
+		&run.ID, &run.ReportID, &run.PeriodStart, &run.PeriodEnd, &run.Trigger, &run.Status,
+		&run.RequestedBy, &run.RecipientsSent, &run.Error, &run.CreatedAt, &run.CompletedAt,
+		&run.DocumentSize,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// sectionStrings converts report sections for a TEXT[] column
+func sectionStrings(sections []domain.ReportSection) []string {
+	values := make([]string, len(sections))
+	for i, section := range sections {
+		values[i] = string(section)
+	}
+	return values
+}
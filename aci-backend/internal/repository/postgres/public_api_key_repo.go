@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const publicAPIKeyColumns = `id, name, key_prefix, key_hash, requests_per_minute, created_by, created_at, last_used_at, revoked_at`
+
+// PublicAPIKeyRepository implements repository.PublicAPIKeyRepository for PostgreSQL
+type PublicAPIKeyRepository struct {
+	db *DB
+}
+
+// NewPublicAPIKeyRepository creates a new PostgreSQL public API key repository
+func NewPublicAPIKeyRepository(db *DB) *PublicAPIKeyRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &PublicAPIKeyRepository{db: db}
+}
+
+// Create stores a new public API key
+func (r *PublicAPIKeyRepository) Create(ctx context.Context, key *domain.PublicAPIKey) error {
+	if key == nil {
+		return fmt.Errorf("public api key cannot be nil")
+	}
+
+	query := `
+		INSERT INTO public_api_keys (name, key_prefix, key_hash, requests_per_minute, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		key.Name,
+		key.KeyPrefix,
+		key.KeyHash,
+		key.RequestsPerMinute,
+		key.CreatedBy,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create public api key: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash returns the key with the given hash, revoked or not
+func (r *PublicAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.PublicAPIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM public_api_keys WHERE key_hash = $1`, publicAPIKeyColumns)
+
+	key, err := scanPublicAPIKey(r.db.Pool.QueryRow(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "public api key",
+				ID:       "hash",
+			}
+		}
+		return nil, fmt.Errorf("failed to get public api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// List returns all keys, newest first
+func (r *PublicAPIKeyRepository) List(ctx context.Context) ([]*domain.PublicAPIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM public_api_keys ORDER BY created_at DESC`, publicAPIKeyColumns)
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list public api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]*domain.PublicAPIKey, 0)
+	for rows.Next() {
+		key, err := scanPublicAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan public api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating public api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke marks the key revoked and returns it; revoking twice keeps the first time
+func (r *PublicAPIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) (*domain.PublicAPIKey, error) {
+	query := fmt.Sprintf(`
+		UPDATE public_api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1
+		RETURNING %s
+	`, publicAPIKeyColumns)
+
+	key, err := scanPublicAPIKey(r.db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "public api key",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to revoke public api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// TouchLastUsed records that the key was used
+func (r *PublicAPIKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.db.Pool.Exec(ctx, `UPDATE public_api_keys SET last_used_at = NOW() WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to update public api key last use: %w", err)
+	}
+	return nil
+}
+
+// scanPublicAPIKey scans a row selected with publicAPIKeyColumns
+func scanPublicAPIKey(row pgx.Row) (*domain.PublicAPIKey, error) {
+	key := &domain.PublicAPIKey{}
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.KeyPrefix,
+		&key.KeyHash,
+		&key.RequestsPerMinute,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
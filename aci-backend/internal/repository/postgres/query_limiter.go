@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// maxLoggedStatementLength truncates the SQL of logged slow queries; arguments are never logged
+const maxLoggedStatementLength = 2000
+
+// queryTimeoutKey carries a timeout set by withQueryTimeout
+type queryTimeoutKey struct{}
+
+// queryTraceKey carries the state of a query between TraceQueryStart and TraceQueryEnd
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql    string
+	start  time.Time
+	cancel context.CancelFunc
+}
+
+// QueryLimiter gives every query a deadline and logs the slow ones
+// Set it on pgx.ConnConfig.Tracer. The deadline covers reading all rows of a query, so a
+// runaway query is cancelled instead of holding its pool connection indefinitely
+type QueryLimiter struct {
+	timeout       time.Duration
+	slowThreshold time.Duration
+}
+
+// NewQueryLimiter creates a new query limiter
+// timeout is the default per-query deadline and slowThreshold the duration from which a
+// query is logged; zero disables either
+func NewQueryLimiter(timeout, slowThreshold time.Duration) *QueryLimiter {
+	return &QueryLimiter{
+		timeout:       timeout,
+		slowThreshold: slowThreshold,
+	}
+}
+
+// withQueryTimeout overrides the default deadline for queries run with the returned context
+// Repositories use it for queries expected to run long, such as streamed exports; zero removes the deadline
+func withQueryTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, queryTimeoutKey{}, timeout)
+}
+
+// TraceQueryStart implements pgx.QueryTracer
+func (l *QueryLimiter) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	timeout := l.timeout
+	if override, ok := ctx.Value(queryTimeoutKey{}).(time.Duration); ok {
+		timeout = override
+	}
+
+	trace := &queryTrace{
+		sql:    data.SQL,
+		start:  time.Now(),
+		cancel: func() {},
+	}
+
+	if timeout > 0 {
+		ctx, trace.cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	return context.WithValue(ctx, queryTraceKey{}, trace)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer
+func (l *QueryLimiter) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	trace.cancel()
+
+	elapsed := time.Since(trace.start)
+	if l.slowThreshold <= 0 || elapsed < l.slowThreshold {
+		return
+	}
+
+	statement := trace.sql
+	if len(statement) > maxLoggedStatementLength {
+		statement = statement[:maxLoggedStatementLength]
+	}
+
+	event := log.Warn().
+		Dur("duration", elapsed).
+		Str("sql", statement).
+		Int64("rows_affected", data.CommandTag.RowsAffected())
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		event = event.Err(data.Err)
+	}
+	event.Msg("Slow database query")
+}
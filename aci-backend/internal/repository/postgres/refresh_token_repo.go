@@ -148,7 +148,7 @@ func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID uu
 		WHERE user_id = $1 AND revoked_at IS NULL
 	`
 
-	_, err := r.db.Pool.Exec(ctx, query, userID, now)
+	_, err := r.db.conn(ctx).Exec(ctx, query, userID, now)
 	if err != nil {
 		return fmt.Errorf("failed to revoke all tokens for user: %w", err)
 	}
@@ -175,3 +175,27 @@ func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 
 	return nil
 }
+
+// DeleteExpiredBefore deletes up to limit tokens that expired or were revoked before the given time
+// Deleting in batches keeps each statement's row locks and WAL volume small
+func (r *RefreshTokenRepository) DeleteExpiredBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	if limit < 1 {
+		return 0, fmt.Errorf("limit must be at least 1")
+	}
+
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE id IN (
+			SELECT id FROM refresh_tokens
+			WHERE expires_at < $1 OR revoked_at < $1
+			LIMIT $2
+		)
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired tokens: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
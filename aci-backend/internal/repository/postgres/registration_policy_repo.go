@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// RegistrationPolicyRepository implements repository.RegistrationPolicyRepository for PostgreSQL
+type RegistrationPolicyRepository struct {
+	db *DB
+}
+
+// NewRegistrationPolicyRepository creates a new PostgreSQL registration policy repository
+func NewRegistrationPolicyRepository(db *DB) *RegistrationPolicyRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &RegistrationPolicyRepository{db: db}
+}
+
+// Get returns the saved policy, or a NotFoundError if none has been saved
+func (r *RegistrationPolicyRepository) Get(ctx context.Context) (*domain.RegistrationPolicy, error) {
+	query := `
+		SELECT enabled, allowed_domains, updated_by, updated_at
+		FROM registration_policy
+		WHERE id
+	`
+
+	policy := &domain.RegistrationPolicy{Source: domain.RegistrationPolicySourceAdmin}
+	err := r.db.Pool.QueryRow(ctx, query).Scan(
+		&policy.Enabled,
+		&policy.AllowedDomains,
+		&policy.UpdatedBy,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "registration policy",
+				ID:       "current",
+			}
+		}
+		return nil, fmt.Errorf("failed to get registration policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// Upsert saves the policy, replacing any saved before
+func (r *RegistrationPolicyRepository) Upsert(ctx context.Context, policy *domain.RegistrationPolicy) error {
+	if policy == nil {
+		return fmt.Errorf("registration policy cannot be nil")
+	}
+
+	domains := policy.AllowedDomains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	query := `
+		INSERT INTO registration_policy (id, enabled, allowed_domains, updated_by, updated_at)
+		VALUES (TRUE, $1, $2, $3, NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			allowed_domains = EXCLUDED.allowed_domains,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query, policy.Enabled, domains, policy.UpdatedBy).Scan(&policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save registration policy: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the saved policy; deleting when none is saved is not an error
+func (r *RegistrationPolicyRepository) Delete(ctx context.Context) error {
+	if _, err := r.db.Pool.Exec(ctx, `DELETE FROM registration_policy`); err != nil {
+		return fmt.Errorf("failed to delete registration policy: %w", err)
+	}
+	return nil
+}
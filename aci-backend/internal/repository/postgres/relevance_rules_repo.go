@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// RelevanceRulesRepository implements repository.RelevanceRulesRepository for PostgreSQL
+type RelevanceRulesRepository struct {
+	db *DB
+}
+
+// NewRelevanceRulesRepository creates a new PostgreSQL relevance rules repository
+func NewRelevanceRulesRepository(db *DB) *RelevanceRulesRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &RelevanceRulesRepository{db: db}
+}
+
+// GetLatest returns the ruleset in use, or a NotFoundError if none has been saved
+func (r *RelevanceRulesRepository) GetLatest(ctx context.Context) (*domain.RelevanceRules, error) {
+	query := `
+		SELECT version, rules, created_by, created_at
+		FROM relevance_rules
+		ORDER BY version DESC
+		LIMIT 1
+	`
+
+	var (
+		version   int
+		data      []byte
+		createdBy *uuid.UUID
+		createdAt time.Time
+	)
+	err := r.db.Pool.QueryRow(ctx, query).Scan(&version, &data, &createdBy, &createdAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "relevance rules",
+				ID:       "latest",
+			}
+		}
+		return nil, fmt.Errorf("failed to get relevance rules: %w", err)
+	}
+
+	var rules domain.RelevanceRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal relevance rules: %w", err)
+	}
+
+	// Columns are authoritative over anything stored in the document
+	rules.Version = version
+	rules.CreatedBy = createdBy
+	rules.CreatedAt = createdAt
+
+	return &rules, nil
+}
+
+// Create stores the ruleset as the next version, setting Version and CreatedAt
+// Two admins saving at once conflict on the version instead of overwriting each other
+func (r *RelevanceRulesRepository) Create(ctx context.Context, rules *domain.RelevanceRules) error {
+	if rules == nil {
+		return fmt.Errorf("relevance rules cannot be nil")
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal relevance rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO relevance_rules (version, rules, created_by)
+		SELECT COALESCE(MAX(version), 0) + 1, $1, $2 FROM relevance_rules
+		RETURNING version, created_at
+	`
+
+	err = r.db.Pool.QueryRow(ctx, query, data, rules.CreatedBy).Scan(&rules.Version, &rules.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &domainerrors.ConflictError{
+				Resource: "relevance rules",
+				Field:    "version",
+				Value:    "next",
+			}
+		}
+		return fmt.Errorf("failed to create relevance rules: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// replicaPingTimeout bounds each replica health check
+const replicaPingTimeout = 2 * time.Second
+
+// replica is a read replica pool and whether it last answered a health check
+type replica struct {
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// AddReplica registers a read replica; call it before the DB is used
+// A replica that cannot be reached now is skipped until MonitorReplicas finds it healthy
+func (db *DB) AddReplica(ctx context.Context, pool *pgxpool.Pool) {
+	r := &replica{pool: pool}
+	r.healthy.Store(r.ping(ctx) == nil)
+	db.replicas = append(db.replicas, r)
+}
+
+// HealthyReplicas returns how many replicas are serving reads and how many are configured
+func (db *DB) HealthyReplicas() (healthy, total int) {
+	for _, r := range db.replicas {
+		if r.healthy.Load() {
+			healthy++
+		}
+	}
+	return healthy, len(db.replicas)
+}
+
+// MonitorReplicas checks every replica on each interval until the context is cancelled
+// Reads fall back to the primary while a replica fails its check
+func (db *DB) MonitorReplicas(ctx context.Context, interval time.Duration) {
+	if len(db.replicas) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for i, r := range db.replicas {
+				err := r.ping(ctx)
+				healthy := err == nil
+				if r.healthy.Swap(healthy) == healthy {
+					continue
+				}
+
+				if healthy {
+					log.Info().Int("replica", i).Msg("Database replica recovered; serving reads again")
+				} else {
+					log.Warn().Err(err).Int("replica", i).Msg("Database replica unavailable; reading from primary")
+				}
+			}
+		}
+	}
+}
+
+// read returns where a read should run: the transaction started by WithinTx, a healthy
+// replica when the context allows replica reads, or the primary
+// Replicas are used in turn so reads spread across them
+func (db *DB) read(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	if len(db.replicas) == 0 || !repository.ReplicaReadsAllowed(ctx) {
+		return db.Pool
+	}
+
+	start := db.nextReplica.Add(1)
+	for i := range db.replicas {
+		r := db.replicas[(start+uint64(i))%uint64(len(db.replicas))]
+		if r.healthy.Load() {
+			return r.pool
+		}
+	}
+
+	return db.Pool
+}
+
+// ping checks that the replica accepts queries
+func (r *replica) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+	defer cancel()
+	return r.pool.Ping(ctx)
+}
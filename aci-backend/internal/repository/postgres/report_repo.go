@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// ReportRepository implements repository.ReportRepository
+type ReportRepository struct {
+	db *DB
+}
+
+// NewReportRepository creates a new report repository instance
+func NewReportRepository(db *DB) *ReportRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ReportRepository{db: db}
+}
+
+// GetSeverityBreakdown counts articles published in [from, to) that match the filter by severity
+func (r *ReportRepository) GetSeverityBreakdown(ctx context.Context, from, to time.Time, filter *domain.ReportFilter) (*domain.SeverityBreakdown, error) {
+	where, args := reportArticleConditions(from, to, filter)
+
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(*) FILTER (WHERE severity = 'critical'),
+			COUNT(*) FILTER (WHERE severity = 'high'),
+			COUNT(*) FILTER (WHERE severity = 'medium'),
+			COUNT(*) FILTER (WHERE severity = 'low'),
+			COUNT(*) FILTER (WHERE severity = 'informational'),
+			COUNT(*)
+		FROM articles
+		WHERE %s
+	`, strings.Join(where, " AND "))
+
+	breakdown := &domain.SeverityBreakdown{}
+	err := r.db.read(ctx).QueryRow(ctx, query, args...).Scan(
+		&breakdown.Critical,
+		&breakdown.High,
+		&breakdown.Medium,
+		&breakdown.Low,
+		&breakdown.Informational,
+		&breakdown.Total,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get severity breakdown: %w", err)
+	}
+
+	return breakdown, nil
+}
+
+// GetTopArticles returns the most severe articles published in [from, to) that match the filter,
+// most viewed first within a severity; editorial titles replace source titles as they do for readers
+func (r *ReportRepository) GetTopArticles(ctx context.Context, from, to time.Time, filter *domain.ReportFilter, limit int) ([]*domain.ReportArticle, error) {
+	where, args := reportArticleConditions(from, to, filter)
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT
+			articles.id, COALESCE(articles.editorial_title, articles.title), articles.slug, articles.severity,
+			COALESCE(s.name, ''), articles.cves, articles.view_count, articles.published_at
+		FROM articles
+		LEFT JOIN sources s ON s.id = articles.source_id
+		WHERE %s
+		ORDER BY
+			CASE articles.severity
+				WHEN 'critical' THEN 5
+				WHEN 'high' THEN 4
+				WHEN 'medium' THEN 3
+				WHEN 'low' THEN 2
+				ELSE 1
+			END DESC,
+			articles.view_count DESC,
+			articles.published_at DESC
+		LIMIT $%d
+	`, strings.Join(where, " AND "), len(args))
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top articles: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]*domain.ReportArticle, 0, limit)
+	for rows.Next() {
+		article := &domain.ReportArticle{}
+		if err := rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Severity,
+			&article.SourceName,
+			&article.CVEs,
+			&article.ViewCount,
+			&article.PublishedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan top article: %w", err)
+		}
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating top articles: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetNotableCVEs returns CVEs mentioned by articles published in [from, to) that match the filter:
+// those in the KEV catalog first, then those with a public exploit, each ordered by how many
+// articles mention them
+func (r *ReportRepository) GetNotableCVEs(ctx context.Context, from, to time.Time, filter *domain.ReportFilter, limit int) ([]domain.ReportCVE, error) {
+	where, args := reportArticleConditions(from, to, filter)
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		WITH mentioned AS (
+			SELECT UPPER(c.cve) AS cve, COUNT(DISTINCT articles.id) AS articles
+			FROM articles, UNNEST(articles.cves) AS c(cve)
+			WHERE %s AND c.cve <> ''
+			GROUP BY UPPER(c.cve)
+		)
+		SELECT
+			m.cve,
+			m.articles,
+			k.cve_id IS NOT NULL AS kev,
+			k.due_date,
+			EXISTS (SELECT 1 FROM cve_exploits e WHERE e.cve_id = m.cve) AS exploit_available
+		FROM mentioned m
+		LEFT JOIN kev_vulnerabilities k ON k.cve_id = m.cve
+		ORDER BY kev DESC, exploit_available DESC, m.articles DESC, m.cve DESC
+		LIMIT $%d
+	`, strings.Join(where, " AND "), len(args))
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notable CVEs: %w", err)
+	}
+	defer rows.Close()
+
+	cves := make([]domain.ReportCVE, 0, limit)
+	for rows.Next() {
+		var cve domain.ReportCVE
+		if err := rows.Scan(&cve.CVE, &cve.ArticleCount, &cve.KEV, &cve.KEVDueDate, &cve.ExploitAvailable); err != nil {
+			return nil, fmt.Errorf("failed to scan notable CVE: %w", err)
+		}
+		cves = append(cves, cve)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notable CVEs: %w", err)
+	}
+
+	return cves, nil
+}
+
+// GetAlertMatches summarizes matches made in [from, to) by the alerts the user owns or that are
+// shared with their organization, most matches first; alerts without matches are left out
+func (r *ReportRepository) GetAlertMatches(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.ReportAlertMatches, error) {
+	query := `
+		SELECT
+			al.id,
+			al.name,
+			al.user_id <> $1,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE am.priority = 'critical'),
+			COUNT(*) FILTER (WHERE am.priority = 'high'),
+			COUNT(*) FILTER (WHERE am.status = 'new')
+		FROM alerts al
+		JOIN alert_matches am ON am.alert_id = al.id
+		WHERE (al.user_id = $1
+				OR al.organization_id = (SELECT organization_id FROM organization_members WHERE user_id = $1))
+			AND am.matched_at >= $2 AND am.matched_at < $3
+		GROUP BY al.id, al.name, al.user_id
+		ORDER BY COUNT(*) DESC, al.name ASC
+	`
+
+	return r.queryAlertMatches(ctx, query, userID, from, to)
+}
+
+// GetOrganizationAlertMatches summarizes matches made in [from, to) by the alerts shared with
+// the organization, most matches first; alerts without matches are left out
+func (r *ReportRepository) GetOrganizationAlertMatches(ctx context.Context, orgID uuid.UUID, from, to time.Time) ([]domain.ReportAlertMatches, error) {
+	query := `
+		SELECT
+			al.id,
+			al.name,
+			true,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE am.priority = 'critical'),
+			COUNT(*) FILTER (WHERE am.priority = 'high'),
+			COUNT(*) FILTER (WHERE am.status = 'new')
+		FROM alerts al
+		JOIN alert_matches am ON am.alert_id = al.id
+		WHERE al.organization_id = $1 AND am.matched_at >= $2 AND am.matched_at < $3
+		GROUP BY al.id, al.name
+		ORDER BY COUNT(*) DESC, al.name ASC
+	`
+
+	return r.queryAlertMatches(ctx, query, orgID, from, to)
+}
+
+// queryAlertMatches runs an alert match summary query
+func (r *ReportRepository) queryAlertMatches(ctx context.Context, query string, args ...interface{}) ([]domain.ReportAlertMatches, error) {
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert matches: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := make([]domain.ReportAlertMatches, 0)
+	for rows.Next() {
+		var summary domain.ReportAlertMatches
+		if err := rows.Scan(
+			&summary.AlertID,
+			&summary.AlertName,
+			&summary.Shared,
+			&summary.Matches,
+			&summary.Critical,
+			&summary.High,
+			&summary.Unacknowledged,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan alert matches: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating alert matches: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// ListPendingRecipients returns verified users on the weekly digest with email notifications
+// enabled who have not been sent the briefing for the week starting periodStart
+// Users who never saved preferences are on the daily digest, so they are not included
+func (r *ReportRepository) ListPendingRecipients(ctx context.Context, periodStart time.Time, limit int) ([]*domain.ReportRecipient, error) {
+	query := `
+		SELECT u.id, u.email, u.name
+		FROM users u
+		JOIN notification_preferences p ON p.user_id = u.id
+		WHERE p.digest_frequency = 'weekly'
+			AND u.email_verified = true
+			AND COALESCE((p.channels -> 'email' ->> 'enabled')::BOOLEAN, true)
+			AND NOT EXISTS (
+				SELECT 1 FROM weekly_report_deliveries d
+				WHERE d.user_id = u.id AND d.period_start = $1
+			)
+		ORDER BY u.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, periodStart, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report recipients: %w", err)
+	}
+	defer rows.Close()
+
+	recipients := make([]*domain.ReportRecipient, 0, limit)
+	for rows.Next() {
+		recipient := &domain.ReportRecipient{}
+		if err := rows.Scan(&recipient.UserID, &recipient.Email, &recipient.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan report recipient: %w", err)
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating report recipients: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// ClaimDelivery records that the week's briefing is being sent to the user
+// It returns false when it has already been claimed, by this or another instance
+func (r *ReportRepository) ClaimDelivery(ctx context.Context, userID uuid.UUID, periodStart time.Time) (bool, error) {
+	query := `
+		INSERT INTO weekly_report_deliveries (user_id, period_start, sent_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id, period_start) DO NOTHING
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, userID, periodStart)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim report delivery: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// ReleaseDelivery removes a claim whose email could not be sent, so it is tried again
+func (r *ReportRepository) ReleaseDelivery(ctx context.Context, userID uuid.UUID, periodStart time.Time) error {
+	query := `DELETE FROM weekly_report_deliveries WHERE user_id = $1 AND period_start = $2`
+
+	if _, err := r.db.Pool.Exec(ctx, query, userID, periodStart); err != nil {
+		return fmt.Errorf("failed to release report delivery: %w", err)
+	}
+
+	return nil
+}
+
+// reportArticleConditions returns the conditions selecting the published articles a report on
+// [from, to) covers, with their arguments numbered from $1
+func reportArticleConditions(from, to time.Time, filter *domain.ReportFilter) ([]string, []interface{}) {
+	where := []string{"articles.is_published = true", "articles.published_at >= $1", "articles.published_at < $2"}
+	args := []interface{}{from, to}
+
+	if filter == nil {
+		return where, args
+	}
+
+	if len(filter.Severities) > 0 {
+		values := make([]string, len(filter.Severities))
+		for i, severity := range filter.Severities {
+			values[i] = string(severity)
+		}
+		args = append(args, values)
+		where = append(where, fmt.Sprintf("articles.severity = ANY($%d)", len(args)))
+	}
+
+	if len(filter.CategoryIDs) > 0 {
+		args = append(args, filter.CategoryIDs)
+		where = append(where, inCategorySubtrees(len(args)))
+	}
+
+	if len(filter.Vendors) > 0 {
+		args = append(args, filter.Vendors)
+		where = append(where, fmt.Sprintf("articles.vendors && $%d", len(args)))
+	}
+
+	return where, args
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RequiredSchemaVersion is the latest migration this build depends on; bump it with each new migration
+const RequiredSchemaVersion = 63
+
+// SchemaRepository implements repository.SchemaRepository for PostgreSQL
+type SchemaRepository struct {
+	db *DB
+}
+
+// NewSchemaRepository creates a new PostgreSQL schema repository
+func NewSchemaRepository(db *DB) *SchemaRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &SchemaRepository{db: db}
+}
+
+// Ping checks that a connection can be acquired and used
+func (r *SchemaRepository) Ping(ctx context.Context) error {
+	return r.db.Pool.Ping(ctx)
+}
+
+// Version reads the golang-migrate version table
+func (r *SchemaRepository) Version(ctx context.Context) (int64, bool, error) {
+	var version int64
+	var dirty bool
+
+	err := r.db.Pool.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "42P01" {
+			return 0, false, fmt.Errorf("no migrations applied: schema_migrations table not found")
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, fmt.Errorf("no migrations applied")
+		}
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	return version, dirty, nil
+}
@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// Match scores assigned by global search queries
+// exact match > prefix match > substring match
+const (
+	matchScoreExact     = 1.0
+	matchScorePrefix    = 0.75
+	matchScoreSubstring = 0.5
+	matchScoreContent   = 0.25
+)
+
+// maxSuggestionTitles bounds the article titles whose words are considered for a suggestion
+const maxSuggestionTitles = 50
+
+// SearchRepository implements repository.GlobalSearchRepository, repository.SearchIndexRepository
+// and repository.SearchSuggestionRepository
+type SearchRepository struct {
+	db *DB
+}
+
+// NewSearchRepository creates a new PostgreSQL global search repository
+func NewSearchRepository(db *DB) *SearchRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &SearchRepository{db: db}
+}
+
+// SearchArticles finds published articles by title, falling back to content matches
+func (r *SearchRepository) SearchArticles(ctx context.Context, query string, limit int) ([]*domain.ArticleSearchHit, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	sqlQuery := `
+		SELECT id, title, slug, summary, severity, published_at, score
+		FROM (
+			SELECT
+				id, display_title AS title, slug, summary, severity, published_at,
+				CASE
+					WHEN LOWER(display_title) = LOWER($1) THEN $4::float8
+					WHEN display_title ILIKE $2 ESCAPE '\' THEN $5::float8
+					WHEN display_title ILIKE $3 ESCAPE '\' THEN $6::float8
+					ELSE $7::float8
+				END AS score
+			FROM (
+				SELECT
+					id, COALESCE(editorial_title, title) AS display_title, slug,
+					COALESCE(editorial_summary, summary) AS summary, severity, published_at, content
+				FROM articles
+				WHERE is_published = true
+			) published
+			WHERE display_title ILIKE $3 ESCAPE '\' OR content ILIKE $3 ESCAPE '\'
+		) matches
+		ORDER BY score DESC, published_at DESC
+		LIMIT $8
+	`
+
+	escaped := escapeLikePattern(query)
+
+	rows, err := r.db.read(ctx).Query(ctx, sqlQuery,
+		query,
+		escaped+"%",
+		"%"+escaped+"%",
+		matchScoreExact,
+		matchScorePrefix,
+		matchScoreSubstring,
+		matchScoreContent,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search articles: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]*domain.ArticleSearchHit, 0)
+	for rows.Next() {
+		hit := &domain.ArticleSearchHit{}
+		if err := rows.Scan(
+			&hit.ID,
+			&hit.Title,
+			&hit.Slug,
+			&hit.Summary,
+			&hit.Severity,
+			&hit.PublishedAt,
+			&hit.Score,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan article hit: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating article hits: %w", err)
+	}
+
+	return hits, nil
+}
+
+// SearchCVEs finds CVE identifiers referenced by published articles
+func (r *SearchRepository) SearchCVEs(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error) {
+	sqlQuery := `
+		SELECT term.value, COUNT(DISTINCT a.id), MAX(a.published_at)
+		FROM articles a
+		CROSS JOIN LATERAL UNNEST(a.cves) AS term(value)
+		WHERE a.is_published = true
+			AND term.value ILIKE $1 ESCAPE '\'
+		GROUP BY term.value
+	`
+
+	return r.searchTerms(ctx, sqlQuery, query, limit, "cves")
+}
+
+// SearchVendors finds vendor names referenced by published articles
+func (r *SearchRepository) SearchVendors(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error) {
+	sqlQuery := `
+		SELECT term.value, COUNT(DISTINCT a.id), MAX(a.published_at)
+		FROM articles a
+		CROSS JOIN LATERAL UNNEST(a.vendors) AS term(value)
+		WHERE a.is_published = true
+			AND term.value ILIKE $1 ESCAPE '\'
+		GROUP BY term.value
+	`
+
+	return r.searchTerms(ctx, sqlQuery, query, limit, "vendors")
+}
+
+// SearchThreatActors finds threat actors from deep dive profiles by name or alias
+func (r *SearchRepository) SearchThreatActors(ctx context.Context, query string, limit int) ([]*domain.TermSearchHit, error) {
+	sqlQuery := `
+		SELECT ta.name, COUNT(DISTINCT a.id), MAX(a.published_at)
+		FROM deep_dive_threat_actors ta
+		JOIN article_deep_dives dd ON dd.id = ta.deep_dive_id
+		JOIN articles a ON a.id = dd.article_id
+		WHERE a.is_published = true
+			AND (ta.name ILIKE $1 ESCAPE '\' OR ta.aliases::text ILIKE $1 ESCAPE '\')
+		GROUP BY ta.name
+	`
+
+	return r.searchTerms(ctx, sqlQuery, query, limit, "threat actors")
+}
+
+// searchTerms runs an aggregate term query and ranks the results by match quality
+// The query must select (value, article_count, last_seen_at) filtered by a $1 ILIKE pattern
+func (r *SearchRepository) searchTerms(ctx context.Context, baseQuery, query string, limit int, kind string) ([]*domain.TermSearchHit, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT value, article_count, last_seen_at,
+			CASE
+				WHEN LOWER(value) = LOWER($2) THEN $4::float8
+				WHEN value ILIKE $3 ESCAPE '\' THEN $5::float8
+				ELSE $6::float8
+			END AS score
+		FROM (%s) AS terms(value, article_count, last_seen_at)
+		ORDER BY score DESC, article_count DESC, last_seen_at DESC
+		LIMIT $7
+	`, baseQuery)
+
+	escaped := escapeLikePattern(query)
+
+	rows, err := r.db.read(ctx).Query(ctx, sqlQuery,
+		"%"+escaped+"%",
+		query,
+		escaped+"%",
+		matchScoreExact,
+		matchScorePrefix,
+		matchScoreSubstring,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", kind, err)
+	}
+	defer rows.Close()
+
+	hits := make([]*domain.TermSearchHit, 0)
+	for rows.Next() {
+		hit := &domain.TermSearchHit{}
+		if err := rows.Scan(&hit.Value, &hit.ArticleCount, &hit.LastSeenAt, &hit.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan %s hit: %w", kind, err)
+		}
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s hits: %w", kind, err)
+	}
+
+	return hits, nil
+}
+
+// SuggestTerm implements repository.SearchSuggestionRepository
+// Candidates are tag names and the words of published titles that contain a similar word
+func (r *SearchRepository) SuggestTerm(ctx context.Context, word string) (string, error) {
+	if word == "" {
+		return "", fmt.Errorf("word cannot be empty")
+	}
+
+	sqlQuery := `
+		SELECT term
+		FROM (
+			SELECT t.name AS term, similarity(t.name, $1) AS score
+			FROM tags t
+			WHERE t.name % $1
+			UNION ALL
+			SELECT word, similarity(word, $1)
+			FROM (
+				SELECT title
+				FROM articles
+				WHERE is_published = true AND $1 <% title
+				LIMIT $2
+			) candidates,
+			regexp_split_to_table(LOWER(candidates.title), '[^[:alnum:]-]+') AS word
+			WHERE word % $1
+		) terms
+		ORDER BY score DESC, term
+		LIMIT 1
+	`
+
+	var term string
+	err := r.db.read(ctx).QueryRow(ctx, sqlQuery, strings.ToLower(word), maxSuggestionTitles).Scan(&term)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to suggest search term: %w", err)
+	}
+
+	// The word is already known, so there is nothing to correct
+	if term == strings.ToLower(word) {
+		return "", nil
+	}
+
+	return term, nil
+}
+
+// escapeLikePattern escapes LIKE wildcards so user input is matched literally
+func escapeLikePattern(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s)
+}
+
+// searchIndexes are the article indexes used by search and global search
+var searchIndexes = []string{
+	"idx_articles_search_vector",
+	"idx_articles_title_trgm",
+	"idx_articles_tags",
+	"idx_articles_cves",
+	"idx_articles_vendors",
+	"idx_tags_name_trgm",
+}
+
+// Reindex implements repository.SearchIndexRepository
+// REINDEX CONCURRENTLY cannot run in a transaction, so each index is rebuilt in its own statement
+func (r *SearchRepository) Reindex(ctx context.Context) error {
+	for _, index := range searchIndexes {
+		if _, err := r.db.Pool.Exec(ctx, "REINDEX INDEX CONCURRENTLY "+index); err != nil {
+			return fmt.Errorf("failed to reindex %s: %w", index, err)
+		}
+	}
+
+	if _, err := r.db.Pool.Exec(ctx, "ANALYZE articles"); err != nil {
+		return fmt.Errorf("failed to analyze articles: %w", err)
+	}
+
+	if _, err := r.db.Pool.Exec(ctx, "ANALYZE tags"); err != nil {
+		return fmt.Errorf("failed to analyze tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ShareLinkRepository implements repository.ShareLinkRepository for PostgreSQL
+type ShareLinkRepository struct {
+	db *DB
+}
+
+// NewShareLinkRepository creates a new PostgreSQL share link repository
+func NewShareLinkRepository(db *DB) *ShareLinkRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ShareLinkRepository{db: db}
+}
+
+// Create inserts a new share link
+func (r *ShareLinkRepository) Create(ctx context.Context, link *domain.ShareLink) error {
+	if link == nil {
+		return fmt.Errorf("share link cannot be nil")
+	}
+
+	if link.ID == uuid.Nil {
+		return fmt.Errorf("share link ID cannot be nil")
+	}
+
+	query := `
+		INSERT INTO article_share_links (id, code, article_id, created_by, channel, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		link.ID,
+		link.Code,
+		link.ArticleID,
+		link.CreatedBy,
+		link.Channel,
+		link.ExpiresAt,
+		link.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetByCode retrieves a share link by its code
+func (r *ShareLinkRepository) GetByCode(ctx context.Context, code string) (*domain.ShareLink, error) {
+	query := `
+		SELECT id, code, article_id, created_by, channel, expires_at, click_count, last_clicked_at, created_at
+		FROM article_share_links
+		WHERE code = $1
+	`
+
+	var link domain.ShareLink
+	err := r.db.Pool.QueryRow(ctx, query, code).Scan(
+		&link.ID,
+		&link.Code,
+		&link.ArticleID,
+		&link.CreatedBy,
+		&link.Channel,
+		&link.ExpiresAt,
+		&link.ClickCount,
+		&link.LastClickedAt,
+		&link.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "share link",
+			ID:       code,
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+
+	return &link, nil
+}
+
+// RecordClick stores a click and increments the link's click count in one transaction
+func (r *ShareLinkRepository) RecordClick(ctx context.Context, click *domain.ShareClick) error {
+	if click == nil {
+		return fmt.Errorf("share click cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO article_share_clicks (id, share_link_id, source, referrer_host, clicked_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, click.ID, click.ShareLinkID, click.Source, click.ReferrerHost, click.ClickedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record share click: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE article_share_links
+		SET click_count = click_count + 1, last_clicked_at = $2
+		WHERE id = $1
+	`, click.ShareLinkID, click.ClickedAt)
+	if err != nil {
+		return fmt.Errorf("failed to increment share link clicks: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit share click: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// maxSIEMEventErrorLength bounds the delivery error stored on an event
+const maxSIEMEventErrorLength = 1000
+
+// siemEventColumns are the columns scanned by scanSIEMEvent
+const siemEventColumns = `
+	id, destination, event_type, payload, occurred_at, status, attempts,
+	last_error, next_attempt_at, delivered_at, created_at`
+
+// SIEMRepository implements repository.SIEMRepository
+type SIEMRepository struct {
+	db *DB
+}
+
+// NewSIEMRepository creates a new SIEM repository instance
+func NewSIEMRepository(db *DB) *SIEMRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &SIEMRepository{db: db}
+}
+
+// Enqueue copies the events into the queue in one round trip
+func (r *SIEMRepository) Enqueue(ctx context.Context, events []*domain.SIEMEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	columns := []string{
+		"id", "destination", "event_type", "payload", "occurred_at",
+		"status", "next_attempt_at", "created_at",
+	}
+
+	rows := make([][]interface{}, len(events))
+	for i, event := range events {
+		rows[i] = []interface{}{
+			event.ID,
+			event.Destination,
+			string(event.Type),
+			[]byte(event.Payload),
+			event.OccurredAt,
+			string(domain.SIEMEventPending),
+			event.NextAttemptAt,
+			event.CreatedAt,
+		}
+	}
+
+	if _, err := r.db.Pool.CopyFrom(ctx, pgx.Identifier{"siem_events"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to queue SIEM events: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue claims up to limit of a destination's due pending events, oldest first
+// Claimed events are not due again until leaseUntil, so several workers can run at once
+// and events held by a crashed worker are picked up once the lease runs out
+func (r *SIEMRepository) ClaimDue(ctx context.Context, destination string, limit int, leaseUntil time.Time) ([]*domain.SIEMEvent, error) {
+	query := `
+		UPDATE siem_events
+		SET next_attempt_at = $3
+		WHERE id IN (
+			SELECT id FROM siem_events
+			WHERE destination = $1 AND status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + siemEventColumns
+
+	rows, err := r.db.Pool.Query(ctx, query, destination, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim SIEM events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.SIEMEvent, 0, limit)
+	for rows.Next() {
+		event, err := scanSIEMEvent(rows, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SIEM event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating SIEM events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkDelivered records that the events reached their destination
+func (r *SIEMRepository) MarkDelivered(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE siem_events
+		SET status = 'delivered', attempts = attempts + 1, last_error = NULL, delivered_at = $2
+		WHERE id = ANY($1)
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, ids, at); err != nil {
+		return fmt.Errorf("failed to mark SIEM events delivered: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFailure records a failed delivery attempt for the events
+// They are retried with exponential backoff capped at one hour, unless the failure is
+// permanent or this was attempt maxAttempts, in which case they are marked failed
+func (r *SIEMRepository) RecordFailure(ctx context.Context, ids []uuid.UUID, message string, permanent bool, maxAttempts int) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if len(message) > maxSIEMEventErrorLength {
+		message = message[:maxSIEMEventErrorLength]
+	}
+
+	query := `
+		UPDATE siem_events
+		SET attempts = attempts + 1,
+			last_error = $2,
+			status = CASE WHEN $3 OR attempts + 1 >= $4 THEN 'failed' ELSE 'pending' END,
+			next_attempt_at = NOW() + LEAST(INTERVAL '1 hour', INTERVAL '10 seconds' * POWER(2, LEAST(attempts, 12)))
+		WHERE id = ANY($1)
+		RETURNING status
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, ids, message, permanent, maxAttempts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record SIEM event failure: %w", err)
+	}
+	defer rows.Close()
+
+	var failed int64
+	for rows.Next() {
+		var status domain.SIEMEventStatus
+		if err := rows.Scan(&status); err != nil {
+			return 0, fmt.Errorf("failed to scan SIEM event status: %w", err)
+		}
+		if status == domain.SIEMEventFailed {
+			failed++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating SIEM event statuses: %w", err)
+	}
+
+	return failed, nil
+}
+
+// List returns events matching the filter, newest first
+func (r *SIEMRepository) List(ctx context.Context, filter *domain.SIEMEventFilter) ([]*domain.SIEMEvent, int, error) {
+	if filter == nil {
+		filter = &domain.SIEMEventFilter{Page: 1, PageSize: 20}
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.Destination != nil {
+		args = append(args, *filter.Destination)
+		where = append(where, fmt.Sprintf("destination = $%d", len(args)))
+	}
+
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if filter.Type != nil {
+		args = append(args, *filter.Type)
+		where = append(where, fmt.Sprintf("event_type = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER ()
+		FROM siem_events
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, siemEventColumns, strings.Join(where, " AND "), len(args)+1, len(args)+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list SIEM events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.SIEMEvent, 0)
+	total := 0
+
+	for rows.Next() {
+		event, err := scanSIEMEvent(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan SIEM event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating SIEM events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// RetryFailed queues a destination's failed events for immediate delivery with a fresh set
+// of attempts and returns how many there were
+func (r *SIEMRepository) RetryFailed(ctx context.Context, destination string) (int64, error) {
+	query := `
+		UPDATE siem_events
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+		WHERE destination = $1 AND status = 'failed'
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, destination)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retry SIEM events: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// DeleteDeliveredBefore deletes events delivered before the cutoff; failed events are kept
+func (r *SIEMRepository) DeleteDeliveredBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `DELETE FROM siem_events WHERE status = 'delivered' AND delivered_at < $1`
+
+	result, err := r.db.Pool.Exec(ctx, query, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete delivered SIEM events: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetDestinationState returns a destination's toggle, enabled when it has never been set,
+// along with its pending and failed event counts and last delivery
+func (r *SIEMRepository) GetDestinationState(ctx context.Context, name string) (*domain.SIEMDestinationState, error) {
+	query := `
+		SELECT
+			COALESCE(d.enabled, TRUE),
+			d.updated_by,
+			d.updated_at,
+			(SELECT COUNT(*) FROM siem_events WHERE destination = $1 AND status = 'pending'),
+			(SELECT COUNT(*) FROM siem_events WHERE destination = $1 AND status = 'failed'),
+			(SELECT MAX(delivered_at) FROM siem_events WHERE destination = $1 AND status = 'delivered')
+		FROM (SELECT $1::VARCHAR AS name) AS requested
+		LEFT JOIN siem_destinations d ON d.name = requested.name
+	`
+
+	state := &domain.SIEMDestinationState{}
+	err := r.db.read(ctx).QueryRow(ctx, query, name).Scan(
+		&state.Enabled,
+		&state.UpdatedBy,
+		&state.UpdatedAt,
+		&state.Pending,
+		&state.Failed,
+		&state.LastDeliveredAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SIEM destination state: %w", err)
+	}
+
+	return state, nil
+}
+
+// SetDestinationEnabled turns a destination on or off
+func (r *SIEMRepository) SetDestinationEnabled(ctx context.Context, name string, enabled bool, updatedBy uuid.UUID) error {
+	query := `
+		INSERT INTO siem_destinations (name, enabled, updated_by, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (name) DO UPDATE
+		SET enabled = EXCLUDED.enabled, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, name, enabled, updatedBy); err != nil {
+		return fmt.Errorf("failed to set SIEM destination state: %w", err)
+	}
+
+	return nil
+}
+
+// scanSIEMEvent scans siemEventColumns, followed by a total count when total is non-nil
+func scanSIEMEvent(row pgx.Row, total *int) (*domain.SIEMEvent, error) {
+	event := &domain.SIEMEvent{}
+	var payload []byte
+
+	dest := []interface{}{
+		&event.ID,
+		&event.Destination,
+		&event.Type,
+		&payload,
+		&event.OccurredAt,
+		&event.Status,
+		&event.Attempts,
+		&event.LastError,
+		&event.NextAttemptAt,
+		&event.DeliveredAt,
+		&event.CreatedAt,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	event.Payload = payload
+
+	return event, nil
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// inventoryItemColumns are the columns scanned by scanInventoryItems, selected from software_inventory i
+// with the item's matched CVEs, limited to $cveParam when it is set
+const inventoryItemColumns = `
+	i.id, i.user_id, i.organization_id, i.vendor, i.product, i.version, i.cpe, i.created_by, i.created_at,
+	COALESCE(ARRAY(
+		SELECT m.cve_id FROM software_inventory_cves m
+		WHERE m.item_id = i.id %s
+		ORDER BY m.cve_id DESC
+	), '{}')
+`
+
+// SoftwareInventoryRepository implements repository.SoftwareInventoryRepository for PostgreSQL
+type SoftwareInventoryRepository struct {
+	db *DB
+}
+
+// NewSoftwareInventoryRepository creates a new PostgreSQL software inventory repository
+func NewSoftwareInventoryRepository(db *DB) *SoftwareInventoryRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &SoftwareInventoryRepository{db: db}
+}
+
+// ListByOwner returns the owner's inventory ordered by vendor, product and version, with matched CVEs
+func (r *SoftwareInventoryRepository) ListByOwner(ctx context.Context, owner domain.InventoryOwner) ([]*domain.InventoryItem, error) {
+	query := `SELECT ` + fmt.Sprintf(inventoryItemColumns, "") + `
+		FROM software_inventory i
+		WHERE i.user_id = $1 OR i.organization_id = $2
+		ORDER BY i.vendor ASC, i.product ASC, i.version ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, owner.UserID, owner.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list software inventory: %w", err)
+	}
+
+	return scanInventoryItems(rows)
+}
+
+// Replace swaps the owner's whole inventory for the items, with their matched CVEs, in one transaction
+func (r *SoftwareInventoryRepository) Replace(ctx context.Context, owner domain.InventoryOwner, items []*domain.InventoryItem) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM software_inventory WHERE user_id = $1 OR organization_id = $2`, owner.UserID, owner.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to remove software inventory: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	for _, item := range items {
+		batch.Queue(`
+			INSERT INTO software_inventory (id, user_id, organization_id, vendor, product, version, cpe, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, item.ID, item.UserID, item.OrganizationID, item.Vendor, item.Product, item.Version, item.CPE, item.CreatedBy, item.CreatedAt)
+	}
+	queueInventoryMatches(batch, items)
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to insert software inventory: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit software inventory: %w", err)
+	}
+
+	return nil
+}
+
+// ListByProducts returns every inventory item of any of the products, across owners
+func (r *SoftwareInventoryRepository) ListByProducts(ctx context.Context, keys []domain.ProductKey) ([]*domain.InventoryItem, error) {
+	if len(keys) == 0 {
+		return []*domain.InventoryItem{}, nil
+	}
+
+	vendors, products := splitProductKeys(keys)
+	query := `SELECT ` + fmt.Sprintf(inventoryItemColumns, "") + `
+		FROM software_inventory i
+		WHERE (i.vendor, i.product) IN (SELECT * FROM UNNEST($1::TEXT[], $2::TEXT[]))
+		ORDER BY i.vendor ASC, i.product ASC, i.version ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, vendors, products)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list software inventory by product: %w", err)
+	}
+
+	return scanInventoryItems(rows)
+}
+
+// ReplaceMatches replaces the matched CVEs of each item, in one transaction
+func (r *SoftwareInventoryRepository) ReplaceMatches(ctx context.Context, items []*domain.InventoryItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	itemIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		itemIDs[i] = item.ID
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM software_inventory_cves WHERE item_id = ANY($1)`, itemIDs); err != nil {
+		return fmt.Errorf("failed to remove inventory matches: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	queueInventoryMatches(batch, items)
+
+	if batch.Len() > 0 {
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to insert inventory matches: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit inventory matches: %w", err)
+	}
+
+	return nil
+}
+
+// ListMatching returns the items of the user's or the organization's inventory matched to any of
+// the CVEs, each with only those matched CVEs
+func (r *SoftwareInventoryRepository) ListMatching(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID, cveIDs []string) ([]*domain.InventoryItem, error) {
+	if len(cveIDs) == 0 {
+		return []*domain.InventoryItem{}, nil
+	}
+
+	query := `SELECT ` + fmt.Sprintf(inventoryItemColumns, "AND m.cve_id = ANY($3)") + `
+		FROM software_inventory i
+		WHERE (i.user_id = $1 OR i.organization_id = $2)
+			AND EXISTS (SELECT 1 FROM software_inventory_cves m WHERE m.item_id = i.id AND m.cve_id = ANY($3))
+		ORDER BY i.vendor ASC, i.product ASC, i.version ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, userID, orgID, cveIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list matching software inventory: %w", err)
+	}
+
+	return scanInventoryItems(rows)
+}
+
+// queueInventoryMatches queues an insert of each item's matched CVEs
+func queueInventoryMatches(batch *pgx.Batch, items []*domain.InventoryItem) {
+	for _, item := range items {
+		for _, cveID := range item.MatchedCVEs {
+			batch.Queue(`
+				INSERT INTO software_inventory_cves (item_id, cve_id) VALUES ($1, $2)
+				ON CONFLICT DO NOTHING
+			`, item.ID, cveID)
+		}
+	}
+}
+
+// scanInventoryItems reads and closes rows selected with inventoryItemColumns
+func scanInventoryItems(rows pgx.Rows) ([]*domain.InventoryItem, error) {
+	defer rows.Close()
+
+	items := make([]*domain.InventoryItem, 0)
+	for rows.Next() {
+		var item domain.InventoryItem
+		if err := rows.Scan(
+			&item.ID, &item.UserID, &item.OrganizationID, &item.Vendor, &item.Product, &item.Version,
+			&item.CPE, &item.CreatedBy, &item.CreatedAt, &item.MatchedCVEs,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan inventory item: %w", err)
+		}
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating inventory items: %w", err)
+	}
+
+	return items, nil
+}
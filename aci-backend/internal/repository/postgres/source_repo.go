@@ -38,7 +38,7 @@ func (r *sourceRepository) Create(ctx context.Context, source *domain.Source) er
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
-	_, err := r.db.Pool.Exec(ctx, query,
+	_, err := r.db.conn(ctx).Exec(ctx, query,
 		source.ID,
 		source.Name,
 		source.URL,
@@ -104,7 +104,7 @@ func (r *sourceRepository) GetByURL(ctx context.Context, url string) (*domain.So
 	`
 
 	source := &domain.Source{}
-	err := r.db.Pool.QueryRow(ctx, query, url).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, url).Scan(
 		&source.ID,
 		&source.Name,
 		&source.URL,
@@ -139,7 +139,7 @@ func (r *sourceRepository) GetByName(ctx context.Context, name string) (*domain.
 	`
 
 	source := &domain.Source{}
-	err := r.db.Pool.QueryRow(ctx, query, name).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, name).Scan(
 		&source.ID,
 		&source.Name,
 		&source.URL,
@@ -161,6 +161,50 @@ func (r *sourceRepository) GetByName(ctx context.Context, name string) (*domain.
 	return source, nil
 }
 
+// GetByIDs retrieves the sources with the given IDs
+func (r *sourceRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Source, error) {
+	sources := make([]*domain.Source, 0, len(ids))
+	if len(ids) == 0 {
+		return sources, nil
+	}
+
+	query := `
+		SELECT id, name, url, description, is_active, trust_score, last_scraped_at, created_at
+		FROM sources
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sources: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		source := &domain.Source{}
+		err := rows.Scan(
+			&source.ID,
+			&source.Name,
+			&source.URL,
+			&source.Description,
+			&source.IsActive,
+			&source.TrustScore,
+			&source.LastScrapedAt,
+			&source.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan source: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sources: %w", err)
+	}
+
+	return sources, nil
+}
+
 // List retrieves all sources, optionally filtering by active status
 func (r *sourceRepository) List(ctx context.Context, activeOnly bool) ([]*domain.Source, error) {
 	query := `
@@ -222,7 +266,7 @@ func (r *sourceRepository) Update(ctx context.Context, source *domain.Source) er
 		WHERE id = $1
 	`
 
-	cmdTag, err := r.db.Pool.Exec(ctx, query,
+	cmdTag, err := r.db.conn(ctx).Exec(ctx, query,
 		source.ID,
 		source.Name,
 		source.URL,
@@ -251,7 +295,7 @@ func (r *sourceRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	query := `DELETE FROM sources WHERE id = $1`
 
-	cmdTag, err := r.db.Pool.Exec(ctx, query, id)
+	cmdTag, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete source: %w", err)
 	}
@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// SourceTrustRepository implements repository.SourceTrustRepository for PostgreSQL
+type SourceTrustRepository struct {
+	db *DB
+}
+
+// NewSourceTrustRepository creates a new PostgreSQL source trust repository
+func NewSourceTrustRepository(db *DB) *SourceTrustRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &SourceTrustRepository{db: db}
+}
+
+// RecordEvent stores a correction or deletion against the article's source
+func (r *SourceTrustRepository) RecordEvent(ctx context.Context, sourceID, articleID uuid.UUID, eventType domain.SourceTrustEventType) error {
+	query := `
+		INSERT INTO source_trust_events (source_id, article_id, event_type)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, sourceID, articleID, eventType); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &domainerrors.NotFoundError{
+				Resource: "source",
+				ID:       sourceID.String(),
+			}
+		}
+		return fmt.Errorf("failed to record source trust event: %w", err)
+	}
+
+	return nil
+}
+
+// GetSignals aggregates each active source's signals over articles created since the given time
+// Reads are counted on those articles only, so older articles do not inflate engagement
+func (r *SourceTrustRepository) GetSignals(ctx context.Context, since time.Time) ([]*domain.SourceTrustSignals, error) {
+	query := `
+		WITH window_articles AS (
+			SELECT a.id, a.source_id, a.is_competitor_favorable,
+				(f.canonical_id IS NOT NULL AND f.canonical_id <> a.id) AS is_duplicate
+			FROM articles a
+			LEFT JOIN article_fingerprints f ON f.article_id = a.id
+			WHERE a.created_at >= $1
+		),
+		article_counts AS (
+			SELECT source_id,
+				COUNT(*) AS articles,
+				COUNT(*) FILTER (WHERE is_duplicate) AS duplicates,
+				COUNT(*) FILTER (WHERE is_competitor_favorable) AS competitor_favorable
+			FROM window_articles
+			GROUP BY source_id
+		),
+		read_counts AS (
+			SELECT wa.source_id, COUNT(*) AS reads
+			FROM article_reads ar
+			JOIN window_articles wa ON wa.id = ar.article_id
+			GROUP BY wa.source_id
+		),
+		event_counts AS (
+			SELECT source_id,
+				COUNT(*) FILTER (WHERE event_type = 'corrected') AS corrected,
+				COUNT(*) FILTER (WHERE event_type = 'deleted') AS deleted
+			FROM source_trust_events
+			WHERE created_at >= $1
+			GROUP BY source_id
+		)
+		SELECT
+			s.id, s.trust_score,
+			(SELECT MAX(c.created_at) FROM source_trust_changes c WHERE c.source_id = s.id),
+			COALESCE(ac.articles, 0), COALESCE(ac.duplicates, 0),
+			COALESCE(ec.corrected, 0), COALESCE(ec.deleted, 0),
+			COALESCE(ac.competitor_favorable, 0), COALESCE(rc.reads, 0)
+		FROM sources s
+		LEFT JOIN article_counts ac ON ac.source_id = s.id
+		LEFT JOIN read_counts rc ON rc.source_id = s.id
+		LEFT JOIN event_counts ec ON ec.source_id = s.id
+		WHERE s.is_active = true
+		ORDER BY s.name ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source trust signals: %w", err)
+	}
+	defer rows.Close()
+
+	signals := make([]*domain.SourceTrustSignals, 0)
+	for rows.Next() {
+		s := &domain.SourceTrustSignals{}
+		if err := rows.Scan(
+			&s.SourceID,
+			&s.TrustScore,
+			&s.LastCalibratedAt,
+			&s.Articles,
+			&s.Duplicates,
+			&s.Corrected,
+			&s.Deleted,
+			&s.CompetitorFavorable,
+			&s.Reads,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan source trust signals: %w", err)
+		}
+		signals = append(signals, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating source trust signals: %w", err)
+	}
+
+	return signals, nil
+}
+
+// GetAverageReads returns the average reads per article across all sources since the given time
+func (r *SourceTrustRepository) GetAverageReads(ctx context.Context, since time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(
+			(SELECT COUNT(*) FROM article_reads ar JOIN articles a ON a.id = ar.article_id WHERE a.created_at >= $1)::FLOAT
+				/ NULLIF((SELECT COUNT(*) FROM articles WHERE created_at >= $1), 0),
+			0
+		)
+	`
+
+	var avg float64
+	if err := r.db.Pool.QueryRow(ctx, query, since).Scan(&avg); err != nil {
+		return 0, fmt.Errorf("failed to get average reads: %w", err)
+	}
+
+	return avg, nil
+}
+
+// Apply updates the source's trust score and records the change in one transaction
+// The update only applies if the score is unchanged since the signals were read
+func (r *SourceTrustRepository) Apply(ctx context.Context, change *domain.SourceTrustChange) error {
+	if change == nil {
+		return fmt.Errorf("source trust change cannot be nil")
+	}
+
+	signals, err := json.Marshal(change.Signals)
+	if err != nil {
+		return fmt.Errorf("failed to marshal source trust signals: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx,
+		`UPDATE sources SET trust_score = $2 WHERE id = $1 AND trust_score = $3`,
+		change.SourceID, change.NewScore, change.PreviousScore,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update source trust score: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.ConflictError{
+			Resource: "source",
+			Field:    "trust_score",
+			Value:    change.SourceID.String(),
+		}
+	}
+
+	query := `
+		INSERT INTO source_trust_changes (source_id, previous_score, new_score, target_score, signals)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err = tx.QueryRow(ctx, query,
+		change.SourceID,
+		change.PreviousScore,
+		change.NewScore,
+		change.TargetScore,
+		signals,
+	).Scan(&change.ID, &change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record source trust change: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit source trust change: %w", err)
+	}
+
+	return nil
+}
+
+// ListChanges returns the source's trust score changes, newest first
+func (r *SourceTrustRepository) ListChanges(ctx context.Context, sourceID uuid.UUID, limit, offset int) ([]*domain.SourceTrustChange, int, error) {
+	var total int
+	if err := r.db.Pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM source_trust_changes WHERE source_id = $1`, sourceID,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count source trust changes: %w", err)
+	}
+
+	query := `
+		SELECT id, source_id, previous_score, new_score, target_score, signals, created_at
+		FROM source_trust_changes
+		WHERE source_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, sourceID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list source trust changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := make([]*domain.SourceTrustChange, 0)
+	for rows.Next() {
+		change := &domain.SourceTrustChange{}
+		var signals []byte
+		if err := rows.Scan(
+			&change.ID,
+			&change.SourceID,
+			&change.PreviousScore,
+			&change.NewScore,
+			&change.TargetScore,
+			&signals,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan source trust change: %w", err)
+		}
+
+		change.Delta = domain.RoundTrustScore(change.NewScore - change.PreviousScore)
+		change.Signals = &domain.SourceTrustSignals{}
+		if err := json.Unmarshal(signals, change.Signals); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal source trust signals: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating source trust changes: %w", err)
+	}
+
+	return changes, total, nil
+}
@@ -0,0 +1,340 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// TagRepository implements repository.TagRepository for PostgreSQL
+type TagRepository struct {
+	db *DB
+}
+
+// NewTagRepository creates a new PostgreSQL tag repository
+func NewTagRepository(db *DB) *TagRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &TagRepository{db: db}
+}
+
+// List returns tags with their aliases and article counts, most used first
+func (r *TagRepository) List(ctx context.Context, query string, limit, offset int) ([]*domain.Tag, int, error) {
+	where := ""
+	args := []interface{}{}
+	if query != "" {
+		where = `WHERE t.name ILIKE $1 OR EXISTS (
+			SELECT 1 FROM tag_aliases ta WHERE ta.tag_id = t.id AND ta.alias ILIKE $1
+		)`
+		args = append(args, "%"+query+"%")
+	}
+
+	var total int
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM tags t %s`, where)
+	if err := r.db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count tags: %w", err)
+	}
+
+	listQuery := fmt.Sprintf(`
+		SELECT
+			t.id, t.name, t.created_at, t.updated_at,
+			ARRAY(SELECT ta.alias FROM tag_aliases ta WHERE ta.tag_id = t.id ORDER BY ta.alias),
+			COALESCE(c.article_count, 0)
+		FROM tags t
+		LEFT JOIN (
+			SELECT tag, COUNT(*) AS article_count
+			FROM articles, UNNEST(articles.tags) AS tag
+			GROUP BY tag
+		) c ON c.tag = t.name
+		%s
+		ORDER BY COALESCE(c.article_count, 0) DESC, t.name ASC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.db.Pool.Query(ctx, listQuery, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]*domain.Tag, 0)
+	for rows.Next() {
+		tag := &domain.Tag{}
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.CreatedAt, &tag.UpdatedAt, &tag.Aliases, &tag.ArticleCount); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating tags: %w", err)
+	}
+
+	return tags, total, nil
+}
+
+// GetByID returns a tag with its aliases and article count
+func (r *TagRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	query := `
+		SELECT
+			t.id, t.name, t.created_at, t.updated_at,
+			ARRAY(SELECT ta.alias FROM tag_aliases ta WHERE ta.tag_id = t.id ORDER BY ta.alias),
+			(SELECT COUNT(*) FROM articles a WHERE a.tags @> ARRAY[t.name]::text[])
+		FROM tags t
+		WHERE t.id = $1
+	`
+
+	tag := &domain.Tag{}
+	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+		&tag.ID,
+		&tag.Name,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+		&tag.Aliases,
+		&tag.ArticleCount,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "tag",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// ResolveAliases maps each given name that is an alias to its canonical tag name
+func (r *TagRepository) ResolveAliases(ctx context.Context, names []string) (map[string]string, error) {
+	resolved := make(map[string]string)
+	if len(names) == 0 {
+		return resolved, nil
+	}
+
+	query := `
+		SELECT ta.alias, t.name
+		FROM tag_aliases ta
+		JOIN tags t ON t.id = ta.tag_id
+		WHERE ta.alias = ANY($1)
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve tag aliases: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var alias, name string
+		if err := rows.Scan(&alias, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan tag alias: %w", err)
+		}
+		resolved[alias] = name
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tag aliases: %w", err)
+	}
+
+	return resolved, nil
+}
+
+// EnsureExist registers canonical tags that are not yet known
+func (r *TagRepository) EnsureExist(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO tags (name)
+		SELECT UNNEST($1::text[])
+		ON CONFLICT (name) DO NOTHING
+	`
+
+	if _, err := r.db.Pool.Exec(ctx, query, names); err != nil {
+		return fmt.Errorf("failed to register tags: %w", err)
+	}
+
+	return nil
+}
+
+// Rename renames a tag within a transaction; the old name becomes an alias and
+// articles and feed preferences carrying it are rewritten
+func (r *TagRepository) Rename(ctx context.Context, id uuid.UUID, name string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var oldName string
+	err = tx.QueryRow(ctx, `SELECT name FROM tags WHERE id = $1 FOR UPDATE`, id).Scan(&oldName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domainerrors.NotFoundError{
+				Resource: "tag",
+				ID:       id.String(),
+			}
+		}
+		return 0, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	if oldName == name {
+		return 0, nil
+	}
+
+	// Renaming to one of the tag's own aliases drops that alias; another tag's alias is taken
+	var aliasOwner uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT tag_id FROM tag_aliases WHERE alias = $1`, name).Scan(&aliasOwner)
+	switch {
+	case err == nil && aliasOwner != id:
+		return 0, &domainerrors.ConflictError{
+			Resource: "tag alias",
+			Field:    "name",
+			Value:    name,
+		}
+	case err == nil:
+		if _, err := tx.Exec(ctx, `DELETE FROM tag_aliases WHERE alias = $1`, name); err != nil {
+			return 0, fmt.Errorf("failed to remove tag alias: %w", err)
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return 0, fmt.Errorf("failed to check tag aliases: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE tags SET name = $2, updated_at = NOW() WHERE id = $1`, id, name); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, &domainerrors.ConflictError{
+				Resource: "tag",
+				Field:    "name",
+				Value:    name,
+			}
+		}
+		return 0, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `INSERT INTO tag_aliases (alias, tag_id) VALUES ($1, $2)`, oldName, id); err != nil {
+		return 0, fmt.Errorf("failed to add tag alias: %w", err)
+	}
+
+	updated, err := rewriteTag(ctx, tx, oldName, name)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit tag rename: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Merge folds the source tag into the target within a transaction; the source name and
+// its aliases become aliases of the target and the source tag is deleted
+func (r *TagRepository) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (int64, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	names := make(map[uuid.UUID]string, 2)
+	rows, err := tx.Query(ctx, `SELECT id, name FROM tags WHERE id = ANY($1) FOR UPDATE`, []uuid.UUID{sourceID, targetID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tags: %w", err)
+	}
+	for rows.Next() {
+		var id uuid.UUID
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		names[id] = name
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating tags: %w", err)
+	}
+
+	for _, id := range []uuid.UUID{sourceID, targetID} {
+		if _, ok := names[id]; !ok {
+			return 0, &domainerrors.NotFoundError{
+				Resource: "tag",
+				ID:       id.String(),
+			}
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE tag_aliases SET tag_id = $2 WHERE tag_id = $1`, sourceID, targetID); err != nil {
+		return 0, fmt.Errorf("failed to move tag aliases: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM tags WHERE id = $1`, sourceID); err != nil {
+		return 0, fmt.Errorf("failed to delete merged tag: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `INSERT INTO tag_aliases (alias, tag_id) VALUES ($1, $2)`, names[sourceID], targetID); err != nil {
+		return 0, fmt.Errorf("failed to add tag alias: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE tags SET updated_at = NOW() WHERE id = $1`, targetID); err != nil {
+		return 0, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	updated, err := rewriteTag(ctx, tx, names[sourceID], names[targetID])
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit tag merge: %w", err)
+	}
+
+	return updated, nil
+}
+
+// rewriteTag replaces a tag on every article and feed preference that carries it,
+// dropping the duplicate when the new tag is already present, and returns the articles changed
+func rewriteTag(ctx context.Context, tx pgx.Tx, from, to string) (int64, error) {
+	articleQuery := `
+		UPDATE articles
+		SET tags = ARRAY(
+				SELECT n.tag
+				FROM UNNEST(ARRAY_REPLACE(tags, $1::text, $2::text)) WITH ORDINALITY AS n(tag, ord)
+				GROUP BY n.tag
+				ORDER BY MIN(n.ord)
+			),
+			updated_at = NOW()
+		WHERE tags @> ARRAY[$1::text]
+	`
+
+	result, err := tx.Exec(ctx, articleQuery, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rewrite article tags: %w", err)
+	}
+
+	prefsQuery := `
+		UPDATE user_preferences
+		SET excluded_tags = ARRAY(
+				SELECT DISTINCT UNNEST(ARRAY_REPLACE(excluded_tags, $1::text, $2::text))
+			)
+		WHERE excluded_tags @> ARRAY[$1::text]
+	`
+
+	if _, err := tx.Exec(ctx, prefsQuery, from, to); err != nil {
+		return 0, fmt.Errorf("failed to rewrite excluded tags: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+const tenantColumns = `id, slug, name, hostname, display_name, logo_url, primary_color, support_email, created_at, updated_at`
+
+// TenantRepository implements repository.TenantRepository for PostgreSQL
+type TenantRepository struct {
+	db *DB
+}
+
+// NewTenantRepository creates a new PostgreSQL tenant repository
+func NewTenantRepository(db *DB) *TenantRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &TenantRepository{db: db}
+}
+
+// Create creates a new tenant
+func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) error {
+	if tenant == nil {
+		return fmt.Errorf("tenant cannot be nil")
+	}
+
+	query := `
+		INSERT INTO tenants (slug, name, hostname, display_name, logo_url, primary_color, support_email)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		tenant.Slug,
+		tenant.Name,
+		tenant.Hostname,
+		tenant.Branding.DisplayName,
+		tenant.Branding.LogoURL,
+		tenant.Branding.PrimaryColor,
+		tenant.Branding.SupportEmail,
+	).Scan(&tenant.ID, &tenant.CreatedAt, &tenant.UpdatedAt)
+	if err != nil {
+		return r.mapWriteError(err, tenant, "failed to create tenant")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a tenant by ID
+func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Tenant, error) {
+	query := fmt.Sprintf(`SELECT %s FROM tenants WHERE id = $1`, tenantColumns)
+
+	tenant, err := scanTenant(r.db.read(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "tenant",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
+// List returns all tenants ordered by slug
+func (r *TenantRepository) List(ctx context.Context) ([]*domain.Tenant, error) {
+	query := fmt.Sprintf(`SELECT %s FROM tenants ORDER BY slug ASC`, tenantColumns)
+
+	rows, err := r.db.read(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	defer rows.Close()
+
+	tenants := make([]*domain.Tenant, 0)
+	for rows.Next() {
+		tenant, err := scanTenant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tenant: %w", err)
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tenants: %w", err)
+	}
+
+	return tenants, nil
+}
+
+// Update updates a tenant's slug, name, hostname and branding
+func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) error {
+	if tenant == nil {
+		return fmt.Errorf("tenant cannot be nil")
+	}
+
+	query := `
+		UPDATE tenants
+		SET slug = $2, name = $3, hostname = $4, display_name = $5, logo_url = $6,
+			primary_color = $7, support_email = $8, updated_at = NOW()
+		WHERE id = $1
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		tenant.ID,
+		tenant.Slug,
+		tenant.Name,
+		tenant.Hostname,
+		tenant.Branding.DisplayName,
+		tenant.Branding.LogoURL,
+		tenant.Branding.PrimaryColor,
+		tenant.Branding.SupportEmail,
+	).Scan(&tenant.CreatedAt, &tenant.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domainerrors.NotFoundError{
+				Resource: "tenant",
+				ID:       tenant.ID.String(),
+			}
+		}
+		return r.mapWriteError(err, tenant, "failed to update tenant")
+	}
+
+	return nil
+}
+
+// Delete deletes a tenant; its users, articles and other data cascade
+func (r *TenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.Exec(ctx, `DELETE FROM tenants WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "tenant",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// mapWriteError maps a duplicate slug or hostname to a ConflictError
+func (r *TenantRepository) mapWriteError(err error, tenant *domain.Tenant, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		if strings.Contains(pgErr.ConstraintName, "hostname") && tenant.Hostname != nil {
+			return &domainerrors.ConflictError{
+				Resource: "tenant",
+				Field:    "hostname",
+				Value:    *tenant.Hostname,
+			}
+		}
+		return &domainerrors.ConflictError{
+			Resource: "tenant",
+			Field:    "slug",
+			Value:    tenant.Slug,
+		}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// scanTenant scans a row selected with tenantColumns
+func scanTenant(row pgx.Row) (*domain.Tenant, error) {
+	tenant := &domain.Tenant{}
+	err := row.Scan(
+		&tenant.ID,
+		&tenant.Slug,
+		&tenant.Name,
+		&tenant.Hostname,
+		&tenant.Branding.DisplayName,
+		&tenant.Branding.LogoURL,
+		&tenant.Branding.PrimaryColor,
+		&tenant.Branding.SupportEmail,
+		&tenant.CreatedAt,
+		&tenant.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
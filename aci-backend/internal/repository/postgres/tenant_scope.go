@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// ScopeTenants limits every query on the pool to the tenant of its context
+// Before a connection is used it sets app.tenant_id, which the row-level security policies of
+// migration 000061 filter on: the tenant's ID, the nil UUID for the default workspace, or empty
+// for repository.WithoutTenant. Pools without it leave the setting empty, so every row is
+// visible, which is single-tenant mode. The database role must not be a superuser or have
+// BYPASSRLS, since either skips the policies
+func ScopeTenants(poolConfig *pgxpool.Config) {
+	poolConfig.PrepareConn = func(ctx context.Context, conn *pgx.Conn) (bool, error) {
+		setting := ""
+		if tenantID, ok := repository.TenantFromContext(ctx); ok {
+			setting = tenantID.String()
+		}
+
+		// A connection whose scope cannot be set is discarded rather than used unscoped
+		if _, err := conn.Exec(ctx, `SELECT set_config('app.tenant_id', $1, false)`, setting); err != nil {
+			return false, fmt.Errorf("failed to set tenant scope: %w", err)
+		}
+
+		return true, nil
+	}
+}
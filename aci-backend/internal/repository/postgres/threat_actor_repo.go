@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// ThreatActorRepository implements repository.ThreatActorRepository for PostgreSQL
+type ThreatActorRepository struct {
+	db *DB
+}
+
+// NewThreatActorRepository creates a new PostgreSQL threat actor repository
+func NewThreatActorRepository(db *DB) *ThreatActorRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ThreatActorRepository{db: db}
+}
+
+// threatActorColumns are the profile columns followed by the article statistics over published articles
+const threatActorColumns = `
+	t.id, t.slug, t.name, t.kind, t.aliases, t.match_name, t.motivation, t.origin, t.description,
+	t.curated, t.created_at, t.updated_at,
+	COUNT(DISTINCT a.id), MIN(a.published_at), MAX(a.published_at)
+`
+
+// threatActorJoins join each profile to its published articles
+const threatActorJoins = `
+	FROM threat_actors t
+	LEFT JOIN article_threat_actors ata ON ata.actor_id = t.id
+	LEFT JOIN articles a ON a.id = ata.article_id AND a.is_published = true
+`
+
+// Create stores a new profile; ConflictError if the slug is taken
+func (r *ThreatActorRepository) Create(ctx context.Context, actor *domain.ThreatActor) error {
+	if actor == nil {
+		return fmt.Errorf("threat actor cannot be nil")
+	}
+
+	aliases := actor.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	query := `
+		INSERT INTO threat_actors (
+			id, slug, name, kind, aliases, match_name, motivation, origin, description,
+			curated, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query,
+		actor.ID, actor.Slug, actor.Name, actor.Kind, aliases, actor.MatchName, actor.Motivation,
+		actor.Origin, actor.Description, actor.Curated, actor.CreatedAt, actor.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &domainerrors.ConflictError{
+				Resource: "threat actor",
+				Field:    "slug",
+				Value:    actor.Slug,
+			}
+		}
+		return fmt.Errorf("failed to create threat actor: %w", err)
+	}
+
+	return nil
+}
+
+// GetBySlug returns a profile with its article statistics, or a NotFoundError
+func (r *ThreatActorRepository) GetBySlug(ctx context.Context, slug string) (*domain.ThreatActor, error) {
+	query := `SELECT ` + threatActorColumns + threatActorJoins + `
+		WHERE t.slug = $1
+		GROUP BY t.id
+	`
+
+	actor, err := scanThreatActor(r.db.read(ctx).QueryRow(ctx, query, slug), nil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "threat actor",
+			ID:       slug,
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get threat actor: %w", err)
+	}
+
+	return actor, nil
+}
+
+// List returns profiles matching the filter, most recently mentioned first
+func (r *ThreatActorRepository) List(ctx context.Context, filter *domain.ThreatActorFilter) ([]*domain.ThreatActor, int, error) {
+	if filter == nil {
+		filter = domain.NewThreatActorFilter()
+	}
+
+	where := []string{"1=1"}
+	args := []interface{}{}
+	argCount := 0
+
+	if filter.Kind != nil {
+		argCount++
+		where = append(where, fmt.Sprintf("t.kind = $%d", argCount))
+		args = append(args, *filter.Kind)
+	}
+
+	if filter.Search != nil && strings.TrimSpace(*filter.Search) != "" {
+		argCount++
+		where = append(where, fmt.Sprintf(
+			`(t.name ILIKE $%d ESCAPE '\' OR EXISTS (SELECT 1 FROM unnest(t.aliases) AS alias WHERE alias ILIKE $%d ESCAPE '\'))`,
+			argCount, argCount,
+		))
+		args = append(args, "%"+escapeLikePattern(strings.TrimSpace(*filter.Search))+"%")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER ()
+		%s
+		WHERE %s
+		GROUP BY t.id
+		ORDER BY MAX(a.published_at) DESC NULLS LAST, t.name ASC
+		LIMIT $%d OFFSET $%d
+	`, threatActorColumns, threatActorJoins, strings.Join(where, " AND "), argCount+1, argCount+2)
+
+	args = append(args, filter.PageSize, filter.Offset())
+
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list threat actors: %w", err)
+	}
+	defer rows.Close()
+
+	actors := make([]*domain.ThreatActor, 0)
+	total := 0
+
+	for rows.Next() {
+		actor, err := scanThreatActor(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan threat actor: %w", err)
+		}
+		actors = append(actors, actor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating threat actors: %w", err)
+	}
+
+	return actors, total, nil
+}
+
+// ListAll returns every profile without statistics, for building the alias dictionary
+func (r *ThreatActorRepository) ListAll(ctx context.Context) ([]*domain.ThreatActor, error) {
+	query := `
+		SELECT id, slug, name, kind, aliases, match_name, motivation, origin, description,
+			curated, created_at, updated_at
+		FROM threat_actors
+		ORDER BY curated DESC, created_at ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list threat actors: %w", err)
+	}
+	defer rows.Close()
+
+	actors := make([]*domain.ThreatActor, 0)
+	for rows.Next() {
+		actor := &domain.ThreatActor{}
+		if err := rows.Scan(
+			&actor.ID, &actor.Slug, &actor.Name, &actor.Kind, &actor.Aliases, &actor.MatchName,
+			&actor.Motivation, &actor.Origin, &actor.Description, &actor.Curated,
+			&actor.CreatedAt, &actor.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan threat actor: %w", err)
+		}
+		actors = append(actors, actor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating threat actors: %w", err)
+	}
+
+	return actors, nil
+}
+
+// ReplaceForArticle links an article to exactly the given actors for one mention source
+func (r *ThreatActorRepository) ReplaceForArticle(ctx context.Context, articleID uuid.UUID, source domain.ActorMentionSource, actorIDs []uuid.UUID) error {
+	if articleID == uuid.Nil {
+		return fmt.Errorf("article ID cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM article_threat_actors WHERE article_id = $1 AND source = $2`, articleID, source); err != nil {
+		return fmt.Errorf("failed to clear article threat actors: %w", err)
+	}
+
+	if len(actorIDs) > 0 {
+		query := `
+			INSERT INTO article_threat_actors (article_id, actor_id, source)
+			SELECT $1, actor_id, $2 FROM unnest($3::uuid[]) AS actor_id
+			ON CONFLICT (article_id, actor_id, source) DO NOTHING
+		`
+		if _, err := tx.Exec(ctx, query, articleID, source, actorIDs); err != nil {
+			return fmt.Errorf("failed to link article threat actors: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit article threat actors: %w", err)
+	}
+
+	return nil
+}
+
+// scanThreatActor scans threatActorColumns, followed by the total row count when total is set
+func scanThreatActor(row pgx.Row, total *int) (*domain.ThreatActor, error) {
+	actor := &domain.ThreatActor{}
+	dest := []interface{}{
+		&actor.ID, &actor.Slug, &actor.Name, &actor.Kind, &actor.Aliases, &actor.MatchName,
+		&actor.Motivation, &actor.Origin, &actor.Description, &actor.Curated,
+		&actor.CreatedAt, &actor.UpdatedAt,
+		&actor.ArticleCount, &actor.FirstSeen, &actor.LastSeen,
+	}
+	if total != nil {
+		dest = append(dest, total)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return actor, nil
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// ThreatLandscapeRepository implements repository.ThreatLandscapeRepository for PostgreSQL
+// Only published articles are counted, by their publication time
+type ThreatLandscapeRepository struct {
+	db *DB
+}
+
+// NewThreatLandscapeRepository creates a new PostgreSQL threat landscape repository
+func NewThreatLandscapeRepository(db *DB) *ThreatLandscapeRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &ThreatLandscapeRepository{db: db}
+}
+
+// GetSeverityTrend counts articles published since the given time by severity, per interval bucket
+// Buckets without articles are omitted
+func (r *ThreatLandscapeRepository) GetSeverityTrend(ctx context.Context, since time.Time, interval domain.ThreatLandscapeInterval) ([]domain.SeverityTrendPoint, error) {
+	query := `
+		SELECT
+			DATE_TRUNC($2, published_at AT TIME ZONE 'UTC') AS bucket,
+			COUNT(*) FILTER (WHERE severity = 'critical'),
+			COUNT(*) FILTER (WHERE severity = 'high'),
+			COUNT(*) FILTER (WHERE severity = 'medium'),
+			COUNT(*) FILTER (WHERE severity = 'low'),
+			COUNT(*) FILTER (WHERE severity = 'informational'),
+			COUNT(*)
+		FROM articles
+		WHERE is_published = true AND published_at >= $1
+		GROUP BY bucket
+		ORDER BY bucket ASC
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, since, string(interval))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get severity trend: %w", err)
+	}
+	defer rows.Close()
+
+	points := make([]domain.SeverityTrendPoint, 0)
+	for rows.Next() {
+		var point domain.SeverityTrendPoint
+		if err := rows.Scan(
+			&point.Bucket,
+			&point.Critical,
+			&point.High,
+			&point.Medium,
+			&point.Low,
+			&point.Informational,
+			&point.Total,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan severity trend: %w", err)
+		}
+		point.Bucket = point.Bucket.UTC()
+		points = append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating severity trend: %w", err)
+	}
+
+	return points, nil
+}
+
+// GetCategoryCounts counts articles published since the given time by primary category, largest first
+func (r *ThreatLandscapeRepository) GetCategoryCounts(ctx context.Context, since time.Time, limit int) ([]domain.NamedCount, error) {
+	query := `
+		SELECT c.id, c.name, COUNT(*) AS total
+		FROM articles a
+		JOIN categories c ON c.id = a.category_id
+		WHERE a.is_published = true AND a.published_at >= $1
+		GROUP BY c.id, c.name
+		ORDER BY total DESC, c.name ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]domain.NamedCount, 0)
+	for rows.Next() {
+		var count domain.NamedCount
+		if err := rows.Scan(&count.ID, &count.Name, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan category count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating category counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetTopVendors counts articles published since the given time mentioning each vendor
+// Vendors differing only in case are counted together
+func (r *ThreatLandscapeRepository) GetTopVendors(ctx context.Context, since time.Time, limit int) ([]domain.TermCount, error) {
+	query := `
+		SELECT MIN(v.vendor), COUNT(DISTINCT a.id) AS total
+		FROM articles a, UNNEST(a.vendors) AS v(vendor)
+		WHERE a.is_published = true AND a.published_at >= $1 AND v.vendor <> ''
+		GROUP BY LOWER(v.vendor)
+		ORDER BY total DESC, LOWER(v.vendor) ASC
+		LIMIT $2
+	`
+
+	counts, err := r.termCounts(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top vendors: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetTopCVEs counts articles published since the given time mentioning each CVE ID
+func (r *ThreatLandscapeRepository) GetTopCVEs(ctx context.Context, since time.Time, limit int) ([]domain.TermCount, error) {
+	query := `
+		SELECT UPPER(c.cve) AS cve, COUNT(DISTINCT a.id) AS total
+		FROM articles a, UNNEST(a.cves) AS c(cve)
+		WHERE a.is_published = true AND a.published_at >= $1 AND c.cve <> ''
+		GROUP BY UPPER(c.cve)
+		ORDER BY total DESC, cve DESC
+		LIMIT $2
+	`
+
+	counts, err := r.termCounts(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top CVEs: %w", err)
+	}
+
+	return counts, nil
+}
+
+// termCounts runs a query returning (name, count) rows
+func (r *ThreatLandscapeRepository) termCounts(ctx context.Context, query string, args ...interface{}) ([]domain.TermCount, error) {
+	rows, err := r.db.read(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]domain.TermCount, 0)
+	for rows.Next() {
+		var count domain.TermCount
+		if err := rows.Scan(&count.Name, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, rows.Err()
+}
@@ -41,9 +41,13 @@ func (r *UserRepository) Create(ctx context.Context, user *entities.User) error
 		return fmt.Errorf("user email is required")
 	}
 
+	if user.Status == "" {
+		user.Status = entities.UserStatusActive
+	}
+
 	query := `
-		INSERT INTO users (id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO users (id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err := r.db.Pool.Exec(
@@ -58,6 +62,7 @@ func (r *UserRepository) Create(ctx context.Context, user *entities.User) error
 		user.CreatedAt,
 		user.UpdatedAt,
 		user.LastLoginAt,
+		user.Status,
 	)
 
 	if err != nil {
@@ -88,7 +93,8 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.U
 	}
 
 	query := `
-		SELECT id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at
+		SELECT id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at,
+			status, status_reason, suspended_until
 		FROM users
 		WHERE id = $1
 	`
@@ -104,6 +110,9 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.U
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLoginAt,
+		&user.Status,
+		&user.StatusReason,
+		&user.SuspendedUntil,
 	)
 
 	if err != nil {
@@ -126,7 +135,8 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entitie
 	}
 
 	query := `
-		SELECT id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at
+		SELECT id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at,
+			status, status_reason, suspended_until
 		FROM users
 		WHERE email = $1
 	`
@@ -142,6 +152,9 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entitie
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLoginAt,
+		&user.Status,
+		&user.StatusReason,
+		&user.SuspendedUntil,
 	)
 
 	if err != nil {
@@ -175,7 +188,7 @@ func (r *UserRepository) Update(ctx context.Context, user *entities.User) error
 		WHERE id = $1
 	`
 
-	result, err := r.db.Pool.Exec(
+	result, err := r.db.conn(ctx).Exec(
 		ctx,
 		query,
 		user.ID,
@@ -227,6 +240,150 @@ func (r *UserRepository) UpdateLastLogin(ctx context.Context, id uuid.UUID) erro
 	return nil
 }
 
+// UpdatePassword replaces a user's password hash
+func (r *UserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("user ID cannot be nil")
+	}
+
+	if passwordHash == "" {
+		return fmt.Errorf("password hash cannot be empty")
+	}
+
+	query := `
+		UPDATE users
+		SET password_hash = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, passwordHash, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "user",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// SetStatus changes a user's account status; reason and until are cleared when nil
+func (r *UserRepository) SetStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	status entities.UserStatus,
+	reason *string,
+	until *time.Time,
+	changedBy *uuid.UUID,
+) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("user ID cannot be nil")
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE users
+		SET status = $2, status_reason = $3, suspended_until = $4,
+			status_changed_at = $5, status_changed_by = $6, updated_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, id, status, reason, until, now, changedBy)
+	if err != nil {
+		return fmt.Errorf("failed to set user status: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &domainerrors.NotFoundError{
+			Resource: "user",
+			ID:       id.String(),
+		}
+	}
+
+	return nil
+}
+
+// ActivateInvited sets an invited user's name and password and makes the account active,
+// with the email verified since the invitation reached it
+// It reports false when the user is not awaiting an invitation, such as one already accepted
+func (r *UserRepository) ActivateInvited(ctx context.Context, id uuid.UUID, name, passwordHash string) (bool, error) {
+	if id == uuid.Nil {
+		return false, fmt.Errorf("user ID cannot be nil")
+	}
+
+	if passwordHash == "" {
+		return false, fmt.Errorf("password hash cannot be empty")
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE users
+		SET name = $2, password_hash = $3, status = 'active', email_verified = true,
+			status_changed_at = $4, updated_at = $4
+		WHERE id = $1 AND status = 'invited'
+	`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, name, passwordHash, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to activate invited user: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// List returns a page of users, newest first, with the total count
+func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, int, error) {
+	query := `
+		SELECT id, email, password_hash, name, role, email_verified, created_at, updated_at, last_login_at,
+			status, status_reason, suspended_until,
+			COUNT(*) OVER ()
+		FROM users
+		ORDER BY created_at DESC, id
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.read(ctx).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*entities.User, 0, limit)
+	total := 0
+
+	for rows.Next() {
+		var user entities.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.PasswordHash,
+			&user.Name,
+			&user.Role,
+			&user.EmailVerified,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.LastLoginAt,
+			&user.Status,
+			&user.StatusReason,
+			&user.SuspendedUntil,
+			&total,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, total, nil
+}
+
 // Delete removes a user from the database
 func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if id == uuid.Nil {
@@ -235,7 +392,7 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	query := `DELETE FROM users WHERE id = $1`
 
-	result, err := r.db.Pool.Exec(ctx, query, id)
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// VendorWatchlistRepository implements repository.VendorWatchlistRepository for PostgreSQL
+type VendorWatchlistRepository struct {
+	db *DB
+}
+
+// NewVendorWatchlistRepository creates a new PostgreSQL vendor watchlist repository
+func NewVendorWatchlistRepository(db *DB) *VendorWatchlistRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+	return &VendorWatchlistRepository{db: db}
+}
+
+// List returns a user's watched vendors, oldest first
+func (r *VendorWatchlistRepository) List(ctx context.Context, userID uuid.UUID) ([]*domain.WatchedVendor, error) {
+	query := `
+		SELECT id, user_id, vendor, alert_id, created_at
+		FROM vendor_watchlist
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watched vendors: %w", err)
+	}
+	defer rows.Close()
+
+	vendors := make([]*domain.WatchedVendor, 0)
+	for rows.Next() {
+		var vendor domain.WatchedVendor
+		if err := rows.Scan(&vendor.ID, &vendor.UserID, &vendor.Vendor, &vendor.AlertID, &vendor.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan watched vendor: %w", err)
+		}
+		vendors = append(vendors, &vendor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating watched vendors: %w", err)
+	}
+
+	return vendors, nil
+}
+
+// Create adds a vendor to a user's watchlist
+func (r *VendorWatchlistRepository) Create(ctx context.Context, vendor *domain.WatchedVendor) error {
+	query := `
+		INSERT INTO vendor_watchlist (id, user_id, vendor, alert_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Pool.Exec(ctx, query, vendor.ID, vendor.UserID, vendor.Vendor, vendor.AlertID, vendor.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &domainerrors.ConflictError{
+				Resource: "watched vendor",
+				Field:    "vendor",
+				Value:    vendor.Vendor,
+			}
+		}
+		return fmt.Errorf("failed to create watched vendor: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes one of a user's watched vendors and returns it
+func (r *VendorWatchlistRepository) Delete(ctx context.Context, id, userID uuid.UUID) (*domain.WatchedVendor, error) {
+	query := `
+		DELETE FROM vendor_watchlist
+		WHERE id = $1 AND user_id = $2
+		RETURNING id, user_id, vendor, alert_id, created_at
+	`
+
+	var vendor domain.WatchedVendor
+	err := r.db.Pool.QueryRow(ctx, query, id, userID).Scan(
+		&vendor.ID,
+		&vendor.UserID,
+		&vendor.Vendor,
+		&vendor.AlertID,
+		&vendor.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "watched vendor",
+				ID:       id.String(),
+			}
+		}
+		return nil, fmt.Errorf("failed to delete watched vendor: %w", err)
+	}
+
+	return &vendor, nil
+}
@@ -34,8 +34,8 @@ func (r *webhookLogRepository) Create(ctx context.Context, log *domain.WebhookLo
 	}
 
 	query := `
-		INSERT INTO webhook_logs (id, event_type, status, payload, workflow_id, execution_id, error_msg, processed_at, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO webhook_logs (id, event_type, status, payload, workflow_id, execution_id, key_version, error_msg, processed_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err := r.db.Pool.Exec(ctx, query,
@@ -45,6 +45,7 @@ func (r *webhookLogRepository) Create(ctx context.Context, log *domain.WebhookLo
 		log.Payload,
 		log.WorkflowID,
 		log.ExecutionID,
+		log.KeyVersion,
 		log.ErrorMsg,
 		log.ProcessedAt,
 		log.CreatedAt,
@@ -64,7 +65,7 @@ func (r *webhookLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*doma
 	}
 
 	query := `
-		SELECT id, event_type, status, payload, workflow_id, execution_id, error_msg, processed_at, created_at
+		SELECT id, event_type, status, payload, workflow_id, execution_id, key_version, error_msg, processed_at, created_at
 		FROM webhook_logs
 		WHERE id = $1
 	`
@@ -77,6 +78,7 @@ func (r *webhookLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*doma
 		&log.Payload,
 		&log.WorkflowID,
 		&log.ExecutionID,
+		&log.KeyVersion,
 		&log.ErrorMsg,
 		&log.ProcessedAt,
 		&log.CreatedAt,
@@ -138,7 +140,7 @@ func (r *webhookLogRepository) List(ctx context.Context, limit, offset int) ([]*
 	}
 
 	query := `
-		SELECT id, event_type, status, payload, workflow_id, execution_id, error_msg, processed_at, created_at
+		SELECT id, event_type, status, payload, workflow_id, execution_id, key_version, error_msg, processed_at, created_at
 		FROM webhook_logs
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -160,6 +162,7 @@ func (r *webhookLogRepository) List(ctx context.Context, limit, offset int) ([]*
 			&log.Payload,
 			&log.WorkflowID,
 			&log.ExecutionID,
+			&log.KeyVersion,
 			&log.ErrorMsg,
 			&log.ProcessedAt,
 			&log.CreatedAt,
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WebhookNonceRepository implements repository.WebhookNonceRepository
+type WebhookNonceRepository struct {
+	db *DB
+}
+
+// NewWebhookNonceRepository creates a new webhook nonce repository instance
+func NewWebhookNonceRepository(db *DB) *WebhookNonceRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &WebhookNonceRepository{db: db}
+}
+
+// Claim inserts the signature; a conflict means the same signed request was already accepted
+func (r *WebhookNonceRepository) Claim(ctx context.Context, signature string, expiresAt time.Time) (bool, error) {
+	tag, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO webhook_nonces (signature, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (signature) DO NOTHING
+	`, signature, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim webhook nonce: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// Release deletes a claimed signature
+func (r *WebhookNonceRepository) Release(ctx context.Context, signature string) error {
+	if _, err := r.db.Pool.Exec(ctx, `DELETE FROM webhook_nonces WHERE signature = $1`, signature); err != nil {
+		return fmt.Errorf("failed to release webhook nonce: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes signatures whose expiry has passed
+func (r *WebhookNonceRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := r.db.Pool.Exec(ctx, `DELETE FROM webhook_nonces WHERE expires_at < NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired webhook nonces: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// WebhookSecretRepository implements repository.WebhookSecretRepository
+// Secret values are encrypted by the service; the repository stores them as given
+type WebhookSecretRepository struct {
+	db *DB
+}
+
+// NewWebhookSecretRepository creates a new webhook secret repository instance
+func NewWebhookSecretRepository(db *DB) *WebhookSecretRepository {
+	if db == nil {
+		panic("database cannot be nil")
+	}
+
+	return &WebhookSecretRepository{db: db}
+}
+
+// ListActive returns the versions that have not expired, newest first
+// It always reads the primary, so a rotation is seen as soon as it commits. Secrets are
+// scoped to the context's tenant like every other row
+func (r *WebhookSecretRepository) ListActive(ctx context.Context) ([]*domain.WebhookSecret, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT tenant_id, version, secret, created_by, created_at, expires_at
+		FROM webhook_secrets
+		WHERE expires_at IS NULL OR expires_at > NOW()
+		ORDER BY tenant_id NULLS FIRST, version DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook secrets: %w", err)
+	}
+	defer rows.Close()
+
+	var secrets []*domain.WebhookSecret
+	for rows.Next() {
+		secret := &domain.WebhookSecret{}
+		if err := rows.Scan(&secret.TenantID, &secret.Version, &secret.Secret, &secret.CreatedBy, &secret.CreatedAt, &secret.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook secret: %w", err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhook secrets: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// Rotate stores the next version and schedules the expiry of the versions it replaces
+// Both apply to the secrets of the context's tenant, which the new version belongs to
+func (r *WebhookSecretRepository) Rotate(ctx context.Context, secret *domain.WebhookSecret, initial *domain.WebhookSecret, retireAt time.Time) error {
+	if secret == nil {
+		return fmt.Errorf("webhook secret cannot be nil")
+	}
+
+	return r.db.WithinTx(ctx, func(ctx context.Context) error {
+		conn := r.db.conn(ctx)
+
+		// Concurrent rotations would otherwise pick the same next version
+		if _, err := conn.Exec(ctx, `LOCK TABLE webhook_secrets IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return fmt.Errorf("failed to lock webhook secrets: %w", err)
+		}
+
+		if initial != nil {
+			if _, err := conn.Exec(ctx, `
+				INSERT INTO webhook_secrets (version, secret, created_at)
+				VALUES (0, $1, $2)
+				ON CONFLICT DO NOTHING
+			`, initial.Secret, initial.CreatedAt); err != nil {
+				return fmt.Errorf("failed to record initial webhook secret: %w", err)
+			}
+		}
+
+		if _, err := conn.Exec(ctx, `
+			UPDATE webhook_secrets
+			SET expires_at = $1
+			WHERE expires_at IS NULL OR expires_at > $1
+		`, retireAt); err != nil {
+			return fmt.Errorf("failed to retire webhook secrets: %w", err)
+		}
+
+		err := conn.QueryRow(ctx, `
+			INSERT INTO webhook_secrets (version, secret, created_by)
+			SELECT COALESCE(MAX(version), 0) + 1, $1, $2
+			FROM webhook_secrets
+			RETURNING version, created_at
+		`, secret.Secret, secret.CreatedBy).Scan(&secret.Version, &secret.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to store webhook secret: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UpdateSecret replaces the stored value of one version, which the service uses to encrypt
+// secrets stored before encryption
+func (r *WebhookSecretRepository) UpdateSecret(ctx context.Context, tenantID *uuid.UUID, version int, secret string) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE webhook_secrets
+		SET secret = $3
+		WHERE tenant_id IS NOT DISTINCT FROM $1 AND version = $2
+	`, tenantID, version, secret)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook secret: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "context"
+
+// replicaReadsKey marks a context whose reads may be served by a read replica
+type replicaReadsKey struct{}
+
+// WithReplicaReads allows reads made with the returned context to use a read replica
+// Use it only where replication lag is acceptable, e.g. requests that do not write
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsKey{}, true)
+}
+
+// ReplicaReadsAllowed reports whether ctx was marked with WithReplicaReads
+func ReplicaReadsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(replicaReadsKey{}).(bool)
+	return allowed
+}
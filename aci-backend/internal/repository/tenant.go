@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// tenantKey holds the tenant scope of a context's queries
+type tenantKey struct{}
+
+// tenantScope is the tenant a context's queries are limited to; system scopes are not limited
+type tenantScope struct {
+	tenantID uuid.UUID
+	system   bool
+}
+
+// WithTenant limits queries made with the returned context to the tenant's rows and the shared
+// rows every tenant sees. uuid.Nil is the default workspace, which a context starts in
+func WithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{tenantID: tenantID})
+}
+
+// WithoutTenant lets queries made with the returned context see the rows of every tenant
+// Use it only for system work that serves every tenant, e.g. maintenance jobs
+func WithoutTenant(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{system: true})
+}
+
+// TenantFromContext returns the tenant queries made with ctx are limited to
+// ok is false for a context marked with WithoutTenant
+func TenantFromContext(ctx context.Context) (tenantID uuid.UUID, ok bool) {
+	scope, _ := ctx.Value(tenantKey{}).(tenantScope)
+	return scope.tenantID, !scope.system
+}
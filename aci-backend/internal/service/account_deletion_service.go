@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/crypto"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultAccountDeletionGracePeriod is how long a deletion can be cancelled before data is purged
+	DefaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+	// accountPurgeBatchSize is how many due deletions are purged per run
+	accountPurgeBatchSize = 50
+)
+
+// AccountDeletionService handles self-service account deletion
+// Unlike the admin hard-delete, a request revokes the user's sessions immediately but
+// keeps the account recoverable for a grace period; a background job then purges the
+// user's personal data, keeping their article reads anonymized for engagement totals
+type AccountDeletionService struct {
+	deletionRepo repository.AccountDeletionRepository
+	userRepo     UserRepoInterface
+	tokenRepo    repository.RefreshTokenRepository
+	auditRepo    repository.AuditLogRepository
+	gracePeriod  time.Duration
+}
+
+// NewAccountDeletionService creates a new account deletion service instance
+// A non-positive grace period falls back to DefaultAccountDeletionGracePeriod
+func NewAccountDeletionService(
+	deletionRepo repository.AccountDeletionRepository,
+	userRepo UserRepoInterface,
+	tokenRepo repository.RefreshTokenRepository,
+	auditRepo repository.AuditLogRepository,
+	gracePeriod time.Duration,
+) *AccountDeletionService {
+	if deletionRepo == nil {
+		panic("deletionRepo cannot be nil")
+	}
+	if userRepo == nil {
+		panic("userRepo cannot be nil")
+	}
+	if tokenRepo == nil {
+		panic("tokenRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultAccountDeletionGracePeriod
+	}
+
+	return &AccountDeletionService{
+		deletionRepo: deletionRepo,
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		auditRepo:    auditRepo,
+		gracePeriod:  gracePeriod,
+	}
+}
+
+// Request schedules the user's account for deletion after confirming their password
+// All refresh tokens are revoked at once; requesting again returns the pending deletion
+func (s *AccountDeletionService) Request(ctx context.Context, userID uuid.UUID, password, ipAddress, userAgent string) (*domain.AccountDeletion, error) {
+	if password == "" {
+		return nil, &domainerrors.ValidationError{
+			Field:   "password",
+			Message: "password is required to delete your account",
+		}
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !crypto.CheckPassword(password, user.PasswordHash) {
+		return nil, fmt.Errorf("invalid password: %w", domainerrors.ErrUnauthorized)
+	}
+
+	now := time.Now()
+	deletion := &domain.AccountDeletion{
+		UserID:      userID,
+		RequestedAt: now,
+		PurgeAfter:  now.Add(s.gracePeriod),
+	}
+
+	if err := s.deletionRepo.Create(ctx, deletion); err != nil {
+		var conflictErr *domainerrors.ConflictError
+		if !errors.As(err, &conflictErr) {
+			return nil, err
+		}
+
+		deletion, err = s.deletionRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	s.audit(ctx, &userID, domain.AuditActionAccountDeletionRequested, userID, nil, deletion, ipAddress, userAgent)
+
+	log.Info().
+		Str("user_id", userID.String()).
+		Time("purge_after", deletion.PurgeAfter).
+		Msg("Account deletion scheduled")
+
+	return deletion, nil
+}
+
+// Get returns the user's pending deletion, or nil if none is scheduled
+func (s *AccountDeletionService) Get(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	deletion, err := s.deletionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// Cancel withdraws the user's pending deletion and reports whether one was pending
+func (s *AccountDeletionService) Cancel(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (bool, error) {
+	deletion, err := s.Get(ctx, userID)
+	if err != nil || deletion == nil {
+		return false, err
+	}
+
+	if err := s.deletionRepo.Delete(ctx, userID); err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	s.audit(ctx, &userID, domain.AuditActionAccountDeletionCancelled, userID, deletion, nil, ipAddress, userAgent)
+
+	log.Info().
+		Str("user_id", userID.String()).
+		Msg("Account deletion cancelled")
+
+	return true, nil
+}
+
+// PurgeDue purges accounts whose grace period has ended and returns how many were purged
+// A failed purge is logged and retried on the next run
+func (s *AccountDeletionService) PurgeDue(ctx context.Context) (int, error) {
+	deletions, err := s.deletionRepo.ListDue(ctx, time.Now(), accountPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, deletion := range deletions {
+		if err := s.deletionRepo.Purge(ctx, deletion.UserID); err != nil {
+			log.Error().
+				Err(err).
+				Str("user_id", deletion.UserID.String()).
+				Msg("Failed to purge account")
+			continue
+		}
+
+		// The user row is gone, so the record references the account only by resource ID
+		s.audit(ctx, nil, domain.AuditActionAccountPurged, deletion.UserID, deletion, nil, "", "")
+		purged++
+	}
+
+	return purged, nil
+}
+
+// Run purges due accounts on every interval until the context is cancelled
+func (s *AccountDeletionService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeDue(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to purge deleted accounts")
+				continue
+			}
+
+			if purged > 0 {
+				log.Info().Int("purged", purged).Msg("Purged deleted accounts")
+			}
+		}
+	}
+}
+
+// audit records an account deletion event; failures are logged and do not fail the operation
+func (s *AccountDeletionService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	action string,
+	userID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, action, "user", &userID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Str("action", action).
+			Msg("Failed to write account deletion audit log")
+	}
+}
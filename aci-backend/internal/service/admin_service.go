@@ -9,15 +9,21 @@ import (
 
 	"github.com/phillipboles/aci-backend/internal/domain"
 	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	"github.com/phillipboles/aci-backend/internal/pkg/sanitizer"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
+// editorialSanitizer cleans article content written by admins, which may use richer markup than feeds
+var editorialSanitizer = sanitizer.NewWithPolicy(sanitizer.EditorialPolicy())
+
 // AdminService handles admin-only business logic
 type AdminService struct {
 	articleRepo  repository.ArticleRepository
 	sourceRepo   repository.SourceRepository
 	userRepo     repository.UserRepository
 	auditLogRepo repository.AuditLogRepository
+	outboxRepo   repository.AuditOutboxRepository
+	txManager    repository.TxManager
 }
 
 // NewAdminService creates a new admin service instance
@@ -26,6 +32,8 @@ func NewAdminService(
 	sourceRepo repository.SourceRepository,
 	userRepo repository.UserRepository,
 	auditLogRepo repository.AuditLogRepository,
+	outboxRepo repository.AuditOutboxRepository,
+	txManager repository.TxManager,
 ) *AdminService {
 	if articleRepo == nil {
 		panic("articleRepo cannot be nil")
@@ -39,12 +47,20 @@ func NewAdminService(
 	if auditLogRepo == nil {
 		panic("auditLogRepo cannot be nil")
 	}
+	if outboxRepo == nil {
+		panic("outboxRepo cannot be nil")
+	}
+	if txManager == nil {
+		panic("txManager cannot be nil")
+	}
 
 	return &AdminService{
 		articleRepo:  articleRepo,
 		sourceRepo:   sourceRepo,
 		userRepo:     userRepo,
 		auditLogRepo: auditLogRepo,
+		outboxRepo:   outboxRepo,
+		txManager:    txManager,
 	}
 }
 
@@ -86,31 +102,32 @@ func (s *AdminService) UpdateArticle(
 		return nil, fmt.Errorf("invalid article: %w", err)
 	}
 
-	// Update article in database
-	if err := s.articleRepo.Update(ctx, article); err != nil {
-		return nil, fmt.Errorf("failed to update article: %w", err)
-	}
+	// Update the article and stage its audit log together
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.articleRepo.Update(ctx, article); err != nil {
+			return fmt.Errorf("failed to update article: %w", err)
+		}
 
-	// Store new state for audit log
-	newState, err := articleToMap(article)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize new state: %w", err)
-	}
+		// Store new state for audit log
+		newState, err := articleToMap(article)
+		if err != nil {
+			return fmt.Errorf("failed to serialize new state: %w", err)
+		}
 
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"update_article",
-		"article",
-		&articleID,
-		oldState,
-		newState,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		// Log error but don't fail the operation
-		fmt.Printf("failed to log audit event: %v\n", err)
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"update_article",
+			"article",
+			&articleID,
+			oldState,
+			newState,
+			&ipAddress,
+			&userAgent,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return article, nil
@@ -142,27 +159,24 @@ func (s *AdminService) DeleteArticle(
 		return fmt.Errorf("failed to serialize article state: %w", err)
 	}
 
-	// Delete article
-	if err := s.articleRepo.Delete(ctx, articleID); err != nil {
-		return fmt.Errorf("failed to delete article: %w", err)
-	}
-
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"delete_article",
-		"article",
-		&articleID,
-		oldState,
-		nil,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
-	}
+	// Delete the article and stage its audit log together
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.articleRepo.Delete(ctx, articleID); err != nil {
+			return fmt.Errorf("failed to delete article: %w", err)
+		}
 
-	return nil
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"delete_article",
+			"article",
+			&articleID,
+			oldState,
+			nil,
+			&ipAddress,
+			&userAgent,
+		)
+	})
 }
 
 // ListSources lists all sources including inactive ones (admin-only)
@@ -200,29 +214,31 @@ func (s *AdminService) CreateSource(
 		return nil, fmt.Errorf("source with URL %s already exists", source.URL)
 	}
 
-	// Create source
-	if err := s.sourceRepo.Create(ctx, source); err != nil {
-		return nil, fmt.Errorf("failed to create source: %w", err)
-	}
+	// Create the source and stage its audit log together
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.sourceRepo.Create(ctx, source); err != nil {
+			return fmt.Errorf("failed to create source: %w", err)
+		}
 
-	// Log audit event
-	newState, err := sourceToMap(source)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize source state: %w", err)
-	}
+		newState, err := sourceToMap(source)
+		if err != nil {
+			return fmt.Errorf("failed to serialize source state: %w", err)
+		}
 
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"create_source",
-		"source",
-		&source.ID,
-		nil,
-		newState,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"create_source",
+			"source",
+			&source.ID,
+			nil,
+			newState,
+			&ipAddress,
+			&userAgent,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return source, nil
@@ -265,29 +281,31 @@ func (s *AdminService) UpdateSource(
 		return nil, fmt.Errorf("invalid source: %w", err)
 	}
 
-	// Update source
-	if err := s.sourceRepo.Update(ctx, source); err != nil {
-		return nil, fmt.Errorf("failed to update source: %w", err)
-	}
+	// Update the source and stage its audit log together
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.sourceRepo.Update(ctx, source); err != nil {
+			return fmt.Errorf("failed to update source: %w", err)
+		}
 
-	newState, err := sourceToMap(source)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize new state: %w", err)
-	}
+		newState, err := sourceToMap(source)
+		if err != nil {
+			return fmt.Errorf("failed to serialize new state: %w", err)
+		}
 
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"update_source",
-		"source",
-		&sourceID,
-		oldState,
-		newState,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"update_source",
+			"source",
+			&sourceID,
+			oldState,
+			newState,
+			&ipAddress,
+			&userAgent,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return source, nil
@@ -319,33 +337,30 @@ func (s *AdminService) DeleteSource(
 		return fmt.Errorf("failed to serialize source state: %w", err)
 	}
 
-	// Soft delete by deactivating
+	// Soft delete by deactivating, staging the audit log in the same transaction
 	source.Deactivate()
-	if err := s.sourceRepo.Update(ctx, source); err != nil {
-		return fmt.Errorf("failed to deactivate source: %w", err)
-	}
-
-	newState, err := sourceToMap(source)
-	if err != nil {
-		return fmt.Errorf("failed to serialize source state: %w", err)
-	}
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.sourceRepo.Update(ctx, source); err != nil {
+			return fmt.Errorf("failed to deactivate source: %w", err)
+		}
 
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"delete_source",
-		"source",
-		&sourceID,
-		oldState,
-		newState,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
-	}
+		newState, err := sourceToMap(source)
+		if err != nil {
+			return fmt.Errorf("failed to serialize source state: %w", err)
+		}
 
-	return nil
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"delete_source",
+			"source",
+			&sourceID,
+			oldState,
+			newState,
+			&ipAddress,
+			&userAgent,
+		)
+	})
 }
 
 // ListUsers lists all users with pagination (admin-only)
@@ -358,9 +373,12 @@ func (s *AdminService) ListUsers(ctx context.Context, limit, offset int) ([]*ent
 		return nil, 0, fmt.Errorf("offset must be non-negative")
 	}
 
-	// Since UserRepository doesn't have a List method, we'll need to implement one
-	// For now, return an error indicating this needs implementation
-	return nil, 0, fmt.Errorf("ListUsers not yet implemented - UserRepository needs List method")
+	users, total, err := s.userRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, total, nil
 }
 
 // UpdateUser updates a user (admin-only)
@@ -395,35 +413,38 @@ func (s *AdminService) UpdateUser(
 		return nil, fmt.Errorf("failed to apply updates: %w", err)
 	}
 
-	// Update user
-	if err := s.userRepo.Update(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to update user: %w", err)
-	}
+	// Update the user and stage its audit log together
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
 
-	newState, err := userToMap(user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize new state: %w", err)
-	}
+		newState, err := userToMap(user)
+		if err != nil {
+			return fmt.Errorf("failed to serialize new state: %w", err)
+		}
 
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"update_user",
-		"user",
-		&userID,
-		oldState,
-		newState,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"update_user",
+			"user",
+			&userID,
+			oldState,
+			newState,
+			&ipAddress,
+			&userAgent,
+		)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return user, nil
 }
 
-// DeleteUser disables a user account (admin-only)
+// DeleteUser permanently removes a user account (admin-only)
+// UserStatusService suspends or bans an account while keeping its data
 func (s *AdminService) DeleteUser(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -453,27 +474,24 @@ func (s *AdminService) DeleteUser(
 		return fmt.Errorf("failed to serialize user state: %w", err)
 	}
 
-	// Delete user
-	if err := s.userRepo.Delete(ctx, userID); err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
-	}
-
-	// Log audit event
-	if err := s.LogAuditEvent(
-		ctx,
-		&adminUserID,
-		"delete_user",
-		"user",
-		&userID,
-		oldState,
-		nil,
-		&ipAddress,
-		&userAgent,
-	); err != nil {
-		fmt.Printf("failed to log audit event: %v\n", err)
-	}
+	// Delete the user and stage its audit log together
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Delete(ctx, userID); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
 
-	return nil
+		return s.LogAuditEvent(
+			ctx,
+			&adminUserID,
+			"delete_user",
+			"user",
+			&userID,
+			oldState,
+			nil,
+			&ipAddress,
+			&userAgent,
+		)
+	})
 }
 
 // ListAuditLogs lists audit logs with filtering (admin-only)
@@ -490,7 +508,9 @@ func (s *AdminService) ListAuditLogs(ctx context.Context, filter *domain.AuditLo
 	return logs, totalCount, nil
 }
 
-// LogAuditEvent logs an admin action to the audit trail
+// LogAuditEvent stages an admin action for the audit trail in the outbox
+// Called within TxManager.WithinTx, the entry is committed or rolled back with the action;
+// the AuditOutboxRelay then delivers it to the audit log
 func (s *AdminService) LogAuditEvent(
 	ctx context.Context,
 	userID *uuid.UUID,
@@ -513,8 +533,8 @@ func (s *AdminService) LogAuditEvent(
 		userAgent,
 	)
 
-	if err := s.auditLogRepo.Create(ctx, auditLog); err != nil {
-		return fmt.Errorf("failed to create audit log: %w", err)
+	if err := s.outboxRepo.Enqueue(ctx, auditLog); err != nil {
+		return fmt.Errorf("failed to stage audit log: %w", err)
 	}
 
 	return nil
@@ -568,6 +588,11 @@ func userToMap(user *entities.User) (map[string]interface{}, error) {
 }
 
 func applyArticleUpdates(article *domain.Article, updates map[string]interface{}) error {
+	// Content and its format are applied together once every field is read
+	var content *string
+	contentFormat := article.ContentFormat
+	contentUpdated := false
+
 	for key, value := range updates {
 		switch key {
 		case "severity":
@@ -587,13 +612,23 @@ func applyArticleUpdates(article *domain.Article, updates map[string]interface{}
 				article.Summary = &summary
 			}
 		case "content":
-			if content, ok := value.(string); ok {
-				article.Content = content
+			if contentStr, ok := value.(string); ok {
+				content = &contentStr
+				contentUpdated = true
+			}
+		case "content_format":
+			if formatStr, ok := value.(string); ok {
+				contentFormat = domain.ContentFormat(formatStr)
+				contentUpdated = true
 			}
 		default:
 			return fmt.Errorf("unsupported field: %s", key)
 		}
 	}
+
+	if contentUpdated {
+		return setArticleContent(article, contentFormat, content, editorialSanitizer.SanitizeHTML)
+	}
 	return nil
 }
 
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// DefaultAICacheTTL is how long cached AI responses are served when no TTL is configured
+const DefaultAICacheTTL = 7 * 24 * time.Hour
+
+// AICacheService stores AI responses so identical prompts, e.g. from bulk re-imports
+// or retries, are served without another provider call. It implements ai.ResponseCache
+type AICacheService struct {
+	cacheRepo repository.AIResponseCacheRepository
+	ttl       time.Duration
+}
+
+// NewAICacheService creates a new AI cache service instance
+func NewAICacheService(cacheRepo repository.AIResponseCacheRepository, ttl time.Duration) *AICacheService {
+	if cacheRepo == nil {
+		panic("cacheRepo cannot be nil")
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultAICacheTTL
+	}
+
+	return &AICacheService{
+		cacheRepo: cacheRepo,
+		ttl:       ttl,
+	}
+}
+
+// Lookup returns the cached response for key, if one exists and has not expired
+func (s *AICacheService) Lookup(ctx context.Context, key string) (string, bool) {
+	entry, err := s.cacheRepo.Get(ctx, key)
+	if err != nil {
+		var notFound *domainerrors.NotFoundError
+		if !errors.As(err, &notFound) {
+			log.Warn().Err(err).Msg("AI response cache lookup failed")
+		}
+		metrics.RecordCacheLookup(metrics.CacheAIResponse, false)
+		return "", false
+	}
+
+	metrics.RecordCacheLookup(metrics.CacheAIResponse, true)
+	return entry.Response, true
+}
+
+// Store caches a response for the configured TTL
+// Failures are logged rather than returned so caching never fails an AI call
+func (s *AICacheService) Store(ctx context.Context, cached ai.CachedResponse) {
+	now := time.Now()
+	entry := &domain.AIResponseCacheEntry{
+		Key:       cached.Key,
+		Provider:  cached.Provider,
+		Model:     cached.Model,
+		Operation: cached.Operation,
+		Response:  cached.Response,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+
+	// The response was paid for even if the call's context has since been cancelled
+	if err := s.cacheRepo.Upsert(context.WithoutCancel(ctx), entry); err != nil {
+		log.Warn().
+			Err(err).
+			Str("operation", entry.Operation).
+			Msg("Failed to store AI response in cache")
+	}
+}
+
+// Stats summarizes unexpired cache entries
+func (s *AICacheService) Stats(ctx context.Context) (*domain.AICacheStats, error) {
+	stats, err := s.cacheRepo.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.TTLSeconds = int64(s.ttl / time.Second)
+	return stats, nil
+}
+
+// Invalidate removes cached responses for an operation, or all responses when operation is empty,
+// and returns the number removed
+func (s *AICacheService) Invalidate(ctx context.Context, operation string) (int64, error) {
+	deleted, err := s.cacheRepo.Delete(ctx, operation)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info().
+		Str("operation", operation).
+		Int64("deleted", deleted).
+		Msg("AI response cache invalidated")
+
+	return deleted, nil
+}
+
+// PurgeExpired removes expired entries; expired entries are never served, this only reclaims space
+func (s *AICacheService) PurgeExpired(ctx context.Context) (int64, error) {
+	return s.cacheRepo.DeleteExpired(ctx)
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultAIUsageDays is the default number of days in the usage report
+	DefaultAIUsageDays = 30
+
+	// MaxAIUsageDays bounds the usage report
+	MaxAIUsageDays = 366
+)
+
+// AIUsageService records the token usage and cost of AI calls and enforces the monthly budget
+// It implements ai.UsageRecorder
+type AIUsageService struct {
+	usageRepo repository.AIUsageRepository
+	pricing   *ai.ModelPricing // overrides list prices when set
+
+	mu            sync.Mutex
+	monthlyBudget float64
+	monthStart    time.Time
+	monthCost     float64
+}
+
+// NewAIUsageService creates a new AI usage service instance
+// A monthlyBudget of 0 disables the budget; pricing overrides the built-in list prices when non-nil
+func NewAIUsageService(usageRepo repository.AIUsageRepository, monthlyBudget float64, pricing *ai.ModelPricing) *AIUsageService {
+	if usageRepo == nil {
+		panic("usageRepo cannot be nil")
+	}
+
+	if monthlyBudget < 0 {
+		monthlyBudget = 0
+	}
+
+	return &AIUsageService{
+		usageRepo:     usageRepo,
+		monthlyBudget: monthlyBudget,
+		pricing:       pricing,
+		monthStart:    startOfMonth(time.Now()),
+	}
+}
+
+// SetMonthlyBudget replaces the monthly budget, e.g. on a config reload; 0 disables it
+func (s *AIUsageService) SetMonthlyBudget(monthlyBudget float64) {
+	if monthlyBudget < 0 {
+		monthlyBudget = 0
+	}
+
+	s.mu.Lock()
+	s.monthlyBudget = monthlyBudget
+	s.mu.Unlock()
+}
+
+// Load initializes month-to-date spend from stored usage so the budget survives restarts
+func (s *AIUsageService) Load(ctx context.Context) error {
+	monthStart := startOfMonth(time.Now())
+
+	total, err := s.usageRepo.TotalCost(ctx, monthStart)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.monthStart = monthStart
+	s.monthCost = total
+	s.mu.Unlock()
+
+	return nil
+}
+
+// RecordUsage stores usage for an AI call and adds its cost to month-to-date spend
+// Failures are logged rather than returned so tracking never fails an AI call
+func (s *AIUsageService) RecordUsage(ctx context.Context, usage ai.Usage) {
+	record := &domain.AIUsageRecord{
+		Provider:     usage.Provider,
+		Model:        usage.Model,
+		Operation:    usage.Operation,
+		InputTokens:  usage.InputTokens,
+		OutputTokens: usage.OutputTokens,
+		CostUSD:      s.cost(usage),
+		Success:      usage.Success,
+		DurationMs:   usage.Duration.Milliseconds(),
+		CreatedAt:    time.Now(),
+	}
+
+	exceeded, budget := s.addCost(record.CreatedAt, record.CostUSD)
+
+	// The call's context may already be past its deadline; the record should still be written
+	if err := s.usageRepo.Create(context.WithoutCancel(ctx), record); err != nil {
+		log.Error().
+			Err(err).
+			Str("operation", record.Operation).
+			Float64("cost_usd", record.CostUSD).
+			Msg("Failed to record AI usage")
+	}
+
+	if exceeded {
+		log.Warn().
+			Float64("monthly_budget_usd", budget).
+			Msg("Monthly AI budget exceeded, pausing non-critical enrichment")
+	}
+}
+
+// BudgetExceeded reports whether month-to-date spend has reached the monthly budget
+func (s *AIUsageService) BudgetExceeded() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.monthlyBudget <= 0 {
+		return false
+	}
+
+	s.rollover(time.Now())
+	return s.monthCost >= s.monthlyBudget
+}
+
+// Report returns per-day usage for the last days days and month-to-date spend against the budget
+func (s *AIUsageService) Report(ctx context.Context, days int) (*domain.AIUsageReport, error) {
+	if days < 1 || days > MaxAIUsageDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", MaxAIUsageDays)
+	}
+
+	now := time.Now().UTC()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -(days - 1))
+
+	daily, err := s.usageRepo.DailyAggregates(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	monthCost, err := s.usageRepo.TotalCost(ctx, startOfMonth(now))
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	budget := s.monthlyBudget
+	s.mu.Unlock()
+
+	report := &domain.AIUsageReport{
+		Days:               daily,
+		MonthToDateCostUSD: monthCost,
+		MonthlyBudgetUSD:   budget,
+		GeneratedAt:        now,
+	}
+
+	if budget > 0 {
+		remaining := budget - monthCost
+		if remaining < 0 {
+			remaining = 0
+		}
+		report.BudgetRemainingUSD = &remaining
+		report.BudgetExceeded = monthCost >= budget
+	}
+
+	return report, nil
+}
+
+// cost estimates the USD cost of a call; unknown models without an override cost nothing
+func (s *AIUsageService) cost(usage ai.Usage) float64 {
+	if s.pricing != nil {
+		return s.pricing.Cost(usage.InputTokens, usage.OutputTokens)
+	}
+
+	pricing, ok := ai.LookupPricing(usage.Model)
+	if !ok {
+		return 0
+	}
+
+	return pricing.Cost(usage.InputTokens, usage.OutputTokens)
+}
+
+// addCost adds to month-to-date spend and reports whether this call crossed the budget, and the budget
+func (s *AIUsageService) addCost(at time.Time, cost float64) (bool, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rollover(at)
+
+	before := s.monthCost
+	s.monthCost += cost
+
+	crossed := s.monthlyBudget > 0 && before < s.monthlyBudget && s.monthCost >= s.monthlyBudget
+	return crossed, s.monthlyBudget
+}
+
+// rollover resets month-to-date spend when a new month starts; callers must hold mu
+func (s *AIUsageService) rollover(now time.Time) {
+	if monthStart := startOfMonth(now); monthStart.After(s.monthStart) {
+		s.monthStart = monthStart
+		s.monthCost = 0
+	}
+}
+
+// startOfMonth returns midnight UTC on the first day of t's month
+func startOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -3,12 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
@@ -17,8 +19,16 @@ type AlertService struct {
 	alertRepo      repository.AlertRepository
 	alertMatchRepo repository.AlertMatchRepository
 	articleRepo    repository.ArticleRepository
+	orgService     *OrganizationService
+	securityEvents *SecurityEventService
+	notifications  *NotificationService
+	kev            *KEVService
+	exploits       *ExploitService
 }
 
+// alertBackfillPageSize is how many articles a backfill reads per page
+const alertBackfillPageSize = 100
+
 // NewAlertService creates a new alert service
 func NewAlertService(
 	alertRepo repository.AlertRepository,
@@ -42,8 +52,34 @@ func NewAlertService(
 	}
 }
 
+// SetOrganizationService enables alerts shared with an organization
+func (s *AlertService) SetOrganizationService(orgService *OrganizationService) {
+	s.orgService = orgService
+}
+
+// SetSecurityEventService records alert creation and deletion as security events
+func (s *AlertService) SetSecurityEventService(securityEvents *SecurityEventService) {
+	s.securityEvents = securityEvents
+}
+
+// SetNotificationService sends the user a summary when a new alert finishes backfilling
+func (s *AlertService) SetNotificationService(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// SetKEVService raises the priority of matches for articles about KEV-listed CVEs
+func (s *AlertService) SetKEVService(kev *KEVService) {
+	s.kev = kev
+}
+
+// SetExploitService lets exploit alerts match new articles about CVEs with a public exploit
+func (s *AlertService) SetExploitService(exploits *ExploitService) {
+	s.exploits = exploits
+}
+
 // Create creates a new alert for a user
-func (s *AlertService) Create(ctx context.Context, userID uuid.UUID, name string, alertType domain.AlertType, value string) (*domain.Alert, error) {
+// A shared alert belongs to the user's organization and is visible to all of its members
+func (s *AlertService) Create(ctx context.Context, userID uuid.UUID, name string, alertType domain.AlertType, value string, shared bool, ipAddress, userAgent string) (*domain.Alert, error) {
 	if userID == uuid.Nil {
 		return nil, fmt.Errorf("user ID is required")
 	}
@@ -76,14 +112,56 @@ func (s *AlertService) Create(ctx context.Context, userID uuid.UUID, name string
 		return nil, fmt.Errorf("alert validation failed: %w", err)
 	}
 
+	if shared {
+		member, err := s.membershipOf(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if member == nil {
+			return nil, &domainerrors.ValidationError{Field: "shared", Message: "user does not belong to an organization"}
+		}
+		alert.OrganizationID = &member.OrganizationID
+	}
+
 	if err := s.alertRepo.Create(ctx, alert); err != nil {
 		return nil, fmt.Errorf("failed to create alert: %w", err)
 	}
 
+	s.recordSecurityEvent(ctx, userID, domain.SecurityEventAlertCreated, alert, ipAddress, userAgent)
+
 	return alert, nil
 }
 
-// List returns all alerts for a user with match counts
+// CreateWatchlistAlert creates the private vendor alert for a vendor on the user's watchlist
+// Its matches have a higher priority than those of an ordinary vendor alert
+func (s *AlertService) CreateWatchlistAlert(ctx context.Context, userID uuid.UUID, vendor string, ipAddress, userAgent string) (*domain.Alert, error) {
+	now := time.Now()
+	alert := &domain.Alert{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      "Watchlist: " + vendor,
+		Type:      domain.AlertTypeVendor,
+		Value:     vendor,
+		IsActive:  true,
+		Watchlist: true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := alert.Validate(); err != nil {
+		return nil, fmt.Errorf("alert validation failed: %w", err)
+	}
+
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		return nil, fmt.Errorf("failed to create alert: %w", err)
+	}
+
+	s.recordSecurityEvent(ctx, userID, domain.SecurityEventAlertCreated, alert, ipAddress, userAgent)
+
+	return alert, nil
+}
+
+// List returns the user's alerts and those shared with their organization, with match counts
 func (s *AlertService) List(ctx context.Context, userID uuid.UUID) ([]*domain.Alert, error) {
 	if userID == uuid.Nil {
 		return nil, fmt.Errorf("user ID is required")
@@ -112,8 +190,8 @@ func (s *AlertService) GetByID(ctx context.Context, id, userID uuid.UUID) (*doma
 		return nil, fmt.Errorf("failed to get alert: %w", err)
 	}
 
-	// Check ownership
-	if alert.UserID != userID {
+	// Check ownership or organization membership
+	if !s.canView(ctx, alert, userID) {
 		return nil, fmt.Errorf("alert not found")
 	}
 
@@ -136,8 +214,8 @@ func (s *AlertService) Update(ctx context.Context, id, userID uuid.UUID, name, v
 		return nil, fmt.Errorf("failed to get alert: %w", err)
 	}
 
-	// Check ownership
-	if alert.UserID != userID {
+	// Check ownership; organization admins may manage shared alerts
+	if !s.canManage(ctx, alert, userID) {
 		return nil, fmt.Errorf("alert not found")
 	}
 
@@ -176,7 +254,7 @@ func (s *AlertService) Update(ctx context.Context, id, userID uuid.UUID, name, v
 }
 
 // Delete removes an alert with ownership check
-func (s *AlertService) Delete(ctx context.Context, id, userID uuid.UUID) error {
+func (s *AlertService) Delete(ctx context.Context, id, userID uuid.UUID, ipAddress, userAgent string) error {
 	if id == uuid.Nil {
 		return fmt.Errorf("alert ID is required")
 	}
@@ -191,8 +269,8 @@ func (s *AlertService) Delete(ctx context.Context, id, userID uuid.UUID) error {
 		return fmt.Errorf("failed to get alert: %w", err)
 	}
 
-	// Check ownership
-	if alert.UserID != userID {
+	// Check ownership; organization admins may manage shared alerts
+	if !s.canManage(ctx, alert, userID) {
 		return fmt.Errorf("alert not found")
 	}
 
@@ -201,11 +279,30 @@ func (s *AlertService) Delete(ctx context.Context, id, userID uuid.UUID) error {
 		return fmt.Errorf("failed to delete alert: %w", err)
 	}
 
+	s.recordSecurityEvent(ctx, userID, domain.SecurityEventAlertDeleted, alert, ipAddress, userAgent)
+
 	return nil
 }
 
+// recordSecurityEvent records an alert change on the acting user's account when security events are enabled
+func (s *AlertService) recordSecurityEvent(ctx context.Context, userID uuid.UUID, event string, alert *domain.Alert, ipAddress, userAgent string) {
+	if s.securityEvents == nil {
+		return
+	}
+
+	details := map[string]interface{}{
+		"name":   alert.Name,
+		"type":   alert.Type,
+		"value":  alert.Value,
+		"shared": alert.OrganizationID != nil,
+	}
+
+	s.securityEvents.Record(ctx, &userID, event, "alert", &alert.ID, details, ipAddress, userAgent)
+}
+
 // ListMatches returns matches for an alert with ownership check and pagination
-func (s *AlertService) ListMatches(ctx context.Context, alertID, userID uuid.UUID, page, pageSize int) ([]*domain.AlertMatch, int, error) {
+// A non-nil status limits results to matches in that triage state
+func (s *AlertService) ListMatches(ctx context.Context, alertID, userID uuid.UUID, status *domain.AlertMatchStatus, page, pageSize int) ([]*domain.AlertMatch, int, error) {
 	if alertID == uuid.Nil {
 		return nil, 0, fmt.Errorf("alert ID is required")
 	}
@@ -222,14 +319,18 @@ func (s *AlertService) ListMatches(ctx context.Context, alertID, userID uuid.UUI
 		return nil, 0, fmt.Errorf("page_size must be between 1 and 100")
 	}
 
+	if status != nil && !status.IsValid() {
+		return nil, 0, fmt.Errorf("invalid match status")
+	}
+
 	// Get alert to check ownership
 	alert, err := s.alertRepo.GetByID(ctx, alertID)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get alert: %w", err)
 	}
 
-	// Check ownership
-	if alert.UserID != userID {
+	// Check ownership or organization membership
+	if !s.canView(ctx, alert, userID) {
 		return nil, 0, fmt.Errorf("alert not found")
 	}
 
@@ -239,6 +340,16 @@ func (s *AlertService) ListMatches(ctx context.Context, alertID, userID uuid.UUI
 		return nil, 0, fmt.Errorf("failed to get alert matches: %w", err)
 	}
 
+	if status != nil {
+		filtered := make([]*domain.AlertMatch, 0, len(matches))
+		for _, match := range matches {
+			if match.Status == *status {
+				filtered = append(filtered, match)
+			}
+		}
+		matches = filtered
+	}
+
 	total := len(matches)
 
 	// Apply pagination
@@ -255,24 +366,140 @@ func (s *AlertService) ListMatches(ctx context.Context, alertID, userID uuid.UUI
 
 	paginatedMatches := matches[offset:end]
 
-	// Populate article details for each match
+	// Populate article details for the page in one query
+	articleIDs := make([]uuid.UUID, len(paginatedMatches))
 	for i, match := range paginatedMatches {
-		article, err := s.articleRepo.GetByID(ctx, match.ArticleID)
-		if err != nil {
+		articleIDs[i] = match.ArticleID
+	}
+
+	articles, err := loadArticles(ctx, s.articleRepo, articleIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load articles for alert matches: %w", err)
+	}
+
+	for i, match := range paginatedMatches {
+		article, ok := articles[match.ArticleID]
+		if !ok {
 			log.Error().
-				Err(err).
 				Str("article_id", match.ArticleID.String()).
-				Msg("Failed to load article for alert match")
+				Msg("Article for alert match not found")
 			continue
 		}
-		paginatedMatches[i].Article = article
+		paginatedMatches[i].Article = article.ForDisplay()
 	}
 
 	return paginatedMatches, total, nil
 }
 
+// UpdateMatchStatus moves an alert match to a triage state with ownership check
+func (s *AlertService) UpdateMatchStatus(ctx context.Context, alertID, matchID, userID uuid.UUID, status domain.AlertMatchStatus) (*domain.AlertMatch, error) {
+	if alertID == uuid.Nil {
+		return nil, fmt.Errorf("alert ID is required")
+	}
+
+	if matchID == uuid.Nil {
+		return nil, fmt.Errorf("match ID is required")
+	}
+
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid match status")
+	}
+
+	// Get alert to check ownership
+	alert, err := s.alertRepo.GetByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert: %w", err)
+	}
+
+	if !s.canView(ctx, alert, userID) {
+		return nil, fmt.Errorf("alert not found")
+	}
+
+	match, err := s.alertMatchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert match: %w", err)
+	}
+
+	if match.AlertID != alertID {
+		return nil, fmt.Errorf("alert match not found")
+	}
+
+	if match.Status != status {
+		match.SetStatus(status, userID)
+
+		if err := s.alertMatchRepo.UpdateStatus(ctx, match); err != nil {
+			return nil, fmt.Errorf("failed to update alert match status: %w", err)
+		}
+
+		log.Info().
+			Str("alert_id", alertID.String()).
+			Str("match_id", matchID.String()).
+			Str("user_id", userID.String()).
+			Str("status", string(status)).
+			Msg("Alert match status updated")
+	}
+
+	return match, nil
+}
+
+// canView reports whether the user owns the alert or belongs to the organization it is shared with
+func (s *AlertService) canView(ctx context.Context, alert *domain.Alert, userID uuid.UUID) bool {
+	if alert.UserID == userID {
+		return true
+	}
+
+	return s.sharedMembership(ctx, alert, userID) != nil
+}
+
+// canManage reports whether the user owns the alert or administers the organization it is shared with
+func (s *AlertService) canManage(ctx context.Context, alert *domain.Alert, userID uuid.UUID) bool {
+	if alert.UserID == userID {
+		return true
+	}
+
+	member := s.sharedMembership(ctx, alert, userID)
+	return member != nil && member.IsAdmin()
+}
+
+// sharedMembership returns the user's membership in the organization the alert is shared with, if any
+func (s *AlertService) sharedMembership(ctx context.Context, alert *domain.Alert, userID uuid.UUID) *domain.OrganizationMember {
+	if !alert.IsShared() {
+		return nil
+	}
+
+	member, err := s.membershipOf(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("alert_id", alert.ID.String()).
+			Str("user_id", userID.String()).
+			Msg("Failed to check organization membership for alert")
+		return nil
+	}
+
+	if member == nil || member.OrganizationID != *alert.OrganizationID {
+		return nil
+	}
+
+	return member
+}
+
+// membershipOf returns the user's organization membership, or nil when organizations are not enabled
+func (s *AlertService) membershipOf(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	if s.orgService == nil {
+		return nil, nil
+	}
+
+	return s.orgService.MembershipOf(ctx, userID)
+}
+
 // MatchArticle checks article against all active alerts and creates matches
-// This is called when a new article is created
+// This is called when a new article is created, inside its ingestion transaction, so a match
+// that cannot be saved fails the call rather than being skipped
 func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article) ([]*domain.AlertMatch, error) {
 	if article == nil {
 		return nil, fmt.Errorf("article cannot be nil")
@@ -292,6 +519,25 @@ func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article
 		return []*domain.AlertMatch{}, nil
 	}
 
+	// A new article is not yet flagged; a failed lookup only loses the priority boost
+	if s.kev != nil && !article.KEV {
+		if err := s.kev.Annotate(ctx, article); err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to look up KEV listing for alert priority")
+		}
+	}
+
+	if s.exploits != nil && !article.ExploitAvailable {
+		if err := s.exploits.Annotate(ctx, article); err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to look up public exploits for alert matching")
+		}
+	}
+
 	// Check article against each alert
 	matches := make([]*domain.AlertMatch, 0)
 
@@ -302,7 +548,7 @@ func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article
 		}
 
 		// Determine priority based on article severity
-		priority := domain.DeterminePriority(article)
+		priority := alert.MatchPriority(article)
 
 		// Create alert match
 		now := time.Now()
@@ -312,6 +558,7 @@ func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article
 			ArticleID: article.ID,
 			Priority:  priority,
 			MatchedAt: now,
+			Status:    domain.AlertMatchStatusNew,
 		}
 
 		if err := match.Validate(); err != nil {
@@ -325,12 +572,7 @@ func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article
 
 		// Save match to database
 		if err := s.alertMatchRepo.Create(ctx, match); err != nil {
-			log.Error().
-				Err(err).
-				Str("alert_id", alert.ID.String()).
-				Str("article_id", article.ID.String()).
-				Msg("Failed to create alert match")
-			continue
+			return nil, fmt.Errorf("failed to create match for alert %s: %w", alert.ID, err)
 		}
 
 		// Populate alert and article for notification
@@ -350,3 +592,174 @@ func (s *AlertService) MatchArticle(ctx context.Context, article *domain.Article
 
 	return matches, nil
 }
+
+// Backfill matches a newly created alert against articles published in the last days days
+// so the alert has results before the next ingestion. Matches are recorded like those of
+// new articles, and the alert's owner is sent a summary when the backfill completes
+func (s *AlertService) Backfill(ctx context.Context, alert *domain.Alert, days int) (*domain.AlertBackfillSummary, error) {
+	if alert == nil {
+		return nil, fmt.Errorf("alert cannot be nil")
+	}
+
+	if days < 1 || days > domain.MaxAlertBackfillDays {
+		return nil, fmt.Errorf("backfill days must be between 1 and %d", domain.MaxAlertBackfillDays)
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	filter := domain.NewArticleFilter()
+	filter.PublishedOnly = true
+	filter.DateFrom = &since
+	filter.PageSize = alertBackfillPageSize
+
+	summary := &domain.AlertBackfillSummary{
+		AlertID:    alert.ID,
+		AlertName:  alert.Name,
+		Days:       days,
+		TopMatches: make([]*domain.AlertMatch, 0),
+	}
+
+	for {
+		articles, total, err := s.articleRepo.List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list articles: %w", err)
+		}
+
+		for _, article := range articles {
+			summary.Scanned++
+
+			if !alert.Matches(article) {
+				continue
+			}
+
+			match := &domain.AlertMatch{
+				ID:        uuid.New(),
+				AlertID:   alert.ID,
+				ArticleID: article.ID,
+				Priority:  alert.MatchPriority(article),
+				MatchedAt: time.Now(),
+				Status:    domain.AlertMatchStatusNew,
+			}
+
+			if err := s.alertMatchRepo.Create(ctx, match); err != nil {
+				return nil, fmt.Errorf("failed to create match for article %s: %w", article.ID, err)
+			}
+
+			match.Article = article
+			summary.Matched++
+			summary.TopMatches = append(summary.TopMatches, match)
+		}
+
+		if len(articles) == 0 || filter.Page*filter.PageSize >= total {
+			break
+		}
+		filter.Page++
+	}
+
+	// Articles arrive newest first, so a stable sort keeps the newest of each priority first
+	sort.SliceStable(summary.TopMatches, func(i, j int) bool {
+		return backfillPriorityRank(summary.TopMatches[i].Priority) < backfillPriorityRank(summary.TopMatches[j].Priority)
+	})
+	if len(summary.TopMatches) > domain.AlertBackfillTopMatches {
+		summary.TopMatches = summary.TopMatches[:domain.AlertBackfillTopMatches]
+	}
+
+	log.Info().
+		Str("alert_id", alert.ID.String()).
+		Int("days", days).
+		Int("scanned", summary.Scanned).
+		Int("matched", summary.Matched).
+		Msg("Alert backfill completed")
+
+	if s.notifications != nil {
+		if err := s.notifications.NotifyAlertBackfill(alert.UserID, summary); err != nil {
+			log.Warn().
+				Err(err).
+				Str("alert_id", alert.ID.String()).
+				Msg("Failed to send alert backfill summary")
+		}
+	}
+
+	return summary, nil
+}
+
+// backfillPriorityRank orders match priorities from most to least urgent
+func backfillPriorityRank(priority string) int {
+	switch priority {
+	case "critical":
+		return 0
+	case "high":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// MatchExploitAlerts matches active exploit alerts against articles published since that now have a
+// public exploit, so an exploit published after an article was ingested still raises its alerts
+// Matches that already exist are left as they are; it returns how many alert/article pairs matched
+func (s *AlertService) MatchExploitAlerts(ctx context.Context, since time.Time) (int, error) {
+	activeAlerts, err := s.alertRepo.GetActiveAlerts(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active alerts: %w", err)
+	}
+
+	exploitAlerts := make([]*domain.Alert, 0)
+	for _, alert := range activeAlerts {
+		if alert.Type == domain.AlertTypeExploit {
+			exploitAlerts = append(exploitAlerts, alert)
+		}
+	}
+
+	if len(exploitAlerts) == 0 {
+		return 0, nil
+	}
+
+	exploitAvailable := true
+	filter := domain.NewArticleFilter()
+	filter.PublishedOnly = true
+	filter.ExploitAvailable = &exploitAvailable
+	filter.DateFrom = &since
+	filter.PageSize = alertBackfillPageSize
+
+	matched := 0
+	for {
+		articles, total, err := s.articleRepo.List(ctx, filter)
+		if err != nil {
+			return matched, fmt.Errorf("failed to list articles: %w", err)
+		}
+
+		for _, article := range articles {
+			for _, alert := range exploitAlerts {
+				if !alert.Matches(article) {
+					continue
+				}
+
+				match := &domain.AlertMatch{
+					ID:        uuid.New(),
+					AlertID:   alert.ID,
+					ArticleID: article.ID,
+					Priority:  alert.MatchPriority(article),
+					MatchedAt: time.Now(),
+					Status:    domain.AlertMatchStatusNew,
+				}
+
+				if err := s.alertMatchRepo.Create(ctx, match); err != nil {
+					return matched, fmt.Errorf("failed to create match for article %s: %w", article.ID, err)
+				}
+				matched++
+			}
+		}
+
+		if len(articles) == 0 || filter.Page*filter.PageSize >= total {
+			break
+		}
+		filter.Page++
+	}
+
+	log.Info().
+		Int("alerts", len(exploitAlerts)).
+		Int("matched", matched).
+		Msg("Exploit alert matching completed")
+
+	return matched, nil
+}
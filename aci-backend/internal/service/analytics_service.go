@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultAnalyticsWindow is the default reporting window
+	DefaultAnalyticsWindow = 30 * 24 * time.Hour
+
+	// MaxAnalyticsWindow is the largest reporting window accepted
+	MaxAnalyticsWindow = 90 * 24 * time.Hour
+
+	// DefaultAnalyticsTopN is how many sources, categories and articles are ranked by default
+	DefaultAnalyticsTopN = 10
+
+	// MaxAnalyticsTopN is the largest ranking size accepted
+	MaxAnalyticsTopN = 50
+
+	// analyticsCacheTTL is how long a computed report is served before it is rebuilt
+	analyticsCacheTTL = 5 * time.Minute
+)
+
+// analyticsCacheKey identifies a cached report
+type analyticsCacheKey struct {
+	tenant string
+	window time.Duration
+	topN   int
+}
+
+// analyticsCacheEntry is a cached report and when it expires
+type analyticsCacheEntry struct {
+	report    *domain.AdminAnalytics
+	expiresAt time.Time
+}
+
+// AnalyticsService builds the admin analytics dashboard
+// Reports are aggregated from the live tables, and client engagement from the daily event
+// aggregates, and cached briefly so repeated dashboard loads do not rerun the aggregate queries
+type AnalyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+
+	mu    sync.Mutex
+	cache map[analyticsCacheKey]analyticsCacheEntry
+}
+
+// NewAnalyticsService creates a new analytics service instance
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository) *AnalyticsService {
+	if analyticsRepo == nil {
+		panic("analyticsRepo cannot be nil")
+	}
+
+	return &AnalyticsService{
+		analyticsRepo: analyticsRepo,
+		cache:         make(map[analyticsCacheKey]analyticsCacheEntry),
+	}
+}
+
+// Report returns the analytics dashboard for the given window, ranking the top N entries
+// A zero window or top N falls back to the defaults
+func (s *AnalyticsService) Report(ctx context.Context, window time.Duration, topN int) (*domain.AdminAnalytics, error) {
+	if window <= 0 {
+		window = DefaultAnalyticsWindow
+	}
+
+	if window > MaxAnalyticsWindow {
+		return nil, fmt.Errorf("window cannot exceed %s", MaxAnalyticsWindow)
+	}
+
+	if topN <= 0 {
+		topN = DefaultAnalyticsTopN
+	}
+
+	if topN > MaxAnalyticsTopN {
+		return nil, fmt.Errorf("limit cannot exceed %d", MaxAnalyticsTopN)
+	}
+
+	key := analyticsCacheKey{tenant: tenantCacheScope(ctx), window: window, topN: topN}
+	now := time.Now()
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return entry.report, nil
+	}
+
+	report, err := s.build(ctx, now, window, topN)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	for k, e := range s.cache {
+		if !now.Before(e.expiresAt) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = analyticsCacheEntry{report: report, expiresAt: now.Add(analyticsCacheTTL)}
+	s.mu.Unlock()
+
+	return report, nil
+}
+
+// build runs the aggregate queries for one report
+func (s *AnalyticsService) build(ctx context.Context, now time.Time, window time.Duration, topN int) (*domain.AdminAnalytics, error) {
+	since := now.Add(-window)
+
+	ingestion, err := s.analyticsRepo.GetIngestionVolume(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingestion volume: %w", err)
+	}
+
+	latency, err := s.analyticsRepo.GetEnrichmentLatency(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrichment latency: %w", err)
+	}
+
+	topArticles, err := s.analyticsRepo.GetTopArticles(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top articles: %w", err)
+	}
+
+	activeUsers, err := s.analyticsRepo.GetActiveUsers(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active users: %w", err)
+	}
+
+	alertMatches, err := s.analyticsRepo.GetAlertMatchRates(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert match rates: %w", err)
+	}
+
+	clientEngagement, err := s.analyticsRepo.GetClientEngagement(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client engagement: %w", err)
+	}
+
+	shareLinks, err := s.analyticsRepo.GetShareLinkStats(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share link stats: %w", err)
+	}
+
+	return &domain.AdminAnalytics{
+		Window:            window.String(),
+		Since:             since,
+		GeneratedAt:       now,
+		Ingestion:         *ingestion,
+		EnrichmentLatency: *latency,
+		TopArticles:       topArticles,
+		ActiveUsers:       *activeUsers,
+		AlertMatches:      *alertMatches,
+		ClientEngagement:  *clientEngagement,
+		ShareLinks:        *shareLinks,
+	}, nil
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// AnnotationService manages analysts' internal comments and highlights on articles
+// Annotations belong to the author's organization and are visible to all of its members;
+// analysts and admins write them, only the author edits them, and the author or an
+// organization admin deletes them
+type AnnotationService struct {
+	annotationRepo repository.AnnotationRepository
+	articleRepo    repository.ArticleRepository
+	orgService     *OrganizationService
+	notifications  *NotificationService
+}
+
+// NewAnnotationService creates a new annotation service instance
+func NewAnnotationService(
+	annotationRepo repository.AnnotationRepository,
+	articleRepo repository.ArticleRepository,
+	orgService *OrganizationService,
+) *AnnotationService {
+	if annotationRepo == nil {
+		panic("annotationRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &AnnotationService{
+		annotationRepo: annotationRepo,
+		articleRepo:    articleRepo,
+		orgService:     orgService,
+	}
+}
+
+// SetNotificationService enables realtime notifications to mentioned members
+func (s *AnnotationService) SetNotificationService(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// AnnotationInput is the editable content of an annotation
+type AnnotationInput struct {
+	Body     string
+	Quote    *string
+	Mentions []uuid.UUID
+}
+
+// Create annotates an article for the author's organization and notifies the mentioned members
+func (s *AnnotationService) Create(ctx context.Context, userID uuid.UUID, role domain.UserRole, articleID uuid.UUID, input AnnotationInput) (*domain.Annotation, error) {
+	if !role.CanAnnotate() {
+		return nil, domainerrors.ErrForbidden
+	}
+
+	member, err := s.requireMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	article, err := s.getArticle(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	annotation := &domain.Annotation{
+		ID:             uuid.New(),
+		ArticleID:      article.ID,
+		OrganizationID: member.OrganizationID,
+		UserID:         userID,
+		Body:           strings.TrimSpace(input.Body),
+		Quote:          trimOptional(input.Quote),
+		Mentions:       dedupeMentions(input.Mentions, userID),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.validate(ctx, annotation); err != nil {
+		return nil, err
+	}
+
+	if err := s.annotationRepo.Create(ctx, annotation); err != nil {
+		return nil, err
+	}
+
+	created, err := s.annotationRepo.GetByID(ctx, annotation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyMentions(created, article, annotation.Mentions)
+
+	return created, nil
+}
+
+// Update replaces the content of the user's own annotation and notifies newly mentioned members
+func (s *AnnotationService) Update(ctx context.Context, id, userID uuid.UUID, input AnnotationInput) (*domain.Annotation, error) {
+	annotation, _, err := s.load(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if annotation.UserID != userID {
+		return nil, domainerrors.ErrForbidden
+	}
+
+	previous := make(map[uuid.UUID]bool, len(annotation.Mentions))
+	for _, mentioned := range annotation.Mentions {
+		previous[mentioned] = true
+	}
+
+	annotation.Body = strings.TrimSpace(input.Body)
+	annotation.Quote = trimOptional(input.Quote)
+	annotation.Mentions = dedupeMentions(input.Mentions, userID)
+
+	if err := s.validate(ctx, annotation); err != nil {
+		return nil, err
+	}
+
+	if err := s.annotationRepo.Update(ctx, annotation); err != nil {
+		return nil, err
+	}
+
+	added := make([]uuid.UUID, 0)
+	for _, mentioned := range annotation.Mentions {
+		if !previous[mentioned] {
+			added = append(added, mentioned)
+		}
+	}
+
+	if len(added) > 0 {
+		if article, err := s.getArticle(ctx, annotation.ArticleID); err == nil {
+			s.notifyMentions(annotation, article, added)
+		}
+	}
+
+	return annotation, nil
+}
+
+// Delete removes an annotation; only its author or an organization admin can delete it
+func (s *AnnotationService) Delete(ctx context.Context, id, userID uuid.UUID) error {
+	annotation, member, err := s.load(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if annotation.UserID != userID && !member.IsAdmin() {
+		return domainerrors.ErrForbidden
+	}
+
+	return s.annotationRepo.Delete(ctx, id)
+}
+
+// ListForArticle returns the annotations on an article visible to the user, oldest first
+// Users outside an organization see none
+func (s *AnnotationService) ListForArticle(ctx context.Context, userID, articleID uuid.UUID) ([]*domain.Annotation, error) {
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return []*domain.Annotation{}, nil
+	}
+
+	return s.annotationRepo.ListByArticle(ctx, member.OrganizationID, articleID)
+}
+
+// ListRecent returns a page of the annotations in the user's organization created since, newest first
+// Digests include the organization's annotations from the digest period through this list
+func (s *AnnotationService) ListRecent(ctx context.Context, userID uuid.UUID, since *time.Time, page, pageSize int) ([]*domain.Annotation, int, error) {
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if member == nil {
+		return []*domain.Annotation{}, 0, nil
+	}
+
+	return s.annotationRepo.ListByOrganization(ctx, member.OrganizationID, since, pageSize, (page-1)*pageSize)
+}
+
+// load returns an annotation in the user's organization with the user's membership,
+// hiding annotations from other organizations
+func (s *AnnotationService) load(ctx context.Context, id, userID uuid.UUID) (*domain.Annotation, *domain.OrganizationMember, error) {
+	annotation, err := s.annotationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if member == nil || member.OrganizationID != annotation.OrganizationID {
+		return nil, nil, &domainerrors.NotFoundError{Resource: "annotation", ID: id.String()}
+	}
+
+	return annotation, member, nil
+}
+
+// requireMembership returns the user's membership, or a ValidationError if they belong to no organization
+func (s *AnnotationService) requireMembership(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, &domainerrors.ValidationError{Field: "organization", Message: "user does not belong to an organization"}
+	}
+
+	return member, nil
+}
+
+// validate checks the annotation and that every mentioned user belongs to its organization
+func (s *AnnotationService) validate(ctx context.Context, annotation *domain.Annotation) error {
+	if err := annotation.Validate(); err != nil {
+		return &domainerrors.ValidationError{Field: "annotation", Message: err.Error()}
+	}
+
+	for _, mentioned := range annotation.Mentions {
+		member, err := s.orgService.MembershipOf(ctx, mentioned)
+		if err != nil {
+			return err
+		}
+		if member == nil || member.OrganizationID != annotation.OrganizationID {
+			return &domainerrors.ValidationError{
+				Field:   "mentions",
+				Message: "mentioned user " + mentioned.String() + " is not a member of the organization",
+			}
+		}
+	}
+
+	return nil
+}
+
+// getArticle loads an article, mapping a missing article to a NotFoundError
+func (s *AnnotationService) getArticle(ctx context.Context, articleID uuid.UUID) (*domain.Article, error) {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// notifyMentions sends a realtime notification to each mentioned member
+func (s *AnnotationService) notifyMentions(annotation *domain.Annotation, article *domain.Article, mentioned []uuid.UUID) {
+	if s.notifications == nil {
+		return
+	}
+
+	mention := &domain.AnnotationMention{
+		AnnotationID: annotation.ID,
+		ArticleID:    article.ID,
+		ArticleTitle: article.DisplayTitle(),
+		AuthorID:     annotation.UserID,
+		AuthorName:   annotation.AuthorName,
+		Body:         annotation.Body,
+		Quote:        annotation.Quote,
+	}
+
+	for _, userID := range mentioned {
+		if err := s.notifications.NotifyAnnotationMention(userID, article.Severity, mention); err != nil {
+			log.Warn().
+				Err(err).
+				Str("annotation_id", annotation.ID.String()).
+				Str("user_id", userID.String()).
+				Msg("Failed to send annotation mention notification")
+		}
+	}
+}
+
+// dedupeMentions removes repeated mentions and the author's own
+func dedupeMentions(mentions []uuid.UUID, authorID uuid.UUID) []uuid.UUID {
+	seen := map[uuid.UUID]bool{authorID: true, uuid.Nil: true}
+	unique := make([]uuid.UUID, 0, len(mentions))
+
+	for _, mentioned := range mentions {
+		if seen[mentioned] {
+			continue
+		}
+		seen[mentioned] = true
+		unique = append(unique, mentioned)
+	}
+
+	return unique
+}
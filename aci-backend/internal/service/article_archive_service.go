@@ -0,0 +1,261 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/html/charset"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/readability"
+	"github.com/phillipboles/aci-backend/internal/util/safehttp"
+)
+
+const (
+	// articleArchiveBatchSize is how many source pages are fetched per run
+	articleArchiveBatchSize = 20
+
+	// articleArchiveWindow is how long after ingestion an article's source page is still archived
+	// Older articles are not archived retroactively
+	articleArchiveWindow = 7 * 24 * time.Hour
+
+	// articleArchiveMaxAttempts is how many failures a page gets before it is given up on
+	articleArchiveMaxAttempts = 5
+
+	// articleArchiveFetchTimeout bounds fetching one source page
+	articleArchiveFetchTimeout = 20 * time.Second
+
+	// maxArchivedPageSize is the largest source page that is archived
+	maxArchivedPageSize = 5 << 20
+
+	// articleArchiveUserAgent identifies archive fetches to source sites
+	articleArchiveUserAgent = "ACI-Archiver/1.0"
+)
+
+// ArticleArchiveStore reads and writes archived pages in object storage
+type ArticleArchiveStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, string, error)
+}
+
+// ArticleArchiveService keeps a snapshot of each article's source page in object storage so
+// articles survive link rot. Shortly after ingestion the page is fetched, its main content
+// extracted, and stored as HTML and plain text; failures are retried with backoff
+type ArticleArchiveService struct {
+	archiveRepo repository.ArticleArchiveRepository
+	store       ArticleArchiveStore
+	prefix      string
+	client      *http.Client
+}
+
+// NewArticleArchiveService creates a new article archive service instance
+// Pages are stored under prefix/<article id>/ in the store's bucket
+func NewArticleArchiveService(archiveRepo repository.ArticleArchiveRepository, store ArticleArchiveStore, prefix string) *ArticleArchiveService {
+	if archiveRepo == nil {
+		panic("archiveRepo cannot be nil")
+	}
+
+	if store == nil {
+		panic("store cannot be nil")
+	}
+
+	return &ArticleArchiveService{
+		archiveRepo: archiveRepo,
+		store:       store,
+		prefix:      prefix,
+		// Source URLs come from ingested content, so fetches may only reach public addresses
+		client: safehttp.NewClient(articleArchiveFetchTimeout),
+	}
+}
+
+// ArchivePending archives the source pages of recently ingested articles
+// It returns how many pages were archived; a page that cannot be fetched or has no content
+// is recorded as failed and does not stop the run
+func (s *ArticleArchiveService) ArchivePending(ctx context.Context) (int, error) {
+	candidates, err := s.archiveRepo.ListPending(ctx, time.Now().Add(-articleArchiveWindow), articleArchiveMaxAttempts, articleArchiveBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	archived := 0
+	for _, candidate := range candidates {
+		if err := ctx.Err(); err != nil {
+			return archived, err
+		}
+
+		if err := s.archive(ctx, candidate); err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", candidate.ArticleID.String()).
+				Str("source_url", candidate.SourceURL).
+				Msg("Failed to archive article source page")
+
+			if err := s.archiveRepo.MarkFailed(ctx, candidate.ArticleID, err.Error()); err != nil {
+				return archived, err
+			}
+			continue
+		}
+
+		archived++
+	}
+
+	return archived, nil
+}
+
+// Run archives pending source pages on every interval until the context is cancelled
+func (s *ArticleArchiveService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			archived, err := s.ArchivePending(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Error().Err(err).Int("archived", archived).Msg("Failed to archive article source pages")
+				continue
+			}
+
+			if archived > 0 {
+				log.Info().Int("archived", archived).Msg("Archived article source pages")
+			}
+		}
+	}
+}
+
+// GetArchive returns the article's archive record, or nil when its page has not been archived
+func (s *ArticleArchiveService) GetArchive(ctx context.Context, articleID uuid.UUID) (*domain.ArticleArchive, error) {
+	archive, err := s.archiveRepo.GetByArticleID(ctx, articleID)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if archive.Status != domain.ArticleArchiveArchived {
+		return nil, nil
+	}
+
+	return archive, nil
+}
+
+// GetSnapshot returns the archived page as HTML, or as plain text when text is true, with its
+// content type; a *domainerrors.NotFoundError means the page has not been archived
+func (s *ArticleArchiveService) GetSnapshot(ctx context.Context, articleID uuid.UUID, text bool) ([]byte, string, error) {
+	archive, err := s.GetArchive(ctx, articleID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if archive == nil || archive.HTMLKey == nil || archive.TextKey == nil {
+		return nil, "", &domainerrors.NotFoundError{
+			Resource: "article archive",
+			ID:       articleID.String(),
+		}
+	}
+
+	key := *archive.HTMLKey
+	if text {
+		key = *archive.TextKey
+	}
+
+	return s.store.Get(ctx, key)
+}
+
+// archive fetches, extracts and stores one source page
+func (s *ArticleArchiveService) archive(ctx context.Context, candidate *domain.ArticleArchiveCandidate) error {
+	page, err := s.fetch(ctx, candidate.SourceURL)
+	if err != nil {
+		return err
+	}
+
+	doc, err := readability.Extract(page)
+	if err != nil {
+		return err
+	}
+
+	if doc.Text == "" {
+		return fmt.Errorf("no content found on page")
+	}
+
+	snapshot := fmt.Sprintf(
+		"<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<base href=\"%s\">\n<title>%s</title>\n</head>\n<body>\n%s\n</body>\n</html>\n",
+		html.EscapeString(candidate.SourceURL),
+		html.EscapeString(doc.Title),
+		doc.HTML,
+	)
+
+	htmlKey := path.Join(s.prefix, candidate.ArticleID.String(), "page.html")
+	if err := s.store.Put(ctx, htmlKey, []byte(snapshot), "text/html; charset=utf-8"); err != nil {
+		return err
+	}
+
+	textKey := path.Join(s.prefix, candidate.ArticleID.String(), "page.txt")
+	if err := s.store.Put(ctx, textKey, []byte(doc.Text), "text/plain; charset=utf-8"); err != nil {
+		return err
+	}
+
+	return s.archiveRepo.MarkArchived(ctx, candidate.ArticleID, htmlKey, textKey)
+}
+
+// fetch downloads an HTML source page and returns it decoded to UTF-8
+func (s *ArticleArchiveService) fetch(ctx context.Context, sourceURL string) (io.Reader, error) {
+	parsed, err := safehttp.ParseURL(sourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", articleArchiveUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch source page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("source page returned status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType != "" && !strings.Contains(contentType, "html") {
+		return nil, fmt.Errorf("source page is %s, not html", contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxArchivedPageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source page: %w", err)
+	}
+
+	if len(body) > maxArchivedPageSize {
+		return nil, fmt.Errorf("source page exceeds %d bytes", maxArchivedPageSize)
+	}
+
+	page, err := charset.NewReader(bytes.NewReader(body), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode source page: %w", err)
+	}
+
+	return page, nil
+}
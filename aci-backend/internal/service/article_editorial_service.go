@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// ArticleEditorialService manages editorial title and summary overrides
+// Overrides are stored beside the source content, which is never rewritten; every change
+// is written to the audit log with the source title so edits can be traced back.
+// A new editorial title also renames the slug, keeping the old one as a redirect
+type ArticleEditorialService struct {
+	articleRepo repository.ArticleRepository
+	auditRepo   repository.AuditLogRepository
+}
+
+// NewArticleEditorialService creates a new article editorial service instance
+func NewArticleEditorialService(articleRepo repository.ArticleRepository, auditRepo repository.AuditLogRepository) *ArticleEditorialService {
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &ArticleEditorialService{
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// Get returns the article's source title and summary alongside its overrides
+func (s *ArticleEditorialService) Get(ctx context.Context, articleID uuid.UUID) (*domain.ArticleEditorial, error) {
+	article, err := s.getArticle(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewArticleEditorial(article), nil
+}
+
+// Update replaces both overrides; a nil or blank value clears that override
+func (s *ArticleEditorialService) Update(
+	ctx context.Context,
+	articleID uuid.UUID,
+	title, summary *string,
+	editor *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.ArticleEditorial, error) {
+	title = normalizeEditorial(title)
+	summary = normalizeEditorial(summary)
+
+	if title != nil && len(*title) > domain.MaxEditorialTitleLength {
+		return nil, &domainerrors.ValidationError{
+			Field:   "editorial_title",
+			Message: fmt.Sprintf("editorial title cannot exceed %d characters", domain.MaxEditorialTitleLength),
+		}
+	}
+
+	if summary != nil && len(*summary) > domain.MaxEditorialSummaryLength {
+		return nil, &domainerrors.ValidationError{
+			Field:   "editorial_summary",
+			Message: fmt.Sprintf("editorial summary cannot exceed %d characters", domain.MaxEditorialSummaryLength),
+		}
+	}
+
+	article, err := s.getArticle(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := domain.NewArticleEditorial(article)
+
+	if err := s.articleRepo.UpdateEditorial(ctx, articleID, title, summary, editor); err != nil {
+		return nil, fmt.Errorf("failed to update editorial overrides: %w", err)
+	}
+
+	if title != nil && (previous.EditorialTitle == nil || *title != *previous.EditorialTitle) {
+		s.renameSlug(ctx, article, *title)
+	}
+
+	updated, err := s.Get(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, editor, articleID, previous, updated, ipAddress, userAgent)
+
+	return updated, nil
+}
+
+// renameSlug gives the article a slug built from its new editorial title; links to the old
+// slug keep resolving. Failures are logged and leave the slug as it was
+func (s *ArticleEditorialService) renameSlug(ctx context.Context, article *domain.Article, title string) {
+	newSlug, err := uniqueArticleSlug(ctx, s.articleRepo, title, article.Slug)
+	if err == nil && newSlug != article.Slug {
+		err = s.articleRepo.UpdateSlug(ctx, article.ID, newSlug)
+	}
+
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("article_id", article.ID.String()).
+			Msg("Failed to rename article slug after editorial title change")
+	}
+}
+
+// getArticle loads an article, mapping a missing article to a NotFoundError
+func (s *ArticleEditorialService) getArticle(ctx context.Context, articleID uuid.UUID) (*domain.Article, error) {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// audit records an editorial change; failures are logged and do not fail the update
+func (s *ArticleEditorialService) audit(
+	ctx context.Context,
+	editor *uuid.UUID,
+	articleID uuid.UUID,
+	oldValue, newValue *domain.ArticleEditorial,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(editor, domain.AuditActionArticleEditorialUpdated, "article", &articleID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to write article editorial audit log")
+	}
+}
+
+// normalizeEditorial trims an override, treating a blank value as no override
+func normalizeEditorial(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/canonical"
+	"github.com/phillipboles/aci-backend/internal/util/safehttp"
+)
+
+const (
+	// DefaultRedirectTimeout bounds resolving the redirects of an incoming source URL
+	DefaultRedirectTimeout = 5 * time.Second
+
+	// contentHashMinWords is the shortest text hashed; shorter text is often a teaser
+	// or boilerplate shared by unrelated articles
+	contentHashMinWords = 50
+
+	// redirectResolverUserAgent identifies redirect resolution to publishers
+	redirectResolverUserAgent = "ACI-Ingest/1.0"
+)
+
+// DuplicateArticleError is returned for an incoming article that is already stored
+// under another source URL; the URL it arrived under is linked to the stored article
+type DuplicateArticleError struct {
+	ArticleID uuid.UUID
+	Reason    domain.DuplicateReason
+}
+
+func (e *DuplicateArticleError) Error() string {
+	return fmt.Sprintf("article duplicates %s by %s", e.ArticleID, e.Reason)
+}
+
+// ArticleIdentity is the canonical source URL and content hash of an incoming article
+type ArticleIdentity struct {
+	CanonicalURL string
+	ContentHash  string // empty when the text is too short to compare
+}
+
+// ArticleIdentityService detects articles that arrive again under a different URL
+// Source URLs are canonicalized, after following their redirects, and article text is
+// reduced to a normalized hash; either matching a stored article makes the new one a
+// true duplicate. Near-duplicates with edited text are clustered by DeduplicationService
+type ArticleIdentityService struct {
+	identityRepo repository.ArticleIdentityRepository
+	client       *http.Client // nil when redirects are not resolved
+}
+
+// NewArticleIdentityService creates a new article identity service instance
+// A non-positive redirectTimeout falls back to DefaultRedirectTimeout
+func NewArticleIdentityService(
+	identityRepo repository.ArticleIdentityRepository,
+	resolveRedirects bool,
+	redirectTimeout time.Duration,
+) *ArticleIdentityService {
+	if identityRepo == nil {
+		panic("identityRepo cannot be nil")
+	}
+
+	if redirectTimeout <= 0 {
+		redirectTimeout = DefaultRedirectTimeout
+	}
+
+	s := &ArticleIdentityService{identityRepo: identityRepo}
+	if resolveRedirects {
+		// Source URLs come from webhook payloads, so requests may only reach public addresses
+		s.client = safehttp.NewClient(redirectTimeout)
+	}
+
+	return s
+}
+
+// Identify computes the identity of an incoming article from its source URL and sanitized content
+// A source URL that cannot be resolved or canonicalized is compared as received
+func (s *ArticleIdentityService) Identify(ctx context.Context, sourceURL, content string) ArticleIdentity {
+	resolved := sourceURL
+	if s.client != nil {
+		if target, err := s.resolve(ctx, sourceURL); err != nil {
+			log.Debug().Err(err).Str("source_url", sourceURL).Msg("Failed to resolve source URL redirects")
+		} else {
+			resolved = target
+		}
+	}
+
+	canonicalURL, err := canonical.URL(resolved)
+	if err != nil {
+		canonicalURL = strings.TrimSpace(sourceURL)
+	}
+
+	return ArticleIdentity{
+		CanonicalURL: canonicalURL,
+		ContentHash:  canonical.ContentHash(content, contentHashMinWords),
+	}
+}
+
+// FindDuplicate returns the stored article an incoming article duplicates and why,
+// or uuid.Nil when it is new
+func (s *ArticleIdentityService) FindDuplicate(ctx context.Context, identity ArticleIdentity) (uuid.UUID, domain.DuplicateReason, error) {
+	articleID, err := s.identityRepo.FindByCanonicalURL(ctx, identity.CanonicalURL)
+	if err == nil {
+		return articleID, domain.DuplicateReasonURL, nil
+	}
+	if !isNotFound(err) {
+		return uuid.Nil, "", err
+	}
+
+	if identity.ContentHash == "" {
+		return uuid.Nil, "", nil
+	}
+
+	articleID, err = s.identityRepo.FindByContentHash(ctx, identity.ContentHash)
+	if err == nil {
+		return articleID, domain.DuplicateReasonContent, nil
+	}
+	if !isNotFound(err) {
+		return uuid.Nil, "", err
+	}
+
+	return uuid.Nil, "", nil
+}
+
+// Record stores the identity of a newly created article, in the caller's transaction if any
+func (s *ArticleIdentityService) Record(ctx context.Context, articleID uuid.UUID, sourceURL string, identity ArticleIdentity) error {
+	return s.identityRepo.Record(ctx, &domain.ArticleSourceURL{
+		CanonicalURL: identity.CanonicalURL,
+		ArticleID:    articleID,
+		SourceURL:    sourceURL,
+		CreatedAt:    time.Now(),
+	}, identity.ContentHash)
+}
+
+// Link records that a duplicate arrived under sourceURL; failures are logged, not returned
+func (s *ArticleIdentityService) Link(ctx context.Context, articleID uuid.UUID, sourceURL string, identity ArticleIdentity, reason domain.DuplicateReason) {
+	err := s.identityRepo.LinkURL(ctx, &domain.ArticleSourceURL{
+		CanonicalURL: identity.CanonicalURL,
+		ArticleID:    articleID,
+		SourceURL:    sourceURL,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Str("source_url", sourceURL).
+			Msg("Failed to link duplicate article URL")
+		return
+	}
+
+	log.Info().
+		Str("article_id", articleID.String()).
+		Str("source_url", sourceURL).
+		Str("reason", string(reason)).
+		Msg("Duplicate article linked to stored article")
+}
+
+// ListURLs returns the URLs an article was received under, oldest first
+func (s *ArticleIdentityService) ListURLs(ctx context.Context, articleID uuid.UUID) ([]*domain.ArticleSourceURL, error) {
+	return s.identityRepo.ListURLs(ctx, articleID)
+}
+
+// resolve follows the redirects of a source URL and returns where they end
+func (s *ArticleIdentityService) resolve(ctx context.Context, sourceURL string) (string, error) {
+	parsed, err := safehttp.ParseURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, parsed.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", redirectResolverUserAgent)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve URL: %w", err)
+	}
+	resp.Body.Close()
+
+	// Error pages are not the article, so the URL as received is a better key
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.Request.URL.String(), nil
+}
+
+// isNotFound reports whether err is a repository NotFoundError
+func isNotFound(err error) bool {
+	var notFoundErr *domainerrors.NotFoundError
+	return errors.As(err, &notFoundErr)
+}
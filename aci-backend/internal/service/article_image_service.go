@@ -0,0 +1,223 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/safehttp"
+	"github.com/phillipboles/aci-backend/internal/util/thumbnail"
+)
+
+const (
+	// articleImageBatchSize is how many images are fetched per run
+	articleImageBatchSize = 20
+
+	// articleImageWindow is how long after ingestion an article's image is still fetched
+	articleImageWindow = 7 * 24 * time.Hour
+
+	// articleImageMaxAttempts is how many failures an image gets before it is given up on
+	articleImageMaxAttempts = 5
+
+	// articleImageFetchTimeout bounds fetching one image
+	articleImageFetchTimeout = 20 * time.Second
+
+	// maxArticleImageSize is the largest image file that is fetched
+	maxArticleImageSize = 10 << 20
+
+	// maxArticleImagePixels is the largest image, in pixels, that is decoded
+	maxArticleImagePixels = 40_000_000
+
+	// articleImageUserAgent identifies image fetches to source sites
+	articleImageUserAgent = "ACI-ImageFetcher/1.0"
+)
+
+// ArticleImageStore reads and writes resized images in object storage
+type ArticleImageStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, string, error)
+}
+
+// ArticleImageService stores the hero images of ingested articles
+// Shortly after ingestion the image is fetched from its remote URL, checked, resized to the
+// standard sizes and stored as JPEG; failures are retried with backoff. Remote images are
+// never served directly, so readers only ever load images from this service
+type ArticleImageService struct {
+	imageRepo repository.ArticleImageRepository
+	store     ArticleImageStore
+	prefix    string
+	client    *http.Client
+}
+
+// NewArticleImageService creates a new article image service instance
+// Images are stored under prefix/<article id>/<size>.jpg in the store's bucket
+func NewArticleImageService(imageRepo repository.ArticleImageRepository, store ArticleImageStore, prefix string) *ArticleImageService {
+	if imageRepo == nil {
+		panic("imageRepo cannot be nil")
+	}
+
+	if store == nil {
+		panic("store cannot be nil")
+	}
+
+	return &ArticleImageService{
+		imageRepo: imageRepo,
+		store:     store,
+		prefix:    prefix,
+		// Image URLs come from webhook payloads, so fetches may only reach public addresses
+		client: safehttp.NewClient(articleImageFetchTimeout),
+	}
+}
+
+// StorePending fetches and stores the images of recently ingested articles
+// It returns how many images were stored; an image that cannot be fetched or decoded is
+// recorded as failed and does not stop the run
+func (s *ArticleImageService) StorePending(ctx context.Context) (int, error) {
+	candidates, err := s.imageRepo.ListPending(ctx, time.Now().Add(-articleImageWindow), articleImageMaxAttempts, articleImageBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	stored := 0
+	for _, candidate := range candidates {
+		if err := ctx.Err(); err != nil {
+			return stored, err
+		}
+
+		if err := s.storeImage(ctx, candidate); err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", candidate.ArticleID.String()).
+				Str("image_url", candidate.ImageURL).
+				Msg("Failed to store article image")
+
+			if err := s.imageRepo.MarkFailed(ctx, candidate.ArticleID, err.Error()); err != nil {
+				return stored, err
+			}
+			continue
+		}
+
+		stored++
+	}
+
+	return stored, nil
+}
+
+// Run stores pending images on every interval until the context is cancelled
+func (s *ArticleImageService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stored, err := s.StorePending(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Error().Err(err).Int("stored", stored).Msg("Failed to store article images")
+				continue
+			}
+
+			if stored > 0 {
+				log.Info().Int("stored", stored).Msg("Stored article images")
+			}
+		}
+	}
+}
+
+// GetImage returns the article's hero image at the named size with its content type
+// A *domainerrors.NotFoundError means the article has no stored image or the size is unknown
+func (s *ArticleImageService) GetImage(ctx context.Context, article *domain.Article, sizeName string) ([]byte, string, error) {
+	size, ok := domain.ArticleImageSizeByName(sizeName)
+	if !ok || article.ImageKey == nil {
+		return nil, "", &domainerrors.NotFoundError{
+			Resource: "article image",
+			ID:       article.ID.String(),
+		}
+	}
+
+	return s.store.Get(ctx, articleImageObjectKey(*article.ImageKey, size))
+}
+
+// storeImage fetches one image and stores it at every standard size
+func (s *ArticleImageService) storeImage(ctx context.Context, candidate *domain.ArticleImageCandidate) error {
+	data, err := s.fetch(ctx, candidate.ImageURL)
+	if err != nil {
+		return err
+	}
+
+	img, err := thumbnail.Decode(data, maxArticleImagePixels)
+	if err != nil {
+		return err
+	}
+
+	key := path.Join(s.prefix, candidate.ArticleID.String())
+	for _, size := range domain.ArticleImageSizes {
+		var buf bytes.Buffer
+		if err := thumbnail.EncodeJPEG(&buf, thumbnail.Resize(img, size.Width)); err != nil {
+			return err
+		}
+
+		if err := s.store.Put(ctx, articleImageObjectKey(key, size), buf.Bytes(), "image/jpeg"); err != nil {
+			return err
+		}
+	}
+
+	return s.imageRepo.MarkStored(ctx, candidate.ArticleID, key)
+}
+
+// fetch downloads an image, refusing responses that are not images or are too large
+func (s *ArticleImageService) fetch(ctx context.Context, imageURL string) ([]byte, error) {
+	parsed, err := safehttp.ParseURL(imageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", articleImageUserAgent)
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("image returned status %d", resp.StatusCode)
+	}
+
+	if resp.ContentLength > maxArticleImageSize {
+		return nil, fmt.Errorf("image exceeds %d bytes", maxArticleImageSize)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxArticleImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	if len(data) > maxArticleImageSize {
+		return nil, fmt.Errorf("image exceeds %d bytes", maxArticleImageSize)
+	}
+
+	return data, nil
+}
+
+// articleImageObjectKey is where an image is stored at one size
+func articleImageObjectKey(key string, size domain.ArticleImageSize) string {
+	return key + "/" + size.Name + ".jpg"
+}
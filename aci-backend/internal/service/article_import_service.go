@@ -0,0 +1,474 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// articleImportCheckpointInterval is how many records are processed between checkpoints
+	articleImportCheckpointInterval = 100
+
+	// articleImportStaleAfter is how long a running import may go without a checkpoint before
+	// it is assumed to have died with its process and may be resumed
+	articleImportStaleAfter = 10 * time.Minute
+
+	// articleImportListLimit bounds how many imports List returns
+	articleImportListLimit = 50
+
+	// articleImportListSeparator separates the values of list fields in CSV records
+	articleImportListSeparator = ";"
+)
+
+// ArticleImportRecord is one article in an import; the fields match an article.created webhook
+type ArticleImportRecord struct {
+	Title         string   `json:"title"`
+	Content       string   `json:"content"`
+	Summary       string   `json:"summary,omitempty"`
+	CategorySlug  string   `json:"category_slug"`
+	CategorySlugs []string `json:"category_slugs,omitempty"`
+	Severity      string   `json:"severity,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	SourceURL     string   `json:"source_url"`
+	SourceName    string   `json:"source_name,omitempty"`
+	ImageURL      string   `json:"image_url,omitempty"`
+	PublishedAt   string   `json:"published_at,omitempty"`
+	CVEs          []string `json:"cves,omitempty"`
+	Vendors       []string `json:"vendors,omitempty"`
+}
+
+// ArticleImportService backfills historical articles from JSONL and CSV dumps
+// Records are streamed one at a time through the same validation and duplicate detection as
+// webhooks, and are stored as historical articles: published without review, unenriched, and
+// not matched against alerts. Progress is checkpointed so a failed or interrupted import can be
+// resumed with the same file; records after the last checkpoint are processed again and are
+// then skipped as duplicates
+type ArticleImportService struct {
+	importRepo     repository.ArticleImportRepository
+	auditRepo      repository.AuditLogRepository
+	articleService *ArticleService
+}
+
+// NewArticleImportService creates a new article import service instance
+func NewArticleImportService(
+	importRepo repository.ArticleImportRepository,
+	auditRepo repository.AuditLogRepository,
+	articleService *ArticleService,
+) *ArticleImportService {
+	if importRepo == nil {
+		panic("importRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+	if articleService == nil {
+		panic("articleService cannot be nil")
+	}
+
+	return &ArticleImportService{
+		importRepo:     importRepo,
+		auditRepo:      auditRepo,
+		articleService: articleService,
+	}
+}
+
+// Start records a new import; pass it to Process with the file's contents
+func (s *ArticleImportService) Start(ctx context.Context, format, filename string, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleImport, error) {
+	if !domain.IsValidArticleImportFormat(format) {
+		return nil, &domainerrors.ValidationError{Field: "format", Message: "must be jsonl or csv"}
+	}
+
+	now := time.Now()
+	articleImport := &domain.ArticleImport{
+		ID:        uuid.New(),
+		Format:    format,
+		Filename:  truncateFilename(filename),
+		Status:    domain.ArticleImportStatusRunning,
+		Errors:    []domain.ArticleImportError{},
+		CreatedBy: actorID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.importRepo.Create(ctx, articleImport); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, actorID, articleImport, ipAddress, userAgent)
+
+	return articleImport, nil
+}
+
+// Resume marks a stopped import as running again; pass it to Process with the same file
+// Completed imports, and running imports that checkpointed recently, cannot be resumed
+func (s *ArticleImportService) Resume(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleImport, error) {
+	articleImport, err := s.importRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch articleImport.Status {
+	case domain.ArticleImportStatusCompleted:
+		return nil, &domainerrors.ValidationError{Field: "status", Message: "import has already completed"}
+	case domain.ArticleImportStatusRunning:
+		if time.Since(articleImport.UpdatedAt) < articleImportStaleAfter {
+			return nil, &domainerrors.ValidationError{Field: "status", Message: "import is still running"}
+		}
+	}
+
+	articleImport.Status = domain.ArticleImportStatusRunning
+	articleImport.Error = nil
+	if err := s.importRepo.UpdateProgress(ctx, articleImport); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, actorID, articleImport, ipAddress, userAgent)
+
+	return articleImport, nil
+}
+
+// Get returns an import and its progress
+func (s *ArticleImportService) Get(ctx context.Context, id uuid.UUID) (*domain.ArticleImport, error) {
+	return s.importRepo.GetByID(ctx, id)
+}
+
+// List returns the most recent imports, newest first
+func (s *ArticleImportService) List(ctx context.Context) ([]*domain.ArticleImport, error) {
+	return s.importRepo.List(ctx, articleImportListLimit)
+}
+
+// Process reads an import's records from r, skipping those before its checkpoint, and stores
+// each new article. progress, if not nil, is called after every checkpoint
+// A record that cannot be stored is counted and recorded, and the import continues; an
+// unreadable file, a file that does not match the checkpoint, or cancellation of ctx stops
+// the import as failed. The import is saved in its final state before Process returns
+func (s *ArticleImportService) Process(ctx context.Context, articleImport *domain.ArticleImport, r io.Reader, progress func(*domain.ArticleImport)) error {
+	err := s.process(ctx, articleImport, r, progress)
+
+	now := time.Now()
+	if err != nil {
+		message := err.Error()
+		articleImport.Status = domain.ArticleImportStatusFailed
+		articleImport.Error = &message
+	} else {
+		articleImport.Status = domain.ArticleImportStatusCompleted
+		articleImport.CompletedAt = &now
+	}
+
+	// The import context may be the one that was cancelled
+	if updateErr := s.importRepo.UpdateProgress(requestctx.Detach(ctx), articleImport); updateErr != nil {
+		log.Error().
+			Err(updateErr).
+			Str("request_id", requestctx.RequestID(ctx)).
+			Str("import_id", articleImport.ID.String()).
+			Msg("Failed to save article import")
+	}
+
+	log.Info().
+		Str("request_id", requestctx.RequestID(ctx)).
+		Str("import_id", articleImport.ID.String()).
+		Str("status", string(articleImport.Status)).
+		Int("processed", articleImport.Processed).
+		Int("created", articleImport.Created).
+		Int("duplicates", articleImport.Duplicates).
+		Int("failed", articleImport.Failed).
+		Msg("Article import finished")
+
+	return err
+}
+
+// process runs an import until the file ends or it must stop
+func (s *ArticleImportService) process(ctx context.Context, articleImport *domain.ArticleImport, r io.Reader, progress func(*domain.ArticleImport)) error {
+	reader, err := newArticleRecordReader(articleImport.Format, r)
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.New()
+	checkpoint := articleImport.Processed
+
+	for number := 1; ; number++ {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("import interrupted: %w", err)
+		}
+
+		raw, record, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			if number <= checkpoint {
+				return fmt.Errorf("file has %d records but the import was checkpointed after %d; resume with the same file", number-1, checkpoint)
+			}
+			return nil
+		}
+		var invalidErr *invalidRecordError
+		if err != nil && !errors.As(err, &invalidErr) {
+			return fmt.Errorf("failed to read record %d: %w", number, err)
+		}
+
+		checksum.Write(raw)
+		checksum.Write([]byte{'\n'})
+
+		// Records before the checkpoint were processed by an earlier run
+		if number <= checkpoint {
+			if number == checkpoint && sumHex(checksum) != articleImport.Checksum {
+				return fmt.Errorf("the first %d records do not match the import being resumed; resume with the same file", checkpoint)
+			}
+			continue
+		}
+
+		if invalidErr != nil {
+			articleImport.AddError(number, invalidErr.Error())
+		} else {
+			s.importRecord(ctx, articleImport, number, record)
+		}
+
+		articleImport.Processed = number
+		if number%articleImportCheckpointInterval == 0 {
+			articleImport.Checksum = sumHex(checksum)
+			if err := s.importRepo.UpdateProgress(ctx, articleImport); err != nil {
+				return fmt.Errorf("failed to save checkpoint: %w", err)
+			}
+			if progress != nil {
+				progress(articleImport)
+			}
+		}
+	}
+}
+
+// importRecord stores one record as a historical article and counts the outcome
+func (s *ArticleImportService) importRecord(ctx context.Context, articleImport *domain.ArticleImport, number int, record *ArticleImportRecord) {
+	_, err := s.articleService.CreateArticle(ctx, ArticleCreatedData{
+		Title:          record.Title,
+		Content:        record.Content,
+		Summary:        record.Summary,
+		CategorySlug:   record.CategorySlug,
+		CategorySlugs:  record.CategorySlugs,
+		Severity:       record.Severity,
+		Tags:           record.Tags,
+		SourceURL:      record.SourceURL,
+		SourceName:     record.SourceName,
+		ImageURL:       record.ImageURL,
+		PublishedAt:    record.PublishedAt,
+		CVEs:           record.CVEs,
+		Vendors:        record.Vendors,
+		SkipEnrichment: true,
+		Historical:     true,
+	})
+	if err == nil {
+		articleImport.Created++
+		return
+	}
+
+	var duplicateErr *DuplicateArticleError
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &duplicateErr) || errors.As(err, &conflictErr) {
+		articleImport.Duplicates++
+		return
+	}
+
+	articleImport.AddError(number, err.Error())
+}
+
+// audit records that an import was started or resumed
+func (s *ArticleImportService) audit(ctx context.Context, actorID *uuid.UUID, articleImport *domain.ArticleImport, ipAddress, userAgent string) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	newValue := map[string]interface{}{
+		"format":    articleImport.Format,
+		"filename":  articleImport.Filename,
+		"processed": articleImport.Processed,
+	}
+
+	entry := domain.NewAuditLog(actorID, domain.AuditActionArticleImportStarted, "article_import", &articleImport.ID, nil, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("import_id", articleImport.ID.String()).
+			Msg("Failed to write article import audit log")
+	}
+}
+
+// articleRecordReader streams the records of an import file
+type articleRecordReader interface {
+	// next returns the raw record, used for the checkpoint checksum, and the decoded record
+	// A record that cannot be decoded is returned raw with an *invalidRecordError; any other
+	// error, including io.EOF at the end of the file, means no more records can be read
+	next() (raw []byte, record *ArticleImportRecord, err error)
+}
+
+// invalidRecordError is a record that was read but could not be decoded
+type invalidRecordError struct {
+	message string
+}
+
+func (e *invalidRecordError) Error() string {
+	return e.message
+}
+
+// newArticleRecordReader returns a reader for the import format
+func newArticleRecordReader(format string, r io.Reader) (articleRecordReader, error) {
+	switch format {
+	case domain.ArticleImportFormatJSONL:
+		return &jsonlRecordReader{reader: bufio.NewReader(r)}, nil
+	case domain.ArticleImportFormatCSV:
+		return newCSVRecordReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+}
+
+// jsonlRecordReader reads one JSON object per line; blank lines are skipped
+// Lines are read whole, so article content is not limited by a scanner buffer
+type jsonlRecordReader struct {
+	reader *bufio.Reader
+}
+
+func (r *jsonlRecordReader) next() ([]byte, *ArticleImportRecord, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, err
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return nil, nil, io.EOF
+			}
+			continue
+		}
+
+		record := &ArticleImportRecord{}
+		if decodeErr := json.Unmarshal(line, record); decodeErr != nil {
+			return line, nil, &invalidRecordError{message: "invalid JSON: " + decodeErr.Error()}
+		}
+
+		return line, record, nil
+	}
+}
+
+// csvRecordReader reads records whose columns are named by a header row
+type csvRecordReader struct {
+	reader  *csv.Reader
+	columns []string
+}
+
+// newCSVRecordReader reads the header row and checks that it names the required columns
+func newCSVRecordReader(r io.Reader) (*csvRecordReader, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make([]string, len(header))
+	present := make(map[string]bool, len(header))
+	for i, column := range header {
+		columns[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		present[columns[i]] = true
+	}
+
+	for _, required := range []string{"title", "content", "source_url"} {
+		if !present[required] {
+			return nil, fmt.Errorf("CSV header has no %s column", required)
+		}
+	}
+
+	return &csvRecordReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvRecordReader) next() ([]byte, *ArticleImportRecord, error) {
+	fields, err := r.reader.Read()
+	if err != nil {
+		// A row with the wrong number of fields is still a whole row; anything else is not
+		if errors.Is(err, csv.ErrFieldCount) {
+			return []byte(strings.Join(fields, "\x1f")), nil, &invalidRecordError{
+				message: fmt.Sprintf("expected %d fields, got %d", len(r.columns), len(fields)),
+			}
+		}
+		return nil, nil, err
+	}
+
+	record := &ArticleImportRecord{}
+	for i, value := range fields {
+		switch r.columns[i] {
+		case "title":
+			record.Title = value
+		case "content":
+			record.Content = value
+		case "summary":
+			record.Summary = value
+		case "category_slug":
+			record.CategorySlug = value
+		case "category_slugs":
+			record.CategorySlugs = splitImportList(value)
+		case "severity":
+			record.Severity = value
+		case "tags":
+			record.Tags = splitImportList(value)
+		case "source_url":
+			record.SourceURL = value
+		case "source_name":
+			record.SourceName = value
+		case "image_url":
+			record.ImageURL = value
+		case "published_at":
+			record.PublishedAt = value
+		case "cves":
+			record.CVEs = splitImportList(value)
+		case "vendors":
+			record.Vendors = splitImportList(value)
+		}
+	}
+
+	return []byte(strings.Join(fields, "\x1f")), record, nil
+}
+
+// splitImportList splits a CSV list field, dropping empty values
+func splitImportList(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, articleImportListSeparator) {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// truncateFilename keeps filenames within the stored length
+func truncateFilename(filename string) string {
+	if len(filename) <= 255 {
+		return filename
+	}
+	return filename[:255]
+}
+
+// sumHex returns the hex digest of the records hashed so far, without resetting the hash
+func sumHex(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// ArticleReviewService moderates webhook-ingested articles before publication
+// In review mode new articles are stored unpublished and queued; approving one publishes it
+// and notifies subscribers, rejecting one keeps it hidden with the reviewer's reason
+type ArticleReviewService struct {
+	reviewRepo   repository.ArticleReviewRepository
+	articleRepo  repository.ArticleRepository
+	auditRepo    repository.AuditLogRepository
+	notification *NotificationService
+}
+
+// NewArticleReviewService creates a new article review service instance
+func NewArticleReviewService(
+	reviewRepo repository.ArticleReviewRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+) *ArticleReviewService {
+	if reviewRepo == nil {
+		panic("reviewRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &ArticleReviewService{
+		reviewRepo:  reviewRepo,
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// SetNotificationService broadcasts approved articles to subscribers
+func (s *ArticleReviewService) SetNotificationService(notification *NotificationService) {
+	s.notification = notification
+}
+
+// Submit queues a newly ingested article for review
+// Failures are logged rather than returned so they never fail ingestion
+func (s *ArticleReviewService) Submit(ctx context.Context, articleID uuid.UUID) {
+	review := &domain.ArticleReview{
+		ArticleID: articleID,
+		Status:    domain.ReviewStatusPending,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to queue article for review")
+		return
+	}
+
+	log.Info().
+		Str("article_id", articleID.String()).
+		Msg("Article queued for review")
+}
+
+// GetByArticleID retrieves the review of an article
+func (s *ArticleReviewService) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.ArticleReview, error) {
+	return s.reviewRepo.GetByArticleID(ctx, articleID)
+}
+
+// List retrieves the review queue
+func (s *ArticleReviewService) List(ctx context.Context, filter *domain.ArticleReviewFilter) ([]*domain.ArticleReview, int, error) {
+	return s.reviewRepo.List(ctx, filter)
+}
+
+// Approve publishes a pending article and notifies subscribers
+func (s *ArticleReviewService) Approve(ctx context.Context, articleID uuid.UUID, reviewer *uuid.UUID, ipAddress, userAgent string) (*domain.ArticleReview, error) {
+	review, err := s.decide(ctx, articleID, domain.ReviewStatusApproved, nil, reviewer, ipAddress, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, articleID)
+
+	return review, nil
+}
+
+// Reject keeps a pending article unpublished; a reason is required
+func (s *ArticleReviewService) Reject(ctx context.Context, articleID uuid.UUID, reviewer *uuid.UUID, reason, ipAddress, userAgent string) (*domain.ArticleReview, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &domainerrors.ValidationError{
+			Field:   "reason",
+			Message: "a reason is required to reject an article",
+		}
+	}
+
+	if len(reason) > 1000 {
+		return nil, &domainerrors.ValidationError{
+			Field:   "reason",
+			Message: "reason cannot exceed 1000 characters",
+		}
+	}
+
+	return s.decide(ctx, articleID, domain.ReviewStatusRejected, &reason, reviewer, ipAddress, userAgent)
+}
+
+// BulkApprove approves each pending article in turn
+// Articles that cannot be approved are reported individually and do not stop the rest
+func (s *ArticleReviewService) BulkApprove(ctx context.Context, articleIDs []uuid.UUID, reviewer *uuid.UUID, ipAddress, userAgent string) (*domain.BulkReviewResult, error) {
+	if len(articleIDs) == 0 {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article_ids",
+			Message: "at least one article ID is required",
+		}
+	}
+
+	if len(articleIDs) > domain.MaxBulkReviewSize {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article_ids",
+			Message: fmt.Sprintf("cannot review more than %d articles at once", domain.MaxBulkReviewSize),
+		}
+	}
+
+	result := &domain.BulkReviewResult{
+		Approved: make([]uuid.UUID, 0, len(articleIDs)),
+		Failed:   make([]domain.BulkReviewFailure, 0),
+	}
+
+	seen := make(map[uuid.UUID]bool, len(articleIDs))
+	for _, articleID := range articleIDs {
+		if seen[articleID] {
+			continue
+		}
+		seen[articleID] = true
+
+		if _, err := s.Approve(ctx, articleID, reviewer, ipAddress, userAgent); err != nil {
+			result.Failed = append(result.Failed, domain.BulkReviewFailure{
+				ArticleID: articleID,
+				Error:     err.Error(),
+			})
+			continue
+		}
+
+		result.Approved = append(result.Approved, articleID)
+	}
+
+	return result, nil
+}
+
+// decide stores the outcome of a pending review and records it in the audit log
+func (s *ArticleReviewService) decide(
+	ctx context.Context,
+	articleID uuid.UUID,
+	status domain.ReviewStatus,
+	reason *string,
+	reviewer *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.ArticleReview, error) {
+	review, err := s.reviewRepo.GetByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.Status != domain.ReviewStatusPending {
+		return nil, &domainerrors.ValidationError{
+			Field:   "status",
+			Message: fmt.Sprintf("article is %s, only pending articles can be reviewed", review.Status),
+		}
+	}
+
+	previous := *review
+
+	now := time.Now()
+	review.Status = status
+	review.Reason = reason
+	review.ReviewedBy = reviewer
+	review.ReviewedAt = &now
+
+	if err := s.reviewRepo.Decide(ctx, review); err != nil {
+		return nil, err
+	}
+
+	action := domain.AuditActionArticleApproved
+	if status == domain.ReviewStatusRejected {
+		action = domain.AuditActionArticleRejected
+	}
+	s.audit(ctx, reviewer, action, articleID, &previous, review, ipAddress, userAgent)
+
+	return review, nil
+}
+
+// notify broadcasts a newly published article; failures are logged
+func (s *ArticleReviewService) notify(ctx context.Context, articleID uuid.UUID) {
+	if s.notification == nil {
+		return
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to load approved article for notification")
+		return
+	}
+
+	if err := s.notification.NotifyNewArticle(article); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to notify approved article")
+	}
+}
+
+// audit records a review decision; failures are logged and do not fail the review
+func (s *ArticleReviewService) audit(
+	ctx context.Context,
+	reviewer *uuid.UUID,
+	action string,
+	articleID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(reviewer, action, "article", &articleID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Str("action", action).
+			Msg("Failed to write article review audit log")
+	}
+}
@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
 	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/contentmetrics"
+	"github.com/phillipboles/aci-backend/internal/util/markdown"
+	"github.com/phillipboles/aci-backend/internal/util/safehttp"
 	"github.com/phillipboles/aci-backend/internal/util/sanitizer"
-	"github.com/phillipboles/aci-backend/internal/util/slug"
 )
 
 // ArticleService handles article business logic
@@ -21,36 +27,55 @@ type ArticleService struct {
 	webhookLogRepo   repository.WebhookLogRepository
 	competitorFilter *CompetitorFilter
 	relevanceScorer  *RelevanceScorer
-	slugGenerator    *slug.Generator
+	iocExtractor     *IOCExtractor
 	sanitizer        *sanitizer.Sanitizer
+	ingestSLO        *IngestSLOService
+	classification   atomic.Pointer[ClassificationService] // swapped at runtime by config reloads
+	deduplication    *DeduplicationService
+	identity         *ArticleIdentityService
+	iocs             *IOCService
+	actors           *ThreatActorService
+	review           atomic.Pointer[ArticleReviewService] // swapped at runtime by config reloads
+	tags             *TagService
+	sourceTrust      *SourceTrustService
+	txManager        repository.TxManager
+	alerts           *AlertService
+	search           *SearchService
+	orgCategories    *OrgCategoryService
 }
 
 // ArticleCreatedData represents article creation data from webhook
 type ArticleCreatedData struct {
 	Title          string
 	Content        string
+	ContentFormat  string // html (default) or markdown
 	Summary        string
 	CategorySlug   string
+	CategorySlugs  []string // additional categories beyond the primary CategorySlug
 	Severity       string
 	Tags           []string
 	SourceURL      string
 	SourceName     string
+	ImageURL       string // hero image; stored by ArticleImageService after ingestion
 	PublishedAt    string
 	CVEs           []string
 	Vendors        []string
 	SkipEnrichment bool
+	ReceivedAt     time.Time // when the webhook arrived; defaults to the start of CreateArticle
+	Historical     bool      // backfilled from an archive: published without review, alert matching or SLO timings
 }
 
 // ArticleUpdatedData represents article update data from webhook
 type ArticleUpdatedData struct {
-	Title       *string
-	Content     *string
-	Summary     *string
-	Severity    *string
-	Tags        []string
-	CVEs        []string
-	Vendors     []string
-	IsPublished *bool
+	Title         *string
+	Content       *string
+	ContentFormat *string // omitted keeps the article's current format
+	Summary       *string
+	Severity      *string
+	Tags          []string
+	CVEs          []string
+	Vendors       []string
+	IsPublished   *bool
 }
 
 // NewArticleService creates a new article service
@@ -67,15 +92,111 @@ func NewArticleService(
 		webhookLogRepo:   webhookLogRepo,
 		competitorFilter: NewCompetitorFilter(),
 		relevanceScorer:  NewRelevanceScorer(),
-		slugGenerator:    slug.NewGenerator(),
+		iocExtractor:     NewIOCExtractor(),
 		sanitizer:        sanitizer.NewSanitizer(),
 	}
 }
 
+// SetIngestSLOService enables recording of ingest pipeline stage timings
+func (s *ArticleService) SetIngestSLOService(ingestSLO *IngestSLOService) {
+	s.ingestSLO = ingestSLO
+}
+
+// SetClassificationService enables AI suggestions for articles ingested without a category or severity
+// It may be called while articles are being ingested; nil disables classification
+func (s *ArticleService) SetClassificationService(classification *ClassificationService) {
+	s.classification.Store(classification)
+}
+
+// SetDeduplicationService enables near-duplicate clustering of new articles
+func (s *ArticleService) SetDeduplicationService(deduplication *DeduplicationService) {
+	s.deduplication = deduplication
+}
+
+// SetIdentityService links articles arriving again under another URL to the stored article
+// instead of storing them twice
+func (s *ArticleService) SetIdentityService(identity *ArticleIdentityService) {
+	s.identity = identity
+}
+
+// SetReviewService holds new articles unpublished in the review queue until an admin approves them
+// It may be called while articles are being ingested; nil publishes new articles immediately
+func (s *ArticleService) SetReviewService(review *ArticleReviewService) {
+	s.review.Store(review)
+}
+
+// SetTagService normalizes incoming tags and rewrites aliases to their canonical tag
+func (s *ArticleService) SetTagService(tags *TagService) {
+	s.tags = tags
+}
+
+// SetIOCService keeps the normalized IOC tables in sync with created and edited articles
+func (s *ArticleService) SetIOCService(iocs *IOCService) {
+	s.iocs = iocs
+}
+
+// SetThreatActorService links created and edited articles to the threat actors they mention
+func (s *ArticleService) SetThreatActorService(actors *ThreatActorService) {
+	s.actors = actors
+}
+
+// SetRelevanceScorer replaces the built-in scorer, e.g. with one whose rules are managed at runtime
+func (s *ArticleService) SetRelevanceScorer(scorer *RelevanceScorer) {
+	s.relevanceScorer = scorer
+}
+
+// SetSourceTrustService records corrections and deletions as signals against the article's source
+func (s *ArticleService) SetSourceTrustService(sourceTrust *SourceTrustService) {
+	s.sourceTrust = sourceTrust
+}
+
+// SetTxManager makes ingestion of each article atomic: its source, the article and its
+// alert matches commit together or not at all
+func (s *ArticleService) SetTxManager(txManager repository.TxManager) {
+	s.txManager = txManager
+}
+
+// SetAlertService matches new articles against active alerts as part of their ingestion
+func (s *ArticleService) SetAlertService(alerts *AlertService) {
+	s.alerts = alerts
+}
+
+// SetSearchService drops cached search results that a newly ingested article belongs in
+func (s *ArticleService) SetSearchService(search *SearchService) {
+	s.search = search
+}
+
+// SetOrgCategoryService applies organization categories whose rules match new articles
+func (s *ArticleService) SetOrgCategoryService(orgCategories *OrgCategoryService) {
+	s.orgCategories = orgCategories
+}
+
+// withinTx runs fn in a transaction when a transaction manager is set, otherwise directly
+func (s *ArticleService) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txManager == nil {
+		return fn(ctx)
+	}
+	return s.txManager.WithinTx(ctx, fn)
+}
+
 // CreateArticle creates a new article from webhook data
+// The source, article and alert matches are written in one transaction; the remaining
+// enrichment steps run after it commits and only log their failures
 func (s *ArticleService) CreateArticle(ctx context.Context, data ArticleCreatedData) (*domain.Article, error) {
+	receivedAt := data.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+
+	// Read the runtime toggles once so a reload cannot change them partway through
+	classification := s.classification.Load()
+	review := s.review.Load()
+	if data.Historical {
+		review = nil
+	}
+
 	// Validate input
-	if err := s.validateArticleData(data); err != nil {
+	if err := s.validateArticleData(data, classification != nil); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
@@ -86,27 +207,55 @@ func (s *ArticleService) CreateArticle(ctx context.Context, data ArticleCreatedD
 	}
 
 	if existing != nil {
-		return nil, fmt.Errorf("article with source URL already exists: %s", data.SourceURL)
+		return nil, &domainerrors.ConflictError{
+			Resource: "article",
+			Field:    "source_url",
+			Value:    data.SourceURL,
+		}
+	}
+
+	// Sanitize HTML content, rendering markdown first
+	contentFormat := domain.ContentFormat(data.ContentFormat)
+	if contentFormat == "" {
+		contentFormat = domain.ContentFormatHTML
+	}
+
+	sanitizedContent, contentMarkdown, err := renderArticleContent(contentFormat, data.Content, s.sanitizer.SanitizeHTML)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Link true duplicates under another URL before spending a classification on them
+	var identity ArticleIdentity
+	if s.identity != nil {
+		identity = s.identity.Identify(ctx, data.SourceURL, sanitizedContent)
+
+		duplicateID, reason, err := s.identity.FindDuplicate(ctx, identity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for duplicate: %w", err)
+		}
+
+		if duplicateID != uuid.Nil {
+			s.identity.Link(ctx, duplicateID, data.SourceURL, identity, reason)
+			return nil, &DuplicateArticleError{ArticleID: duplicateID, Reason: reason}
+		}
+	}
+
+	// Suggest metadata the payload omitted
+	suggestion := s.classifyMissing(ctx, classification, &data)
+
 	// Get category by slug
 	category, err := s.categoryRepo.GetBySlug(ctx, data.CategorySlug)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category: %w", err)
 	}
 
-	// Get or create source
-	source, err := s.getOrCreateSource(ctx, data.SourceURL, data.SourceName)
+	// Generate a slug no other article uses or used before
+	articleSlug, err := uniqueArticleSlug(ctx, s.articleRepo, data.Title, "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to get or create source: %w", err)
+		return nil, err
 	}
 
-	// Generate unique slug
-	articleSlug := s.slugGenerator.GenerateUnique(data.Title)
-
-	// Sanitize HTML content
-	sanitizedContent := s.sanitizer.SanitizeHTML(data.Content)
-
 	// Parse severity
 	severity := domain.Severity(strings.ToLower(data.Severity))
 	if !severity.IsValid() {
@@ -132,6 +281,9 @@ func (s *ArticleService) CreateArticle(ctx context.Context, data ArticleCreatedD
 	if tags == nil {
 		tags = []string{}
 	}
+	if s.tags != nil {
+		tags = s.tags.Normalize(ctx, tags)
+	}
 	cves := data.CVEs
 	if cves == nil {
 		cves = []string{}
@@ -146,28 +298,35 @@ func (s *ArticleService) CreateArticle(ctx context.Context, data ArticleCreatedD
 		Title:              data.Title,
 		Slug:               articleSlug,
 		Content:            sanitizedContent,
+		ContentFormat:      contentFormat,
+		ContentMarkdown:    contentMarkdown,
 		CategoryID:         category.ID,
-		SourceID:           source.ID,
+		CategoryIDs:        []uuid.UUID{category.ID},
 		SourceURL:          data.SourceURL,
 		Severity:           severity,
 		Tags:               tags,
 		CVEs:               cves,
 		Vendors:            vendors,
 		RecommendedActions: []string{},
-		IOCs:               []domain.IOC{},
-		ReadingTimeMinutes: s.sanitizer.CalculateReadingTime(sanitizedContent),
+		IOCs:               s.iocExtractor.Extract(data.Title, sanitizedContent),
 		ViewCount:          0,
-		IsPublished:        true,
+		IsPublished:        review == nil,
 		PublishedAt:        publishedAt,
 		CreatedAt:          now,
 		UpdatedAt:          now,
 	}
 
+	applyContentMetrics(article)
+
 	// Set summary if provided
 	if data.Summary != "" {
 		article.Summary = &data.Summary
 	}
 
+	if data.ImageURL != "" {
+		article.ImageURL = &data.ImageURL
+	}
+
 	// Run competitor filter
 	article.CompetitorScore, article.IsCompetitorFavorable = s.competitorFilter.Score(
 		article.Title,
@@ -180,14 +339,89 @@ func (s *ArticleService) CreateArticle(ctx context.Context, data ArticleCreatedD
 	// Generate CTA if relevant
 	article.ArmorCTA = s.relevanceScorer.GenerateCTA(article)
 
-	// Validate article
-	if err := article.Validate(); err != nil {
-		return nil, fmt.Errorf("article validation failed: %w", err)
+	var validatedAt time.Time
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		// Get or create source
+		source, err := s.getOrCreateSource(ctx, data.SourceURL, data.SourceName)
+		if err != nil {
+			return fmt.Errorf("failed to get or create source: %w", err)
+		}
+		article.SourceID = source.ID
+
+		// Validate article
+		if err := article.Validate(); err != nil {
+			return fmt.Errorf("article validation failed: %w", err)
+		}
+		validatedAt = time.Now()
+
+		// Save to database
+		if err := s.articleRepo.Create(ctx, article); err != nil {
+			return fmt.Errorf("failed to create article: %w", err)
+		}
+
+		if s.identity != nil {
+			if err := s.identity.Record(ctx, article.ID, data.SourceURL, identity); err != nil {
+				return fmt.Errorf("failed to record article identity: %w", err)
+			}
+		}
+
+		if s.alerts != nil && !data.Historical {
+			if _, err := s.alerts.MatchArticle(ctx, article); err != nil {
+				return fmt.Errorf("failed to match alerts: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if suggestion != nil {
+		classification.Record(ctx, article.ID, suggestion)
+	}
+
+	if len(data.CategorySlugs) > 0 {
+		s.addCategories(ctx, article, data.CategorySlugs)
+	}
+
+	// Organization rules can match on any of the article's categories, so they run after all are set
+	if s.orgCategories != nil {
+		s.orgCategories.ApplyRules(ctx, article)
+	}
+
+	if review != nil {
+		review.Submit(ctx, article.ID)
+	}
+
+	if s.search != nil {
+		s.search.InvalidateArticle(ctx, article)
+	}
+
+	if s.iocs != nil {
+		s.iocs.SyncArticle(ctx, article)
+	}
+
+	if s.actors != nil {
+		s.actors.SyncArticle(ctx, article)
 	}
 
-	// Save to database
-	if err := s.articleRepo.Create(ctx, article); err != nil {
-		return nil, fmt.Errorf("failed to create article: %w", err)
+	if s.deduplication != nil {
+		if _, err := s.deduplication.Fingerprint(ctx, article); err != nil {
+			// Unfingerprinted articles are treated as canonical, so ingestion continues
+			log.Error().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to fingerprint article")
+		}
+	}
+
+	if s.ingestSLO != nil && !data.Historical {
+		s.ingestSLO.RecordStages(ctx, article.ID, map[domain.IngestStage]time.Time{
+			domain.IngestStageReceived:  receivedAt,
+			domain.IngestStageValidated: validatedAt,
+			domain.IngestStagePersisted: time.Now(),
+		})
 	}
 
 	return article, nil
@@ -206,14 +440,20 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, id uuid.UUID, data A
 	}
 
 	// Update fields if provided
+	// The slug is kept, so links to the article survive title corrections
 	if data.Title != nil {
 		article.Title = *data.Title
-		article.Slug = s.slugGenerator.GenerateUnique(*data.Title)
 	}
 
-	if data.Content != nil {
-		article.Content = s.sanitizer.SanitizeHTML(*data.Content)
-		article.ReadingTimeMinutes = s.sanitizer.CalculateReadingTime(article.Content)
+	if data.Content != nil || data.ContentFormat != nil {
+		format := article.ContentFormat
+		if data.ContentFormat != nil {
+			format = domain.ContentFormat(*data.ContentFormat)
+		}
+
+		if err := setArticleContent(article, format, data.Content, s.sanitizer.SanitizeHTML); err != nil {
+			return nil, fmt.Errorf("invalid content: %w", err)
+		}
 	}
 
 	if data.Summary != nil {
@@ -229,6 +469,9 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, id uuid.UUID, data A
 
 	if len(data.Tags) > 0 {
 		article.Tags = data.Tags
+		if s.tags != nil {
+			article.Tags = s.tags.Normalize(ctx, data.Tags)
+		}
 	}
 
 	if len(data.CVEs) > 0 {
@@ -243,6 +486,14 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, id uuid.UUID, data A
 		article.IsPublished = *data.IsPublished
 	}
 
+	// Re-extract IOCs from the edited text, keeping those found by AI enrichment
+	if data.Title != nil || data.Content != nil {
+		article.IOCs = s.iocExtractor.Merge(
+			s.iocExtractor.Extract(article.Title, article.Content),
+			s.iocExtractor.FromAI(article.IOCs),
+		)
+	}
+
 	// Recalculate scores
 	article.CompetitorScore, article.IsCompetitorFavorable = s.competitorFilter.Score(
 		article.Title,
@@ -264,6 +515,18 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, id uuid.UUID, data A
 		return nil, fmt.Errorf("failed to update article: %w", err)
 	}
 
+	if s.iocs != nil && (data.Title != nil || data.Content != nil) {
+		s.iocs.SyncArticle(ctx, article)
+	}
+
+	if s.actors != nil && (data.Title != nil || data.Content != nil) {
+		s.actors.SyncArticle(ctx, article)
+	}
+
+	if s.sourceTrust != nil && (data.Title != nil || data.Content != nil) {
+		s.sourceTrust.Record(ctx, article, domain.SourceTrustEventCorrected)
+	}
+
 	return article, nil
 }
 
@@ -273,10 +536,23 @@ func (s *ArticleService) DeleteArticle(ctx context.Context, id uuid.UUID) error
 		return fmt.Errorf("article ID is required")
 	}
 
+	// The row is gone after the delete, so load the source first
+	var article *domain.Article
+	if s.sourceTrust != nil {
+		var err error
+		if article, err = s.articleRepo.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("failed to get article: %w", err)
+		}
+	}
+
 	if err := s.articleRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete article: %w", err)
 	}
 
+	if article != nil {
+		s.sourceTrust.Record(ctx, article, domain.SourceTrustEventDeleted)
+	}
+
 	return nil
 }
 
@@ -298,7 +574,7 @@ func (s *ArticleService) BulkImport(ctx context.Context, articles []ArticleCreat
 }
 
 // validateArticleData validates article creation data
-func (s *ArticleService) validateArticleData(data ArticleCreatedData) error {
+func (s *ArticleService) validateArticleData(data ArticleCreatedData, classifying bool) error {
 	if data.Title == "" {
 		return fmt.Errorf("title is required")
 	}
@@ -307,7 +583,12 @@ func (s *ArticleService) validateArticleData(data ArticleCreatedData) error {
 		return fmt.Errorf("content is required")
 	}
 
-	if data.CategorySlug == "" {
+	if data.ContentFormat != "" && !domain.ContentFormat(data.ContentFormat).IsValid() {
+		return fmt.Errorf("content_format must be html or markdown")
+	}
+
+	// Without classification there is no way to choose a category
+	if data.CategorySlug == "" && !classifying {
 		return fmt.Errorf("category_slug is required")
 	}
 
@@ -315,9 +596,194 @@ func (s *ArticleService) validateArticleData(data ArticleCreatedData) error {
 		return fmt.Errorf("source_url is required")
 	}
 
+	if data.ImageURL != "" {
+		if len(data.ImageURL) > domain.MaxArticleImageURLLength {
+			return fmt.Errorf("image_url cannot exceed %d characters", domain.MaxArticleImageURLLength)
+		}
+
+		if _, err := safehttp.ParseURL(data.ImageURL); err != nil {
+			return fmt.Errorf("invalid image_url: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// renderArticleContent returns the HTML stored for content written in format, cleaned by
+// sanitize, and the markdown source to keep when the content is markdown
+func renderArticleContent(format domain.ContentFormat, content string, sanitize func(string) string) (string, *string, error) {
+	switch format {
+	case domain.ContentFormatHTML:
+		return sanitize(content), nil, nil
+	case domain.ContentFormatMarkdown:
+		rendered, err := markdown.ToHTML(content)
+		if err != nil {
+			return "", nil, err
+		}
+		return sanitize(rendered), &content, nil
+	default:
+		return "", nil, fmt.Errorf("content_format must be html or markdown")
+	}
+}
+
+// setArticleContent replaces an article's content, or only its format when content is nil
+// Switching a markdown article to HTML keeps its rendered HTML; switching to markdown
+// needs the markdown source
+func setArticleContent(article *domain.Article, format domain.ContentFormat, content *string, sanitize func(string) string) error {
+	if content == nil {
+		switch {
+		case format == article.ContentFormat:
+			return nil
+		case format == domain.ContentFormatHTML:
+			article.ContentFormat = format
+			article.ContentMarkdown = nil
+			return nil
+		case format == domain.ContentFormatMarkdown:
+			return fmt.Errorf("content is required to switch to markdown")
+		default:
+			return fmt.Errorf("content_format must be html or markdown")
+		}
+	}
+
+	rendered, source, err := renderArticleContent(format, *content, sanitize)
+	if err != nil {
+		return err
+	}
+
+	article.Content = rendered
+	article.ContentFormat = format
+	article.ContentMarkdown = source
+	applyContentMetrics(article)
+	return nil
+}
+
+// applyContentMetrics measures the article's content; every path that sets content calls it,
+// so reading time, word count, language and links always describe the stored HTML
+func applyContentMetrics(article *domain.Article) {
+	metrics := contentmetrics.Analyze(article.Content)
+
+	article.WordCount = metrics.WordCount
+	article.ReadingTimeMinutes = metrics.ReadingTimeMinutes
+	article.ExternalLinks = metrics.ExternalLinks
+	article.Language = nil
+	if metrics.Language != "" {
+		article.Language = &metrics.Language
+	}
+}
+
+// loadArticles fetches the articles with the given IDs in one query, keyed by ID
+// Callers walk their own ID list to keep its order; unknown IDs are simply absent
+func loadArticles(ctx context.Context, articleRepo repository.ArticleRepository, ids []uuid.UUID) (map[uuid.UUID]*domain.Article, error) {
+	articles, err := articleRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*domain.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+
+	return byID, nil
+}
+
+// classifyMissing fills an omitted category, severity, tags, and vendors from an AI suggestion
+// Only fields meeting the auto-apply threshold are written; a missing category falls back to the default
+func (s *ArticleService) classifyMissing(
+	ctx context.Context,
+	classification *ClassificationService,
+	data *ArticleCreatedData,
+) *domain.ClassificationSuggestion {
+	if classification == nil || (data.CategorySlug != "" && data.Severity != "") {
+		return nil
+	}
+
+	missing := make([]domain.ClassificationField, 0, 4)
+	if data.CategorySlug == "" {
+		missing = append(missing, domain.ClassificationFieldCategory)
+	}
+	if data.Severity == "" {
+		missing = append(missing, domain.ClassificationFieldSeverity)
+	}
+	if len(data.Tags) == 0 {
+		missing = append(missing, domain.ClassificationFieldTags)
+	}
+	if len(data.Vendors) == 0 {
+		missing = append(missing, domain.ClassificationFieldVendors)
+	}
+
+	suggestion, err := classification.Suggest(ctx, data.Title, data.Content, missing)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("source_url", data.SourceURL).
+			Msg("Failed to classify article, using defaults")
+		suggestion = nil
+	}
+
+	if suggestion != nil {
+		for _, field := range suggestion.AppliedFields {
+			switch field {
+			case domain.ClassificationFieldCategory:
+				data.CategorySlug = *suggestion.CategorySlug
+			case domain.ClassificationFieldSeverity:
+				data.Severity = string(*suggestion.Severity)
+			case domain.ClassificationFieldTags:
+				data.Tags = suggestion.Tags
+			case domain.ClassificationFieldVendors:
+				data.Vendors = suggestion.Vendors
+			}
+		}
+	}
+
+	if data.CategorySlug == "" {
+		data.CategorySlug = classification.FallbackCategory()
+	}
+
+	return suggestion
+}
+
+// addCategories adds the categories with the given slugs to a new article
+// Unknown slugs are skipped and failures are logged, so ingestion never fails on extra categories
+func (s *ArticleService) addCategories(ctx context.Context, article *domain.Article, slugs []string) {
+	categoryIDs := []uuid.UUID{article.CategoryID}
+	seen := map[uuid.UUID]bool{article.CategoryID: true}
+	for _, categorySlug := range slugs {
+		if len(categoryIDs) >= domain.MaxArticleCategories {
+			break
+		}
+
+		category, err := s.categoryRepo.GetBySlug(ctx, categorySlug)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Str("category_slug", categorySlug).
+				Msg("Skipping unknown additional category")
+			continue
+		}
+
+		if !seen[category.ID] {
+			seen[category.ID] = true
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+	}
+
+	if len(categoryIDs) == 1 {
+		return
+	}
+
+	if err := s.categoryRepo.SetArticleCategories(ctx, article.ID, categoryIDs); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", article.ID.String()).
+			Msg("Failed to add article categories")
+		return
+	}
+
+	article.CategoryIDs = categoryIDs
+}
+
 // getOrCreateSource gets an existing source or creates a new one
 func (s *ArticleService) getOrCreateSource(ctx context.Context, sourceURL, sourceName string) (*domain.Source, error) {
 	// Try to get existing source by URL first
@@ -356,7 +822,11 @@ func (s *ArticleService) getOrCreateSource(ctx context.Context, sourceURL, sourc
 		CreatedAt:  time.Now(),
 	}
 
-	if err := s.sourceRepo.Create(ctx, newSource); err != nil {
+	// The insert gets its own savepoint so the lookups below still work inside a transaction
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		return s.sourceRepo.Create(ctx, newSource)
+	})
+	if err != nil {
 		// Check if it was created by another goroutine (race condition)
 		existing, getErr := s.sourceRepo.GetByURL(ctx, sourceURL)
 		if getErr == nil {
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/slug"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// fallbackArticleSlug is used for titles with no letters or digits to build a slug from
+const fallbackArticleSlug = "article"
+
+// ArticleSlugChange is the result of changing an article's slug
+type ArticleSlugChange struct {
+	ArticleID    uuid.UUID `json:"article_id"`
+	Slug         string    `json:"slug"`
+	PreviousSlug string    `json:"previous_slug"`
+}
+
+// ArticleSlugService changes article slugs while keeping links to the old ones working
+// Former slugs stay reserved for their article and resolve to its current slug
+type ArticleSlugService struct {
+	articleRepo repository.ArticleRepository
+	auditRepo   repository.AuditLogRepository
+}
+
+// NewArticleSlugService creates a new article slug service instance
+func NewArticleSlugService(articleRepo repository.ArticleRepository, auditRepo repository.AuditLogRepository) *ArticleSlugService {
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &ArticleSlugService{
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// Update gives an article a new slug; its old slug keeps resolving to the article
+func (s *ArticleSlugService) Update(
+	ctx context.Context,
+	articleID uuid.UUID,
+	newSlug string,
+	editor *uuid.UUID,
+	ipAddress, userAgent string,
+) (*ArticleSlugChange, error) {
+	newSlug = strings.TrimSpace(newSlug)
+	if !slug.IsValid(newSlug) {
+		return nil, &domainerrors.ValidationError{
+			Field:   "slug",
+			Message: "slug must be lowercase letters, digits and single hyphens, at most 200 characters",
+		}
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{Resource: "article", ID: articleID.String()}
+		}
+		return nil, err
+	}
+
+	change := &ArticleSlugChange{ArticleID: articleID, Slug: newSlug, PreviousSlug: article.Slug}
+	if newSlug == article.Slug {
+		return change, nil
+	}
+
+	available, err := slugAvailable(ctx, s.articleRepo, newSlug, article.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, &domainerrors.ConflictError{Resource: "article", Field: "slug", Value: newSlug}
+	}
+
+	if err := s.articleRepo.UpdateSlug(ctx, articleID, newSlug); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, editor, articleID, change, ipAddress, userAgent)
+
+	return change, nil
+}
+
+// audit records a slug change; failures are logged and do not fail the update
+func (s *ArticleSlugService) audit(
+	ctx context.Context,
+	editor *uuid.UUID,
+	articleID uuid.UUID,
+	change *ArticleSlugChange,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(editor, domain.AuditActionArticleSlugUpdated, "article", &articleID,
+		map[string]string{"slug": change.PreviousSlug}, map[string]string{"slug": change.Slug}, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to write article slug audit log")
+	}
+}
+
+// uniqueArticleSlug builds a slug from a title that is free for the article whose slug is
+// currentSlug (empty for a new article), adding -2, -3, ... until one is
+func uniqueArticleSlug(ctx context.Context, articleRepo repository.ArticleRepository, title, currentSlug string) (string, error) {
+	if slug.Generate(title) == "" {
+		title = fallbackArticleSlug
+	}
+
+	var slugErr error
+	articleSlug := slug.GenerateUnique(title, func(candidate string) bool {
+		if slugErr != nil {
+			return false
+		}
+		available, err := slugAvailable(ctx, articleRepo, candidate, currentSlug)
+		if err != nil {
+			slugErr = err
+			return false
+		}
+		return !available
+	})
+	if slugErr != nil {
+		return "", fmt.Errorf("failed to generate article slug: %w", slugErr)
+	}
+
+	return articleSlug, nil
+}
+
+// slugAvailable reports whether the article whose slug is currentSlug may take candidate:
+// no article uses it now or used it before, or it is the article's own current or former slug
+func slugAvailable(ctx context.Context, articleRepo repository.ArticleRepository, candidate, currentSlug string) (bool, error) {
+	if currentSlug != "" && candidate == currentSlug {
+		return true, nil
+	}
+
+	exists, err := articleRepo.SlugExists(ctx, candidate)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+	if currentSlug == "" {
+		return false, nil
+	}
+
+	owner, err := articleRepo.GetSlugRedirect(ctx, candidate)
+	var notFound *domainerrors.NotFoundError
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return owner == currentSlug, nil
+}
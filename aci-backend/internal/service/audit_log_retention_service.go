@@ -0,0 +1,314 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// MaxAuditExportRange is the longest date range a single CSV export may cover
+	MaxAuditExportRange = 366 * 24 * time.Hour
+
+	// auditArchiveBatchSize is how many entries go into each archive object
+	auditArchiveBatchSize = 10000
+
+	// auditArchiveContentType is the media type of the gzipped JSON Lines archives
+	auditArchiveContentType = "application/gzip"
+)
+
+// AuditArchiveStore uploads archive objects to object storage
+type AuditArchiveStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+}
+
+// AuditArchiveResult summarises one archive run
+type AuditArchiveResult struct {
+	Archived int64    `json:"archived"`
+	Objects  []string `json:"objects"`
+}
+
+// AuditLogRetentionService archives old audit logs to object storage and exports them as CSV
+// Entries older than the retention period are written in batches as gzipped JSON Lines;
+// a batch is deleted from the database only after its object has been uploaded
+type AuditLogRetentionService struct {
+	archiveRepo repository.AuditLogArchiveRepository
+	auditRepo   repository.AuditLogRepository
+	store       AuditArchiveStore
+	retention   time.Duration
+	prefix      string
+}
+
+// NewAuditLogRetentionService creates a new audit log retention service instance
+// store may be nil when no bucket is configured; exports still work but Archive fails
+func NewAuditLogRetentionService(
+	archiveRepo repository.AuditLogArchiveRepository,
+	auditRepo repository.AuditLogRepository,
+	store AuditArchiveStore,
+	retention time.Duration,
+	prefix string,
+) *AuditLogRetentionService {
+	if archiveRepo == nil {
+		panic("archiveRepo cannot be nil")
+	}
+
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &AuditLogRetentionService{
+		archiveRepo: archiveRepo,
+		auditRepo:   auditRepo,
+		store:       store,
+		retention:   retention,
+		prefix:      prefix,
+	}
+}
+
+// Archive uploads entries older than the retention period and deletes them once stored
+// Object keys are derived from each batch's first entry, so a run retried after a failed
+// delete overwrites the same object instead of duplicating it
+func (s *AuditLogRetentionService) Archive(ctx context.Context) (*AuditArchiveResult, error) {
+	if s.store == nil {
+		return nil, fmt.Errorf("audit log archive storage is not configured")
+	}
+
+	if s.retention <= 0 {
+		return nil, fmt.Errorf("audit log retention must be positive")
+	}
+
+	cutoff := time.Now().Add(-s.retention)
+	result := &AuditArchiveResult{Objects: []string{}}
+
+	for {
+		logs, err := s.archiveRepo.ListBefore(ctx, cutoff, auditArchiveBatchSize)
+		if err != nil {
+			return result, err
+		}
+
+		if len(logs) == 0 {
+			break
+		}
+
+		body, err := encodeAuditArchive(logs)
+		if err != nil {
+			return result, err
+		}
+
+		key := s.archiveKey(logs[0])
+		if err := s.store.Put(ctx, key, body, auditArchiveContentType); err != nil {
+			return result, err
+		}
+
+		ids := make([]uuid.UUID, len(logs))
+		for i, entry := range logs {
+			ids[i] = entry.ID
+		}
+
+		deleted, err := s.archiveRepo.DeleteByIDs(ctx, ids)
+		if err != nil {
+			return result, fmt.Errorf("archived %s but failed to purge its entries: %w", key, err)
+		}
+
+		result.Archived += deleted
+		result.Objects = append(result.Objects, key)
+		metrics.AddAuditLogsArchived(deleted)
+		s.recordArchive(ctx, key, logs)
+
+		if len(logs) < auditArchiveBatchSize {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+	}
+
+	metrics.AuditArchiveSucceeded()
+	return result, nil
+}
+
+// Run archives old entries on every interval until the context is cancelled
+func (s *AuditLogRetentionService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := s.Archive(ctx)
+			if err != nil {
+				var archived int64
+				if result != nil {
+					archived = result.Archived
+				}
+				log.Error().Err(err).Int64("archived", archived).Msg("Failed to archive audit logs")
+				continue
+			}
+
+			if result.Archived > 0 {
+				log.Info().
+					Int64("archived", result.Archived).
+					Int("objects", len(result.Objects)).
+					Msg("Archived audit logs to object storage")
+			}
+		}
+	}
+}
+
+// ExportCSV writes entries created in [from, to) to w as CSV, oldest first
+func (s *AuditLogRetentionService) ExportCSV(ctx context.Context, from, to time.Time, w io.Writer) error {
+	if !from.Before(to) {
+		return &domainerrors.ValidationError{
+			Field:   "to",
+			Message: "to must be after from",
+		}
+	}
+
+	if to.Sub(from) > MaxAuditExportRange {
+		return &domainerrors.ValidationError{
+			Field:   "to",
+			Message: "date range cannot exceed 366 days",
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"id", "created_at", "user_id", "user_email", "action", "resource_type",
+		"resource_id", "ip_address", "user_agent", "old_value", "new_value",
+	}); err != nil {
+		return fmt.Errorf("failed to write audit log export: %w", err)
+	}
+
+	err := s.archiveRepo.Each(ctx, from, to, func(entry *domain.AuditLog) error {
+		oldValue, err := auditValueJSON(entry.OldValue)
+		if err != nil {
+			return err
+		}
+
+		newValue, err := auditValueJSON(entry.NewValue)
+		if err != nil {
+			return err
+		}
+
+		record := []string{
+			entry.ID.String(),
+			entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+			uuidString(entry.UserID),
+			stringValue(entry.UserEmail),
+			entry.Action,
+			entry.ResourceType,
+			uuidString(entry.ResourceID),
+			stringValue(entry.IPAddress),
+			stringValue(entry.UserAgent),
+			oldValue,
+			newValue,
+		}
+
+		if err := writer.Write(sanitizeCSVRecord(record)); err != nil {
+			return fmt.Errorf("failed to write audit log export: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write audit log export: %w", err)
+	}
+
+	return nil
+}
+
+// archiveKey places a batch under prefix/YYYY/MM/DD of its first entry
+func (s *AuditLogRetentionService) archiveKey(first *domain.AuditLog) string {
+	created := first.CreatedAt.UTC()
+	name := fmt.Sprintf("%s-%s.jsonl.gz", created.Format("20060102T150405.000000000Z"), first.ID)
+	return path.Join(s.prefix, created.Format("2006/01/02"), name)
+}
+
+// recordArchive writes an audit entry describing the archived batch; failures are only logged
+func (s *AuditLogRetentionService) recordArchive(ctx context.Context, key string, logs []*domain.AuditLog) {
+	newValue := map[string]interface{}{
+		"object": key,
+		"count":  len(logs),
+		"from":   logs[0].CreatedAt.UTC(),
+		"to":     logs[len(logs)-1].CreatedAt.UTC(),
+	}
+
+	entry := domain.NewAuditLog(nil, domain.AuditActionAuditLogsArchived, "audit_log", nil, nil, newValue, nil, nil)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("object", key).
+			Msg("Failed to write audit log archive audit log")
+	}
+}
+
+// encodeAuditArchive gzips the entries as JSON Lines
+func encodeAuditArchive(logs []*domain.AuditLog) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+
+	for _, entry := range logs {
+		if err := encoder.Encode(entry); err != nil {
+			return nil, fmt.Errorf("failed to encode audit log %s: %w", entry.ID, err)
+		}
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress audit log archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// auditValueJSON renders an old or new value as compact JSON, empty when unset
+func auditValueJSON(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit log value: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+// uuidString formats an optional ID, empty when unset
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// stringValue dereferences an optional string, empty when unset
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
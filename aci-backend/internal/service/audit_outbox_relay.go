@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// auditOutboxRelayBatchSize is the most entries relayed per run
+const auditOutboxRelayBatchSize = 500
+
+// AuditOutboxRelay delivers audit logs staged in the outbox to the audit log
+// Entries are staged in the same transaction as the change they record, so an entry
+// exists exactly when its change was committed; the relay retries until it is delivered
+type AuditOutboxRelay struct {
+	outboxRepo repository.AuditOutboxRepository
+}
+
+// NewAuditOutboxRelay creates a new audit outbox relay instance
+func NewAuditOutboxRelay(outboxRepo repository.AuditOutboxRepository) *AuditOutboxRelay {
+	if outboxRepo == nil {
+		panic("outboxRepo cannot be nil")
+	}
+
+	return &AuditOutboxRelay{
+		outboxRepo: outboxRepo,
+	}
+}
+
+// Relay delivers due entries until none are left or the batch size is reached
+// and returns how many were delivered and how many failed
+func (r *AuditOutboxRelay) Relay(ctx context.Context) (relayed, failed int) {
+	defer func() {
+		metrics.AddAuditOutboxRelayed(relayed)
+		metrics.AddAuditOutboxFailures(failed)
+	}()
+
+	for relayed+failed < auditOutboxRelayBatchSize {
+		if ctx.Err() != nil {
+			return relayed, failed
+		}
+
+		found, err := r.outboxRepo.RelayNext(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to relay staged audit log")
+			if !found {
+				return relayed, failed
+			}
+			failed++
+			continue
+		}
+
+		if !found {
+			return relayed, failed
+		}
+		relayed++
+	}
+
+	return relayed, failed
+}
+
+// Run relays staged entries on every interval until the context is cancelled
+func (r *AuditOutboxRelay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			relayed, failed := r.Relay(ctx)
+			if relayed > 0 || failed > 0 {
+				log.Debug().Int("relayed", relayed).Int("failed", failed).Msg("Relayed staged audit logs")
+			}
+
+			pending, err := r.outboxRepo.Pending(ctx)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to count staged audit logs")
+				continue
+			}
+			metrics.SetAuditOutboxPending(pending)
+		}
+	}
+}
@@ -4,35 +4,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
 	"github.com/phillipboles/aci-backend/internal/domain/entities"
 	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
 	"github.com/phillipboles/aci-backend/internal/pkg/crypto"
 	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
 	"github.com/phillipboles/aci-backend/internal/repository"
 )
 
-const (
-	minPasswordLength = 8
-	minNameLength     = 2
-)
-
-var (
-	// emailRegex is a basic email validation pattern
-	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-)
-
 // AuthService handles authentication business logic
 type AuthService struct {
 	userRepo  UserRepoInterface
 	tokenRepo repository.RefreshTokenRepository
 	jwtSvc    jwt.Service
+
+	orgService      *OrganizationService
+	deletionService *AccountDeletionService
+	securityEvents  *SecurityEventService
+	registration    *RegistrationPolicyService
 }
 
 // NewAuthService creates a new authentication service
@@ -59,27 +55,59 @@ func NewAuthService(
 }
 
 // Register creates a new user account with validation and password hashing
+// The registration policy, when set, is checked first, so closed signup never reveals which emails have accounts
 func (s *AuthService) Register(ctx context.Context, email, password, name string) (*entities.User, *jwt.TokenPair, error) {
+	if s.registration != nil {
+		if err := s.validateEmail(email); err != nil {
+			return nil, nil, err
+		}
+		if err := s.registration.Check(ctx, email); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	user, err := s.createUser(ctx, email, password, name, entities.RoleUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Generate token pair
+	tokenPair, err := s.generateAndStoreTokens(ctx, user, "", "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate tokens: %w", err)
+	}
+
+	return user, tokenPair, nil
+}
+
+// CreateAdmin creates an administrator account without issuing tokens
+// It is used to bootstrap the first admin, who then signs in normally
+func (s *AuthService) CreateAdmin(ctx context.Context, email, password, name string) (*entities.User, error) {
+	return s.createUser(ctx, email, password, name, entities.RoleAdmin)
+}
+
+// createUser validates the registration fields and persists a user with the given role
+func (s *AuthService) createUser(ctx context.Context, email, password, name string, role entities.UserRole) (*entities.User, error) {
 	// Validate email
 	if err := s.validateEmail(email); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Validate password strength
 	if err := s.validatePassword(password); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Validate name
 	if err := s.validateName(name); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Check if email already exists
 	_, err := s.userRepo.GetByEmail(ctx, email)
 	if err == nil {
 		// User found - email conflict
-		return nil, nil, &domainerrors.ConflictError{
+		return nil, &domainerrors.ConflictError{
 			Resource: "user",
 			Field:    "email",
 			Value:    email,
@@ -89,34 +117,31 @@ func (s *AuthService) Register(ctx context.Context, email, password, name string
 	// If error is not NotFound, it's an actual error
 	var notFoundErr *domainerrors.NotFoundError
 	if err != nil && !errors.As(err, &notFoundErr) {
-		return nil, nil, fmt.Errorf("failed to check existing user: %w", err)
+		return nil, fmt.Errorf("failed to check existing user: %w", err)
 	}
 
 	// Hash password
 	passwordHash, err := crypto.HashPassword(password)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to hash password: %w", err)
+		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	// Create user entity
 	user := entities.NewUser(email, passwordHash, name)
+	user.Role = role
 
 	// Persist user
 	if err := s.userRepo.Create(ctx, user); err != nil {
-		return nil, nil, fmt.Errorf("failed to create user: %w", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Generate token pair
-	tokenPair, err := s.generateAndStoreTokens(ctx, user, "", "")
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate tokens: %w", err)
-	}
-
-	return user, tokenPair, nil
+	return user, nil
 }
 
 // Login authenticates user credentials and returns tokens
-func (s *AuthService) Login(ctx context.Context, email, password string) (*entities.User, *jwt.TokenPair, error) {
+// Suspended and banned users are refused with an AccountBlockedError
+// Successful and failed attempts are recorded as security events when a SecurityEventService is set
+func (s *AuthService) Login(ctx context.Context, email, password, ipAddress, userAgent string) (*entities.User, *jwt.TokenPair, error) {
 	if email == "" {
 		return nil, nil, &domainerrors.ValidationError{
 			Field:   "email",
@@ -134,15 +159,36 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*entit
 	// Get user by email
 	user, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
+		s.recordSecurityEvent(ctx, nil, domain.SecurityEventLoginFailed,
+			map[string]string{"email": email, "reason": "unknown_email"}, ipAddress, userAgent)
 		// Return generic unauthorized error to prevent email enumeration
 		return nil, nil, fmt.Errorf("invalid credentials: %w", domainerrors.ErrUnauthorized)
 	}
 
 	// Verify password
 	if !crypto.CheckPassword(password, user.PasswordHash) {
+		s.recordSecurityEvent(ctx, &user.ID, domain.SecurityEventLoginFailed,
+			map[string]string{"reason": "invalid_password"}, ipAddress, userAgent)
 		return nil, nil, fmt.Errorf("invalid credentials: %w", domainerrors.ErrUnauthorized)
 	}
 
+	// Suspended and banned users learn why only after proving their password
+	if err := accountBlockedError(user, time.Now()); err != nil {
+		s.recordSecurityEvent(ctx, &user.ID, domain.SecurityEventLoginFailed,
+			map[string]string{"reason": "account_" + string(user.Status)}, ipAddress, userAgent)
+		return nil, nil, err
+	}
+
+	// Logging in during the grace period restores an account scheduled for deletion
+	if s.deletionService != nil {
+		if _, err := s.deletionService.Cancel(ctx, user.ID, "", ""); err != nil {
+			log.Error().
+				Err(err).
+				Str("user_id", user.ID.String()).
+				Msg("Failed to cancel account deletion on login")
+		}
+	}
+
 	// Update last login timestamp
 	if err := s.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
 		// Log error but don't fail login
@@ -151,16 +197,18 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*entit
 	}
 
 	// Generate token pair
-	tokenPair, err := s.generateAndStoreTokens(ctx, user, "", "")
+	tokenPair, err := s.generateAndStoreTokens(ctx, user, ipAddress, userAgent)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate tokens: %w", err)
 	}
 
+	s.recordSecurityEvent(ctx, &user.ID, domain.SecurityEventLogin, nil, ipAddress, userAgent)
+
 	return user, tokenPair, nil
 }
 
 // Refresh generates new token pair from valid refresh token (token rotation)
-func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*jwt.TokenPair, error) {
+func (s *AuthService) Refresh(ctx context.Context, refreshToken, ipAddress, userAgent string) (*jwt.TokenPair, error) {
 	if refreshToken == "" {
 		return nil, fmt.Errorf("refresh token is required: %w", domainerrors.ErrUnauthorized)
 	}
@@ -191,6 +239,10 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*jwt.To
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	if err := accountBlockedError(user, time.Now()); err != nil {
+		return nil, err
+	}
+
 	// Revoke old refresh token (token rotation security)
 	if err := s.tokenRepo.Revoke(ctx, storedToken.ID); err != nil {
 		// Log error but continue - we'll still issue new token
@@ -198,14 +250,60 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*jwt.To
 	}
 
 	// Generate new token pair
-	tokenPair, err := s.generateAndStoreTokens(ctx, user, "", "")
+	tokenPair, err := s.generateAndStoreTokens(ctx, user, ipAddress, userAgent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate new tokens: %w", err)
 	}
 
+	s.recordSecurityEvent(ctx, &user.ID, domain.SecurityEventTokenRefreshed, nil, ipAddress, userAgent)
+
 	return tokenPair, nil
 }
 
+// ChangePassword replaces the user's password after confirming the current one
+// Every refresh token is revoked, so other sessions must log in again once their access token expires
+func (s *AuthService) ChangePassword(ctx context.Context, userID uuid.UUID, currentPassword, newPassword, ipAddress, userAgent string) error {
+	if currentPassword == "" {
+		return &domainerrors.ValidationError{
+			Field:   "current_password",
+			Message: "current password is required",
+		}
+	}
+
+	if err := s.validatePassword(newPassword); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !crypto.CheckPassword(currentPassword, user.PasswordHash) {
+		return fmt.Errorf("invalid password: %w", domainerrors.ErrUnauthorized)
+	}
+
+	passwordHash, err := crypto.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, userID, passwordHash); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := s.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("Failed to revoke refresh tokens after password change")
+	}
+
+	s.recordSecurityEvent(ctx, &userID, domain.SecurityEventPasswordChanged, nil, ipAddress, userAgent)
+
+	return nil
+}
+
 // Logout invalidates a specific refresh token
 func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
 	if refreshToken == "" {
@@ -237,6 +335,36 @@ func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
 	return nil
 }
 
+// SetOrganizationService adds organization membership to issued access tokens
+func (s *AuthService) SetOrganizationService(orgService *OrganizationService) {
+	s.orgService = orgService
+}
+
+// SetAccountDeletionService makes logging in cancel a pending account deletion
+func (s *AuthService) SetAccountDeletionService(deletionService *AccountDeletionService) {
+	s.deletionService = deletionService
+}
+
+// SetRegistrationPolicyService lets admins close registration or limit it to email domains
+// Invitations and admin bootstrapping do not go through Register and are not affected
+func (s *AuthService) SetRegistrationPolicyService(registration *RegistrationPolicyService) {
+	s.registration = registration
+}
+
+// SetSecurityEventService records logins, failed logins, token refreshes and password changes
+func (s *AuthService) SetSecurityEventService(securityEvents *SecurityEventService) {
+	s.securityEvents = securityEvents
+}
+
+// recordSecurityEvent records an event on the user's account when security events are enabled
+func (s *AuthService) recordSecurityEvent(ctx context.Context, userID *uuid.UUID, event string, details interface{}, ipAddress, userAgent string) {
+	if s.securityEvents == nil {
+		return
+	}
+
+	s.securityEvents.Record(ctx, userID, event, "user", userID, details, ipAddress, userAgent)
+}
+
 // LogoutAll invalidates all refresh tokens for a user
 func (s *AuthService) LogoutAll(ctx context.Context, userID uuid.UUID) error {
 	if userID == uuid.Nil {
@@ -258,7 +386,7 @@ func (s *AuthService) generateAndStoreTokens(
 	userAgent string,
 ) (*jwt.TokenPair, error) {
 	// Generate JWT token pair
-	tokenPair, err := s.jwtSvc.GenerateTokenPair(user.ID, user.Email, string(user.Role))
+	tokenPair, err := s.jwtSvc.GenerateTokenPair(user.ID, user.Email, string(user.Role), s.tokenOptions(ctx, user.ID)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token pair: %w", err)
 	}
@@ -285,111 +413,61 @@ func (s *AuthService) generateAndStoreTokens(
 	return tokenPair, nil
 }
 
-// validateEmail checks email format and requirements
-func (s *AuthService) validateEmail(email string) error {
-	if email == "" {
-		return &domainerrors.ValidationError{
-			Field:   "email",
-			Message: "email is required",
-		}
-	}
-
-	email = strings.TrimSpace(strings.ToLower(email))
-
-	if !emailRegex.MatchString(email) {
-		return &domainerrors.ValidationError{
-			Field:   "email",
-			Message: "invalid email format",
-		}
-	}
-
-	return nil
-}
-
-// validatePassword checks password strength requirements
-func (s *AuthService) validatePassword(password string) error {
-	if password == "" {
-		return &domainerrors.ValidationError{
-			Field:   "password",
-			Message: "password is required",
-		}
-	}
-
-	if len(password) < minPasswordLength {
-		return &domainerrors.ValidationError{
-			Field:   "password",
-			Message: fmt.Sprintf("password must be at least %d characters", minPasswordLength),
-		}
-	}
-
-	// Check for at least one uppercase letter
-	hasUpper := false
-	for _, char := range password {
-		if char >= 'A' && char <= 'Z' {
-			hasUpper = true
-			break
-		}
+// tokenOptions returns optional access token claims for a user
+// The workspace is the one the request is scoped to, since only its users can sign in there
+func (s *AuthService) tokenOptions(ctx context.Context, userID uuid.UUID) []jwt.TokenOption {
+	var opts []jwt.TokenOption
+	if tenantID, ok := repository.TenantFromContext(ctx); ok && tenantID != uuid.Nil {
+		opts = append(opts, jwt.WithTenant(tenantID))
 	}
 
-	if !hasUpper {
-		return &domainerrors.ValidationError{
-			Field:   "password",
-			Message: "password must contain at least one uppercase letter",
-		}
+	if s.orgService == nil {
+		return opts
 	}
 
-	// Check for at least one lowercase letter
-	hasLower := false
-	for _, char := range password {
-		if char >= 'a' && char <= 'z' {
-			hasLower = true
-			break
-		}
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("Failed to load organization membership for token")
+		return opts
 	}
 
-	if !hasLower {
-		return &domainerrors.ValidationError{
-			Field:   "password",
-			Message: "password must contain at least one lowercase letter",
-		}
+	if member == nil {
+		return opts
 	}
 
-	// Check for at least one digit
-	hasDigit := false
-	for _, char := range password {
-		if char >= '0' && char <= '9' {
-			hasDigit = true
-			break
-		}
-	}
+	return append(opts, jwt.WithOrganization(member.OrganizationID, string(member.Role)))
+}
 
-	if !hasDigit {
-		return &domainerrors.ValidationError{
-			Field:   "password",
-			Message: "password must contain at least one digit",
-		}
-	}
+// validateEmail checks email format and requirements
+func (s *AuthService) validateEmail(email string) error {
+	return checkField("email", strings.TrimSpace(strings.ToLower(email)), "required,email,max=255")
+}
 
-	return nil
+// validatePassword checks password strength requirements
+func (s *AuthService) validatePassword(password string) error {
+	return checkField("password", password, "required,password,max=128")
 }
 
 // validateName checks name requirements
 func (s *AuthService) validateName(name string) error {
-	if name == "" {
-		return &domainerrors.ValidationError{
-			Field:   "name",
-			Message: "name is required",
-		}
-	}
+	return checkField("name", strings.TrimSpace(name), "required,min=2,max=255")
+}
 
-	name = strings.TrimSpace(name)
+// checkField validates a value against validator tags such as "required,email", returning the
+// first failure as a ValidationError for field
+func checkField(field string, value interface{}, tag string) error {
+	err := validator.Var(field, value, tag)
 
-	if len(name) < minNameLength {
+	var invalid *validator.ValidationErrors
+	if errors.As(err, &invalid) && len(invalid.Errors) > 0 {
 		return &domainerrors.ValidationError{
-			Field:   "name",
-			Message: fmt.Sprintf("name must be at least %d characters", minNameLength),
+			Field:   field,
+			Message: invalid.Errors[0].Message,
 		}
 	}
 
-	return nil
+	return err
 }
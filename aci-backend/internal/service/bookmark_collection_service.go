@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// BookmarkCollectionService manages named bookmark collections
+// Shared collections are readable and editable by every member of the organization;
+// only the creator or an organization admin can delete them
+type BookmarkCollectionService struct {
+	collectionRepo repository.BookmarkCollectionRepository
+	articleRepo    repository.ArticleRepository
+	orgService     *OrganizationService
+}
+
+// NewBookmarkCollectionService creates a new bookmark collection service instance
+func NewBookmarkCollectionService(
+	collectionRepo repository.BookmarkCollectionRepository,
+	articleRepo repository.ArticleRepository,
+	orgService *OrganizationService,
+) *BookmarkCollectionService {
+	if collectionRepo == nil {
+		panic("collectionRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &BookmarkCollectionService{
+		collectionRepo: collectionRepo,
+		articleRepo:    articleRepo,
+		orgService:     orgService,
+	}
+}
+
+// Create creates a collection, shared with the user's organization when shared is true
+func (s *BookmarkCollectionService) Create(ctx context.Context, userID uuid.UUID, name string, shared bool) (*domain.BookmarkCollection, error) {
+	now := time.Now()
+	collection := &domain.BookmarkCollection{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := collection.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "collection", Message: err.Error()}
+	}
+
+	if shared {
+		member, err := s.orgService.MembershipOf(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if member == nil {
+			return nil, &domainerrors.ValidationError{Field: "shared", Message: "user does not belong to an organization"}
+		}
+		collection.OrganizationID = &member.OrganizationID
+	}
+
+	if err := s.collectionRepo.Create(ctx, collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// List returns the user's private collections and their organization's shared collections
+func (s *BookmarkCollectionService) List(ctx context.Context, userID uuid.UUID) ([]*domain.BookmarkCollection, error) {
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgID *uuid.UUID
+	if member != nil {
+		orgID = &member.OrganizationID
+	}
+
+	return s.collectionRepo.ListVisible(ctx, userID, orgID)
+}
+
+// Get returns a collection the user can see
+func (s *BookmarkCollectionService) Get(ctx context.Context, id, userID uuid.UUID) (*domain.BookmarkCollection, error) {
+	collection, _, err := s.load(ctx, id, userID)
+	return collection, err
+}
+
+// Delete removes a collection; shared collections require the creator or an organization admin
+func (s *BookmarkCollectionService) Delete(ctx context.Context, id, userID uuid.UUID) error {
+	collection, member, err := s.load(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if collection.UserID != userID && (member == nil || !member.IsAdmin()) {
+		return domainerrors.ErrForbidden
+	}
+
+	return s.collectionRepo.Delete(ctx, id)
+}
+
+// AddArticle adds an article to a collection the user can see
+func (s *BookmarkCollectionService) AddArticle(ctx context.Context, id, userID, articleID uuid.UUID) error {
+	if _, _, err := s.load(ctx, id, userID); err != nil {
+		return err
+	}
+
+	return s.collectionRepo.AddArticle(ctx, id, articleID, userID)
+}
+
+// RemoveArticle removes an article from a collection the user can see
+func (s *BookmarkCollectionService) RemoveArticle(ctx context.Context, id, userID, articleID uuid.UUID) error {
+	if _, _, err := s.load(ctx, id, userID); err != nil {
+		return err
+	}
+
+	return s.collectionRepo.RemoveArticle(ctx, id, articleID)
+}
+
+// ListArticles returns a page of a collection's articles, most recently added first
+func (s *BookmarkCollectionService) ListArticles(ctx context.Context, id, userID uuid.UUID, page, pageSize int) ([]*domain.Article, int, error) {
+	if _, _, err := s.load(ctx, id, userID); err != nil {
+		return nil, 0, err
+	}
+
+	ids, total, err := s.collectionRepo.ListArticleIDs(ctx, id, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byID, err := loadArticles(ctx, s.articleRepo, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	articles := make([]*domain.Article, 0, len(ids))
+	for _, articleID := range ids {
+		article, ok := byID[articleID]
+		if !ok {
+			log.Error().
+				Str("article_id", articleID.String()).
+				Msg("Article for bookmark collection not found")
+			continue
+		}
+		articles = append(articles, article)
+	}
+
+	return articles, total, nil
+}
+
+// load returns a collection and the user's membership, hiding collections the user cannot see
+func (s *BookmarkCollectionService) load(ctx context.Context, id, userID uuid.UUID) (*domain.BookmarkCollection, *domain.OrganizationMember, error) {
+	collection, err := s.collectionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	visible := collection.UserID == userID && !collection.IsShared()
+	if collection.IsShared() && member != nil && member.OrganizationID == *collection.OrganizationID {
+		visible = true
+	}
+
+	if !visible {
+		return nil, nil, &domainerrors.NotFoundError{Resource: "bookmark collection", ID: id.String()}
+	}
+
+	return collection, member, nil
+}
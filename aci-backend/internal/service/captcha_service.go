@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/captcha"
+)
+
+var (
+	// ErrCaptchaRequired is returned when a request needs a CAPTCHA response and has none
+	ErrCaptchaRequired = errors.New("captcha response required")
+
+	// ErrCaptchaFailed is returned when the provider does not accept a CAPTCHA response
+	ErrCaptchaFailed = errors.New("captcha verification failed")
+)
+
+// maxLoginFailureEntries bounds the failed login counters; past it, new emails and addresses are not counted
+const maxLoginFailureEntries = 50000
+
+// CaptchaVerifier checks a CAPTCHA challenge response with its provider
+// Responses the provider does not accept return an error wrapping captcha.ErrRejected;
+// any other error means the provider could not be asked
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// CaptchaServiceConfig configures when challenges are required
+type CaptchaServiceConfig struct {
+	Provider           string        // shown to clients so they load the right widget
+	SiteKey            string        // public key clients render the widget with
+	Registration       bool          // require a challenge on every registration
+	LoginFailures      int           // failed logins before a challenge is required; 0 never requires one
+	LoginFailureWindow time.Duration // how long failed logins are counted
+}
+
+// CaptchaSettings tells clients how to render the challenge and when to show it
+type CaptchaSettings struct {
+	Provider      string `json:"provider"`
+	SiteKey       string `json:"site_key"`
+	Registration  bool   `json:"registration"`
+	LoginFailures int    `json:"login_failures,omitempty"`
+}
+
+// CaptchaService requires CAPTCHA challenges on registration and after repeated failed logins
+// Failed logins are counted per email and per client address on each instance, so behind a
+// load balancer a client may get a few more attempts before it is challenged
+type CaptchaService struct {
+	verifier CaptchaVerifier
+	cfg      CaptchaServiceConfig
+
+	mu       sync.Mutex
+	failures map[string]loginFailureCount
+}
+
+// loginFailureCount counts failed logins since the window started
+type loginFailureCount struct {
+	count     int
+	expiresAt time.Time
+}
+
+// NewCaptchaService creates a new CAPTCHA service instance
+func NewCaptchaService(verifier CaptchaVerifier, cfg CaptchaServiceConfig) *CaptchaService {
+	if verifier == nil {
+		panic("verifier cannot be nil")
+	}
+
+	return &CaptchaService{
+		verifier: verifier,
+		cfg:      cfg,
+		failures: make(map[string]loginFailureCount),
+	}
+}
+
+// Settings returns what clients need to render challenges
+func (s *CaptchaService) Settings() CaptchaSettings {
+	return CaptchaSettings{
+		Provider:      s.cfg.Provider,
+		SiteKey:       s.cfg.SiteKey,
+		Registration:  s.cfg.Registration,
+		LoginFailures: s.cfg.LoginFailures,
+	}
+}
+
+// CheckRegistration verifies the challenge response sent with a registration
+func (s *CaptchaService) CheckRegistration(ctx context.Context, token, remoteIP string) error {
+	if !s.cfg.Registration {
+		return nil
+	}
+	return s.verify(ctx, token, remoteIP)
+}
+
+// CheckLogin verifies the challenge response sent with a login once the email or the
+// client address has failed too often; until then the response is ignored
+func (s *CaptchaService) CheckLogin(ctx context.Context, email, token, remoteIP string) error {
+	if !s.loginChallengeRequired(email, remoteIP) {
+		return nil
+	}
+	return s.verify(ctx, token, remoteIP)
+}
+
+// LoginFailed counts a failed login against the email and the client address
+func (s *CaptchaService) LoginFailed(email, remoteIP string) {
+	if s.cfg.LoginFailures <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range loginFailureKeys(email, remoteIP) {
+		entry, ok := s.failures[key]
+		if !ok || now.After(entry.expiresAt) {
+			if len(s.failures) >= maxLoginFailureEntries {
+				s.evictExpired(now)
+			}
+			if len(s.failures) >= maxLoginFailureEntries {
+				continue
+			}
+			entry = loginFailureCount{expiresAt: now.Add(s.cfg.LoginFailureWindow)}
+		}
+		entry.count++
+		s.failures[key] = entry
+	}
+}
+
+// LoginSucceeded clears the email's failed logins
+// The client address keeps its count, so one valid account cannot unlock guessing at others
+func (s *CaptchaService) LoginSucceeded(email string) {
+	s.mu.Lock()
+	delete(s.failures, "email:"+strings.ToLower(strings.TrimSpace(email)))
+	s.mu.Unlock()
+}
+
+// loginChallengeRequired reports whether the email or the client address has failed too often
+func (s *CaptchaService) loginChallengeRequired(email, remoteIP string) bool {
+	if s.cfg.LoginFailures <= 0 {
+		return false
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range loginFailureKeys(email, remoteIP) {
+		entry, ok := s.failures[key]
+		if ok && !now.After(entry.expiresAt) && entry.count >= s.cfg.LoginFailures {
+			return true
+		}
+	}
+	return false
+}
+
+// evictExpired drops expired counters; the caller holds the lock
+func (s *CaptchaService) evictExpired(now time.Time) {
+	for key, entry := range s.failures {
+		if now.After(entry.expiresAt) {
+			delete(s.failures, key)
+		}
+	}
+}
+
+// verify checks a challenge response, mapping rejections to ErrCaptchaRequired or ErrCaptchaFailed
+func (s *CaptchaService) verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return ErrCaptchaRequired
+	}
+
+	if err := s.verifier.Verify(ctx, token, remoteIP); err != nil {
+		if errors.Is(err, captcha.ErrRejected) {
+			return fmt.Errorf("%w: %v", ErrCaptchaFailed, err)
+		}
+		return fmt.Errorf("failed to verify captcha: %w", err)
+	}
+
+	return nil
+}
+
+// loginFailureKeys are the counters a login attempt is checked and counted against
+func loginFailureKeys(email, remoteIP string) []string {
+	keys := []string{"email:" + strings.ToLower(strings.TrimSpace(email))}
+	if remoteIP != "" {
+		keys = append(keys, "ip:"+remoteIP)
+	}
+	return keys
+}
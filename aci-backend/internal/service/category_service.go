@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// CategoryService manages the category hierarchy and article category membership
+type CategoryService struct {
+	categoryRepo repository.CategoryRepository
+	articleRepo  repository.ArticleRepository
+	auditRepo    repository.AuditLogRepository
+}
+
+// NewCategoryService creates a new category service instance
+func NewCategoryService(
+	categoryRepo repository.CategoryRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+) *CategoryService {
+	if categoryRepo == nil {
+		panic("categoryRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+		articleRepo:  articleRepo,
+		auditRepo:    auditRepo,
+	}
+}
+
+// SetParent moves a category under a parent, or to the top level when parentID is nil
+func (s *CategoryService) SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Category, error) {
+	if parentID != nil && *parentID == id {
+		return nil, &domainerrors.ValidationError{
+			Field:   "parent_id",
+			Message: "a category cannot be its own parent",
+		}
+	}
+
+	if err := s.categoryRepo.SetParent(ctx, id, parentID); err != nil {
+		return nil, err
+	}
+
+	return s.categoryRepo.GetByID(ctx, id)
+}
+
+// SetArticleCategories replaces an article's categories; the first becomes its primary category
+func (s *CategoryService) SetArticleCategories(
+	ctx context.Context,
+	articleID uuid.UUID,
+	categoryIDs []uuid.UUID,
+	actor *uuid.UUID,
+	ipAddress, userAgent string,
+) ([]uuid.UUID, error) {
+	unique := make([]uuid.UUID, 0, len(categoryIDs))
+	seen := make(map[uuid.UUID]bool, len(categoryIDs))
+	for _, id := range categoryIDs {
+		if id == uuid.Nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 {
+		return nil, &domainerrors.ValidationError{
+			Field:   "category_ids",
+			Message: "at least one category is required",
+		}
+	}
+
+	if len(unique) > domain.MaxArticleCategories {
+		return nil, &domainerrors.ValidationError{
+			Field:   "category_ids",
+			Message: fmt.Sprintf("an article can belong to at most %d categories", domain.MaxArticleCategories),
+		}
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.categoryRepo.SetArticleCategories(ctx, articleID, unique); err != nil {
+		return nil, err
+	}
+
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actor, domain.AuditActionArticleCategoriesUpdated, "article", &articleID, article.CategoryIDs, unique, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to write article categories audit log")
+	}
+
+	return unique, nil
+}
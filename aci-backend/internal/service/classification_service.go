@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// DefaultClassificationThreshold is the confidence at or above which suggestions are applied without review
+const DefaultClassificationThreshold = 0.8
+
+// ClassificationService suggests metadata for articles ingested without a category or severity
+// Suggestions at or above the threshold are applied at ingest; the rest wait for admin review
+type ClassificationService struct {
+	classifier       *ai.Classifier
+	suggestionRepo   repository.ClassificationSuggestionRepository
+	articleRepo      repository.ArticleRepository
+	categoryRepo     repository.CategoryRepository
+	threshold        float64
+	fallbackCategory string
+	tags             *TagService
+}
+
+// NewClassificationService creates a new classification service instance
+// fallbackCategory is used when the category is missing and cannot be auto-applied
+func NewClassificationService(
+	classifier *ai.Classifier,
+	suggestionRepo repository.ClassificationSuggestionRepository,
+	articleRepo repository.ArticleRepository,
+	categoryRepo repository.CategoryRepository,
+	threshold float64,
+	fallbackCategory string,
+) *ClassificationService {
+	if classifier == nil {
+		panic("classifier cannot be nil")
+	}
+
+	if suggestionRepo == nil {
+		panic("suggestionRepo cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	if categoryRepo == nil {
+		panic("categoryRepo cannot be nil")
+	}
+
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultClassificationThreshold
+	}
+
+	return &ClassificationService{
+		classifier:       classifier,
+		suggestionRepo:   suggestionRepo,
+		articleRepo:      articleRepo,
+		categoryRepo:     categoryRepo,
+		threshold:        threshold,
+		fallbackCategory: fallbackCategory,
+	}
+}
+
+// SetTagService normalizes suggested tags when a suggestion is approved
+func (s *ClassificationService) SetTagService(tags *TagService) {
+	s.tags = tags
+}
+
+// FallbackCategory returns the category slug used when no category can be applied
+func (s *ClassificationService) FallbackCategory() string {
+	return s.fallbackCategory
+}
+
+// Suggest classifies an article and marks the missing fields that meet the auto-apply threshold
+// The returned suggestion has no article ID until it is recorded
+func (s *ClassificationService) Suggest(ctx context.Context, title, content string, missing []domain.ClassificationField) (*domain.ClassificationSuggestion, error) {
+	categories, err := s.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	slugs := make([]string, len(categories))
+	for i, category := range categories {
+		slugs[i] = category.Slug
+	}
+
+	result, err := s.classifier.Classify(ctx, title, content, slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion := &domain.ClassificationSuggestion{
+		ID:                 uuid.New(),
+		CategoryConfidence: result.Category.Confidence,
+		SeverityConfidence: result.Severity.Confidence,
+		Tags:               result.Tags.Values,
+		TagsConfidence:     result.Tags.Confidence,
+		Vendors:            result.Vendors.Values,
+		VendorsConfidence:  result.Vendors.Confidence,
+		MissingFields:      missing,
+		AppliedFields:      []domain.ClassificationField{},
+		Provider:           s.classifier.ProviderName(),
+		CreatedAt:          time.Now(),
+	}
+
+	if result.Category.Value != "" {
+		suggestion.CategorySlug = &result.Category.Value
+	}
+
+	if result.Severity.Value != "" {
+		severity := domain.Severity(result.Severity.Value)
+		suggestion.Severity = &severity
+	}
+
+	for _, field := range missing {
+		if suggestion.HasValue(field) && suggestion.Confidence(field) >= s.threshold {
+			suggestion.AppliedFields = append(suggestion.AppliedFields, field)
+		}
+	}
+
+	suggestion.Status = domain.ClassificationStatusApplied
+	if len(suggestion.PendingFields()) > 0 {
+		suggestion.Status = domain.ClassificationStatusPending
+	}
+
+	return suggestion, nil
+}
+
+// Record stores a suggestion for the created article
+// Failures are logged rather than returned so they never fail ingestion
+func (s *ClassificationService) Record(ctx context.Context, articleID uuid.UUID, suggestion *domain.ClassificationSuggestion) {
+	suggestion.ArticleID = articleID
+
+	if err := s.suggestionRepo.Create(ctx, suggestion); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to record classification suggestion")
+		return
+	}
+
+	if suggestion.Status == domain.ClassificationStatusPending {
+		log.Info().
+			Str("article_id", articleID.String()).
+			Str("suggestion_id", suggestion.ID.String()).
+			Interface("pending_fields", suggestion.PendingFields()).
+			Msg("Classification suggestion queued for review")
+	}
+}
+
+// GetByID retrieves a classification suggestion
+func (s *ClassificationService) GetByID(ctx context.Context, id uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	return s.suggestionRepo.GetByID(ctx, id)
+}
+
+// List retrieves classification suggestions
+func (s *ClassificationService) List(ctx context.Context, filter *domain.ClassificationFilter) ([]*domain.ClassificationSuggestion, int, error) {
+	return s.suggestionRepo.List(ctx, filter)
+}
+
+// Approve applies the pending fields of a suggestion to its article
+func (s *ClassificationService) Approve(ctx context.Context, id uuid.UUID, reviewer *uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	suggestion, err := s.getPending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, suggestion.ArticleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article: %w", err)
+	}
+
+	pending := suggestion.PendingFields()
+	for _, field := range pending {
+		switch field {
+		case domain.ClassificationFieldCategory:
+			category, err := s.categoryRepo.GetBySlug(ctx, *suggestion.CategorySlug)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get suggested category: %w", err)
+			}
+			article.CategoryID = category.ID
+		case domain.ClassificationFieldSeverity:
+			article.Severity = *suggestion.Severity
+		case domain.ClassificationFieldTags:
+			article.Tags = suggestion.Tags
+			if s.tags != nil {
+				article.Tags = s.tags.Normalize(ctx, suggestion.Tags)
+			}
+		case domain.ClassificationFieldVendors:
+			article.Vendors = suggestion.Vendors
+		}
+	}
+
+	article.UpdatedAt = time.Now()
+	if err := s.articleRepo.Update(ctx, article); err != nil {
+		return nil, fmt.Errorf("failed to update article: %w", err)
+	}
+
+	suggestion.AppliedFields = append(suggestion.AppliedFields, pending...)
+	return s.review(ctx, suggestion, domain.ClassificationStatusApproved, reviewer)
+}
+
+// Reject discards the pending fields of a suggestion, leaving the article unchanged
+func (s *ClassificationService) Reject(ctx context.Context, id uuid.UUID, reviewer *uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	suggestion, err := s.getPending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, suggestion, domain.ClassificationStatusRejected, reviewer)
+}
+
+// getPending retrieves a suggestion that is awaiting review
+func (s *ClassificationService) getPending(ctx context.Context, id uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	suggestion, err := s.suggestionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if suggestion.Status != domain.ClassificationStatusPending {
+		return nil, &domainerrors.ValidationError{
+			Field:   "status",
+			Message: fmt.Sprintf("suggestion is %s, only pending suggestions can be reviewed", suggestion.Status),
+		}
+	}
+
+	return suggestion, nil
+}
+
+// review stores the review outcome of a suggestion
+func (s *ClassificationService) review(ctx context.Context, suggestion *domain.ClassificationSuggestion, status domain.ClassificationStatus, reviewer *uuid.UUID) (*domain.ClassificationSuggestion, error) {
+	now := time.Now()
+	suggestion.Status = status
+	suggestion.ReviewedBy = reviewer
+	suggestion.ReviewedAt = &now
+
+	if err := s.suggestionRepo.UpdateReview(ctx, suggestion); err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
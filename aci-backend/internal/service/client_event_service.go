@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// MaxClientEventsPerRequest caps how many events a client reports in one request
+	MaxClientEventsPerRequest = 100
+
+	// clientEventMaxAge is how late an event can be reported; older events are dropped so they
+	// always land in a partition that exists and a day the aggregation job still recomputes
+	clientEventMaxAge = 24 * time.Hour
+)
+
+// ClientEventInput is one engagement event as reported by a client
+type ClientEventInput struct {
+	Type        domain.ClientEventType
+	ArticleID   uuid.UUID
+	SessionID   *string
+	ScrollDepth *int
+	Channel     *string
+	VariantID   *uuid.UUID
+	OccurredAt  *time.Time // defaults to when the event is received
+}
+
+// ClientEventService ingests client engagement events and rolls them up for the analytics dashboard
+// Events are buffered in memory and written in batches, when a batch fills or on every flush
+// interval; the buffer is bounded and events beyond it are dropped, so a slow database sheds
+// analytics rather than memory. The aggregation job recomputes the daily aggregates and keeps
+// the monthly partitions of the events table ahead of time and within retention
+type ClientEventService struct {
+	eventRepo       repository.ClientEventRepository
+	batchSize       int
+	bufferLimit     int
+	retentionMonths int
+
+	mu     sync.Mutex
+	buffer []*domain.ClientEvent
+	full   chan struct{}
+}
+
+// NewClientEventService creates a new client event service instance
+// retentionMonths of 0 keeps raw events forever
+func NewClientEventService(eventRepo repository.ClientEventRepository, batchSize, bufferLimit, retentionMonths int) *ClientEventService {
+	if eventRepo == nil {
+		panic("eventRepo cannot be nil")
+	}
+
+	return &ClientEventService{
+		eventRepo:       eventRepo,
+		batchSize:       batchSize,
+		bufferLimit:     bufferLimit,
+		retentionMonths: retentionMonths,
+		buffer:          make([]*domain.ClientEvent, 0, batchSize),
+		full:            make(chan struct{}, 1),
+	}
+}
+
+// Record validates the events and buffers them for writing; the whole request is rejected if
+// any event is invalid. It returns how many events were accepted, which excludes events older
+// than a day and events that did not fit in the buffer
+func (s *ClientEventService) Record(userID *uuid.UUID, inputs []ClientEventInput) (int, error) {
+	if len(inputs) == 0 {
+		return 0, &domainerrors.ValidationError{Field: "events", Message: "at least one event is required"}
+	}
+
+	if len(inputs) > MaxClientEventsPerRequest {
+		return 0, &domainerrors.ValidationError{
+			Field:   "events",
+			Message: fmt.Sprintf("cannot report more than %d events per request", MaxClientEventsPerRequest),
+		}
+	}
+
+	now := time.Now()
+	events := make([]*domain.ClientEvent, 0, len(inputs))
+	stale := 0
+
+	for i, input := range inputs {
+		occurredAt := now
+		if input.OccurredAt != nil && input.OccurredAt.Before(now) {
+			occurredAt = *input.OccurredAt
+		}
+
+		event := &domain.ClientEvent{
+			ID:          uuid.New(),
+			Type:        domain.ClientEventType(strings.ToLower(string(input.Type))),
+			ArticleID:   input.ArticleID,
+			UserID:      userID,
+			SessionID:   trimOptional(input.SessionID),
+			ScrollDepth: input.ScrollDepth,
+			Channel:     trimOptional(input.Channel),
+			VariantID:   input.VariantID,
+			OccurredAt:  occurredAt,
+			ReceivedAt:  now,
+		}
+
+		if err := event.Validate(); err != nil {
+			return 0, &domainerrors.ValidationError{Field: fmt.Sprintf("events[%d]", i), Message: err.Error()}
+		}
+
+		if now.Sub(occurredAt) > clientEventMaxAge {
+			stale++
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	if stale > 0 {
+		metrics.AddClientEventsDropped(metrics.ClientEventsStale, stale)
+	}
+
+	return s.enqueue(events), nil
+}
+
+// enqueue appends events to the buffer up to its limit and returns how many fit
+func (s *ClientEventService) enqueue(events []*domain.ClientEvent) int {
+	s.mu.Lock()
+	room := s.bufferLimit - len(s.buffer)
+	accepted := min(max(room, 0), len(events))
+	s.buffer = append(s.buffer, events[:accepted]...)
+	ready := len(s.buffer) >= s.batchSize
+	s.mu.Unlock()
+
+	if dropped := len(events) - accepted; dropped > 0 {
+		metrics.AddClientEventsDropped(metrics.ClientEventsBufferFull, dropped)
+	}
+
+	if ready {
+		select {
+		case s.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return accepted
+}
+
+// Flush writes every buffered event in batches
+// A batch that fails is put back if the buffer has room, so it is retried on the next flush
+func (s *ClientEventService) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	pending := s.buffer
+	s.buffer = make([]*domain.ClientEvent, 0, s.batchSize)
+	s.mu.Unlock()
+
+	for start := 0; start < len(pending); start += s.batchSize {
+		batch := pending[start:min(start+s.batchSize, len(pending))]
+
+		if err := s.eventRepo.InsertBatch(ctx, batch); err != nil {
+			requeued := s.requeue(pending[start:])
+			if dropped := len(pending) - start - requeued; dropped > 0 {
+				metrics.AddClientEventsDropped(metrics.ClientEventsWriteFailed, dropped)
+			}
+			return err
+		}
+
+		metrics.AddClientEventsWritten(len(batch))
+	}
+
+	return nil
+}
+
+// requeue puts unwritten events back at the front of the buffer, as many as fit, and returns how many
+func (s *ClientEventService) requeue(events []*domain.ClientEvent) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	room := max(s.bufferLimit-len(s.buffer), 0)
+	requeued := min(room, len(events))
+	s.buffer = append(events[:requeued:requeued], s.buffer...)
+
+	return requeued
+}
+
+// Aggregate recomputes the daily aggregates for yesterday and today (UTC), the only days
+// events can still arrive for
+func (s *ClientEventService) Aggregate(ctx context.Context) (int64, error) {
+	now := time.Now()
+	return s.eventRepo.Aggregate(ctx, now.Add(-clientEventMaxAge), now)
+}
+
+// MaintainPartitions creates the partitions for last month through next month and drops those
+// past retention
+func (s *ClientEventService) MaintainPartitions(ctx context.Context) error {
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if err := s.eventRepo.EnsurePartitions(ctx, thisMonth.AddDate(0, -1, 0), 3); err != nil {
+		return err
+	}
+
+	if s.retentionMonths <= 0 {
+		return nil
+	}
+
+	cutoff := thisMonth.AddDate(0, -s.retentionMonths, 0)
+	dropped, err := s.eventRepo.DropPartitionsBefore(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+
+	if dropped > 0 {
+		log.Info().Int("dropped", dropped).Time("cutoff", cutoff).Msg("Dropped expired client event partitions")
+	}
+
+	return nil
+}
+
+// Run writes buffered events on every flush interval, or sooner when a batch fills, and
+// aggregates them on every aggregation interval until the context is cancelled
+// Call Flush after the HTTP server stops to write the events still buffered
+func (s *ClientEventService) Run(ctx context.Context, flushInterval, aggregationInterval time.Duration) {
+	if err := s.MaintainPartitions(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to maintain client event partitions")
+	}
+
+	flushTicker := time.NewTicker(flushInterval)
+	defer flushTicker.Stop()
+
+	aggregationTicker := time.NewTicker(aggregationInterval)
+	defer aggregationTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-flushTicker.C:
+			s.flush(ctx)
+		case <-s.full:
+			s.flush(ctx)
+		case <-aggregationTicker.C:
+			if err := s.MaintainPartitions(ctx); err != nil {
+				log.Warn().Err(err).Msg("Failed to maintain client event partitions")
+			}
+
+			aggregated, err := s.Aggregate(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to aggregate client events")
+				continue
+			}
+			log.Debug().Int64("rows", aggregated).Msg("Aggregated client events")
+		}
+	}
+}
+
+// flush writes buffered events, logging a failure
+func (s *ClientEventService) flush(ctx context.Context) {
+	if err := s.Flush(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to write client events")
+	}
+}
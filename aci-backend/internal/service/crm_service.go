@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/crm"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// crmClickDedupWindow is how long repeat CTA clicks by a reader on the same article are not pushed again
+	crmClickDedupWindow = 24 * time.Hour
+
+	// crmDeliveryLease is how long a claimed event stays with its worker before another worker may claim it
+	crmDeliveryLease = 5 * time.Minute
+
+	// crmPushTimeout bounds pushing one event
+	crmPushTimeout = 30 * time.Second
+)
+
+// CRMServiceConfig configures the CRM service
+type CRMServiceConfig struct {
+	SiteURL     string // origin of the web app, used for article links; they are left out when empty
+	BatchSize   int    // events pushed per Deliver
+	MaxAttempts int    // attempts before an event is moved to the failure queue
+}
+
+// LeadInput is a lead form submission
+type LeadInput struct {
+	Email     string     `json:"email"`
+	FirstName string     `json:"first_name,omitempty"`
+	LastName  string     `json:"last_name,omitempty"`
+	Company   string     `json:"company,omitempty"`
+	JobTitle  string     `json:"job_title,omitempty"`
+	Phone     string     `json:"phone,omitempty"`
+	Message   string     `json:"message,omitempty"`
+	ArticleID *uuid.UUID `json:"article_id,omitempty"` // the article the form was shown with, if any
+}
+
+// CRMService pushes CTA clicks and lead form submissions to the configured CRM
+// Conversions are queued and pushed by Run, so a slow or unavailable CRM never holds up
+// readers. Failed pushes are retried with backoff; events that fail permanently or run out
+// of attempts are kept as a failure queue that admins can retry once the cause is fixed
+type CRMService struct {
+	eventRepo   repository.CRMEventRepository
+	userRepo    repository.UserRepository
+	orgRepo     repository.OrganizationRepository
+	articleRepo repository.ArticleRepository
+	connector   crm.Connector
+	cfg         CRMServiceConfig
+}
+
+// NewCRMService creates a new CRM service instance
+func NewCRMService(
+	eventRepo repository.CRMEventRepository,
+	userRepo repository.UserRepository,
+	orgRepo repository.OrganizationRepository,
+	articleRepo repository.ArticleRepository,
+	connector crm.Connector,
+	cfg CRMServiceConfig,
+) *CRMService {
+	if eventRepo == nil {
+		panic("eventRepo cannot be nil")
+	}
+	if userRepo == nil {
+		panic("userRepo cannot be nil")
+	}
+	if orgRepo == nil {
+		panic("orgRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if connector == nil {
+		panic("connector cannot be nil")
+	}
+
+	cfg.SiteURL = strings.TrimRight(cfg.SiteURL, "/")
+
+	return &CRMService{
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+		orgRepo:     orgRepo,
+		articleRepo: articleRepo,
+		connector:   connector,
+		cfg:         cfg,
+	}
+}
+
+// RecordCTAClick queues a reader's click on an article's CTA
+// The reader's account supplies the contact; their organization, if any, is the company.
+// Repeat clicks on the same article within a day are not pushed again
+func (s *CRMService) RecordCTAClick(ctx context.Context, userID uuid.UUID, article *domain.Article, cta *domain.ArmorCTA, variantName string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	firstName, lastName := splitName(user.Name)
+	conversion := domain.CRMConversion{
+		Type:       domain.ConversionCTAClick,
+		Email:      strings.ToLower(user.Email),
+		FirstName:  firstName,
+		LastName:   lastName,
+		CTATitle:   cta.Title,
+		CTAURL:     cta.URL,
+		CTAVariant: variantName,
+		OccurredAt: time.Now(),
+	}
+	s.setArticle(&conversion, article)
+
+	recent, err := s.eventRepo.HasRecent(ctx, conversion.Email, conversion.Type, conversion.ArticleID, conversion.OccurredAt.Add(-crmClickDedupWindow))
+	if err != nil {
+		return err
+	}
+	if recent {
+		return nil
+	}
+
+	membership, err := s.orgRepo.GetMembership(ctx, userID)
+	if err == nil {
+		org, err := s.orgRepo.GetByID(ctx, membership.OrganizationID)
+		if err != nil {
+			return fmt.Errorf("failed to get organization: %w", err)
+		}
+		conversion.Company = org.Name
+	} else if !isNotFound(err) {
+		return fmt.Errorf("failed to get organization membership: %w", err)
+	}
+
+	return s.enqueue(ctx, &userID, conversion)
+}
+
+// SubmitLead queues a lead form submission
+func (s *CRMService) SubmitLead(ctx context.Context, input LeadInput) error {
+	conversion := domain.CRMConversion{
+		Type:       domain.ConversionLeadForm,
+		Email:      strings.ToLower(strings.TrimSpace(input.Email)),
+		FirstName:  strings.TrimSpace(input.FirstName),
+		LastName:   strings.TrimSpace(input.LastName),
+		Company:    strings.TrimSpace(input.Company),
+		JobTitle:   strings.TrimSpace(input.JobTitle),
+		Phone:      strings.TrimSpace(input.Phone),
+		Message:    strings.TrimSpace(input.Message),
+		OccurredAt: time.Now(),
+	}
+
+	if err := conversion.Validate(); err != nil {
+		return &domainerrors.ValidationError{Field: "lead", Message: err.Error()}
+	}
+
+	if input.ArticleID != nil {
+		article, err := s.articleRepo.GetByID(ctx, *input.ArticleID)
+		if err != nil {
+			if isNotFound(err) {
+				return &domainerrors.ValidationError{Field: "article_id", Message: "article not found"}
+			}
+			return fmt.Errorf("failed to get article: %w", err)
+		}
+		if !article.IsPublished {
+			return &domainerrors.ValidationError{Field: "article_id", Message: "article not found"}
+		}
+		s.setArticle(&conversion, article)
+	}
+
+	return s.enqueue(ctx, nil, conversion)
+}
+
+// ListEvents returns queued, delivered and failed events matching the filter
+func (s *CRMService) ListEvents(ctx context.Context, filter *domain.CRMEventFilter) ([]*domain.CRMEvent, int, error) {
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, 0, &domainerrors.ValidationError{Field: "status", Message: "status must be pending, delivered or failed"}
+	}
+
+	if filter.Type != nil && !filter.Type.IsValid() {
+		return nil, 0, &domainerrors.ValidationError{Field: "type", Message: "type must be cta_click or lead_form"}
+	}
+
+	return s.eventRepo.List(ctx, filter)
+}
+
+// Retry queues a failed event for delivery again
+func (s *CRMService) Retry(ctx context.Context, id uuid.UUID) (*domain.CRMEvent, error) {
+	return s.eventRepo.Retry(ctx, id)
+}
+
+// RetryFailed queues every failed event for delivery again and returns how many there were
+func (s *CRMService) RetryFailed(ctx context.Context) (int64, error) {
+	return s.eventRepo.RetryFailed(ctx)
+}
+
+// Deliver pushes one batch of due events and returns how many were delivered and how many failed
+func (s *CRMService) Deliver(ctx context.Context) (delivered, failed int, err error) {
+	events, err := s.eventRepo.ClaimDue(ctx, s.cfg.BatchSize, time.Now().Add(crmDeliveryLease))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, event := range events {
+		if ctx.Err() != nil {
+			// Unpushed claims expire and are picked up again
+			return delivered, failed, ctx.Err()
+		}
+
+		pushCtx, cancel := context.WithTimeout(ctx, crmPushTimeout)
+		pushErr := s.connector.Push(pushCtx, &event.Conversion)
+		cancel()
+
+		if pushErr == nil {
+			if err := s.eventRepo.MarkDelivered(ctx, event.ID, time.Now()); err != nil {
+				return delivered, failed, err
+			}
+			delivered++
+			continue
+		}
+
+		failed++
+		status, err := s.eventRepo.RecordFailure(ctx, event.ID, pushErr.Error(), isPermanent(pushErr), s.cfg.MaxAttempts)
+		if err != nil {
+			return delivered, failed, err
+		}
+
+		logEvent := log.Warn()
+		if status == domain.CRMEventFailed {
+			logEvent = log.Error()
+		}
+		logEvent.
+			Err(pushErr).
+			Str("event_id", event.ID.String()).
+			Str("connector", event.Connector).
+			Str("status", string(status)).
+			Msg("Failed to push CRM event")
+	}
+
+	return delivered, failed, nil
+}
+
+// Run pushes due events on every interval until the context is cancelled
+func (s *CRMService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delivered, failed, err := s.Deliver(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to push CRM events")
+			}
+			if delivered > 0 || failed > 0 {
+				log.Debug().Int("delivered", delivered).Int("failed", failed).Msg("Pushed CRM events")
+			}
+		}
+	}
+}
+
+// enqueue queues a conversion for the configured connector
+func (s *CRMService) enqueue(ctx context.Context, userID *uuid.UUID, conversion domain.CRMConversion) error {
+	now := time.Now()
+	return s.eventRepo.Enqueue(ctx, &domain.CRMEvent{
+		ID:            uuid.New(),
+		Connector:     s.connector.Name(),
+		Conversion:    conversion,
+		UserID:        userID,
+		Status:        domain.CRMEventPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+}
+
+// setArticle records the article a conversion happened on
+func (s *CRMService) setArticle(conversion *domain.CRMConversion, article *domain.Article) {
+	id := article.ID
+	conversion.ArticleID = &id
+	conversion.ArticleTitle = article.Title
+	if s.cfg.SiteURL != "" {
+		conversion.ArticleURL = s.cfg.SiteURL + "/threats/" + article.ID.String()
+	}
+}
+
+// splitName splits a full name into a first name and the rest
+func splitName(name string) (first, last string) {
+	first, last, _ = strings.Cut(strings.TrimSpace(name), " ")
+	return first, strings.TrimSpace(last)
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultCTAReportDays and MaxCTAReportDays bound the variant report window
+	DefaultCTAReportDays = 30
+	MaxCTAReportDays     = 365
+
+	// ctaVariantCacheTTL is how long active variants are cached before they are reloaded
+	ctaVariantCacheTTL = time.Minute
+)
+
+// CTAExperimentService runs A/B tests on the Armor CTAs shown with articles
+// A reader is assigned a variant by hashing their ID with the CTA's URL, so they keep seeing
+// the same copy while the split across readers follows the variant weights
+type CTAExperimentService struct {
+	variantRepo repository.CTAVariantRepository
+	articleRepo repository.ArticleRepository
+	auditRepo   repository.AuditLogRepository
+	crm         *CRMService // nil when no CRM is configured
+
+	mu     sync.RWMutex
+	loaded map[string]*ctaVariantCache // by tenant scope, since each workspace sees its own variants
+}
+
+// ctaVariantCache holds a tenant scope's active variants by CTA URL
+type ctaVariantCache struct {
+	byBaseURL map[string][]*domain.CTAVariant
+	loadedAt  time.Time
+}
+
+// CTAVariantInput holds the editable fields of a CTA variant
+type CTAVariantInput struct {
+	BaseURL  string `json:"base_url"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	Weight   int    `json:"weight"`              // defaults to 1
+	IsActive *bool  `json:"is_active,omitempty"` // defaults to true on create, unchanged on update
+}
+
+// NewCTAExperimentService creates a new CTA experiment service instance
+func NewCTAExperimentService(
+	variantRepo repository.CTAVariantRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+) *CTAExperimentService {
+	if variantRepo == nil {
+		panic("variantRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &CTAExperimentService{
+		variantRepo: variantRepo,
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// SetCRMService pushes CTA clicks to the CRM
+func (s *CTAExperimentService) SetCRMService(crm *CRMService) {
+	s.crm = crm
+}
+
+// Serve returns the CTA to show the reader: an assigned variant if the CTA is under test,
+// otherwise the CTA unchanged. Served variants are counted as impressions
+func (s *CTAExperimentService) Serve(ctx context.Context, cta *domain.ArmorCTA, readerID string) *domain.ArmorCTA {
+	if cta == nil {
+		return nil
+	}
+
+	variants := s.activeFor(ctx, cta.URL)
+	if len(variants) == 0 {
+		return cta
+	}
+
+	variant := assignCTAVariant(variants, readerID+"|"+cta.URL)
+
+	// Count the impression without holding up the response
+	requestctx.Go(ctx, func(ctx context.Context) {
+		if err := s.variantRepo.RecordImpression(ctx, variant.ID); err != nil {
+			log.Error().
+				Err(err).
+				Str("request_id", requestctx.RequestID(ctx)).
+				Str("variant_id", variant.ID.String()).
+				Msg("Failed to record CTA impression")
+		}
+	})
+
+	return variant.CTA()
+}
+
+// Click records a reader's click on the CTA shown with the article
+// variantID is the served variant, or uuid.Nil when the CTA is not under test; a variant click
+// counts toward its click-through rate. When a CRM is configured the click is also pushed to it
+func (s *CTAExperimentService) Click(ctx context.Context, articleID, variantID, userID uuid.UUID) error {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       articleID.String(),
+			}
+		}
+		return fmt.Errorf("failed to get article: %w", err)
+	}
+
+	if !article.IsPublished {
+		return &domainerrors.NotFoundError{
+			Resource: "article",
+			ID:       articleID.String(),
+		}
+	}
+
+	if article.ArmorCTA == nil {
+		return &domainerrors.ValidationError{
+			Field:   "article",
+			Message: "the article has no CTA",
+		}
+	}
+
+	cta := article.ArmorCTA
+	var variantName string
+
+	if variantID != uuid.Nil {
+		variant, err := s.variantRepo.GetByID(ctx, variantID)
+		if err != nil {
+			return err
+		}
+
+		if article.ArmorCTA.URL != variant.BaseURL {
+			return &domainerrors.ValidationError{
+				Field:   "variant_id",
+				Message: "the variant is not shown with this article",
+			}
+		}
+
+		if err := s.variantRepo.RecordClick(ctx, variantID); err != nil {
+			return err
+		}
+
+		cta = variant.CTA()
+		variantName = variant.Name
+	}
+
+	if s.crm != nil {
+		// The click is already counted; a CRM queue failure is logged rather than returned
+		if err := s.crm.RecordCTAClick(ctx, userID, article, cta, variantName); err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", articleID.String()).
+				Msg("Failed to queue CTA click for the CRM")
+		}
+	}
+
+	return nil
+}
+
+// List returns every variant, active or not
+func (s *CTAExperimentService) List(ctx context.Context) ([]*domain.CTAVariant, error) {
+	return s.variantRepo.List(ctx, false)
+}
+
+// Report returns each variant's impressions, clicks and click-through rate over the last days
+func (s *CTAExperimentService) Report(ctx context.Context, days int) ([]*domain.CTAVariantStats, error) {
+	since := time.Now().UTC().AddDate(0, 0, -(days - 1))
+	return s.variantRepo.Stats(ctx, since)
+}
+
+// Create adds a variant for the CTA with the input's base URL
+func (s *CTAExperimentService) Create(ctx context.Context, input CTAVariantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.CTAVariant, error) {
+	variant := &domain.CTAVariant{IsActive: true, CreatedBy: actorID}
+	applyCTAVariantInput(variant, input)
+
+	if err := variant.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "variant", Message: err.Error()}
+	}
+
+	if err := s.variantRepo.Create(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	s.audit(ctx, actorID, domain.AuditActionCTAVariantCreated, variant.ID, nil, variant, ipAddress, userAgent)
+
+	return variant, nil
+}
+
+// Update replaces a variant's copy, URLs and weight; existing statistics are kept
+func (s *CTAExperimentService) Update(ctx context.Context, id uuid.UUID, input CTAVariantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.CTAVariant, error) {
+	variant, err := s.variantRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *variant
+	applyCTAVariantInput(variant, input)
+
+	if err := variant.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "variant", Message: err.Error()}
+	}
+
+	if err := s.variantRepo.Update(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	s.audit(ctx, actorID, domain.AuditActionCTAVariantUpdated, variant.ID, &previous, variant, ipAddress, userAgent)
+
+	return variant, nil
+}
+
+// Delete removes a variant and its statistics
+func (s *CTAExperimentService) Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error {
+	variant, err := s.variantRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.variantRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidate()
+	s.audit(ctx, actorID, domain.AuditActionCTAVariantDeleted, id, variant, nil, ipAddress, userAgent)
+
+	return nil
+}
+
+// activeFor returns the active variants for a CTA URL, reloading the cache once it expires
+// If reloading fails the previous variants are kept so articles still render
+func (s *CTAExperimentService) activeFor(ctx context.Context, baseURL string) []*domain.CTAVariant {
+	scope := tenantCacheScope(ctx)
+
+	s.mu.RLock()
+	cached := s.loaded[scope]
+	s.mu.RUnlock()
+
+	var variants []*domain.CTAVariant
+	if cached != nil {
+		variants = cached.byBaseURL[baseURL]
+	}
+
+	if cached != nil && time.Since(cached.loadedAt) < ctaVariantCacheTTL {
+		return variants
+	}
+
+	all, err := s.variantRepo.List(ctx, true)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load CTA variants")
+		return variants
+	}
+
+	byBaseURL := make(map[string][]*domain.CTAVariant)
+	for _, variant := range all {
+		byBaseURL[variant.BaseURL] = append(byBaseURL[variant.BaseURL], variant)
+	}
+
+	s.mu.Lock()
+	if s.loaded == nil {
+		s.loaded = make(map[string]*ctaVariantCache)
+	}
+	s.loaded[scope] = &ctaVariantCache{byBaseURL: byBaseURL, loadedAt: time.Now()}
+	s.mu.Unlock()
+
+	return byBaseURL[baseURL]
+}
+
+// invalidate forces the next Serve to reload active variants
+func (s *CTAExperimentService) invalidate() {
+	s.mu.Lock()
+	s.loaded = nil
+	s.mu.Unlock()
+}
+
+// audit records a variant change; failures are logged and do not fail the operation
+func (s *CTAExperimentService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	action string,
+	variantID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, action, "cta_variant", &variantID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("variant_id", variantID.String()).
+			Str("action", action).
+			Msg("Failed to write CTA variant audit log")
+	}
+}
+
+// applyCTAVariantInput copies the input onto the variant
+func applyCTAVariantInput(variant *domain.CTAVariant, input CTAVariantInput) {
+	variant.BaseURL = strings.TrimSpace(input.BaseURL)
+	variant.Name = input.Name
+	variant.Type = input.Type
+	variant.Title = input.Title
+	variant.URL = strings.TrimSpace(input.URL)
+
+	variant.Weight = input.Weight
+	if variant.Weight == 0 {
+		variant.Weight = 1
+	}
+
+	if input.IsActive != nil {
+		variant.IsActive = *input.IsActive
+	}
+}
+
+// assignCTAVariant picks a variant for the key in proportion to the variants' weights
+func assignCTAVariant(variants []*domain.CTAVariant, key string) *domain.CTAVariant {
+	total := 0
+	for _, variant := range variants {
+		total += variant.Weight
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	bucket := int(h.Sum32() % uint32(total))
+
+	for _, variant := range variants {
+		if bucket < variant.Weight {
+			return variant
+		}
+		bucket -= variant.Weight
+	}
+
+	return variants[len(variants)-1]
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/simhash"
+)
+
+// Deduplication defaults
+const (
+	// DefaultDuplicateMaxDistance is the largest SimHash Hamming distance treated as a near-duplicate
+	DefaultDuplicateMaxDistance = 6
+
+	// DefaultDuplicateWindow bounds how far back candidates are compared; syndication happens within days
+	DefaultDuplicateWindow = 14 * 24 * time.Hour
+)
+
+// DeduplicationService clusters near-duplicate articles so syndicated advisories appear once in the feed
+type DeduplicationService struct {
+	fingerprintRepo repository.ArticleFingerprintRepository
+	articleRepo     repository.ArticleRepository
+	maxDistance     int
+	window          time.Duration
+}
+
+// NewDeduplicationService creates a new deduplication service instance
+// Non-positive maxDistance or window values fall back to the defaults
+func NewDeduplicationService(
+	fingerprintRepo repository.ArticleFingerprintRepository,
+	articleRepo repository.ArticleRepository,
+	maxDistance int,
+	window time.Duration,
+) *DeduplicationService {
+	if fingerprintRepo == nil {
+		panic("fingerprintRepo cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	if maxDistance <= 0 {
+		maxDistance = DefaultDuplicateMaxDistance
+	}
+
+	if window <= 0 {
+		window = DefaultDuplicateWindow
+	}
+
+	return &DeduplicationService{
+		fingerprintRepo: fingerprintRepo,
+		articleRepo:     articleRepo,
+		maxDistance:     maxDistance,
+		window:          window,
+	}
+}
+
+// Fingerprint computes an article's fingerprint and assigns it to the closest recent cluster
+// An article with no near-duplicate becomes the canonical article of a new cluster
+func (s *DeduplicationService) Fingerprint(ctx context.Context, article *domain.Article) (*domain.ArticleFingerprint, error) {
+	if article == nil {
+		return nil, fmt.Errorf("article cannot be nil")
+	}
+
+	fingerprint := &domain.ArticleFingerprint{
+		ArticleID:   article.ID,
+		Simhash:     simhash.Compute(article.Title + "\n" + article.Content),
+		CanonicalID: article.ID,
+		CreatedAt:   time.Now(),
+	}
+
+	candidates, err := s.fingerprintRepo.ListSince(ctx, fingerprint.CreatedAt.Add(-s.window))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate candidates: %w", err)
+	}
+
+	// Match against the closest candidate; ties go to the oldest since candidates are ordered by age
+	bestDistance := s.maxDistance + 1
+	for _, candidate := range candidates {
+		if candidate.ArticleID == article.ID {
+			continue
+		}
+
+		distance := simhash.Distance(fingerprint.Simhash, candidate.Simhash)
+		if distance < bestDistance {
+			bestDistance = distance
+			fingerprint.CanonicalID = candidate.CanonicalID
+			fingerprint.Distance = distance
+		}
+	}
+
+	if err := s.fingerprintRepo.Create(ctx, fingerprint); err != nil {
+		return nil, err
+	}
+
+	if !fingerprint.IsCanonical() {
+		log.Info().
+			Str("article_id", article.ID.String()).
+			Str("canonical_id", fingerprint.CanonicalID.String()).
+			Int("distance", fingerprint.Distance).
+			Msg("Near-duplicate article detected")
+	}
+
+	return fingerprint, nil
+}
+
+// GetDuplicates returns the canonical ID and the other articles in an article's duplicate cluster
+func (s *DeduplicationService) GetDuplicates(ctx context.Context, articleID uuid.UUID) (uuid.UUID, []*domain.Article, error) {
+	fingerprint, err := s.fingerprintRepo.GetByArticleID(ctx, articleID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	members, err := s.fingerprintRepo.ListByCanonical(ctx, fingerprint.CanonicalID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	duplicates := make([]*domain.Article, 0, len(members))
+	for _, member := range members {
+		if member.ArticleID == articleID {
+			continue
+		}
+
+		article, err := s.articleRepo.GetByID(ctx, member.ArticleID)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("failed to get duplicate article: %w", err)
+		}
+		duplicates = append(duplicates, article)
+	}
+
+	return fingerprint.CanonicalID, duplicates, nil
+}
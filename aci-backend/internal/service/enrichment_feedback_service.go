@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/ioc"
+)
+
+const (
+	// DefaultEnrichmentAccuracyWeeks is the accuracy report window when none is requested
+	DefaultEnrichmentAccuracyWeeks = 12
+
+	// MaxEnrichmentAccuracyWeeks bounds the accuracy report window
+	MaxEnrichmentAccuracyWeeks = 104
+)
+
+// EnrichmentFeedbackService records analysts' flags on wrong AI enrichment output
+// A flagged IOC is left out of IOC exports from then on; flags on every field feed a weekly
+// accuracy report, so the effect of a prompt or model change shows up in the weeks after it
+type EnrichmentFeedbackService struct {
+	feedbackRepo repository.EnrichmentFeedbackRepository
+	articleRepo  repository.ArticleRepository
+	auditRepo    repository.AuditLogRepository
+}
+
+// NewEnrichmentFeedbackService creates a new enrichment feedback service instance
+func NewEnrichmentFeedbackService(
+	feedbackRepo repository.EnrichmentFeedbackRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+) *EnrichmentFeedbackService {
+	if feedbackRepo == nil {
+		panic("feedbackRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &EnrichmentFeedbackService{
+		feedbackRepo: feedbackRepo,
+		articleRepo:  articleRepo,
+		auditRepo:    auditRepo,
+	}
+}
+
+// EnrichmentFeedbackInput is a flag on one field of an article's enrichment
+type EnrichmentFeedbackInput struct {
+	Field    domain.EnrichmentField
+	IOCType  *string // required for ioc feedback
+	IOCValue *string // required for ioc feedback; may be defanged
+	Comment  *string
+}
+
+// Flag records that a field of an article's current enrichment is wrong
+// Only analysts and admins give feedback; an IOC must be one of the article's indicators
+func (s *EnrichmentFeedbackService) Flag(
+	ctx context.Context,
+	userID uuid.UUID,
+	role domain.UserRole,
+	articleID uuid.UUID,
+	input EnrichmentFeedbackInput,
+	ipAddress, userAgent string,
+) (*domain.EnrichmentFeedback, error) {
+	if !role.CanAnnotate() {
+		return nil, domainerrors.ErrForbidden
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.EnrichedAt == nil {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article_id",
+			Message: "article has not been enriched",
+		}
+	}
+
+	feedback := &domain.EnrichmentFeedback{
+		ID:         uuid.New(),
+		ArticleID:  article.ID,
+		Field:      input.Field,
+		IOCType:    trimOptional(input.IOCType),
+		IOCValue:   trimOptional(input.IOCValue),
+		Comment:    trimOptional(input.Comment),
+		EnrichedAt: *article.EnrichedAt,
+		CreatedBy:  userID,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := feedback.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "feedback", Message: err.Error()}
+	}
+
+	if feedback.Field == domain.EnrichmentFieldIOC {
+		value, ok := articleIOC(article, *feedback.IOCType, *feedback.IOCValue)
+		if !ok {
+			return nil, &domainerrors.ValidationError{
+				Field:   "ioc_value",
+				Message: "the article has no such indicator",
+			}
+		}
+		feedback.IOCValue = &value
+	}
+
+	if err := s.feedbackRepo.Create(ctx, feedback); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, userID, domain.AuditActionEnrichmentFlagged, article.ID, nil, feedback, ipAddress, userAgent)
+
+	return feedback, nil
+}
+
+// List returns feedback matching the filter, newest first
+func (s *EnrichmentFeedbackService) List(ctx context.Context, filter *domain.EnrichmentFeedbackFilter) ([]*domain.EnrichmentFeedback, int, error) {
+	if filter == nil {
+		filter = &domain.EnrichmentFeedbackFilter{Page: 1, PageSize: 20}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	return s.feedbackRepo.List(ctx, filter)
+}
+
+// Delete withdraws feedback given by mistake; a withdrawn IOC flag makes the indicator exportable again
+func (s *EnrichmentFeedbackService) Delete(ctx context.Context, id, actorID uuid.UUID, ipAddress, userAgent string) error {
+	feedback, err := s.feedbackRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.feedbackRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.audit(ctx, actorID, domain.AuditActionEnrichmentUnflagged, feedback.ArticleID, feedback, nil, ipAddress, userAgent)
+
+	return nil
+}
+
+// Accuracy reports enriched and flagged articles for each of the last weeks UTC weeks, the
+// current one included. Accuracy is the share of enriched articles nobody flagged
+func (s *EnrichmentFeedbackService) Accuracy(ctx context.Context, weeks int) (*domain.EnrichmentAccuracyReport, error) {
+	if weeks < 1 || weeks > MaxEnrichmentAccuracyWeeks {
+		return nil, &domainerrors.ValidationError{
+			Field:   "weeks",
+			Message: "weeks must be between 1 and 104",
+		}
+	}
+
+	now := time.Now().UTC()
+	since := startOfWeek(now).AddDate(0, 0, -7*(weeks-1))
+
+	report := &domain.EnrichmentAccuracyReport{GeneratedAt: now}
+
+	var err error
+	report.Weeks, err = s.feedbackRepo.WeeklyAccuracy(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, week := range report.Weeks {
+		week.Accuracy = accuracy(week.Enriched, week.FlaggedArticles)
+		report.Enriched += week.Enriched
+		report.FlaggedArticles += week.FlaggedArticles
+	}
+	report.Accuracy = accuracy(report.Enriched, report.FlaggedArticles)
+
+	return report, nil
+}
+
+// audit records a feedback change; failures are logged and do not fail the request
+func (s *EnrichmentFeedbackService) audit(
+	ctx context.Context,
+	actorID uuid.UUID,
+	action string,
+	articleID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(&actorID, action, "article", &articleID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Str("action", action).
+			Msg("Failed to write enrichment feedback audit log")
+	}
+}
+
+// articleIOC finds an indicator among the article's IOCs and returns its stored value
+// The value is refanged, and compared without case except for URLs, whose paths keep theirs
+func articleIOC(article *domain.Article, iocType, value string) (string, bool) {
+	value = ioc.Refang(value)
+
+	for _, candidate := range article.IOCs {
+		if candidate.Type != iocType {
+			continue
+		}
+
+		if candidate.Value == value || (iocType != ioc.TypeURL && strings.EqualFold(candidate.Value, value)) {
+			return candidate.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// accuracy is the share of enriched articles that were not flagged; a week whose flagged
+// articles have since been re-enriched into a later week can have more flags than enrichments.
+// It is 0 when nothing was enriched
+func accuracy(enriched, flagged int) float64 {
+	if enriched == 0 || flagged >= enriched {
+		return 0
+	}
+	return float64(enriched-flagged) / float64(enriched)
+}
+
+// startOfWeek returns Monday 00:00 of t's week, matching Postgres date_trunc('week')
+func startOfWeek(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
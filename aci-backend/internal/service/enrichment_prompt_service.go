@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// DefaultEnrichmentPromptTrialArticles is the trial sample size when none is requested
+const DefaultEnrichmentPromptTrialArticles = 5
+
+// EnrichmentPromptService manages the enricher's versioned system prompts
+// Every edit creates a new draft version. A draft can be tried on a sample of articles, which
+// runs the AI without saving anything, and is used for enrichment once activated. A key with no
+// active version uses the prompt built into the enricher
+type EnrichmentPromptService struct {
+	promptRepo  repository.EnrichmentPromptRepository
+	articleRepo repository.ArticleRepository
+	auditRepo   repository.AuditLogRepository
+	enricher    *ai.Enricher
+}
+
+// NewEnrichmentPromptService creates a new enrichment prompt service instance
+func NewEnrichmentPromptService(
+	promptRepo repository.EnrichmentPromptRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+	enricher *ai.Enricher,
+) *EnrichmentPromptService {
+	if promptRepo == nil {
+		panic("promptRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+	if enricher == nil {
+		panic("enricher cannot be nil")
+	}
+
+	return &EnrichmentPromptService{
+		promptRepo:  promptRepo,
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+		enricher:    enricher,
+	}
+}
+
+// EnrichmentPromptInput holds the editable fields of a prompt version
+type EnrichmentPromptInput struct {
+	SystemPrompt string
+	Model        *string // nil runs on the configured AI model
+	Notes        *string
+}
+
+// EnrichmentPromptTrial is the output of a prompt version on a sample of articles
+type EnrichmentPromptTrial struct {
+	Prompt  *domain.EnrichmentPrompt       `json:"prompt"`
+	Results []*EnrichmentPromptTrialResult `json:"results"`
+}
+
+// EnrichmentPromptTrialResult sets an article's stored enrichment beside the trial output
+// Current and Trial hold a threat analysis, or an Armor CTA for the armor_cta prompt
+type EnrichmentPromptTrialResult struct {
+	ArticleID uuid.UUID   `json:"article_id"`
+	Title     string      `json:"title"`
+	Current   interface{} `json:"current,omitempty"`
+	Trial     interface{} `json:"trial,omitempty"`
+	Error     string      `json:"error,omitempty"` // the trial failed for this article
+}
+
+// Prompt returns the active prompt for key, implementing ai.PromptSource
+// Lookup failures are logged and fall back to the built-in prompt, so enrichment keeps running
+func (s *EnrichmentPromptService) Prompt(ctx context.Context, key domain.EnrichmentPromptKey) (ai.Prompt, bool) {
+	active, err := s.promptRepo.GetActive(ctx, key)
+	if err != nil {
+		var notFound *domainerrors.NotFoundError
+		if !errors.As(err, &notFound) {
+			log.Warn().
+				Err(err).
+				Str("prompt_key", string(key)).
+				Msg("Failed to load active enrichment prompt, using built-in prompt")
+		}
+		return ai.Prompt{}, false
+	}
+
+	return toAIPrompt(active), true
+}
+
+// Create stores a new draft version of the prompt for key
+func (s *EnrichmentPromptService) Create(ctx context.Context, key domain.EnrichmentPromptKey, input EnrichmentPromptInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error) {
+	prompt := &domain.EnrichmentPrompt{
+		ID:           uuid.New(),
+		Key:          key,
+		SystemPrompt: input.SystemPrompt,
+		Model:        trimOptional(input.Model),
+		Notes:        trimOptional(input.Notes),
+		CreatedBy:    actorID,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := prompt.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "prompt", Message: err.Error()}
+	}
+
+	if err := s.promptRepo.CreateVersion(ctx, prompt); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("prompt_key", string(prompt.Key)).
+		Int("version", prompt.Version).
+		Msg("Enrichment prompt draft created")
+
+	s.audit(ctx, actorID, domain.AuditActionEnrichmentPromptCreated, prompt.ID, nil, prompt, ipAddress, userAgent)
+
+	return prompt, nil
+}
+
+// Update stores an edit of a version as a new draft of the same key
+func (s *EnrichmentPromptService) Update(ctx context.Context, id uuid.UUID, input EnrichmentPromptInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error) {
+	base, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Create(ctx, base.Key, input, actorID, ipAddress, userAgent)
+}
+
+// GetByID retrieves a prompt version by ID
+func (s *EnrichmentPromptService) GetByID(ctx context.Context, id uuid.UUID) (*domain.EnrichmentPrompt, error) {
+	return s.promptRepo.GetByID(ctx, id)
+}
+
+// List returns the active and latest version of every prompt key, with its built-in prompt
+func (s *EnrichmentPromptService) List(ctx context.Context) ([]*domain.EnrichmentPromptOverview, error) {
+	prompts, err := s.promptRepo.ListCurrent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrichment prompts: %w", err)
+	}
+
+	overviews := make([]*domain.EnrichmentPromptOverview, 0, len(domain.EnrichmentPromptKeys))
+	for _, key := range domain.EnrichmentPromptKeys {
+		builtIn, _ := ai.BuiltInPrompt(key)
+		overview := &domain.EnrichmentPromptOverview{Key: key, BuiltInPrompt: builtIn.SystemPrompt}
+
+		// Versions arrive newest first within a key
+		for _, prompt := range prompts {
+			if prompt.Key != key {
+				continue
+			}
+			if overview.Latest == nil {
+				overview.Latest = prompt
+			}
+			if prompt.IsActive {
+				overview.Active = prompt
+			}
+		}
+
+		overviews = append(overviews, overview)
+	}
+
+	return overviews, nil
+}
+
+// ListVersions retrieves every version of the key the given version belongs to
+func (s *EnrichmentPromptService) ListVersions(ctx context.Context, id uuid.UUID) ([]*domain.EnrichmentPrompt, error) {
+	prompt, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.promptRepo.ListVersions(ctx, prompt.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrichment prompt versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// Activate makes a version the one enrichment uses, e.g. a tried draft or an older version to roll back to
+func (s *EnrichmentPromptService) Activate(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.EnrichmentPrompt, error) {
+	previous, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.promptRepo.Activate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	activated, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("prompt_key", string(activated.Key)).
+		Int("version", activated.Version).
+		Msg("Enrichment prompt activated")
+
+	s.audit(ctx, actorID, domain.AuditActionEnrichmentPromptActivated, id, previous, activated, ipAddress, userAgent)
+
+	return activated, nil
+}
+
+// Delete removes a draft that was never active; versions that were used are kept as history
+func (s *EnrichmentPromptService) Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error {
+	prompt, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if prompt.ActivatedAt != nil {
+		return &domainerrors.ValidationError{
+			Field:   "id",
+			Message: "only drafts that were never active can be deleted",
+		}
+	}
+
+	if err := s.promptRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.audit(ctx, actorID, domain.AuditActionEnrichmentPromptDeleted, id, prompt, nil, ipAddress, userAgent)
+
+	return nil
+}
+
+// Trial runs a prompt version on sample articles without saving the output
+// The sample is the given articles, or the sampleSize most recently published enriched articles.
+// Trials call the AI and count toward AI usage like any other enrichment
+func (s *EnrichmentPromptService) Trial(ctx context.Context, id uuid.UUID, articleIDs []uuid.UUID, sampleSize int) (*EnrichmentPromptTrial, error) {
+	if !s.enricher.Enabled() {
+		return nil, ErrEnrichmentDisabled
+	}
+
+	prompt, err := s.promptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.trialArticles(ctx, articleIDs, sampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	trial := &EnrichmentPromptTrial{
+		Prompt:  prompt,
+		Results: make([]*EnrichmentPromptTrialResult, len(articles)),
+	}
+	for i, article := range articles {
+		trial.Results[i] = &EnrichmentPromptTrialResult{ArticleID: article.ID, Title: article.Title}
+	}
+
+	aiPrompt := toAIPrompt(prompt)
+
+	switch prompt.Key {
+	case domain.EnrichmentPromptBatchThreatAnalysis:
+		results, err := s.enricher.EnrichArticlesWithPrompt(ctx, articles, aiPrompt)
+		for i, result := range trial.Results {
+			result.Current = storedAnalysis(articles[i])
+			switch {
+			case err != nil:
+				result.Error = err.Error()
+			case results[i] == nil:
+				result.Error = "no valid analysis for this article in the batch response"
+			default:
+				result.Trial = results[i]
+			}
+		}
+
+	case domain.EnrichmentPromptArmorCTA:
+		for i, result := range trial.Results {
+			result.Current = articles[i].ArmorCTA
+			cta, err := s.enricher.GenerateArmorCTAWithPrompt(ctx, articles[i], aiPrompt)
+			if err != nil {
+				result.Error = err.Error()
+				continue
+			}
+			result.Trial = cta
+		}
+
+	default:
+		for i, result := range trial.Results {
+			result.Current = storedAnalysis(articles[i])
+			analysis, err := s.enricher.EnrichArticleWithPrompt(ctx, articles[i], aiPrompt)
+			if err != nil {
+				result.Error = err.Error()
+				continue
+			}
+			result.Trial = analysis
+		}
+	}
+
+	return trial, nil
+}
+
+// trialArticles loads the requested articles, or picks the most recently published enriched ones
+func (s *EnrichmentPromptService) trialArticles(ctx context.Context, articleIDs []uuid.UUID, sampleSize int) ([]*domain.Article, error) {
+	if len(articleIDs) > domain.MaxEnrichmentPromptTrialArticles {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article_ids",
+			Message: fmt.Sprintf("cannot try a prompt on more than %d articles", domain.MaxEnrichmentPromptTrialArticles),
+		}
+	}
+
+	if len(articleIDs) == 0 {
+		if sampleSize == 0 {
+			sampleSize = DefaultEnrichmentPromptTrialArticles
+		}
+		if sampleSize < 1 || sampleSize > domain.MaxEnrichmentPromptTrialArticles {
+			return nil, &domainerrors.ValidationError{
+				Field:   "sample_size",
+				Message: fmt.Sprintf("sample_size must be between 1 and %d", domain.MaxEnrichmentPromptTrialArticles),
+			}
+		}
+
+		enriched := true
+		sample, _, err := s.articleRepo.List(ctx, &domain.ArticleFilter{
+			IsEnriched: &enriched,
+			Page:       1,
+			PageSize:   sampleSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to pick trial articles: %w", err)
+		}
+
+		for _, article := range sample {
+			articleIDs = append(articleIDs, article.ID)
+		}
+	}
+
+	byID, err := loadArticles(ctx, s.articleRepo, articleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trial articles: %w", err)
+	}
+
+	articles := make([]*domain.Article, 0, len(articleIDs))
+	seen := make(map[uuid.UUID]bool, len(articleIDs))
+	for _, id := range articleIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		article, ok := byID[id]
+		if !ok {
+			return nil, &domainerrors.NotFoundError{Resource: "article", ID: id.String()}
+		}
+		if strings.TrimSpace(article.Content) == "" {
+			return nil, &domainerrors.ValidationError{
+				Field:   "article_ids",
+				Message: fmt.Sprintf("article %s has no content to analyze", id),
+			}
+		}
+		articles = append(articles, article)
+	}
+
+	if len(articles) == 0 {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article_ids",
+			Message: "there are no enriched articles to try the prompt on",
+		}
+	}
+
+	return articles, nil
+}
+
+// audit records a prompt change; failures are logged and do not fail the change
+func (s *EnrichmentPromptService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	action string,
+	promptID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, action, "enrichment_prompt", &promptID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("prompt_id", promptID.String()).
+			Str("action", action).
+			Msg("Failed to write enrichment prompt audit log")
+	}
+}
+
+// toAIPrompt converts a stored prompt version to the enricher's prompt
+func toAIPrompt(prompt *domain.EnrichmentPrompt) ai.Prompt {
+	return ai.Prompt{
+		SystemPrompt: prompt.SystemPrompt,
+		Model:        stringValue(prompt.Model),
+	}
+}
+
+// storedAnalysis returns the threat analysis an article was last enriched with, nil if none
+// Threat actors and confidence are not stored on the article, so they are left empty
+func storedAnalysis(article *domain.Article) *ai.EnrichmentResult {
+	if article.EnrichedAt == nil {
+		return nil
+	}
+
+	iocs := make([]ai.IOC, len(article.IOCs))
+	for i, ioc := range article.IOCs {
+		iocs[i] = ai.IOC{Type: ioc.Type, Value: ioc.Value, Context: ioc.Context}
+	}
+
+	return &ai.EnrichmentResult{
+		ThreatType:         stringValue(article.ThreatType),
+		AttackVector:       stringValue(article.AttackVector),
+		ImpactAssessment:   stringValue(article.ImpactAssessment),
+		RecommendedActions: article.RecommendedActions,
+		IOCs:               iocs,
+	}
+}
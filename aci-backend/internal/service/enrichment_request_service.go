@@ -0,0 +1,144 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// EnrichmentRequestedEvent is the event type of outbound enrichment requests
+	EnrichmentRequestedEvent = "enrichment.requested"
+
+	// enrichmentRequestTimeout bounds delivering one enrichment request
+	enrichmentRequestTimeout = 15 * time.Second
+)
+
+// EnrichmentRequestConfig configures the enrichment request service
+type EnrichmentRequestConfig struct {
+	WebhookURL  string // n8n webhook the requests are posted to
+	CallbackURL string // where the workflow posts its enrichment.complete event
+}
+
+// EnrichmentRequestService hands enrichment to an external pipeline while the AI provider is
+// disabled: each article is posted to an n8n webhook as an enrichment.requested event, and the
+// workflow returns its analysis as enrichment.complete. Requests are signed like inbound
+// webhooks, over "<timestamp>.<body>" with the primary webhook secret
+type EnrichmentRequestService struct {
+	secrets *WebhookSecretService
+	cfg     EnrichmentRequestConfig
+	client  *http.Client
+}
+
+// EnrichmentRequestPayload is the body of an enrichment.requested webhook
+type EnrichmentRequestPayload struct {
+	EventType string                `json:"event_type"`
+	Data      EnrichmentRequestData `json:"data"`
+	Metadata  EnrichmentRequestMeta `json:"metadata"`
+}
+
+// EnrichmentRequestData is the article to enrich and where to send the result
+type EnrichmentRequestData struct {
+	ArticleID   uuid.UUID       `json:"article_id"`
+	Title       string          `json:"title"`
+	Content     string          `json:"content"`
+	Summary     *string         `json:"summary,omitempty"`
+	Severity    domain.Severity `json:"severity"`
+	SourceURL   string          `json:"source_url"`
+	CVEs        []string        `json:"cves"`
+	Vendors     []string        `json:"vendors"`
+	PublishedAt time.Time       `json:"published_at"`
+	CallbackURL string          `json:"callback_url"`
+}
+
+// EnrichmentRequestMeta identifies the request
+type EnrichmentRequestMeta struct {
+	RequestID string    `json:"request_id"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// NewEnrichmentRequestService creates a new enrichment request service instance
+func NewEnrichmentRequestService(secrets *WebhookSecretService, cfg EnrichmentRequestConfig) *EnrichmentRequestService {
+	if secrets == nil {
+		panic("secrets cannot be nil")
+	}
+
+	if cfg.WebhookURL == "" {
+		panic("webhook url cannot be empty")
+	}
+
+	return &EnrichmentRequestService{
+		secrets: secrets,
+		cfg:     cfg,
+		client:  &http.Client{Timeout: enrichmentRequestTimeout},
+	}
+}
+
+// Request posts an enrichment.requested event for the article
+// A non-2xx response is an error; the article stays unenriched until the workflow calls back
+func (s *EnrichmentRequestService) Request(ctx context.Context, article *domain.Article) error {
+	if article == nil {
+		return fmt.Errorf("article cannot be nil")
+	}
+
+	now := time.Now().UTC()
+	body, err := json.Marshal(EnrichmentRequestPayload{
+		EventType: EnrichmentRequestedEvent,
+		Data: EnrichmentRequestData{
+			ArticleID:   article.ID,
+			Title:       article.Title,
+			Content:     article.Content,
+			Summary:     article.Summary,
+			Severity:    article.Severity,
+			SourceURL:   article.SourceURL,
+			CVEs:        article.CVEs,
+			Vendors:     article.Vendors,
+			PublishedAt: article.PublishedAt,
+			CallbackURL: s.cfg.CallbackURL,
+		},
+		Metadata: EnrichmentRequestMeta{
+			RequestID: uuid.New().String(),
+			SentAt:    now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode enrichment request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	keyVersion, signature, ok := s.secrets.Sign(ctx, append([]byte(timestamp+"."), body...))
+	if !ok {
+		return fmt.Errorf("the workspace has no webhook secret to sign enrichment requests with")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create enrichment request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-N8N-Signature", "sha256="+signature)
+	req.Header.Set("X-N8N-Timestamp", timestamp)
+	req.Header.Set("X-N8N-Key-Version", strconv.Itoa(keyVersion))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send enrichment request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("enrichment webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
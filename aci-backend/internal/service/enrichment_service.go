@@ -2,20 +2,47 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/phillipboles/aci-backend/internal/ai"
 	"github.com/phillipboles/aci-backend/internal/domain"
 	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/tracing"
 )
 
+// ErrEnrichmentPaused is returned for non-critical articles while the monthly AI budget is exceeded
+var ErrEnrichmentPaused = errors.New("enrichment paused: monthly AI budget exceeded")
+
+// ErrEnrichmentDisabled is returned when the AI provider is disabled (AI_PROVIDER=disabled)
+// Articles are left unenriched rather than filled with canned analysis
+var ErrEnrichmentDisabled = errors.New("enrichment disabled: no AI provider configured")
+
 // EnrichmentService handles AI enrichment of articles
 type EnrichmentService struct {
-	enricher    *ai.Enricher
-	articleRepo repository.ArticleRepository
+	enricher     *ai.Enricher
+	articleRepo  repository.ArticleRepository
+	iocExtractor *IOCExtractor
+	summarize    *SummarizeService
+	usage        *AIUsageService
+	iocs         *IOCService
+	actors       *ThreatActorService
+	siem         *SIEMService
+	batching     EnrichmentBatchConfig
+}
+
+// EnrichmentBatchConfig groups short articles into a single analysis call
+// MaxArticles of 1 or less disables batching; token counts are estimates (see ai.EstimateTokens)
+type EnrichmentBatchConfig struct {
+	MaxArticles      int // articles per call
+	MaxTokens        int // estimated prompt tokens per call
+	MaxArticleTokens int // longer articles are always analyzed on their own
 }
 
 // NewEnrichmentService creates a new enrichment service instance
@@ -29,17 +56,73 @@ func NewEnrichmentService(enricher *ai.Enricher, articleRepo repository.ArticleR
 	}
 
 	return &EnrichmentService{
-		enricher:    enricher,
-		articleRepo: articleRepo,
+		enricher:     enricher,
+		articleRepo:  articleRepo,
+		iocExtractor: NewIOCExtractor(),
 	}
 }
 
+// SetSummarizeService enables summary generation for enriched articles that have no summary
+func (s *EnrichmentService) SetSummarizeService(summarize *SummarizeService) {
+	s.summarize = summarize
+}
+
+// SetIOCService keeps the normalized IOC tables in sync with enriched articles
+func (s *EnrichmentService) SetIOCService(iocs *IOCService) {
+	s.iocs = iocs
+}
+
+// SetThreatActorService links enriched articles to the threat actors named by the enricher
+func (s *EnrichmentService) SetThreatActorService(actors *ThreatActorService) {
+	s.actors = actors
+}
+
+// SetSIEMService forwards enriched articles and their IOCs to the configured SIEM destinations
+func (s *EnrichmentService) SetSIEMService(siem *SIEMService) {
+	s.siem = siem
+}
+
+// SetBatching enables analyzing several short articles per AI call
+func (s *EnrichmentService) SetBatching(cfg EnrichmentBatchConfig) {
+	s.batching = cfg
+}
+
+// SetAIUsageService enables the monthly AI budget guardrail
+func (s *EnrichmentService) SetAIUsageService(usage *AIUsageService) {
+	s.usage = usage
+}
+
+// Enabled reports whether articles can be enriched; it is false while the AI provider is disabled
+func (s *EnrichmentService) Enabled() bool {
+	return s.enricher.Enabled()
+}
+
+// IsPaused reports whether enrichment of the article is paused by the AI budget
+// Critical articles are always enriched
+func (s *EnrichmentService) IsPaused(article *domain.Article) bool {
+	return s.usage != nil && article.Severity != domain.SeverityCritical && s.usage.BudgetExceeded()
+}
+
 // EnrichArticle enriches an article with AI analysis and saves to DB
 func (s *EnrichmentService) EnrichArticle(ctx context.Context, articleID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "EnrichmentService.EnrichArticle",
+		trace.WithAttributes(attribute.String("article.id", articleID.String())),
+	)
+	err := s.enrichArticle(ctx, articleID)
+	tracing.End(span, err)
+	return err
+}
+
+// enrichArticle runs the enrichment traced by EnrichArticle
+func (s *EnrichmentService) enrichArticle(ctx context.Context, articleID uuid.UUID) error {
 	if articleID == uuid.Nil {
 		return fmt.Errorf("article id is required")
 	}
 
+	if !s.Enabled() {
+		return ErrEnrichmentDisabled
+	}
+
 	// Retrieve the article
 	article, err := s.articleRepo.GetByID(ctx, articleID)
 	if err != nil {
@@ -56,42 +139,82 @@ func (s *EnrichmentService) EnrichArticle(ctx context.Context, articleID uuid.UU
 		return nil
 	}
 
+	if s.IsPaused(article) {
+		return ErrEnrichmentPaused
+	}
+
 	// Perform threat analysis
 	enrichmentResult, err := s.enricher.EnrichArticle(ctx, article)
 	if err != nil {
 		return fmt.Errorf("failed to enrich article: %w", err)
 	}
 
+	return s.applyEnrichment(ctx, article, enrichmentResult)
+}
+
+// ApplyExternalEnrichment stores a threat analysis produced outside the service, such as the
+// enrichment.complete answer of an n8n workflow, replacing any earlier enrichment of the article
+func (s *EnrichmentService) ApplyExternalEnrichment(ctx context.Context, articleID uuid.UUID, result *ai.EnrichmentResult) error {
+	if articleID == uuid.Nil {
+		return fmt.Errorf("article id is required")
+	}
+
+	if result == nil {
+		return fmt.Errorf("enrichment result is required")
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to get article: %w", err)
+	}
+
+	if article == nil {
+		return fmt.Errorf("article not found: %s", articleID)
+	}
+
+	return s.applyEnrichment(ctx, article, result)
+}
+
+// applyEnrichment stores a threat analysis on the article, adds its Armor CTA, and hands the
+// enriched article to the IOC, threat actor, SIEM and summary services. While the AI provider is
+// disabled the CTA and summary are left out rather than filled with canned text
+func (s *EnrichmentService) applyEnrichment(ctx context.Context, article *domain.Article, enrichmentResult *ai.EnrichmentResult) error {
+	articleID := article.ID
+
 	// Update article with enrichment data
 	article.ThreatType = &enrichmentResult.ThreatType
 	article.AttackVector = &enrichmentResult.AttackVector
 	article.ImpactAssessment = &enrichmentResult.ImpactAssessment
 	article.RecommendedActions = enrichmentResult.RecommendedActions
 
-	// Convert AI IOCs to domain IOCs
-	article.IOCs = make([]domain.IOC, len(enrichmentResult.IOCs))
+	// Merge AI IOCs into the deterministically extracted ones
+	aiIOCs := make([]domain.IOC, len(enrichmentResult.IOCs))
 	for i, ioc := range enrichmentResult.IOCs {
-		article.IOCs[i] = domain.IOC{
+		aiIOCs[i] = domain.IOC{
 			Type:    ioc.Type,
 			Value:   ioc.Value,
 			Context: ioc.Context,
+			Source:  domain.IOCSourceAI,
 		}
 	}
+	article.IOCs = s.iocExtractor.Merge(s.iocExtractor.Extract(article.Title, article.Content), aiIOCs)
 
 	// Generate Armor CTA
-	armorCTA, err := s.enricher.GenerateArmorCTA(ctx, article)
-	if err != nil {
-		// Log error but don't fail - CTA is optional
-		log.Printf("failed to generate armor cta for article %s: %v", articleID, err)
-	} else {
-		article.ArmorCTA = armorCTA
-
-		// Calculate armor relevance based on threat type and severity
-		article.ArmorRelevance = calculateArmorRelevance(
-			enrichmentResult.ThreatType,
-			article.Severity,
-			enrichmentResult.ConfidenceScore,
-		)
+	if s.Enabled() {
+		armorCTA, err := s.enricher.GenerateArmorCTA(ctx, article)
+		if err != nil {
+			// Log error but don't fail - CTA is optional
+			log.Printf("failed to generate armor cta for article %s: %v", articleID, err)
+		} else {
+			article.ArmorCTA = armorCTA
+
+			// Calculate armor relevance based on threat type and severity
+			article.ArmorRelevance = calculateArmorRelevance(
+				enrichmentResult.ThreatType,
+				article.Severity,
+				enrichmentResult.ConfidenceScore,
+			)
+		}
 	}
 
 	// Set enrichment timestamp
@@ -103,13 +226,140 @@ func (s *EnrichmentService) EnrichArticle(ctx context.Context, articleID uuid.UU
 		return fmt.Errorf("failed to update article: %w", err)
 	}
 
+	if s.iocs != nil {
+		s.iocs.SyncArticle(ctx, article)
+	}
+
+	if s.actors != nil {
+		s.actors.SyncEnrichment(ctx, article, enrichmentResult.ThreatActors)
+	}
+
+	if s.siem != nil {
+		s.siem.ForwardArticle(ctx, article)
+	}
+
+	// Generate summaries for articles ingested without one
+	if s.summarize != nil && s.Enabled() && article.Summary == nil {
+		if _, err := s.summarize.SummarizeArticle(ctx, article); err != nil {
+			// Log error but don't fail - summaries can be generated on demand
+			log.Printf("failed to summarize article %s: %v", articleID, err)
+		}
+	}
+
 	log.Printf("successfully enriched article %s (threat_type=%s, confidence=%.2f)",
 		articleID, enrichmentResult.ThreatType, enrichmentResult.ConfidenceScore)
 
 	return nil
 }
 
-// EnrichPendingArticles processes articles that haven't been enriched
+// EnrichBatch enriches articles whose threat analysis is requested in one AI call, returning
+// each article's outcome (nil on success or when it was already enriched). Articles missing
+// from the batch response are enriched on their own
+func (s *EnrichmentService) EnrichBatch(ctx context.Context, articleIDs []uuid.UUID) map[uuid.UUID]error {
+	ctx, span := tracing.Start(ctx, "EnrichmentService.EnrichBatch",
+		trace.WithAttributes(attribute.Int("batch.size", len(articleIDs))),
+	)
+	defer tracing.End(span, nil)
+
+	outcomes := make(map[uuid.UUID]error, len(articleIDs))
+	if len(articleIDs) == 1 {
+		outcomes[articleIDs[0]] = s.EnrichArticle(ctx, articleIDs[0])
+		return outcomes
+	}
+
+	if !s.Enabled() {
+		for _, id := range articleIDs {
+			outcomes[id] = ErrEnrichmentDisabled
+		}
+		return outcomes
+	}
+
+	articles, err := loadArticles(ctx, s.articleRepo, articleIDs)
+	if err != nil {
+		for _, id := range articleIDs {
+			outcomes[id] = fmt.Errorf("failed to get article: %w", err)
+		}
+		return outcomes
+	}
+
+	pending := make([]*domain.Article, 0, len(articleIDs))
+	for _, id := range articleIDs {
+		article, ok := articles[id]
+		switch {
+		case !ok:
+			outcomes[id] = fmt.Errorf("article not found: %s", id)
+		case article.EnrichedAt != nil:
+			outcomes[id] = nil
+		case s.IsPaused(article):
+			outcomes[id] = ErrEnrichmentPaused
+		default:
+			pending = append(pending, article)
+		}
+	}
+
+	if len(pending) == 0 {
+		return outcomes
+	}
+
+	results := make([]*ai.EnrichmentResult, len(pending))
+	if len(pending) > 1 {
+		results, err = s.enricher.EnrichArticles(ctx, pending)
+		if err != nil {
+			log.Printf("batch enrichment of %d articles failed, enriching them one by one: %v", len(pending), err)
+			results = make([]*ai.EnrichmentResult, len(pending))
+		}
+	}
+
+	for i, article := range pending {
+		if results[i] == nil {
+			outcomes[article.ID] = s.EnrichArticle(ctx, article.ID)
+			continue
+		}
+		outcomes[article.ID] = s.applyEnrichment(ctx, article, results[i])
+	}
+
+	return outcomes
+}
+
+// Batches groups articles for EnrichBatch in the given order. Articles up to MaxArticleTokens
+// share a batch until it holds MaxArticles or would exceed MaxTokens; longer articles, and all
+// articles when batching is off, get a batch of their own
+func (s *EnrichmentService) Batches(articles []*domain.Article) [][]uuid.UUID {
+	batches := make([][]uuid.UUID, 0, len(articles))
+	if s.batching.MaxArticles <= 1 {
+		for _, article := range articles {
+			batches = append(batches, []uuid.UUID{article.ID})
+		}
+		return batches
+	}
+
+	var current []uuid.UUID
+	currentTokens := 0
+	for _, article := range articles {
+		tokens := ai.EstimateTokens(article.Title) + ai.EstimateTokens(article.Content)
+		if tokens > s.batching.MaxArticleTokens {
+			batches = append(batches, []uuid.UUID{article.ID})
+			continue
+		}
+
+		if len(current) > 0 && (len(current) >= s.batching.MaxArticles || currentTokens+tokens > s.batching.MaxTokens) {
+			batches = append(batches, current)
+			current, currentTokens = nil, 0
+		}
+
+		current = append(current, article.ID)
+		currentTokens += tokens
+	}
+
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
+
+// EnrichPendingArticles processes articles that haven't been enriched, batching short ones
+// when batching is enabled
 func (s *EnrichmentService) EnrichPendingArticles(ctx context.Context, limit int) (int, error) {
 	if limit < 1 {
 		return 0, fmt.Errorf("limit must be at least 1")
@@ -119,10 +369,16 @@ func (s *EnrichmentService) EnrichPendingArticles(ctx context.Context, limit int
 		return 0, fmt.Errorf("limit cannot exceed 100")
 	}
 
+	if !s.Enabled() {
+		return 0, ErrEnrichmentDisabled
+	}
+
 	// Create filter for unenriched articles
+	notEnriched := false
 	filter := &domain.ArticleFilter{
-		Page:     1,
-		PageSize: limit,
+		IsEnriched: &notEnriched,
+		Page:       1,
+		PageSize:   limit,
 	}
 
 	articles, _, err := s.articleRepo.List(ctx, filter)
@@ -130,22 +386,25 @@ func (s *EnrichmentService) EnrichPendingArticles(ctx context.Context, limit int
 		return 0, fmt.Errorf("failed to list articles: %w", err)
 	}
 
-	enrichedCount := 0
+	// Skip already enriched articles
+	pending := make([]*domain.Article, 0, len(articles))
 	for _, article := range articles {
-		// Skip already enriched articles
-		if article.EnrichedAt != nil {
-			continue
+		if article.EnrichedAt == nil {
+			pending = append(pending, article)
 		}
+	}
 
-		// Enrich article
-		if err := s.EnrichArticle(ctx, article.ID); err != nil {
-			// Log error but continue with other articles
-			log.Printf("failed to enrich article %s: %v", article.ID, err)
-			continue
+	enrichedCount := 0
+	for _, batch := range s.Batches(pending) {
+		for articleID, err := range s.EnrichBatch(ctx, batch) {
+			if err != nil {
+				// Log error but continue with other articles
+				log.Printf("failed to enrich article %s: %v", articleID, err)
+				continue
+			}
+			enrichedCount++
 		}
 
-		enrichedCount++
-
 		// Add small delay to respect API rate limits
 		select {
 		case <-ctx.Done():
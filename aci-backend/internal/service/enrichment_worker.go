@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultEnrichmentConcurrency is the default number of articles enriched in parallel
+	DefaultEnrichmentConcurrency = 2
+
+	// DefaultEnrichmentRatePerMinute is the default cap on enrichment calls per minute
+	DefaultEnrichmentRatePerMinute = 30
+
+	// DefaultEnrichmentPollInterval is the default delay between scans for unenriched articles
+	DefaultEnrichmentPollInterval = 30 * time.Second
+
+	// DefaultEnrichmentMinAge leaves fresh articles to the inline enrichment triggered on ingest
+	DefaultEnrichmentMinAge = 2 * time.Minute
+
+	// DefaultEnrichmentMaxAttempts is how many failures an article gets before the worker gives up on it
+	DefaultEnrichmentMaxAttempts = 3
+
+	// enrichmentScanSize is how many unenriched articles are fetched per scan
+	enrichmentScanSize = 100
+
+	// enrichmentRetryBackoff is the base delay before retrying a failed article
+	enrichmentRetryBackoff = time.Minute
+)
+
+// EnrichmentWorkerConfig configures the background enrichment worker
+type EnrichmentWorkerConfig struct {
+	Concurrency   int
+	RatePerMinute int
+	PollInterval  time.Duration
+	MinAge        time.Duration
+	MaxAttempts   int
+}
+
+// EnrichmentWorkerStats is a snapshot of worker throughput and failures
+type EnrichmentWorkerStats struct {
+	Running           bool       `json:"running"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	LastScanAt        *time.Time `json:"last_scan_at,omitempty"`
+	InFlight          int64      `json:"in_flight"`
+	QueueDepth        int64      `json:"queue_depth"`
+	ProcessedTotal    uint64     `json:"processed_total"`
+	SucceededTotal    uint64     `json:"succeeded_total"`
+	FailedTotal       uint64     `json:"failed_total"`
+	AbandonedArticles int        `json:"abandoned_articles"`
+	ThroughputPerMin  float64    `json:"throughput_per_minute"`
+	AvgDurationMs     float64    `json:"avg_duration_ms"`
+	Concurrency       int        `json:"concurrency"`
+	RatePerMinute     int        `json:"rate_per_minute"`
+}
+
+// enrichmentFailure tracks retry state for an article that failed enrichment
+type enrichmentFailure struct {
+	attempts    int
+	nextAttempt time.Time
+}
+
+// EnrichmentWorker enriches articles that have no enrichment yet
+// Work is bounded by a concurrency limit and a per-minute rate limit on AI calls
+type EnrichmentWorker struct {
+	enrichmentService *EnrichmentService
+	articleRepo       repository.ArticleRepository
+	cfg               EnrichmentWorkerConfig
+	ratePerMinute     atomic.Int64 // starts at cfg.RatePerMinute; changed by SetRatePerMinute
+
+	running   atomic.Bool
+	startedAt atomic.Int64 // unix nanoseconds
+	lastScan  atomic.Int64 // unix nanoseconds
+
+	inFlight        atomic.Int64
+	queueDepth      atomic.Int64 // unenriched articles seen by the last scan
+	processedTotal  atomic.Uint64
+	succeededTotal  atomic.Uint64
+	failedTotal     atomic.Uint64
+	durationTotalNs atomic.Int64
+
+	failuresMu sync.Mutex
+	failures   map[uuid.UUID]*enrichmentFailure
+	active     map[uuid.UUID]bool
+}
+
+// NewEnrichmentWorker creates a new enrichment worker
+// Zero config values fall back to the defaults
+func NewEnrichmentWorker(enrichmentService *EnrichmentService, articleRepo repository.ArticleRepository, cfg EnrichmentWorkerConfig) *EnrichmentWorker {
+	if enrichmentService == nil {
+		panic("enrichmentService cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultEnrichmentConcurrency
+	}
+
+	if cfg.RatePerMinute <= 0 {
+		cfg.RatePerMinute = DefaultEnrichmentRatePerMinute
+	}
+
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultEnrichmentPollInterval
+	}
+
+	if cfg.MinAge < 0 {
+		cfg.MinAge = 0
+	} else if cfg.MinAge == 0 {
+		cfg.MinAge = DefaultEnrichmentMinAge
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultEnrichmentMaxAttempts
+	}
+
+	w := &EnrichmentWorker{
+		enrichmentService: enrichmentService,
+		articleRepo:       articleRepo,
+		cfg:               cfg,
+		failures:          make(map[uuid.UUID]*enrichmentFailure),
+		active:            make(map[uuid.UUID]bool),
+	}
+	w.ratePerMinute.Store(int64(cfg.RatePerMinute))
+
+	return w
+}
+
+// SetRatePerMinute changes the AI call rate limit; a running worker picks it up before its next call
+func (w *EnrichmentWorker) SetRatePerMinute(ratePerMinute int) {
+	if ratePerMinute <= 0 {
+		ratePerMinute = DefaultEnrichmentRatePerMinute
+	}
+	w.ratePerMinute.Store(int64(ratePerMinute))
+}
+
+// Run polls for unenriched articles until the context is cancelled
+// In-flight enrichments are allowed to finish before Run returns
+func (w *EnrichmentWorker) Run(ctx context.Context) {
+	if !w.running.CompareAndSwap(false, true) {
+		log.Warn().Msg("Enrichment worker already running")
+		return
+	}
+	defer w.running.Store(false)
+
+	w.startedAt.Store(time.Now().UnixNano())
+
+	log.Info().
+		Int("concurrency", w.cfg.Concurrency).
+		Int64("rate_per_minute", w.ratePerMinute.Load()).
+		Dur("poll_interval", w.cfg.PollInterval).
+		Msg("Enrichment worker started")
+
+	// One token per allowed call; the ticker refills at the configured rate
+	rate := w.ratePerMinute.Load()
+	limiter := time.NewTicker(time.Minute / time.Duration(rate))
+	defer limiter.Stop()
+
+	sem := make(chan struct{}, w.cfg.Concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Enrichment worker stopping")
+			return
+		case <-poll.C:
+		}
+
+		articles, err := w.nextBatch(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to fetch unenriched articles")
+		}
+
+		// A batch is one analysis call, so it takes one rate limit token and one slot
+		for _, batch := range w.enrichmentService.Batches(articles) {
+			if current := w.ratePerMinute.Load(); current != rate {
+				rate = current
+				limiter.Reset(time.Minute / time.Duration(rate))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-limiter.C:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case sem <- struct{}{}:
+			}
+
+			w.markActive(batch, true)
+			wg.Add(1)
+			go func(articleIDs []uuid.UUID) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				defer w.markActive(articleIDs, false)
+				w.process(ctx, articleIDs)
+			}(batch)
+		}
+
+		poll.Reset(w.cfg.PollInterval)
+	}
+}
+
+// nextBatch returns unenriched articles that are old enough and not backing off
+func (w *EnrichmentWorker) nextBatch(ctx context.Context) ([]*domain.Article, error) {
+	w.lastScan.Store(time.Now().UnixNano())
+
+	notEnriched := false
+	filter := &domain.ArticleFilter{
+		IsEnriched: &notEnriched,
+		Page:       1,
+		PageSize:   enrichmentScanSize,
+	}
+
+	articles, total, err := w.articleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unenriched articles: %w", err)
+	}
+	w.queueDepth.Store(int64(total))
+
+	now := time.Now()
+	eligible := make([]*domain.Article, 0, len(articles))
+
+	w.failuresMu.Lock()
+	defer w.failuresMu.Unlock()
+
+	for _, article := range articles {
+		if now.Sub(article.CreatedAt) < w.cfg.MinAge || w.active[article.ID] {
+			continue
+		}
+
+		// Paused articles are picked up again once the budget resets
+		if w.enrichmentService.IsPaused(article) {
+			continue
+		}
+
+		if failure, ok := w.failures[article.ID]; ok {
+			if failure.attempts >= w.cfg.MaxAttempts || now.Before(failure.nextAttempt) {
+				continue
+			}
+		}
+
+		eligible = append(eligible, article)
+	}
+
+	return eligible, nil
+}
+
+// process enriches a batch of articles and records each outcome
+func (w *EnrichmentWorker) process(ctx context.Context, articleIDs []uuid.UUID) {
+	w.inFlight.Add(int64(len(articleIDs)))
+	defer w.inFlight.Add(-int64(len(articleIDs)))
+
+	start := time.Now()
+	outcomes := w.enrichmentService.EnrichBatch(ctx, articleIDs)
+	w.durationTotalNs.Add(int64(time.Since(start)))
+
+	for articleID, err := range outcomes {
+		w.record(articleID, err)
+	}
+}
+
+// record counts an article's enrichment outcome and updates its retry state
+func (w *EnrichmentWorker) record(articleID uuid.UUID, err error) {
+	w.processedTotal.Add(1)
+
+	// The budget was exceeded mid-batch or AI is disabled; this is not the article's fault
+	if errors.Is(err, ErrEnrichmentPaused) || errors.Is(err, ErrEnrichmentDisabled) {
+		return
+	}
+
+	if err != nil {
+		w.failedTotal.Add(1)
+		attempts := w.recordFailure(articleID)
+
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Int("attempts", attempts).
+			Msg("Enrichment worker failed to enrich article")
+		return
+	}
+
+	w.succeededTotal.Add(1)
+
+	w.failuresMu.Lock()
+	delete(w.failures, articleID)
+	w.failuresMu.Unlock()
+}
+
+// recordFailure updates retry state with exponential backoff and returns the attempt count
+func (w *EnrichmentWorker) recordFailure(articleID uuid.UUID) int {
+	w.failuresMu.Lock()
+	defer w.failuresMu.Unlock()
+
+	failure, ok := w.failures[articleID]
+	if !ok {
+		failure = &enrichmentFailure{}
+		w.failures[articleID] = failure
+	}
+
+	failure.attempts++
+	failure.nextAttempt = time.Now().Add(enrichmentRetryBackoff << (failure.attempts - 1))
+
+	return failure.attempts
+}
+
+// markActive tracks articles currently being enriched so scans do not pick them twice
+func (w *EnrichmentWorker) markActive(articleIDs []uuid.UUID, active bool) {
+	w.failuresMu.Lock()
+	defer w.failuresMu.Unlock()
+
+	for _, articleID := range articleIDs {
+		if active {
+			w.active[articleID] = true
+		} else {
+			delete(w.active, articleID)
+		}
+	}
+}
+
+// QueueDepth returns the number of unenriched articles found by the last scan
+func (w *EnrichmentWorker) QueueDepth() int64 {
+	return w.queueDepth.Load()
+}
+
+// InFlight returns the number of articles currently being enriched
+func (w *EnrichmentWorker) InFlight() int64 {
+	return w.inFlight.Load()
+}
+
+// Stats returns a snapshot of worker metrics
+func (w *EnrichmentWorker) Stats() EnrichmentWorkerStats {
+	stats := EnrichmentWorkerStats{
+		Running:        w.running.Load(),
+		InFlight:       w.inFlight.Load(),
+		QueueDepth:     w.queueDepth.Load(),
+		ProcessedTotal: w.processedTotal.Load(),
+		SucceededTotal: w.succeededTotal.Load(),
+		FailedTotal:    w.failedTotal.Load(),
+		Concurrency:    w.cfg.Concurrency,
+		RatePerMinute:  int(w.ratePerMinute.Load()),
+	}
+
+	if ns := w.startedAt.Load(); ns > 0 {
+		startedAt := time.Unix(0, ns)
+		stats.StartedAt = &startedAt
+
+		if minutes := time.Since(startedAt).Minutes(); minutes > 0 {
+			stats.ThroughputPerMin = float64(stats.SucceededTotal) / minutes
+		}
+	}
+
+	if ns := w.lastScan.Load(); ns > 0 {
+		lastScan := time.Unix(0, ns)
+		stats.LastScanAt = &lastScan
+	}
+
+	if stats.ProcessedTotal > 0 {
+		stats.AvgDurationMs = float64(w.durationTotalNs.Load()) / float64(stats.ProcessedTotal) / float64(time.Millisecond)
+	}
+
+	w.failuresMu.Lock()
+	for _, failure := range w.failures {
+		if failure.attempts >= w.cfg.MaxAttempts {
+			stats.AbandonedArticles++
+		}
+	}
+	w.failuresMu.Unlock()
+
+	return stats
+}
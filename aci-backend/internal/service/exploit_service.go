@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultExploitDBURL is the index of the Exploit-DB archive, one row per exploit
+	DefaultExploitDBURL = "https://gitlab.com/exploit-database/exploitdb/-/raw/main/files_exploits.csv"
+
+	// DefaultGitHubPoCURL is the root of the PoC-in-GitHub index, one JSON file per CVE at /<year>/<cve>.json
+	DefaultGitHubPoCURL = "https://raw.githubusercontent.com/nomi-sec/PoC-in-GitHub/master"
+
+	// maxExploitDBBytes bounds the Exploit-DB index download; the file is tens of megabytes
+	maxExploitDBBytes = 256 << 20
+
+	// maxGitHubPoCBytes bounds one PoC-in-GitHub file
+	maxGitHubPoCBytes = 4 << 20
+
+	// exploitDBDateLayout is the date format used in the Exploit-DB index
+	exploitDBDateLayout = "2006-01-02"
+)
+
+// gitHubPoC is one repository in a PoC-in-GitHub file
+type gitHubPoC struct {
+	FullName  string `json:"full_name"`
+	HTMLURL   string `json:"html_url"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ExploitService tracks public exploits for CVEs from Exploit-DB and GitHub proof-of-concept repositories
+// Articles mentioning a CVE with a public exploit are flagged and can trigger exploit alerts
+type ExploitService struct {
+	exploitRepo   repository.ExploitRepository
+	articleRepo   repository.ArticleRepository
+	alerts        *AlertService
+	exploitDBURL  string
+	gitHubPoCURL  string
+	lookback      time.Duration
+	gitHubMaxCVEs int
+	httpClient    *http.Client
+}
+
+// NewExploitService creates a new exploit service
+// An empty URL disables that source; GitHub is queried for the CVEs of articles published within
+// lookback, at most gitHubMaxCVEs of them per sync, and exploit alerts are matched over the same window
+func NewExploitService(
+	exploitRepo repository.ExploitRepository,
+	articleRepo repository.ArticleRepository,
+	exploitDBURL, gitHubPoCURL string,
+	lookback time.Duration,
+	gitHubMaxCVEs int,
+	timeout time.Duration,
+) *ExploitService {
+	if exploitRepo == nil {
+		panic("exploitRepo cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	return &ExploitService{
+		exploitRepo:   exploitRepo,
+		articleRepo:   articleRepo,
+		exploitDBURL:  exploitDBURL,
+		gitHubPoCURL:  strings.TrimSuffix(gitHubPoCURL, "/"),
+		lookback:      lookback,
+		gitHubMaxCVEs: gitHubMaxCVEs,
+		httpClient:    &http.Client{Timeout: timeout},
+	}
+}
+
+// SetAlertService matches exploit alerts against articles flagged by a sync
+func (s *ExploitService) SetAlertService(alerts *AlertService) {
+	s.alerts = alerts
+}
+
+// Sources returns the enabled exploit sources
+func (s *ExploitService) Sources() []domain.ExploitSource {
+	sources := make([]domain.ExploitSource, 0, 2)
+	if s.exploitDBURL != "" {
+		sources = append(sources, domain.ExploitSourceExploitDB)
+	}
+	if s.gitHubPoCURL != "" {
+		sources = append(sources, domain.ExploitSourceGitHub)
+	}
+	return sources
+}
+
+// SyncAll syncs every enabled source, then matches exploit alerts against newly flagged articles
+// A failed source is reported in the returned error; the others are still synced
+func (s *ExploitService) SyncAll(ctx context.Context) ([]*domain.ExploitSyncResult, error) {
+	results := make([]*domain.ExploitSyncResult, 0)
+	var errs []error
+
+	for _, source := range s.Sources() {
+		result, err := s.Sync(ctx, source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+			continue
+		}
+		results = append(results, result)
+	}
+
+	if len(results) > 0 && s.alerts != nil {
+		if _, err := s.alerts.MatchExploitAlerts(ctx, time.Now().Add(-s.lookback)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to match exploit alerts: %w", err))
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// Sync downloads one source and replaces its local copy
+func (s *ExploitService) Sync(ctx context.Context, source domain.ExploitSource) (*domain.ExploitSyncResult, error) {
+	switch source {
+	case domain.ExploitSourceExploitDB:
+		if s.exploitDBURL == "" {
+			return nil, fmt.Errorf("exploit source %s is disabled", source)
+		}
+		return s.syncExploitDB(ctx)
+
+	case domain.ExploitSourceGitHub:
+		if s.gitHubPoCURL == "" {
+			return nil, fmt.Errorf("exploit source %s is disabled", source)
+		}
+		return s.syncGitHub(ctx)
+
+	default:
+		return nil, fmt.Errorf("unknown exploit source %q", source)
+	}
+}
+
+// syncExploitDB replaces the local copy of every Exploit-DB entry that names a CVE
+func (s *ExploitService) syncExploitDB(ctx context.Context) (*domain.ExploitSyncResult, error) {
+	body, err := s.fetch(ctx, s.exploitDBURL, "text/csv")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	reader := csv.NewReader(io.LimitReader(body, maxExploitDBBytes))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Exploit-DB index header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range []string{"id", "description", "date_published", "codes"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("Exploit-DB index has no %s column", name)
+		}
+	}
+
+	exploits := make([]*domain.PublicExploit, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Exploit-DB index: %w", err)
+		}
+
+		if len(record) < len(header) {
+			continue
+		}
+
+		var publishedAt *time.Time
+		if published, err := time.Parse(exploitDBDateLayout, record[columns["date_published"]]); err == nil {
+			publishedAt = &published
+		}
+
+		url := "https://www.exploit-db.com/exploits/" + record[columns["id"]]
+		for _, code := range strings.Split(record[columns["codes"]], ";") {
+			cveID := strings.ToUpper(strings.TrimSpace(code))
+			if !strings.HasPrefix(cveID, "CVE-") {
+				continue
+			}
+
+			exploits = append(exploits, &domain.PublicExploit{
+				CVEID:       cveID,
+				Source:      domain.ExploitSourceExploitDB,
+				URL:         url,
+				Title:       record[columns["description"]],
+				PublishedAt: publishedAt,
+			})
+		}
+	}
+
+	// Guard against a truncated or changed index emptying the local copy
+	if len(exploits) == 0 {
+		return nil, fmt.Errorf("Exploit-DB index contained no CVE exploits")
+	}
+
+	return s.replace(ctx, domain.ExploitSourceExploitDB, nil, exploits, 0)
+}
+
+// syncGitHub looks up proof-of-concept repositories for the CVEs in recent articles
+// A CVE whose lookup fails keeps its previous exploits
+func (s *ExploitService) syncGitHub(ctx context.Context) (*domain.ExploitSyncResult, error) {
+	cveIDs, err := s.exploitRepo.ListArticleCVEs(ctx, time.Now().Add(-s.lookback), s.gitHubMaxCVEs)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := make([]string, 0, len(cveIDs))
+	exploits := make([]*domain.PublicExploit, 0)
+	failed := 0
+
+	for _, cveID := range cveIDs {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		found, err := s.lookupGitHub(ctx, cveID)
+		if err != nil {
+			failed++
+			log.Warn().Err(err).Str("cve_id", cveID).Msg("Failed to look up GitHub proofs of concept")
+			continue
+		}
+
+		checked = append(checked, cveID)
+		exploits = append(exploits, found...)
+	}
+
+	if failed > 0 && len(checked) == 0 {
+		return nil, fmt.Errorf("all %d GitHub proof-of-concept lookups failed", failed)
+	}
+
+	return s.replace(ctx, domain.ExploitSourceGitHub, checked, exploits, len(checked))
+}
+
+// lookupGitHub returns the proof-of-concept repositories listed for a CVE
+func (s *ExploitService) lookupGitHub(ctx context.Context, cveID string) ([]*domain.PublicExploit, error) {
+	// CVE-2024-1234 is listed under /2024/CVE-2024-1234.json
+	parts := strings.SplitN(cveID, "-", 3)
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	body, err := s.fetch(ctx, fmt.Sprintf("%s/%s/%s.json", s.gitHubPoCURL, parts[1], cveID), "application/json")
+	if errors.Is(err, errExploitNotListed) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var repos []gitHubPoC
+	if err := json.NewDecoder(io.LimitReader(body, maxGitHubPoCBytes)).Decode(&repos); err != nil {
+		return nil, fmt.Errorf("failed to decode PoC-in-GitHub entry: %w", err)
+	}
+
+	exploits := make([]*domain.PublicExploit, 0, len(repos))
+	for _, repo := range repos {
+		if repo.HTMLURL == "" {
+			continue
+		}
+
+		exploit := &domain.PublicExploit{
+			CVEID:  cveID,
+			Source: domain.ExploitSourceGitHub,
+			URL:    repo.HTMLURL,
+			Title:  repo.FullName,
+		}
+		if createdAt, err := time.Parse(time.RFC3339, repo.CreatedAt); err == nil {
+			exploit.PublishedAt = &createdAt
+		}
+
+		exploits = append(exploits, exploit)
+	}
+
+	return exploits, nil
+}
+
+// errExploitNotListed is returned by fetch when the source has no entry at the URL
+var errExploitNotListed = errors.New("not listed")
+
+// fetch downloads a source URL; the caller closes the body
+func (s *ExploitService) fetch(ctx context.Context, url, accept string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exploit source request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download exploit source: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, errExploitNotListed
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("exploit source returned status %d", resp.StatusCode)
+	}
+}
+
+// replace stores a source's exploits and reports the sync
+func (s *ExploitService) replace(ctx context.Context, source domain.ExploitSource, cveIDs []string, exploits []*domain.PublicExploit, checked int) (*domain.ExploitSyncResult, error) {
+	syncedAt := time.Now().UTC()
+	removed, err := s.exploitRepo.Replace(ctx, source, cveIDs, exploits, syncedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ExploitSyncResult{
+		Source:      source,
+		CVEsChecked: checked,
+		Count:       len(exploits),
+		Removed:     removed,
+		SyncedAt:    syncedAt,
+	}, nil
+}
+
+// Status describes the local copy of each exploit source
+func (s *ExploitService) Status(ctx context.Context) ([]*domain.ExploitSourceStatus, error) {
+	return s.exploitRepo.Status(ctx)
+}
+
+// ListForArticle returns the public exploits for a published article's CVEs, with the feed each came from
+func (s *ExploitService) ListForArticle(ctx context.Context, articleID uuid.UUID) ([]*domain.PublicExploit, error) {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, err
+	}
+
+	if article == nil || !article.IsPublished {
+		return nil, &domainerrors.NotFoundError{
+			Resource: "article",
+			ID:       articleID.String(),
+		}
+	}
+
+	if len(article.CVEs) == 0 {
+		return []*domain.PublicExploit{}, nil
+	}
+
+	return s.exploitRepo.GetByCVEs(ctx, article.CVEs)
+}
+
+// Annotate sets the article's exploit flag and sources from its CVEs
+func (s *ExploitService) Annotate(ctx context.Context, article *domain.Article) error {
+	if len(article.CVEs) == 0 {
+		return nil
+	}
+
+	exploits, err := s.exploitRepo.GetByCVEs(ctx, article.CVEs)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	article.ExploitSources = nil
+	for _, exploit := range exploits {
+		if !seen[string(exploit.Source)] {
+			seen[string(exploit.Source)] = true
+			article.ExploitSources = append(article.ExploitSources, string(exploit.Source))
+		}
+	}
+	sort.Strings(article.ExploitSources)
+	article.ExploitAvailable = len(article.ExploitSources) > 0
+	return nil
+}
+
+// Run syncs every enabled source immediately and then every interval until the context is canceled
+func (s *ExploitService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		results, err := s.SyncAll(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to sync exploit sources")
+		}
+		for _, result := range results {
+			log.Info().
+				Str("source", string(result.Source)).
+				Int("cves_checked", result.CVEsChecked).
+				Int("count", result.Count).
+				Int("removed", result.Removed).
+				Msg("Synced exploit source")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
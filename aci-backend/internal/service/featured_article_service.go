@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// FeaturedArticleService manages the homepage hero carousel
+type FeaturedArticleService struct {
+	featuredRepo repository.FeaturedArticleRepository
+	articleRepo  repository.ArticleRepository
+}
+
+// NewFeaturedArticleService creates a new featured article service instance
+func NewFeaturedArticleService(featuredRepo repository.FeaturedArticleRepository, articleRepo repository.ArticleRepository) *FeaturedArticleService {
+	if featuredRepo == nil {
+		panic("featuredRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	return &FeaturedArticleService{
+		featuredRepo: featuredRepo,
+		articleRepo:  articleRepo,
+	}
+}
+
+// List returns the whole featured set with each article, marking which entries are live now
+func (s *FeaturedArticleService) List(ctx context.Context) ([]*domain.FeaturedArticle, error) {
+	featured, err := s.featuredRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := loadArticles(ctx, s.articleRepo, featuredArticleIDs(featured))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, entry := range featured {
+		entry.Active = entry.IsActive(now)
+
+		article, ok := articles[entry.ArticleID]
+		if !ok {
+			log.Error().
+				Str("article_id", entry.ArticleID.String()).
+				Msg("Featured article not found")
+			continue
+		}
+		entry.Article = article
+	}
+
+	return featured, nil
+}
+
+// Feature adds an article to the featured set or updates its position and schedule
+// A nil position keeps an existing entry's position and appends a new entry to the end
+func (s *FeaturedArticleService) Feature(
+	ctx context.Context,
+	articleID uuid.UUID,
+	position *int,
+	featureFrom, featureUntil *time.Time,
+	createdBy *uuid.UUID,
+) (*domain.FeaturedArticle, error) {
+	if _, err := s.articleRepo.GetByID(ctx, articleID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{
+				Resource: "article",
+				ID:       articleID.String(),
+			}
+		}
+		return nil, err
+	}
+
+	featured, err := s.featuredRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *domain.FeaturedArticle
+	for _, entry := range featured {
+		if entry.ArticleID == articleID {
+			existing = entry
+			break
+		}
+	}
+
+	if existing == nil && len(featured) >= domain.MaxFeaturedArticles {
+		return nil, &domainerrors.LimitExceededError{
+			Resource: "featured article",
+			Limit:    domain.MaxFeaturedArticles,
+			Message:  fmt.Sprintf("cannot feature more than %d articles", domain.MaxFeaturedArticles),
+		}
+	}
+
+	entry := &domain.FeaturedArticle{
+		ArticleID:    articleID,
+		FeatureFrom:  featureFrom,
+		FeatureUntil: featureUntil,
+		CreatedBy:    createdBy,
+	}
+
+	switch {
+	case position != nil:
+		entry.Position = *position
+	case existing != nil:
+		entry.Position = existing.Position
+	default:
+		for _, other := range featured {
+			if other.Position >= entry.Position {
+				entry.Position = other.Position + 1
+			}
+		}
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{
+			Field:   "featured_article",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.featuredRepo.Upsert(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	entry.Active = entry.IsActive(time.Now())
+
+	return entry, nil
+}
+
+// Unfeature removes an article from the featured set
+func (s *FeaturedArticleService) Unfeature(ctx context.Context, articleID uuid.UUID) error {
+	return s.featuredRepo.Delete(ctx, articleID)
+}
+
+// Reorder sets the carousel order; the IDs must list every featured article exactly once
+func (s *FeaturedArticleService) Reorder(ctx context.Context, articleIDs []uuid.UUID) error {
+	featured, err := s.featuredRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := make(map[uuid.UUID]bool, len(featured))
+	for _, entry := range featured {
+		remaining[entry.ArticleID] = true
+	}
+
+	for _, articleID := range articleIDs {
+		if !remaining[articleID] {
+			return &domainerrors.ValidationError{
+				Field:   "article_ids",
+				Message: fmt.Sprintf("article %s is not featured or is listed twice", articleID),
+			}
+		}
+		delete(remaining, articleID)
+	}
+
+	if len(remaining) > 0 {
+		return &domainerrors.ValidationError{
+			Field:   "article_ids",
+			Message: "every featured article must be listed",
+		}
+	}
+
+	return s.featuredRepo.Reorder(ctx, articleIDs)
+}
+
+// Active returns the articles featured right now, in carousel order
+func (s *FeaturedArticleService) Active(ctx context.Context, limit int) ([]*domain.Article, error) {
+	if limit <= 0 {
+		limit = domain.DefaultFeaturedLimit
+	}
+
+	if limit > domain.MaxFeaturedLimit {
+		limit = domain.MaxFeaturedLimit
+	}
+
+	featured, err := s.featuredRepo.ListActive(ctx, time.Now(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	byID, err := loadArticles(ctx, s.articleRepo, featuredArticleIDs(featured))
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]*domain.Article, 0, len(featured))
+	for _, entry := range featured {
+		article, ok := byID[entry.ArticleID]
+		if !ok {
+			log.Error().
+				Str("article_id", entry.ArticleID.String()).
+				Msg("Featured article not found")
+			continue
+		}
+		articles = append(articles, article)
+	}
+
+	return articles, nil
+}
+
+// featuredArticleIDs returns the article IDs of the featured entries in carousel order
+func featuredArticleIDs(featured []*domain.FeaturedArticle) []uuid.UUID {
+	ids := make([]uuid.UUID, len(featured))
+	for i, entry := range featured {
+		ids[i] = entry.ArticleID
+	}
+	return ids
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// FeedPreferenceService manages each user's default feed filters
+type FeedPreferenceService struct {
+	prefsRepo    repository.FeedPreferenceRepository
+	categoryRepo repository.CategoryRepository
+	watchlist    *VendorWatchlistService
+}
+
+// NewFeedPreferenceService creates a new feed preference service instance
+func NewFeedPreferenceService(prefsRepo repository.FeedPreferenceRepository, categoryRepo repository.CategoryRepository) *FeedPreferenceService {
+	if prefsRepo == nil {
+		panic("prefsRepo cannot be nil")
+	}
+	if categoryRepo == nil {
+		panic("categoryRepo cannot be nil")
+	}
+
+	return &FeedPreferenceService{
+		prefsRepo:    prefsRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// SetVendorWatchlistService boosts the user's watched vendors in their feed
+func (s *FeedPreferenceService) SetVendorWatchlistService(watchlist *VendorWatchlistService) {
+	s.watchlist = watchlist
+}
+
+// Get returns a user's feed preferences, or the unfiltered defaults if they have not saved any
+func (s *FeedPreferenceService) Get(ctx context.Context, userID uuid.UUID) (*domain.FeedPreferences, error) {
+	prefs, err := s.prefsRepo.Get(ctx, userID)
+	if err != nil {
+		var notFound *domainerrors.NotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+		return domain.DefaultFeedPreferences(userID), nil
+	}
+
+	return prefs, nil
+}
+
+// Update validates and replaces a user's feed preferences
+func (s *FeedPreferenceService) Update(ctx context.Context, prefs *domain.FeedPreferences) (*domain.FeedPreferences, error) {
+	prefs.Normalize()
+
+	if err := prefs.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "preferences", Message: err.Error()}
+	}
+
+	if len(prefs.PreferredCategories) > 0 {
+		categories, err := s.categoryRepo.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		known := make(map[uuid.UUID]bool, len(categories))
+		for _, category := range categories {
+			known[category.ID] = true
+		}
+
+		for _, categoryID := range prefs.PreferredCategories {
+			if !known[categoryID] {
+				return nil, &domainerrors.ValidationError{Field: "preferred_categories", Message: "unknown category: " + categoryID.String()}
+			}
+		}
+	}
+
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, err
+	}
+
+	return prefs, nil
+}
+
+// ApplyTo narrows an article filter to the user's feed preferences
+// and ranks articles about the user's watched vendors first
+func (s *FeedPreferenceService) ApplyTo(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter) error {
+	prefs, err := s.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	prefs.ApplyTo(filter)
+
+	if s.watchlist != nil {
+		vendors, err := s.watchlist.Vendors(ctx, userID)
+		if err != nil {
+			return err
+		}
+		filter.BoostVendors = vendors
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/tracing"
+)
+
+const (
+	// maxPopularityBoost caps how much article volume can lift a term above better text matches
+	maxPopularityBoost = 0.2
+	// recentArticleWindow is the age under which an article receives a recency boost
+	recentArticleWindow = 7 * 24 * time.Hour
+	// recentArticleBoost is added to articles published within recentArticleWindow
+	recentArticleBoost = 0.1
+)
+
+// GlobalSearchService performs typed search across articles, CVEs, vendors, and threat actors
+type GlobalSearchService struct {
+	searchRepo repository.GlobalSearchRepository
+}
+
+// NewGlobalSearchService creates a new global search service instance
+func NewGlobalSearchService(searchRepo repository.GlobalSearchRepository) *GlobalSearchService {
+	if searchRepo == nil {
+		panic("searchRepo cannot be nil")
+	}
+
+	return &GlobalSearchService{
+		searchRepo: searchRepo,
+	}
+}
+
+// Search runs the requested entity searches concurrently and returns ranked, grouped results
+func (s *GlobalSearchService) Search(ctx context.Context, query *domain.GlobalSearchQuery) (*domain.GlobalSearchResults, error) {
+	if query == nil {
+		return nil, fmt.Errorf("query cannot be nil")
+	}
+
+	ctx, span := tracing.Start(ctx, "GlobalSearchService.Search",
+		trace.WithAttributes(attribute.Int("search.query_length", len(query.Query))),
+	)
+	results, err := s.search(ctx, query)
+	tracing.End(span, err)
+	return results, err
+}
+
+// search runs the query traced by Search
+func (s *GlobalSearchService) search(ctx context.Context, query *domain.GlobalSearchQuery) (*domain.GlobalSearchResults, error) {
+
+	if err := query.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid search query: %w", err)
+	}
+
+	results := &domain.GlobalSearchResults{Query: query.Query}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	run := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	if query.Includes(domain.SearchEntityArticle) {
+		run(func() error {
+			hits, err := s.searchRepo.SearchArticles(ctx, query.Query, query.LimitFor(domain.SearchEntityArticle))
+			if err != nil {
+				return err
+			}
+			results.Articles = rankArticleHits(hits, time.Now())
+			return nil
+		})
+	}
+
+	if query.Includes(domain.SearchEntityCVE) {
+		run(func() error {
+			hits, err := s.searchRepo.SearchCVEs(ctx, query.Query, query.LimitFor(domain.SearchEntityCVE))
+			if err != nil {
+				return err
+			}
+			results.CVEs = rankTermHits(hits)
+			return nil
+		})
+	}
+
+	if query.Includes(domain.SearchEntityVendor) {
+		run(func() error {
+			hits, err := s.searchRepo.SearchVendors(ctx, query.Query, query.LimitFor(domain.SearchEntityVendor))
+			if err != nil {
+				return err
+			}
+			results.Vendors = rankTermHits(hits)
+			return nil
+		})
+	}
+
+	if query.Includes(domain.SearchEntityThreatActor) {
+		run(func() error {
+			hits, err := s.searchRepo.SearchThreatActors(ctx, query.Query, query.LimitFor(domain.SearchEntityThreatActor))
+			if err != nil {
+				return err
+			}
+			results.ThreatActors = rankTermHits(hits)
+			return nil
+		})
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, fmt.Errorf("global search failed: %w", firstErr)
+	}
+
+	return results, nil
+}
+
+// rankArticleHits adds a recency boost to the match score and re-sorts
+func rankArticleHits(hits []*domain.ArticleSearchHit, now time.Time) []*domain.ArticleSearchHit {
+	for _, hit := range hits {
+		if now.Sub(hit.PublishedAt) <= recentArticleWindow {
+			hit.Score += recentArticleBoost
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].PublishedAt.After(hits[j].PublishedAt)
+	})
+
+	return hits
+}
+
+// rankTermHits adds a bounded popularity boost to the match score and re-sorts
+func rankTermHits(hits []*domain.TermSearchHit) []*domain.TermSearchHit {
+	for _, hit := range hits {
+		boost := math.Log10(1+float64(hit.ArticleCount)) / 10
+		hit.Score += math.Min(boost, maxPopularityBoost)
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].LastSeenAt.After(hits[j].LastSeenAt)
+	})
+
+	return hits
+}
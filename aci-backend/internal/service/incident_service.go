@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// MaxIncidentArticles caps how many published articles an incident links, so a timeline fits one page
+	MaxIncidentArticles = 100
+
+	// maxIncidentSuggestionCVEs caps how many of the incident's CVEs are searched for suggestions
+	maxIncidentSuggestionCVEs = 5
+
+	// incidentSuggestionLookback is how long before the incident's first article suggestions may be published
+	incidentSuggestionLookback = 30 * 24 * time.Hour
+)
+
+// IncidentService groups the articles covering the same breach or campaign into incident timelines
+// Admins link articles by hand; suggestions surface unlinked articles sharing a CVE with the
+// incident or with a title similar to the incident title
+type IncidentService struct {
+	incidentRepo repository.IncidentRepository
+	articleRepo  repository.ArticleRepository
+}
+
+// NewIncidentService creates a new incident service instance
+func NewIncidentService(incidentRepo repository.IncidentRepository, articleRepo repository.ArticleRepository) *IncidentService {
+	if incidentRepo == nil {
+		panic("incidentRepo cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	return &IncidentService{
+		incidentRepo: incidentRepo,
+		articleRepo:  articleRepo,
+	}
+}
+
+// Create creates an ongoing incident
+func (s *IncidentService) Create(ctx context.Context, userID uuid.UUID, title string, summary *string) (*domain.Incident, error) {
+	now := time.Now()
+	incident := &domain.Incident{
+		ID:        uuid.New(),
+		Title:     strings.TrimSpace(title),
+		Summary:   trimOptional(summary),
+		Status:    domain.IncidentStatusOngoing,
+		CreatedBy: &userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := incident.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "incident", Message: err.Error()}
+	}
+
+	if err := s.incidentRepo.Create(ctx, incident); err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// Update replaces an incident's title, summary and status; a nil or empty summary clears it
+func (s *IncidentService) Update(ctx context.Context, id uuid.UUID, title string, summary *string, status domain.IncidentStatus) (*domain.Incident, error) {
+	incident, err := s.incidentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	incident.Title = strings.TrimSpace(title)
+	incident.Summary = trimOptional(summary)
+	incident.Status = status
+
+	if err := incident.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "incident", Message: err.Error()}
+	}
+
+	if err := s.incidentRepo.Update(ctx, incident); err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// Delete removes an incident; its articles are kept
+func (s *IncidentService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.incidentRepo.Delete(ctx, id)
+}
+
+// List returns a page of incidents matching the filter
+func (s *IncidentService) List(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, int, error) {
+	if filter == nil {
+		filter = domain.NewIncidentFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	return s.incidentRepo.List(ctx, filter)
+}
+
+// AddArticle links an article to an incident, up to MaxIncidentArticles
+func (s *IncidentService) AddArticle(ctx context.Context, id, userID, articleID uuid.UUID) error {
+	incident, err := s.incidentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if incident.ArticleCount >= MaxIncidentArticles {
+		return &domainerrors.LimitExceededError{
+			Resource: "incident article",
+			Limit:    MaxIncidentArticles,
+			Message:  fmt.Sprintf("an incident cannot link more than %d articles", MaxIncidentArticles),
+		}
+	}
+
+	return s.incidentRepo.AddArticle(ctx, id, articleID, userID)
+}
+
+// RemoveArticle unlinks an article from an incident
+func (s *IncidentService) RemoveArticle(ctx context.Context, id, articleID uuid.UUID) error {
+	return s.incidentRepo.RemoveArticle(ctx, id, articleID)
+}
+
+// Timeline returns the incident with its published articles, oldest first, and their merged summary
+func (s *IncidentService) Timeline(ctx context.Context, id uuid.UUID) (*domain.IncidentTimeline, error) {
+	incident, err := s.incidentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.articles(ctx, incident.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.IncidentTimeline{
+		Incident: incident,
+		Summary:  mergeIncidentSummary(incident, articles),
+		Articles: articles,
+	}, nil
+}
+
+// Suggest returns up to limit published articles that are not linked to the incident but share a
+// CVE with its articles or have a title similar to its title
+// Articles with both reasons come first, then those sharing a CVE, then the most similar titles
+func (s *IncidentService) Suggest(ctx context.Context, id uuid.UUID, limit int) ([]*domain.IncidentSuggestion, error) {
+	incident, err := s.incidentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := s.articles(ctx, incident.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := make(map[uuid.UUID]bool, len(linked))
+	for _, article := range linked {
+		exclude[article.ID] = true
+	}
+
+	var since *time.Time
+	if incident.FirstPublishedAt != nil {
+		from := incident.FirstPublishedAt.Add(-incidentSuggestionLookback)
+		since = &from
+	}
+
+	suggestions := make([]*domain.IncidentSuggestion, 0)
+	byArticle := make(map[uuid.UUID]*domain.IncidentSuggestion)
+	add := func(articles []*domain.Article, reason domain.IncidentSuggestionReason) {
+		for _, article := range articles {
+			if exclude[article.ID] {
+				continue
+			}
+			if suggestion, ok := byArticle[article.ID]; ok {
+				if suggestion.Reasons[len(suggestion.Reasons)-1] != reason {
+					suggestion.Reasons = append(suggestion.Reasons, reason)
+				}
+				continue
+			}
+			suggestion := &domain.IncidentSuggestion{Article: article, Reasons: []domain.IncidentSuggestionReason{reason}}
+			byArticle[article.ID] = suggestion
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	cves := mergeStrings(linked, func(a *domain.Article) []string { return a.CVEs })
+	if len(cves) > maxIncidentSuggestionCVEs {
+		cves = cves[:maxIncidentSuggestionCVEs]
+	}
+	for _, cve := range cves {
+		filter := incidentSuggestionFilter(limit, since)
+		filter.CVE = &cve
+		articles, _, err := s.articleRepo.List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list articles sharing %s: %w", cve, err)
+		}
+		add(articles, domain.IncidentSuggestionSharedCVE)
+	}
+
+	filter := incidentSuggestionFilter(limit, since)
+	filter.SimilarTo = &incident.Title
+	articles, _, err := s.articleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles with similar titles: %w", err)
+	}
+	add(articles, domain.IncidentSuggestionSimilarTitle)
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return len(suggestions[i].Reasons) > len(suggestions[j].Reasons)
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions, nil
+}
+
+// articles returns the incident's published articles, oldest first
+func (s *IncidentService) articles(ctx context.Context, incidentID uuid.UUID) ([]*domain.Article, error) {
+	filter := domain.NewArticleFilter()
+	filter.IncidentID = &incidentID
+	filter.PublishedOnly = true
+	filter.Chronological = true
+	filter.PageSize = MaxIncidentArticles
+
+	articles, _, err := s.articleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident articles: %w", err)
+	}
+
+	return articles, nil
+}
+
+// incidentSuggestionFilter matches canonical published articles, enough to fill the limit after excluding linked ones
+func incidentSuggestionFilter(limit int, since *time.Time) *domain.ArticleFilter {
+	filter := domain.NewArticleFilter()
+	filter.PublishedOnly = true
+	filter.ExcludeDuplicates = true
+	filter.DateFrom = since
+	filter.PageSize = min(limit*2, 100)
+	return filter
+}
+
+// mergeIncidentSummary combines what the articles report into one summary
+func mergeIncidentSummary(incident *domain.Incident, articles []*domain.Article) domain.IncidentSummary {
+	summary := domain.IncidentSummary{
+		Severity:     domain.SeverityInformational,
+		CVEs:         mergeStrings(articles, func(a *domain.Article) []string { return a.CVEs }),
+		Vendors:      mergeStrings(articles, func(a *domain.Article) []string { return a.Vendors }),
+		ArticleCount: len(articles),
+	}
+
+	lines := make([]string, 0, len(articles))
+	for _, article := range articles {
+		if article.Severity.AtLeast(summary.Severity) {
+			summary.Severity = article.Severity
+		}
+		summary.KEV = summary.KEV || article.KEV
+		summary.ExploitAvailable = summary.ExploitAvailable || article.ExploitAvailable
+
+		line := article.PublishedAt.Format("2 Jan 2006") + ": " + article.DisplayTitle()
+		if text := article.DisplaySummary(); text != nil && strings.TrimSpace(*text) != "" {
+			line += " - " + firstSentence(*text)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(articles) > 0 {
+		first := articles[0].PublishedAt
+		last := articles[len(articles)-1].PublishedAt
+		summary.FirstReportedAt = &first
+		summary.LastUpdatedAt = &last
+	}
+
+	if incident.Summary != nil && *incident.Summary != "" {
+		summary.Text = *incident.Summary
+	} else {
+		summary.Text = strings.Join(lines, "\n")
+	}
+
+	return summary
+}
+
+// mergeStrings returns the distinct values across the articles, ignoring case, in order of first appearance
+func mergeStrings(articles []*domain.Article, values func(*domain.Article) []string) []string {
+	seen := make(map[string]bool)
+	merged := make([]string, 0)
+
+	for _, article := range articles {
+		for _, value := range values(article) {
+			key := strings.ToLower(value)
+			if value == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, value)
+		}
+	}
+
+	return merged
+}
+
+// firstSentence returns the text up to and including its first full stop, or all of it
+func firstSentence(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if i := strings.Index(text, ". "); i >= 0 {
+		return text[:i+1]
+	}
+	return text
+}
+
+// trimOptional trims an optional string, returning nil when nothing is left
+func trimOptional(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
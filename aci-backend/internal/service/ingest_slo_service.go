@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultIngestLatencyBudget is the default ingest-to-notify latency budget per article
+	DefaultIngestLatencyBudget = 5 * time.Minute
+
+	// DefaultIngestObjective is the default fraction of articles that must meet the budget
+	DefaultIngestObjective = 0.95
+
+	// DefaultSLOWindow is the default reporting window
+	DefaultSLOWindow = 24 * time.Hour
+
+	// MaxSLOWindow is the largest reporting window accepted
+	MaxSLOWindow = 30 * 24 * time.Hour
+)
+
+// IngestSLOService tracks article ingest pipeline latency against an SLO
+type IngestSLOService struct {
+	latencyRepo repository.IngestLatencyRepository
+	budget      time.Duration
+	objective   float64
+
+	mu            sync.Mutex
+	alertingSince *time.Time // set by Monitor while the error budget is exhausted
+}
+
+// NewIngestSLOService creates a new ingest SLO service
+// A zero budget or objective falls back to the defaults
+func NewIngestSLOService(latencyRepo repository.IngestLatencyRepository, budget time.Duration, objective float64) *IngestSLOService {
+	if latencyRepo == nil {
+		panic("latencyRepo cannot be nil")
+	}
+
+	if budget <= 0 {
+		budget = DefaultIngestLatencyBudget
+	}
+
+	if objective <= 0 || objective > 1 {
+		objective = DefaultIngestObjective
+	}
+
+	return &IngestSLOService{
+		latencyRepo: latencyRepo,
+		budget:      budget,
+		objective:   objective,
+	}
+}
+
+// RecordStages stores stage timestamps for an article
+// Failures are logged rather than returned so latency tracking never breaks ingestion
+func (s *IngestSLOService) RecordStages(ctx context.Context, articleID uuid.UUID, stages map[domain.IngestStage]time.Time) {
+	timing, err := s.latencyRepo.RecordStages(ctx, articleID, stages)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Msg("Failed to record ingest stage timings")
+		return
+	}
+
+	latency, ok := timing.EndToEnd()
+	if !ok {
+		return
+	}
+
+	if latency > s.budget {
+		log.Warn().
+			Str("article_id", articleID.String()).
+			Dur("latency", latency).
+			Dur("budget", s.budget).
+			Msg("Article exceeded ingest-to-notify latency budget")
+	}
+}
+
+// RecordStage stores a single stage timestamp for an article
+func (s *IngestSLOService) RecordStage(ctx context.Context, articleID uuid.UUID, stage domain.IngestStage, at time.Time) {
+	s.RecordStages(ctx, articleID, map[domain.IngestStage]time.Time{stage: at})
+}
+
+// GetTiming retrieves stage timings for an article
+func (s *IngestSLOService) GetTiming(ctx context.Context, articleID uuid.UUID) (*domain.IngestTiming, error) {
+	if articleID == uuid.Nil {
+		return nil, fmt.Errorf("article ID is required")
+	}
+
+	return s.latencyRepo.GetByArticleID(ctx, articleID)
+}
+
+// Report computes latency percentiles and SLO compliance over the given window
+func (s *IngestSLOService) Report(ctx context.Context, window time.Duration) (*domain.IngestSLOReport, error) {
+	if window <= 0 {
+		window = DefaultSLOWindow
+	}
+
+	if window > MaxSLOWindow {
+		return nil, fmt.Errorf("window cannot exceed %s", MaxSLOWindow)
+	}
+
+	now := time.Now()
+	stats, err := s.latencyRepo.GetStats(ctx, now.Add(-window), s.budget)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingest latency stats: %w", err)
+	}
+
+	compliance := 1.0
+	if stats.SampleCount > 0 {
+		compliance = float64(stats.SampleCount-stats.BreachedCount) / float64(stats.SampleCount)
+	}
+
+	s.mu.Lock()
+	alertingSince := s.alertingSince
+	s.mu.Unlock()
+
+	return &domain.IngestSLOReport{
+		IngestLatencyStats: *stats,
+		Window:             window.String(),
+		BudgetMs:           s.budget.Milliseconds(),
+		Objective:          s.objective,
+		Compliance:         compliance,
+		BudgetBreached:     compliance < s.objective,
+		AlertingSince:      alertingSince,
+		GeneratedAt:        now,
+	}, nil
+}
+
+// Monitor periodically evaluates the SLO over window, raising an alert when the error budget is
+// exhausted and clearing it on recovery. Blocks until the context is cancelled
+func (s *IngestSLOService) Monitor(ctx context.Context, interval, window time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.evaluate(ctx, window)
+		}
+	}
+}
+
+// evaluate checks the SLO once, publishing compliance to the ingest SLO metrics
+// A new breach is logged, counted and reported as alerting_since until the budget recovers
+func (s *IngestSLOService) evaluate(ctx context.Context, window time.Duration) {
+	report, err := s.Report(ctx, window)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to evaluate ingest latency SLO")
+		return
+	}
+
+	metrics.ObserveIngestSLO(report.Compliance, report.BudgetBreached)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case report.BudgetBreached && s.alertingSince == nil:
+		since := report.GeneratedAt
+		s.alertingSince = &since
+		metrics.IngestSLOBreached()
+		log.Error().
+			Float64("compliance", report.Compliance).
+			Float64("objective", report.Objective).
+			Float64("p95_ms", report.EndToEnd.P95Ms).
+			Int("breached_count", report.BreachedCount).
+			Int("sample_count", report.SampleCount).
+			Str("window", report.Window).
+			Msg("Ingest-to-notify latency SLO budget exhausted")
+	case !report.BudgetBreached && s.alertingSince != nil:
+		s.alertingSince = nil
+		log.Info().
+			Float64("compliance", report.Compliance).
+			Str("window", report.Window).
+			Msg("Ingest-to-notify latency SLO recovered")
+	}
+}
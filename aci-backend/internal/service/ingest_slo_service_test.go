@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/metrics"
+)
+
+// fakeIngestLatencyRepository returns fixed latency stats
+type fakeIngestLatencyRepository struct {
+	stats domain.IngestLatencyStats
+}
+
+func (r *fakeIngestLatencyRepository) RecordStages(ctx context.Context, articleID uuid.UUID, stages map[domain.IngestStage]time.Time) (*domain.IngestTiming, error) {
+	return &domain.IngestTiming{ArticleID: articleID}, nil
+}
+
+func (r *fakeIngestLatencyRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) (*domain.IngestTiming, error) {
+	return &domain.IngestTiming{ArticleID: articleID}, nil
+}
+
+func (r *fakeIngestLatencyRepository) GetStats(ctx context.Context, since time.Time, budget time.Duration) (*domain.IngestLatencyStats, error) {
+	stats := r.stats
+	return &stats, nil
+}
+
+// scrapeMetrics returns the exposition text served on /metrics
+func scrapeMetrics(t *testing.T) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestIngestSLOService_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeIngestLatencyRepository{stats: domain.IngestLatencyStats{SampleCount: 100, BreachedCount: 20}}
+	s := NewIngestSLOService(repo, time.Minute, 0.95)
+
+	// A breach raises the alert on the dashboard and in the metrics
+	s.evaluate(ctx, time.Hour)
+
+	report, err := s.Report(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, report.BudgetBreached)
+	require.NotNil(t, report.AlertingSince)
+	since := *report.AlertingSince
+
+	scraped := scrapeMetrics(t)
+	assert.Contains(t, scraped, "aci_ingest_slo_budget_breached 1")
+	assert.Contains(t, scraped, "aci_ingest_slo_compliance 0.8")
+	assert.Contains(t, scraped, "aci_ingest_slo_breaches_total 1")
+
+	// A continuing breach keeps its start and is not counted again
+	s.evaluate(ctx, time.Hour)
+
+	report, err = s.Report(ctx, time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, report.AlertingSince)
+	assert.Equal(t, since, *report.AlertingSince)
+	assert.Contains(t, scrapeMetrics(t), "aci_ingest_slo_breaches_total 1")
+
+	// Recovery clears the alert
+	repo.stats.BreachedCount = 1
+	s.evaluate(ctx, time.Hour)
+
+	report, err = s.Report(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, report.BudgetBreached)
+	assert.Nil(t, report.AlertingSince)
+
+	scraped = scrapeMetrics(t)
+	assert.Contains(t, scraped, "aci_ingest_slo_budget_breached 0")
+	assert.Contains(t, scraped, "aci_ingest_slo_compliance 0.99")
+}
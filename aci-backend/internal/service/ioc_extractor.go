@@ -0,0 +1,83 @@
+package service
+
+import (
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/util/ioc"
+)
+
+// IOCExtractor finds indicators of compromise in article text and merges them with AI-extracted ones
+// Extraction is deterministic, so articles carry IOCs even when AI enrichment is unavailable
+type IOCExtractor struct{}
+
+// NewIOCExtractor creates a new IOC extractor
+func NewIOCExtractor() *IOCExtractor {
+	return &IOCExtractor{}
+}
+
+// Extract returns the indicators found in an article's title and content
+func (e *IOCExtractor) Extract(title, content string) []domain.IOC {
+	indicators := ioc.Extract(title + "\n" + content)
+
+	iocs := make([]domain.IOC, 0, len(indicators))
+	for _, indicator := range indicators {
+		iocs = append(iocs, domain.IOC{
+			Type:    indicator.Type,
+			Value:   indicator.Value,
+			Context: indicator.Context,
+			Source:  domain.IOCSourceExtracted,
+		})
+	}
+
+	return iocs
+}
+
+// Merge combines IOC lists, deduplicated by type and normalized value
+// Entries are normalized (refanged, lowercased) and invalid ones are dropped. When the same
+// indicator appears in both lists, the first is kept and gains the second's context if it had none
+func (e *IOCExtractor) Merge(base, additional []domain.IOC) []domain.IOC {
+	merged := make([]domain.IOC, 0, len(base)+len(additional))
+	index := make(map[string]int)
+
+	for _, list := range [][]domain.IOC{base, additional} {
+		for _, entry := range list {
+			indicator, ok := ioc.Normalize(entry.Type, entry.Value)
+			if !ok {
+				continue
+			}
+
+			key := ioc.Key(indicator.Type, indicator.Value)
+			if i, exists := index[key]; exists {
+				if merged[i].Context == "" {
+					merged[i].Context = entry.Context
+				}
+				continue
+			}
+
+			iocContext := entry.Context
+			if iocContext == "" {
+				iocContext = indicator.Context
+			}
+
+			index[key] = len(merged)
+			merged = append(merged, domain.IOC{
+				Type:    indicator.Type,
+				Value:   indicator.Value,
+				Context: iocContext,
+				Source:  entry.Source,
+			})
+		}
+	}
+
+	return merged
+}
+
+// FromAI returns the AI-sourced entries of iocs, so they survive re-extraction after an edit
+func (e *IOCExtractor) FromAI(iocs []domain.IOC) []domain.IOC {
+	fromAI := make([]domain.IOC, 0)
+	for _, entry := range iocs {
+		if entry.Source == domain.IOCSourceAI {
+			fromAI = append(fromAI, entry)
+		}
+	}
+	return fromAI
+}
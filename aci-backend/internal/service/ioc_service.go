@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/ioc"
+)
+
+// IOCService keeps the normalized IOC tables in sync with articles and serves indicator search
+type IOCService struct {
+	iocRepo repository.IOCRepository
+}
+
+// NewIOCService creates a new IOC service instance
+func NewIOCService(iocRepo repository.IOCRepository) *IOCService {
+	if iocRepo == nil {
+		panic("iocRepo cannot be nil")
+	}
+
+	return &IOCService{
+		iocRepo: iocRepo,
+	}
+}
+
+// SyncArticle replaces an article's indicator links with its current IOCs
+// Failures are logged rather than returned; the article's own IOC list remains authoritative
+func (s *IOCService) SyncArticle(ctx context.Context, article *domain.Article) {
+	if article == nil {
+		return
+	}
+
+	if err := s.iocRepo.ReplaceForArticle(ctx, article.ID, article.IOCs); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", article.ID.String()).
+			Int("ioc_count", len(article.IOCs)).
+			Msg("Failed to sync article IOCs")
+	}
+}
+
+// Search returns a page of indicators matching the filter
+func (s *IOCService) Search(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, int, error) {
+	if filter == nil {
+		filter = domain.NewIndicatorFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	normalizeValuePrefix(filter)
+
+	return s.iocRepo.List(ctx, filter)
+}
+
+// Export returns up to domain.MaxIndicatorExport indicators matching the filter, ignoring pagination
+// Indicators flagged as bogus in enrichment feedback are never exported
+func (s *IOCService) Export(ctx context.Context, filter *domain.IndicatorFilter) ([]*domain.Indicator, error) {
+	if filter == nil {
+		filter = domain.NewIndicatorFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	exportFilter := *filter
+	exportFilter.Page = 1
+	exportFilter.PageSize = domain.MaxIndicatorExport
+	exportFilter.ExcludeFlagged = true
+	normalizeValuePrefix(&exportFilter)
+
+	indicators, total, err := s.iocRepo.List(ctx, &exportFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export iocs: %w", err)
+	}
+
+	if total > len(indicators) {
+		log.Warn().
+			Int("total", total).
+			Int("exported", len(indicators)).
+			Msg("IOC export truncated")
+	}
+
+	return indicators, nil
+}
+
+// normalizeValuePrefix refangs the prefix and lowercases it to match stored values
+// URL paths keep their case, so URL prefixes are only refanged
+func normalizeValuePrefix(filter *domain.IndicatorFilter) {
+	if filter.ValuePrefix == nil {
+		return
+	}
+
+	prefix := ioc.Refang(strings.TrimSpace(*filter.ValuePrefix))
+	if filter.Type == nil || *filter.Type != ioc.TypeURL {
+		prefix = strings.ToLower(prefix)
+	}
+	filter.ValuePrefix = &prefix
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultKEVFeedURL is the JSON feed of the CISA Known Exploited Vulnerabilities catalog
+	DefaultKEVFeedURL = "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"
+
+	// maxKEVFeedBytes bounds the catalog download; the feed is a few megabytes
+	maxKEVFeedBytes = 64 << 20
+
+	// kevDateLayout is the date format used in the feed
+	kevDateLayout = "2006-01-02"
+)
+
+// kevFeed is the CISA KEV catalog feed
+type kevFeed struct {
+	CatalogVersion  string `json:"catalogVersion"`
+	Vulnerabilities []struct {
+		CVEID                      string `json:"cveID"`
+		VendorProject              string `json:"vendorProject"`
+		Product                    string `json:"product"`
+		VulnerabilityName          string `json:"vulnerabilityName"`
+		DateAdded                  string `json:"dateAdded"`
+		ShortDescription           string `json:"shortDescription"`
+		RequiredAction             string `json:"requiredAction"`
+		DueDate                    string `json:"dueDate"`
+		KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+		Notes                      string `json:"notes"`
+	} `json:"vulnerabilities"`
+}
+
+// KEVService keeps a local copy of the CISA Known Exploited Vulnerabilities catalog
+// Articles mentioning a listed CVE are flagged and their alert matches get raised priority
+type KEVService struct {
+	kevRepo    repository.KEVRepository
+	feedURL    string
+	httpClient *http.Client
+}
+
+// NewKEVService creates a new KEV service that downloads the catalog from feedURL
+func NewKEVService(kevRepo repository.KEVRepository, feedURL string, timeout time.Duration) *KEVService {
+	if kevRepo == nil {
+		panic("kevRepo cannot be nil")
+	}
+
+	if feedURL == "" {
+		feedURL = DefaultKEVFeedURL
+	}
+
+	return &KEVService{
+		kevRepo:    kevRepo,
+		feedURL:    feedURL,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Sync downloads the catalog and replaces the local copy with it
+// An empty or unparseable catalog leaves the local copy unchanged
+func (s *KEVService) Sync(ctx context.Context) (*domain.KEVSyncResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.feedURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create KEV feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download KEV feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("KEV feed returned status %d", resp.StatusCode)
+	}
+
+	var feed kevFeed
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxKEVFeedBytes)).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("failed to decode KEV feed: %w", err)
+	}
+
+	vulnerabilities := make([]*domain.KEVVulnerability, 0, len(feed.Vulnerabilities))
+	for _, entry := range feed.Vulnerabilities {
+		cveID := strings.ToUpper(strings.TrimSpace(entry.CVEID))
+		if cveID == "" {
+			continue
+		}
+
+		dateAdded, err := time.Parse(kevDateLayout, entry.DateAdded)
+		if err != nil {
+			log.Warn().Str("cve_id", cveID).Str("date_added", entry.DateAdded).Msg("Skipping KEV entry with invalid dateAdded")
+			continue
+		}
+
+		v := &domain.KEVVulnerability{
+			CVEID:              cveID,
+			VendorProject:      entry.VendorProject,
+			Product:            entry.Product,
+			VulnerabilityName:  entry.VulnerabilityName,
+			ShortDescription:   entry.ShortDescription,
+			RequiredAction:     entry.RequiredAction,
+			DateAdded:          dateAdded,
+			KnownRansomwareUse: strings.EqualFold(entry.KnownRansomwareCampaignUse, "Known"),
+			Notes:              entry.Notes,
+		}
+
+		if dueDate, err := time.Parse(kevDateLayout, entry.DueDate); err == nil {
+			v.DueDate = &dueDate
+		}
+
+		vulnerabilities = append(vulnerabilities, v)
+	}
+
+	// Guard against a truncated or changed feed emptying the local copy
+	if len(vulnerabilities) == 0 {
+		return nil, fmt.Errorf("KEV feed contained no vulnerabilities")
+	}
+
+	syncedAt := time.Now().UTC()
+	removed, err := s.kevRepo.ReplaceAll(ctx, vulnerabilities, syncedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.KEVSyncResult{
+		CatalogVersion: feed.CatalogVersion,
+		Count:          len(vulnerabilities),
+		Removed:        removed,
+		SyncedAt:       syncedAt,
+	}, nil
+}
+
+// Status describes the local copy of the catalog
+func (s *KEVService) Status(ctx context.Context) (*domain.KEVCatalogStatus, error) {
+	return s.kevRepo.Status(ctx)
+}
+
+// Annotate sets the article's KEV flag and earliest due date from its CVEs
+func (s *KEVService) Annotate(ctx context.Context, article *domain.Article) error {
+	if len(article.CVEs) == 0 {
+		return nil
+	}
+
+	listed, err := s.kevRepo.GetByCVEs(ctx, article.CVEs)
+	if err != nil {
+		return err
+	}
+
+	if len(listed) == 0 {
+		return nil
+	}
+
+	// Entries are ordered by due date, earliest first
+	article.KEV = true
+	article.KEVDueDate = listed[0].DueDate
+	return nil
+}
+
+// Run syncs the catalog immediately and then every interval until the context is canceled
+func (s *KEVService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.Sync(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to sync KEV catalog")
+		} else {
+			log.Info().
+				Str("catalog_version", result.CatalogVersion).
+				Int("count", result.Count).
+				Int("removed", result.Removed).
+				Msg("Synced KEV catalog")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,819 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/newsletter"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/signedtoken"
+)
+
+var (
+	// ErrCampaignNotDraft is returned when a campaign that has already been sent is edited, deleted or sent again
+	ErrCampaignNotDraft = errors.New("only draft campaigns can be changed or sent")
+
+	// ErrSubscriptionTokenExpired is returned when a subscription is confirmed after its link expired
+	ErrSubscriptionTokenExpired = errors.New("subscription confirmation link has expired")
+)
+
+const (
+	// newsletterClaimTimeout is how long a claimed delivery stays with its sender before another
+	// sender may claim it, so deliveries held by a crashed instance are still sent
+	newsletterClaimTimeout = 10 * time.Minute
+
+	// newsletterSendTimeout bounds sending one message
+	newsletterSendTimeout = 30 * time.Second
+
+	// confirmationResendInterval is how soon another confirmation email can be sent to a pending
+	// address, so the sign-up form cannot be used to flood someone's inbox
+	confirmationResendInterval = 10 * time.Minute
+
+	// publicConsentSource is recorded for subscribers who signed up and confirmed themselves
+	publicConsentSource = "double-opt-in"
+
+	// Token purposes, so a confirmation token cannot unsubscribe and vice versa
+	confirmTokenPurpose     = "newsletter-confirm"
+	unsubscribeTokenPurpose = "newsletter-unsubscribe"
+)
+
+// NewsletterMailer sends one email with HTML and plain-text alternatives
+// Errors that retrying will not fix, such as a rejected mailbox, implement Permanent() bool
+type NewsletterMailer interface {
+	Send(ctx context.Context, to, subject, htmlBody, textBody string, headers map[string]string) error
+}
+
+// permanentError is implemented by mailer errors for messages that will never be accepted
+type permanentError interface {
+	Permanent() bool
+}
+
+// NewsletterServiceConfig configures the newsletter service
+type NewsletterServiceConfig struct {
+	BaseURL     string        // public origin of this API, prefixed to the tracking links
+	SiteURL     string        // origin of the web app, which hosts the confirm and unsubscribe pages
+	BatchSize   int           // messages sent per SendPending
+	TokenSecret string        // signs confirmation and unsubscribe tokens
+	ConfirmTTL  time.Duration // how long a confirmation link stays valid
+}
+
+// CampaignInput is the content of a newsletter campaign as composed by an admin
+type CampaignInput struct {
+	Subject    string
+	Preheader  *string
+	Intro      *string
+	Template   string // defaults to newsletter.DefaultTemplate
+	ArticleIDs []uuid.UUID
+}
+
+// NewsletterPreview is a campaign rendered without tracking links
+type NewsletterPreview struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+// NewsletterService composes newsletters from selected articles and sends them to subscribers
+// Sending a campaign queues one delivery per subscriber; the send worker claims deliveries in
+// batches and renders each recipient's copy with their own open pixel, click-tracking and
+// unsubscribe links, which all carry the delivery ID. Clicks redirect to the article on the
+// web app, tagged with the campaign for the site's own analytics.
+// Anyone can sign up with an email address; the subscription stays pending until the address
+// is confirmed through a signed link (double opt-in). Addresses on the suppression list, such
+// as ones the relay rejected as undeliverable, are never mailed
+type NewsletterService struct {
+	newsletterRepo repository.NewsletterRepository
+	articleRepo    repository.ArticleRepository
+	mailer         NewsletterMailer
+	cfg            NewsletterServiceConfig
+	tokenSecret    []byte
+}
+
+// NewNewsletterService creates a new newsletter service instance
+func NewNewsletterService(
+	newsletterRepo repository.NewsletterRepository,
+	articleRepo repository.ArticleRepository,
+	mailer NewsletterMailer,
+	cfg NewsletterServiceConfig,
+) *NewsletterService {
+	if newsletterRepo == nil {
+		panic("newsletterRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if mailer == nil {
+		panic("mailer cannot be nil")
+	}
+	if cfg.TokenSecret == "" {
+		panic("token secret cannot be empty")
+	}
+
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	cfg.SiteURL = strings.TrimRight(cfg.SiteURL, "/")
+
+	return &NewsletterService{
+		newsletterRepo: newsletterRepo,
+		articleRepo:    articleRepo,
+		mailer:         mailer,
+		cfg:            cfg,
+		tokenSecret:    []byte(cfg.TokenSecret),
+	}
+}
+
+// Templates returns the names of the available newsletter templates
+func (s *NewsletterService) Templates() []string {
+	return newsletter.Templates()
+}
+
+// AddSubscriber adds an opted-in subscriber, or re-subscribes an existing address
+// consentSource records how the subscriber opted in and is required
+func (s *NewsletterService) AddSubscriber(ctx context.Context, email string, name *string, consentSource string) (*domain.NewsletterSubscriber, error) {
+	now := time.Now()
+	subscriber := &domain.NewsletterSubscriber{
+		ID:            uuid.New(),
+		Email:         strings.ToLower(strings.TrimSpace(email)),
+		Name:          trimOptional(name),
+		Status:        domain.SubscriberStatusSubscribed,
+		ConsentSource: strings.TrimSpace(consentSource),
+		SubscribedAt:  now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := subscriber.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "subscriber", Message: err.Error()}
+	}
+
+	suppressed, err := s.newsletterRepo.IsSuppressed(ctx, subscriber.Email)
+	if err != nil {
+		return nil, err
+	}
+	if suppressed {
+		return nil, &domainerrors.ValidationError{Field: "email", Message: "address is on the suppression list"}
+	}
+
+	if err := s.newsletterRepo.UpsertSubscriber(ctx, subscriber); err != nil {
+		return nil, err
+	}
+
+	return subscriber, nil
+}
+
+// ListSubscribers returns subscribers matching the filter
+func (s *NewsletterService) ListSubscribers(ctx context.Context, filter *domain.SubscriberFilter) ([]*domain.NewsletterSubscriber, int, error) {
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, 0, &domainerrors.ValidationError{Field: "status", Message: "status must be pending, subscribed or unsubscribed"}
+	}
+
+	return s.newsletterRepo.ListSubscribers(ctx, filter)
+}
+
+// Unsubscribe unsubscribes a subscriber on their behalf
+func (s *NewsletterService) Unsubscribe(ctx context.Context, id uuid.UUID) error {
+	return s.newsletterRepo.Unsubscribe(ctx, id)
+}
+
+// Subscribe records a public sign-up and emails a confirmation link to addresses that still need one
+// The outcome looks the same whether the address is new, pending, already subscribed or suppressed,
+// so the sign-up form cannot be used to find out who is on the list
+func (s *NewsletterService) Subscribe(ctx context.Context, email string, name *string) error {
+	now := time.Now()
+	subscriber := &domain.NewsletterSubscriber{
+		ID:            uuid.New(),
+		Email:         strings.ToLower(strings.TrimSpace(email)),
+		Name:          trimOptional(name),
+		Status:        domain.SubscriberStatusPending,
+		ConsentSource: publicConsentSource,
+		SubscribedAt:  now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := subscriber.Validate(); err != nil {
+		return &domainerrors.ValidationError{Field: "subscriber", Message: err.Error()}
+	}
+
+	suppressed, err := s.newsletterRepo.IsSuppressed(ctx, subscriber.Email)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		return nil
+	}
+
+	stored, err := s.newsletterRepo.UpsertPendingSubscriber(ctx, subscriber)
+	if err != nil {
+		return err
+	}
+
+	if stored.Status != domain.SubscriberStatusPending {
+		return nil
+	}
+
+	if stored.ConfirmationSentAt != nil && now.Sub(*stored.ConfirmationSentAt) < confirmationResendInterval {
+		return nil
+	}
+
+	if err := s.sendConfirmation(ctx, stored, now); err != nil {
+		if !isPermanent(err) {
+			return fmt.Errorf("failed to send confirmation email: %w", err)
+		}
+
+		// The address does not exist; keep it from being mailed again
+		log.Warn().Err(err).Str("subscriber_id", stored.ID.String()).Msg("Newsletter confirmation bounced")
+		return s.suppressBounce(ctx, stored.Email, err)
+	}
+
+	return s.newsletterRepo.MarkConfirmationSent(ctx, stored.ID, now)
+}
+
+// Confirm subscribes the pending subscriber a confirmation token was issued to
+// Confirming an address that is already subscribed succeeds, so following the link twice is harmless
+func (s *NewsletterService) Confirm(ctx context.Context, token string) error {
+	id, err := s.verifyToken(confirmTokenPurpose, token)
+	if err != nil {
+		return err
+	}
+
+	err = s.newsletterRepo.ConfirmSubscriber(ctx, id, time.Now())
+	if err == nil {
+		return nil
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		return err
+	}
+
+	subscriber, getErr := s.newsletterRepo.GetSubscriber(ctx, id)
+	if getErr != nil {
+		return getErr
+	}
+	if subscriber.Status == domain.SubscriberStatusSubscribed {
+		return nil
+	}
+
+	return err
+}
+
+// UnsubscribeByToken unsubscribes the subscriber an unsubscribe token was issued to
+func (s *NewsletterService) UnsubscribeByToken(ctx context.Context, token string) error {
+	id, err := s.verifyToken(unsubscribeTokenPurpose, token)
+	if err != nil {
+		return err
+	}
+
+	return s.newsletterRepo.Unsubscribe(ctx, id)
+}
+
+// ExportSubscribersCSV writes subscribers with the given status, or all subscribers when nil, to w as CSV
+func (s *NewsletterService) ExportSubscribersCSV(ctx context.Context, status *domain.SubscriberStatus, w io.Writer) error {
+	if status != nil && !status.IsValid() {
+		return &domainerrors.ValidationError{Field: "status", Message: "status must be pending, subscribed or unsubscribed"}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"id", "email", "name", "status", "consent_source", "subscribed_at",
+		"unsubscribed_at", "confirmed_at", "suppressed", "created_at",
+	}); err != nil {
+		return fmt.Errorf("failed to write subscriber export: %w", err)
+	}
+
+	err := s.newsletterRepo.EachSubscriber(ctx, status, func(subscriber *domain.NewsletterSubscriber) error {
+		record := []string{
+			subscriber.ID.String(),
+			subscriber.Email,
+			stringValue(subscriber.Name),
+			string(subscriber.Status),
+			subscriber.ConsentSource,
+			subscriber.SubscribedAt.UTC().Format(time.RFC3339),
+			timeString(subscriber.UnsubscribedAt),
+			timeString(subscriber.ConfirmedAt),
+			fmt.Sprint(subscriber.Suppressed),
+			subscriber.CreatedAt.UTC().Format(time.RFC3339),
+		}
+
+		if err := writer.Write(sanitizeCSVRecord(record)); err != nil {
+			return fmt.Errorf("failed to write subscriber export: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write subscriber export: %w", err)
+	}
+
+	return nil
+}
+
+// AddSuppression puts an address on the suppression list on an admin's behalf
+// An address that is already suppressed keeps its original entry, which is returned
+func (s *NewsletterService) AddSuppression(ctx context.Context, userID uuid.UUID, email string, reason domain.SuppressionReason, details *string) (*domain.NewsletterSuppression, error) {
+	suppression := &domain.NewsletterSuppression{
+		ID:        uuid.New(),
+		Email:     strings.ToLower(strings.TrimSpace(email)),
+		Reason:    reason,
+		Details:   trimOptional(details),
+		CreatedBy: &userID,
+		CreatedAt: time.Now(),
+	}
+
+	if suppression.Reason == "" {
+		suppression.Reason = domain.SuppressionReasonManual
+	}
+
+	if err := suppression.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "suppression", Message: err.Error()}
+	}
+
+	if err := s.newsletterRepo.AddSuppression(ctx, suppression); err != nil {
+		return nil, err
+	}
+
+	return suppression, nil
+}
+
+// ListSuppressions returns a page of suppressed addresses, newest first
+func (s *NewsletterService) ListSuppressions(ctx context.Context, limit, offset int) ([]*domain.NewsletterSuppression, int, error) {
+	return s.newsletterRepo.ListSuppressions(ctx, limit, offset)
+}
+
+// RemoveSuppression takes an address off the suppression list so it can be mailed again
+func (s *NewsletterService) RemoveSuppression(ctx context.Context, id uuid.UUID) error {
+	return s.newsletterRepo.DeleteSuppression(ctx, id)
+}
+
+// CreateCampaign creates a draft campaign
+func (s *NewsletterService) CreateCampaign(ctx context.Context, userID uuid.UUID, input CampaignInput) (*domain.NewsletterCampaign, error) {
+	now := time.Now()
+	campaign := &domain.NewsletterCampaign{
+		ID:        uuid.New(),
+		Status:    domain.CampaignStatusDraft,
+		CreatedBy: &userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.applyInput(ctx, campaign, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.newsletterRepo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	campaign.Stats = &domain.CampaignStats{}
+	return campaign, nil
+}
+
+// GetCampaign returns a campaign with its delivery stats
+func (s *NewsletterService) GetCampaign(ctx context.Context, id uuid.UUID) (*domain.NewsletterCampaign, error) {
+	return s.newsletterRepo.GetCampaign(ctx, id)
+}
+
+// ListCampaigns returns a page of campaigns, newest first
+func (s *NewsletterService) ListCampaigns(ctx context.Context, limit, offset int) ([]*domain.NewsletterCampaign, int, error) {
+	return s.newsletterRepo.ListCampaigns(ctx, limit, offset)
+}
+
+// UpdateCampaign replaces a draft's content
+func (s *NewsletterService) UpdateCampaign(ctx context.Context, id uuid.UUID, input CampaignInput) (*domain.NewsletterCampaign, error) {
+	campaign, err := s.newsletterRepo.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Status != domain.CampaignStatusDraft {
+		return nil, ErrCampaignNotDraft
+	}
+
+	if err := s.applyInput(ctx, campaign, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.newsletterRepo.UpdateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// DeleteCampaign deletes a draft
+func (s *NewsletterService) DeleteCampaign(ctx context.Context, id uuid.UUID) error {
+	campaign, err := s.newsletterRepo.GetCampaign(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if campaign.Status != domain.CampaignStatusDraft {
+		return ErrCampaignNotDraft
+	}
+
+	return s.newsletterRepo.DeleteCampaign(ctx, id)
+}
+
+// Preview renders a campaign as subscribers will see it, with direct article links
+func (s *NewsletterService) Preview(ctx context.Context, id uuid.UUID) (*NewsletterPreview, error) {
+	campaign, err := s.newsletterRepo.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.loadArticles(ctx, campaign.ArticleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := s.renderData(campaign, articles, func(article *domain.Article) string {
+		return s.cfg.SiteURL + "/threats/" + article.ID.String()
+	})
+	data.UnsubscribeURL = s.cfg.BaseURL + "/n/u/preview"
+
+	html, text, err := newsletter.Render(campaign.Template, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NewsletterPreview{Subject: campaign.Subject, HTML: html, Text: text}, nil
+}
+
+// Send queues a draft for delivery to every current subscriber; the send worker delivers it
+// The featured articles must all still be published
+func (s *NewsletterService) Send(ctx context.Context, id, userID uuid.UUID) (*domain.NewsletterCampaign, error) {
+	campaign, err := s.newsletterRepo.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Status != domain.CampaignStatusDraft {
+		return nil, ErrCampaignNotDraft
+	}
+
+	if len(campaign.ArticleIDs) == 0 {
+		return nil, &domainerrors.ValidationError{Field: "article_ids", Message: "a newsletter must feature at least one article"}
+	}
+
+	if _, err := s.loadArticles(ctx, campaign.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	recipients, err := s.newsletterRepo.StartCampaign(ctx, id, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("campaign_id", id.String()).
+		Int("recipients", recipients).
+		Msg("Newsletter campaign queued for sending")
+
+	return s.newsletterRepo.GetCampaign(ctx, id)
+}
+
+// TrackOpen records that a recipient opened their newsletter
+func (s *NewsletterService) TrackOpen(ctx context.Context, deliveryID uuid.UUID) error {
+	return s.newsletterRepo.RecordOpen(ctx, deliveryID, time.Now())
+}
+
+// TrackClick records a click on a newsletter article and returns the article URL to redirect to
+// The click is validated against the campaign, so the links cannot be used as an open redirect
+func (s *NewsletterService) TrackClick(ctx context.Context, deliveryID, articleID uuid.UUID) (string, error) {
+	campaignID, err := s.newsletterRepo.RecordClick(ctx, deliveryID, articleID, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("utm_source", "newsletter")
+	query.Set("utm_medium", "email")
+	query.Set("utm_campaign", campaignID.String())
+
+	return s.cfg.SiteURL + "/threats/" + articleID.String() + "?" + query.Encode(), nil
+}
+
+// UnsubscribeByDelivery unsubscribes the recipient of a newsletter through its unsubscribe link
+func (s *NewsletterService) UnsubscribeByDelivery(ctx context.Context, deliveryID uuid.UUID) error {
+	return s.newsletterRepo.UnsubscribeByDelivery(ctx, deliveryID)
+}
+
+// SendPending sends one batch of queued deliveries and marks finished campaigns as sent
+// It returns how many messages were sent
+func (s *NewsletterService) SendPending(ctx context.Context) (int, error) {
+	deliveries, err := s.newsletterRepo.ClaimDeliveries(ctx, s.cfg.BatchSize, time.Now().Add(-newsletterClaimTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	campaigns := make(map[uuid.UUID]*domain.NewsletterCampaign)
+	articles := make(map[uuid.UUID][]*domain.Article)
+	sent := 0
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			// Unsent claims expire and are picked up again
+			return sent, ctx.Err()
+		}
+
+		campaign, ok := campaigns[delivery.CampaignID]
+		if !ok {
+			campaign, err = s.newsletterRepo.GetCampaign(ctx, delivery.CampaignID)
+			if err != nil {
+				return sent, err
+			}
+
+			// Articles unpublished since the campaign was queued are left out rather than failing the send
+			featured := make([]*domain.Article, 0, len(campaign.ArticleIDs))
+			for _, articleID := range campaign.ArticleIDs {
+				article, err := s.articleRepo.GetByID(ctx, articleID)
+				if err != nil {
+					if strings.Contains(err.Error(), "not found") {
+						continue
+					}
+					return sent, err
+				}
+				if article.IsPublished {
+					featured = append(featured, article)
+				}
+			}
+
+			campaigns[delivery.CampaignID] = campaign
+			articles[delivery.CampaignID] = featured
+		}
+
+		status := domain.DeliveryStatusSent
+		var errMsg *string
+		if err := s.deliver(ctx, campaign, articles[delivery.CampaignID], delivery); err != nil {
+			log.Warn().
+				Err(err).
+				Str("campaign_id", campaign.ID.String()).
+				Str("delivery_id", delivery.ID.String()).
+				Msg("Failed to send newsletter")
+
+			status = domain.DeliveryStatusFailed
+			message := err.Error()
+			errMsg = &message
+
+			if isPermanent(err) {
+				if err := s.suppressBounce(ctx, delivery.Email, err); err != nil {
+					return sent, err
+				}
+			}
+		} else {
+			sent++
+		}
+
+		if err := s.newsletterRepo.MarkDelivery(ctx, delivery.ID, status, errMsg, time.Now()); err != nil {
+			return sent, err
+		}
+	}
+
+	completed, err := s.newsletterRepo.CompleteCampaigns(ctx)
+	if err != nil {
+		return sent, err
+	}
+
+	if completed > 0 {
+		log.Info().Int64("campaigns", completed).Msg("Newsletter campaigns sent")
+	}
+
+	return sent, nil
+}
+
+// Run sends queued deliveries on every interval until the context is cancelled
+func (s *NewsletterService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := s.SendPending(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to send newsletters")
+			}
+			if sent > 0 {
+				log.Debug().Int("sent", sent).Msg("Sent newsletters")
+			}
+		}
+	}
+}
+
+// deliver renders a recipient's copy of the campaign and sends it
+func (s *NewsletterService) deliver(ctx context.Context, campaign *domain.NewsletterCampaign, articles []*domain.Article, delivery *domain.NewsletterDelivery) error {
+	trackingBase := s.cfg.BaseURL + "/n"
+	deliveryID := delivery.ID.String()
+
+	data := s.renderData(campaign, articles, func(article *domain.Article) string {
+		return trackingBase + "/c/" + deliveryID + "/" + article.ID.String()
+	})
+	data.UnsubscribeURL = trackingBase + "/u/" + deliveryID
+	data.OpenPixelURL = trackingBase + "/o/" + deliveryID
+
+	html, text, err := newsletter.Render(campaign.Template, data)
+	if err != nil {
+		return err
+	}
+
+	to := delivery.Email
+	if delivery.Name != nil {
+		to = (&mail.Address{Name: *delivery.Name, Address: delivery.Email}).String()
+	}
+
+	headers := map[string]string{
+		"List-Unsubscribe":      "<" + data.UnsubscribeURL + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, newsletterSendTimeout)
+	defer cancel()
+
+	return s.mailer.Send(sendCtx, to, campaign.Subject, html, text, headers)
+}
+
+// sendConfirmation emails a pending subscriber their confirmation link
+func (s *NewsletterService) sendConfirmation(ctx context.Context, subscriber *domain.NewsletterSubscriber, now time.Time) error {
+	confirmToken := signedtoken.New(s.tokenSecret, confirmTokenPurpose, subscriber.ID, now.Add(s.cfg.ConfirmTTL))
+	unsubscribeToken := signedtoken.New(s.tokenSecret, unsubscribeTokenPurpose, subscriber.ID, time.Time{})
+
+	data := newsletter.ConfirmationData{
+		ConfirmURL:     s.cfg.SiteURL + "/newsletter/confirm?token=" + url.QueryEscape(confirmToken),
+		UnsubscribeURL: s.cfg.SiteURL + "/newsletter/unsubscribe?token=" + url.QueryEscape(unsubscribeToken),
+		ExpiresIn:      humanizeDuration(s.cfg.ConfirmTTL),
+	}
+
+	to := subscriber.Email
+	if subscriber.Name != nil {
+		data.Name = *subscriber.Name
+		to = (&mail.Address{Name: *subscriber.Name, Address: subscriber.Email}).String()
+	}
+
+	html, text, err := newsletter.RenderConfirmation(data)
+	if err != nil {
+		return err
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, newsletterSendTimeout)
+	defer cancel()
+
+	return s.mailer.Send(sendCtx, to, newsletter.ConfirmationSubject, html, text, nil)
+}
+
+// verifyToken returns the subscriber ID a token was issued to
+func (s *NewsletterService) verifyToken(purpose, token string) (uuid.UUID, error) {
+	id, err := signedtoken.Verify(s.tokenSecret, purpose, token, time.Now())
+	if errors.Is(err, signedtoken.ErrExpired) {
+		return uuid.Nil, ErrSubscriptionTokenExpired
+	}
+	if err != nil {
+		return uuid.Nil, &domainerrors.ValidationError{Field: "token", Message: "token is invalid"}
+	}
+
+	return id, nil
+}
+
+// suppressBounce puts an address the relay rejected on the suppression list
+func (s *NewsletterService) suppressBounce(ctx context.Context, email string, sendErr error) error {
+	details := sendErr.Error()
+	if len(details) > 2000 {
+		details = details[:2000]
+	}
+
+	return s.newsletterRepo.AddSuppression(ctx, &domain.NewsletterSuppression{
+		ID:        uuid.New(),
+		Email:     strings.ToLower(email),
+		Reason:    domain.SuppressionReasonBounce,
+		Details:   &details,
+		CreatedAt: time.Now(),
+	})
+}
+
+// isPermanent reports whether a mailer error means the message will never be accepted
+func isPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent) && permanent.Permanent()
+}
+
+// humanizeDuration describes a link lifetime in whole days or hours, e.g. 3 days
+func humanizeDuration(d time.Duration) string {
+	if d >= 48*time.Hour && d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%d days", d/(24*time.Hour))
+	}
+
+	hours := int(d.Round(time.Hour) / time.Hour)
+	if hours <= 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
+
+// timeString formats an optional time as RFC 3339, empty when unset
+func timeString(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// renderData builds the template data for a campaign; articleURL gives each article's link
+func (s *NewsletterService) renderData(campaign *domain.NewsletterCampaign, articles []*domain.Article, articleURL func(*domain.Article) string) newsletter.Data {
+	data := newsletter.Data{
+		Subject:  campaign.Subject,
+		Articles: make([]newsletter.Article, 0, len(articles)),
+	}
+	if campaign.Preheader != nil {
+		data.Preheader = *campaign.Preheader
+	}
+	if campaign.Intro != nil {
+		data.Intro = *campaign.Intro
+	}
+
+	for _, article := range articles {
+		item := newsletter.Article{
+			Title:    article.Title,
+			Severity: string(article.Severity),
+			URL:      articleURL(article),
+		}
+		if article.Summary != nil {
+			item.Summary = *article.Summary
+		}
+		if article.Category != nil {
+			item.Category = article.Category.Name
+		}
+		data.Articles = append(data.Articles, item)
+	}
+
+	return data
+}
+
+// applyInput validates campaign content and copies it onto the campaign
+func (s *NewsletterService) applyInput(ctx context.Context, campaign *domain.NewsletterCampaign, input CampaignInput) error {
+	campaign.Subject = strings.TrimSpace(input.Subject)
+	campaign.Preheader = trimOptional(input.Preheader)
+	campaign.Intro = trimOptional(input.Intro)
+	campaign.Template = strings.TrimSpace(input.Template)
+	if campaign.Template == "" {
+		campaign.Template = newsletter.DefaultTemplate
+	}
+	campaign.ArticleIDs = input.ArticleIDs
+	if campaign.ArticleIDs == nil {
+		campaign.ArticleIDs = []uuid.UUID{}
+	}
+
+	if err := campaign.Validate(); err != nil {
+		return &domainerrors.ValidationError{Field: "campaign", Message: err.Error()}
+	}
+
+	if !newsletter.Exists(campaign.Template) {
+		return &domainerrors.ValidationError{
+			Field:   "template",
+			Message: fmt.Sprintf("template must be one of: %s", strings.Join(newsletter.Templates(), ", ")),
+		}
+	}
+
+	_, err := s.loadArticles(ctx, campaign.ArticleIDs)
+	return err
+}
+
+// loadArticles loads the featured articles in order; every one must exist and be published
+func (s *NewsletterService) loadArticles(ctx context.Context, ids []uuid.UUID) ([]*domain.Article, error) {
+	articles := make([]*domain.Article, 0, len(ids))
+
+	for _, id := range ids {
+		article, err := s.articleRepo.GetByID(ctx, id)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				return nil, &domainerrors.ValidationError{Field: "article_ids", Message: fmt.Sprintf("article %s not found", id)}
+			}
+			return nil, err
+		}
+
+		if !article.IsPublished {
+			return nil, &domainerrors.ValidationError{Field: "article_ids", Message: fmt.Sprintf("article %s is not published", id)}
+		}
+
+		articles = append(articles, article)
+	}
+
+	return articles, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// preferenceCacheTTL bounds how long another instance's preference changes can go unnoticed
+const preferenceCacheTTL = time.Minute
+
+// NotificationPreferenceService manages per-user notification preferences
+// Lookups are cached briefly because they run for every recipient of every notification
+type NotificationPreferenceService struct {
+	prefsRepo repository.NotificationPreferenceRepository
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedPreferences
+}
+
+type cachedPreferences struct {
+	prefs    *domain.NotificationPreferences
+	loadedAt time.Time
+}
+
+// NewNotificationPreferenceService creates a new notification preference service instance
+func NewNotificationPreferenceService(prefsRepo repository.NotificationPreferenceRepository) *NotificationPreferenceService {
+	if prefsRepo == nil {
+		panic("prefsRepo cannot be nil")
+	}
+
+	return &NotificationPreferenceService{
+		prefsRepo: prefsRepo,
+		cache:     make(map[uuid.UUID]cachedPreferences),
+	}
+}
+
+// Get returns a user's preferences, or the defaults if they have not saved any
+func (s *NotificationPreferenceService) Get(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error) {
+	prefs, err := s.prefsRepo.Get(ctx, userID)
+	if err != nil {
+		var notFound *domainerrors.NotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+		prefs = domain.DefaultNotificationPreferences(userID)
+	}
+
+	s.store(prefs)
+	return prefs, nil
+}
+
+// Update validates and saves a user's preferences
+// Channels missing from prefs keep their defaults so clients can send partial channel maps
+func (s *NotificationPreferenceService) Update(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	if prefs.Channels == nil {
+		prefs.Channels = make(map[domain.NotificationChannel]domain.ChannelPreference)
+	}
+
+	defaults := domain.DefaultNotificationPreferences(prefs.UserID)
+	for channel, pref := range defaults.Channels {
+		if _, ok := prefs.Channels[channel]; !ok {
+			prefs.Channels[channel] = pref
+		}
+	}
+
+	for channel, pref := range prefs.Channels {
+		if pref.Events == nil {
+			pref.Events = map[domain.NotificationEvent]bool{}
+		}
+		if pref.MinSeverity == "" {
+			pref.MinSeverity = domain.SeverityInformational
+		}
+		prefs.Channels[channel] = pref
+	}
+
+	if prefs.DigestFrequency == "" {
+		prefs.DigestFrequency = defaults.DigestFrequency
+	}
+
+	if err := prefs.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "preferences", Message: err.Error()}
+	}
+
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, err
+	}
+
+	s.store(prefs)
+	return prefs, nil
+}
+
+// Allows reports whether a user should receive an event on channel
+// Lookup failures fall back to the defaults so a database error never silences notifications
+func (s *NotificationPreferenceService) Allows(ctx context.Context, userID uuid.UUID, channel domain.NotificationChannel, event domain.NotificationEvent, severity domain.Severity) bool {
+	return s.lookup(ctx, userID).Allows(channel, event, severity)
+}
+
+// lookup returns cached preferences, loading them when missing or stale
+func (s *NotificationPreferenceService) lookup(ctx context.Context, userID uuid.UUID) *domain.NotificationPreferences {
+	s.mu.Lock()
+	cached, ok := s.cache[userID]
+	s.mu.Unlock()
+
+	if ok && time.Since(cached.loadedAt) < preferenceCacheTTL {
+		return cached.prefs
+	}
+
+	prefs, err := s.Get(ctx, userID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("Failed to load notification preferences, using defaults")
+		return domain.DefaultNotificationPreferences(userID)
+	}
+
+	return prefs
+}
+
+func (s *NotificationPreferenceService) store(prefs *domain.NotificationPreferences) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache[prefs.UserID] = cachedPreferences{prefs: prefs, loadedAt: time.Now()}
+}
@@ -1,18 +1,25 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
 	"github.com/phillipboles/aci-backend/internal/websocket"
 	"github.com/rs/zerolog/log"
 )
 
+// preferenceLookupTimeout bounds preference lookups for a single notification
+const preferenceLookupTimeout = 5 * time.Second
+
 // NotificationService handles broadcasting notifications via WebSocket
 type NotificationService struct {
-	hub *websocket.Hub
+	hub         *websocket.Hub
+	preferences *NotificationPreferenceService
 }
 
 // NewNotificationService creates a new notification service
@@ -26,6 +33,11 @@ func NewNotificationService(hub *websocket.Hub) (*NotificationService, error) {
 	}, nil
 }
 
+// SetPreferenceService filters deliveries by each user's notification preferences
+func (s *NotificationService) SetPreferenceService(preferences *NotificationPreferenceService) {
+	s.preferences = preferences
+}
+
 // NotifyNewArticle broadcasts new article to appropriate channels
 // Broadcasts to:
 // - articles:all
@@ -38,32 +50,34 @@ func (s *NotificationService) NotifyNewArticle(article *domain.Article) error {
 	}
 
 	// Create message
-	msg, err := websocket.NewMessage(websocket.MessageTypeArticleNew, article)
+	msg, err := websocket.NewMessage(websocket.MessageTypeArticleNew, article.ForDisplay())
 	if err != nil {
 		return fmt.Errorf("failed to create message: %w", err)
 	}
 
+	broadcast := s.articleBroadcaster(domain.NotificationEventArticleNew, article)
+
 	// Broadcast to articles:all
-	s.hub.Broadcast(websocket.ChannelArticlesAll, msg)
+	broadcast(websocket.ChannelArticlesAll, msg)
 
 	// Broadcast to severity-specific channels
 	switch article.Severity {
 	case domain.SeverityCritical:
-		s.hub.Broadcast(websocket.ChannelArticlesCritical, msg)
+		broadcast(websocket.ChannelArticlesCritical, msg)
 	case domain.SeverityHigh:
-		s.hub.Broadcast(websocket.ChannelArticlesHigh, msg)
+		broadcast(websocket.ChannelArticlesHigh, msg)
 	}
 
 	// Broadcast to category channel
 	if article.Category != nil {
 		categoryChannel := websocket.BuildCategoryChannel(article.Category.Slug)
-		s.hub.Broadcast(categoryChannel, msg)
+		broadcast(categoryChannel, msg)
 	}
 
 	// Broadcast to vendor channels
 	for _, vendor := range article.Vendors {
 		vendorChannel := websocket.BuildVendorChannel(strings.ToLower(vendor))
-		s.hub.Broadcast(vendorChannel, msg)
+		broadcast(vendorChannel, msg)
 	}
 
 	log.Info().
@@ -88,32 +102,34 @@ func (s *NotificationService) NotifyArticleUpdated(article *domain.Article) erro
 	}
 
 	// Create message
-	msg, err := websocket.NewMessage(websocket.MessageTypeArticleUpdated, article)
+	msg, err := websocket.NewMessage(websocket.MessageTypeArticleUpdated, article.ForDisplay())
 	if err != nil {
 		return fmt.Errorf("failed to create message: %w", err)
 	}
 
+	broadcast := s.articleBroadcaster(domain.NotificationEventArticleUpdated, article)
+
 	// Broadcast to articles:all
-	s.hub.Broadcast(websocket.ChannelArticlesAll, msg)
+	broadcast(websocket.ChannelArticlesAll, msg)
 
 	// Broadcast to severity-specific channels
 	switch article.Severity {
 	case domain.SeverityCritical:
-		s.hub.Broadcast(websocket.ChannelArticlesCritical, msg)
+		broadcast(websocket.ChannelArticlesCritical, msg)
 	case domain.SeverityHigh:
-		s.hub.Broadcast(websocket.ChannelArticlesHigh, msg)
+		broadcast(websocket.ChannelArticlesHigh, msg)
 	}
 
 	// Broadcast to category channel
 	if article.Category != nil {
 		categoryChannel := websocket.BuildCategoryChannel(article.Category.Slug)
-		s.hub.Broadcast(categoryChannel, msg)
+		broadcast(categoryChannel, msg)
 	}
 
 	// Broadcast to vendor channels
 	for _, vendor := range article.Vendors {
 		vendorChannel := websocket.BuildVendorChannel(strings.ToLower(vendor))
-		s.hub.Broadcast(vendorChannel, msg)
+		broadcast(vendorChannel, msg)
 	}
 
 	log.Info().
@@ -135,6 +151,19 @@ func (s *NotificationService) NotifyAlertMatch(userID uuid.UUID, match *domain.A
 		return fmt.Errorf("alert match is required")
 	}
 
+	if s.preferences != nil {
+		ctx, cancel := context.WithTimeout(repository.WithoutTenant(context.Background()), preferenceLookupTimeout)
+		defer cancel()
+
+		if !s.preferences.Allows(ctx, userID, domain.NotificationChannelWebSocket, domain.NotificationEventAlertMatch, alertMatchSeverity(match)) {
+			log.Debug().
+				Str("user_id", userID.String()).
+				Str("alert_id", match.AlertID.String()).
+				Msg("Alert match notification suppressed by user preferences")
+			return nil
+		}
+	}
+
 	// Create message
 	msg, err := websocket.NewMessage(websocket.MessageTypeAlertMatch, match)
 	if err != nil {
@@ -154,6 +183,91 @@ func (s *NotificationService) NotifyAlertMatch(userID uuid.UUID, match *domain.A
 	return nil
 }
 
+// NotifyAlertBackfill sends the summary of a new alert's backfill to its owner
+// It is gated by the same preference as alert matches, using the most urgent match found
+func (s *NotificationService) NotifyAlertBackfill(userID uuid.UUID, summary *domain.AlertBackfillSummary) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if summary == nil {
+		return fmt.Errorf("backfill summary is required")
+	}
+
+	if s.preferences != nil {
+		severity := domain.SeverityInformational
+		if len(summary.TopMatches) > 0 {
+			severity = alertMatchSeverity(summary.TopMatches[0])
+		}
+
+		ctx, cancel := context.WithTimeout(repository.WithoutTenant(context.Background()), preferenceLookupTimeout)
+		defer cancel()
+
+		if !s.preferences.Allows(ctx, userID, domain.NotificationChannelWebSocket, domain.NotificationEventAlertMatch, severity) {
+			log.Debug().
+				Str("user_id", userID.String()).
+				Str("alert_id", summary.AlertID.String()).
+				Msg("Alert backfill notification suppressed by user preferences")
+			return nil
+		}
+	}
+
+	msg, err := websocket.NewMessage(websocket.MessageTypeAlertBackfill, summary)
+	if err != nil {
+		return fmt.Errorf("failed to create message: %w", err)
+	}
+
+	s.hub.BroadcastToUser(userID, msg)
+
+	log.Info().
+		Str("user_id", userID.String()).
+		Str("alert_id", summary.AlertID.String()).
+		Int("matched", summary.Matched).
+		Msg("Alert backfill summary sent to user")
+
+	return nil
+}
+
+// NotifyAnnotationMention tells a user they were mentioned in an annotation
+// Mentions are gated by the user's annotation.mention preference and the article's severity
+func (s *NotificationService) NotifyAnnotationMention(userID uuid.UUID, severity domain.Severity, mention *domain.AnnotationMention) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if mention == nil {
+		return fmt.Errorf("annotation mention is required")
+	}
+
+	if s.preferences != nil {
+		ctx, cancel := context.WithTimeout(repository.WithoutTenant(context.Background()), preferenceLookupTimeout)
+		defer cancel()
+
+		if !s.preferences.Allows(ctx, userID, domain.NotificationChannelWebSocket, domain.NotificationEventAnnotation, severity) {
+			log.Debug().
+				Str("user_id", userID.String()).
+				Str("annotation_id", mention.AnnotationID.String()).
+				Msg("Annotation mention notification suppressed by user preferences")
+			return nil
+		}
+	}
+
+	msg, err := websocket.NewMessage(websocket.MessageTypeAnnotation, mention)
+	if err != nil {
+		return fmt.Errorf("failed to create message: %w", err)
+	}
+
+	s.hub.BroadcastToUser(userID, msg)
+
+	log.Info().
+		Str("user_id", userID.String()).
+		Str("annotation_id", mention.AnnotationID.String()).
+		Str("article_id", mention.ArticleID.String()).
+		Msg("Annotation mention notification sent to user")
+
+	return nil
+}
+
 // BroadcastSystemMessage broadcasts a system message to all connected clients
 func (s *NotificationService) BroadcastSystemMessage(message string) error {
 	if message == "" {
@@ -180,6 +294,57 @@ func (s *NotificationService) BroadcastSystemMessage(message string) error {
 	return nil
 }
 
+// articleBroadcaster returns a broadcast function for an article event
+// A workspace's own article is only delivered to its users; shared feed articles go to every workspace.
+// With a preference service set, delivery is limited to connected users whose preferences allow the event
+func (s *NotificationService) articleBroadcaster(event domain.NotificationEvent, article *domain.Article) func(channel string, msg *websocket.Message) {
+	var allow func(userID uuid.UUID) bool
+	if s.preferences != nil {
+		ctx, cancel := context.WithTimeout(repository.WithoutTenant(context.Background()), preferenceLookupTimeout)
+		defer cancel()
+
+		allowed := make(map[uuid.UUID]bool)
+		for _, userID := range s.hub.ConnectedUserIDs() {
+			if s.preferences.Allows(ctx, userID, domain.NotificationChannelWebSocket, event, article.Severity) {
+				allowed[userID] = true
+			}
+		}
+
+		allow = func(userID uuid.UUID) bool { return allowed[userID] }
+	}
+
+	if article.TenantID != nil {
+		tenantID := *article.TenantID
+		return func(channel string, msg *websocket.Message) {
+			s.hub.BroadcastToTenant(channel, msg, tenantID, allow)
+		}
+	}
+
+	if allow == nil {
+		return s.hub.Broadcast
+	}
+
+	return func(channel string, msg *websocket.Message) {
+		s.hub.BroadcastFiltered(channel, msg, allow)
+	}
+}
+
+// alertMatchSeverity returns the matched article's severity, falling back to the match priority
+func alertMatchSeverity(match *domain.AlertMatch) domain.Severity {
+	if match.Article != nil && match.Article.Severity.IsValid() {
+		return match.Article.Severity
+	}
+
+	switch match.Priority {
+	case "critical":
+		return domain.SeverityCritical
+	case "high":
+		return domain.SeverityHigh
+	default:
+		return domain.SeverityMedium
+	}
+}
+
 // GetHubStats returns current hub statistics
 func (s *NotificationService) GetHubStats() map[string]interface{} {
 	return s.hub.GetStats()
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// NotificationTemplateService manages versioned notification templates
+// Every edit creates a new version; exactly one version per (key, channel, locale)
+// is active and used for rendering
+type NotificationTemplateService struct {
+	templateRepo repository.NotificationTemplateRepository
+}
+
+// NewNotificationTemplateService creates a new notification template service
+func NewNotificationTemplateService(templateRepo repository.NotificationTemplateRepository) *NotificationTemplateService {
+	if templateRepo == nil {
+		panic("templateRepo cannot be nil")
+	}
+
+	return &NotificationTemplateService{
+		templateRepo: templateRepo,
+	}
+}
+
+// NotificationTemplateInput holds the editable fields of a template
+type NotificationTemplateInput struct {
+	Key       string
+	Channel   domain.NotificationChannel
+	Locale    string
+	Subject   *string
+	Body      string
+	Variables []string
+}
+
+// Create validates and stores a template as a new active version
+// If the (key, channel, locale) already exists, the new version supersedes the active one
+func (s *NotificationTemplateService) Create(ctx context.Context, input NotificationTemplateInput, createdBy *uuid.UUID) (*domain.NotificationTemplate, error) {
+	tmpl := buildNotificationTemplate(input, createdBy)
+
+	if err := tmpl.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "template", Message: err.Error()}
+	}
+
+	if err := s.templateRepo.CreateVersion(ctx, tmpl, true); err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+
+	log.Info().
+		Str("template_key", tmpl.Key).
+		Str("channel", string(tmpl.Channel)).
+		Str("locale", tmpl.Locale).
+		Int("version", tmpl.Version).
+		Msg("Notification template version created")
+
+	return tmpl, nil
+}
+
+// Update creates a new active version of an existing template
+// Key, channel and locale are taken from the base version and cannot change
+func (s *NotificationTemplateService) Update(ctx context.Context, id uuid.UUID, subject *string, body string, variables []string, createdBy *uuid.UUID) (*domain.NotificationTemplate, error) {
+	base, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Create(ctx, NotificationTemplateInput{
+		Key:       base.Key,
+		Channel:   base.Channel,
+		Locale:    base.Locale,
+		Subject:   subject,
+		Body:      body,
+		Variables: variables,
+	}, createdBy)
+}
+
+// GetByID retrieves a template version by ID
+func (s *NotificationTemplateService) GetByID(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("template ID is required")
+	}
+
+	return s.templateRepo.GetByID(ctx, id)
+}
+
+// List retrieves the latest version of each template matching the filter
+func (s *NotificationTemplateService) List(ctx context.Context, filter *domain.NotificationTemplateFilter) ([]*domain.NotificationTemplate, error) {
+	templates, err := s.templateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// ListVersions retrieves every version of the template the given version belongs to
+func (s *NotificationTemplateService) ListVersions(ctx context.Context, id uuid.UUID) ([]*domain.NotificationTemplate, error) {
+	tmpl, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.templateRepo.ListVersions(ctx, tmpl.Key, tmpl.Channel, tmpl.Locale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// Activate makes a version the active one, e.g. to roll back a bad edit
+func (s *NotificationTemplateService) Activate(ctx context.Context, id uuid.UUID) (*domain.NotificationTemplate, error) {
+	if err := s.templateRepo.Activate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.templateRepo.GetByID(ctx, id)
+}
+
+// Preview validates an unsaved template and renders it
+// When vars is nil, placeholder values are generated for each declared variable
+func (s *NotificationTemplateService) Preview(input NotificationTemplateInput, vars map[string]interface{}) (*domain.RenderedNotification, error) {
+	tmpl := buildNotificationTemplate(input, nil)
+
+	if err := tmpl.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "template", Message: err.Error()}
+	}
+
+	return renderPreview(tmpl, vars)
+}
+
+// PreviewVersion renders a stored template version
+func (s *NotificationTemplateService) PreviewVersion(ctx context.Context, id uuid.UUID, vars map[string]interface{}) (*domain.RenderedNotification, error) {
+	tmpl, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderPreview(tmpl, vars)
+}
+
+// Render renders the active template for a key and channel
+// Falls back to DefaultLocale when no template exists for the requested locale
+func (s *NotificationTemplateService) Render(ctx context.Context, key string, channel domain.NotificationChannel, locale string, vars map[string]interface{}) (*domain.RenderedNotification, error) {
+	if locale == "" {
+		locale = domain.DefaultLocale
+	}
+
+	tmpl, err := s.templateRepo.GetActive(ctx, key, channel, locale)
+	if err != nil && locale != domain.DefaultLocale {
+		var notFound *domainerrors.NotFoundError
+		if errors.As(err, &notFound) {
+			tmpl, err = s.templateRepo.GetActive(ctx, key, channel, domain.DefaultLocale)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := tmpl.Render(vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render template %s: %w", key, err)
+	}
+
+	return rendered, nil
+}
+
+// buildNotificationTemplate creates an unsaved template from input
+func buildNotificationTemplate(input NotificationTemplateInput, createdBy *uuid.UUID) *domain.NotificationTemplate {
+	locale := input.Locale
+	if locale == "" {
+		locale = domain.DefaultLocale
+	}
+
+	variables := input.Variables
+	if variables == nil {
+		variables = []string{}
+	}
+
+	return &domain.NotificationTemplate{
+		ID:        uuid.New(),
+		Key:       input.Key,
+		Channel:   input.Channel,
+		Locale:    locale,
+		Subject:   input.Subject,
+		Body:      input.Body,
+		Variables: variables,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+}
+
+// renderPreview renders a template, generating sample values if none were given
+func renderPreview(tmpl *domain.NotificationTemplate, vars map[string]interface{}) (*domain.RenderedNotification, error) {
+	if vars == nil {
+		vars = tmpl.SampleVariables()
+	}
+
+	rendered, err := tmpl.Render(vars)
+	if err != nil {
+		return nil, &domainerrors.ValidationError{Field: "variables", Message: err.Error()}
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/cpe"
+)
+
+const (
+	// DefaultNVDAPIURL is the NVD CVE API 2.0 endpoint, queried one CVE at a time with ?cveId=
+	DefaultNVDAPIURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+	// maxNVDResponseBytes bounds one CVE record; records with many configurations run to megabytes
+	maxNVDResponseBytes = 16 << 20
+
+	// nvdTimestampLayout is the format of timestamps in NVD records, which are UTC without a zone
+	nvdTimestampLayout = "2006-01-02T15:04:05.000"
+)
+
+// nvdResponse is the part of an NVD CVE API response that lists affected products
+type nvdResponse struct {
+	Vulnerabilities []struct {
+		CVE struct {
+			ID             string `json:"id"`
+			LastModified   string `json:"lastModified"`
+			Configurations []struct {
+				Nodes []struct {
+					CPEMatch []nvdCPEMatch `json:"cpeMatch"`
+				} `json:"nodes"`
+			} `json:"configurations"`
+		} `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+// nvdCPEMatch is one CPE range in an NVD configuration
+type nvdCPEMatch struct {
+	Vulnerable            bool   `json:"vulnerable"`
+	Criteria              string `json:"criteria"`
+	VersionStartIncluding string `json:"versionStartIncluding"`
+	VersionStartExcluding string `json:"versionStartExcluding"`
+	VersionEndIncluding   string `json:"versionEndIncluding"`
+	VersionEndExcluding   string `json:"versionEndExcluding"`
+}
+
+// errNVDRateLimited is returned by lookup when NVD refuses further requests for now
+var errNVDRateLimited = errors.New("rate limited by NVD")
+
+// NVDService looks up the products affected by the CVEs in recent articles in the NVD CVE API,
+// so software inventories can be matched against them
+type NVDService struct {
+	nvdRepo         repository.NVDRepository
+	inventory       *SoftwareInventoryService
+	apiURL          string
+	apiKey          string
+	lookback        time.Duration
+	refreshAfter    time.Duration
+	maxCVEs         int
+	requestInterval time.Duration
+	httpClient      *http.Client
+}
+
+// NewNVDService creates a new NVD service
+// It looks up the CVEs of articles published within lookback that were never looked up or were last
+// looked up more than refreshAfter ago, at most maxCVEs per sync and requestInterval apart
+func NewNVDService(
+	nvdRepo repository.NVDRepository,
+	apiURL, apiKey string,
+	lookback, refreshAfter time.Duration,
+	maxCVEs int,
+	requestInterval, timeout time.Duration,
+) *NVDService {
+	if nvdRepo == nil {
+		panic("nvdRepo cannot be nil")
+	}
+
+	return &NVDService{
+		nvdRepo:         nvdRepo,
+		apiURL:          apiURL,
+		apiKey:          apiKey,
+		lookback:        lookback,
+		refreshAfter:    refreshAfter,
+		maxCVEs:         maxCVEs,
+		requestInterval: requestInterval,
+		httpClient:      &http.Client{Timeout: timeout},
+	}
+}
+
+// SetSoftwareInventoryService rematches software inventories against the products a sync updated
+func (s *NVDService) SetSoftwareInventoryService(inventory *SoftwareInventoryService) {
+	s.inventory = inventory
+}
+
+// Sync looks up the affected products of the CVEs due for a lookup, then rematches the inventory
+// items of every product involved. A CVE whose lookup fails keeps its previous products; the sync
+// stops early if NVD rate limits it
+func (s *NVDService) Sync(ctx context.Context) (*domain.NVDSyncResult, error) {
+	now := time.Now()
+	cveIDs, err := s.nvdRepo.ListCVEsToSync(ctx, now.Add(-s.lookback), now.Add(-s.refreshAfter), s.maxCVEs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.NVDSyncResult{}
+	keySet := make(map[domain.ProductKey]bool)
+
+	for i, cveID := range cveIDs {
+		if i > 0 && s.requestInterval > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(s.requestInterval):
+			}
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		products, lastModified, err := s.lookup(ctx, cveID)
+		if errors.Is(err, errNVDRateLimited) {
+			result.RateLimited = true
+			log.Warn().Str("cve_id", cveID).Msg("NVD rate limited the sync; the remaining CVEs wait for the next one")
+			break
+		}
+		if err != nil {
+			result.Failed++
+			log.Warn().Err(err).Str("cve_id", cveID).Msg("Failed to look up CVE in NVD")
+			continue
+		}
+
+		// The products the CVE affected before the lookup may no longer be listed, so rematch those too
+		previous, err := s.nvdRepo.ListByCVE(ctx, cveID)
+		if err != nil {
+			return nil, err
+		}
+		for _, product := range append(previous, products...) {
+			keySet[product.Key()] = true
+		}
+
+		if err := s.nvdRepo.ReplaceProducts(ctx, cveID, lastModified, products, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+
+		result.CVEsChecked++
+		result.Products += len(products)
+	}
+
+	if result.Failed > 0 && result.CVEsChecked == 0 {
+		return nil, fmt.Errorf("all %d NVD lookups failed", result.Failed)
+	}
+
+	if s.inventory != nil && len(keySet) > 0 {
+		keys := make([]domain.ProductKey, 0, len(keySet))
+		for key := range keySet {
+			keys = append(keys, key)
+		}
+
+		rematched, err := s.inventory.MatchProducts(ctx, keys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match software inventories: %w", err)
+		}
+		result.ItemsRematched = rematched
+	}
+
+	result.SyncedAt = time.Now().UTC()
+	return result, nil
+}
+
+// lookup returns the vulnerable products NVD lists for a CVE, and when its record last changed
+// A CVE unknown to NVD, or not yet analyzed, has no products
+func (s *NVDService) lookup(ctx context.Context, cveID string) ([]*domain.AffectedProduct, *time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.apiURL+"?cveId="+url.QueryEscape(cveID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create NVD request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("apiKey", s.apiKey)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query NVD: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []*domain.AffectedProduct{}, nil, nil
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		return nil, nil, errNVDRateLimited
+	default:
+		return nil, nil, fmt.Errorf("NVD returned status %d", resp.StatusCode)
+	}
+
+	var body nvdResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxNVDResponseBytes)).Decode(&body); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode NVD response: %w", err)
+	}
+
+	products := make([]*domain.AffectedProduct, 0)
+	var lastModified *time.Time
+
+	for _, vuln := range body.Vulnerabilities {
+		if vuln.CVE.ID != cveID {
+			continue
+		}
+
+		if modified, err := time.Parse(nvdTimestampLayout, vuln.CVE.LastModified); err == nil {
+			lastModified = &modified
+		}
+
+		seen := make(map[nvdCPEMatch]bool)
+		for _, config := range vuln.CVE.Configurations {
+			for _, node := range config.Nodes {
+				for _, match := range node.CPEMatch {
+					// Non-vulnerable matches are the platforms a vulnerable product runs on
+					if !match.Vulnerable || seen[match] {
+						continue
+					}
+					seen[match] = true
+
+					name, err := cpe.Parse(match.Criteria)
+					if err != nil {
+						continue
+					}
+
+					products = append(products, &domain.AffectedProduct{
+						CVEID:                 cveID,
+						Criteria:              match.Criteria,
+						Vendor:                name.Vendor,
+						Product:               name.Product,
+						Version:               name.Version,
+						VersionStartIncluding: match.VersionStartIncluding,
+						VersionStartExcluding: match.VersionStartExcluding,
+						VersionEndIncluding:   match.VersionEndIncluding,
+						VersionEndExcluding:   match.VersionEndExcluding,
+					})
+				}
+			}
+		}
+	}
+
+	return products, lastModified, nil
+}
+
+// Status describes the local copy of NVD affected products
+func (s *NVDService) Status(ctx context.Context) (*domain.NVDStatus, error) {
+	return s.nvdRepo.Status(ctx)
+}
+
+// Run syncs immediately and then every interval until the context is canceled
+func (s *NVDService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.Sync(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to sync NVD affected products")
+		} else {
+			log.Info().
+				Int("cves_checked", result.CVEsChecked).
+				Int("products", result.Products).
+				Int("failed", result.Failed).
+				Bool("rate_limited", result.RateLimited).
+				Int("items_rematched", result.ItemsRematched).
+				Msg("Synced NVD affected products")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// OrgCategoryInput is an organization category as defined by an organization admin
+type OrgCategoryInput struct {
+	Name        string
+	Slug        string // derived from the name when empty
+	Description *string
+	Color       string
+	Rules       []domain.OrgCategoryRule
+}
+
+// OrgCategoryService manages the private categories organizations apply on top of the global
+// taxonomy. Members see their organization's categories and the articles carrying them; its
+// admins define them and apply or remove them by hand. Rules are evaluated once, as an article
+// is ingested, so changing them does not relabel articles already in the feed
+type OrgCategoryService struct {
+	orgCategoryRepo repository.OrgCategoryRepository
+	articleRepo     repository.ArticleRepository
+	orgService      *OrganizationService
+}
+
+// NewOrgCategoryService creates a new organization category service instance
+func NewOrgCategoryService(
+	orgCategoryRepo repository.OrgCategoryRepository,
+	articleRepo repository.ArticleRepository,
+	orgService *OrganizationService,
+) *OrgCategoryService {
+	if orgCategoryRepo == nil {
+		panic("orgCategoryRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &OrgCategoryService{
+		orgCategoryRepo: orgCategoryRepo,
+		articleRepo:     articleRepo,
+		orgService:      orgService,
+	}
+}
+
+// List returns the organization's categories
+func (s *OrgCategoryService) List(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrgCategory, error) {
+	if err := s.authorize(ctx, orgID, userID, role, false); err != nil {
+		return nil, err
+	}
+
+	return s.orgCategoryRepo.ListByOrganization(ctx, orgID)
+}
+
+// Get returns one of the organization's categories
+func (s *OrgCategoryService) Get(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) (*domain.OrgCategory, error) {
+	if err := s.authorize(ctx, orgID, userID, role, false); err != nil {
+		return nil, err
+	}
+
+	return s.load(ctx, orgID, id)
+}
+
+// Create defines a category for the organization
+func (s *OrgCategoryService) Create(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, input OrgCategoryInput) (*domain.OrgCategory, error) {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.orgCategoryRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxOrgCategories {
+		return nil, &domainerrors.LimitExceededError{
+			Resource: "organization category",
+			Limit:    domain.MaxOrgCategories,
+			Message:  fmt.Sprintf("an organization can have at most %d categories", domain.MaxOrgCategories),
+		}
+	}
+
+	now := time.Now()
+	category := &domain.OrgCategory{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		CreatedBy:      &userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	applyOrgCategoryInput(category, input)
+
+	if err := category.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "category", Message: err.Error()}
+	}
+
+	if err := s.orgCategoryRepo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("category_id", category.ID.String()).
+		Str("organization_id", orgID.String()).
+		Str("user_id", userID.String()).
+		Int("rules", len(category.Rules)).
+		Msg("Organization category created")
+
+	return category, nil
+}
+
+// Update redefines one of the organization's categories
+func (s *OrgCategoryService) Update(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, input OrgCategoryInput) (*domain.OrgCategory, error) {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return nil, err
+	}
+
+	category, err := s.load(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applyOrgCategoryInput(category, input)
+
+	if err := category.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "category", Message: err.Error()}
+	}
+
+	if err := s.orgCategoryRepo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// Delete removes one of the organization's categories from the organization and its articles
+func (s *OrgCategoryService) Delete(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) error {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return err
+	}
+
+	if _, err := s.load(ctx, orgID, id); err != nil {
+		return err
+	}
+
+	return s.orgCategoryRepo.Delete(ctx, id)
+}
+
+// Articles returns the published articles carrying one of the organization's categories and
+// matching the rest of the filter, with the total
+func (s *OrgCategoryService) Articles(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, filter *domain.ArticleFilter) ([]*domain.Article, int, error) {
+	if err := s.authorize(ctx, orgID, userID, role, false); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := s.load(ctx, orgID, id); err != nil {
+		return nil, 0, err
+	}
+
+	filter.OrgCategoryID = &id
+	filter.PublishedOnly = true
+
+	return s.articleRepo.List(ctx, filter)
+}
+
+// ArticleCategories returns the organization's categories applied to an article
+func (s *OrgCategoryService) ArticleCategories(ctx context.Context, orgID, articleID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrgArticleCategory, error) {
+	if err := s.authorize(ctx, orgID, userID, role, false); err != nil {
+		return nil, err
+	}
+
+	return s.orgCategoryRepo.ListByArticle(ctx, orgID, articleID)
+}
+
+// AddArticle applies one of the organization's categories to an article by hand
+func (s *OrgCategoryService) AddArticle(ctx context.Context, orgID, id, articleID, userID uuid.UUID, role domain.UserRole) error {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return err
+	}
+
+	category, err := s.load(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.articleRepo.GetByID(ctx, articleID); err != nil {
+		return err
+	}
+
+	return s.orgCategoryRepo.AddArticle(ctx, category, articleID, domain.OrgCategorySourceManual, &userID)
+}
+
+// RemoveArticle removes one of the organization's categories from an article, whether a rule
+// or an admin applied it
+func (s *OrgCategoryService) RemoveArticle(ctx context.Context, orgID, id, articleID, userID uuid.UUID, role domain.UserRole) error {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return err
+	}
+
+	if _, err := s.load(ctx, orgID, id); err != nil {
+		return err
+	}
+
+	return s.orgCategoryRepo.RemoveArticle(ctx, id, articleID)
+}
+
+// ApplyRules applies every organization category whose rules match a newly ingested article
+// A workspace's own article is only matched against its organizations' categories; shared feed
+// articles are matched against every organization's. Failures are logged, not returned, so they
+// never hold up ingestion
+func (s *OrgCategoryService) ApplyRules(ctx context.Context, article *domain.Article) {
+	if article.TenantID != nil {
+		ctx = repository.WithTenant(ctx, *article.TenantID)
+	} else {
+		ctx = repository.WithoutTenant(ctx)
+	}
+
+	categories, err := s.orgCategoryRepo.ListWithRules(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", article.ID.String()).
+			Msg("Failed to load organization category rules")
+		return
+	}
+
+	for _, category := range categories {
+		if !category.Matches(article) {
+			continue
+		}
+
+		if err := s.orgCategoryRepo.AddArticle(ctx, category, article.ID, domain.OrgCategorySourceRule, nil); err != nil {
+			log.Error().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Str("category_id", category.ID.String()).
+				Msg("Failed to apply organization category")
+		}
+	}
+}
+
+// authorize allows platform admins and the organization's admins; members too unless manage is set
+func (s *OrgCategoryService) authorize(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, manage bool) error {
+	if role == domain.RoleAdmin {
+		_, err := s.orgService.Get(ctx, orgID)
+		return err
+	}
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if member == nil || member.OrganizationID != orgID || (manage && !member.IsAdmin()) {
+		return domainerrors.ErrForbidden
+	}
+
+	return nil
+}
+
+// load returns a category of the organization
+func (s *OrgCategoryService) load(ctx context.Context, orgID, id uuid.UUID) (*domain.OrgCategory, error) {
+	category, err := s.orgCategoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.OrganizationID != orgID {
+		return nil, &domainerrors.NotFoundError{Resource: "organization category", ID: id.String()}
+	}
+
+	return category, nil
+}
+
+// applyOrgCategoryInput copies an admin's definition onto a category
+func applyOrgCategoryInput(category *domain.OrgCategory, input OrgCategoryInput) {
+	category.Name = input.Name
+	category.Slug = strings.ToLower(input.Slug)
+	category.Description = input.Description
+	category.Color = strings.TrimSpace(input.Color)
+
+	category.Rules = input.Rules
+	if category.Rules == nil {
+		category.Rules = []domain.OrgCategoryRule{}
+	}
+
+	category.Normalize()
+}
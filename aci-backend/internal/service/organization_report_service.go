@@ -0,0 +1,478 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/email"
+	"github.com/phillipboles/aci-backend/internal/newsletter"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// maxReportRange is the longest period a report can be re-run for
+const maxReportRange = 366 * 24 * time.Hour
+
+// OrganizationReportInput is a report template as defined by an organization admin
+type OrganizationReportInput struct {
+	Name       string
+	Filter     domain.ReportFilter
+	Sections   []domain.ReportSection // defaults to every section
+	Recipients []string
+	Cadence    domain.ReportCadence
+	Enabled    bool
+}
+
+// OrganizationReportServiceConfig configures the organization report service
+type OrganizationReportServiceConfig struct {
+	BatchSize int // due reports generated per RunDue
+}
+
+// OrganizationReportService manages report templates defined by organization admins and
+// generates them: on their cadence for the period just ended, or on demand for any date range.
+// Every run is kept with its PDF. Scheduled runs, and re-runs that ask for it, are emailed to
+// the template's recipients when a mailer is set. Periods end at midnight, Monday or the first
+// of the month in UTC; a template's next run is claimed before it is generated, so each period
+// is generated once even with several instances running
+type OrganizationReportService struct {
+	orgReportRepo repository.OrganizationReportRepository
+	reportService *ReportService
+	orgService    *OrganizationService
+	mailer        ReportMailer
+	cfg           OrganizationReportServiceConfig
+}
+
+// NewOrganizationReportService creates a new organization report service instance
+func NewOrganizationReportService(
+	orgReportRepo repository.OrganizationReportRepository,
+	reportService *ReportService,
+	orgService *OrganizationService,
+	cfg OrganizationReportServiceConfig,
+) *OrganizationReportService {
+	if orgReportRepo == nil {
+		panic("orgReportRepo cannot be nil")
+	}
+	if reportService == nil {
+		panic("reportService cannot be nil")
+	}
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &OrganizationReportService{
+		orgReportRepo: orgReportRepo,
+		reportService: reportService,
+		orgService:    orgService,
+		cfg:           cfg,
+	}
+}
+
+// SetMailer enables emailing generated reports to their recipients
+func (s *OrganizationReportService) SetMailer(mailer ReportMailer) {
+	s.mailer = mailer
+}
+
+// Create defines a report template; its first run is at the end of the current period
+func (s *OrganizationReportService) Create(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, input OrganizationReportInput) (*domain.OrganizationReport, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &domain.OrganizationReport{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		CreatedBy:      &userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	applyReportInput(report, input)
+	report.NextRunAt = report.Cadence.NextBoundary(now)
+
+	if err := report.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "report", Message: err.Error()}
+	}
+
+	if err := s.orgReportRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("report_id", report.ID.String()).
+		Str("organization_id", orgID.String()).
+		Str("user_id", userID.String()).
+		Str("cadence", string(report.Cadence)).
+		Msg("Organization report created")
+
+	return report, nil
+}
+
+// List returns the organization's report templates
+func (s *OrganizationReportService) List(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.OrganizationReport, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, err
+	}
+
+	return s.orgReportRepo.ListByOrganization(ctx, orgID)
+}
+
+// Get returns one of the organization's report templates
+func (s *OrganizationReportService) Get(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) (*domain.OrganizationReport, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, err
+	}
+
+	return s.load(ctx, orgID, id)
+}
+
+// Update redefines a report template; changing its cadence or enabling it reschedules it to
+// the end of the current period
+func (s *OrganizationReportService) Update(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, input OrganizationReportInput) (*domain.OrganizationReport, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, err
+	}
+
+	report, err := s.load(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reschedule := input.Cadence != report.Cadence || (input.Enabled && !report.Enabled)
+	applyReportInput(report, input)
+	if reschedule {
+		report.NextRunAt = report.Cadence.NextBoundary(time.Now())
+	}
+
+	if err := report.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "report", Message: err.Error()}
+	}
+
+	if err := s.orgReportRepo.Update(ctx, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Delete removes a report template and its run history
+func (s *OrganizationReportService) Delete(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole) error {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return err
+	}
+
+	if _, err := s.load(ctx, orgID, id); err != nil {
+		return err
+	}
+
+	return s.orgReportRepo.Delete(ctx, id)
+}
+
+// ListRuns returns a report template's runs, newest first, and the total
+func (s *OrganizationReportService) ListRuns(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, limit, offset int) ([]*domain.OrganizationReportRun, int, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := s.load(ctx, orgID, id); err != nil {
+		return nil, 0, err
+	}
+
+	return s.orgReportRepo.ListRuns(ctx, id, limit, offset)
+}
+
+// RunDocument returns a completed run's PDF and its file name
+func (s *OrganizationReportService) RunDocument(ctx context.Context, orgID, id, runID, userID uuid.UUID, role domain.UserRole) (string, []byte, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return "", nil, err
+	}
+
+	report, err := s.load(ctx, orgID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	run, err := s.orgReportRepo.GetRun(ctx, runID)
+	if err != nil {
+		return "", nil, err
+	}
+	if run.ReportID != id {
+		return "", nil, &domainerrors.NotFoundError{Resource: "organization report run", ID: runID.String()}
+	}
+
+	document, err := s.orgReportRepo.GetRunDocument(ctx, runID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return OrganizationReportFilename(report, run.PeriodStart), document, nil
+}
+
+// Rerun generates a report template for [from, to), emailing it to the recipients when deliver
+// is set. The run is returned, and kept, even when it failed
+func (s *OrganizationReportService) Rerun(ctx context.Context, orgID, id, userID uuid.UUID, role domain.UserRole, from, to time.Time, deliver bool) (*domain.OrganizationReportRun, error) {
+	if err := s.authorize(ctx, orgID, userID, role); err != nil {
+		return nil, err
+	}
+
+	report, err := s.load(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !from.Before(to) {
+		return nil, &domainerrors.ValidationError{Field: "to", Message: "to must be after from"}
+	}
+	if to.Sub(from) > maxReportRange {
+		return nil, &domainerrors.ValidationError{Field: "to", Message: "date range cannot exceed 366 days"}
+	}
+	if from.After(time.Now()) {
+		return nil, &domainerrors.ValidationError{Field: "from", Message: "from cannot be in the future"}
+	}
+
+	return s.generate(ctx, report, from.UTC(), to.UTC(), domain.ReportRunTriggerManual, &userID, deliver)
+}
+
+// RunDue generates one batch of report templates whose period has ended
+// It returns how many were generated
+func (s *OrganizationReportService) RunDue(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	reports, err := s.orgReportRepo.ListDue(ctx, now, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, report := range reports {
+		if ctx.Err() != nil {
+			return generated, ctx.Err()
+		}
+
+		// Periods missed while no scheduler was running are not caught up; the next run
+		// is the end of the current period
+		periodEnd := report.NextRunAt
+		claimed, err := s.orgReportRepo.AdvanceSchedule(ctx, report.ID, periodEnd, report.Cadence.NextBoundary(now))
+		if err != nil {
+			return generated, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if _, err := s.generate(ctx, report, report.Cadence.PeriodStart(periodEnd), periodEnd, domain.ReportRunTriggerScheduled, nil, true); err != nil {
+			log.Warn().
+				Err(err).
+				Str("report_id", report.ID.String()).
+				Str("organization_id", report.OrganizationID.String()).
+				Msg("Failed to generate scheduled organization report")
+			continue
+		}
+
+		generated++
+	}
+
+	return generated, nil
+}
+
+// Run generates due report templates every interval until ctx is cancelled
+func (s *OrganizationReportService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			generated, err := s.RunDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to generate scheduled organization reports")
+			}
+			if generated > 0 {
+				log.Info().Int("generated", generated).Msg("Generated scheduled organization reports")
+			}
+		}
+	}
+}
+
+// generate records a run of the report for [from, to), renders it and, when deliver is set,
+// emails it. A failure to render fails the run; failing to email some recipients does not
+func (s *OrganizationReportService) generate(
+	ctx context.Context,
+	report *domain.OrganizationReport,
+	from, to time.Time,
+	trigger domain.ReportRunTrigger,
+	requestedBy *uuid.UUID,
+	deliver bool,
+) (*domain.OrganizationReportRun, error) {
+	run := &domain.OrganizationReportRun{
+		ID:          uuid.New(),
+		ReportID:    report.ID,
+		PeriodStart: from,
+		PeriodEnd:   to,
+		Trigger:     trigger,
+		Status:      domain.ReportRunStatusRunning,
+		RequestedBy: requestedBy,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.orgReportRepo.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	content, document, genErr := s.reportService.Organization(ctx, report, from, to)
+	if genErr != nil {
+		run.Status = domain.ReportRunStatusFailed
+		message := genErr.Error()
+		run.Error = &message
+	} else {
+		run.Status = domain.ReportRunStatusCompleted
+		if deliver {
+			run.RecipientsSent, run.Error = s.deliver(ctx, report, content, document)
+		}
+	}
+
+	completedAt := time.Now()
+	run.CompletedAt = &completedAt
+
+	if err := s.orgReportRepo.CompleteRun(ctx, run, document); err != nil {
+		return nil, err
+	}
+
+	if genErr != nil {
+		return run, fmt.Errorf("failed to generate organization report: %w", genErr)
+	}
+
+	return run, nil
+}
+
+// deliver emails a generated report to each of its recipients
+// It returns how many were sent and, when some were not, a summary of the failures
+func (s *OrganizationReportService) deliver(ctx context.Context, report *domain.OrganizationReport, content *domain.ThreatReport, document []byte) (int, *string) {
+	if s.mailer == nil || len(report.Recipients) == 0 {
+		return 0, nil
+	}
+
+	period := reportPeriod(content)
+	html, text, err := newsletter.RenderOrganizationReport(newsletter.OrganizationReportData{
+		ReportName:   report.Name,
+		Period:       period,
+		Articles:     content.Severity.Total,
+		Critical:     content.Severity.Critical,
+		High:         content.Severity.High,
+		DashboardURL: s.reportService.cfg.SiteURL + "/dashboard",
+	})
+	if err != nil {
+		message := err.Error()
+		return 0, &message
+	}
+
+	attachments := []email.Attachment{{
+		Filename:    OrganizationReportFilename(report, content.PeriodStart),
+		ContentType: "application/pdf",
+		Data:        document,
+	}}
+	subject := fmt.Sprintf(newsletter.OrganizationReportSubject, report.Name, period)
+
+	sent := 0
+	var lastErr error
+	for _, recipient := range report.Recipients {
+		sendCtx, cancel := context.WithTimeout(ctx, newsletterSendTimeout)
+		err := s.mailer.SendWithAttachments(sendCtx, recipient, subject, html, text, nil, attachments)
+		cancel()
+
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("report_id", report.ID.String()).
+				Msg("Failed to email organization report")
+			lastErr = err
+			continue
+		}
+		sent++
+	}
+
+	if lastErr != nil {
+		message := fmt.Sprintf("failed to email %d of %d recipients: %v", len(report.Recipients)-sent, len(report.Recipients), lastErr)
+		return sent, &message
+	}
+
+	return sent, nil
+}
+
+// authorize allows platform admins and the organization's admins
+func (s *OrganizationReportService) authorize(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) error {
+	if role == domain.RoleAdmin {
+		_, err := s.orgService.Get(ctx, orgID)
+		return err
+	}
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if member == nil || member.OrganizationID != orgID || !member.IsAdmin() {
+		return domainerrors.ErrForbidden
+	}
+
+	return nil
+}
+
+// load returns a report template of the organization
+func (s *OrganizationReportService) load(ctx context.Context, orgID, id uuid.UUID) (*domain.OrganizationReport, error) {
+	report, err := s.orgReportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.OrganizationID != orgID {
+		return nil, &domainerrors.NotFoundError{Resource: "organization report", ID: id.String()}
+	}
+
+	return report, nil
+}
+
+// applyReportInput copies an admin's definition onto a report template
+func applyReportInput(report *domain.OrganizationReport, input OrganizationReportInput) {
+	report.Name = strings.TrimSpace(input.Name)
+	report.Filter = input.Filter
+	report.Cadence = input.Cadence
+	report.Enabled = input.Enabled
+
+	report.Sections = input.Sections
+	if len(report.Sections) == 0 {
+		report.Sections = domain.ReportSections
+	}
+
+	report.Recipients = make([]string, 0, len(input.Recipients))
+	for _, recipient := range input.Recipients {
+		report.Recipients = append(report.Recipients, strings.TrimSpace(recipient))
+	}
+}
+
+// OrganizationReportFilename names a report's PDF after the report and its period start
+func OrganizationReportFilename(report *domain.OrganizationReport, periodStart time.Time) string {
+	var slug strings.Builder
+	for _, r := range strings.ToLower(report.Name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			slug.WriteRune(r)
+		case slug.Len() > 0 && !strings.HasSuffix(slug.String(), "-"):
+			slug.WriteByte('-')
+		}
+	}
+
+	name := strings.TrimSuffix(slug.String(), "-")
+	if name == "" {
+		name = "report"
+	}
+
+	return name + "-" + periodStart.UTC().Format("20060102") + ".pdf"
+}
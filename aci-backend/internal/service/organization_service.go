@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/slug"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// OrganizationService manages organizations, their members and engagement rollups
+type OrganizationService struct {
+	orgRepo repository.OrganizationRepository
+}
+
+// NewOrganizationService creates a new organization service instance
+func NewOrganizationService(orgRepo repository.OrganizationRepository) *OrganizationService {
+	if orgRepo == nil {
+		panic("orgRepo cannot be nil")
+	}
+
+	return &OrganizationService{
+		orgRepo: orgRepo,
+	}
+}
+
+// Create creates an organization with a unique slug derived from its name
+func (s *OrganizationService) Create(ctx context.Context, name string) (*domain.Organization, error) {
+	name = strings.TrimSpace(name)
+
+	var slugErr error
+	orgSlug := slug.GenerateUnique(name, func(candidate string) bool {
+		exists, err := s.orgRepo.SlugExists(ctx, candidate)
+		if err != nil {
+			slugErr = err
+			return false
+		}
+		return exists
+	})
+	if slugErr != nil {
+		return nil, slugErr
+	}
+
+	now := time.Now()
+	org := &domain.Organization{
+		ID:        uuid.New(),
+		Name:      name,
+		Slug:      orgSlug,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := org.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "name", Message: err.Error()}
+	}
+
+	if err := s.orgRepo.Create(ctx, org); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("organization_id", org.ID.String()).
+		Str("slug", org.Slug).
+		Msg("Organization created")
+
+	return org, nil
+}
+
+// List returns all organizations
+func (s *OrganizationService) List(ctx context.Context) ([]*domain.Organization, error) {
+	return s.orgRepo.List(ctx)
+}
+
+// Get returns an organization by ID
+func (s *OrganizationService) Get(ctx context.Context, id uuid.UUID) (*domain.Organization, error) {
+	return s.orgRepo.GetByID(ctx, id)
+}
+
+// Delete removes an organization along with its shared alerts and collections
+func (s *OrganizationService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.orgRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("organization_id", id.String()).
+		Msg("Organization deleted")
+
+	return nil
+}
+
+// Members returns an organization's members
+func (s *OrganizationService) Members(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	if _, err := s.orgRepo.GetByID(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	return s.orgRepo.ListMembers(ctx, orgID)
+}
+
+// SetMember adds a user to an organization or changes their role
+func (s *OrganizationService) SetMember(ctx context.Context, orgID, userID uuid.UUID, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	if role == "" {
+		role = domain.OrganizationRoleMember
+	}
+
+	if !role.IsValid() {
+		return nil, &domainerrors.ValidationError{Field: "role", Message: "role must be admin or member"}
+	}
+
+	member := &domain.OrganizationMember{
+		OrganizationID: orgID,
+		UserID:         userID,
+		Role:           role,
+	}
+
+	if err := s.orgRepo.UpsertMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("organization_id", orgID.String()).
+		Str("user_id", userID.String()).
+		Str("role", string(role)).
+		Msg("Organization member set")
+
+	return member, nil
+}
+
+// RemoveMember removes a user from an organization
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	if err := s.orgRepo.RemoveMember(ctx, orgID, userID); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("organization_id", orgID.String()).
+		Str("user_id", userID.String()).
+		Msg("Organization member removed")
+
+	return nil
+}
+
+// MembershipOf returns the user's organization membership, or nil if they belong to none
+func (s *OrganizationService) MembershipOf(ctx context.Context, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	member, err := s.orgRepo.GetMembership(ctx, userID)
+	if err != nil {
+		var notFound *domainerrors.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+
+	return member, nil
+}
+
+// Stats returns engagement rolled up across an organization's members
+func (s *OrganizationService) Stats(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationStats, error) {
+	if _, err := s.orgRepo.GetByID(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	return s.orgRepo.GetStats(ctx, orgID)
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/crypto"
+	"github.com/phillipboles/aci-backend/internal/pkg/requestctx"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// publicAPIKeyCacheTTL is how long a looked-up key is trusted before it is read again,
+	// which bounds how long a revoked key keeps working on other instances
+	publicAPIKeyCacheTTL = time.Minute
+
+	// maxPublicAPIKeyCacheEntries stops a flood of made-up keys from growing the cache
+	maxPublicAPIKeyCacheEntries = 10000
+
+	// publicAPIKeyUsageEventInterval is the minimum time between two usage security events for one key
+	publicAPIKeyUsageEventInterval = time.Hour
+)
+
+// PublicAPIKeyService issues and authenticates keys for the public read-only API
+type PublicAPIKeyService struct {
+	keyRepo     repository.PublicAPIKeyRepository
+	auditRepo   repository.AuditLogRepository
+	defaultRate atomic.Int64 // changed by SetDefaultRate
+
+	mu    sync.Mutex
+	cache map[string]cachedPublicAPIKey
+
+	securityEvents *SecurityEventService
+	usageRecorded  map[uuid.UUID]time.Time // guarded by mu; last usage event per key
+}
+
+// cachedPublicAPIKey is a lookup result; a nil key means the hash is unknown
+type cachedPublicAPIKey struct {
+	key       *domain.PublicAPIKey
+	expiresAt time.Time
+}
+
+// NewPublicAPIKeyService creates a new public API key service instance
+// defaultRate is the requests per minute given to keys created without one
+func NewPublicAPIKeyService(
+	keyRepo repository.PublicAPIKeyRepository,
+	auditRepo repository.AuditLogRepository,
+	defaultRate int,
+) *PublicAPIKeyService {
+	if keyRepo == nil {
+		panic("keyRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	s := &PublicAPIKeyService{
+		keyRepo:   keyRepo,
+		auditRepo: auditRepo,
+		cache:     make(map[string]cachedPublicAPIKey),
+
+		usageRecorded: make(map[uuid.UUID]time.Time),
+	}
+	s.defaultRate.Store(int64(defaultRate))
+
+	return s
+}
+
+// SetSecurityEventService records key usage as a security event on the account that created the key
+// Usage is recorded at most once an hour per key and instance
+func (s *PublicAPIKeyService) SetSecurityEventService(securityEvents *SecurityEventService) {
+	s.securityEvents = securityEvents
+}
+
+// SetDefaultRate changes the requests per minute given to keys created without one
+// Existing keys keep their stored rate
+func (s *PublicAPIKeyService) SetDefaultRate(defaultRate int) {
+	s.defaultRate.Store(int64(defaultRate))
+}
+
+// Create issues a new key and returns it with the plaintext key, which is not stored
+func (s *PublicAPIKeyService) Create(
+	ctx context.Context,
+	name string,
+	requestsPerMinute int,
+	actorID *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.PublicAPIKey, string, error) {
+	if requestsPerMinute == 0 {
+		requestsPerMinute = int(s.defaultRate.Load())
+	}
+
+	token, err := crypto.GenerateToken()
+	if err != nil {
+		return nil, "", err
+	}
+	plaintext := domain.PublicAPIKeyPrefix + token
+
+	key := &domain.PublicAPIKey{
+		Name:              name,
+		KeyPrefix:         plaintext[:domain.PublicAPIKeyDisplayLength],
+		KeyHash:           crypto.HashToken(plaintext),
+		RequestsPerMinute: requestsPerMinute,
+		CreatedBy:         actorID,
+	}
+
+	if err := key.Validate(); err != nil {
+		return nil, "", &domainerrors.ValidationError{Field: "key", Message: err.Error()}
+	}
+
+	if err := s.keyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	s.audit(ctx, actorID, domain.AuditActionPublicAPIKeyCreated, key.ID, nil, key, ipAddress, userAgent)
+
+	return key, plaintext, nil
+}
+
+// List returns all keys, newest first
+func (s *PublicAPIKeyService) List(ctx context.Context) ([]*domain.PublicAPIKey, error) {
+	return s.keyRepo.List(ctx)
+}
+
+// Revoke revokes a key; instances that cached it reject it once their cache entry expires
+func (s *PublicAPIKeyService) Revoke(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.PublicAPIKey, error) {
+	key, err := s.keyRepo.Revoke(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	delete(s.cache, key.KeyHash)
+	s.mu.Unlock()
+
+	s.audit(ctx, actorID, domain.AuditActionPublicAPIKeyRevoked, key.ID, nil, key, ipAddress, userAgent)
+
+	return key, nil
+}
+
+// Authenticate returns the active key matching the plaintext key
+// Unknown and revoked keys return domainerrors.ErrUnauthorized
+func (s *PublicAPIKeyService) Authenticate(ctx context.Context, plaintext string) (*domain.PublicAPIKey, error) {
+	hash := crypto.HashToken(plaintext)
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[hash]
+	s.mu.Unlock()
+
+	if !ok || now.After(cached.expiresAt) {
+		key, err := s.keyRepo.GetByHash(ctx, hash)
+		if err != nil {
+			var notFoundErr *domainerrors.NotFoundError
+			if !errors.As(err, &notFoundErr) {
+				return nil, err
+			}
+			key = nil
+		}
+
+		cached = cachedPublicAPIKey{key: key, expiresAt: now.Add(publicAPIKeyCacheTTL)}
+
+		s.mu.Lock()
+		if len(s.cache) >= maxPublicAPIKeyCacheEntries {
+			s.evictExpired(now)
+		}
+		if len(s.cache) < maxPublicAPIKeyCacheEntries {
+			s.cache[hash] = cached
+		}
+		s.mu.Unlock()
+
+		// Last use is recorded at most once per cache period
+		if key != nil && key.IsActive() {
+			requestctx.Go(ctx, func(ctx context.Context) {
+				if err := s.keyRepo.TouchLastUsed(ctx, key.ID); err != nil {
+					log.Warn().
+						Err(err).
+						Str("request_id", requestctx.RequestID(ctx)).
+						Str("key_id", key.ID.String()).
+						Msg("Failed to record public API key use")
+				}
+				s.recordUsage(ctx, key, now)
+			})
+		}
+	}
+
+	if cached.key == nil || !cached.key.IsActive() {
+		return nil, fmt.Errorf("invalid public api key: %w", domainerrors.ErrUnauthorized)
+	}
+
+	return cached.key, nil
+}
+
+// recordUsage writes a usage security event unless one was written for the key within the interval
+func (s *PublicAPIKeyService) recordUsage(ctx context.Context, key *domain.PublicAPIKey, now time.Time) {
+	if s.securityEvents == nil {
+		return
+	}
+
+	s.mu.Lock()
+	last, ok := s.usageRecorded[key.ID]
+	due := !ok || now.Sub(last) >= publicAPIKeyUsageEventInterval
+	if due {
+		s.usageRecorded[key.ID] = now
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return
+	}
+
+	details := map[string]string{
+		"name":       key.Name,
+		"key_prefix": key.KeyPrefix,
+	}
+
+	s.securityEvents.Record(ctx, key.CreatedBy, domain.SecurityEventAPIKeyUsed, "public_api_key", &key.ID, details, "", "")
+}
+
+// evictExpired drops expired cache entries; the caller holds the lock
+func (s *PublicAPIKeyService) evictExpired(now time.Time) {
+	for hash, entry := range s.cache {
+		if now.After(entry.expiresAt) {
+			delete(s.cache, hash)
+		}
+	}
+}
+
+// audit records a key change; failures are logged and do not fail the operation
+func (s *PublicAPIKeyService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	action string,
+	keyID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, action, "public_api_key", &keyID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("key_id", keyID.String()).
+			Str("action", action).
+			Msg("Failed to write public API key audit log")
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/metrics"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultRefreshTokenRetention is how long expired and revoked refresh tokens are kept
+	DefaultRefreshTokenRetention = 7 * 24 * time.Hour
+
+	// refreshTokenCleanupBatchSize is how many tokens are deleted per statement
+	refreshTokenCleanupBatchSize = 1000
+)
+
+// RefreshTokenCleanupService deletes refresh tokens that can no longer be used
+// Expired and revoked tokens are kept for the retention period so recent sessions stay
+// visible when investigating an account, then deleted in batches
+type RefreshTokenCleanupService struct {
+	tokenRepo repository.RefreshTokenRepository
+	retention time.Duration
+}
+
+// NewRefreshTokenCleanupService creates a new refresh token cleanup service instance
+// A negative retention falls back to DefaultRefreshTokenRetention; 0 deletes tokens as soon as they lapse
+func NewRefreshTokenCleanupService(tokenRepo repository.RefreshTokenRepository, retention time.Duration) *RefreshTokenCleanupService {
+	if tokenRepo == nil {
+		panic("tokenRepo cannot be nil")
+	}
+
+	if retention < 0 {
+		retention = DefaultRefreshTokenRetention
+	}
+
+	return &RefreshTokenCleanupService{
+		tokenRepo: tokenRepo,
+		retention: retention,
+	}
+}
+
+// Cleanup deletes tokens that expired or were revoked more than the retention period ago
+// and returns how many were deleted
+func (s *RefreshTokenCleanupService) Cleanup(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-s.retention)
+
+	var total int64
+	for {
+		deleted, err := s.tokenRepo.DeleteExpiredBefore(ctx, before, refreshTokenCleanupBatchSize)
+		total += deleted
+		metrics.AddRefreshTokensPurged(deleted)
+		if err != nil {
+			return total, err
+		}
+
+		if deleted < refreshTokenCleanupBatchSize {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+	}
+
+	metrics.RefreshTokenCleanupSucceeded()
+	return total, nil
+}
+
+// Run cleans up tokens on every interval until the context is cancelled
+func (s *RefreshTokenCleanupService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.Cleanup(ctx)
+			if err != nil {
+				log.Error().Err(err).Int64("deleted", deleted).Msg("Failed to clean up refresh tokens")
+				continue
+			}
+
+			if deleted > 0 {
+				log.Info().Int64("deleted", deleted).Msg("Cleaned up expired and revoked refresh tokens")
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+var (
+	// ErrRegistrationDisabled is returned when public registration is closed
+	ErrRegistrationDisabled = errors.New("registration is disabled")
+
+	// ErrRegistrationDomainNotAllowed is returned when an email's domain is not allowed to register
+	ErrRegistrationDomainNotAllowed = errors.New("email domain is not allowed to register")
+)
+
+// RegistrationPolicyService decides whether public registration is open and to which email domains
+// The REGISTRATION_* settings are the default; a policy saved by an admin replaces them until it is reset
+type RegistrationPolicyService struct {
+	policyRepo repository.RegistrationPolicyRepository
+	auditRepo  repository.AuditLogRepository
+	defaults   domain.RegistrationPolicy
+}
+
+// NewRegistrationPolicyService creates a new registration policy service instance
+func NewRegistrationPolicyService(
+	policyRepo repository.RegistrationPolicyRepository,
+	auditRepo repository.AuditLogRepository,
+	enabled bool,
+	allowedDomains []string,
+) *RegistrationPolicyService {
+	if policyRepo == nil {
+		panic("policyRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	defaults := domain.RegistrationPolicy{
+		Enabled:        enabled,
+		AllowedDomains: allowedDomains,
+		Source:         domain.RegistrationPolicySourceConfig,
+	}
+	defaults.Normalize()
+
+	return &RegistrationPolicyService{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		defaults:   defaults,
+	}
+}
+
+// Policy returns the policy in effect: the saved one, or the configured defaults
+func (s *RegistrationPolicyService) Policy(ctx context.Context) (*domain.RegistrationPolicy, error) {
+	policy, err := s.policyRepo.Get(ctx)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			defaults := s.defaults
+			defaults.AllowedDomains = append([]string{}, s.defaults.AllowedDomains...)
+			return &defaults, nil
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Check returns ErrRegistrationDisabled or ErrRegistrationDomainNotAllowed when the email may not register
+func (s *RegistrationPolicyService) Check(ctx context.Context, email string) error {
+	policy, err := s.Policy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !policy.Enabled {
+		return ErrRegistrationDisabled
+	}
+	if !policy.AllowsDomain(email) {
+		return ErrRegistrationDomainNotAllowed
+	}
+
+	return nil
+}
+
+// Update saves a policy that replaces the configured defaults
+func (s *RegistrationPolicyService) Update(
+	ctx context.Context,
+	enabled bool,
+	allowedDomains []string,
+	actorID uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.RegistrationPolicy, error) {
+	policy := &domain.RegistrationPolicy{
+		Enabled:        enabled,
+		AllowedDomains: allowedDomains,
+		Source:         domain.RegistrationPolicySourceAdmin,
+		UpdatedBy:      &actorID,
+	}
+	policy.Normalize()
+
+	if err := policy.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{
+			Field:   "allowed_domains",
+			Message: err.Error(),
+		}
+	}
+
+	previous, err := s.Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Upsert(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, domain.AuditActionRegistrationPolicyUpdated, actorID, previous, policy, ipAddress, userAgent)
+
+	log.Info().
+		Bool("enabled", policy.Enabled).
+		Int("allowed_domains", len(policy.AllowedDomains)).
+		Str("updated_by", actorID.String()).
+		Msg("Registration policy updated")
+
+	return policy, nil
+}
+
+// Reset removes the saved policy so the configured defaults apply again, returning them
+func (s *RegistrationPolicyService) Reset(ctx context.Context, actorID uuid.UUID, ipAddress, userAgent string) (*domain.RegistrationPolicy, error) {
+	previous, err := s.Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Delete(ctx); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, domain.AuditActionRegistrationPolicyReset, actorID, previous, policy, ipAddress, userAgent)
+
+	log.Info().
+		Str("updated_by", actorID.String()).
+		Msg("Registration policy reset to configured defaults")
+
+	return policy, nil
+}
+
+// audit records a policy change; failures are logged and do not fail the operation
+func (s *RegistrationPolicyService) audit(
+	ctx context.Context,
+	action string,
+	actorID uuid.UUID,
+	previous, current *domain.RegistrationPolicy,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(&actorID, action, "registration_policy", nil, previous, current, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("action", action).
+			Msg("Failed to write registration policy audit log")
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// RelevanceRulesService manages the relevance scorer's ruleset at runtime
+// Saved rulesets are versioned and applied to the shared scorer at once; other instances
+// pick up a new version on their next refresh
+type RelevanceRulesService struct {
+	rulesRepo   repository.RelevanceRulesRepository
+	articleRepo repository.ArticleRepository
+	auditRepo   repository.AuditLogRepository
+	scorer      *RelevanceScorer
+}
+
+// RelevancePreviewArticle is article content to score without storing it
+type RelevancePreviewArticle struct {
+	Title    string          `json:"title"`
+	Content  string          `json:"content"`
+	Summary  *string         `json:"summary,omitempty"`
+	Severity domain.Severity `json:"severity"`
+}
+
+// RelevancePreview compares an article's score under the current and a proposed ruleset
+type RelevancePreview struct {
+	ArticleID *uuid.UUID             `json:"article_id,omitempty"`
+	Current   *domain.RelevanceScore `json:"current"`
+	Preview   *domain.RelevanceScore `json:"preview"`
+}
+
+// NewRelevanceRulesService creates a new relevance rules service instance
+func NewRelevanceRulesService(
+	rulesRepo repository.RelevanceRulesRepository,
+	articleRepo repository.ArticleRepository,
+	auditRepo repository.AuditLogRepository,
+	scorer *RelevanceScorer,
+) *RelevanceRulesService {
+	if rulesRepo == nil {
+		panic("rulesRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+	if scorer == nil {
+		panic("scorer cannot be nil")
+	}
+
+	return &RelevanceRulesService{
+		rulesRepo:   rulesRepo,
+		articleRepo: articleRepo,
+		auditRepo:   auditRepo,
+		scorer:      scorer,
+	}
+}
+
+// Load applies the latest saved ruleset to the scorer, keeping the current one if none is saved
+func (s *RelevanceRulesService) Load(ctx context.Context) error {
+	rules, err := s.rulesRepo.GetLatest(ctx)
+	if err != nil {
+		var notFoundErr *domainerrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	if rules.Version != s.scorer.Rules().Version {
+		s.scorer.SetRules(rules)
+		log.Info().Int("version", rules.Version).Msg("Loaded relevance rules")
+	}
+
+	return nil
+}
+
+// Get returns the ruleset in use; version 0 is the built-in default
+func (s *RelevanceRulesService) Get() *domain.RelevanceRules {
+	return s.scorer.Rules()
+}
+
+// Update validates and saves a new ruleset version and applies it to the scorer
+// Existing article scores are not recalculated; new and edited articles use the new rules
+func (s *RelevanceRulesService) Update(
+	ctx context.Context,
+	rules *domain.RelevanceRules,
+	actorID *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.RelevanceRules, error) {
+	if err := s.validate(rules); err != nil {
+		return nil, err
+	}
+
+	previous := s.scorer.Rules()
+	rules.CreatedBy = actorID
+
+	if err := s.rulesRepo.Create(ctx, rules); err != nil {
+		return nil, err
+	}
+
+	s.scorer.SetRules(rules)
+	s.audit(ctx, actorID, previous, rules, ipAddress, userAgent)
+
+	log.Info().
+		Int("version", rules.Version).
+		Int("product_keywords", len(rules.ProductKeywords)).
+		Int("industry_keywords", len(rules.IndustryKeywords)).
+		Msg("Relevance rules updated")
+
+	return rules, nil
+}
+
+// Preview scores a stored article or ad-hoc content under the current ruleset and,
+// if given, a proposed ruleset; nothing is saved
+func (s *RelevanceRulesService) Preview(
+	ctx context.Context,
+	articleID *uuid.UUID,
+	draft *RelevancePreviewArticle,
+	rules *domain.RelevanceRules,
+) (*RelevancePreview, error) {
+	article, err := s.previewArticle(ctx, articleID, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &RelevancePreview{
+		ArticleID: articleID,
+		Current:   s.scorer.Explain(article),
+	}
+
+	if rules == nil {
+		preview.Preview = preview.Current
+		return preview, nil
+	}
+
+	if err := s.validate(rules); err != nil {
+		return nil, err
+	}
+
+	preview.Preview = NewRelevanceScorerWithRules(rules).Explain(article)
+	return preview, nil
+}
+
+// Run reloads the latest ruleset on every interval until the context is cancelled,
+// so changes saved through another instance take effect here
+func (s *RelevanceRulesService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to reload relevance rules")
+			}
+		}
+	}
+}
+
+// validate normalizes keywords and checks the ruleset
+func (s *RelevanceRulesService) validate(rules *domain.RelevanceRules) error {
+	if rules == nil {
+		return &domainerrors.ValidationError{
+			Field:   "rules",
+			Message: "rules are required",
+		}
+	}
+
+	rules.Normalize()
+	if err := rules.Validate(); err != nil {
+		return &domainerrors.ValidationError{
+			Field:   "rules",
+			Message: err.Error(),
+		}
+	}
+
+	return nil
+}
+
+// previewArticle loads the stored article or builds one from the draft content
+func (s *RelevanceRulesService) previewArticle(ctx context.Context, articleID *uuid.UUID, draft *RelevancePreviewArticle) (*domain.Article, error) {
+	if articleID != nil {
+		article, err := s.articleRepo.GetByID(ctx, *articleID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				return nil, &domainerrors.NotFoundError{
+					Resource: "article",
+					ID:       articleID.String(),
+				}
+			}
+			return nil, fmt.Errorf("failed to get article: %w", err)
+		}
+		return article, nil
+	}
+
+	if draft == nil || (strings.TrimSpace(draft.Title) == "" && strings.TrimSpace(draft.Content) == "") {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article",
+			Message: "article_id or an article with a title or content is required",
+		}
+	}
+
+	if draft.Severity != "" && !draft.Severity.IsValid() {
+		return nil, &domainerrors.ValidationError{
+			Field:   "article.severity",
+			Message: fmt.Sprintf("invalid severity: %s", draft.Severity),
+		}
+	}
+
+	return &domain.Article{
+		Title:    draft.Title,
+		Content:  draft.Content,
+		Summary:  draft.Summary,
+		Severity: draft.Severity,
+	}, nil
+}
+
+// audit records a ruleset change; failures are logged and do not fail the update
+func (s *RelevanceRulesService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	oldValue, newValue *domain.RelevanceRules,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, domain.AuditActionRelevanceRulesUpdated, "relevance_rules", nil, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Int("version", newValue.Version).
+			Msg("Failed to write relevance rules audit log")
+	}
+}
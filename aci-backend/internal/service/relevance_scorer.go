@@ -2,69 +2,46 @@ package service
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
 )
 
 // RelevanceScorer calculates Armor.com relevance for articles
+// The ruleset can be replaced at runtime; scoring always uses a consistent snapshot
 type RelevanceScorer struct {
-	productKeywords  []string
-	industryKeywords []string
+	mu    sync.RWMutex
+	rules *domain.RelevanceRules
 }
 
-// NewRelevanceScorer creates a new relevance scorer with default keywords
+// NewRelevanceScorer creates a new relevance scorer with the default ruleset
 func NewRelevanceScorer() *RelevanceScorer {
-	return &RelevanceScorer{
-		productKeywords: []string{
-			// Armor products and services
-			"managed security",
-			"security operations center",
-			"soc",
-			"threat detection",
-			"threat response",
-			"incident response",
-			"security monitoring",
-			"cloud security",
-			"compliance",
-			"pci dss",
-			"pci compliance",
-			"hipaa",
-			"gdpr",
-			"vulnerability management",
-			"penetration testing",
-			"security assessment",
-			"managed cloud",
-			"cloud hosting",
-			"dedicated hosting",
-			"hybrid cloud",
-			"disaster recovery",
-			"business continuity",
-			"backup",
-			"security automation",
-			"threat intelligence",
-			"log management",
-			"siem",
-		},
-		industryKeywords: []string{
-			// Target industries
-			"healthcare",
-			"financial services",
-			"fintech",
-			"banking",
-			"e-commerce",
-			"retail",
-			"payment processing",
-			"credit card",
-			"payment card industry",
-			"online payments",
-			"saas",
-			"software as a service",
-			"enterprise",
-			"small business",
-			"medium business",
-			"smb",
-		},
+	return NewRelevanceScorerWithRules(domain.DefaultRelevanceRules())
+}
+
+// NewRelevanceScorerWithRules creates a relevance scorer with the given ruleset
+func NewRelevanceScorerWithRules(rules *domain.RelevanceRules) *RelevanceScorer {
+	if rules == nil {
+		rules = domain.DefaultRelevanceRules()
+	}
+	return &RelevanceScorer{rules: rules}
+}
+
+// Rules returns the ruleset currently in use; callers must not modify it
+func (s *RelevanceScorer) Rules() *domain.RelevanceRules {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rules
+}
+
+// SetRules replaces the ruleset used for subsequent scoring
+func (s *RelevanceScorer) SetRules(rules *domain.RelevanceRules) {
+	if rules == nil {
+		return
 	}
+	s.mu.Lock()
+	s.rules = rules
+	s.mu.Unlock()
 }
 
 // Score calculates the Armor relevance score for an article (0-1)
@@ -73,47 +50,52 @@ func (s *RelevanceScorer) Score(article *domain.Article) float64 {
 		return 0.0
 	}
 
+	return s.score(s.Rules(), article).Score
+}
+
+// Explain scores an article and reports the keywords, boost and CTA behind the score
+func (s *RelevanceScorer) Explain(article *domain.Article) *domain.RelevanceScore {
+	if article == nil {
+		return &domain.RelevanceScore{ProductMatches: []string{}, IndustryMatches: []string{}}
+	}
+
+	rules := s.Rules()
+	result := s.score(rules, article)
+	result.CTA = s.cta(rules, article, result.Score)
+	return result
+}
+
+// score applies the ruleset's keyword weights and severity boost
+func (s *RelevanceScorer) score(rules *domain.RelevanceRules, article *domain.Article) *domain.RelevanceScore {
 	combinedText := strings.ToLower(article.Title + " " + article.Content)
 	if article.Summary != nil {
 		combinedText += " " + strings.ToLower(*article.Summary)
 	}
 
-	// Calculate product keyword matches
-	productMatches := 0
-	for _, keyword := range s.productKeywords {
-		if strings.Contains(combinedText, keyword) {
-			productMatches++
-		}
+	result := &domain.RelevanceScore{
+		ProductMatches:  s.matches(combinedText, rules.ProductKeywords),
+		IndustryMatches: s.matches(combinedText, rules.IndustryKeywords),
+		SeverityBoost:   1.0,
 	}
 
-	// Calculate industry keyword matches
-	industryMatches := 0
-	for _, keyword := range s.industryKeywords {
-		if strings.Contains(combinedText, keyword) {
-			industryMatches++
-		}
+	// Weighted scoring: by default product keywords are more important
+	score := 0.0
+	if len(rules.ProductKeywords) > 0 {
+		score += float64(len(result.ProductMatches)) / float64(len(rules.ProductKeywords)) * rules.ProductWeight
+	}
+	if len(rules.IndustryKeywords) > 0 {
+		score += float64(len(result.IndustryMatches)) / float64(len(rules.IndustryKeywords)) * rules.IndustryWeight
 	}
-
-	// Weighted scoring: product keywords are more important
-	productWeight := 0.7
-	industryWeight := 0.3
-
-	productScore := float64(productMatches) / float64(len(s.productKeywords))
-	industryScore := float64(industryMatches) / float64(len(s.industryKeywords))
-
-	// Combine scores with weights
-	score := (productScore * productWeight) + (industryScore * industryWeight)
 
 	// Normalize to 0-1 range
 	if score > 1.0 {
 		score = 1.0
 	}
 
-	// Boost score for high severity articles (critical/high)
-	if article.Severity == domain.SeverityCritical {
-		score *= 1.2
-	} else if article.Severity == domain.SeverityHigh {
-		score *= 1.1
+	// Boost score by severity (critical/high by default)
+	if boost, ok := rules.SeverityBoosts[article.Severity]; ok {
+		result.SeverityBoost = boost
+		score *= boost
 	}
 
 	// Cap at 1.0
@@ -121,7 +103,8 @@ func (s *RelevanceScorer) Score(article *domain.Article) float64 {
 		score = 1.0
 	}
 
-	return score
+	result.Score = score
+	return result
 }
 
 // GenerateCTA generates a call-to-action if relevance is high enough
@@ -130,70 +113,36 @@ func (s *RelevanceScorer) GenerateCTA(article *domain.Article) *domain.ArmorCTA
 		return nil
 	}
 
-	// Only generate CTA if relevance is > 0.5
-	if article.ArmorRelevance <= 0.5 {
+	return s.cta(s.Rules(), article, article.ArmorRelevance)
+}
+
+// cta returns the first matching CTA rule's call-to-action when the score exceeds the threshold
+func (s *RelevanceScorer) cta(rules *domain.RelevanceRules, article *domain.Article, score float64) *domain.ArmorCTA {
+	if score <= rules.CTAThreshold {
 		return nil
 	}
 
 	combinedText := strings.ToLower(article.Title + " " + article.Content)
 
-	// Determine CTA type based on content
-	if s.containsAny(combinedText, []string{"managed security", "soc", "threat detection", "incident response"}) {
-		return &domain.ArmorCTA{
-			Type:  "service",
-			Title: "Protect Your Business with Armor Managed Security",
-			URL:   "https://www.armor.com/services/managed-security",
-		}
-	}
-
-	if s.containsAny(combinedText, []string{"cloud security", "cloud hosting", "aws", "azure", "gcp"}) {
-		return &domain.ArmorCTA{
-			Type:  "service",
-			Title: "Secure Your Cloud Infrastructure with Armor",
-			URL:   "https://www.armor.com/services/cloud-security",
-		}
-	}
-
-	if s.containsAny(combinedText, []string{"compliance", "pci", "hipaa", "gdpr"}) {
-		return &domain.ArmorCTA{
-			Type:  "service",
-			Title: "Achieve Compliance with Armor's Expert Guidance",
-			URL:   "https://www.armor.com/services/compliance",
+	for i := range rules.CTARules {
+		if rules.CTARules[i].Matches(combinedText, article.Severity) {
+			cta := rules.CTARules[i].CTA
+			return &cta
 		}
 	}
 
-	if s.containsAny(combinedText, []string{"vulnerability", "penetration test", "security assessment"}) {
-		return &domain.ArmorCTA{
-			Type:  "service",
-			Title: "Schedule a Security Assessment with Armor",
-			URL:   "https://www.armor.com/services/security-assessment",
-		}
-	}
-
-	if article.Severity == domain.SeverityCritical || article.Severity == domain.SeverityHigh {
-		return &domain.ArmorCTA{
-			Type:  "consultation",
-			Title: "Speak with an Armor Security Expert",
-			URL:   "https://www.armor.com/contact",
-		}
-	}
-
-	// Default CTA
-	return &domain.ArmorCTA{
-		Type:  "product",
-		Title: "Learn How Armor Can Protect Your Business",
-		URL:   "https://www.armor.com/solutions",
-	}
+	return nil
 }
 
-// containsAny checks if the text contains any of the keywords
-func (s *RelevanceScorer) containsAny(text string, keywords []string) bool {
+// matches returns the keywords found in the text
+func (s *RelevanceScorer) matches(text string, keywords []string) []string {
+	found := make([]string, 0)
 	for _, keyword := range keywords {
 		if strings.Contains(text, keyword) {
-			return true
+			found = append(found, keyword)
 		}
 	}
-	return false
+	return found
 }
 
 // AddProductKeyword adds a product keyword
@@ -201,7 +150,12 @@ func (s *RelevanceScorer) AddProductKeyword(keyword string) {
 	if keyword == "" {
 		return
 	}
-	s.productKeywords = append(s.productKeywords, strings.ToLower(keyword))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := *s.rules
+	rules.ProductKeywords = append(append([]string{}, rules.ProductKeywords...), strings.ToLower(keyword))
+	s.rules = &rules
 }
 
 // AddIndustryKeyword adds an industry keyword
@@ -209,5 +163,10 @@ func (s *RelevanceScorer) AddIndustryKeyword(keyword string) {
 	if keyword == "" {
 		return
 	}
-	s.industryKeywords = append(s.industryKeywords, strings.ToLower(keyword))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := *s.rules
+	rules.IndustryKeywords = append(append([]string{}, rules.IndustryKeywords...), strings.ToLower(keyword))
+	s.rules = &rules
 }
@@ -0,0 +1,470 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/email"
+	"github.com/phillipboles/aci-backend/internal/newsletter"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/pdf"
+)
+
+const (
+	// reportTopArticles is how many articles a report lists
+	reportTopArticles = 10
+
+	// reportNotableCVEs is how many CVEs a report lists
+	reportNotableCVEs = 10
+
+	// reportWeek is the period a weekly briefing covers
+	reportWeek = 7 * 24 * time.Hour
+)
+
+// severityColors are the bar colors of the severity breakdown, matching the web app's badges
+var severityColors = map[domain.Severity]pdf.Color{
+	domain.SeverityCritical:      {R: 0.71, G: 0.14, B: 0.09},
+	domain.SeverityHigh:          {R: 0.77, G: 0.35, B: 0.05},
+	domain.SeverityMedium:        {R: 0.85, G: 0.65, B: 0.13},
+	domain.SeverityLow:           {R: 0.04, G: 0.41, B: 0.64},
+	domain.SeverityInformational: {R: 0.45, G: 0.45, B: 0.45},
+}
+
+// ReportMailer sends a message with files attached
+type ReportMailer interface {
+	SendWithAttachments(ctx context.Context, to, subject, htmlBody, textBody string, headers map[string]string, attachments []email.Attachment) error
+}
+
+// ReportServiceConfig configures the report service
+type ReportServiceConfig struct {
+	SiteURL   string // origin of the web app, linked from the briefing email
+	BatchSize int    // briefings emailed per SendPending
+}
+
+// ReportService builds threat reports, rendered to PDF: a period's severity breakdown, its top
+// articles and notable CVEs, and alert matches. The weekly briefing covers a reader's alerts;
+// organization reports cover their template's filters and sections and the organization's alerts.
+// Weeks run Monday to Sunday in UTC. With a mailer set, readers on the weekly digest are
+// emailed the previous week's briefing as an attachment; each delivery is claimed before
+// sending so that it goes out once even with several instances running
+type ReportService struct {
+	reportRepo repository.ReportRepository
+	mailer     ReportMailer
+	cfg        ReportServiceConfig
+}
+
+// NewReportService creates a new report service instance
+func NewReportService(reportRepo repository.ReportRepository, cfg ReportServiceConfig) *ReportService {
+	if reportRepo == nil {
+		panic("reportRepo cannot be nil")
+	}
+
+	cfg.SiteURL = strings.TrimRight(cfg.SiteURL, "/")
+
+	return &ReportService{
+		reportRepo: reportRepo,
+		cfg:        cfg,
+	}
+}
+
+// SetMailer enables emailing the weekly briefing to readers on the weekly digest
+func (s *ReportService) SetMailer(mailer ReportMailer) {
+	s.mailer = mailer
+}
+
+// WeekStart returns the start of the week containing t: Monday 00:00 UTC
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+// LastWeek returns the start of the most recent completed week
+func LastWeek(now time.Time) time.Time {
+	return WeekStart(now).Add(-reportWeek)
+}
+
+// Weekly builds the user's briefing for the week starting periodStart
+func (s *ReportService) Weekly(ctx context.Context, userID uuid.UUID, periodStart time.Time) (*domain.ThreatReport, error) {
+	from := WeekStart(periodStart)
+	to := from.Add(reportWeek)
+
+	return s.build(ctx, from, to, nil, domain.ReportSections, func() ([]domain.ReportAlertMatches, error) {
+		return s.reportRepo.GetAlertMatches(ctx, userID, from, to)
+	})
+}
+
+// RenderPDF lays out a weekly briefing as a PDF
+func (s *ReportService) RenderPDF(report *domain.ThreatReport) ([]byte, error) {
+	return renderReport(report, reportLayout{
+		title:          "Weekly Threat Briefing",
+		sections:       domain.ReportSections,
+		matchesHeading: "Your alert matches",
+		markShared:     true,
+	})
+}
+
+// Organization builds and renders an organization report template for [from, to); its alert
+// matches are those of the alerts shared with the organization
+func (s *ReportService) Organization(ctx context.Context, template *domain.OrganizationReport, from, to time.Time) (*domain.ThreatReport, []byte, error) {
+	report, err := s.build(ctx, from, to, &template.Filter, template.Sections, func() ([]domain.ReportAlertMatches, error) {
+		return s.reportRepo.GetOrganizationAlertMatches(ctx, template.OrganizationID, from, to)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	document, err := renderReport(report, reportLayout{
+		title:          template.Name,
+		sections:       template.Sections,
+		matchesHeading: "Organization alert matches",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, document, nil
+}
+
+// build gathers the sections of a report on [from, to); sections that are not included are left empty
+func (s *ReportService) build(
+	ctx context.Context,
+	from, to time.Time,
+	filter *domain.ReportFilter,
+	sections []domain.ReportSection,
+	alertMatches func() ([]domain.ReportAlertMatches, error),
+) (*domain.ThreatReport, error) {
+	report := &domain.ThreatReport{
+		PeriodStart: from,
+		PeriodEnd:   to,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	// The total article count is part of every report, even without the severity section
+	severity, err := s.reportRepo.GetSeverityBreakdown(ctx, from, to, filter)
+	if err != nil {
+		return nil, err
+	}
+	report.Severity = *severity
+
+	for _, section := range sections {
+		switch section {
+		case domain.ReportSectionTopArticles:
+			if report.TopArticles, err = s.reportRepo.GetTopArticles(ctx, from, to, filter, reportTopArticles); err != nil {
+				return nil, err
+			}
+		case domain.ReportSectionNotableCVEs:
+			if report.NotableCVEs, err = s.reportRepo.GetNotableCVEs(ctx, from, to, filter, reportNotableCVEs); err != nil {
+				return nil, err
+			}
+		case domain.ReportSectionAlertMatches:
+			if report.AlertMatches, err = alertMatches(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// reportLayout is how a report is laid out as a PDF
+type reportLayout struct {
+	title          string
+	sections       []domain.ReportSection // in the order they appear
+	matchesHeading string
+	markShared     bool // label alerts shared with the reader's organization
+}
+
+// renderReport lays out a report as a PDF
+func renderReport(report *domain.ThreatReport, layout reportLayout) ([]byte, error) {
+	doc := pdf.New(layout.title)
+
+	doc.Title(layout.title)
+	doc.Note(fmt.Sprintf("%s. Generated %s UTC.", reportPeriod(report), report.GeneratedAt.Format("Jan 2, 2006 15:04")))
+
+	for _, section := range layout.sections {
+		switch section {
+		case domain.ReportSectionSeverity:
+			renderSeverity(doc, report)
+		case domain.ReportSectionTopArticles:
+			renderTopArticles(doc, report)
+		case domain.ReportSectionNotableCVEs:
+			renderNotableCVEs(doc, report)
+		case domain.ReportSectionAlertMatches:
+			renderAlertMatches(doc, report, layout)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render report: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderSeverity adds the severity breakdown chart
+func renderSeverity(doc *pdf.Document, report *domain.ThreatReport) {
+	doc.Heading("Severity breakdown")
+	doc.Paragraph(fmt.Sprintf("%d articles were published in this period.", report.Severity.Total))
+	doc.Bars([]pdf.Bar{
+		{Label: "Critical", Value: report.Severity.Critical, Color: severityColors[domain.SeverityCritical]},
+		{Label: "High", Value: report.Severity.High, Color: severityColors[domain.SeverityHigh]},
+		{Label: "Medium", Value: report.Severity.Medium, Color: severityColors[domain.SeverityMedium]},
+		{Label: "Low", Value: report.Severity.Low, Color: severityColors[domain.SeverityLow]},
+		{Label: "Informational", Value: report.Severity.Informational, Color: severityColors[domain.SeverityInformational]},
+	})
+}
+
+// renderTopArticles adds the top articles table
+func renderTopArticles(doc *pdf.Document, report *domain.ThreatReport) {
+	doc.Heading("Top articles")
+	if len(report.TopArticles) == 0 {
+		doc.Note("No articles were published in this period.")
+		return
+	}
+
+	rows := make([][]string, len(report.TopArticles))
+	for i, article := range report.TopArticles {
+		rows[i] = []string{
+			reportSeverity(article.Severity),
+			article.Title,
+			article.SourceName,
+			article.PublishedAt.UTC().Format("Jan 2"),
+		}
+	}
+	doc.Table([]pdf.Column{
+		{Header: "Severity", Width: 0.14},
+		{Header: "Title", Width: 0.56},
+		{Header: "Source", Width: 0.2},
+		{Header: "Published", Width: 0.1},
+	}, rows)
+}
+
+// renderNotableCVEs adds the notable CVEs table
+func renderNotableCVEs(doc *pdf.Document, report *domain.ThreatReport) {
+	doc.Heading("Notable CVEs")
+	if len(report.NotableCVEs) == 0 {
+		doc.Note("No CVEs were mentioned in this period.")
+		return
+	}
+
+	rows := make([][]string, len(report.NotableCVEs))
+	for i, cve := range report.NotableCVEs {
+		kev := "No"
+		if cve.KEV {
+			kev = "Yes"
+			if cve.KEVDueDate != nil {
+				kev = "Due " + cve.KEVDueDate.UTC().Format("Jan 2, 2006")
+			}
+		}
+
+		exploit := "No"
+		if cve.ExploitAvailable {
+			exploit = "Yes"
+		}
+
+		rows[i] = []string{cve.CVE, strconv.Itoa(cve.ArticleCount), kev, exploit}
+	}
+	doc.Table([]pdf.Column{
+		{Header: "CVE", Width: 0.3},
+		{Header: "Articles", Width: 0.15},
+		{Header: "Known exploited", Width: 0.3},
+		{Header: "Public exploit", Width: 0.25},
+	}, rows)
+}
+
+// renderAlertMatches adds the alert matches table
+func renderAlertMatches(doc *pdf.Document, report *domain.ThreatReport, layout reportLayout) {
+	doc.Heading(layout.matchesHeading)
+	if len(report.AlertMatches) == 0 {
+		doc.Note("No alerts matched an article in this period.")
+		return
+	}
+
+	rows := make([][]string, len(report.AlertMatches))
+	for i, alert := range report.AlertMatches {
+		name := alert.AlertName
+		if layout.markShared && alert.Shared {
+			name += " (organization)"
+		}
+
+		rows[i] = []string{
+			name,
+			strconv.Itoa(alert.Matches),
+			strconv.Itoa(alert.Critical),
+			strconv.Itoa(alert.High),
+			strconv.Itoa(alert.Unacknowledged),
+		}
+	}
+	doc.Table([]pdf.Column{
+		{Header: "Alert", Width: 0.44},
+		{Header: "Matches", Width: 0.14},
+		{Header: "Critical", Width: 0.14},
+		{Header: "High", Width: 0.14},
+		{Header: "New", Width: 0.14},
+	}, rows)
+}
+
+// SendPending emails one batch of last week's briefings to readers on the weekly digest
+// It returns how many were sent
+func (s *ReportService) SendPending(ctx context.Context) (int, error) {
+	if s.mailer == nil {
+		return 0, nil
+	}
+
+	periodStart := LastWeek(time.Now())
+
+	recipients, err := s.reportRepo.ListPendingRecipients(ctx, periodStart, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, recipient := range recipients {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+
+		claimed, err := s.reportRepo.ClaimDelivery(ctx, recipient.UserID, periodStart)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.deliver(ctx, recipient, periodStart); err != nil {
+			log.Warn().
+				Err(err).
+				Str("user_id", recipient.UserID.String()).
+				Time("period_start", periodStart).
+				Msg("Failed to send weekly report")
+
+			// A rejected address stays claimed so it is not retried every tick
+			if !isPermanent(err) {
+				if err := s.reportRepo.ReleaseDelivery(ctx, recipient.UserID, periodStart); err != nil {
+					return sent, err
+				}
+			}
+			continue
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+// Run emails pending weekly briefings every interval until ctx is cancelled
+func (s *ReportService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := s.SendPending(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to send weekly reports")
+			}
+			if sent > 0 {
+				log.Info().Int("sent", sent).Msg("Sent weekly reports")
+			}
+		}
+	}
+}
+
+// deliver builds, renders and emails one reader's briefing
+func (s *ReportService) deliver(ctx context.Context, recipient *domain.ReportRecipient, periodStart time.Time) error {
+	report, err := s.Weekly(ctx, recipient.UserID, periodStart)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := s.RenderPDF(report)
+	if err != nil {
+		return err
+	}
+
+	matches := 0
+	for _, alert := range report.AlertMatches {
+		matches += alert.Matches
+	}
+
+	period := reportPeriod(report)
+	html, text, err := newsletter.RenderWeeklyReport(newsletter.WeeklyReportData{
+		Name:         recipient.Name,
+		Period:       period,
+		Articles:     report.Severity.Total,
+		Critical:     report.Severity.Critical,
+		High:         report.Severity.High,
+		AlertMatches: matches,
+		DashboardURL: s.cfg.SiteURL + "/dashboard",
+	})
+	if err != nil {
+		return err
+	}
+
+	to := recipient.Email
+	if recipient.Name != "" {
+		to = (&mail.Address{Name: recipient.Name, Address: recipient.Email}).String()
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, newsletterSendTimeout)
+	defer cancel()
+
+	return s.mailer.SendWithAttachments(
+		sendCtx,
+		to,
+		fmt.Sprintf(newsletter.WeeklyReportSubject, period),
+		html,
+		text,
+		nil,
+		[]email.Attachment{{
+			Filename:    ReportFilename(report.PeriodStart),
+			ContentType: "application/pdf",
+			Data:        attachment,
+		}},
+	)
+}
+
+// ReportFilename is the file name of the briefing for the week starting periodStart
+func ReportFilename(periodStart time.Time) string {
+	return "weekly-briefing-" + periodStart.UTC().Format("20060102") + ".pdf"
+}
+
+// reportPeriod describes the days a report covers, e.g. Oct 5 - Oct 11, 2026
+func reportPeriod(report *domain.ThreatReport) string {
+	first := report.PeriodStart.UTC()
+	last := report.PeriodEnd.UTC().Add(-time.Nanosecond)
+
+	switch {
+	case first.YearDay() == last.YearDay() && first.Year() == last.Year():
+		return last.Format("Jan 2, 2006")
+	case first.Year() != last.Year():
+		return first.Format("Jan 2, 2006") + " - " + last.Format("Jan 2, 2006")
+	default:
+		return first.Format("Jan 2") + " - " + last.Format("Jan 2, 2006")
+	}
+}
+
+// reportSeverity capitalizes a severity for display
+func reportSeverity(severity domain.Severity) string {
+	s := string(severity)
+	if s == "" {
+		return ""
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// searchFieldPrefixes are the field scopes recognised in search queries
+var searchFieldPrefixes = map[string]domain.SearchField{
+	"cve:":    domain.SearchFieldCVE,
+	"vendor:": domain.SearchFieldVendor,
+	"tag:":    domain.SearchFieldTag,
+}
+
+// searchToken is a term or an OR operator read from a search query
+type searchToken struct {
+	term domain.SearchTerm
+	or   bool
+}
+
+// ParseSearchQuery parses the article search syntax:
+//
+//	ransomware "supply chain"   every word and quoted phrase must match
+//	-phishing -"test post"      a leading minus excludes articles that match
+//	cve:CVE-2024-1234           cve:, vendor: and tag: match that field; vendor:"palo alto" quotes a value with spaces
+//	cve:CVE-2024-*              a field value ending in * matches as a prefix
+//	lockbit OR blackcat         OR matches either neighbouring term and binds tighter than the implicit AND
+//
+// Anything else, including an unknown prefix such as http:, is searched as text.
+// Syntax errors are returned as *domainerrors.ValidationError
+func ParseSearchQuery(input string) (*domain.SearchQuery, error) {
+	tokens, err := tokenizeSearchQuery(input)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &domain.SearchQuery{}
+	terms := 0
+	pendingOr := false
+
+	for _, token := range tokens {
+		if token.or {
+			if len(query.Groups) == 0 || pendingOr {
+				return nil, searchQueryError("OR must appear between two terms")
+			}
+			pendingOr = true
+			continue
+		}
+
+		terms++
+		if terms > domain.MaxSearchTerms {
+			return nil, searchQueryError(fmt.Sprintf("search query cannot have more than %d terms", domain.MaxSearchTerms))
+		}
+
+		if pendingOr {
+			last := len(query.Groups) - 1
+			query.Groups[last] = append(query.Groups[last], token.term)
+			pendingOr = false
+			continue
+		}
+
+		query.Groups = append(query.Groups, []domain.SearchTerm{token.term})
+	}
+
+	if pendingOr {
+		return nil, searchQueryError("OR must appear between two terms")
+	}
+
+	if len(query.Groups) == 0 {
+		return nil, searchQueryError("search query has no terms")
+	}
+
+	return query, nil
+}
+
+// tokenizeSearchQuery splits a search query into terms and OR operators
+func tokenizeSearchQuery(input string) ([]searchToken, error) {
+	var tokens []searchToken
+
+	i := 0
+	for i < len(input) {
+		if isSearchSpace(input[i]) {
+			i++
+			continue
+		}
+
+		term := domain.SearchTerm{}
+		if input[i] == '-' && i+1 < len(input) && !isSearchSpace(input[i+1]) {
+			term.Negated = true
+			i++
+		}
+
+		for prefix, field := range searchFieldPrefixes {
+			if len(input)-i >= len(prefix) && strings.EqualFold(input[i:i+len(prefix)], prefix) {
+				term.Field = field
+				i += len(prefix)
+				break
+			}
+		}
+
+		if i < len(input) && input[i] == '"' {
+			end := strings.IndexByte(input[i+1:], '"')
+			if end < 0 {
+				return nil, searchQueryError("quoted phrase is missing its closing quote")
+			}
+			term.Value = strings.Join(strings.Fields(input[i+1:i+1+end]), " ")
+			term.Phrase = true
+			i += end + 2
+		} else {
+			start := i
+			for i < len(input) && !isSearchSpace(input[i]) {
+				i++
+			}
+			term.Value = input[start:i]
+		}
+
+		if term.Value == "" {
+			if term.Field != domain.SearchFieldText {
+				return nil, searchQueryError(fmt.Sprintf("%s: needs a value", term.Field))
+			}
+			// An empty phrase matches nothing in particular, so it is dropped
+			continue
+		}
+
+		if term.Field == domain.SearchFieldText && !term.Phrase && !term.Negated && term.Value == "OR" {
+			tokens = append(tokens, searchToken{or: true})
+			continue
+		}
+
+		if term.Field == domain.SearchFieldText && !term.Phrase && term.Value == "-" {
+			continue
+		}
+
+		tokens = append(tokens, searchToken{term: term})
+	}
+
+	return tokens, nil
+}
+
+// isSearchSpace reports whether b separates search terms
+func isSearchSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// searchQueryError reports a search query syntax error
+func searchQueryError(message string) error {
+	return &domainerrors.ValidationError{
+		Field:   "q",
+		Message: message,
+	}
+}
+
+// FormatSearchQuery writes a parsed query back in the search syntax
+func FormatSearchQuery(query *domain.SearchQuery) string {
+	groups := make([]string, len(query.Groups))
+	for i, group := range query.Groups {
+		terms := make([]string, len(group))
+		for j, term := range group {
+			var b strings.Builder
+			if term.Negated {
+				b.WriteByte('-')
+			}
+			if term.Field != domain.SearchFieldText {
+				b.WriteString(string(term.Field))
+				b.WriteByte(':')
+			}
+			if term.Phrase {
+				b.WriteString(`"` + term.Value + `"`)
+			} else {
+				b.WriteString(term.Value)
+			}
+			terms[j] = b.String()
+		}
+		groups[i] = strings.Join(terms, " OR ")
+	}
+
+	return strings.Join(groups, " ")
+}
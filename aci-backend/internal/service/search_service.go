@@ -2,15 +2,60 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/metrics"
 	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/tracing"
 )
 
+// fuzzyFallbackMinResults is the result count below which a search adds similar articles and
+// suggests a corrected query
+const fuzzyFallbackMinResults = 5
+
+// similarMatchScore is the score of articles added by the fuzzy fallback, below exact matches
+const similarMatchScore = 0.5
+
+// minSuggestionWordLength is the shortest word a correction is suggested for
+const minSuggestionWordLength = 3
+
+// maxSearchCacheEntries bounds the search cache; expired entries are evicted first, then the cache is cleared
+const maxSearchCacheEntries = 1000
+
+// searchCacheEntry is a cached result page, the category and severity it was scoped to, and when it expires
+type searchCacheEntry struct {
+	page        *SearchPage
+	categoryID  *uuid.UUID
+	severity    *domain.Severity
+	minSeverity *domain.Severity
+	expiresAt   time.Time
+}
+
 // SearchService handles article search operations
+// With a cache enabled, result pages are cached per normalized query and filters for a short
+// TTL. Ingesting an article drops the cached pages it could appear in; the cache is local to
+// the instance, so other instances pick up the article when their entries expire
 type SearchService struct {
 	articleRepo repository.ArticleRepository
+
+	// suggestions proposes corrected queries when a search finds few results; nil disables them
+	suggestions repository.SearchSuggestionRepository
+
+	categoryRepo repository.CategoryRepository
+	cacheTTL     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]searchCacheEntry
 }
 
 // NewSearchService creates a new search service instance
@@ -21,9 +66,35 @@ func NewSearchService(articleRepo repository.ArticleRepository) *SearchService {
 
 	return &SearchService{
 		articleRepo: articleRepo,
+		cache:       make(map[string]searchCacheEntry),
 	}
 }
 
+// EnableCache caches search results for ttl; categoryRepo resolves the parent categories of
+// ingested articles, since a category filter also matches subcategories
+func (s *SearchService) EnableCache(categoryRepo repository.CategoryRepository, ttl time.Duration) {
+	if categoryRepo == nil {
+		panic("categoryRepo cannot be nil")
+	}
+
+	s.categoryRepo = categoryRepo
+	s.cacheTTL = ttl
+}
+
+// SetSuggestionRepository enables "did you mean" suggestions
+func (s *SearchService) SetSuggestionRepository(suggestions repository.SearchSuggestionRepository) {
+	s.suggestions = suggestions
+}
+
+// SearchPage is one page of search results
+type SearchPage struct {
+	Results []*SearchResult
+	Total   int
+	// DidYouMean is a corrected query, set when the search found few results and a query word
+	// looks misspelled
+	DidYouMean string
+}
+
 // SearchResult represents a search result with relevance score
 type SearchResult struct {
 	Article   *domain.Article `json:"article"`
@@ -33,36 +104,302 @@ type SearchResult struct {
 
 // Search performs full-text search on articles
 // Uses PostgreSQL full-text search with ranking
-func (s *SearchService) Search(ctx context.Context, query string, filter *domain.ArticleFilter) ([]*SearchResult, int, error) {
+// When it finds fewer than fuzzyFallbackMinResults articles, the first page is topped up with
+// articles whose titles are similar to the query words, so misspellings still find results
+func (s *SearchService) Search(ctx context.Context, query string, filter *domain.ArticleFilter) (*SearchPage, error) {
+	ctx, span := tracing.Start(ctx, "SearchService.Search",
+		trace.WithAttributes(attribute.Int("search.query_length", len(query))),
+	)
+	page, err := s.search(ctx, query, filter)
+	if page != nil {
+		span.SetAttributes(attribute.Int("search.total", page.Total))
+	}
+	tracing.End(span, err)
+	return page, err
+}
+
+// search runs the query traced by Search
+func (s *SearchService) search(ctx context.Context, query string, filter *domain.ArticleFilter) (*SearchPage, error) {
+	query = strings.TrimSpace(query)
 	if query == "" {
-		return nil, 0, fmt.Errorf("search query cannot be empty")
+		return nil, fmt.Errorf("search query cannot be empty")
 	}
 
 	if filter == nil {
 		filter = domain.NewArticleFilter()
 	}
 
-	filter.SearchQuery = &query
+	parsed, err := ParseSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	filter.Query = parsed
 
 	if err := filter.Validate(); err != nil {
-		return nil, 0, fmt.Errorf("invalid filter: %w", err)
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	if s.cacheTTL <= 0 {
+		return s.runSearch(ctx, query, filter)
+	}
+
+	key, err := searchCacheKey(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
 
+	now := time.Now()
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+
+	hit := ok && now.Before(entry.expiresAt)
+	metrics.RecordCacheLookup(metrics.CacheSearch, hit)
+	if hit {
+		return entry.page, nil
+	}
+
+	page, err := s.runSearch(ctx, query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if len(s.cache) >= maxSearchCacheEntries {
+		for k, e := range s.cache {
+			if !now.Before(e.expiresAt) {
+				delete(s.cache, k)
+			}
+		}
+		if len(s.cache) >= maxSearchCacheEntries {
+			s.cache = make(map[string]searchCacheEntry)
+		}
+	}
+	s.cache[key] = searchCacheEntry{
+		page:        page,
+		categoryID:  filter.CategoryID,
+		severity:    filter.Severity,
+		minSeverity: filter.MinSeverity,
+		expiresAt:   now.Add(s.cacheTTL),
+	}
+	s.mu.Unlock()
+
+	return page, nil
+}
+
+// runSearch queries the repository for one result page
+func (s *SearchService) runSearch(ctx context.Context, query string, filter *domain.ArticleFilter) (*SearchPage, error) {
 	articles, total, err := s.articleRepo.List(ctx, filter)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to search articles: %w", err)
+		return nil, fmt.Errorf("failed to search articles: %w", err)
 	}
 
-	results := make([]*SearchResult, len(articles))
+	page := &SearchPage{
+		Results: make([]*SearchResult, len(articles)),
+		Total:   total,
+	}
 	for i, article := range articles {
-		results[i] = &SearchResult{
+		page.Results[i] = &SearchResult{
 			Article:   article,
 			Score:     1.0, // Repository should provide relevance score
 			Highlight: extractHighlight(article, query),
 		}
 	}
 
-	return results, total, nil
+	if filter.Page != 1 || total >= fuzzyFallbackMinResults || filter.Query == nil {
+		return page, nil
+	}
+
+	// The fallback is best effort; the exact matches are returned even when it fails
+	if err := s.addSimilarArticles(ctx, query, filter, page); err != nil {
+		log.Warn().Err(err).Msg("Failed to find similar articles for search")
+	}
+
+	if s.suggestions != nil {
+		suggestion, err := s.suggestQuery(ctx, filter.Query)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to suggest search query")
+		}
+		page.DidYouMean = suggestion
+	}
+
+	return page, nil
+}
+
+// addSimilarArticles fills the rest of the page with articles whose titles are similar to the
+// query's text terms; other filters still apply, and excluded and field terms are not used
+func (s *SearchService) addSimilarArticles(ctx context.Context, query string, filter *domain.ArticleFilter, page *SearchPage) error {
+	var words []string
+	for _, group := range filter.Query.Groups {
+		for _, term := range group {
+			if term.Field == domain.SearchFieldText && !term.Negated {
+				words = append(words, term.Value)
+			}
+		}
+	}
+
+	remaining := filter.PageSize - len(page.Results)
+	if len(words) == 0 || remaining <= 0 {
+		return nil
+	}
+
+	text := strings.Join(words, " ")
+	similar := *filter
+	similar.Query = nil
+	similar.SimilarTo = &text
+	similar.PageSize = remaining
+
+	articles, _, err := s.articleRepo.List(ctx, &similar)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uuid.UUID]bool, len(page.Results))
+	for _, result := range page.Results {
+		found[result.Article.ID] = true
+	}
+
+	for _, article := range articles {
+		if found[article.ID] {
+			continue
+		}
+
+		page.Results = append(page.Results, &SearchResult{
+			Article:   article,
+			Score:     similarMatchScore,
+			Highlight: extractHighlight(article, query),
+		})
+		page.Total++
+	}
+
+	return nil
+}
+
+// suggestQuery returns the query with misspelled words corrected, or "" when no word changed
+// Only plain words are corrected; phrases, exclusions and field values are kept as typed
+func (s *SearchService) suggestQuery(ctx context.Context, query *domain.SearchQuery) (string, error) {
+	corrected := &domain.SearchQuery{Groups: make([][]domain.SearchTerm, len(query.Groups))}
+	changed := false
+
+	for i, group := range query.Groups {
+		corrected.Groups[i] = make([]domain.SearchTerm, len(group))
+		for j, term := range group {
+			if term.Field == domain.SearchFieldText && !term.Phrase && !term.Negated && len(term.Value) >= minSuggestionWordLength {
+				suggestion, err := s.suggestions.SuggestTerm(ctx, term.Value)
+				if err != nil {
+					return "", err
+				}
+				if suggestion != "" {
+					term.Value = suggestion
+					changed = true
+				}
+			}
+			corrected.Groups[i][j] = term
+		}
+	}
+
+	if !changed {
+		return "", nil
+	}
+
+	return FormatSearchQuery(corrected), nil
+}
+
+// InvalidateArticle drops the cached result pages a newly ingested article could appear in
+// Pages scoped to another category or severity are kept; the article's categories are
+// expanded to their parents only when a category-scoped page is cached
+func (s *SearchService) InvalidateArticle(ctx context.Context, article *domain.Article) {
+	if s.cacheTTL <= 0 || article == nil {
+		return
+	}
+
+	s.mu.Lock()
+	categoryScoped := false
+	for _, entry := range s.cache {
+		if entry.categoryID != nil {
+			categoryScoped = true
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	var categories map[uuid.UUID]bool
+	if categoryScoped {
+		var err error
+		categories, err = s.categoryScopes(ctx, article)
+		if err != nil {
+			// Without the hierarchy every category-scoped page is dropped
+			log.Warn().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Msg("Failed to resolve article categories for search cache invalidation")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, entry := range s.cache {
+		if entry.severity != nil && *entry.severity != article.Severity {
+			continue
+		}
+		if entry.minSeverity != nil && !article.Severity.AtLeast(*entry.minSeverity) {
+			continue
+		}
+		if entry.categoryID != nil && categories != nil && !categories[*entry.categoryID] {
+			continue
+		}
+		delete(s.cache, key)
+	}
+}
+
+// categoryScopes returns the article's categories and all their parent categories
+func (s *SearchService) categoryScopes(ctx context.Context, article *domain.Article) (map[uuid.UUID]bool, error) {
+	all, err := s.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	parents := make(map[uuid.UUID]*uuid.UUID, len(all))
+	for _, category := range all {
+		parents[category.ID] = category.ParentID
+	}
+
+	scopes := make(map[uuid.UUID]bool)
+	ids := append([]uuid.UUID{article.CategoryID}, article.CategoryIDs...)
+	for _, id := range ids {
+		// The hierarchy has no cycles, but stop at a category already seen regardless
+		for current := &id; current != nil && !scopes[*current]; current = parents[*current] {
+			scopes[*current] = true
+		}
+	}
+
+	return scopes, nil
+}
+
+// searchCacheKey identifies a result page by its tenant scope, parsed query and filters
+// Term values are lowercased since matching is case-insensitive
+func searchCacheKey(ctx context.Context, filter *domain.ArticleFilter) (string, error) {
+	normalized := *filter
+	if filter.Query != nil {
+		query := &domain.SearchQuery{Groups: make([][]domain.SearchTerm, len(filter.Query.Groups))}
+		for i, group := range filter.Query.Groups {
+			query.Groups[i] = make([]domain.SearchTerm, len(group))
+			for j, term := range group {
+				term.Value = strings.ToLower(term.Value)
+				query.Groups[i][j] = term
+			}
+		}
+		normalized.Query = query
+	}
+
+	key, err := json.Marshal(&normalized)
+	if err != nil {
+		return "", fmt.Errorf("failed to build search cache key: %w", err)
+	}
+
+	return tenantCacheScope(ctx) + "|" + string(key), nil
 }
 
 // SemanticSearch performs vector similarity search using embeddings
@@ -83,9 +420,10 @@ func (s *SearchService) SemanticSearch(ctx context.Context, query string, limit
 	// TODO: Implement vector similarity search using pgvector
 	// For now, fall back to full-text search
 	filter := &domain.ArticleFilter{
-		SearchQuery: &query,
-		Page:        1,
-		PageSize:    limit,
+		SearchQuery:   &query,
+		PublishedOnly: true,
+		Page:          1,
+		PageSize:      limit,
 	}
 
 	articles, _, err := s.articleRepo.List(ctx, filter)
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// SecurityEventService records security events on user accounts and lists them for their owners
+// Events are written to the audit log with the security event taxonomy from the domain package
+type SecurityEventService struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewSecurityEventService creates a new security event service instance
+func NewSecurityEventService(auditRepo repository.AuditLogRepository) *SecurityEventService {
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &SecurityEventService{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record writes a security event; failures are logged and do not fail the operation
+// userID is the account the event concerns and may be nil, e.g. for a failed login to an unknown email
+func (s *SecurityEventService) Record(
+	ctx context.Context,
+	userID *uuid.UUID,
+	event string,
+	resourceType string,
+	resourceID *uuid.UUID,
+	details interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(userID, event, resourceType, resourceID, nil, details, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		logEvent := log.Error().
+			Err(err).
+			Str("event", event)
+		if userID != nil {
+			logEvent = logEvent.Str("user_id", userID.String())
+		}
+		logEvent.Msg("Failed to write security event")
+	}
+}
+
+// ListForUser returns the user's security events, newest first, with the total count
+func (s *SecurityEventService) ListForUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]*domain.SecurityActivity, int, error) {
+	if userID == uuid.Nil {
+		return nil, 0, fmt.Errorf("user ID is required")
+	}
+
+	filter := &domain.AuditLogFilter{
+		UserID:  &userID,
+		Actions: domain.SecurityEvents,
+		Limit:   pageSize,
+		Offset:  (page - 1) * pageSize,
+	}
+
+	logs, total, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list security events: %w", err)
+	}
+
+	activity := make([]*domain.SecurityActivity, len(logs))
+	for i, entry := range logs {
+		activity[i] = domain.NewSecurityActivity(entry)
+	}
+
+	return activity, total, nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/sharetoken"
+)
+
+// ErrShareLinkExpired is returned when a share link is followed after it expired
+var ErrShareLinkExpired = errors.New("share link has expired")
+
+// directShareSource is recorded for clicks on links with neither a src parameter nor a channel
+const directShareSource = "direct"
+
+// ShareLinkService issues short signed share links for articles and resolves them
+// A link's token is its code followed by a signature, so forged tokens are rejected before
+// any lookup; following a link records where the visitor came from and redirects them to
+// the article on the web app, tagged with the share source for the site's own analytics
+type ShareLinkService struct {
+	shareRepo   repository.ShareLinkRepository
+	articleRepo repository.ArticleRepository
+	secret      []byte
+	baseURL     string
+	siteURL     string
+	maxTTL      time.Duration
+}
+
+// NewShareLinkService creates a new share link service instance
+// baseURL is the public origin of this API and siteURL the origin of the web app
+func NewShareLinkService(
+	shareRepo repository.ShareLinkRepository,
+	articleRepo repository.ArticleRepository,
+	secret, baseURL, siteURL string,
+	maxTTL time.Duration,
+) *ShareLinkService {
+	if shareRepo == nil {
+		panic("shareRepo cannot be nil")
+	}
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+	if secret == "" {
+		panic("secret cannot be empty")
+	}
+
+	return &ShareLinkService{
+		shareRepo:   shareRepo,
+		articleRepo: articleRepo,
+		secret:      []byte(secret),
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		siteURL:     strings.TrimRight(siteURL, "/"),
+		maxTTL:      maxTTL,
+	}
+}
+
+// Create issues a share link for a published article; a zero ttl creates a link that never expires
+func (s *ShareLinkService) Create(ctx context.Context, userID, articleID uuid.UUID, channel *string, ttl time.Duration) (*domain.ShareLink, error) {
+	if ttl < 0 || ttl > s.maxTTL {
+		return nil, &domainerrors.ValidationError{
+			Field:   "expires_in",
+			Message: fmt.Sprintf("expires_in must be between 0 and %s", s.maxTTL),
+		}
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &domainerrors.NotFoundError{Resource: "article", ID: articleID.String()}
+		}
+		return nil, err
+	}
+
+	if !article.IsPublished {
+		return nil, &domainerrors.NotFoundError{Resource: "article", ID: articleID.String()}
+	}
+
+	code, token, err := sharetoken.New(s.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	link := &domain.ShareLink{
+		ID:        uuid.New(),
+		Code:      code,
+		ArticleID: article.ID,
+		CreatedBy: &userID,
+		Channel:   trimOptional(channel),
+		CreatedAt: now,
+	}
+	if link.Channel != nil {
+		lowered := strings.ToLower(*link.Channel)
+		link.Channel = &lowered
+	}
+	if ttl > 0 {
+		expiresAt := now.Add(ttl)
+		link.ExpiresAt = &expiresAt
+	}
+
+	if err := link.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "share_link", Message: err.Error()}
+	}
+
+	if err := s.shareRepo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	link.URL = s.baseURL + "/s/" + token
+	return link, nil
+}
+
+// Follow resolves a share token, records the click and returns the article URL to redirect to
+// source is the src query parameter of the followed link and referrer its Referer header; both may be empty
+func (s *ShareLinkService) Follow(ctx context.Context, token, source, referrer string) (string, error) {
+	code, ok := sharetoken.Verify(s.secret, token)
+	if !ok {
+		return "", &domainerrors.NotFoundError{Resource: "share link", ID: token}
+	}
+
+	link, err := s.shareRepo.GetByCode(ctx, code)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if link.IsExpired(now) {
+		return "", ErrShareLinkExpired
+	}
+
+	click := &domain.ShareClick{
+		ID:           uuid.New(),
+		ShareLinkID:  link.ID,
+		Source:       shareSource(source, link.Channel),
+		ReferrerHost: referrerHost(referrer),
+		ClickedAt:    now,
+	}
+
+	if err := s.shareRepo.RecordClick(ctx, click); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("utm_source", click.Source)
+	query.Set("utm_medium", "share")
+
+	return s.siteURL + "/threats/" + link.ArticleID.String() + "?" + query.Encode(), nil
+}
+
+// shareSource picks the source recorded for a click: the src parameter, else the link's channel, else direct
+func shareSource(source string, channel *string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+	if source == "" && channel != nil {
+		source = *channel
+	}
+	if source == "" {
+		return directShareSource
+	}
+	if len(source) > 64 {
+		source = source[:64]
+	}
+	return source
+}
+
+// referrerHost returns the host of a Referer header, or nil when there is none or it cannot be parsed
+func referrerHost(referrer string) *string {
+	if referrer == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(referrer)
+	if err != nil || parsed.Hostname() == "" {
+		return nil
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if len(host) > 255 {
+		return nil
+	}
+	return &host
+}
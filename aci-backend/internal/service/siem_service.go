@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/siem"
+)
+
+const (
+	// siemDeliveryLease is how long a claimed batch stays with its worker before another worker may claim it
+	siemDeliveryLease = 5 * time.Minute
+
+	// siemSendTimeout bounds sending one batch
+	siemSendTimeout = 30 * time.Second
+)
+
+// SIEMServiceConfig configures the SIEM service
+type SIEMServiceConfig struct {
+	SiteURL     string        // origin of the web app, used for article links; they are left out when empty
+	BatchSize   int           // events sent per request
+	MaxAttempts int           // attempts before an event is marked failed
+	Retention   time.Duration // how long delivered events are kept
+}
+
+// SIEMService forwards enriched articles and their IOCs to the configured SIEM destinations
+// Events are queued per destination when an article is enriched and sent in batches by Run,
+// so a slow or unavailable SIEM never holds up enrichment. Failed batches are retried with
+// backoff. An admin can turn a destination off, which stops events being queued for it and
+// holds back the ones already queued until it is turned on again
+type SIEMService struct {
+	siemRepo   repository.SIEMRepository
+	forwarders []siem.Forwarder
+	cfg        SIEMServiceConfig
+}
+
+// NewSIEMService creates a new SIEM service instance
+func NewSIEMService(siemRepo repository.SIEMRepository, forwarders []siem.Forwarder, cfg SIEMServiceConfig) *SIEMService {
+	if siemRepo == nil {
+		panic("siemRepo cannot be nil")
+	}
+
+	if len(forwarders) == 0 {
+		panic("forwarders cannot be empty")
+	}
+
+	cfg.SiteURL = strings.TrimRight(cfg.SiteURL, "/")
+
+	return &SIEMService{
+		siemRepo:   siemRepo,
+		forwarders: forwarders,
+		cfg:        cfg,
+	}
+}
+
+// ForwardArticle queues an enriched article and each of its IOCs for every enabled destination
+// Failures are logged rather than returned; forwarding never fails enrichment
+func (s *SIEMService) ForwardArticle(ctx context.Context, article *domain.Article) {
+	if article == nil {
+		return
+	}
+
+	events, err := s.articleEvents(article)
+	if err != nil {
+		log.Error().Err(err).Str("article_id", article.ID.String()).Msg("Failed to build SIEM events")
+		return
+	}
+
+	for _, forwarder := range s.forwarders {
+		state, err := s.siemRepo.GetDestinationState(ctx, forwarder.Name())
+		if err != nil {
+			log.Error().Err(err).Str("destination", forwarder.Name()).Msg("Failed to get SIEM destination state")
+			continue
+		}
+		if !state.Enabled {
+			continue
+		}
+
+		now := time.Now()
+		queued := make([]*domain.SIEMEvent, len(events))
+		for i, event := range events {
+			queued[i] = &domain.SIEMEvent{
+				ID:            uuid.New(),
+				Destination:   forwarder.Name(),
+				Type:          event.Type,
+				Payload:       event.Payload,
+				OccurredAt:    event.OccurredAt,
+				Status:        domain.SIEMEventPending,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+			}
+		}
+
+		if err := s.siemRepo.Enqueue(ctx, queued); err != nil {
+			log.Error().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Str("destination", forwarder.Name()).
+				Msg("Failed to queue SIEM events")
+		}
+	}
+}
+
+// ListDestinations returns the configured destinations with their toggle and queue counts
+func (s *SIEMService) ListDestinations(ctx context.Context) ([]*domain.SIEMDestination, error) {
+	destinations := make([]*domain.SIEMDestination, 0, len(s.forwarders))
+	for _, forwarder := range s.forwarders {
+		destination, err := s.destination(ctx, forwarder)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, destination)
+	}
+
+	return destinations, nil
+}
+
+// SetDestinationEnabled turns a configured destination on or off
+func (s *SIEMService) SetDestinationEnabled(ctx context.Context, name string, enabled bool, updatedBy uuid.UUID) (*domain.SIEMDestination, error) {
+	forwarder, err := s.forwarder(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.siemRepo.SetDestinationEnabled(ctx, name, enabled, updatedBy); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("destination", name).
+		Bool("enabled", enabled).
+		Str("updated_by", updatedBy.String()).
+		Msg("SIEM destination toggled")
+
+	return s.destination(ctx, forwarder)
+}
+
+// ListEvents returns queued, delivered and failed events matching the filter
+func (s *SIEMService) ListEvents(ctx context.Context, filter *domain.SIEMEventFilter) ([]*domain.SIEMEvent, int, error) {
+	if filter.Destination != nil {
+		if _, err := s.forwarder(*filter.Destination); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, 0, &domainerrors.ValidationError{Field: "status", Message: "status must be pending, delivered or failed"}
+	}
+
+	if filter.Type != nil && !filter.Type.IsValid() {
+		return nil, 0, &domainerrors.ValidationError{Field: "type", Message: "type must be article.enriched or ioc.observed"}
+	}
+
+	return s.siemRepo.List(ctx, filter)
+}
+
+// RetryFailed queues a destination's failed events for delivery again and returns how many there were
+func (s *SIEMService) RetryFailed(ctx context.Context, name string) (int64, error) {
+	if _, err := s.forwarder(name); err != nil {
+		return 0, err
+	}
+
+	return s.siemRepo.RetryFailed(ctx, name)
+}
+
+// Deliver sends one batch to each enabled destination and returns how many events were
+// delivered and how many failed
+func (s *SIEMService) Deliver(ctx context.Context) (delivered, failed int, err error) {
+	for _, forwarder := range s.forwarders {
+		if ctx.Err() != nil {
+			return delivered, failed, ctx.Err()
+		}
+
+		state, err := s.siemRepo.GetDestinationState(ctx, forwarder.Name())
+		if err != nil {
+			return delivered, failed, err
+		}
+		if !state.Enabled {
+			continue
+		}
+
+		sent, rejected, err := s.deliverBatch(ctx, forwarder)
+		delivered += sent
+		failed += rejected
+		if err != nil {
+			return delivered, failed, err
+		}
+	}
+
+	return delivered, failed, nil
+}
+
+// Run sends due events on every interval and deletes old delivered events until the context is cancelled
+func (s *SIEMService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastCleanup := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delivered, failed, err := s.Deliver(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to forward SIEM events")
+			}
+			if delivered > 0 || failed > 0 {
+				log.Debug().Int("delivered", delivered).Int("failed", failed).Msg("Forwarded SIEM events")
+			}
+
+			if s.cfg.Retention > 0 && time.Since(lastCleanup) >= time.Hour {
+				lastCleanup = time.Now()
+				deleted, err := s.siemRepo.DeleteDeliveredBefore(ctx, time.Now().Add(-s.cfg.Retention))
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to delete delivered SIEM events")
+				} else if deleted > 0 {
+					log.Debug().Int64("deleted", deleted).Msg("Deleted delivered SIEM events")
+				}
+			}
+		}
+	}
+}
+
+// deliverBatch sends one batch of a destination's due events
+func (s *SIEMService) deliverBatch(ctx context.Context, forwarder siem.Forwarder) (delivered, failed int, err error) {
+	events, err := s.siemRepo.ClaimDue(ctx, forwarder.Name(), s.cfg.BatchSize, time.Now().Add(siemDeliveryLease))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, 0, nil
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, siemSendTimeout)
+	rejected, sendErr := forwarder.Send(sendCtx, events)
+	cancel()
+
+	if sendErr != nil {
+		ids := make([]uuid.UUID, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+
+		gaveUp, err := s.siemRepo.RecordFailure(ctx, ids, sendErr.Error(), isPermanent(sendErr), s.cfg.MaxAttempts)
+		if err != nil {
+			return 0, len(events), err
+		}
+
+		logEvent := log.Warn()
+		if gaveUp > 0 {
+			logEvent = log.Error()
+		}
+		logEvent.
+			Err(sendErr).
+			Str("destination", forwarder.Name()).
+			Int("events", len(events)).
+			Int64("failed", gaveUp).
+			Msg("Failed to send SIEM events")
+
+		return 0, len(events), nil
+	}
+
+	accepted := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		rejectErr, ok := rejected[event.ID]
+		if !ok {
+			accepted = append(accepted, event.ID)
+			continue
+		}
+
+		failed++
+		if _, err := s.siemRepo.RecordFailure(ctx, []uuid.UUID{event.ID}, rejectErr.Error(), isPermanent(rejectErr), s.cfg.MaxAttempts); err != nil {
+			return 0, failed, err
+		}
+
+		log.Warn().
+			Err(rejectErr).
+			Str("destination", forwarder.Name()).
+			Str("event_id", event.ID.String()).
+			Msg("SIEM rejected event")
+	}
+
+	if err := s.siemRepo.MarkDelivered(ctx, accepted, time.Now()); err != nil {
+		return 0, failed, err
+	}
+
+	return len(accepted), failed, nil
+}
+
+// articleEvents builds the article.enriched event and an ioc.observed event per IOC
+func (s *SIEMService) articleEvents(article *domain.Article) ([]*domain.SIEMEvent, error) {
+	occurredAt := time.Now()
+	if article.EnrichedAt != nil {
+		occurredAt = *article.EnrichedAt
+	}
+
+	var articleURL string
+	if s.cfg.SiteURL != "" {
+		articleURL = s.cfg.SiteURL + "/threats/" + article.ID.String()
+	}
+
+	payloads := []interface{}{domain.SIEMArticle{
+		ArticleID:      article.ID,
+		Title:          article.Title,
+		URL:            articleURL,
+		SourceURL:      article.SourceURL,
+		Severity:       article.Severity,
+		ThreatType:     stringValue(article.ThreatType),
+		AttackVector:   stringValue(article.AttackVector),
+		CVEs:           article.CVEs,
+		Vendors:        article.Vendors,
+		Tags:           article.Tags,
+		IOCCount:       len(article.IOCs),
+		KEV:            article.KEV,
+		ArmorRelevance: article.ArmorRelevance,
+		PublishedAt:    article.PublishedAt,
+		EnrichedAt:     article.EnrichedAt,
+	}}
+
+	for _, ioc := range article.IOCs {
+		payloads = append(payloads, domain.SIEMIndicator{
+			Type:         ioc.Type,
+			Value:        ioc.Value,
+			Context:      ioc.Context,
+			Source:       ioc.Source,
+			ArticleID:    article.ID,
+			ArticleTitle: article.Title,
+			ArticleURL:   articleURL,
+			Severity:     article.Severity,
+		})
+	}
+
+	events := make([]*domain.SIEMEvent, len(payloads))
+	for i, payload := range payloads {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal SIEM event: %w", err)
+		}
+
+		eventType := domain.SIEMEventIOC
+		if i == 0 {
+			eventType = domain.SIEMEventArticle
+		}
+
+		events[i] = &domain.SIEMEvent{Type: eventType, Payload: data, OccurredAt: occurredAt}
+	}
+
+	return events, nil
+}
+
+// destination returns a forwarder's destination with its stored state
+func (s *SIEMService) destination(ctx context.Context, forwarder siem.Forwarder) (*domain.SIEMDestination, error) {
+	state, err := s.siemRepo.GetDestinationState(ctx, forwarder.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SIEMDestination{
+		Name:                 forwarder.Name(),
+		Endpoint:             forwarder.Endpoint(),
+		SIEMDestinationState: *state,
+	}, nil
+}
+
+// forwarder returns the configured forwarder with the given name
+func (s *SIEMService) forwarder(name string) (siem.Forwarder, error) {
+	for _, forwarder := range s.forwarders {
+		if forwarder.Name() == name {
+			return forwarder, nil
+		}
+	}
+
+	return nil, &domainerrors.NotFoundError{Resource: "SIEM destination", ID: name}
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/cpe"
+)
+
+// InventoryItemInput is a product release in an uploaded software inventory, given either as a
+// CPE name or as a vendor, product and version
+type InventoryItemInput struct {
+	CPE     string
+	Vendor  string
+	Product string
+	Version string // overrides the CPE name's version when both are given
+}
+
+// SoftwareInventoryService manages the software inventories of users and organizations and
+// matches them against the products NVD lists as affected by each CVE. Articles whose CVEs
+// affect a product in the reader's own or their organization's inventory carry an "affects your
+// stack" badge and can be filtered on it
+type SoftwareInventoryService struct {
+	inventoryRepo repository.SoftwareInventoryRepository
+	nvdRepo       repository.NVDRepository
+	orgService    *OrganizationService
+}
+
+// NewSoftwareInventoryService creates a new software inventory service instance
+func NewSoftwareInventoryService(
+	inventoryRepo repository.SoftwareInventoryRepository,
+	nvdRepo repository.NVDRepository,
+	orgService *OrganizationService,
+) *SoftwareInventoryService {
+	if inventoryRepo == nil {
+		panic("inventoryRepo cannot be nil")
+	}
+	if nvdRepo == nil {
+		panic("nvdRepo cannot be nil")
+	}
+	if orgService == nil {
+		panic("orgService cannot be nil")
+	}
+
+	return &SoftwareInventoryService{
+		inventoryRepo: inventoryRepo,
+		nvdRepo:       nvdRepo,
+		orgService:    orgService,
+	}
+}
+
+// ListMine returns the user's own inventory
+func (s *SoftwareInventoryService) ListMine(ctx context.Context, userID uuid.UUID) ([]*domain.InventoryItem, error) {
+	return s.inventoryRepo.ListByOwner(ctx, domain.InventoryOwner{UserID: &userID})
+}
+
+// ReplaceMine replaces the user's own inventory with the uploaded items
+func (s *SoftwareInventoryService) ReplaceMine(ctx context.Context, userID uuid.UUID, inputs []InventoryItemInput) ([]*domain.InventoryItem, error) {
+	return s.replace(ctx, domain.InventoryOwner{UserID: &userID}, userID, inputs)
+}
+
+// ListForOrganization returns the organization's inventory to one of its members
+func (s *SoftwareInventoryService) ListForOrganization(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole) ([]*domain.InventoryItem, error) {
+	if err := s.authorize(ctx, orgID, userID, role, false); err != nil {
+		return nil, err
+	}
+
+	return s.inventoryRepo.ListByOwner(ctx, domain.InventoryOwner{OrganizationID: &orgID})
+}
+
+// ReplaceForOrganization replaces the organization's inventory with the uploaded items; organization admins only
+func (s *SoftwareInventoryService) ReplaceForOrganization(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, inputs []InventoryItemInput) ([]*domain.InventoryItem, error) {
+	if err := s.authorize(ctx, orgID, userID, role, true); err != nil {
+		return nil, err
+	}
+
+	return s.replace(ctx, domain.InventoryOwner{OrganizationID: &orgID}, userID, inputs)
+}
+
+// ApplyTo limits an affects_stack filter to the user's own and their organization's inventories
+func (s *SoftwareInventoryService) ApplyTo(ctx context.Context, userID uuid.UUID, filter *domain.ArticleFilter) error {
+	filter.StackUserID = &userID
+	filter.StackOrganizationID = nil
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		filter.StackOrganizationID = &member.OrganizationID
+	}
+
+	return nil
+}
+
+// Annotate sets the affects-your-stack badge of each article for the user, naming the products
+// of their own and their organization's inventories that the article's CVEs affect
+func (s *SoftwareInventoryService) Annotate(ctx context.Context, userID uuid.UUID, articles []*domain.Article) error {
+	cveSet := make(map[string]bool)
+	for _, article := range articles {
+		for _, cveID := range article.CVEs {
+			cveSet[strings.ToUpper(cveID)] = true
+		}
+	}
+	if len(cveSet) == 0 {
+		return nil
+	}
+
+	var orgID *uuid.UUID
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		orgID = &member.OrganizationID
+	}
+
+	cveIDs := make([]string, 0, len(cveSet))
+	for cveID := range cveSet {
+		cveIDs = append(cveIDs, cveID)
+	}
+
+	items, err := s.inventoryRepo.ListMatching(ctx, userID, orgID, cveIDs)
+	if err != nil {
+		return err
+	}
+
+	productsByCVE := make(map[string][]string)
+	for _, item := range items {
+		for _, cveID := range item.MatchedCVEs {
+			productsByCVE[cveID] = append(productsByCVE[cveID], inventoryItemLabel(item))
+		}
+	}
+
+	for _, article := range articles {
+		seen := make(map[string]bool)
+		article.StackProducts = nil
+		for _, cveID := range article.CVEs {
+			for _, label := range productsByCVE[strings.ToUpper(cveID)] {
+				if !seen[label] {
+					seen[label] = true
+					article.StackProducts = append(article.StackProducts, label)
+				}
+			}
+		}
+		sort.Strings(article.StackProducts)
+		article.AffectsStack = len(article.StackProducts) > 0
+	}
+
+	return nil
+}
+
+// MatchProducts rematches every inventory item of the products against their current NVD
+// affected products, returning how many items were rematched
+// It runs after an NVD sync changes the affected products of those products
+func (s *SoftwareInventoryService) MatchProducts(ctx context.Context, keys []domain.ProductKey) (int, error) {
+	items, err := s.inventoryRepo.ListByProducts(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.match(ctx, items); err != nil {
+		return 0, err
+	}
+
+	if err := s.inventoryRepo.ReplaceMatches(ctx, items); err != nil {
+		return 0, err
+	}
+
+	return len(items), nil
+}
+
+// replace validates the uploaded items, matches them and stores them as the owner's inventory
+func (s *SoftwareInventoryService) replace(ctx context.Context, owner domain.InventoryOwner, createdBy uuid.UUID, inputs []InventoryItemInput) ([]*domain.InventoryItem, error) {
+	if len(inputs) > domain.MaxInventoryItems {
+		return nil, &domainerrors.LimitExceededError{
+			Resource: "software inventory",
+			Limit:    domain.MaxInventoryItems,
+			Message:  fmt.Sprintf("a software inventory can have at most %d items", domain.MaxInventoryItems),
+		}
+	}
+
+	now := time.Now()
+	items := make([]*domain.InventoryItem, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+
+	for i, input := range inputs {
+		item, err := inventoryItemFromInput(input)
+		if err != nil {
+			return nil, &domainerrors.ValidationError{Field: fmt.Sprintf("items.%d", i), Message: err.Error()}
+		}
+
+		item.ID = uuid.New()
+		item.UserID = owner.UserID
+		item.OrganizationID = owner.OrganizationID
+		item.CreatedBy = &createdBy
+		item.CreatedAt = now
+
+		if err := item.Validate(); err != nil {
+			return nil, &domainerrors.ValidationError{Field: fmt.Sprintf("items.%d", i), Message: err.Error()}
+		}
+
+		// The same release listed twice is stored once
+		key := item.Vendor + ":" + item.Product + ":" + item.Version
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		items = append(items, item)
+	}
+
+	if err := s.match(ctx, items); err != nil {
+		return nil, err
+	}
+
+	if err := s.inventoryRepo.Replace(ctx, owner, items); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Interface("user_id", owner.UserID).
+		Interface("organization_id", owner.OrganizationID).
+		Int("items", len(items)).
+		Msg("Software inventory replaced")
+
+	return s.inventoryRepo.ListByOwner(ctx, owner)
+}
+
+// match sets the matched CVEs of each item from the NVD affected products of its product
+func (s *SoftwareInventoryService) match(ctx context.Context, items []*domain.InventoryItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	keySet := make(map[domain.ProductKey]bool)
+	keys := make([]domain.ProductKey, 0)
+	for _, item := range items {
+		if !keySet[item.Key()] {
+			keySet[item.Key()] = true
+			keys = append(keys, item.Key())
+		}
+	}
+
+	affected, err := s.nvdRepo.ListByProducts(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[domain.ProductKey][]*domain.AffectedProduct)
+	for _, product := range affected {
+		byKey[product.Key()] = append(byKey[product.Key()], product)
+	}
+
+	for _, item := range items {
+		matched := make(map[string]bool)
+		item.MatchedCVEs = []string{}
+		for _, product := range byKey[item.Key()] {
+			if matched[product.CVEID] || !affectedRange(product).Contains(item.Version) {
+				continue
+			}
+			matched[product.CVEID] = true
+			item.MatchedCVEs = append(item.MatchedCVEs, product.CVEID)
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(item.MatchedCVEs)))
+	}
+
+	return nil
+}
+
+// authorize checks that the user may see (or, with manage, change) the organization's inventory
+// Platform admins may act on any organization
+func (s *SoftwareInventoryService) authorize(ctx context.Context, orgID, userID uuid.UUID, role domain.UserRole, manage bool) error {
+	if role == domain.RoleAdmin {
+		_, err := s.orgService.Get(ctx, orgID)
+		return err
+	}
+
+	member, err := s.orgService.MembershipOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if member == nil || member.OrganizationID != orgID || (manage && !member.IsAdmin()) {
+		return domainerrors.ErrForbidden
+	}
+
+	return nil
+}
+
+// inventoryItemFromInput normalizes an uploaded item, reading vendor, product and version from its CPE name if given
+func inventoryItemFromInput(input InventoryItemInput) (*domain.InventoryItem, error) {
+	item := &domain.InventoryItem{
+		Vendor:  cpe.Normalize(input.Vendor),
+		Product: cpe.Normalize(input.Product),
+		Version: strings.TrimSpace(input.Version),
+	}
+
+	if strings.TrimSpace(input.CPE) != "" {
+		name, err := cpe.Parse(input.CPE)
+		if err != nil {
+			return nil, err
+		}
+
+		item.CPE = strings.TrimSpace(input.CPE)
+		item.Vendor = name.Vendor
+		item.Product = name.Product
+		if item.Version == "" {
+			item.Version = name.Version
+		}
+	}
+
+	return item, nil
+}
+
+// affectedRange returns the versions of a product an NVD affected product covers
+func affectedRange(product *domain.AffectedProduct) cpe.Range {
+	return cpe.Range{
+		Version:        product.Version,
+		StartIncluding: product.VersionStartIncluding,
+		StartExcluding: product.VersionStartExcluding,
+		EndIncluding:   product.VersionEndIncluding,
+		EndExcluding:   product.VersionEndExcluding,
+	}
+}
+
+// inventoryItemLabel names an inventory item for the stack badge, e.g. "apache log4j 2.14.1"
+func inventoryItemLabel(item *domain.InventoryItem) string {
+	label := item.Vendor + " " + item.Product
+	if item.Version != "" {
+		label += " " + item.Version
+	}
+	return label
+}
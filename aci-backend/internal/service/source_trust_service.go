@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultSourceTrustMaxDelta is the largest change to a trust score per calibration
+	DefaultSourceTrustMaxDelta = 0.05
+
+	// DefaultSourceTrustMinArticles is how many articles a source needs in the window to be calibrated
+	DefaultSourceTrustMinArticles = 10
+
+	// DefaultSourceTrustWindow is how far back signals are aggregated
+	DefaultSourceTrustWindow = 30 * 24 * time.Hour
+)
+
+// SourceTrustService calibrates source trust scores from ingestion and reader signals
+// Each run moves a score towards the target its signals support by a bounded step, so a
+// single bad period cannot swing a source; every change is kept with the signals behind it
+type SourceTrustService struct {
+	trustRepo   repository.SourceTrustRepository
+	auditRepo   repository.AuditLogRepository
+	window      time.Duration
+	maxDelta    float64
+	minArticles int
+}
+
+// SourceTrustCalibration summarizes a calibration run
+type SourceTrustCalibration struct {
+	Evaluated int                         `json:"evaluated"`
+	Changes   []*domain.SourceTrustChange `json:"changes"`
+}
+
+// NewSourceTrustService creates a new source trust service instance
+// Non-positive settings fall back to the defaults
+func NewSourceTrustService(
+	trustRepo repository.SourceTrustRepository,
+	auditRepo repository.AuditLogRepository,
+	window time.Duration,
+	maxDelta float64,
+	minArticles int,
+) *SourceTrustService {
+	if trustRepo == nil {
+		panic("trustRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	if window <= 0 {
+		window = DefaultSourceTrustWindow
+	}
+	if maxDelta <= 0 {
+		maxDelta = DefaultSourceTrustMaxDelta
+	}
+	if minArticles <= 0 {
+		minArticles = DefaultSourceTrustMinArticles
+	}
+
+	return &SourceTrustService{
+		trustRepo:   trustRepo,
+		auditRepo:   auditRepo,
+		window:      window,
+		maxDelta:    maxDelta,
+		minArticles: minArticles,
+	}
+}
+
+// Record stores a correction or deletion against the article's source
+// Failures are logged and do not fail the article operation
+func (s *SourceTrustService) Record(ctx context.Context, article *domain.Article, eventType domain.SourceTrustEventType) {
+	if article == nil || article.SourceID == uuid.Nil {
+		return
+	}
+
+	if err := s.trustRepo.RecordEvent(ctx, article.SourceID, article.ID, eventType); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", article.ID.String()).
+			Str("event_type", string(eventType)).
+			Msg("Failed to record source trust event")
+	}
+}
+
+// Calibrate adjusts the trust score of every active source with enough articles in the window
+// Sources changed within minInterval are skipped, so manual runs cannot stack steps
+func (s *SourceTrustService) Calibrate(ctx context.Context, minInterval time.Duration) (*SourceTrustCalibration, error) {
+	now := time.Now()
+	since := now.Add(-s.window)
+
+	signals, err := s.trustRepo.GetSignals(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	avgReads, err := s.trustRepo.GetAverageReads(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SourceTrustCalibration{Changes: make([]*domain.SourceTrustChange, 0)}
+	for _, sig := range signals {
+		if sig.Sample() < s.minArticles {
+			continue
+		}
+		if sig.LastCalibratedAt != nil && now.Sub(*sig.LastCalibratedAt) < minInterval {
+			continue
+		}
+		result.Evaluated++
+
+		target := sig.Target(avgReads)
+		newScore := domain.StepTrustScore(sig.TrustScore, target, s.maxDelta)
+		if newScore == domain.RoundTrustScore(sig.TrustScore) {
+			continue
+		}
+
+		change := &domain.SourceTrustChange{
+			SourceID:      sig.SourceID,
+			PreviousScore: sig.TrustScore,
+			NewScore:      newScore,
+			Delta:         domain.RoundTrustScore(newScore - sig.TrustScore),
+			TargetScore:   target,
+			Signals:       sig,
+		}
+
+		if err := s.trustRepo.Apply(ctx, change); err != nil {
+			// A concurrent manual edit wins; the source is reconsidered on the next run
+			var conflictErr *domainerrors.ConflictError
+			if !errors.As(err, &conflictErr) {
+				log.Error().
+					Err(err).
+					Str("source_id", sig.SourceID.String()).
+					Msg("Failed to apply source trust change")
+			}
+			continue
+		}
+
+		s.audit(ctx, change)
+		result.Changes = append(result.Changes, change)
+	}
+
+	return result, nil
+}
+
+// History returns a source's trust score changes, newest first
+func (s *SourceTrustService) History(ctx context.Context, sourceID uuid.UUID, page, pageSize int) ([]*domain.SourceTrustChange, int, error) {
+	offset := (page - 1) * pageSize
+	return s.trustRepo.ListChanges(ctx, sourceID, pageSize, offset)
+}
+
+// Run calibrates trust scores on every interval until the context is cancelled
+func (s *SourceTrustService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Allow a little slack so ticker jitter does not skip a whole period
+			result, err := s.Calibrate(ctx, interval-interval/10)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to calibrate source trust scores")
+				continue
+			}
+
+			if len(result.Changes) > 0 {
+				log.Info().
+					Int("evaluated", result.Evaluated).
+					Int("changed", len(result.Changes)).
+					Msg("Calibrated source trust scores")
+			}
+		}
+	}
+}
+
+// audit records an automatic trust score change; failures are logged and do not fail the run
+func (s *SourceTrustService) audit(ctx context.Context, change *domain.SourceTrustChange) {
+	oldValue := map[string]float64{"trust_score": change.PreviousScore}
+	newValue := map[string]interface{}{"trust_score": change.NewScore, "target_score": change.TargetScore}
+
+	entry := domain.NewAuditLog(nil, domain.AuditActionSourceTrustCalibrated, "source", &change.SourceID, oldValue, newValue, nil, nil)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("source_id", change.SourceID.String()).
+			Msg("Failed to write source trust audit log")
+	}
+}
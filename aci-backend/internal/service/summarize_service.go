@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/ai"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// SummarizeService generates and stores article summaries at each length preset
+type SummarizeService struct {
+	summarizer  *ai.Summarizer
+	articleRepo repository.ArticleRepository
+	summaryRepo repository.ArticleSummaryRepository
+}
+
+// NewSummarizeService creates a new summarize service instance
+func NewSummarizeService(
+	summarizer *ai.Summarizer,
+	articleRepo repository.ArticleRepository,
+	summaryRepo repository.ArticleSummaryRepository,
+) *SummarizeService {
+	if summarizer == nil {
+		panic("summarizer cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	if summaryRepo == nil {
+		panic("summaryRepo cannot be nil")
+	}
+
+	return &SummarizeService{
+		summarizer:  summarizer,
+		articleRepo: articleRepo,
+		summaryRepo: summaryRepo,
+	}
+}
+
+// GetSummary returns the stored summary at the given length, generating all presets on first request
+func (s *SummarizeService) GetSummary(ctx context.Context, article *domain.Article, length domain.SummaryLength) (*domain.ArticleSummary, error) {
+	if article == nil {
+		return nil, fmt.Errorf("article cannot be nil")
+	}
+
+	if !length.IsValid() {
+		return nil, &domainerrors.ValidationError{
+			Field:   "summary",
+			Message: fmt.Sprintf("must be one of: %s, %s, %s", domain.SummaryLengthShort, domain.SummaryLengthMedium, domain.SummaryLengthExecutive),
+		}
+	}
+
+	summary, err := s.summaryRepo.Get(ctx, article.ID, length)
+	if err == nil {
+		return summary, nil
+	}
+
+	var notFound *domainerrors.NotFoundError
+	if !errors.As(err, &notFound) {
+		return nil, fmt.Errorf("failed to get article summary: %w", err)
+	}
+
+	summaries, err := s.SummarizeArticle(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, generated := range summaries {
+		if generated.Length == length {
+			return generated, nil
+		}
+	}
+
+	return nil, fmt.Errorf("summary of length %s was not generated", length)
+}
+
+// SummarizeArticle generates and stores every length preset for an article
+// Articles without a summary get the medium preset as their default summary
+func (s *SummarizeService) SummarizeArticle(ctx context.Context, article *domain.Article) ([]*domain.ArticleSummary, error) {
+	if article == nil {
+		return nil, fmt.Errorf("article cannot be nil")
+	}
+
+	result, err := s.summarizer.Summarize(ctx, article)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize article: %w", err)
+	}
+
+	now := time.Now()
+	summaries := make([]*domain.ArticleSummary, 0, len(domain.SummaryLengths()))
+	for _, length := range domain.SummaryLengths() {
+		summary := &domain.ArticleSummary{
+			ArticleID:   article.ID,
+			Length:      length,
+			Content:     result.ForLength(length),
+			Provider:    s.summarizer.ProviderName(),
+			GeneratedAt: now,
+		}
+
+		if err := s.summaryRepo.Upsert(ctx, summary); err != nil {
+			return nil, fmt.Errorf("failed to store %s summary: %w", length, err)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if article.Summary == nil {
+		article.Summary = &result.Medium
+		if err := s.articleRepo.Update(ctx, article); err != nil {
+			return nil, fmt.Errorf("failed to update article summary: %w", err)
+		}
+	}
+
+	return summaries, nil
+}
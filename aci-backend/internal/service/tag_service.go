@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// TagService keeps article tags canonical
+// Incoming tags are normalized and their aliases resolved on ingestion, and admins can
+// rename or merge tags, which rewrites the tags of existing articles
+type TagService struct {
+	tagRepo   repository.TagRepository
+	auditRepo repository.AuditLogRepository
+}
+
+// NewTagService creates a new tag service instance
+func NewTagService(tagRepo repository.TagRepository, auditRepo repository.AuditLogRepository) *TagService {
+	if tagRepo == nil {
+		panic("tagRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &TagService{
+		tagRepo:   tagRepo,
+		auditRepo: auditRepo,
+	}
+}
+
+// List returns a page of tags, optionally filtered by a name or alias substring
+func (s *TagService) List(ctx context.Context, query string, page, pageSize int) ([]*domain.Tag, int, error) {
+	return s.tagRepo.List(ctx, query, pageSize, (page-1)*pageSize)
+}
+
+// Normalize returns the canonical form of the given tags: normalized, with aliases resolved
+// and duplicates removed. New tags are registered. If the tag tables cannot be read the
+// normalized spelling is kept, so ingestion never fails on tags
+func (s *TagService) Normalize(ctx context.Context, tags []string) []string {
+	normalized := domain.NormalizeTags(tags)
+	if len(normalized) == 0 {
+		return normalized
+	}
+
+	aliases, err := s.tagRepo.ResolveAliases(ctx, normalized)
+	if err != nil {
+		log.Error().Err(err).Strs("tags", normalized).Msg("Failed to resolve tag aliases")
+		return normalized
+	}
+
+	canonical := make([]string, 0, len(normalized))
+	seen := make(map[string]bool, len(normalized))
+	for _, tag := range normalized {
+		if name, ok := aliases[tag]; ok {
+			tag = name
+		}
+		if seen[tag] || domain.ValidateTagName(tag) != nil {
+			continue
+		}
+		seen[tag] = true
+		canonical = append(canonical, tag)
+	}
+
+	if err := s.tagRepo.EnsureExist(ctx, canonical); err != nil {
+		log.Error().Err(err).Strs("tags", canonical).Msg("Failed to register tags")
+	}
+
+	return canonical
+}
+
+// Rename changes a tag's canonical name; the old name is kept as an alias
+func (s *TagService) Rename(
+	ctx context.Context,
+	id uuid.UUID,
+	name string,
+	actor *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.TagChange, error) {
+	name = domain.NormalizeTag(name)
+	if err := domain.ValidateTagName(name); err != nil {
+		return nil, &domainerrors.ValidationError{
+			Field:   "name",
+			Message: err.Error(),
+		}
+	}
+
+	previous, err := s.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.tagRepo.Rename(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &domain.TagChange{Tag: tag, ArticlesUpdated: updated}
+	s.audit(ctx, actor, domain.AuditActionTagRenamed, id, previous, change, ipAddress, userAgent)
+
+	log.Info().
+		Str("tag_id", id.String()).
+		Str("from", previous.Name).
+		Str("to", name).
+		Int64("articles_updated", updated).
+		Msg("Tag renamed")
+
+	return change, nil
+}
+
+// Merge folds the source tag into the target; the source name and aliases become aliases of the target
+func (s *TagService) Merge(
+	ctx context.Context,
+	sourceID, targetID uuid.UUID,
+	actor *uuid.UUID,
+	ipAddress, userAgent string,
+) (*domain.TagChange, error) {
+	if sourceID == targetID {
+		return nil, &domainerrors.ValidationError{
+			Field:   "target_id",
+			Message: "a tag cannot be merged into itself",
+		}
+	}
+
+	source, err := s.tagRepo.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.tagRepo.Merge(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &domain.TagChange{Tag: tag, ArticlesUpdated: updated}
+	s.audit(ctx, actor, domain.AuditActionTagMerged, sourceID, source, change, ipAddress, userAgent)
+
+	log.Info().
+		Str("source", source.Name).
+		Str("target", tag.Name).
+		Int64("articles_updated", updated).
+		Msg("Tag merged")
+
+	return change, nil
+}
+
+// audit records a tag change; failures are logged and do not fail the change
+func (s *TagService) audit(
+	ctx context.Context,
+	actor *uuid.UUID,
+	action string,
+	tagID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actor, action, "tag", &tagID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("tag_id", tagID.String()).
+			Str("action", action).
+			Msg("Failed to write tag audit log")
+	}
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// TenantService manages the workspaces of a multi-tenant deployment and resolves request
+// hostnames to them. Tenants are held in memory and refreshed periodically, so a workspace
+// created through another instance is served here after the next refresh
+type TenantService struct {
+	tenantRepo repository.TenantRepository
+	auditRepo  repository.AuditLogRepository
+	baseDomain string
+
+	mu         sync.RWMutex
+	byID       map[uuid.UUID]*domain.Tenant
+	byHostname map[string]*domain.Tenant
+}
+
+// TenantInput holds the editable fields of a tenant
+type TenantInput struct {
+	Slug     string                `json:"slug"`
+	Name     string                `json:"name"`
+	Hostname *string               `json:"hostname,omitempty"`
+	Branding domain.TenantBranding `json:"branding"`
+}
+
+// NewTenantService creates a new tenant service instance
+// baseDomain is TENANT_BASE_DOMAIN; when set, <slug>.<baseDomain> serves each tenant
+func NewTenantService(
+	tenantRepo repository.TenantRepository,
+	auditRepo repository.AuditLogRepository,
+	baseDomain string,
+) *TenantService {
+	if tenantRepo == nil {
+		panic("tenantRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	return &TenantService{
+		tenantRepo: tenantRepo,
+		auditRepo:  auditRepo,
+		baseDomain: strings.ToLower(strings.Trim(baseDomain, ". ")),
+		byID:       make(map[uuid.UUID]*domain.Tenant),
+		byHostname: make(map[string]*domain.Tenant),
+	}
+}
+
+// Load replaces the cached tenants with those in the database
+func (s *TenantService) Load(ctx context.Context) error {
+	tenants, err := s.tenantRepo.List(repository.WithoutTenant(ctx))
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[uuid.UUID]*domain.Tenant, len(tenants))
+	byHostname := make(map[string]*domain.Tenant, 2*len(tenants))
+	for _, tenant := range tenants {
+		byID[tenant.ID] = tenant
+		if s.baseDomain != "" {
+			byHostname[tenant.Slug+"."+s.baseDomain] = tenant
+		}
+		if tenant.Hostname != nil {
+			byHostname[*tenant.Hostname] = tenant
+		}
+	}
+
+	s.mu.Lock()
+	changed := len(byID) != len(s.byID)
+	s.byID = byID
+	s.byHostname = byHostname
+	s.mu.Unlock()
+
+	if changed {
+		log.Info().Int("tenants", len(tenants)).Msg("Loaded tenants")
+	}
+
+	return nil
+}
+
+// Run reloads the tenants on every interval until the context is cancelled
+func (s *TenantService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to reload tenants")
+			}
+		}
+	}
+}
+
+// Resolve returns the tenant served at the host, which may carry a port
+// ok is false for hosts of the default workspace
+func (s *TenantService) Resolve(host string) (*domain.Tenant, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tenant, ok := s.byHostname[host]
+	return tenant, ok
+}
+
+// Current returns the tenant the context is scoped to, or nil for the default workspace
+func (s *TenantService) Current(ctx context.Context) *domain.Tenant {
+	tenantID, ok := repository.TenantFromContext(ctx)
+	if !ok || tenantID == uuid.Nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.byID[tenantID]
+}
+
+// List returns all tenants ordered by slug
+func (s *TenantService) List(ctx context.Context) ([]*domain.Tenant, error) {
+	return s.tenantRepo.List(repository.WithoutTenant(ctx))
+}
+
+// Get returns a tenant by ID
+func (s *TenantService) Get(ctx context.Context, id uuid.UUID) (*domain.Tenant, error) {
+	return s.tenantRepo.GetByID(repository.WithoutTenant(ctx), id)
+}
+
+// Create creates a workspace; it starts without users, which sign up at its hostname
+func (s *TenantService) Create(ctx context.Context, input TenantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.Tenant, error) {
+	tenant := &domain.Tenant{}
+	applyTenantInput(tenant, input)
+
+	if err := tenant.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "tenant", Message: err.Error()}
+	}
+
+	if err := s.tenantRepo.Create(repository.WithoutTenant(ctx), tenant); err != nil {
+		return nil, err
+	}
+
+	s.reload(ctx)
+	s.audit(ctx, actorID, domain.AuditActionTenantCreated, tenant.ID, nil, tenant, ipAddress, userAgent)
+
+	return tenant, nil
+}
+
+// Update replaces a tenant's slug, name, hostname and branding
+func (s *TenantService) Update(ctx context.Context, id uuid.UUID, input TenantInput, actorID *uuid.UUID, ipAddress, userAgent string) (*domain.Tenant, error) {
+	systemCtx := repository.WithoutTenant(ctx)
+
+	tenant, err := s.tenantRepo.GetByID(systemCtx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *tenant
+	applyTenantInput(tenant, input)
+
+	if err := tenant.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "tenant", Message: err.Error()}
+	}
+
+	if err := s.tenantRepo.Update(systemCtx, tenant); err != nil {
+		return nil, err
+	}
+
+	s.reload(ctx)
+	s.audit(ctx, actorID, domain.AuditActionTenantUpdated, tenant.ID, &previous, tenant, ipAddress, userAgent)
+
+	return tenant, nil
+}
+
+// Delete removes a workspace along with its users, articles and other data
+func (s *TenantService) Delete(ctx context.Context, id uuid.UUID, actorID *uuid.UUID, ipAddress, userAgent string) error {
+	systemCtx := repository.WithoutTenant(ctx)
+
+	tenant, err := s.tenantRepo.GetByID(systemCtx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tenantRepo.Delete(systemCtx, id); err != nil {
+		return err
+	}
+
+	s.reload(ctx)
+	s.audit(ctx, actorID, domain.AuditActionTenantDeleted, id, tenant, nil, ipAddress, userAgent)
+
+	return nil
+}
+
+// reload refreshes the cache after a change, so this instance serves it immediately
+func (s *TenantService) reload(ctx context.Context) {
+	if err := s.Load(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to reload tenants after a change")
+	}
+}
+
+// audit records a tenant change in the acting admin's workspace
+func (s *TenantService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	action string,
+	tenantID uuid.UUID,
+	oldValue, newValue interface{},
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	entry := domain.NewAuditLog(actorID, action, "tenant", &tenantID, oldValue, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("tenant_id", tenantID.String()).
+			Str("action", action).
+			Msg("Failed to write tenant audit log")
+	}
+}
+
+// tenantCacheScope identifies the tenant scope of ctx in the keys of caches holding query
+// results, since each workspace sees different rows
+func tenantCacheScope(ctx context.Context) string {
+	tenantID, ok := repository.TenantFromContext(ctx)
+	if !ok {
+		return "system"
+	}
+	return tenantID.String()
+}
+
+// applyTenantInput copies the input onto the tenant
+func applyTenantInput(tenant *domain.Tenant, input TenantInput) {
+	tenant.Slug = input.Slug
+	tenant.Name = input.Name
+	tenant.Hostname = input.Hostname
+	tenant.Branding = input.Branding
+}
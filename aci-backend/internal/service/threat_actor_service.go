@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/actor"
+	"github.com/phillipboles/aci-backend/internal/util/slug"
+)
+
+const (
+	// threatActorDictionaryTTL is how long the alias dictionary is used before it is reloaded,
+	// so profiles created by other instances are picked up
+	threatActorDictionaryTTL = 5 * time.Minute
+
+	// maxEnrichmentActors caps how many actors named by the enricher are linked to one article
+	maxEnrichmentActors = 10
+
+	// maxThreatActorNameLength bounds the name of a profile created from an enricher-reported name
+	maxThreatActorNameLength = 100
+)
+
+// ThreatActorService links articles to threat actor and ransomware group profiles
+// Mentions are found by matching the curated alias dictionary in article text and by resolving
+// the actors named by the AI enricher; an enricher-named actor with no profile gets a new one
+type ThreatActorService struct {
+	actorRepo   repository.ThreatActorRepository
+	articleRepo repository.ArticleRepository
+	slugs       *slug.Generator
+
+	mu       sync.Mutex
+	matcher  *actor.Matcher
+	loadedAt time.Time
+}
+
+// NewThreatActorService creates a new threat actor service instance
+func NewThreatActorService(actorRepo repository.ThreatActorRepository, articleRepo repository.ArticleRepository) *ThreatActorService {
+	if actorRepo == nil {
+		panic("actorRepo cannot be nil")
+	}
+
+	if articleRepo == nil {
+		panic("articleRepo cannot be nil")
+	}
+
+	return &ThreatActorService{
+		actorRepo:   actorRepo,
+		articleRepo: articleRepo,
+		slugs:       slug.NewGenerator(),
+	}
+}
+
+// dictionary returns the alias dictionary, reloading it once it is older than threatActorDictionaryTTL
+// Dictionary keys are profile IDs
+func (s *ThreatActorService) dictionary(ctx context.Context) (*actor.Matcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.matcher != nil && time.Since(s.loadedAt) < threatActorDictionaryTTL {
+		return s.matcher, nil
+	}
+
+	actors, err := s.actorRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]actor.Entry, len(actors))
+	for i, a := range actors {
+		entries[i] = actor.Entry{Key: a.ID.String(), Names: a.Aliases}
+		if a.MatchName {
+			entries[i].Names = append([]string{a.Name}, a.Aliases...)
+		} else {
+			entries[i].LookupOnly = []string{a.Name}
+		}
+	}
+
+	s.matcher = actor.NewMatcher(entries)
+	s.loadedAt = time.Now()
+	return s.matcher, nil
+}
+
+// invalidate makes the next lookup reload the dictionary
+func (s *ThreatActorService) invalidate() {
+	s.mu.Lock()
+	s.matcher = nil
+	s.mu.Unlock()
+}
+
+// SyncArticle replaces an article's dictionary links with the actors mentioned in its title and content
+// Failures are logged rather than returned; actor links are not needed to store an article
+func (s *ThreatActorService) SyncArticle(ctx context.Context, article *domain.Article) {
+	if article == nil {
+		return
+	}
+
+	matcher, err := s.dictionary(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load threat actor dictionary")
+		return
+	}
+
+	keys := matcher.Find(article.Title + "\n" + article.Content)
+	s.replace(ctx, article.ID, domain.ActorMentionDictionary, keys)
+}
+
+// SyncEnrichment replaces an article's AI links with the actors named by the enricher
+// Names that resolve to no profile get a new, uncurated profile
+func (s *ThreatActorService) SyncEnrichment(ctx context.Context, article *domain.Article, names []string) {
+	if article == nil {
+		return
+	}
+
+	matcher, err := s.dictionary(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load threat actor dictionary")
+		return
+	}
+
+	if len(names) > maxEnrichmentActors {
+		names = names[:maxEnrichmentActors]
+	}
+
+	keys := make([]string, 0, len(names))
+	created := false
+	for _, name := range names {
+		if key, ok := matcher.Lookup(name); ok {
+			keys = append(keys, key)
+			continue
+		}
+
+		profile, err := s.createFromEnrichment(ctx, name)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("article_id", article.ID.String()).
+				Str("actor", name).
+				Msg("Failed to create threat actor profile")
+			continue
+		}
+		if profile != nil {
+			keys = append(keys, profile.ID.String())
+			created = true
+		}
+	}
+
+	if created {
+		s.invalidate()
+	}
+
+	s.replace(ctx, article.ID, domain.ActorMentionAI, keys)
+}
+
+// createFromEnrichment creates an uncurated profile for an enricher-reported name
+// A name that cleans up to a taken slug resolves to that profile; an unusable name returns nil
+func (s *ThreatActorService) createFromEnrichment(ctx context.Context, reported string) (*domain.ThreatActor, error) {
+	name := strings.Join(strings.Fields(reported), " ")
+	trimmed := actor.Trim(actor.Normalize(name))
+	if len(trimmed) < actor.MinNameLength || len(name) > maxThreatActorNameLength {
+		return nil, nil
+	}
+
+	kind := domain.ThreatActorKindActor
+	if strings.Contains(actor.Normalize(name), "ransomware") {
+		kind = domain.ThreatActorKindRansomware
+	}
+
+	now := time.Now()
+	profile := &domain.ThreatActor{
+		ID:         uuid.New(),
+		Slug:       s.slugs.Generate(trimmed),
+		Name:       name,
+		Kind:       kind,
+		Aliases:    []string{},
+		Motivation: "unknown",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	err := s.actorRepo.Create(ctx, profile)
+	var conflictErr *domainerrors.ConflictError
+	if errors.As(err, &conflictErr) {
+		return s.actorRepo.GetBySlug(ctx, profile.Slug)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("slug", profile.Slug).
+		Str("name", profile.Name).
+		Msg("Created threat actor profile from enrichment")
+
+	return profile, nil
+}
+
+// replace links the article to the actors with the given dictionary keys for one source
+func (s *ThreatActorService) replace(ctx context.Context, articleID uuid.UUID, source domain.ActorMentionSource, keys []string) {
+	actorIDs := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		if id, err := uuid.Parse(key); err == nil {
+			actorIDs = append(actorIDs, id)
+		}
+	}
+
+	if err := s.actorRepo.ReplaceForArticle(ctx, articleID, source, actorIDs); err != nil {
+		log.Error().
+			Err(err).
+			Str("article_id", articleID.String()).
+			Str("source", string(source)).
+			Int("actor_count", len(actorIDs)).
+			Msg("Failed to sync article threat actors")
+	}
+}
+
+// List returns a page of profiles matching the filter
+func (s *ThreatActorService) List(ctx context.Context, filter *domain.ThreatActorFilter) ([]*domain.ThreatActor, int, error) {
+	if filter == nil {
+		filter = domain.NewThreatActorFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, &domainerrors.ValidationError{Field: "filter", Message: err.Error()}
+	}
+
+	return s.actorRepo.List(ctx, filter)
+}
+
+// ListArticles returns the profile and a page of the articles linked to it
+func (s *ThreatActorService) ListArticles(ctx context.Context, actorSlug string, filter *domain.ArticleFilter) (*domain.ThreatActor, []*domain.Article, int, error) {
+	profile, err := s.actorRepo.GetBySlug(ctx, actorSlug)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	filter.ThreatActorID = &profile.ID
+	articles, total, err := s.articleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to list threat actor articles: %w", err)
+	}
+
+	return profile, articles, total, nil
+}
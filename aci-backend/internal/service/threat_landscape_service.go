@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultThreatLandscapeWindow is the default threat landscape window
+	DefaultThreatLandscapeWindow = 30 * 24 * time.Hour
+
+	// MaxThreatLandscapeWindow is the largest threat landscape window accepted
+	MaxThreatLandscapeWindow = 90 * 24 * time.Hour
+
+	// hourlyThreatLandscapeWindow is the largest window charted in hourly buckets; longer windows use days
+	hourlyThreatLandscapeWindow = 48 * time.Hour
+
+	// DefaultThreatLandscapeTopN is how many categories, vendors and CVEs are ranked by default
+	DefaultThreatLandscapeTopN = 10
+
+	// MaxThreatLandscapeTopN is the largest ranking size accepted
+	MaxThreatLandscapeTopN = 50
+
+	// threatLandscapeCacheTTL is how long a computed landscape is served before it is rebuilt
+	threatLandscapeCacheTTL = 5 * time.Minute
+)
+
+// threatLandscapeCacheKey identifies a cached landscape
+type threatLandscapeCacheKey struct {
+	tenant string
+	window time.Duration
+	topN   int
+}
+
+// threatLandscapeCacheEntry is a cached landscape and when it expires
+type threatLandscapeCacheEntry struct {
+	landscape *domain.ThreatLandscape
+	expiresAt time.Time
+}
+
+// ThreatLandscapeService builds severity trends and threat rankings for dashboard charts
+// Landscapes are aggregated from published articles and cached briefly, so every dashboard
+// load does not rerun the aggregate queries
+type ThreatLandscapeService struct {
+	repo repository.ThreatLandscapeRepository
+
+	mu    sync.Mutex
+	cache map[threatLandscapeCacheKey]threatLandscapeCacheEntry
+}
+
+// NewThreatLandscapeService creates a new threat landscape service instance
+func NewThreatLandscapeService(repo repository.ThreatLandscapeRepository) *ThreatLandscapeService {
+	if repo == nil {
+		panic("repo cannot be nil")
+	}
+
+	return &ThreatLandscapeService{
+		repo:  repo,
+		cache: make(map[threatLandscapeCacheKey]threatLandscapeCacheEntry),
+	}
+}
+
+// Landscape returns the threat landscape for the given window, ranking the top N entries
+// A zero window or top N falls back to the defaults
+func (s *ThreatLandscapeService) Landscape(ctx context.Context, window time.Duration, topN int) (*domain.ThreatLandscape, error) {
+	if window <= 0 {
+		window = DefaultThreatLandscapeWindow
+	}
+
+	if window > MaxThreatLandscapeWindow {
+		return nil, fmt.Errorf("window cannot exceed %s", MaxThreatLandscapeWindow)
+	}
+
+	if topN <= 0 {
+		topN = DefaultThreatLandscapeTopN
+	}
+
+	if topN > MaxThreatLandscapeTopN {
+		return nil, fmt.Errorf("limit cannot exceed %d", MaxThreatLandscapeTopN)
+	}
+
+	key := threatLandscapeCacheKey{tenant: tenantCacheScope(ctx), window: window, topN: topN}
+	now := time.Now().UTC()
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return entry.landscape, nil
+	}
+
+	landscape, err := s.build(ctx, now, window, topN)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	for k, e := range s.cache {
+		if !now.Before(e.expiresAt) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = threatLandscapeCacheEntry{landscape: landscape, expiresAt: now.Add(threatLandscapeCacheTTL)}
+	s.mu.Unlock()
+
+	return landscape, nil
+}
+
+// build runs the aggregate queries for one landscape
+func (s *ThreatLandscapeService) build(ctx context.Context, now time.Time, window time.Duration, topN int) (*domain.ThreatLandscape, error) {
+	since := now.Add(-window)
+
+	interval := domain.ThreatLandscapeIntervalDay
+	if window <= hourlyThreatLandscapeWindow {
+		interval = domain.ThreatLandscapeIntervalHour
+	}
+
+	points, err := s.repo.GetSeverityTrend(ctx, since, interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get severity trend: %w", err)
+	}
+
+	categories, err := s.repo.GetCategoryCounts(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category counts: %w", err)
+	}
+
+	vendors, err := s.repo.GetTopVendors(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top vendors: %w", err)
+	}
+
+	cves, err := s.repo.GetTopCVEs(ctx, since, topN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top CVEs: %w", err)
+	}
+
+	trend := fillSeverityTrend(points, since, now, interval)
+
+	total := 0
+	for _, point := range trend {
+		total += point.Total
+	}
+
+	return &domain.ThreatLandscape{
+		Window:        window.String(),
+		Interval:      interval,
+		Since:         since,
+		GeneratedAt:   now,
+		Total:         total,
+		SeverityTrend: trend,
+		ByCategory:    categories,
+		TopVendors:    vendors,
+		TopCVEs:       cves,
+	}, nil
+}
+
+// fillSeverityTrend returns one point per bucket from since to now, with zero counts for
+// buckets that had no articles, so charts get an unbroken series
+func fillSeverityTrend(points []domain.SeverityTrendPoint, since, now time.Time, interval domain.ThreatLandscapeInterval) []domain.SeverityTrendPoint {
+	byBucket := make(map[int64]domain.SeverityTrendPoint, len(points))
+	for _, point := range points {
+		byBucket[point.Bucket.Unix()] = point
+	}
+
+	step := interval.Duration()
+	trend := make([]domain.SeverityTrendPoint, 0, int(now.Sub(since)/step)+1)
+	for bucket := since.Truncate(step); !bucket.After(now); bucket = bucket.Add(step) {
+		point, ok := byBucket[bucket.Unix()]
+		if !ok {
+			point = domain.SeverityTrendPoint{Bucket: bucket}
+		}
+		trend = append(trend, point)
+	}
+
+	return trend
+}
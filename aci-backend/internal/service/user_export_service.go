@@ -0,0 +1,380 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// exportBatchSize is how many rows are loaded per query while streaming an export
+const exportBatchSize = 200
+
+// Export formats
+const (
+	ExportFormatJSON = "json" // single JSON document
+	ExportFormatCSV  = "csv"  // zip archive of CSV files plus notification_preferences.json
+)
+
+// UserExportService streams a user's bookmarks, reading history, alerts and preferences
+// for data-portability requests. Rows are written in batches as they are read, so exports
+// of any size use constant memory.
+type UserExportService struct {
+	bookmarkRepo    repository.BookmarkRepository
+	articleReadRepo repository.ArticleReadRepository
+	alertRepo       repository.AlertRepository
+	prefsService    *NotificationPreferenceService
+}
+
+// NewUserExportService creates a new user export service instance
+func NewUserExportService(
+	bookmarkRepo repository.BookmarkRepository,
+	articleReadRepo repository.ArticleReadRepository,
+	alertRepo repository.AlertRepository,
+	prefsService *NotificationPreferenceService,
+) *UserExportService {
+	if bookmarkRepo == nil {
+		panic("bookmarkRepo cannot be nil")
+	}
+	if articleReadRepo == nil {
+		panic("articleReadRepo cannot be nil")
+	}
+	if alertRepo == nil {
+		panic("alertRepo cannot be nil")
+	}
+	if prefsService == nil {
+		panic("prefsService cannot be nil")
+	}
+
+	return &UserExportService{
+		bookmarkRepo:    bookmarkRepo,
+		articleReadRepo: articleReadRepo,
+		alertRepo:       alertRepo,
+		prefsService:    prefsService,
+	}
+}
+
+// exportBookmark is a bookmarked article in an export
+type exportBookmark struct {
+	ArticleID   uuid.UUID       `json:"article_id"`
+	Title       string          `json:"title"`
+	Slug        string          `json:"slug"`
+	SourceURL   string          `json:"source_url"`
+	Severity    domain.Severity `json:"severity"`
+	PublishedAt time.Time       `json:"published_at"`
+}
+
+// exportRead is a reading history entry in an export
+type exportRead struct {
+	ArticleID          uuid.UUID `json:"article_id"`
+	Title              string    `json:"title"`
+	Slug               string    `json:"slug"`
+	ReadAt             time.Time `json:"read_at"`
+	ReadingTimeSeconds int       `json:"reading_time_seconds"`
+}
+
+// exportAlert is an alert the user created
+type exportAlert struct {
+	ID         uuid.UUID        `json:"id"`
+	Name       string           `json:"name"`
+	Type       domain.AlertType `json:"type"`
+	Value      string           `json:"value"`
+	IsActive   bool             `json:"is_active"`
+	Shared     bool             `json:"shared"`
+	MatchCount int              `json:"match_count"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// Export writes the user's data to w in the given format
+// Once writing has started an error leaves w truncated; callers cannot change the response status
+func (s *UserExportService) Export(ctx context.Context, userID uuid.UUID, format string, w io.Writer) error {
+	switch format {
+	case ExportFormatJSON:
+		return s.exportJSON(ctx, userID, w)
+	case ExportFormatCSV:
+		return s.exportArchive(ctx, userID, w)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// exportJSON streams a single JSON document, encoding array elements as they are loaded
+func (s *UserExportService) exportJSON(ctx context.Context, userID uuid.UUID, w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	header := fmt.Sprintf(`{"user_id":%q,"exported_at":%q,`, userID.String(), time.Now().UTC().Format(time.RFC3339))
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	sections := []struct {
+		name string
+		each func(func(interface{}) error) error
+	}{
+		{"bookmarks", func(fn func(interface{}) error) error {
+			return s.eachBookmark(ctx, userID, func(b exportBookmark) error { return fn(b) })
+		}},
+		{"reading_history", func(fn func(interface{}) error) error {
+			return s.eachRead(ctx, userID, func(r exportRead) error { return fn(r) })
+		}},
+		{"alerts", func(fn func(interface{}) error) error {
+			return s.eachAlert(ctx, userID, func(a exportAlert) error { return fn(a) })
+		}},
+	}
+
+	for _, section := range sections {
+		if _, err := fmt.Fprintf(w, `%q:[`, section.name); err != nil {
+			return err
+		}
+
+		first := true
+		err := section.each(func(item interface{}) error {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			return enc.Encode(item)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", section.name, err)
+		}
+
+		if _, err := io.WriteString(w, "],"); err != nil {
+			return err
+		}
+	}
+
+	prefs, err := s.prefsService.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to export notification preferences: %w", err)
+	}
+
+	if _, err := io.WriteString(w, `"notification_preferences":`); err != nil {
+		return err
+	}
+	if err := enc.Encode(prefs); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "}\n")
+	return err
+}
+
+// exportArchive streams a zip archive with one CSV file per section and the preferences as JSON
+func (s *UserExportService) exportArchive(ctx context.Context, userID uuid.UUID, w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	err := s.writeCSV(archive, "bookmarks.csv",
+		[]string{"article_id", "title", "slug", "source_url", "severity", "published_at"},
+		func(write func([]string) error) error {
+			return s.eachBookmark(ctx, userID, func(b exportBookmark) error {
+				return write([]string{
+					b.ArticleID.String(),
+					b.Title,
+					b.Slug,
+					b.SourceURL,
+					string(b.Severity),
+					b.PublishedAt.UTC().Format(time.RFC3339),
+				})
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = s.writeCSV(archive, "reading_history.csv",
+		[]string{"article_id", "title", "slug", "read_at", "reading_time_seconds"},
+		func(write func([]string) error) error {
+			return s.eachRead(ctx, userID, func(r exportRead) error {
+				return write([]string{
+					r.ArticleID.String(),
+					r.Title,
+					r.Slug,
+					r.ReadAt.UTC().Format(time.RFC3339),
+					strconv.Itoa(r.ReadingTimeSeconds),
+				})
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = s.writeCSV(archive, "alerts.csv",
+		[]string{"id", "name", "type", "value", "is_active", "shared", "match_count", "created_at"},
+		func(write func([]string) error) error {
+			return s.eachAlert(ctx, userID, func(a exportAlert) error {
+				return write([]string{
+					a.ID.String(),
+					a.Name,
+					string(a.Type),
+					a.Value,
+					strconv.FormatBool(a.IsActive),
+					strconv.FormatBool(a.Shared),
+					strconv.Itoa(a.MatchCount),
+					a.CreatedAt.UTC().Format(time.RFC3339),
+				})
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	prefs, err := s.prefsService.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to export notification preferences: %w", err)
+	}
+
+	file, err := archive.Create("notification_preferences.json")
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(file)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(prefs); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// writeCSV adds a CSV file to the archive, flushing after every row batch
+func (s *UserExportService) writeCSV(archive *zip.Writer, name string, header []string, rows func(write func([]string) error) error) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	count := 0
+	err = rows(func(record []string) error {
+		if err := writer.Write(sanitizeCSVRecord(record)); err != nil {
+			return err
+		}
+		count++
+		if count%exportBatchSize == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export %s: %w", name, err)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// eachBookmark calls fn for every bookmarked article, loading them in batches
+func (s *UserExportService) eachBookmark(ctx context.Context, userID uuid.UUID, fn func(exportBookmark) error) error {
+	for offset := 0; ; offset += exportBatchSize {
+		articles, _, err := s.bookmarkRepo.GetByUserID(ctx, userID, exportBatchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, article := range articles {
+			item := exportBookmark{
+				ArticleID:   article.ID,
+				Title:       article.Title,
+				Slug:        article.Slug,
+				SourceURL:   article.SourceURL,
+				Severity:    article.Severity,
+				PublishedAt: article.PublishedAt,
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if len(articles) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// eachRead calls fn for every reading history entry, loading them in batches
+func (s *UserExportService) eachRead(ctx context.Context, userID uuid.UUID, fn func(exportRead) error) error {
+	for offset := 0; ; offset += exportBatchSize {
+		reads, _, err := s.articleReadRepo.GetByUserID(ctx, userID, exportBatchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, read := range reads {
+			item := exportRead{
+				ArticleID:          read.ArticleID,
+				ReadAt:             read.ReadAt,
+				ReadingTimeSeconds: read.ReadingTimeSeconds,
+			}
+			if read.Article != nil {
+				item.Title = read.Article.Title
+				item.Slug = read.Article.Slug
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if len(reads) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// eachAlert calls fn for every alert the user created; alerts shared with them by others are not their data
+func (s *UserExportService) eachAlert(ctx context.Context, userID uuid.UUID, fn func(exportAlert) error) error {
+	alerts, err := s.alertRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		if alert.UserID != userID {
+			continue
+		}
+
+		item := exportAlert{
+			ID:         alert.ID,
+			Name:       alert.Name,
+			Type:       alert.Type,
+			Value:      alert.Value,
+			IsActive:   alert.IsActive,
+			Shared:     alert.IsShared(),
+			MatchCount: alert.MatchCount,
+			CreatedAt:  alert.CreatedAt,
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sanitizeCSVRecord prefixes values that spreadsheets would evaluate as formulas
+func sanitizeCSVRecord(record []string) []string {
+	for i, value := range record {
+		if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+			record[i] = "'" + value
+		}
+	}
+	return record
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/newsletter"
+	"github.com/phillipboles/aci-backend/internal/pkg/crypto"
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/util/signedtoken"
+)
+
+// ErrInvitationExpired is returned when an invitation is accepted after its link expired
+var ErrInvitationExpired = errors.New("invitation link has expired")
+
+// inviteTokenPurpose keeps invitation tokens from being accepted as any other signed token
+const inviteTokenPurpose = "user-invite"
+
+// UserInviteServiceConfig configures the user invitation service
+type UserInviteServiceConfig struct {
+	SiteURL     string        // origin of the web app, which hosts the accept page
+	TokenSecret string        // signs invitation links
+	TTL         time.Duration // how long an invitation link stays valid
+}
+
+// InviteInput is an invitation as sent by an admin
+type InviteInput struct {
+	Email            string
+	Name             string
+	Role             entities.UserRole       // defaults to user
+	OrganizationID   *uuid.UUID              // organization the invitee joins, if any
+	OrganizationRole domain.OrganizationRole // defaults to member
+}
+
+// UserInviteService creates accounts for invited users, who choose their password through a signed link
+// An invited account cannot sign in until the invitation is accepted; inviting the same address
+// again updates the pending account and sends a fresh link
+type UserInviteService struct {
+	userRepo    repository.UserRepository
+	auditRepo   repository.AuditLogRepository
+	authService *AuthService
+	mailer      NewsletterMailer
+	cfg         UserInviteServiceConfig
+	tokenSecret []byte
+
+	orgService *OrganizationService
+}
+
+// NewUserInviteService creates a new user invitation service instance
+func NewUserInviteService(
+	userRepo repository.UserRepository,
+	auditRepo repository.AuditLogRepository,
+	authService *AuthService,
+	mailer NewsletterMailer,
+	cfg UserInviteServiceConfig,
+) *UserInviteService {
+	if userRepo == nil {
+		panic("userRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+	if authService == nil {
+		panic("authService cannot be nil")
+	}
+	if mailer == nil {
+		panic("mailer cannot be nil")
+	}
+	if cfg.TokenSecret == "" {
+		panic("token secret cannot be empty")
+	}
+
+	cfg.SiteURL = strings.TrimRight(cfg.SiteURL, "/")
+
+	return &UserInviteService{
+		userRepo:    userRepo,
+		auditRepo:   auditRepo,
+		authService: authService,
+		mailer:      mailer,
+		cfg:         cfg,
+		tokenSecret: []byte(cfg.TokenSecret),
+	}
+}
+
+// SetOrganizationService lets invitations add the invitee to an organization
+func (s *UserInviteService) SetOrganizationService(orgService *OrganizationService) {
+	s.orgService = orgService
+}
+
+// Invite creates an invited account and emails its invitation link, returning when the link expires
+// If the email cannot be sent the account is kept, so inviting the address again resends it
+func (s *UserInviteService) Invite(
+	ctx context.Context,
+	input InviteInput,
+	adminID uuid.UUID,
+	ipAddress, userAgent string,
+) (*entities.User, time.Time, error) {
+	email := strings.TrimSpace(input.Email)
+	if err := s.authService.validateEmail(email); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if err := s.authService.validateName(name); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	role := input.Role
+	if role == "" {
+		role = entities.RoleUser
+	}
+	if role != entities.RoleUser && role != entities.RoleAnalyst && role != entities.RoleAdmin {
+		return nil, time.Time{}, &domainerrors.ValidationError{
+			Field:   "role",
+			Message: "role must be user, analyst or admin",
+		}
+	}
+
+	var org *domain.Organization
+	if input.OrganizationID != nil {
+		if s.orgService == nil {
+			return nil, time.Time{}, &domainerrors.ValidationError{
+				Field:   "organization_id",
+				Message: "organizations are not available",
+			}
+		}
+
+		var err error
+		org, err = s.orgService.Get(ctx, *input.OrganizationID)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	user, err := s.pendingUser(ctx, email, name, role)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if org != nil {
+		if _, err := s.orgService.SetMember(ctx, org.ID, user.ID, input.OrganizationRole); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	s.audit(ctx, adminID, user, org, ipAddress, userAgent)
+
+	expiresAt := time.Now().Add(s.cfg.TTL)
+	if err := s.sendInvitation(ctx, user, org, adminID, expiresAt); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to send invitation: %w", err)
+	}
+
+	log.Info().
+		Str("user_id", user.ID.String()).
+		Str("invited_by", adminID.String()).
+		Msg("User invited")
+
+	return user, expiresAt, nil
+}
+
+// pendingUser creates the invited account, or updates the one a previous invitation created
+func (s *UserInviteService) pendingUser(ctx context.Context, email, name string, role entities.UserRole) (*entities.User, error) {
+	existing, err := s.userRepo.GetByEmail(ctx, email)
+	if err == nil {
+		if existing.Status != entities.UserStatusInvited {
+			return nil, &domainerrors.ConflictError{
+				Resource: "user",
+				Field:    "email",
+				Value:    email,
+			}
+		}
+
+		existing.Name = name
+		existing.Role = role
+		if err := s.userRepo.Update(ctx, existing); err != nil {
+			return nil, fmt.Errorf("failed to update invited user: %w", err)
+		}
+		return existing, nil
+	}
+
+	var notFoundErr *domainerrors.NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		return nil, fmt.Errorf("failed to check existing user: %w", err)
+	}
+
+	// The account has no password until the invitation is accepted, so it cannot sign in
+	user := entities.NewUser(email, "", name)
+	user.Role = role
+	user.Status = entities.UserStatusInvited
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Lookup returns the invited account an invitation link was issued for, so the accept page can greet the invitee
+func (s *UserInviteService) Lookup(ctx context.Context, token string) (*entities.User, error) {
+	userID, err := s.verifyToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.invitedUser(ctx, userID)
+}
+
+// Accept sets the invitee's password, activates their account and signs them in
+// name replaces the name the admin gave when set
+func (s *UserInviteService) Accept(
+	ctx context.Context,
+	token, name, password string,
+	ipAddress, userAgent string,
+) (*entities.User, *jwt.TokenPair, error) {
+	userID, err := s.verifyToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.invitedUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if name = strings.TrimSpace(name); name == "" {
+		name = user.Name
+	}
+	if err := s.authService.validateName(name); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.authService.validatePassword(password); err != nil {
+		return nil, nil, err
+	}
+
+	passwordHash, err := crypto.HashPassword(password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	activated, err := s.userRepo.ActivateInvited(ctx, user.ID, name, passwordHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !activated {
+		return nil, nil, &domainerrors.ValidationError{
+			Field:   "token",
+			Message: "invitation has already been accepted",
+		}
+	}
+
+	return s.authService.Login(ctx, user.Email, password, ipAddress, userAgent)
+}
+
+// invitedUser returns the user if their invitation is still open
+func (s *UserInviteService) invitedUser(ctx context.Context, userID uuid.UUID) (*entities.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Status != entities.UserStatusInvited {
+		return nil, &domainerrors.ValidationError{
+			Field:   "token",
+			Message: "invitation has already been accepted",
+		}
+	}
+
+	return user, nil
+}
+
+// verifyToken returns the user ID an invitation token was issued to
+func (s *UserInviteService) verifyToken(token string) (uuid.UUID, error) {
+	id, err := signedtoken.Verify(s.tokenSecret, inviteTokenPurpose, token, time.Now())
+	if errors.Is(err, signedtoken.ErrExpired) {
+		return uuid.Nil, ErrInvitationExpired
+	}
+	if err != nil {
+		return uuid.Nil, &domainerrors.ValidationError{Field: "token", Message: "token is invalid"}
+	}
+	return id, nil
+}
+
+// sendInvitation emails the invitee their invitation link
+func (s *UserInviteService) sendInvitation(
+	ctx context.Context,
+	user *entities.User,
+	org *domain.Organization,
+	adminID uuid.UUID,
+	expiresAt time.Time,
+) error {
+	token := signedtoken.New(s.tokenSecret, inviteTokenPurpose, user.ID, expiresAt)
+
+	data := newsletter.InvitationData{
+		Name:      user.Name,
+		AcceptURL: s.cfg.SiteURL + "/invite/accept?token=" + url.QueryEscape(token),
+		ExpiresIn: humanizeDuration(s.cfg.TTL),
+	}
+	if admin, err := s.userRepo.GetByID(ctx, adminID); err == nil {
+		data.InvitedBy = admin.Name
+	}
+	if org != nil {
+		data.Organization = org.Name
+	}
+
+	html, text, err := newsletter.RenderInvitation(data)
+	if err != nil {
+		return err
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, newsletterSendTimeout)
+	defer cancel()
+
+	return s.mailer.Send(sendCtx, user.Email, newsletter.InvitationSubject, html, text, nil)
+}
+
+// audit records an invitation; failures are logged and do not fail the operation
+func (s *UserInviteService) audit(
+	ctx context.Context,
+	adminID uuid.UUID,
+	user *entities.User,
+	org *domain.Organization,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	invitation := map[string]interface{}{
+		"email": user.Email,
+		"name":  user.Name,
+		"role":  user.Role,
+	}
+	if org != nil {
+		invitation["organization_id"] = org.ID
+	}
+
+	entry := domain.NewAuditLog(&adminID, domain.AuditActionUserInvited, "user", &user.ID, nil, invitation, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("user_id", user.ID.String()).
+			Msg("Failed to write user invitation audit log")
+	}
+}
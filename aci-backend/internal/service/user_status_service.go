@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// userStatusCacheTTL is how long a looked-up status is trusted before it is read again,
+	// which bounds how long a suspended user keeps access on other instances
+	userStatusCacheTTL = 30 * time.Second
+
+	// maxUserStatusCacheEntries bounds the status cache; users past it are read on every request
+	maxUserStatusCacheEntries = 10000
+)
+
+// UserStatusService suspends, bans and reinstates user accounts
+// Unlike deletion, a blocked account keeps all its data; its refresh tokens are revoked at once
+// and CheckStatus rejects its access tokens until the block is lifted or expires
+type UserStatusService struct {
+	userRepo   repository.UserRepository
+	tokenRepo  repository.RefreshTokenRepository
+	outboxRepo repository.AuditOutboxRepository
+	txManager  repository.TxManager
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedUserStatus
+}
+
+// cachedUserStatus is a status lookup result
+type cachedUserStatus struct {
+	user      *entities.User
+	expiresAt time.Time
+}
+
+// NewUserStatusService creates a new user status service instance
+func NewUserStatusService(
+	userRepo repository.UserRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	outboxRepo repository.AuditOutboxRepository,
+	txManager repository.TxManager,
+) *UserStatusService {
+	if userRepo == nil {
+		panic("userRepo cannot be nil")
+	}
+	if tokenRepo == nil {
+		panic("tokenRepo cannot be nil")
+	}
+	if outboxRepo == nil {
+		panic("outboxRepo cannot be nil")
+	}
+	if txManager == nil {
+		panic("txManager cannot be nil")
+	}
+
+	return &UserStatusService{
+		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		cache:      make(map[uuid.UUID]cachedUserStatus),
+	}
+}
+
+// Suspend blocks a user's account with a reason and revokes every refresh token it holds
+// A suspension with an until time lifts on its own; a ban has no end and must be lifted by an admin
+func (s *UserStatusService) Suspend(
+	ctx context.Context,
+	userID uuid.UUID,
+	status entities.UserStatus,
+	reason string,
+	until *time.Time,
+	adminID uuid.UUID,
+	ipAddress, userAgent string,
+) (*entities.User, error) {
+	if status != entities.UserStatusSuspended && status != entities.UserStatusBanned {
+		return nil, &domainerrors.ValidationError{
+			Field:   "status",
+			Message: "status must be suspended or banned",
+		}
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &domainerrors.ValidationError{
+			Field:   "reason",
+			Message: "reason is required",
+		}
+	}
+	if len(reason) > domain.MaxUserStatusReasonLength {
+		return nil, &domainerrors.ValidationError{
+			Field:   "reason",
+			Message: fmt.Sprintf("reason must be at most %d characters", domain.MaxUserStatusReasonLength),
+		}
+	}
+
+	if until != nil {
+		if status == entities.UserStatusBanned {
+			return nil, &domainerrors.ValidationError{
+				Field:   "until",
+				Message: "a ban has no end; suspend the user instead",
+			}
+		}
+		if !until.After(time.Now()) {
+			return nil, &domainerrors.ValidationError{
+				Field:   "until",
+				Message: "until must be in the future",
+			}
+		}
+	}
+
+	if userID == adminID {
+		return nil, &domainerrors.ValidationError{
+			Field:   "id",
+			Message: "you cannot suspend your own account",
+		}
+	}
+
+	action := domain.AuditActionUserSuspended
+	if status == entities.UserStatusBanned {
+		action = domain.AuditActionUserBanned
+	}
+
+	return s.setStatus(ctx, userID, status, &reason, until, action, adminID, ipAddress, userAgent, true)
+}
+
+// Unsuspend makes a suspended or banned account active again
+// Sessions revoked by the suspension stay revoked; the user signs in again
+func (s *UserStatusService) Unsuspend(ctx context.Context, userID, adminID uuid.UUID, ipAddress, userAgent string) (*entities.User, error) {
+	return s.setStatus(ctx, userID, entities.UserStatusActive, nil, nil,
+		domain.AuditActionUserUnsuspended, adminID, ipAddress, userAgent, false)
+}
+
+// setStatus stores the status and stages its audit log together, revoking sessions when asked
+func (s *UserStatusService) setStatus(
+	ctx context.Context,
+	userID uuid.UUID,
+	status entities.UserStatus,
+	reason *string,
+	until *time.Time,
+	action string,
+	adminID uuid.UUID,
+	ipAddress, userAgent string,
+	revokeSessions bool,
+) (*entities.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Status == entities.UserStatusInvited {
+		return nil, &domainerrors.ValidationError{
+			Field:   "id",
+			Message: "user has not accepted their invitation; delete the account instead",
+		}
+	}
+
+	oldState := userStatusState(user)
+
+	user.Status = status
+	user.StatusReason = reason
+	user.SuspendedUntil = until
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.SetStatus(ctx, userID, status, reason, until, &adminID); err != nil {
+			return err
+		}
+
+		if revokeSessions {
+			if err := s.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+		}
+
+		var ip, ua *string
+		if ipAddress != "" {
+			ip = &ipAddress
+		}
+		if userAgent != "" {
+			ua = &userAgent
+		}
+
+		entry := domain.NewAuditLog(&adminID, action, "user", &userID, oldState, userStatusState(user), ip, ua)
+		if err := s.outboxRepo.Enqueue(ctx, entry); err != nil {
+			return fmt.Errorf("failed to stage audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	delete(s.cache, userID)
+	s.mu.Unlock()
+
+	return user, nil
+}
+
+// CheckStatus returns an AccountBlockedError when the user is suspended or banned
+// Statuses are cached briefly, so a change made on another instance applies within userStatusCacheTTL
+func (s *UserStatusService) CheckStatus(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[userID]
+	s.mu.Unlock()
+
+	if !ok || now.After(cached.expiresAt) {
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		cached = cachedUserStatus{user: user, expiresAt: now.Add(userStatusCacheTTL)}
+
+		s.mu.Lock()
+		if len(s.cache) >= maxUserStatusCacheEntries {
+			s.evictExpired(now)
+		}
+		if len(s.cache) < maxUserStatusCacheEntries {
+			s.cache[userID] = cached
+		}
+		s.mu.Unlock()
+	}
+
+	return accountBlockedError(cached.user, now)
+}
+
+// evictExpired drops expired cache entries; the caller holds the lock
+func (s *UserStatusService) evictExpired(now time.Time) {
+	for userID, entry := range s.cache {
+		if now.After(entry.expiresAt) {
+			delete(s.cache, userID)
+		}
+	}
+}
+
+// accountBlockedError returns an AccountBlockedError when the user is blocked at the given time, or nil
+func accountBlockedError(user *entities.User, now time.Time) error {
+	status := user.EffectiveStatus(now)
+	if status == entities.UserStatusActive {
+		return nil
+	}
+
+	blocked := &domainerrors.AccountBlockedError{Status: string(status)}
+	if user.StatusReason != nil {
+		blocked.Reason = *user.StatusReason
+	}
+	if status == entities.UserStatusSuspended {
+		blocked.Until = user.SuspendedUntil
+	}
+
+	return blocked
+}
+
+// userStatusState is the part of a user recorded in status change audit logs
+func userStatusState(user *entities.User) map[string]interface{} {
+	return map[string]interface{}{
+		"status":          user.Status,
+		"status_reason":   user.StatusReason,
+		"suspended_until": user.SuspendedUntil,
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// VendorWatchlistService manages the vendors each user runs
+// Every watched vendor has a watchlist alert, created and deleted with it, and is boosted in the user's feed
+type VendorWatchlistService struct {
+	watchlistRepo repository.VendorWatchlistRepository
+	alertService  *AlertService
+}
+
+// NewVendorWatchlistService creates a new vendor watchlist service instance
+func NewVendorWatchlistService(watchlistRepo repository.VendorWatchlistRepository, alertService *AlertService) *VendorWatchlistService {
+	if watchlistRepo == nil {
+		panic("watchlistRepo cannot be nil")
+	}
+	if alertService == nil {
+		panic("alertService cannot be nil")
+	}
+
+	return &VendorWatchlistService{
+		watchlistRepo: watchlistRepo,
+		alertService:  alertService,
+	}
+}
+
+// List returns a user's watched vendors, oldest first
+func (s *VendorWatchlistService) List(ctx context.Context, userID uuid.UUID) ([]*domain.WatchedVendor, error) {
+	return s.watchlistRepo.List(ctx, userID)
+}
+
+// Vendors returns the names of a user's watched vendors
+func (s *VendorWatchlistService) Vendors(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	watched, err := s.watchlistRepo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	vendors := make([]string, len(watched))
+	for i, vendor := range watched {
+		vendors[i] = vendor.Vendor
+	}
+	return vendors, nil
+}
+
+// Add watches a vendor and creates its watchlist alert
+func (s *VendorWatchlistService) Add(ctx context.Context, userID uuid.UUID, vendor, ipAddress, userAgent string) (*domain.WatchedVendor, error) {
+	watched := &domain.WatchedVendor{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Vendor:    strings.TrimSpace(vendor),
+		CreatedAt: time.Now(),
+	}
+
+	if err := watched.Validate(); err != nil {
+		return nil, &domainerrors.ValidationError{Field: "vendor", Message: err.Error()}
+	}
+
+	existing, err := s.watchlistRepo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range existing {
+		if strings.EqualFold(entry.Vendor, watched.Vendor) {
+			return nil, &domainerrors.ConflictError{Resource: "watched vendor", Field: "vendor", Value: watched.Vendor}
+		}
+	}
+
+	if len(existing) >= domain.MaxWatchedVendors {
+		return nil, &domainerrors.LimitExceededError{
+			Resource: "watched vendor",
+			Limit:    domain.MaxWatchedVendors,
+			Message:  fmt.Sprintf("watchlist cannot exceed %d vendors", domain.MaxWatchedVendors),
+		}
+	}
+
+	alert, err := s.alertService.CreateWatchlistAlert(ctx, userID, watched.Vendor, ipAddress, userAgent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watchlist alert: %w", err)
+	}
+	watched.AlertID = &alert.ID
+
+	if err := s.watchlistRepo.Create(ctx, watched); err != nil {
+		// Do not leave an alert behind for a vendor that is not watched
+		if deleteErr := s.alertService.Delete(ctx, alert.ID, userID, ipAddress, userAgent); deleteErr != nil {
+			log.Error().
+				Err(deleteErr).
+				Str("alert_id", alert.ID.String()).
+				Msg("Failed to delete watchlist alert after watchlist entry was not saved")
+		}
+		return nil, err
+	}
+
+	return watched, nil
+}
+
+// Remove stops watching a vendor and deletes its watchlist alert
+func (s *VendorWatchlistService) Remove(ctx context.Context, id, userID uuid.UUID, ipAddress, userAgent string) error {
+	watched, err := s.watchlistRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if watched.AlertID == nil {
+		return nil
+	}
+
+	if err := s.alertService.Delete(ctx, *watched.AlertID, userID, ipAddress, userAgent); err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to delete watchlist alert: %w", err)
+	}
+
+	return nil
+}
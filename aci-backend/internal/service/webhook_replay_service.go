@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+// DefaultWebhookMaxSkew is how far a webhook timestamp may be from the server clock
+const DefaultWebhookMaxSkew = 5 * time.Minute
+
+var (
+	// ErrWebhookTimestampInvalid is returned for a timestamp that is not Unix seconds
+	ErrWebhookTimestampInvalid = errors.New("webhook timestamp is not a Unix time in seconds")
+
+	// ErrWebhookTimestampSkew is returned for a timestamp outside the accepted clock skew
+	ErrWebhookTimestampSkew = errors.New("webhook timestamp is outside the accepted window")
+
+	// ErrWebhookReplayed is returned when a signed request has already been accepted
+	ErrWebhookReplayed = errors.New("webhook request has already been received")
+)
+
+// WebhookReplayService rejects stale and replayed signed webhooks
+// Signed requests carry a timestamp covered by the signature; a request is accepted only if
+// its timestamp is within the skew of the server clock and its signature has not been seen
+// before. Signatures are remembered until their timestamp leaves the window, after which
+// the timestamp check alone rejects them
+type WebhookReplayService struct {
+	nonceRepo repository.WebhookNonceRepository
+	maxSkew   time.Duration
+}
+
+// NewWebhookReplayService creates a new webhook replay service instance
+// A non-positive maxSkew falls back to DefaultWebhookMaxSkew
+func NewWebhookReplayService(nonceRepo repository.WebhookNonceRepository, maxSkew time.Duration) *WebhookReplayService {
+	if nonceRepo == nil {
+		panic("nonceRepo cannot be nil")
+	}
+
+	if maxSkew <= 0 {
+		maxSkew = DefaultWebhookMaxSkew
+	}
+
+	return &WebhookReplayService{
+		nonceRepo: nonceRepo,
+		maxSkew:   maxSkew,
+	}
+}
+
+// Check accepts a request whose signature has already been verified against its timestamp
+// It returns ErrWebhookTimestampInvalid, ErrWebhookTimestampSkew or ErrWebhookReplayed when the
+// request must be rejected
+func (s *WebhookReplayService) Check(ctx context.Context, timestamp, signature string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrWebhookTimestampInvalid
+	}
+
+	sentAt := time.Unix(seconds, 0)
+	skew := time.Since(sentAt)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > s.maxSkew {
+		return ErrWebhookTimestampSkew
+	}
+
+	claimed, err := s.nonceRepo.Claim(ctx, signature, sentAt.Add(s.maxSkew))
+	if err != nil {
+		return fmt.Errorf("failed to record webhook signature: %w", err)
+	}
+
+	if !claimed {
+		return ErrWebhookReplayed
+	}
+
+	return nil
+}
+
+// Release forgets the signature of a request accepted by Check whose delivery then failed, so the
+// sender can retry the same signed request while its timestamp is still in the window
+func (s *WebhookReplayService) Release(ctx context.Context, signature string) error {
+	return s.nonceRepo.Release(ctx, signature)
+}
+
+// Run deletes expired signatures on every interval until the context is cancelled
+func (s *WebhookReplayService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.nonceRepo.DeleteExpired(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to delete expired webhook nonces")
+				continue
+			}
+
+			if deleted > 0 {
+				log.Debug().Int64("deleted", deleted).Msg("Deleted expired webhook nonces")
+			}
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/pkg/crypto"
+	"github.com/phillipboles/aci-backend/internal/repository"
+)
+
+const (
+	// DefaultWebhookSecretGracePeriod is how long replaced webhook secrets are still accepted
+	DefaultWebhookSecretGracePeriod = 24 * time.Hour
+
+	// webhookSecretBytes is the length of generated webhook secrets before hex encoding
+	webhookSecretBytes = 32
+
+	// encryptedWebhookSecretPrefix marks stored secrets encrypted with N8N_WEBHOOK_SECRET_KEY;
+	// rows without it were stored in plaintext before secrets were encrypted
+	encryptedWebhookSecretPrefix = "enc:v1:"
+)
+
+// ErrWebhookSecretKeyMissing is returned by Rotate when no key is configured to encrypt the new secret
+var ErrWebhookSecretKeyMissing = errors.New("webhook secrets cannot be rotated: N8N_WEBHOOK_SECRET_KEY is not configured")
+
+// WebhookSecretService holds the webhook secrets accepted for n8n signatures
+// Signatures are checked against every active version, so a rotated secret keeps working
+// for the grace period while workflows switch to the new primary. Other instances pick up
+// a rotation on their next refresh; until any rotation, N8N_WEBHOOK_SECRET is version 0.
+// In multi-tenant mode each workspace has its own secrets, chosen by the context's tenant;
+// a workspace other than the default has none until its first rotation
+type WebhookSecretService struct {
+	secretRepo  repository.WebhookSecretRepository
+	auditRepo   repository.AuditLogRepository
+	configured  *domain.WebhookSecret
+	gracePeriod time.Duration
+	secretKey   []byte // encrypts the secrets stored in webhook_secrets; nil if not configured
+
+	mu      sync.RWMutex
+	secrets map[uuid.UUID][]*domain.WebhookSecret // by tenant, uuid.Nil for the default workspace; newest first
+}
+
+// WebhookSecretRotation is a new primary secret, whose value is only shown once
+type WebhookSecretRotation struct {
+	*domain.WebhookSecret
+	Secret          string    `json:"secret"`
+	PreviousExpires time.Time `json:"previous_expires_at"`
+}
+
+// NewWebhookSecretService creates a new webhook secret service instance
+// configuredSecret is N8N_WEBHOOK_SECRET; a non-positive gracePeriod falls back to DefaultWebhookSecretGracePeriod.
+// secretKey is the decoded N8N_WEBHOOK_SECRET_KEY; without it secrets cannot be rotated
+func NewWebhookSecretService(
+	secretRepo repository.WebhookSecretRepository,
+	auditRepo repository.AuditLogRepository,
+	configuredSecret string,
+	gracePeriod time.Duration,
+	secretKey []byte,
+) *WebhookSecretService {
+	if secretRepo == nil {
+		panic("secretRepo cannot be nil")
+	}
+	if auditRepo == nil {
+		panic("auditRepo cannot be nil")
+	}
+
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultWebhookSecretGracePeriod
+	}
+
+	configured := &domain.WebhookSecret{Version: 0, Secret: configuredSecret, CreatedAt: time.Now()}
+
+	return &WebhookSecretService{
+		secretRepo:  secretRepo,
+		auditRepo:   auditRepo,
+		configured:  configured,
+		gracePeriod: gracePeriod,
+		secretKey:   secretKey,
+		secrets:     map[uuid.UUID][]*domain.WebhookSecret{uuid.Nil: {configured}},
+	}
+}
+
+// Load replaces the accepted secrets with the active versions of every workspace, or the
+// configured secret if the default workspace's secret has never been rotated
+func (s *WebhookSecretService) Load(ctx context.Context) error {
+	active, err := s.secretRepo.ListActive(repository.WithoutTenant(ctx))
+	if err != nil {
+		return err
+	}
+
+	secrets := make(map[uuid.UUID][]*domain.WebhookSecret)
+	for _, secret := range active {
+		if err := s.decrypt(ctx, secret); err != nil {
+			return err
+		}
+
+		tenantID := uuid.Nil
+		if secret.TenantID != nil {
+			tenantID = *secret.TenantID
+		}
+		secrets[tenantID] = append(secrets[tenantID], secret)
+	}
+
+	if len(secrets[uuid.Nil]) == 0 {
+		secrets[uuid.Nil] = []*domain.WebhookSecret{s.configured}
+	}
+
+	s.mu.Lock()
+	previous := s.secrets[uuid.Nil][0].Version
+	s.secrets = secrets
+	s.mu.Unlock()
+
+	if primary := secrets[uuid.Nil][0]; primary.Version != previous {
+		log.Info().Int("version", primary.Version).Int("active", len(secrets[uuid.Nil])).Msg("Loaded webhook secrets")
+	}
+
+	return nil
+}
+
+// List returns the versions accepted for the context's workspace, newest first, without their values
+func (s *WebhookSecretService) List(ctx context.Context) []*domain.WebhookSecret {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	active := s.secrets[webhookSecretTenant(ctx)]
+	secrets := make([]*domain.WebhookSecret, 0, len(active))
+	for _, secret := range active {
+		if secret.IsActive(now) {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// Verify reports which active version of the context's workspace signed the payload with the
+// hex HMAC-SHA256 signature
+func (s *WebhookSecretService) Verify(ctx context.Context, payload []byte, signature string) (int, bool) {
+	received := []byte(signature)
+	now := time.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, secret := range s.secrets[webhookSecretTenant(ctx)] {
+		if !secret.IsActive(now) {
+			continue
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret.Secret))
+		mac.Write(payload)
+		if hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), received) {
+			return secret.Version, true
+		}
+	}
+
+	return 0, false
+}
+
+// Sign returns the hex HMAC-SHA256 signature of the payload made with the primary secret of
+// the context's workspace, and its version; ok is false if the workspace has no secret yet
+// Outbound webhooks are signed this way, so n8n verifies them with the secret it signs with
+func (s *WebhookSecretService) Sign(ctx context.Context, payload []byte) (version int, signature string, ok bool) {
+	s.mu.RLock()
+	secrets := s.secrets[webhookSecretTenant(ctx)]
+	s.mu.RUnlock()
+
+	if len(secrets) == 0 {
+		return 0, "", false
+	}
+
+	primary := secrets[0]
+	mac := hmac.New(sha256.New, []byte(primary.Secret))
+	mac.Write(payload)
+	return primary.Version, hex.EncodeToString(mac.Sum(nil)), true
+}
+
+// Rotate generates a new primary secret for the context's workspace; the versions it replaces
+// expire after the grace period. Secrets are stored encrypted, so a key must be configured
+func (s *WebhookSecretService) Rotate(ctx context.Context, actorID *uuid.UUID, ipAddress, userAgent string) (*WebhookSecretRotation, error) {
+	if s.secretKey == nil {
+		return nil, ErrWebhookSecretKeyMissing
+	}
+
+	value, err := crypto.GenerateRandomToken(webhookSecretBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.encrypt(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// The configured secret is recorded the first time it is rotated, so it can expire
+	s.mu.RLock()
+	current := s.secrets[webhookSecretTenant(ctx)]
+	recordConfigured := len(current) > 0 && current[0] == s.configured
+	s.mu.RUnlock()
+
+	var initial *domain.WebhookSecret
+	if recordConfigured {
+		configured, err := s.encrypt(s.configured.Secret)
+		if err != nil {
+			return nil, err
+		}
+		initial = &domain.WebhookSecret{Version: 0, Secret: configured, CreatedAt: s.configured.CreatedAt}
+	}
+
+	previousExpires := time.Now().Add(s.gracePeriod)
+	secret := &domain.WebhookSecret{Secret: stored, CreatedBy: actorID}
+	if err := s.secretRepo.Rotate(ctx, secret, initial, previousExpires); err != nil {
+		return nil, err
+	}
+
+	if err := s.Load(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to reload webhook secrets after rotation")
+	}
+
+	s.audit(ctx, actorID, secret, previousExpires, ipAddress, userAgent)
+
+	log.Info().
+		Int("version", secret.Version).
+		Time("previous_expires_at", previousExpires).
+		Msg("Webhook secret rotated")
+
+	return &WebhookSecretRotation{
+		WebhookSecret:   secret,
+		Secret:          value,
+		PreviousExpires: previousExpires,
+	}, nil
+}
+
+// Run reloads the active secrets on every interval until the context is cancelled,
+// so rotations made through another instance take effect here
+func (s *WebhookSecretService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to reload webhook secrets")
+			}
+		}
+	}
+}
+
+// encrypt returns the stored form of a secret value
+func (s *WebhookSecretService) encrypt(value string) (string, error) {
+	encrypted, err := crypto.Encrypt(s.secretKey, value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+	return encryptedWebhookSecretPrefix + encrypted, nil
+}
+
+// decrypt replaces a stored secret with its value. A secret stored in plaintext before
+// encryption is encrypted in place when a key is configured
+func (s *WebhookSecretService) decrypt(ctx context.Context, secret *domain.WebhookSecret) error {
+	if encrypted, ok := strings.CutPrefix(secret.Secret, encryptedWebhookSecretPrefix); ok {
+		if s.secretKey == nil {
+			return fmt.Errorf("webhook secret version %d is encrypted but N8N_WEBHOOK_SECRET_KEY is not configured", secret.Version)
+		}
+
+		value, err := crypto.Decrypt(s.secretKey, encrypted)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt webhook secret version %d: %w", secret.Version, err)
+		}
+		secret.Secret = value
+		return nil
+	}
+
+	if s.secretKey == nil {
+		log.Warn().Int("version", secret.Version).Msg("Webhook secret is stored in plaintext; set N8N_WEBHOOK_SECRET_KEY to encrypt it")
+		return nil
+	}
+
+	stored, err := s.encrypt(secret.Secret)
+	if err != nil {
+		return err
+	}
+
+	if err := s.secretRepo.UpdateSecret(ctx, secret.TenantID, secret.Version, stored); err != nil {
+		// The plaintext value still verifies signatures; encrypting is retried on the next load
+		log.Error().Err(err).Int("version", secret.Version).Msg("Failed to encrypt stored webhook secret")
+		return nil
+	}
+
+	log.Info().Int("version", secret.Version).Msg("Encrypted stored webhook secret")
+	return nil
+}
+
+// webhookSecretTenant returns the workspace whose secrets apply to ctx; system work uses the
+// default workspace's
+func webhookSecretTenant(ctx context.Context) uuid.UUID {
+	tenantID, _ := repository.TenantFromContext(ctx)
+	return tenantID
+}
+
+// audit records a rotation; secret values are never written to the audit log
+func (s *WebhookSecretService) audit(
+	ctx context.Context,
+	actorID *uuid.UUID,
+	secret *domain.WebhookSecret,
+	previousExpires time.Time,
+	ipAddress, userAgent string,
+) {
+	var ip, ua *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+	if userAgent != "" {
+		ua = &userAgent
+	}
+
+	newValue := map[string]interface{}{
+		"version":             secret.Version,
+		"previous_expires_at": previousExpires,
+	}
+
+	entry := domain.NewAuditLog(actorID, domain.AuditActionWebhookSecretRotated, "webhook_secret", nil, nil, newValue, ip, ua)
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Int("version", secret.Version).
+			Msg("Failed to write webhook secret rotation audit log")
+	}
+}
@@ -0,0 +1,197 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// DefaultElasticsearchIndex is the index or data stream events are written to when none is configured
+	DefaultElasticsearchIndex = "aci-events"
+
+	// elasticsearchBulkPath is the bulk API endpoint
+	elasticsearchBulkPath = "/_bulk"
+)
+
+// elasticsearchDatasets are the ECS event.dataset values, by event type
+var elasticsearchDatasets = map[domain.SIEMEventType]string{
+	domain.SIEMEventArticle: "aci.article",
+	domain.SIEMEventIOC:     "aci.ioc",
+}
+
+// ElasticsearchConfig configures an Elasticsearch destination
+// APIKey takes precedence over Username and Password; with neither, requests are unauthenticated
+type ElasticsearchConfig struct {
+	URL      string // cluster URL, e.g. https://es.example.com:9200
+	APIKey   string // base64 encoded id:api_key, as returned by the create API key API
+	Username string
+	Password string
+	Index    string // defaults to DefaultElasticsearchIndex
+}
+
+// ElasticsearchForwarder implements Forwarder for the Elasticsearch bulk API
+// Each event is a create action with the event ID as the document ID, so an event sent again
+// after a lost response is reported as a conflict and counted as delivered rather than duplicated
+type ElasticsearchForwarder struct {
+	client
+	baseURL  string
+	index    string
+	apiKey   string
+	username string
+	password string
+}
+
+// NewElasticsearchForwarder creates a forwarder for an Elasticsearch cluster
+func NewElasticsearchForwarder(cfg ElasticsearchConfig) (*ElasticsearchForwarder, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("elasticsearch url is required")
+	}
+
+	index := cfg.Index
+	if index == "" {
+		index = DefaultElasticsearchIndex
+	}
+
+	return &ElasticsearchForwarder{
+		client:   newClient(DestinationElasticsearch),
+		baseURL:  strings.TrimRight(cfg.URL, "/"),
+		index:    index,
+		apiKey:   cfg.APIKey,
+		username: cfg.Username,
+		password: cfg.Password,
+	}, nil
+}
+
+// Name returns the destination identifier
+func (f *ElasticsearchForwarder) Name() string {
+	return string(DestinationElasticsearch)
+}
+
+// Endpoint returns the bulk API endpoint
+func (f *ElasticsearchForwarder) Endpoint() string {
+	return f.baseURL + elasticsearchBulkPath
+}
+
+// elasticsearchAction is the action line preceding each document in a bulk request
+type elasticsearchAction struct {
+	Create struct {
+		Index string `json:"_index"`
+		ID    string `json:"_id"`
+	} `json:"create"`
+}
+
+// elasticsearchBulkResponse is the part of a bulk response needed to find rejected documents
+type elasticsearchBulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		ID     string `json:"_id"`
+		Status int    `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// Send writes the batch with one bulk request
+func (f *ElasticsearchForwarder) Send(ctx context.Context, events []*domain.SIEMEvent) (map[uuid.UUID]error, error) {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+
+	for _, event := range events {
+		doc, err := f.document(event)
+		if err != nil {
+			return nil, err
+		}
+
+		var action elasticsearchAction
+		action.Create.Index = f.index
+		action.Create.ID = event.ID.String()
+
+		if err := encoder.Encode(action); err != nil {
+			return nil, fmt.Errorf("failed to encode elasticsearch action: %w", err)
+		}
+		if err := encoder.Encode(doc); err != nil {
+			return nil, fmt.Errorf("failed to encode elasticsearch document: %w", err)
+		}
+	}
+
+	respBody, err := f.post(ctx, f.Endpoint(), "application/x-ndjson", f.headers(), body.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	var result elasticsearchBulkResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode elasticsearch response: %w", err)
+	}
+
+	if !result.Errors {
+		return nil, nil
+	}
+
+	rejected := make(map[uuid.UUID]error)
+	for _, item := range result.Items {
+		for _, outcome := range item {
+			// Conflicts mean the document was written by an earlier attempt
+			if outcome.Error == nil || outcome.Status == http.StatusConflict {
+				continue
+			}
+
+			id, err := uuid.Parse(outcome.ID)
+			if err != nil {
+				continue
+			}
+
+			message := outcome.Error.Type + ": " + outcome.Error.Reason
+			if isPermanentStatus(outcome.Status) {
+				rejected[id] = &PermanentError{StatusCode: outcome.Status, Message: message}
+			} else {
+				rejected[id] = fmt.Errorf("elasticsearch rejected document: status %d: %s", outcome.Status, message)
+			}
+		}
+	}
+
+	return rejected, nil
+}
+
+// document is the event payload with the ECS @timestamp and event fields added
+func (f *ElasticsearchForwarder) document(event *domain.SIEMEvent) (map[string]interface{}, error) {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(event.Payload, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode event %s payload: %w", event.ID, err)
+	}
+
+	doc["@timestamp"] = event.OccurredAt.UTC().Format(time.RFC3339Nano)
+	doc["event"] = map[string]string{
+		"id":      event.ID.String(),
+		"kind":    "enrichment",
+		"action":  string(event.Type),
+		"dataset": elasticsearchDatasets[event.Type],
+	}
+
+	return doc, nil
+}
+
+// headers returns the authorization header, if credentials are configured
+func (f *ElasticsearchForwarder) headers() map[string]string {
+	switch {
+	case f.apiKey != "":
+		return map[string]string{"Authorization": "ApiKey " + f.apiKey}
+	case f.username != "":
+		credentials := base64.StdEncoding.EncodeToString([]byte(f.username + ":" + f.password))
+		return map[string]string{"Authorization": "Basic " + credentials}
+	default:
+		return nil
+	}
+}
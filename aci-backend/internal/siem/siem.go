@@ -0,0 +1,137 @@
+// Package siem forwards enriched article events and IOCs to SIEMs in their native ingest formats
+// Splunk receives events through the HTTP Event Collector and Elasticsearch through the bulk API.
+// Forwarders send a batch of events per request and report which events were not accepted
+package siem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// defaultHTTPTimeout bounds requests when the caller's context has no deadline
+	defaultHTTPTimeout = 30 * time.Second
+
+	// maxErrorBodyBytes limits how much of an error response is included in errors
+	maxErrorBodyBytes = 512
+
+	// maxResponseBytes limits how much of a successful response is read
+	maxResponseBytes = 4 << 20
+)
+
+// DestinationType identifies a SIEM destination
+type DestinationType string
+
+const (
+	DestinationSplunk        DestinationType = "splunk"
+	DestinationElasticsearch DestinationType = "elasticsearch"
+)
+
+// IsValid checks if the destination type is valid
+func (t DestinationType) IsValid() bool {
+	switch t {
+	case DestinationSplunk, DestinationElasticsearch:
+		return true
+	default:
+		return false
+	}
+}
+
+// Forwarder sends events to a SIEM destination
+type Forwarder interface {
+	// Name returns the destination identifier, stored with each queued event
+	Name() string
+
+	// Endpoint returns the URL events are sent to, without credentials
+	Endpoint() string
+
+	// Send delivers a batch of events in one request
+	// A non-nil error means none of the events were accepted. Otherwise rejected holds the
+	// events the SIEM refused individually, keyed by event ID; all other events were accepted.
+	// Errors the SIEM will keep returning, such as a malformed event, implement Permanent() bool
+	Send(ctx context.Context, events []*domain.SIEMEvent) (rejected map[uuid.UUID]error, err error)
+}
+
+// PermanentError is returned when the SIEM rejects a request or event in a way retrying
+// will not fix, e.g. a mapping conflict or an invalid token
+type PermanentError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *PermanentError) Error() string {
+	return fmt.Sprintf("SIEM rejected request: status %d: %s", e.StatusCode, e.Message)
+}
+
+// Permanent reports that retrying the request will not help
+func (e *PermanentError) Permanent() bool {
+	return true
+}
+
+// isPermanentStatus reports whether a 4xx status will be returned again on retry
+// Timeouts, conflicts and rate limits are worth retrying
+func isPermanentStatus(status int) bool {
+	return status >= 400 && status < 500 &&
+		status != http.StatusRequestTimeout &&
+		status != http.StatusConflict &&
+		status != http.StatusTooManyRequests
+}
+
+// client posts batches to a SIEM API
+type client struct {
+	name       string
+	httpClient *http.Client
+}
+
+// newClient creates a client named after the destination
+func newClient(destination DestinationType) client {
+	return client{name: string(destination), httpClient: &http.Client{Timeout: defaultHTTPTimeout}}
+}
+
+// post sends body and returns the response body of a 2xx response
+// 4xx responses other than 408, 409 and 429 are PermanentErrors; everything else is worth retrying
+func (c *client) post(ctx context.Context, endpoint, contentType string, headers map[string]string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		message := strings.TrimSpace(string(errBody))
+
+		if isPermanentStatus(resp.StatusCode) {
+			return nil, &PermanentError{StatusCode: resp.StatusCode, Message: message}
+		}
+
+		return nil, fmt.Errorf("%s request failed: status %d: %s", c.name, resp.StatusCode, message)
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s response: %w", c.name, err)
+	}
+
+	return respBody, nil
+}
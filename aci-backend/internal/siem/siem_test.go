@@ -0,0 +1,186 @@
+package siem
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+func sampleEvents() []*domain.SIEMEvent {
+	occurredAt := time.Date(2026, 10, 15, 9, 30, 0, 500_000_000, time.UTC)
+	return []*domain.SIEMEvent{
+		{
+			ID:         uuid.New(),
+			Type:       domain.SIEMEventArticle,
+			Payload:    json.RawMessage(`{"title":"Ransomware hits hospitals","severity":"critical"}`),
+			OccurredAt: occurredAt,
+		},
+		{
+			ID:         uuid.New(),
+			Type:       domain.SIEMEventIOC,
+			Payload:    json.RawMessage(`{"ioc_type":"ip","ioc_value":"203.0.113.7"}`),
+			OccurredAt: occurredAt,
+		},
+	}
+}
+
+// readLines decodes each line of an NDJSON or concatenated JSON body
+func readLines(t *testing.T, r *http.Request) []map[string]interface{} {
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestSplunkForwarder_Send(t *testing.T) {
+	events := sampleEvents()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/services/collector/event", r.URL.Path)
+		assert.Equal(t, "Splunk hec-token", r.Header.Get("Authorization"))
+
+		lines := readLines(t, r)
+		require.Len(t, lines, 2)
+
+		assert.Equal(t, "aci:article", lines[0]["sourcetype"])
+		assert.Equal(t, "aci:ioc", lines[1]["sourcetype"])
+		assert.Equal(t, "security", lines[0]["index"])
+		assert.InDelta(t, 1792056600.5, lines[0]["time"], 0.001)
+		assert.Equal(t, "Ransomware hits hospitals", lines[0]["event"].(map[string]interface{})["title"])
+		assert.Equal(t, events[1].ID.String(), lines[1]["fields"].(map[string]interface{})["event_id"])
+
+		_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+	}))
+	defer server.Close()
+
+	forwarder, err := NewSplunkForwarder(SplunkConfig{URL: server.URL + "/", Token: "hec-token", Index: "security"})
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/services/collector/event", forwarder.Endpoint())
+
+	rejected, err := forwarder.Send(context.Background(), events)
+	require.NoError(t, err)
+	assert.Empty(t, rejected)
+}
+
+func TestSplunkForwarder_ClassifiesErrors(t *testing.T) {
+	status := http.StatusForbidden
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"text":"Invalid token","code":4}`))
+	}))
+	defer server.Close()
+
+	forwarder, err := NewSplunkForwarder(SplunkConfig{URL: server.URL, Token: "hec-token"})
+	require.NoError(t, err)
+
+	_, err = forwarder.Send(context.Background(), sampleEvents())
+	var permanent *PermanentError
+	require.ErrorAs(t, err, &permanent)
+	assert.Contains(t, permanent.Message, "Invalid token")
+
+	for _, status = range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		_, err = forwarder.Send(context.Background(), sampleEvents())
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &permanent), "status %d should be retried", status)
+	}
+}
+
+func TestElasticsearchForwarder_Send(t *testing.T) {
+	events := sampleEvents()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_bulk", r.URL.Path)
+		assert.Equal(t, "ApiKey a2V5", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+
+		lines := readLines(t, r)
+		require.Len(t, lines, 4)
+
+		action := lines[0]["create"].(map[string]interface{})
+		assert.Equal(t, "threat-intel", action["_index"])
+		assert.Equal(t, events[0].ID.String(), action["_id"])
+
+		doc := lines[1]
+		assert.Equal(t, "2026-10-15T09:30:00.5Z", doc["@timestamp"])
+		assert.Equal(t, "critical", doc["severity"])
+		assert.Equal(t, "aci.article", doc["event"].(map[string]interface{})["dataset"])
+		assert.Equal(t, "aci.ioc", lines[3]["event"].(map[string]interface{})["dataset"])
+
+		_, _ = w.Write([]byte(`{"errors":false,"items":[{"create":{"status":201}},{"create":{"status":201}}]}`))
+	}))
+	defer server.Close()
+
+	forwarder, err := NewElasticsearchForwarder(ElasticsearchConfig{URL: server.URL, APIKey: "a2V5", Index: "threat-intel"})
+	require.NoError(t, err)
+
+	rejected, err := forwarder.Send(context.Background(), events)
+	require.NoError(t, err)
+	assert.Empty(t, rejected)
+}
+
+func TestElasticsearchForwarder_ReportsRejectedDocuments(t *testing.T) {
+	events := append(sampleEvents(), &domain.SIEMEvent{
+		ID:         uuid.New(),
+		Type:       domain.SIEMEventIOC,
+		Payload:    json.RawMessage(`{"ioc_type":"domain","ioc_value":"evil.example"}`),
+		OccurredAt: time.Now(),
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "elastic", username)
+		assert.Equal(t, "changeme", password)
+
+		items := []string{
+			`{"create":{"_id":"` + events[0].ID.String() + `","status":409,"error":{"type":"version_conflict_engine_exception","reason":"document already exists"}}}`,
+			`{"create":{"_id":"` + events[1].ID.String() + `","status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse field [ioc_value]"}}}`,
+			`{"create":{"_id":"` + events[2].ID.String() + `","status":429,"error":{"type":"es_rejected_execution_exception","reason":"queue is full"}}}`,
+		}
+		_, _ = w.Write([]byte(`{"errors":true,"items":[` + strings.Join(items, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	forwarder, err := NewElasticsearchForwarder(ElasticsearchConfig{URL: server.URL, Username: "elastic", Password: "changeme"})
+	require.NoError(t, err)
+
+	rejected, err := forwarder.Send(context.Background(), events)
+	require.NoError(t, err)
+
+	// The conflicting document was written by an earlier attempt
+	assert.NotContains(t, rejected, events[0].ID)
+	require.Len(t, rejected, 2)
+
+	var permanent *PermanentError
+	require.ErrorAs(t, rejected[events[1].ID], &permanent)
+	assert.Contains(t, permanent.Message, "mapper_parsing_exception")
+	assert.False(t, errors.As(rejected[events[2].ID], &permanent))
+}
+
+func TestNewForwarders_RequireEndpoint(t *testing.T) {
+	_, err := NewSplunkForwarder(SplunkConfig{Token: "hec-token"})
+	assert.Error(t, err)
+
+	_, err = NewSplunkForwarder(SplunkConfig{URL: "https://splunk.example.com:8088"})
+	assert.Error(t, err)
+
+	_, err = NewElasticsearchForwarder(ElasticsearchConfig{})
+	assert.Error(t, err)
+}
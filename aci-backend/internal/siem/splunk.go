@@ -0,0 +1,107 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+const (
+	// splunkEventPath is the HEC endpoint for JSON events
+	splunkEventPath = "/services/collector/event"
+
+	// splunkSource is the source field of every event
+	splunkSource = "aci-backend"
+)
+
+// splunkSourceTypes are the sourcetypes events are indexed under, by event type
+var splunkSourceTypes = map[domain.SIEMEventType]string{
+	domain.SIEMEventArticle: "aci:article",
+	domain.SIEMEventIOC:     "aci:ioc",
+}
+
+// SplunkConfig configures a Splunk HTTP Event Collector destination
+type SplunkConfig struct {
+	URL   string // HEC base URL, e.g. https://splunk.example.com:8088
+	Token string // HEC token
+	Index string // overrides the token's default index when set
+}
+
+// SplunkForwarder implements Forwarder for the Splunk HTTP Event Collector
+// A batch is sent as concatenated HEC event objects; HEC accepts or rejects it as a whole
+type SplunkForwarder struct {
+	client
+	endpoint string
+	index    string
+	headers  map[string]string
+}
+
+// NewSplunkForwarder creates a forwarder for a HEC endpoint
+func NewSplunkForwarder(cfg SplunkConfig) (*SplunkForwarder, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("splunk hec url is required")
+	}
+
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("splunk hec token is required")
+	}
+
+	return &SplunkForwarder{
+		client:   newClient(DestinationSplunk),
+		endpoint: strings.TrimRight(cfg.URL, "/") + splunkEventPath,
+		index:    cfg.Index,
+		headers:  map[string]string{"Authorization": "Splunk " + cfg.Token},
+	}, nil
+}
+
+// Name returns the destination identifier
+func (f *SplunkForwarder) Name() string {
+	return string(DestinationSplunk)
+}
+
+// Endpoint returns the HEC event endpoint
+func (f *SplunkForwarder) Endpoint() string {
+	return f.endpoint
+}
+
+// splunkEvent is a HEC event envelope
+type splunkEvent struct {
+	Time       float64           `json:"time"` // epoch seconds
+	Source     string            `json:"source"`
+	SourceType string            `json:"sourcetype"`
+	Index      string            `json:"index,omitempty"`
+	Event      json.RawMessage   `json:"event"`
+	Fields     map[string]string `json:"fields"` // indexed fields
+}
+
+// Send posts the batch to HEC
+func (f *SplunkForwarder) Send(ctx context.Context, events []*domain.SIEMEvent) (map[uuid.UUID]error, error) {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+
+	for _, event := range events {
+		envelope := splunkEvent{
+			Time:       float64(event.OccurredAt.UnixMilli()) / 1000,
+			Source:     splunkSource,
+			SourceType: splunkSourceTypes[event.Type],
+			Index:      f.index,
+			Event:      event.Payload,
+			Fields:     map[string]string{"event_id": event.ID.String(), "event_type": string(event.Type)},
+		}
+		if err := encoder.Encode(envelope); err != nil {
+			return nil, fmt.Errorf("failed to encode splunk event: %w", err)
+		}
+	}
+
+	if _, err := f.post(ctx, f.endpoint, "application/json", f.headers, body.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
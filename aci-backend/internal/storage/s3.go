@@ -0,0 +1,108 @@
+// Package storage reads and writes archives in S3-compatible object storage
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3Config holds the connection settings for an S3-compatible bucket
+// Endpoint is empty for AWS itself and set for MinIO, R2 and similar services
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UsePathStyle    bool // address the bucket as endpoint/bucket rather than bucket.endpoint
+}
+
+// S3Store puts objects into and gets them from a single bucket
+type S3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3Store creates a new S3 store instance authenticated with a static access key
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access key ID and secret access key are required")
+	}
+
+	opts := s3.Options{
+		Region:       cfg.Region,
+		Credentials:  credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		UsePathStyle: cfg.UsePathStyle,
+	}
+
+	if cfg.Endpoint != "" {
+		opts.BaseEndpoint = aws.String(cfg.Endpoint)
+	}
+
+	return &S3Store{
+		client: s3.New(opts),
+		bucket: cfg.Bucket,
+	}, nil
+}
+
+// Bucket returns the bucket objects are written to
+func (s *S3Store) Bucket() string {
+	return s.bucket
+}
+
+// Put uploads body under key, replacing any existing object
+func (s *S3Store) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	if key == "" {
+		return fmt.Errorf("key is required")
+	}
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(body),
+		ContentLength: aws.Int64(int64(len(body))),
+		ContentType:   aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Get downloads the object under key and returns it with its content type
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, string, error) {
+	if key == "" {
+		return nil, "", fmt.Errorf("key is required")
+	}
+
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer out.Body.Close()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	return body, aws.ToString(out.ContentType), nil
+}
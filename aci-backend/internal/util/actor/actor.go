@@ -0,0 +1,166 @@
+// Package actor finds mentions of threat actors in article text using a curated alias dictionary
+package actor
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MinNameLength is the shortest name or alias that is matched; shorter ones match too many words
+const MinNameLength = 3
+
+// descriptorWords are dropped from the end of a reported name before it is looked up,
+// so "the LockBit ransomware gang" resolves to LockBit
+var descriptorWords = map[string]bool{
+	"ransomware": true,
+	"group":      true,
+	"gang":       true,
+	"operation":  true,
+	"operators":  true,
+	"actor":      true,
+	"actors":     true,
+}
+
+// Entry is one actor in the dictionary: its key and every name it is known by
+// LookupOnly names are common words; they resolve reported names but are not matched in text
+type Entry struct {
+	Key        string
+	Names      []string
+	LookupOnly []string
+}
+
+// name is a normalized dictionary name and the actor it belongs to
+type name struct {
+	key   string
+	value string
+}
+
+// Matcher finds dictionary names in text
+type Matcher struct {
+	names  []name
+	lookup map[string]string
+}
+
+// NewMatcher builds a matcher over the dictionary entries
+// A name shared by two actors belongs to the first entry listing it
+func NewMatcher(entries []Entry) *Matcher {
+	m := &Matcher{lookup: make(map[string]string)}
+
+	for _, entry := range entries {
+		for _, raw := range entry.Names {
+			value := Normalize(raw)
+			if len(value) < MinNameLength {
+				continue
+			}
+			if _, exists := m.lookup[value]; exists {
+				continue
+			}
+			m.lookup[value] = entry.Key
+			m.names = append(m.names, name{key: entry.Key, value: value})
+		}
+
+		for _, raw := range entry.LookupOnly {
+			if value := Normalize(raw); value != "" {
+				if _, exists := m.lookup[value]; !exists {
+					m.lookup[value] = entry.Key
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// Find returns the keys of the actors mentioned in the text, in order of first mention
+// Names match whole words, ignoring case and runs of whitespace
+func (m *Matcher) Find(text string) []string {
+	text = Normalize(text)
+	first := make(map[string]int)
+
+	for _, n := range m.names {
+		for offset := 0; offset < len(text); {
+			i := strings.Index(text[offset:], n.value)
+			if i < 0 {
+				break
+			}
+			start := offset + i
+			end := start + len(n.value)
+
+			if isBoundary(text, start, end) {
+				if pos, seen := first[n.key]; !seen || start < pos {
+					first[n.key] = start
+				}
+				break
+			}
+			offset = start + 1
+		}
+	}
+
+	keys := make([]string, 0, len(first))
+	for key := range first {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if first[keys[i]] != first[keys[j]] {
+			return first[keys[i]] < first[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// Lookup resolves a reported actor name, such as one named by the AI enricher, to a dictionary key
+// Leading "the" and trailing descriptors such as "ransomware" or "group" are ignored
+func (m *Matcher) Lookup(reported string) (string, bool) {
+	value := Normalize(reported)
+	if key, ok := m.lookup[value]; ok {
+		return key, true
+	}
+
+	value = Trim(value)
+	key, ok := m.lookup[value]
+	return key, ok
+}
+
+// Normalize lowercases a name or text and collapses whitespace to single spaces
+func Normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// Trim removes a leading "the" and trailing descriptor words from a normalized name
+func Trim(value string) string {
+	words := strings.Fields(value)
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+	for len(words) > 1 && descriptorWords[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// isBoundary reports whether text[start:end] is a whole word: not preceded or followed by a letter or digit
+func isBoundary(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if isWordRune(r) {
+			return false
+		}
+	}
+
+	if end < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[end:])
+		if isWordRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
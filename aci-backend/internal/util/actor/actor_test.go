@@ -0,0 +1,56 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testEntries = []Entry{
+	{Key: "lockbit", Names: []string{"LockBit", "LockBit 3.0", "LockBit Black"}},
+	{Key: "apt28", Names: []string{"APT28", "Fancy Bear", "Forest Blizzard"}},
+	{Key: "play", Names: []string{"Play ransomware", "PlayCrypt"}, LookupOnly: []string{"Play"}},
+	{Key: "scattered-spider", Names: []string{"Scattered Spider", "UNC3944", "LockBit"}},
+	{Key: "short", Names: []string{"TA"}},
+}
+
+func TestFind(t *testing.T) {
+	m := NewMatcher(testEntries)
+
+	text := `<p>Researchers tied the intrusion to FANCY   BEAR, also tracked as Forest Blizzard.
+Separately, a LockBit affiliate and the Play ransomware crew hit the same sector.</p>`
+
+	assert.Equal(t, []string{"apt28", "lockbit", "play"}, m.Find(text))
+}
+
+func TestFind_WholeWordsOnly(t *testing.T) {
+	m := NewMatcher(testEntries)
+
+	assert.Empty(t, m.Find("LockBits of data, APT288 and a game of play."))
+	assert.Empty(t, m.Find("TA is shorter than the minimum name length"))
+	assert.Equal(t, []string{"lockbit"}, m.Find("LockBit's leak site (lockbit) went offline"),
+		"a name shared by two actors belongs to the first entry")
+}
+
+func TestLookup(t *testing.T) {
+	m := NewMatcher(testEntries)
+
+	key, ok := m.Lookup("  the LockBit ransomware gang ")
+	assert.True(t, ok)
+	assert.Equal(t, "lockbit", key)
+
+	key, ok = m.Lookup("Play Ransomware")
+	assert.True(t, ok)
+	assert.Equal(t, "play", key, "descriptors are kept when they are part of the name")
+
+	key, ok = m.Lookup("Play")
+	assert.True(t, ok)
+	assert.Equal(t, "play", key, "lookup-only names resolve reported names")
+	_, ok = m.Lookup("Unknown Group")
+	assert.False(t, ok)
+}
+
+func TestTrim(t *testing.T) {
+	assert.Equal(t, "black basta", Trim("the black basta ransomware group"))
+	assert.Equal(t, "ransomware", Trim("ransomware"))
+}
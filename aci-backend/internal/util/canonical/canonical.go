@@ -0,0 +1,125 @@
+// Package canonical reduces article URLs and content to keys under which true duplicates collide
+package canonical
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ampCacheSuffix is the host suffix of the Google AMP cache, which serves pages at /c/s/<host>/<path>
+const ampCacheSuffix = ".cdn.ampproject.org"
+
+var (
+	tagRegex  = regexp.MustCompile(`<[^>]*>`)
+	wordRegex = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+	// trackingParams are query parameters that identify a campaign or click, not a page
+	trackingParams = map[string]bool{
+		"fbclid":  true,
+		"gclid":   true,
+		"dclid":   true,
+		"msclkid": true,
+		"yclid":   true,
+		"mc_cid":  true,
+		"mc_eid":  true,
+		"igshid":  true,
+		"_ga":     true,
+		"_hsenc":  true,
+		"_hsmi":   true,
+		"ref_src": true,
+		"amp":     true,
+	}
+)
+
+// URL returns the canonical form of an http or https URL
+// The scheme, a leading www. and default ports are dropped, the host is lowercased, AMP cache
+// and /amp paths are unwrapped, tracking parameters and the fragment are removed, the
+// remaining query parameters are sorted and a trailing slash is trimmed. The result is a
+// comparison key such as example.com/news/story?id=1, not a fetchable URL
+func URL(rawURL string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("URL must use http or https")
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return "", fmt.Errorf("URL must have a host")
+	}
+
+	path := parsed.EscapedPath()
+
+	// https://example-com.cdn.ampproject.org/c/s/example.com/story serves example.com/story
+	if strings.HasSuffix(host, ampCacheSuffix) {
+		rest := strings.TrimPrefix(path, "/c/s/")
+		rest = strings.TrimPrefix(rest, "/c/")
+		if rest != path {
+			host, path, _ = strings.Cut(rest, "/")
+			host = strings.ToLower(host)
+			path = "/" + path
+		}
+	}
+
+	host = strings.TrimPrefix(host, "www.")
+	if port := parsed.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	path = strings.TrimSuffix(path, "/")
+	path = strings.TrimSuffix(path, "/amp")
+	path = strings.TrimSuffix(path, "/")
+
+	canonical := host + path
+
+	query := parsed.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		lower := strings.ToLower(key)
+		if trackingParams[lower] || strings.HasPrefix(lower, "utm_") {
+			continue
+		}
+		// Some publishers serve their AMP page at ?outputType=amp
+		if lower == "outputtype" && strings.EqualFold(query.Get(key), "amp") {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		params := make([]string, 0, len(keys))
+		for _, key := range keys {
+			values := query[key]
+			sort.Strings(values)
+			for _, value := range values {
+				params = append(params, url.QueryEscape(key)+"="+url.QueryEscape(value))
+			}
+		}
+		canonical += "?" + strings.Join(params, "&")
+	}
+
+	return canonical, nil
+}
+
+// ContentHash returns the hex SHA-256 of text's words, lowercased and separated by single
+// spaces, so copies differing only in markup, case, whitespace or punctuation hash the same
+// It returns an empty string when text has fewer than minWords words, which are too short
+// to tell a duplicate from a shared boilerplate paragraph
+func ContentHash(text string, minWords int) string {
+	words := wordRegex.FindAllString(strings.ToLower(tagRegex.ReplaceAllString(text, " ")), -1)
+	if len(words) == 0 || len(words) < minWords {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+package canonical
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL_CollapsesVariantsOfOnePage(t *testing.T) {
+	variants := []string{
+		"https://www.example.com/news/citrix-flaw",
+		"http://example.com/news/citrix-flaw/",
+		"https://EXAMPLE.com:443/news/citrix-flaw#comments",
+		"https://example.com/news/citrix-flaw?utm_source=twitter&utm_medium=social&fbclid=abc",
+		"https://example.com/news/citrix-flaw/amp",
+		"https://example.com/news/citrix-flaw?amp=1",
+		"https://example.com/news/citrix-flaw?outputType=amp",
+		"https://example-com.cdn.ampproject.org/c/s/example.com/news/citrix-flaw/amp",
+	}
+
+	for _, variant := range variants {
+		canonical, err := URL(variant)
+		require.NoError(t, err, variant)
+		assert.Equal(t, "example.com/news/citrix-flaw", canonical, variant)
+	}
+}
+
+func TestURL_KeepsIdentifyingQueryParameters(t *testing.T) {
+	a, err := URL("https://example.com/article?utm_campaign=x&page=2&id=7")
+	require.NoError(t, err)
+	b, err := URL("https://example.com/article?id=7&page=2")
+	require.NoError(t, err)
+	c, err := URL("https://example.com/article?id=8")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com/article?id=7&page=2", a)
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestURL_KeepsNonDefaultPortsAndPathCase(t *testing.T) {
+	canonical, err := URL("https://example.com:8443/News/Story")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com:8443/News/Story", canonical)
+}
+
+func TestURL_RejectsInvalidURLs(t *testing.T) {
+	for _, rawURL := range []string{"", "ftp://example.com/file", "/relative/path", "https://"} {
+		_, err := URL(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
+
+func TestContentHash_IgnoresMarkupCaseAndPunctuation(t *testing.T) {
+	text := "Citrix has released security updates to address a critical vulnerability in NetScaler ADC."
+	copied := "<p>CITRIX has released security updates,  to address a <b>critical</b> vulnerability in NetScaler ADC</p>"
+
+	assert.NotEmpty(t, ContentHash(text, 5))
+	assert.Equal(t, ContentHash(text, 5), ContentHash(copied, 5))
+	assert.NotEqual(t, ContentHash(text, 5), ContentHash(text+" Patch now.", 5))
+}
+
+func TestContentHash_SkipsShortText(t *testing.T) {
+	assert.Empty(t, ContentHash("Read more on our blog", 10))
+	assert.Empty(t, ContentHash("<br/>", 0))
+	assert.NotEmpty(t, ContentHash(strings.Repeat("word ", 10), 10))
+}
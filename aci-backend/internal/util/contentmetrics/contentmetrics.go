@@ -0,0 +1,187 @@
+// Package contentmetrics measures article content: word count, reading time, language and outbound links
+package contentmetrics
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	htmlsanitizer "github.com/phillipboles/aci-backend/internal/pkg/sanitizer"
+)
+
+const (
+	// WordsPerMinute is the reading speed reading times are based on
+	WordsPerMinute = 200
+
+	// MaxExternalLinks bounds the links kept for one article
+	MaxExternalLinks = 100
+
+	// minLanguageWords is the shortest text whose language is guessed
+	minLanguageWords = 20
+
+	// minLanguageHits is how many stopwords of the winning language the text must contain
+	minLanguageHits = 5
+)
+
+// Metrics are the measurements of one piece of content
+type Metrics struct {
+	WordCount          int
+	ReadingTimeMinutes int
+	Language           string   // ISO 639-1 code; empty when the text is too short or unrecognized
+	ExternalLinks      []string // absolute http(s) link targets, in order of appearance, without duplicates
+}
+
+// text extracts plain text; any policy works, since only text is kept
+var text = htmlsanitizer.New()
+
+// Analyze measures HTML content
+func Analyze(content string) Metrics {
+	words := strings.Fields(text.SanitizeText(content))
+
+	return Metrics{
+		WordCount:          len(words),
+		ReadingTimeMinutes: ReadingTime(len(words)),
+		Language:           DetectLanguage(words),
+		ExternalLinks:      ExternalLinks(content),
+	}
+}
+
+// ReadingTime estimates the minutes needed to read wordCount words; any text takes at least a minute
+func ReadingTime(wordCount int) int {
+	if wordCount <= 0 {
+		return 0
+	}
+
+	minutes := wordCount / WordsPerMinute
+	if minutes == 0 {
+		minutes = 1
+	}
+	return minutes
+}
+
+// stopwords are frequent words that are rare in the other supported languages
+var stopwords = map[string]map[string]bool{
+	"en": toSet("the", "and", "of", "to", "is", "that", "with", "for", "this", "are", "was", "have", "from", "which", "has", "been", "were", "it"),
+	"es": toSet("el", "los", "las", "del", "que", "y", "por", "una", "para", "con", "es", "se", "su", "al", "como", "más"),
+	"fr": toSet("le", "les", "des", "et", "est", "une", "du", "que", "dans", "pour", "pas", "sur", "au", "avec", "sont", "qui"),
+	"de": toSet("der", "die", "und", "das", "ist", "nicht", "ein", "eine", "mit", "den", "auf", "sich", "dem", "von", "wird", "auch"),
+	"it": toSet("il", "di", "che", "è", "della", "per", "una", "sono", "gli", "nel", "con", "non", "delle", "anche", "dei", "alla"),
+	"pt": toSet("o", "os", "do", "da", "que", "não", "uma", "para", "com", "em", "no", "na", "são", "dos", "das", "mais"),
+	"nl": toSet("de", "het", "een", "en", "van", "is", "dat", "niet", "zijn", "met", "voor", "op", "ook", "wordt", "bij", "naar"),
+}
+
+// languages are the supported languages in a fixed order
+var languages = []string{"de", "en", "es", "fr", "it", "nl", "pt"}
+
+// DetectLanguage guesses the language of words by counting stopwords
+// It returns an empty string when the text is short or no language clearly wins
+func DetectLanguage(words []string) string {
+	if len(words) < minLanguageWords {
+		return ""
+	}
+
+	hits := make(map[string]int, len(stopwords))
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		}))
+		for language, set := range stopwords {
+			if set[word] {
+				hits[language]++
+			}
+		}
+	}
+
+	best, bestHits, runnerUpHits := "", 0, 0
+	for _, language := range languages {
+		switch count := hits[language]; {
+		case count > bestHits:
+			best, bestHits, runnerUpHits = language, count, bestHits
+		case count > runnerUpHits:
+			runnerUpHits = count
+		}
+	}
+
+	if bestHits < minLanguageHits || bestHits == runnerUpHits {
+		return ""
+	}
+	return best
+}
+
+// ExternalLinks returns the absolute http(s) targets of the links in HTML content
+func ExternalLinks(content string) []string {
+	links := []string{}
+	if content == "" {
+		return links
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return links
+	}
+
+	seen := make(map[string]bool)
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if len(links) >= MaxExternalLinks {
+			return
+		}
+
+		if n.Type == html.ElementNode && n.DataAtom == atom.A {
+			for _, attr := range n.Attr {
+				if attr.Key != "href" {
+					continue
+				}
+				if link, ok := externalLink(attr.Val); ok && !seen[link] {
+					seen[link] = true
+					links = append(links, link)
+				}
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+
+	for _, n := range nodes {
+		walk(n)
+	}
+
+	return links
+}
+
+// externalLink normalizes an absolute http(s) URL, dropping the fragment
+func externalLink(raw string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", false
+	}
+
+	u.Scheme = scheme
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String(), true
+}
+
+// toSet builds a set of words
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
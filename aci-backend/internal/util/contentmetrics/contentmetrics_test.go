@@ -0,0 +1,123 @@
+package contentmetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const englishArticle = `<h2>Patch now</h2>
+<p>The vendor has released an update for a critical flaw that is being exploited in the wild.
+Attackers were seen chaining it with a second bug, and the advisory says this has been fixed
+in the latest release. See <a href="https://Example.com/advisory#details">the advisory</a> and
+<a href="https://nvd.nist.gov/vuln/detail/CVE-2026-1234">NVD</a>.</p>
+<p>Administrators should apply the patch to all exposed systems; read
+<a href="https://example.com/advisory">the advisory</a> for the list of affected versions.</p>`
+
+func TestAnalyze(t *testing.T) {
+	metrics := Analyze(englishArticle)
+
+	assert.Equal(t, 63, metrics.WordCount)
+	assert.Equal(t, 1, metrics.ReadingTimeMinutes)
+	assert.Equal(t, "en", metrics.Language)
+	assert.Equal(t, []string{
+		"https://example.com/advisory",
+		"https://nvd.nist.gov/vuln/detail/CVE-2026-1234",
+	}, metrics.ExternalLinks)
+}
+
+func TestAnalyze_Empty(t *testing.T) {
+	metrics := Analyze("")
+
+	assert.Equal(t, 0, metrics.WordCount)
+	assert.Equal(t, 0, metrics.ReadingTimeMinutes)
+	assert.Equal(t, "", metrics.Language)
+	assert.Empty(t, metrics.ExternalLinks)
+}
+
+func TestAnalyze_IgnoresScripts(t *testing.T) {
+	metrics := Analyze(`<p>two words</p><script>var a = "not counted at all";</script>`)
+	assert.Equal(t, 2, metrics.WordCount)
+}
+
+func TestReadingTime(t *testing.T) {
+	tests := []struct {
+		words int
+		want  int
+	}{
+		{0, 0},
+		{1, 1},
+		{199, 1},
+		{200, 1},
+		{450, 2},
+		{1000, 5},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ReadingTime(tt.words), "words=%d", tt.words)
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "spanish",
+			text: "El fabricante ha publicado una actualización para la vulnerabilidad que afecta a los servidores, " +
+				"y los atacantes la están usando por primera vez para robar datos del sistema con una herramienta nueva.",
+			want: "es",
+		},
+		{
+			name: "german",
+			text: "Der Hersteller hat ein Update für die Schwachstelle veröffentlicht, die auf den Servern ausgenutzt wird, " +
+				"und das Problem ist nicht neu, denn die Angreifer nutzen es auch mit einer anderen Lücke von außen.",
+			want: "de",
+		},
+		{
+			name: "french",
+			text: "Le fournisseur a publié une mise à jour pour la faille qui est exploitée dans les serveurs, " +
+				"et les attaquants qui sont actifs depuis des mois ne sont pas encore identifiés par les chercheurs.",
+			want: "fr",
+		},
+		{
+			name: "too short",
+			text: "The patch is out",
+			want: "",
+		},
+		{
+			name: "no stopwords",
+			text: strings.Repeat("CVE-2026-1234 ", 30),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectLanguage(strings.Fields(tt.text)))
+		})
+	}
+}
+
+func TestExternalLinks(t *testing.T) {
+	links := ExternalLinks(`<a href="/about">About</a>
+		<a href="mailto:security@example.com">Mail</a>
+		<a href="javascript:alert(1)">x</a>
+		<a href="HTTP://EXAMPLE.com/a">A</a>
+		<a href="http://example.com/a#top">A again</a>
+		<a>no href</a>`)
+
+	assert.Equal(t, []string{"http://example.com/a"}, links)
+}
+
+func TestExternalLinks_Limit(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < MaxExternalLinks+10; i++ {
+		b.WriteString(`<a href="https://example.com/` + strings.Repeat("x", i+1) + `">link</a>`)
+	}
+
+	assert.Len(t, ExternalLinks(b.String()), MaxExternalLinks)
+}
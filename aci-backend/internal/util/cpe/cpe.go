@@ -0,0 +1,234 @@
+// Package cpe parses CPE names and decides whether a product version falls in an NVD affected range
+package cpe
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Name is the part of a CPE name that identifies a product release
+// Version is empty when the name leaves it open ("*") or not applicable ("-")
+type Name struct {
+	Part    string // a (application), o (operating system) or h (hardware)
+	Vendor  string
+	Product string
+	Version string
+}
+
+// Parse reads a CPE 2.3 formatted string (cpe:2.3:a:vendor:product:version:...) or a
+// CPE 2.2 URI (cpe:/a:vendor:product:version), returning its vendor and product normalized
+func Parse(s string) (Name, error) {
+	s = strings.TrimSpace(s)
+
+	var fields []string
+	switch {
+	case strings.HasPrefix(strings.ToLower(s), "cpe:2.3:"):
+		fields = splitFormatted(s[len("cpe:2.3:"):])
+	case strings.HasPrefix(strings.ToLower(s), "cpe:/"):
+		fields = strings.Split(s[len("cpe:/"):], ":")
+	default:
+		return Name{}, fmt.Errorf("not a CPE name: %q", s)
+	}
+
+	if len(fields) < 3 {
+		return Name{}, fmt.Errorf("CPE name has no vendor and product: %q", s)
+	}
+
+	name := Name{
+		Part:    strings.ToLower(fields[0]),
+		Vendor:  Normalize(unescape(fields[1])),
+		Product: Normalize(unescape(fields[2])),
+	}
+	if len(fields) > 3 {
+		name.Version = openVersion(unescape(fields[3]))
+	}
+
+	switch name.Part {
+	case "a", "o", "h":
+	default:
+		return Name{}, fmt.Errorf("CPE name has unknown part %q", name.Part)
+	}
+
+	if name.Vendor == "" || name.Product == "" || name.Vendor == "*" || name.Product == "*" {
+		return Name{}, fmt.Errorf("CPE name has no vendor and product: %q", s)
+	}
+
+	return name, nil
+}
+
+// Normalize lowercases a vendor or product name and joins its words with underscores, as CPE
+// dictionary names are written, so "Apache Tomcat" and "apache_tomcat" compare equal
+func Normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.TrimSpace(s))), "_")
+}
+
+// Range is a set of versions of one product, as listed in an NVD configuration
+// Version holds a single affected version; empty means the bounds alone decide, and with no
+// bounds every version is affected
+type Range struct {
+	Version        string
+	StartIncluding string
+	StartExcluding string
+	EndIncluding   string
+	EndExcluding   string
+}
+
+// Contains reports whether the version falls in the range
+// An unknown (empty) version is treated as affected, since it cannot be ruled out
+func (r Range) Contains(version string) bool {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return true
+	}
+
+	if exact := openVersion(r.Version); exact != "" {
+		return CompareVersions(version, exact) == 0
+	}
+
+	if r.StartIncluding != "" && CompareVersions(version, r.StartIncluding) < 0 {
+		return false
+	}
+	if r.StartExcluding != "" && CompareVersions(version, r.StartExcluding) <= 0 {
+		return false
+	}
+	if r.EndIncluding != "" && CompareVersions(version, r.EndIncluding) > 0 {
+		return false
+	}
+	if r.EndExcluding != "" && CompareVersions(version, r.EndExcluding) >= 0 {
+		return false
+	}
+
+	return true
+}
+
+// CompareVersions orders two version strings, returning -1, 0 or 1
+// Versions are split into runs of digits and of letters; digit runs compare numerically, letter
+// runs alphabetically, and a version that is a prefix of another sorts first (2.1 < 2.1.1).
+// Separators are ignored, so 7.2-4 equals 7.2.4
+func CompareVersions(a, b string) int {
+	as, bs := versionSegments(a), versionSegments(b)
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareSegment(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// versionSegments splits a version into lowercase runs of digits and letters
+func versionSegments(version string) []string {
+	segments := make([]string, 0, 4)
+	var current strings.Builder
+	currentDigit := false
+
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range strings.ToLower(version) {
+		isDigit := unicode.IsDigit(r)
+		if !isDigit && !unicode.IsLetter(r) {
+			flush()
+			continue
+		}
+		if current.Len() > 0 && isDigit != currentDigit {
+			flush()
+		}
+		currentDigit = isDigit
+		current.WriteRune(r)
+	}
+	flush()
+
+	return segments
+}
+
+// compareSegment compares two version segments; a numeric segment sorts after a letter one,
+// so 2.0 is newer than 2.rc1
+func compareSegment(a, b string) int {
+	aDigit, bDigit := unicode.IsDigit(rune(a[0])), unicode.IsDigit(rune(b[0]))
+
+	switch {
+	case aDigit && bDigit:
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	case aDigit:
+		return 1
+	case bDigit:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// openVersion returns the version, or empty when it is "*" (any) or "-" (not applicable)
+func openVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "*" || version == "-" {
+		return ""
+	}
+	return version
+}
+
+// splitFormatted splits the fields of a CPE 2.3 formatted string on colons not escaped by a backslash
+func splitFormatted(s string) []string {
+	fields := make([]string, 0, 11)
+	var current strings.Builder
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune('\\')
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ':':
+			fields = append(fields, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	fields = append(fields, current.String())
+
+	return fields
+}
+
+// unescape removes the backslashes CPE 2.3 puts before punctuation
+func unescape(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	var out strings.Builder
+	escaped := false
+	for _, r := range s {
+		if r == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		out.WriteRune(r)
+	}
+	return out.String()
+}
@@ -0,0 +1,89 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Name
+	}{
+		{"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", Name{Part: "a", Vendor: "apache", Product: "log4j", Version: "2.14.1"}},
+		{"cpe:2.3:o:fortinet:fortios:*:*:*:*:*:*:*:*", Name{Part: "o", Vendor: "fortinet", Product: "fortios"}},
+		{"cpe:2.3:a:microsoft:exchange_server:2019:cumulative_update_12:*:*:*:*:*:*", Name{Part: "a", Vendor: "microsoft", Product: "exchange_server", Version: "2019"}},
+		{"cpe:2.3:a:vendor:product\\:plus:1.0:*:*:*:*:*:*:*", Name{Part: "a", Vendor: "vendor", Product: "product:plus", Version: "1.0"}},
+		{"cpe:/a:openbsd:openssh:9.3", Name{Part: "a", Vendor: "openbsd", Product: "openssh", Version: "9.3"}},
+		{"CPE:2.3:A:Ivanti:Connect_Secure:-", Name{Part: "a", Vendor: "ivanti", Product: "connect_secure"}},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+}
+
+func TestParse_Rejects(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"apache log4j",
+		"cpe:2.3:a:apache",
+		"cpe:2.3:x:apache:log4j:1.0",
+		"cpe:2.3:a:*:*:1.0",
+	} {
+		_, err := Parse(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "apache_tomcat", Normalize("  Apache   Tomcat "))
+	assert.Equal(t, "fortios", Normalize("FortiOS"))
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.14.1", "2.14.1", 0},
+		{"2.9", "2.10", -1},
+		{"2.10", "2.9", 1},
+		{"2.1", "2.1.1", -1},
+		{"7.2-4", "7.2.4", 0},
+		{"1.0.2k", "1.0.2l", -1},
+		{"1.0.2", "1.0.2a", -1},
+		{"2.0rc1", "2.0.1", -1},
+		{"010", "10", 0},
+		{"21H2", "22h2", -1},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	log4shell := Range{StartIncluding: "2.0.1", EndExcluding: "2.15.0"}
+	assert.True(t, log4shell.Contains("2.14.1"))
+	assert.True(t, log4shell.Contains("2.0.1"))
+	assert.False(t, log4shell.Contains("2.15.0"))
+	assert.False(t, log4shell.Contains("1.2.17"))
+	assert.True(t, log4shell.Contains(""), "an unknown version cannot be ruled out")
+
+	through := Range{StartExcluding: "7.0", EndIncluding: "7.2.4"}
+	assert.False(t, through.Contains("7.0"))
+	assert.True(t, through.Contains("7.2.4"))
+	assert.False(t, through.Contains("7.2.5"))
+
+	exact := Range{Version: "9.3"}
+	assert.True(t, exact.Contains("9.3"))
+	assert.False(t, exact.Contains("9.3.1"))
+
+	assert.True(t, Range{Version: "*"}.Contains("1.0"), "an open version covers every release")
+	assert.True(t, Range{}.Contains("1.0"))
+}
@@ -0,0 +1,204 @@
+// Package ioc extracts indicators of compromise from article text without relying on AI
+package ioc
+
+import (
+	"html"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Indicator types, matching domain.IOC types
+const (
+	TypeIP     = "ip"
+	TypeDomain = "domain"
+	TypeHash   = "hash"
+	TypeURL    = "url"
+	TypeEmail  = "email"
+)
+
+// MaxIndicators caps how many indicators are extracted from a single text
+const MaxIndicators = 200
+
+// Indicator is a validated, refanged indicator of compromise
+type Indicator struct {
+	Type    string
+	Value   string
+	Context string // hash algorithm for hashes
+}
+
+var (
+	tagRegex = regexp.MustCompile(`<[^>]*>`)
+
+	// Defanging conventions, applied in order
+	defangReplacer = []struct {
+		pattern *regexp.Regexp
+		with    string
+	}{
+		{regexp.MustCompile(`(?i)\bhxxp(s?)\b`), "http$1"},
+		{regexp.MustCompile(`(?i)\bfxp\b`), "ftp"},
+		{regexp.MustCompile(`\[://\]`), "://"},
+		{regexp.MustCompile(`\[:\]`), ":"},
+		{regexp.MustCompile(`\[/\]`), "/"},
+		{regexp.MustCompile(`(?i)\s?[\[\(\{](?:\.|dot)[\]\)\}]\s?`), "."},
+		{regexp.MustCompile(`(?i)\s?[\[\(\{](?:@|at)[\]\)\}]\s?`), "@"},
+	}
+
+	urlRegex    = regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"'\x60]+`)
+	emailRegex  = regexp.MustCompile(`(?i)\b[a-z0-9._%+\-]+@(?:[a-z0-9](?:[a-z0-9\-]{0,61}[a-z0-9])?\.)+[a-z]{2,24}\b`)
+	ipv4Regex   = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	hashRegex   = regexp.MustCompile(`\b(?:[a-fA-F0-9]{64}|[a-fA-F0-9]{40}|[a-fA-F0-9]{32})\b`)
+	domainRegex = regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9\-]{0,61}[a-z0-9])?\.)+[a-z]{2,24}\b`)
+	hexRegex    = regexp.MustCompile(`^[a-f0-9]+$`)
+
+	hashAlgorithms = map[int]string{32: "md5", 40: "sha1", 64: "sha256"}
+
+	// knownTLDs limits domain extraction to real TLDs so file names such as
+	// payload.exe or config.json are not reported. TLDs that double as common
+	// file extensions (zip, mov, sh, py) are left out deliberately
+	knownTLDs = toSet(
+		"com", "net", "org", "info", "biz", "io", "co", "me", "us", "uk", "ru", "cn", "de", "fr", "nl",
+		"eu", "jp", "kr", "in", "br", "au", "ca", "it", "es", "pl", "ua", "ir", "kp", "tw", "hk", "sg",
+		"top", "xyz", "online", "site", "club", "shop", "live", "app", "dev", "cloud", "tk", "ml", "ga",
+		"cf", "gq", "pw", "cc", "ws", "su", "onion", "gov", "edu", "mil", "int", "tech", "store", "link",
+		"icu", "vip", "work", "name", "pro", "mobi", "space", "website", "fun", "ch", "se", "no",
+	)
+)
+
+// Refang reverses common defanging (hxxp, [.], (dot), [@]) so indicators can be matched
+func Refang(text string) string {
+	for _, r := range defangReplacer {
+		text = r.pattern.ReplaceAllString(text, r.with)
+	}
+	return text
+}
+
+// Extract returns the validated indicators found in text, in order of first appearance
+// HTML is ignored and defanged indicators are refanged. Domains and IPs that appear only
+// as part of an extracted URL or email address are not reported separately
+func Extract(text string) []Indicator {
+	text = Refang(html.UnescapeString(tagRegex.ReplaceAllString(text, " ")))
+
+	indicators := make([]Indicator, 0)
+	seen := make(map[string]bool)
+	add := func(typ, value string) {
+		if len(indicators) >= MaxIndicators {
+			return
+		}
+		indicator, ok := Normalize(typ, value)
+		if !ok || seen[Key(indicator.Type, indicator.Value)] {
+			return
+		}
+		seen[Key(indicator.Type, indicator.Value)] = true
+		indicators = append(indicators, indicator)
+	}
+
+	for _, match := range urlRegex.FindAllString(text, -1) {
+		add(TypeURL, match)
+	}
+	for _, match := range emailRegex.FindAllString(text, -1) {
+		add(TypeEmail, match)
+	}
+	for _, match := range hashRegex.FindAllString(text, -1) {
+		add(TypeHash, match)
+	}
+
+	// Hosts inside URLs and emails are already covered by those indicators
+	remaining := emailRegex.ReplaceAllString(urlRegex.ReplaceAllString(text, " "), " ")
+
+	for _, match := range ipv4Regex.FindAllString(remaining, -1) {
+		add(TypeIP, match)
+	}
+	for _, match := range domainRegex.FindAllString(remaining, -1) {
+		add(TypeDomain, match)
+	}
+
+	return indicators
+}
+
+// Normalize refangs and validates a single indicator, returning it in canonical form
+// Domains, emails and hashes are lowercased; URLs keep their path but lowercase scheme and host
+func Normalize(typ, value string) (Indicator, bool) {
+	typ = strings.ToLower(strings.TrimSpace(typ))
+	value = strings.TrimSpace(Refang(value))
+
+	switch typ {
+	case TypeIP:
+		if !isPublicIP(value) {
+			return Indicator{}, false
+		}
+		return Indicator{Type: TypeIP, Value: net.ParseIP(value).String()}, true
+
+	case TypeDomain:
+		value = strings.TrimSuffix(strings.ToLower(value), ".")
+		if !isDomain(value) {
+			return Indicator{}, false
+		}
+		return Indicator{Type: TypeDomain, Value: value}, true
+
+	case TypeHash:
+		value = strings.ToLower(value)
+		algorithm, ok := hashAlgorithms[len(value)]
+		if !ok || !hexRegex.MatchString(value) {
+			return Indicator{}, false
+		}
+		return Indicator{Type: TypeHash, Value: value, Context: algorithm}, true
+
+	case TypeURL:
+		value = strings.TrimRight(value, ".,;:!?)]}'\"")
+		parsed, err := url.Parse(value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "ftp") {
+			return Indicator{}, false
+		}
+		host := strings.ToLower(parsed.Hostname())
+		if !isDomain(host) && net.ParseIP(host) == nil {
+			return Indicator{}, false
+		}
+		parsed.Scheme = strings.ToLower(parsed.Scheme)
+		parsed.Host = strings.ToLower(parsed.Host)
+		return Indicator{Type: TypeURL, Value: parsed.String()}, true
+
+	case TypeEmail:
+		value = strings.ToLower(value)
+		at := strings.LastIndex(value, "@")
+		if at <= 0 || !isDomain(value[at+1:]) {
+			return Indicator{}, false
+		}
+		return Indicator{Type: TypeEmail, Value: value}, true
+	}
+
+	return Indicator{}, false
+}
+
+// Key returns the deduplication key for an indicator of the given type and normalized value
+func Key(typ, value string) string {
+	return typ + ":" + value
+}
+
+// isDomain reports whether s is a hostname under a known TLD
+func isDomain(s string) bool {
+	if len(s) > 253 || !domainRegex.MatchString(s) || domainRegex.FindString(s) != s {
+		return false
+	}
+	return knownTLDs[s[strings.LastIndex(s, ".")+1:]]
+}
+
+// isPublicIP reports whether s is an IPv4 or IPv6 address worth reporting
+// Private, loopback, link-local, multicast and unspecified addresses are excluded
+func isPublicIP(s string) bool {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return false
+	}
+	return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+func toSet(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
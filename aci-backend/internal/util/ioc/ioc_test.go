@@ -0,0 +1,53 @@
+package ioc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefang(t *testing.T) {
+	assert.Equal(t, "https://evil.example.com/payload", Refang("hxxps[://]evil[.]example(.)com/payload"))
+	assert.Equal(t, "ops@evil.ru", Refang("ops[@]evil[dot]ru"))
+	assert.Equal(t, "http://203.0.113.7:8080", Refang("HXXP://203[.]0[.]113[.]7[:]8080"))
+}
+
+func TestExtract(t *testing.T) {
+	text := `<p>The loader beacons to hxxp://update-check[.]com/gate.php and 198[.]51[.]100[.]23.
+Stage two is hosted on cdn-files[.]xyz. Phishing mail came from billing[@]invoices-portal[.]net.
+Dropper SHA-256: 3F79BB7B435B05321651DAEFD374CDC681DC06FAA65E374E38337B88CA046DEA, internal host 10.0.0.5.</p>`
+
+	assert.Equal(t, []Indicator{
+		{Type: TypeURL, Value: "http://update-check.com/gate.php"},
+		{Type: TypeEmail, Value: "billing@invoices-portal.net"},
+		{Type: TypeHash, Value: "3f79bb7b435b05321651daefd374cdc681dc06faa65e374e38337b88ca046dea", Context: "sha256"},
+		{Type: TypeIP, Value: "198.51.100.23"},
+		{Type: TypeDomain, Value: "cdn-files.xyz"},
+	}, Extract(text))
+}
+
+func TestExtract_IgnoresFileNamesAndDuplicates(t *testing.T) {
+	text := "The payload.exe reads config.json. Contact evil.com, EVIL.com or evil[.]com."
+
+	assert.Equal(t, []Indicator{{Type: TypeDomain, Value: "evil.com"}}, Extract(text))
+	assert.Empty(t, Extract("Patch to version 10.2.1.4 is not an address? 999.1.1.1 is invalid."), "999.x is not a valid ip")
+}
+
+func TestNormalize(t *testing.T) {
+	indicator, ok := Normalize("URL", "HXXPS://Evil[.]COM/Path?q=1).")
+	assert.True(t, ok)
+	assert.Equal(t, Indicator{Type: TypeURL, Value: "https://evil.com/Path?q=1"}, indicator)
+
+	indicator, ok = Normalize("hash", "D41D8CD98F00B204E9800998ECF8427E")
+	assert.True(t, ok)
+	assert.Equal(t, "md5", indicator.Context)
+
+	_, ok = Normalize("hash", "not-a-hash")
+	assert.False(t, ok)
+	_, ok = Normalize("ip", "192.168.1.10")
+	assert.False(t, ok, "private addresses are not indicators")
+	_, ok = Normalize("domain", "localhost")
+	assert.False(t, ok)
+	_, ok = Normalize("registry_key", "HKLM\\Run")
+	assert.False(t, ok)
+}
@@ -0,0 +1,37 @@
+// Package markdown renders CommonMark with GitHub tables, strikethrough and autolinks to HTML
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// MaxSourceLength bounds the markdown accepted for rendering
+const MaxSourceLength = 1 << 20
+
+// renderer leaves raw HTML in the source out of the output; callers still sanitize the
+// result, since links and images may carry any URL the author wrote
+var renderer = goldmark.New(
+	goldmark.WithExtensions(
+		extension.Table,
+		extension.Strikethrough,
+		extension.Linkify,
+	),
+)
+
+// ToHTML renders markdown to HTML
+func ToHTML(source string) (string, error) {
+	if len(source) > MaxSourceLength {
+		return "", fmt.Errorf("markdown cannot exceed %d bytes", MaxSourceLength)
+	}
+
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	return buf.String(), nil
+}
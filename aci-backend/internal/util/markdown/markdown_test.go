@@ -0,0 +1,72 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+	}{
+		{
+			name:     "headings and emphasis",
+			source:   "# Patch now\n\nA **critical** flaw in *NetScaler*.",
+			contains: []string{"<h1>Patch now</h1>", "<strong>critical</strong>", "<em>NetScaler</em>"},
+		},
+		{
+			name:     "links",
+			source:   "See [the advisory](https://example.com/advisory).",
+			contains: []string{`<a href="https://example.com/advisory">the advisory</a>`},
+		},
+		{
+			name:     "autolinks",
+			source:   "Details at https://example.com/cve",
+			contains: []string{`<a href="https://example.com/cve">https://example.com/cve</a>`},
+		},
+		{
+			name:     "tables",
+			source:   "| CVE | CVSS |\n|-----|------|\n| CVE-2026-1234 | 9.8 |",
+			contains: []string{"<table>", "<th>CVE</th>", "<td>CVE-2026-1234</td>"},
+		},
+		{
+			name:     "strikethrough",
+			source:   "~~no patch~~ patched",
+			contains: []string{"<del>no patch</del>"},
+		},
+		{
+			name:     "code blocks are escaped",
+			source:   "```\n<script>alert(1)</script>\n```",
+			contains: []string{"&lt;script&gt;alert(1)&lt;/script&gt;"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToHTML(tt.source)
+			require.NoError(t, err)
+			for _, want := range tt.contains {
+				assert.Contains(t, got, want)
+			}
+		})
+	}
+}
+
+func TestToHTML_OmitsRawHTML(t *testing.T) {
+	got, err := ToHTML("Hello <script>alert(1)</script>\n\n<div onclick=\"x()\">block</div>")
+	require.NoError(t, err)
+
+	assert.NotContains(t, got, "<script>")
+	assert.NotContains(t, got, "onclick")
+	assert.Contains(t, got, "Hello")
+}
+
+func TestToHTML_TooLong(t *testing.T) {
+	_, err := ToHTML(strings.Repeat("a", MaxSourceLength+1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,424 @@
+// Package pdf lays out simple text documents - headings, paragraphs, tables and bar charts -
+// on A4 pages and writes them as PDF
+// Only the standard Helvetica fonts are used, so nothing is embedded and text is limited to
+// the Windows-1252 character set; other characters are written as "?"
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Page geometry in points (1/72 inch)
+const (
+	pageWidth    = 595.28
+	pageHeight   = 841.89
+	margin       = 50.0
+	footerHeight = 24.0
+	contentWidth = pageWidth - 2*margin
+)
+
+// Font sizes and the spacing between lines of each
+const (
+	titleSize     = 20.0
+	headingSize   = 13.0
+	paragraphSize = 10.0
+	tableSize     = 9.0
+	footerSize    = 8.0
+	lineSpacing   = 1.35
+)
+
+// Color is an RGB color with components from 0 to 1
+type Color struct {
+	R, G, B float64
+}
+
+// Colors used for text and rules
+var (
+	Black     = Color{0, 0, 0}
+	Gray      = Color{0.45, 0.45, 0.45}
+	LightGray = Color{0.85, 0.85, 0.85}
+)
+
+// Column is a table column; Width is its share of the page width, and the shares of a
+// table's columns should add up to 1
+type Column struct {
+	Header string
+	Width  float64
+}
+
+// Bar is one bar of a horizontal bar chart
+type Bar struct {
+	Label string
+	Value int
+	Color Color
+}
+
+// Document is a PDF being laid out, top to bottom; content that does not fit on the
+// current page starts a new one
+type Document struct {
+	title string
+	pages []*bytes.Buffer
+	y     float64 // baseline of the next line on the current page, from the bottom
+}
+
+// New creates an empty document; title is its metadata title and appears in every page footer
+func New(title string) *Document {
+	d := &Document{title: title}
+	d.newPage()
+	return d
+}
+
+// Title adds the document title in large bold type
+func (d *Document) Title(text string) {
+	d.textBlock(text, titleSize, true, Black, 0)
+	d.y -= 4
+}
+
+// Heading adds a section heading, moving it to the next page if nothing would fit under it
+func (d *Document) Heading(text string) {
+	d.y -= 10
+	d.ensure(headingSize*lineSpacing + 3*paragraphSize*lineSpacing)
+	d.textBlock(text, headingSize, true, Black, 0)
+	d.y -= 2
+}
+
+// Paragraph adds body text, wrapped to the page width
+func (d *Document) Paragraph(text string) {
+	d.textBlock(text, paragraphSize, false, Black, 0)
+	d.y -= 4
+}
+
+// Note adds small gray text, such as a caption or an empty-section placeholder
+func (d *Document) Note(text string) {
+	d.textBlock(text, tableSize, false, Gray, 0)
+	d.y -= 4
+}
+
+// Table adds a table with a bold header row, repeated at the top of each page it spans
+// Cells too wide for their column are cut short with "..."
+func (d *Document) Table(columns []Column, rows [][]string) {
+	rowHeight := tableSize * 1.9
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Header
+	}
+
+	d.ensure(2 * rowHeight)
+	d.tableRow(columns, header, true, rowHeight)
+
+	for _, row := range rows {
+		if d.y-rowHeight < margin+footerHeight {
+			d.newPage()
+			d.tableRow(columns, header, true, rowHeight)
+		}
+		d.tableRow(columns, row, false, rowHeight)
+	}
+
+	d.y -= 6
+}
+
+// Bars adds a horizontal bar chart, scaled to the largest value, with each value after its bar
+func (d *Document) Bars(bars []Bar) {
+	labelWidth := contentWidth * 0.22
+	valueWidth := contentWidth * 0.1
+	trackWidth := contentWidth - labelWidth - valueWidth
+	rowHeight := paragraphSize * 2
+
+	max := 0
+	for _, bar := range bars {
+		if bar.Value > max {
+			max = bar.Value
+		}
+	}
+
+	for _, bar := range bars {
+		d.ensure(rowHeight)
+		baseline := d.y - paragraphSize
+
+		d.text(margin, baseline, fit(bar.Label, paragraphSize, false, labelWidth-6), paragraphSize, false, Black)
+
+		width := 0.0
+		if max > 0 {
+			width = trackWidth * float64(bar.Value) / float64(max)
+		}
+		if width > 0 {
+			fmt.Fprintf(d.page(), "%s rg %.2f %.2f %.2f %.2f re f\n",
+				colorOperands(bar.Color), margin+labelWidth, baseline-2, width, paragraphSize+2)
+		}
+
+		d.text(margin+labelWidth+width+6, baseline, fmt.Sprint(bar.Value), paragraphSize, false, Black)
+		d.y -= rowHeight
+	}
+
+	d.y -= 6
+}
+
+// WriteTo writes the document as a PDF file
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	offsets := []int{0} // object 0 is the head of the free list
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets)-1, body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1-5 are fixed; each page is then a page object followed by its content stream
+	const firstPage = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (ACI) >>", escape(encode(d.title))))
+
+	for i, page := range d.pages {
+		content := bytes.NewBuffer(append([]byte(nil), page.Bytes()...))
+		d.footer(content, i+1, len(d.pages))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(content.Bytes()); err != nil {
+			return 0, fmt.Errorf("failed to compress page: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return 0, fmt.Errorf("failed to compress page: %w", err)
+		}
+
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, firstPage+2*i+1,
+		))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets))
+	for _, offset := range offsets[1:] {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets), xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+// newPage starts a new page and moves to its top
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pageHeight - margin
+}
+
+// page returns the content of the current page
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// ensure starts a new page unless height fits above the footer of the current one
+func (d *Document) ensure(height float64) {
+	if d.y-height < margin+footerHeight {
+		d.newPage()
+	}
+}
+
+// textBlock writes text wrapped to the page width, indented by indent
+func (d *Document) textBlock(text string, size float64, bold bool, color Color, indent float64) {
+	leading := size * lineSpacing
+	for _, line := range wrap(text, size, bold, contentWidth-indent) {
+		d.ensure(leading)
+		d.text(margin+indent, d.y-size, line, size, bold, color)
+		d.y -= leading
+	}
+}
+
+// tableRow writes one row of a table with a rule under it
+func (d *Document) tableRow(columns []Column, cells []string, bold bool, height float64) {
+	baseline := d.y - tableSize - (height-tableSize)/2 + 2
+
+	x := margin
+	for i, column := range columns {
+		width := contentWidth * column.Width
+		if i < len(cells) {
+			d.text(x, baseline, fit(cells[i], tableSize, bold, width-6), tableSize, bold, Black)
+		}
+		x += width
+	}
+
+	rule := LightGray
+	if bold {
+		rule = Gray
+	}
+	fmt.Fprintf(d.page(), "%s RG 0.5 w %.2f %.2f m %.2f %.2f l S\n",
+		colorOperands(rule), margin, d.y-height, margin+contentWidth, d.y-height)
+
+	d.y -= height
+}
+
+// footer writes the document title and page number at the bottom of a page
+func (d *Document) footer(content *bytes.Buffer, number, total int) {
+	y := margin - footerSize
+	writeText(content, margin, y, fit(d.title, footerSize, false, contentWidth*0.7), footerSize, false, Gray)
+
+	label := fmt.Sprintf("Page %d of %d", number, total)
+	writeText(content, margin+contentWidth-width(label, footerSize, false), y, label, footerSize, false, Gray)
+}
+
+// text writes one line of text with its baseline at (x, y)
+func (d *Document) text(x, y float64, s string, size float64, bold bool, color Color) {
+	writeText(d.page(), x, y, s, size, bold, color)
+}
+
+// writeText writes the operators that draw one line of text
+func writeText(content *bytes.Buffer, x, y float64, s string, size float64, bold bool, color Color) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+
+	fmt.Fprintf(content, "BT /%s %.1f Tf %s rg %.2f %.2f Td (%s) Tj ET\n",
+		font, size, colorOperands(color), x, y, escape(encode(s)))
+}
+
+// colorOperands formats a color as the operands of rg and RG
+func colorOperands(c Color) string {
+	return fmt.Sprintf("%.3f %.3f %.3f", c.R, c.G, c.B)
+}
+
+// wrap breaks text into lines no wider than maxWidth, at spaces where possible
+// Line breaks in the text are kept
+func wrap(text string, size float64, bold bool, maxWidth float64) []string {
+	lines := make([]string, 0, 1)
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+
+			if width(candidate, size, bold) <= maxWidth {
+				line = candidate
+				continue
+			}
+
+			if line != "" {
+				lines = append(lines, line)
+			}
+
+			// A word wider than a whole line is broken wherever it runs out of room
+			line = ""
+			for _, r := range word {
+				if line != "" && width(line+string(r), size, bold) > maxWidth {
+					lines = append(lines, line)
+					line = ""
+				}
+				line += string(r)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// fit cuts text short with "..." so it is no wider than maxWidth
+func fit(text string, size float64, bold bool, maxWidth float64) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if width(text, size, bold) <= maxWidth {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		cut := strings.TrimRight(string(runes), " ") + "..."
+		if width(cut, size, bold) <= maxWidth {
+			return cut
+		}
+	}
+
+	return ""
+}
+
+// width is the width of text in points when set in Helvetica at size
+func width(text string, size float64, bold bool) float64 {
+	metrics := &helveticaWidths
+	if bold {
+		metrics = &helveticaBoldWidths
+	}
+
+	units := 0
+	for _, b := range encode(text) {
+		if b >= 32 && b <= 126 {
+			units += metrics[b-32]
+		} else {
+			units += defaultWidth
+		}
+	}
+
+	return float64(units) * size / 1000
+}
+
+// encode converts text to Windows-1252, the encoding of the standard fonts
+func encode(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		b, ok := charmap.Windows1252.EncodeRune(r)
+		if !ok || b < 32 {
+			b = '?'
+		}
+		encoded = append(encoded, b)
+	}
+	return encoded
+}
+
+// escape escapes the delimiters of a PDF literal string
+func escape(text []byte) []byte {
+	escaped := make([]byte, 0, len(text))
+	for _, b := range text {
+		if b == '(' || b == ')' || b == '\\' {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, b)
+	}
+	return escaped
+}
+
+// defaultWidth is used for characters outside printable ASCII, most of which are accented
+// letters close to the width of a lowercase letter
+const defaultWidth = 556
+
+// helveticaWidths are the advance widths of printable ASCII (space to tilde) in Helvetica,
+// in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// helveticaBoldWidths are the advance widths of printable ASCII in Helvetica-Bold
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,144 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// render writes the document and returns the file
+func render(t *testing.T, d *Document) []byte {
+	var buf bytes.Buffer
+	n, err := d.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	return buf.Bytes()
+}
+
+// pageContents returns the decompressed content stream of each page
+func pageContents(t *testing.T, file []byte) []string {
+	streams := regexp.MustCompile(`(?s)/FlateDecode >>\nstream\n(.*?)\nendstream`).FindAllSubmatch(file, -1)
+
+	contents := make([]string, len(streams))
+	for i, stream := range streams {
+		zr, err := zlib.NewReader(bytes.NewReader(stream[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		contents[i] = string(content)
+	}
+	return contents
+}
+
+func TestWriteTo_ProducesValidStructure(t *testing.T) {
+	d := New("Weekly Briefing")
+	d.Title("Weekly Threat Briefing")
+	d.Paragraph("Hello")
+
+	file := render(t, d)
+
+	assert.True(t, bytes.HasPrefix(file, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(file, []byte("%%EOF\n")))
+
+	// startxref points at the xref table, and each entry at its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(file)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(file[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(file[xref:], -1)
+	require.Len(t, entries, 7)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(file[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+
+	contents := pageContents(t, file)
+	require.Len(t, contents, 1)
+	assert.Contains(t, contents[0], "(Weekly Threat Briefing) Tj")
+	assert.Contains(t, contents[0], "(Page 1 of 1) Tj")
+}
+
+func TestTable_RepeatsHeaderOnEachPage(t *testing.T) {
+	d := New("Report")
+
+	rows := make([][]string, 120)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("Row %d", i), "value"}
+	}
+	d.Table([]Column{{"Name", 0.7}, {"Value", 0.3}}, rows)
+
+	contents := pageContents(t, render(t, d))
+	require.Greater(t, len(contents), 1)
+
+	for i, content := range contents {
+		assert.Contains(t, content, "/F2 9.0 Tf", "page %d has a bold header", i+1)
+		assert.Contains(t, content, fmt.Sprintf("(Page %d of %d) Tj", i+1, len(contents)))
+	}
+	assert.Contains(t, contents[len(contents)-1], "(Row 119) Tj")
+}
+
+func TestWrap_BreaksAtSpacesAndLongWords(t *testing.T) {
+	lines := wrap("the quick brown fox jumps over the lazy dog", 10, false, 80)
+	require.Greater(t, len(lines), 1)
+	for _, line := range lines {
+		assert.LessOrEqual(t, width(line, 10, false), 80.0)
+	}
+	assert.Equal(t, "the quick brown fox jumps over the lazy dog", strings.Join(lines, " "))
+
+	lines = wrap(strings.Repeat("x", 100), 10, false, 50)
+	require.Greater(t, len(lines), 1)
+	assert.Equal(t, strings.Repeat("x", 100), strings.Join(lines, ""))
+}
+
+func TestFit_TruncatesWithEllipsis(t *testing.T) {
+	assert.Equal(t, "short", fit("short", 10, false, 100))
+
+	cut := fit("A rather long article title that will not fit", 10, false, 100)
+	assert.True(t, strings.HasSuffix(cut, "..."))
+	assert.LessOrEqual(t, width(cut, 10, false), 100.0)
+}
+
+func TestWidth_UsesFontMetrics(t *testing.T) {
+	// "i" is 222 units in Helvetica and 278 in Helvetica-Bold; "W" is 944 in both
+	assert.InDelta(t, 2.22, width("i", 10, false), 0.001)
+	assert.InDelta(t, 2.78, width("i", 10, true), 0.001)
+	assert.InDelta(t, 9.44, width("W", 10, false), 0.001)
+}
+
+func TestText_EncodesAndEscapes(t *testing.T) {
+	assert.Equal(t, []byte("caf\xe9 \x80 ?"), encode("café € 漢"))
+	assert.Equal(t, []byte(`\(a\) \\ b`), escape([]byte(`(a) \ b`)))
+
+	d := New("Report")
+	d.Paragraph("Patch (now)")
+	contents := pageContents(t, render(t, d))
+	assert.Contains(t, contents[0], `(Patch \(now\)) Tj`)
+}
+
+func TestBars_ScaleToLargestValue(t *testing.T) {
+	d := New("Report")
+	d.Bars([]Bar{
+		{Label: "Critical", Value: 4, Color: Color{1, 0, 0}},
+		{Label: "High", Value: 2, Color: Color{1, 0.5, 0}},
+		{Label: "Low", Value: 0, Color: Color{0, 0, 1}},
+	})
+
+	content := pageContents(t, render(t, d))[0]
+
+	fills := regexp.MustCompile(`re f`).FindAllString(content, -1)
+	assert.Len(t, fills, 2, "zero values draw no bar")
+	assert.Contains(t, content, "1.000 0.000 0.000 rg")
+	assert.Contains(t, content, "(Critical) Tj")
+	assert.Contains(t, content, "(0) Tj")
+}
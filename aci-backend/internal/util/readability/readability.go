@@ -0,0 +1,237 @@
+// Package readability extracts the main content of an HTML page as clean HTML and plain text
+package readability
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Document is the extracted content of a page
+type Document struct {
+	Title string
+	// HTML is the main content with scripts, styles, navigation and forms removed, and
+	// attributes other than links, image sources and alt text dropped
+	HTML string
+	// Text is the main content as paragraphs separated by blank lines
+	Text string
+}
+
+// removedElements never hold article content
+var removedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Svg:      true,
+	atom.Template: true,
+	atom.Nav:      true,
+	atom.Header:   true,
+	atom.Footer:   true,
+	atom.Aside:    true,
+	atom.Form:     true,
+	atom.Button:   true,
+	atom.Input:    true,
+	atom.Select:   true,
+	atom.Textarea: true,
+}
+
+// keptAttributes are the attributes left on extracted elements
+var keptAttributes = map[string]bool{
+	"href": true,
+	"src":  true,
+	"alt":  true,
+}
+
+// blockElements start a new paragraph in the plain text
+var blockElements = map[atom.Atom]bool{
+	atom.P:          true,
+	atom.Div:        true,
+	atom.Section:    true,
+	atom.Article:    true,
+	atom.Main:       true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Ul:         true,
+	atom.Ol:         true,
+	atom.Li:         true,
+	atom.Blockquote: true,
+	atom.Pre:        true,
+	atom.Table:      true,
+	atom.Tr:         true,
+	atom.Br:         true,
+	atom.Figcaption: true,
+}
+
+// Extract parses an HTML page and returns its main content
+// The content is the page's <article> element, else its <main> element, else the element
+// whose paragraphs hold the most text, else the whole <body>
+func Extract(r io.Reader) (*Document, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html: %w", err)
+	}
+
+	doc := &Document{}
+	if title := findFirst(root, atom.Title); title != nil {
+		doc.Title = collapseSpaces(textContent(title))
+	}
+
+	clean(root)
+
+	content := findFirst(root, atom.Article)
+	if content == nil {
+		content = findFirst(root, atom.Main)
+	}
+	if content == nil {
+		content = densestElement(root)
+	}
+	if content == nil {
+		content = findFirst(root, atom.Body)
+	}
+	if content == nil {
+		content = root
+	}
+
+	var buf bytes.Buffer
+	for child := content.FirstChild; child != nil; child = child.NextSibling {
+		if err := html.Render(&buf, child); err != nil {
+			return nil, fmt.Errorf("failed to render content: %w", err)
+		}
+	}
+
+	doc.HTML = strings.TrimSpace(buf.String())
+	doc.Text = plainText(content)
+
+	return doc, nil
+}
+
+// clean removes comments and non-content elements below n and strips their attributes
+func clean(n *html.Node) {
+	child := n.FirstChild
+	for child != nil {
+		next := child.NextSibling
+
+		switch {
+		case child.Type == html.CommentNode, child.Type == html.ElementNode && removedElements[child.DataAtom]:
+			n.RemoveChild(child)
+		case child.Type == html.ElementNode:
+			attrs := child.Attr[:0]
+			for _, attr := range child.Attr {
+				if keptAttributes[attr.Key] && !strings.HasPrefix(strings.ToLower(strings.TrimSpace(attr.Val)), "javascript:") {
+					attrs = append(attrs, attr)
+				}
+			}
+			child.Attr = attrs
+			clean(child)
+		}
+
+		child = next
+	}
+}
+
+// findFirst returns the first element of the given type in document order
+func findFirst(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if found := findFirst(child, a); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// densestElement returns the element whose child paragraphs hold the most text, or nil
+// when the page has no paragraphs
+func densestElement(root *html.Node) *html.Node {
+	scores := make(map[*html.Node]int)
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.P && n.Parent != nil {
+			scores[n.Parent] += len(collapseSpaces(textContent(n)))
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	var best *html.Node
+	for n, score := range scores {
+		if score > 0 && (best == nil || score > scores[best]) {
+			best = n
+		}
+	}
+
+	return best
+}
+
+// textContent concatenates the text below n
+func textContent(n *html.Node) string {
+	var b strings.Builder
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(n)
+
+	return b.String()
+}
+
+// plainText returns the text below n with block elements as paragraphs
+func plainText(n *html.Node) string {
+	var b strings.Builder
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		block := n.Type == html.ElementNode && blockElements[n.DataAtom]
+		if block {
+			b.WriteByte('\n')
+		}
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+		if block {
+			b.WriteByte('\n')
+		}
+	}
+	walk(n)
+
+	var paragraphs []string
+	for _, line := range strings.Split(b.String(), "\n") {
+		if line = collapseSpaces(line); line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// collapseSpaces trims s and replaces runs of whitespace with a single space
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,65 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const page = `<!DOCTYPE html>
+<html>
+<head>
+	<title> Critical Flaw in NetScaler ADC | Security News </title>
+	<style>body { color: red; }</style>
+	<script>trackVisit();</script>
+</head>
+<body>
+	<nav><a href="/">Home</a> <a href="/news">News</a></nav>
+	<article class="post" onclick="track()">
+		<h1>Critical Flaw in NetScaler ADC</h1>
+		<p>Citrix has released security updates for a <a href="https://example.com/cve" data-id="1">critical vulnerability</a>.</p>
+		<!-- ad slot -->
+		<p>Exploitation has been observed   in the wild.</p>
+		<form><input name="email"><button>Subscribe</button></form>
+	</article>
+	<footer>Copyright 2026</footer>
+</body>
+</html>`
+
+func TestExtract_UsesArticleElement(t *testing.T) {
+	doc, err := Extract(strings.NewReader(page))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Critical Flaw in NetScaler ADC | Security News", doc.Title)
+	assert.Equal(t, "Critical Flaw in NetScaler ADC\n\nCitrix has released security updates for a critical vulnerability.\n\nExploitation has been observed in the wild.", doc.Text)
+}
+
+func TestExtract_RemovesScriptsNavigationAndAttributes(t *testing.T) {
+	doc, err := Extract(strings.NewReader(page))
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.HTML, `<a href="https://example.com/cve">critical vulnerability</a>`)
+	for _, removed := range []string{"trackVisit", "Home", "Subscribe", "Copyright", "ad slot", "onclick", "class=", "data-id"} {
+		assert.NotContains(t, doc.HTML, removed)
+	}
+}
+
+func TestExtract_FallsBackToDensestElement(t *testing.T) {
+	doc, err := Extract(strings.NewReader(`<html><body>
+		<div id="sidebar"><p>Related posts</p></div>
+		<div id="story"><p>Researchers found a new loader.</p><p>It spreads through malicious ads.</p></div>
+	</body></html>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Researchers found a new loader.\n\nIt spreads through malicious ads.", doc.Text)
+	assert.NotContains(t, doc.HTML, "Related posts")
+}
+
+func TestExtract_DropsJavascriptLinks(t *testing.T) {
+	doc, err := Extract(strings.NewReader(`<article><p><a href="javascript:alert(1)">click</a></p></article>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "<p><a>click</a></p>", doc.HTML)
+}
@@ -0,0 +1,66 @@
+// Package safehttp fetches URLs taken from ingested content without reaching internal networks
+package safehttp
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// dialTimeout bounds establishing a connection
+const dialTimeout = 10 * time.Second
+
+// NewClient returns an HTTP client that only connects to public addresses
+// Addresses are checked after DNS resolution, so hostnames that resolve to private, loopback
+// or link-local addresses are refused, including when reached through a redirect
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !IsPublicIP(net.ParseIP(host)) {
+				return fmt.Errorf("refusing to connect to non-public address %s", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+}
+
+// IsPublicIP reports whether ip is a globally routable unicast address
+func IsPublicIP(ip net.IP) bool {
+	return ip != nil && ip.IsGlobalUnicast() && !ip.IsPrivate()
+}
+
+// ParseURL parses rawURL and checks that it is an absolute http or https URL
+func ParseURL(rawURL string) (*url.URL, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("URL must use http or https")
+	}
+
+	if parsed.Hostname() == "" {
+		return nil, fmt.Errorf("URL must have a host")
+	}
+
+	if parsed.User != nil {
+		return nil, fmt.Errorf("URL cannot contain credentials")
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,69 @@
+package sharetoken
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("a-share-link-secret-of-32-bytes!")
+
+func TestNew_TokenVerifiesToItsCode(t *testing.T) {
+	code, token, err := New(secret)
+	require.NoError(t, err)
+
+	assert.Len(t, code, codeLength)
+	assert.Len(t, token, Length)
+
+	verified, ok := Verify(secret, token)
+	assert.True(t, ok)
+	assert.Equal(t, code, verified)
+}
+
+func TestNew_CodesAreRandom(t *testing.T) {
+	first, _, err := New(secret)
+	require.NoError(t, err)
+	second, _, err := New(secret)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestVerify_RejectsTamperedTokens(t *testing.T) {
+	_, token, err := New(secret)
+	require.NoError(t, err)
+
+	flip := func(s string, i int) string {
+		b := []byte(s)
+		if b[i] == 'A' {
+			b[i] = 'B'
+		} else {
+			b[i] = 'A'
+		}
+		return string(b)
+	}
+
+	_, ok := Verify(secret, flip(token, 0))
+	assert.False(t, ok, "changed code")
+
+	_, ok = Verify(secret, flip(token, Length-1))
+	assert.False(t, ok, "changed signature")
+
+	_, ok = Verify([]byte("another-secret"), token)
+	assert.False(t, ok, "different secret")
+}
+
+func TestVerify_RejectsWrongLength(t *testing.T) {
+	_, token, err := New(secret)
+	require.NoError(t, err)
+
+	_, ok := Verify(secret, token[:Length-1])
+	assert.False(t, ok)
+
+	_, ok = Verify(secret, token+"A")
+	assert.False(t, ok)
+
+	_, ok = Verify(secret, "")
+	assert.False(t, ok)
+}
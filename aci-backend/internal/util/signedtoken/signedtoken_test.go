@@ -0,0 +1,76 @@
+package signedtoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var secret = []byte("a-newsletter-token-secret-32-byt")
+
+func TestNew_TokenVerifiesToItsID(t *testing.T) {
+	id := uuid.New()
+	now := time.Now()
+	token := New(secret, "confirm", id, now.Add(time.Hour))
+
+	verified, err := Verify(secret, "confirm", token, now)
+	assert.NoError(t, err)
+	assert.Equal(t, id, verified)
+}
+
+func TestVerify_TokenWithoutExpiryNeverExpires(t *testing.T) {
+	id := uuid.New()
+	token := New(secret, "unsubscribe", id, time.Time{})
+
+	verified, err := Verify(secret, "unsubscribe", token, time.Now().AddDate(50, 0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, id, verified)
+}
+
+func TestVerify_RejectsExpiredTokens(t *testing.T) {
+	now := time.Now()
+	token := New(secret, "confirm", uuid.New(), now.Add(time.Hour))
+
+	_, err := Verify(secret, "confirm", token, now.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrExpired)
+}
+
+func TestVerify_RejectsOtherPurposes(t *testing.T) {
+	token := New(secret, "unsubscribe", uuid.New(), time.Time{})
+
+	_, err := Verify(secret, "confirm", token, time.Now())
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestVerify_RejectsOtherSecrets(t *testing.T) {
+	token := New(secret, "confirm", uuid.New(), time.Time{})
+
+	_, err := Verify([]byte("another-secret-of-thirty-two-byt"), "confirm", token, time.Now())
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestVerify_RejectsMalformedAndTamperedTokens(t *testing.T) {
+	token := New(secret, "confirm", uuid.New(), time.Time{})
+
+	tampered := []byte(token)
+	if tampered[0] == 'A' {
+		tampered[0] = 'B'
+	} else {
+		tampered[0] = 'A'
+	}
+
+	for name, candidate := range map[string]string{
+		"empty":        "",
+		"no separator": "abcdef",
+		"tampered":     string(tampered),
+		"truncated":    token[:len(token)-1],
+		"bad payload":  "!!!." + sign(secret, "confirm", "!!!"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Verify(secret, "confirm", candidate, time.Now())
+			assert.ErrorIs(t, err, ErrInvalid)
+		})
+	}
+}
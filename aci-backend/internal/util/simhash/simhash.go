@@ -0,0 +1,63 @@
+// Package simhash computes 64-bit SimHash fingerprints for near-duplicate text detection
+package simhash
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"regexp"
+	"strings"
+)
+
+// shingleSize is the number of consecutive words hashed together
+// Word shingles make the fingerprint sensitive to phrasing, not just vocabulary
+const shingleSize = 3
+
+var (
+	tagRegex  = regexp.MustCompile(`<[^>]*>`)
+	wordRegex = regexp.MustCompile(`[\p{L}\p{N}]+`)
+)
+
+// Compute returns the SimHash fingerprint of text
+// HTML tags are ignored and words are compared case-insensitively
+func Compute(text string) uint64 {
+	words := wordRegex.FindAllString(strings.ToLower(tagRegex.ReplaceAllString(text, " ")), -1)
+	if len(words) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	if len(words) < shingleSize {
+		addFeature(strings.Join(words, " "))
+	} else {
+		for i := 0; i+shingleSize <= len(words); i++ {
+			addFeature(strings.Join(words[i:i+shingleSize], " "))
+		}
+	}
+
+	var fingerprint uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			fingerprint |= 1 << uint(bit)
+		}
+	}
+
+	return fingerprint
+}
+
+// Distance returns the Hamming distance between two fingerprints (0 = identical, 64 = opposite)
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,31 @@
+package simhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const advisory = `Citrix has released security updates to address a critical vulnerability in NetScaler ADC and
+NetScaler Gateway. The flaw, tracked as CVE-2026-1234, allows an unauthenticated attacker to execute arbitrary
+code on affected appliances. Citrix urges customers to upgrade to the latest firmware immediately, as exploitation
+has been observed in the wild against government and healthcare organizations.`
+
+func TestCompute_NearDuplicatesAreClose(t *testing.T) {
+	syndicated := "<p>" + advisory + "</p><p>Originally published by the vendor.</p>"
+
+	assert.LessOrEqual(t, Distance(Compute(advisory), Compute(syndicated)), 10)
+}
+
+func TestCompute_UnrelatedTextIsFar(t *testing.T) {
+	unrelated := `A phishing campaign impersonating a popular parcel delivery service is targeting mobile users with
+SMS messages that link to credential harvesting pages. Researchers attribute the activity to a financially
+motivated group that has been active since early last year across Europe and North America.`
+
+	assert.Greater(t, Distance(Compute(advisory), Compute(unrelated)), 10)
+}
+
+func TestCompute_IgnoresCaseAndMarkup(t *testing.T) {
+	assert.Equal(t, Compute("Critical flaw in NetScaler ADC"), Compute("<b>critical</b> FLAW in netscaler adc"))
+	assert.Equal(t, uint64(0), Compute("  <br/> "))
+}
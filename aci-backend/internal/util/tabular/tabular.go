@@ -0,0 +1,233 @@
+// Package tabular writes rows of text as CSV or XLSX without holding them in memory
+// Rows are encoded as they are written, so an export of any size needs only the current row.
+// XLSX output is a minimal single-sheet workbook with inline strings, written straight into
+// the zip stream
+package tabular
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format is an export file format
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat parses a format name, case-insensitively
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case FormatCSV, FormatXLSX:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q: must be csv or xlsx", name)
+	}
+}
+
+// ContentType is the MIME type of the format
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Writer writes rows to an export
+type Writer interface {
+	// Write writes one row; the first row written is styled as the header in XLSX
+	Write(record []string) error
+	// Close flushes buffered rows and, for XLSX, completes the workbook; it does not close the underlying writer
+	Close() error
+}
+
+// NewWriter returns a writer of the format; sheet names the XLSX worksheet and is ignored for CSV
+func NewWriter(w io.Writer, format Format, sheet string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{csv: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w, sheet)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// csvWriter writes CSV, escaping values a spreadsheet would evaluate as formulas
+type csvWriter struct {
+	csv *csv.Writer
+}
+
+func (c *csvWriter) Write(record []string) error {
+	escaped := make([]string, len(record))
+	for i, value := range record {
+		if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+			value = "'" + value
+		}
+		escaped[i] = value
+	}
+
+	return c.csv.Write(escaped)
+}
+
+func (c *csvWriter) Close() error {
+	c.csv.Flush()
+	return c.csv.Error()
+}
+
+const (
+	// maxCellLength is the most characters an Excel cell holds
+	maxCellLength = 32767
+
+	// maxSheetNameLength is the most characters in an Excel sheet name
+	maxSheetNameLength = 31
+)
+
+// invalidSheetNameChars are not allowed in Excel sheet names
+var invalidSheetNameChars = strings.NewReplacer(
+	"[", "", "]", "", ":", "", "*", "", "?", "", "/", "", `\`, "",
+)
+
+// xlsxParts are the fixed parts of the workbook, written before the worksheet
+// workbook.xml is formatted with the sheet name
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	// Style 1 is bold, for the header row
+	{"xl/styles.xml", xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`},
+}
+
+// xlsxWriter streams rows into the worksheet part of a workbook
+type xlsxWriter struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+// newXLSXWriter writes the fixed parts of the workbook and opens the worksheet
+func newXLSXWriter(w io.Writer, sheet string) (*xlsxWriter, error) {
+	sheet = invalidSheetNameChars.Replace(sheet)
+	if utf8.RuneCountInString(sheet) > maxSheetNameLength {
+		sheet = string([]rune(sheet)[:maxSheetNameLength])
+	}
+	if strings.TrimSpace(sheet) == "" {
+		sheet = "Sheet1"
+	}
+
+	var escapedSheet strings.Builder
+	if err := xml.EscapeText(&escapedSheet, []byte(sheet)); err != nil {
+		return nil, fmt.Errorf("failed to escape sheet name: %w", err)
+	}
+
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		content := part.content
+		if part.name == "xl/workbook.xml" {
+			content = fmt.Sprintf(content, escapedSheet.String())
+		}
+
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(f, content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+
+	f, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	sheetWriter := bufio.NewWriter(f)
+	if _, err := sheetWriter.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	return &xlsxWriter{zip: archive, sheet: sheetWriter}, nil
+}
+
+func (x *xlsxWriter) Write(record []string) error {
+	x.rows++
+
+	style := ""
+	if x.rows == 1 {
+		style = ` s="1"`
+	}
+
+	fmt.Fprintf(x.sheet, `<row r="%d">`, x.rows)
+	for i, value := range record {
+		if utf8.RuneCountInString(value) > maxCellLength {
+			value = string([]rune(value)[:maxCellLength])
+		}
+
+		fmt.Fprintf(x.sheet, `<c r="%s%d" t="inlineStr"%s><is><t xml:space="preserve">`, columnName(i), x.rows, style)
+		if err := xml.EscapeText(x.sheet, []byte(value)); err != nil {
+			return fmt.Errorf("failed to write cell: %w", err)
+		}
+		x.sheet.WriteString(`</t></is></c>`)
+	}
+
+	if _, err := x.sheet.WriteString(`</row>`); err != nil {
+		return fmt.Errorf("failed to write row: %w", err)
+	}
+
+	return nil
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := x.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	if err := x.sheet.Flush(); err != nil {
+		return fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	if err := x.zip.Close(); err != nil {
+		return fmt.Errorf("failed to complete workbook: %w", err)
+	}
+
+	return nil
+}
+
+// columnName returns the spreadsheet column letters of a zero-based index: A, B, ... Z, AA, AB
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
@@ -0,0 +1,106 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat(" XLSX ")
+	require.NoError(t, err)
+	assert.Equal(t, FormatXLSX, format)
+
+	_, err = ParseFormat("json")
+	assert.Error(t, err)
+}
+
+func TestCSVWriter_EscapesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, FormatCSV, "")
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Write([]string{"name", "note"}))
+	require.NoError(t, writer.Write([]string{"=HYPERLINK(\"x\")", "plain, with comma"}))
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, "name,note\n\"'=HYPERLINK(\"\"x\"\")\",\"plain, with comma\"\n", buf.String())
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+	assert.Equal(t, "BA", columnName(52))
+}
+
+// readPart returns the contents of a part of a workbook
+func readPart(t *testing.T, workbook []byte, name string) string {
+	archive, err := zip.NewReader(bytes.NewReader(workbook), int64(len(workbook)))
+	require.NoError(t, err)
+
+	f, err := archive.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestXLSXWriter_WritesWorkbook(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, FormatXLSX, "Audit logs: 2026/10")
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Write([]string{"id", "title"}))
+	require.NoError(t, writer.Write([]string{"1", "Fish & <chips>"}))
+	require.NoError(t, writer.Close())
+
+	for _, part := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		assert.NotEmpty(t, readPart(t, buf.Bytes(), part))
+	}
+
+	// Characters Excel does not allow in sheet names are removed
+	assert.Contains(t, readPart(t, buf.Bytes(), "xl/workbook.xml"), `<sheet name="Audit logs 202610"`)
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+
+	var parsed struct {
+		Rows []struct {
+			Index int `xml:"r,attr"`
+			Cells []struct {
+				Ref   string `xml:"r,attr"`
+				Style string `xml:"s,attr"`
+				Text  string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(sheet), &parsed))
+
+	require.Len(t, parsed.Rows, 2)
+	assert.Equal(t, "1", parsed.Rows[0].Cells[0].Style)
+	assert.Equal(t, "", parsed.Rows[1].Cells[0].Style)
+	assert.Equal(t, "B2", parsed.Rows[1].Cells[1].Ref)
+	assert.Equal(t, "Fish & <chips>", parsed.Rows[1].Cells[1].Text)
+}
+
+func TestXLSXWriter_KeepsFormulasAsText(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, FormatXLSX, "Users")
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Write([]string{"=1+1"}))
+	require.NoError(t, writer.Close())
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet, `t="inlineStr"`)
+	assert.Contains(t, sheet, `<t xml:space="preserve">=1+1</t>`)
+	assert.NotContains(t, sheet, "<f>")
+}
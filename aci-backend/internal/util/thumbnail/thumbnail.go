@@ -0,0 +1,85 @@
+// Package thumbnail decodes remote images and resizes them to JPEG at fixed widths
+package thumbnail
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+
+	"golang.org/x/image/draw"
+
+	// Decoders for the formats accepted as hero images
+	_ "golang.org/x/image/webp"
+	_ "image/gif"
+	_ "image/png"
+)
+
+// jpegQuality is the quality resized images are encoded at
+const jpegQuality = 85
+
+// Formats are the image formats Decode accepts, as reported by image.Decode
+var Formats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"gif":  true,
+	"webp": true,
+}
+
+// Decode reads an image, refusing images with more than maxPixels pixels before decoding
+// them, so a small file cannot expand into a huge bitmap
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	if !Formats[format] {
+		return nil, fmt.Errorf("unsupported image format %s", format)
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("image is %dx%d, larger than %d pixels", cfg.Width, cfg.Height, maxPixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	return img, nil
+}
+
+// Resize scales img to width, keeping its aspect ratio; narrower images keep their width
+// Transparent areas are flattened onto white, since the result is encoded as JPEG
+func Resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if width > bounds.Dx() {
+		width = bounds.Dx()
+	}
+
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+// EncodeJPEG writes img as a JPEG
+func EncodeJPEG(w io.Writer, img image.Image) error {
+	if err := jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return fmt.Errorf("failed to encode jpeg: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.NRGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestResize_KeepsAspectRatio(t *testing.T) {
+	img, err := Decode(pngImage(t, 1000, 500), 1<<20)
+	require.NoError(t, err)
+
+	resized := Resize(img, 320)
+	assert.Equal(t, image.Rect(0, 0, 320, 160), resized.Bounds())
+}
+
+func TestResize_DoesNotUpscale(t *testing.T) {
+	img, err := Decode(pngImage(t, 200, 100), 1<<20)
+	require.NoError(t, err)
+
+	assert.Equal(t, image.Rect(0, 0, 200, 100), Resize(img, 800).Bounds())
+}
+
+func TestResize_FlattensTransparencyOntoWhite(t *testing.T) {
+	img, err := Decode(pngImage(t, 10, 10), 1<<20)
+	require.NoError(t, err)
+
+	r, g, b, _ := Resize(img, 10).At(5, 5).RGBA()
+	assert.Equal(t, []uint32{0xffff, 0xffff, 0xffff}, []uint32{r, g, b})
+}
+
+func TestDecode_RejectsOversizedAndUnknownImages(t *testing.T) {
+	_, err := Decode(pngImage(t, 100, 100), 5000)
+	assert.ErrorContains(t, err, "larger than")
+
+	_, err = Decode([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"/>"), 1<<20)
+	assert.Error(t, err)
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	img, err := Decode(pngImage(t, 40, 20), 1<<20)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeJPEG(&buf, Resize(img, 20)))
+
+	decoded, err := jpeg.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 20, 10), decoded.Bounds())
+}
@@ -0,0 +1,247 @@
+package websocket
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+// BackpressurePolicy determines what happens when a client's send buffer is full
+type BackpressurePolicy string
+
+const (
+	// BackpressureCoalesce keeps only the latest undelivered message per channel
+	// Suited to state-like channels where newer messages supersede older ones
+	BackpressureCoalesce BackpressurePolicy = "coalesce"
+
+	// BackpressureDropOldest evicts the oldest queued message and sends a resync
+	// notice so the client knows to re-fetch the affected channel via REST
+	BackpressureDropOldest BackpressurePolicy = "drop_oldest"
+
+	// BackpressureDisconnect drops messages (with a resync notice) until MaxDrops
+	// consecutive drops, then disconnects the client as a slow consumer
+	BackpressureDisconnect BackpressurePolicy = "disconnect"
+)
+
+const (
+	// DefaultMaxDrops is the consecutive drop limit for the disconnect policy
+	DefaultMaxDrops = 100
+
+	// closeCodeTryAgainLater is the RFC 6455 close code for overloaded peers
+	closeCodeTryAgainLater = 1013
+)
+
+// IsValid checks if the backpressure policy is valid
+func (p BackpressurePolicy) IsValid() bool {
+	switch p {
+	case BackpressureCoalesce, BackpressureDropOldest, BackpressureDisconnect:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChannelPolicy configures backpressure handling for a channel or channel prefix
+type ChannelPolicy struct {
+	Policy   BackpressurePolicy
+	MaxDrops int // only used by BackpressureDisconnect
+}
+
+// DefaultChannelPolicy is applied to channels without a configured policy
+var DefaultChannelPolicy = ChannelPolicy{Policy: BackpressureDropOldest}
+
+// DefaultChannelPolicies returns the built-in per-channel backpressure policies
+// Keys ending in ":" match any channel with that prefix
+func DefaultChannelPolicies() map[string]ChannelPolicy {
+	return map[string]ChannelPolicy{
+		ChannelPrefixArticles: {Policy: BackpressureDropOldest},
+		ChannelPrefixAlerts:   {Policy: BackpressureDisconnect, MaxDrops: DefaultMaxDrops},
+		ChannelSystem:         {Policy: BackpressureCoalesce},
+	}
+}
+
+// policyResolver looks up the backpressure policy for a channel
+type policyResolver struct {
+	policies map[string]ChannelPolicy
+	fallback ChannelPolicy
+}
+
+// newPolicyResolver validates policies and builds a resolver
+func newPolicyResolver(policies map[string]ChannelPolicy, fallback ChannelPolicy) (*policyResolver, error) {
+	if !fallback.Policy.IsValid() {
+		return nil, fmt.Errorf("invalid default backpressure policy: %s", fallback.Policy)
+	}
+
+	resolved := make(map[string]ChannelPolicy, len(policies))
+	for channel, policy := range policies {
+		if !policy.Policy.IsValid() {
+			return nil, fmt.Errorf("invalid backpressure policy for %s: %s", channel, policy.Policy)
+		}
+		if policy.Policy == BackpressureDisconnect && policy.MaxDrops <= 0 {
+			policy.MaxDrops = DefaultMaxDrops
+		}
+		resolved[channel] = policy
+	}
+
+	if fallback.Policy == BackpressureDisconnect && fallback.MaxDrops <= 0 {
+		fallback.MaxDrops = DefaultMaxDrops
+	}
+
+	return &policyResolver{policies: resolved, fallback: fallback}, nil
+}
+
+// resolve returns the policy for a channel: exact match, then longest prefix, then fallback
+func (r *policyResolver) resolve(channel string) ChannelPolicy {
+	if policy, ok := r.policies[channel]; ok {
+		return policy
+	}
+
+	best := ""
+	for key := range r.policies {
+		if strings.HasSuffix(key, ":") && strings.HasPrefix(channel, key) && len(key) > len(best) {
+			best = key
+		}
+	}
+
+	if best != "" {
+		return r.policies[best]
+	}
+
+	return r.fallback
+}
+
+// channelGap records messages lost on a channel since the last resync notice
+type channelGap struct {
+	dropped int
+	since   time.Time
+}
+
+// deliver queues a channel message, applying the channel's backpressure policy
+// when the send buffer is full. Returns true if the message was queued.
+func (c *Client) deliver(channel string, msgBytes []byte, policy ChannelPolicy) bool {
+	if c.enqueue(msgBytes) {
+		c.consecutiveDrops.Store(0)
+		return true
+	}
+
+	if c.hub != nil {
+		c.hub.metrics.recordDrop(channel)
+	}
+
+	switch policy.Policy {
+	case BackpressureCoalesce:
+		c.pendingMu.Lock()
+		c.coalesced[channel] = msgBytes
+		c.pendingMu.Unlock()
+		return true
+
+	case BackpressureDisconnect:
+		c.recordGap(channel)
+		if int(c.consecutiveDrops.Add(1)) >= policy.MaxDrops {
+			c.disconnectSlowConsumer(channel)
+		}
+		return false
+
+	default: // BackpressureDropOldest
+		select {
+		case <-c.send:
+		default:
+		}
+		c.recordGap(channel)
+		if c.enqueue(msgBytes) {
+			return true
+		}
+		return false
+	}
+}
+
+// recordGap notes a lost message so a resync notice is sent for the channel
+func (c *Client) recordGap(channel string) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	gap, ok := c.gaps[channel]
+	if !ok {
+		gap = &channelGap{since: time.Now()}
+		c.gaps[channel] = gap
+	}
+	gap.dropped++
+}
+
+// disconnectSlowConsumer closes a client that cannot keep up with its subscriptions
+func (c *Client) disconnectSlowConsumer(channel string) {
+	c.slowOnce.Do(func() {
+		log.Warn().
+			Str("user_id", c.userID.String()).
+			Str("channel", channel).
+			Int64("consecutive_drops", c.consecutiveDrops.Load()).
+			Msg("Disconnecting slow WebSocket consumer")
+
+		deadline := time.Now().Add(writeWait)
+		_ = c.conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(closeCodeTryAgainLater, "slow consumer"),
+			deadline,
+		)
+		go c.conn.Close()
+	})
+}
+
+// flushPending writes coalesced messages and resync notices accumulated under backpressure
+// Called from WritePump only, after the queued batch has been written
+func (c *Client) flushPending() error {
+	c.pendingMu.Lock()
+	coalesced := c.coalesced
+	gaps := c.gaps
+	if len(coalesced) > 0 {
+		c.coalesced = make(map[string][]byte)
+	}
+	if len(gaps) > 0 {
+		c.gaps = make(map[string]*channelGap)
+	}
+	c.pendingMu.Unlock()
+
+	for channel, gap := range gaps {
+		msg, err := NewResyncMessage(channel, gap.dropped, gap.since)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to create resync message")
+			continue
+		}
+
+		data, err := msg.Marshal()
+		if err != nil {
+			continue
+		}
+
+		c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+			return err
+		}
+		c.messagesSent.Add(1)
+	}
+
+	for _, data := range coalesced {
+		c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+			return err
+		}
+		c.messagesSent.Add(1)
+	}
+
+	return nil
+}
+
+// resyncEndpoint returns the REST endpoint a client should use to re-fetch a channel
+func resyncEndpoint(channel string) string {
+	switch {
+	case strings.HasPrefix(channel, ChannelPrefixAlerts):
+		return "/v1/alerts"
+	case strings.HasPrefix(channel, ChannelPrefixArticles):
+		return "/v1/articles"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,94 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(bufferSize int) *Client {
+	client := NewClient(nil, nil, uuid.New(), "test@example.com", "user", time.Time{})
+	client.send = make(chan []byte, bufferSize)
+	return client
+}
+
+func TestPolicyResolver_Resolve(t *testing.T) {
+	resolver, err := newPolicyResolver(map[string]ChannelPolicy{
+		"articles:":          {Policy: BackpressureDropOldest},
+		"articles:critical":  {Policy: BackpressureCoalesce},
+		"articles:category:": {Policy: BackpressureDisconnect},
+	}, ChannelPolicy{Policy: BackpressureCoalesce})
+	require.NoError(t, err)
+
+	assert.Equal(t, BackpressureCoalesce, resolver.resolve("articles:critical").Policy, "exact match wins")
+	assert.Equal(t, BackpressureDisconnect, resolver.resolve("articles:category:ransomware").Policy, "longest prefix wins")
+	assert.Equal(t, DefaultMaxDrops, resolver.resolve("articles:category:ransomware").MaxDrops, "disconnect gets default max drops")
+	assert.Equal(t, BackpressureDropOldest, resolver.resolve("articles:vendor:acme").Policy)
+	assert.Equal(t, BackpressureCoalesce, resolver.resolve("system").Policy, "fallback for unmatched channels")
+}
+
+func TestPolicyResolver_RejectsInvalidPolicy(t *testing.T) {
+	_, err := newPolicyResolver(map[string]ChannelPolicy{
+		"articles:": {Policy: "block"},
+	}, DefaultChannelPolicy)
+	assert.Error(t, err)
+}
+
+func TestDeliver_DropOldestRecordsGap(t *testing.T) {
+	client := newTestClient(2)
+	policy := ChannelPolicy{Policy: BackpressureDropOldest}
+
+	require.True(t, client.deliver("articles:all", []byte("1"), policy))
+	require.True(t, client.deliver("articles:all", []byte("2"), policy))
+	require.True(t, client.deliver("articles:all", []byte("3"), policy))
+
+	assert.Equal(t, []byte("2"), <-client.send)
+	assert.Equal(t, []byte("3"), <-client.send)
+	require.Contains(t, client.gaps, "articles:all")
+	assert.Equal(t, 1, client.gaps["articles:all"].dropped)
+	assert.Equal(t, uint64(1), client.messagesDropped.Load())
+}
+
+func TestDeliver_CoalesceKeepsLatest(t *testing.T) {
+	client := newTestClient(1)
+	policy := ChannelPolicy{Policy: BackpressureCoalesce}
+
+	require.True(t, client.deliver("system", []byte("1"), policy))
+	require.True(t, client.deliver("system", []byte("2"), policy))
+	require.True(t, client.deliver("system", []byte("3"), policy))
+
+	assert.Equal(t, []byte("1"), <-client.send)
+	assert.Equal(t, []byte("3"), client.coalesced["system"])
+	assert.Empty(t, client.gaps, "coalescing does not require a resync")
+}
+
+func TestDeliver_SuccessResetsConsecutiveDrops(t *testing.T) {
+	client := newTestClient(1)
+	policy := ChannelPolicy{Policy: BackpressureDisconnect, MaxDrops: 5}
+
+	require.True(t, client.deliver("alerts:user", []byte("1"), policy))
+	assert.False(t, client.deliver("alerts:user", []byte("2"), policy))
+	assert.Equal(t, int64(1), client.consecutiveDrops.Load())
+
+	<-client.send
+	require.True(t, client.deliver("alerts:user", []byte("3"), policy))
+	assert.Equal(t, int64(0), client.consecutiveDrops.Load())
+}
+
+func TestDeliver_CountsChannelDropsInHub(t *testing.T) {
+	hub := NewHub(nil)
+	client := newTestClient(1)
+	client.hub = hub
+	policy := ChannelPolicy{Policy: BackpressureDropOldest}
+
+	require.True(t, client.deliver("articles:all", []byte("1"), policy))
+	require.True(t, client.deliver("articles:all", []byte("2"), policy))
+
+	stats := hub.channelStats()
+	assert.Equal(t, uint64(1), stats["articles:all"].Dropped)
+	assert.Equal(t, 0, stats["articles:all"].Subscribers)
+	assert.Equal(t, uint64(1), hub.metrics.bufferFullTotal.Load())
+}
@@ -2,6 +2,8 @@ package websocket
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,25 +42,74 @@ type Client struct {
 	email  string
 	role   string
 
+	// Workspace of the user in multi-tenant mode; uuid.Nil for the default workspace
+	tenantID uuid.UUID
+
 	// JWT expiration for token_expiring warnings
 	tokenExp time.Time
 
 	// Subscribed channels
 	channels map[string]bool
+
+	// closing is closed to ask WritePump to flush pending messages and close
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	// Heartbeat and delivery metrics
+	connectedAt     time.Time
+	lastPong        atomic.Int64 // unix nanoseconds of last pong or client ping
+	messagesSent    atomic.Uint64
+	messagesDropped atomic.Uint64
+
+	// Backpressure state (see backpressure.go)
+	pendingMu        sync.Mutex
+	coalesced        map[string][]byte
+	gaps             map[string]*channelGap
+	consecutiveDrops atomic.Int64
+	slowOnce         sync.Once
+}
+
+// ClientStats is a point-in-time snapshot of a client connection's health
+type ClientStats struct {
+	UserID          uuid.UUID `json:"user_id"`
+	ConnectedAt     time.Time `json:"connected_at"`
+	LastPongAt      time.Time `json:"last_pong_at"`
+	Channels        int       `json:"channels"`
+	MessagesSent    uint64    `json:"messages_sent"`
+	MessagesDropped uint64    `json:"messages_dropped"`
+	SendBufferLen   int       `json:"send_buffer_len"`
+	SendBufferCap   int       `json:"send_buffer_cap"`
+
+	SendBufferUtilization float64 `json:"send_buffer_utilization"`
 }
 
 // NewClient creates a new WebSocket client
 func NewClient(hub *Hub, conn *websocket.Conn, userID uuid.UUID, email, role string, tokenExp time.Time) *Client {
-	return &Client{
-		hub:      hub,
-		conn:     conn,
-		send:     make(chan []byte, sendChannelSize),
-		userID:   userID,
-		email:    email,
-		role:     role,
-		tokenExp: tokenExp,
-		channels: make(map[string]bool),
+	now := time.Now()
+
+	client := &Client{
+		hub:         hub,
+		conn:        conn,
+		send:        make(chan []byte, sendChannelSize),
+		userID:      userID,
+		email:       email,
+		role:        role,
+		tokenExp:    tokenExp,
+		channels:    make(map[string]bool),
+		closing:     make(chan struct{}),
+		connectedAt: now,
+		coalesced:   make(map[string][]byte),
+		gaps:        make(map[string]*channelGap),
 	}
+	client.lastPong.Store(now.UnixNano())
+
+	return client
+}
+
+// SetTenant records the user's workspace, so the client only receives that workspace's articles
+// It must be called before the client is registered
+func (c *Client) SetTenant(tenantID uuid.UUID) {
+	c.tenantID = tenantID
 }
 
 // ReadPump reads messages from the WebSocket connection
@@ -71,6 +122,7 @@ func (c *Client) ReadPump() {
 	c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.conn.SetReadLimit(maxMessageSize)
 	c.conn.SetPongHandler(func(string) error {
+		c.markAlive()
 		c.conn.SetReadDeadline(time.Now().Add(pongWait))
 		return nil
 	})
@@ -129,16 +181,51 @@ func (c *Client) WritePump() {
 			if err := w.Close(); err != nil {
 				return
 			}
+			c.messagesSent.Add(uint64(n + 1))
+
+			if err := c.flushPending(); err != nil {
+				return
+			}
 
 		case <-ticker.C:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
+
+		case <-c.closing:
+			c.flushAndClose()
+			return
 		}
 	}
 }
 
+// beginClose asks WritePump to flush queued messages and close the connection
+func (c *Client) beginClose() {
+	c.closeOnce.Do(func() { close(c.closing) })
+}
+
+// flushAndClose writes any queued messages followed by a going-away close frame
+func (c *Client) flushAndClose() {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+
+	for n := len(c.send); n > 0; n-- {
+		message, ok := <-c.send
+		if !ok {
+			return
+		}
+		if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			return
+		}
+		c.messagesSent.Add(1)
+	}
+
+	_ = c.conn.WriteMessage(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+	)
+}
+
 // handleMessage processes incoming messages from the client
 func (c *Client) handleMessage(msg *Message) {
 	if msg == nil {
@@ -158,6 +245,7 @@ func (c *Client) handleMessage(msg *Message) {
 		c.handleUnsubscribe(msg)
 
 	case MessageTypePing:
+		c.markAlive()
 		c.handlePing()
 
 	default:
@@ -303,10 +391,52 @@ func (c *Client) SendMessage(msg *Message) error {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
+	if !c.enqueue(msgBytes) {
+		return fmt.Errorf("send channel full")
+	}
+
+	return nil
+}
+
+// enqueue queues an encoded message without blocking
+// Returns false and counts a drop when the send buffer is full
+func (c *Client) enqueue(msgBytes []byte) bool {
 	select {
 	case c.send <- msgBytes:
-		return nil
+		return true
 	default:
-		return fmt.Errorf("send channel full")
+		c.messagesDropped.Add(1)
+		return false
 	}
 }
+
+// markAlive records heartbeat activity from the peer
+func (c *Client) markAlive() {
+	c.lastPong.Store(time.Now().UnixNano())
+}
+
+// lastPongAt returns the time of the last heartbeat from the peer
+func (c *Client) lastPongAt() time.Time {
+	return time.Unix(0, c.lastPong.Load())
+}
+
+// stats returns a snapshot of the client's metrics
+// Caller must hold the hub lock since channels is read
+func (c *Client) stats() ClientStats {
+	stats := ClientStats{
+		UserID:          c.userID,
+		ConnectedAt:     c.connectedAt,
+		LastPongAt:      c.lastPongAt(),
+		Channels:        len(c.channels),
+		MessagesSent:    c.messagesSent.Load(),
+		MessagesDropped: c.messagesDropped.Load(),
+		SendBufferLen:   len(c.send),
+		SendBufferCap:   cap(c.send),
+	}
+
+	if stats.SendBufferCap > 0 {
+		stats.SendBufferUtilization = float64(stats.SendBufferLen) / float64(stats.SendBufferCap)
+	}
+
+	return stats
+}
@@ -3,12 +3,25 @@ package websocket
 import (
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gorilla/websocket"
 	jwtPkg "github.com/phillipboles/aci-backend/internal/pkg/jwt"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// Subprotocol is the application subprotocol negotiated with clients
+	Subprotocol = "aci.v1"
+
+	// subprotocolTokenPrefix marks the subprotocol entry carrying the access token
+	subprotocolTokenPrefix = "bearer."
+
+	// defaultAuthTimeout is how long an unauthenticated connection may wait to send its auth message
+	defaultAuthTimeout = 10 * time.Second
+)
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -21,8 +34,9 @@ var upgrader = websocket.Upgrader{
 
 // Handler handles WebSocket upgrade requests
 type Handler struct {
-	hub        *Hub
-	jwtService jwtPkg.Service
+	hub         *Hub
+	jwtService  jwtPkg.Service
+	authTimeout time.Duration
 }
 
 // NewHandler creates a new WebSocket handler
@@ -36,47 +50,59 @@ func NewHandler(hub *Hub, jwtService jwtPkg.Service) (*Handler, error) {
 	}
 
 	return &Handler{
-		hub:        hub,
-		jwtService: jwtService,
+		hub:         hub,
+		jwtService:  jwtService,
+		authTimeout: defaultAuthTimeout,
 	}, nil
 }
 
 // ServeWS handles WebSocket upgrade requests
-// GET /ws?token=<jwt>
+//
+// Clients authenticate in one of three ways, in order of preference:
+//  1. Sec-WebSocket-Protocol: aci.v1, bearer.<jwt> (token validated before upgrade)
+//  2. First frame after upgrade: {"type":"auth","payload":{"token":"<jwt>"}}
+//  3. GET /ws?token=<jwt> (deprecated: tokens leak into proxy and access logs)
 func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
-	// Extract JWT from query parameter
-	tokenString := r.URL.Query().Get("token")
-	if tokenString == "" {
-		log.Warn().
-			Str("remote_addr", r.RemoteAddr).
-			Msg("WebSocket connection attempt without token")
-		http.Error(w, "Token is required", http.StatusUnauthorized)
-		return
+	token, subprotocolOffered := tokenFromSubprotocols(websocket.Subprotocols(r))
+
+	if token == "" {
+		if queryToken := r.URL.Query().Get("token"); queryToken != "" {
+			log.Warn().
+				Str("remote_addr", r.RemoteAddr).
+				Msg("WebSocket query parameter authentication is deprecated, use the auth message or subprotocol")
+			token = queryToken
+		}
 	}
 
-	// Validate JWT
-	claims, err := h.jwtService.ValidateAccessToken(tokenString)
-	if err != nil {
-		log.Warn().
-			Err(err).
-			Str("remote_addr", r.RemoteAddr).
-			Msg("Invalid JWT token for WebSocket")
-		http.Error(w, "Invalid token", http.StatusUnauthorized)
-		return
+	// Token supplied with the upgrade request - reject before upgrading if invalid
+	var claims *jwtPkg.Claims
+	if token != "" {
+		validated, err := h.jwtService.ValidateAccessToken(token)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("remote_addr", r.RemoteAddr).
+				Msg("Invalid JWT token for WebSocket")
+			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		if !h.checkConnectionLimit(validated) {
+			http.Error(w, "Max connections reached", http.StatusTooManyRequests)
+			return
+		}
+
+		claims = validated
 	}
 
-	// Check connection limit before upgrading
-	if h.hub.GetConnectionCount(claims.UserID) >= h.hub.maxConnectionsPerUser {
-		log.Warn().
-			Str("user_id", claims.UserID.String()).
-			Int("current_connections", h.hub.GetConnectionCount(claims.UserID)).
-			Msg("Max connections per user reached")
-		http.Error(w, "Max connections reached", http.StatusTooManyRequests)
-		return
+	// Echo only the application subprotocol; never reflect the token back
+	var responseHeader http.Header
+	if subprotocolOffered {
+		responseHeader = http.Header{"Sec-WebSocket-Protocol": []string{Subprotocol}}
 	}
 
 	// Upgrade connection to WebSocket
-	conn, err := upgrader.Upgrade(w, r, nil)
+	conn, err := upgrader.Upgrade(w, r, responseHeader)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -85,11 +111,32 @@ func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// No token yet - the first frame must be an auth message
+	if claims == nil {
+		claims, err = h.authenticateFirstMessage(conn)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("remote_addr", r.RemoteAddr).
+				Msg("WebSocket auth handshake failed")
+			closeWithError(conn, websocket.ClosePolicyViolation, "auth_failed", err.Error())
+			return
+		}
+
+		if !h.checkConnectionLimit(claims) {
+			closeWithError(conn, websocket.ClosePolicyViolation, "max_connections", "Maximum connections per user reached")
+			return
+		}
+	}
+
 	// Extract token expiration
 	tokenExp := claims.ExpiresAt.Time
 
 	// Create new client
 	client := NewClient(h.hub, conn, claims.UserID, claims.Email, claims.Role, tokenExp)
+	if claims.TenantID != nil {
+		client.SetTenant(*claims.TenantID)
+	}
 
 	// Register client with hub
 	if err := h.hub.RegisterClient(client); err != nil {
@@ -113,6 +160,80 @@ func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
 	go client.ReadPump()
 }
 
+// authenticateFirstMessage waits for an auth frame and validates its token
+func (h *Handler) authenticateFirstMessage(conn *websocket.Conn) (*jwtPkg.Claims, error) {
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(h.authTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var msg Message
+	if err := conn.ReadJSON(&msg); err != nil {
+		return nil, fmt.Errorf("failed to read auth message: %w", err)
+	}
+
+	if msg.Type != MessageTypeAuth {
+		return nil, fmt.Errorf("first message must be of type %s", MessageTypeAuth)
+	}
+
+	var payload AuthPayload
+	if err := msg.UnmarshalPayload(&payload); err != nil || payload.Token == "" {
+		return nil, fmt.Errorf("auth message requires a token")
+	}
+
+	claims, err := h.jwtService.ValidateAccessToken(payload.Token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return claims, nil
+}
+
+// checkConnectionLimit reports whether the user may open another connection
+func (h *Handler) checkConnectionLimit(claims *jwtPkg.Claims) bool {
+	count := h.hub.GetConnectionCount(claims.UserID)
+	if count >= h.hub.maxConnectionsPerUser {
+		log.Warn().
+			Str("user_id", claims.UserID.String()).
+			Int("current_connections", count).
+			Msg("Max connections per user reached")
+		return false
+	}
+	return true
+}
+
+// tokenFromSubprotocols extracts a bearer token from offered subprotocols
+// Returns the token (if any) and whether the aci.v1 subprotocol was offered
+func tokenFromSubprotocols(protocols []string) (string, bool) {
+	var token string
+	offered := false
+
+	for _, p := range protocols {
+		switch {
+		case p == Subprotocol:
+			offered = true
+		case strings.HasPrefix(p, subprotocolTokenPrefix):
+			token = strings.TrimPrefix(p, subprotocolTokenPrefix)
+		}
+	}
+
+	return token, offered
+}
+
+// closeWithError sends an error message and a close frame before closing the connection
+func closeWithError(conn *websocket.Conn, closeCode int, code, message string) {
+	deadline := time.Now().Add(writeWait)
+
+	if msg, err := NewErrorMessage(code, message); err == nil {
+		if data, err := msg.Marshal(); err == nil {
+			conn.SetWriteDeadline(deadline)
+			_ = conn.WriteMessage(websocket.TextMessage, data)
+		}
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, message), deadline)
+	conn.Close()
+}
+
 // ServeHTTP implements http.Handler interface for routing integration
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.ServeWS(w, r)
@@ -0,0 +1,223 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jwtPkg "github.com/phillipboles/aci-backend/internal/pkg/jwt"
+)
+
+const testIssuer = "aci-backend-test"
+
+// handshakeTest serves a WebSocket handler backed by a running hub
+type handshakeTest struct {
+	handler    *Handler
+	jwtService jwtPkg.Service
+	privatePEM []byte
+	url        string
+}
+
+// newHandshakeTest starts a hub and a test server for the handler
+func newHandshakeTest(t *testing.T) *handshakeTest {
+	t.Helper()
+
+	privatePEM, publicPEM, err := jwtPkg.GenerateKeyPair(jwtPkg.DefaultKeyBits)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	privatePath := filepath.Join(dir, "private.pem")
+	publicPath := filepath.Join(dir, "public.pem")
+	require.NoError(t, os.WriteFile(privatePath, privatePEM, 0o600))
+	require.NoError(t, os.WriteFile(publicPath, publicPEM, 0o600))
+
+	jwtService, err := jwtPkg.NewService(&jwtPkg.Config{
+		PrivateKeyPath: privatePath,
+		PublicKeyPath:  publicPath,
+		Issuer:         testIssuer,
+	})
+	require.NoError(t, err)
+
+	hub := NewHub(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	go hub.Run(ctx)
+	t.Cleanup(cancel)
+
+	handler, err := NewHandler(hub, jwtService)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &handshakeTest{
+		handler:    handler,
+		jwtService: jwtService,
+		privatePEM: privatePEM,
+		url:        "ws" + strings.TrimPrefix(server.URL, "http"),
+	}
+}
+
+// accessToken returns a valid access token for a new user
+func (ht *handshakeTest) accessToken(t *testing.T) string {
+	t.Helper()
+
+	tokens, err := ht.jwtService.GenerateTokenPair(uuid.New(), "analyst@example.com", "user")
+	require.NoError(t, err)
+	return tokens.AccessToken
+}
+
+// expiredToken returns an access token signed with the right key that expired a minute ago
+func (ht *handshakeTest) expiredToken(t *testing.T) string {
+	t.Helper()
+
+	key, err := gojwt.ParseRSAPrivateKeyFromPEM(ht.privatePEM)
+	require.NoError(t, err)
+
+	issued := time.Now().Add(-time.Hour)
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodRS256, &jwtPkg.Claims{
+		RegisteredClaims: gojwt.RegisteredClaims{
+			ExpiresAt: gojwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			IssuedAt:  gojwt.NewNumericDate(issued),
+			NotBefore: gojwt.NewNumericDate(issued),
+			Issuer:    testIssuer,
+		},
+		UserID: uuid.New(),
+		Email:  "analyst@example.com",
+		Role:   "user",
+	}).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+// dial opens a connection offering the given subprotocols
+func (ht *handshakeTest) dial(t *testing.T, url string, subprotocols ...string) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+
+	dialer := websocket.Dialer{Subprotocols: subprotocols, HandshakeTimeout: 5 * time.Second}
+	conn, resp, err := dialer.Dial(url, nil)
+	if conn != nil {
+		t.Cleanup(func() { conn.Close() })
+	}
+	return conn, resp, err
+}
+
+// readMessage reads the next message from the server
+func readMessage(t *testing.T, conn *websocket.Conn) *Message {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg Message
+	require.NoError(t, conn.ReadJSON(&msg))
+	return &msg
+}
+
+// requireAuthFailure asserts that the server reports a failed handshake and closes the connection
+func requireAuthFailure(t *testing.T, conn *websocket.Conn) {
+	t.Helper()
+
+	msg := readMessage(t, conn)
+	require.Equal(t, MessageTypeError, msg.Type)
+	var payload ErrorPayload
+	require.NoError(t, msg.UnmarshalPayload(&payload))
+	assert.Equal(t, "auth_failed", payload.Code)
+
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "expected a policy violation close, got %v", err)
+}
+
+func TestServeWS_SubprotocolToken(t *testing.T) {
+	ht := newHandshakeTest(t)
+
+	conn, resp, err := ht.dial(t, ht.url, Subprotocol, subprotocolTokenPrefix+ht.accessToken(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, Subprotocol, resp.Header.Get("Sec-WebSocket-Protocol"), "only the application subprotocol is echoed")
+	assert.Equal(t, MessageTypeConnected, readMessage(t, conn).Type)
+}
+
+func TestServeWS_QueryTokenFallback(t *testing.T) {
+	ht := newHandshakeTest(t)
+
+	conn, _, err := ht.dial(t, ht.url+"?token="+ht.accessToken(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, MessageTypeConnected, readMessage(t, conn).Type)
+}
+
+func TestServeWS_FirstMessageAuth(t *testing.T) {
+	ht := newHandshakeTest(t)
+
+	conn, _, err := ht.dial(t, ht.url, Subprotocol)
+	require.NoError(t, err)
+
+	auth, err := NewMessage(MessageTypeAuth, &AuthPayload{Token: ht.accessToken(t)})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(auth))
+
+	assert.Equal(t, MessageTypeConnected, readMessage(t, conn).Type)
+}
+
+func TestServeWS_AuthTimeout(t *testing.T) {
+	ht := newHandshakeTest(t)
+	ht.handler.authTimeout = 100 * time.Millisecond
+
+	conn, _, err := ht.dial(t, ht.url)
+	require.NoError(t, err)
+
+	requireAuthFailure(t, conn)
+}
+
+func TestServeWS_RejectsInvalidTokens(t *testing.T) {
+	t.Run("expired subprotocol token is refused before upgrade", func(t *testing.T) {
+		ht := newHandshakeTest(t)
+
+		_, resp, err := ht.dial(t, ht.url, Subprotocol, subprotocolTokenPrefix+ht.expiredToken(t))
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("malformed query token is refused before upgrade", func(t *testing.T) {
+		ht := newHandshakeTest(t)
+
+		_, resp, err := ht.dial(t, ht.url+"?token=not-a-jwt")
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("expired token in the auth message closes the connection", func(t *testing.T) {
+		ht := newHandshakeTest(t)
+
+		conn, _, err := ht.dial(t, ht.url)
+		require.NoError(t, err)
+
+		auth, err := NewMessage(MessageTypeAuth, &AuthPayload{Token: ht.expiredToken(t)})
+		require.NoError(t, err)
+		require.NoError(t, conn.WriteJSON(auth))
+
+		requireAuthFailure(t, conn)
+	})
+
+	t.Run("a first message other than auth closes the connection", func(t *testing.T) {
+		ht := newHandshakeTest(t)
+
+		conn, _, err := ht.dial(t, ht.url)
+		require.NoError(t, err)
+
+		ping, err := NewMessage(MessageTypePing, nil)
+		require.NoError(t, err)
+		require.NoError(t, conn.WriteJSON(ping))
+
+		requireAuthFailure(t, conn)
+	})
+}
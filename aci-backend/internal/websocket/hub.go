@@ -1,8 +1,11 @@
 package websocket
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -14,6 +17,15 @@ const (
 
 	// DefaultMaxChannelsPerClient is the maximum channels a client can subscribe to
 	DefaultMaxChannelsPerClient = 50
+
+	// DefaultStaleTimeout is how long a client may go without a pong before being reaped
+	DefaultStaleTimeout = 90 * time.Second
+
+	// DefaultReapInterval is how often the hub scans for stale connections
+	DefaultReapInterval = 30 * time.Second
+
+	// drainPollInterval is how often Drain checks whether all clients have disconnected
+	drainPollInterval = 50 * time.Millisecond
 )
 
 // Hub maintains active clients and handles broadcasting
@@ -40,18 +52,58 @@ type Hub struct {
 	// Connection limits
 	maxConnectionsPerUser int
 	maxChannelsPerClient  int
+
+	// Backpressure policies per channel
+	policies *policyResolver
+
+	// Stale connection reaping
+	staleTimeout time.Duration
+	reapInterval time.Duration
+	reapedTotal  atomic.Uint64
+
+	// Counters retained from clients that have disconnected
+	sentTotal    atomic.Uint64
+	droppedTotal atomic.Uint64
+
+	// Per-channel and send buffer counters (see metrics.go)
+	metrics *hubMetrics
+
+	// draining is set once Drain starts; new registrations are rejected
+	draining bool
+
+	// running is true while Run's loop is active
+	running atomic.Bool
+
+	// done is closed when Run returns
+	done     chan struct{}
+	doneOnce sync.Once
 }
 
 // BroadcastMessage represents a message to broadcast to a channel
 type BroadcastMessage struct {
 	Channel string
 	Message *Message
+
+	// Allow restricts delivery to users it returns true for; nil delivers to every subscriber
+	// It runs on the hub goroutine with the hub lock held, so it must not block
+	Allow func(userID uuid.UUID) bool
+
+	// Tenant restricts delivery to the clients of one workspace, uuid.Nil being the default
+	// workspace; nil delivers to every workspace
+	Tenant *uuid.UUID
 }
 
 // HubConfig holds configuration for the hub
 type HubConfig struct {
 	MaxConnectionsPerUser int
 	MaxChannelsPerClient  int
+	StaleTimeout          time.Duration
+	ReapInterval          time.Duration
+
+	// ChannelPolicies maps channels (or prefixes ending in ":") to backpressure policies
+	// Nil uses DefaultChannelPolicies; unmatched channels use DefaultPolicy
+	ChannelPolicies map[string]ChannelPolicy
+	DefaultPolicy   *ChannelPolicy
 }
 
 // NewHub creates a new WebSocket hub
@@ -71,6 +123,28 @@ func NewHub(cfg *HubConfig) *Hub {
 		cfg.MaxChannelsPerClient = DefaultMaxChannelsPerClient
 	}
 
+	if cfg.StaleTimeout <= 0 {
+		cfg.StaleTimeout = DefaultStaleTimeout
+	}
+
+	if cfg.ReapInterval <= 0 {
+		cfg.ReapInterval = DefaultReapInterval
+	}
+
+	if cfg.ChannelPolicies == nil {
+		cfg.ChannelPolicies = DefaultChannelPolicies()
+	}
+
+	fallback := DefaultChannelPolicy
+	if cfg.DefaultPolicy != nil {
+		fallback = *cfg.DefaultPolicy
+	}
+
+	policies, err := newPolicyResolver(cfg.ChannelPolicies, fallback)
+	if err != nil {
+		panic(fmt.Sprintf("invalid hub config: %v", err))
+	}
+
 	return &Hub{
 		clients:               make(map[*Client]bool),
 		userClients:           make(map[uuid.UUID]map[*Client]bool),
@@ -80,13 +154,34 @@ func NewHub(cfg *HubConfig) *Hub {
 		broadcast:             make(chan *BroadcastMessage, 256),
 		maxConnectionsPerUser: cfg.MaxConnectionsPerUser,
 		maxChannelsPerClient:  cfg.MaxChannelsPerClient,
+		policies:              policies,
+		staleTimeout:          cfg.StaleTimeout,
+		reapInterval:          cfg.ReapInterval,
+		metrics:               newHubMetrics(),
+		done:                  make(chan struct{}),
 	}
 }
 
-// Run starts the hub's main loop
-func (h *Hub) Run() {
+// Run starts the hub's main loop and blocks until ctx is cancelled
+// After Run returns, registration fails and unregistration happens inline
+func (h *Hub) Run(ctx context.Context) {
+	defer h.doneOnce.Do(func() { close(h.done) })
+
+	h.running.Store(true)
+	defer h.running.Store(false)
+
+	reapTicker := time.NewTicker(h.reapInterval)
+	defer reapTicker.Stop()
+
 	for {
 		select {
+		case <-reapTicker.C:
+			h.reapStale(time.Now())
+
+		case <-ctx.Done():
+			log.Info().Msg("WebSocket hub stopped")
+			return
+
 		case client := <-h.register:
 			h.handleRegister(client)
 
@@ -108,6 +203,19 @@ func (h *Hub) handleRegister(client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	// Reject new connections once draining has started
+	if h.draining {
+		msg, err := NewMessage(MessageTypeServerShuttingDown, &ServerShuttingDownPayload{
+			Message: "Server is shutting down",
+		})
+		if err == nil {
+			_ = client.SendMessage(msg)
+		}
+
+		go client.conn.Close()
+		return
+	}
+
 	// Check connection limit for user
 	userConns := h.userClients[client.userID]
 	if len(userConns) >= h.maxConnectionsPerUser {
@@ -175,8 +283,10 @@ func (h *Hub) handleUnregister(client *Client) {
 		}
 	}
 
-	// Remove from clients
+	// Remove from clients, keeping its counters in the hub totals
 	delete(h.clients, client)
+	h.sentTotal.Add(client.messagesSent.Load())
+	h.droppedTotal.Add(client.messagesDropped.Load())
 
 	// Close send channel
 	close(client.send)
@@ -211,17 +321,24 @@ func (h *Hub) handleBroadcast(bm *BroadcastMessage) {
 		return
 	}
 
+	policy := h.policies.resolve(bm.Channel)
+
 	count := 0
 	for client := range clients {
-		select {
-		case client.send <- msgBytes:
+		if bm.Tenant != nil && *bm.Tenant != client.tenantID {
+			continue
+		}
+		if bm.Allow != nil && !bm.Allow(client.userID) {
+			continue
+		}
+		if client.deliver(bm.Channel, msgBytes, policy) {
 			count++
-		default:
-			// Client send channel is full, skip
+		} else {
 			log.Warn().
 				Str("user_id", client.userID.String()).
 				Str("channel", bm.Channel).
-				Msg("Client send channel full, skipping message")
+				Str("policy", string(policy.Policy)).
+				Msg("Client send channel full, message dropped")
 		}
 	}
 
@@ -238,8 +355,12 @@ func (h *Hub) RegisterClient(client *Client) error {
 		return fmt.Errorf("client is required")
 	}
 
-	h.register <- client
-	return nil
+	select {
+	case h.register <- client:
+		return nil
+	case <-h.done:
+		return fmt.Errorf("hub is not running")
+	}
 }
 
 // UnregisterClient removes a client from the hub
@@ -248,7 +369,11 @@ func (h *Hub) UnregisterClient(client *Client) {
 		return
 	}
 
-	h.unregister <- client
+	select {
+	case h.unregister <- client:
+	case <-h.done:
+		h.handleUnregister(client)
+	}
 }
 
 // Subscribe adds a client to a channel
@@ -338,12 +463,110 @@ func (h *Hub) Broadcast(channel string, msg *Message) {
 		return
 	}
 
-	h.broadcast <- &BroadcastMessage{
-		Channel: channel,
-		Message: msg,
+	select {
+	case h.broadcast <- &BroadcastMessage{Channel: channel, Message: msg}:
+	case <-h.done:
+	}
+}
+
+// BroadcastFiltered sends a message to the clients in a channel whose user allow returns true for
+func (h *Hub) BroadcastFiltered(channel string, msg *Message, allow func(userID uuid.UUID) bool) {
+	if channel == "" || msg == nil {
+		return
+	}
+
+	select {
+	case h.broadcast <- &BroadcastMessage{Channel: channel, Message: msg, Allow: allow}:
+	case <-h.done:
+	}
+}
+
+// BroadcastToTenant sends a message to the clients in a channel that belong to the workspace
+// and, unless allow is nil, whose user allow returns true for
+func (h *Hub) BroadcastToTenant(channel string, msg *Message, tenantID uuid.UUID, allow func(userID uuid.UUID) bool) {
+	if channel == "" || msg == nil {
+		return
+	}
+
+	select {
+	case h.broadcast <- &BroadcastMessage{Channel: channel, Message: msg, Allow: allow, Tenant: &tenantID}:
+	case <-h.done:
+	}
+}
+
+// ConnectedUserIDs returns the IDs of users with at least one open connection
+func (h *Hub) ConnectedUserIDs() []uuid.UUID {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	userIDs := make([]uuid.UUID, 0, len(h.userClients))
+	for userID := range h.userClients {
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
+
+// Drain notifies every client that the server is shutting down and closes
+// their connections, waiting until all clients unregister or ctx expires.
+// Connections still open when ctx expires are closed forcibly.
+func (h *Hub) Drain(ctx context.Context) error {
+	msg, err := NewMessage(MessageTypeServerShuttingDown, &ServerShuttingDownPayload{
+		Message: "Server is shutting down, please reconnect shortly",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create shutdown message: %w", err)
+	}
+
+	// Notify under the lock: unregistering closes a client's send channel, so only
+	// clients still registered may be sent to
+	h.mu.Lock()
+	h.draining = true
+	count := len(h.clients)
+	for client := range h.clients {
+		_ = client.SendMessage(msg)
+		client.beginClose()
+	}
+	h.mu.Unlock()
+
+	log.Info().
+		Int("clients", count).
+		Msg("Draining WebSocket connections")
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if h.clientCount() == 0 {
+			log.Info().Msg("WebSocket connections drained")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			h.mu.RLock()
+			remaining := len(h.clients)
+			for client := range h.clients {
+				client.conn.Close()
+			}
+			h.mu.RUnlock()
+
+			log.Warn().
+				Int("remaining", remaining).
+				Msg("Drain deadline reached, closing remaining WebSocket connections")
+			return ctx.Err()
+		case <-ticker.C:
+		}
 	}
 }
 
+// clientCount returns the number of registered clients
+func (h *Hub) clientCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.clients)
+}
+
 // BroadcastToUser sends a message to all clients of a specific user
 func (h *Hub) BroadcastToUser(userID uuid.UUID, msg *Message) {
 	if userID == uuid.Nil || msg == nil {
@@ -367,15 +590,17 @@ func (h *Hub) BroadcastToUser(userID uuid.UUID, msg *Message) {
 		return
 	}
 
+	// Direct user messages are alert deliveries and follow the alerts:user policy
+	policy := h.policies.resolve(ChannelAlertsUser)
+
 	count := 0
 	for client := range clients {
-		select {
-		case client.send <- msgBytes:
+		if client.deliver(ChannelAlertsUser, msgBytes, policy) {
 			count++
-		default:
+		} else {
 			log.Warn().
 				Str("user_id", userID.String()).
-				Msg("Client send channel full, skipping message")
+				Msg("Client send channel full, message dropped")
 		}
 	}
 
@@ -398,14 +623,76 @@ func (h *Hub) GetConnectionCount(userID uuid.UUID) int {
 	return len(h.userClients[userID])
 }
 
-// GetStats returns hub statistics
+// IsRunning reports whether the hub's main loop is processing registrations and broadcasts
+func (h *Hub) IsRunning() bool {
+	return h.running.Load()
+}
+
+// IsDraining reports whether Drain has started and new connections are being rejected
+func (h *Hub) IsDraining() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.draining
+}
+
+// ClientCount returns the number of open connections across all users
+func (h *Hub) ClientCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.clients)
+}
+
+// reapStale closes connections that have not sent a pong within the stale timeout
+// Closing the connection makes ReadPump exit, which unregisters the client
+func (h *Hub) reapStale(now time.Time) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.clients {
+		silence := now.Sub(client.lastPongAt())
+		if silence <= h.staleTimeout {
+			continue
+		}
+
+		log.Warn().
+			Str("user_id", client.userID.String()).
+			Dur("silence", silence).
+			Msg("Reaping stale WebSocket connection")
+
+		h.reapedTotal.Add(1)
+		go client.conn.Close()
+	}
+}
+
+// GetStats returns hub statistics including per-connection metrics
 func (h *Hub) GetStats() map[string]interface{} {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
+	sent := h.sentTotal.Load()
+	dropped := h.droppedTotal.Load()
+
+	clients := make([]ClientStats, 0, len(h.clients))
+	for client := range h.clients {
+		stats := client.stats()
+		sent += stats.MessagesSent
+		dropped += stats.MessagesDropped
+		clients = append(clients, stats)
+	}
+
 	return map[string]interface{}{
-		"total_clients":  len(h.clients),
-		"total_users":    len(h.userClients),
-		"total_channels": len(h.channels),
+		"total_clients":          len(h.clients),
+		"total_users":            len(h.userClients),
+		"total_channels":         len(h.channels),
+		"messages_sent_total":    sent,
+		"messages_dropped_total": dropped,
+		"stale_reaped_total":     h.reapedTotal.Load(),
+		"stale_timeout_seconds":  int(h.staleTimeout.Seconds()),
+		"channels":               h.channelStats(),
+		"user_connections":       h.userConnectionCounts(),
+		"send_buffer":            h.sendBufferStats(clients),
+		"clients":                clients,
 	}
 }
@@ -0,0 +1,70 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleBroadcast_TenantLimitsDelivery(t *testing.T) {
+	hub := NewHub(nil)
+	tenantID := uuid.New()
+
+	defaultClient := newTestClient(4)
+	tenantClient := newTestClient(4)
+	tenantClient.SetTenant(tenantID)
+	hub.channels["articles:all"] = map[*Client]bool{defaultClient: true, tenantClient: true}
+
+	msg, err := NewMessage(MessageTypeArticleNew, map[string]string{"id": "1"})
+	require.NoError(t, err)
+
+	hub.handleBroadcast(&BroadcastMessage{Channel: "articles:all", Message: msg, Tenant: &tenantID})
+	assert.Len(t, tenantClient.send, 1)
+	assert.Len(t, defaultClient.send, 0, "other workspaces do not receive a workspace's articles")
+
+	defaultTenant := uuid.Nil
+	hub.handleBroadcast(&BroadcastMessage{Channel: "articles:all", Message: msg, Tenant: &defaultTenant})
+	assert.Len(t, tenantClient.send, 1)
+	assert.Len(t, defaultClient.send, 1)
+
+	hub.handleBroadcast(&BroadcastMessage{Channel: "articles:all", Message: msg})
+	assert.Len(t, tenantClient.send, 2, "shared broadcasts reach every workspace")
+	assert.Len(t, defaultClient.send, 2)
+}
+
+func TestDrain_ClientsUnregisteringConcurrently(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		hub := NewHub(nil)
+
+		clients := make([]*Client, 200)
+		for j := range clients {
+			clients[j] = newTestClient(4)
+			hub.handleRegister(clients[j])
+		}
+
+		// Clients disconnect while the shutdown notice is being sent
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for _, client := range clients {
+			wg.Add(1)
+			go func(client *Client) {
+				defer wg.Done()
+				<-start
+				hub.handleUnregister(client)
+			}(client)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		close(start)
+		require.NoError(t, hub.Drain(ctx))
+		cancel()
+		wg.Wait()
+
+		assert.Zero(t, hub.clientCount())
+	}
+}
@@ -12,6 +12,7 @@ type MessageType string
 
 const (
 	// Client -> Server
+	MessageTypeAuth        MessageType = "auth"
 	MessageTypeSubscribe   MessageType = "subscribe"
 	MessageTypeUnsubscribe MessageType = "unsubscribe"
 	MessageTypePing        MessageType = "ping"
@@ -26,6 +27,11 @@ const (
 	MessageTypeArticleNew     MessageType = "article.new"
 	MessageTypeArticleUpdated MessageType = "article.updated"
 	MessageTypeAlertMatch     MessageType = "alert.match"
+	MessageTypeAlertBackfill  MessageType = "alert.backfill"
+	MessageTypeAnnotation     MessageType = "annotation.mention"
+
+	MessageTypeServerShuttingDown MessageType = "server_shutting_down"
+	MessageTypeResync             MessageType = "resync"
 )
 
 // Message is the envelope for all WebSocket messages
@@ -36,6 +42,11 @@ type Message struct {
 	Payload   json.RawMessage `json:"payload,omitempty"`
 }
 
+// AuthPayload represents an auth message payload (first frame after upgrade)
+type AuthPayload struct {
+	Token string `json:"token"`
+}
+
 // SubscribePayload represents a subscribe message payload
 type SubscribePayload struct {
 	Channel string `json:"channel"`
@@ -73,6 +84,20 @@ type TokenExpiringPayload struct {
 	ExpiresIn int       `json:"expires_in"` // Seconds until expiration
 }
 
+// ServerShuttingDownPayload represents a server shutdown notice
+type ServerShuttingDownPayload struct {
+	Message string `json:"message"`
+}
+
+// ResyncPayload tells the client messages were lost and it should re-fetch via REST
+type ResyncPayload struct {
+	Channel  string    `json:"channel"`
+	Reason   string    `json:"reason"`
+	Dropped  int       `json:"dropped"`
+	Since    time.Time `json:"since"`
+	Endpoint string    `json:"endpoint,omitempty"`
+}
+
 // NewMessage creates a new message with timestamp and ID
 func NewMessage(msgType MessageType, payload interface{}) (*Message, error) {
 	var payloadBytes json.RawMessage
@@ -101,6 +126,17 @@ func NewErrorMessage(code, message string) (*Message, error) {
 	})
 }
 
+// NewResyncMessage creates a resync notice for a channel that lost messages
+func NewResyncMessage(channel string, dropped int, since time.Time) (*Message, error) {
+	return NewMessage(MessageTypeResync, &ResyncPayload{
+		Channel:  channel,
+		Reason:   "messages_dropped",
+		Dropped:  dropped,
+		Since:    since,
+		Endpoint: resyncEndpoint(channel),
+	})
+}
+
 // Marshal serializes the message to JSON
 func (m *Message) Marshal() ([]byte, error) {
 	return json.Marshal(m)
@@ -0,0 +1,105 @@
+package websocket
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// sendBufferSaturationThreshold is the utilization at which a client's send buffer counts as saturated
+const sendBufferSaturationThreshold = 0.8
+
+// ChannelStats reports subscribers and backpressure for one channel
+type ChannelStats struct {
+	Subscribers int    `json:"subscribers"`
+	Dropped     uint64 `json:"dropped"`
+}
+
+// SendBufferStats summarizes send buffer saturation across connected clients
+type SendBufferStats struct {
+	Capacity         int     `json:"capacity"`
+	SaturatedClients int     `json:"saturated_clients"`
+	MaxUtilization   float64 `json:"max_utilization"`
+	AvgUtilization   float64 `json:"avg_utilization"`
+	FullEventsTotal  uint64  `json:"full_events_total"`
+}
+
+// hubMetrics holds hub-wide counters that outlive individual clients
+type hubMetrics struct {
+	// Per-channel count of messages that found a recipient's send buffer full
+	dropsMu        sync.Mutex
+	channelDropped map[string]uint64
+
+	bufferFullTotal atomic.Uint64
+}
+
+// newHubMetrics creates empty hub metrics
+func newHubMetrics() *hubMetrics {
+	return &hubMetrics{
+		channelDropped: make(map[string]uint64),
+	}
+}
+
+// recordDrop counts a message on channel that hit a full send buffer
+func (m *hubMetrics) recordDrop(channel string) {
+	m.bufferFullTotal.Add(1)
+
+	m.dropsMu.Lock()
+	m.channelDropped[channel]++
+	m.dropsMu.Unlock()
+}
+
+// channelStats merges live subscriber counts with drop counters
+// Channels without subscribers are included while they have recorded drops
+// Caller must hold h.mu
+func (h *Hub) channelStats() map[string]ChannelStats {
+	stats := make(map[string]ChannelStats, len(h.channels))
+	for channel, clients := range h.channels {
+		stats[channel] = ChannelStats{Subscribers: len(clients)}
+	}
+
+	h.metrics.dropsMu.Lock()
+	for channel, dropped := range h.metrics.channelDropped {
+		s := stats[channel]
+		s.Dropped = dropped
+		stats[channel] = s
+	}
+	h.metrics.dropsMu.Unlock()
+
+	return stats
+}
+
+// userConnectionCounts returns the number of open connections per user
+// Caller must hold h.mu
+func (h *Hub) userConnectionCounts() map[string]int {
+	counts := make(map[string]int, len(h.userClients))
+	for userID, clients := range h.userClients {
+		counts[userID.String()] = len(clients)
+	}
+	return counts
+}
+
+// sendBufferStats summarizes buffer utilization from client snapshots
+func (h *Hub) sendBufferStats(clients []ClientStats) SendBufferStats {
+	stats := SendBufferStats{
+		Capacity:        sendChannelSize,
+		FullEventsTotal: h.metrics.bufferFullTotal.Load(),
+	}
+
+	if len(clients) == 0 {
+		return stats
+	}
+
+	total := 0.0
+	for _, c := range clients {
+		total += c.SendBufferUtilization
+		if c.SendBufferUtilization > stats.MaxUtilization {
+			stats.MaxUtilization = c.SendBufferUtilization
+		}
+		if c.SendBufferUtilization >= sendBufferSaturationThreshold {
+			stats.SaturatedClients++
+		}
+	}
+	stats.AvgUtilization = total / float64(len(clients))
+
+	return stats
+}
@@ -0,0 +1,7 @@
+-- Migration 000007: Notification Templates (Rollback)
+-- Description: Remove notification templates table
+
+DROP INDEX IF EXISTS idx_notification_templates_active;
+DROP INDEX IF EXISTS idx_notification_templates_lookup;
+
+DROP TABLE IF EXISTS notification_templates CASCADE;
@@ -0,0 +1,36 @@
+-- Migration 000007: Notification Templates
+-- Description: Admin-editable, versioned notification templates per channel and locale
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS notification_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_key VARCHAR(100) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    version INTEGER NOT NULL,
+    subject TEXT,
+    body TEXT NOT NULL,
+    variables TEXT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_notification_templates_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_notification_template_key_not_empty CHECK (LENGTH(template_key) >= 1),
+    CONSTRAINT chk_notification_template_channel CHECK (channel IN ('email', 'slack', 'push', 'in_app')),
+    CONSTRAINT chk_notification_template_version CHECK (version >= 1),
+    CONSTRAINT chk_notification_template_body_not_empty CHECK (LENGTH(body) >= 1),
+    CONSTRAINT unique_notification_template_version UNIQUE (template_key, channel, locale, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_templates_lookup
+    ON notification_templates(template_key, channel, locale, version DESC);
+
+-- At most one active version per (key, channel, locale)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_templates_active
+    ON notification_templates(template_key, channel, locale)
+    WHERE is_active = true;
+
+COMMENT ON TABLE notification_templates IS 'Versioned notification templates (subject/body) per delivery channel and locale';
+COMMENT ON COLUMN notification_templates.variables IS 'Variables the template may reference; validated on save';
@@ -0,0 +1,6 @@
+-- Migration 000008: Ingest Latency Tracking (Rollback)
+-- Description: Remove ingest stage timings table
+
+DROP INDEX IF EXISTS idx_article_ingest_timings_notified_at;
+
+DROP TABLE IF EXISTS article_ingest_timings CASCADE;
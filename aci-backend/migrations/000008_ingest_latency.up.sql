@@ -0,0 +1,23 @@
+-- Migration 000008: Ingest Latency Tracking
+-- Description: Per-article pipeline stage timestamps for ingest-to-notify SLO reporting
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_ingest_timings (
+    article_id UUID PRIMARY KEY,
+    received_at TIMESTAMP WITH TIME ZONE,
+    validated_at TIMESTAMP WITH TIME ZONE,
+    persisted_at TIMESTAMP WITH TIME ZONE,
+    enriched_at TIMESTAMP WITH TIME ZONE,
+    notified_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_ingest_timings_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE
+);
+
+-- Window queries over completed pipelines
+CREATE INDEX IF NOT EXISTS idx_article_ingest_timings_notified_at
+    ON article_ingest_timings(notified_at DESC)
+    WHERE notified_at IS NOT NULL;
+
+COMMENT ON TABLE article_ingest_timings IS 'Timestamps for each ingest pipeline stage: received, validated, persisted, enriched, notified';
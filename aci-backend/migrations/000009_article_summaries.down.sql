@@ -0,0 +1,4 @@
+-- Migration 000009: Article Summaries (Rollback)
+-- Description: Remove article summaries table
+
+DROP TABLE IF EXISTS article_summaries CASCADE;
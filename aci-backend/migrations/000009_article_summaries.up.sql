@@ -0,0 +1,19 @@
+-- Migration 000009: Article Summaries
+-- Description: AI-generated article summaries stored per length preset (short, medium, executive)
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_summaries (
+    article_id UUID NOT NULL,
+    length VARCHAR(20) NOT NULL,
+    content TEXT NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    generated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (article_id, length),
+
+    CONSTRAINT fk_article_summaries_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT chk_article_summaries_length CHECK (length IN ('short', 'medium', 'executive'))
+);
+
+COMMENT ON TABLE article_summaries IS 'AI-generated summaries per article and length preset';
@@ -0,0 +1,6 @@
+-- Migration 000010: Classification Suggestions (Rollback)
+-- Description: Remove classification suggestions table
+
+DROP INDEX IF EXISTS idx_classification_suggestions_status;
+
+DROP TABLE IF EXISTS classification_suggestions CASCADE;
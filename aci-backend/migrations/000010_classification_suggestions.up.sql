@@ -0,0 +1,36 @@
+-- Migration 000010: Classification Suggestions
+-- Description: AI-suggested category, severity, tags, and vendors for articles ingested without them
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS classification_suggestions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    article_id UUID NOT NULL,
+    category_slug VARCHAR(100),
+    category_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+    severity VARCHAR(20),
+    severity_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    tags_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+    vendors TEXT[] NOT NULL DEFAULT '{}',
+    vendors_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+    missing_fields TEXT[] NOT NULL DEFAULT '{}',
+    applied_fields TEXT[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_classification_suggestions_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_classification_suggestions_reviewed_by FOREIGN KEY (reviewed_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_classification_suggestions_status CHECK (status IN ('applied', 'pending', 'approved', 'rejected')),
+    CONSTRAINT unique_classification_suggestions_article UNIQUE (article_id)
+);
+
+-- Admin review queue
+CREATE INDEX IF NOT EXISTS idx_classification_suggestions_status
+    ON classification_suggestions(status, created_at DESC);
+
+COMMENT ON TABLE classification_suggestions IS 'AI classification suggestions; fields below the auto-apply threshold wait for admin review';
@@ -0,0 +1,7 @@
+-- Migration 000011: Article Fingerprints (Rollback)
+-- Description: Remove article fingerprints table
+
+DROP INDEX IF EXISTS idx_article_fingerprints_created_at;
+DROP INDEX IF EXISTS idx_article_fingerprints_canonical_id;
+
+DROP TABLE IF EXISTS article_fingerprints CASCADE;
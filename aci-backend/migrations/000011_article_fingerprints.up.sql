@@ -0,0 +1,28 @@
+-- Migration 000011: Article Fingerprints
+-- Description: SimHash fingerprints for clustering near-duplicate (syndicated) articles
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_fingerprints (
+    article_id UUID PRIMARY KEY,
+    simhash BIGINT NOT NULL,
+    canonical_id UUID NOT NULL,
+    distance SMALLINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_fingerprints_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_fingerprints_canonical FOREIGN KEY (canonical_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT chk_article_fingerprints_distance CHECK (distance BETWEEN 0 AND 64)
+);
+
+-- Cluster membership lookups
+CREATE INDEX IF NOT EXISTS idx_article_fingerprints_canonical_id
+    ON article_fingerprints(canonical_id);
+
+-- Candidate scans over the matching window
+CREATE INDEX IF NOT EXISTS idx_article_fingerprints_created_at
+    ON article_fingerprints(created_at DESC);
+
+COMMENT ON TABLE article_fingerprints IS 'Near-duplicate fingerprints; articles sharing canonical_id are one syndicated story';
+COMMENT ON COLUMN article_fingerprints.simhash IS '64-bit SimHash stored as a signed BIGINT';
@@ -0,0 +1,6 @@
+-- Migration 000012: AI Usage (Rollback)
+-- Description: Remove AI usage table
+
+DROP INDEX IF EXISTS idx_ai_usage_created_at;
+
+DROP TABLE IF EXISTS ai_usage CASCADE;
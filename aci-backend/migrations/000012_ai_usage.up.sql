@@ -0,0 +1,25 @@
+-- Migration 000012: AI Usage
+-- Description: Token usage and estimated cost per AI call for spend reporting and budget guardrails
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS ai_usage (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    operation VARCHAR(50) NOT NULL,
+    input_tokens BIGINT NOT NULL DEFAULT 0,
+    output_tokens BIGINT NOT NULL DEFAULT 0,
+    cost_usd NUMERIC(12, 6) NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_ai_usage_tokens CHECK (input_tokens >= 0 AND output_tokens >= 0),
+    CONSTRAINT chk_ai_usage_cost CHECK (cost_usd >= 0)
+);
+
+-- Daily aggregates and month-to-date spend
+CREATE INDEX IF NOT EXISTS idx_ai_usage_created_at
+    ON ai_usage(created_at DESC);
+
+COMMENT ON TABLE ai_usage IS 'One row per AI provider call; cost_usd is estimated from list prices';
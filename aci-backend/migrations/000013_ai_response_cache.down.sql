@@ -0,0 +1,7 @@
+-- Migration 000013: AI Response Cache (Rollback)
+-- Description: Remove AI response cache table
+
+DROP INDEX IF EXISTS idx_ai_response_cache_operation;
+DROP INDEX IF EXISTS idx_ai_response_cache_expires_at;
+
+DROP TABLE IF EXISTS ai_response_cache CASCADE;
@@ -0,0 +1,27 @@
+-- Migration 000013: AI Response Cache
+-- Description: Provider responses keyed by a hash of the prompt so re-submitted content is not re-paid
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS ai_response_cache (
+    key CHAR(64) PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    operation VARCHAR(50) NOT NULL,
+    response TEXT NOT NULL,
+    hits BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_hit_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_ai_response_cache_expiry CHECK (expires_at > created_at)
+);
+
+-- Purging expired entries
+CREATE INDEX IF NOT EXISTS idx_ai_response_cache_expires_at
+    ON ai_response_cache(expires_at);
+
+-- Invalidation by operation
+CREATE INDEX IF NOT EXISTS idx_ai_response_cache_operation
+    ON ai_response_cache(operation);
+
+COMMENT ON TABLE ai_response_cache IS 'Cached AI responses; key is the SHA-256 of provider, model and prompt';
@@ -0,0 +1,8 @@
+-- Migration 000014: IOCs (Rollback)
+-- Description: Remove normalized IOC tables
+
+DROP INDEX IF EXISTS idx_article_iocs_ioc_id;
+DROP INDEX IF EXISTS idx_iocs_value_prefix;
+
+DROP TABLE IF EXISTS article_iocs CASCADE;
+DROP TABLE IF EXISTS iocs CASCADE;
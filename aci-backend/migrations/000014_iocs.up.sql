@@ -0,0 +1,56 @@
+-- Migration 000014: IOCs
+-- Description: Normalized indicators of compromise linked to the articles that mention them, for search and export
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS iocs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(20) NOT NULL,
+    value TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_iocs_type_value UNIQUE (type, value),
+    CONSTRAINT chk_iocs_type CHECK (type IN ('ip', 'domain', 'hash', 'url', 'email'))
+);
+
+CREATE TABLE IF NOT EXISTS article_iocs (
+    article_id UUID NOT NULL,
+    ioc_id UUID NOT NULL,
+    context TEXT,
+    source VARCHAR(20),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (article_id, ioc_id),
+    CONSTRAINT fk_article_iocs_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_iocs_ioc FOREIGN KEY (ioc_id)
+        REFERENCES iocs(id) ON DELETE CASCADE
+);
+
+-- Value prefix search
+CREATE INDEX IF NOT EXISTS idx_iocs_value_prefix
+    ON iocs(value text_pattern_ops);
+
+-- Pivot from an indicator to its articles
+CREATE INDEX IF NOT EXISTS idx_article_iocs_ioc_id
+    ON article_iocs(ioc_id);
+
+-- Backfill from the per-article JSON column
+INSERT INTO iocs (type, value)
+SELECT DISTINCT elem->>'type', elem->>'value'
+FROM articles, jsonb_array_elements(articles.iocs) AS elem
+WHERE jsonb_typeof(articles.iocs) = 'array'
+  AND elem->>'type' IN ('ip', 'domain', 'hash', 'url', 'email')
+  AND COALESCE(elem->>'value', '') <> ''
+ON CONFLICT (type, value) DO NOTHING;
+
+INSERT INTO article_iocs (article_id, ioc_id, context, source)
+SELECT DISTINCT ON (articles.id, iocs.id)
+    articles.id, iocs.id, NULLIF(elem->>'context', ''), NULLIF(elem->>'source', '')
+FROM articles
+CROSS JOIN LATERAL jsonb_array_elements(articles.iocs) AS elem
+JOIN iocs ON iocs.type = elem->>'type' AND iocs.value = elem->>'value'
+WHERE jsonb_typeof(articles.iocs) = 'array'
+ON CONFLICT (article_id, ioc_id) DO NOTHING;
+
+COMMENT ON TABLE iocs IS 'Distinct indicators of compromise; first/last seen are derived from linked articles';
+COMMENT ON TABLE article_iocs IS 'Articles mentioning each indicator, kept in sync with articles.iocs';
@@ -0,0 +1,6 @@
+-- Migration 000015: Notification Preferences (Rollback)
+-- Description: Remove notification preferences table
+
+DROP TRIGGER IF EXISTS update_notification_preferences_updated_at ON notification_preferences;
+
+DROP TABLE IF EXISTS notification_preferences CASCADE;
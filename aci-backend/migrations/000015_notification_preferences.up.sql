@@ -0,0 +1,25 @@
+-- Migration 000015: Notification Preferences
+-- Description: Per-user notification channels, event toggles, severity thresholds and digest frequency
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    channels JSONB NOT NULL DEFAULT '{}'::JSONB,
+    digest_frequency VARCHAR(20) NOT NULL DEFAULT 'daily',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_notification_preferences_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_notification_preferences_digest CHECK (
+        digest_frequency IN ('realtime', 'hourly', 'daily', 'weekly', 'never')
+    )
+);
+
+CREATE TRIGGER update_notification_preferences_updated_at
+    BEFORE UPDATE ON notification_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE notification_preferences IS 'Notification preference center; users without a row get the defaults';
+COMMENT ON COLUMN notification_preferences.channels IS 'Per-channel (websocket, email, slack) enabled flag, event toggles and min_severity';
@@ -0,0 +1,11 @@
+-- Migration 000016: Alert Match Triage (Rollback)
+-- Description: Remove triage state from alert matches
+
+DROP INDEX IF EXISTS idx_alert_matches_alert_new;
+
+ALTER TABLE alert_matches
+    DROP CONSTRAINT IF EXISTS fk_alert_matches_status_changed_by,
+    DROP CONSTRAINT IF EXISTS chk_alert_matches_status_valid,
+    DROP COLUMN IF EXISTS status_changed_by,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
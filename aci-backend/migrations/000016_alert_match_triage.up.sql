@@ -0,0 +1,22 @@
+-- Migration 000016: Alert Match Triage
+-- Description: Triage state on alert matches so they can be worked as a queue
+-- Date: 2026-10-15
+
+ALTER TABLE alert_matches
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'new',
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS status_changed_by UUID;
+
+ALTER TABLE alert_matches
+    ADD CONSTRAINT chk_alert_matches_status_valid CHECK (
+        status IN ('new', 'acknowledged', 'dismissed', 'escalated')
+    ),
+    ADD CONSTRAINT fk_alert_matches_status_changed_by FOREIGN KEY (status_changed_by)
+        REFERENCES users(id) ON DELETE SET NULL;
+
+-- Unacknowledged counts per alert
+CREATE INDEX IF NOT EXISTS idx_alert_matches_alert_new
+    ON alert_matches(alert_id)
+    WHERE status = 'new';
+
+COMMENT ON COLUMN alert_matches.status IS 'Triage state: new, acknowledged, dismissed, escalated';
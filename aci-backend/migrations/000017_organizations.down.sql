@@ -0,0 +1,20 @@
+-- Migration 000017: Organizations (Rollback)
+-- Description: Remove organizations, memberships, shared alerts and bookmark collections
+
+DROP TRIGGER IF EXISTS update_bookmark_collections_updated_at ON bookmark_collections;
+DROP TRIGGER IF EXISTS update_organizations_updated_at ON organizations;
+
+DROP INDEX IF EXISTS idx_bookmark_collection_articles_added_at;
+DROP INDEX IF EXISTS idx_bookmark_collections_organization_id;
+DROP INDEX IF EXISTS idx_bookmark_collections_user_id;
+DROP INDEX IF EXISTS idx_alerts_organization_id;
+
+DROP TABLE IF EXISTS bookmark_collection_articles CASCADE;
+DROP TABLE IF EXISTS bookmark_collections CASCADE;
+
+ALTER TABLE alerts
+    DROP CONSTRAINT IF EXISTS fk_alerts_organization,
+    DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS organization_members CASCADE;
+DROP TABLE IF EXISTS organizations CASCADE;
@@ -0,0 +1,95 @@
+-- Migration 000017: Organizations
+-- Description: Organizations with member roles, org-scoped alerts and shared bookmark collections
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_organizations_slug UNIQUE (slug)
+);
+
+-- A user belongs to at most one organization
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'member',
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (organization_id, user_id),
+
+    CONSTRAINT fk_organization_members_organization FOREIGN KEY (organization_id)
+        REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_organization_members_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_organization_members_user UNIQUE (user_id),
+    CONSTRAINT chk_organization_members_role CHECK (role IN ('admin', 'member'))
+);
+
+-- Org-scoped alerts are visible to every member; user_id remains the creator
+ALTER TABLE alerts
+    ADD COLUMN IF NOT EXISTS organization_id UUID;
+
+ALTER TABLE alerts
+    ADD CONSTRAINT fk_alerts_organization FOREIGN KEY (organization_id)
+        REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_alerts_organization_id
+    ON alerts(organization_id)
+    WHERE organization_id IS NOT NULL;
+
+-- Named bookmark collections, private to the creator or shared with an organization
+CREATE TABLE IF NOT EXISTS bookmark_collections (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    organization_id UUID,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_bookmark_collections_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_bookmark_collections_organization FOREIGN KEY (organization_id)
+        REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS bookmark_collection_articles (
+    collection_id UUID NOT NULL,
+    article_id UUID NOT NULL,
+    added_by UUID,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (collection_id, article_id),
+
+    CONSTRAINT fk_bookmark_collection_articles_collection FOREIGN KEY (collection_id)
+        REFERENCES bookmark_collections(id) ON DELETE CASCADE,
+    CONSTRAINT fk_bookmark_collection_articles_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_bookmark_collection_articles_added_by FOREIGN KEY (added_by)
+        REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmark_collections_user_id ON bookmark_collections(user_id);
+CREATE INDEX IF NOT EXISTS idx_bookmark_collections_organization_id
+    ON bookmark_collections(organization_id)
+    WHERE organization_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_bookmark_collection_articles_added_at
+    ON bookmark_collection_articles(collection_id, added_at DESC);
+
+CREATE TRIGGER update_organizations_updated_at
+    BEFORE UPDATE ON organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_bookmark_collections_updated_at
+    BEFORE UPDATE ON bookmark_collections
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE organizations IS 'Teams that share alerts and bookmark collections';
+COMMENT ON TABLE organization_members IS 'Organization membership; each user belongs to at most one organization';
+COMMENT ON COLUMN alerts.organization_id IS 'When set, the alert is shared with all members of the organization';
+COMMENT ON TABLE bookmark_collections IS 'Named article collections, private or shared with an organization';
@@ -0,0 +1,9 @@
+-- Migration 000018: Account Deletion (Rollback)
+-- Description: Remove account deletion requests and anonymized reads
+
+DELETE FROM article_reads WHERE user_id IS NULL;
+ALTER TABLE article_reads ALTER COLUMN user_id SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_account_deletion_requests_purge_after;
+
+DROP TABLE IF EXISTS account_deletion_requests CASCADE;
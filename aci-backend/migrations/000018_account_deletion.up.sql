@@ -0,0 +1,19 @@
+-- Migration 000018: Account Deletion
+-- Description: Self-service account deletion requests held for a grace period before PII is purged
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS account_deletion_requests (
+    user_id UUID PRIMARY KEY,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    purge_after TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT fk_account_deletion_requests_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_account_deletion_requests_purge_after CHECK (purge_after >= requested_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletion_requests_purge_after
+    ON account_deletion_requests(purge_after);
+
+-- Purged users' reads are kept without a reader so article engagement totals survive
+ALTER TABLE article_reads ALTER COLUMN user_id DROP NOT NULL;
@@ -0,0 +1,8 @@
+-- Migration 000019: Feed Preferences (Rollback)
+-- Description: Remove feed filter columns from user_preferences
+
+ALTER TABLE user_preferences
+    DROP CONSTRAINT IF EXISTS chk_user_preferences_min_severity,
+    DROP COLUMN IF EXISTS excluded_tags,
+    DROP COLUMN IF EXISTS min_severity,
+    DROP COLUMN IF EXISTS preferred_vendors;
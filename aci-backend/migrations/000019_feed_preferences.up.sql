@@ -0,0 +1,14 @@
+-- Migration 000019: Feed Preferences
+-- Description: Default feed filters (vendors, minimum severity, excluded tags) on user_preferences
+-- Date: 2026-10-15
+
+ALTER TABLE user_preferences
+    ADD COLUMN IF NOT EXISTS preferred_vendors TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS min_severity VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS excluded_tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT chk_user_preferences_min_severity CHECK (
+        min_severity IS NULL OR min_severity IN ('critical', 'high', 'medium', 'low', 'informational')
+    );
+
+COMMENT ON COLUMN user_preferences.preferred_categories IS 'Feed shows articles in these categories or from preferred vendors';
+COMMENT ON COLUMN user_preferences.excluded_tags IS 'Feed hides articles carrying any of these tags';
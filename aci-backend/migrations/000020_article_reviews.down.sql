@@ -0,0 +1,6 @@
+-- Migration 000020: Article Reviews (Rollback)
+-- Description: Remove article review queue table
+
+DROP INDEX IF EXISTS idx_article_reviews_status;
+
+DROP TABLE IF EXISTS article_reviews CASCADE;
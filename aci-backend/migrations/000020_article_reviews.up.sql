@@ -0,0 +1,25 @@
+-- Migration 000020: Article Reviews
+-- Description: Review queue for webhook-ingested articles held back from publication
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_reviews (
+    article_id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending_review',
+    reason TEXT,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_reviews_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_reviews_reviewed_by FOREIGN KEY (reviewed_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_article_reviews_status CHECK (status IN ('pending_review', 'approved', 'rejected')),
+    CONSTRAINT chk_article_reviews_reason CHECK (status <> 'rejected' OR reason IS NOT NULL)
+);
+
+-- Admin review queue
+CREATE INDEX IF NOT EXISTS idx_article_reviews_status
+    ON article_reviews(status, created_at);
+
+COMMENT ON TABLE article_reviews IS 'Articles ingested in review mode; they stay unpublished until an admin approves them';
@@ -0,0 +1,10 @@
+-- Migration 000021: Article Editorial Overrides (Rollback)
+-- Description: Remove editorial override columns from articles
+
+ALTER TABLE articles DROP CONSTRAINT IF EXISTS fk_articles_editorial_updated_by;
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS editorial_updated_at,
+    DROP COLUMN IF EXISTS editorial_updated_by,
+    DROP COLUMN IF EXISTS editorial_summary,
+    DROP COLUMN IF EXISTS editorial_title;
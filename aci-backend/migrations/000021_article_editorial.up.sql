@@ -0,0 +1,16 @@
+-- Migration 000021: Article Editorial Overrides
+-- Description: Editorial title and summary shown to readers in place of the source content
+-- Date: 2026-10-15
+
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS editorial_title VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS editorial_summary TEXT,
+    ADD COLUMN IF NOT EXISTS editorial_updated_by UUID,
+    ADD COLUMN IF NOT EXISTS editorial_updated_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE articles
+    ADD CONSTRAINT fk_articles_editorial_updated_by FOREIGN KEY (editorial_updated_by)
+        REFERENCES users(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN articles.editorial_title IS 'Editorial override of title; the source title is kept unchanged';
+COMMENT ON COLUMN articles.editorial_summary IS 'Editorial override of summary; the source summary is kept unchanged';
@@ -0,0 +1,6 @@
+-- Migration 000022: Featured Articles (Rollback)
+-- Description: Remove featured articles table
+
+DROP INDEX IF EXISTS idx_featured_articles_position;
+
+DROP TABLE IF EXISTS featured_articles CASCADE;
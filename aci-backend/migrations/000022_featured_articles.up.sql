@@ -0,0 +1,28 @@
+-- Migration 000022: Featured Articles
+-- Description: Ordered, optionally scheduled set of articles for the homepage hero carousel
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS featured_articles (
+    article_id UUID PRIMARY KEY,
+    position INTEGER NOT NULL DEFAULT 0,
+    feature_from TIMESTAMP WITH TIME ZONE,
+    feature_until TIMESTAMP WITH TIME ZONE,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_featured_articles_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_featured_articles_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_featured_articles_position CHECK (position >= 0),
+    CONSTRAINT chk_featured_articles_schedule CHECK (
+        feature_from IS NULL OR feature_until IS NULL OR feature_until > feature_from
+    )
+);
+
+-- Carousel ordering
+CREATE INDEX IF NOT EXISTS idx_featured_articles_position
+    ON featured_articles(position, created_at);
+
+COMMENT ON TABLE featured_articles IS 'Articles featured on the homepage; shown between feature_from and feature_until when set';
@@ -0,0 +1,5 @@
+-- Migration 000023: Tags (Rollback)
+-- Description: Drop tag and tag alias tables; article tags keep their normalized values
+
+DROP TABLE IF EXISTS tag_aliases;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,44 @@
+-- Migration 000023: Tags
+-- Description: Canonical tags with aliases; existing article tags are normalized and registered
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    alias VARCHAR(100) PRIMARY KEY,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tag_aliases_tag_id ON tag_aliases(tag_id);
+
+-- Normalize existing article tags: lowercase, hyphen-separated, duplicates removed in first-seen order
+UPDATE articles a
+SET tags = normalized.tags
+FROM (
+    SELECT id, ARRAY(
+        SELECT n.tag
+        FROM (
+            SELECT TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(TRIM(t.tag)), '[\s_-]+', '-', 'g')) AS tag, t.ord
+            FROM UNNEST(articles.tags) WITH ORDINALITY AS t(tag, ord)
+        ) n
+        WHERE n.tag <> ''
+        GROUP BY n.tag
+        ORDER BY MIN(n.ord)
+    ) AS tags
+    FROM articles
+) normalized
+WHERE a.id = normalized.id AND a.tags IS DISTINCT FROM normalized.tags;
+
+INSERT INTO tags (name)
+SELECT DISTINCT LEFT(tag, 100)
+FROM articles, UNNEST(articles.tags) AS tag
+ON CONFLICT (name) DO NOTHING;
+
+COMMENT ON TABLE tags IS 'Canonical article tags; articles.tags holds canonical names';
+COMMENT ON TABLE tag_aliases IS 'Alternate spellings rewritten to their canonical tag on ingestion';
@@ -0,0 +1,11 @@
+-- Migration 000024: Category Hierarchy (Rollback)
+-- Description: Remove multi-category membership and the category hierarchy
+
+DROP TRIGGER IF EXISTS sync_articles_primary_category ON articles;
+DROP FUNCTION IF EXISTS sync_article_primary_category();
+
+DROP TABLE IF EXISTS article_categories;
+
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS chk_categories_parent;
+DROP INDEX IF EXISTS idx_categories_parent_id;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,42 @@
+-- Migration 000024: Category Hierarchy
+-- Description: Parent/child categories and multi-category articles through article_categories
+-- Date: 2026-10-15
+
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_categories_parent CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+
+CREATE TABLE IF NOT EXISTS article_categories (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (article_id, category_id)
+);
+
+CREATE INDEX idx_article_categories_category_id ON article_categories(category_id);
+
+-- Every article belongs to its primary category
+INSERT INTO article_categories (article_id, category_id, created_at)
+SELECT id, category_id, created_at FROM articles
+ON CONFLICT DO NOTHING;
+
+-- Keep the primary category in article_categories whenever it is set or changed
+CREATE OR REPLACE FUNCTION sync_article_primary_category()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO article_categories (article_id, category_id)
+    VALUES (NEW.id, NEW.category_id)
+    ON CONFLICT DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sync_articles_primary_category
+    AFTER INSERT OR UPDATE OF category_id ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION sync_article_primary_category();
+
+COMMENT ON COLUMN categories.parent_id IS 'Parent category; NULL for top-level categories';
+COMMENT ON TABLE article_categories IS 'Category membership; includes each article''s primary category (articles.category_id)';
@@ -0,0 +1,5 @@
+-- Migration 000025: Source Trust Calibration (Rollback)
+-- Description: Drop source trust events and trust score history
+
+DROP TABLE IF EXISTS source_trust_changes;
+DROP TABLE IF EXISTS source_trust_events;
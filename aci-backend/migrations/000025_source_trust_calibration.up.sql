@@ -0,0 +1,31 @@
+-- Migration 000025: Source Trust Calibration
+-- Description: Correction and deletion events per source, and the history of trust score changes
+-- Date: 2026-10-15
+
+-- Articles are hard-deleted, so corrections and deletions are recorded against the source
+CREATE TABLE IF NOT EXISTS source_trust_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    article_id UUID NOT NULL,
+    event_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_source_trust_events_type CHECK (event_type IN ('corrected', 'deleted'))
+);
+
+CREATE INDEX idx_source_trust_events_source_created ON source_trust_events(source_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS source_trust_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    previous_score DECIMAL(3,2) NOT NULL,
+    new_score DECIMAL(3,2) NOT NULL,
+    target_score DECIMAL(3,2) NOT NULL,
+    signals JSONB NOT NULL DEFAULT '{}'::JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_source_trust_changes_source_created ON source_trust_changes(source_id, created_at DESC);
+
+COMMENT ON TABLE source_trust_events IS 'Article corrections and deletions, kept per source for trust calibration';
+COMMENT ON TABLE source_trust_changes IS 'Audit trail of automatic source trust score adjustments with the signals behind each';
@@ -0,0 +1,4 @@
+-- Migration 000026: Relevance Rules (Rollback)
+-- Description: Drop relevance scoring rulesets
+
+DROP TABLE IF EXISTS relevance_rules;
@@ -0,0 +1,17 @@
+-- Migration 000026: Relevance Rules
+-- Description: Admin-editable, versioned rulesets for the Armor relevance scorer
+-- Date: 2026-10-15
+
+-- The highest version is in use; without any row the built-in defaults apply
+CREATE TABLE IF NOT EXISTS relevance_rules (
+    version INTEGER PRIMARY KEY,
+    rules JSONB NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_relevance_rules_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_relevance_rules_version CHECK (version >= 1)
+);
+
+COMMENT ON TABLE relevance_rules IS 'Versioned relevance scoring rulesets: keywords, weights, severity boosts and CTA rules';
@@ -0,0 +1,5 @@
+-- Migration 000027: CTA Variants (Rollback)
+-- Description: Drop CTA variants and their statistics
+
+DROP TABLE IF EXISTS cta_variant_stats;
+DROP TABLE IF EXISTS cta_variants;
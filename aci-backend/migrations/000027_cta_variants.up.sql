@@ -0,0 +1,40 @@
+-- Migration 000027: CTA Variants
+-- Description: Weighted A/B variants of Armor CTAs with daily impression and click counts
+-- Date: 2026-10-15
+
+-- Variants replace the generated CTA whose URL equals base_url
+CREATE TABLE IF NOT EXISTS cta_variants (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    base_url VARCHAR(2048) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    cta_type VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 1,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_cta_variants_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_cta_variants_type CHECK (cta_type IN ('product', 'service', 'consultation')),
+    CONSTRAINT chk_cta_variants_weight CHECK (weight BETWEEN 1 AND 1000),
+    CONSTRAINT unique_cta_variant_name UNIQUE (base_url, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cta_variants_active ON cta_variants(base_url) WHERE is_active = true;
+
+CREATE TABLE IF NOT EXISTS cta_variant_stats (
+    variant_id UUID NOT NULL,
+    day DATE NOT NULL,
+    impressions BIGINT NOT NULL DEFAULT 0,
+    clicks BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (variant_id, day),
+    CONSTRAINT fk_cta_variant_stats_variant FOREIGN KEY (variant_id)
+        REFERENCES cta_variants(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE cta_variants IS 'A/B variants of generated Armor CTAs, assigned to readers by weight';
+COMMENT ON TABLE cta_variant_stats IS 'Daily impressions (article detail views) and clicks per CTA variant';
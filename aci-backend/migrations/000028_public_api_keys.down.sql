@@ -0,0 +1,4 @@
+-- Migration 000028: Public API Keys (Rollback)
+-- Description: Drop public API keys
+
+DROP TABLE IF EXISTS public_api_keys;
@@ -0,0 +1,24 @@
+-- Migration 000028: Public API Keys
+-- Description: API keys with per-key rate limits for the read-only public API
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS public_api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(20) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    requests_per_minute INTEGER NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_public_api_keys_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_public_api_keys_name_not_empty CHECK (LENGTH(name) >= 1),
+    CONSTRAINT chk_public_api_keys_rate CHECK (requests_per_minute BETWEEN 1 AND 100000),
+    CONSTRAINT unique_public_api_key_hash UNIQUE (key_hash)
+);
+
+COMMENT ON TABLE public_api_keys IS 'Keys for the public read-only API; only the SHA-256 hash of each key is stored';
+COMMENT ON COLUMN public_api_keys.key_prefix IS 'First characters of the key, shown to identify it';
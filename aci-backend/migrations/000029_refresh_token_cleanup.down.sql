@@ -0,0 +1,4 @@
+-- Migration 000029: Refresh Token Cleanup (Rollback)
+-- Description: Drop the revoked refresh token index
+
+DROP INDEX IF EXISTS idx_refresh_tokens_revoked_at;
@@ -0,0 +1,6 @@
+-- Migration 000029: Refresh Token Cleanup
+-- Description: Index revoked refresh tokens so the cleanup job can find them without a table scan
+-- Date: 2026-10-15
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_revoked_at ON refresh_tokens(revoked_at)
+    WHERE revoked_at IS NOT NULL;
@@ -0,0 +1,4 @@
+-- Migration 000030: Audit Outbox (Rollback)
+-- Description: Drop the audit outbox; staged entries not yet relayed are lost
+
+DROP TABLE IF EXISTS audit_outbox;
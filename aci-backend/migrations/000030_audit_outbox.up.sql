@@ -0,0 +1,23 @@
+-- Migration 000030: Audit Outbox
+-- Description: Stage audit logs in the transaction of the change they record; a relay moves them to audit_logs
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS audit_outbox (
+    id UUID PRIMARY KEY,
+    user_id UUID, -- no foreign key, so staging never fails; the relay drops IDs of deleted users
+    action VARCHAR(100) NOT NULL,
+    resource_type VARCHAR(100) NOT NULL,
+    resource_id UUID,
+    old_value JSONB,
+    new_value JSONB,
+    ip_address INET,
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Delivery state
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_outbox_due ON audit_outbox(next_attempt_at, created_at);
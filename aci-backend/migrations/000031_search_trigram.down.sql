@@ -0,0 +1,5 @@
+-- Migration 000031: Search Trigram Indexes (Rollback)
+-- Description: Drop the trigram indexes; the pg_trgm extension is kept since other objects may use it
+
+DROP INDEX IF EXISTS idx_tags_name_trgm;
+DROP INDEX IF EXISTS idx_articles_title_trgm;
@@ -0,0 +1,8 @@
+-- Migration 000031: Search Trigram Indexes
+-- Description: Trigram indexes for typo-tolerant article search and "did you mean" suggestions
+-- Date: 2026-10-15
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_articles_title_trgm ON articles USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tags_name_trgm ON tags USING GIN (name gin_trgm_ops);
@@ -0,0 +1,4 @@
+-- Migration 000032: Article Archives (Rollback)
+-- Description: Drop archive tracking; the archived objects stay in the bucket
+
+DROP TABLE IF EXISTS article_archives;
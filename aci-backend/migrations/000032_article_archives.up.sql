@@ -0,0 +1,21 @@
+-- Migration 000032: Article Archives
+-- Description: Track snapshots of article source pages kept in object storage
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_archives (
+    article_id UUID PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('archived', 'failed')),
+    html_key TEXT,
+    text_key TEXT,
+
+    -- Retry state of failed attempts
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+
+    archived_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_archives_retry ON article_archives(next_attempt_at) WHERE status = 'failed';
@@ -0,0 +1,7 @@
+-- Migration 000033: Article Images (Rollback)
+-- Description: Drop hero image columns; stored images stay in the bucket
+
+DROP TABLE IF EXISTS article_image_failures;
+DROP INDEX IF EXISTS idx_articles_image_pending;
+ALTER TABLE articles DROP COLUMN IF EXISTS image_key;
+ALTER TABLE articles DROP COLUMN IF EXISTS image_url;
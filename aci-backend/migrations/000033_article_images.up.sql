@@ -0,0 +1,20 @@
+-- Migration 000033: Article Images
+-- Description: Hero images fetched from ingested image URLs and stored resized in object storage
+-- Date: 2026-10-15
+
+-- image_url is the remote image from the webhook; image_key is the object key prefix of the
+-- resized copies, set once they are stored
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS image_url VARCHAR(2000);
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS image_key TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_articles_image_pending ON articles(created_at)
+    WHERE image_url IS NOT NULL AND image_key IS NULL;
+
+-- Retry state of images that could not be fetched or processed
+CREATE TABLE IF NOT EXISTS article_image_failures (
+    article_id UUID PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- Migration 000034: Webhook Nonces (Rollback)
+-- Description: Drop webhook replay tracking
+
+DROP TABLE IF EXISTS webhook_nonces;
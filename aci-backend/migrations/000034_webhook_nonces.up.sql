@@ -0,0 +1,12 @@
+-- Migration 000034: Webhook Nonces
+-- Description: Signatures of recently accepted n8n webhooks, so captured requests cannot be replayed
+-- Date: 2026-10-15
+
+-- A signature covers the request timestamp, so it is only kept until the timestamp falls
+-- outside the accepted clock skew; older requests are rejected before this table is checked
+CREATE TABLE IF NOT EXISTS webhook_nonces (
+    signature VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_nonces_expires_at ON webhook_nonces (expires_at);
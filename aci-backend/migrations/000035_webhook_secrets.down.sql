@@ -0,0 +1,5 @@
+-- Migration 000035: Webhook Secrets (Rollback)
+-- Description: Drop versioned webhook secrets; N8N_WEBHOOK_SECRET is used alone again
+
+ALTER TABLE webhook_logs DROP COLUMN IF EXISTS key_version;
+DROP TABLE IF EXISTS webhook_secrets;
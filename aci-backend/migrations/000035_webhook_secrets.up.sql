@@ -0,0 +1,18 @@
+-- Migration 000035: Webhook Secrets
+-- Description: Versioned n8n webhook secrets, so the secret can be rotated without rejecting deliveries
+-- Date: 2026-10-15
+
+-- The newest version is the primary secret; rotating sets expires_at on the versions it replaces,
+-- which stay valid until then. Version 0 is N8N_WEBHOOK_SECRET, recorded when it is first rotated
+CREATE TABLE IF NOT EXISTS webhook_secrets (
+    version INTEGER PRIMARY KEY,
+    secret TEXT NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_webhook_secrets_version CHECK (version >= 0)
+);
+
+-- The secret version that verified each webhook's signature
+ALTER TABLE webhook_logs ADD COLUMN IF NOT EXISTS key_version INTEGER;
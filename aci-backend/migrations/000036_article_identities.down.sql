@@ -0,0 +1,5 @@
+-- Migration 000036: Article Identities (Rollback)
+-- Description: Drop canonical source URLs and content hashes; duplicates are detected by exact source URL only
+
+DROP TABLE IF EXISTS article_content_hashes;
+DROP TABLE IF EXISTS article_source_urls;
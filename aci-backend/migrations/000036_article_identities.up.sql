@@ -0,0 +1,34 @@
+-- Migration 000036: Article Identities
+-- Description: Canonical source URLs and normalized content hashes, so an article arriving again
+-- under another URL (AMP pages, tracking parameters, redirects) is linked instead of stored twice
+-- Date: 2026-10-15
+
+-- Every canonical URL an article has been received under; the first is the one it was stored for
+CREATE TABLE IF NOT EXISTS article_source_urls (
+    canonical_url TEXT PRIMARY KEY,
+    article_id UUID NOT NULL,
+    source_url TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_source_urls_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_source_urls_article_id
+    ON article_source_urls(article_id);
+
+-- Articles too short to hash have no row
+CREATE TABLE IF NOT EXISTS article_content_hashes (
+    article_id UUID PRIMARY KEY,
+    content_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_content_hashes_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_content_hashes_content_hash
+    ON article_content_hashes(content_hash);
+
+COMMENT ON TABLE article_source_urls IS 'Canonical source URLs linked to the article stored for them';
+COMMENT ON COLUMN article_content_hashes.content_hash IS 'Hex SHA-256 of the article text, lowercased with punctuation and markup removed';
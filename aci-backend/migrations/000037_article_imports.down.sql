@@ -0,0 +1,4 @@
+-- Migration 000037: Article Imports (Rollback)
+-- Description: Drop article import progress; imports can no longer be resumed
+
+DROP TABLE IF EXISTS article_imports;
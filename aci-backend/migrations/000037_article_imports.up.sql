@@ -0,0 +1,29 @@
+-- Migration 000037: Article Imports
+-- Description: Resumable backfills of historical articles from JSONL and CSV dumps
+-- Date: 2026-10-15
+
+-- processed is the checkpoint a resumed import starts from; checksum covers the records
+-- before it, so a different file cannot be resumed by mistake
+CREATE TABLE IF NOT EXISTS article_imports (
+    id UUID PRIMARY KEY,
+    format VARCHAR(10) NOT NULL,
+    filename VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    processed INTEGER NOT NULL DEFAULT 0,
+    created INTEGER NOT NULL DEFAULT 0,
+    duplicates INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    checksum CHAR(64),
+    errors JSONB NOT NULL DEFAULT '[]',
+    error TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_article_imports_format CHECK (format IN ('jsonl', 'csv')),
+    CONSTRAINT chk_article_imports_status CHECK (status IN ('running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_imports_created_at
+    ON article_imports(created_at DESC);
@@ -0,0 +1,9 @@
+-- Migration 000038: Vendor Watchlist (Rollback)
+-- Description: Drop vendor watchlists and their alerts
+
+DELETE FROM alerts WHERE watchlist = true;
+
+DROP TABLE IF EXISTS vendor_watchlist;
+
+ALTER TABLE alerts
+    DROP COLUMN IF EXISTS watchlist;
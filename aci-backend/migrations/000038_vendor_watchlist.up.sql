@@ -0,0 +1,22 @@
+-- Migration 000038: Vendor Watchlist
+-- Description: Vendors each user runs, boosted in their feed and alerted on with raised priority
+-- Date: 2026-10-15
+
+-- Watchlist alerts are created and deleted with their watchlist entry; their matches are
+-- one priority level above an ordinary alert's
+ALTER TABLE alerts
+    ADD COLUMN IF NOT EXISTS watchlist BOOLEAN NOT NULL DEFAULT false;
+
+-- alert_id is cleared if the user deletes the alert directly; the vendor stays watched
+CREATE TABLE IF NOT EXISTS vendor_watchlist (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    vendor VARCHAR(255) NOT NULL,
+    alert_id UUID REFERENCES alerts(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_vendor_watchlist_vendor CHECK (LENGTH(TRIM(vendor)) >= 1)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_watchlist_user_vendor
+    ON vendor_watchlist(user_id, LOWER(vendor));
@@ -0,0 +1,4 @@
+-- Migration 000039: Known Exploited Vulnerabilities (Rollback)
+-- Description: Drop the local KEV catalog; articles are no longer flagged
+
+DROP TABLE IF EXISTS kev_vulnerabilities;
@@ -0,0 +1,22 @@
+-- Migration 000039: Known Exploited Vulnerabilities
+-- Description: Local copy of the CISA KEV catalog, synced on a schedule
+-- Date: 2026-10-15
+
+-- Articles are flagged at read time by joining their CVEs against this table, so a CVE
+-- added to the catalog flags articles ingested before it was listed
+CREATE TABLE IF NOT EXISTS kev_vulnerabilities (
+    cve_id VARCHAR(32) PRIMARY KEY,
+    vendor_project VARCHAR(255) NOT NULL DEFAULT '',
+    product VARCHAR(255) NOT NULL DEFAULT '',
+    vulnerability_name TEXT NOT NULL DEFAULT '',
+    short_description TEXT NOT NULL DEFAULT '',
+    required_action TEXT NOT NULL DEFAULT '',
+    date_added DATE NOT NULL,
+    due_date DATE,
+    known_ransomware_use BOOLEAN NOT NULL DEFAULT false,
+    notes TEXT NOT NULL DEFAULT '',
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_kev_vulnerabilities_date_added
+    ON kev_vulnerabilities(date_added DESC);
@@ -0,0 +1,11 @@
+-- Migration 000040: Public Exploits (Rollback)
+-- Description: Drop exploit alerts and the local copy of the public exploit feeds
+
+DELETE FROM alerts WHERE type = 'exploit';
+
+ALTER TABLE alerts DROP CONSTRAINT IF EXISTS chk_alert_type_valid;
+ALTER TABLE alerts ADD CONSTRAINT chk_alert_type_valid CHECK (
+    type IN ('keyword', 'cve', 'vendor', 'category', 'severity', 'source')
+);
+
+DROP TABLE IF EXISTS cve_exploits;
@@ -0,0 +1,24 @@
+-- Migration 000040: Public Exploits
+-- Description: Exploits and proofs of concept for CVEs from public feeds, and the exploit alert type
+-- Date: 2026-10-15
+
+-- Like the KEV catalog, articles are flagged at read time by joining their CVEs against this table
+CREATE TABLE IF NOT EXISTS cve_exploits (
+    cve_id VARCHAR(32) NOT NULL,
+    source VARCHAR(32) NOT NULL,
+    url TEXT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMP WITH TIME ZONE,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (cve_id, source, url),
+    CONSTRAINT chk_cve_exploits_source CHECK (source IN ('exploitdb', 'github'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_cve_exploits_source_synced_at
+    ON cve_exploits(source, synced_at);
+
+-- Exploit alerts match articles once a public exploit exists for one of their CVEs
+ALTER TABLE alerts DROP CONSTRAINT IF EXISTS chk_alert_type_valid;
+ALTER TABLE alerts ADD CONSTRAINT chk_alert_type_valid CHECK (
+    type IN ('keyword', 'cve', 'vendor', 'category', 'severity', 'source', 'exploit')
+);
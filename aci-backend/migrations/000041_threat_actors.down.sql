@@ -0,0 +1,5 @@
+-- Migration 000041: Threat Actors (Rollback)
+-- Description: Drop threat actor profiles and their article links
+
+DROP TABLE IF EXISTS article_threat_actors;
+DROP TABLE IF EXISTS threat_actors;
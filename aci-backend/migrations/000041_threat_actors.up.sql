@@ -0,0 +1,79 @@
+-- Migration 000041: Threat Actors
+-- Description: Threat actor and ransomware group profiles, seeded with a curated alias dictionary, linked to the articles that mention them
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS threat_actors (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(32) NOT NULL DEFAULT 'threat_actor',
+    -- Aliases are matched in article text; the name is too unless match_name is false,
+    -- for names that are common words such as "Play"
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    match_name BOOLEAN NOT NULL DEFAULT true,
+    motivation VARCHAR(50) NOT NULL DEFAULT 'unknown',
+    origin VARCHAR(255),
+    description TEXT,
+    curated BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_threat_actors_slug UNIQUE (slug),
+    CONSTRAINT chk_threat_actors_kind CHECK (kind IN ('ransomware_group', 'threat_actor')),
+    CONSTRAINT chk_threat_actors_motivation CHECK (motivation IN ('financial', 'espionage', 'hacktivism', 'terrorism', 'unknown')),
+    CONSTRAINT chk_threat_actors_name_not_empty CHECK (LENGTH(name) >= 1)
+);
+
+CREATE TABLE IF NOT EXISTS article_threat_actors (
+    article_id UUID NOT NULL,
+    actor_id UUID NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (article_id, actor_id, source),
+    CONSTRAINT fk_article_threat_actors_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_threat_actors_actor FOREIGN KEY (actor_id)
+        REFERENCES threat_actors(id) ON DELETE CASCADE,
+    CONSTRAINT chk_article_threat_actors_source CHECK (source IN ('dictionary', 'ai'))
+);
+
+-- Pivot from an actor to its articles
+CREATE INDEX IF NOT EXISTS idx_article_threat_actors_actor_id
+    ON article_threat_actors(actor_id);
+
+-- Curated alias dictionary
+INSERT INTO threat_actors (slug, name, kind, aliases, match_name, motivation, origin, curated) VALUES
+    ('lockbit', 'LockBit', 'ransomware_group', '{"LockBit 2.0","LockBit 3.0","LockBit Black","LockBit Green"}', true, 'financial', NULL, true),
+    ('alphv', 'ALPHV', 'ransomware_group', '{"BlackCat","Noberus"}', true, 'financial', NULL, true),
+    ('clop', 'Cl0p', 'ransomware_group', '{"Clop"}', true, 'financial', NULL, true),
+    ('black-basta', 'Black Basta', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('akira', 'Akira', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('play', 'Play', 'ransomware_group', '{"Play ransomware","PlayCrypt"}', false, 'financial', NULL, true),
+    ('royal', 'Royal', 'ransomware_group', '{"Royal ransomware"}', false, 'financial', NULL, true),
+    ('blacksuit', 'BlackSuit', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('rhysida', 'Rhysida', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('medusa', 'Medusa', 'ransomware_group', '{"Medusa ransomware"}', false, 'financial', NULL, true),
+    ('8base', '8Base', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('ransomhub', 'RansomHub', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('qilin', 'Qilin', 'ransomware_group', '{"Agenda ransomware"}', true, 'financial', NULL, true),
+    ('conti', 'Conti', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('revil', 'REvil', 'ransomware_group', '{"Sodinokibi"}', true, 'financial', NULL, true),
+    ('hunters-international', 'Hunters International', 'ransomware_group', '{}', true, 'financial', NULL, true),
+    ('scattered-spider', 'Scattered Spider', 'threat_actor', '{"UNC3944","Octo Tempest","Muddled Libra","0ktapus"}', true, 'financial', NULL, true),
+    ('lapsus', 'LAPSUS$', 'threat_actor', '{"Lapsus","DEV-0537","Strawberry Tempest"}', true, 'financial', NULL, true),
+    ('fin7', 'FIN7', 'threat_actor', '{"Carbanak","Sangria Tempest"}', true, 'financial', NULL, true),
+    ('apt28', 'APT28', 'threat_actor', '{"Fancy Bear","Sofacy","Sednit","Forest Blizzard","Pawn Storm"}', true, 'espionage', 'Russia', true),
+    ('apt29', 'APT29', 'threat_actor', '{"Cozy Bear","Midnight Blizzard","Nobelium"}', true, 'espionage', 'Russia', true),
+    ('sandworm', 'Sandworm', 'threat_actor', '{"APT44","Seashell Blizzard","Voodoo Bear"}', true, 'espionage', 'Russia', true),
+    ('lazarus', 'Lazarus Group', 'threat_actor', '{"Lazarus","Hidden Cobra"}', true, 'espionage', 'North Korea', true),
+    ('kimsuky', 'Kimsuky', 'threat_actor', '{"Velvet Chollima","Emerald Sleet"}', true, 'espionage', 'North Korea', true),
+    ('volt-typhoon', 'Volt Typhoon', 'threat_actor', '{"Bronze Silhouette","Vanguard Panda"}', true, 'espionage', 'China', true),
+    ('salt-typhoon', 'Salt Typhoon', 'threat_actor', '{"GhostEmperor","FamousSparrow"}', true, 'espionage', 'China', true),
+    ('apt41', 'APT41', 'threat_actor', '{"Double Dragon","Wicked Panda","Brass Typhoon"}', true, 'espionage', 'China', true),
+    ('charming-kitten', 'Charming Kitten', 'threat_actor', '{"APT35","Mint Sandstorm"}', true, 'espionage', 'Iran', true),
+    ('muddywater', 'MuddyWater', 'threat_actor', '{"Mango Sandstorm","Static Kitten","Seedworm"}', true, 'espionage', 'Iran', true)
+ON CONFLICT (slug) DO NOTHING;
+
+COMMENT ON TABLE threat_actors IS 'Threat actor and ransomware group profiles; curated rows form the alias dictionary';
+COMMENT ON TABLE article_threat_actors IS 'Articles mentioning each actor, by dictionary match or AI enrichment';
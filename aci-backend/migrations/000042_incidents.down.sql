@@ -0,0 +1,5 @@
+-- Migration 000042: Incidents (Rollback)
+-- Description: Drop incidents and their article links
+
+DROP TABLE IF EXISTS incident_articles;
+DROP TABLE IF EXISTS incidents;
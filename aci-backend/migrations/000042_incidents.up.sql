@@ -0,0 +1,45 @@
+-- Migration 000042: Incidents
+-- Description: Incidents group the articles covering the same breach or campaign into a timeline
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(255) NOT NULL,
+    -- Editor-written summary shown in place of the one merged from the articles
+    summary TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'ongoing',
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_incidents_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_incidents_status CHECK (status IN ('ongoing', 'resolved'))
+);
+
+CREATE TABLE IF NOT EXISTS incident_articles (
+    incident_id UUID NOT NULL,
+    article_id UUID NOT NULL,
+    added_by UUID,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (incident_id, article_id),
+
+    CONSTRAINT fk_incident_articles_incident FOREIGN KEY (incident_id)
+        REFERENCES incidents(id) ON DELETE CASCADE,
+    CONSTRAINT fk_incident_articles_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_incident_articles_added_by FOREIGN KEY (added_by)
+        REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_incidents_status ON incidents(status, updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_incident_articles_article_id ON incident_articles(article_id);
+
+CREATE TRIGGER update_incidents_updated_at
+    BEFORE UPDATE ON incidents
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE incidents IS 'Ongoing or resolved breaches and campaigns, each linking the articles that cover it';
+COMMENT ON TABLE incident_articles IS 'Articles linked to an incident by an admin';
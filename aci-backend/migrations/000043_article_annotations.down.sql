@@ -0,0 +1,4 @@
+-- Migration 000043: Article Annotations (Rollback)
+-- Description: Drop article annotations
+
+DROP TABLE IF EXISTS article_annotations;
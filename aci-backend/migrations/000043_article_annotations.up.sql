@@ -0,0 +1,37 @@
+-- Migration 000043: Article Annotations
+-- Description: Internal analyst comments and highlights on articles, shared within the analyst's organization
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_annotations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    article_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    -- Highlighted passage of the article the annotation refers to
+    quote TEXT,
+    mentions UUID[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_annotations_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_annotations_organization FOREIGN KEY (organization_id)
+        REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_annotations_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_article_annotations_content CHECK (body <> '' OR quote IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_annotations_article
+    ON article_annotations(organization_id, article_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_article_annotations_recent
+    ON article_annotations(organization_id, created_at DESC);
+
+CREATE TRIGGER update_article_annotations_updated_at
+    BEFORE UPDATE ON article_annotations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE article_annotations IS 'Analyst comments and highlights on articles, visible to the analyst''s organization';
+COMMENT ON COLUMN article_annotations.mentions IS 'Organization members mentioned in the annotation, notified when added';
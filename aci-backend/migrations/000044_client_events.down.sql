@@ -0,0 +1,6 @@
+-- Migration 000044: Client Events (Rollback)
+-- Description: Drop client events, their partitions and daily aggregates
+
+DROP TABLE IF EXISTS client_event_daily_stats;
+DROP FUNCTION IF EXISTS create_client_events_partition(DATE);
+DROP TABLE IF EXISTS client_events;
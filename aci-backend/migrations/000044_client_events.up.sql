@@ -0,0 +1,66 @@
+-- Migration 000044: Client Events
+-- Description: Engagement events reported by clients, partitioned by month, and their daily aggregates
+-- Date: 2026-10-15
+
+-- No foreign keys: events are append-only and written in bulk, and the aggregates
+-- outlive the raw events once their partitions are dropped
+CREATE TABLE IF NOT EXISTS client_events (
+    id UUID NOT NULL DEFAULT uuid_generate_v4(),
+    event_type VARCHAR(20) NOT NULL,
+    article_id UUID NOT NULL,
+    user_id UUID,
+    session_id VARCHAR(64),
+    -- Deepest point of the article reached, as a percentage (scroll events)
+    scroll_depth SMALLINT,
+    -- Where the article was shared to (share events)
+    channel VARCHAR(32),
+    -- CTA variant clicked (cta_click events)
+    variant_id UUID,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (id, occurred_at),
+    CONSTRAINT chk_client_events_type CHECK (event_type IN ('view', 'scroll', 'share', 'cta_click')),
+    CONSTRAINT chk_client_events_scroll_depth CHECK (scroll_depth IS NULL OR scroll_depth BETWEEN 0 AND 100)
+) PARTITION BY RANGE (occurred_at);
+
+CREATE INDEX IF NOT EXISTS idx_client_events_occurred_at ON client_events(occurred_at);
+
+-- Creates the monthly partition holding the given month, if it does not exist yet
+CREATE OR REPLACE FUNCTION create_client_events_partition(month DATE)
+RETURNS VOID AS $$
+DECLARE
+    month_start DATE := date_trunc('month', month)::date;
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS %I PARTITION OF client_events FOR VALUES FROM (%L) TO (%L)',
+        'client_events_' || to_char(month_start, 'YYYY_MM'),
+        month_start::timestamptz,
+        (month_start + INTERVAL '1 month')::timestamptz
+    );
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT create_client_events_partition((CURRENT_DATE - INTERVAL '1 month')::date);
+SELECT create_client_events_partition(CURRENT_DATE);
+SELECT create_client_events_partition((CURRENT_DATE + INTERVAL '1 month')::date);
+
+CREATE TABLE IF NOT EXISTS client_event_daily_stats (
+    day DATE NOT NULL,
+    article_id UUID NOT NULL,
+    event_type VARCHAR(20) NOT NULL,
+    events INTEGER NOT NULL DEFAULT 0,
+    unique_users INTEGER NOT NULL DEFAULT 0,
+    unique_sessions INTEGER NOT NULL DEFAULT 0,
+    avg_scroll_depth NUMERIC(5, 2),
+    aggregated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (day, article_id, event_type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_event_daily_stats_article
+    ON client_event_daily_stats(article_id, day);
+
+COMMENT ON TABLE client_events IS 'Engagement events reported by clients (view, scroll, share, cta_click), one partition per month';
+COMMENT ON TABLE client_event_daily_stats IS 'Client events aggregated per UTC day, article and event type by the aggregation job';
+COMMENT ON COLUMN client_event_daily_stats.unique_users IS 'Distinct signed-in users that day; not additive across days';
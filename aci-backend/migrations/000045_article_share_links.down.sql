@@ -0,0 +1,5 @@
+-- Migration 000045: Article Share Links (Rollback)
+-- Description: Drop article share links and their clicks
+
+DROP TABLE IF EXISTS article_share_clicks;
+DROP TABLE IF EXISTS article_share_links;
@@ -0,0 +1,43 @@
+-- Migration 000045: Article Share Links
+-- Description: Short signed share links for articles and the clicks recorded when they are followed
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_share_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(16) UNIQUE NOT NULL,
+    article_id UUID NOT NULL,
+    created_by UUID,
+    -- Where the link is meant to be posted, e.g. linkedin or email
+    channel VARCHAR(32),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    click_count INTEGER NOT NULL DEFAULT 0,
+    last_clicked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_share_links_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_share_links_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_share_links_article ON article_share_links(article_id);
+CREATE INDEX IF NOT EXISTS idx_article_share_links_created_at ON article_share_links(created_at);
+
+CREATE TABLE IF NOT EXISTS article_share_clicks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    share_link_id UUID NOT NULL,
+    -- The src query parameter of the followed link, else the link's channel, else direct
+    source VARCHAR(64) NOT NULL,
+    referrer_host VARCHAR(255),
+    clicked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_article_share_clicks_link FOREIGN KEY (share_link_id)
+        REFERENCES article_share_links(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_share_clicks_link ON article_share_clicks(share_link_id);
+CREATE INDEX IF NOT EXISTS idx_article_share_clicks_clicked_at ON article_share_clicks(clicked_at);
+
+COMMENT ON TABLE article_share_links IS 'Short signed links to articles, optionally expiring, created by readers to share them';
+COMMENT ON TABLE article_share_clicks IS 'One row per followed share link, with where the visitor came from';
+COMMENT ON COLUMN article_share_clicks.referrer_host IS 'Host of the Referer header only; the full referring URL is not kept';
@@ -0,0 +1,7 @@
+-- Migration 000046: Newsletters (Rollback)
+-- Description: Drop newsletter subscribers, campaigns, deliveries and clicks
+
+DROP TABLE IF EXISTS newsletter_clicks;
+DROP TABLE IF EXISTS newsletter_deliveries;
+DROP TABLE IF EXISTS newsletter_campaigns;
+DROP TABLE IF EXISTS newsletter_subscribers;
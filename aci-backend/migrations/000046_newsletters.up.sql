@@ -0,0 +1,100 @@
+-- Migration 000046: Newsletters
+-- Description: Opted-in newsletter subscribers, admin-composed campaigns, and per-recipient delivery, open and click tracking
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS newsletter_subscribers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    email VARCHAR(255) NOT NULL,
+    name VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'subscribed',
+    -- How the subscriber opted in, e.g. signup-form or event-2026
+    consent_source VARCHAR(100) NOT NULL,
+    subscribed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    unsubscribed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_newsletter_subscribers_status CHECK (status IN ('subscribed', 'unsubscribed'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_newsletter_subscribers_email ON newsletter_subscribers(LOWER(email));
+CREATE INDEX IF NOT EXISTS idx_newsletter_subscribers_status ON newsletter_subscribers(status, created_at DESC);
+
+CREATE TRIGGER update_newsletter_subscribers_updated_at
+    BEFORE UPDATE ON newsletter_subscribers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS newsletter_campaigns (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subject VARCHAR(200) NOT NULL,
+    preheader VARCHAR(200),
+    intro TEXT,
+    template VARCHAR(50) NOT NULL,
+    -- Articles in the order they appear in the newsletter
+    article_ids UUID[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    created_by UUID,
+    sent_by UUID,
+    send_started_at TIMESTAMP WITH TIME ZONE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_newsletter_campaigns_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_newsletter_campaigns_sent_by FOREIGN KEY (sent_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_newsletter_campaigns_status CHECK (status IN ('draft', 'sending', 'sent'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_newsletter_campaigns_created_at ON newsletter_campaigns(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_newsletter_campaigns_sending ON newsletter_campaigns(status) WHERE status = 'sending';
+
+CREATE TRIGGER update_newsletter_campaigns_updated_at
+    BEFORE UPDATE ON newsletter_campaigns
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS newsletter_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    campaign_id UUID NOT NULL,
+    subscriber_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    error TEXT,
+    -- Set while a sender holds the delivery; a stale claim is picked up again
+    claimed_at TIMESTAMP WITH TIME ZONE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    opened_at TIMESTAMP WITH TIME ZONE,
+    open_count INTEGER NOT NULL DEFAULT 0,
+    clicked_at TIMESTAMP WITH TIME ZONE,
+    click_count INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT fk_newsletter_deliveries_campaign FOREIGN KEY (campaign_id)
+        REFERENCES newsletter_campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT fk_newsletter_deliveries_subscriber FOREIGN KEY (subscriber_id)
+        REFERENCES newsletter_subscribers(id) ON DELETE CASCADE,
+    CONSTRAINT uq_newsletter_deliveries_recipient UNIQUE (campaign_id, subscriber_id),
+    CONSTRAINT chk_newsletter_deliveries_status CHECK (status IN ('pending', 'sending', 'sent', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_newsletter_deliveries_unsent
+    ON newsletter_deliveries(status, claimed_at) WHERE status IN ('pending', 'sending');
+
+CREATE TABLE IF NOT EXISTS newsletter_clicks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    delivery_id UUID NOT NULL,
+    article_id UUID NOT NULL,
+    clicked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_newsletter_clicks_delivery FOREIGN KEY (delivery_id)
+        REFERENCES newsletter_deliveries(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_newsletter_clicks_delivery ON newsletter_clicks(delivery_id);
+
+COMMENT ON TABLE newsletter_subscribers IS 'Newsletter recipients who opted in; unsubscribed rows are kept so they are never mailed again';
+COMMENT ON TABLE newsletter_campaigns IS 'Newsletters composed by admins from selected articles, kept for history';
+COMMENT ON TABLE newsletter_deliveries IS 'One row per campaign recipient; its ID is the token in the recipient''s tracking and unsubscribe links';
+COMMENT ON TABLE newsletter_clicks IS 'Article links followed from a newsletter';
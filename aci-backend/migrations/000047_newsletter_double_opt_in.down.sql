@@ -0,0 +1,14 @@
+-- Migration 000047: Newsletter Double Opt-In (Rollback)
+-- Description: Drop the suppression list and pending subscriptions
+
+DROP TABLE IF EXISTS newsletter_suppressions;
+
+DELETE FROM newsletter_subscribers WHERE status = 'pending';
+
+ALTER TABLE newsletter_subscribers
+    DROP COLUMN IF EXISTS confirmed_at,
+    DROP COLUMN IF EXISTS confirmation_sent_at;
+
+ALTER TABLE newsletter_subscribers DROP CONSTRAINT IF EXISTS chk_newsletter_subscribers_status;
+ALTER TABLE newsletter_subscribers
+    ADD CONSTRAINT chk_newsletter_subscribers_status CHECK (status IN ('subscribed', 'unsubscribed'));
@@ -0,0 +1,32 @@
+-- Migration 000047: Newsletter Double Opt-In
+-- Description: Pending newsletter subscriptions awaiting email confirmation and a suppression list for bounced or complaining addresses
+-- Date: 2026-10-15
+
+-- Public sign-ups stay pending until the address is confirmed
+ALTER TABLE newsletter_subscribers DROP CONSTRAINT IF EXISTS chk_newsletter_subscribers_status;
+ALTER TABLE newsletter_subscribers
+    ADD CONSTRAINT chk_newsletter_subscribers_status CHECK (status IN ('pending', 'subscribed', 'unsubscribed'));
+
+ALTER TABLE newsletter_subscribers
+    ADD COLUMN IF NOT EXISTS confirmation_sent_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS confirmed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS newsletter_suppressions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    email VARCHAR(255) NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    details TEXT,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_newsletter_suppressions_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_newsletter_suppressions_reason CHECK (reason IN ('bounce', 'complaint', 'manual'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_newsletter_suppressions_email ON newsletter_suppressions(LOWER(email));
+CREATE INDEX IF NOT EXISTS idx_newsletter_suppressions_created_at ON newsletter_suppressions(created_at DESC);
+
+COMMENT ON COLUMN newsletter_subscribers.confirmation_sent_at IS 'When the last double opt-in confirmation email was sent';
+COMMENT ON COLUMN newsletter_subscribers.confirmed_at IS 'When the subscriber confirmed their address; NULL for subscribers added by admins';
+COMMENT ON TABLE newsletter_suppressions IS 'Addresses that are never mailed: hard bounces, spam complaints and manual blocks';
@@ -0,0 +1,4 @@
+-- Migration 000048: CRM Events (Rollback)
+-- Description: Drop the CRM event queue
+
+DROP TABLE IF EXISTS crm_events;
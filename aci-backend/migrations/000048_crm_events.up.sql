@@ -0,0 +1,41 @@
+-- Migration 000048: CRM Events
+-- Description: Queue of CTA clicks and lead form submissions pushed to the configured CRM, kept as a record once delivered and as a failure queue when delivery gives up
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS crm_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    -- CRM the event is pushed to: hubspot or salesforce
+    connector VARCHAR(20) NOT NULL,
+    conversion_type VARCHAR(20) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    -- The contact and activity as pushed (domain.CRMConversion)
+    payload JSONB NOT NULL,
+    user_id UUID,
+    article_id UUID,
+
+    -- Delivery state
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Events are personal data, so they go with the account that produced them
+    CONSTRAINT fk_crm_events_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_crm_events_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE SET NULL,
+    CONSTRAINT chk_crm_events_conversion_type CHECK (conversion_type IN ('cta_click', 'lead_form')),
+    CONSTRAINT chk_crm_events_status CHECK (status IN ('pending', 'delivered', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_crm_events_due ON crm_events(next_attempt_at, created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_crm_events_status ON crm_events(status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_crm_events_recent ON crm_events(LOWER(email), conversion_type, article_id, created_at DESC);
+
+CREATE TRIGGER update_crm_events_updated_at
+    BEFORE UPDATE ON crm_events
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
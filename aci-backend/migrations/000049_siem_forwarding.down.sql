@@ -0,0 +1,5 @@
+-- Migration 000049: SIEM Forwarding (Rollback)
+-- Description: Drop the SIEM event queue and destination toggles
+
+DROP TABLE IF EXISTS siem_destinations;
+DROP TABLE IF EXISTS siem_events;
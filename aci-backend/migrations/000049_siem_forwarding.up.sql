@@ -0,0 +1,40 @@
+-- Migration 000049: SIEM Forwarding
+-- Description: Queue of enriched article and IOC events forwarded to Splunk and Elasticsearch, and the admin toggle of each destination
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS siem_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    -- Destination the event is forwarded to: splunk or elasticsearch
+    destination VARCHAR(20) NOT NULL,
+    event_type VARCHAR(30) NOT NULL,
+    -- The event as forwarded (domain.SIEMArticle or domain.SIEMIndicator)
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Delivery state
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_siem_events_event_type CHECK (event_type IN ('article.enriched', 'ioc.observed')),
+    CONSTRAINT chk_siem_events_status CHECK (status IN ('pending', 'delivered', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_siem_events_due ON siem_events(destination, next_attempt_at, created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_siem_events_status ON siem_events(destination, status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_siem_events_delivered ON siem_events(delivered_at) WHERE status = 'delivered';
+
+-- Destinations are configured in the environment; a row records an admin turning one off or on.
+-- Destinations without a row are enabled
+CREATE TABLE IF NOT EXISTS siem_destinations (
+    name VARCHAR(20) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL,
+    updated_by UUID,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_siem_destinations_updated_by FOREIGN KEY (updated_by)
+        REFERENCES users(id) ON DELETE SET NULL
+);
@@ -0,0 +1,5 @@
+-- Migration 000050: Weekly Reports (Rollback)
+-- Description: Drop the weekly briefing delivery record
+
+DROP INDEX IF EXISTS idx_notification_preferences_digest;
+DROP TABLE IF EXISTS weekly_report_deliveries;
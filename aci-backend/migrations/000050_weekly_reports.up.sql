@@ -0,0 +1,19 @@
+-- Migration 000050: Weekly Reports
+-- Description: Record of the weekly threat briefings emailed to each reader, so each week is sent once
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS weekly_report_deliveries (
+    user_id UUID NOT NULL,
+    -- Start (Monday 00:00 UTC) of the week the briefing covers
+    period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, period_start),
+    CONSTRAINT fk_weekly_report_deliveries_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_weekly_report_deliveries_period ON weekly_report_deliveries(period_start);
+
+-- Weekly digest readers are looked up by frequency
+CREATE INDEX IF NOT EXISTS idx_notification_preferences_digest ON notification_preferences(digest_frequency);
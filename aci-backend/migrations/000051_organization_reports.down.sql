@@ -0,0 +1,5 @@
+-- Migration 000051: Organization Reports (Rollback)
+-- Description: Drop organization report templates and their runs
+
+DROP TABLE IF EXISTS organization_report_runs;
+DROP TABLE IF EXISTS organization_reports;
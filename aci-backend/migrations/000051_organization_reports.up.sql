@@ -0,0 +1,59 @@
+-- Migration 000051: Organization Reports
+-- Description: Custom report templates defined by organization admins, delivered on a schedule, and the history of their runs
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS organization_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    -- Severities, category subtrees and vendors the report's articles are limited to
+    filters JSONB NOT NULL DEFAULT '{}',
+    sections TEXT[] NOT NULL,
+    recipients TEXT[] NOT NULL DEFAULT '{}',
+    cadence VARCHAR(20) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_organization_reports_organization FOREIGN KEY (organization_id)
+        REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_organization_reports_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_organization_reports_cadence CHECK (cadence IN ('daily', 'weekly', 'monthly'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_reports_organization ON organization_reports(organization_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_organization_reports_due ON organization_reports(next_run_at) WHERE enabled = true;
+
+CREATE TABLE IF NOT EXISTS organization_report_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL,
+    period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    period_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    trigger VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    requested_by UUID,
+    document BYTEA,
+    recipients_sent INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_organization_report_runs_report FOREIGN KEY (report_id)
+        REFERENCES organization_reports(id) ON DELETE CASCADE,
+    CONSTRAINT fk_organization_report_runs_requested_by FOREIGN KEY (requested_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_organization_report_runs_period CHECK (period_end > period_start),
+    CONSTRAINT chk_organization_report_runs_trigger CHECK (trigger IN ('scheduled', 'manual')),
+    CONSTRAINT chk_organization_report_runs_status CHECK (status IN ('running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_report_runs_report ON organization_report_runs(report_id, created_at DESC);
+
+COMMENT ON TABLE organization_reports IS 'Report templates defined by organization admins and generated on their cadence';
+COMMENT ON COLUMN organization_reports.next_run_at IS 'End of the next period to report on; the scheduler generates the report once it has passed';
+COMMENT ON TABLE organization_report_runs IS 'Generated organization reports, scheduled or re-run for a chosen date range';
+COMMENT ON COLUMN organization_report_runs.document IS 'The rendered PDF; NULL until the run completes';
@@ -0,0 +1,13 @@
+-- Migration 000052: User Status (Rollback)
+-- Description: Remove suspension and ban state from user accounts
+
+DROP INDEX IF EXISTS idx_users_status_blocked;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS fk_users_status_changed_by,
+    DROP CONSTRAINT IF EXISTS chk_users_status_valid,
+    DROP COLUMN IF EXISTS status_changed_by,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS suspended_until,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,25 @@
+-- Migration 000052: User Status
+-- Description: Suspension and ban state on user accounts, kept separate from deletion
+-- Date: 2026-10-15
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS status_reason TEXT,
+    ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS status_changed_by UUID;
+
+ALTER TABLE users
+    ADD CONSTRAINT chk_users_status_valid CHECK (
+        status IN ('active', 'suspended', 'banned')
+    ),
+    ADD CONSTRAINT fk_users_status_changed_by FOREIGN KEY (status_changed_by)
+        REFERENCES users(id) ON DELETE SET NULL;
+
+-- Admin listing of blocked accounts
+CREATE INDEX IF NOT EXISTS idx_users_status_blocked
+    ON users(status)
+    WHERE status <> 'active';
+
+COMMENT ON COLUMN users.status IS 'Account status: active, suspended, banned';
+COMMENT ON COLUMN users.suspended_until IS 'When a suspension lifts on its own; NULL suspends until lifted by an admin';
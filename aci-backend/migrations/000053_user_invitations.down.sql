@@ -0,0 +1,14 @@
+-- Migration 000053: User Invitations (Rollback)
+-- Description: Remove the invited status; invitations that were never accepted are deleted
+
+DELETE FROM users WHERE status = 'invited';
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_status_valid;
+
+ALTER TABLE users
+    ADD CONSTRAINT chk_users_status_valid CHECK (
+        status IN ('active', 'suspended', 'banned')
+    );
+
+COMMENT ON COLUMN users.status IS 'Account status: active, suspended, banned';
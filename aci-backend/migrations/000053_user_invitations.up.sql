@@ -0,0 +1,13 @@
+-- Migration 000053: User Invitations
+-- Description: Invited status for accounts created by an admin, active once the invitee sets a password
+-- Date: 2026-10-15
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_status_valid;
+
+ALTER TABLE users
+    ADD CONSTRAINT chk_users_status_valid CHECK (
+        status IN ('active', 'suspended', 'banned', 'invited')
+    );
+
+COMMENT ON COLUMN users.status IS 'Account status: active, suspended, banned, invited';
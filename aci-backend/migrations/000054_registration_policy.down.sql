@@ -0,0 +1,4 @@
+-- Migration 000054: Registration Policy (Rollback)
+-- Description: Remove the registration policy override; the REGISTRATION_* settings apply again
+
+DROP TABLE IF EXISTS registration_policy;
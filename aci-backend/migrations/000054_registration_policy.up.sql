@@ -0,0 +1,17 @@
+-- Migration 000054: Registration Policy
+-- Description: Admin override of the self-registration toggle and allowed email domains
+-- Date: 2026-10-15
+
+-- Holds at most one row; without it the REGISTRATION_* settings apply
+CREATE TABLE IF NOT EXISTS registration_policy (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE,
+    enabled BOOLEAN NOT NULL,
+    allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_registration_policy_single_row CHECK (id)
+);
+
+COMMENT ON TABLE registration_policy IS 'Admin override of the self-registration settings; at most one row';
+COMMENT ON COLUMN registration_policy.allowed_domains IS 'Lowercased email domains allowed to register; empty allows any';
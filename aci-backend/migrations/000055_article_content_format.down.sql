@@ -0,0 +1,6 @@
+-- Migration 000055: Article Content Format (Rollback)
+-- Description: Drop the markdown source; articles keep their rendered HTML content
+
+ALTER TABLE articles DROP CONSTRAINT IF EXISTS chk_articles_content_format;
+ALTER TABLE articles DROP COLUMN IF EXISTS content_markdown;
+ALTER TABLE articles DROP COLUMN IF EXISTS content_format;
@@ -0,0 +1,17 @@
+-- Migration 000055: Article Content Format
+-- Description: Articles written in markdown keep their source next to the rendered HTML
+-- Date: 2026-10-15
+
+-- content always holds sanitized HTML; markdown articles also keep the source they were
+-- rendered from, so editors can change it and clients can ask for it
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_format VARCHAR(16) NOT NULL DEFAULT 'html';
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_markdown TEXT;
+
+ALTER TABLE articles DROP CONSTRAINT IF EXISTS chk_articles_content_format;
+ALTER TABLE articles ADD CONSTRAINT chk_articles_content_format CHECK (
+    (content_format = 'html' AND content_markdown IS NULL) OR
+    (content_format = 'markdown' AND content_markdown IS NOT NULL)
+);
+
+COMMENT ON COLUMN articles.content_format IS 'Format the content was written in: html or markdown';
+COMMENT ON COLUMN articles.content_markdown IS 'Markdown source of content; NULL for HTML articles';
@@ -0,0 +1,6 @@
+-- Migration 000056: Article Content Metrics (Rollback)
+-- Description: Drop the measured content metrics
+
+ALTER TABLE articles DROP COLUMN IF EXISTS external_links;
+ALTER TABLE articles DROP COLUMN IF EXISTS language;
+ALTER TABLE articles DROP COLUMN IF EXISTS word_count;
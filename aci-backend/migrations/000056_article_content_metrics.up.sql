@@ -0,0 +1,18 @@
+-- Migration 000056: Article Content Metrics
+-- Description: Word count, detected language and outbound links measured from article content
+-- Date: 2026-10-15
+
+-- Measured by the API whenever content is set; existing articles get an approximate word
+-- count here, and their language and links once their content is next updated
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS word_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS language VARCHAR(8);
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS external_links TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE articles
+SET word_count = COALESCE(array_length(
+    regexp_split_to_array(btrim(regexp_replace(content, '<[^>]*>', ' ', 'g')), '\s+'), 1), 0)
+WHERE word_count = 0 AND btrim(regexp_replace(content, '<[^>]*>', ' ', 'g')) <> '';
+
+COMMENT ON COLUMN articles.word_count IS 'Words in content as readers see it';
+COMMENT ON COLUMN articles.language IS 'ISO 639-1 language detected from content; NULL when unknown';
+COMMENT ON COLUMN articles.external_links IS 'Absolute http(s) link targets in content, in order of appearance';
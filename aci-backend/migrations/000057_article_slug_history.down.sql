@@ -0,0 +1,4 @@
+-- Migration 000057: Article Slug History (Rollback)
+-- Description: Drop former article slugs; links to them stop resolving
+
+DROP TABLE IF EXISTS article_slug_history;
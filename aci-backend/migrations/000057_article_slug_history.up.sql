@@ -0,0 +1,13 @@
+-- Migration 000057: Article Slug History
+-- Description: Former article slugs, so links to them redirect to the current slug
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS article_slug_history (
+    slug VARCHAR(600) PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_slug_history_article ON article_slug_history(article_id);
+
+COMMENT ON TABLE article_slug_history IS 'Slugs articles used before; a former slug is never given to another article';
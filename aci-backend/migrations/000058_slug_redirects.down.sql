@@ -0,0 +1,8 @@
+-- Migration 000058: Slug Redirects (Rollback)
+-- Description: Rename slug_redirects back to article_slug_history
+
+ALTER INDEX IF EXISTS slug_redirects_pkey RENAME TO article_slug_history_pkey;
+ALTER INDEX IF EXISTS idx_slug_redirects_article RENAME TO idx_article_slug_history_article;
+ALTER TABLE IF EXISTS slug_redirects RENAME TO article_slug_history;
+
+COMMENT ON TABLE article_slug_history IS 'Slugs articles used before; a former slug is never given to another article';
@@ -0,0 +1,9 @@
+-- Migration 000058: Slug Redirects
+-- Description: Article slug history becomes slug_redirects, resolved transparently by the article endpoints
+-- Date: 2026-10-15
+
+ALTER TABLE IF EXISTS article_slug_history RENAME TO slug_redirects;
+ALTER INDEX IF EXISTS idx_article_slug_history_article RENAME TO idx_slug_redirects_article;
+ALTER INDEX IF EXISTS article_slug_history_pkey RENAME TO slug_redirects_pkey;
+
+COMMENT ON TABLE slug_redirects IS 'Former article slugs and the article they resolve to; a former slug is never given to another article';
@@ -0,0 +1,5 @@
+-- Migration 000059: Enrichment Feedback (Rollback)
+-- Description: Drop enrichment feedback
+
+DROP INDEX IF EXISTS idx_articles_enriched_at;
+DROP TABLE IF EXISTS enrichment_feedback;
@@ -0,0 +1,45 @@
+-- Migration 000059: Enrichment Feedback
+-- Description: Analyst flags on wrong AI enrichment output, used to hide bogus IOCs from exports and to measure accuracy
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS enrichment_feedback (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    article_id UUID NOT NULL,
+    field VARCHAR(30) NOT NULL,
+    ioc_type VARCHAR(20),
+    ioc_value TEXT,
+    comment TEXT,
+    -- Enrichment run the feedback was given on
+    enriched_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_enrichment_feedback_article FOREIGN KEY (article_id)
+        REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_enrichment_feedback_user FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_enrichment_feedback_field CHECK (field IN (
+        'threat_type', 'attack_vector', 'impact_assessment', 'recommended_actions', 'ioc', 'armor_cta'
+    )),
+    CONSTRAINT chk_enrichment_feedback_ioc CHECK (
+        (field = 'ioc') = (ioc_type IS NOT NULL AND ioc_value IS NOT NULL)
+    )
+);
+
+-- One flag per user, article and field (or indicator)
+CREATE UNIQUE INDEX IF NOT EXISTS uq_enrichment_feedback
+    ON enrichment_feedback(article_id, field, created_by, COALESCE(ioc_type, ''), COALESCE(ioc_value, ''));
+
+-- Export exclusion of flagged indicators
+CREATE INDEX IF NOT EXISTS idx_enrichment_feedback_ioc
+    ON enrichment_feedback(ioc_type, ioc_value)
+    WHERE field = 'ioc';
+
+-- Accuracy report
+CREATE INDEX IF NOT EXISTS idx_enrichment_feedback_enriched_at
+    ON enrichment_feedback(enriched_at);
+CREATE INDEX IF NOT EXISTS idx_articles_enriched_at
+    ON articles(enriched_at)
+    WHERE enriched_at IS NOT NULL;
+
+COMMENT ON TABLE enrichment_feedback IS 'Analyst flags on wrong AI enrichment output; flagged IOCs are left out of IOC exports';
@@ -0,0 +1,4 @@
+-- Migration 000060: Enrichment Prompts (Rollback)
+-- Description: Drop enrichment prompts
+
+DROP TABLE IF EXISTS enrichment_prompts;
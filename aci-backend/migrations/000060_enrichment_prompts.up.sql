@@ -0,0 +1,32 @@
+-- Migration 000060: Enrichment Prompts
+-- Description: Admin-editable, versioned system prompts for AI enrichment, with an optional model per version
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS enrichment_prompts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    prompt_key VARCHAR(50) NOT NULL,
+    version INTEGER NOT NULL,
+    system_prompt TEXT NOT NULL,
+    -- Model to run the prompt on; NULL uses the configured AI model
+    model VARCHAR(100),
+    notes TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    activated_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_enrichment_prompts_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_enrichment_prompt_key CHECK (prompt_key IN ('threat_analysis', 'batch_threat_analysis', 'armor_cta')),
+    CONSTRAINT chk_enrichment_prompt_version CHECK (version >= 1),
+    CONSTRAINT chk_enrichment_prompt_not_empty CHECK (LENGTH(system_prompt) >= 1),
+    CONSTRAINT unique_enrichment_prompt_version UNIQUE (prompt_key, version)
+);
+
+-- At most one active version per key
+CREATE UNIQUE INDEX IF NOT EXISTS idx_enrichment_prompts_active
+    ON enrichment_prompts(prompt_key)
+    WHERE is_active = true;
+
+COMMENT ON TABLE enrichment_prompts IS 'Versioned enrichment system prompts; a key without an active version uses the built-in prompt';
+COMMENT ON COLUMN enrichment_prompts.activated_at IS 'Most recent activation; NULL for a draft that was never active';
@@ -0,0 +1,47 @@
+-- Migration 000061: Tenants (Rollback)
+-- Description: Drop tenants, their row-level security policies and tenant columns
+
+-- Drops the policies apply_tenant_policies created; tables with direct policies follow below
+DO $$
+DECLARE
+    pol RECORD;
+BEGIN
+    FOR pol IN
+        SELECT schemaname, tablename, policyname
+        FROM pg_policies
+        WHERE schemaname = 'public'
+          AND policyname IN ('tenant_isolation', 'tenant_read', 'tenant_insert', 'tenant_update', 'tenant_delete')
+    LOOP
+        EXECUTE format('DROP POLICY IF EXISTS %I ON %I.%I', pol.policyname, pol.schemaname, pol.tablename);
+        EXECUTE format('ALTER TABLE %I.%I NO FORCE ROW LEVEL SECURITY', pol.schemaname, pol.tablename);
+        EXECUTE format('ALTER TABLE %I.%I DISABLE ROW LEVEL SECURITY', pol.schemaname, pol.tablename);
+    END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS apply_tenant_policies();
+
+-- Only the default workspace's secrets and users keep their unique keys
+DELETE FROM webhook_secrets WHERE tenant_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_webhook_secrets_tenant_version;
+ALTER TABLE webhook_secrets ADD PRIMARY KEY (version);
+
+DELETE FROM users WHERE tenant_id IS NOT NULL;
+DROP INDEX IF EXISTS users_email_key;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+DELETE FROM articles WHERE tenant_id IS NOT NULL;
+DELETE FROM organizations WHERE tenant_id IS NOT NULL;
+
+DELETE FROM audit_outbox WHERE tenant_id IS NOT NULL;
+ALTER TABLE audit_outbox DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE webhook_logs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE webhook_secrets DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE organizations DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE articles DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+
+DROP FUNCTION IF EXISTS app_tenant_id();
+DROP FUNCTION IF EXISTS app_tenant_scoped();
+
+DROP TABLE IF EXISTS tenants;
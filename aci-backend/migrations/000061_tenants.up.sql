@@ -0,0 +1,159 @@
+-- Migration 000061: Tenants
+-- Description: Customer workspaces with their own users, articles, webhook secrets and branding, isolated by row-level security
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS tenants (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(63) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    -- Custom domain serving the workspace, besides <slug>.<TENANT_BASE_DOMAIN>
+    hostname VARCHAR(255),
+    display_name VARCHAR(255),
+    logo_url TEXT,
+    primary_color VARCHAR(7),
+    support_email VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_tenants_slug UNIQUE (slug),
+    CONSTRAINT uq_tenants_hostname UNIQUE (hostname),
+    CONSTRAINT chk_tenants_slug CHECK (slug ~ '^[a-z0-9]([a-z0-9-]*[a-z0-9])?$'),
+    CONSTRAINT chk_tenants_primary_color CHECK (primary_color IS NULL OR primary_color ~ '^#[0-9A-Fa-f]{6}$')
+);
+
+-- app.tenant_id is set on every connection in multi-tenant mode: a tenant's ID, the nil UUID
+-- for the default workspace, or empty for system work. Unset or empty applies no scope
+CREATE OR REPLACE FUNCTION app_tenant_scoped() RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT COALESCE(current_setting('app.tenant_id', true), '') <> ''
+$$;
+
+-- The scoped tenant; NULL for the default workspace and for system work
+CREATE OR REPLACE FUNCTION app_tenant_id() RETURNS UUID
+LANGUAGE sql STABLE AS $$
+    SELECT NULLIF(NULLIF(current_setting('app.tenant_id', true), ''), '00000000-0000-0000-0000-000000000000')::UUID
+$$;
+
+-- Tenant-owned tables; rows written in a tenant's scope belong to it, NULL is the default
+-- workspace. Articles without a tenant form the shared feed
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE webhook_secrets ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE webhook_logs ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+
+-- Staged audit entries keep the workspace they were written in until the relay delivers them
+ALTER TABLE audit_outbox ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT app_tenant_id()
+    REFERENCES tenants(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_users_tenant_id ON users(tenant_id) WHERE tenant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_articles_tenant_id ON articles(tenant_id) WHERE tenant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_organizations_tenant_id ON organizations(tenant_id) WHERE tenant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_logs_tenant_id ON webhook_logs(tenant_id) WHERE tenant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_id ON audit_logs(tenant_id) WHERE tenant_id IS NOT NULL;
+
+-- An email address can sign up once per workspace
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key
+    ON users((COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::UUID)), email);
+
+-- Each workspace numbers its own webhook secret versions
+ALTER TABLE webhook_secrets DROP CONSTRAINT IF EXISTS webhook_secrets_pkey;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_secrets_tenant_version
+    ON webhook_secrets((COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::UUID)), version);
+
+-- Row-level security. FORCE applies the policies to the table owner as well; superusers and
+-- roles with BYPASSRLS still skip them, so the application must not connect as one
+ALTER TABLE tenants ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenants FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenants
+    USING (NOT app_tenant_scoped() OR id = app_tenant_id());
+
+DO $$
+DECLARE
+    tbl TEXT;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY['users', 'organizations', 'webhook_secrets', 'webhook_logs', 'audit_logs'] LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', tbl);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', tbl);
+        EXECUTE format(
+            'CREATE POLICY tenant_isolation ON %I USING (NOT app_tenant_scoped() OR tenant_id IS NOT DISTINCT FROM app_tenant_id())',
+            tbl
+        );
+    END LOOP;
+END $$;
+
+-- Every workspace reads the shared feed; only the default workspace changes it
+ALTER TABLE articles ENABLE ROW LEVEL SECURITY;
+ALTER TABLE articles FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_read ON articles FOR SELECT
+    USING (NOT app_tenant_scoped() OR tenant_id IS NULL OR tenant_id = app_tenant_id());
+CREATE POLICY tenant_insert ON articles FOR INSERT
+    WITH CHECK (NOT app_tenant_scoped() OR tenant_id IS NOT DISTINCT FROM app_tenant_id());
+CREATE POLICY tenant_update ON articles FOR UPDATE
+    USING (NOT app_tenant_scoped() OR tenant_id IS NOT DISTINCT FROM app_tenant_id());
+CREATE POLICY tenant_delete ON articles FOR DELETE
+    USING (NOT app_tenant_scoped() OR tenant_id IS NOT DISTINCT FROM app_tenant_id());
+
+-- apply_tenant_policies scopes every other table through its foreign keys: a row is visible
+-- when the users, articles and organizations it references are. Rows with a NULL reference
+-- are not owned by any workspace. Migrations that add tables referencing those call it again
+CREATE OR REPLACE FUNCTION apply_tenant_policies() RETURNS VOID
+LANGUAGE plpgsql AS $$
+DECLARE
+    tbl REGCLASS;
+    fk RECORD;
+    condition TEXT;
+BEGIN
+    FOR tbl IN
+        SELECT DISTINCT c.conrelid::REGCLASS
+        FROM pg_constraint c
+        WHERE c.contype = 'f'
+          AND cardinality(c.conkey) = 1
+          AND c.confrelid IN ('users'::REGCLASS, 'articles'::REGCLASS, 'organizations'::REGCLASS)
+          AND c.conrelid NOT IN (
+              'tenants'::REGCLASS, 'users'::REGCLASS, 'articles'::REGCLASS, 'organizations'::REGCLASS,
+              'webhook_secrets'::REGCLASS, 'webhook_logs'::REGCLASS, 'audit_logs'::REGCLASS
+          )
+    LOOP
+        condition := NULL;
+
+        FOR fk IN
+            SELECT a.attname AS col, a.attnotnull AS not_null,
+                   c.confrelid::REGCLASS AS parent, pa.attname AS parent_col
+            FROM pg_constraint c
+            JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = c.conkey[1]
+            JOIN pg_attribute pa ON pa.attrelid = c.confrelid AND pa.attnum = c.confkey[1]
+            WHERE c.contype = 'f'
+              AND c.conrelid = tbl
+              AND cardinality(c.conkey) = 1
+              AND c.confrelid IN ('users'::REGCLASS, 'articles'::REGCLASS, 'organizations'::REGCLASS)
+            ORDER BY a.attnum
+        LOOP
+            condition := concat_ws(' AND ', condition, format(
+                CASE WHEN fk.not_null
+                    THEN 'EXISTS (SELECT 1 FROM %3$s p WHERE p.%4$I = %1$s.%2$I)'
+                    ELSE '(%1$s.%2$I IS NULL OR EXISTS (SELECT 1 FROM %3$s p WHERE p.%4$I = %1$s.%2$I))'
+                END,
+                tbl, fk.col, fk.parent, fk.parent_col
+            ));
+        END LOOP;
+
+        EXECUTE format('ALTER TABLE %s ENABLE ROW LEVEL SECURITY', tbl);
+        EXECUTE format('ALTER TABLE %s FORCE ROW LEVEL SECURITY', tbl);
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %s', tbl);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %s USING (NOT app_tenant_scoped() OR (%s))', tbl, condition);
+    END LOOP;
+END $$;
+
+SELECT apply_tenant_policies();
+
+COMMENT ON TABLE tenants IS 'Customer workspaces; data without a tenant belongs to the default workspace';
+COMMENT ON COLUMN articles.tenant_id IS 'Owning workspace; NULL articles are the shared feed every workspace reads';
@@ -0,0 +1,5 @@
+-- Migration 000062: Organization Categories (Rollback)
+-- Description: Drop organization categories and their article assignments
+
+DROP TABLE IF EXISTS org_article_categories;
+DROP TABLE IF EXISTS org_categories;
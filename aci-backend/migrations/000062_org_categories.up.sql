@@ -0,0 +1,49 @@
+-- Migration 000062: Organization Categories
+-- Description: Private categories organizations apply on top of the global taxonomy, with rules that apply them during ingestion
+-- Date: 2026-10-15
+
+CREATE TABLE IF NOT EXISTS org_categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    description TEXT,
+    color VARCHAR(7) NOT NULL,
+    -- Array of rules; an article matching any of them gets the category as it is ingested
+    rules JSONB NOT NULL DEFAULT '[]',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_org_categories_slug UNIQUE (organization_id, slug),
+    CONSTRAINT uq_org_categories_organization UNIQUE (id, organization_id),
+    CONSTRAINT chk_org_categories_color CHECK (color ~ '^#([A-Fa-f0-9]{6}|[A-Fa-f0-9]{3})$')
+);
+
+-- Ingestion only loads the categories that have rules
+CREATE INDEX IF NOT EXISTS idx_org_categories_with_rules
+    ON org_categories(organization_id) WHERE rules <> '[]'::JSONB;
+
+-- organization_id repeats the category's organization so the tenant policies cover the row
+CREATE TABLE IF NOT EXISTS org_article_categories (
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    source VARCHAR(10) NOT NULL DEFAULT 'rule',
+    applied_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (category_id, article_id),
+    CONSTRAINT fk_org_article_categories_category FOREIGN KEY (category_id, organization_id)
+        REFERENCES org_categories(id, organization_id) ON DELETE CASCADE,
+    CONSTRAINT chk_org_article_categories_source CHECK (source IN ('rule', 'manual'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_org_article_categories_article
+    ON org_article_categories(article_id, organization_id);
+
+-- Scope both tables to their organization's workspace
+SELECT apply_tenant_policies();
+
+COMMENT ON TABLE org_categories IS 'Private categories an organization applies on top of the global taxonomy';
+COMMENT ON TABLE org_article_categories IS 'Organization categories applied to articles, by rule during ingestion or by hand';
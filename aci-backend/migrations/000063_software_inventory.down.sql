@@ -0,0 +1,7 @@
+-- Migration 000063: Software Inventory (Rollback)
+-- Description: Drop software inventories, their CVE matches and the NVD affected products
+
+DROP TABLE IF EXISTS software_inventory_cves;
+DROP TABLE IF EXISTS software_inventory;
+DROP TABLE IF EXISTS cve_affected_products;
+DROP TABLE IF EXISTS nvd_cves;
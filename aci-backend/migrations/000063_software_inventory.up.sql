@@ -0,0 +1,69 @@
+-- Migration 000063: Software Inventory
+-- Description: Software inventories of users and organizations, NVD affected products, and the CVEs matched between them
+-- Date: 2026-10-15
+
+-- A product release in the inventory of exactly one user or one organization
+CREATE TABLE IF NOT EXISTS software_inventory (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    -- Normalized as in CPE names: lowercase, words joined by underscores
+    vendor VARCHAR(255) NOT NULL,
+    product VARCHAR(255) NOT NULL,
+    version VARCHAR(100) NOT NULL DEFAULT '',
+    cpe TEXT NOT NULL DEFAULT '',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_software_inventory_owner CHECK ((user_id IS NULL) <> (organization_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_software_inventory_user
+    ON software_inventory(user_id) WHERE user_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_software_inventory_organization
+    ON software_inventory(organization_id) WHERE organization_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_software_inventory_product
+    ON software_inventory(vendor, product);
+
+-- CVEs affecting each inventory item, rematched whenever the inventory or the NVD data changes
+CREATE TABLE IF NOT EXISTS software_inventory_cves (
+    item_id UUID NOT NULL REFERENCES software_inventory(id) ON DELETE CASCADE,
+    cve_id VARCHAR(32) NOT NULL,
+    PRIMARY KEY (item_id, cve_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_software_inventory_cves_cve
+    ON software_inventory_cves(cve_id);
+
+-- CVEs looked up in the NVD API, including those it lists no affected products for yet
+CREATE TABLE IF NOT EXISTS nvd_cves (
+    cve_id VARCHAR(32) PRIMARY KEY,
+    last_modified TIMESTAMP WITH TIME ZONE,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Vulnerable CPE ranges from each CVE's NVD configurations
+CREATE TABLE IF NOT EXISTS cve_affected_products (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    cve_id VARCHAR(32) NOT NULL REFERENCES nvd_cves(cve_id) ON DELETE CASCADE,
+    criteria TEXT NOT NULL,
+    vendor VARCHAR(255) NOT NULL,
+    product VARCHAR(255) NOT NULL,
+    version VARCHAR(100) NOT NULL DEFAULT '',
+    version_start_including VARCHAR(100) NOT NULL DEFAULT '',
+    version_start_excluding VARCHAR(100) NOT NULL DEFAULT '',
+    version_end_including VARCHAR(100) NOT NULL DEFAULT '',
+    version_end_excluding VARCHAR(100) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_cve_affected_products_cve
+    ON cve_affected_products(cve_id);
+CREATE INDEX IF NOT EXISTS idx_cve_affected_products_product
+    ON cve_affected_products(vendor, product);
+
+-- Scope inventories to their user's or organization's workspace
+SELECT apply_tenant_policies();
+
+COMMENT ON TABLE software_inventory IS 'Product releases in the software inventory of a user or an organization';
+COMMENT ON TABLE software_inventory_cves IS 'CVEs whose NVD affected products include an inventory item';
+COMMENT ON TABLE cve_affected_products IS 'Vulnerable CPE ranges listed in the NVD record of each CVE';
@@ -0,0 +1,84 @@
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+)
+
+// AlertBuilder builds an active keyword alert
+type AlertBuilder struct {
+	alert *domain.Alert
+	owner *entities.User
+}
+
+// Alert starts an alert with default values
+func Alert() *AlertBuilder {
+	now := time.Now()
+	return &AlertBuilder{
+		alert: &domain.Alert{
+			ID:        uuid.New(),
+			Name:      fmt.Sprintf("Test Alert %d", next()),
+			Type:      domain.AlertTypeKeyword,
+			Value:     "ransomware",
+			IsActive:  true,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+// WithOwner sets the saved user the alert belongs to
+func (b *AlertBuilder) WithOwner(owner *entities.User) *AlertBuilder {
+	b.owner = owner
+	return b
+}
+
+// WithName sets the name
+func (b *AlertBuilder) WithName(name string) *AlertBuilder {
+	b.alert.Name = name
+	return b
+}
+
+// Matching sets the alert type and the value it matches
+func (b *AlertBuilder) Matching(alertType domain.AlertType, value string) *AlertBuilder {
+	b.alert.Type = alertType
+	b.alert.Value = value
+	return b
+}
+
+// Inactive marks the alert inactive
+func (b *AlertBuilder) Inactive() *AlertBuilder {
+	b.alert.IsActive = false
+	return b
+}
+
+// Build returns the alert without saving it; its owner is only set when one was given
+func (b *AlertBuilder) Build() *domain.Alert {
+	if b.owner != nil {
+		b.alert.UserID = b.owner.ID
+	}
+	return b.alert
+}
+
+// CreateAlert saves an alert, creating a user to own it when none was given;
+// a nil builder uses the defaults
+func (f *Fixtures) CreateAlert(b *AlertBuilder) *domain.Alert {
+	f.t.Helper()
+
+	if b == nil {
+		b = Alert()
+	}
+
+	if b.owner == nil {
+		b.owner = f.CreateUser(nil)
+	}
+
+	alert := b.Build()
+	f.must(f.Alerts.Create(f.ctx, alert), "alert")
+	return alert
+}
@@ -0,0 +1,147 @@
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// ArticleBuilder builds a published, medium severity HTML article with a unique title,
+// slug and source URL
+type ArticleBuilder struct {
+	article  *domain.Article
+	category *domain.Category
+	source   *domain.Source
+}
+
+// Article starts an article with default values
+func Article() *ArticleBuilder {
+	n := next()
+	now := time.Now()
+	return &ArticleBuilder{
+		article: &domain.Article{
+			ID:                 uuid.New(),
+			Title:              fmt.Sprintf("Test Article %d", n),
+			Slug:               fmt.Sprintf("test-article-%d", n),
+			Content:            "<p>Attackers are exploiting a vulnerability in a widely used product.</p>",
+			ContentFormat:      domain.ContentFormatHTML,
+			SourceURL:          fmt.Sprintf("https://news.example.com/articles/%d", n),
+			Severity:           domain.SeverityMedium,
+			Tags:               []string{},
+			CVEs:               []string{},
+			Vendors:            []string{},
+			RecommendedActions: []string{},
+			ReadingTimeMinutes: 1,
+			IsPublished:        true,
+			PublishedAt:        now,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		},
+	}
+}
+
+// WithTitle sets the title
+func (b *ArticleBuilder) WithTitle(title string) *ArticleBuilder {
+	b.article.Title = title
+	return b
+}
+
+// WithSlug sets the slug
+func (b *ArticleBuilder) WithSlug(slug string) *ArticleBuilder {
+	b.article.Slug = slug
+	return b
+}
+
+// WithContent sets the HTML content
+func (b *ArticleBuilder) WithContent(content string) *ArticleBuilder {
+	b.article.Content = content
+	return b
+}
+
+// WithSeverity sets the severity
+func (b *ArticleBuilder) WithSeverity(severity domain.Severity) *ArticleBuilder {
+	b.article.Severity = severity
+	return b
+}
+
+// WithCVEs sets the CVE IDs
+func (b *ArticleBuilder) WithCVEs(cves ...string) *ArticleBuilder {
+	b.article.CVEs = cves
+	return b
+}
+
+// WithVendors sets the vendors
+func (b *ArticleBuilder) WithVendors(vendors ...string) *ArticleBuilder {
+	b.article.Vendors = vendors
+	return b
+}
+
+// WithTags sets the tags
+func (b *ArticleBuilder) WithTags(tags ...string) *ArticleBuilder {
+	b.article.Tags = tags
+	return b
+}
+
+// WithCategory files the article under a saved category
+func (b *ArticleBuilder) WithCategory(category *domain.Category) *ArticleBuilder {
+	b.category = category
+	return b
+}
+
+// WithSource attributes the article to a saved source
+func (b *ArticleBuilder) WithSource(source *domain.Source) *ArticleBuilder {
+	b.source = source
+	return b
+}
+
+// PublishedAt sets the publication time
+func (b *ArticleBuilder) PublishedAt(at time.Time) *ArticleBuilder {
+	b.article.PublishedAt = at
+	return b
+}
+
+// Unpublished leaves the article unpublished
+func (b *ArticleBuilder) Unpublished() *ArticleBuilder {
+	b.article.IsPublished = false
+	return b
+}
+
+// Build returns the article without saving it; its category and source are only set
+// when they were given
+func (b *ArticleBuilder) Build() *domain.Article {
+	if b.category != nil {
+		b.article.CategoryID = b.category.ID
+		b.article.CategoryIDs = []uuid.UUID{b.category.ID}
+	}
+
+	if b.source != nil {
+		b.article.SourceID = b.source.ID
+	}
+
+	return b.article
+}
+
+// CreateArticle saves an article, creating a category and source for it when none were
+// given; a nil builder uses the defaults
+func (f *Fixtures) CreateArticle(b *ArticleBuilder) *domain.Article {
+	f.t.Helper()
+
+	if b == nil {
+		b = Article()
+	}
+
+	if b.category == nil {
+		b.category = f.CreateCategory(nil)
+	}
+
+	if b.source == nil {
+		b.source = f.CreateSource(nil)
+	}
+
+	article := b.Build()
+	f.must(f.Articles.Create(f.ctx, article), "article")
+	return article
+}
@@ -0,0 +1,50 @@
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// CategoryBuilder builds a top-level category with a unique name and slug
+type CategoryBuilder struct {
+	category *domain.Category
+}
+
+// Category starts a category with default values
+func Category() *CategoryBuilder {
+	return &CategoryBuilder{
+		category: domain.NewCategory(fmt.Sprintf("Test Category %d", next()), "#3366FF", nil, nil),
+	}
+}
+
+// WithName sets the name and the slug derived from it
+func (b *CategoryBuilder) WithName(name string) *CategoryBuilder {
+	b.category.Name = name
+	b.category.Slug = domain.GenerateSlug(name)
+	return b
+}
+
+// WithParent nests the category under a parent
+func (b *CategoryBuilder) WithParent(parent *domain.Category) *CategoryBuilder {
+	b.category.ParentID = &parent.ID
+	return b
+}
+
+// Build returns the category without saving it
+func (b *CategoryBuilder) Build() *domain.Category {
+	return b.category
+}
+
+// CreateCategory saves a category; a nil builder uses the defaults
+func (f *Fixtures) CreateCategory(b *CategoryBuilder) *domain.Category {
+	f.t.Helper()
+
+	if b == nil {
+		b = Category()
+	}
+
+	category := b.Build()
+	f.must(f.Categories.Create(f.ctx, category), "category")
+	return category
+}
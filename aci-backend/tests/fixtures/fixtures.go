@@ -0,0 +1,66 @@
+// Package fixtures builds domain objects with valid defaults for tests and saves them
+// through the real repositories, so a test only spells out the fields it is about
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+)
+
+// sequence makes generated emails, slugs and URLs unique across a test run
+var sequence atomic.Uint64
+
+// next returns a unique suffix for generated values
+func next() uint64 {
+	return sequence.Add(1)
+}
+
+// unique appends a unique suffix to a prefix, e.g. "user-7"
+func unique(prefix string) string {
+	return fmt.Sprintf("%s-%d", prefix, next())
+}
+
+// Fixtures saves built objects through the postgres repositories, failing the test on error
+type Fixtures struct {
+	t   testing.TB
+	ctx context.Context
+
+	Users      repository.UserRepository
+	Sources    repository.SourceRepository
+	Categories repository.CategoryRepository
+	Articles   repository.ArticleRepository
+	Alerts     repository.AlertRepository
+}
+
+// New creates fixtures backed by db
+func New(t testing.TB, db *postgres.DB) *Fixtures {
+	t.Helper()
+
+	if db == nil {
+		t.Fatal("fixtures: db cannot be nil")
+	}
+
+	return &Fixtures{
+		t:          t,
+		ctx:        context.Background(),
+		Users:      postgres.NewUserRepository(db),
+		Sources:    postgres.NewSourceRepository(db),
+		Categories: postgres.NewCategoryRepository(db),
+		Articles:   postgres.NewArticleRepository(db),
+		Alerts:     postgres.NewAlertRepository(db),
+	}
+}
+
+// must fails the test when a fixture cannot be saved
+func (f *Fixtures) must(err error, what string) {
+	f.t.Helper()
+
+	if err != nil {
+		f.t.Fatalf("fixtures: failed to create %s: %v", what, err)
+	}
+}
@@ -0,0 +1,72 @@
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+)
+
+// SourceBuilder builds an active source with a unique name and URL
+type SourceBuilder struct {
+	source *domain.Source
+}
+
+// Source starts a source with default values
+func Source() *SourceBuilder {
+	n := next()
+	return &SourceBuilder{
+		source: &domain.Source{
+			ID:         uuid.New(),
+			Name:       fmt.Sprintf("Test Source %d", n),
+			URL:        fmt.Sprintf("https://source-%d.example.com", n),
+			IsActive:   true,
+			TrustScore: 0.5,
+			CreatedAt:  time.Now(),
+		},
+	}
+}
+
+// WithName sets the name
+func (b *SourceBuilder) WithName(name string) *SourceBuilder {
+	b.source.Name = name
+	return b
+}
+
+// WithURL sets the URL
+func (b *SourceBuilder) WithURL(url string) *SourceBuilder {
+	b.source.URL = url
+	return b
+}
+
+// WithTrustScore sets the trust score
+func (b *SourceBuilder) WithTrustScore(score float64) *SourceBuilder {
+	b.source.TrustScore = score
+	return b
+}
+
+// Inactive marks the source inactive
+func (b *SourceBuilder) Inactive() *SourceBuilder {
+	b.source.IsActive = false
+	return b
+}
+
+// Build returns the source without saving it
+func (b *SourceBuilder) Build() *domain.Source {
+	return b.source
+}
+
+// CreateSource saves a source; a nil builder uses the defaults
+func (f *Fixtures) CreateSource(b *SourceBuilder) *domain.Source {
+	f.t.Helper()
+
+	if b == nil {
+		b = Source()
+	}
+
+	source := b.Build()
+	f.must(f.Sources.Create(f.ctx, source), "source")
+	return source
+}
@@ -0,0 +1,68 @@
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+)
+
+// UserBuilder builds an active, unverified user with a unique email
+type UserBuilder struct {
+	user *entities.User
+}
+
+// User starts a user with default values
+func User() *UserBuilder {
+	n := next()
+	return &UserBuilder{
+		user: entities.NewUser(fmt.Sprintf("user-%d@example.com", n), "not-a-real-hash", fmt.Sprintf("Test User %d", n)),
+	}
+}
+
+// WithEmail sets the email
+func (b *UserBuilder) WithEmail(email string) *UserBuilder {
+	b.user.Email = email
+	return b
+}
+
+// WithName sets the display name
+func (b *UserBuilder) WithName(name string) *UserBuilder {
+	b.user.Name = name
+	return b
+}
+
+// WithRole sets the role
+func (b *UserBuilder) WithRole(role entities.UserRole) *UserBuilder {
+	b.user.Role = role
+	return b
+}
+
+// WithStatus sets the account status
+func (b *UserBuilder) WithStatus(status entities.UserStatus) *UserBuilder {
+	b.user.Status = status
+	return b
+}
+
+// Verified marks the email as verified
+func (b *UserBuilder) Verified() *UserBuilder {
+	b.user.EmailVerified = true
+	return b
+}
+
+// Build returns the user without saving it
+func (b *UserBuilder) Build() *entities.User {
+	return b.user
+}
+
+// CreateUser saves a user; a nil builder uses the defaults
+func (f *Fixtures) CreateUser(b *UserBuilder) *entities.User {
+	f.t.Helper()
+
+	if b == nil {
+		b = User()
+	}
+
+	user := b.Build()
+	f.must(f.Users.Create(f.ctx, user), "user")
+	return user
+}
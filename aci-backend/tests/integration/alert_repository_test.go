@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/tests/fixtures"
+)
+
+func TestAlertRepository_GetByUserID(t *testing.T) {
+	_, f := SetupRepositoryTest(t)
+	ctx := context.Background()
+
+	owner := f.CreateUser(nil)
+	stranger := f.CreateUser(nil)
+
+	keyword := f.CreateAlert(fixtures.Alert().WithOwner(owner))
+	cve := f.CreateAlert(fixtures.Alert().WithOwner(owner).Matching(domain.AlertTypeCVE, "CVE-2026-1234"))
+	f.CreateAlert(fixtures.Alert().WithOwner(stranger))
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		want   []uuid.UUID
+	}{
+		{name: "own alerts", userID: owner.ID, want: []uuid.UUID{keyword.ID, cve.ID}},
+		{name: "user without alerts", userID: uuid.New(), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts, err := f.Alerts.GetByUserID(ctx, tt.userID)
+			require.NoError(t, err)
+
+			got := make([]uuid.UUID, 0, len(alerts))
+			for _, alert := range alerts {
+				got = append(got, alert.ID)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestAlertRepository_GetActiveAlerts(t *testing.T) {
+	_, f := SetupRepositoryTest(t)
+	ctx := context.Background()
+
+	active := f.CreateAlert(nil)
+	f.CreateAlert(fixtures.Alert().Inactive())
+
+	alerts, err := f.Alerts.GetActiveAlerts(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, alerts, 1)
+	assert.Equal(t, active.ID, alerts[0].ID)
+}
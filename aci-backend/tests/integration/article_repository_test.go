@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/tests/fixtures"
+)
+
+func TestArticleRepository_GetByIDs(t *testing.T) {
+	_, f := SetupRepositoryTest(t)
+	ctx := context.Background()
+
+	first := f.CreateArticle(nil)
+	second := f.CreateArticle(fixtures.Article().Unpublished())
+
+	tests := []struct {
+		name string
+		ids  []uuid.UUID
+		want []uuid.UUID
+	}{
+		{name: "no ids", ids: nil, want: nil},
+		{name: "all known", ids: []uuid.UUID{first.ID, second.ID}, want: []uuid.UUID{first.ID, second.ID}},
+		{name: "unknown ids are skipped", ids: []uuid.UUID{uuid.New(), second.ID}, want: []uuid.UUID{second.ID}},
+		{name: "repeated ids load once", ids: []uuid.UUID{first.ID, first.ID}, want: []uuid.UUID{first.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles, err := f.Articles.GetByIDs(ctx, tt.ids)
+			require.NoError(t, err)
+
+			got := make([]uuid.UUID, 0, len(articles))
+			for _, article := range articles {
+				got = append(got, article.ID)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestArticleRepository_CreateRejectsTakenSlug(t *testing.T) {
+	_, f := SetupRepositoryTest(t)
+	ctx := context.Background()
+
+	existing := f.CreateArticle(nil)
+
+	duplicate := fixtures.Article().
+		WithSlug(existing.Slug).
+		WithCategory(f.CreateCategory(nil)).
+		WithSource(f.CreateSource(nil)).
+		Build()
+
+	err := f.Articles.Create(ctx, duplicate)
+
+	var conflict *domainerrors.ConflictError
+	assert.True(t, errors.As(err, &conflict), "expected a conflict, got %v", err)
+}
+
+func TestArticleRepository_UpdateSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		// slug picks the new slug for an article, given another article's slug
+		slug         func(otherSlug string) string
+		wantConflict bool
+	}{
+		{
+			name: "new slug",
+			slug: func(string) string { return "renamed-article" },
+		},
+		{
+			name:         "another article's slug",
+			slug:         func(otherSlug string) string { return otherSlug },
+			wantConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, f := SetupRepositoryTest(t)
+			ctx := context.Background()
+
+			article := f.CreateArticle(nil)
+			other := f.CreateArticle(nil)
+
+			newSlug := tt.slug(other.Slug)
+			err := f.Articles.UpdateSlug(ctx, article.ID, newSlug)
+
+			if tt.wantConflict {
+				var conflict *domainerrors.ConflictError
+				assert.True(t, errors.As(err, &conflict), "expected a conflict, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			renamed, err := f.Articles.GetBySlug(ctx, newSlug)
+			require.NoError(t, err)
+			assert.Equal(t, article.ID, renamed.ID)
+
+			current, err := f.Articles.GetSlugRedirect(ctx, article.Slug)
+			require.NoError(t, err)
+			assert.Equal(t, newSlug, current)
+
+			exists, err := f.Articles.SlugExists(ctx, article.Slug)
+			require.NoError(t, err)
+			assert.True(t, exists, "a former slug stays reserved")
+		})
+	}
+}
+
+func TestArticleRepository_UpdateSlugTakesBackFormerSlug(t *testing.T) {
+	_, f := SetupRepositoryTest(t)
+	ctx := context.Background()
+
+	article := f.CreateArticle(nil)
+	original := article.Slug
+
+	require.NoError(t, f.Articles.UpdateSlug(ctx, article.ID, "temporary-slug"))
+	require.NoError(t, f.Articles.UpdateSlug(ctx, article.ID, original))
+
+	restored, err := f.Articles.GetBySlug(ctx, original)
+	require.NoError(t, err)
+	assert.Equal(t, article.ID, restored.ID)
+
+	_, err = f.Articles.GetSlugRedirect(ctx, original)
+	var notFound *domainerrors.NotFoundError
+	assert.True(t, errors.As(err, &notFound), "the current slug has no redirect, got %v", err)
+
+	current, err := f.Articles.GetSlugRedirect(ctx, "temporary-slug")
+	require.NoError(t, err)
+	assert.Equal(t, original, current)
+}
@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/phillipboles/aci-backend/tests/fixtures"
+)
+
+// The repository suites share one container for the whole package run; each test starts
+// from empty tables instead of a fresh database, which keeps them fast
+var (
+	repositoryDBOnce sync.Once
+	repositoryDB     *TestDB
+	repositoryDBErr  error
+)
+
+// TestMain terminates the shared repository database once every test has run
+func TestMain(m *testing.M) {
+	code := m.Run()
+
+	if repositoryDB != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+		repositoryDB.DB.Close()
+		repositoryDB.Container.Terminate(ctx)
+		cancel()
+	}
+
+	os.Exit(code)
+}
+
+// SetupRepositoryTest returns the shared repository database, emptied of all rows, and
+// fixtures that save through it. Rows seeded by migrations are removed as well, so a test
+// creates everything it reads
+func SetupRepositoryTest(t *testing.T) (*TestDB, *fixtures.Fixtures) {
+	t.Helper()
+
+	repositoryDBOnce.Do(func() {
+		repositoryDB, repositoryDBErr = startTestDB()
+	})
+	if repositoryDBErr != nil {
+		t.Fatalf("%v", repositoryDBErr)
+	}
+
+	truncateAllTables(t, repositoryDB)
+
+	return repositoryDB, fixtures.New(t, repositoryDB.DB)
+}
+
+// truncateAllTables empties every table in the public schema in a single statement
+func truncateAllTables(t *testing.T, testDB *TestDB) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	query := `
+		DO $$
+		DECLARE
+			tables TEXT;
+		BEGIN
+			SELECT string_agg(format('%I.%I', schemaname, tablename), ', ')
+			INTO tables
+			FROM pg_tables
+			WHERE schemaname = 'public';
+
+			IF tables IS NOT NULL THEN
+				EXECUTE 'TRUNCATE TABLE ' || tables || ' RESTART IDENTITY CASCADE';
+			END IF;
+		END $$
+	`
+
+	if _, err := testDB.DB.Pool.Exec(ctx, query); err != nil {
+		t.Fatalf("failed to truncate tables: %v", err)
+	}
+}
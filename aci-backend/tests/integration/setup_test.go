@@ -5,7 +5,6 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
-	"database/sql"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
@@ -15,12 +14,12 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
-	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/phillipboles/aci-backend/internal/ai"
 	"github.com/phillipboles/aci-backend/internal/api"
 	"github.com/phillipboles/aci-backend/internal/api/handlers"
@@ -48,7 +47,6 @@ type TestDB struct {
 	Container testcontainers.Container
 	DSN       string
 	DB        *postgres.DB
-	SqlDB     *sql.DB // For repositories that still use database/sql
 }
 
 // TestKeys holds test RSA key pairs for JWT
@@ -71,6 +69,16 @@ type TestServer struct {
 func SetupTestDB(t *testing.T) *TestDB {
 	t.Helper()
 
+	testDB, err := startTestDB()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return testDB
+}
+
+// startTestDB starts a PostgreSQL container, connects to it and applies the migrations
+func startTestDB() (*TestDB, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
 	defer cancel()
 
@@ -87,21 +95,21 @@ func SetupTestDB(t *testing.T) *TestDB {
 		),
 	)
 	if err != nil {
-		t.Fatalf("failed to start postgres container: %v", err)
+		return nil, fmt.Errorf("failed to start postgres container: %w", err)
 	}
 
 	// Get connection string
 	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
 	if err != nil {
 		container.Terminate(ctx)
-		t.Fatalf("failed to get connection string: %v", err)
+		return nil, fmt.Errorf("failed to get connection string: %w", err)
 	}
 
 	// Parse the DSN to extract host and port from the container
 	host, port, err := parseHostPortFromDSN(dsn)
 	if err != nil {
 		container.Terminate(ctx)
-		t.Fatalf("failed to parse DSN: %v", err)
+		return nil, fmt.Errorf("failed to parse DSN: %w", err)
 	}
 
 	// Create database connection using the container's host and port
@@ -119,40 +127,23 @@ func SetupTestDB(t *testing.T) *TestDB {
 	})
 	if err != nil {
 		container.Terminate(ctx)
-		t.Fatalf("failed to create database connection: %v", err)
-	}
-
-	// Create sql.DB connection for repositories that still use database/sql
-	// Register the pgx connection config with stdlib
-	connString := stdlib.RegisterConnConfig(db.Pool.Config().ConnConfig)
-	sqlDB, err := sql.Open("pgx", connString)
-	if err != nil {
-		db.Close()
-		container.Terminate(ctx)
-		t.Fatalf("failed to create sql.DB connection: %v", err)
-	}
-
-	if err := sqlDB.Ping(); err != nil {
-		sqlDB.Close()
-		db.Close()
-		container.Terminate(ctx)
-		t.Fatalf("failed to ping sql.DB connection: %v", err)
+		return nil, fmt.Errorf("failed to create database connection: %w", err)
 	}
 
 	testDB := &TestDB{
 		Container: container,
 		DSN:       dsn,
 		DB:        db,
-		SqlDB:     sqlDB,
 	}
 
 	// Run migrations
 	if err := runMigrations(ctx, testDB); err != nil {
-		TeardownTestDB(t, testDB)
-		t.Fatalf("failed to run migrations: %v", err)
+		testDB.DB.Close()
+		container.Terminate(ctx)
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	return testDB
+	return testDB, nil
 }
 
 // parseHostPortFromDSN extracts host and port from a PostgreSQL DSN
@@ -182,22 +173,20 @@ func parseHostPortFromDSN(dsn string) (string, int, error) {
 }
 
 
-// runMigrations applies database migrations
+// runMigrations applies every up migration in version order
 func runMigrations(ctx context.Context, testDB *TestDB) error {
 	// Get migrations directory
 	migrationsDir := filepath.Join("..", "..", "migrations")
 
-	// Read and execute migrations in order
-	migrations := []string{
-		"000001_initial_schema.up.sql",
-		"000002_content_schema.up.sql",
-		"000003_alerts_schema.up.sql",
-		"000004_engagement_schema.up.sql",
-		"000005_audit_schema.up.sql",
+	// File names start with a zero-padded version, so lexical order is version order
+	migrations, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
 	}
+	sort.Strings(migrations)
 
-	for _, migration := range migrations {
-		migrationPath := filepath.Join(migrationsDir, migration)
+	for _, migrationPath := range migrations {
+		migration := filepath.Base(migrationPath)
 		content, err := os.ReadFile(migrationPath)
 		if err != nil {
 			return fmt.Errorf("failed to read migration %s: %w", migration, err)
@@ -222,11 +211,6 @@ func TeardownTestDB(t *testing.T, testDB *TestDB) {
 	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
 	defer cancel()
 
-	// Close sql.DB connection
-	if testDB.SqlDB != nil {
-		testDB.SqlDB.Close()
-	}
-
 	// Close database connection
 	if testDB.DB != nil {
 		testDB.DB.Close()
@@ -347,10 +331,8 @@ func SetupTestServer(t *testing.T, testDB *TestDB) *TestServer {
 	webhookLogRepo := postgres.NewWebhookLogRepository(testDB.DB)
 	alertRepo := postgres.NewAlertRepository(testDB.DB)
 	alertMatchRepo := postgres.NewAlertMatchRepository(testDB.DB)
-
-	// Create repositories using sql.DB (for engagement service)
-	bookmarkRepo := postgres.NewBookmarkRepository(testDB.SqlDB)
-	articleReadRepo := postgres.NewArticleReadRepository(testDB.SqlDB)
+	bookmarkRepo := postgres.NewBookmarkRepository(testDB.DB)
+	articleReadRepo := postgres.NewArticleReadRepository(testDB.DB)
 
 	// Create services
 	authService := service.NewAuthService(userRepo, tokenRepo, jwtService)
@@ -359,11 +341,10 @@ func SetupTestServer(t *testing.T, testDB *TestDB) *TestServer {
 	searchService := service.NewSearchService(articleRepo)
 	engagementService := service.NewEngagementService(bookmarkRepo, articleReadRepo, articleRepo)
 
-	// Create AI client for enrichment service (with dummy API key for testing)
-	// Most integration tests don't actually call enrichment, so this won't make real API calls
+	// The disabled provider never calls a model; enrichment is skipped and other AI calls
+	// get canned responses
 	aiClient, err := ai.NewClient(ai.Config{
-		APIKey: "test-api-key-placeholder",
-		Model:  "claude-3-haiku-20240307",
+		Provider: ai.ProviderDisabled,
 	})
 	if err != nil {
 		TeardownTestKeys(t, keys)
@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/repository/postgres"
+	"github.com/phillipboles/aci-backend/tests/fixtures"
+)
+
+// tenantTestRole is a role row-level security applies to; the container's own user is a superuser
+const tenantTestRole = "aci_tenant_test"
+
+// tenantScopedDB connects to the test database as tenantTestRole, scoping every query to the
+// tenant of its context as multi-tenant mode does
+func tenantScopedDB(t *testing.T, testDB *TestDB) *postgres.DB {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	_, err := testDB.DB.Pool.Exec(ctx, `
+		DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = '`+tenantTestRole+`') THEN
+				CREATE ROLE `+tenantTestRole+` LOGIN PASSWORD '`+tenantTestRole+`' NOSUPERUSER NOBYPASSRLS;
+			END IF;
+		END $$;
+		GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO `+tenantTestRole+`;
+		GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO `+tenantTestRole+`;
+	`)
+	require.NoError(t, err)
+
+	dsn, err := url.Parse(testDB.DSN)
+	require.NoError(t, err)
+	dsn.User = url.UserPassword(tenantTestRole, tenantTestRole)
+
+	poolConfig, err := pgxpool.ParseConfig(dsn.String())
+	require.NoError(t, err)
+	postgres.ScopeTenants(poolConfig)
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return &postgres.DB{Pool: pool}
+}
+
+func TestTenantIsolation_Users(t *testing.T) {
+	testDB, _ := SetupRepositoryTest(t)
+	scoped := tenantScopedDB(t, testDB)
+	ctx := context.Background()
+
+	tenant := &domain.Tenant{Slug: "acme", Name: "Acme"}
+	require.NoError(t, postgres.NewTenantRepository(testDB.DB).Create(ctx, tenant))
+	acmeCtx := repository.WithTenant(ctx, tenant.ID)
+
+	users := postgres.NewUserRepository(scoped)
+
+	// An email address can sign up once per workspace
+	defaultUser := fixtures.User().WithEmail("analyst@example.com").Build()
+	require.NoError(t, users.Create(ctx, defaultUser))
+	acmeUser := fixtures.User().WithEmail("analyst@example.com").Build()
+	require.NoError(t, users.Create(acmeCtx, acmeUser))
+
+	found, err := users.GetByEmail(acmeCtx, "analyst@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, acmeUser.ID, found.ID)
+
+	found, err = users.GetByEmail(ctx, "analyst@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, defaultUser.ID, found.ID)
+
+	var notFound *domainerrors.NotFoundError
+	_, err = users.GetByID(ctx, acmeUser.ID)
+	assert.True(t, errors.As(err, &notFound), "the default workspace cannot see a tenant's users")
+	_, err = users.GetByID(acmeCtx, defaultUser.ID)
+	assert.True(t, errors.As(err, &notFound), "a tenant cannot see the default workspace's users")
+
+	// System work sees every workspace
+	_, err = users.GetByID(repository.WithoutTenant(ctx), acmeUser.ID)
+	assert.NoError(t, err)
+}
+
+func TestTenantIsolation_Articles(t *testing.T) {
+	testDB, f := SetupRepositoryTest(t)
+	scoped := tenantScopedDB(t, testDB)
+	ctx := context.Background()
+
+	tenant := &domain.Tenant{Slug: "acme", Name: "Acme"}
+	require.NoError(t, postgres.NewTenantRepository(testDB.DB).Create(ctx, tenant))
+	acmeCtx := repository.WithTenant(ctx, tenant.ID)
+
+	articles := postgres.NewArticleRepository(scoped)
+	category := f.CreateCategory(nil)
+	source := f.CreateSource(nil)
+
+	shared := fixtures.Article().WithCategory(category).WithSource(source).Build()
+	require.NoError(t, articles.Create(ctx, shared))
+	assert.Nil(t, shared.TenantID, "default workspace articles form the shared feed")
+
+	private := fixtures.Article().WithCategory(category).WithSource(source).Build()
+	require.NoError(t, articles.Create(acmeCtx, private))
+	require.NotNil(t, private.TenantID)
+	assert.Equal(t, tenant.ID, *private.TenantID)
+
+	_, err := articles.GetByID(acmeCtx, shared.ID)
+	assert.NoError(t, err, "every workspace reads the shared feed")
+
+	_, err = articles.GetByID(ctx, private.ID)
+	assert.Error(t, err, "the default workspace cannot see a tenant's articles")
+
+	assert.Error(t, articles.Delete(acmeCtx, shared.ID), "a tenant cannot change the shared feed")
+	assert.NoError(t, articles.Delete(acmeCtx, private.ID))
+}
@@ -237,12 +237,14 @@ func TestWebhook_ArticleCreated_HappyPath(t *testing.T) {
 	// Assert
 	assert.Equal(t, http.StatusAccepted, rr.Code)
 
-	var response map[string]interface{}
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
 	err = json.Unmarshal(rr.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, "accepted", response["status"])
-	assert.NotEmpty(t, response["job_id"])
+	assert.Equal(t, "accepted", response.Data["status"])
+	assert.NotEmpty(t, response.Data["job_id"])
 
 	// Verify article was stored in database
 	ctx := context.Background()
@@ -321,11 +323,13 @@ func TestWebhook_BulkImport_HappyPath(t *testing.T) {
 	// Assert
 	assert.Equal(t, http.StatusAccepted, rr.Code)
 
-	var response map[string]interface{}
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
 	err = json.Unmarshal(rr.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	result := response["result"].(map[string]interface{})
+	result := response.Data["result"].(map[string]interface{})
 	assert.Equal(t, float64(50), result["total"])
 	assert.Equal(t, float64(50), result["success"])
 	assert.Equal(t, float64(0), result["failed"])
@@ -519,10 +523,24 @@ func TestWebhook_EnrichmentComplete_HappyPath(t *testing.T) {
 	defer CleanupDB(t, db)
 
 	handler := setupWebhookHandler(t, db)
+	ctx := context.Background()
+
+	// Create the article the workflow enriches
+	articleData := validArticlePayload
+	articleData.SkipEnrichment = true
+	createPayload, err := createWebhookPayload("article.created", articleData)
+	require.NoError(t, err)
+
+	rr1 := makeWebhookRequest(t, handler.HandleN8nWebhook, createPayload, signPayload(createPayload, testWebhookSecret))
+	require.Equal(t, http.StatusAccepted, rr1.Code)
+
+	articleRepo := postgres.NewArticleRepository(db.DB)
+	article, err := articleRepo.GetBySourceURL(ctx, articleData.SourceURL)
+	require.NoError(t, err)
 
 	// Create enrichment data
 	enrichmentData := handlers.EnrichmentCompleteData{
-		ArticleID:          uuid.New().String(),
+		ArticleID:          article.ID.String(),
 		ThreatType:         stringPtr("Ransomware"),
 		AttackVector:       stringPtr("Phishing"),
 		ImpactAssessment:   stringPtr("High impact on financial sector"),
@@ -544,10 +562,42 @@ func TestWebhook_EnrichmentComplete_HappyPath(t *testing.T) {
 	// Assert
 	assert.Equal(t, http.StatusAccepted, rr.Code)
 
-	var response map[string]interface{}
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
 	err = json.Unmarshal(rr.Body.Bytes(), &response)
 	require.NoError(t, err)
-	assert.Equal(t, "accepted", response["status"])
+	assert.Equal(t, "accepted", response.Data["status"])
+
+	// Verify the enrichment was stored
+	enriched, err := articleRepo.GetByID(ctx, article.ID)
+	require.NoError(t, err)
+	require.NotNil(t, enriched.EnrichedAt)
+	require.NotNil(t, enriched.ThreatType)
+	assert.Equal(t, "Ransomware", *enriched.ThreatType)
+	assert.Equal(t, []string{"Apply patches", "Monitor network", "Enable MFA"}, enriched.RecommendedActions)
+}
+
+// TestWebhook_EnrichmentComplete_UnknownArticle tests that enrichment of a missing article fails
+func TestWebhook_EnrichmentComplete_UnknownArticle(t *testing.T) {
+	// Setup
+	db := SetupTestDB(t)
+	defer TeardownTestDB(t, db)
+	defer CleanupDB(t, db)
+
+	handler := setupWebhookHandler(t, db)
+
+	payload, err := createWebhookPayload("enrichment.complete", handlers.EnrichmentCompleteData{
+		ArticleID:  uuid.New().String(),
+		ThreatType: stringPtr("Ransomware"),
+	})
+	require.NoError(t, err)
+
+	// Execute
+	rr := makeWebhookRequest(t, handler.HandleN8nWebhook, payload, signPayload(payload, testWebhookSecret))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
 }
 
 // TestWebhook_UnsupportedEventType tests handling of unknown event types
@@ -810,11 +860,13 @@ func TestWebhook_BulkImportPartialFailure(t *testing.T) {
 	// Assert
 	assert.Equal(t, http.StatusAccepted, rr.Code)
 
-	var response map[string]interface{}
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
 	err = json.Unmarshal(rr.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	result := response["result"].(map[string]interface{})
+	result := response.Data["result"].(map[string]interface{})
 	assert.Equal(t, float64(3), result["total"])
 	assert.Equal(t, float64(2), result["success"])
 	assert.Equal(t, float64(1), result["failed"])
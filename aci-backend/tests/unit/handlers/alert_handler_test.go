@@ -0,0 +1,175 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// alertRouter routes the alert endpoints to a handler using alertService
+func alertRouter(alertService *mocks.AlertService) http.Handler {
+	h := handlers.NewAlertHandler(alertService)
+	return newRouter(func(r chi.Router) {
+		r.Get("/v1/alerts", h.List)
+		r.Post("/v1/alerts", h.Create)
+		r.Get("/v1/alerts/{id}", h.GetByID)
+	})
+}
+
+func TestAlertHandler_Create(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+
+	t.Run("creates the alert", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+		alert := &domain.Alert{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			Name:      "Ransomware",
+			Type:      domain.AlertTypeKeyword,
+			Value:     "ransomware",
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		alertService.On("Create", mock.Anything, user.ID, "Ransomware", domain.AlertTypeKeyword, "ransomware", false, mock.Anything, mock.Anything).
+			Return(alert, nil).Once()
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodPost, "/v1/alerts", handlers.CreateAlertRequest{
+			Name:  "Ransomware",
+			Type:  "keyword",
+			Value: "ransomware",
+		})
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var got handlers.AlertResponse
+		decodeData(t, rec, &got)
+		assert.Equal(t, alert.ID, got.ID)
+		assert.Equal(t, "keyword", got.Type)
+		assert.True(t, got.IsActive)
+	})
+
+	t.Run("rejects an invalid type without calling the service", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodPost, "/v1/alerts", handlers.CreateAlertRequest{
+			Name:  "Ransomware",
+			Type:  "phrase",
+			Value: "ransomware",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"type"}, invalidFields(t, rec))
+		alertService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("maps a service validation error to 400", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+		alertService.On("Create", mock.Anything, user.ID, "Shared", domain.AlertTypeKeyword, "apt29", true, mock.Anything, mock.Anything).
+			Return(nil, &domainerrors.ValidationError{Field: "shared", Message: "you are not a member of an organization"}).Once()
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodPost, "/v1/alerts", handlers.CreateAlertRequest{
+			Name:   "Shared",
+			Type:   "keyword",
+			Value:  "apt29",
+			Shared: true,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, response.ErrCodeBadRequest, errorCode(t, rec))
+		assert.Equal(t, []string{"shared"}, invalidFields(t, rec))
+	})
+
+	t.Run("checks the value against the alert type", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodPost, "/v1/alerts", handlers.CreateAlertRequest{
+			Name:  "Severe",
+			Type:  "severity",
+			Value: "urgent",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"value"}, invalidFields(t, rec))
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+
+		rec := do(t, alertRouter(alertService), nil, http.MethodPost, "/v1/alerts", handlers.CreateAlertRequest{
+			Name:  "Ransomware",
+			Type:  "keyword",
+			Value: "ransomware",
+		})
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAlertHandler_List(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+
+	t.Run("returns the user's alerts", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+		alerts := []*domain.Alert{
+			{ID: uuid.New(), UserID: user.ID, Name: "Critical", Type: domain.AlertTypeSeverity, Value: "critical"},
+			{ID: uuid.New(), UserID: user.ID, Name: "Fortinet", Type: domain.AlertTypeVendor, Value: "fortinet"},
+		}
+		alertService.On("List", mock.Anything, user.ID).Return(alerts, nil).Once()
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodGet, "/v1/alerts", nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []handlers.AlertResponse
+		decodeData(t, rec, &got)
+		if assert.Len(t, got, 2) {
+			assert.Equal(t, alerts[0].ID, got[0].ID)
+			assert.Equal(t, "vendor", got[1].Type)
+		}
+	})
+
+	t.Run("maps a service failure to 500", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+		alertService.On("List", mock.Anything, user.ID).Return(nil, errors.New("connection refused")).Once()
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodGet, "/v1/alerts", nil)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, response.ErrCodeInternal, errorCode(t, rec))
+	})
+}
+
+func TestAlertHandler_GetByID(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+
+	t.Run("rejects a malformed ID", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodGet, "/v1/alerts/not-a-uuid", nil)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("returns 404 for another user's alert", func(t *testing.T) {
+		alertService := mocks.NewAlertService(t)
+		alertID := uuid.New()
+		alertService.On("GetByID", mock.Anything, alertID, user.ID).
+			Return(nil, &domainerrors.NotFoundError{Resource: "alert", ID: alertID.String()}).Once()
+
+		rec := do(t, alertRouter(alertService), &user, http.MethodGet, "/v1/alerts/"+alertID.String(), nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "ALERT_NOT_FOUND", errorCode(t, rec))
+	})
+}
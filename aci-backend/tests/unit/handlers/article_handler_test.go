@@ -0,0 +1,303 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/repository"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// fakeArticleRepository serves articles from a map; the embedded interface panics on any other call
+type fakeArticleRepository struct {
+	repository.ArticleRepository
+	articles map[uuid.UUID]*domain.Article
+	viewed   chan uuid.UUID
+	filter   *domain.ArticleFilter // last filter passed to List
+}
+
+func newFakeArticleRepository(articles ...*domain.Article) *fakeArticleRepository {
+	repo := &fakeArticleRepository{
+		articles: make(map[uuid.UUID]*domain.Article, len(articles)),
+		viewed:   make(chan uuid.UUID, 1),
+	}
+	for _, article := range articles {
+		repo.articles[article.ID] = article
+	}
+	return repo
+}
+
+func (r *fakeArticleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Article, error) {
+	article, ok := r.articles[id]
+	if !ok {
+		return nil, &domainerrors.NotFoundError{Resource: "article", ID: id.String()}
+	}
+	return article, nil
+}
+
+// List returns every article, recording the filter it was asked for
+func (r *fakeArticleRepository) List(ctx context.Context, filter *domain.ArticleFilter) ([]*domain.Article, int, error) {
+	r.filter = filter
+	articles := make([]*domain.Article, 0, len(r.articles))
+	for _, article := range r.articles {
+		articles = append(articles, article)
+	}
+	return articles, len(articles), nil
+}
+
+func (r *fakeArticleRepository) IncrementViewCount(ctx context.Context, id uuid.UUID) error {
+	r.viewed <- id
+	return nil
+}
+
+// articleRouter routes the article endpoints to a handler using articleRepo, searchService and engagementService
+func articleRouter(articleRepo repository.ArticleRepository, searchService *mocks.SearchService, engagementService *mocks.EngagementService) http.Handler {
+	h := handlers.NewArticleHandler(articleRepo, searchService, engagementService)
+	return newRouter(func(r chi.Router) {
+		r.Get("/v1/articles/search", h.Search)
+		r.Get("/v1/articles/{id}", h.GetByID)
+		r.Post("/v1/articles/{id}/bookmark", h.AddBookmark)
+		r.Delete("/v1/articles/{id}/bookmark", h.RemoveBookmark)
+		r.Post("/v1/articles/{id}/read", h.MarkRead)
+	})
+}
+
+// articleListRouter routes the article list to a handler using articleRepo, flagging articles
+// with inventoryService unless it is nil
+func articleListRouter(t *testing.T, articleRepo repository.ArticleRepository, inventoryService *mocks.SoftwareInventoryService) http.Handler {
+	h := handlers.NewArticleHandler(articleRepo, mocks.NewSearchService(t), mocks.NewEngagementService(t))
+	if inventoryService != nil {
+		h.SetSoftwareInventoryService(inventoryService)
+	}
+	return newRouter(func(r chi.Router) {
+		r.Get("/v1/articles", h.List)
+	})
+}
+
+func TestArticleHandler_List_AffectsStack(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	orgID := uuid.New()
+	newArticle := func() *domain.Article {
+		return &domain.Article{ID: uuid.New(), Title: "Log4Shell exploited", Slug: "log4shell-exploited", IsPublished: true, CVEs: []string{"CVE-2021-44228"}}
+	}
+
+	t.Run("scopes the filter to the caller's inventories and flags the articles", func(t *testing.T) {
+		article := newArticle()
+		articleRepo := newFakeArticleRepository(article)
+		inventoryService := mocks.NewSoftwareInventoryService(t)
+		inventoryService.On("ApplyTo", mock.Anything, user.ID, mock.AnythingOfType("*domain.ArticleFilter")).
+			Run(func(args mock.Arguments) {
+				filter := args.Get(2).(*domain.ArticleFilter)
+				filter.StackUserID = &user.ID
+				filter.StackOrganizationID = &orgID
+			}).Return(nil).Once()
+		inventoryService.On("Annotate", mock.Anything, user.ID, []*domain.Article{article}).
+			Run(func(args mock.Arguments) {
+				for _, a := range args.Get(2).([]*domain.Article) {
+					a.AffectsStack = true
+					a.StackProducts = []string{"apache log4j 2.14.1"}
+				}
+			}).Return(nil).Once()
+
+		rec := do(t, articleListRouter(t, articleRepo, inventoryService), &user, http.MethodGet, "/v1/articles?affects_stack=true", nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.NotNil(t, articleRepo.filter.AffectsStack) {
+			assert.True(t, *articleRepo.filter.AffectsStack)
+		}
+		assert.Equal(t, &orgID, articleRepo.filter.StackOrganizationID)
+
+		var got []handlers.ArticleResponse
+		decodeData(t, rec, &got)
+		if assert.Len(t, got, 1) {
+			assert.True(t, got[0].AffectsStack)
+			assert.Equal(t, []string{"apache log4j 2.14.1"}, got[0].StackProducts)
+		}
+	})
+
+	t.Run("serves the list without the badge when matching fails", func(t *testing.T) {
+		inventoryService := mocks.NewSoftwareInventoryService(t)
+		inventoryService.On("Annotate", mock.Anything, user.ID, mock.Anything).Return(errors.New("connection reset")).Once()
+
+		rec := do(t, articleListRouter(t, newFakeArticleRepository(newArticle()), inventoryService), &user, http.MethodGet, "/v1/articles", nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []handlers.ArticleResponse
+		decodeData(t, rec, &got)
+		if assert.Len(t, got, 1) {
+			assert.False(t, got[0].AffectsStack)
+		}
+	})
+
+	t.Run("reports the filter unavailable without inventory matching", func(t *testing.T) {
+		rec := do(t, articleListRouter(t, newFakeArticleRepository(newArticle()), nil), &user, http.MethodGet, "/v1/articles?affects_stack=true", nil)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
+
+func TestArticleHandler_GetByID(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	published := &domain.Article{ID: uuid.New(), Title: "Published", Slug: "published", IsPublished: true}
+	draft := &domain.Article{ID: uuid.New(), Title: "Draft", Slug: "draft"}
+
+	t.Run("returns a published article and counts the view", func(t *testing.T) {
+		articleRepo := newFakeArticleRepository(published)
+
+		rec := do(t, articleRouter(articleRepo, mocks.NewSearchService(t), mocks.NewEngagementService(t)), &user, http.MethodGet, "/v1/articles/"+published.ID.String(), nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got handlers.ArticleDetailResponse
+		decodeData(t, rec, &got)
+		assert.Equal(t, published.ID, got.ID)
+		assert.Equal(t, published.ID, <-articleRepo.viewed)
+	})
+
+	t.Run("hides unpublished and unknown articles", func(t *testing.T) {
+		router := articleRouter(newFakeArticleRepository(draft), mocks.NewSearchService(t), mocks.NewEngagementService(t))
+
+		rec := do(t, router, &user, http.MethodGet, "/v1/articles/"+draft.ID.String(), nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = do(t, router, &user, http.MethodGet, "/v1/articles/"+uuid.NewString(), nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects a malformed ID", func(t *testing.T) {
+		router := articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), mocks.NewEngagementService(t))
+
+		rec := do(t, router, &user, http.MethodGet, "/v1/articles/not-a-uuid", nil)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestArticleHandler_Search(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+
+	t.Run("returns scored results with a suggestion", func(t *testing.T) {
+		searchService := mocks.NewSearchService(t)
+		article := &domain.Article{ID: uuid.New(), Title: "Ransomware wave", Slug: "ransomware-wave", IsPublished: true}
+		searchService.On("Search", mock.Anything, "ransomwear", mock.AnythingOfType("*domain.ArticleFilter")).
+			Return(&service.SearchPage{
+				Results:    []*service.SearchResult{{Article: article, Score: 0.8}},
+				Total:      1,
+				DidYouMean: "ransomware",
+			}, nil).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), searchService, mocks.NewEngagementService(t)), &user, http.MethodGet, "/v1/articles/search?q=ransomwear", nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []struct {
+			Article handlers.ArticleResponse `json:"article"`
+			Score   float64                  `json:"score"`
+		}
+		meta := decodeData(t, rec, &got)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, article.ID, got[0].Article.ID)
+			assert.Equal(t, 0.8, got[0].Score)
+		}
+		assert.Equal(t, "ransomware", meta.DidYouMean)
+	})
+
+	t.Run("requires a query", func(t *testing.T) {
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), mocks.NewEngagementService(t)), &user, http.MethodGet, "/v1/articles/search", nil)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("maps an invalid query to 400", func(t *testing.T) {
+		searchService := mocks.NewSearchService(t)
+		searchService.On("Search", mock.Anything, "\"unclosed", mock.Anything).
+			Return(nil, &domainerrors.ValidationError{Field: "q", Message: "unbalanced quotes"}).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), searchService, mocks.NewEngagementService(t)), &user, http.MethodGet, "/v1/articles/search?q=%22unclosed", nil)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestArticleHandler_Bookmark(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	articleID := uuid.New()
+	path := "/v1/articles/" + articleID.String() + "/bookmark"
+
+	t.Run("bookmarks the article for the caller", func(t *testing.T) {
+		engagementService := mocks.NewEngagementService(t)
+		engagementService.On("AddBookmark", mock.Anything, user.ID, articleID).Return(nil).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), engagementService), &user, http.MethodPost, path, nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got map[string]bool
+		decodeData(t, rec, &got)
+		assert.True(t, got["bookmarked"])
+	})
+
+	t.Run("removes the caller's bookmark", func(t *testing.T) {
+		engagementService := mocks.NewEngagementService(t)
+		engagementService.On("RemoveBookmark", mock.Anything, user.ID, articleID).Return(nil).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), engagementService), &user, http.MethodDelete, path, nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got map[string]bool
+		decodeData(t, rec, &got)
+		assert.False(t, got["bookmarked"])
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), mocks.NewEngagementService(t)), nil, http.MethodPost, path, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("reports a service failure as 500", func(t *testing.T) {
+		engagementService := mocks.NewEngagementService(t)
+		engagementService.On("AddBookmark", mock.Anything, user.ID, articleID).Return(errors.New("connection reset")).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), engagementService), &user, http.MethodPost, path, nil)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestArticleHandler_MarkRead(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	articleID := uuid.New()
+	path := "/v1/articles/" + articleID.String() + "/read"
+
+	t.Run("records the reading time", func(t *testing.T) {
+		engagementService := mocks.NewEngagementService(t)
+		engagementService.On("MarkRead", mock.Anything, user.ID, articleID, mock.MatchedBy(func(seconds *int) bool {
+			return seconds != nil && *seconds == 90
+		})).Return(nil).Once()
+		seconds := 90
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), engagementService), &user, http.MethodPost, path, handlers.MarkReadRequest{
+			ReadingTimeSeconds: &seconds,
+		})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("accepts an empty body", func(t *testing.T) {
+		engagementService := mocks.NewEngagementService(t)
+		engagementService.On("MarkRead", mock.Anything, user.ID, articleID, (*int)(nil)).Return(nil).Once()
+
+		rec := do(t, articleRouter(newFakeArticleRepository(), mocks.NewSearchService(t), engagementService), &user, http.MethodPost, path, nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,321 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/domain/entities"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+)
+
+// authRouter routes the public auth endpoints to a handler using authService
+func authRouter(authService *mocks.AuthService) http.Handler {
+	h := handlers.NewAuthHandler(authService)
+	return newPublicRouter(func(r chi.Router) {
+		r.Post("/v1/auth/register", h.Register)
+		r.Post("/v1/auth/login", h.Login)
+		r.Post("/v1/auth/refresh", h.Refresh)
+		r.Post("/v1/auth/logout", h.Logout)
+		r.Get("/v1/auth/csrf", h.CSRFToken)
+	})
+}
+
+// cookieAuthRouter routes the public auth endpoints, guarded as in production, to a handler
+// using authService in cookie auth mode
+func cookieAuthRouter(authService *mocks.AuthService, cookies *handlers.AuthCookies) http.Handler {
+	h := handlers.NewAuthHandler(authService)
+	h.SetAuthCookies(cookies)
+	return newPublicRouter(func(r chi.Router) {
+		r.With(middleware.CSRF(handlers.RefreshCookieName)).Post("/v1/auth/refresh", h.Refresh)
+		r.Get("/v1/auth/csrf", h.CSRFToken)
+	})
+}
+
+// findCookie returns the named cookie set by the response
+func findCookie(t *testing.T, rec *httptest.ResponseRecorder, name string) *http.Cookie {
+	t.Helper()
+
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	require.Failf(t, "cookie not set", "no %s cookie in the response", name)
+	return nil
+}
+
+// accountRouter routes the auth endpoints that need the caller's identity to a handler using authService
+func accountRouter(authService *mocks.AuthService) http.Handler {
+	h := handlers.NewAuthHandler(authService)
+	return newRouter(func(r chi.Router) {
+		r.Post("/v1/auth/logout", h.Logout)
+		r.Post("/v1/users/me/password", h.ChangePassword)
+	})
+}
+
+func TestAuthHandler_Register(t *testing.T) {
+	t.Run("registers the user", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		user := &entities.User{
+			ID:    uuid.New(),
+			Email: "analyst@example.com",
+			Name:  "Ana Lyst",
+			Role:  entities.RoleUser,
+		}
+		tokens := &jwt.TokenPair{AccessToken: "access", RefreshToken: "refresh", ExpiresAt: time.Now().Add(15 * time.Minute)}
+		authService.On("Register", mock.Anything, "analyst@example.com", "Str0ngPass", "Ana Lyst").Return(user, tokens, nil).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/register", handlers.RegisterRequest{
+			Email:    "analyst@example.com",
+			Password: "Str0ngPass",
+			Name:     "Ana Lyst",
+		})
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var got handlers.AuthResponse
+		decodeData(t, rec, &got)
+		assert.Equal(t, user.ID.String(), got.User.ID)
+		assert.Equal(t, "refresh", got.RefreshToken)
+	})
+
+	t.Run("lists every invalid field without calling the service", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/register", handlers.RegisterRequest{
+			Email:    "analyst@example",
+			Password: "password",
+			Name:     "A",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.ElementsMatch(t, []string{"email", "password", "name"}, invalidFields(t, rec))
+	})
+
+	t.Run("maps a taken email to 409", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("Register", mock.Anything, "analyst@example.com", "Str0ngPass", "Ana Lyst").
+			Return(nil, nil, &domainerrors.ConflictError{Resource: "user", Field: "email", Value: "analyst@example.com"}).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/register", handlers.RegisterRequest{
+			Email:    "analyst@example.com",
+			Password: "Str0ngPass",
+			Name:     "Ana Lyst",
+		})
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	t.Run("requires a password", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/login", handlers.LoginRequest{
+			Email: "analyst@example.com",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"password"}, invalidFields(t, rec))
+	})
+
+	t.Run("maps wrong credentials to 401", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("Login", mock.Anything, "analyst@example.com", "WrongPass1", mock.Anything, mock.Anything).
+			Return(nil, nil, domainerrors.ErrUnauthorized).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/login", handlers.LoginRequest{
+			Email:    "analyst@example.com",
+			Password: "WrongPass1",
+		})
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAuthHandler_CSRFToken(t *testing.T) {
+	t.Run("is not found without cookie authentication", func(t *testing.T) {
+		rec := do(t, authRouter(mocks.NewAuthService(t)), nil, http.MethodGet, "/v1/auth/csrf", nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "COOKIE_AUTHENTICATION_NOT_FOUND", errorCode(t, rec))
+	})
+}
+
+func TestAuthHandler_CookieAuthentication(t *testing.T) {
+	for mode, sameSite := range map[string]http.SameSite{"lax": http.SameSiteLaxMode, "strict": http.SameSiteStrictMode} {
+		t.Run("rotates the refresh cookie with SameSite "+mode, func(t *testing.T) {
+			cookies, err := handlers.NewAuthCookies("example.com", true, mode, 7*24*time.Hour)
+			require.NoError(t, err)
+
+			authService := mocks.NewAuthService(t)
+			tokens := &jwt.TokenPair{AccessToken: "new-access", RefreshToken: "new-refresh", ExpiresAt: time.Now().Add(15 * time.Minute)}
+			authService.On("Refresh", mock.Anything, "old-refresh", mock.Anything, mock.Anything).Return(tokens, nil).Once()
+			router := cookieAuthRouter(authService, cookies)
+
+			rec := do(t, router, nil, http.MethodGet, "/v1/auth/csrf", nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			csrf := findCookie(t, rec, middleware.CSRFCookieName)
+			assert.False(t, csrf.HttpOnly)
+			assert.Equal(t, sameSite, csrf.SameSite)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/auth/refresh", nil)
+			req.AddCookie(&http.Cookie{Name: handlers.RefreshCookieName, Value: "old-refresh"})
+			req.AddCookie(&http.Cookie{Name: middleware.CSRFCookieName, Value: csrf.Value})
+			req.Header.Set(middleware.CSRFHeaderName, csrf.Value)
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			refresh := findCookie(t, rec, handlers.RefreshCookieName)
+			assert.Equal(t, "new-refresh", refresh.Value)
+			assert.True(t, refresh.HttpOnly)
+			assert.True(t, refresh.Secure)
+			assert.Equal(t, sameSite, refresh.SameSite)
+			assert.Equal(t, "/v1/auth", refresh.Path)
+			assert.Equal(t, "example.com", refresh.Domain)
+
+			var got handlers.TokenResponse
+			decodeData(t, rec, &got)
+			assert.Empty(t, got.RefreshToken)
+		})
+	}
+
+	t.Run("rejects a cookie refresh without the CSRF token", func(t *testing.T) {
+		cookies, err := handlers.NewAuthCookies("", false, "strict", time.Hour)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: handlers.RefreshCookieName, Value: "old-refresh"})
+		rec := httptest.NewRecorder()
+		cookieAuthRouter(mocks.NewAuthService(t), cookies).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, "CSRF_FAILED", errorCode(t, rec))
+	})
+
+	t.Run("does not offer SameSite None", func(t *testing.T) {
+		_, err := handlers.NewAuthCookies("", true, "none", time.Hour)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	t.Run("rotates the token pair", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		tokens := &jwt.TokenPair{AccessToken: "new-access", RefreshToken: "new-refresh", ExpiresAt: time.Now().Add(15 * time.Minute)}
+		authService.On("Refresh", mock.Anything, "old-refresh", mock.Anything, mock.Anything).Return(tokens, nil).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/refresh", handlers.RefreshRequest{RefreshToken: "old-refresh"})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got handlers.TokenResponse
+		decodeData(t, rec, &got)
+		assert.Equal(t, "new-access", got.AccessToken)
+		assert.Equal(t, "new-refresh", got.RefreshToken)
+	})
+
+	t.Run("requires a refresh token", func(t *testing.T) {
+		rec := do(t, authRouter(mocks.NewAuthService(t)), nil, http.MethodPost, "/v1/auth/refresh", handlers.RefreshRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("maps a revoked token to 401", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("Refresh", mock.Anything, "revoked", mock.Anything, mock.Anything).Return(nil, domainerrors.ErrUnauthorized).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/refresh", handlers.RefreshRequest{RefreshToken: "revoked"})
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	t.Run("revokes the given refresh token", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("Logout", mock.Anything, "refresh").Return(nil).Once()
+
+		rec := do(t, authRouter(authService), nil, http.MethodPost, "/v1/auth/logout", handlers.LogoutRequest{RefreshToken: "refresh"})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("revokes every session of the caller", func(t *testing.T) {
+		user := newTestUser(domain.RoleUser)
+		authService := mocks.NewAuthService(t)
+		authService.On("LogoutAll", mock.Anything, user.ID).Return(nil).Once()
+
+		rec := do(t, accountRouter(authService), &user, http.MethodPost, "/v1/auth/logout", handlers.LogoutRequest{AllDevices: true})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("requires a refresh token for a single device", func(t *testing.T) {
+		rec := do(t, authRouter(mocks.NewAuthService(t)), nil, http.MethodPost, "/v1/auth/logout", handlers.LogoutRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+
+	t.Run("changes the caller's password", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("ChangePassword", mock.Anything, user.ID, "Old-Pass1", "N3w-Password", mock.Anything, mock.Anything).Return(nil).Once()
+
+		rec := do(t, accountRouter(authService), &user, http.MethodPost, "/v1/users/me/password", handlers.ChangePasswordRequest{
+			CurrentPassword: "Old-Pass1",
+			NewPassword:     "N3w-Password",
+		})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("rejects a weak new password without calling the service", func(t *testing.T) {
+		rec := do(t, accountRouter(mocks.NewAuthService(t)), &user, http.MethodPost, "/v1/users/me/password", handlers.ChangePasswordRequest{
+			CurrentPassword: "Old-Pass1",
+			NewPassword:     "password",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"new_password"}, invalidFields(t, rec))
+	})
+
+	t.Run("reports a wrong current password as 400", func(t *testing.T) {
+		authService := mocks.NewAuthService(t)
+		authService.On("ChangePassword", mock.Anything, user.ID, "Wrong-Pass1", "N3w-Password", mock.Anything, mock.Anything).
+			Return(domainerrors.ErrUnauthorized).Once()
+
+		rec := do(t, accountRouter(authService), &user, http.MethodPost, "/v1/users/me/password", handlers.ChangePasswordRequest{
+			CurrentPassword: "Wrong-Pass1",
+			NewPassword:     "N3w-Password",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "INVALID_PASSWORD", errorCode(t, rec))
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		rec := do(t, accountRouter(mocks.NewAuthService(t)), nil, http.MethodPost, "/v1/users/me/password", handlers.ChangePasswordRequest{
+			CurrentPassword: "Old-Pass1",
+			NewPassword:     "N3w-Password",
+		})
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -0,0 +1,88 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+)
+
+// newsletterRouter routes the newsletter endpoints to a handler using newsletterService
+func newsletterRouter(newsletterService *mocks.NewsletterService) http.Handler {
+	h := handlers.NewNewsletterHandler(newsletterService)
+	return newRouter(func(r chi.Router) {
+		r.Post("/v1/admin/newsletter/subscribers", h.AddSubscriber)
+		r.Post("/v1/admin/newsletter/campaigns", h.CreateCampaign)
+	})
+}
+
+func TestNewsletterHandler_AddSubscriber(t *testing.T) {
+	admin := newTestUser(domain.RoleAdmin)
+
+	t.Run("lists every invalid field without calling the service", func(t *testing.T) {
+		newsletterService := mocks.NewNewsletterService(t)
+
+		rec := do(t, newsletterRouter(newsletterService), &admin, http.MethodPost, "/v1/admin/newsletter/subscribers", handlers.AddSubscriberRequest{
+			Email: "reader@example",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.ElementsMatch(t, []string{"email", "consent_source"}, invalidFields(t, rec))
+	})
+
+	t.Run("maps a suppressed address to a field error", func(t *testing.T) {
+		newsletterService := mocks.NewNewsletterService(t)
+		newsletterService.On("AddSubscriber", mock.Anything, "reader@example.com", (*string)(nil), "signup-form").
+			Return(nil, &domainerrors.ValidationError{Field: "email", Message: "address is on the suppression list"}).Once()
+
+		rec := do(t, newsletterRouter(newsletterService), &admin, http.MethodPost, "/v1/admin/newsletter/subscribers", handlers.AddSubscriberRequest{
+			Email:         "reader@example.com",
+			ConsentSource: "signup-form",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"email"}, invalidFields(t, rec))
+	})
+}
+
+func TestNewsletterHandler_CreateCampaign(t *testing.T) {
+	admin := newTestUser(domain.RoleAdmin)
+
+	t.Run("rejects repeated and nil article IDs", func(t *testing.T) {
+		newsletterService := mocks.NewNewsletterService(t)
+		articleID := uuid.New()
+
+		rec := do(t, newsletterRouter(newsletterService), &admin, http.MethodPost, "/v1/admin/newsletter/campaigns", handlers.CampaignRequest{
+			Subject:    "Weekly brief",
+			ArticleIDs: []uuid.UUID{articleID, articleID},
+		})
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"article_ids"}, invalidFields(t, rec))
+
+		rec = do(t, newsletterRouter(newsletterService), &admin, http.MethodPost, "/v1/admin/newsletter/campaigns", handlers.CampaignRequest{
+			Subject:    "Weekly brief",
+			ArticleIDs: []uuid.UUID{articleID, uuid.Nil},
+		})
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"article_ids.1"}, invalidFields(t, rec))
+	})
+
+	t.Run("requires a subject", func(t *testing.T) {
+		newsletterService := mocks.NewNewsletterService(t)
+
+		rec := do(t, newsletterRouter(newsletterService), &admin, http.MethodPost, "/v1/admin/newsletter/campaigns", handlers.CampaignRequest{
+			ArticleIDs: []uuid.UUID{uuid.New()},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"subject"}, invalidFields(t, rec))
+	})
+}
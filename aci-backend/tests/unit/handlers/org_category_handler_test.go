@@ -0,0 +1,165 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// orgCategoryRouter routes the organization category endpoints to a handler using orgCategoryService
+func orgCategoryRouter(orgCategoryService *mocks.OrgCategoryService) http.Handler {
+	h := handlers.NewOrgCategoryHandler(orgCategoryService)
+	return newRouter(func(r chi.Router) {
+		r.Get("/v1/orgs/{id}/categories", h.List)
+		r.Post("/v1/orgs/{id}/categories", h.Create)
+		r.Get("/v1/orgs/{id}/categories/{categoryID}/articles", h.ListArticles)
+		r.Put("/v1/orgs/{id}/categories/{categoryID}/articles/{articleID}", h.AddArticle)
+	})
+}
+
+func TestOrgCategoryHandler_Create(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	orgID := uuid.New()
+	path := "/v1/orgs/" + orgID.String() + "/categories"
+	body := handlers.OrgCategoryRequest{
+		Name:  "Our Stack",
+		Color: "#3366FF",
+		Rules: []domain.OrgCategoryRule{{Vendors: []string{"fortinet"}}},
+	}
+	input := service.OrgCategoryInput{Name: body.Name, Color: body.Color, Rules: body.Rules}
+
+	t.Run("creates the category", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		category := &domain.OrgCategory{
+			ID:             uuid.New(),
+			OrganizationID: orgID,
+			Name:           "Our Stack",
+			Slug:           "our-stack",
+			Color:          "#3366FF",
+			Rules:          body.Rules,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+		orgCategoryService.On("Create", mock.Anything, orgID, user.ID, domain.RoleUser, input).Return(category, nil).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPost, path, body)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var got domain.OrgCategory
+		decodeData(t, rec, &got)
+		assert.Equal(t, category.ID, got.ID)
+		assert.Equal(t, "our-stack", got.Slug)
+	})
+
+	t.Run("maps a non-admin member to 403", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		orgCategoryService.On("Create", mock.Anything, orgID, user.ID, domain.RoleUser, input).
+			Return(nil, domainerrors.ErrForbidden).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPost, path, body)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, response.ErrCodeForbidden, errorCode(t, rec))
+	})
+
+	t.Run("maps a slug clash to 409", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		orgCategoryService.On("Create", mock.Anything, orgID, user.ID, domain.RoleUser, input).
+			Return(nil, &domainerrors.ConflictError{Resource: "organization category", Field: "slug", Value: "our-stack"}).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPost, path, body)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, "ORGANIZATION_CATEGORY_ALREADY_EXISTS", errorCode(t, rec))
+	})
+
+	t.Run("maps a full organization to a limit code", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		orgCategoryService.On("Create", mock.Anything, orgID, user.ID, domain.RoleUser, input).
+			Return(nil, &domainerrors.LimitExceededError{Resource: "organization category", Limit: domain.MaxOrgCategories}).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPost, path, body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "ORGANIZATION_CATEGORY_LIMIT_EXCEEDED", errorCode(t, rec))
+	})
+
+	t.Run("rejects a malformed organization ID", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPost, "/v1/orgs/acme/categories", body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestOrgCategoryHandler_ListArticles(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	orgID := uuid.New()
+	categoryID := uuid.New()
+	path := "/v1/orgs/" + orgID.String() + "/categories/" + categoryID.String() + "/articles"
+
+	t.Run("returns a page of articles with pagination meta", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		articles := []*domain.Article{
+			{ID: uuid.New(), Title: "FortiOS flaw exploited", Slug: "fortios-flaw-exploited", Severity: domain.SeverityCritical},
+			{ID: uuid.New(), Title: "FortiGate patch released", Slug: "fortigate-patch-released", Severity: domain.SeverityHigh},
+		}
+		orgCategoryService.On("Articles", mock.Anything, orgID, categoryID, user.ID, domain.RoleUser,
+			mock.MatchedBy(func(filter *domain.ArticleFilter) bool {
+				return filter.Page == 2 && filter.PageSize == 2
+			})).Return(articles, 5, nil).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodGet, path+"?page=2&page_size=2", nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []handlers.ArticleResponse
+		meta := decodeData(t, rec, &got)
+		assert.Len(t, got, 2)
+		if assert.NotNil(t, meta) {
+			assert.Equal(t, 2, meta.Page)
+			assert.Equal(t, 5, meta.TotalCount)
+			assert.Equal(t, 3, meta.TotalPages)
+			assert.Equal(t, path+"?page=3&page_size=2", meta.Next)
+			assert.Equal(t, path+"?page=1&page_size=2", meta.Prev)
+		}
+	})
+
+	t.Run("maps a category of another organization to 404", func(t *testing.T) {
+		orgCategoryService := mocks.NewOrgCategoryService(t)
+		orgCategoryService.On("Articles", mock.Anything, orgID, categoryID, user.ID, domain.RoleUser, mock.Anything).
+			Return(nil, 0, &domainerrors.NotFoundError{Resource: "organization category", ID: categoryID.String()}).Once()
+
+		rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodGet, path, nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "ORGANIZATION_CATEGORY_NOT_FOUND", errorCode(t, rec))
+	})
+}
+
+func TestOrgCategoryHandler_AddArticle(t *testing.T) {
+	user := newTestUser(domain.RoleAdmin)
+	orgID := uuid.New()
+	categoryID := uuid.New()
+	articleID := uuid.New()
+
+	orgCategoryService := mocks.NewOrgCategoryService(t)
+	orgCategoryService.On("AddArticle", mock.Anything, orgID, categoryID, articleID, user.ID, domain.RoleAdmin).Return(nil).Once()
+
+	rec := do(t, orgCategoryRouter(orgCategoryService), &user, http.MethodPut,
+		"/v1/orgs/"+orgID.String()+"/categories/"+categoryID.String()+"/articles/"+articleID.String(), nil)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
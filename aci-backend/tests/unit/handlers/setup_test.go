@@ -0,0 +1,165 @@
+// Package handlers_test unit tests the HTTP handlers against mocked services
+// Requests go through a chi router and the auth middleware, as in the server, but no database
+// or container is needed: each test sets the service calls it expects on the mocks in
+// internal/api/handlers/mocks
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/pkg/jwt"
+	"github.com/phillipboles/aci-backend/internal/pkg/validator"
+)
+
+// jwtService signs the tokens of test requests; it is created once as key generation is slow
+var jwtService jwt.Service
+
+func TestMain(m *testing.M) {
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	dir, err := os.MkdirTemp("", "handlers-test")
+	if err != nil {
+		panic(err)
+	}
+
+	jwtService, err = newJWTService(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// newJWTService creates a JWT service with a new key pair written to dir
+func newJWTService(dir string) (jwt.Service, error) {
+	privatePEM, publicPEM, err := jwt.GenerateKeyPair(jwt.DefaultKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	privatePath := filepath.Join(dir, "private.pem")
+	publicPath := filepath.Join(dir, "public.pem")
+	if err := os.WriteFile(privatePath, privatePEM, 0o600); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(publicPath, publicPEM, 0o600); err != nil {
+		return nil, err
+	}
+
+	return jwt.NewService(&jwt.Config{
+		PrivateKeyPath: privatePath,
+		PublicKeyPath:  publicPath,
+		Issuer:         "aci-backend-test",
+	})
+}
+
+// testUser is the authenticated caller of a test request
+type testUser struct {
+	ID   uuid.UUID
+	Role domain.UserRole
+}
+
+// newTestUser returns a caller with the given role
+func newTestUser(role domain.UserRole) testUser {
+	return testUser{ID: uuid.New(), Role: role}
+}
+
+// newRouter returns a router whose routes require authentication, as the server's do
+func newRouter(register func(r chi.Router)) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.Auth(jwtService))
+	register(r)
+	return r
+}
+
+// newPublicRouter returns a router whose routes need no authentication
+func newPublicRouter(register func(r chi.Router)) http.Handler {
+	r := chi.NewRouter()
+	register(r)
+	return r
+}
+
+// do sends a request to the router as user, or unauthenticated when user is nil, and returns the recorded response
+// A non-nil body is sent as JSON
+func do(t *testing.T, router http.Handler, user *testUser, method, path string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(payload)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+
+	if user != nil {
+		tokens, err := jwtService.GenerateTokenPair(user.ID, "analyst@example.com", string(user.Role))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeData decodes the data of a success response into v
+func decodeData(t *testing.T, rec *httptest.ResponseRecorder, v interface{}) *response.Meta {
+	t.Helper()
+
+	envelope := struct {
+		Data json.RawMessage `json:"data"`
+		Meta *response.Meta  `json:"meta"`
+	}{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+	require.NoError(t, json.Unmarshal(envelope.Data, v))
+	return envelope.Meta
+}
+
+// errorCode returns the code of an error response
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var body response.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Error.Code
+}
+
+// invalidFields returns the fields named by the details of a validation error response
+func invalidFields(t *testing.T, rec *httptest.ResponseRecorder) []string {
+	t.Helper()
+
+	body := struct {
+		Error struct {
+			Details []validator.FieldError `json:"details"`
+		} `json:"error"`
+	}{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+
+	fields := make([]string, len(body.Error.Details))
+	for i, detail := range body.Error.Details {
+		fields[i] = detail.Field
+	}
+	return fields
+}
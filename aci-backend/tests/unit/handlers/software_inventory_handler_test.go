@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	domainerrors "github.com/phillipboles/aci-backend/internal/domain/errors"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+// softwareInventoryRouter routes the software inventory endpoints to a handler using inventoryService
+func softwareInventoryRouter(inventoryService *mocks.SoftwareInventoryService) http.Handler {
+	h := handlers.NewSoftwareInventoryHandler(inventoryService)
+	return newRouter(func(r chi.Router) {
+		r.Put("/v1/users/me/inventory", h.ReplaceMine)
+		r.Put("/v1/orgs/{id}/inventory", h.ReplaceForOrganization)
+	})
+}
+
+func TestSoftwareInventoryHandler_ReplaceMine(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	body := handlers.SoftwareInventoryRequest{Items: []handlers.InventoryItemRequest{
+		{CPE: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"},
+		{Vendor: "Fortinet", Product: "FortiOS", Version: "7.2.4"},
+	}}
+
+	t.Run("replaces the caller's inventory", func(t *testing.T) {
+		inventoryService := mocks.NewSoftwareInventoryService(t)
+		item := &domain.InventoryItem{ID: uuid.New(), UserID: &user.ID, Vendor: "apache", Product: "log4j", Version: "2.14.1", MatchedCVEs: []string{"CVE-2021-44228"}}
+		inventoryService.On("ReplaceMine", mock.Anything, user.ID, []service.InventoryItemInput{
+			{CPE: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"},
+			{Vendor: "Fortinet", Product: "FortiOS", Version: "7.2.4"},
+		}).Return([]*domain.InventoryItem{item}, nil).Once()
+
+		rec := do(t, softwareInventoryRouter(inventoryService), &user, http.MethodPut, "/v1/users/me/inventory", body)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []domain.InventoryItem
+		decodeData(t, rec, &got)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, []string{"CVE-2021-44228"}, got[0].MatchedCVEs)
+		}
+	})
+
+	t.Run("requires a CPE name or a vendor and product", func(t *testing.T) {
+		rec := do(t, softwareInventoryRouter(mocks.NewSoftwareInventoryService(t)), &user, http.MethodPut, "/v1/users/me/inventory", handlers.SoftwareInventoryRequest{
+			Items: []handlers.InventoryItemRequest{{Version: "1.0"}},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.ElementsMatch(t, []string{"items.0.vendor", "items.0.product"}, invalidFields(t, rec))
+	})
+
+	t.Run("reports an unreadable CPE name against its item", func(t *testing.T) {
+		inventoryService := mocks.NewSoftwareInventoryService(t)
+		inventoryService.On("ReplaceMine", mock.Anything, user.ID, mock.Anything).
+			Return(nil, &domainerrors.ValidationError{Field: "items.1", Message: "not a CPE name"}).Once()
+
+		rec := do(t, softwareInventoryRouter(inventoryService), &user, http.MethodPut, "/v1/users/me/inventory", body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, []string{"items.1"}, invalidFields(t, rec))
+	})
+}
+
+func TestSoftwareInventoryHandler_ReplaceForOrganization(t *testing.T) {
+	user := newTestUser(domain.RoleUser)
+	orgID := uuid.New()
+	path := "/v1/orgs/" + orgID.String() + "/inventory"
+
+	t.Run("maps a non-admin member to 403", func(t *testing.T) {
+		inventoryService := mocks.NewSoftwareInventoryService(t)
+		inventoryService.On("ReplaceForOrganization", mock.Anything, orgID, user.ID, domain.RoleUser, mock.Anything).
+			Return(nil, domainerrors.ErrForbidden).Once()
+
+		rec := do(t, softwareInventoryRouter(inventoryService), &user, http.MethodPut, path, handlers.SoftwareInventoryRequest{})
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, response.ErrCodeForbidden, errorCode(t, rec))
+	})
+}
@@ -0,0 +1,303 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/api/handlers"
+	"github.com/phillipboles/aci-backend/internal/api/handlers/mocks"
+	"github.com/phillipboles/aci-backend/internal/domain"
+	"github.com/phillipboles/aci-backend/internal/service"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+// fakeWebhookLogRepository keeps webhook logs in memory
+type fakeWebhookLogRepository struct {
+	mu   sync.Mutex
+	logs map[uuid.UUID]domain.WebhookLog
+}
+
+func newFakeWebhookLogRepository() *fakeWebhookLogRepository {
+	return &fakeWebhookLogRepository{logs: make(map[uuid.UUID]domain.WebhookLog)}
+}
+
+func (r *fakeWebhookLogRepository) Create(ctx context.Context, log *domain.WebhookLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logs[log.ID] = *log
+	return nil
+}
+
+func (r *fakeWebhookLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	log, ok := r.logs[id]
+	if !ok {
+		return nil, errors.New("webhook log not found")
+	}
+	return &log, nil
+}
+
+func (r *fakeWebhookLogRepository) Update(ctx context.Context, log *domain.WebhookLog) error {
+	return r.Create(ctx, log)
+}
+
+func (r *fakeWebhookLogRepository) List(ctx context.Context, limit, offset int) ([]*domain.WebhookLog, error) {
+	return nil, nil
+}
+
+// fakeWebhookNonceRepository keeps claimed webhook signatures in memory
+type fakeWebhookNonceRepository struct {
+	mu     sync.Mutex
+	claims map[string]time.Time
+}
+
+func newFakeWebhookNonceRepository() *fakeWebhookNonceRepository {
+	return &fakeWebhookNonceRepository{claims: make(map[string]time.Time)}
+}
+
+func (r *fakeWebhookNonceRepository) Claim(ctx context.Context, signature string, expiresAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.claims[signature]; ok {
+		return false, nil
+	}
+	r.claims[signature] = expiresAt
+	return true, nil
+}
+
+func (r *fakeWebhookNonceRepository) Release(ctx context.Context, signature string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.claims, signature)
+	return nil
+}
+
+func (r *fakeWebhookNonceRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+// webhookRouter routes the n8n webhook to a handler using articleService and webhookLogRepo
+func webhookRouter(articleService *mocks.ArticleService, webhookLogRepo *fakeWebhookLogRepository, configure func(h *handlers.WebhookHandler)) http.Handler {
+	h := handlers.NewWebhookHandler(articleService, nil, webhookLogRepo, testWebhookSecret)
+	if configure != nil {
+		configure(h)
+	}
+	return newPublicRouter(func(r chi.Router) {
+		r.Post("/v1/webhooks/n8n", h.HandleN8nWebhook)
+	})
+}
+
+// signWebhook returns the X-N8N-Signature of body, signing "<timestamp>.<body>" when timestamp is set
+func signWebhook(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	if timestamp != "" {
+		mac.Write([]byte(timestamp + "."))
+	}
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendWebhook posts payload to the router with the given signature and timestamp headers
+func sendWebhook(t *testing.T, router http.Handler, payload interface{}, signature, timestamp string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	if signature == "" {
+		signature = signWebhook(timestamp, body)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/webhooks/n8n", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-N8N-Signature", signature)
+	if timestamp != "" {
+		req.Header.Set("X-N8N-Timestamp", timestamp)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// articleCreatedPayload returns an article.created event for a new article
+func articleCreatedPayload() map[string]interface{} {
+	return map[string]interface{}{
+		"event_type": "article.created",
+		"data": map[string]interface{}{
+			"title":           "Critical VPN flaw exploited",
+			"content":         "<p>Attackers are exploiting the flaw.</p>",
+			"source_url":      "https://example.com/vpn-flaw",
+			"category_slug":   "vulnerabilities",
+			"skip_enrichment": true,
+		},
+	}
+}
+
+func TestWebhookHandler_HandleN8nWebhook(t *testing.T) {
+	t.Run("creates the article and records the job", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		webhookLogRepo := newFakeWebhookLogRepository()
+		article := &domain.Article{ID: uuid.New(), Slug: "critical-vpn-flaw-exploited"}
+		articleService.On("CreateArticle", mock.Anything, mock.MatchedBy(func(data service.ArticleCreatedData) bool {
+			return data.Title == "Critical VPN flaw exploited" && data.SourceURL == "https://example.com/vpn-flaw" && data.SkipEnrichment
+		})).Return(article, nil).Once()
+
+		rec := sendWebhook(t, webhookRouter(articleService, webhookLogRepo, nil), articleCreatedPayload(), "", "")
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		var got struct {
+			JobID  string                 `json:"job_id"`
+			Result map[string]interface{} `json:"result"`
+		}
+		decodeData(t, rec, &got)
+		assert.Equal(t, article.ID.String(), got.Result["article_id"])
+
+		webhookLog, err := webhookLogRepo.GetByID(context.Background(), uuid.MustParse(got.JobID))
+		require.NoError(t, err)
+		assert.Equal(t, domain.WebhookStatusSuccess, webhookLog.Status)
+	})
+
+	t.Run("accepts a signed timestamp", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		replayService := mocks.NewWebhookReplayService(t)
+		articleService.On("CreateArticle", mock.Anything, mock.Anything).Return(&domain.Article{ID: uuid.New()}, nil).Once()
+		replayService.On("Check", mock.Anything, "1700000000", mock.Anything).Return(nil).Once()
+
+		router := webhookRouter(articleService, newFakeWebhookLogRepository(), func(h *handlers.WebhookHandler) {
+			h.SetReplayService(replayService, true)
+		})
+		rec := sendWebhook(t, router, articleCreatedPayload(), "", "1700000000")
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+
+	t.Run("rejects a bad signature without creating anything", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		webhookLogRepo := newFakeWebhookLogRepository()
+
+		rec := sendWebhook(t, webhookRouter(articleService, webhookLogRepo, nil), articleCreatedPayload(), "sha256=deadbeef", "")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, webhookLogRepo.logs)
+	})
+
+	t.Run("rejects a missing timestamp when one is required", func(t *testing.T) {
+		router := webhookRouter(mocks.NewArticleService(t), newFakeWebhookLogRepository(), func(h *handlers.WebhookHandler) {
+			h.SetReplayService(mocks.NewWebhookReplayService(t), true)
+		})
+
+		rec := sendWebhook(t, router, articleCreatedPayload(), "", "")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("accepts a retry of a delivery that failed and rejects a replay of one that succeeded", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		articleService.On("CreateArticle", mock.Anything, mock.Anything).Return(nil, errors.New("connection reset")).Once()
+		articleService.On("CreateArticle", mock.Anything, mock.Anything).Return(&domain.Article{ID: uuid.New()}, nil).Once()
+		nonceRepo := newFakeWebhookNonceRepository()
+
+		router := webhookRouter(articleService, newFakeWebhookLogRepository(), func(h *handlers.WebhookHandler) {
+			h.SetReplayService(service.NewWebhookReplayService(nonceRepo, 0), true)
+		})
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		rec := sendWebhook(t, router, articleCreatedPayload(), "", timestamp)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Empty(t, nonceRepo.claims)
+
+		rec = sendWebhook(t, router, articleCreatedPayload(), "", timestamp)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, nonceRepo.claims, 1)
+
+		rec = sendWebhook(t, router, articleCreatedPayload(), "", timestamp)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("rejects a replayed request", func(t *testing.T) {
+		replayService := mocks.NewWebhookReplayService(t)
+		replayService.On("Check", mock.Anything, "1700000000", mock.Anything).Return(service.ErrWebhookReplayed).Once()
+
+		router := webhookRouter(mocks.NewArticleService(t), newFakeWebhookLogRepository(), func(h *handlers.WebhookHandler) {
+			h.SetReplayService(replayService, true)
+		})
+		rec := sendWebhook(t, router, articleCreatedPayload(), "", "1700000000")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("lists the fields that break the event schema", func(t *testing.T) {
+		payload := articleCreatedPayload()
+		payload["data"] = map[string]interface{}{"title": "", "content": "<p>Body</p>"}
+
+		rec := sendWebhook(t, webhookRouter(mocks.NewArticleService(t), newFakeWebhookLogRepository(), nil), payload, "", "")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.ElementsMatch(t, []string{"data.source_url", "data.title"}, invalidFields(t, rec))
+	})
+
+	t.Run("rejects an unsupported event type", func(t *testing.T) {
+		webhookLogRepo := newFakeWebhookLogRepository()
+
+		rec := sendWebhook(t, webhookRouter(mocks.NewArticleService(t), webhookLogRepo, nil), map[string]interface{}{
+			"event_type": "article.archived",
+			"data":       map[string]interface{}{},
+		}, "", "")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Len(t, webhookLogRepo.logs, 1)
+		for _, webhookLog := range webhookLogRepo.logs {
+			assert.Equal(t, domain.WebhookStatusFailed, webhookLog.Status)
+		}
+	})
+
+	t.Run("reports a service failure and marks the job failed", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		webhookLogRepo := newFakeWebhookLogRepository()
+		articleService.On("CreateArticle", mock.Anything, mock.Anything).Return(nil, errors.New("connection reset")).Once()
+
+		rec := sendWebhook(t, webhookRouter(articleService, webhookLogRepo, nil), articleCreatedPayload(), "", "")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		require.Len(t, webhookLogRepo.logs, 1)
+		for _, webhookLog := range webhookLogRepo.logs {
+			assert.Equal(t, domain.WebhookStatusFailed, webhookLog.Status)
+		}
+	})
+
+	t.Run("treats a duplicate as handled", func(t *testing.T) {
+		articleService := mocks.NewArticleService(t)
+		existingID := uuid.New()
+		articleService.On("CreateArticle", mock.Anything, mock.Anything).
+			Return(nil, &service.DuplicateArticleError{ArticleID: existingID, Reason: domain.DuplicateReasonURL}).Once()
+
+		rec := sendWebhook(t, webhookRouter(articleService, newFakeWebhookLogRepository(), nil), articleCreatedPayload(), "", "")
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		var got struct {
+			Result map[string]interface{} `json:"result"`
+		}
+		decodeData(t, rec, &got)
+		assert.Equal(t, existingID.String(), got.Result["article_id"])
+		assert.Equal(t, true, got.Result["duplicate"])
+	})
+}
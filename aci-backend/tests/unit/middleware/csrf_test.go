@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phillipboles/aci-backend/internal/api/middleware"
+	"github.com/phillipboles/aci-backend/internal/api/response"
+)
+
+const refreshCookie = "aci_refresh"
+
+// csrfRequest sends a request through the CSRF middleware guarding refreshCookie, with the given
+// cookies and X-CSRF-Token header, and reports whether it reached the handler
+func csrfRequest(t *testing.T, method string, cookies map[string]string, header string) (*httptest.ResponseRecorder, bool) {
+	t.Helper()
+
+	reached := false
+	handler := middleware.CSRF(refreshCookie)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(method, "/v1/auth/refresh", nil)
+	for name, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+	if header != "" {
+		req.Header.Set(middleware.CSRFHeaderName, header)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, reached
+}
+
+// errorCode returns the error code of an error response
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var body response.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Error.Code
+}
+
+func TestCSRF(t *testing.T) {
+	t.Run("rejects an unsafe request with the refresh cookie and no token", func(t *testing.T) {
+		rec, reached := csrfRequest(t, http.MethodPost, map[string]string{refreshCookie: "refresh", middleware.CSRFCookieName: "token"}, "")
+
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, response.ErrCodeCSRFFailed, errorCode(t, rec))
+	})
+
+	t.Run("rejects a token that does not match the CSRF cookie", func(t *testing.T) {
+		rec, reached := csrfRequest(t, http.MethodPost, map[string]string{refreshCookie: "refresh", middleware.CSRFCookieName: "token"}, "other-token")
+
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, response.ErrCodeCSRFFailed, errorCode(t, rec))
+	})
+
+	t.Run("rejects a token without a CSRF cookie to match", func(t *testing.T) {
+		rec, reached := csrfRequest(t, http.MethodDelete, map[string]string{refreshCookie: "refresh"}, "token")
+
+		assert.False(t, reached)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, response.ErrCodeCSRFFailed, errorCode(t, rec))
+	})
+
+	t.Run("allows a token matching the CSRF cookie", func(t *testing.T) {
+		rec, reached := csrfRequest(t, http.MethodPost, map[string]string{refreshCookie: "refresh", middleware.CSRFCookieName: "token"}, "token")
+
+		assert.True(t, reached)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("passes safe methods through", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+			rec, reached := csrfRequest(t, method, map[string]string{refreshCookie: "refresh"}, "")
+
+			assert.True(t, reached, method)
+			assert.Equal(t, http.StatusNoContent, rec.Code, method)
+		}
+	})
+
+	t.Run("passes bearer requests without the refresh cookie through", func(t *testing.T) {
+		rec, reached := csrfRequest(t, http.MethodPost, map[string]string{middleware.CSRFCookieName: "token"}, "")
+
+		assert.True(t, reached)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
@@ -9,6 +9,7 @@ import hmac
 import json
 import re
 import sys
+import time
 from datetime import datetime
 from typing import Optional
 from urllib.request import urlopen, Request
@@ -41,9 +42,9 @@ SEVERITY_KEYWORDS = {
 }
 
 
-def create_hmac_signature(payload: bytes, secret: str) -> str:
-    """Create HMAC-SHA256 signature for webhook authentication."""
-    mac = hmac.new(secret.encode(), payload, hashlib.sha256)
+def create_hmac_signature(payload: bytes, secret: str, timestamp: str) -> str:
+    """Create HMAC-SHA256 signature over "<timestamp>.<payload>" for webhook authentication."""
+    mac = hmac.new(secret.encode(), timestamp.encode() + b"." + payload, hashlib.sha256)
     return f"sha256={mac.hexdigest()}"
 
 
@@ -151,10 +152,12 @@ def post_to_webhook(article_data: dict) -> bool:
     }
 
     payload_bytes = json.dumps(payload).encode("utf-8")
-    signature = create_hmac_signature(payload_bytes, WEBHOOK_SECRET)
+    timestamp = str(int(time.time()))
+    signature = create_hmac_signature(payload_bytes, WEBHOOK_SECRET, timestamp)
 
     headers = {
         "Content-Type": "application/json",
+        "X-N8N-Timestamp": timestamp,
         "X-N8N-Signature": signature,
     }
 